@@ -4,10 +4,16 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/Mobo140/chat/internal/app"
 )
 
+const (
+	verifyAuditCommand = "verify-audit"
+	purgeCommand       = "purge"
+)
+
 var (
 	configPath  string
 	logLevel    string
@@ -25,6 +31,17 @@ func main() {
 
 	ctx := context.Background()
 
+	switch flag.Arg(0) {
+	case verifyAuditCommand:
+		verifyAudit(ctx)
+
+		return
+	case purgeCommand:
+		purge(ctx)
+
+		return
+	}
+
 	a, err := app.NewApp(ctx, configPath, logLevel)
 	if err != nil {
 		log.Fatalf("failed to init app: %v", err)
@@ -35,3 +52,43 @@ func main() {
 		log.Fatalf("failed to run app: %v", err)
 	}
 }
+
+// verifyAudit walks the audit log hash chain, the exit code is 1 if it is broken.
+func verifyAudit(ctx context.Context) {
+	report, err := app.VerifyAuditLog(ctx, configPath, logLevel)
+	if err != nil {
+		log.Fatalf("failed to verify audit log: %v", err)
+	}
+
+	log.Printf("audit log: %d entries verified, %d written before the chain", report.Checked, report.Unchained)
+
+	if report.PurgedUpTo != 0 {
+		log.Printf("audit log: entries up to %d were purged by the retention", report.PurgedUpTo)
+	}
+
+	if report.BrokenID != 0 {
+		log.Printf("audit log chain is broken at entry %d: %s", report.BrokenID, report.Reason)
+		os.Exit(1)
+	}
+
+	log.Printf("audit log chain is intact")
+}
+
+// purge runs the retention purge once, RETENTION_DRY_RUN only reports the counts.
+func purge(ctx context.Context) {
+	report, err := app.Purge(ctx, configPath, logLevel)
+	if err != nil {
+		log.Fatalf("failed to purge: %v", err)
+	}
+
+	if report.DryRun {
+		log.Printf("dry run: %d deleted chats, %d messages in %d chats, %d audit log entries "+
+			"and %d outbox events would be deleted",
+			report.DeletedChats, report.Messages, report.Chats, report.Logs, report.OutboxEvents)
+
+		return
+	}
+
+	log.Printf("deleted %d deleted chats, %d messages in %d chats, %d audit log entries and %d outbox events",
+		report.DeletedChats, report.Messages, report.Chats, report.Logs, report.OutboxEvents)
+}
@@ -2,6 +2,8 @@ package app
 
 import (
 	"context"
+	"errors"
+	"expvar"
 	"flag"
 	"fmt"
 	"io"
@@ -9,12 +11,17 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/Mobo140/chat/internal/config"
 	"github.com/Mobo140/chat/internal/interceptor"
 	"github.com/Mobo140/chat/internal/ratelimiter"
+	"github.com/Mobo140/chat/internal/transport/bridge"
+	chatHandler "github.com/Mobo140/chat/internal/transport/handlers/chat"
 
 	desc "github.com/Mobo140/chat/pkg/chat_v1"
 	_ "github.com/Mobo140/chat/statik" // init statik
@@ -31,7 +38,9 @@ import (
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/encoding/protojson"
 	"gopkg.in/natefinch/lumberjack.v2"
 )
 
@@ -43,6 +52,7 @@ var (
 	logsMaxAge      = 7
 	chatServiceName = "chat_service"
 	reqTimeout      = 5 * time.Second
+	shutdownTimeout = 10 * time.Second
 )
 
 type App struct {
@@ -159,13 +169,26 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 	}
 
 	rateLimiter := ratelimiter.NewTokenBucketLimiter(ctx, countPerSecond, time.Second)
+	botRateLimiter := ratelimiter.NewKeyedLimiter(time.Minute)
+	grpcConfig := a.serviceProvider.GRPCConfig()
 	a.grpcServer = grpc.NewServer(grpc.Creds(creds),
+		// Dead connections cancel their streams, the clients may ping as
+		// often as the heartbeats of the streams come.
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    grpcConfig.KeepaliveTime(),
+			Timeout: grpcConfig.KeepaliveTimeout(),
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             grpcConfig.KeepaliveMinTime(),
+			PermitWithoutStream: true,
+		}),
 		grpc.UnaryInterceptor(
 			grpcMiddleware.ChainUnaryServer(
 				interceptor.LogInterceptor,
 				interceptor.ValidateInterceptor,
 				interceptor.TimeoutUnaryServerInterceptor(reqTimeout),
 				interceptor.NewRateLimiterInterceptor(rateLimiter).Unary,
+				interceptor.NewBotAuthInterceptor(a.serviceProvider.BotService(ctx), botRateLimiter).Unary,
 				interceptor.ServerTracingInterceptor,
 			),
 		))
@@ -203,7 +226,18 @@ func (a *App) initGRPCAccessClient(_ context.Context) error {
 }
 
 func (a *App) initHTTPServer(ctx context.Context) error {
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &downloadMarshaler{
+			HTTPBodyMarshaler: &runtime.HTTPBodyMarshaler{
+				Marshaler: &runtime.JSONPb{
+					MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+					UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+				},
+			},
+		}),
+	)
 
 	creds, err := credentials.NewClientTLSFromFile("secure/service.pem", "")
 	if err != nil {
@@ -219,10 +253,36 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 		return err
 	}
 
+	// The streams of the browsers: the gateway can't serve ConnectChat and
+	// Chat, the WebSocket bridges them and the Server-Sent Events bridge the
+	// events of ConnectChatEvents.
+	conn, err := grpc.NewClient(a.serviceProvider.GRPCConfig().Address(), opts...)
+	if err != nil {
+		return err
+	}
+	closer.Add(conn.Close)
+
+	chatClient := desc.NewChatV1Client(conn)
+	webSocket := bridge.NewWebSocketHandler(chatClient, a.serviceProvider.HTTPConfig().WebSocketOrigins())
+
+	err = mux.HandlePath(http.MethodGet, bridge.WebSocketPath,
+		func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			webSocket.ServeHTTP(w, r)
+		})
+	if err != nil {
+		return err
+	}
+
+	err = mux.HandlePath(http.MethodGet, bridge.EventsPath, bridge.NewEventsHandler(chatClient))
+	if err != nil {
+		return err
+	}
+
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "Content-length"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "Content-length", "X-Api-Key", "Last-Event-ID"},
+		ExposedHeaders:   []string{"Content-Disposition"},
 		AllowCredentials: true,
 	})
 
@@ -234,6 +294,35 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 	return nil
 }
 
+// headerMatcher passes the API keys of the bots on top of the default headers.
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, interceptor.APIKeyHeader) {
+		return interceptor.APIKeyHeader, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher names the downloaded files on top of the default headers.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == chatHandler.ContentDispositionHeader {
+		return "Content-Disposition", true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// downloadMarshaler is the default gateway marshaler without the newline the
+// default one writes after every chunk of a stream: the chunks of a download
+// are parts of the file.
+type downloadMarshaler struct {
+	*runtime.HTTPBodyMarshaler
+}
+
+func (m *downloadMarshaler) Delimiter() []byte {
+	return nil
+}
+
 func (a *App) initSwaggerServer(_ context.Context) error {
 	statikFs, err := fs.New()
 	if err != nil {
@@ -243,6 +332,8 @@ func (a *App) initSwaggerServer(_ context.Context) error {
 	mux := http.NewServeMux()
 	mux.Handle("/", http.StripPrefix("/", http.FileServer(statikFs)))
 	mux.HandleFunc("/api.swagger.json", serveSwaggerFile("/api.swagger.json"))
+	// The chat stream metrics, see chatHandler.StreamOptions.
+	mux.Handle("/debug/vars", expvar.Handler())
 
 	a.swaggerServer = &http.Server{
 		Addr:    a.serviceProvider.SwaggerConfig().Address(),
@@ -299,6 +390,62 @@ func (a *App) Run() error {
 		closer.Wait()
 	}()
 
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	shutdownDone := make(chan struct{})
+	go func() {
+		<-ctx.Done()
+		a.shutdown()
+		close(shutdownDone)
+	}()
+
+	relay := a.serviceProvider.OutboxRelay(ctx)
+	broadcaster := a.serviceProvider.OutboxBroadcaster(ctx, a.serviceProvider.ChatHandler(ctx, a.grpcAccessClient))
+	sender := a.serviceProvider.WebhookSender(ctx)
+	scheduler := a.serviceProvider.Scheduler(ctx)
+	purger := a.serviceProvider.Purger(ctx)
+	chainer := a.serviceProvider.AuditChainer(ctx)
+
+	workers := sync.WaitGroup{}
+	workers.Add(6)
+
+	go func() {
+		defer workers.Done()
+
+		relay.Run(ctx)
+	}()
+
+	go func() {
+		defer workers.Done()
+
+		broadcaster.Run(ctx)
+	}()
+
+	go func() {
+		defer workers.Done()
+
+		sender.Run(ctx)
+	}()
+
+	go func() {
+		defer workers.Done()
+
+		scheduler.Run(ctx)
+	}()
+
+	go func() {
+		defer workers.Done()
+
+		purger.Run(ctx)
+	}()
+
+	go func() {
+		defer workers.Done()
+
+		chainer.Run(ctx)
+	}()
+
 	wg := sync.WaitGroup{}
 	wg.Add(count)
 
@@ -331,9 +478,53 @@ func (a *App) Run() error {
 
 	wg.Wait()
 
+	stop()
+	// The servers return once they stop listening, the streams may still
+	// write audit entries until shutdown drains or closes them.
+	<-shutdownDone
+	workers.Wait()
+
+	// Read events still buffered are written before the database is closed.
+	a.serviceProvider.FlushAudit()
+
 	return nil
 }
 
+// shutdown stops the servers, letting the requests in flight finish. The
+// streams never finish on their own: the gRPC ones still open after the
+// shutdown timeout are closed, and with them the bridged HTTP ones.
+func (a *App) shutdown() {
+	log.Printf("Shutting down...")
+
+	stopped := make(chan struct{})
+	go func() {
+		a.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(a.serviceProvider.GRPCConfig().ShutdownTimeout()):
+		log.Printf("GRPC server graceful stop timed out, closing the open streams")
+
+		a.grpcServer.Stop()
+		<-stopped
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	err := a.httpServer.Shutdown(ctx)
+	if err != nil {
+		log.Printf("failed to shut down HTTP server: %v", err)
+	}
+
+	err = a.swaggerServer.Shutdown(ctx)
+	if err != nil {
+		log.Printf("failed to shut down Swagger server: %v", err)
+	}
+}
+
 func (a *App) runGRPCServer() error {
 	log.Printf("GRPC server is running on: %s", a.serviceProvider.GRPCConfig().Address())
 
@@ -354,7 +545,7 @@ func (a *App) runHTTPServer() error {
 	log.Printf("HTTP server is running on: %s", a.serviceProvider.HTTPConfig().Address())
 
 	err := a.httpServer.ListenAndServeTLS("secure/service.pem", "secure/service.key")
-	if err != nil {
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return err
 	}
 
@@ -365,7 +556,7 @@ func (a *App) runSwaggerServer() error {
 	log.Printf("Swagger server is running on: %s", a.serviceProvider.SwaggerConfig().Address())
 
 	err := a.swaggerServer.ListenAndServeTLS("secure/service.pem", "secure/service.key")
-	if err != nil {
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return err
 	}
 
@@ -9,12 +9,28 @@ import (
 	accessClient "github.com/Mobo140/chat/internal/client/access"
 	"github.com/Mobo140/chat/internal/config"
 	"github.com/Mobo140/chat/internal/config/env"
+	"github.com/Mobo140/chat/internal/model"
 	"github.com/Mobo140/chat/internal/repository"
+	botRepository "github.com/Mobo140/chat/internal/repository/bot"
 	chatRepository "github.com/Mobo140/chat/internal/repository/chat"
+	deliveryRepository "github.com/Mobo140/chat/internal/repository/delivery"
+	editRepository "github.com/Mobo140/chat/internal/repository/edit"
 	logRepository "github.com/Mobo140/chat/internal/repository/logs"
 	messageRepository "github.com/Mobo140/chat/internal/repository/message"
+	outboxRepository "github.com/Mobo140/chat/internal/repository/outbox"
+	reactionRepository "github.com/Mobo140/chat/internal/repository/reaction"
+	scheduledRepository "github.com/Mobo140/chat/internal/repository/scheduled"
+	sessionRepository "github.com/Mobo140/chat/internal/repository/session"
+	webhookRepository "github.com/Mobo140/chat/internal/repository/webhook"
 	"github.com/Mobo140/chat/internal/service"
+	auditService "github.com/Mobo140/chat/internal/service/audit"
+	botService "github.com/Mobo140/chat/internal/service/bot"
 	chatService "github.com/Mobo140/chat/internal/service/chat"
+	"github.com/Mobo140/chat/internal/service/command"
+	outboxService "github.com/Mobo140/chat/internal/service/outbox"
+	retentionService "github.com/Mobo140/chat/internal/service/retention"
+	schedulerService "github.com/Mobo140/chat/internal/service/scheduler"
+	webhookService "github.com/Mobo140/chat/internal/service/webhook"
 	"google.golang.org/grpc"
 
 	"github.com/Mobo140/chat/internal/transport/handlers/chat"
@@ -26,20 +42,49 @@ import (
 )
 
 type serviceProvider struct {
-	chatRepository    repository.ChatRepository
-	messageRepository repository.MessageRepository
-	logRepository     repository.LogRepository
+	chatRepository      repository.ChatRepository
+	messageRepository   repository.MessageRepository
+	editRepository      repository.MessageEditRepository
+	reactionRepository  repository.ReactionRepository
+	logRepository       repository.LogRepository
+	outboxRepository    repository.OutboxRepository
+	scheduledRepository repository.ScheduledRepository
+	deliveryRepository  repository.DeliveryRepository
+	sessionRepository   repository.SessionRepository
+	webhookRepository   repository.WebhookRepository
+	botRepository       repository.BotRepository
 
 	grpcConfig         config.GRPCConfig
 	httpConfig         config.HTTPConfig
 	accessClientConfig config.AccessClientConfig
 	jaegerConfig       config.JaegerConfig
+	auditConfig        config.AuditConfig
+	outboxConfig       config.OutboxConfig
+	schedulerConfig    config.SchedulerConfig
+	retentionConfig    config.RetentionConfig
+	streamConfig       config.StreamConfig
+	webhookConfig      config.WebhookConfig
+	commandConfig      config.CommandConfig
 	pgConfig           config.PGConfig
 	swaggerConfig      config.SwaggerConfig
 	txManager          db.TxManager
 	dbClient           db.Client
 
-	chatService  service.ChatService
+	chatService       service.ChatService
+	commandRegistry   *command.Registry
+	auditService      service.AuditService
+	auditWriter       service.AuditWriter
+	auditChainer      service.AuditChainer
+	outboxRelay       service.OutboxRelay
+	outboxBroadcaster service.OutboxBroadcaster
+	scheduler         service.Scheduler
+	purger            service.Purger
+
+	webhookService   service.WebhookService
+	webhookPublisher service.EventPublisher
+	webhookSender    service.WebhookSender
+
+	botService   service.BotService
 	accessClient client.AccessServiceClient
 
 	chatImplementation *chat.Implementation
@@ -51,7 +96,18 @@ func newServiceProvider() *serviceProvider {
 
 func (s *serviceProvider) ChatHandler(ctx context.Context, conn *grpc.ClientConn) *chat.Implementation {
 	if s.chatImplementation == nil {
-		s.chatImplementation = chatHandler.NewImplementation(s.ChatAPIService(ctx), s.AccessClient(conn))
+		s.chatImplementation = chatHandler.NewImplementation(
+			s.ChatAPIService(ctx),
+			s.WebhookService(ctx),
+			s.BotService(ctx),
+			s.AccessClient(conn),
+			chatHandler.StreamOptions{
+				QueueSize:         s.StreamConfig().QueueSize(),
+				Overflow:          s.StreamConfig().Overflow(),
+				HeartbeatInterval: s.StreamConfig().HeartbeatInterval(),
+				MissedHeartbeats:  s.StreamConfig().MissedHeartbeats(),
+			},
+		)
 	}
 
 	return s.chatImplementation
@@ -62,17 +118,228 @@ func (s *serviceProvider) ChatAPIService(ctx context.Context) service.ChatServic
 		s.chatService = chatService.NewService(
 			s.ChatRepository(ctx),
 			s.MessageRepository(ctx),
+			s.MessageEditRepository(ctx),
+			s.ReactionRepository(ctx),
 			s.LogRepository(ctx),
+			s.OutboxRepository(ctx),
+			s.ScheduledRepository(ctx),
+			s.DeliveryRepository(ctx),
+			s.SessionRepository(ctx),
 			s.TxManager(ctx),
+			s.AuditWriter(ctx),
+			s.AuditConfig().Policy(),
+			s.CommandRegistry(ctx),
 		)
 	}
 
 	return s.chatService
 }
 
+// CommandRegistry holds the builtin slash commands and the webhook ones.
+func (s *serviceProvider) CommandRegistry(ctx context.Context) *command.Registry {
+	if s.commandRegistry == nil {
+		cfg := s.CommandConfig()
+
+		handlers := command.Builtins(s.ChatRepository(ctx), s.OutboxRepository(ctx), s.ScheduledRepository(ctx))
+		for name, url := range cfg.Webhooks() {
+			handlers = append(handlers, command.NewWebhookCommand(name, url, cfg.WebhookSecret(), cfg.WebhookTimeout()))
+		}
+
+		registry, err := command.NewRegistry(handlers...)
+		if err != nil {
+			log.Fatalf("failed to register commands: %v", err)
+		}
+		s.commandRegistry = registry
+	}
+
+	return s.commandRegistry
+}
+
+func (s *serviceProvider) AuditService(ctx context.Context) service.AuditService {
+	if s.auditService == nil {
+		s.auditService = auditService.NewService(s.LogRepository(ctx), s.AuditConfig().ChainKey())
+	}
+
+	return s.auditService
+}
+
+func (s *serviceProvider) AuditWriter(ctx context.Context) service.AuditWriter {
+	if s.auditWriter == nil {
+		cfg := s.AuditConfig()
+		s.auditWriter = auditService.NewWriter(
+			s.LogRepository(ctx),
+			s.TxManager(ctx),
+			cfg.BufferSize(),
+			cfg.BatchSize(),
+			cfg.FlushInterval(),
+		)
+	}
+
+	return s.auditWriter
+}
+
+// AuditChainer links the audit log entries to the hash chain.
+func (s *serviceProvider) AuditChainer(ctx context.Context) service.AuditChainer {
+	if s.auditChainer == nil {
+		cfg := s.AuditConfig()
+		s.auditChainer = auditService.NewChainer(
+			s.LogRepository(ctx),
+			s.TxManager(ctx),
+			cfg.ChainKey(),
+			uint64(cfg.BatchSize()),
+			cfg.FlushInterval(),
+		)
+	}
+
+	return s.auditChainer
+}
+
+// FlushAudit writes the buffered audit logs. It runs on shutdown before the
+// database client is closed.
+func (s *serviceProvider) FlushAudit() {
+	if s.auditWriter == nil {
+		return
+	}
+
+	err := s.auditWriter.Close()
+	if err != nil {
+		log.Printf("failed to flush audit logs: %v", err)
+	}
+}
+
+// OutboxRelay publishes the outbox events to the webhooks, once for every
+// instance.
+func (s *serviceProvider) OutboxRelay(ctx context.Context) service.OutboxRelay {
+	if s.outboxRelay == nil {
+		cfg := s.OutboxConfig()
+		s.outboxRelay = outboxService.NewRelay(
+			s.OutboxRepository(ctx),
+			s.TxManager(ctx),
+			[]service.EventPublisher{s.WebhookPublisher(ctx)},
+			cfg.BatchSize(),
+			cfg.PollInterval(),
+		)
+	}
+
+	return s.outboxRelay
+}
+
+// OutboxBroadcaster publishes the relayed events to the chat streams of this
+// instance through the publisher.
+func (s *serviceProvider) OutboxBroadcaster(
+	ctx context.Context,
+	publisher service.EventPublisher,
+) service.OutboxBroadcaster {
+	if s.outboxBroadcaster == nil {
+		s.outboxBroadcaster = outboxService.NewBroadcaster(
+			s.OutboxRepository(ctx),
+			outboxRepository.NewListener(s.PGConfig().DSN()),
+			[]service.EventPublisher{publisher},
+			s.OutboxConfig().PollInterval(),
+		)
+	}
+
+	return s.outboxBroadcaster
+}
+
+// Scheduler sends the due scheduled messages through the chat service.
+func (s *serviceProvider) Scheduler(ctx context.Context) service.Scheduler {
+	if s.scheduler == nil {
+		cfg := s.SchedulerConfig()
+		s.scheduler = schedulerService.NewScheduler(
+			s.ScheduledRepository(ctx),
+			s.ChatAPIService(ctx),
+			s.TxManager(ctx),
+			cfg.BatchSize(),
+			cfg.PollInterval(),
+		)
+	}
+
+	return s.scheduler
+}
+
+// Purger deletes the deleted chats, the messages and the audit log beyond the retention.
+func (s *serviceProvider) Purger(ctx context.Context) service.Purger {
+	if s.purger == nil {
+		cfg := s.RetentionConfig()
+		s.purger = retentionService.NewPurger(
+			s.ChatRepository(ctx),
+			s.MessageRepository(ctx),
+			s.LogRepository(ctx),
+			s.OutboxRepository(ctx),
+			s.AuditChainer(ctx),
+			s.TxManager(ctx),
+			s.AuditConfig().Policy(),
+			retentionService.Options{
+				Messages: model.Retention{
+					MaxAge:   cfg.MessageMaxAge(),
+					MaxCount: cfg.MessageMaxCount(),
+				},
+				LogMaxAge: cfg.LogMaxAge(),
+				BatchSize: cfg.BatchSize(),
+				Interval:  cfg.Interval(),
+				DryRun:    cfg.DryRun(),
+				ChatGrace: cfg.ChatGrace(),
+
+				OutboxMaxAge: cfg.OutboxMaxAge(),
+			},
+		)
+	}
+
+	return s.purger
+}
+
+func (s *serviceProvider) WebhookService(ctx context.Context) service.WebhookService {
+	if s.webhookService == nil {
+		s.webhookService = webhookService.NewService(s.WebhookRepository(ctx))
+	}
+
+	return s.webhookService
+}
+
+func (s *serviceProvider) WebhookPublisher(ctx context.Context) service.EventPublisher {
+	if s.webhookPublisher == nil {
+		s.webhookPublisher = webhookService.NewPublisher(s.WebhookRepository(ctx))
+	}
+
+	return s.webhookPublisher
+}
+
+func (s *serviceProvider) WebhookSender(ctx context.Context) service.WebhookSender {
+	if s.webhookSender == nil {
+		cfg := s.WebhookConfig()
+		s.webhookSender = webhookService.NewSender(
+			s.WebhookRepository(ctx),
+			s.TxManager(ctx),
+			webhookService.SenderOptions{
+				BatchSize:    cfg.BatchSize(),
+				PollInterval: cfg.PollInterval(),
+				Timeout:      cfg.Timeout(),
+				MaxAttempts:  cfg.MaxAttempts(),
+				BaseBackoff:  cfg.BaseBackoff(),
+				MaxBackoff:   cfg.MaxBackoff(),
+
+				AllowPrivateNetworks: cfg.AllowPrivateNetworks(),
+			},
+		)
+	}
+
+	return s.webhookSender
+}
+
+func (s *serviceProvider) BotService(ctx context.Context) service.BotService {
+	if s.botService == nil {
+		s.botService = botService.NewService(s.BotRepository(ctx), s.TxManager(ctx))
+	}
+
+	return s.botService
+}
+
 func (s *serviceProvider) AccessClient(conn *grpc.ClientConn) client.AccessServiceClient {
 	if s.accessClient == nil {
-		s.accessClient = accessClient.NewAccessClient(descAccess.NewAccessV1Client(conn))
+		s.accessClient = accessClient.NewBotAccessClient(
+			accessClient.NewAccessClient(descAccess.NewAccessV1Client(conn)),
+		)
 	}
 
 	return s.accessClient
@@ -94,6 +361,22 @@ func (s *serviceProvider) MessageRepository(ctx context.Context) repository.Mess
 	return s.messageRepository
 }
 
+func (s *serviceProvider) MessageEditRepository(ctx context.Context) repository.MessageEditRepository {
+	if s.editRepository == nil {
+		s.editRepository = editRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.editRepository
+}
+
+func (s *serviceProvider) ReactionRepository(ctx context.Context) repository.ReactionRepository {
+	if s.reactionRepository == nil {
+		s.reactionRepository = reactionRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.reactionRepository
+}
+
 func (s *serviceProvider) LogRepository(ctx context.Context) repository.LogRepository {
 	if s.logRepository == nil {
 		s.logRepository = logRepository.NewRepository(s.DBClient(ctx))
@@ -102,6 +385,54 @@ func (s *serviceProvider) LogRepository(ctx context.Context) repository.LogRepos
 	return s.logRepository
 }
 
+func (s *serviceProvider) OutboxRepository(ctx context.Context) repository.OutboxRepository {
+	if s.outboxRepository == nil {
+		s.outboxRepository = outboxRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.outboxRepository
+}
+
+func (s *serviceProvider) ScheduledRepository(ctx context.Context) repository.ScheduledRepository {
+	if s.scheduledRepository == nil {
+		s.scheduledRepository = scheduledRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.scheduledRepository
+}
+
+func (s *serviceProvider) DeliveryRepository(ctx context.Context) repository.DeliveryRepository {
+	if s.deliveryRepository == nil {
+		s.deliveryRepository = deliveryRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.deliveryRepository
+}
+
+func (s *serviceProvider) SessionRepository(ctx context.Context) repository.SessionRepository {
+	if s.sessionRepository == nil {
+		s.sessionRepository = sessionRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.sessionRepository
+}
+
+func (s *serviceProvider) WebhookRepository(ctx context.Context) repository.WebhookRepository {
+	if s.webhookRepository == nil {
+		s.webhookRepository = webhookRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.webhookRepository
+}
+
+func (s *serviceProvider) BotRepository(ctx context.Context) repository.BotRepository {
+	if s.botRepository == nil {
+		s.botRepository = botRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.botRepository
+}
+
 func (s *serviceProvider) TxManager(ctx context.Context) db.TxManager {
 	if s.txManager == nil {
 		s.txManager = transaction.NewTransactionManager(s.DBClient(ctx).DB())
@@ -182,6 +513,90 @@ func (s *serviceProvider) JaegerConfig() config.JaegerConfig {
 	return s.jaegerConfig
 }
 
+func (s *serviceProvider) AuditConfig() config.AuditConfig {
+	if s.auditConfig == nil {
+		cfg, err := env.NewAuditConfig()
+		if err != nil {
+			log.Fatalf("failed to initialize audit config: %v", err)
+		}
+		s.auditConfig = cfg
+	}
+
+	return s.auditConfig
+}
+
+func (s *serviceProvider) OutboxConfig() config.OutboxConfig {
+	if s.outboxConfig == nil {
+		cfg, err := env.NewOutboxConfig()
+		if err != nil {
+			log.Fatalf("failed to initialize outbox config: %v", err)
+		}
+		s.outboxConfig = cfg
+	}
+
+	return s.outboxConfig
+}
+
+func (s *serviceProvider) StreamConfig() config.StreamConfig {
+	if s.streamConfig == nil {
+		cfg, err := env.NewStreamConfig()
+		if err != nil {
+			log.Fatalf("failed to initialize stream config: %v", err)
+		}
+		s.streamConfig = cfg
+	}
+
+	return s.streamConfig
+}
+
+func (s *serviceProvider) RetentionConfig() config.RetentionConfig {
+	if s.retentionConfig == nil {
+		cfg, err := env.NewRetentionConfig()
+		if err != nil {
+			log.Fatalf("failed to initialize retention config: %v", err)
+		}
+		s.retentionConfig = cfg
+	}
+
+	return s.retentionConfig
+}
+
+func (s *serviceProvider) SchedulerConfig() config.SchedulerConfig {
+	if s.schedulerConfig == nil {
+		cfg, err := env.NewSchedulerConfig()
+		if err != nil {
+			log.Fatalf("failed to initialize scheduler config: %v", err)
+		}
+		s.schedulerConfig = cfg
+	}
+
+	return s.schedulerConfig
+}
+
+func (s *serviceProvider) WebhookConfig() config.WebhookConfig {
+	if s.webhookConfig == nil {
+		cfg, err := env.NewWebhookConfig()
+		if err != nil {
+			log.Fatalf("failed to initialize webhook config: %v", err)
+		}
+		s.webhookConfig = cfg
+	}
+
+	return s.webhookConfig
+}
+
+func (s *serviceProvider) CommandConfig() config.CommandConfig {
+	if s.commandConfig == nil {
+		cfg, err := env.NewCommandConfig()
+		if err != nil {
+			log.Fatalf("failed to initialize command config: %v", err)
+		}
+		s.commandConfig = cfg
+	}
+
+	return s.commandConfig
+}
+
 func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
 	if s.dbClient == nil {
 		cl, err := pg.NewClient(ctx, s.PGConfig().DSN())
@@ -0,0 +1,58 @@
+package app
+
+import (
+	"context"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/closer"
+)
+
+// VerifyAuditLog checks the audit log hash chain without starting the servers.
+func VerifyAuditLog(ctx context.Context, configPath string, loggerLevel string) (*model.ChainReport, error) {
+	defer func() {
+		closer.CloseAll()
+		closer.Wait()
+	}()
+
+	a, err := newCommandApp(ctx, configPath, loggerLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.serviceProvider.AuditService(ctx).Verify(ctx)
+}
+
+// Purge runs the retention purge once without starting the servers.
+func Purge(ctx context.Context, configPath string, loggerLevel string) (*model.PurgeReport, error) {
+	defer func() {
+		closer.CloseAll()
+		closer.Wait()
+	}()
+
+	a, err := newCommandApp(ctx, configPath, loggerLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.serviceProvider.Purger(ctx).Purge(ctx)
+}
+
+// newCommandApp initializes the dependencies of a one-off command.
+func newCommandApp(ctx context.Context, configPath string, loggerLevel string) (*App, error) {
+	a := &App{configPath: configPath, loggerLevel: loggerLevel}
+
+	inits := []func(context.Context) error{
+		a.initConfig,
+		a.initLogger,
+		a.initServiceProvider,
+	}
+
+	for _, f := range inits {
+		err := f(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return a, nil
+}
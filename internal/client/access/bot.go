@@ -0,0 +1,48 @@
+package access
+
+import (
+	"context"
+
+	cl "github.com/Mobo140/chat/internal/client"
+	"github.com/Mobo140/chat/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ cl.AccessServiceClient = (*botClient)(nil)
+
+// botClient lets the bots authenticated by their API key in to the bot
+// endpoints and asks the auth service about everyone else.
+type botClient struct {
+	next cl.AccessServiceClient
+}
+
+func NewBotAccessClient(next cl.AccessServiceClient) *botClient { //nolint:revive // it's ok
+	return &botClient{
+		next: next,
+	}
+}
+
+func (c *botClient) Check(ctx context.Context, endpoint string) error {
+	bot, ok := model.BotFromContext(ctx)
+	if !ok {
+		return c.next.Check(ctx, endpoint)
+	}
+
+	if !model.BotEndpoint(endpoint) {
+		return status.Errorf(codes.PermissionDenied, "bot %q may not call %s", bot.Name, endpoint)
+	}
+
+	return nil
+}
+
+// Caller returns the username of the bot, or asks the next client about the
+// access token.
+func (c *botClient) Caller(ctx context.Context) (string, error) {
+	bot, ok := model.BotFromContext(ctx)
+	if !ok {
+		return c.next.Caller(ctx)
+	}
+
+	return bot.Username(), nil
+}
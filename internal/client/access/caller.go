@@ -0,0 +1,53 @@
+package access
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// claims are the claims of the access tokens issued by the auth service.
+type claims struct {
+	Username string `json:"username"`
+}
+
+// Caller returns the username of the access token of the request. The claims
+// are read, not verified: the signature is verified by the auth service on
+// Check, which has to pass first.
+func (c *client) Caller(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return "", status.Error(codes.Unauthenticated, "access token is missing")
+	}
+
+	parts := strings.Split(strings.TrimPrefix(values[0], bearerPrefix), ".")
+	if len(parts) != 3 {
+		return "", status.Error(codes.Unauthenticated, "access token is invalid")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, "access token is invalid")
+	}
+
+	var tokenClaims claims
+
+	err = json.Unmarshal(payload, &tokenClaims)
+	if err != nil || len(tokenClaims.Username) == 0 {
+		return "", status.Error(codes.Unauthenticated, "access token is invalid")
+	}
+
+	return tokenClaims.Username, nil
+}
@@ -4,4 +4,6 @@ import "context"
 
 type AccessServiceClient interface {
 	Check(ctx context.Context, endpoint string) error
+	// Caller returns the username of the authenticated caller, once Check passed.
+	Caller(ctx context.Context) (string, error)
 }
@@ -0,0 +1,741 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Mobo140/chat/internal/client.AccessServiceClient -o access_service_client_minimock.go -n AccessServiceClientMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// AccessServiceClientMock implements mm_client.AccessServiceClient
+type AccessServiceClientMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCaller          func(ctx context.Context) (s1 string, err error)
+	funcCallerOrigin    string
+	inspectFuncCaller   func(ctx context.Context)
+	afterCallerCounter  uint64
+	beforeCallerCounter uint64
+	CallerMock          mAccessServiceClientMockCaller
+
+	funcCheck          func(ctx context.Context, endpoint string) (err error)
+	funcCheckOrigin    string
+	inspectFuncCheck   func(ctx context.Context, endpoint string)
+	afterCheckCounter  uint64
+	beforeCheckCounter uint64
+	CheckMock          mAccessServiceClientMockCheck
+}
+
+// NewAccessServiceClientMock returns a mock for mm_client.AccessServiceClient
+func NewAccessServiceClientMock(t minimock.Tester) *AccessServiceClientMock {
+	m := &AccessServiceClientMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CallerMock = mAccessServiceClientMockCaller{mock: m}
+	m.CallerMock.callArgs = []*AccessServiceClientMockCallerParams{}
+
+	m.CheckMock = mAccessServiceClientMockCheck{mock: m}
+	m.CheckMock.callArgs = []*AccessServiceClientMockCheckParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAccessServiceClientMockCaller struct {
+	optional           bool
+	mock               *AccessServiceClientMock
+	defaultExpectation *AccessServiceClientMockCallerExpectation
+	expectations       []*AccessServiceClientMockCallerExpectation
+
+	callArgs []*AccessServiceClientMockCallerParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessServiceClientMockCallerExpectation specifies expectation struct of the AccessServiceClient.Caller
+type AccessServiceClientMockCallerExpectation struct {
+	mock               *AccessServiceClientMock
+	params             *AccessServiceClientMockCallerParams
+	paramPtrs          *AccessServiceClientMockCallerParamPtrs
+	expectationOrigins AccessServiceClientMockCallerExpectationOrigins
+	results            *AccessServiceClientMockCallerResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessServiceClientMockCallerParams contains parameters of the AccessServiceClient.Caller
+type AccessServiceClientMockCallerParams struct {
+	ctx context.Context
+}
+
+// AccessServiceClientMockCallerParamPtrs contains pointers to parameters of the AccessServiceClient.Caller
+type AccessServiceClientMockCallerParamPtrs struct {
+	ctx *context.Context
+}
+
+// AccessServiceClientMockCallerResults contains results of the AccessServiceClient.Caller
+type AccessServiceClientMockCallerResults struct {
+	s1  string
+	err error
+}
+
+// AccessServiceClientMockCallerOrigins contains origins of expectations of the AccessServiceClient.Caller
+type AccessServiceClientMockCallerExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCaller *mAccessServiceClientMockCaller) Optional() *mAccessServiceClientMockCaller {
+	mmCaller.optional = true
+	return mmCaller
+}
+
+// Expect sets up expected params for AccessServiceClient.Caller
+func (mmCaller *mAccessServiceClientMockCaller) Expect(ctx context.Context) *mAccessServiceClientMockCaller {
+	if mmCaller.mock.funcCaller != nil {
+		mmCaller.mock.t.Fatalf("AccessServiceClientMock.Caller mock is already set by Set")
+	}
+
+	if mmCaller.defaultExpectation == nil {
+		mmCaller.defaultExpectation = &AccessServiceClientMockCallerExpectation{}
+	}
+
+	if mmCaller.defaultExpectation.paramPtrs != nil {
+		mmCaller.mock.t.Fatalf("AccessServiceClientMock.Caller mock is already set by ExpectParams functions")
+	}
+
+	mmCaller.defaultExpectation.params = &AccessServiceClientMockCallerParams{ctx}
+	mmCaller.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCaller.expectations {
+		if minimock.Equal(e.params, mmCaller.defaultExpectation.params) {
+			mmCaller.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCaller.defaultExpectation.params)
+		}
+	}
+
+	return mmCaller
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessServiceClient.Caller
+func (mmCaller *mAccessServiceClientMockCaller) ExpectCtxParam1(ctx context.Context) *mAccessServiceClientMockCaller {
+	if mmCaller.mock.funcCaller != nil {
+		mmCaller.mock.t.Fatalf("AccessServiceClientMock.Caller mock is already set by Set")
+	}
+
+	if mmCaller.defaultExpectation == nil {
+		mmCaller.defaultExpectation = &AccessServiceClientMockCallerExpectation{}
+	}
+
+	if mmCaller.defaultExpectation.params != nil {
+		mmCaller.mock.t.Fatalf("AccessServiceClientMock.Caller mock is already set by Expect")
+	}
+
+	if mmCaller.defaultExpectation.paramPtrs == nil {
+		mmCaller.defaultExpectation.paramPtrs = &AccessServiceClientMockCallerParamPtrs{}
+	}
+	mmCaller.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCaller.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCaller
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessServiceClient.Caller
+func (mmCaller *mAccessServiceClientMockCaller) Inspect(f func(ctx context.Context)) *mAccessServiceClientMockCaller {
+	if mmCaller.mock.inspectFuncCaller != nil {
+		mmCaller.mock.t.Fatalf("Inspect function is already set for AccessServiceClientMock.Caller")
+	}
+
+	mmCaller.mock.inspectFuncCaller = f
+
+	return mmCaller
+}
+
+// Return sets up results that will be returned by AccessServiceClient.Caller
+func (mmCaller *mAccessServiceClientMockCaller) Return(s1 string, err error) *AccessServiceClientMock {
+	if mmCaller.mock.funcCaller != nil {
+		mmCaller.mock.t.Fatalf("AccessServiceClientMock.Caller mock is already set by Set")
+	}
+
+	if mmCaller.defaultExpectation == nil {
+		mmCaller.defaultExpectation = &AccessServiceClientMockCallerExpectation{mock: mmCaller.mock}
+	}
+	mmCaller.defaultExpectation.results = &AccessServiceClientMockCallerResults{s1, err}
+	mmCaller.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCaller.mock
+}
+
+// Set uses given function f to mock the AccessServiceClient.Caller method
+func (mmCaller *mAccessServiceClientMockCaller) Set(f func(ctx context.Context) (s1 string, err error)) *AccessServiceClientMock {
+	if mmCaller.defaultExpectation != nil {
+		mmCaller.mock.t.Fatalf("Default expectation is already set for the AccessServiceClient.Caller method")
+	}
+
+	if len(mmCaller.expectations) > 0 {
+		mmCaller.mock.t.Fatalf("Some expectations are already set for the AccessServiceClient.Caller method")
+	}
+
+	mmCaller.mock.funcCaller = f
+	mmCaller.mock.funcCallerOrigin = minimock.CallerInfo(1)
+	return mmCaller.mock
+}
+
+// When sets expectation for the AccessServiceClient.Caller which will trigger the result defined by the following
+// Then helper
+func (mmCaller *mAccessServiceClientMockCaller) When(ctx context.Context) *AccessServiceClientMockCallerExpectation {
+	if mmCaller.mock.funcCaller != nil {
+		mmCaller.mock.t.Fatalf("AccessServiceClientMock.Caller mock is already set by Set")
+	}
+
+	expectation := &AccessServiceClientMockCallerExpectation{
+		mock:               mmCaller.mock,
+		params:             &AccessServiceClientMockCallerParams{ctx},
+		expectationOrigins: AccessServiceClientMockCallerExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCaller.expectations = append(mmCaller.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessServiceClient.Caller return parameters for the expectation previously defined by the When method
+func (e *AccessServiceClientMockCallerExpectation) Then(s1 string, err error) *AccessServiceClientMock {
+	e.results = &AccessServiceClientMockCallerResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessServiceClient.Caller should be invoked
+func (mmCaller *mAccessServiceClientMockCaller) Times(n uint64) *mAccessServiceClientMockCaller {
+	if n == 0 {
+		mmCaller.mock.t.Fatalf("Times of AccessServiceClientMock.Caller mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCaller.expectedInvocations, n)
+	mmCaller.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCaller
+}
+
+func (mmCaller *mAccessServiceClientMockCaller) invocationsDone() bool {
+	if len(mmCaller.expectations) == 0 && mmCaller.defaultExpectation == nil && mmCaller.mock.funcCaller == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCaller.mock.afterCallerCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCaller.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Caller implements mm_client.AccessServiceClient
+func (mmCaller *AccessServiceClientMock) Caller(ctx context.Context) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmCaller.beforeCallerCounter, 1)
+	defer mm_atomic.AddUint64(&mmCaller.afterCallerCounter, 1)
+
+	mmCaller.t.Helper()
+
+	if mmCaller.inspectFuncCaller != nil {
+		mmCaller.inspectFuncCaller(ctx)
+	}
+
+	mm_params := AccessServiceClientMockCallerParams{ctx}
+
+	// Record call args
+	mmCaller.CallerMock.mutex.Lock()
+	mmCaller.CallerMock.callArgs = append(mmCaller.CallerMock.callArgs, &mm_params)
+	mmCaller.CallerMock.mutex.Unlock()
+
+	for _, e := range mmCaller.CallerMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmCaller.CallerMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCaller.CallerMock.defaultExpectation.Counter, 1)
+		mm_want := mmCaller.CallerMock.defaultExpectation.params
+		mm_want_ptrs := mmCaller.CallerMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceClientMockCallerParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCaller.t.Errorf("AccessServiceClientMock.Caller got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCaller.CallerMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCaller.t.Errorf("AccessServiceClientMock.Caller got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCaller.CallerMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCaller.CallerMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCaller.t.Fatal("No results are set for the AccessServiceClientMock.Caller")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmCaller.funcCaller != nil {
+		return mmCaller.funcCaller(ctx)
+	}
+	mmCaller.t.Fatalf("Unexpected call to AccessServiceClientMock.Caller. %v", ctx)
+	return
+}
+
+// CallerAfterCounter returns a count of finished AccessServiceClientMock.Caller invocations
+func (mmCaller *AccessServiceClientMock) CallerAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCaller.afterCallerCounter)
+}
+
+// CallerBeforeCounter returns a count of AccessServiceClientMock.Caller invocations
+func (mmCaller *AccessServiceClientMock) CallerBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCaller.beforeCallerCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceClientMock.Caller.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCaller *mAccessServiceClientMockCaller) Calls() []*AccessServiceClientMockCallerParams {
+	mmCaller.mutex.RLock()
+
+	argCopy := make([]*AccessServiceClientMockCallerParams, len(mmCaller.callArgs))
+	copy(argCopy, mmCaller.callArgs)
+
+	mmCaller.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCallerDone returns true if the count of the Caller invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceClientMock) MinimockCallerDone() bool {
+	if m.CallerMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CallerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CallerMock.invocationsDone()
+}
+
+// MinimockCallerInspect logs each unmet expectation
+func (m *AccessServiceClientMock) MinimockCallerInspect() {
+	for _, e := range m.CallerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceClientMock.Caller at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCallerCounter := mm_atomic.LoadUint64(&m.afterCallerCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CallerMock.defaultExpectation != nil && afterCallerCounter < 1 {
+		if m.CallerMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessServiceClientMock.Caller at\n%s", m.CallerMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessServiceClientMock.Caller at\n%s with params: %#v", m.CallerMock.defaultExpectation.expectationOrigins.origin, *m.CallerMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCaller != nil && afterCallerCounter < 1 {
+		m.t.Errorf("Expected call to AccessServiceClientMock.Caller at\n%s", m.funcCallerOrigin)
+	}
+
+	if !m.CallerMock.invocationsDone() && afterCallerCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceClientMock.Caller at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CallerMock.expectedInvocations), m.CallerMock.expectedInvocationsOrigin, afterCallerCounter)
+	}
+}
+
+type mAccessServiceClientMockCheck struct {
+	optional           bool
+	mock               *AccessServiceClientMock
+	defaultExpectation *AccessServiceClientMockCheckExpectation
+	expectations       []*AccessServiceClientMockCheckExpectation
+
+	callArgs []*AccessServiceClientMockCheckParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessServiceClientMockCheckExpectation specifies expectation struct of the AccessServiceClient.Check
+type AccessServiceClientMockCheckExpectation struct {
+	mock               *AccessServiceClientMock
+	params             *AccessServiceClientMockCheckParams
+	paramPtrs          *AccessServiceClientMockCheckParamPtrs
+	expectationOrigins AccessServiceClientMockCheckExpectationOrigins
+	results            *AccessServiceClientMockCheckResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessServiceClientMockCheckParams contains parameters of the AccessServiceClient.Check
+type AccessServiceClientMockCheckParams struct {
+	ctx      context.Context
+	endpoint string
+}
+
+// AccessServiceClientMockCheckParamPtrs contains pointers to parameters of the AccessServiceClient.Check
+type AccessServiceClientMockCheckParamPtrs struct {
+	ctx      *context.Context
+	endpoint *string
+}
+
+// AccessServiceClientMockCheckResults contains results of the AccessServiceClient.Check
+type AccessServiceClientMockCheckResults struct {
+	err error
+}
+
+// AccessServiceClientMockCheckOrigins contains origins of expectations of the AccessServiceClient.Check
+type AccessServiceClientMockCheckExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originEndpoint string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheck *mAccessServiceClientMockCheck) Optional() *mAccessServiceClientMockCheck {
+	mmCheck.optional = true
+	return mmCheck
+}
+
+// Expect sets up expected params for AccessServiceClient.Check
+func (mmCheck *mAccessServiceClientMockCheck) Expect(ctx context.Context, endpoint string) *mAccessServiceClientMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceClientMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessServiceClientMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceClientMock.Check mock is already set by ExpectParams functions")
+	}
+
+	mmCheck.defaultExpectation.params = &AccessServiceClientMockCheckParams{ctx, endpoint}
+	mmCheck.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheck.expectations {
+		if minimock.Equal(e.params, mmCheck.defaultExpectation.params) {
+			mmCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheck.defaultExpectation.params)
+		}
+	}
+
+	return mmCheck
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessServiceClient.Check
+func (mmCheck *mAccessServiceClientMockCheck) ExpectCtxParam1(ctx context.Context) *mAccessServiceClientMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceClientMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessServiceClientMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceClientMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &AccessServiceClientMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheck.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheck
+}
+
+// ExpectEndpointParam2 sets up expected param endpoint for AccessServiceClient.Check
+func (mmCheck *mAccessServiceClientMockCheck) ExpectEndpointParam2(endpoint string) *mAccessServiceClientMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceClientMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessServiceClientMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceClientMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &AccessServiceClientMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.endpoint = &endpoint
+	mmCheck.defaultExpectation.expectationOrigins.originEndpoint = minimock.CallerInfo(1)
+
+	return mmCheck
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessServiceClient.Check
+func (mmCheck *mAccessServiceClientMockCheck) Inspect(f func(ctx context.Context, endpoint string)) *mAccessServiceClientMockCheck {
+	if mmCheck.mock.inspectFuncCheck != nil {
+		mmCheck.mock.t.Fatalf("Inspect function is already set for AccessServiceClientMock.Check")
+	}
+
+	mmCheck.mock.inspectFuncCheck = f
+
+	return mmCheck
+}
+
+// Return sets up results that will be returned by AccessServiceClient.Check
+func (mmCheck *mAccessServiceClientMockCheck) Return(err error) *AccessServiceClientMock {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceClientMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessServiceClientMockCheckExpectation{mock: mmCheck.mock}
+	}
+	mmCheck.defaultExpectation.results = &AccessServiceClientMockCheckResults{err}
+	mmCheck.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheck.mock
+}
+
+// Set uses given function f to mock the AccessServiceClient.Check method
+func (mmCheck *mAccessServiceClientMockCheck) Set(f func(ctx context.Context, endpoint string) (err error)) *AccessServiceClientMock {
+	if mmCheck.defaultExpectation != nil {
+		mmCheck.mock.t.Fatalf("Default expectation is already set for the AccessServiceClient.Check method")
+	}
+
+	if len(mmCheck.expectations) > 0 {
+		mmCheck.mock.t.Fatalf("Some expectations are already set for the AccessServiceClient.Check method")
+	}
+
+	mmCheck.mock.funcCheck = f
+	mmCheck.mock.funcCheckOrigin = minimock.CallerInfo(1)
+	return mmCheck.mock
+}
+
+// When sets expectation for the AccessServiceClient.Check which will trigger the result defined by the following
+// Then helper
+func (mmCheck *mAccessServiceClientMockCheck) When(ctx context.Context, endpoint string) *AccessServiceClientMockCheckExpectation {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessServiceClientMock.Check mock is already set by Set")
+	}
+
+	expectation := &AccessServiceClientMockCheckExpectation{
+		mock:               mmCheck.mock,
+		params:             &AccessServiceClientMockCheckParams{ctx, endpoint},
+		expectationOrigins: AccessServiceClientMockCheckExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheck.expectations = append(mmCheck.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessServiceClient.Check return parameters for the expectation previously defined by the When method
+func (e *AccessServiceClientMockCheckExpectation) Then(err error) *AccessServiceClientMock {
+	e.results = &AccessServiceClientMockCheckResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessServiceClient.Check should be invoked
+func (mmCheck *mAccessServiceClientMockCheck) Times(n uint64) *mAccessServiceClientMockCheck {
+	if n == 0 {
+		mmCheck.mock.t.Fatalf("Times of AccessServiceClientMock.Check mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheck.expectedInvocations, n)
+	mmCheck.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheck
+}
+
+func (mmCheck *mAccessServiceClientMockCheck) invocationsDone() bool {
+	if len(mmCheck.expectations) == 0 && mmCheck.defaultExpectation == nil && mmCheck.mock.funcCheck == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheck.mock.afterCheckCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheck.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Check implements mm_client.AccessServiceClient
+func (mmCheck *AccessServiceClientMock) Check(ctx context.Context, endpoint string) (err error) {
+	mm_atomic.AddUint64(&mmCheck.beforeCheckCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheck.afterCheckCounter, 1)
+
+	mmCheck.t.Helper()
+
+	if mmCheck.inspectFuncCheck != nil {
+		mmCheck.inspectFuncCheck(ctx, endpoint)
+	}
+
+	mm_params := AccessServiceClientMockCheckParams{ctx, endpoint}
+
+	// Record call args
+	mmCheck.CheckMock.mutex.Lock()
+	mmCheck.CheckMock.callArgs = append(mmCheck.CheckMock.callArgs, &mm_params)
+	mmCheck.CheckMock.mutex.Unlock()
+
+	for _, e := range mmCheck.CheckMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheck.CheckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheck.CheckMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheck.CheckMock.defaultExpectation.params
+		mm_want_ptrs := mmCheck.CheckMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessServiceClientMockCheckParams{ctx, endpoint}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheck.t.Errorf("AccessServiceClientMock.Check got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheck.CheckMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.endpoint != nil && !minimock.Equal(*mm_want_ptrs.endpoint, mm_got.endpoint) {
+				mmCheck.t.Errorf("AccessServiceClientMock.Check got unexpected parameter endpoint, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheck.CheckMock.defaultExpectation.expectationOrigins.originEndpoint, *mm_want_ptrs.endpoint, mm_got.endpoint, minimock.Diff(*mm_want_ptrs.endpoint, mm_got.endpoint))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheck.t.Errorf("AccessServiceClientMock.Check got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheck.CheckMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheck.CheckMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheck.t.Fatal("No results are set for the AccessServiceClientMock.Check")
+		}
+		return (*mm_results).err
+	}
+	if mmCheck.funcCheck != nil {
+		return mmCheck.funcCheck(ctx, endpoint)
+	}
+	mmCheck.t.Fatalf("Unexpected call to AccessServiceClientMock.Check. %v %v", ctx, endpoint)
+	return
+}
+
+// CheckAfterCounter returns a count of finished AccessServiceClientMock.Check invocations
+func (mmCheck *AccessServiceClientMock) CheckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.afterCheckCounter)
+}
+
+// CheckBeforeCounter returns a count of AccessServiceClientMock.Check invocations
+func (mmCheck *AccessServiceClientMock) CheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.beforeCheckCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessServiceClientMock.Check.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheck *mAccessServiceClientMockCheck) Calls() []*AccessServiceClientMockCheckParams {
+	mmCheck.mutex.RLock()
+
+	argCopy := make([]*AccessServiceClientMockCheckParams, len(mmCheck.callArgs))
+	copy(argCopy, mmCheck.callArgs)
+
+	mmCheck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckDone returns true if the count of the Check invocations corresponds
+// the number of defined expectations
+func (m *AccessServiceClientMock) MinimockCheckDone() bool {
+	if m.CheckMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckMock.invocationsDone()
+}
+
+// MinimockCheckInspect logs each unmet expectation
+func (m *AccessServiceClientMock) MinimockCheckInspect() {
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessServiceClientMock.Check at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckCounter := mm_atomic.LoadUint64(&m.afterCheckCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckMock.defaultExpectation != nil && afterCheckCounter < 1 {
+		if m.CheckMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessServiceClientMock.Check at\n%s", m.CheckMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessServiceClientMock.Check at\n%s with params: %#v", m.CheckMock.defaultExpectation.expectationOrigins.origin, *m.CheckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheck != nil && afterCheckCounter < 1 {
+		m.t.Errorf("Expected call to AccessServiceClientMock.Check at\n%s", m.funcCheckOrigin)
+	}
+
+	if !m.CheckMock.invocationsDone() && afterCheckCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessServiceClientMock.Check at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckMock.expectedInvocations), m.CheckMock.expectedInvocationsOrigin, afterCheckCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AccessServiceClientMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCallerInspect()
+
+			m.MinimockCheckInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AccessServiceClientMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AccessServiceClientMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCallerDone() &&
+		m.MinimockCheckDone()
+}
@@ -1,13 +1,30 @@
 package config
 
-import "github.com/joho/godotenv"
+import (
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/joho/godotenv"
+)
 
 type GRPCConfig interface {
 	Address() string
+	// KeepaliveTime is the idle time before the server pings a client,
+	// KeepaliveTimeout the wait for the ping ack before closing the connection.
+	KeepaliveTime() time.Duration
+	KeepaliveTimeout() time.Duration
+	// KeepaliveMinTime is the shortest ping interval allowed to clients.
+	KeepaliveMinTime() time.Duration
+	// ShutdownTimeout bounds the graceful stop, the streams still open then
+	// are closed.
+	ShutdownTimeout() time.Duration
 }
 
 type HTTPConfig interface {
 	Address() string
+	// WebSocketOrigins lists the origins the browsers may open the chat
+	// WebSocket from, on top of the gateway one.
+	WebSocketOrigins() []string
 }
 
 type PGConfig interface {
@@ -26,6 +43,72 @@ type JaegerConfig interface {
 	Address() string
 }
 
+type AuditConfig interface {
+	Policy() model.AuditPolicy
+	BufferSize() int
+	BatchSize() int
+	FlushInterval() time.Duration
+	// ChainKey is the HMAC key of the audit log hash chain.
+	ChainKey() []byte
+}
+
+type OutboxConfig interface {
+	BatchSize() uint64
+	PollInterval() time.Duration
+}
+
+type SchedulerConfig interface {
+	BatchSize() uint64
+	PollInterval() time.Duration
+}
+
+// RetentionConfig is the global retention, a zero limit keeps everything.
+type RetentionConfig interface {
+	MessageMaxAge() time.Duration
+	MessageMaxCount() int64
+	LogMaxAge() time.Duration
+	BatchSize() uint64
+	Interval() time.Duration
+	DryRun() bool
+	// ChatGrace is how long a deleted chat can be restored before it is purged.
+	ChatGrace() time.Duration
+	// OutboxMaxAge is how long the published outbox events are kept.
+	OutboxMaxAge() time.Duration
+}
+
+// StreamConfig bounds the event queue of every chat stream and detects the
+// dead ones.
+type StreamConfig interface {
+	QueueSize() int
+	// Overflow is what a full queue does with a new event.
+	Overflow() model.OverflowPolicy
+	// HeartbeatInterval is the longest silence of a stream, a stream whose
+	// client misses MissedHeartbeats heartbeats in a row is removed from its
+	// chat.
+	HeartbeatInterval() time.Duration
+	MissedHeartbeats() int
+}
+
+type WebhookConfig interface {
+	BatchSize() uint64
+	PollInterval() time.Duration
+	Timeout() time.Duration
+	MaxAttempts() int
+	BaseBackoff() time.Duration
+	MaxBackoff() time.Duration
+	// AllowPrivateNetworks lets the webhooks be on the loopback and private
+	// networks.
+	AllowPrivateNetworks() bool
+}
+
+// CommandConfig lists the slash commands handled by outside services.
+type CommandConfig interface {
+	// Webhooks maps the command names to the URLs their calls are POSTed to.
+	Webhooks() map[string]string
+	WebhookSecret() string
+	WebhookTimeout() time.Duration
+}
+
 func Load(path string) error {
 	err := godotenv.Load(path)
 	if err != nil {
@@ -0,0 +1,131 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Mobo140/chat/internal/config"
+	"github.com/Mobo140/chat/internal/model"
+)
+
+var _ config.AuditConfig = (*auditConfig)(nil)
+
+const (
+	auditActionsEnvName       = "AUDIT_ACTIONS"
+	auditBufferSizeEnvName    = "AUDIT_BUFFER_SIZE"
+	auditBatchSizeEnvName     = "AUDIT_BATCH_SIZE"
+	auditFlushIntervalEnvName = "AUDIT_FLUSH_INTERVAL"
+	auditChainKeyEnvName      = "AUDIT_CHAIN_KEY"
+
+	defaultAuditBufferSize    = 1024
+	defaultAuditBatchSize     = 100
+	defaultAuditFlushInterval = time.Second
+)
+
+type auditConfig struct {
+	policy        model.AuditPolicy
+	bufferSize    int
+	batchSize     int
+	flushInterval time.Duration
+	chainKey      []byte
+}
+
+// NewAuditConfig reads the audit policy, a comma separated list of the audited
+// actions (all of them if not set), the settings of the read event writer and
+// the key of the hash chain, required.
+func NewAuditConfig() (*auditConfig, error) { //nolint:revive // it's ok
+	var actions []model.LogAction
+	for _, name := range strings.Split(os.Getenv(auditActionsEnvName), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		action := model.LogAction(name)
+		if !action.Valid() {
+			return nil, fmt.Errorf("unknown audit action %q", name)
+		}
+
+		actions = append(actions, action)
+	}
+
+	bufferSize, err := positiveInt(auditBufferSizeEnvName, defaultAuditBufferSize)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSize, err := positiveInt(auditBatchSizeEnvName, defaultAuditBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	flushInterval, err := positiveDuration(auditFlushIntervalEnvName, defaultAuditFlushInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	chainKey := os.Getenv(auditChainKeyEnvName)
+	if len(chainKey) == 0 {
+		return nil, errors.New("audit chain key not found")
+	}
+
+	return &auditConfig{
+		policy:        model.NewAuditPolicy(actions...),
+		bufferSize:    bufferSize,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		chainKey:      []byte(chainKey),
+	}, nil
+}
+
+func positiveInt(envName string, defaultValue int) (int, error) {
+	value := os.Getenv(envName)
+	if len(value) == 0 {
+		return defaultValue, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid %s: %q", envName, value)
+	}
+
+	return n, nil
+}
+
+func positiveDuration(envName string, defaultValue time.Duration) (time.Duration, error) {
+	value := os.Getenv(envName)
+	if len(value) == 0 {
+		return defaultValue, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid %s: %q", envName, value)
+	}
+
+	return d, nil
+}
+
+func (c *auditConfig) Policy() model.AuditPolicy {
+	return c.policy
+}
+
+func (c *auditConfig) BufferSize() int {
+	return c.bufferSize
+}
+
+func (c *auditConfig) BatchSize() int {
+	return c.batchSize
+}
+
+func (c *auditConfig) FlushInterval() time.Duration {
+	return c.flushInterval
+}
+
+func (c *auditConfig) ChainKey() []byte {
+	return c.chainKey
+}
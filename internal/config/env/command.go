@@ -0,0 +1,79 @@
+package env
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Mobo140/chat/internal/config"
+)
+
+var _ config.CommandConfig = (*commandConfig)(nil)
+
+const (
+	commandWebhooksEnvName       = "COMMAND_WEBHOOKS"
+	commandWebhookSecretEnvName  = "COMMAND_WEBHOOK_SECRET"
+	commandWebhookTimeoutEnvName = "COMMAND_WEBHOOK_TIMEOUT"
+
+	defaultCommandWebhookTimeout = 5 * time.Second
+)
+
+type commandConfig struct {
+	webhooks       map[string]string
+	webhookSecret  string
+	webhookTimeout time.Duration
+}
+
+// NewCommandConfig reads the webhook commands, a comma separated list of
+// name=url pairs, and the secret their calls are signed with.
+func NewCommandConfig() (*commandConfig, error) { //nolint:revive // it's ok
+	webhooks := make(map[string]string)
+	for _, pair := range strings.Split(os.Getenv(commandWebhooksEnvName), ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		name, rawURL, ok := strings.Cut(pair, "=")
+		if !ok || name == "" || strings.ContainsAny(name, " /") {
+			return nil, fmt.Errorf("invalid %s entry %q, want name=url", commandWebhooksEnvName, pair)
+		}
+
+		u, err := url.Parse(rawURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid url of command /%s: %q", name, rawURL)
+		}
+
+		webhooks[strings.ToLower(name)] = rawURL
+	}
+
+	secret := os.Getenv(commandWebhookSecretEnvName)
+	if len(webhooks) > 0 && secret == "" {
+		return nil, fmt.Errorf("%s is required with %s", commandWebhookSecretEnvName, commandWebhooksEnvName)
+	}
+
+	timeout, err := positiveDuration(commandWebhookTimeoutEnvName, defaultCommandWebhookTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commandConfig{
+		webhooks:       webhooks,
+		webhookSecret:  secret,
+		webhookTimeout: timeout,
+	}, nil
+}
+
+func (c *commandConfig) Webhooks() map[string]string {
+	return c.webhooks
+}
+
+func (c *commandConfig) WebhookSecret() string {
+	return c.webhookSecret
+}
+
+func (c *commandConfig) WebhookTimeout() time.Duration {
+	return c.webhookTimeout
+}
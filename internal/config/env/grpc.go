@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net"
 	"os"
+	"time"
 
 	"github.com/Mobo140/chat/internal/config"
 )
@@ -13,11 +14,26 @@ var _ config.GRPCConfig = (*grpcConfig)(nil)
 const (
 	grpcHostEnvName = "GRPC_HOST"
 	grpcPortEnvName = "GRPC_PORT"
+
+	grpcKeepaliveTimeEnvName    = "GRPC_KEEPALIVE_TIME"
+	grpcKeepaliveTimeoutEnvName = "GRPC_KEEPALIVE_TIMEOUT"
+	grpcKeepaliveMinTimeEnvName = "GRPC_KEEPALIVE_MIN_TIME"
+	grpcShutdownTimeoutEnvName  = "GRPC_SHUTDOWN_TIMEOUT"
+
+	defaultKeepaliveTime    = 30 * time.Second
+	defaultKeepaliveTimeout = 10 * time.Second
+	defaultKeepaliveMinTime = 10 * time.Second
+	defaultShutdownTimeout  = 10 * time.Second
 )
 
 type grpcConfig struct {
 	host string
 	port string
+
+	keepaliveTime    time.Duration
+	keepaliveTimeout time.Duration
+	keepaliveMinTime time.Duration
+	shutdownTimeout  time.Duration
 }
 
 func NewGRPCConfig() (*grpcConfig, error) { //nolint:revive // it's ok
@@ -31,12 +47,52 @@ func NewGRPCConfig() (*grpcConfig, error) { //nolint:revive // it's ok
 		return nil, errors.New("grpc port not found")
 	}
 
+	keepaliveTime, err := positiveDuration(grpcKeepaliveTimeEnvName, defaultKeepaliveTime)
+	if err != nil {
+		return nil, err
+	}
+
+	keepaliveTimeout, err := positiveDuration(grpcKeepaliveTimeoutEnvName, defaultKeepaliveTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	keepaliveMinTime, err := positiveDuration(grpcKeepaliveMinTimeEnvName, defaultKeepaliveMinTime)
+	if err != nil {
+		return nil, err
+	}
+
+	shutdownTimeout, err := positiveDuration(grpcShutdownTimeoutEnvName, defaultShutdownTimeout)
+	if err != nil {
+		return nil, err
+	}
+
 	return &grpcConfig{
-		host: host,
-		port: port,
+		host:             host,
+		port:             port,
+		keepaliveTime:    keepaliveTime,
+		keepaliveTimeout: keepaliveTimeout,
+		keepaliveMinTime: keepaliveMinTime,
+		shutdownTimeout:  shutdownTimeout,
 	}, nil
 }
 
 func (cfg *grpcConfig) Address() string {
 	return net.JoinHostPort(cfg.host, cfg.port)
 }
+
+func (cfg *grpcConfig) KeepaliveTime() time.Duration {
+	return cfg.keepaliveTime
+}
+
+func (cfg *grpcConfig) KeepaliveTimeout() time.Duration {
+	return cfg.keepaliveTimeout
+}
+
+func (cfg *grpcConfig) KeepaliveMinTime() time.Duration {
+	return cfg.keepaliveMinTime
+}
+
+func (cfg *grpcConfig) ShutdownTimeout() time.Duration {
+	return cfg.shutdownTimeout
+}
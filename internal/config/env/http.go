@@ -2,8 +2,11 @@ package env
 
 import (
 	"errors"
+	"fmt"
 	"net"
+	"net/url"
 	"os"
+	"strings"
 
 	"github.com/Mobo140/chat/internal/config"
 )
@@ -13,11 +16,14 @@ var _ config.HTTPConfig = (*httpConfig)(nil)
 const (
 	httpHostEnvName = "HTTP_HOST"
 	httpPortEnvName = "HTTP_PORT"
+
+	httpWebSocketOriginsEnvName = "HTTP_WEBSOCKET_ORIGINS"
 )
 
 type httpConfig struct {
-	host string
-	port string
+	host    string
+	port    string
+	origins []string
 }
 
 func NewHTTPConfig() (*httpConfig, error) {
@@ -31,12 +37,32 @@ func NewHTTPConfig() (*httpConfig, error) {
 		return nil, errors.New("http port not found")
 	}
 
+	var origins []string
+	for _, origin := range strings.Split(os.Getenv(httpWebSocketOriginsEnvName), ",") {
+		origin = strings.TrimSpace(origin)
+		if origin == "" {
+			continue
+		}
+
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.Path != "" {
+			return nil, fmt.Errorf("invalid %s entry %q, want scheme://host", httpWebSocketOriginsEnvName, origin)
+		}
+
+		origins = append(origins, origin)
+	}
+
 	return &httpConfig{
-		host: host,
-		port: port,
+		host:    host,
+		port:    port,
+		origins: origins,
 	}, nil
 }
 
 func (c *httpConfig) Address() string {
 	return net.JoinHostPort(c.host, c.port)
 }
+
+func (c *httpConfig) WebSocketOrigins() []string {
+	return c.origins
+}
@@ -0,0 +1,47 @@
+package env
+
+import (
+	"time"
+
+	"github.com/Mobo140/chat/internal/config"
+)
+
+var _ config.OutboxConfig = (*outboxConfig)(nil)
+
+const (
+	outboxBatchSizeEnvName    = "OUTBOX_BATCH_SIZE"
+	outboxPollIntervalEnvName = "OUTBOX_POLL_INTERVAL"
+
+	defaultOutboxBatchSize    = 100
+	defaultOutboxPollInterval = 100 * time.Millisecond
+)
+
+type outboxConfig struct {
+	batchSize    uint64
+	pollInterval time.Duration
+}
+
+func NewOutboxConfig() (*outboxConfig, error) { //nolint:revive // it's ok
+	batchSize, err := positiveInt(outboxBatchSizeEnvName, defaultOutboxBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	pollInterval, err := positiveDuration(outboxPollIntervalEnvName, defaultOutboxPollInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &outboxConfig{
+		batchSize:    uint64(batchSize),
+		pollInterval: pollInterval,
+	}, nil
+}
+
+func (c *outboxConfig) BatchSize() uint64 {
+	return c.batchSize
+}
+
+func (c *outboxConfig) PollInterval() time.Duration {
+	return c.pollInterval
+}
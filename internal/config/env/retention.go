@@ -0,0 +1,156 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/Mobo140/chat/internal/config"
+)
+
+var _ config.RetentionConfig = (*retentionConfig)(nil)
+
+const (
+	retentionMessageMaxAgeEnvName   = "RETENTION_MESSAGE_MAX_AGE"
+	retentionMessageMaxCountEnvName = "RETENTION_MESSAGE_MAX_COUNT"
+	retentionLogMaxAgeEnvName       = "RETENTION_LOG_MAX_AGE"
+	retentionBatchSizeEnvName       = "RETENTION_BATCH_SIZE"
+	retentionIntervalEnvName        = "RETENTION_INTERVAL"
+	retentionDryRunEnvName          = "RETENTION_DRY_RUN"
+	retentionChatGraceEnvName       = "RETENTION_CHAT_GRACE"
+	retentionOutboxMaxAgeEnvName    = "RETENTION_OUTBOX_MAX_AGE"
+
+	defaultRetentionBatchSize = 1000
+	defaultRetentionInterval  = time.Hour
+	defaultRetentionChatGrace = 30 * 24 * time.Hour
+	defaultRetentionOutboxAge = 24 * time.Hour
+)
+
+type retentionConfig struct {
+	messageMaxAge   time.Duration
+	messageMaxCount int64
+	logMaxAge       time.Duration
+	batchSize       uint64
+	interval        time.Duration
+	dryRun          bool
+	chatGrace       time.Duration
+	outboxMaxAge    time.Duration
+}
+
+// NewRetentionConfig keeps everything by default.
+func NewRetentionConfig() (*retentionConfig, error) { //nolint:revive // it's ok
+	messageMaxAge, err := nonNegativeDuration(retentionMessageMaxAgeEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	messageMaxCount, err := nonNegativeInt(retentionMessageMaxCountEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	logMaxAge, err := nonNegativeDuration(retentionLogMaxAgeEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSize, err := positiveInt(retentionBatchSizeEnvName, defaultRetentionBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := positiveDuration(retentionIntervalEnvName, defaultRetentionInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	chatGrace, err := positiveDuration(retentionChatGraceEnvName, defaultRetentionChatGrace)
+	if err != nil {
+		return nil, err
+	}
+
+	outboxMaxAge, err := positiveDuration(retentionOutboxMaxAgeEnvName, defaultRetentionOutboxAge)
+	if err != nil {
+		return nil, err
+	}
+
+	var dryRun bool
+	if value := os.Getenv(retentionDryRunEnvName); len(value) > 0 {
+		dryRun, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %q", retentionDryRunEnvName, value)
+		}
+	}
+
+	return &retentionConfig{
+		messageMaxAge:   messageMaxAge,
+		messageMaxCount: messageMaxCount,
+		logMaxAge:       logMaxAge,
+		batchSize:       uint64(batchSize),
+		interval:        interval,
+		dryRun:          dryRun,
+		chatGrace:       chatGrace,
+		outboxMaxAge:    outboxMaxAge,
+	}, nil
+}
+
+func nonNegativeInt(envName string) (int64, error) {
+	value := os.Getenv(envName)
+	if len(value) == 0 {
+		return 0, nil
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid %s: %q", envName, value)
+	}
+
+	return n, nil
+}
+
+func nonNegativeDuration(envName string) (time.Duration, error) {
+	value := os.Getenv(envName)
+	if len(value) == 0 {
+		return 0, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid %s: %q", envName, value)
+	}
+
+	return d, nil
+}
+
+func (c *retentionConfig) MessageMaxAge() time.Duration {
+	return c.messageMaxAge
+}
+
+func (c *retentionConfig) MessageMaxCount() int64 {
+	return c.messageMaxCount
+}
+
+func (c *retentionConfig) LogMaxAge() time.Duration {
+	return c.logMaxAge
+}
+
+func (c *retentionConfig) BatchSize() uint64 {
+	return c.batchSize
+}
+
+func (c *retentionConfig) Interval() time.Duration {
+	return c.interval
+}
+
+func (c *retentionConfig) DryRun() bool {
+	return c.dryRun
+}
+
+func (c *retentionConfig) ChatGrace() time.Duration {
+	return c.chatGrace
+}
+
+func (c *retentionConfig) OutboxMaxAge() time.Duration {
+	return c.outboxMaxAge
+}
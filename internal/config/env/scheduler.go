@@ -0,0 +1,47 @@
+package env
+
+import (
+	"time"
+
+	"github.com/Mobo140/chat/internal/config"
+)
+
+var _ config.SchedulerConfig = (*schedulerConfig)(nil)
+
+const (
+	schedulerBatchSizeEnvName    = "SCHEDULER_BATCH_SIZE"
+	schedulerPollIntervalEnvName = "SCHEDULER_POLL_INTERVAL"
+
+	defaultSchedulerBatchSize    = 100
+	defaultSchedulerPollInterval = time.Second
+)
+
+type schedulerConfig struct {
+	batchSize    uint64
+	pollInterval time.Duration
+}
+
+func NewSchedulerConfig() (*schedulerConfig, error) { //nolint:revive // it's ok
+	batchSize, err := positiveInt(schedulerBatchSizeEnvName, defaultSchedulerBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	pollInterval, err := positiveDuration(schedulerPollIntervalEnvName, defaultSchedulerPollInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schedulerConfig{
+		batchSize:    uint64(batchSize),
+		pollInterval: pollInterval,
+	}, nil
+}
+
+func (c *schedulerConfig) BatchSize() uint64 {
+	return c.batchSize
+}
+
+func (c *schedulerConfig) PollInterval() time.Duration {
+	return c.pollInterval
+}
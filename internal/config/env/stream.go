@@ -0,0 +1,79 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Mobo140/chat/internal/config"
+	"github.com/Mobo140/chat/internal/model"
+)
+
+var _ config.StreamConfig = (*streamConfig)(nil)
+
+const (
+	streamQueueSizeEnvName = "STREAM_QUEUE_SIZE"
+	streamOverflowEnvName  = "STREAM_OVERFLOW_POLICY"
+	heartbeatIntervalEnv   = "STREAM_HEARTBEAT_INTERVAL"
+	missedHeartbeatsEnv    = "STREAM_MISSED_HEARTBEATS"
+
+	defaultStreamQueueSize   = 100
+	defaultStreamOverflow    = model.OverflowDropOldest
+	defaultHeartbeatInterval = 15 * time.Second
+	defaultMissedHeartbeats  = 3
+)
+
+type streamConfig struct {
+	queueSize         int
+	overflow          model.OverflowPolicy
+	heartbeatInterval time.Duration
+	missedHeartbeats  int
+}
+
+func NewStreamConfig() (*streamConfig, error) { //nolint:revive // it's ok
+	queueSize, err := positiveInt(streamQueueSizeEnvName, defaultStreamQueueSize)
+	if err != nil {
+		return nil, err
+	}
+
+	overflow := defaultStreamOverflow
+	if value := os.Getenv(streamOverflowEnvName); len(value) > 0 {
+		overflow = model.OverflowPolicy(value)
+		if !overflow.Valid() {
+			return nil, fmt.Errorf("invalid %s: %q", streamOverflowEnvName, value)
+		}
+	}
+
+	heartbeatInterval, err := positiveDuration(heartbeatIntervalEnv, defaultHeartbeatInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	missedHeartbeats, err := positiveInt(missedHeartbeatsEnv, defaultMissedHeartbeats)
+	if err != nil {
+		return nil, err
+	}
+
+	return &streamConfig{
+		queueSize:         queueSize,
+		overflow:          overflow,
+		heartbeatInterval: heartbeatInterval,
+		missedHeartbeats:  missedHeartbeats,
+	}, nil
+}
+
+func (c *streamConfig) QueueSize() int {
+	return c.queueSize
+}
+
+func (c *streamConfig) Overflow() model.OverflowPolicy {
+	return c.overflow
+}
+
+func (c *streamConfig) HeartbeatInterval() time.Duration {
+	return c.heartbeatInterval
+}
+
+func (c *streamConfig) MissedHeartbeats() int {
+	return c.missedHeartbeats
+}
@@ -0,0 +1,117 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/Mobo140/chat/internal/config"
+)
+
+var _ config.WebhookConfig = (*webhookConfig)(nil)
+
+const (
+	webhookBatchSizeEnvName    = "WEBHOOK_BATCH_SIZE"
+	webhookPollIntervalEnvName = "WEBHOOK_POLL_INTERVAL"
+	webhookTimeoutEnvName      = "WEBHOOK_TIMEOUT"
+	webhookMaxAttemptsEnvName  = "WEBHOOK_MAX_ATTEMPTS"
+	webhookBaseBackoffEnvName  = "WEBHOOK_BASE_BACKOFF"
+	webhookMaxBackoffEnvName   = "WEBHOOK_MAX_BACKOFF"
+	webhookAllowPrivateEnvName = "WEBHOOK_ALLOW_PRIVATE_NETWORKS"
+
+	defaultWebhookBatchSize    = 20
+	defaultWebhookPollInterval = time.Second
+	defaultWebhookTimeout      = 10 * time.Second
+	defaultWebhookMaxAttempts  = 8
+	defaultWebhookBaseBackoff  = 5 * time.Second
+	defaultWebhookMaxBackoff   = time.Hour
+)
+
+type webhookConfig struct {
+	batchSize    uint64
+	pollInterval time.Duration
+	timeout      time.Duration
+	maxAttempts  int
+	baseBackoff  time.Duration
+	maxBackoff   time.Duration
+	allowPrivate bool
+}
+
+func NewWebhookConfig() (*webhookConfig, error) { //nolint:revive // it's ok
+	batchSize, err := positiveInt(webhookBatchSizeEnvName, defaultWebhookBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	pollInterval, err := positiveDuration(webhookPollIntervalEnvName, defaultWebhookPollInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout, err := positiveDuration(webhookTimeoutEnvName, defaultWebhookTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	maxAttempts, err := positiveInt(webhookMaxAttemptsEnvName, defaultWebhookMaxAttempts)
+	if err != nil {
+		return nil, err
+	}
+
+	baseBackoff, err := positiveDuration(webhookBaseBackoffEnvName, defaultWebhookBaseBackoff)
+	if err != nil {
+		return nil, err
+	}
+
+	maxBackoff, err := positiveDuration(webhookMaxBackoffEnvName, defaultWebhookMaxBackoff)
+	if err != nil {
+		return nil, err
+	}
+
+	var allowPrivate bool
+	if value := os.Getenv(webhookAllowPrivateEnvName); len(value) > 0 {
+		allowPrivate, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %q", webhookAllowPrivateEnvName, value)
+		}
+	}
+
+	return &webhookConfig{
+		batchSize:    uint64(batchSize),
+		pollInterval: pollInterval,
+		timeout:      timeout,
+		maxAttempts:  maxAttempts,
+		baseBackoff:  baseBackoff,
+		maxBackoff:   maxBackoff,
+		allowPrivate: allowPrivate,
+	}, nil
+}
+
+func (c *webhookConfig) BatchSize() uint64 {
+	return c.batchSize
+}
+
+func (c *webhookConfig) PollInterval() time.Duration {
+	return c.pollInterval
+}
+
+func (c *webhookConfig) Timeout() time.Duration {
+	return c.timeout
+}
+
+func (c *webhookConfig) MaxAttempts() int {
+	return c.maxAttempts
+}
+
+func (c *webhookConfig) BaseBackoff() time.Duration {
+	return c.baseBackoff
+}
+
+func (c *webhookConfig) MaxBackoff() time.Duration {
+	return c.maxBackoff
+}
+
+func (c *webhookConfig) AllowPrivateNetworks() bool {
+	return c.allowPrivate
+}
@@ -2,9 +2,11 @@ package converter
 
 import (
 	"errors"
+	"time"
 
 	"github.com/Mobo140/chat/internal/model"
 	desc "github.com/Mobo140/chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ToChatInfoFromDesc(info *desc.ChatInfo) (*model.ChatInfo, error) {
@@ -14,18 +16,55 @@ func ToChatInfoFromDesc(info *desc.ChatInfo) (*model.ChatInfo, error) {
 
 	return &model.ChatInfo{
 		Usernames: info.Usernames,
+		Admins:    info.Admins,
 	}, nil
 }
 
 func ToChatFromService(chat *model.Chat) *desc.Chat {
-	return &desc.Chat{
-		Id:   chat.ID,
-		Info: ToChatInfoFromService(chat.Info),
+	res := &desc.Chat{
+		Id:        chat.ID,
+		Info:      ToChatInfoFromService(chat.Info),
+		Topic:     chat.Topic,
+		Retention: ToRetentionFromService(&chat.Retention),
 	}
+
+	if chat.ArchivedAt != nil {
+		res.ArchivedAt = timestamppb.New(*chat.ArchivedAt)
+	}
+
+	return res
+}
+
+func ToRetentionFromService(retention *model.ChatRetention) *desc.Retention {
+	res := &desc.Retention{
+		MaxCount: retention.MaxCount,
+	}
+
+	if retention.MaxAge != nil {
+		seconds := int64(retention.MaxAge.Seconds())
+		res.MaxAgeSeconds = &seconds
+	}
+
+	return res
+}
+
+func ToChatRetentionFromDesc(req *desc.SetRetentionRequest) *model.ChatRetention {
+	res := &model.ChatRetention{
+		ChatID:   req.GetChatId(),
+		MaxCount: req.GetRetention().MaxCount,
+	}
+
+	if req.GetRetention().MaxAgeSeconds != nil {
+		maxAge := time.Duration(req.GetRetention().GetMaxAgeSeconds()) * time.Second
+		res.MaxAge = &maxAge
+	}
+
+	return res
 }
 
 func ToChatInfoFromService(chatInfo model.ChatInfo) *desc.ChatInfo {
 	return &desc.ChatInfo{
 		Usernames: chatInfo.Usernames,
+		Admins:    chatInfo.Admins,
 	}
 }
@@ -0,0 +1,42 @@
+package converter
+
+import (
+	"github.com/Mobo140/chat/internal/model"
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToDeliveryAckFromDesc(req *desc.AckMessagesRequest) *model.DeliveryAck {
+	return &model.DeliveryAck{
+		ChatID:    req.GetChatId(),
+		Username:  req.GetUsername(),
+		MessageID: req.GetLastMessageId(),
+	}
+}
+
+func ToMemberDeliveriesFromService(members []*model.MemberDelivery) []*desc.MemberDelivery {
+	res := make([]*desc.MemberDelivery, 0, len(members))
+	for _, member := range members {
+		res = append(res, &desc.MemberDelivery{
+			Username:  member.Username,
+			Delivered: member.Delivered,
+		})
+	}
+
+	return res
+}
+
+func ToSessionsFromService(sessions []*model.Session) []*desc.Session {
+	res := make([]*desc.Session, 0, len(sessions))
+	for _, session := range sessions {
+		res = append(res, &desc.Session{
+			SessionId:    session.ID,
+			DeviceId:     session.DeviceID,
+			ChatId:       session.ChatID,
+			ConnectedAt:  timestamppb.New(session.ConnectedAt),
+			LastActiveAt: timestamppb.New(session.LastActiveAt),
+		})
+	}
+
+	return res
+}
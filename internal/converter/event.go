@@ -0,0 +1,52 @@
+package converter
+
+import (
+	"github.com/Mobo140/chat/internal/model"
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ToChatEventFromService converts an outbox event to the stream event,
+// nil if the streams have no frame for the event type.
+func ToChatEventFromService(event *model.ChatEvent) *desc.ChatEvent {
+	res := &desc.ChatEvent{ChatId: event.ChatID}
+
+	switch event.Type {
+	case model.ChatEventMessageSent:
+		res.Payload = &desc.ChatEvent_Message{Message: ToMessageFromService(event.Message)}
+	case model.ChatEventMessageEdited:
+		res.Payload = &desc.ChatEvent_Edited{Edited: &desc.MessageEdited{
+			MessageId: event.Message.ID,
+			Username:  event.Username,
+			Text:      event.Message.Text,
+			EditedAt:  timestamppb.New(*event.Message.EditedAt),
+		}}
+	case model.ChatEventMessageDeleted:
+		res.Payload = &desc.ChatEvent_Deleted{Deleted: &desc.MessageDeleted{
+			MessageId: event.Message.ID,
+			Username:  event.Username,
+			DeletedAt: timestamppb.New(*event.Message.DeletedAt),
+		}}
+	case model.ChatEventReactionAdded:
+		res.Payload = &desc.ChatEvent_ReactionAdded{ReactionAdded: toReactionEvent(event.Reaction)}
+	case model.ChatEventReactionRemoved:
+		res.Payload = &desc.ChatEvent_ReactionRemoved{ReactionRemoved: toReactionEvent(event.Reaction)}
+	case model.ChatEventMessagesRead:
+		res.Payload = &desc.ChatEvent_Read{Read: &desc.ReadEvent{
+			Username:      event.Username,
+			LastMessageId: event.ReadMessageID,
+		}}
+	default:
+		return nil
+	}
+
+	return res
+}
+
+func toReactionEvent(reaction *model.Reaction) *desc.ReactionEvent {
+	return &desc.ReactionEvent{
+		MessageId: reaction.MessageID,
+		Username:  reaction.Username,
+		Emoji:     reaction.Emoji,
+	}
+}
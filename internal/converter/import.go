@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"github.com/Mobo140/chat/internal/model"
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+func ToImportChatResponseFromService(report *model.ImportReport) *desc.ImportChatResponse {
+	errs := make([]*desc.ImportError, 0, len(report.Errors))
+	for _, err := range report.Errors {
+		errs = append(errs, &desc.ImportError{
+			Line:  err.Line,
+			Error: err.Message,
+		})
+	}
+
+	return &desc.ImportChatResponse{
+		ChatId:           report.ChatID,
+		Messages:         report.Messages,
+		Edits:            report.Edits,
+		FailedLines:      report.FailedLines,
+		Errors:           errs,
+		SkippedAudit:     report.SkippedAudit,
+		SkippedReactions: report.SkippedReactions,
+	}
+}
@@ -0,0 +1,77 @@
+package converter
+
+import (
+	"encoding/json"
+
+	"github.com/Mobo140/chat/internal/model"
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToLogFilterFromDesc(req *desc.ListAuditLogRequest) *model.LogFilter {
+	filter := &model.LogFilter{
+		ChatID:    req.GetChatId(),
+		MessageID: req.GetMessageId(),
+		Actor:     req.GetActor(),
+		Action:    model.LogAction(req.GetAction()),
+		BeforeID:  req.GetBeforeId(),
+		Limit:     uint64(req.GetLimit()),
+	}
+
+	if req.GetSince() != nil {
+		since := req.GetSince().AsTime()
+		filter.Since = &since
+	}
+
+	if req.GetUntil() != nil {
+		until := req.GetUntil().AsTime()
+		filter.Until = &until
+	}
+
+	return filter
+}
+
+func ToAuditLogEntriesFromService(entries []*model.LogEntry) ([]*desc.AuditLogEntry, error) {
+	res := make([]*desc.AuditLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		details, err := toStruct(entry.Details)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &desc.AuditLogEntry{
+			Id:        entry.ID,
+			ChatId:    entry.ChatID,
+			MessageId: entry.MessageID,
+			Action:    string(entry.Action),
+			Actor:     entry.Actor,
+			Details:   details,
+			TraceId:   entry.TraceID,
+			CreatedAt: timestamppb.New(entry.CreatedAt),
+		})
+	}
+
+	return res, nil
+}
+
+// toStruct goes through JSON: the details may hold any JSON encodable value.
+func toStruct(details model.LogDetails) (*structpb.Struct, error) {
+	res := &structpb.Struct{}
+	if details == nil {
+		return res, nil
+	}
+
+	raw, err := json.Marshal(details)
+	if err != nil {
+		return nil, err
+	}
+
+	err = protojson.Unmarshal(raw, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
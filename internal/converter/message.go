@@ -5,6 +5,7 @@ import (
 
 	"github.com/Mobo140/chat/internal/model"
 	desc "github.com/Mobo140/chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ToMessageFromDesc(message *desc.Message) (*model.Message, error) {
@@ -16,5 +17,77 @@ func ToMessageFromDesc(message *desc.Message) (*model.Message, error) {
 		From:      message.From,
 		Text:      message.Text,
 		CreatedAt: message.CreatedAt.AsTime(),
+		ReplyToID: message.ReplyToMessageId,
 	}, nil
 }
+
+func ToMessageFromService(message *model.Message) *desc.Message {
+	res := &desc.Message{
+		Id:               message.ID,
+		From:             message.From,
+		Text:             message.Text,
+		CreatedAt:        timestamppb.New(message.CreatedAt),
+		Deleted:          message.DeletedAt != nil,
+		Reactions:        ToReactionsFromService(message.Reactions),
+		ReplyToMessageId: message.ReplyToID,
+		ReplyCount:       message.ReplyCount,
+	}
+
+	if message.EditedAt != nil {
+		res.EditedAt = timestamppb.New(*message.EditedAt)
+	}
+
+	if message.Parent != nil {
+		res.Parent = &desc.MessagePreview{
+			Id:      message.Parent.ID,
+			From:    message.Parent.From,
+			Text:    message.Parent.Text,
+			Deleted: message.Parent.Deleted,
+		}
+	}
+
+	return res
+}
+
+func ToMessagesFromService(messages []*model.Message) []*desc.Message {
+	res := make([]*desc.Message, 0, len(messages))
+	for _, message := range messages {
+		res = append(res, ToMessageFromService(message))
+	}
+
+	return res
+}
+
+func ToReactionsFromService(reactions []model.ReactionCount) []*desc.ReactionCount {
+	res := make([]*desc.ReactionCount, 0, len(reactions))
+	for _, reaction := range reactions {
+		res = append(res, &desc.ReactionCount{
+			Emoji: reaction.Emoji,
+			Count: reaction.Count,
+		})
+	}
+
+	return res
+}
+
+func ToReactionFromDesc(req *desc.ReactionRequest) *model.Reaction {
+	return &model.Reaction{
+		ChatID:    req.GetChatId(),
+		MessageID: req.GetMessageId(),
+		Username:  req.GetUsername(),
+		Emoji:     req.GetEmoji(),
+	}
+}
+
+func ToSearchResultsFromService(results []*model.SearchResult) []*desc.SearchResult {
+	res := make([]*desc.SearchResult, 0, len(results))
+	for _, result := range results {
+		res = append(res, &desc.SearchResult{
+			ChatId:  result.ChatID,
+			Message: ToMessageFromService(result.Message),
+			Snippet: result.Snippet,
+		})
+	}
+
+	return res
+}
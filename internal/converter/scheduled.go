@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"github.com/Mobo140/chat/internal/model"
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToScheduledMessagesFromService(messages []*model.ScheduledMessage) []*desc.ScheduledMessage {
+	res := make([]*desc.ScheduledMessage, 0, len(messages))
+	for _, message := range messages {
+		res = append(res, &desc.ScheduledMessage{
+			Id:     message.ID,
+			ChatId: message.ChatID,
+			Message: &desc.Message{
+				From:             message.Message.From,
+				Text:             message.Message.Text,
+				ReplyToMessageId: message.Message.ReplyToID,
+			},
+			ScheduledAt: timestamppb.New(message.ScheduledAt),
+			CreatedAt:   timestamppb.New(message.CreatedAt),
+			Error:       message.Error,
+		})
+	}
+
+	return res
+}
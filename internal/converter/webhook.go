@@ -0,0 +1,67 @@
+package converter
+
+import (
+	"github.com/Mobo140/chat/internal/model"
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToWebhookFromDesc(req *desc.CreateWebhookRequest) *model.Webhook {
+	eventTypes := make([]model.ChatEventType, 0, len(req.GetEventTypes()))
+	for _, eventType := range req.GetEventTypes() {
+		eventTypes = append(eventTypes, model.ChatEventType(eventType))
+	}
+
+	return &model.Webhook{
+		ChatID:     req.GetChatId(),
+		URL:        req.GetUrl(),
+		EventTypes: eventTypes,
+	}
+}
+
+func ToWebhooksFromService(webhooks []*model.Webhook) []*desc.Webhook {
+	res := make([]*desc.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		eventTypes := make([]string, 0, len(webhook.EventTypes))
+		for _, eventType := range webhook.EventTypes {
+			eventTypes = append(eventTypes, string(eventType))
+		}
+
+		res = append(res, &desc.Webhook{
+			Id:         webhook.ID,
+			ChatId:     webhook.ChatID,
+			Url:        webhook.URL,
+			EventTypes: eventTypes,
+			CreatedAt:  timestamppb.New(webhook.CreatedAt),
+		})
+	}
+
+	return res
+}
+
+func ToWebhookAttemptFilterFromDesc(req *desc.ListWebhookDeliveriesRequest) *model.WebhookAttemptFilter {
+	return &model.WebhookAttemptFilter{
+		WebhookID: req.GetWebhookId(),
+		BeforeID:  req.GetBeforeId(),
+		Limit:     uint64(req.GetLimit()),
+	}
+}
+
+func ToWebhookDeliveriesFromService(attempts []*model.WebhookAttempt) []*desc.WebhookDelivery {
+	res := make([]*desc.WebhookDelivery, 0, len(attempts))
+	for _, attempt := range attempts {
+		res = append(res, &desc.WebhookDelivery{
+			Id:         attempt.ID,
+			EventId:    attempt.EventID,
+			EventType:  string(attempt.EventType),
+			Attempt:    int32(attempt.Attempt),
+			StatusCode: int32(attempt.StatusCode),
+			Error:      attempt.Error,
+			DurationMs: attempt.Duration.Milliseconds(),
+			DeadLetter: attempt.DeadLetter,
+			CreatedAt:  timestamppb.New(attempt.CreatedAt),
+		})
+	}
+
+	return res
+}
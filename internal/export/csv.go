@@ -0,0 +1,180 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+var csvHeader = []string{
+	"record", "id", "message_id", "created_at", "author", "text",
+	"reply_to_message_id", "edited_at", "deleted_at", "details",
+}
+
+// csvEncoder writes a row per record: the chat, its members, the messages
+// each followed by its previous versions, and the audit log entries.
+type csvEncoder struct {
+	writer      *csv.Writer
+	wroteHeader bool
+}
+
+func newCSVEncoder(w io.Writer) *csvEncoder {
+	return &csvEncoder{writer: csv.NewWriter(w)}
+}
+
+func (e *csvEncoder) Encode(record *model.ExportRecord) error {
+	if !e.wroteHeader {
+		e.wroteHeader = true
+
+		err := e.writer.Write(csvHeader)
+		if err != nil {
+			return err
+		}
+	}
+
+	line, err := ToLine(record)
+	if err != nil {
+		return err
+	}
+
+	switch line.Type {
+	case LineTypeChat:
+		return e.writeChat(line.Chat)
+	case LineTypeMessage:
+		return e.writeMessage(line.Message)
+	default:
+		return e.writeAudit(line.Audit)
+	}
+}
+
+func (e *csvEncoder) Close() error {
+	e.writer.Flush()
+
+	return e.writer.Error()
+}
+
+func (e *csvEncoder) writeChat(chat *ChatLine) error {
+	id := strconv.FormatInt(chat.ID, 10)
+
+	err := e.writer.Write([]string{"chat", id, "", "", "", cell(chat.Topic), "", "", "", ""})
+	if err != nil {
+		return err
+	}
+
+	admins := make(map[string]struct{}, len(chat.Admins))
+	for _, admin := range chat.Admins {
+		admins[admin] = struct{}{}
+	}
+
+	for _, username := range chat.Usernames {
+		details := ""
+		if _, ok := admins[username]; ok {
+			details = `{"admin":true}`
+		}
+
+		err = e.writer.Write([]string{"member", id, "", "", cell(username), "", "", "", "", details})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *csvEncoder) writeMessage(message *MessageLine) error {
+	var details string
+	if len(message.Reactions) > 0 {
+		raw, err := json.Marshal(map[string]any{"reactions": message.Reactions})
+		if err != nil {
+			return err
+		}
+
+		details = string(raw)
+	}
+
+	err := e.writer.Write([]string{
+		"message",
+		strconv.FormatInt(message.ID, 10),
+		"",
+		formatTime(&message.CreatedAt),
+		cell(message.From),
+		cell(message.Text),
+		formatID(message.ReplyToMessageID),
+		formatTime(message.EditedAt),
+		formatTime(message.DeletedAt),
+		cell(details),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, edit := range message.Edits {
+		err = e.writer.Write([]string{
+			"edit",
+			"",
+			strconv.FormatInt(message.ID, 10),
+			formatTime(&edit.EditedAt),
+			cell(edit.EditedBy),
+			cell(edit.Text),
+			"", "", "", "",
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *csvEncoder) writeAudit(entry *AuditLine) error {
+	var details string
+	if len(entry.Details) > 0 {
+		raw, err := json.Marshal(entry.Details)
+		if err != nil {
+			return err
+		}
+
+		details = string(raw)
+	}
+
+	return e.writer.Write([]string{
+		"audit",
+		strconv.FormatInt(entry.ID, 10),
+		formatID(entry.MessageID),
+		formatTime(&entry.CreatedAt),
+		cell(entry.Actor),
+		string(entry.Action),
+		"", "", "",
+		cell(details),
+	})
+}
+
+// cell keeps spreadsheets from evaluating a user's text as a formula.
+func cell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
+func formatID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+
+	return strconv.FormatInt(id, 10)
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,134 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+// MaxLineSize is the longest line a JSON Lines import may have.
+const MaxLineSize = 1 << 20
+
+// Decoder reads the records of a JSON Lines export line by line.
+type Decoder struct {
+	scanner *bufio.Scanner
+	line    int64
+}
+
+func NewDecoder(r io.Reader) *Decoder {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64<<10), MaxLineSize)
+
+	return &Decoder{scanner: scanner}
+}
+
+// Next returns the next line, io.EOF after the last one. An invalid line is
+// returned with its error, the error of Next is only for the reader failing.
+func (d *Decoder) Next() (*model.ImportLine, error) {
+	for d.scanner.Scan() {
+		d.line++
+
+		raw := bytes.TrimSpace(d.scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+
+		line := &model.ImportLine{Number: d.line}
+
+		var decoded Line
+
+		err := json.Unmarshal(raw, &decoded)
+		if err != nil {
+			line.Err = err
+
+			return line, nil
+		}
+
+		line.Record, line.Err = FromLine(&decoded)
+
+		return line, nil
+	}
+
+	err := d.scanner.Err()
+	if errors.Is(err, bufio.ErrTooLong) {
+		return nil, fmt.Errorf("%w: line %d is longer than %d bytes", model.ErrInvalidImport, d.line+1, MaxLineSize)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
+
+// FromLine converts a line of the JSON Lines export to its record.
+func FromLine(line *Line) (*model.ExportRecord, error) {
+	switch {
+	case line.Type == LineTypeChat && line.Chat != nil:
+		return &model.ExportRecord{Chat: fromChatLine(line.Chat)}, nil
+	case line.Type == LineTypeMessage && line.Message != nil:
+		return &model.ExportRecord{Message: fromMessageLine(line.Message)}, nil
+	case line.Type == LineTypeAudit && line.Audit != nil:
+		return &model.ExportRecord{Audit: fromAuditLine(line.Audit)}, nil
+	default:
+		return nil, fmt.Errorf("unknown record %q", line.Type)
+	}
+}
+
+func fromChatLine(chat *ChatLine) *model.Chat {
+	return &model.Chat{
+		ID: chat.ID,
+		Info: model.ChatInfo{
+			Usernames: chat.Usernames,
+			Admins:    chat.Admins,
+		},
+		Topic: chat.Topic,
+	}
+}
+
+func fromMessageLine(message *MessageLine) *model.ExportMessage {
+	res := &model.ExportMessage{
+		Message: model.Message{
+			ID:        message.ID,
+			From:      message.From,
+			Text:      message.Text,
+			CreatedAt: message.CreatedAt,
+			EditedAt:  message.EditedAt,
+			DeletedAt: message.DeletedAt,
+			ReplyToID: message.ReplyToMessageID,
+		},
+	}
+
+	for _, reaction := range message.Reactions {
+		res.Reactions = append(res.Reactions, model.ReactionCount{Emoji: reaction.Emoji, Count: reaction.Count})
+	}
+
+	for _, edit := range message.Edits {
+		res.Edits = append(res.Edits, &model.MessageEdit{
+			MessageID: message.ID,
+			Text:      edit.Text,
+			EditedBy:  edit.EditedBy,
+			EditedAt:  edit.EditedAt,
+		})
+	}
+
+	return res
+}
+
+func fromAuditLine(entry *AuditLine) *model.LogEntry {
+	return &model.LogEntry{
+		ID:        entry.ID,
+		MessageID: entry.MessageID,
+		Action:    entry.Action,
+		Actor:     entry.Actor,
+		Details:   entry.Details,
+		TraceID:   entry.TraceID,
+		CreatedAt: entry.CreatedAt,
+		Hash:      entry.Hash,
+	}
+}
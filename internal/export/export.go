@@ -0,0 +1,46 @@
+package export
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+// Encoder writes the records of a chat export in a file format as they come.
+type Encoder interface {
+	Encode(record *model.ExportRecord) error
+	// Close writes the end of the file and flushes it, the writer stays open.
+	Close() error
+}
+
+// NewEncoder returns the encoder of the format writing to w.
+func NewEncoder(format model.ExportFormat, w io.Writer) (Encoder, error) {
+	switch format {
+	case model.ExportFormatJSONL:
+		return newJSONLEncoder(w), nil
+	case model.ExportFormatCSV:
+		return newCSVEncoder(w), nil
+	case model.ExportFormatHTML:
+		return newHTMLEncoder(w), nil
+	default:
+		return nil, fmt.Errorf("unknown export format %q", format)
+	}
+}
+
+// ContentType is the media type of the format.
+func ContentType(format model.ExportFormat) string {
+	switch format {
+	case model.ExportFormatCSV:
+		return "text/csv; charset=utf-8"
+	case model.ExportFormatHTML:
+		return "text/html; charset=utf-8"
+	default:
+		return "application/jsonl"
+	}
+}
+
+// FileName is the name the export of the chat is downloaded under.
+func FileName(chatID int64, format model.ExportFormat) string {
+	return fmt.Sprintf("chat-%d.%s", chatID, format)
+}
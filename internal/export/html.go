@@ -0,0 +1,119 @@
+package export
+
+import (
+	"html/template"
+	"io"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+// htmlTemplates render the transcript piece by piece, html/template escapes
+// the texts of the users.
+var htmlTemplates = template.Must(template.New("transcript").Funcs(template.FuncMap{
+	"time": func(t time.Time) string { return t.UTC().Format("2006-01-02 15:04:05 MST") },
+	"admin": func(chat *ChatLine, username string) bool {
+		for _, admin := range chat.Admins {
+			if admin == username {
+				return true
+			}
+		}
+
+		return false
+	},
+}).Parse(`
+{{define "header"}}<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Chat {{.ID}}</title>
+<style>
+body { font-family: sans-serif; max-width: 48em; margin: 2em auto; color: #222; }
+.member-admin { font-weight: bold; }
+article { border-bottom: 1px solid #ddd; padding: .5em 0; }
+article header { color: #666; font-size: .9em; }
+.deleted { color: #999; font-style: italic; }
+.reply { font-size: .85em; }
+.reactions span { margin-right: .5em; }
+table { border-collapse: collapse; font-size: .85em; }
+td, th { border: 1px solid #ddd; padding: .25em .5em; text-align: left; }
+</style>
+</head>
+<body>
+<h1>Chat {{.ID}}</h1>
+{{if .Topic}}<p>Topic: {{.Topic}}</p>
+{{end}}<h2>Members</h2>
+<ul>
+{{range .Usernames}}<li{{if admin $ .}} class="member-admin"{{end}}>{{.}}{{if admin $ .}} (admin){{end}}</li>
+{{end}}</ul>
+<h2>Messages</h2>
+{{end}}
+
+{{define "message"}}<article id="m{{.ID}}">
+<header><strong>{{.From}}</strong> · {{time .CreatedAt}}{{if .EditedAt}} · edited {{time .EditedAt}}{{end}}</header>
+{{if .ReplyToMessageID}}<p class="reply"><a href="#m{{.ReplyToMessageID}}">in reply to #{{.ReplyToMessageID}}</a></p>
+{{end}}{{if .DeletedAt}}<p class="deleted">deleted {{time .DeletedAt}}</p>
+{{else}}<p>{{.Text}}</p>
+{{end}}{{if .Reactions}}<p class="reactions">{{range .Reactions}}<span>{{.Emoji}} {{.Count}}</span>{{end}}</p>
+{{end}}{{if .Edits}}<details><summary>{{len .Edits}} previous versions</summary>
+<ol>
+{{range .Edits}}<li>{{.Text}} <small>replaced by {{.EditedBy}} at {{time .EditedAt}}</small></li>
+{{end}}</ol>
+</details>
+{{end}}</article>
+{{end}}
+
+{{define "audit_header"}}<h2>Audit log</h2>
+<table>
+<tr><th>#</th><th>Time</th><th>Action</th><th>Actor</th><th>Message</th><th>Hash</th></tr>
+{{end}}
+
+{{define "audit"}}<tr><td>{{.ID}}</td><td>{{time .CreatedAt}}</td><td>{{.Action}}</td><td>{{.Actor}}</td>
+<td>{{if .MessageID}}<a href="#m{{.MessageID}}">#{{.MessageID}}</a>{{end}}</td><td><code>{{.Hash}}</code></td></tr>
+{{end}}
+
+{{define "footer"}}{{if .}}</table>
+{{end}}</body>
+</html>
+{{end}}
+`))
+
+// htmlEncoder writes a self-contained transcript: no scripts, no external
+// styles or images.
+type htmlEncoder struct {
+	w       io.Writer
+	inAudit bool
+}
+
+func newHTMLEncoder(w io.Writer) *htmlEncoder {
+	return &htmlEncoder{w: w}
+}
+
+func (e *htmlEncoder) Encode(record *model.ExportRecord) error {
+	line, err := ToLine(record)
+	if err != nil {
+		return err
+	}
+
+	switch line.Type {
+	case LineTypeChat:
+		return htmlTemplates.ExecuteTemplate(e.w, "header", line.Chat)
+	case LineTypeMessage:
+		return htmlTemplates.ExecuteTemplate(e.w, "message", line.Message)
+	default:
+		if !e.inAudit {
+			e.inAudit = true
+
+			err = htmlTemplates.ExecuteTemplate(e.w, "audit_header", nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		return htmlTemplates.ExecuteTemplate(e.w, "audit", line.Audit)
+	}
+}
+
+func (e *htmlEncoder) Close() error {
+	return htmlTemplates.ExecuteTemplate(e.w, "footer", e.inAudit)
+}
@@ -0,0 +1,165 @@
+package export
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+// LineType tells which field of a JSON Lines record is set.
+type LineType string
+
+const (
+	LineTypeChat    LineType = "chat"
+	LineTypeMessage LineType = "message"
+	LineTypeAudit   LineType = "audit"
+)
+
+// Line is a line of the JSON Lines export, exactly one of the records is set.
+type Line struct {
+	Type    LineType     `json:"type"`
+	Chat    *ChatLine    `json:"chat,omitempty"`
+	Message *MessageLine `json:"message,omitempty"`
+	Audit   *AuditLine   `json:"audit,omitempty"`
+}
+
+type ChatLine struct {
+	ID        int64    `json:"id"`
+	Usernames []string `json:"usernames"`
+	Admins    []string `json:"admins"`
+	Topic     string   `json:"topic,omitempty"`
+}
+
+type MessageLine struct {
+	ID               int64          `json:"id"`
+	From             string         `json:"from"`
+	Text             string         `json:"text"`
+	CreatedAt        time.Time      `json:"created_at"`
+	EditedAt         *time.Time     `json:"edited_at,omitempty"`
+	DeletedAt        *time.Time     `json:"deleted_at,omitempty"`
+	ReplyToMessageID int64          `json:"reply_to_message_id,omitempty"`
+	Reactions        []ReactionLine `json:"reactions,omitempty"`
+	// Edits are the previous versions of the text from the oldest.
+	Edits []EditLine `json:"edits,omitempty"`
+}
+
+type ReactionLine struct {
+	Emoji string `json:"emoji"`
+	Count int64  `json:"count"`
+}
+
+type EditLine struct {
+	Text     string    `json:"text"`
+	EditedBy string    `json:"edited_by"`
+	EditedAt time.Time `json:"edited_at"`
+}
+
+type AuditLine struct {
+	ID        int64            `json:"id"`
+	MessageID int64            `json:"message_id,omitempty"`
+	Action    model.LogAction  `json:"action"`
+	Actor     string           `json:"actor,omitempty"`
+	Details   model.LogDetails `json:"details,omitempty"`
+	TraceID   string           `json:"trace_id,omitempty"`
+	CreatedAt time.Time        `json:"created_at"`
+	Hash      string           `json:"hash,omitempty"`
+}
+
+type jsonlEncoder struct {
+	encoder *json.Encoder
+}
+
+func newJSONLEncoder(w io.Writer) *jsonlEncoder {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+
+	return &jsonlEncoder{encoder: encoder}
+}
+
+func (e *jsonlEncoder) Encode(record *model.ExportRecord) error {
+	line, err := ToLine(record)
+	if err != nil {
+		return err
+	}
+
+	return e.encoder.Encode(line)
+}
+
+func (e *jsonlEncoder) Close() error {
+	return nil
+}
+
+// ToLine converts a record to its JSON Lines form.
+func ToLine(record *model.ExportRecord) (*Line, error) {
+	switch {
+	case record.Chat != nil:
+		return &Line{Type: LineTypeChat, Chat: toChatLine(record.Chat)}, nil
+	case record.Message != nil:
+		return &Line{Type: LineTypeMessage, Message: toMessageLine(record.Message)}, nil
+	case record.Audit != nil:
+		return &Line{Type: LineTypeAudit, Audit: toAuditLine(record.Audit)}, nil
+	default:
+		return nil, errors.New("empty export record")
+	}
+}
+
+func toChatLine(chat *model.Chat) *ChatLine {
+	return &ChatLine{
+		ID:        chat.ID,
+		Usernames: chat.Info.Usernames,
+		Admins:    chat.Info.Admins,
+		Topic:     chat.Topic,
+	}
+}
+
+func toMessageLine(message *model.ExportMessage) *MessageLine {
+	line := &MessageLine{
+		ID:               message.ID,
+		From:             message.From,
+		Text:             message.Text,
+		CreatedAt:        message.CreatedAt.UTC(),
+		EditedAt:         utc(message.EditedAt),
+		DeletedAt:        utc(message.DeletedAt),
+		ReplyToMessageID: message.ReplyToID,
+	}
+
+	for _, reaction := range message.Reactions {
+		line.Reactions = append(line.Reactions, ReactionLine{Emoji: reaction.Emoji, Count: reaction.Count})
+	}
+
+	for _, edit := range message.Edits {
+		line.Edits = append(line.Edits, EditLine{
+			Text:     edit.Text,
+			EditedBy: edit.EditedBy,
+			EditedAt: edit.EditedAt.UTC(),
+		})
+	}
+
+	return line
+}
+
+func toAuditLine(entry *model.LogEntry) *AuditLine {
+	return &AuditLine{
+		ID:        entry.ID,
+		MessageID: entry.MessageID,
+		Action:    entry.Action,
+		Actor:     entry.Actor,
+		Details:   entry.Details,
+		TraceID:   entry.TraceID,
+		CreatedAt: entry.CreatedAt.UTC(),
+		Hash:      entry.Hash,
+	}
+}
+
+func utc(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	res := t.UTC()
+
+	return &res
+}
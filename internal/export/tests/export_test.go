@@ -0,0 +1,190 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Mobo140/chat/internal/export"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func records() []*model.ExportRecord {
+	createdAt := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	editedAt := createdAt.Add(time.Minute)
+	deletedAt := createdAt.Add(time.Hour)
+
+	return []*model.ExportRecord{
+		{Chat: &model.Chat{
+			ID:    7,
+			Info:  model.ChatInfo{Usernames: []string{"alice", "bob"}, Admins: []string{"alice"}},
+			Topic: "release",
+		}},
+		{Message: &model.ExportMessage{
+			Message: model.Message{
+				ID:        1,
+				From:      "alice",
+				Text:      "<b>ship</b> it",
+				CreatedAt: createdAt,
+				EditedAt:  &editedAt,
+				Reactions: []model.ReactionCount{{Emoji: "👍", Count: 2}},
+			},
+			Edits: []*model.MessageEdit{
+				{MessageID: 1, Text: "ship", EditedBy: "alice", EditedAt: editedAt},
+			},
+		}},
+		{Message: &model.ExportMessage{
+			Message: model.Message{
+				ID:        2,
+				From:      "bob",
+				Text:      "=1+1",
+				CreatedAt: createdAt.Add(time.Second),
+				DeletedAt: &deletedAt,
+				ReplyToID: 1,
+			},
+		}},
+		{Audit: &model.LogEntry{
+			ID:        30,
+			ChatID:    7,
+			MessageID: 1,
+			Action:    model.LogActionSendMessage,
+			Actor:     "alice",
+			CreatedAt: createdAt,
+			Hash:      "abc",
+		}},
+	}
+}
+
+func encode(t *testing.T, format model.ExportFormat) string {
+	var buf bytes.Buffer
+
+	encoder, err := export.NewEncoder(format, &buf)
+	require.NoError(t, err)
+
+	for _, record := range records() {
+		require.NoError(t, encoder.Encode(record))
+	}
+
+	require.NoError(t, encoder.Close())
+
+	return buf.String()
+}
+
+func TestJSONL(t *testing.T) {
+	t.Parallel()
+
+	lines := strings.Split(strings.TrimSuffix(encode(t, model.ExportFormatJSONL), "\n"), "\n")
+
+	require.Equal(t, []string{
+		`{"type":"chat","chat":{"id":7,"usernames":["alice","bob"],"admins":["alice"],"topic":"release"}}`,
+		`{"type":"message","message":{"id":1,"from":"alice","text":"<b>ship</b> it",` +
+			`"created_at":"2024-03-01T09:30:00Z","edited_at":"2024-03-01T09:31:00Z",` +
+			`"reactions":[{"emoji":"👍","count":2}],` +
+			`"edits":[{"text":"ship","edited_by":"alice","edited_at":"2024-03-01T09:31:00Z"}]}}`,
+		`{"type":"message","message":{"id":2,"from":"bob","text":"=1+1",` +
+			`"created_at":"2024-03-01T09:30:01Z","deleted_at":"2024-03-01T10:30:00Z","reply_to_message_id":1}}`,
+		`{"type":"audit","audit":{"id":30,"message_id":1,"action":"send_message","actor":"alice",` +
+			`"created_at":"2024-03-01T09:30:00Z","hash":"abc"}}`,
+	}, lines)
+}
+
+func TestCSV(t *testing.T) {
+	t.Parallel()
+
+	rows, err := csv.NewReader(strings.NewReader(encode(t, model.ExportFormatCSV))).ReadAll()
+	require.NoError(t, err)
+
+	require.Equal(t, [][]string{
+		{"record", "id", "message_id", "created_at", "author", "text",
+			"reply_to_message_id", "edited_at", "deleted_at", "details"},
+		{"chat", "7", "", "", "", "release", "", "", "", ""},
+		{"member", "7", "", "", "alice", "", "", "", "", `{"admin":true}`},
+		{"member", "7", "", "", "bob", "", "", "", "", ""},
+		{"message", "1", "", "2024-03-01T09:30:00Z", "alice", "<b>ship</b> it",
+			"", "2024-03-01T09:31:00Z", "", `{"reactions":[{"emoji":"👍","count":2}]}`},
+		{"edit", "", "1", "2024-03-01T09:31:00Z", "alice", "ship", "", "", "", ""},
+		{"message", "2", "", "2024-03-01T09:30:01Z", "bob", "'=1+1", "1", "", "2024-03-01T10:30:00Z", ""},
+		{"audit", "30", "1", "2024-03-01T09:30:00Z", "alice", "send_message", "", "", "", ""},
+	}, rows)
+}
+
+func TestHTML(t *testing.T) {
+	t.Parallel()
+
+	page := encode(t, model.ExportFormatHTML)
+
+	require.True(t, strings.HasPrefix(page, "<!DOCTYPE html>"))
+	require.True(t, strings.HasSuffix(page, "</html>\n"))
+	require.Contains(t, page, `<li class="member-admin">alice (admin)</li>`)
+	require.Contains(t, page, "&lt;b&gt;ship&lt;/b&gt; it")
+	require.NotContains(t, page, "<b>ship</b>")
+	require.Contains(t, page, "edited 2024-03-01 09:31:00 UTC")
+	require.Contains(t, page, `<a href="#m1">in reply to #1</a>`)
+	require.Contains(t, page, `<p class="deleted">deleted 2024-03-01 10:30:00 UTC</p>`)
+	require.NotContains(t, page, "=1+1")
+	require.Contains(t, page, "1 previous versions")
+	require.Contains(t, page, "<h2>Audit log</h2>")
+	require.Contains(t, page, "</table>")
+	require.NotContains(t, page, "<script")
+}
+
+func TestNewEncoderUnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	_, err := export.NewEncoder("xml", &bytes.Buffer{})
+	require.Error(t, err)
+}
+
+func TestDecoder(t *testing.T) {
+	t.Parallel()
+
+	file := encode(t, model.ExportFormatJSONL)
+	lines := strings.SplitAfter(file, "\n")
+
+	// An invalid line and a blank one between the chat and the messages.
+	file = lines[0] + "{not json}\n\n" + `{"type":"reaction"}` + "\n" + strings.Join(lines[1:], "")
+
+	decoder := export.NewDecoder(strings.NewReader(file))
+
+	var got []*model.ImportLine
+
+	for {
+		line, err := decoder.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		require.NoError(t, err)
+		got = append(got, line)
+	}
+
+	require.Len(t, got, 6)
+	require.Equal(t, []int64{1, 2, 4, 5, 6, 7}, []int64{
+		got[0].Number, got[1].Number, got[2].Number, got[3].Number, got[4].Number, got[5].Number,
+	})
+
+	require.Error(t, got[1].Err)
+	require.EqualError(t, got[2].Err, `unknown record "reaction"`)
+
+	want := records()
+	want[1].Message.Edits[0].MessageID = 1
+
+	require.Equal(t, want[0], got[0].Record)
+	require.Equal(t, want[1], got[3].Record)
+	require.Equal(t, want[2], got[4].Record)
+	require.Equal(t, want[3].Audit.ID, got[5].Record.Audit.ID)
+}
+
+func TestDecoderLongLine(t *testing.T) {
+	t.Parallel()
+
+	decoder := export.NewDecoder(strings.NewReader(strings.Repeat("x", export.MaxLineSize+1)))
+
+	_, err := decoder.Next()
+	require.ErrorIs(t, err, model.ErrInvalidImport)
+}
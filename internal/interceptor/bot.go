@@ -0,0 +1,63 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Mobo140/chat/internal/model"
+	rateLimiter "github.com/Mobo140/chat/internal/ratelimiter"
+	"github.com/Mobo140/chat/internal/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// APIKeyHeader carries the API key of a bot.
+const APIKeyHeader = "x-api-key"
+
+// botAuthInterceptor authenticates the requests that carry an API key and
+// marks them as made by the bot. Requests without a key pass untouched to the
+// access check of the handlers.
+type botAuthInterceptor struct {
+	botService service.BotService
+	limiter    *rateLimiter.KeyedLimiter
+}
+
+func NewBotAuthInterceptor(
+	botService service.BotService,
+	limiter *rateLimiter.KeyedLimiter,
+) *botAuthInterceptor { //nolint:revive // it's ok
+	return &botAuthInterceptor{
+		botService: botService,
+		limiter:    limiter,
+	}
+}
+
+func (b *botAuthInterceptor) Unary(ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	keys := md.Get(APIKeyHeader)
+	if len(keys) == 0 {
+		return handler(ctx, req)
+	}
+
+	bot, err := b.botService.Authenticate(ctx, keys[0])
+	if errors.Is(err, model.ErrInvalidAPIKey) {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !b.limiter.Allow(bot.ID, bot.RateLimit) {
+		return nil, status.Error(codes.ResourceExhausted, "bot rate limit exceeded")
+	}
+
+	return handler(model.ContextWithBot(ctx, bot), req)
+}
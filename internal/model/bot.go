@@ -0,0 +1,75 @@
+package model
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// botSuffix marks the messages of bots, so they are never mistaken for users.
+const botSuffix = "[bot]"
+
+// Bot is an account that authenticates with an API key instead of the user
+// tokens of the auth service.
+type Bot struct {
+	ID   int64
+	Name string
+	// ChatIDs are the chats the bot works in, every chat if empty.
+	ChatIDs []int64
+	// RateLimit is the number of requests a minute.
+	RateLimit int
+	CreatedAt time.Time
+}
+
+// Username is the name the messages of the bot are sent from.
+func (b *Bot) Username() string {
+	return b.Name + botSuffix
+}
+
+// IsBotUsername reports whether the name is the one of a bot.
+func IsBotUsername(username string) bool {
+	return strings.HasSuffix(username, botSuffix)
+}
+
+// InScope reports whether the bot may work in the chat.
+func (b *Bot) InScope(chatID int64) bool {
+	if len(b.ChatIDs) == 0 {
+		return true
+	}
+
+	for _, id := range b.ChatIDs {
+		if id == chatID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// botEndpoints are the methods open to bots, the rest need a user token.
+var botEndpoints = map[string]struct{}{
+	"/chat_v1.ChatV1/SendMessage":  {},
+	"/chat_v1.ChatV1/ListMessages": {},
+	"/chat_v1.ChatV1/ListThread":   {},
+}
+
+// BotEndpoint reports whether bots may call the method.
+func BotEndpoint(endpoint string) bool {
+	_, ok := botEndpoints[endpoint]
+
+	return ok
+}
+
+type botKey struct{}
+
+// ContextWithBot marks the request as made by the bot.
+func ContextWithBot(ctx context.Context, bot *Bot) context.Context {
+	return context.WithValue(ctx, botKey{}, bot)
+}
+
+// BotFromContext returns the bot that made the request, if a bot did.
+func BotFromContext(ctx context.Context) (*Bot, bool) {
+	bot, ok := ctx.Value(botKey{}).(*Bot)
+
+	return bot, ok
+}
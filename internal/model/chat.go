@@ -1,12 +1,45 @@
 package model
 
+import "time"
+
 type Chat struct {
-	ID   int64
-	Info ChatInfo
+	ID        int64
+	Info      ChatInfo
+	Topic     string
+	Retention ChatRetention
+	// ArchivedAt is set while the chat is archived, read only.
+	ArchivedAt *time.Time
 }
 
 type ChatInfo struct {
 	Usernames []string
+	Admins    []string
+}
+
+// IsAdmin reports whether the user moderates the chat.
+func (c *Chat) IsAdmin(username string) bool {
+	for _, admin := range c.Info.Admins {
+		if admin == username {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Role returns what the user may do in the chat.
+func (c *Chat) Role(username string) ChatRole {
+	if c.IsAdmin(username) {
+		return ChatRoleAdmin
+	}
+
+	for _, member := range c.Info.Usernames {
+		if member == username {
+			return ChatRoleMember
+		}
+	}
+
+	return ChatRoleNone
 }
 
 type UpdateInfo struct {
@@ -0,0 +1,59 @@
+package model
+
+import "strings"
+
+const commandPrefix = "/"
+
+// ChatRole is what a user may do in a chat, the roles are ordered.
+type ChatRole int
+
+const (
+	ChatRoleNone ChatRole = iota
+	ChatRoleMember
+	ChatRoleAdmin
+)
+
+// CommandSpec describes a slash command.
+type CommandSpec struct {
+	// Name is the command without the slash.
+	Name        string
+	Usage       string
+	Description string
+	// Role is the least role that may run the command.
+	Role    ChatRole
+	MinArgs int
+	// MaxArgs is the most arguments taken, no limit if negative.
+	MaxArgs int
+	// External commands call an outside service, they run out of the
+	// transaction of the command.
+	External bool
+}
+
+// CommandCall is a slash command sent to a chat.
+type CommandCall struct {
+	ChatID int64
+	Caller string
+	// Text is the message as sent, Name and Args are parsed from it.
+	Text string
+	Name string
+	Args []string
+	// Chat is the chat the command was sent to.
+	Chat *Chat
+}
+
+// IsCommand reports whether the message text is a slash command. A double
+// slash escapes a plain message that starts with a slash.
+func IsCommand(text string) bool {
+	return strings.HasPrefix(text, commandPrefix) &&
+		!strings.HasPrefix(text, commandPrefix+commandPrefix) &&
+		len(strings.TrimSpace(text)) > len(commandPrefix)
+}
+
+// UnescapeCommand drops the escaping slash of a plain message.
+func UnescapeCommand(text string) string {
+	if strings.HasPrefix(text, commandPrefix+commandPrefix) {
+		return text[len(commandPrefix):]
+	}
+
+	return text
+}
@@ -0,0 +1,33 @@
+package model
+
+// DeliveryAck moves the delivered cursor of a chat member: the member got
+// every message of the chat up to MessageID.
+type DeliveryAck struct {
+	ChatID    int64
+	Username  string
+	MessageID int64
+}
+
+// DeliveryCursor is the last message a chat member acknowledged and the last
+// one the member read, zero if none.
+type DeliveryCursor struct {
+	Username      string
+	MessageID     int64
+	ReadMessageID int64
+}
+
+// UndeliveredPage is a page of the messages after the delivered cursor of a
+// chat member and after AfterID.
+type UndeliveredPage struct {
+	ChatID   int64
+	Username string
+	AfterID  int64
+	Limit    uint64
+}
+
+// MemberDelivery tells whether a chat member got a message.
+type MemberDelivery struct {
+	Username  string
+	Delivered bool
+	Read      bool
+}
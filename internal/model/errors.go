@@ -0,0 +1,23 @@
+package model
+
+import "errors"
+
+var (
+	ErrMessageNotFound   = errors.New("message not found")
+	ErrPermissionDenied  = errors.New("permission denied")
+	ErrInvalidReply      = errors.New("replied message not found in chat")
+	ErrWebhookNotFound   = errors.New("webhook not found")
+	ErrInvalidWebhook    = errors.New("invalid webhook")
+	ErrBotNotFound       = errors.New("bot not found")
+	ErrBotExists         = errors.New("bot already exists")
+	ErrInvalidAPIKey     = errors.New("invalid api key")
+	ErrUnknownCommand    = errors.New("unknown command")
+	ErrInvalidCommand    = errors.New("invalid command")
+	ErrMuted             = errors.New("muted in chat")
+	ErrScheduledNotFound = errors.New("scheduled message not found")
+	ErrInvalidSchedule   = errors.New("invalid schedule time")
+	ErrChatNotFound      = errors.New("chat not found")
+	ErrLogNotFound       = errors.New("log entry not found")
+	ErrInvalidImport     = errors.New("invalid import")
+	ErrChatArchived      = errors.New("chat is archived")
+)
@@ -0,0 +1,59 @@
+package model
+
+import "time"
+
+// ChatEventType is the kind of a chat event.
+type ChatEventType string
+
+const (
+	ChatEventMessageSent     ChatEventType = "message_sent"
+	ChatEventMessageEdited   ChatEventType = "message_edited"
+	ChatEventMessageDeleted  ChatEventType = "message_deleted"
+	ChatEventReactionAdded   ChatEventType = "reaction_added"
+	ChatEventReactionRemoved ChatEventType = "reaction_removed"
+	ChatEventChatCreated     ChatEventType = "chat_created"
+	ChatEventChatDeleted     ChatEventType = "chat_deleted"
+	ChatEventChatArchived    ChatEventType = "chat_archived"
+	ChatEventMemberAdded     ChatEventType = "member_added"
+	ChatEventMessagesRead    ChatEventType = "messages_read"
+)
+
+var chatEventTypes = map[ChatEventType]struct{}{
+	ChatEventMessageSent:     {},
+	ChatEventMessageEdited:   {},
+	ChatEventMessageDeleted:  {},
+	ChatEventReactionAdded:   {},
+	ChatEventReactionRemoved: {},
+	ChatEventChatCreated:     {},
+	ChatEventChatDeleted:     {},
+	ChatEventChatArchived:    {},
+	ChatEventMemberAdded:     {},
+	ChatEventMessagesRead:    {},
+}
+
+// Valid reports whether the type is a known event type.
+func (t ChatEventType) Valid() bool {
+	_, ok := chatEventTypes[t]
+
+	return ok
+}
+
+// ChatEvent is a change of a chat for its subscribers. It is written to the
+// outbox with the change and published after the commit, at least once.
+type ChatEvent struct {
+	ID     int64
+	ChatID int64
+	Type   ChatEventType
+	// Username is the user who caused the event.
+	Username string
+	// Message is the message after the change for the message events.
+	Message *Message
+	// Reaction is set for the reaction events.
+	Reaction *Reaction
+	// Chat is the membership of a created chat, the added users for
+	// member_added.
+	Chat *ChatInfo
+	// ReadMessageID is the last message Username read for messages_read.
+	ReadMessageID int64
+	CreatedAt     time.Time
+}
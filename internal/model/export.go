@@ -0,0 +1,37 @@
+package model
+
+// ExportFormat is the file format of a chat export.
+type ExportFormat string
+
+const (
+	// ExportFormatJSONL is a JSON object per line, the format ImportChat reads.
+	ExportFormatJSONL ExportFormat = "jsonl"
+	ExportFormatCSV   ExportFormat = "csv"
+	// ExportFormatHTML is a self-contained transcript to read in a browser.
+	ExportFormatHTML ExportFormat = "html"
+)
+
+// Valid reports whether the format is supported.
+func (f ExportFormat) Valid() bool {
+	switch f {
+	case ExportFormatJSONL, ExportFormatCSV, ExportFormatHTML:
+		return true
+	default:
+		return false
+	}
+}
+
+// ExportRecord is one record of a chat export, exactly one field is set. The
+// chat comes first, then its messages from the oldest and its audit log
+// from the newest.
+type ExportRecord struct {
+	Chat    *Chat
+	Message *ExportMessage
+	Audit   *LogEntry
+}
+
+// ExportMessage is a message together with its previous versions.
+type ExportMessage struct {
+	Message
+	Edits []*MessageEdit
+}
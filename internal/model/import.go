@@ -0,0 +1,46 @@
+package model
+
+// MaxImportErrors is how many of the line errors an import report lists.
+const MaxImportErrors = 100
+
+// ImportLine is a line of an import: the record on it or why it is invalid.
+type ImportLine struct {
+	Number int64
+	Record *ExportRecord
+	Err    error
+}
+
+// ImportError is why a line was not imported, or imported only in part.
+type ImportError struct {
+	Line    int64
+	Message string
+}
+
+// ImportReport sums up an import. The invalid lines are skipped, the first
+// MaxImportErrors of them are listed.
+type ImportReport struct {
+	ChatID      int64
+	Messages    int64
+	Edits       int64
+	FailedLines int64
+	Errors      []ImportError
+	// SkippedAudit counts the audit log entries of the source: the log here
+	// is a hash chain of its own, they are not imported.
+	SkippedAudit int64
+	// SkippedReactions counts the reactions of the source: the export has
+	// their counts only, not who reacted.
+	SkippedReactions int64
+}
+
+// Fail records the error of a line that was skipped.
+func (r *ImportReport) Fail(line int64, message string) {
+	r.FailedLines++
+	r.Warn(line, message)
+}
+
+// Warn records the error of a line imported in part.
+func (r *ImportReport) Warn(line int64, message string) {
+	if len(r.Errors) < MaxImportErrors {
+		r.Errors = append(r.Errors, ImportError{Line: line, Message: message})
+	}
+}
@@ -1,9 +1,241 @@
 package model
 
-import "time"
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
 
+// LogAction is what an audit log entry records.
+type LogAction string
+
+const (
+	LogActionUnknown         LogAction = "unknown"
+	LogActionCreateChat      LogAction = "create_chat"
+	LogActionGetChat         LogAction = "get_chat"
+	LogActionDeleteChat      LogAction = "delete_chat"
+	LogActionSendMessage     LogAction = "send_message"
+	LogActionEditMessage     LogAction = "edit_message"
+	LogActionDeleteMessage   LogAction = "delete_message"
+	LogActionListMessages    LogAction = "list_messages"
+	LogActionListThread      LogAction = "list_thread"
+	LogActionSearchMessages  LogAction = "search_messages"
+	LogActionAddReaction     LogAction = "add_reaction"
+	LogActionRemoveReaction  LogAction = "remove_reaction"
+	LogActionListAuditLog    LogAction = "list_audit_log"
+	LogActionRunCommand      LogAction = "run_command"
+	LogActionScheduleMessage LogAction = "schedule_message"
+	LogActionListScheduled   LogAction = "list_scheduled"
+	LogActionCancelScheduled LogAction = "cancel_scheduled"
+	LogActionSetRetention    LogAction = "set_retention"
+	LogActionPurgeMessages   LogAction = "purge_messages"
+	LogActionPurgeLogs       LogAction = "purge_logs"
+	LogActionExportChat      LogAction = "export_chat"
+	LogActionImportChat      LogAction = "import_chat"
+	LogActionArchiveChat     LogAction = "archive_chat"
+	LogActionUnarchiveChat   LogAction = "unarchive_chat"
+	LogActionRestoreChat     LogAction = "restore_chat"
+	LogActionPurgeChat       LogAction = "purge_chat"
+)
+
+var logActions = map[LogAction]struct{}{
+	LogActionUnknown:         {},
+	LogActionCreateChat:      {},
+	LogActionGetChat:         {},
+	LogActionDeleteChat:      {},
+	LogActionSendMessage:     {},
+	LogActionEditMessage:     {},
+	LogActionDeleteMessage:   {},
+	LogActionListMessages:    {},
+	LogActionListThread:      {},
+	LogActionSearchMessages:  {},
+	LogActionAddReaction:     {},
+	LogActionRemoveReaction:  {},
+	LogActionListAuditLog:    {},
+	LogActionRunCommand:      {},
+	LogActionScheduleMessage: {},
+	LogActionListScheduled:   {},
+	LogActionCancelScheduled: {},
+	LogActionSetRetention:    {},
+	LogActionPurgeMessages:   {},
+	LogActionPurgeLogs:       {},
+	LogActionExportChat:      {},
+	LogActionImportChat:      {},
+	LogActionArchiveChat:     {},
+	LogActionUnarchiveChat:   {},
+	LogActionRestoreChat:     {},
+	LogActionPurgeChat:       {},
+}
+
+// Valid reports whether the action is one of the log_action enum values.
+func (a LogAction) Valid() bool {
+	_, ok := logActions[a]
+
+	return ok
+}
+
+var readActions = map[LogAction]struct{}{
+	LogActionGetChat:        {},
+	LogActionListMessages:   {},
+	LogActionListThread:     {},
+	LogActionSearchMessages: {},
+	LogActionListAuditLog:   {},
+	LogActionListScheduled:  {},
+	LogActionExportChat:     {},
+}
+
+// IsRead reports whether the action only reads data.
+func (a LogAction) IsRead() bool {
+	_, ok := readActions[a]
+
+	return ok
+}
+
+// AuditPolicy is the set of actions written to the audit log.
+type AuditPolicy map[LogAction]struct{}
+
+// NewAuditPolicy audits the given actions, or every action if none are given.
+func NewAuditPolicy(actions ...LogAction) AuditPolicy {
+	policy := make(AuditPolicy, len(logActions))
+	if len(actions) == 0 {
+		for action := range logActions {
+			policy[action] = struct{}{}
+		}
+
+		return policy
+	}
+
+	for _, action := range actions {
+		policy[action] = struct{}{}
+	}
+
+	return policy
+}
+
+// Audited reports whether entries of the action are written.
+func (p AuditPolicy) Audited(action LogAction) bool {
+	_, ok := p[action]
+
+	return ok
+}
+
+// LogDetails are action specific attributes of the entry, stored as JSON.
+type LogDetails map[string]any
+
+// LogEntry is a structured audit record: who did what to which chat or message.
+// Entries form a keyed hash chain: Hash covers the content, ChainSeq and
+// PrevHash, the Hash of the previous entry, so altering or removing an entry
+// breaks the chain.
 type LogEntry struct {
+	ID        int64
 	ChatID    int64
-	Activity  string
+	MessageID int64
+	Action    LogAction
+	Actor     string
+	Details   LogDetails
+	TraceID   string
 	CreatedAt time.Time
+	// ChainSeq is the position of the entry in the hash chain, zero until the
+	// chainer links it.
+	ChainSeq int64
+	PrevHash string
+	Hash     string
+}
+
+// chainRecord is the hashed content of an entry, the field order is fixed.
+type chainRecord struct {
+	ChainSeq  int64           `json:"chain_seq"`
+	PrevHash  string          `json:"prev_hash"`
+	ChatID    int64           `json:"chat_id"`
+	MessageID int64           `json:"message_id"`
+	Action    LogAction       `json:"action"`
+	Actor     string          `json:"actor"`
+	Details   json.RawMessage `json:"details"`
+	TraceID   string          `json:"trace_id"`
+	CreatedAt string          `json:"created_at"`
+}
+
+// ComputeHash returns the hex HMAC-SHA256 under key of the entry content,
+// ChainSeq and PrevHash. CreatedAt is hashed with the microsecond precision
+// of the database.
+func (e *LogEntry) ComputeHash(key []byte) (string, error) {
+	details, err := canonicalDetails(e.Details)
+	if err != nil {
+		return "", err
+	}
+
+	raw, err := json.Marshal(chainRecord{
+		ChainSeq:  e.ChainSeq,
+		PrevHash:  e.PrevHash,
+		ChatID:    e.ChatID,
+		MessageID: e.MessageID,
+		Action:    e.Action,
+		Actor:     e.Actor,
+		Details:   details,
+		TraceID:   e.TraceID,
+		CreatedAt: e.CreatedAt.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(raw)
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// canonicalDetails encodes the details the same way before they are stored
+// and after they are read back from JSONB: sorted keys, numbers as written.
+func canonicalDetails(details LogDetails) (json.RawMessage, error) {
+	if len(details) == 0 {
+		return json.RawMessage("{}"), nil
+	}
+
+	raw, err := json.Marshal(details)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var decoded map[string]any
+
+	err = decoder.Decode(&decoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(decoded)
+}
+
+// ChainReport is the result of the audit log verification.
+type ChainReport struct {
+	// Checked is the number of chained entries verified.
+	Checked int64
+	// Unchained is the number of entries written before the keyed chain was introduced.
+	Unchained int64
+	// PurgedUpTo is the chain position of the last entry removed by the
+	// retention, zero if none were.
+	PurgedUpTo int64
+	// BrokenID is the first entry that does not match the chain, zero if it is intact.
+	BrokenID int64
+	Reason   string
+}
+
+// LogFilter selects audit log entries older than BeforeID, the latest if it is zero.
+// Zero values of the other fields do not filter.
+type LogFilter struct {
+	ChatID    int64
+	MessageID int64
+	Actor     string
+	Action    LogAction
+	Since     *time.Time
+	Until     *time.Time
+	BeforeID  int64
+	Limit     uint64
 }
@@ -3,9 +3,25 @@ package model
 import "time"
 
 type Message struct {
+	ID        int64
 	From      string
 	Text      string
 	CreatedAt time.Time
+	EditedAt  *time.Time
+	DeletedAt *time.Time
+	Reactions []ReactionCount
+	// ReplyToID is the message this one replies to, zero if none.
+	ReplyToID  int64
+	ReplyCount int64
+	Parent     *MessagePreview
+}
+
+// MessagePreview is a short view of a replied message.
+type MessagePreview struct {
+	ID      int64
+	From    string
+	Text    string
+	Deleted bool
 }
 
 type MessageInfo struct {
@@ -18,3 +34,71 @@ type SendMessage struct {
 	ChatID  int64
 	Message Message
 }
+
+// ChatMessage is a stored message together with the chat it belongs to.
+type ChatMessage struct {
+	ChatID  int64
+	Message Message
+}
+
+// MessagesPage selects chat messages older than BeforeID, the latest if it is zero.
+type MessagesPage struct {
+	ChatID   int64
+	BeforeID int64
+	Limit    uint64
+}
+
+// ThreadPage selects replies to MessageID newer than AfterID, the first ones if it is zero.
+type ThreadPage struct {
+	ChatID    int64
+	MessageID int64
+	AfterID   int64
+	Limit     uint64
+}
+
+// Thread is a message together with a page of its replies.
+type Thread struct {
+	Parent  *Message
+	Replies []*Message
+}
+
+// SearchQuery selects the messages of the user's chats matching Query.
+// Zero ChatID searches every chat of the user, the rest are optional filters.
+type SearchQuery struct {
+	Username string
+	Query    string
+	ChatID   int64
+	From     string
+	Since    *time.Time
+	Until    *time.Time
+	BeforeID int64
+	Limit    uint64
+}
+
+// SearchResult is a matched message with its highlighted text.
+type SearchResult struct {
+	ChatID  int64
+	Message *Message
+	Snippet string
+}
+
+type EditMessage struct {
+	ChatID    int64
+	MessageID int64
+	Username  string
+	Text      string
+}
+
+type DeleteMessage struct {
+	ChatID    int64
+	MessageID int64
+	Username  string
+}
+
+// MessageEdit is a previous version of an edited or deleted message.
+type MessageEdit struct {
+	MessageID int64
+	Text      string
+	EditedBy  string
+	EditedAt  time.Time
+}
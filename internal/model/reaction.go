@@ -0,0 +1,13 @@
+package model
+
+type Reaction struct {
+	ChatID    int64
+	MessageID int64
+	Username  string
+	Emoji     string
+}
+
+type ReactionCount struct {
+	Emoji string
+	Count int64
+}
@@ -0,0 +1,70 @@
+package model
+
+import "time"
+
+const (
+	// RetentionActor is the actor of the purge entries in the audit log.
+	RetentionActor = "retention"
+
+	// PurgeUpToDetail and PurgeAnchorDetail are the details of a purge_logs
+	// entry: the chain position of the last purged entry and its hash, the
+	// PrevHash of the entry the chain starts with after the purge.
+	PurgeUpToDetail   = "up_to_seq"
+	PurgeAnchorDetail = "anchor_hash"
+)
+
+// Retention limits how old and how many messages a chat keeps. A zero limit
+// keeps everything.
+type Retention struct {
+	MaxAge   time.Duration
+	MaxCount int64
+}
+
+// Enabled reports whether any message may be purged.
+func (r Retention) Enabled() bool {
+	return r.MaxAge > 0 || r.MaxCount > 0
+}
+
+// ChatRetention overrides the global retention for a chat, a nil limit
+// inherits the global one.
+type ChatRetention struct {
+	ChatID   int64
+	MaxAge   *time.Duration
+	MaxCount *int64
+}
+
+// Apply returns the retention of the chat under the global one.
+func (c *ChatRetention) Apply(global Retention) Retention {
+	res := global
+	if c.MaxAge != nil {
+		res.MaxAge = *c.MaxAge
+	}
+
+	if c.MaxCount != nil {
+		res.MaxCount = *c.MaxCount
+	}
+
+	return res
+}
+
+// MessagePurge selects up to Limit of the oldest messages of a chat beyond
+// its retention.
+type MessagePurge struct {
+	ChatID    int64
+	Retention Retention
+	Limit     uint64
+}
+
+// PurgeReport sums up a purge run. In a dry run nothing is deleted, the
+// counts are of what would have been.
+type PurgeReport struct {
+	DryRun bool
+	// DeletedChats are the chats deleted for good after the grace period.
+	DeletedChats int64
+	// Chats are the chats with messages beyond the retention.
+	Chats    int
+	Messages int64
+	Logs     int64
+	// OutboxEvents are the published outbox events.
+	OutboxEvents int64
+}
@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// MaxScheduleAhead is how far in the future a message may be scheduled.
+const MaxScheduleAhead = 366 * 24 * time.Hour
+
+// ScheduledMessage is a message sent to the chat at ScheduledAt.
+type ScheduledMessage struct {
+	ID          int64
+	ChatID      int64
+	Message     Message
+	ScheduledAt time.Time
+	CreatedAt   time.Time
+	// Error is why the message could not be sent, empty while it is pending.
+	Error string
+}
+
+// ScheduledFilter selects the scheduled messages of a user in a chat.
+type ScheduledFilter struct {
+	ChatID   int64
+	Username string
+}
+
+// CancelScheduled cancels a scheduled message of the user, or of anyone for
+// the chat admins.
+type CancelScheduled struct {
+	ChatID   int64
+	ID       int64
+	Username string
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// Session is a stream of a chat member on a device, connected to any server.
+type Session struct {
+	ID           string
+	ChatID       int64
+	Username     string
+	DeviceID     string
+	ConnectedAt  time.Time
+	LastActiveAt time.Time
+}
+
+// SessionFilter selects the live sessions of a user, of the chat only if
+// ChatID is set. The sessions inactive since ActiveSince are stale.
+type SessionFilter struct {
+	Username    string
+	ChatID      int64
+	ActiveSince time.Time
+}
@@ -0,0 +1,28 @@
+package model
+
+// OverflowPolicy is what the full queue of a slow stream does with a new event.
+type OverflowPolicy string
+
+const (
+	// OverflowDropOldest drops the oldest queued event.
+	OverflowDropOldest OverflowPolicy = "drop_oldest"
+	// OverflowDisconnect ends the stream with the last message it got, the
+	// client resumes from there.
+	OverflowDisconnect OverflowPolicy = "disconnect"
+	// OverflowBlock makes the publisher wait, up to its timeout. The outbox
+	// events disconnect the stream instead.
+	OverflowBlock OverflowPolicy = "block"
+)
+
+var overflowPolicies = map[OverflowPolicy]struct{}{
+	OverflowDropOldest: {},
+	OverflowDisconnect: {},
+	OverflowBlock:      {},
+}
+
+// Valid reports whether the policy is a known one.
+func (p OverflowPolicy) Valid() bool {
+	_, ok := overflowPolicies[p]
+
+	return ok
+}
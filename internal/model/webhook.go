@@ -0,0 +1,63 @@
+package model
+
+import "time"
+
+// Webhook subscribes an URL to the events of a chat, or of every chat if
+// ChatID is zero. Deliveries are signed with the Secret.
+type Webhook struct {
+	ID     int64
+	ChatID int64
+	URL    string
+	Secret string
+	// EventTypes are the delivered events, all of them if empty.
+	EventTypes []ChatEventType
+	CreatedAt  time.Time
+}
+
+// Subscribed reports whether the webhook takes events of the type.
+func (w *Webhook) Subscribed(eventType ChatEventType) bool {
+	if len(w.EventTypes) == 0 {
+		return true
+	}
+
+	for _, t := range w.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WebhookDelivery is a pending delivery of an event to a webhook.
+type WebhookDelivery struct {
+	ID        int64
+	WebhookID int64
+	EventID   int64
+	EventType ChatEventType
+	Payload   []byte
+	Attempts  int
+	// URL and Secret are the ones of the webhook.
+	URL    string
+	Secret string
+}
+
+// WebhookAttempt is a delivery log record.
+type WebhookAttempt struct {
+	ID         int64
+	WebhookID  int64
+	EventID    int64
+	EventType  ChatEventType
+	Attempt    int
+	StatusCode int
+	Error      string
+	Duration   time.Duration
+	DeadLetter bool
+	CreatedAt  time.Time
+}
+
+type WebhookAttemptFilter struct {
+	WebhookID int64
+	BeforeID  int64
+	Limit     uint64
+}
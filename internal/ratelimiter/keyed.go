@@ -0,0 +1,60 @@
+package ratelimiter
+
+import (
+	"sync"
+	"time"
+)
+
+// KeyedLimiter keeps a token bucket per key, each with its own limit. The
+// buckets are refilled lazily on Allow, so idle keys cost no goroutines.
+type KeyedLimiter struct {
+	period time.Duration
+
+	buckets map[int64]*bucket
+	mx      sync.Mutex
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+func NewKeyedLimiter(period time.Duration) *KeyedLimiter {
+	return &KeyedLimiter{
+		period:  period,
+		buckets: make(map[int64]*bucket),
+	}
+}
+
+// Allow takes a token from the bucket of the key, which holds up to limit
+// tokens and gets limit tokens a period.
+func (l *KeyedLimiter) Allow(key int64, limit int) bool {
+	if limit <= 0 {
+		return false
+	}
+
+	now := time.Now()
+
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit), updated: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens += now.Sub(b.updated).Seconds() / l.period.Seconds() * float64(limit)
+	if b.tokens > float64(limit) {
+		b.tokens = float64(limit)
+	}
+	b.updated = now
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+
+	return true
+}
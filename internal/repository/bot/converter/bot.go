@@ -0,0 +1,25 @@
+package converter
+
+import (
+	"github.com/Mobo140/chat/internal/model"
+	modelRepo "github.com/Mobo140/chat/internal/repository/bot/model"
+)
+
+func ToBotFromRepo(bot *modelRepo.Bot) *model.Bot {
+	return &model.Bot{
+		ID:        bot.ID,
+		Name:      bot.Name,
+		ChatIDs:   bot.ChatIDs,
+		RateLimit: bot.RateLimit,
+		CreatedAt: bot.CreatedAt,
+	}
+}
+
+// ToChatIDsFromService stores no scope as an empty array, the column is NOT NULL.
+func ToChatIDsFromService(chatIDs []int64) []int64 {
+	if chatIDs == nil {
+		return []int64{}
+	}
+
+	return chatIDs
+}
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+type Bot struct {
+	ID        int64     `db:"id"`
+	Name      string    `db:"name"`
+	ChatIDs   []int64   `db:"chat_ids"`
+	RateLimit int       `db:"rate_limit"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,182 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/repository/bot/converter"
+	modelRepo "github.com/Mobo140/chat/internal/repository/bot/model"
+	"github.com/Mobo140/platform_common/pkg/db"
+	"github.com/jackc/pgx/v4"
+)
+
+var _ repository.BotRepository = (*botRepo)(nil)
+
+const (
+	tableName     = "bots"
+	keysTableName = "bot_keys"
+
+	idColumn        = "id"
+	nameColumn      = "name"
+	chatIDsColumn   = "chat_ids"
+	rateLimitColumn = "rate_limit"
+	createdAtColumn = "created_at"
+
+	botIDColumn     = "bot_id"
+	keyHashColumn   = "key_hash"
+	revokedAtColumn = "revoked_at"
+)
+
+var columns = []string{idColumn, nameColumn, chatIDsColumn, rateLimitColumn, createdAtColumn}
+
+type botRepo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) *botRepo { //nolint:revive // it's ok
+	return &botRepo{db: db}
+}
+
+// Create returns ErrBotExists if the name is taken.
+func (r *botRepo) Create(ctx context.Context, bot *model.Bot) (int64, error) {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(nameColumn, chatIDsColumn, rateLimitColumn).
+		Values(bot.Name, converter.ToChatIDsFromService(bot.ChatIDs), bot.RateLimit).
+		Suffix("ON CONFLICT (" + nameColumn + ") DO NOTHING RETURNING " + idColumn)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "bot_repository.create",
+	}
+
+	var id int64
+
+	err = r.db.DB().ScanOneContext(ctx, &id, q, args...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, model.ErrBotExists
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert bot: %v", err)
+	}
+
+	return id, nil
+}
+
+func (r *botRepo) Get(ctx context.Context, id int64) (*model.Bot, error) {
+	builderSelect := sq.Select(columns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id}).
+		Limit(1)
+
+	return r.get(ctx, "bot_repository.get", builderSelect)
+}
+
+func (r *botRepo) GetByKey(ctx context.Context, keyHash string) (*model.Bot, error) {
+	builderSelect := sq.Select(prefixed("b", columns)...).
+		From(tableName + " b").
+		Join(keysTableName + " k ON k." + botIDColumn + " = b." + idColumn).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"k." + keyHashColumn: keyHash, "k." + revokedAtColumn: nil}).
+		Limit(1)
+
+	bot, err := r.get(ctx, "bot_repository.get_by_key", builderSelect)
+	if errors.Is(err, model.ErrBotNotFound) {
+		return nil, model.ErrInvalidAPIKey
+	}
+
+	return bot, err
+}
+
+func (r *botRepo) CreateKey(ctx context.Context, botID int64, keyHash string) error {
+	builderInsert := sq.Insert(keysTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(botIDColumn, keyHashColumn).
+		Values(botID, keyHash)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "bot_repository.create_key",
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert bot key: %v", err)
+	}
+
+	return nil
+}
+
+func (r *botRepo) RevokeKeys(ctx context.Context, botID int64) error {
+	builderUpdate := sq.Update(keysTableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(revokedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{botIDColumn: botID, revokedAtColumn: nil})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "bot_repository.revoke_keys",
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to revoke bot keys: %v", err)
+	}
+
+	return nil
+}
+
+func (r *botRepo) get(ctx context.Context, name string, builderSelect sq.SelectBuilder) (*model.Bot, error) {
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     name,
+	}
+
+	var bot modelRepo.Bot
+
+	err = r.db.DB().ScanOneContext(ctx, &bot, q, args...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrBotNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to select bot: %v", err)
+	}
+
+	return converter.ToBotFromRepo(&bot), nil
+}
+
+func prefixed(alias string, columns []string) []string {
+	res := make([]string, 0, len(columns))
+	for _, column := range columns {
+		res = append(res, alias+"."+column)
+	}
+
+	return res
+}
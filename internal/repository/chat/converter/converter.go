@@ -1,19 +1,60 @@
 package converter
 
 import (
+	"time"
+
 	model "github.com/Mobo140/chat/internal/model"
 	modelRepo "github.com/Mobo140/chat/internal/repository/chat/model"
 )
 
 func ToChatFromRepo(chat *modelRepo.Chat) *model.Chat {
 	return &model.Chat{
-		ID:   chat.ID,
-		Info: ToChatInfoFromRepo(chat.Info),
+		ID:         chat.ID,
+		Info:       ToChatInfoFromRepo(chat.Info),
+		Topic:      chat.Topic,
+		Retention:  ToChatRetentionFromRepo(chat.ID, &chat.Retention),
+		ArchivedAt: chat.ArchivedAt,
+	}
+}
+
+func ToChatRetentionFromRepo(chatID int64, retention *modelRepo.Retention) model.ChatRetention {
+	res := model.ChatRetention{
+		ChatID:   chatID,
+		MaxCount: retention.MaxCount,
 	}
+
+	if retention.MaxAgeSeconds != nil {
+		maxAge := time.Duration(*retention.MaxAgeSeconds) * time.Second
+		res.MaxAge = &maxAge
+	}
+
+	return res
+}
+
+func ToChatRetentionsFromRepo(retentions []*modelRepo.ChatRetention) []*model.ChatRetention {
+	res := make([]*model.ChatRetention, 0, len(retentions))
+	for _, retention := range retentions {
+		converted := ToChatRetentionFromRepo(retention.ID, &retention.Retention)
+		res = append(res, &converted)
+	}
+
+	return res
+}
+
+// ToRetentionFromService returns the column values of the overrides, nil for NULL.
+func ToRetentionFromService(retention *model.ChatRetention) (*int64, *int64) {
+	var maxAge *int64
+	if retention.MaxAge != nil {
+		seconds := int64(retention.MaxAge.Seconds())
+		maxAge = &seconds
+	}
+
+	return maxAge, retention.MaxCount
 }
 
 func ToChatInfoFromRepo(chatInfo modelRepo.ChatInfo) model.ChatInfo {
 	return model.ChatInfo{
 		Usernames: chatInfo.Usernames,
+		Admins:    chatInfo.Admins,
 	}
 }
@@ -1,12 +1,29 @@
 package model
 
+import "time"
+
 type Chat struct {
-	ID   int64    `db:"id"`
-	Info ChatInfo `db:""`
+	ID         int64      `db:"id"`
+	Info       ChatInfo   `db:""`
+	Topic      string     `db:"topic"`
+	Retention  Retention  `db:""`
+	ArchivedAt *time.Time `db:"archived_at"`
+}
+
+// Retention holds the overrides of a chat, NULL inherits the global setting.
+type Retention struct {
+	MaxAgeSeconds *int64 `db:"retention_max_age_seconds"`
+	MaxCount      *int64 `db:"retention_max_count"`
+}
+
+type ChatRetention struct {
+	ID        int64     `db:"id"`
+	Retention Retention `db:""`
 }
 
 type ChatInfo struct {
 	Usernames []string `db:"usernames"`
+	Admins    []string `db:"admins"`
 }
 
 // type UpdateInfo struct {
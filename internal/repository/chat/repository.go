@@ -2,7 +2,9 @@ package chat
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/Mobo140/chat/internal/model"
@@ -10,14 +12,27 @@ import (
 	"github.com/Mobo140/chat/internal/repository/chat/converter"
 	modelRepo "github.com/Mobo140/chat/internal/repository/chat/model"
 	"github.com/Mobo140/platform_common/pkg/db"
+	"github.com/jackc/pgx/v4"
 )
 
 var _ repository.ChatRepository = (*chatRepo)(nil)
 
 const (
-	tableName       = "chat"
-	usernamesColumn = "usernames"
-	idColumn        = "id"
+	tableName        = "chat"
+	usernamesColumn  = "usernames"
+	adminsColumn     = "admins"
+	topicColumn      = "topic"
+	idColumn         = "id"
+	archivedAtColumn = "archived_at"
+	deletedAtColumn  = "deleted_at"
+
+	retentionMaxAgeColumn   = "retention_max_age_seconds"
+	retentionMaxCountColumn = "retention_max_count"
+
+	mutesTableName   = "chat_mutes"
+	chatIDColumn     = "chat_id"
+	usernameColumn   = "username"
+	mutedUntilColumn = "muted_until"
 )
 
 type chatRepo struct {
@@ -31,8 +46,8 @@ func NewRepository(db db.Client) *chatRepo { //nolint:revive // it's ok
 func (r *chatRepo) Create(ctx context.Context, info *model.ChatInfo) (int64, error) {
 	builderInsert := sq.Insert(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Columns(usernamesColumn).
-		Values(info.Usernames).
+		Columns(usernamesColumn, adminsColumn).
+		Values(info.Usernames, admins(info.Admins)).
 		Suffix("RETURNING id")
 
 	query, args, err := builderInsert.ToSql()
@@ -56,10 +71,18 @@ func (r *chatRepo) Create(ctx context.Context, info *model.ChatInfo) (int64, err
 }
 
 func (r *chatRepo) Get(ctx context.Context, id int64) (*model.Chat, error) {
-	builderSelect := sq.Select(idColumn, usernamesColumn).
+	builderSelect := sq.Select(
+		idColumn,
+		usernamesColumn,
+		adminsColumn,
+		topicColumn,
+		retentionMaxAgeColumn,
+		retentionMaxCountColumn,
+		archivedAtColumn,
+	).
 		From(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Where(sq.Eq{idColumn: id}).
+		Where(sq.Eq{idColumn: id, deletedAtColumn: nil}).
 		Limit(1)
 
 	query, args, err := builderSelect.ToSql()
@@ -75,6 +98,10 @@ func (r *chatRepo) Get(ctx context.Context, id int64) (*model.Chat, error) {
 	}
 
 	err = r.db.DB().ScanOneContext(ctx, &chat, q, args...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrChatNotFound
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("failed to select chat: %v", err)
 	}
@@ -99,25 +126,309 @@ func (r *chatRepo) Get(ctx context.Context, id int64) (*model.Chat, error) {
 
 // }
 
+// Delete hides the chat until Restore or PurgeDeleted.
 func (r *chatRepo) Delete(ctx context.Context, id int64) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(deletedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{idColumn: id, deletedAtColumn: nil})
+
+	return r.update(ctx, "chat_repository.delete", builderUpdate)
+}
+
+// Restore brings back a deleted chat that is not purged yet.
+func (r *chatRepo) Restore(ctx context.Context, id int64) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(deletedAtColumn, nil).
+		Where(sq.Eq{idColumn: id}).
+		Where(sq.NotEq{deletedAtColumn: nil})
+
+	return r.update(ctx, "chat_repository.restore", builderUpdate)
+}
+
+// SetArchived archives or unarchives the chat, archiving keeps the time of
+// the first archive.
+func (r *chatRepo) SetArchived(ctx context.Context, id int64, archived bool) error {
+	var archivedAt interface{}
+	if archived {
+		archivedAt = sq.Expr("COALESCE(" + archivedAtColumn + ", NOW())")
+	}
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(archivedAtColumn, archivedAt).
+		Where(sq.Eq{idColumn: id, deletedAtColumn: nil})
+
+	return r.update(ctx, "chat_repository.set_archived", builderUpdate)
+}
+
+// PurgeDeleted deletes for good the earliest chat deleted more than grace
+// ago, with its messages, and returns its id, 0 if there is none.
+func (r *chatRepo) PurgeDeleted(ctx context.Context, grace time.Duration) (int64, error) {
+	oldest := sq.Select(idColumn).
+		From(tableName).
+		Where(deletedBefore(grace)).
+		OrderBy(deletedAtColumn).
+		Limit(1).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
 	builderDelete := sq.Delete(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Where(sq.Eq{idColumn: id})
+		Where(sq.Expr(idColumn+" IN (?)", oldest)).
+		Suffix("RETURNING " + idColumn)
 
 	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "chat_repository.purge_deleted",
+	}
+
+	var id int64
+
+	err = r.db.DB().ScanOneContext(ctx, &id, q, args...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge chat: %v", err)
+	}
+
+	return id, nil
+}
+
+func (r *chatRepo) SetTopic(ctx context.Context, id int64, topic string) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(topicColumn, topic).
+		Where(sq.Eq{idColumn: id})
+
+	return r.exec(ctx, "chat_repository.set_topic", builderUpdate)
+}
+
+// SetRetention replaces the retention overrides of the chat.
+func (r *chatRepo) SetRetention(ctx context.Context, retention *model.ChatRetention) error {
+	maxAge, maxCount := converter.ToRetentionFromService(retention)
+
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(retentionMaxAgeColumn, maxAge).
+		Set(retentionMaxCountColumn, maxCount).
+		Where(sq.Eq{idColumn: retention.ChatID})
+
+	query, args, err := builderUpdate.ToSql()
 	if err != nil {
 		return fmt.Errorf("failed to build query: %v", err)
 	}
 
 	q := db.Query{
 		QueryRow: query,
-		Name:     "chat_repository.delete",
+		Name:     "chat_repository.set_retention",
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update chat retention: %v", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrChatNotFound
+	}
+
+	return nil
+}
+
+// ListRetention returns the retention overrides of the chats after afterID.
+func (r *chatRepo) ListRetention(ctx context.Context, afterID int64, limit uint64) ([]*model.ChatRetention, error) {
+	builderSelect := sq.Select(idColumn, retentionMaxAgeColumn, retentionMaxCountColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Gt{idColumn: afterID}).
+		Where(sq.Eq{deletedAtColumn: nil}).
+		OrderBy(idColumn).
+		Limit(limit)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "chat_repository.list_retention",
+	}
+
+	var retentions []*modelRepo.ChatRetention
+
+	err = r.db.DB().ScanAllContext(ctx, &retentions, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select chat retention: %v", err)
+	}
+
+	return converter.ToChatRetentionsFromRepo(retentions), nil
+}
+
+func (r *chatRepo) AddUser(ctx context.Context, id int64, username string) (bool, error) {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(usernamesColumn, sq.Expr("array_append("+usernamesColumn+", ?)", username)).
+		Where(sq.And{
+			sq.Eq{idColumn: id},
+			sq.Expr("NOT (? = ANY("+usernamesColumn+"))", username),
+		})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "chat_repository.add_user",
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to add user to chat: %v", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// Mute mutes the user for the duration from now, a mute in force is replaced.
+func (r *chatRepo) Mute(ctx context.Context, id int64, username string, duration time.Duration) error {
+	builderInsert := sq.Insert(mutesTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, usernameColumn, mutedUntilColumn).
+		Values(id, username, sq.Expr("NOW() + ? * INTERVAL '1 millisecond'", duration.Milliseconds())).
+		Suffix("ON CONFLICT (" + chatIDColumn + ", " + usernameColumn + ") DO UPDATE SET " +
+			mutedUntilColumn + " = EXCLUDED." + mutedUntilColumn)
+
+	return r.exec(ctx, "chat_repository.mute", builderInsert)
+}
+
+func (r *chatRepo) Unmute(ctx context.Context, id int64, username string) error {
+	builderDelete := sq.Delete(mutesTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: id, usernameColumn: username})
+
+	return r.exec(ctx, "chat_repository.unmute", builderDelete)
+}
+
+func (r *chatRepo) IsMuted(ctx context.Context, id int64, username string) (bool, error) {
+	builderSelect := sq.Select("1").
+		From(mutesTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: id, usernameColumn: username}).
+		Where(sq.Expr(mutedUntilColumn + " > NOW()")).
+		Prefix("SELECT EXISTS (").
+		Suffix(")")
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "chat_repository.is_muted",
+	}
+
+	var muted bool
+
+	err = r.db.DB().ScanOneContext(ctx, &muted, q, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to select chat mute: %v", err)
+	}
+
+	return muted, nil
+}
+
+func (r *chatRepo) exec(ctx context.Context, name string, builder sq.Sqlizer) error {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     name,
 	}
 
 	_, err = r.db.DB().ExecContext(ctx, q, args...)
 	if err != nil {
-		return fmt.Errorf("failed to delete chat: %v", err)
+		return fmt.Errorf("failed to update chat: %v", err)
 	}
 
 	return nil
 }
+
+// CountDeleted counts the chats PurgeDeleted would delete.
+func (r *chatRepo) CountDeleted(ctx context.Context, grace time.Duration) (int64, error) {
+	builderSelect := sq.Select("COUNT(*)").
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(deletedBefore(grace))
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "chat_repository.count_deleted",
+	}
+
+	var count int64
+
+	err = r.db.DB().ScanOneContext(ctx, &count, q, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count deleted chats: %v", err)
+	}
+
+	return count, nil
+}
+
+// update runs the update of a live chat, ErrChatNotFound if nothing matched.
+func (r *chatRepo) update(ctx context.Context, name string, builder sq.Sqlizer) error {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     name,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update chat: %v", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrChatNotFound
+	}
+
+	return nil
+}
+
+// admins keeps the column NOT NULL when the chat has no admins.
+func admins(usernames []string) []string {
+	if usernames == nil {
+		return []string{}
+	}
+
+	return usernames
+}
+
+// deletedBefore matches the chats deleted more than grace ago.
+func deletedBefore(grace time.Duration) sq.Sqlizer {
+	return sq.Expr(deletedAtColumn+" < NOW() - ? * INTERVAL '1 millisecond'", grace.Milliseconds())
+}
@@ -0,0 +1,7 @@
+package model
+
+type Cursor struct {
+	Username      string `db:"username"`
+	MessageID     int64  `db:"message_id"`
+	ReadMessageID int64  `db:"read_message_id"`
+}
@@ -0,0 +1,163 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	modelRepo "github.com/Mobo140/chat/internal/repository/delivery/model"
+	"github.com/Mobo140/platform_common/pkg/db"
+	"github.com/jackc/pgx/v4"
+)
+
+var _ repository.DeliveryRepository = (*deliveryRepo)(nil)
+
+const (
+	tableName       = "delivery_cursors"
+	chatIDColumn    = "chat_id"
+	usernameColumn  = "username"
+	messageIDColumn = "message_id"
+	readColumn      = "read_message_id"
+	updatedAtColumn = "updated_at"
+)
+
+// forward keeps the greater of the stored and the new cursor.
+func forward(column string) string {
+	return column + " = GREATEST(" + tableName + "." + column + ", EXCLUDED." + column + ")"
+}
+
+type deliveryRepo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) *deliveryRepo { //nolint:revive // it's ok
+	return &deliveryRepo{db: db}
+}
+
+// Ack moves the cursor of the member forward, an older ack leaves it as is.
+func (r *deliveryRepo) Ack(ctx context.Context, ack *model.DeliveryAck) error {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, usernameColumn, messageIDColumn).
+		Values(ack.ChatID, ack.Username, ack.MessageID).
+		Suffix("ON CONFLICT (" + chatIDColumn + ", " + usernameColumn + ") DO UPDATE SET " +
+			forward(messageIDColumn) + ", " + updatedAtColumn + " = NOW()")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "delivery_repository.ack",
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to upsert delivery cursor: %v", err)
+	}
+
+	return nil
+}
+
+// MarkRead moves the read cursor of the member forward, and the delivered one
+// with it: a read message is delivered.
+func (r *deliveryRepo) MarkRead(ctx context.Context, read *model.DeliveryAck) error {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, usernameColumn, messageIDColumn, readColumn).
+		Values(read.ChatID, read.Username, read.MessageID, read.MessageID).
+		Suffix("ON CONFLICT (" + chatIDColumn + ", " + usernameColumn + ") DO UPDATE SET " +
+			forward(messageIDColumn) + ", " + forward(readColumn) + ", " + updatedAtColumn + " = NOW()")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "delivery_repository.mark_read",
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to upsert read cursor: %v", err)
+	}
+
+	return nil
+}
+
+// Get returns the cursors of the member, zero ones if none.
+func (r *deliveryRepo) Get(ctx context.Context, chatID int64, username string) (*model.DeliveryCursor, error) {
+	builderSelect := sq.Select(usernameColumn, messageIDColumn, readColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID, usernameColumn: username})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "delivery_repository.get",
+	}
+
+	var cursor modelRepo.Cursor
+
+	err = r.db.DB().ScanOneContext(ctx, &cursor, q, args...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &model.DeliveryCursor{Username: username}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to select delivery cursor: %v", err)
+	}
+
+	return toCursorFromRepo(&cursor), nil
+}
+
+func (r *deliveryRepo) List(ctx context.Context, chatID int64) ([]*model.DeliveryCursor, error) {
+	builderSelect := sq.Select(usernameColumn, messageIDColumn, readColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "delivery_repository.list",
+	}
+
+	var cursors []*modelRepo.Cursor
+
+	err = r.db.DB().ScanAllContext(ctx, &cursors, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select delivery cursors: %v", err)
+	}
+
+	res := make([]*model.DeliveryCursor, 0, len(cursors))
+	for _, cursor := range cursors {
+		res = append(res, toCursorFromRepo(cursor))
+	}
+
+	return res, nil
+}
+
+func toCursorFromRepo(cursor *modelRepo.Cursor) *model.DeliveryCursor {
+	return &model.DeliveryCursor{
+		Username:      cursor.Username,
+		MessageID:     cursor.MessageID,
+		ReadMessageID: cursor.ReadMessageID,
+	}
+}
@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+type MessageEdit struct {
+	MessageID int64     `db:"message_id"`
+	Text      string    `db:"text"`
+	EditedBy  string    `db:"edited_by"`
+	EditedAt  time.Time `db:"edited_at"`
+}
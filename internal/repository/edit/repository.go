@@ -0,0 +1,121 @@
+package edit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	modelRepo "github.com/Mobo140/chat/internal/repository/edit/model"
+	"github.com/Mobo140/platform_common/pkg/db"
+	"github.com/Mobo140/platform_common/pkg/db/pg"
+	"github.com/jackc/pgx/v4"
+)
+
+var _ repository.MessageEditRepository = (*editRepo)(nil)
+
+const (
+	tableName       = "message_edits"
+	idColumn        = "id"
+	messageIDColumn = "message_id"
+	textColumn      = "text"
+	editedByColumn  = "edited_by"
+	editedAtColumn  = "edited_at"
+)
+
+type editRepo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) *editRepo { //nolint:revive // it's ok
+	return &editRepo{db: db}
+}
+
+func (r *editRepo) Create(ctx context.Context, edit *model.MessageEdit) error {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, textColumn, editedByColumn, editedAtColumn).
+		Values(edit.MessageID, edit.Text, edit.EditedBy, edit.EditedAt)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "message_edit_repository.create",
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert message edit: %v", err)
+	}
+
+	return nil
+}
+
+func (r *editRepo) ListByMessages(ctx context.Context, messageIDs []int64) (map[int64][]*model.MessageEdit, error) {
+	edits := make(map[int64][]*model.MessageEdit, len(messageIDs))
+	if len(messageIDs) == 0 {
+		return edits, nil
+	}
+
+	builderSelect := sq.Select(messageIDColumn, textColumn, editedByColumn, editedAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{messageIDColumn: messageIDs}).
+		OrderBy(messageIDColumn, idColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "message_edit_repository.list_by_messages",
+	}
+
+	var rows []modelRepo.MessageEdit
+
+	err = r.db.DB().ScanAllContext(ctx, &rows, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select message edits: %v", err)
+	}
+
+	for _, row := range rows {
+		edits[row.MessageID] = append(edits[row.MessageID], &model.MessageEdit{
+			MessageID: row.MessageID,
+			Text:      row.Text,
+			EditedBy:  row.EditedBy,
+			EditedAt:  row.EditedAt,
+		})
+	}
+
+	return edits, nil
+}
+
+// Copy inserts the edits with COPY. It has to run in a transaction: COPY goes
+// through its connection.
+func (r *editRepo) Copy(ctx context.Context, edits []*model.MessageEdit) (int64, error) {
+	tx, ok := ctx.Value(pg.TxKey).(pgx.Tx)
+	if !ok {
+		return 0, errors.New("failed to copy message edits: no transaction")
+	}
+
+	rows := make([][]any, 0, len(edits))
+	for _, edit := range edits {
+		rows = append(rows, []any{edit.MessageID, edit.Text, edit.EditedBy, edit.EditedAt})
+	}
+
+	n, err := tx.CopyFrom(ctx, pgx.Identifier{tableName},
+		[]string{messageIDColumn, textColumn, editedByColumn, editedAtColumn}, pgx.CopyFromRows(rows))
+	if err != nil {
+		return 0, fmt.Errorf("failed to copy message edits: %v", err)
+	}
+
+	return n, nil
+}
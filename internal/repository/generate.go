@@ -4,3 +4,12 @@ package repository
 //go:generate minimock -i ChatRepository -o ./mocks/ -s "_minimock.go"
 //go:generate minimock -i MessageRepository -o ./mocks/ -s "_minimock.go"
 //go:generate minimock -i LogRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i MessageEditRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i ReactionRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i OutboxRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i OutboxListener -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i WebhookRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i BotRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i ScheduledRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i DeliveryRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i SessionRepository -o ./mocks/ -s "_minimock.go"
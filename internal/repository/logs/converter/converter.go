@@ -0,0 +1,72 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Mobo140/chat/internal/model"
+	modelRepo "github.com/Mobo140/chat/internal/repository/logs/model"
+)
+
+func ToLogEntryFromRepo(entry *modelRepo.LogEntry) (*model.LogEntry, error) {
+	res := &model.LogEntry{
+		ID:        entry.ID,
+		ChatID:    entry.ChatID,
+		Action:    model.LogAction(entry.Action),
+		Actor:     entry.Actor,
+		TraceID:   entry.TraceID,
+		CreatedAt: entry.CreatedAt,
+		PrevHash:  entry.PrevHash,
+		Hash:      entry.Hash,
+	}
+
+	if entry.MessageID != nil {
+		res.MessageID = *entry.MessageID
+	}
+
+	if entry.ChainSeq != nil {
+		res.ChainSeq = *entry.ChainSeq
+	}
+
+	if len(entry.Details) > 0 {
+		// Numbers stay as written, the hash chain depends on them.
+		decoder := json.NewDecoder(bytes.NewReader(entry.Details))
+		decoder.UseNumber()
+
+		err := decoder.Decode(&res.Details)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode details of log %d: %v", entry.ID, err)
+		}
+	}
+
+	return res, nil
+}
+
+func ToLogEntriesFromRepo(entries []*modelRepo.LogEntry) ([]*model.LogEntry, error) {
+	res := make([]*model.LogEntry, 0, len(entries))
+	for _, entry := range entries {
+		converted, err := ToLogEntryFromRepo(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, converted)
+	}
+
+	return res, nil
+}
+
+// ToDetailsFromService encodes the details as a JSON object, empty if there are none.
+func ToDetailsFromService(details model.LogDetails) (string, error) {
+	if details == nil {
+		return "{}", nil
+	}
+
+	raw, err := json.Marshal(details)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
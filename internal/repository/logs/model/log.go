@@ -3,7 +3,15 @@ package model
 import "time"
 
 type LogEntry struct {
-	UserID    int64     `db:"user_id"`
+	ID        int64     `db:"id"`
+	ChatID    int64     `db:"chat_id"`
+	MessageID *int64    `db:"message_id"`
 	Action    string    `db:"action"`
-	Timestamp time.Time `db:"timestamp"`
+	Actor     string    `db:"actor"`
+	Details   []byte    `db:"details"`
+	TraceID   string    `db:"trace_id"`
+	CreatedAt time.Time `db:"created_at"`
+	ChainSeq  *int64    `db:"chain_seq"`
+	PrevHash  string    `db:"prev_hash"`
+	Hash      string    `db:"hash"`
 }
@@ -0,0 +1,107 @@
+package logs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository/logs/converter"
+	modelRepo "github.com/Mobo140/chat/internal/repository/logs/model"
+	"github.com/Mobo140/platform_common/pkg/db"
+	"github.com/jackc/pgx/v4"
+)
+
+// LastBefore returns the latest chained entry older than maxAge. The entries
+// up to its position are a prefix of the chain, returns ErrLogNotFound if
+// there are none.
+func (l *logRepo) LastBefore(ctx context.Context, maxAge time.Duration) (*model.LogEntry, error) {
+	builder := l.selectEntries().
+		Where(sq.NotEq{chainSeqColumn: nil}).
+		Where(sq.Expr(createdAtColumn+" < NOW() - ? * INTERVAL '1 millisecond'", maxAge.Milliseconds())).
+		OrderBy(chainSeqColumn + " DESC").
+		Limit(1)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		Name:     "log_repository.last_before",
+		QueryRow: query,
+	}
+
+	var entry modelRepo.LogEntry
+
+	err = l.db.DB().ScanOneContext(ctx, &entry, q, args...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrLogNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to select log: %v", err)
+	}
+
+	return converter.ToLogEntryFromRepo(&entry)
+}
+
+// CountUpTo counts the entries up to the chain position seq.
+func (l *logRepo) CountUpTo(ctx context.Context, seq int64) (int64, error) {
+	builder := sq.Select("COUNT(*)").
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.LtOrEq{chainSeqColumn: seq})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		Name:     "log_repository.count_up_to",
+		QueryRow: query,
+	}
+
+	var count int64
+
+	err = l.db.DB().ScanOneContext(ctx, &count, q, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count logs: %v", err)
+	}
+
+	return count, nil
+}
+
+// DeleteUpTo deletes up to limit of the first entries of the chain up to the
+// position seq and returns how many were deleted.
+func (l *logRepo) DeleteUpTo(ctx context.Context, seq int64, limit uint64) (int64, error) {
+	batch := sq.Select(idColumn).
+		From(tableName).
+		Where(sq.LtOrEq{chainSeqColumn: seq}).
+		OrderBy(chainSeqColumn).
+		Limit(limit)
+
+	builder := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Expr(idColumn+" IN (?)", batch))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		Name:     "log_repository.delete_up_to",
+		QueryRow: query,
+	}
+
+	tag, err := l.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete logs: %v", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -2,20 +2,38 @@ package logs
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/Mobo140/chat/internal/model"
 	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/repository/logs/converter"
+	modelRepo "github.com/Mobo140/chat/internal/repository/logs/model"
 	"github.com/Mobo140/platform_common/pkg/db"
+	"github.com/jackc/pgx/v4"
 )
 
 var _ repository.LogRepository = (*logRepo)(nil)
 
 const (
 	tableName       = "logs"
+	idColumn        = "id"
 	chatColumn      = "chat_id"
-	activityColumn  = "activity"
+	messageColumn   = "message_id"
+	actionColumn    = "action"
+	actorColumn     = "actor"
+	detailsColumn   = "details"
+	traceIDColumn   = "trace_id"
 	createdAtColumn = "created_at"
+	chainSeqColumn  = "chain_seq"
+	prevHashColumn  = "prev_hash"
+	hashColumn      = "hash"
+
+	// chainLockID is the advisory lock key of the chainers, only their short
+	// transactions take it.
+	chainLockID = 7304
 )
 
 type logRepo struct {
@@ -26,11 +44,44 @@ func NewRepository(db db.Client) *logRepo { //nolint:revive // it's ok
 	return &logRepo{db: db}
 }
 
+// Create inserts the entry unchained, the chainer links it once the
+// transaction commits. CreatedAt of the entry is filled in with the written value.
 func (l *logRepo) Create(ctx context.Context, logEntry *model.LogEntry) error {
+	if logEntry.CreatedAt.IsZero() {
+		logEntry.CreatedAt = time.Now()
+	}
+	logEntry.CreatedAt = logEntry.CreatedAt.UTC().Truncate(time.Microsecond)
+
+	details, err := converter.ToDetailsFromService(logEntry.Details)
+	if err != nil {
+		return fmt.Errorf("failed to encode log details: %v", err)
+	}
+
+	var messageID *int64
+	if logEntry.MessageID != 0 {
+		messageID = &logEntry.MessageID
+	}
+
 	builder := sq.Insert(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Columns(chatColumn, activityColumn, createdAtColumn).
-		Values(logEntry.ChatID, logEntry.Activity, logEntry.CreatedAt)
+		Columns(
+			chatColumn,
+			messageColumn,
+			actionColumn,
+			actorColumn,
+			detailsColumn,
+			traceIDColumn,
+			createdAtColumn,
+		).
+		Values(
+			logEntry.ChatID,
+			messageID,
+			string(logEntry.Action),
+			logEntry.Actor,
+			details,
+			logEntry.TraceID,
+			logEntry.CreatedAt,
+		)
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -49,3 +100,208 @@ func (l *logRepo) Create(ctx context.Context, logEntry *model.LogEntry) error {
 
 	return nil
 }
+
+// LockChain serializes the chainers until the end of the transaction.
+func (l *logRepo) LockChain(ctx context.Context) error {
+	q := db.Query{
+		Name:     "log_repository.lock_chain",
+		QueryRow: "SELECT pg_advisory_xact_lock($1)",
+	}
+
+	_, err := l.db.DB().ExecContext(ctx, q, chainLockID)
+	if err != nil {
+		return fmt.Errorf("failed to lock log chain: %v", err)
+	}
+
+	return nil
+}
+
+// LastChained returns the entry at the end of the chain, ErrLogNotFound if
+// nothing is chained yet.
+func (l *logRepo) LastChained(ctx context.Context) (*model.LogEntry, error) {
+	builder := l.selectEntries().
+		Where(sq.NotEq{chainSeqColumn: nil}).
+		OrderBy(chainSeqColumn + " DESC").
+		Limit(1)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		Name:     "log_repository.last_chained",
+		QueryRow: query,
+	}
+
+	var entry modelRepo.LogEntry
+
+	err = l.db.DB().ScanOneContext(ctx, &entry, q, args...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrLogNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to select last chained log: %v", err)
+	}
+
+	return converter.ToLogEntryFromRepo(&entry)
+}
+
+// ListUnchained returns up to limit of the committed entries not chained yet,
+// the oldest first.
+func (l *logRepo) ListUnchained(ctx context.Context, limit uint64) ([]*model.LogEntry, error) {
+	builder := l.selectEntries().
+		Where(sq.Eq{chainSeqColumn: nil}).
+		OrderBy(idColumn).
+		Limit(limit)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		Name:     "log_repository.list_unchained",
+		QueryRow: query,
+	}
+
+	var entries []*modelRepo.LogEntry
+
+	err = l.db.DB().ScanAllContext(ctx, &entries, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select unchained logs: %v", err)
+	}
+
+	return converter.ToLogEntriesFromRepo(entries)
+}
+
+// Link stores the chain position and the hashes of the entry.
+func (l *logRepo) Link(ctx context.Context, logEntry *model.LogEntry) error {
+	builder := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(chainSeqColumn, logEntry.ChainSeq).
+		Set(prevHashColumn, logEntry.PrevHash).
+		Set(hashColumn, logEntry.Hash).
+		Where(sq.Eq{idColumn: logEntry.ID, chainSeqColumn: nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		Name:     "log_repository.link",
+		QueryRow: query,
+	}
+
+	tag, err := l.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to link log %d: %v", logEntry.ID, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("log %d is already chained", logEntry.ID)
+	}
+
+	return nil
+}
+
+// ListChain returns the chained entries after the position afterSeq in the chain order.
+func (l *logRepo) ListChain(ctx context.Context, afterSeq int64, limit uint64) ([]*model.LogEntry, error) {
+	builder := l.selectEntries().
+		Where(sq.Gt{chainSeqColumn: afterSeq}).
+		OrderBy(chainSeqColumn).
+		Limit(limit)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		Name:     "log_repository.list_chain",
+		QueryRow: query,
+	}
+
+	var entries []*modelRepo.LogEntry
+
+	err = l.db.DB().ScanAllContext(ctx, &entries, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select logs: %v", err)
+	}
+
+	return converter.ToLogEntriesFromRepo(entries)
+}
+
+func (l *logRepo) selectEntries() sq.SelectBuilder {
+	return sq.Select(
+		idColumn,
+		chatColumn,
+		messageColumn,
+		actionColumn,
+		actorColumn,
+		detailsColumn,
+		traceIDColumn,
+		createdAtColumn,
+		chainSeqColumn,
+		prevHashColumn,
+		hashColumn,
+	).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar)
+}
+
+// List returns the entries matching the filter from the newest to the oldest.
+func (l *logRepo) List(ctx context.Context, filter *model.LogFilter) ([]*model.LogEntry, error) {
+	builder := l.selectEntries().
+		OrderBy(idColumn + " DESC").
+		Limit(filter.Limit)
+
+	if filter.ChatID > 0 {
+		builder = builder.Where(sq.Eq{chatColumn: filter.ChatID})
+	}
+
+	if filter.MessageID > 0 {
+		builder = builder.Where(sq.Eq{messageColumn: filter.MessageID})
+	}
+
+	if filter.Actor != "" {
+		builder = builder.Where(sq.Eq{actorColumn: filter.Actor})
+	}
+
+	if filter.Action != "" {
+		builder = builder.Where(sq.Eq{actionColumn: string(filter.Action)})
+	}
+
+	if filter.Since != nil {
+		builder = builder.Where(sq.GtOrEq{createdAtColumn: *filter.Since})
+	}
+
+	if filter.Until != nil {
+		builder = builder.Where(sq.Lt{createdAtColumn: *filter.Until})
+	}
+
+	if filter.BeforeID > 0 {
+		builder = builder.Where(sq.Lt{idColumn: filter.BeforeID})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		Name:     "log_repository.list",
+		QueryRow: query,
+	}
+
+	var entries []*modelRepo.LogEntry
+
+	err = l.db.DB().ScanAllContext(ctx, &entries, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select logs: %v", err)
+	}
+
+	return converter.ToLogEntriesFromRepo(entries)
+}
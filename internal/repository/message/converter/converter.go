@@ -0,0 +1,54 @@
+package converter
+
+import (
+	model "github.com/Mobo140/chat/internal/model"
+	modelRepo "github.com/Mobo140/chat/internal/repository/message/model"
+)
+
+func ToChatMessageFromRepo(message *modelRepo.Message) *model.ChatMessage {
+	return &model.ChatMessage{
+		ChatID:  message.ChatID,
+		Message: ToMessageFromRepo(message),
+	}
+}
+
+func ToMessageFromRepo(message *modelRepo.Message) model.Message {
+	res := model.Message{
+		ID:        message.ID,
+		From:      message.From,
+		Text:      message.Text,
+		CreatedAt: message.CreatedAt,
+		EditedAt:  message.EditedAt,
+		DeletedAt: message.DeletedAt,
+	}
+
+	if message.ReplyToID != nil {
+		res.ReplyToID = *message.ReplyToID
+	}
+
+	return res
+}
+
+func ToSearchResultsFromRepo(results []*modelRepo.SearchResult) []*model.SearchResult {
+	res := make([]*model.SearchResult, 0, len(results))
+	for _, result := range results {
+		message := ToMessageFromRepo(&result.Message)
+		res = append(res, &model.SearchResult{
+			ChatID:  result.ChatID,
+			Message: &message,
+			Snippet: result.Snippet,
+		})
+	}
+
+	return res
+}
+
+func ToMessagesFromRepo(messages []*modelRepo.Message) []*model.Message {
+	res := make([]*model.Message, 0, len(messages))
+	for _, message := range messages {
+		converted := ToMessageFromRepo(message)
+		res = append(res, &converted)
+	}
+
+	return res
+}
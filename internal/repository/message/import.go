@@ -0,0 +1,65 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/db"
+	"github.com/Mobo140/platform_common/pkg/db/pg"
+	"github.com/jackc/pgx/v4"
+)
+
+// NextIDs reserves n message ids, the imported messages are copied with them.
+func (r *messageRepo) NextIDs(ctx context.Context, n int) ([]int64, error) {
+	q := db.Query{
+		QueryRow: "SELECT nextval(pg_get_serial_sequence('" + tableName + "', '" + idColumn + "'))" +
+			" FROM generate_series(1, $1)",
+		Name: "message_repository.next_ids",
+	}
+
+	var ids []int64
+
+	err := r.db.DB().ScanAllContext(ctx, &ids, q, n)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve message ids: %v", err)
+	}
+
+	return ids, nil
+}
+
+// Copy inserts the messages with their ids and timestamps with COPY. It has
+// to run in a transaction: COPY goes through its connection.
+func (r *messageRepo) Copy(ctx context.Context, chatID int64, messages []*model.Message) (int64, error) {
+	tx, ok := ctx.Value(pg.TxKey).(pgx.Tx)
+	if !ok {
+		return 0, errors.New("failed to copy messages: no transaction")
+	}
+
+	rows := make([][]any, 0, len(messages))
+	for _, message := range messages {
+		var replyToID *int64
+		if message.ReplyToID != 0 {
+			replyToID = &message.ReplyToID
+		}
+
+		rows = append(rows, []any{
+			message.ID,
+			chatID,
+			message.From,
+			message.Text,
+			message.CreatedAt,
+			message.EditedAt,
+			message.DeletedAt,
+			replyToID,
+		})
+	}
+
+	n, err := tx.CopyFrom(ctx, pgx.Identifier{tableName}, columns, pgx.CopyFromRows(rows))
+	if err != nil {
+		return 0, fmt.Errorf("failed to copy messages: %v", err)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+type Message struct {
+	ID        int64      `db:"id"`
+	ChatID    int64      `db:"chat_id"`
+	From      string     `db:"from_user"`
+	Text      string     `db:"text"`
+	CreatedAt time.Time  `db:"timestamp"`
+	EditedAt  *time.Time `db:"edited_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+	ReplyToID *int64     `db:"reply_to_message_id"`
+}
+
+type SearchResult struct {
+	Message
+	Snippet string `db:"snippet"`
+}
+
+type ReplyCount struct {
+	MessageID int64 `db:"reply_to_message_id"`
+	Count     int64 `db:"count"`
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/db"
+)
+
+// CountPurgeable counts the messages of the chat beyond its retention.
+func (r *messageRepo) CountPurgeable(ctx context.Context, purge *model.MessagePurge) (int64, error) {
+	builderSelect := sq.Select("COUNT(*)").
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(purgeCondition(purge))
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "message_repository.count_purgeable",
+	}
+
+	var count int64
+
+	err = r.db.DB().ScanOneContext(ctx, &count, q, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count purgeable messages: %v", err)
+	}
+
+	return count, nil
+}
+
+// Purge deletes up to purge.Limit of the oldest messages beyond the retention
+// and returns how many were deleted. The rows are gone for good, together
+// with their edits and reactions, the replies to them lose the parent.
+func (r *messageRepo) Purge(ctx context.Context, purge *model.MessagePurge) (int64, error) {
+	batch := sq.Select(idColumn).
+		From(tableName).
+		Where(purgeCondition(purge)).
+		OrderBy(idColumn).
+		Limit(purge.Limit)
+
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Expr(idColumn+" IN (?)", batch))
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "message_repository.purge",
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge messages: %v", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// purgeCondition matches the messages of the chat older than MaxAge or
+// beyond the MaxCount latest ones.
+func purgeCondition(purge *model.MessagePurge) sq.Sqlizer {
+	beyond := sq.Or{}
+
+	if purge.Retention.MaxAge > 0 {
+		beyond = append(beyond, sq.Expr(
+			timestampColumn+" < NOW() - ? * INTERVAL '1 millisecond'",
+			purge.Retention.MaxAge.Milliseconds(),
+		))
+	}
+
+	if purge.Retention.MaxCount > 0 {
+		beyond = append(beyond, sq.Expr(
+			idColumn+" <= (SELECT "+idColumn+" FROM "+tableName+" WHERE "+chatIDColumn+" = ?"+
+				" ORDER BY "+idColumn+" DESC OFFSET ? LIMIT 1)",
+			purge.ChatID,
+			purge.Retention.MaxCount,
+		))
+	}
+
+	return sq.And{sq.Eq{chatIDColumn: purge.ChatID}, beyond}
+}
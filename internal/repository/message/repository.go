@@ -2,24 +2,48 @@ package model
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/Mobo140/chat/internal/model"
 	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/repository/message/converter"
+	modelRepo "github.com/Mobo140/chat/internal/repository/message/model"
 	"github.com/Mobo140/platform_common/pkg/db"
+	"github.com/jackc/pgx/v4"
 )
 
 var _ repository.MessageRepository = (*messageRepo)(nil)
 
 const (
 	tableName       = "message"
+	idColumn        = "id"
 	chatIDColumn    = "chat_id"
 	fromUserColumn  = "from_user"
 	textColumn      = "text"
 	timestampColumn = "timestamp"
+	editedAtColumn  = "edited_at"
+	deletedAtColumn = "deleted_at"
+	replyToColumn   = "reply_to_message_id"
 )
 
+var columns = []string{
+	idColumn,
+	chatIDColumn,
+	fromUserColumn,
+	textColumn,
+	timestampColumn,
+	editedAtColumn,
+	deletedAtColumn,
+	replyToColumn,
+}
+
+// liveChat keeps the messages of the deleted chats out of the reads.
+var liveChat = sq.Expr("EXISTS (SELECT 1 FROM chat WHERE chat.id = " + tableName + "." + chatIDColumn +
+	" AND chat.deleted_at IS NULL)")
+
 type messageRepo struct {
 	db db.Client
 }
@@ -28,15 +52,21 @@ func NewRepository(db db.Client) *messageRepo { //nolint:revive // it's ok
 	return &messageRepo{db: db}
 }
 
-func (r *messageRepo) SendMessage(ctx context.Context, message *model.SendMessage) error {
+func (r *messageRepo) SendMessage(ctx context.Context, message *model.SendMessage) (int64, error) {
+	var replyToID *int64
+	if message.Message.ReplyToID != 0 {
+		replyToID = &message.Message.ReplyToID
+	}
+
 	builderInsert := sq.Insert(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Columns(chatIDColumn, fromUserColumn, textColumn, timestampColumn).
-		Values(message.ChatID, message.Message.From, message.Message.Text, message.Message.CreatedAt)
+		Columns(chatIDColumn, fromUserColumn, textColumn, timestampColumn, replyToColumn).
+		Values(message.ChatID, message.Message.From, message.Message.Text, message.Message.CreatedAt, replyToID).
+		Suffix("RETURNING id")
 
 	query, args, err := builderInsert.ToSql()
 	if err != nil {
-		return fmt.Errorf("failed to build query: %v", err)
+		return 0, fmt.Errorf("failed to build query: %v", err)
 	}
 
 	q := db.Query{
@@ -44,9 +74,259 @@ func (r *messageRepo) SendMessage(ctx context.Context, message *model.SendMessag
 		Name:     "send_message_repository",
 	}
 
+	var messageID int64
+
+	err = r.db.DB().ScanOneContext(ctx, &messageID, q, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert message: %v", err)
+	}
+
+	return messageID, nil
+}
+
+func (r *messageRepo) Get(ctx context.Context, id int64) (*model.ChatMessage, error) {
+	builderSelect := sq.Select(columns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id}).
+		Where(liveChat).
+		Limit(1)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "message_repository.get",
+	}
+
+	var message modelRepo.Message
+
+	err = r.db.DB().ScanOneContext(ctx, &message, q, args...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrMessageNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to select message: %v", err)
+	}
+
+	return converter.ToChatMessageFromRepo(&message), nil
+}
+
+func (r *messageRepo) List(ctx context.Context, page *model.MessagesPage) ([]*model.Message, error) {
+	builderSelect := sq.Select(columns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: page.ChatID}).
+		Where(liveChat).
+		OrderBy(idColumn + " DESC").
+		Limit(page.Limit)
+
+	if page.BeforeID > 0 {
+		builderSelect = builderSelect.Where(sq.Lt{idColumn: page.BeforeID})
+	}
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "message_repository.list",
+	}
+
+	var messages []*modelRepo.Message
+
+	err = r.db.DB().ScanAllContext(ctx, &messages, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select messages: %v", err)
+	}
+
+	return converter.ToMessagesFromRepo(messages), nil
+}
+
+// ListAfter returns the chat messages newer than afterID from the oldest,
+// deleted ones included.
+func (r *messageRepo) ListAfter(ctx context.Context, chatID, afterID int64, limit uint64) ([]*model.Message, error) {
+	builderSelect := sq.Select(columns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID}).
+		Where(sq.Gt{idColumn: afterID}).
+		OrderBy(idColumn).
+		Limit(limit)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "message_repository.list_after",
+	}
+
+	var messages []*modelRepo.Message
+
+	err = r.db.DB().ScanAllContext(ctx, &messages, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select messages: %v", err)
+	}
+
+	return converter.ToMessagesFromRepo(messages), nil
+}
+
+// ListReplies returns a page of the thread replies from the oldest to the newest.
+func (r *messageRepo) ListReplies(ctx context.Context, page *model.ThreadPage) ([]*model.Message, error) {
+	builderSelect := sq.Select(columns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: page.ChatID, replyToColumn: page.MessageID}).
+		Where(liveChat).
+		OrderBy(idColumn).
+		Limit(page.Limit)
+
+	if page.AfterID > 0 {
+		builderSelect = builderSelect.Where(sq.Gt{idColumn: page.AfterID})
+	}
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "message_repository.list_replies",
+	}
+
+	var messages []*modelRepo.Message
+
+	err = r.db.DB().ScanAllContext(ctx, &messages, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select replies: %v", err)
+	}
+
+	return converter.ToMessagesFromRepo(messages), nil
+}
+
+func (r *messageRepo) ListByIDs(ctx context.Context, ids []int64) ([]*model.Message, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	builderSelect := sq.Select(columns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: ids})
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "message_repository.list_by_ids",
+	}
+
+	var messages []*modelRepo.Message
+
+	err = r.db.DB().ScanAllContext(ctx, &messages, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select messages: %v", err)
+	}
+
+	return converter.ToMessagesFromRepo(messages), nil
+}
+
+// ReplyCounts counts the live replies of the messages.
+func (r *messageRepo) ReplyCounts(ctx context.Context, ids []int64) (map[int64]int64, error) {
+	counts := make(map[int64]int64, len(ids))
+	if len(ids) == 0 {
+		return counts, nil
+	}
+
+	builderSelect := sq.Select(replyToColumn, "COUNT(*) AS count").
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{replyToColumn: ids, deletedAtColumn: nil}).
+		GroupBy(replyToColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "message_repository.reply_counts",
+	}
+
+	var rows []modelRepo.ReplyCount
+
+	err = r.db.DB().ScanAllContext(ctx, &rows, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count replies: %v", err)
+	}
+
+	for _, row := range rows {
+		counts[row.MessageID] = row.Count
+	}
+
+	return counts, nil
+}
+
+func (r *messageRepo) Update(ctx context.Context, id int64, text string, editedAt time.Time) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(textColumn, text).
+		Set(editedAtColumn, editedAt).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "message_repository.update",
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update message: %v", err)
+	}
+
+	return nil
+}
+
+// Delete leaves a tombstone: the row stays in the history without its text.
+func (r *messageRepo) Delete(ctx context.Context, id int64, deletedAt time.Time) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(textColumn, "").
+		Set(deletedAtColumn, deletedAt).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "message_repository.delete",
+	}
+
 	_, err = r.db.DB().ExecContext(ctx, q, args...)
 	if err != nil {
-		return fmt.Errorf("failed to insert message: %v", err)
+		return fmt.Errorf("failed to delete message: %v", err)
 	}
 
 	return nil
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository/message/converter"
+	modelRepo "github.com/Mobo140/chat/internal/repository/message/model"
+	"github.com/Mobo140/platform_common/pkg/db"
+)
+
+const (
+	// searchConfig is language neutral: chats are not in a single language.
+	searchConfig = "simple"
+
+	// The headline marks the matches with private use characters, stripped
+	// from the text first: the snippet is escaped, then the marks become tags.
+	startMark       = "\uE000"
+	stopMark        = "\uE001"
+	headlineOptions = "StartSel=" + startMark + ", StopSel=" + stopMark + ", MaxWords=20, MinWords=5"
+)
+
+var highlightTags = strings.NewReplacer(startMark, "<b>", stopMark, "</b>")
+
+// Search finds the live messages of the user's chats matching the query,
+// from the newest to the oldest, using the text_search GIN index.
+func (r *messageRepo) Search(ctx context.Context, query *model.SearchQuery) ([]*model.SearchResult, error) {
+	tsQuery := fmt.Sprintf("websearch_to_tsquery('%s', ?)", searchConfig)
+
+	builderSelect := sq.Select(
+		"m."+idColumn,
+		"m."+chatIDColumn,
+		"m."+fromUserColumn,
+		"m."+textColumn,
+		"m."+timestampColumn,
+		"m."+editedAtColumn,
+		"m."+deletedAtColumn,
+		"m."+replyToColumn,
+	).
+		Column(sq.Expr(
+			fmt.Sprintf("ts_headline('%s', translate(m.%s, ?, ''), %s, ?) AS snippet", searchConfig, textColumn, tsQuery),
+			startMark+stopMark,
+			query.Query,
+			headlineOptions,
+		)).
+		From(tableName + " m").
+		Join("chat c ON c.id = m." + chatIDColumn).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Expr("m.text_search @@ "+tsQuery, query.Query)).
+		Where(sq.Expr("? = ANY(c.usernames)", query.Username)).
+		Where(sq.Eq{"m." + deletedAtColumn: nil, "c.deleted_at": nil}).
+		OrderBy("m." + idColumn + " DESC").
+		Limit(query.Limit)
+
+	if query.ChatID > 0 {
+		builderSelect = builderSelect.Where(sq.Eq{"m." + chatIDColumn: query.ChatID})
+	}
+
+	if query.From != "" {
+		builderSelect = builderSelect.Where(sq.Eq{"m." + fromUserColumn: query.From})
+	}
+
+	if query.Since != nil {
+		builderSelect = builderSelect.Where(sq.GtOrEq{"m." + timestampColumn: *query.Since})
+	}
+
+	if query.Until != nil {
+		builderSelect = builderSelect.Where(sq.Lt{"m." + timestampColumn: *query.Until})
+	}
+
+	if query.BeforeID > 0 {
+		builderSelect = builderSelect.Where(sq.Lt{"m." + idColumn: query.BeforeID})
+	}
+
+	sqlQuery, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: sqlQuery,
+		Name:     "message_repository.search",
+	}
+
+	var results []*modelRepo.SearchResult
+
+	err = r.db.DB().ScanAllContext(ctx, &results, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search messages: %v", err)
+	}
+
+	for _, result := range results {
+		result.Snippet = highlight(result.Snippet)
+	}
+
+	return converter.ToSearchResultsFromRepo(results), nil
+}
+
+// highlight escapes the headline and wraps its matches in <b></b>.
+func highlight(headline string) string {
+	return highlightTags.Replace(html.EscapeString(headline))
+}
@@ -0,0 +1,1871 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Mobo140/chat/internal/repository.BotRepository -o bot_repository_minimock.go -n BotRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// BotRepositoryMock implements mm_repository.BotRepository
+type BotRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, bot *model.Bot) (i1 int64, err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, bot *model.Bot)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mBotRepositoryMockCreate
+
+	funcCreateKey          func(ctx context.Context, botID int64, keyHash string) (err error)
+	funcCreateKeyOrigin    string
+	inspectFuncCreateKey   func(ctx context.Context, botID int64, keyHash string)
+	afterCreateKeyCounter  uint64
+	beforeCreateKeyCounter uint64
+	CreateKeyMock          mBotRepositoryMockCreateKey
+
+	funcGet          func(ctx context.Context, id int64) (bp1 *model.Bot, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, id int64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mBotRepositoryMockGet
+
+	funcGetByKey          func(ctx context.Context, keyHash string) (bp1 *model.Bot, err error)
+	funcGetByKeyOrigin    string
+	inspectFuncGetByKey   func(ctx context.Context, keyHash string)
+	afterGetByKeyCounter  uint64
+	beforeGetByKeyCounter uint64
+	GetByKeyMock          mBotRepositoryMockGetByKey
+
+	funcRevokeKeys          func(ctx context.Context, botID int64) (err error)
+	funcRevokeKeysOrigin    string
+	inspectFuncRevokeKeys   func(ctx context.Context, botID int64)
+	afterRevokeKeysCounter  uint64
+	beforeRevokeKeysCounter uint64
+	RevokeKeysMock          mBotRepositoryMockRevokeKeys
+}
+
+// NewBotRepositoryMock returns a mock for mm_repository.BotRepository
+func NewBotRepositoryMock(t minimock.Tester) *BotRepositoryMock {
+	m := &BotRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mBotRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*BotRepositoryMockCreateParams{}
+
+	m.CreateKeyMock = mBotRepositoryMockCreateKey{mock: m}
+	m.CreateKeyMock.callArgs = []*BotRepositoryMockCreateKeyParams{}
+
+	m.GetMock = mBotRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*BotRepositoryMockGetParams{}
+
+	m.GetByKeyMock = mBotRepositoryMockGetByKey{mock: m}
+	m.GetByKeyMock.callArgs = []*BotRepositoryMockGetByKeyParams{}
+
+	m.RevokeKeysMock = mBotRepositoryMockRevokeKeys{mock: m}
+	m.RevokeKeysMock.callArgs = []*BotRepositoryMockRevokeKeysParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mBotRepositoryMockCreate struct {
+	optional           bool
+	mock               *BotRepositoryMock
+	defaultExpectation *BotRepositoryMockCreateExpectation
+	expectations       []*BotRepositoryMockCreateExpectation
+
+	callArgs []*BotRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BotRepositoryMockCreateExpectation specifies expectation struct of the BotRepository.Create
+type BotRepositoryMockCreateExpectation struct {
+	mock               *BotRepositoryMock
+	params             *BotRepositoryMockCreateParams
+	paramPtrs          *BotRepositoryMockCreateParamPtrs
+	expectationOrigins BotRepositoryMockCreateExpectationOrigins
+	results            *BotRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BotRepositoryMockCreateParams contains parameters of the BotRepository.Create
+type BotRepositoryMockCreateParams struct {
+	ctx context.Context
+	bot *model.Bot
+}
+
+// BotRepositoryMockCreateParamPtrs contains pointers to parameters of the BotRepository.Create
+type BotRepositoryMockCreateParamPtrs struct {
+	ctx *context.Context
+	bot **model.Bot
+}
+
+// BotRepositoryMockCreateResults contains results of the BotRepository.Create
+type BotRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// BotRepositoryMockCreateOrigins contains origins of expectations of the BotRepository.Create
+type BotRepositoryMockCreateExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originBot string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mBotRepositoryMockCreate) Optional() *mBotRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for BotRepository.Create
+func (mmCreate *mBotRepositoryMockCreate) Expect(ctx context.Context, bot *model.Bot) *mBotRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("BotRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &BotRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("BotRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &BotRepositoryMockCreateParams{ctx, bot}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotRepository.Create
+func (mmCreate *mBotRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mBotRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("BotRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &BotRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("BotRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &BotRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectBotParam2 sets up expected param bot for BotRepository.Create
+func (mmCreate *mBotRepositoryMockCreate) ExpectBotParam2(bot *model.Bot) *mBotRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("BotRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &BotRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("BotRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &BotRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.bot = &bot
+	mmCreate.defaultExpectation.expectationOrigins.originBot = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotRepository.Create
+func (mmCreate *mBotRepositoryMockCreate) Inspect(f func(ctx context.Context, bot *model.Bot)) *mBotRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for BotRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by BotRepository.Create
+func (mmCreate *mBotRepositoryMockCreate) Return(i1 int64, err error) *BotRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("BotRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &BotRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &BotRepositoryMockCreateResults{i1, err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the BotRepository.Create method
+func (mmCreate *mBotRepositoryMockCreate) Set(f func(ctx context.Context, bot *model.Bot) (i1 int64, err error)) *BotRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the BotRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the BotRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the BotRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mBotRepositoryMockCreate) When(ctx context.Context, bot *model.Bot) *BotRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("BotRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &BotRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &BotRepositoryMockCreateParams{ctx, bot},
+		expectationOrigins: BotRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotRepository.Create return parameters for the expectation previously defined by the When method
+func (e *BotRepositoryMockCreateExpectation) Then(i1 int64, err error) *BotRepositoryMock {
+	e.results = &BotRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times BotRepository.Create should be invoked
+func (mmCreate *mBotRepositoryMockCreate) Times(n uint64) *mBotRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of BotRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mBotRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_repository.BotRepository
+func (mmCreate *BotRepositoryMock) Create(ctx context.Context, bot *model.Bot) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, bot)
+	}
+
+	mm_params := BotRepositoryMockCreateParams{ctx, bot}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := BotRepositoryMockCreateParams{ctx, bot}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("BotRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.bot != nil && !minimock.Equal(*mm_want_ptrs.bot, mm_got.bot) {
+				mmCreate.t.Errorf("BotRepositoryMock.Create got unexpected parameter bot, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originBot, *mm_want_ptrs.bot, mm_got.bot, minimock.Diff(*mm_want_ptrs.bot, mm_got.bot))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("BotRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the BotRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, bot)
+	}
+	mmCreate.t.Fatalf("Unexpected call to BotRepositoryMock.Create. %v %v", ctx, bot)
+	return
+}
+
+// CreateAfterCounter returns a count of finished BotRepositoryMock.Create invocations
+func (mmCreate *BotRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of BotRepositoryMock.Create invocations
+func (mmCreate *BotRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mBotRepositoryMockCreate) Calls() []*BotRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*BotRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *BotRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *BotRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BotRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BotRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to BotRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mBotRepositoryMockCreateKey struct {
+	optional           bool
+	mock               *BotRepositoryMock
+	defaultExpectation *BotRepositoryMockCreateKeyExpectation
+	expectations       []*BotRepositoryMockCreateKeyExpectation
+
+	callArgs []*BotRepositoryMockCreateKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BotRepositoryMockCreateKeyExpectation specifies expectation struct of the BotRepository.CreateKey
+type BotRepositoryMockCreateKeyExpectation struct {
+	mock               *BotRepositoryMock
+	params             *BotRepositoryMockCreateKeyParams
+	paramPtrs          *BotRepositoryMockCreateKeyParamPtrs
+	expectationOrigins BotRepositoryMockCreateKeyExpectationOrigins
+	results            *BotRepositoryMockCreateKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BotRepositoryMockCreateKeyParams contains parameters of the BotRepository.CreateKey
+type BotRepositoryMockCreateKeyParams struct {
+	ctx     context.Context
+	botID   int64
+	keyHash string
+}
+
+// BotRepositoryMockCreateKeyParamPtrs contains pointers to parameters of the BotRepository.CreateKey
+type BotRepositoryMockCreateKeyParamPtrs struct {
+	ctx     *context.Context
+	botID   *int64
+	keyHash *string
+}
+
+// BotRepositoryMockCreateKeyResults contains results of the BotRepository.CreateKey
+type BotRepositoryMockCreateKeyResults struct {
+	err error
+}
+
+// BotRepositoryMockCreateKeyOrigins contains origins of expectations of the BotRepository.CreateKey
+type BotRepositoryMockCreateKeyExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originBotID   string
+	originKeyHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateKey *mBotRepositoryMockCreateKey) Optional() *mBotRepositoryMockCreateKey {
+	mmCreateKey.optional = true
+	return mmCreateKey
+}
+
+// Expect sets up expected params for BotRepository.CreateKey
+func (mmCreateKey *mBotRepositoryMockCreateKey) Expect(ctx context.Context, botID int64, keyHash string) *mBotRepositoryMockCreateKey {
+	if mmCreateKey.mock.funcCreateKey != nil {
+		mmCreateKey.mock.t.Fatalf("BotRepositoryMock.CreateKey mock is already set by Set")
+	}
+
+	if mmCreateKey.defaultExpectation == nil {
+		mmCreateKey.defaultExpectation = &BotRepositoryMockCreateKeyExpectation{}
+	}
+
+	if mmCreateKey.defaultExpectation.paramPtrs != nil {
+		mmCreateKey.mock.t.Fatalf("BotRepositoryMock.CreateKey mock is already set by ExpectParams functions")
+	}
+
+	mmCreateKey.defaultExpectation.params = &BotRepositoryMockCreateKeyParams{ctx, botID, keyHash}
+	mmCreateKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateKey.expectations {
+		if minimock.Equal(e.params, mmCreateKey.defaultExpectation.params) {
+			mmCreateKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateKey.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotRepository.CreateKey
+func (mmCreateKey *mBotRepositoryMockCreateKey) ExpectCtxParam1(ctx context.Context) *mBotRepositoryMockCreateKey {
+	if mmCreateKey.mock.funcCreateKey != nil {
+		mmCreateKey.mock.t.Fatalf("BotRepositoryMock.CreateKey mock is already set by Set")
+	}
+
+	if mmCreateKey.defaultExpectation == nil {
+		mmCreateKey.defaultExpectation = &BotRepositoryMockCreateKeyExpectation{}
+	}
+
+	if mmCreateKey.defaultExpectation.params != nil {
+		mmCreateKey.mock.t.Fatalf("BotRepositoryMock.CreateKey mock is already set by Expect")
+	}
+
+	if mmCreateKey.defaultExpectation.paramPtrs == nil {
+		mmCreateKey.defaultExpectation.paramPtrs = &BotRepositoryMockCreateKeyParamPtrs{}
+	}
+	mmCreateKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateKey
+}
+
+// ExpectBotIDParam2 sets up expected param botID for BotRepository.CreateKey
+func (mmCreateKey *mBotRepositoryMockCreateKey) ExpectBotIDParam2(botID int64) *mBotRepositoryMockCreateKey {
+	if mmCreateKey.mock.funcCreateKey != nil {
+		mmCreateKey.mock.t.Fatalf("BotRepositoryMock.CreateKey mock is already set by Set")
+	}
+
+	if mmCreateKey.defaultExpectation == nil {
+		mmCreateKey.defaultExpectation = &BotRepositoryMockCreateKeyExpectation{}
+	}
+
+	if mmCreateKey.defaultExpectation.params != nil {
+		mmCreateKey.mock.t.Fatalf("BotRepositoryMock.CreateKey mock is already set by Expect")
+	}
+
+	if mmCreateKey.defaultExpectation.paramPtrs == nil {
+		mmCreateKey.defaultExpectation.paramPtrs = &BotRepositoryMockCreateKeyParamPtrs{}
+	}
+	mmCreateKey.defaultExpectation.paramPtrs.botID = &botID
+	mmCreateKey.defaultExpectation.expectationOrigins.originBotID = minimock.CallerInfo(1)
+
+	return mmCreateKey
+}
+
+// ExpectKeyHashParam3 sets up expected param keyHash for BotRepository.CreateKey
+func (mmCreateKey *mBotRepositoryMockCreateKey) ExpectKeyHashParam3(keyHash string) *mBotRepositoryMockCreateKey {
+	if mmCreateKey.mock.funcCreateKey != nil {
+		mmCreateKey.mock.t.Fatalf("BotRepositoryMock.CreateKey mock is already set by Set")
+	}
+
+	if mmCreateKey.defaultExpectation == nil {
+		mmCreateKey.defaultExpectation = &BotRepositoryMockCreateKeyExpectation{}
+	}
+
+	if mmCreateKey.defaultExpectation.params != nil {
+		mmCreateKey.mock.t.Fatalf("BotRepositoryMock.CreateKey mock is already set by Expect")
+	}
+
+	if mmCreateKey.defaultExpectation.paramPtrs == nil {
+		mmCreateKey.defaultExpectation.paramPtrs = &BotRepositoryMockCreateKeyParamPtrs{}
+	}
+	mmCreateKey.defaultExpectation.paramPtrs.keyHash = &keyHash
+	mmCreateKey.defaultExpectation.expectationOrigins.originKeyHash = minimock.CallerInfo(1)
+
+	return mmCreateKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotRepository.CreateKey
+func (mmCreateKey *mBotRepositoryMockCreateKey) Inspect(f func(ctx context.Context, botID int64, keyHash string)) *mBotRepositoryMockCreateKey {
+	if mmCreateKey.mock.inspectFuncCreateKey != nil {
+		mmCreateKey.mock.t.Fatalf("Inspect function is already set for BotRepositoryMock.CreateKey")
+	}
+
+	mmCreateKey.mock.inspectFuncCreateKey = f
+
+	return mmCreateKey
+}
+
+// Return sets up results that will be returned by BotRepository.CreateKey
+func (mmCreateKey *mBotRepositoryMockCreateKey) Return(err error) *BotRepositoryMock {
+	if mmCreateKey.mock.funcCreateKey != nil {
+		mmCreateKey.mock.t.Fatalf("BotRepositoryMock.CreateKey mock is already set by Set")
+	}
+
+	if mmCreateKey.defaultExpectation == nil {
+		mmCreateKey.defaultExpectation = &BotRepositoryMockCreateKeyExpectation{mock: mmCreateKey.mock}
+	}
+	mmCreateKey.defaultExpectation.results = &BotRepositoryMockCreateKeyResults{err}
+	mmCreateKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateKey.mock
+}
+
+// Set uses given function f to mock the BotRepository.CreateKey method
+func (mmCreateKey *mBotRepositoryMockCreateKey) Set(f func(ctx context.Context, botID int64, keyHash string) (err error)) *BotRepositoryMock {
+	if mmCreateKey.defaultExpectation != nil {
+		mmCreateKey.mock.t.Fatalf("Default expectation is already set for the BotRepository.CreateKey method")
+	}
+
+	if len(mmCreateKey.expectations) > 0 {
+		mmCreateKey.mock.t.Fatalf("Some expectations are already set for the BotRepository.CreateKey method")
+	}
+
+	mmCreateKey.mock.funcCreateKey = f
+	mmCreateKey.mock.funcCreateKeyOrigin = minimock.CallerInfo(1)
+	return mmCreateKey.mock
+}
+
+// When sets expectation for the BotRepository.CreateKey which will trigger the result defined by the following
+// Then helper
+func (mmCreateKey *mBotRepositoryMockCreateKey) When(ctx context.Context, botID int64, keyHash string) *BotRepositoryMockCreateKeyExpectation {
+	if mmCreateKey.mock.funcCreateKey != nil {
+		mmCreateKey.mock.t.Fatalf("BotRepositoryMock.CreateKey mock is already set by Set")
+	}
+
+	expectation := &BotRepositoryMockCreateKeyExpectation{
+		mock:               mmCreateKey.mock,
+		params:             &BotRepositoryMockCreateKeyParams{ctx, botID, keyHash},
+		expectationOrigins: BotRepositoryMockCreateKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateKey.expectations = append(mmCreateKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotRepository.CreateKey return parameters for the expectation previously defined by the When method
+func (e *BotRepositoryMockCreateKeyExpectation) Then(err error) *BotRepositoryMock {
+	e.results = &BotRepositoryMockCreateKeyResults{err}
+	return e.mock
+}
+
+// Times sets number of times BotRepository.CreateKey should be invoked
+func (mmCreateKey *mBotRepositoryMockCreateKey) Times(n uint64) *mBotRepositoryMockCreateKey {
+	if n == 0 {
+		mmCreateKey.mock.t.Fatalf("Times of BotRepositoryMock.CreateKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateKey.expectedInvocations, n)
+	mmCreateKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateKey
+}
+
+func (mmCreateKey *mBotRepositoryMockCreateKey) invocationsDone() bool {
+	if len(mmCreateKey.expectations) == 0 && mmCreateKey.defaultExpectation == nil && mmCreateKey.mock.funcCreateKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateKey.mock.afterCreateKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateKey implements mm_repository.BotRepository
+func (mmCreateKey *BotRepositoryMock) CreateKey(ctx context.Context, botID int64, keyHash string) (err error) {
+	mm_atomic.AddUint64(&mmCreateKey.beforeCreateKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateKey.afterCreateKeyCounter, 1)
+
+	mmCreateKey.t.Helper()
+
+	if mmCreateKey.inspectFuncCreateKey != nil {
+		mmCreateKey.inspectFuncCreateKey(ctx, botID, keyHash)
+	}
+
+	mm_params := BotRepositoryMockCreateKeyParams{ctx, botID, keyHash}
+
+	// Record call args
+	mmCreateKey.CreateKeyMock.mutex.Lock()
+	mmCreateKey.CreateKeyMock.callArgs = append(mmCreateKey.CreateKeyMock.callArgs, &mm_params)
+	mmCreateKey.CreateKeyMock.mutex.Unlock()
+
+	for _, e := range mmCreateKey.CreateKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateKey.CreateKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateKey.CreateKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateKey.CreateKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateKey.CreateKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := BotRepositoryMockCreateKeyParams{ctx, botID, keyHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateKey.t.Errorf("BotRepositoryMock.CreateKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateKey.CreateKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.botID != nil && !minimock.Equal(*mm_want_ptrs.botID, mm_got.botID) {
+				mmCreateKey.t.Errorf("BotRepositoryMock.CreateKey got unexpected parameter botID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateKey.CreateKeyMock.defaultExpectation.expectationOrigins.originBotID, *mm_want_ptrs.botID, mm_got.botID, minimock.Diff(*mm_want_ptrs.botID, mm_got.botID))
+			}
+
+			if mm_want_ptrs.keyHash != nil && !minimock.Equal(*mm_want_ptrs.keyHash, mm_got.keyHash) {
+				mmCreateKey.t.Errorf("BotRepositoryMock.CreateKey got unexpected parameter keyHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateKey.CreateKeyMock.defaultExpectation.expectationOrigins.originKeyHash, *mm_want_ptrs.keyHash, mm_got.keyHash, minimock.Diff(*mm_want_ptrs.keyHash, mm_got.keyHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateKey.t.Errorf("BotRepositoryMock.CreateKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateKey.CreateKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateKey.CreateKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateKey.t.Fatal("No results are set for the BotRepositoryMock.CreateKey")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateKey.funcCreateKey != nil {
+		return mmCreateKey.funcCreateKey(ctx, botID, keyHash)
+	}
+	mmCreateKey.t.Fatalf("Unexpected call to BotRepositoryMock.CreateKey. %v %v %v", ctx, botID, keyHash)
+	return
+}
+
+// CreateKeyAfterCounter returns a count of finished BotRepositoryMock.CreateKey invocations
+func (mmCreateKey *BotRepositoryMock) CreateKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateKey.afterCreateKeyCounter)
+}
+
+// CreateKeyBeforeCounter returns a count of BotRepositoryMock.CreateKey invocations
+func (mmCreateKey *BotRepositoryMock) CreateKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateKey.beforeCreateKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotRepositoryMock.CreateKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateKey *mBotRepositoryMockCreateKey) Calls() []*BotRepositoryMockCreateKeyParams {
+	mmCreateKey.mutex.RLock()
+
+	argCopy := make([]*BotRepositoryMockCreateKeyParams, len(mmCreateKey.callArgs))
+	copy(argCopy, mmCreateKey.callArgs)
+
+	mmCreateKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateKeyDone returns true if the count of the CreateKey invocations corresponds
+// the number of defined expectations
+func (m *BotRepositoryMock) MinimockCreateKeyDone() bool {
+	if m.CreateKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateKeyMock.invocationsDone()
+}
+
+// MinimockCreateKeyInspect logs each unmet expectation
+func (m *BotRepositoryMock) MinimockCreateKeyInspect() {
+	for _, e := range m.CreateKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotRepositoryMock.CreateKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateKeyCounter := mm_atomic.LoadUint64(&m.afterCreateKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateKeyMock.defaultExpectation != nil && afterCreateKeyCounter < 1 {
+		if m.CreateKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BotRepositoryMock.CreateKey at\n%s", m.CreateKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BotRepositoryMock.CreateKey at\n%s with params: %#v", m.CreateKeyMock.defaultExpectation.expectationOrigins.origin, *m.CreateKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateKey != nil && afterCreateKeyCounter < 1 {
+		m.t.Errorf("Expected call to BotRepositoryMock.CreateKey at\n%s", m.funcCreateKeyOrigin)
+	}
+
+	if !m.CreateKeyMock.invocationsDone() && afterCreateKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotRepositoryMock.CreateKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateKeyMock.expectedInvocations), m.CreateKeyMock.expectedInvocationsOrigin, afterCreateKeyCounter)
+	}
+}
+
+type mBotRepositoryMockGet struct {
+	optional           bool
+	mock               *BotRepositoryMock
+	defaultExpectation *BotRepositoryMockGetExpectation
+	expectations       []*BotRepositoryMockGetExpectation
+
+	callArgs []*BotRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BotRepositoryMockGetExpectation specifies expectation struct of the BotRepository.Get
+type BotRepositoryMockGetExpectation struct {
+	mock               *BotRepositoryMock
+	params             *BotRepositoryMockGetParams
+	paramPtrs          *BotRepositoryMockGetParamPtrs
+	expectationOrigins BotRepositoryMockGetExpectationOrigins
+	results            *BotRepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BotRepositoryMockGetParams contains parameters of the BotRepository.Get
+type BotRepositoryMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// BotRepositoryMockGetParamPtrs contains pointers to parameters of the BotRepository.Get
+type BotRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// BotRepositoryMockGetResults contains results of the BotRepository.Get
+type BotRepositoryMockGetResults struct {
+	bp1 *model.Bot
+	err error
+}
+
+// BotRepositoryMockGetOrigins contains origins of expectations of the BotRepository.Get
+type BotRepositoryMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mBotRepositoryMockGet) Optional() *mBotRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for BotRepository.Get
+func (mmGet *mBotRepositoryMockGet) Expect(ctx context.Context, id int64) *mBotRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("BotRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &BotRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("BotRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &BotRepositoryMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotRepository.Get
+func (mmGet *mBotRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mBotRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("BotRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &BotRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("BotRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &BotRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for BotRepository.Get
+func (mmGet *mBotRepositoryMockGet) ExpectIdParam2(id int64) *mBotRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("BotRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &BotRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("BotRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &BotRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotRepository.Get
+func (mmGet *mBotRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mBotRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for BotRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by BotRepository.Get
+func (mmGet *mBotRepositoryMockGet) Return(bp1 *model.Bot, err error) *BotRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("BotRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &BotRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &BotRepositoryMockGetResults{bp1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the BotRepository.Get method
+func (mmGet *mBotRepositoryMockGet) Set(f func(ctx context.Context, id int64) (bp1 *model.Bot, err error)) *BotRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the BotRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the BotRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the BotRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mBotRepositoryMockGet) When(ctx context.Context, id int64) *BotRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("BotRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &BotRepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &BotRepositoryMockGetParams{ctx, id},
+		expectationOrigins: BotRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotRepository.Get return parameters for the expectation previously defined by the When method
+func (e *BotRepositoryMockGetExpectation) Then(bp1 *model.Bot, err error) *BotRepositoryMock {
+	e.results = &BotRepositoryMockGetResults{bp1, err}
+	return e.mock
+}
+
+// Times sets number of times BotRepository.Get should be invoked
+func (mmGet *mBotRepositoryMockGet) Times(n uint64) *mBotRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of BotRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mBotRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repository.BotRepository
+func (mmGet *BotRepositoryMock) Get(ctx context.Context, id int64) (bp1 *model.Bot, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := BotRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.bp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := BotRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("BotRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("BotRepositoryMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("BotRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the BotRepositoryMock.Get")
+		}
+		return (*mm_results).bp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to BotRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished BotRepositoryMock.Get invocations
+func (mmGet *BotRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of BotRepositoryMock.Get invocations
+func (mmGet *BotRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mBotRepositoryMockGet) Calls() []*BotRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*BotRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *BotRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *BotRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BotRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BotRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to BotRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotRepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mBotRepositoryMockGetByKey struct {
+	optional           bool
+	mock               *BotRepositoryMock
+	defaultExpectation *BotRepositoryMockGetByKeyExpectation
+	expectations       []*BotRepositoryMockGetByKeyExpectation
+
+	callArgs []*BotRepositoryMockGetByKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BotRepositoryMockGetByKeyExpectation specifies expectation struct of the BotRepository.GetByKey
+type BotRepositoryMockGetByKeyExpectation struct {
+	mock               *BotRepositoryMock
+	params             *BotRepositoryMockGetByKeyParams
+	paramPtrs          *BotRepositoryMockGetByKeyParamPtrs
+	expectationOrigins BotRepositoryMockGetByKeyExpectationOrigins
+	results            *BotRepositoryMockGetByKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BotRepositoryMockGetByKeyParams contains parameters of the BotRepository.GetByKey
+type BotRepositoryMockGetByKeyParams struct {
+	ctx     context.Context
+	keyHash string
+}
+
+// BotRepositoryMockGetByKeyParamPtrs contains pointers to parameters of the BotRepository.GetByKey
+type BotRepositoryMockGetByKeyParamPtrs struct {
+	ctx     *context.Context
+	keyHash *string
+}
+
+// BotRepositoryMockGetByKeyResults contains results of the BotRepository.GetByKey
+type BotRepositoryMockGetByKeyResults struct {
+	bp1 *model.Bot
+	err error
+}
+
+// BotRepositoryMockGetByKeyOrigins contains origins of expectations of the BotRepository.GetByKey
+type BotRepositoryMockGetByKeyExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originKeyHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetByKey *mBotRepositoryMockGetByKey) Optional() *mBotRepositoryMockGetByKey {
+	mmGetByKey.optional = true
+	return mmGetByKey
+}
+
+// Expect sets up expected params for BotRepository.GetByKey
+func (mmGetByKey *mBotRepositoryMockGetByKey) Expect(ctx context.Context, keyHash string) *mBotRepositoryMockGetByKey {
+	if mmGetByKey.mock.funcGetByKey != nil {
+		mmGetByKey.mock.t.Fatalf("BotRepositoryMock.GetByKey mock is already set by Set")
+	}
+
+	if mmGetByKey.defaultExpectation == nil {
+		mmGetByKey.defaultExpectation = &BotRepositoryMockGetByKeyExpectation{}
+	}
+
+	if mmGetByKey.defaultExpectation.paramPtrs != nil {
+		mmGetByKey.mock.t.Fatalf("BotRepositoryMock.GetByKey mock is already set by ExpectParams functions")
+	}
+
+	mmGetByKey.defaultExpectation.params = &BotRepositoryMockGetByKeyParams{ctx, keyHash}
+	mmGetByKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetByKey.expectations {
+		if minimock.Equal(e.params, mmGetByKey.defaultExpectation.params) {
+			mmGetByKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetByKey.defaultExpectation.params)
+		}
+	}
+
+	return mmGetByKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotRepository.GetByKey
+func (mmGetByKey *mBotRepositoryMockGetByKey) ExpectCtxParam1(ctx context.Context) *mBotRepositoryMockGetByKey {
+	if mmGetByKey.mock.funcGetByKey != nil {
+		mmGetByKey.mock.t.Fatalf("BotRepositoryMock.GetByKey mock is already set by Set")
+	}
+
+	if mmGetByKey.defaultExpectation == nil {
+		mmGetByKey.defaultExpectation = &BotRepositoryMockGetByKeyExpectation{}
+	}
+
+	if mmGetByKey.defaultExpectation.params != nil {
+		mmGetByKey.mock.t.Fatalf("BotRepositoryMock.GetByKey mock is already set by Expect")
+	}
+
+	if mmGetByKey.defaultExpectation.paramPtrs == nil {
+		mmGetByKey.defaultExpectation.paramPtrs = &BotRepositoryMockGetByKeyParamPtrs{}
+	}
+	mmGetByKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetByKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetByKey
+}
+
+// ExpectKeyHashParam2 sets up expected param keyHash for BotRepository.GetByKey
+func (mmGetByKey *mBotRepositoryMockGetByKey) ExpectKeyHashParam2(keyHash string) *mBotRepositoryMockGetByKey {
+	if mmGetByKey.mock.funcGetByKey != nil {
+		mmGetByKey.mock.t.Fatalf("BotRepositoryMock.GetByKey mock is already set by Set")
+	}
+
+	if mmGetByKey.defaultExpectation == nil {
+		mmGetByKey.defaultExpectation = &BotRepositoryMockGetByKeyExpectation{}
+	}
+
+	if mmGetByKey.defaultExpectation.params != nil {
+		mmGetByKey.mock.t.Fatalf("BotRepositoryMock.GetByKey mock is already set by Expect")
+	}
+
+	if mmGetByKey.defaultExpectation.paramPtrs == nil {
+		mmGetByKey.defaultExpectation.paramPtrs = &BotRepositoryMockGetByKeyParamPtrs{}
+	}
+	mmGetByKey.defaultExpectation.paramPtrs.keyHash = &keyHash
+	mmGetByKey.defaultExpectation.expectationOrigins.originKeyHash = minimock.CallerInfo(1)
+
+	return mmGetByKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotRepository.GetByKey
+func (mmGetByKey *mBotRepositoryMockGetByKey) Inspect(f func(ctx context.Context, keyHash string)) *mBotRepositoryMockGetByKey {
+	if mmGetByKey.mock.inspectFuncGetByKey != nil {
+		mmGetByKey.mock.t.Fatalf("Inspect function is already set for BotRepositoryMock.GetByKey")
+	}
+
+	mmGetByKey.mock.inspectFuncGetByKey = f
+
+	return mmGetByKey
+}
+
+// Return sets up results that will be returned by BotRepository.GetByKey
+func (mmGetByKey *mBotRepositoryMockGetByKey) Return(bp1 *model.Bot, err error) *BotRepositoryMock {
+	if mmGetByKey.mock.funcGetByKey != nil {
+		mmGetByKey.mock.t.Fatalf("BotRepositoryMock.GetByKey mock is already set by Set")
+	}
+
+	if mmGetByKey.defaultExpectation == nil {
+		mmGetByKey.defaultExpectation = &BotRepositoryMockGetByKeyExpectation{mock: mmGetByKey.mock}
+	}
+	mmGetByKey.defaultExpectation.results = &BotRepositoryMockGetByKeyResults{bp1, err}
+	mmGetByKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetByKey.mock
+}
+
+// Set uses given function f to mock the BotRepository.GetByKey method
+func (mmGetByKey *mBotRepositoryMockGetByKey) Set(f func(ctx context.Context, keyHash string) (bp1 *model.Bot, err error)) *BotRepositoryMock {
+	if mmGetByKey.defaultExpectation != nil {
+		mmGetByKey.mock.t.Fatalf("Default expectation is already set for the BotRepository.GetByKey method")
+	}
+
+	if len(mmGetByKey.expectations) > 0 {
+		mmGetByKey.mock.t.Fatalf("Some expectations are already set for the BotRepository.GetByKey method")
+	}
+
+	mmGetByKey.mock.funcGetByKey = f
+	mmGetByKey.mock.funcGetByKeyOrigin = minimock.CallerInfo(1)
+	return mmGetByKey.mock
+}
+
+// When sets expectation for the BotRepository.GetByKey which will trigger the result defined by the following
+// Then helper
+func (mmGetByKey *mBotRepositoryMockGetByKey) When(ctx context.Context, keyHash string) *BotRepositoryMockGetByKeyExpectation {
+	if mmGetByKey.mock.funcGetByKey != nil {
+		mmGetByKey.mock.t.Fatalf("BotRepositoryMock.GetByKey mock is already set by Set")
+	}
+
+	expectation := &BotRepositoryMockGetByKeyExpectation{
+		mock:               mmGetByKey.mock,
+		params:             &BotRepositoryMockGetByKeyParams{ctx, keyHash},
+		expectationOrigins: BotRepositoryMockGetByKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetByKey.expectations = append(mmGetByKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotRepository.GetByKey return parameters for the expectation previously defined by the When method
+func (e *BotRepositoryMockGetByKeyExpectation) Then(bp1 *model.Bot, err error) *BotRepositoryMock {
+	e.results = &BotRepositoryMockGetByKeyResults{bp1, err}
+	return e.mock
+}
+
+// Times sets number of times BotRepository.GetByKey should be invoked
+func (mmGetByKey *mBotRepositoryMockGetByKey) Times(n uint64) *mBotRepositoryMockGetByKey {
+	if n == 0 {
+		mmGetByKey.mock.t.Fatalf("Times of BotRepositoryMock.GetByKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetByKey.expectedInvocations, n)
+	mmGetByKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetByKey
+}
+
+func (mmGetByKey *mBotRepositoryMockGetByKey) invocationsDone() bool {
+	if len(mmGetByKey.expectations) == 0 && mmGetByKey.defaultExpectation == nil && mmGetByKey.mock.funcGetByKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetByKey.mock.afterGetByKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetByKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetByKey implements mm_repository.BotRepository
+func (mmGetByKey *BotRepositoryMock) GetByKey(ctx context.Context, keyHash string) (bp1 *model.Bot, err error) {
+	mm_atomic.AddUint64(&mmGetByKey.beforeGetByKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetByKey.afterGetByKeyCounter, 1)
+
+	mmGetByKey.t.Helper()
+
+	if mmGetByKey.inspectFuncGetByKey != nil {
+		mmGetByKey.inspectFuncGetByKey(ctx, keyHash)
+	}
+
+	mm_params := BotRepositoryMockGetByKeyParams{ctx, keyHash}
+
+	// Record call args
+	mmGetByKey.GetByKeyMock.mutex.Lock()
+	mmGetByKey.GetByKeyMock.callArgs = append(mmGetByKey.GetByKeyMock.callArgs, &mm_params)
+	mmGetByKey.GetByKeyMock.mutex.Unlock()
+
+	for _, e := range mmGetByKey.GetByKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.bp1, e.results.err
+		}
+	}
+
+	if mmGetByKey.GetByKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetByKey.GetByKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetByKey.GetByKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetByKey.GetByKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := BotRepositoryMockGetByKeyParams{ctx, keyHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetByKey.t.Errorf("BotRepositoryMock.GetByKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetByKey.GetByKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.keyHash != nil && !minimock.Equal(*mm_want_ptrs.keyHash, mm_got.keyHash) {
+				mmGetByKey.t.Errorf("BotRepositoryMock.GetByKey got unexpected parameter keyHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetByKey.GetByKeyMock.defaultExpectation.expectationOrigins.originKeyHash, *mm_want_ptrs.keyHash, mm_got.keyHash, minimock.Diff(*mm_want_ptrs.keyHash, mm_got.keyHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetByKey.t.Errorf("BotRepositoryMock.GetByKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetByKey.GetByKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetByKey.GetByKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetByKey.t.Fatal("No results are set for the BotRepositoryMock.GetByKey")
+		}
+		return (*mm_results).bp1, (*mm_results).err
+	}
+	if mmGetByKey.funcGetByKey != nil {
+		return mmGetByKey.funcGetByKey(ctx, keyHash)
+	}
+	mmGetByKey.t.Fatalf("Unexpected call to BotRepositoryMock.GetByKey. %v %v", ctx, keyHash)
+	return
+}
+
+// GetByKeyAfterCounter returns a count of finished BotRepositoryMock.GetByKey invocations
+func (mmGetByKey *BotRepositoryMock) GetByKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByKey.afterGetByKeyCounter)
+}
+
+// GetByKeyBeforeCounter returns a count of BotRepositoryMock.GetByKey invocations
+func (mmGetByKey *BotRepositoryMock) GetByKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByKey.beforeGetByKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotRepositoryMock.GetByKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetByKey *mBotRepositoryMockGetByKey) Calls() []*BotRepositoryMockGetByKeyParams {
+	mmGetByKey.mutex.RLock()
+
+	argCopy := make([]*BotRepositoryMockGetByKeyParams, len(mmGetByKey.callArgs))
+	copy(argCopy, mmGetByKey.callArgs)
+
+	mmGetByKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetByKeyDone returns true if the count of the GetByKey invocations corresponds
+// the number of defined expectations
+func (m *BotRepositoryMock) MinimockGetByKeyDone() bool {
+	if m.GetByKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetByKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetByKeyMock.invocationsDone()
+}
+
+// MinimockGetByKeyInspect logs each unmet expectation
+func (m *BotRepositoryMock) MinimockGetByKeyInspect() {
+	for _, e := range m.GetByKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotRepositoryMock.GetByKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetByKeyCounter := mm_atomic.LoadUint64(&m.afterGetByKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetByKeyMock.defaultExpectation != nil && afterGetByKeyCounter < 1 {
+		if m.GetByKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BotRepositoryMock.GetByKey at\n%s", m.GetByKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BotRepositoryMock.GetByKey at\n%s with params: %#v", m.GetByKeyMock.defaultExpectation.expectationOrigins.origin, *m.GetByKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetByKey != nil && afterGetByKeyCounter < 1 {
+		m.t.Errorf("Expected call to BotRepositoryMock.GetByKey at\n%s", m.funcGetByKeyOrigin)
+	}
+
+	if !m.GetByKeyMock.invocationsDone() && afterGetByKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotRepositoryMock.GetByKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetByKeyMock.expectedInvocations), m.GetByKeyMock.expectedInvocationsOrigin, afterGetByKeyCounter)
+	}
+}
+
+type mBotRepositoryMockRevokeKeys struct {
+	optional           bool
+	mock               *BotRepositoryMock
+	defaultExpectation *BotRepositoryMockRevokeKeysExpectation
+	expectations       []*BotRepositoryMockRevokeKeysExpectation
+
+	callArgs []*BotRepositoryMockRevokeKeysParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BotRepositoryMockRevokeKeysExpectation specifies expectation struct of the BotRepository.RevokeKeys
+type BotRepositoryMockRevokeKeysExpectation struct {
+	mock               *BotRepositoryMock
+	params             *BotRepositoryMockRevokeKeysParams
+	paramPtrs          *BotRepositoryMockRevokeKeysParamPtrs
+	expectationOrigins BotRepositoryMockRevokeKeysExpectationOrigins
+	results            *BotRepositoryMockRevokeKeysResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BotRepositoryMockRevokeKeysParams contains parameters of the BotRepository.RevokeKeys
+type BotRepositoryMockRevokeKeysParams struct {
+	ctx   context.Context
+	botID int64
+}
+
+// BotRepositoryMockRevokeKeysParamPtrs contains pointers to parameters of the BotRepository.RevokeKeys
+type BotRepositoryMockRevokeKeysParamPtrs struct {
+	ctx   *context.Context
+	botID *int64
+}
+
+// BotRepositoryMockRevokeKeysResults contains results of the BotRepository.RevokeKeys
+type BotRepositoryMockRevokeKeysResults struct {
+	err error
+}
+
+// BotRepositoryMockRevokeKeysOrigins contains origins of expectations of the BotRepository.RevokeKeys
+type BotRepositoryMockRevokeKeysExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originBotID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeKeys *mBotRepositoryMockRevokeKeys) Optional() *mBotRepositoryMockRevokeKeys {
+	mmRevokeKeys.optional = true
+	return mmRevokeKeys
+}
+
+// Expect sets up expected params for BotRepository.RevokeKeys
+func (mmRevokeKeys *mBotRepositoryMockRevokeKeys) Expect(ctx context.Context, botID int64) *mBotRepositoryMockRevokeKeys {
+	if mmRevokeKeys.mock.funcRevokeKeys != nil {
+		mmRevokeKeys.mock.t.Fatalf("BotRepositoryMock.RevokeKeys mock is already set by Set")
+	}
+
+	if mmRevokeKeys.defaultExpectation == nil {
+		mmRevokeKeys.defaultExpectation = &BotRepositoryMockRevokeKeysExpectation{}
+	}
+
+	if mmRevokeKeys.defaultExpectation.paramPtrs != nil {
+		mmRevokeKeys.mock.t.Fatalf("BotRepositoryMock.RevokeKeys mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeKeys.defaultExpectation.params = &BotRepositoryMockRevokeKeysParams{ctx, botID}
+	mmRevokeKeys.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeKeys.expectations {
+		if minimock.Equal(e.params, mmRevokeKeys.defaultExpectation.params) {
+			mmRevokeKeys.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeKeys.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeKeys
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotRepository.RevokeKeys
+func (mmRevokeKeys *mBotRepositoryMockRevokeKeys) ExpectCtxParam1(ctx context.Context) *mBotRepositoryMockRevokeKeys {
+	if mmRevokeKeys.mock.funcRevokeKeys != nil {
+		mmRevokeKeys.mock.t.Fatalf("BotRepositoryMock.RevokeKeys mock is already set by Set")
+	}
+
+	if mmRevokeKeys.defaultExpectation == nil {
+		mmRevokeKeys.defaultExpectation = &BotRepositoryMockRevokeKeysExpectation{}
+	}
+
+	if mmRevokeKeys.defaultExpectation.params != nil {
+		mmRevokeKeys.mock.t.Fatalf("BotRepositoryMock.RevokeKeys mock is already set by Expect")
+	}
+
+	if mmRevokeKeys.defaultExpectation.paramPtrs == nil {
+		mmRevokeKeys.defaultExpectation.paramPtrs = &BotRepositoryMockRevokeKeysParamPtrs{}
+	}
+	mmRevokeKeys.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeKeys.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeKeys
+}
+
+// ExpectBotIDParam2 sets up expected param botID for BotRepository.RevokeKeys
+func (mmRevokeKeys *mBotRepositoryMockRevokeKeys) ExpectBotIDParam2(botID int64) *mBotRepositoryMockRevokeKeys {
+	if mmRevokeKeys.mock.funcRevokeKeys != nil {
+		mmRevokeKeys.mock.t.Fatalf("BotRepositoryMock.RevokeKeys mock is already set by Set")
+	}
+
+	if mmRevokeKeys.defaultExpectation == nil {
+		mmRevokeKeys.defaultExpectation = &BotRepositoryMockRevokeKeysExpectation{}
+	}
+
+	if mmRevokeKeys.defaultExpectation.params != nil {
+		mmRevokeKeys.mock.t.Fatalf("BotRepositoryMock.RevokeKeys mock is already set by Expect")
+	}
+
+	if mmRevokeKeys.defaultExpectation.paramPtrs == nil {
+		mmRevokeKeys.defaultExpectation.paramPtrs = &BotRepositoryMockRevokeKeysParamPtrs{}
+	}
+	mmRevokeKeys.defaultExpectation.paramPtrs.botID = &botID
+	mmRevokeKeys.defaultExpectation.expectationOrigins.originBotID = minimock.CallerInfo(1)
+
+	return mmRevokeKeys
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotRepository.RevokeKeys
+func (mmRevokeKeys *mBotRepositoryMockRevokeKeys) Inspect(f func(ctx context.Context, botID int64)) *mBotRepositoryMockRevokeKeys {
+	if mmRevokeKeys.mock.inspectFuncRevokeKeys != nil {
+		mmRevokeKeys.mock.t.Fatalf("Inspect function is already set for BotRepositoryMock.RevokeKeys")
+	}
+
+	mmRevokeKeys.mock.inspectFuncRevokeKeys = f
+
+	return mmRevokeKeys
+}
+
+// Return sets up results that will be returned by BotRepository.RevokeKeys
+func (mmRevokeKeys *mBotRepositoryMockRevokeKeys) Return(err error) *BotRepositoryMock {
+	if mmRevokeKeys.mock.funcRevokeKeys != nil {
+		mmRevokeKeys.mock.t.Fatalf("BotRepositoryMock.RevokeKeys mock is already set by Set")
+	}
+
+	if mmRevokeKeys.defaultExpectation == nil {
+		mmRevokeKeys.defaultExpectation = &BotRepositoryMockRevokeKeysExpectation{mock: mmRevokeKeys.mock}
+	}
+	mmRevokeKeys.defaultExpectation.results = &BotRepositoryMockRevokeKeysResults{err}
+	mmRevokeKeys.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeKeys.mock
+}
+
+// Set uses given function f to mock the BotRepository.RevokeKeys method
+func (mmRevokeKeys *mBotRepositoryMockRevokeKeys) Set(f func(ctx context.Context, botID int64) (err error)) *BotRepositoryMock {
+	if mmRevokeKeys.defaultExpectation != nil {
+		mmRevokeKeys.mock.t.Fatalf("Default expectation is already set for the BotRepository.RevokeKeys method")
+	}
+
+	if len(mmRevokeKeys.expectations) > 0 {
+		mmRevokeKeys.mock.t.Fatalf("Some expectations are already set for the BotRepository.RevokeKeys method")
+	}
+
+	mmRevokeKeys.mock.funcRevokeKeys = f
+	mmRevokeKeys.mock.funcRevokeKeysOrigin = minimock.CallerInfo(1)
+	return mmRevokeKeys.mock
+}
+
+// When sets expectation for the BotRepository.RevokeKeys which will trigger the result defined by the following
+// Then helper
+func (mmRevokeKeys *mBotRepositoryMockRevokeKeys) When(ctx context.Context, botID int64) *BotRepositoryMockRevokeKeysExpectation {
+	if mmRevokeKeys.mock.funcRevokeKeys != nil {
+		mmRevokeKeys.mock.t.Fatalf("BotRepositoryMock.RevokeKeys mock is already set by Set")
+	}
+
+	expectation := &BotRepositoryMockRevokeKeysExpectation{
+		mock:               mmRevokeKeys.mock,
+		params:             &BotRepositoryMockRevokeKeysParams{ctx, botID},
+		expectationOrigins: BotRepositoryMockRevokeKeysExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeKeys.expectations = append(mmRevokeKeys.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotRepository.RevokeKeys return parameters for the expectation previously defined by the When method
+func (e *BotRepositoryMockRevokeKeysExpectation) Then(err error) *BotRepositoryMock {
+	e.results = &BotRepositoryMockRevokeKeysResults{err}
+	return e.mock
+}
+
+// Times sets number of times BotRepository.RevokeKeys should be invoked
+func (mmRevokeKeys *mBotRepositoryMockRevokeKeys) Times(n uint64) *mBotRepositoryMockRevokeKeys {
+	if n == 0 {
+		mmRevokeKeys.mock.t.Fatalf("Times of BotRepositoryMock.RevokeKeys mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeKeys.expectedInvocations, n)
+	mmRevokeKeys.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeKeys
+}
+
+func (mmRevokeKeys *mBotRepositoryMockRevokeKeys) invocationsDone() bool {
+	if len(mmRevokeKeys.expectations) == 0 && mmRevokeKeys.defaultExpectation == nil && mmRevokeKeys.mock.funcRevokeKeys == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeKeys.mock.afterRevokeKeysCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeKeys.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeKeys implements mm_repository.BotRepository
+func (mmRevokeKeys *BotRepositoryMock) RevokeKeys(ctx context.Context, botID int64) (err error) {
+	mm_atomic.AddUint64(&mmRevokeKeys.beforeRevokeKeysCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeKeys.afterRevokeKeysCounter, 1)
+
+	mmRevokeKeys.t.Helper()
+
+	if mmRevokeKeys.inspectFuncRevokeKeys != nil {
+		mmRevokeKeys.inspectFuncRevokeKeys(ctx, botID)
+	}
+
+	mm_params := BotRepositoryMockRevokeKeysParams{ctx, botID}
+
+	// Record call args
+	mmRevokeKeys.RevokeKeysMock.mutex.Lock()
+	mmRevokeKeys.RevokeKeysMock.callArgs = append(mmRevokeKeys.RevokeKeysMock.callArgs, &mm_params)
+	mmRevokeKeys.RevokeKeysMock.mutex.Unlock()
+
+	for _, e := range mmRevokeKeys.RevokeKeysMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeKeys.RevokeKeysMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeKeys.RevokeKeysMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeKeys.RevokeKeysMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeKeys.RevokeKeysMock.defaultExpectation.paramPtrs
+
+		mm_got := BotRepositoryMockRevokeKeysParams{ctx, botID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeKeys.t.Errorf("BotRepositoryMock.RevokeKeys got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeKeys.RevokeKeysMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.botID != nil && !minimock.Equal(*mm_want_ptrs.botID, mm_got.botID) {
+				mmRevokeKeys.t.Errorf("BotRepositoryMock.RevokeKeys got unexpected parameter botID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeKeys.RevokeKeysMock.defaultExpectation.expectationOrigins.originBotID, *mm_want_ptrs.botID, mm_got.botID, minimock.Diff(*mm_want_ptrs.botID, mm_got.botID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeKeys.t.Errorf("BotRepositoryMock.RevokeKeys got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeKeys.RevokeKeysMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeKeys.RevokeKeysMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeKeys.t.Fatal("No results are set for the BotRepositoryMock.RevokeKeys")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeKeys.funcRevokeKeys != nil {
+		return mmRevokeKeys.funcRevokeKeys(ctx, botID)
+	}
+	mmRevokeKeys.t.Fatalf("Unexpected call to BotRepositoryMock.RevokeKeys. %v %v", ctx, botID)
+	return
+}
+
+// RevokeKeysAfterCounter returns a count of finished BotRepositoryMock.RevokeKeys invocations
+func (mmRevokeKeys *BotRepositoryMock) RevokeKeysAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeKeys.afterRevokeKeysCounter)
+}
+
+// RevokeKeysBeforeCounter returns a count of BotRepositoryMock.RevokeKeys invocations
+func (mmRevokeKeys *BotRepositoryMock) RevokeKeysBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeKeys.beforeRevokeKeysCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotRepositoryMock.RevokeKeys.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeKeys *mBotRepositoryMockRevokeKeys) Calls() []*BotRepositoryMockRevokeKeysParams {
+	mmRevokeKeys.mutex.RLock()
+
+	argCopy := make([]*BotRepositoryMockRevokeKeysParams, len(mmRevokeKeys.callArgs))
+	copy(argCopy, mmRevokeKeys.callArgs)
+
+	mmRevokeKeys.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeKeysDone returns true if the count of the RevokeKeys invocations corresponds
+// the number of defined expectations
+func (m *BotRepositoryMock) MinimockRevokeKeysDone() bool {
+	if m.RevokeKeysMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeKeysMock.invocationsDone()
+}
+
+// MinimockRevokeKeysInspect logs each unmet expectation
+func (m *BotRepositoryMock) MinimockRevokeKeysInspect() {
+	for _, e := range m.RevokeKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotRepositoryMock.RevokeKeys at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeKeysCounter := mm_atomic.LoadUint64(&m.afterRevokeKeysCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeKeysMock.defaultExpectation != nil && afterRevokeKeysCounter < 1 {
+		if m.RevokeKeysMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BotRepositoryMock.RevokeKeys at\n%s", m.RevokeKeysMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BotRepositoryMock.RevokeKeys at\n%s with params: %#v", m.RevokeKeysMock.defaultExpectation.expectationOrigins.origin, *m.RevokeKeysMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeKeys != nil && afterRevokeKeysCounter < 1 {
+		m.t.Errorf("Expected call to BotRepositoryMock.RevokeKeys at\n%s", m.funcRevokeKeysOrigin)
+	}
+
+	if !m.RevokeKeysMock.invocationsDone() && afterRevokeKeysCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotRepositoryMock.RevokeKeys at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeKeysMock.expectedInvocations), m.RevokeKeysMock.expectedInvocationsOrigin, afterRevokeKeysCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *BotRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockCreateKeyInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockGetByKeyInspect()
+
+			m.MinimockRevokeKeysInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *BotRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *BotRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockCreateKeyDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockGetByKeyDone() &&
+		m.MinimockRevokeKeysDone()
+}
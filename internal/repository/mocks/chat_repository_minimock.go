@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/Mobo140/chat/internal/model"
@@ -19,6 +20,20 @@ type ChatRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAddUser          func(ctx context.Context, id int64, username string) (b1 bool, err error)
+	funcAddUserOrigin    string
+	inspectFuncAddUser   func(ctx context.Context, id int64, username string)
+	afterAddUserCounter  uint64
+	beforeAddUserCounter uint64
+	AddUserMock          mChatRepositoryMockAddUser
+
+	funcCountDeleted          func(ctx context.Context, grace time.Duration) (i1 int64, err error)
+	funcCountDeletedOrigin    string
+	inspectFuncCountDeleted   func(ctx context.Context, grace time.Duration)
+	afterCountDeletedCounter  uint64
+	beforeCountDeletedCounter uint64
+	CountDeletedMock          mChatRepositoryMockCountDeleted
+
 	funcCreate          func(ctx context.Context, chat *model.ChatInfo) (i1 int64, err error)
 	funcCreateOrigin    string
 	inspectFuncCreate   func(ctx context.Context, chat *model.ChatInfo)
@@ -39,6 +54,69 @@ type ChatRepositoryMock struct {
 	afterGetCounter  uint64
 	beforeGetCounter uint64
 	GetMock          mChatRepositoryMockGet
+
+	funcIsMuted          func(ctx context.Context, id int64, username string) (b1 bool, err error)
+	funcIsMutedOrigin    string
+	inspectFuncIsMuted   func(ctx context.Context, id int64, username string)
+	afterIsMutedCounter  uint64
+	beforeIsMutedCounter uint64
+	IsMutedMock          mChatRepositoryMockIsMuted
+
+	funcListRetention          func(ctx context.Context, afterID int64, limit uint64) (cpa1 []*model.ChatRetention, err error)
+	funcListRetentionOrigin    string
+	inspectFuncListRetention   func(ctx context.Context, afterID int64, limit uint64)
+	afterListRetentionCounter  uint64
+	beforeListRetentionCounter uint64
+	ListRetentionMock          mChatRepositoryMockListRetention
+
+	funcMute          func(ctx context.Context, id int64, username string, duration time.Duration) (err error)
+	funcMuteOrigin    string
+	inspectFuncMute   func(ctx context.Context, id int64, username string, duration time.Duration)
+	afterMuteCounter  uint64
+	beforeMuteCounter uint64
+	MuteMock          mChatRepositoryMockMute
+
+	funcPurgeDeleted          func(ctx context.Context, grace time.Duration) (i1 int64, err error)
+	funcPurgeDeletedOrigin    string
+	inspectFuncPurgeDeleted   func(ctx context.Context, grace time.Duration)
+	afterPurgeDeletedCounter  uint64
+	beforePurgeDeletedCounter uint64
+	PurgeDeletedMock          mChatRepositoryMockPurgeDeleted
+
+	funcRestore          func(ctx context.Context, id int64) (err error)
+	funcRestoreOrigin    string
+	inspectFuncRestore   func(ctx context.Context, id int64)
+	afterRestoreCounter  uint64
+	beforeRestoreCounter uint64
+	RestoreMock          mChatRepositoryMockRestore
+
+	funcSetArchived          func(ctx context.Context, id int64, archived bool) (err error)
+	funcSetArchivedOrigin    string
+	inspectFuncSetArchived   func(ctx context.Context, id int64, archived bool)
+	afterSetArchivedCounter  uint64
+	beforeSetArchivedCounter uint64
+	SetArchivedMock          mChatRepositoryMockSetArchived
+
+	funcSetRetention          func(ctx context.Context, retention *model.ChatRetention) (err error)
+	funcSetRetentionOrigin    string
+	inspectFuncSetRetention   func(ctx context.Context, retention *model.ChatRetention)
+	afterSetRetentionCounter  uint64
+	beforeSetRetentionCounter uint64
+	SetRetentionMock          mChatRepositoryMockSetRetention
+
+	funcSetTopic          func(ctx context.Context, id int64, topic string) (err error)
+	funcSetTopicOrigin    string
+	inspectFuncSetTopic   func(ctx context.Context, id int64, topic string)
+	afterSetTopicCounter  uint64
+	beforeSetTopicCounter uint64
+	SetTopicMock          mChatRepositoryMockSetTopic
+
+	funcUnmute          func(ctx context.Context, id int64, username string) (err error)
+	funcUnmuteOrigin    string
+	inspectFuncUnmute   func(ctx context.Context, id int64, username string)
+	afterUnmuteCounter  uint64
+	beforeUnmuteCounter uint64
+	UnmuteMock          mChatRepositoryMockUnmute
 }
 
 // NewChatRepositoryMock returns a mock for mm_repository.ChatRepository
@@ -49,6 +127,12 @@ func NewChatRepositoryMock(t minimock.Tester) *ChatRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddUserMock = mChatRepositoryMockAddUser{mock: m}
+	m.AddUserMock.callArgs = []*ChatRepositoryMockAddUserParams{}
+
+	m.CountDeletedMock = mChatRepositoryMockCountDeleted{mock: m}
+	m.CountDeletedMock.callArgs = []*ChatRepositoryMockCountDeletedParams{}
+
 	m.CreateMock = mChatRepositoryMockCreate{mock: m}
 	m.CreateMock.callArgs = []*ChatRepositoryMockCreateParams{}
 
@@ -58,58 +142,88 @@ func NewChatRepositoryMock(t minimock.Tester) *ChatRepositoryMock {
 	m.GetMock = mChatRepositoryMockGet{mock: m}
 	m.GetMock.callArgs = []*ChatRepositoryMockGetParams{}
 
+	m.IsMutedMock = mChatRepositoryMockIsMuted{mock: m}
+	m.IsMutedMock.callArgs = []*ChatRepositoryMockIsMutedParams{}
+
+	m.ListRetentionMock = mChatRepositoryMockListRetention{mock: m}
+	m.ListRetentionMock.callArgs = []*ChatRepositoryMockListRetentionParams{}
+
+	m.MuteMock = mChatRepositoryMockMute{mock: m}
+	m.MuteMock.callArgs = []*ChatRepositoryMockMuteParams{}
+
+	m.PurgeDeletedMock = mChatRepositoryMockPurgeDeleted{mock: m}
+	m.PurgeDeletedMock.callArgs = []*ChatRepositoryMockPurgeDeletedParams{}
+
+	m.RestoreMock = mChatRepositoryMockRestore{mock: m}
+	m.RestoreMock.callArgs = []*ChatRepositoryMockRestoreParams{}
+
+	m.SetArchivedMock = mChatRepositoryMockSetArchived{mock: m}
+	m.SetArchivedMock.callArgs = []*ChatRepositoryMockSetArchivedParams{}
+
+	m.SetRetentionMock = mChatRepositoryMockSetRetention{mock: m}
+	m.SetRetentionMock.callArgs = []*ChatRepositoryMockSetRetentionParams{}
+
+	m.SetTopicMock = mChatRepositoryMockSetTopic{mock: m}
+	m.SetTopicMock.callArgs = []*ChatRepositoryMockSetTopicParams{}
+
+	m.UnmuteMock = mChatRepositoryMockUnmute{mock: m}
+	m.UnmuteMock.callArgs = []*ChatRepositoryMockUnmuteParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mChatRepositoryMockCreate struct {
+type mChatRepositoryMockAddUser struct {
 	optional           bool
 	mock               *ChatRepositoryMock
-	defaultExpectation *ChatRepositoryMockCreateExpectation
-	expectations       []*ChatRepositoryMockCreateExpectation
+	defaultExpectation *ChatRepositoryMockAddUserExpectation
+	expectations       []*ChatRepositoryMockAddUserExpectation
 
-	callArgs []*ChatRepositoryMockCreateParams
+	callArgs []*ChatRepositoryMockAddUserParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatRepositoryMockCreateExpectation specifies expectation struct of the ChatRepository.Create
-type ChatRepositoryMockCreateExpectation struct {
+// ChatRepositoryMockAddUserExpectation specifies expectation struct of the ChatRepository.AddUser
+type ChatRepositoryMockAddUserExpectation struct {
 	mock               *ChatRepositoryMock
-	params             *ChatRepositoryMockCreateParams
-	paramPtrs          *ChatRepositoryMockCreateParamPtrs
-	expectationOrigins ChatRepositoryMockCreateExpectationOrigins
-	results            *ChatRepositoryMockCreateResults
+	params             *ChatRepositoryMockAddUserParams
+	paramPtrs          *ChatRepositoryMockAddUserParamPtrs
+	expectationOrigins ChatRepositoryMockAddUserExpectationOrigins
+	results            *ChatRepositoryMockAddUserResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatRepositoryMockCreateParams contains parameters of the ChatRepository.Create
-type ChatRepositoryMockCreateParams struct {
-	ctx  context.Context
-	chat *model.ChatInfo
+// ChatRepositoryMockAddUserParams contains parameters of the ChatRepository.AddUser
+type ChatRepositoryMockAddUserParams struct {
+	ctx      context.Context
+	id       int64
+	username string
 }
 
-// ChatRepositoryMockCreateParamPtrs contains pointers to parameters of the ChatRepository.Create
-type ChatRepositoryMockCreateParamPtrs struct {
-	ctx  *context.Context
-	chat **model.ChatInfo
+// ChatRepositoryMockAddUserParamPtrs contains pointers to parameters of the ChatRepository.AddUser
+type ChatRepositoryMockAddUserParamPtrs struct {
+	ctx      *context.Context
+	id       *int64
+	username *string
 }
 
-// ChatRepositoryMockCreateResults contains results of the ChatRepository.Create
-type ChatRepositoryMockCreateResults struct {
-	i1  int64
+// ChatRepositoryMockAddUserResults contains results of the ChatRepository.AddUser
+type ChatRepositoryMockAddUserResults struct {
+	b1  bool
 	err error
 }
 
-// ChatRepositoryMockCreateOrigins contains origins of expectations of the ChatRepository.Create
-type ChatRepositoryMockCreateExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originChat string
+// ChatRepositoryMockAddUserOrigins contains origins of expectations of the ChatRepository.AddUser
+type ChatRepositoryMockAddUserExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originId       string
+	originUsername string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -117,341 +231,370 @@ type ChatRepositoryMockCreateExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreate *mChatRepositoryMockCreate) Optional() *mChatRepositoryMockCreate {
-	mmCreate.optional = true
-	return mmCreate
+func (mmAddUser *mChatRepositoryMockAddUser) Optional() *mChatRepositoryMockAddUser {
+	mmAddUser.optional = true
+	return mmAddUser
 }
 
-// Expect sets up expected params for ChatRepository.Create
-func (mmCreate *mChatRepositoryMockCreate) Expect(ctx context.Context, chat *model.ChatInfo) *mChatRepositoryMockCreate {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("ChatRepositoryMock.Create mock is already set by Set")
+// Expect sets up expected params for ChatRepository.AddUser
+func (mmAddUser *mChatRepositoryMockAddUser) Expect(ctx context.Context, id int64, username string) *mChatRepositoryMockAddUser {
+	if mmAddUser.mock.funcAddUser != nil {
+		mmAddUser.mock.t.Fatalf("ChatRepositoryMock.AddUser mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &ChatRepositoryMockCreateExpectation{}
+	if mmAddUser.defaultExpectation == nil {
+		mmAddUser.defaultExpectation = &ChatRepositoryMockAddUserExpectation{}
 	}
 
-	if mmCreate.defaultExpectation.paramPtrs != nil {
-		mmCreate.mock.t.Fatalf("ChatRepositoryMock.Create mock is already set by ExpectParams functions")
+	if mmAddUser.defaultExpectation.paramPtrs != nil {
+		mmAddUser.mock.t.Fatalf("ChatRepositoryMock.AddUser mock is already set by ExpectParams functions")
 	}
 
-	mmCreate.defaultExpectation.params = &ChatRepositoryMockCreateParams{ctx, chat}
-	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreate.expectations {
-		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
-			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+	mmAddUser.defaultExpectation.params = &ChatRepositoryMockAddUserParams{ctx, id, username}
+	mmAddUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddUser.expectations {
+		if minimock.Equal(e.params, mmAddUser.defaultExpectation.params) {
+			mmAddUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddUser.defaultExpectation.params)
 		}
 	}
 
-	return mmCreate
+	return mmAddUser
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatRepository.Create
-func (mmCreate *mChatRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockCreate {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("ChatRepositoryMock.Create mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.AddUser
+func (mmAddUser *mChatRepositoryMockAddUser) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockAddUser {
+	if mmAddUser.mock.funcAddUser != nil {
+		mmAddUser.mock.t.Fatalf("ChatRepositoryMock.AddUser mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &ChatRepositoryMockCreateExpectation{}
+	if mmAddUser.defaultExpectation == nil {
+		mmAddUser.defaultExpectation = &ChatRepositoryMockAddUserExpectation{}
 	}
 
-	if mmCreate.defaultExpectation.params != nil {
-		mmCreate.mock.t.Fatalf("ChatRepositoryMock.Create mock is already set by Expect")
+	if mmAddUser.defaultExpectation.params != nil {
+		mmAddUser.mock.t.Fatalf("ChatRepositoryMock.AddUser mock is already set by Expect")
 	}
 
-	if mmCreate.defaultExpectation.paramPtrs == nil {
-		mmCreate.defaultExpectation.paramPtrs = &ChatRepositoryMockCreateParamPtrs{}
+	if mmAddUser.defaultExpectation.paramPtrs == nil {
+		mmAddUser.defaultExpectation.paramPtrs = &ChatRepositoryMockAddUserParamPtrs{}
 	}
-	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreate
+	return mmAddUser
 }
 
-// ExpectChatParam2 sets up expected param chat for ChatRepository.Create
-func (mmCreate *mChatRepositoryMockCreate) ExpectChatParam2(chat *model.ChatInfo) *mChatRepositoryMockCreate {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("ChatRepositoryMock.Create mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for ChatRepository.AddUser
+func (mmAddUser *mChatRepositoryMockAddUser) ExpectIdParam2(id int64) *mChatRepositoryMockAddUser {
+	if mmAddUser.mock.funcAddUser != nil {
+		mmAddUser.mock.t.Fatalf("ChatRepositoryMock.AddUser mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &ChatRepositoryMockCreateExpectation{}
+	if mmAddUser.defaultExpectation == nil {
+		mmAddUser.defaultExpectation = &ChatRepositoryMockAddUserExpectation{}
 	}
 
-	if mmCreate.defaultExpectation.params != nil {
-		mmCreate.mock.t.Fatalf("ChatRepositoryMock.Create mock is already set by Expect")
+	if mmAddUser.defaultExpectation.params != nil {
+		mmAddUser.mock.t.Fatalf("ChatRepositoryMock.AddUser mock is already set by Expect")
 	}
 
-	if mmCreate.defaultExpectation.paramPtrs == nil {
-		mmCreate.defaultExpectation.paramPtrs = &ChatRepositoryMockCreateParamPtrs{}
+	if mmAddUser.defaultExpectation.paramPtrs == nil {
+		mmAddUser.defaultExpectation.paramPtrs = &ChatRepositoryMockAddUserParamPtrs{}
 	}
-	mmCreate.defaultExpectation.paramPtrs.chat = &chat
-	mmCreate.defaultExpectation.expectationOrigins.originChat = minimock.CallerInfo(1)
+	mmAddUser.defaultExpectation.paramPtrs.id = &id
+	mmAddUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmCreate
+	return mmAddUser
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatRepository.Create
-func (mmCreate *mChatRepositoryMockCreate) Inspect(f func(ctx context.Context, chat *model.ChatInfo)) *mChatRepositoryMockCreate {
-	if mmCreate.mock.inspectFuncCreate != nil {
-		mmCreate.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.Create")
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.AddUser
+func (mmAddUser *mChatRepositoryMockAddUser) ExpectUsernameParam3(username string) *mChatRepositoryMockAddUser {
+	if mmAddUser.mock.funcAddUser != nil {
+		mmAddUser.mock.t.Fatalf("ChatRepositoryMock.AddUser mock is already set by Set")
 	}
 
-	mmCreate.mock.inspectFuncCreate = f
+	if mmAddUser.defaultExpectation == nil {
+		mmAddUser.defaultExpectation = &ChatRepositoryMockAddUserExpectation{}
+	}
 
-	return mmCreate
+	if mmAddUser.defaultExpectation.params != nil {
+		mmAddUser.mock.t.Fatalf("ChatRepositoryMock.AddUser mock is already set by Expect")
+	}
+
+	if mmAddUser.defaultExpectation.paramPtrs == nil {
+		mmAddUser.defaultExpectation.paramPtrs = &ChatRepositoryMockAddUserParamPtrs{}
+	}
+	mmAddUser.defaultExpectation.paramPtrs.username = &username
+	mmAddUser.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmAddUser
 }
 
-// Return sets up results that will be returned by ChatRepository.Create
-func (mmCreate *mChatRepositoryMockCreate) Return(i1 int64, err error) *ChatRepositoryMock {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("ChatRepositoryMock.Create mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.AddUser
+func (mmAddUser *mChatRepositoryMockAddUser) Inspect(f func(ctx context.Context, id int64, username string)) *mChatRepositoryMockAddUser {
+	if mmAddUser.mock.inspectFuncAddUser != nil {
+		mmAddUser.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.AddUser")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &ChatRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	mmAddUser.mock.inspectFuncAddUser = f
+
+	return mmAddUser
+}
+
+// Return sets up results that will be returned by ChatRepository.AddUser
+func (mmAddUser *mChatRepositoryMockAddUser) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmAddUser.mock.funcAddUser != nil {
+		mmAddUser.mock.t.Fatalf("ChatRepositoryMock.AddUser mock is already set by Set")
 	}
-	mmCreate.defaultExpectation.results = &ChatRepositoryMockCreateResults{i1, err}
-	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreate.mock
+
+	if mmAddUser.defaultExpectation == nil {
+		mmAddUser.defaultExpectation = &ChatRepositoryMockAddUserExpectation{mock: mmAddUser.mock}
+	}
+	mmAddUser.defaultExpectation.results = &ChatRepositoryMockAddUserResults{b1, err}
+	mmAddUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddUser.mock
 }
 
-// Set uses given function f to mock the ChatRepository.Create method
-func (mmCreate *mChatRepositoryMockCreate) Set(f func(ctx context.Context, chat *model.ChatInfo) (i1 int64, err error)) *ChatRepositoryMock {
-	if mmCreate.defaultExpectation != nil {
-		mmCreate.mock.t.Fatalf("Default expectation is already set for the ChatRepository.Create method")
+// Set uses given function f to mock the ChatRepository.AddUser method
+func (mmAddUser *mChatRepositoryMockAddUser) Set(f func(ctx context.Context, id int64, username string) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmAddUser.defaultExpectation != nil {
+		mmAddUser.mock.t.Fatalf("Default expectation is already set for the ChatRepository.AddUser method")
 	}
 
-	if len(mmCreate.expectations) > 0 {
-		mmCreate.mock.t.Fatalf("Some expectations are already set for the ChatRepository.Create method")
+	if len(mmAddUser.expectations) > 0 {
+		mmAddUser.mock.t.Fatalf("Some expectations are already set for the ChatRepository.AddUser method")
 	}
 
-	mmCreate.mock.funcCreate = f
-	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
-	return mmCreate.mock
+	mmAddUser.mock.funcAddUser = f
+	mmAddUser.mock.funcAddUserOrigin = minimock.CallerInfo(1)
+	return mmAddUser.mock
 }
 
-// When sets expectation for the ChatRepository.Create which will trigger the result defined by the following
+// When sets expectation for the ChatRepository.AddUser which will trigger the result defined by the following
 // Then helper
-func (mmCreate *mChatRepositoryMockCreate) When(ctx context.Context, chat *model.ChatInfo) *ChatRepositoryMockCreateExpectation {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("ChatRepositoryMock.Create mock is already set by Set")
+func (mmAddUser *mChatRepositoryMockAddUser) When(ctx context.Context, id int64, username string) *ChatRepositoryMockAddUserExpectation {
+	if mmAddUser.mock.funcAddUser != nil {
+		mmAddUser.mock.t.Fatalf("ChatRepositoryMock.AddUser mock is already set by Set")
 	}
 
-	expectation := &ChatRepositoryMockCreateExpectation{
-		mock:               mmCreate.mock,
-		params:             &ChatRepositoryMockCreateParams{ctx, chat},
-		expectationOrigins: ChatRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatRepositoryMockAddUserExpectation{
+		mock:               mmAddUser.mock,
+		params:             &ChatRepositoryMockAddUserParams{ctx, id, username},
+		expectationOrigins: ChatRepositoryMockAddUserExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	mmAddUser.expectations = append(mmAddUser.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatRepository.Create return parameters for the expectation previously defined by the When method
-func (e *ChatRepositoryMockCreateExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
-	e.results = &ChatRepositoryMockCreateResults{i1, err}
+// Then sets up ChatRepository.AddUser return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockAddUserExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockAddUserResults{b1, err}
 	return e.mock
 }
 
-// Times sets number of times ChatRepository.Create should be invoked
-func (mmCreate *mChatRepositoryMockCreate) Times(n uint64) *mChatRepositoryMockCreate {
+// Times sets number of times ChatRepository.AddUser should be invoked
+func (mmAddUser *mChatRepositoryMockAddUser) Times(n uint64) *mChatRepositoryMockAddUser {
 	if n == 0 {
-		mmCreate.mock.t.Fatalf("Times of ChatRepositoryMock.Create mock can not be zero")
+		mmAddUser.mock.t.Fatalf("Times of ChatRepositoryMock.AddUser mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
-	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreate
+	mm_atomic.StoreUint64(&mmAddUser.expectedInvocations, n)
+	mmAddUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddUser
 }
 
-func (mmCreate *mChatRepositoryMockCreate) invocationsDone() bool {
-	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+func (mmAddUser *mChatRepositoryMockAddUser) invocationsDone() bool {
+	if len(mmAddUser.expectations) == 0 && mmAddUser.defaultExpectation == nil && mmAddUser.mock.funcAddUser == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddUser.mock.afterAddUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddUser.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Create implements mm_repository.ChatRepository
-func (mmCreate *ChatRepositoryMock) Create(ctx context.Context, chat *model.ChatInfo) (i1 int64, err error) {
-	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+// AddUser implements mm_repository.ChatRepository
+func (mmAddUser *ChatRepositoryMock) AddUser(ctx context.Context, id int64, username string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmAddUser.beforeAddUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddUser.afterAddUserCounter, 1)
 
-	mmCreate.t.Helper()
+	mmAddUser.t.Helper()
 
-	if mmCreate.inspectFuncCreate != nil {
-		mmCreate.inspectFuncCreate(ctx, chat)
+	if mmAddUser.inspectFuncAddUser != nil {
+		mmAddUser.inspectFuncAddUser(ctx, id, username)
 	}
 
-	mm_params := ChatRepositoryMockCreateParams{ctx, chat}
+	mm_params := ChatRepositoryMockAddUserParams{ctx, id, username}
 
 	// Record call args
-	mmCreate.CreateMock.mutex.Lock()
-	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
-	mmCreate.CreateMock.mutex.Unlock()
+	mmAddUser.AddUserMock.mutex.Lock()
+	mmAddUser.AddUserMock.callArgs = append(mmAddUser.AddUserMock.callArgs, &mm_params)
+	mmAddUser.AddUserMock.mutex.Unlock()
 
-	for _, e := range mmCreate.CreateMock.expectations {
+	for _, e := range mmAddUser.AddUserMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.i1, e.results.err
+			return e.results.b1, e.results.err
 		}
 	}
 
-	if mmCreate.CreateMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreate.CreateMock.defaultExpectation.params
-		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+	if mmAddUser.AddUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddUser.AddUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddUser.AddUserMock.defaultExpectation.params
+		mm_want_ptrs := mmAddUser.AddUserMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatRepositoryMockCreateParams{ctx, chat}
+		mm_got := ChatRepositoryMockAddUserParams{ctx, id, username}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreate.t.Errorf("ChatRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAddUser.t.Errorf("ChatRepositoryMock.AddUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddUser.AddUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.chat != nil && !minimock.Equal(*mm_want_ptrs.chat, mm_got.chat) {
-				mmCreate.t.Errorf("ChatRepositoryMock.Create got unexpected parameter chat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originChat, *mm_want_ptrs.chat, mm_got.chat, minimock.Diff(*mm_want_ptrs.chat, mm_got.chat))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmAddUser.t.Errorf("ChatRepositoryMock.AddUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddUser.AddUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmAddUser.t.Errorf("ChatRepositoryMock.AddUser got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddUser.AddUserMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreate.t.Errorf("ChatRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddUser.t.Errorf("ChatRepositoryMock.AddUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddUser.AddUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		mm_results := mmAddUser.AddUserMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreate.t.Fatal("No results are set for the ChatRepositoryMock.Create")
+			mmAddUser.t.Fatal("No results are set for the ChatRepositoryMock.AddUser")
 		}
-		return (*mm_results).i1, (*mm_results).err
+		return (*mm_results).b1, (*mm_results).err
 	}
-	if mmCreate.funcCreate != nil {
-		return mmCreate.funcCreate(ctx, chat)
+	if mmAddUser.funcAddUser != nil {
+		return mmAddUser.funcAddUser(ctx, id, username)
 	}
-	mmCreate.t.Fatalf("Unexpected call to ChatRepositoryMock.Create. %v %v", ctx, chat)
+	mmAddUser.t.Fatalf("Unexpected call to ChatRepositoryMock.AddUser. %v %v %v", ctx, id, username)
 	return
 }
 
-// CreateAfterCounter returns a count of finished ChatRepositoryMock.Create invocations
-func (mmCreate *ChatRepositoryMock) CreateAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+// AddUserAfterCounter returns a count of finished ChatRepositoryMock.AddUser invocations
+func (mmAddUser *ChatRepositoryMock) AddUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddUser.afterAddUserCounter)
 }
 
-// CreateBeforeCounter returns a count of ChatRepositoryMock.Create invocations
-func (mmCreate *ChatRepositoryMock) CreateBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+// AddUserBeforeCounter returns a count of ChatRepositoryMock.AddUser invocations
+func (mmAddUser *ChatRepositoryMock) AddUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddUser.beforeAddUserCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatRepositoryMock.Create.
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.AddUser.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreate *mChatRepositoryMockCreate) Calls() []*ChatRepositoryMockCreateParams {
-	mmCreate.mutex.RLock()
+func (mmAddUser *mChatRepositoryMockAddUser) Calls() []*ChatRepositoryMockAddUserParams {
+	mmAddUser.mutex.RLock()
 
-	argCopy := make([]*ChatRepositoryMockCreateParams, len(mmCreate.callArgs))
-	copy(argCopy, mmCreate.callArgs)
+	argCopy := make([]*ChatRepositoryMockAddUserParams, len(mmAddUser.callArgs))
+	copy(argCopy, mmAddUser.callArgs)
 
-	mmCreate.mutex.RUnlock()
+	mmAddUser.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// MinimockAddUserDone returns true if the count of the AddUser invocations corresponds
 // the number of defined expectations
-func (m *ChatRepositoryMock) MinimockCreateDone() bool {
-	if m.CreateMock.optional {
+func (m *ChatRepositoryMock) MinimockAddUserDone() bool {
+	if m.AddUserMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateMock.expectations {
+	for _, e := range m.AddUserMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateMock.invocationsDone()
+	return m.AddUserMock.invocationsDone()
 }
 
-// MinimockCreateInspect logs each unmet expectation
-func (m *ChatRepositoryMock) MinimockCreateInspect() {
-	for _, e := range m.CreateMock.expectations {
+// MinimockAddUserInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockAddUserInspect() {
+	for _, e := range m.AddUserMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	afterAddUserCounter := mm_atomic.LoadUint64(&m.afterAddUserCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
-		if m.CreateMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+	if m.AddUserMock.defaultExpectation != nil && afterAddUserCounter < 1 {
+		if m.AddUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddUser at\n%s", m.AddUserMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddUser at\n%s with params: %#v", m.AddUserMock.defaultExpectation.expectationOrigins.origin, *m.AddUserMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreate != nil && afterCreateCounter < 1 {
-		m.t.Errorf("Expected call to ChatRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	if m.funcAddUser != nil && afterAddUserCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.AddUser at\n%s", m.funcAddUserOrigin)
 	}
 
-	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatRepositoryMock.Create at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	if !m.AddUserMock.invocationsDone() && afterAddUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.AddUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddUserMock.expectedInvocations), m.AddUserMock.expectedInvocationsOrigin, afterAddUserCounter)
 	}
 }
 
-type mChatRepositoryMockDelete struct {
+type mChatRepositoryMockCountDeleted struct {
 	optional           bool
 	mock               *ChatRepositoryMock
-	defaultExpectation *ChatRepositoryMockDeleteExpectation
-	expectations       []*ChatRepositoryMockDeleteExpectation
+	defaultExpectation *ChatRepositoryMockCountDeletedExpectation
+	expectations       []*ChatRepositoryMockCountDeletedExpectation
 
-	callArgs []*ChatRepositoryMockDeleteParams
+	callArgs []*ChatRepositoryMockCountDeletedParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatRepositoryMockDeleteExpectation specifies expectation struct of the ChatRepository.Delete
-type ChatRepositoryMockDeleteExpectation struct {
+// ChatRepositoryMockCountDeletedExpectation specifies expectation struct of the ChatRepository.CountDeleted
+type ChatRepositoryMockCountDeletedExpectation struct {
 	mock               *ChatRepositoryMock
-	params             *ChatRepositoryMockDeleteParams
-	paramPtrs          *ChatRepositoryMockDeleteParamPtrs
-	expectationOrigins ChatRepositoryMockDeleteExpectationOrigins
-	results            *ChatRepositoryMockDeleteResults
+	params             *ChatRepositoryMockCountDeletedParams
+	paramPtrs          *ChatRepositoryMockCountDeletedParamPtrs
+	expectationOrigins ChatRepositoryMockCountDeletedExpectationOrigins
+	results            *ChatRepositoryMockCountDeletedResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatRepositoryMockDeleteParams contains parameters of the ChatRepository.Delete
-type ChatRepositoryMockDeleteParams struct {
-	ctx context.Context
-	id  int64
+// ChatRepositoryMockCountDeletedParams contains parameters of the ChatRepository.CountDeleted
+type ChatRepositoryMockCountDeletedParams struct {
+	ctx   context.Context
+	grace time.Duration
 }
 
-// ChatRepositoryMockDeleteParamPtrs contains pointers to parameters of the ChatRepository.Delete
-type ChatRepositoryMockDeleteParamPtrs struct {
-	ctx *context.Context
-	id  *int64
+// ChatRepositoryMockCountDeletedParamPtrs contains pointers to parameters of the ChatRepository.CountDeleted
+type ChatRepositoryMockCountDeletedParamPtrs struct {
+	ctx   *context.Context
+	grace *time.Duration
 }
 
-// ChatRepositoryMockDeleteResults contains results of the ChatRepository.Delete
-type ChatRepositoryMockDeleteResults struct {
+// ChatRepositoryMockCountDeletedResults contains results of the ChatRepository.CountDeleted
+type ChatRepositoryMockCountDeletedResults struct {
+	i1  int64
 	err error
 }
 
-// ChatRepositoryMockDeleteOrigins contains origins of expectations of the ChatRepository.Delete
-type ChatRepositoryMockDeleteExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// ChatRepositoryMockCountDeletedOrigins contains origins of expectations of the ChatRepository.CountDeleted
+type ChatRepositoryMockCountDeletedExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originGrace string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -459,342 +602,4297 @@ type ChatRepositoryMockDeleteExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDelete *mChatRepositoryMockDelete) Optional() *mChatRepositoryMockDelete {
-	mmDelete.optional = true
-	return mmDelete
+func (mmCountDeleted *mChatRepositoryMockCountDeleted) Optional() *mChatRepositoryMockCountDeleted {
+	mmCountDeleted.optional = true
+	return mmCountDeleted
 }
 
-// Expect sets up expected params for ChatRepository.Delete
-func (mmDelete *mChatRepositoryMockDelete) Expect(ctx context.Context, id int64) *mChatRepositoryMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ChatRepositoryMock.Delete mock is already set by Set")
+// Expect sets up expected params for ChatRepository.CountDeleted
+func (mmCountDeleted *mChatRepositoryMockCountDeleted) Expect(ctx context.Context, grace time.Duration) *mChatRepositoryMockCountDeleted {
+	if mmCountDeleted.mock.funcCountDeleted != nil {
+		mmCountDeleted.mock.t.Fatalf("ChatRepositoryMock.CountDeleted mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &ChatRepositoryMockDeleteExpectation{}
+	if mmCountDeleted.defaultExpectation == nil {
+		mmCountDeleted.defaultExpectation = &ChatRepositoryMockCountDeletedExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs != nil {
-		mmDelete.mock.t.Fatalf("ChatRepositoryMock.Delete mock is already set by ExpectParams functions")
+	if mmCountDeleted.defaultExpectation.paramPtrs != nil {
+		mmCountDeleted.mock.t.Fatalf("ChatRepositoryMock.CountDeleted mock is already set by ExpectParams functions")
 	}
 
-	mmDelete.defaultExpectation.params = &ChatRepositoryMockDeleteParams{ctx, id}
-	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDelete.expectations {
-		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
-			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+	mmCountDeleted.defaultExpectation.params = &ChatRepositoryMockCountDeletedParams{ctx, grace}
+	mmCountDeleted.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountDeleted.expectations {
+		if minimock.Equal(e.params, mmCountDeleted.defaultExpectation.params) {
+			mmCountDeleted.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountDeleted.defaultExpectation.params)
 		}
 	}
 
-	return mmDelete
+	return mmCountDeleted
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatRepository.Delete
-func (mmDelete *mChatRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ChatRepositoryMock.Delete mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.CountDeleted
+func (mmCountDeleted *mChatRepositoryMockCountDeleted) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockCountDeleted {
+	if mmCountDeleted.mock.funcCountDeleted != nil {
+		mmCountDeleted.mock.t.Fatalf("ChatRepositoryMock.CountDeleted mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &ChatRepositoryMockDeleteExpectation{}
+	if mmCountDeleted.defaultExpectation == nil {
+		mmCountDeleted.defaultExpectation = &ChatRepositoryMockCountDeletedExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.params != nil {
-		mmDelete.mock.t.Fatalf("ChatRepositoryMock.Delete mock is already set by Expect")
+	if mmCountDeleted.defaultExpectation.params != nil {
+		mmCountDeleted.mock.t.Fatalf("ChatRepositoryMock.CountDeleted mock is already set by Expect")
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs == nil {
+	if mmCountDeleted.defaultExpectation.paramPtrs == nil {
+		mmCountDeleted.defaultExpectation.paramPtrs = &ChatRepositoryMockCountDeletedParamPtrs{}
+	}
+	mmCountDeleted.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountDeleted.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountDeleted
+}
+
+// ExpectGraceParam2 sets up expected param grace for ChatRepository.CountDeleted
+func (mmCountDeleted *mChatRepositoryMockCountDeleted) ExpectGraceParam2(grace time.Duration) *mChatRepositoryMockCountDeleted {
+	if mmCountDeleted.mock.funcCountDeleted != nil {
+		mmCountDeleted.mock.t.Fatalf("ChatRepositoryMock.CountDeleted mock is already set by Set")
+	}
+
+	if mmCountDeleted.defaultExpectation == nil {
+		mmCountDeleted.defaultExpectation = &ChatRepositoryMockCountDeletedExpectation{}
+	}
+
+	if mmCountDeleted.defaultExpectation.params != nil {
+		mmCountDeleted.mock.t.Fatalf("ChatRepositoryMock.CountDeleted mock is already set by Expect")
+	}
+
+	if mmCountDeleted.defaultExpectation.paramPtrs == nil {
+		mmCountDeleted.defaultExpectation.paramPtrs = &ChatRepositoryMockCountDeletedParamPtrs{}
+	}
+	mmCountDeleted.defaultExpectation.paramPtrs.grace = &grace
+	mmCountDeleted.defaultExpectation.expectationOrigins.originGrace = minimock.CallerInfo(1)
+
+	return mmCountDeleted
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.CountDeleted
+func (mmCountDeleted *mChatRepositoryMockCountDeleted) Inspect(f func(ctx context.Context, grace time.Duration)) *mChatRepositoryMockCountDeleted {
+	if mmCountDeleted.mock.inspectFuncCountDeleted != nil {
+		mmCountDeleted.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.CountDeleted")
+	}
+
+	mmCountDeleted.mock.inspectFuncCountDeleted = f
+
+	return mmCountDeleted
+}
+
+// Return sets up results that will be returned by ChatRepository.CountDeleted
+func (mmCountDeleted *mChatRepositoryMockCountDeleted) Return(i1 int64, err error) *ChatRepositoryMock {
+	if mmCountDeleted.mock.funcCountDeleted != nil {
+		mmCountDeleted.mock.t.Fatalf("ChatRepositoryMock.CountDeleted mock is already set by Set")
+	}
+
+	if mmCountDeleted.defaultExpectation == nil {
+		mmCountDeleted.defaultExpectation = &ChatRepositoryMockCountDeletedExpectation{mock: mmCountDeleted.mock}
+	}
+	mmCountDeleted.defaultExpectation.results = &ChatRepositoryMockCountDeletedResults{i1, err}
+	mmCountDeleted.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountDeleted.mock
+}
+
+// Set uses given function f to mock the ChatRepository.CountDeleted method
+func (mmCountDeleted *mChatRepositoryMockCountDeleted) Set(f func(ctx context.Context, grace time.Duration) (i1 int64, err error)) *ChatRepositoryMock {
+	if mmCountDeleted.defaultExpectation != nil {
+		mmCountDeleted.mock.t.Fatalf("Default expectation is already set for the ChatRepository.CountDeleted method")
+	}
+
+	if len(mmCountDeleted.expectations) > 0 {
+		mmCountDeleted.mock.t.Fatalf("Some expectations are already set for the ChatRepository.CountDeleted method")
+	}
+
+	mmCountDeleted.mock.funcCountDeleted = f
+	mmCountDeleted.mock.funcCountDeletedOrigin = minimock.CallerInfo(1)
+	return mmCountDeleted.mock
+}
+
+// When sets expectation for the ChatRepository.CountDeleted which will trigger the result defined by the following
+// Then helper
+func (mmCountDeleted *mChatRepositoryMockCountDeleted) When(ctx context.Context, grace time.Duration) *ChatRepositoryMockCountDeletedExpectation {
+	if mmCountDeleted.mock.funcCountDeleted != nil {
+		mmCountDeleted.mock.t.Fatalf("ChatRepositoryMock.CountDeleted mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockCountDeletedExpectation{
+		mock:               mmCountDeleted.mock,
+		params:             &ChatRepositoryMockCountDeletedParams{ctx, grace},
+		expectationOrigins: ChatRepositoryMockCountDeletedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountDeleted.expectations = append(mmCountDeleted.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.CountDeleted return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockCountDeletedExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockCountDeletedResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.CountDeleted should be invoked
+func (mmCountDeleted *mChatRepositoryMockCountDeleted) Times(n uint64) *mChatRepositoryMockCountDeleted {
+	if n == 0 {
+		mmCountDeleted.mock.t.Fatalf("Times of ChatRepositoryMock.CountDeleted mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountDeleted.expectedInvocations, n)
+	mmCountDeleted.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountDeleted
+}
+
+func (mmCountDeleted *mChatRepositoryMockCountDeleted) invocationsDone() bool {
+	if len(mmCountDeleted.expectations) == 0 && mmCountDeleted.defaultExpectation == nil && mmCountDeleted.mock.funcCountDeleted == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountDeleted.mock.afterCountDeletedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountDeleted.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountDeleted implements mm_repository.ChatRepository
+func (mmCountDeleted *ChatRepositoryMock) CountDeleted(ctx context.Context, grace time.Duration) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCountDeleted.beforeCountDeletedCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountDeleted.afterCountDeletedCounter, 1)
+
+	mmCountDeleted.t.Helper()
+
+	if mmCountDeleted.inspectFuncCountDeleted != nil {
+		mmCountDeleted.inspectFuncCountDeleted(ctx, grace)
+	}
+
+	mm_params := ChatRepositoryMockCountDeletedParams{ctx, grace}
+
+	// Record call args
+	mmCountDeleted.CountDeletedMock.mutex.Lock()
+	mmCountDeleted.CountDeletedMock.callArgs = append(mmCountDeleted.CountDeletedMock.callArgs, &mm_params)
+	mmCountDeleted.CountDeletedMock.mutex.Unlock()
+
+	for _, e := range mmCountDeleted.CountDeletedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCountDeleted.CountDeletedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountDeleted.CountDeletedMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountDeleted.CountDeletedMock.defaultExpectation.params
+		mm_want_ptrs := mmCountDeleted.CountDeletedMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockCountDeletedParams{ctx, grace}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountDeleted.t.Errorf("ChatRepositoryMock.CountDeleted got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountDeleted.CountDeletedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.grace != nil && !minimock.Equal(*mm_want_ptrs.grace, mm_got.grace) {
+				mmCountDeleted.t.Errorf("ChatRepositoryMock.CountDeleted got unexpected parameter grace, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountDeleted.CountDeletedMock.defaultExpectation.expectationOrigins.originGrace, *mm_want_ptrs.grace, mm_got.grace, minimock.Diff(*mm_want_ptrs.grace, mm_got.grace))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountDeleted.t.Errorf("ChatRepositoryMock.CountDeleted got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountDeleted.CountDeletedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountDeleted.CountDeletedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountDeleted.t.Fatal("No results are set for the ChatRepositoryMock.CountDeleted")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCountDeleted.funcCountDeleted != nil {
+		return mmCountDeleted.funcCountDeleted(ctx, grace)
+	}
+	mmCountDeleted.t.Fatalf("Unexpected call to ChatRepositoryMock.CountDeleted. %v %v", ctx, grace)
+	return
+}
+
+// CountDeletedAfterCounter returns a count of finished ChatRepositoryMock.CountDeleted invocations
+func (mmCountDeleted *ChatRepositoryMock) CountDeletedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountDeleted.afterCountDeletedCounter)
+}
+
+// CountDeletedBeforeCounter returns a count of ChatRepositoryMock.CountDeleted invocations
+func (mmCountDeleted *ChatRepositoryMock) CountDeletedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountDeleted.beforeCountDeletedCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.CountDeleted.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountDeleted *mChatRepositoryMockCountDeleted) Calls() []*ChatRepositoryMockCountDeletedParams {
+	mmCountDeleted.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockCountDeletedParams, len(mmCountDeleted.callArgs))
+	copy(argCopy, mmCountDeleted.callArgs)
+
+	mmCountDeleted.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountDeletedDone returns true if the count of the CountDeleted invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockCountDeletedDone() bool {
+	if m.CountDeletedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountDeletedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountDeletedMock.invocationsDone()
+}
+
+// MinimockCountDeletedInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockCountDeletedInspect() {
+	for _, e := range m.CountDeletedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CountDeleted at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountDeletedCounter := mm_atomic.LoadUint64(&m.afterCountDeletedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountDeletedMock.defaultExpectation != nil && afterCountDeletedCounter < 1 {
+		if m.CountDeletedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CountDeleted at\n%s", m.CountDeletedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.CountDeleted at\n%s with params: %#v", m.CountDeletedMock.defaultExpectation.expectationOrigins.origin, *m.CountDeletedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountDeleted != nil && afterCountDeletedCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.CountDeleted at\n%s", m.funcCountDeletedOrigin)
+	}
+
+	if !m.CountDeletedMock.invocationsDone() && afterCountDeletedCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.CountDeleted at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountDeletedMock.expectedInvocations), m.CountDeletedMock.expectedInvocationsOrigin, afterCountDeletedCounter)
+	}
+}
+
+type mChatRepositoryMockCreate struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockCreateExpectation
+	expectations       []*ChatRepositoryMockCreateExpectation
+
+	callArgs []*ChatRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockCreateExpectation specifies expectation struct of the ChatRepository.Create
+type ChatRepositoryMockCreateExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockCreateParams
+	paramPtrs          *ChatRepositoryMockCreateParamPtrs
+	expectationOrigins ChatRepositoryMockCreateExpectationOrigins
+	results            *ChatRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockCreateParams contains parameters of the ChatRepository.Create
+type ChatRepositoryMockCreateParams struct {
+	ctx  context.Context
+	chat *model.ChatInfo
+}
+
+// ChatRepositoryMockCreateParamPtrs contains pointers to parameters of the ChatRepository.Create
+type ChatRepositoryMockCreateParamPtrs struct {
+	ctx  *context.Context
+	chat **model.ChatInfo
+}
+
+// ChatRepositoryMockCreateResults contains results of the ChatRepository.Create
+type ChatRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// ChatRepositoryMockCreateOrigins contains origins of expectations of the ChatRepository.Create
+type ChatRepositoryMockCreateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originChat string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mChatRepositoryMockCreate) Optional() *mChatRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for ChatRepository.Create
+func (mmCreate *mChatRepositoryMockCreate) Expect(ctx context.Context, chat *model.ChatInfo) *mChatRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ChatRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ChatRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("ChatRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &ChatRepositoryMockCreateParams{ctx, chat}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.Create
+func (mmCreate *mChatRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ChatRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ChatRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("ChatRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &ChatRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectChatParam2 sets up expected param chat for ChatRepository.Create
+func (mmCreate *mChatRepositoryMockCreate) ExpectChatParam2(chat *model.ChatInfo) *mChatRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ChatRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ChatRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("ChatRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &ChatRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.chat = &chat
+	mmCreate.defaultExpectation.expectationOrigins.originChat = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.Create
+func (mmCreate *mChatRepositoryMockCreate) Inspect(f func(ctx context.Context, chat *model.ChatInfo)) *mChatRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by ChatRepository.Create
+func (mmCreate *mChatRepositoryMockCreate) Return(i1 int64, err error) *ChatRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ChatRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ChatRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &ChatRepositoryMockCreateResults{i1, err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the ChatRepository.Create method
+func (mmCreate *mChatRepositoryMockCreate) Set(f func(ctx context.Context, chat *model.ChatInfo) (i1 int64, err error)) *ChatRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the ChatRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the ChatRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the ChatRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mChatRepositoryMockCreate) When(ctx context.Context, chat *model.ChatInfo) *ChatRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ChatRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &ChatRepositoryMockCreateParams{ctx, chat},
+		expectationOrigins: ChatRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.Create return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockCreateExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.Create should be invoked
+func (mmCreate *mChatRepositoryMockCreate) Times(n uint64) *mChatRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of ChatRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mChatRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_repository.ChatRepository
+func (mmCreate *ChatRepositoryMock) Create(ctx context.Context, chat *model.ChatInfo) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, chat)
+	}
+
+	mm_params := ChatRepositoryMockCreateParams{ctx, chat}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockCreateParams{ctx, chat}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("ChatRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chat != nil && !minimock.Equal(*mm_want_ptrs.chat, mm_got.chat) {
+				mmCreate.t.Errorf("ChatRepositoryMock.Create got unexpected parameter chat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originChat, *mm_want_ptrs.chat, mm_got.chat, minimock.Diff(*mm_want_ptrs.chat, mm_got.chat))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("ChatRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the ChatRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, chat)
+	}
+	mmCreate.t.Fatalf("Unexpected call to ChatRepositoryMock.Create. %v %v", ctx, chat)
+	return
+}
+
+// CreateAfterCounter returns a count of finished ChatRepositoryMock.Create invocations
+func (mmCreate *ChatRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of ChatRepositoryMock.Create invocations
+func (mmCreate *ChatRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mChatRepositoryMockCreate) Calls() []*ChatRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mChatRepositoryMockDelete struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockDeleteExpectation
+	expectations       []*ChatRepositoryMockDeleteExpectation
+
+	callArgs []*ChatRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockDeleteExpectation specifies expectation struct of the ChatRepository.Delete
+type ChatRepositoryMockDeleteExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockDeleteParams
+	paramPtrs          *ChatRepositoryMockDeleteParamPtrs
+	expectationOrigins ChatRepositoryMockDeleteExpectationOrigins
+	results            *ChatRepositoryMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockDeleteParams contains parameters of the ChatRepository.Delete
+type ChatRepositoryMockDeleteParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ChatRepositoryMockDeleteParamPtrs contains pointers to parameters of the ChatRepository.Delete
+type ChatRepositoryMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ChatRepositoryMockDeleteResults contains results of the ChatRepository.Delete
+type ChatRepositoryMockDeleteResults struct {
+	err error
+}
+
+// ChatRepositoryMockDeleteOrigins contains origins of expectations of the ChatRepository.Delete
+type ChatRepositoryMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mChatRepositoryMockDelete) Optional() *mChatRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for ChatRepository.Delete
+func (mmDelete *mChatRepositoryMockDelete) Expect(ctx context.Context, id int64) *mChatRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ChatRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ChatRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("ChatRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &ChatRepositoryMockDeleteParams{ctx, id}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.Delete
+func (mmDelete *mChatRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ChatRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ChatRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("ChatRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for ChatRepository.Delete
+func (mmDelete *mChatRepositoryMockDelete) ExpectIdParam2(id int64) *mChatRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ChatRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ChatRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("ChatRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
 		mmDelete.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteParamPtrs{}
 	}
-	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.Delete
+func (mmDelete *mChatRepositoryMockDelete) Inspect(f func(ctx context.Context, id int64)) *mChatRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by ChatRepository.Delete
+func (mmDelete *mChatRepositoryMockDelete) Return(err error) *ChatRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ChatRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ChatRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &ChatRepositoryMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the ChatRepository.Delete method
+func (mmDelete *mChatRepositoryMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *ChatRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the ChatRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the ChatRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the ChatRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mChatRepositoryMockDelete) When(ctx context.Context, id int64) *ChatRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ChatRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &ChatRepositoryMockDeleteParams{ctx, id},
+		expectationOrigins: ChatRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockDeleteExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.Delete should be invoked
+func (mmDelete *mChatRepositoryMockDelete) Times(n uint64) *mChatRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of ChatRepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mChatRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_repository.ChatRepository
+func (mmDelete *ChatRepositoryMock) Delete(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := ChatRepositoryMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("ChatRepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("ChatRepositoryMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("ChatRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the ChatRepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to ChatRepositoryMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished ChatRepositoryMock.Delete invocations
+func (mmDelete *ChatRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of ChatRepositoryMock.Delete invocations
+func (mmDelete *ChatRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mChatRepositoryMockDelete) Calls() []*ChatRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mChatRepositoryMockGet struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetExpectation
+	expectations       []*ChatRepositoryMockGetExpectation
+
+	callArgs []*ChatRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetExpectation specifies expectation struct of the ChatRepository.Get
+type ChatRepositoryMockGetExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetParams
+	paramPtrs          *ChatRepositoryMockGetParamPtrs
+	expectationOrigins ChatRepositoryMockGetExpectationOrigins
+	results            *ChatRepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetParams contains parameters of the ChatRepository.Get
+type ChatRepositoryMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ChatRepositoryMockGetParamPtrs contains pointers to parameters of the ChatRepository.Get
+type ChatRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ChatRepositoryMockGetResults contains results of the ChatRepository.Get
+type ChatRepositoryMockGetResults struct {
+	cp1 *model.Chat
+	err error
+}
+
+// ChatRepositoryMockGetOrigins contains origins of expectations of the ChatRepository.Get
+type ChatRepositoryMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mChatRepositoryMockGet) Optional() *mChatRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for ChatRepository.Get
+func (mmGet *mChatRepositoryMockGet) Expect(ctx context.Context, id int64) *mChatRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ChatRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &ChatRepositoryMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.Get
+func (mmGet *mChatRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ChatRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &ChatRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for ChatRepository.Get
+func (mmGet *mChatRepositoryMockGet) ExpectIdParam2(id int64) *mChatRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ChatRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &ChatRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.Get
+func (mmGet *mChatRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mChatRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by ChatRepository.Get
+func (mmGet *mChatRepositoryMockGet) Return(cp1 *model.Chat, err error) *ChatRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ChatRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &ChatRepositoryMockGetResults{cp1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the ChatRepository.Get method
+func (mmGet *mChatRepositoryMockGet) Set(f func(ctx context.Context, id int64) (cp1 *model.Chat, err error)) *ChatRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the ChatRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the ChatRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the ChatRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mChatRepositoryMockGet) When(ctx context.Context, id int64) *ChatRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &ChatRepositoryMockGetParams{ctx, id},
+		expectationOrigins: ChatRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.Get return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetExpectation) Then(cp1 *model.Chat, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.Get should be invoked
+func (mmGet *mChatRepositoryMockGet) Times(n uint64) *mChatRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of ChatRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mChatRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repository.ChatRepository
+func (mmGet *ChatRepositoryMock) Get(ctx context.Context, id int64) (cp1 *model.Chat, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := ChatRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("ChatRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("ChatRepositoryMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("ChatRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the ChatRepositoryMock.Get")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to ChatRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished ChatRepositoryMock.Get invocations
+func (mmGet *ChatRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of ChatRepositoryMock.Get invocations
+func (mmGet *ChatRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mChatRepositoryMockGet) Calls() []*ChatRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mChatRepositoryMockIsMuted struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockIsMutedExpectation
+	expectations       []*ChatRepositoryMockIsMutedExpectation
+
+	callArgs []*ChatRepositoryMockIsMutedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockIsMutedExpectation specifies expectation struct of the ChatRepository.IsMuted
+type ChatRepositoryMockIsMutedExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockIsMutedParams
+	paramPtrs          *ChatRepositoryMockIsMutedParamPtrs
+	expectationOrigins ChatRepositoryMockIsMutedExpectationOrigins
+	results            *ChatRepositoryMockIsMutedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockIsMutedParams contains parameters of the ChatRepository.IsMuted
+type ChatRepositoryMockIsMutedParams struct {
+	ctx      context.Context
+	id       int64
+	username string
+}
+
+// ChatRepositoryMockIsMutedParamPtrs contains pointers to parameters of the ChatRepository.IsMuted
+type ChatRepositoryMockIsMutedParamPtrs struct {
+	ctx      *context.Context
+	id       *int64
+	username *string
+}
+
+// ChatRepositoryMockIsMutedResults contains results of the ChatRepository.IsMuted
+type ChatRepositoryMockIsMutedResults struct {
+	b1  bool
+	err error
+}
+
+// ChatRepositoryMockIsMutedOrigins contains origins of expectations of the ChatRepository.IsMuted
+type ChatRepositoryMockIsMutedExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originId       string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIsMuted *mChatRepositoryMockIsMuted) Optional() *mChatRepositoryMockIsMuted {
+	mmIsMuted.optional = true
+	return mmIsMuted
+}
+
+// Expect sets up expected params for ChatRepository.IsMuted
+func (mmIsMuted *mChatRepositoryMockIsMuted) Expect(ctx context.Context, id int64, username string) *mChatRepositoryMockIsMuted {
+	if mmIsMuted.mock.funcIsMuted != nil {
+		mmIsMuted.mock.t.Fatalf("ChatRepositoryMock.IsMuted mock is already set by Set")
+	}
+
+	if mmIsMuted.defaultExpectation == nil {
+		mmIsMuted.defaultExpectation = &ChatRepositoryMockIsMutedExpectation{}
+	}
+
+	if mmIsMuted.defaultExpectation.paramPtrs != nil {
+		mmIsMuted.mock.t.Fatalf("ChatRepositoryMock.IsMuted mock is already set by ExpectParams functions")
+	}
+
+	mmIsMuted.defaultExpectation.params = &ChatRepositoryMockIsMutedParams{ctx, id, username}
+	mmIsMuted.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIsMuted.expectations {
+		if minimock.Equal(e.params, mmIsMuted.defaultExpectation.params) {
+			mmIsMuted.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsMuted.defaultExpectation.params)
+		}
+	}
+
+	return mmIsMuted
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.IsMuted
+func (mmIsMuted *mChatRepositoryMockIsMuted) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockIsMuted {
+	if mmIsMuted.mock.funcIsMuted != nil {
+		mmIsMuted.mock.t.Fatalf("ChatRepositoryMock.IsMuted mock is already set by Set")
+	}
+
+	if mmIsMuted.defaultExpectation == nil {
+		mmIsMuted.defaultExpectation = &ChatRepositoryMockIsMutedExpectation{}
+	}
+
+	if mmIsMuted.defaultExpectation.params != nil {
+		mmIsMuted.mock.t.Fatalf("ChatRepositoryMock.IsMuted mock is already set by Expect")
+	}
+
+	if mmIsMuted.defaultExpectation.paramPtrs == nil {
+		mmIsMuted.defaultExpectation.paramPtrs = &ChatRepositoryMockIsMutedParamPtrs{}
+	}
+	mmIsMuted.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIsMuted.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIsMuted
+}
+
+// ExpectIdParam2 sets up expected param id for ChatRepository.IsMuted
+func (mmIsMuted *mChatRepositoryMockIsMuted) ExpectIdParam2(id int64) *mChatRepositoryMockIsMuted {
+	if mmIsMuted.mock.funcIsMuted != nil {
+		mmIsMuted.mock.t.Fatalf("ChatRepositoryMock.IsMuted mock is already set by Set")
+	}
+
+	if mmIsMuted.defaultExpectation == nil {
+		mmIsMuted.defaultExpectation = &ChatRepositoryMockIsMutedExpectation{}
+	}
+
+	if mmIsMuted.defaultExpectation.params != nil {
+		mmIsMuted.mock.t.Fatalf("ChatRepositoryMock.IsMuted mock is already set by Expect")
+	}
+
+	if mmIsMuted.defaultExpectation.paramPtrs == nil {
+		mmIsMuted.defaultExpectation.paramPtrs = &ChatRepositoryMockIsMutedParamPtrs{}
+	}
+	mmIsMuted.defaultExpectation.paramPtrs.id = &id
+	mmIsMuted.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmIsMuted
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.IsMuted
+func (mmIsMuted *mChatRepositoryMockIsMuted) ExpectUsernameParam3(username string) *mChatRepositoryMockIsMuted {
+	if mmIsMuted.mock.funcIsMuted != nil {
+		mmIsMuted.mock.t.Fatalf("ChatRepositoryMock.IsMuted mock is already set by Set")
+	}
+
+	if mmIsMuted.defaultExpectation == nil {
+		mmIsMuted.defaultExpectation = &ChatRepositoryMockIsMutedExpectation{}
+	}
+
+	if mmIsMuted.defaultExpectation.params != nil {
+		mmIsMuted.mock.t.Fatalf("ChatRepositoryMock.IsMuted mock is already set by Expect")
+	}
+
+	if mmIsMuted.defaultExpectation.paramPtrs == nil {
+		mmIsMuted.defaultExpectation.paramPtrs = &ChatRepositoryMockIsMutedParamPtrs{}
+	}
+	mmIsMuted.defaultExpectation.paramPtrs.username = &username
+	mmIsMuted.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmIsMuted
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.IsMuted
+func (mmIsMuted *mChatRepositoryMockIsMuted) Inspect(f func(ctx context.Context, id int64, username string)) *mChatRepositoryMockIsMuted {
+	if mmIsMuted.mock.inspectFuncIsMuted != nil {
+		mmIsMuted.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.IsMuted")
+	}
+
+	mmIsMuted.mock.inspectFuncIsMuted = f
+
+	return mmIsMuted
+}
+
+// Return sets up results that will be returned by ChatRepository.IsMuted
+func (mmIsMuted *mChatRepositoryMockIsMuted) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmIsMuted.mock.funcIsMuted != nil {
+		mmIsMuted.mock.t.Fatalf("ChatRepositoryMock.IsMuted mock is already set by Set")
+	}
+
+	if mmIsMuted.defaultExpectation == nil {
+		mmIsMuted.defaultExpectation = &ChatRepositoryMockIsMutedExpectation{mock: mmIsMuted.mock}
+	}
+	mmIsMuted.defaultExpectation.results = &ChatRepositoryMockIsMutedResults{b1, err}
+	mmIsMuted.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIsMuted.mock
+}
+
+// Set uses given function f to mock the ChatRepository.IsMuted method
+func (mmIsMuted *mChatRepositoryMockIsMuted) Set(f func(ctx context.Context, id int64, username string) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmIsMuted.defaultExpectation != nil {
+		mmIsMuted.mock.t.Fatalf("Default expectation is already set for the ChatRepository.IsMuted method")
+	}
+
+	if len(mmIsMuted.expectations) > 0 {
+		mmIsMuted.mock.t.Fatalf("Some expectations are already set for the ChatRepository.IsMuted method")
+	}
+
+	mmIsMuted.mock.funcIsMuted = f
+	mmIsMuted.mock.funcIsMutedOrigin = minimock.CallerInfo(1)
+	return mmIsMuted.mock
+}
+
+// When sets expectation for the ChatRepository.IsMuted which will trigger the result defined by the following
+// Then helper
+func (mmIsMuted *mChatRepositoryMockIsMuted) When(ctx context.Context, id int64, username string) *ChatRepositoryMockIsMutedExpectation {
+	if mmIsMuted.mock.funcIsMuted != nil {
+		mmIsMuted.mock.t.Fatalf("ChatRepositoryMock.IsMuted mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockIsMutedExpectation{
+		mock:               mmIsMuted.mock,
+		params:             &ChatRepositoryMockIsMutedParams{ctx, id, username},
+		expectationOrigins: ChatRepositoryMockIsMutedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIsMuted.expectations = append(mmIsMuted.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.IsMuted return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockIsMutedExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockIsMutedResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.IsMuted should be invoked
+func (mmIsMuted *mChatRepositoryMockIsMuted) Times(n uint64) *mChatRepositoryMockIsMuted {
+	if n == 0 {
+		mmIsMuted.mock.t.Fatalf("Times of ChatRepositoryMock.IsMuted mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIsMuted.expectedInvocations, n)
+	mmIsMuted.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIsMuted
+}
+
+func (mmIsMuted *mChatRepositoryMockIsMuted) invocationsDone() bool {
+	if len(mmIsMuted.expectations) == 0 && mmIsMuted.defaultExpectation == nil && mmIsMuted.mock.funcIsMuted == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIsMuted.mock.afterIsMutedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIsMuted.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IsMuted implements mm_repository.ChatRepository
+func (mmIsMuted *ChatRepositoryMock) IsMuted(ctx context.Context, id int64, username string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmIsMuted.beforeIsMutedCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsMuted.afterIsMutedCounter, 1)
+
+	mmIsMuted.t.Helper()
+
+	if mmIsMuted.inspectFuncIsMuted != nil {
+		mmIsMuted.inspectFuncIsMuted(ctx, id, username)
+	}
+
+	mm_params := ChatRepositoryMockIsMutedParams{ctx, id, username}
+
+	// Record call args
+	mmIsMuted.IsMutedMock.mutex.Lock()
+	mmIsMuted.IsMutedMock.callArgs = append(mmIsMuted.IsMutedMock.callArgs, &mm_params)
+	mmIsMuted.IsMutedMock.mutex.Unlock()
+
+	for _, e := range mmIsMuted.IsMutedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmIsMuted.IsMutedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsMuted.IsMutedMock.defaultExpectation.Counter, 1)
+		mm_want := mmIsMuted.IsMutedMock.defaultExpectation.params
+		mm_want_ptrs := mmIsMuted.IsMutedMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockIsMutedParams{ctx, id, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIsMuted.t.Errorf("ChatRepositoryMock.IsMuted got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsMuted.IsMutedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmIsMuted.t.Errorf("ChatRepositoryMock.IsMuted got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsMuted.IsMutedMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmIsMuted.t.Errorf("ChatRepositoryMock.IsMuted got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsMuted.IsMutedMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsMuted.t.Errorf("ChatRepositoryMock.IsMuted got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIsMuted.IsMutedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIsMuted.IsMutedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsMuted.t.Fatal("No results are set for the ChatRepositoryMock.IsMuted")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmIsMuted.funcIsMuted != nil {
+		return mmIsMuted.funcIsMuted(ctx, id, username)
+	}
+	mmIsMuted.t.Fatalf("Unexpected call to ChatRepositoryMock.IsMuted. %v %v %v", ctx, id, username)
+	return
+}
+
+// IsMutedAfterCounter returns a count of finished ChatRepositoryMock.IsMuted invocations
+func (mmIsMuted *ChatRepositoryMock) IsMutedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsMuted.afterIsMutedCounter)
+}
+
+// IsMutedBeforeCounter returns a count of ChatRepositoryMock.IsMuted invocations
+func (mmIsMuted *ChatRepositoryMock) IsMutedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsMuted.beforeIsMutedCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.IsMuted.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIsMuted *mChatRepositoryMockIsMuted) Calls() []*ChatRepositoryMockIsMutedParams {
+	mmIsMuted.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockIsMutedParams, len(mmIsMuted.callArgs))
+	copy(argCopy, mmIsMuted.callArgs)
+
+	mmIsMuted.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIsMutedDone returns true if the count of the IsMuted invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockIsMutedDone() bool {
+	if m.IsMutedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IsMutedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IsMutedMock.invocationsDone()
+}
+
+// MinimockIsMutedInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockIsMutedInspect() {
+	for _, e := range m.IsMutedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.IsMuted at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIsMutedCounter := mm_atomic.LoadUint64(&m.afterIsMutedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsMutedMock.defaultExpectation != nil && afterIsMutedCounter < 1 {
+		if m.IsMutedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.IsMuted at\n%s", m.IsMutedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.IsMuted at\n%s with params: %#v", m.IsMutedMock.defaultExpectation.expectationOrigins.origin, *m.IsMutedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsMuted != nil && afterIsMutedCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.IsMuted at\n%s", m.funcIsMutedOrigin)
+	}
+
+	if !m.IsMutedMock.invocationsDone() && afterIsMutedCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.IsMuted at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IsMutedMock.expectedInvocations), m.IsMutedMock.expectedInvocationsOrigin, afterIsMutedCounter)
+	}
+}
+
+type mChatRepositoryMockListRetention struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockListRetentionExpectation
+	expectations       []*ChatRepositoryMockListRetentionExpectation
+
+	callArgs []*ChatRepositoryMockListRetentionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockListRetentionExpectation specifies expectation struct of the ChatRepository.ListRetention
+type ChatRepositoryMockListRetentionExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockListRetentionParams
+	paramPtrs          *ChatRepositoryMockListRetentionParamPtrs
+	expectationOrigins ChatRepositoryMockListRetentionExpectationOrigins
+	results            *ChatRepositoryMockListRetentionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockListRetentionParams contains parameters of the ChatRepository.ListRetention
+type ChatRepositoryMockListRetentionParams struct {
+	ctx     context.Context
+	afterID int64
+	limit   uint64
+}
+
+// ChatRepositoryMockListRetentionParamPtrs contains pointers to parameters of the ChatRepository.ListRetention
+type ChatRepositoryMockListRetentionParamPtrs struct {
+	ctx     *context.Context
+	afterID *int64
+	limit   *uint64
+}
+
+// ChatRepositoryMockListRetentionResults contains results of the ChatRepository.ListRetention
+type ChatRepositoryMockListRetentionResults struct {
+	cpa1 []*model.ChatRetention
+	err  error
+}
+
+// ChatRepositoryMockListRetentionOrigins contains origins of expectations of the ChatRepository.ListRetention
+type ChatRepositoryMockListRetentionExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originAfterID string
+	originLimit   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListRetention *mChatRepositoryMockListRetention) Optional() *mChatRepositoryMockListRetention {
+	mmListRetention.optional = true
+	return mmListRetention
+}
+
+// Expect sets up expected params for ChatRepository.ListRetention
+func (mmListRetention *mChatRepositoryMockListRetention) Expect(ctx context.Context, afterID int64, limit uint64) *mChatRepositoryMockListRetention {
+	if mmListRetention.mock.funcListRetention != nil {
+		mmListRetention.mock.t.Fatalf("ChatRepositoryMock.ListRetention mock is already set by Set")
+	}
+
+	if mmListRetention.defaultExpectation == nil {
+		mmListRetention.defaultExpectation = &ChatRepositoryMockListRetentionExpectation{}
+	}
+
+	if mmListRetention.defaultExpectation.paramPtrs != nil {
+		mmListRetention.mock.t.Fatalf("ChatRepositoryMock.ListRetention mock is already set by ExpectParams functions")
+	}
+
+	mmListRetention.defaultExpectation.params = &ChatRepositoryMockListRetentionParams{ctx, afterID, limit}
+	mmListRetention.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListRetention.expectations {
+		if minimock.Equal(e.params, mmListRetention.defaultExpectation.params) {
+			mmListRetention.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListRetention.defaultExpectation.params)
+		}
+	}
+
+	return mmListRetention
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.ListRetention
+func (mmListRetention *mChatRepositoryMockListRetention) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockListRetention {
+	if mmListRetention.mock.funcListRetention != nil {
+		mmListRetention.mock.t.Fatalf("ChatRepositoryMock.ListRetention mock is already set by Set")
+	}
+
+	if mmListRetention.defaultExpectation == nil {
+		mmListRetention.defaultExpectation = &ChatRepositoryMockListRetentionExpectation{}
+	}
+
+	if mmListRetention.defaultExpectation.params != nil {
+		mmListRetention.mock.t.Fatalf("ChatRepositoryMock.ListRetention mock is already set by Expect")
+	}
+
+	if mmListRetention.defaultExpectation.paramPtrs == nil {
+		mmListRetention.defaultExpectation.paramPtrs = &ChatRepositoryMockListRetentionParamPtrs{}
+	}
+	mmListRetention.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListRetention.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListRetention
+}
+
+// ExpectAfterIDParam2 sets up expected param afterID for ChatRepository.ListRetention
+func (mmListRetention *mChatRepositoryMockListRetention) ExpectAfterIDParam2(afterID int64) *mChatRepositoryMockListRetention {
+	if mmListRetention.mock.funcListRetention != nil {
+		mmListRetention.mock.t.Fatalf("ChatRepositoryMock.ListRetention mock is already set by Set")
+	}
+
+	if mmListRetention.defaultExpectation == nil {
+		mmListRetention.defaultExpectation = &ChatRepositoryMockListRetentionExpectation{}
+	}
+
+	if mmListRetention.defaultExpectation.params != nil {
+		mmListRetention.mock.t.Fatalf("ChatRepositoryMock.ListRetention mock is already set by Expect")
+	}
+
+	if mmListRetention.defaultExpectation.paramPtrs == nil {
+		mmListRetention.defaultExpectation.paramPtrs = &ChatRepositoryMockListRetentionParamPtrs{}
+	}
+	mmListRetention.defaultExpectation.paramPtrs.afterID = &afterID
+	mmListRetention.defaultExpectation.expectationOrigins.originAfterID = minimock.CallerInfo(1)
+
+	return mmListRetention
+}
+
+// ExpectLimitParam3 sets up expected param limit for ChatRepository.ListRetention
+func (mmListRetention *mChatRepositoryMockListRetention) ExpectLimitParam3(limit uint64) *mChatRepositoryMockListRetention {
+	if mmListRetention.mock.funcListRetention != nil {
+		mmListRetention.mock.t.Fatalf("ChatRepositoryMock.ListRetention mock is already set by Set")
+	}
+
+	if mmListRetention.defaultExpectation == nil {
+		mmListRetention.defaultExpectation = &ChatRepositoryMockListRetentionExpectation{}
+	}
+
+	if mmListRetention.defaultExpectation.params != nil {
+		mmListRetention.mock.t.Fatalf("ChatRepositoryMock.ListRetention mock is already set by Expect")
+	}
+
+	if mmListRetention.defaultExpectation.paramPtrs == nil {
+		mmListRetention.defaultExpectation.paramPtrs = &ChatRepositoryMockListRetentionParamPtrs{}
+	}
+	mmListRetention.defaultExpectation.paramPtrs.limit = &limit
+	mmListRetention.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListRetention
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.ListRetention
+func (mmListRetention *mChatRepositoryMockListRetention) Inspect(f func(ctx context.Context, afterID int64, limit uint64)) *mChatRepositoryMockListRetention {
+	if mmListRetention.mock.inspectFuncListRetention != nil {
+		mmListRetention.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.ListRetention")
+	}
+
+	mmListRetention.mock.inspectFuncListRetention = f
+
+	return mmListRetention
+}
+
+// Return sets up results that will be returned by ChatRepository.ListRetention
+func (mmListRetention *mChatRepositoryMockListRetention) Return(cpa1 []*model.ChatRetention, err error) *ChatRepositoryMock {
+	if mmListRetention.mock.funcListRetention != nil {
+		mmListRetention.mock.t.Fatalf("ChatRepositoryMock.ListRetention mock is already set by Set")
+	}
+
+	if mmListRetention.defaultExpectation == nil {
+		mmListRetention.defaultExpectation = &ChatRepositoryMockListRetentionExpectation{mock: mmListRetention.mock}
+	}
+	mmListRetention.defaultExpectation.results = &ChatRepositoryMockListRetentionResults{cpa1, err}
+	mmListRetention.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListRetention.mock
+}
+
+// Set uses given function f to mock the ChatRepository.ListRetention method
+func (mmListRetention *mChatRepositoryMockListRetention) Set(f func(ctx context.Context, afterID int64, limit uint64) (cpa1 []*model.ChatRetention, err error)) *ChatRepositoryMock {
+	if mmListRetention.defaultExpectation != nil {
+		mmListRetention.mock.t.Fatalf("Default expectation is already set for the ChatRepository.ListRetention method")
+	}
+
+	if len(mmListRetention.expectations) > 0 {
+		mmListRetention.mock.t.Fatalf("Some expectations are already set for the ChatRepository.ListRetention method")
+	}
+
+	mmListRetention.mock.funcListRetention = f
+	mmListRetention.mock.funcListRetentionOrigin = minimock.CallerInfo(1)
+	return mmListRetention.mock
+}
+
+// When sets expectation for the ChatRepository.ListRetention which will trigger the result defined by the following
+// Then helper
+func (mmListRetention *mChatRepositoryMockListRetention) When(ctx context.Context, afterID int64, limit uint64) *ChatRepositoryMockListRetentionExpectation {
+	if mmListRetention.mock.funcListRetention != nil {
+		mmListRetention.mock.t.Fatalf("ChatRepositoryMock.ListRetention mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockListRetentionExpectation{
+		mock:               mmListRetention.mock,
+		params:             &ChatRepositoryMockListRetentionParams{ctx, afterID, limit},
+		expectationOrigins: ChatRepositoryMockListRetentionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListRetention.expectations = append(mmListRetention.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.ListRetention return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockListRetentionExpectation) Then(cpa1 []*model.ChatRetention, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockListRetentionResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.ListRetention should be invoked
+func (mmListRetention *mChatRepositoryMockListRetention) Times(n uint64) *mChatRepositoryMockListRetention {
+	if n == 0 {
+		mmListRetention.mock.t.Fatalf("Times of ChatRepositoryMock.ListRetention mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListRetention.expectedInvocations, n)
+	mmListRetention.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListRetention
+}
+
+func (mmListRetention *mChatRepositoryMockListRetention) invocationsDone() bool {
+	if len(mmListRetention.expectations) == 0 && mmListRetention.defaultExpectation == nil && mmListRetention.mock.funcListRetention == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListRetention.mock.afterListRetentionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListRetention.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListRetention implements mm_repository.ChatRepository
+func (mmListRetention *ChatRepositoryMock) ListRetention(ctx context.Context, afterID int64, limit uint64) (cpa1 []*model.ChatRetention, err error) {
+	mm_atomic.AddUint64(&mmListRetention.beforeListRetentionCounter, 1)
+	defer mm_atomic.AddUint64(&mmListRetention.afterListRetentionCounter, 1)
+
+	mmListRetention.t.Helper()
+
+	if mmListRetention.inspectFuncListRetention != nil {
+		mmListRetention.inspectFuncListRetention(ctx, afterID, limit)
+	}
+
+	mm_params := ChatRepositoryMockListRetentionParams{ctx, afterID, limit}
+
+	// Record call args
+	mmListRetention.ListRetentionMock.mutex.Lock()
+	mmListRetention.ListRetentionMock.callArgs = append(mmListRetention.ListRetentionMock.callArgs, &mm_params)
+	mmListRetention.ListRetentionMock.mutex.Unlock()
+
+	for _, e := range mmListRetention.ListRetentionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmListRetention.ListRetentionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListRetention.ListRetentionMock.defaultExpectation.Counter, 1)
+		mm_want := mmListRetention.ListRetentionMock.defaultExpectation.params
+		mm_want_ptrs := mmListRetention.ListRetentionMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockListRetentionParams{ctx, afterID, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListRetention.t.Errorf("ChatRepositoryMock.ListRetention got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRetention.ListRetentionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.afterID != nil && !minimock.Equal(*mm_want_ptrs.afterID, mm_got.afterID) {
+				mmListRetention.t.Errorf("ChatRepositoryMock.ListRetention got unexpected parameter afterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRetention.ListRetentionMock.defaultExpectation.expectationOrigins.originAfterID, *mm_want_ptrs.afterID, mm_got.afterID, minimock.Diff(*mm_want_ptrs.afterID, mm_got.afterID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListRetention.t.Errorf("ChatRepositoryMock.ListRetention got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRetention.ListRetentionMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListRetention.t.Errorf("ChatRepositoryMock.ListRetention got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListRetention.ListRetentionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListRetention.ListRetentionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListRetention.t.Fatal("No results are set for the ChatRepositoryMock.ListRetention")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmListRetention.funcListRetention != nil {
+		return mmListRetention.funcListRetention(ctx, afterID, limit)
+	}
+	mmListRetention.t.Fatalf("Unexpected call to ChatRepositoryMock.ListRetention. %v %v %v", ctx, afterID, limit)
+	return
+}
+
+// ListRetentionAfterCounter returns a count of finished ChatRepositoryMock.ListRetention invocations
+func (mmListRetention *ChatRepositoryMock) ListRetentionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRetention.afterListRetentionCounter)
+}
+
+// ListRetentionBeforeCounter returns a count of ChatRepositoryMock.ListRetention invocations
+func (mmListRetention *ChatRepositoryMock) ListRetentionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRetention.beforeListRetentionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.ListRetention.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListRetention *mChatRepositoryMockListRetention) Calls() []*ChatRepositoryMockListRetentionParams {
+	mmListRetention.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockListRetentionParams, len(mmListRetention.callArgs))
+	copy(argCopy, mmListRetention.callArgs)
+
+	mmListRetention.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRetentionDone returns true if the count of the ListRetention invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockListRetentionDone() bool {
+	if m.ListRetentionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRetentionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRetentionMock.invocationsDone()
+}
+
+// MinimockListRetentionInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockListRetentionInspect() {
+	for _, e := range m.ListRetentionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListRetention at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRetentionCounter := mm_atomic.LoadUint64(&m.afterListRetentionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRetentionMock.defaultExpectation != nil && afterListRetentionCounter < 1 {
+		if m.ListRetentionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListRetention at\n%s", m.ListRetentionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListRetention at\n%s with params: %#v", m.ListRetentionMock.defaultExpectation.expectationOrigins.origin, *m.ListRetentionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRetention != nil && afterListRetentionCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.ListRetention at\n%s", m.funcListRetentionOrigin)
+	}
+
+	if !m.ListRetentionMock.invocationsDone() && afterListRetentionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.ListRetention at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRetentionMock.expectedInvocations), m.ListRetentionMock.expectedInvocationsOrigin, afterListRetentionCounter)
+	}
+}
+
+type mChatRepositoryMockMute struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockMuteExpectation
+	expectations       []*ChatRepositoryMockMuteExpectation
+
+	callArgs []*ChatRepositoryMockMuteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockMuteExpectation specifies expectation struct of the ChatRepository.Mute
+type ChatRepositoryMockMuteExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockMuteParams
+	paramPtrs          *ChatRepositoryMockMuteParamPtrs
+	expectationOrigins ChatRepositoryMockMuteExpectationOrigins
+	results            *ChatRepositoryMockMuteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockMuteParams contains parameters of the ChatRepository.Mute
+type ChatRepositoryMockMuteParams struct {
+	ctx      context.Context
+	id       int64
+	username string
+	duration time.Duration
+}
+
+// ChatRepositoryMockMuteParamPtrs contains pointers to parameters of the ChatRepository.Mute
+type ChatRepositoryMockMuteParamPtrs struct {
+	ctx      *context.Context
+	id       *int64
+	username *string
+	duration *time.Duration
+}
+
+// ChatRepositoryMockMuteResults contains results of the ChatRepository.Mute
+type ChatRepositoryMockMuteResults struct {
+	err error
+}
+
+// ChatRepositoryMockMuteOrigins contains origins of expectations of the ChatRepository.Mute
+type ChatRepositoryMockMuteExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originId       string
+	originUsername string
+	originDuration string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMute *mChatRepositoryMockMute) Optional() *mChatRepositoryMockMute {
+	mmMute.optional = true
+	return mmMute
+}
+
+// Expect sets up expected params for ChatRepository.Mute
+func (mmMute *mChatRepositoryMockMute) Expect(ctx context.Context, id int64, username string, duration time.Duration) *mChatRepositoryMockMute {
+	if mmMute.mock.funcMute != nil {
+		mmMute.mock.t.Fatalf("ChatRepositoryMock.Mute mock is already set by Set")
+	}
+
+	if mmMute.defaultExpectation == nil {
+		mmMute.defaultExpectation = &ChatRepositoryMockMuteExpectation{}
+	}
+
+	if mmMute.defaultExpectation.paramPtrs != nil {
+		mmMute.mock.t.Fatalf("ChatRepositoryMock.Mute mock is already set by ExpectParams functions")
+	}
+
+	mmMute.defaultExpectation.params = &ChatRepositoryMockMuteParams{ctx, id, username, duration}
+	mmMute.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMute.expectations {
+		if minimock.Equal(e.params, mmMute.defaultExpectation.params) {
+			mmMute.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMute.defaultExpectation.params)
+		}
+	}
+
+	return mmMute
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.Mute
+func (mmMute *mChatRepositoryMockMute) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockMute {
+	if mmMute.mock.funcMute != nil {
+		mmMute.mock.t.Fatalf("ChatRepositoryMock.Mute mock is already set by Set")
+	}
+
+	if mmMute.defaultExpectation == nil {
+		mmMute.defaultExpectation = &ChatRepositoryMockMuteExpectation{}
+	}
+
+	if mmMute.defaultExpectation.params != nil {
+		mmMute.mock.t.Fatalf("ChatRepositoryMock.Mute mock is already set by Expect")
+	}
+
+	if mmMute.defaultExpectation.paramPtrs == nil {
+		mmMute.defaultExpectation.paramPtrs = &ChatRepositoryMockMuteParamPtrs{}
+	}
+	mmMute.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMute.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMute
+}
+
+// ExpectIdParam2 sets up expected param id for ChatRepository.Mute
+func (mmMute *mChatRepositoryMockMute) ExpectIdParam2(id int64) *mChatRepositoryMockMute {
+	if mmMute.mock.funcMute != nil {
+		mmMute.mock.t.Fatalf("ChatRepositoryMock.Mute mock is already set by Set")
+	}
+
+	if mmMute.defaultExpectation == nil {
+		mmMute.defaultExpectation = &ChatRepositoryMockMuteExpectation{}
+	}
+
+	if mmMute.defaultExpectation.params != nil {
+		mmMute.mock.t.Fatalf("ChatRepositoryMock.Mute mock is already set by Expect")
+	}
+
+	if mmMute.defaultExpectation.paramPtrs == nil {
+		mmMute.defaultExpectation.paramPtrs = &ChatRepositoryMockMuteParamPtrs{}
+	}
+	mmMute.defaultExpectation.paramPtrs.id = &id
+	mmMute.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmMute
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.Mute
+func (mmMute *mChatRepositoryMockMute) ExpectUsernameParam3(username string) *mChatRepositoryMockMute {
+	if mmMute.mock.funcMute != nil {
+		mmMute.mock.t.Fatalf("ChatRepositoryMock.Mute mock is already set by Set")
+	}
+
+	if mmMute.defaultExpectation == nil {
+		mmMute.defaultExpectation = &ChatRepositoryMockMuteExpectation{}
+	}
+
+	if mmMute.defaultExpectation.params != nil {
+		mmMute.mock.t.Fatalf("ChatRepositoryMock.Mute mock is already set by Expect")
+	}
+
+	if mmMute.defaultExpectation.paramPtrs == nil {
+		mmMute.defaultExpectation.paramPtrs = &ChatRepositoryMockMuteParamPtrs{}
+	}
+	mmMute.defaultExpectation.paramPtrs.username = &username
+	mmMute.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmMute
+}
+
+// ExpectDurationParam4 sets up expected param duration for ChatRepository.Mute
+func (mmMute *mChatRepositoryMockMute) ExpectDurationParam4(duration time.Duration) *mChatRepositoryMockMute {
+	if mmMute.mock.funcMute != nil {
+		mmMute.mock.t.Fatalf("ChatRepositoryMock.Mute mock is already set by Set")
+	}
+
+	if mmMute.defaultExpectation == nil {
+		mmMute.defaultExpectation = &ChatRepositoryMockMuteExpectation{}
+	}
+
+	if mmMute.defaultExpectation.params != nil {
+		mmMute.mock.t.Fatalf("ChatRepositoryMock.Mute mock is already set by Expect")
+	}
+
+	if mmMute.defaultExpectation.paramPtrs == nil {
+		mmMute.defaultExpectation.paramPtrs = &ChatRepositoryMockMuteParamPtrs{}
+	}
+	mmMute.defaultExpectation.paramPtrs.duration = &duration
+	mmMute.defaultExpectation.expectationOrigins.originDuration = minimock.CallerInfo(1)
+
+	return mmMute
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.Mute
+func (mmMute *mChatRepositoryMockMute) Inspect(f func(ctx context.Context, id int64, username string, duration time.Duration)) *mChatRepositoryMockMute {
+	if mmMute.mock.inspectFuncMute != nil {
+		mmMute.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.Mute")
+	}
+
+	mmMute.mock.inspectFuncMute = f
+
+	return mmMute
+}
+
+// Return sets up results that will be returned by ChatRepository.Mute
+func (mmMute *mChatRepositoryMockMute) Return(err error) *ChatRepositoryMock {
+	if mmMute.mock.funcMute != nil {
+		mmMute.mock.t.Fatalf("ChatRepositoryMock.Mute mock is already set by Set")
+	}
+
+	if mmMute.defaultExpectation == nil {
+		mmMute.defaultExpectation = &ChatRepositoryMockMuteExpectation{mock: mmMute.mock}
+	}
+	mmMute.defaultExpectation.results = &ChatRepositoryMockMuteResults{err}
+	mmMute.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMute.mock
+}
+
+// Set uses given function f to mock the ChatRepository.Mute method
+func (mmMute *mChatRepositoryMockMute) Set(f func(ctx context.Context, id int64, username string, duration time.Duration) (err error)) *ChatRepositoryMock {
+	if mmMute.defaultExpectation != nil {
+		mmMute.mock.t.Fatalf("Default expectation is already set for the ChatRepository.Mute method")
+	}
+
+	if len(mmMute.expectations) > 0 {
+		mmMute.mock.t.Fatalf("Some expectations are already set for the ChatRepository.Mute method")
+	}
+
+	mmMute.mock.funcMute = f
+	mmMute.mock.funcMuteOrigin = minimock.CallerInfo(1)
+	return mmMute.mock
+}
+
+// When sets expectation for the ChatRepository.Mute which will trigger the result defined by the following
+// Then helper
+func (mmMute *mChatRepositoryMockMute) When(ctx context.Context, id int64, username string, duration time.Duration) *ChatRepositoryMockMuteExpectation {
+	if mmMute.mock.funcMute != nil {
+		mmMute.mock.t.Fatalf("ChatRepositoryMock.Mute mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockMuteExpectation{
+		mock:               mmMute.mock,
+		params:             &ChatRepositoryMockMuteParams{ctx, id, username, duration},
+		expectationOrigins: ChatRepositoryMockMuteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMute.expectations = append(mmMute.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.Mute return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockMuteExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockMuteResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.Mute should be invoked
+func (mmMute *mChatRepositoryMockMute) Times(n uint64) *mChatRepositoryMockMute {
+	if n == 0 {
+		mmMute.mock.t.Fatalf("Times of ChatRepositoryMock.Mute mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMute.expectedInvocations, n)
+	mmMute.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMute
+}
+
+func (mmMute *mChatRepositoryMockMute) invocationsDone() bool {
+	if len(mmMute.expectations) == 0 && mmMute.defaultExpectation == nil && mmMute.mock.funcMute == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMute.mock.afterMuteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMute.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Mute implements mm_repository.ChatRepository
+func (mmMute *ChatRepositoryMock) Mute(ctx context.Context, id int64, username string, duration time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmMute.beforeMuteCounter, 1)
+	defer mm_atomic.AddUint64(&mmMute.afterMuteCounter, 1)
+
+	mmMute.t.Helper()
+
+	if mmMute.inspectFuncMute != nil {
+		mmMute.inspectFuncMute(ctx, id, username, duration)
+	}
+
+	mm_params := ChatRepositoryMockMuteParams{ctx, id, username, duration}
+
+	// Record call args
+	mmMute.MuteMock.mutex.Lock()
+	mmMute.MuteMock.callArgs = append(mmMute.MuteMock.callArgs, &mm_params)
+	mmMute.MuteMock.mutex.Unlock()
+
+	for _, e := range mmMute.MuteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMute.MuteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMute.MuteMock.defaultExpectation.Counter, 1)
+		mm_want := mmMute.MuteMock.defaultExpectation.params
+		mm_want_ptrs := mmMute.MuteMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockMuteParams{ctx, id, username, duration}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMute.t.Errorf("ChatRepositoryMock.Mute got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMute.MuteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMute.t.Errorf("ChatRepositoryMock.Mute got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMute.MuteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmMute.t.Errorf("ChatRepositoryMock.Mute got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMute.MuteMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.duration != nil && !minimock.Equal(*mm_want_ptrs.duration, mm_got.duration) {
+				mmMute.t.Errorf("ChatRepositoryMock.Mute got unexpected parameter duration, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMute.MuteMock.defaultExpectation.expectationOrigins.originDuration, *mm_want_ptrs.duration, mm_got.duration, minimock.Diff(*mm_want_ptrs.duration, mm_got.duration))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMute.t.Errorf("ChatRepositoryMock.Mute got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMute.MuteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMute.MuteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMute.t.Fatal("No results are set for the ChatRepositoryMock.Mute")
+		}
+		return (*mm_results).err
+	}
+	if mmMute.funcMute != nil {
+		return mmMute.funcMute(ctx, id, username, duration)
+	}
+	mmMute.t.Fatalf("Unexpected call to ChatRepositoryMock.Mute. %v %v %v %v", ctx, id, username, duration)
+	return
+}
+
+// MuteAfterCounter returns a count of finished ChatRepositoryMock.Mute invocations
+func (mmMute *ChatRepositoryMock) MuteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMute.afterMuteCounter)
+}
+
+// MuteBeforeCounter returns a count of ChatRepositoryMock.Mute invocations
+func (mmMute *ChatRepositoryMock) MuteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMute.beforeMuteCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.Mute.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMute *mChatRepositoryMockMute) Calls() []*ChatRepositoryMockMuteParams {
+	mmMute.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockMuteParams, len(mmMute.callArgs))
+	copy(argCopy, mmMute.callArgs)
+
+	mmMute.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMuteDone returns true if the count of the Mute invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockMuteDone() bool {
+	if m.MuteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MuteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MuteMock.invocationsDone()
+}
+
+// MinimockMuteInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockMuteInspect() {
+	for _, e := range m.MuteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Mute at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMuteCounter := mm_atomic.LoadUint64(&m.afterMuteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MuteMock.defaultExpectation != nil && afterMuteCounter < 1 {
+		if m.MuteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Mute at\n%s", m.MuteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Mute at\n%s with params: %#v", m.MuteMock.defaultExpectation.expectationOrigins.origin, *m.MuteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMute != nil && afterMuteCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.Mute at\n%s", m.funcMuteOrigin)
+	}
+
+	if !m.MuteMock.invocationsDone() && afterMuteCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.Mute at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MuteMock.expectedInvocations), m.MuteMock.expectedInvocationsOrigin, afterMuteCounter)
+	}
+}
+
+type mChatRepositoryMockPurgeDeleted struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockPurgeDeletedExpectation
+	expectations       []*ChatRepositoryMockPurgeDeletedExpectation
+
+	callArgs []*ChatRepositoryMockPurgeDeletedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockPurgeDeletedExpectation specifies expectation struct of the ChatRepository.PurgeDeleted
+type ChatRepositoryMockPurgeDeletedExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockPurgeDeletedParams
+	paramPtrs          *ChatRepositoryMockPurgeDeletedParamPtrs
+	expectationOrigins ChatRepositoryMockPurgeDeletedExpectationOrigins
+	results            *ChatRepositoryMockPurgeDeletedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockPurgeDeletedParams contains parameters of the ChatRepository.PurgeDeleted
+type ChatRepositoryMockPurgeDeletedParams struct {
+	ctx   context.Context
+	grace time.Duration
+}
+
+// ChatRepositoryMockPurgeDeletedParamPtrs contains pointers to parameters of the ChatRepository.PurgeDeleted
+type ChatRepositoryMockPurgeDeletedParamPtrs struct {
+	ctx   *context.Context
+	grace *time.Duration
+}
+
+// ChatRepositoryMockPurgeDeletedResults contains results of the ChatRepository.PurgeDeleted
+type ChatRepositoryMockPurgeDeletedResults struct {
+	i1  int64
+	err error
+}
+
+// ChatRepositoryMockPurgeDeletedOrigins contains origins of expectations of the ChatRepository.PurgeDeleted
+type ChatRepositoryMockPurgeDeletedExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originGrace string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeDeleted *mChatRepositoryMockPurgeDeleted) Optional() *mChatRepositoryMockPurgeDeleted {
+	mmPurgeDeleted.optional = true
+	return mmPurgeDeleted
+}
+
+// Expect sets up expected params for ChatRepository.PurgeDeleted
+func (mmPurgeDeleted *mChatRepositoryMockPurgeDeleted) Expect(ctx context.Context, grace time.Duration) *mChatRepositoryMockPurgeDeleted {
+	if mmPurgeDeleted.mock.funcPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("ChatRepositoryMock.PurgeDeleted mock is already set by Set")
+	}
+
+	if mmPurgeDeleted.defaultExpectation == nil {
+		mmPurgeDeleted.defaultExpectation = &ChatRepositoryMockPurgeDeletedExpectation{}
+	}
+
+	if mmPurgeDeleted.defaultExpectation.paramPtrs != nil {
+		mmPurgeDeleted.mock.t.Fatalf("ChatRepositoryMock.PurgeDeleted mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeDeleted.defaultExpectation.params = &ChatRepositoryMockPurgeDeletedParams{ctx, grace}
+	mmPurgeDeleted.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeDeleted.expectations {
+		if minimock.Equal(e.params, mmPurgeDeleted.defaultExpectation.params) {
+			mmPurgeDeleted.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeDeleted.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeDeleted
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.PurgeDeleted
+func (mmPurgeDeleted *mChatRepositoryMockPurgeDeleted) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockPurgeDeleted {
+	if mmPurgeDeleted.mock.funcPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("ChatRepositoryMock.PurgeDeleted mock is already set by Set")
+	}
+
+	if mmPurgeDeleted.defaultExpectation == nil {
+		mmPurgeDeleted.defaultExpectation = &ChatRepositoryMockPurgeDeletedExpectation{}
+	}
+
+	if mmPurgeDeleted.defaultExpectation.params != nil {
+		mmPurgeDeleted.mock.t.Fatalf("ChatRepositoryMock.PurgeDeleted mock is already set by Expect")
+	}
+
+	if mmPurgeDeleted.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeleted.defaultExpectation.paramPtrs = &ChatRepositoryMockPurgeDeletedParamPtrs{}
+	}
+	mmPurgeDeleted.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeDeleted.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeDeleted
+}
+
+// ExpectGraceParam2 sets up expected param grace for ChatRepository.PurgeDeleted
+func (mmPurgeDeleted *mChatRepositoryMockPurgeDeleted) ExpectGraceParam2(grace time.Duration) *mChatRepositoryMockPurgeDeleted {
+	if mmPurgeDeleted.mock.funcPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("ChatRepositoryMock.PurgeDeleted mock is already set by Set")
+	}
+
+	if mmPurgeDeleted.defaultExpectation == nil {
+		mmPurgeDeleted.defaultExpectation = &ChatRepositoryMockPurgeDeletedExpectation{}
+	}
+
+	if mmPurgeDeleted.defaultExpectation.params != nil {
+		mmPurgeDeleted.mock.t.Fatalf("ChatRepositoryMock.PurgeDeleted mock is already set by Expect")
+	}
+
+	if mmPurgeDeleted.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeleted.defaultExpectation.paramPtrs = &ChatRepositoryMockPurgeDeletedParamPtrs{}
+	}
+	mmPurgeDeleted.defaultExpectation.paramPtrs.grace = &grace
+	mmPurgeDeleted.defaultExpectation.expectationOrigins.originGrace = minimock.CallerInfo(1)
+
+	return mmPurgeDeleted
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.PurgeDeleted
+func (mmPurgeDeleted *mChatRepositoryMockPurgeDeleted) Inspect(f func(ctx context.Context, grace time.Duration)) *mChatRepositoryMockPurgeDeleted {
+	if mmPurgeDeleted.mock.inspectFuncPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.PurgeDeleted")
+	}
+
+	mmPurgeDeleted.mock.inspectFuncPurgeDeleted = f
+
+	return mmPurgeDeleted
+}
+
+// Return sets up results that will be returned by ChatRepository.PurgeDeleted
+func (mmPurgeDeleted *mChatRepositoryMockPurgeDeleted) Return(i1 int64, err error) *ChatRepositoryMock {
+	if mmPurgeDeleted.mock.funcPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("ChatRepositoryMock.PurgeDeleted mock is already set by Set")
+	}
+
+	if mmPurgeDeleted.defaultExpectation == nil {
+		mmPurgeDeleted.defaultExpectation = &ChatRepositoryMockPurgeDeletedExpectation{mock: mmPurgeDeleted.mock}
+	}
+	mmPurgeDeleted.defaultExpectation.results = &ChatRepositoryMockPurgeDeletedResults{i1, err}
+	mmPurgeDeleted.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeleted.mock
+}
+
+// Set uses given function f to mock the ChatRepository.PurgeDeleted method
+func (mmPurgeDeleted *mChatRepositoryMockPurgeDeleted) Set(f func(ctx context.Context, grace time.Duration) (i1 int64, err error)) *ChatRepositoryMock {
+	if mmPurgeDeleted.defaultExpectation != nil {
+		mmPurgeDeleted.mock.t.Fatalf("Default expectation is already set for the ChatRepository.PurgeDeleted method")
+	}
+
+	if len(mmPurgeDeleted.expectations) > 0 {
+		mmPurgeDeleted.mock.t.Fatalf("Some expectations are already set for the ChatRepository.PurgeDeleted method")
+	}
+
+	mmPurgeDeleted.mock.funcPurgeDeleted = f
+	mmPurgeDeleted.mock.funcPurgeDeletedOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeleted.mock
+}
+
+// When sets expectation for the ChatRepository.PurgeDeleted which will trigger the result defined by the following
+// Then helper
+func (mmPurgeDeleted *mChatRepositoryMockPurgeDeleted) When(ctx context.Context, grace time.Duration) *ChatRepositoryMockPurgeDeletedExpectation {
+	if mmPurgeDeleted.mock.funcPurgeDeleted != nil {
+		mmPurgeDeleted.mock.t.Fatalf("ChatRepositoryMock.PurgeDeleted mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockPurgeDeletedExpectation{
+		mock:               mmPurgeDeleted.mock,
+		params:             &ChatRepositoryMockPurgeDeletedParams{ctx, grace},
+		expectationOrigins: ChatRepositoryMockPurgeDeletedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeDeleted.expectations = append(mmPurgeDeleted.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.PurgeDeleted return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockPurgeDeletedExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockPurgeDeletedResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.PurgeDeleted should be invoked
+func (mmPurgeDeleted *mChatRepositoryMockPurgeDeleted) Times(n uint64) *mChatRepositoryMockPurgeDeleted {
+	if n == 0 {
+		mmPurgeDeleted.mock.t.Fatalf("Times of ChatRepositoryMock.PurgeDeleted mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeDeleted.expectedInvocations, n)
+	mmPurgeDeleted.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeleted
+}
+
+func (mmPurgeDeleted *mChatRepositoryMockPurgeDeleted) invocationsDone() bool {
+	if len(mmPurgeDeleted.expectations) == 0 && mmPurgeDeleted.defaultExpectation == nil && mmPurgeDeleted.mock.funcPurgeDeleted == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeDeleted.mock.afterPurgeDeletedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeDeleted.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeDeleted implements mm_repository.ChatRepository
+func (mmPurgeDeleted *ChatRepositoryMock) PurgeDeleted(ctx context.Context, grace time.Duration) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeDeleted.beforePurgeDeletedCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeDeleted.afterPurgeDeletedCounter, 1)
+
+	mmPurgeDeleted.t.Helper()
+
+	if mmPurgeDeleted.inspectFuncPurgeDeleted != nil {
+		mmPurgeDeleted.inspectFuncPurgeDeleted(ctx, grace)
+	}
+
+	mm_params := ChatRepositoryMockPurgeDeletedParams{ctx, grace}
+
+	// Record call args
+	mmPurgeDeleted.PurgeDeletedMock.mutex.Lock()
+	mmPurgeDeleted.PurgeDeletedMock.callArgs = append(mmPurgeDeleted.PurgeDeletedMock.callArgs, &mm_params)
+	mmPurgeDeleted.PurgeDeletedMock.mutex.Unlock()
+
+	for _, e := range mmPurgeDeleted.PurgeDeletedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeDeleted.PurgeDeletedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockPurgeDeletedParams{ctx, grace}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeDeleted.t.Errorf("ChatRepositoryMock.PurgeDeleted got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.grace != nil && !minimock.Equal(*mm_want_ptrs.grace, mm_got.grace) {
+				mmPurgeDeleted.t.Errorf("ChatRepositoryMock.PurgeDeleted got unexpected parameter grace, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.expectationOrigins.originGrace, *mm_want_ptrs.grace, mm_got.grace, minimock.Diff(*mm_want_ptrs.grace, mm_got.grace))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeDeleted.t.Errorf("ChatRepositoryMock.PurgeDeleted got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeDeleted.PurgeDeletedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeDeleted.t.Fatal("No results are set for the ChatRepositoryMock.PurgeDeleted")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeDeleted.funcPurgeDeleted != nil {
+		return mmPurgeDeleted.funcPurgeDeleted(ctx, grace)
+	}
+	mmPurgeDeleted.t.Fatalf("Unexpected call to ChatRepositoryMock.PurgeDeleted. %v %v", ctx, grace)
+	return
+}
+
+// PurgeDeletedAfterCounter returns a count of finished ChatRepositoryMock.PurgeDeleted invocations
+func (mmPurgeDeleted *ChatRepositoryMock) PurgeDeletedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeleted.afterPurgeDeletedCounter)
+}
+
+// PurgeDeletedBeforeCounter returns a count of ChatRepositoryMock.PurgeDeleted invocations
+func (mmPurgeDeleted *ChatRepositoryMock) PurgeDeletedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeleted.beforePurgeDeletedCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.PurgeDeleted.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeDeleted *mChatRepositoryMockPurgeDeleted) Calls() []*ChatRepositoryMockPurgeDeletedParams {
+	mmPurgeDeleted.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockPurgeDeletedParams, len(mmPurgeDeleted.callArgs))
+	copy(argCopy, mmPurgeDeleted.callArgs)
+
+	mmPurgeDeleted.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeDeletedDone returns true if the count of the PurgeDeleted invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockPurgeDeletedDone() bool {
+	if m.PurgeDeletedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeDeletedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeDeletedMock.invocationsDone()
+}
+
+// MinimockPurgeDeletedInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockPurgeDeletedInspect() {
+	for _, e := range m.PurgeDeletedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.PurgeDeleted at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeDeletedCounter := mm_atomic.LoadUint64(&m.afterPurgeDeletedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeDeletedMock.defaultExpectation != nil && afterPurgeDeletedCounter < 1 {
+		if m.PurgeDeletedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.PurgeDeleted at\n%s", m.PurgeDeletedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.PurgeDeleted at\n%s with params: %#v", m.PurgeDeletedMock.defaultExpectation.expectationOrigins.origin, *m.PurgeDeletedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeDeleted != nil && afterPurgeDeletedCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.PurgeDeleted at\n%s", m.funcPurgeDeletedOrigin)
+	}
+
+	if !m.PurgeDeletedMock.invocationsDone() && afterPurgeDeletedCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.PurgeDeleted at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeDeletedMock.expectedInvocations), m.PurgeDeletedMock.expectedInvocationsOrigin, afterPurgeDeletedCounter)
+	}
+}
+
+type mChatRepositoryMockRestore struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockRestoreExpectation
+	expectations       []*ChatRepositoryMockRestoreExpectation
+
+	callArgs []*ChatRepositoryMockRestoreParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockRestoreExpectation specifies expectation struct of the ChatRepository.Restore
+type ChatRepositoryMockRestoreExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockRestoreParams
+	paramPtrs          *ChatRepositoryMockRestoreParamPtrs
+	expectationOrigins ChatRepositoryMockRestoreExpectationOrigins
+	results            *ChatRepositoryMockRestoreResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockRestoreParams contains parameters of the ChatRepository.Restore
+type ChatRepositoryMockRestoreParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ChatRepositoryMockRestoreParamPtrs contains pointers to parameters of the ChatRepository.Restore
+type ChatRepositoryMockRestoreParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ChatRepositoryMockRestoreResults contains results of the ChatRepository.Restore
+type ChatRepositoryMockRestoreResults struct {
+	err error
+}
+
+// ChatRepositoryMockRestoreOrigins contains origins of expectations of the ChatRepository.Restore
+type ChatRepositoryMockRestoreExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRestore *mChatRepositoryMockRestore) Optional() *mChatRepositoryMockRestore {
+	mmRestore.optional = true
+	return mmRestore
+}
+
+// Expect sets up expected params for ChatRepository.Restore
+func (mmRestore *mChatRepositoryMockRestore) Expect(ctx context.Context, id int64) *mChatRepositoryMockRestore {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("ChatRepositoryMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &ChatRepositoryMockRestoreExpectation{}
+	}
+
+	if mmRestore.defaultExpectation.paramPtrs != nil {
+		mmRestore.mock.t.Fatalf("ChatRepositoryMock.Restore mock is already set by ExpectParams functions")
+	}
+
+	mmRestore.defaultExpectation.params = &ChatRepositoryMockRestoreParams{ctx, id}
+	mmRestore.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRestore.expectations {
+		if minimock.Equal(e.params, mmRestore.defaultExpectation.params) {
+			mmRestore.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestore.defaultExpectation.params)
+		}
+	}
+
+	return mmRestore
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.Restore
+func (mmRestore *mChatRepositoryMockRestore) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockRestore {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("ChatRepositoryMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &ChatRepositoryMockRestoreExpectation{}
+	}
+
+	if mmRestore.defaultExpectation.params != nil {
+		mmRestore.mock.t.Fatalf("ChatRepositoryMock.Restore mock is already set by Expect")
+	}
+
+	if mmRestore.defaultExpectation.paramPtrs == nil {
+		mmRestore.defaultExpectation.paramPtrs = &ChatRepositoryMockRestoreParamPtrs{}
+	}
+	mmRestore.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRestore.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRestore
+}
+
+// ExpectIdParam2 sets up expected param id for ChatRepository.Restore
+func (mmRestore *mChatRepositoryMockRestore) ExpectIdParam2(id int64) *mChatRepositoryMockRestore {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("ChatRepositoryMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &ChatRepositoryMockRestoreExpectation{}
+	}
+
+	if mmRestore.defaultExpectation.params != nil {
+		mmRestore.mock.t.Fatalf("ChatRepositoryMock.Restore mock is already set by Expect")
+	}
+
+	if mmRestore.defaultExpectation.paramPtrs == nil {
+		mmRestore.defaultExpectation.paramPtrs = &ChatRepositoryMockRestoreParamPtrs{}
+	}
+	mmRestore.defaultExpectation.paramPtrs.id = &id
+	mmRestore.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRestore
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.Restore
+func (mmRestore *mChatRepositoryMockRestore) Inspect(f func(ctx context.Context, id int64)) *mChatRepositoryMockRestore {
+	if mmRestore.mock.inspectFuncRestore != nil {
+		mmRestore.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.Restore")
+	}
+
+	mmRestore.mock.inspectFuncRestore = f
+
+	return mmRestore
+}
+
+// Return sets up results that will be returned by ChatRepository.Restore
+func (mmRestore *mChatRepositoryMockRestore) Return(err error) *ChatRepositoryMock {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("ChatRepositoryMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &ChatRepositoryMockRestoreExpectation{mock: mmRestore.mock}
+	}
+	mmRestore.defaultExpectation.results = &ChatRepositoryMockRestoreResults{err}
+	mmRestore.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRestore.mock
+}
+
+// Set uses given function f to mock the ChatRepository.Restore method
+func (mmRestore *mChatRepositoryMockRestore) Set(f func(ctx context.Context, id int64) (err error)) *ChatRepositoryMock {
+	if mmRestore.defaultExpectation != nil {
+		mmRestore.mock.t.Fatalf("Default expectation is already set for the ChatRepository.Restore method")
+	}
+
+	if len(mmRestore.expectations) > 0 {
+		mmRestore.mock.t.Fatalf("Some expectations are already set for the ChatRepository.Restore method")
+	}
+
+	mmRestore.mock.funcRestore = f
+	mmRestore.mock.funcRestoreOrigin = minimock.CallerInfo(1)
+	return mmRestore.mock
+}
+
+// When sets expectation for the ChatRepository.Restore which will trigger the result defined by the following
+// Then helper
+func (mmRestore *mChatRepositoryMockRestore) When(ctx context.Context, id int64) *ChatRepositoryMockRestoreExpectation {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("ChatRepositoryMock.Restore mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockRestoreExpectation{
+		mock:               mmRestore.mock,
+		params:             &ChatRepositoryMockRestoreParams{ctx, id},
+		expectationOrigins: ChatRepositoryMockRestoreExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRestore.expectations = append(mmRestore.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.Restore return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockRestoreExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockRestoreResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.Restore should be invoked
+func (mmRestore *mChatRepositoryMockRestore) Times(n uint64) *mChatRepositoryMockRestore {
+	if n == 0 {
+		mmRestore.mock.t.Fatalf("Times of ChatRepositoryMock.Restore mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRestore.expectedInvocations, n)
+	mmRestore.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRestore
+}
+
+func (mmRestore *mChatRepositoryMockRestore) invocationsDone() bool {
+	if len(mmRestore.expectations) == 0 && mmRestore.defaultExpectation == nil && mmRestore.mock.funcRestore == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRestore.mock.afterRestoreCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRestore.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Restore implements mm_repository.ChatRepository
+func (mmRestore *ChatRepositoryMock) Restore(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmRestore.beforeRestoreCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestore.afterRestoreCounter, 1)
+
+	mmRestore.t.Helper()
+
+	if mmRestore.inspectFuncRestore != nil {
+		mmRestore.inspectFuncRestore(ctx, id)
+	}
+
+	mm_params := ChatRepositoryMockRestoreParams{ctx, id}
+
+	// Record call args
+	mmRestore.RestoreMock.mutex.Lock()
+	mmRestore.RestoreMock.callArgs = append(mmRestore.RestoreMock.callArgs, &mm_params)
+	mmRestore.RestoreMock.mutex.Unlock()
+
+	for _, e := range mmRestore.RestoreMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRestore.RestoreMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestore.RestoreMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestore.RestoreMock.defaultExpectation.params
+		mm_want_ptrs := mmRestore.RestoreMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockRestoreParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRestore.t.Errorf("ChatRepositoryMock.Restore got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestore.RestoreMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRestore.t.Errorf("ChatRepositoryMock.Restore got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestore.RestoreMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestore.t.Errorf("ChatRepositoryMock.Restore got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRestore.RestoreMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRestore.RestoreMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRestore.t.Fatal("No results are set for the ChatRepositoryMock.Restore")
+		}
+		return (*mm_results).err
+	}
+	if mmRestore.funcRestore != nil {
+		return mmRestore.funcRestore(ctx, id)
+	}
+	mmRestore.t.Fatalf("Unexpected call to ChatRepositoryMock.Restore. %v %v", ctx, id)
+	return
+}
+
+// RestoreAfterCounter returns a count of finished ChatRepositoryMock.Restore invocations
+func (mmRestore *ChatRepositoryMock) RestoreAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestore.afterRestoreCounter)
+}
+
+// RestoreBeforeCounter returns a count of ChatRepositoryMock.Restore invocations
+func (mmRestore *ChatRepositoryMock) RestoreBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestore.beforeRestoreCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.Restore.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestore *mChatRepositoryMockRestore) Calls() []*ChatRepositoryMockRestoreParams {
+	mmRestore.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockRestoreParams, len(mmRestore.callArgs))
+	copy(argCopy, mmRestore.callArgs)
+
+	mmRestore.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreDone returns true if the count of the Restore invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockRestoreDone() bool {
+	if m.RestoreMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RestoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RestoreMock.invocationsDone()
+}
+
+// MinimockRestoreInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockRestoreInspect() {
+	for _, e := range m.RestoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Restore at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRestoreCounter := mm_atomic.LoadUint64(&m.afterRestoreCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreMock.defaultExpectation != nil && afterRestoreCounter < 1 {
+		if m.RestoreMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Restore at\n%s", m.RestoreMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Restore at\n%s with params: %#v", m.RestoreMock.defaultExpectation.expectationOrigins.origin, *m.RestoreMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestore != nil && afterRestoreCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.Restore at\n%s", m.funcRestoreOrigin)
+	}
+
+	if !m.RestoreMock.invocationsDone() && afterRestoreCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.Restore at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RestoreMock.expectedInvocations), m.RestoreMock.expectedInvocationsOrigin, afterRestoreCounter)
+	}
+}
+
+type mChatRepositoryMockSetArchived struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockSetArchivedExpectation
+	expectations       []*ChatRepositoryMockSetArchivedExpectation
+
+	callArgs []*ChatRepositoryMockSetArchivedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockSetArchivedExpectation specifies expectation struct of the ChatRepository.SetArchived
+type ChatRepositoryMockSetArchivedExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockSetArchivedParams
+	paramPtrs          *ChatRepositoryMockSetArchivedParamPtrs
+	expectationOrigins ChatRepositoryMockSetArchivedExpectationOrigins
+	results            *ChatRepositoryMockSetArchivedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockSetArchivedParams contains parameters of the ChatRepository.SetArchived
+type ChatRepositoryMockSetArchivedParams struct {
+	ctx      context.Context
+	id       int64
+	archived bool
+}
+
+// ChatRepositoryMockSetArchivedParamPtrs contains pointers to parameters of the ChatRepository.SetArchived
+type ChatRepositoryMockSetArchivedParamPtrs struct {
+	ctx      *context.Context
+	id       *int64
+	archived *bool
+}
+
+// ChatRepositoryMockSetArchivedResults contains results of the ChatRepository.SetArchived
+type ChatRepositoryMockSetArchivedResults struct {
+	err error
+}
+
+// ChatRepositoryMockSetArchivedOrigins contains origins of expectations of the ChatRepository.SetArchived
+type ChatRepositoryMockSetArchivedExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originId       string
+	originArchived string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetArchived *mChatRepositoryMockSetArchived) Optional() *mChatRepositoryMockSetArchived {
+	mmSetArchived.optional = true
+	return mmSetArchived
+}
+
+// Expect sets up expected params for ChatRepository.SetArchived
+func (mmSetArchived *mChatRepositoryMockSetArchived) Expect(ctx context.Context, id int64, archived bool) *mChatRepositoryMockSetArchived {
+	if mmSetArchived.mock.funcSetArchived != nil {
+		mmSetArchived.mock.t.Fatalf("ChatRepositoryMock.SetArchived mock is already set by Set")
+	}
+
+	if mmSetArchived.defaultExpectation == nil {
+		mmSetArchived.defaultExpectation = &ChatRepositoryMockSetArchivedExpectation{}
+	}
+
+	if mmSetArchived.defaultExpectation.paramPtrs != nil {
+		mmSetArchived.mock.t.Fatalf("ChatRepositoryMock.SetArchived mock is already set by ExpectParams functions")
+	}
+
+	mmSetArchived.defaultExpectation.params = &ChatRepositoryMockSetArchivedParams{ctx, id, archived}
+	mmSetArchived.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetArchived.expectations {
+		if minimock.Equal(e.params, mmSetArchived.defaultExpectation.params) {
+			mmSetArchived.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetArchived.defaultExpectation.params)
+		}
+	}
+
+	return mmSetArchived
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.SetArchived
+func (mmSetArchived *mChatRepositoryMockSetArchived) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockSetArchived {
+	if mmSetArchived.mock.funcSetArchived != nil {
+		mmSetArchived.mock.t.Fatalf("ChatRepositoryMock.SetArchived mock is already set by Set")
+	}
+
+	if mmSetArchived.defaultExpectation == nil {
+		mmSetArchived.defaultExpectation = &ChatRepositoryMockSetArchivedExpectation{}
+	}
+
+	if mmSetArchived.defaultExpectation.params != nil {
+		mmSetArchived.mock.t.Fatalf("ChatRepositoryMock.SetArchived mock is already set by Expect")
+	}
+
+	if mmSetArchived.defaultExpectation.paramPtrs == nil {
+		mmSetArchived.defaultExpectation.paramPtrs = &ChatRepositoryMockSetArchivedParamPtrs{}
+	}
+	mmSetArchived.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetArchived.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetArchived
+}
+
+// ExpectIdParam2 sets up expected param id for ChatRepository.SetArchived
+func (mmSetArchived *mChatRepositoryMockSetArchived) ExpectIdParam2(id int64) *mChatRepositoryMockSetArchived {
+	if mmSetArchived.mock.funcSetArchived != nil {
+		mmSetArchived.mock.t.Fatalf("ChatRepositoryMock.SetArchived mock is already set by Set")
+	}
+
+	if mmSetArchived.defaultExpectation == nil {
+		mmSetArchived.defaultExpectation = &ChatRepositoryMockSetArchivedExpectation{}
+	}
+
+	if mmSetArchived.defaultExpectation.params != nil {
+		mmSetArchived.mock.t.Fatalf("ChatRepositoryMock.SetArchived mock is already set by Expect")
+	}
+
+	if mmSetArchived.defaultExpectation.paramPtrs == nil {
+		mmSetArchived.defaultExpectation.paramPtrs = &ChatRepositoryMockSetArchivedParamPtrs{}
+	}
+	mmSetArchived.defaultExpectation.paramPtrs.id = &id
+	mmSetArchived.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmSetArchived
+}
+
+// ExpectArchivedParam3 sets up expected param archived for ChatRepository.SetArchived
+func (mmSetArchived *mChatRepositoryMockSetArchived) ExpectArchivedParam3(archived bool) *mChatRepositoryMockSetArchived {
+	if mmSetArchived.mock.funcSetArchived != nil {
+		mmSetArchived.mock.t.Fatalf("ChatRepositoryMock.SetArchived mock is already set by Set")
+	}
+
+	if mmSetArchived.defaultExpectation == nil {
+		mmSetArchived.defaultExpectation = &ChatRepositoryMockSetArchivedExpectation{}
+	}
+
+	if mmSetArchived.defaultExpectation.params != nil {
+		mmSetArchived.mock.t.Fatalf("ChatRepositoryMock.SetArchived mock is already set by Expect")
+	}
+
+	if mmSetArchived.defaultExpectation.paramPtrs == nil {
+		mmSetArchived.defaultExpectation.paramPtrs = &ChatRepositoryMockSetArchivedParamPtrs{}
+	}
+	mmSetArchived.defaultExpectation.paramPtrs.archived = &archived
+	mmSetArchived.defaultExpectation.expectationOrigins.originArchived = minimock.CallerInfo(1)
+
+	return mmSetArchived
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.SetArchived
+func (mmSetArchived *mChatRepositoryMockSetArchived) Inspect(f func(ctx context.Context, id int64, archived bool)) *mChatRepositoryMockSetArchived {
+	if mmSetArchived.mock.inspectFuncSetArchived != nil {
+		mmSetArchived.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.SetArchived")
+	}
+
+	mmSetArchived.mock.inspectFuncSetArchived = f
+
+	return mmSetArchived
+}
+
+// Return sets up results that will be returned by ChatRepository.SetArchived
+func (mmSetArchived *mChatRepositoryMockSetArchived) Return(err error) *ChatRepositoryMock {
+	if mmSetArchived.mock.funcSetArchived != nil {
+		mmSetArchived.mock.t.Fatalf("ChatRepositoryMock.SetArchived mock is already set by Set")
+	}
+
+	if mmSetArchived.defaultExpectation == nil {
+		mmSetArchived.defaultExpectation = &ChatRepositoryMockSetArchivedExpectation{mock: mmSetArchived.mock}
+	}
+	mmSetArchived.defaultExpectation.results = &ChatRepositoryMockSetArchivedResults{err}
+	mmSetArchived.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetArchived.mock
+}
+
+// Set uses given function f to mock the ChatRepository.SetArchived method
+func (mmSetArchived *mChatRepositoryMockSetArchived) Set(f func(ctx context.Context, id int64, archived bool) (err error)) *ChatRepositoryMock {
+	if mmSetArchived.defaultExpectation != nil {
+		mmSetArchived.mock.t.Fatalf("Default expectation is already set for the ChatRepository.SetArchived method")
+	}
+
+	if len(mmSetArchived.expectations) > 0 {
+		mmSetArchived.mock.t.Fatalf("Some expectations are already set for the ChatRepository.SetArchived method")
+	}
+
+	mmSetArchived.mock.funcSetArchived = f
+	mmSetArchived.mock.funcSetArchivedOrigin = minimock.CallerInfo(1)
+	return mmSetArchived.mock
+}
+
+// When sets expectation for the ChatRepository.SetArchived which will trigger the result defined by the following
+// Then helper
+func (mmSetArchived *mChatRepositoryMockSetArchived) When(ctx context.Context, id int64, archived bool) *ChatRepositoryMockSetArchivedExpectation {
+	if mmSetArchived.mock.funcSetArchived != nil {
+		mmSetArchived.mock.t.Fatalf("ChatRepositoryMock.SetArchived mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockSetArchivedExpectation{
+		mock:               mmSetArchived.mock,
+		params:             &ChatRepositoryMockSetArchivedParams{ctx, id, archived},
+		expectationOrigins: ChatRepositoryMockSetArchivedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetArchived.expectations = append(mmSetArchived.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.SetArchived return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockSetArchivedExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockSetArchivedResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.SetArchived should be invoked
+func (mmSetArchived *mChatRepositoryMockSetArchived) Times(n uint64) *mChatRepositoryMockSetArchived {
+	if n == 0 {
+		mmSetArchived.mock.t.Fatalf("Times of ChatRepositoryMock.SetArchived mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetArchived.expectedInvocations, n)
+	mmSetArchived.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetArchived
+}
+
+func (mmSetArchived *mChatRepositoryMockSetArchived) invocationsDone() bool {
+	if len(mmSetArchived.expectations) == 0 && mmSetArchived.defaultExpectation == nil && mmSetArchived.mock.funcSetArchived == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetArchived.mock.afterSetArchivedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetArchived.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetArchived implements mm_repository.ChatRepository
+func (mmSetArchived *ChatRepositoryMock) SetArchived(ctx context.Context, id int64, archived bool) (err error) {
+	mm_atomic.AddUint64(&mmSetArchived.beforeSetArchivedCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetArchived.afterSetArchivedCounter, 1)
+
+	mmSetArchived.t.Helper()
+
+	if mmSetArchived.inspectFuncSetArchived != nil {
+		mmSetArchived.inspectFuncSetArchived(ctx, id, archived)
+	}
+
+	mm_params := ChatRepositoryMockSetArchivedParams{ctx, id, archived}
+
+	// Record call args
+	mmSetArchived.SetArchivedMock.mutex.Lock()
+	mmSetArchived.SetArchivedMock.callArgs = append(mmSetArchived.SetArchivedMock.callArgs, &mm_params)
+	mmSetArchived.SetArchivedMock.mutex.Unlock()
+
+	for _, e := range mmSetArchived.SetArchivedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetArchived.SetArchivedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetArchived.SetArchivedMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetArchived.SetArchivedMock.defaultExpectation.params
+		mm_want_ptrs := mmSetArchived.SetArchivedMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockSetArchivedParams{ctx, id, archived}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetArchived.t.Errorf("ChatRepositoryMock.SetArchived got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetArchived.SetArchivedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmSetArchived.t.Errorf("ChatRepositoryMock.SetArchived got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetArchived.SetArchivedMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.archived != nil && !minimock.Equal(*mm_want_ptrs.archived, mm_got.archived) {
+				mmSetArchived.t.Errorf("ChatRepositoryMock.SetArchived got unexpected parameter archived, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetArchived.SetArchivedMock.defaultExpectation.expectationOrigins.originArchived, *mm_want_ptrs.archived, mm_got.archived, minimock.Diff(*mm_want_ptrs.archived, mm_got.archived))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetArchived.t.Errorf("ChatRepositoryMock.SetArchived got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetArchived.SetArchivedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetArchived.SetArchivedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetArchived.t.Fatal("No results are set for the ChatRepositoryMock.SetArchived")
+		}
+		return (*mm_results).err
+	}
+	if mmSetArchived.funcSetArchived != nil {
+		return mmSetArchived.funcSetArchived(ctx, id, archived)
+	}
+	mmSetArchived.t.Fatalf("Unexpected call to ChatRepositoryMock.SetArchived. %v %v %v", ctx, id, archived)
+	return
+}
+
+// SetArchivedAfterCounter returns a count of finished ChatRepositoryMock.SetArchived invocations
+func (mmSetArchived *ChatRepositoryMock) SetArchivedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetArchived.afterSetArchivedCounter)
+}
+
+// SetArchivedBeforeCounter returns a count of ChatRepositoryMock.SetArchived invocations
+func (mmSetArchived *ChatRepositoryMock) SetArchivedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetArchived.beforeSetArchivedCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.SetArchived.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetArchived *mChatRepositoryMockSetArchived) Calls() []*ChatRepositoryMockSetArchivedParams {
+	mmSetArchived.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockSetArchivedParams, len(mmSetArchived.callArgs))
+	copy(argCopy, mmSetArchived.callArgs)
+
+	mmSetArchived.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetArchivedDone returns true if the count of the SetArchived invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockSetArchivedDone() bool {
+	if m.SetArchivedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetArchivedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetArchivedMock.invocationsDone()
+}
+
+// MinimockSetArchivedInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockSetArchivedInspect() {
+	for _, e := range m.SetArchivedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetArchived at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetArchivedCounter := mm_atomic.LoadUint64(&m.afterSetArchivedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetArchivedMock.defaultExpectation != nil && afterSetArchivedCounter < 1 {
+		if m.SetArchivedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetArchived at\n%s", m.SetArchivedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetArchived at\n%s with params: %#v", m.SetArchivedMock.defaultExpectation.expectationOrigins.origin, *m.SetArchivedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetArchived != nil && afterSetArchivedCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.SetArchived at\n%s", m.funcSetArchivedOrigin)
+	}
+
+	if !m.SetArchivedMock.invocationsDone() && afterSetArchivedCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.SetArchived at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetArchivedMock.expectedInvocations), m.SetArchivedMock.expectedInvocationsOrigin, afterSetArchivedCounter)
+	}
+}
+
+type mChatRepositoryMockSetRetention struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockSetRetentionExpectation
+	expectations       []*ChatRepositoryMockSetRetentionExpectation
+
+	callArgs []*ChatRepositoryMockSetRetentionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockSetRetentionExpectation specifies expectation struct of the ChatRepository.SetRetention
+type ChatRepositoryMockSetRetentionExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockSetRetentionParams
+	paramPtrs          *ChatRepositoryMockSetRetentionParamPtrs
+	expectationOrigins ChatRepositoryMockSetRetentionExpectationOrigins
+	results            *ChatRepositoryMockSetRetentionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockSetRetentionParams contains parameters of the ChatRepository.SetRetention
+type ChatRepositoryMockSetRetentionParams struct {
+	ctx       context.Context
+	retention *model.ChatRetention
+}
+
+// ChatRepositoryMockSetRetentionParamPtrs contains pointers to parameters of the ChatRepository.SetRetention
+type ChatRepositoryMockSetRetentionParamPtrs struct {
+	ctx       *context.Context
+	retention **model.ChatRetention
+}
+
+// ChatRepositoryMockSetRetentionResults contains results of the ChatRepository.SetRetention
+type ChatRepositoryMockSetRetentionResults struct {
+	err error
+}
+
+// ChatRepositoryMockSetRetentionOrigins contains origins of expectations of the ChatRepository.SetRetention
+type ChatRepositoryMockSetRetentionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originRetention string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetRetention *mChatRepositoryMockSetRetention) Optional() *mChatRepositoryMockSetRetention {
+	mmSetRetention.optional = true
+	return mmSetRetention
+}
+
+// Expect sets up expected params for ChatRepository.SetRetention
+func (mmSetRetention *mChatRepositoryMockSetRetention) Expect(ctx context.Context, retention *model.ChatRetention) *mChatRepositoryMockSetRetention {
+	if mmSetRetention.mock.funcSetRetention != nil {
+		mmSetRetention.mock.t.Fatalf("ChatRepositoryMock.SetRetention mock is already set by Set")
+	}
+
+	if mmSetRetention.defaultExpectation == nil {
+		mmSetRetention.defaultExpectation = &ChatRepositoryMockSetRetentionExpectation{}
+	}
+
+	if mmSetRetention.defaultExpectation.paramPtrs != nil {
+		mmSetRetention.mock.t.Fatalf("ChatRepositoryMock.SetRetention mock is already set by ExpectParams functions")
+	}
+
+	mmSetRetention.defaultExpectation.params = &ChatRepositoryMockSetRetentionParams{ctx, retention}
+	mmSetRetention.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetRetention.expectations {
+		if minimock.Equal(e.params, mmSetRetention.defaultExpectation.params) {
+			mmSetRetention.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetRetention.defaultExpectation.params)
+		}
+	}
 
-	return mmDelete
+	return mmSetRetention
 }
 
-// ExpectIdParam2 sets up expected param id for ChatRepository.Delete
-func (mmDelete *mChatRepositoryMockDelete) ExpectIdParam2(id int64) *mChatRepositoryMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ChatRepositoryMock.Delete mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.SetRetention
+func (mmSetRetention *mChatRepositoryMockSetRetention) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockSetRetention {
+	if mmSetRetention.mock.funcSetRetention != nil {
+		mmSetRetention.mock.t.Fatalf("ChatRepositoryMock.SetRetention mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &ChatRepositoryMockDeleteExpectation{}
+	if mmSetRetention.defaultExpectation == nil {
+		mmSetRetention.defaultExpectation = &ChatRepositoryMockSetRetentionExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.params != nil {
-		mmDelete.mock.t.Fatalf("ChatRepositoryMock.Delete mock is already set by Expect")
+	if mmSetRetention.defaultExpectation.params != nil {
+		mmSetRetention.mock.t.Fatalf("ChatRepositoryMock.SetRetention mock is already set by Expect")
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs == nil {
-		mmDelete.defaultExpectation.paramPtrs = &ChatRepositoryMockDeleteParamPtrs{}
+	if mmSetRetention.defaultExpectation.paramPtrs == nil {
+		mmSetRetention.defaultExpectation.paramPtrs = &ChatRepositoryMockSetRetentionParamPtrs{}
 	}
-	mmDelete.defaultExpectation.paramPtrs.id = &id
-	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmSetRetention.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetRetention.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDelete
+	return mmSetRetention
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatRepository.Delete
-func (mmDelete *mChatRepositoryMockDelete) Inspect(f func(ctx context.Context, id int64)) *mChatRepositoryMockDelete {
-	if mmDelete.mock.inspectFuncDelete != nil {
-		mmDelete.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.Delete")
+// ExpectRetentionParam2 sets up expected param retention for ChatRepository.SetRetention
+func (mmSetRetention *mChatRepositoryMockSetRetention) ExpectRetentionParam2(retention *model.ChatRetention) *mChatRepositoryMockSetRetention {
+	if mmSetRetention.mock.funcSetRetention != nil {
+		mmSetRetention.mock.t.Fatalf("ChatRepositoryMock.SetRetention mock is already set by Set")
 	}
 
-	mmDelete.mock.inspectFuncDelete = f
+	if mmSetRetention.defaultExpectation == nil {
+		mmSetRetention.defaultExpectation = &ChatRepositoryMockSetRetentionExpectation{}
+	}
 
-	return mmDelete
+	if mmSetRetention.defaultExpectation.params != nil {
+		mmSetRetention.mock.t.Fatalf("ChatRepositoryMock.SetRetention mock is already set by Expect")
+	}
+
+	if mmSetRetention.defaultExpectation.paramPtrs == nil {
+		mmSetRetention.defaultExpectation.paramPtrs = &ChatRepositoryMockSetRetentionParamPtrs{}
+	}
+	mmSetRetention.defaultExpectation.paramPtrs.retention = &retention
+	mmSetRetention.defaultExpectation.expectationOrigins.originRetention = minimock.CallerInfo(1)
+
+	return mmSetRetention
 }
 
-// Return sets up results that will be returned by ChatRepository.Delete
-func (mmDelete *mChatRepositoryMockDelete) Return(err error) *ChatRepositoryMock {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ChatRepositoryMock.Delete mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.SetRetention
+func (mmSetRetention *mChatRepositoryMockSetRetention) Inspect(f func(ctx context.Context, retention *model.ChatRetention)) *mChatRepositoryMockSetRetention {
+	if mmSetRetention.mock.inspectFuncSetRetention != nil {
+		mmSetRetention.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.SetRetention")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &ChatRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	mmSetRetention.mock.inspectFuncSetRetention = f
+
+	return mmSetRetention
+}
+
+// Return sets up results that will be returned by ChatRepository.SetRetention
+func (mmSetRetention *mChatRepositoryMockSetRetention) Return(err error) *ChatRepositoryMock {
+	if mmSetRetention.mock.funcSetRetention != nil {
+		mmSetRetention.mock.t.Fatalf("ChatRepositoryMock.SetRetention mock is already set by Set")
 	}
-	mmDelete.defaultExpectation.results = &ChatRepositoryMockDeleteResults{err}
-	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDelete.mock
+
+	if mmSetRetention.defaultExpectation == nil {
+		mmSetRetention.defaultExpectation = &ChatRepositoryMockSetRetentionExpectation{mock: mmSetRetention.mock}
+	}
+	mmSetRetention.defaultExpectation.results = &ChatRepositoryMockSetRetentionResults{err}
+	mmSetRetention.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetRetention.mock
 }
 
-// Set uses given function f to mock the ChatRepository.Delete method
-func (mmDelete *mChatRepositoryMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *ChatRepositoryMock {
-	if mmDelete.defaultExpectation != nil {
-		mmDelete.mock.t.Fatalf("Default expectation is already set for the ChatRepository.Delete method")
+// Set uses given function f to mock the ChatRepository.SetRetention method
+func (mmSetRetention *mChatRepositoryMockSetRetention) Set(f func(ctx context.Context, retention *model.ChatRetention) (err error)) *ChatRepositoryMock {
+	if mmSetRetention.defaultExpectation != nil {
+		mmSetRetention.mock.t.Fatalf("Default expectation is already set for the ChatRepository.SetRetention method")
 	}
 
-	if len(mmDelete.expectations) > 0 {
-		mmDelete.mock.t.Fatalf("Some expectations are already set for the ChatRepository.Delete method")
+	if len(mmSetRetention.expectations) > 0 {
+		mmSetRetention.mock.t.Fatalf("Some expectations are already set for the ChatRepository.SetRetention method")
 	}
 
-	mmDelete.mock.funcDelete = f
-	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
-	return mmDelete.mock
+	mmSetRetention.mock.funcSetRetention = f
+	mmSetRetention.mock.funcSetRetentionOrigin = minimock.CallerInfo(1)
+	return mmSetRetention.mock
 }
 
-// When sets expectation for the ChatRepository.Delete which will trigger the result defined by the following
+// When sets expectation for the ChatRepository.SetRetention which will trigger the result defined by the following
 // Then helper
-func (mmDelete *mChatRepositoryMockDelete) When(ctx context.Context, id int64) *ChatRepositoryMockDeleteExpectation {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ChatRepositoryMock.Delete mock is already set by Set")
+func (mmSetRetention *mChatRepositoryMockSetRetention) When(ctx context.Context, retention *model.ChatRetention) *ChatRepositoryMockSetRetentionExpectation {
+	if mmSetRetention.mock.funcSetRetention != nil {
+		mmSetRetention.mock.t.Fatalf("ChatRepositoryMock.SetRetention mock is already set by Set")
 	}
 
-	expectation := &ChatRepositoryMockDeleteExpectation{
-		mock:               mmDelete.mock,
-		params:             &ChatRepositoryMockDeleteParams{ctx, id},
-		expectationOrigins: ChatRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatRepositoryMockSetRetentionExpectation{
+		mock:               mmSetRetention.mock,
+		params:             &ChatRepositoryMockSetRetentionParams{ctx, retention},
+		expectationOrigins: ChatRepositoryMockSetRetentionExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	mmSetRetention.expectations = append(mmSetRetention.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatRepository.Delete return parameters for the expectation previously defined by the When method
-func (e *ChatRepositoryMockDeleteExpectation) Then(err error) *ChatRepositoryMock {
-	e.results = &ChatRepositoryMockDeleteResults{err}
+// Then sets up ChatRepository.SetRetention return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockSetRetentionExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockSetRetentionResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatRepository.Delete should be invoked
-func (mmDelete *mChatRepositoryMockDelete) Times(n uint64) *mChatRepositoryMockDelete {
+// Times sets number of times ChatRepository.SetRetention should be invoked
+func (mmSetRetention *mChatRepositoryMockSetRetention) Times(n uint64) *mChatRepositoryMockSetRetention {
 	if n == 0 {
-		mmDelete.mock.t.Fatalf("Times of ChatRepositoryMock.Delete mock can not be zero")
+		mmSetRetention.mock.t.Fatalf("Times of ChatRepositoryMock.SetRetention mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
-	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDelete
+	mm_atomic.StoreUint64(&mmSetRetention.expectedInvocations, n)
+	mmSetRetention.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetRetention
 }
 
-func (mmDelete *mChatRepositoryMockDelete) invocationsDone() bool {
-	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+func (mmSetRetention *mChatRepositoryMockSetRetention) invocationsDone() bool {
+	if len(mmSetRetention.expectations) == 0 && mmSetRetention.defaultExpectation == nil && mmSetRetention.mock.funcSetRetention == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetRetention.mock.afterSetRetentionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetRetention.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Delete implements mm_repository.ChatRepository
-func (mmDelete *ChatRepositoryMock) Delete(ctx context.Context, id int64) (err error) {
-	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
-	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+// SetRetention implements mm_repository.ChatRepository
+func (mmSetRetention *ChatRepositoryMock) SetRetention(ctx context.Context, retention *model.ChatRetention) (err error) {
+	mm_atomic.AddUint64(&mmSetRetention.beforeSetRetentionCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetRetention.afterSetRetentionCounter, 1)
 
-	mmDelete.t.Helper()
+	mmSetRetention.t.Helper()
 
-	if mmDelete.inspectFuncDelete != nil {
-		mmDelete.inspectFuncDelete(ctx, id)
+	if mmSetRetention.inspectFuncSetRetention != nil {
+		mmSetRetention.inspectFuncSetRetention(ctx, retention)
+	}
+
+	mm_params := ChatRepositoryMockSetRetentionParams{ctx, retention}
+
+	// Record call args
+	mmSetRetention.SetRetentionMock.mutex.Lock()
+	mmSetRetention.SetRetentionMock.callArgs = append(mmSetRetention.SetRetentionMock.callArgs, &mm_params)
+	mmSetRetention.SetRetentionMock.mutex.Unlock()
+
+	for _, e := range mmSetRetention.SetRetentionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetRetention.SetRetentionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetRetention.SetRetentionMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetRetention.SetRetentionMock.defaultExpectation.params
+		mm_want_ptrs := mmSetRetention.SetRetentionMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockSetRetentionParams{ctx, retention}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetRetention.t.Errorf("ChatRepositoryMock.SetRetention got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetRetention.SetRetentionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.retention != nil && !minimock.Equal(*mm_want_ptrs.retention, mm_got.retention) {
+				mmSetRetention.t.Errorf("ChatRepositoryMock.SetRetention got unexpected parameter retention, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetRetention.SetRetentionMock.defaultExpectation.expectationOrigins.originRetention, *mm_want_ptrs.retention, mm_got.retention, minimock.Diff(*mm_want_ptrs.retention, mm_got.retention))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetRetention.t.Errorf("ChatRepositoryMock.SetRetention got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetRetention.SetRetentionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetRetention.SetRetentionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetRetention.t.Fatal("No results are set for the ChatRepositoryMock.SetRetention")
+		}
+		return (*mm_results).err
+	}
+	if mmSetRetention.funcSetRetention != nil {
+		return mmSetRetention.funcSetRetention(ctx, retention)
+	}
+	mmSetRetention.t.Fatalf("Unexpected call to ChatRepositoryMock.SetRetention. %v %v", ctx, retention)
+	return
+}
+
+// SetRetentionAfterCounter returns a count of finished ChatRepositoryMock.SetRetention invocations
+func (mmSetRetention *ChatRepositoryMock) SetRetentionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetRetention.afterSetRetentionCounter)
+}
+
+// SetRetentionBeforeCounter returns a count of ChatRepositoryMock.SetRetention invocations
+func (mmSetRetention *ChatRepositoryMock) SetRetentionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetRetention.beforeSetRetentionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.SetRetention.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetRetention *mChatRepositoryMockSetRetention) Calls() []*ChatRepositoryMockSetRetentionParams {
+	mmSetRetention.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockSetRetentionParams, len(mmSetRetention.callArgs))
+	copy(argCopy, mmSetRetention.callArgs)
+
+	mmSetRetention.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetRetentionDone returns true if the count of the SetRetention invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockSetRetentionDone() bool {
+	if m.SetRetentionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetRetentionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetRetentionMock.invocationsDone()
+}
+
+// MinimockSetRetentionInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockSetRetentionInspect() {
+	for _, e := range m.SetRetentionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetRetention at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetRetentionCounter := mm_atomic.LoadUint64(&m.afterSetRetentionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetRetentionMock.defaultExpectation != nil && afterSetRetentionCounter < 1 {
+		if m.SetRetentionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetRetention at\n%s", m.SetRetentionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetRetention at\n%s with params: %#v", m.SetRetentionMock.defaultExpectation.expectationOrigins.origin, *m.SetRetentionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetRetention != nil && afterSetRetentionCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.SetRetention at\n%s", m.funcSetRetentionOrigin)
+	}
+
+	if !m.SetRetentionMock.invocationsDone() && afterSetRetentionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.SetRetention at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetRetentionMock.expectedInvocations), m.SetRetentionMock.expectedInvocationsOrigin, afterSetRetentionCounter)
+	}
+}
+
+type mChatRepositoryMockSetTopic struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockSetTopicExpectation
+	expectations       []*ChatRepositoryMockSetTopicExpectation
+
+	callArgs []*ChatRepositoryMockSetTopicParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockSetTopicExpectation specifies expectation struct of the ChatRepository.SetTopic
+type ChatRepositoryMockSetTopicExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockSetTopicParams
+	paramPtrs          *ChatRepositoryMockSetTopicParamPtrs
+	expectationOrigins ChatRepositoryMockSetTopicExpectationOrigins
+	results            *ChatRepositoryMockSetTopicResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockSetTopicParams contains parameters of the ChatRepository.SetTopic
+type ChatRepositoryMockSetTopicParams struct {
+	ctx   context.Context
+	id    int64
+	topic string
+}
+
+// ChatRepositoryMockSetTopicParamPtrs contains pointers to parameters of the ChatRepository.SetTopic
+type ChatRepositoryMockSetTopicParamPtrs struct {
+	ctx   *context.Context
+	id    *int64
+	topic *string
+}
+
+// ChatRepositoryMockSetTopicResults contains results of the ChatRepository.SetTopic
+type ChatRepositoryMockSetTopicResults struct {
+	err error
+}
+
+// ChatRepositoryMockSetTopicOrigins contains origins of expectations of the ChatRepository.SetTopic
+type ChatRepositoryMockSetTopicExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originId    string
+	originTopic string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetTopic *mChatRepositoryMockSetTopic) Optional() *mChatRepositoryMockSetTopic {
+	mmSetTopic.optional = true
+	return mmSetTopic
+}
+
+// Expect sets up expected params for ChatRepository.SetTopic
+func (mmSetTopic *mChatRepositoryMockSetTopic) Expect(ctx context.Context, id int64, topic string) *mChatRepositoryMockSetTopic {
+	if mmSetTopic.mock.funcSetTopic != nil {
+		mmSetTopic.mock.t.Fatalf("ChatRepositoryMock.SetTopic mock is already set by Set")
+	}
+
+	if mmSetTopic.defaultExpectation == nil {
+		mmSetTopic.defaultExpectation = &ChatRepositoryMockSetTopicExpectation{}
+	}
+
+	if mmSetTopic.defaultExpectation.paramPtrs != nil {
+		mmSetTopic.mock.t.Fatalf("ChatRepositoryMock.SetTopic mock is already set by ExpectParams functions")
+	}
+
+	mmSetTopic.defaultExpectation.params = &ChatRepositoryMockSetTopicParams{ctx, id, topic}
+	mmSetTopic.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetTopic.expectations {
+		if minimock.Equal(e.params, mmSetTopic.defaultExpectation.params) {
+			mmSetTopic.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetTopic.defaultExpectation.params)
+		}
+	}
+
+	return mmSetTopic
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.SetTopic
+func (mmSetTopic *mChatRepositoryMockSetTopic) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockSetTopic {
+	if mmSetTopic.mock.funcSetTopic != nil {
+		mmSetTopic.mock.t.Fatalf("ChatRepositoryMock.SetTopic mock is already set by Set")
+	}
+
+	if mmSetTopic.defaultExpectation == nil {
+		mmSetTopic.defaultExpectation = &ChatRepositoryMockSetTopicExpectation{}
+	}
+
+	if mmSetTopic.defaultExpectation.params != nil {
+		mmSetTopic.mock.t.Fatalf("ChatRepositoryMock.SetTopic mock is already set by Expect")
+	}
+
+	if mmSetTopic.defaultExpectation.paramPtrs == nil {
+		mmSetTopic.defaultExpectation.paramPtrs = &ChatRepositoryMockSetTopicParamPtrs{}
+	}
+	mmSetTopic.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetTopic.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetTopic
+}
+
+// ExpectIdParam2 sets up expected param id for ChatRepository.SetTopic
+func (mmSetTopic *mChatRepositoryMockSetTopic) ExpectIdParam2(id int64) *mChatRepositoryMockSetTopic {
+	if mmSetTopic.mock.funcSetTopic != nil {
+		mmSetTopic.mock.t.Fatalf("ChatRepositoryMock.SetTopic mock is already set by Set")
+	}
+
+	if mmSetTopic.defaultExpectation == nil {
+		mmSetTopic.defaultExpectation = &ChatRepositoryMockSetTopicExpectation{}
+	}
+
+	if mmSetTopic.defaultExpectation.params != nil {
+		mmSetTopic.mock.t.Fatalf("ChatRepositoryMock.SetTopic mock is already set by Expect")
+	}
+
+	if mmSetTopic.defaultExpectation.paramPtrs == nil {
+		mmSetTopic.defaultExpectation.paramPtrs = &ChatRepositoryMockSetTopicParamPtrs{}
+	}
+	mmSetTopic.defaultExpectation.paramPtrs.id = &id
+	mmSetTopic.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmSetTopic
+}
+
+// ExpectTopicParam3 sets up expected param topic for ChatRepository.SetTopic
+func (mmSetTopic *mChatRepositoryMockSetTopic) ExpectTopicParam3(topic string) *mChatRepositoryMockSetTopic {
+	if mmSetTopic.mock.funcSetTopic != nil {
+		mmSetTopic.mock.t.Fatalf("ChatRepositoryMock.SetTopic mock is already set by Set")
+	}
+
+	if mmSetTopic.defaultExpectation == nil {
+		mmSetTopic.defaultExpectation = &ChatRepositoryMockSetTopicExpectation{}
+	}
+
+	if mmSetTopic.defaultExpectation.params != nil {
+		mmSetTopic.mock.t.Fatalf("ChatRepositoryMock.SetTopic mock is already set by Expect")
+	}
+
+	if mmSetTopic.defaultExpectation.paramPtrs == nil {
+		mmSetTopic.defaultExpectation.paramPtrs = &ChatRepositoryMockSetTopicParamPtrs{}
+	}
+	mmSetTopic.defaultExpectation.paramPtrs.topic = &topic
+	mmSetTopic.defaultExpectation.expectationOrigins.originTopic = minimock.CallerInfo(1)
+
+	return mmSetTopic
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.SetTopic
+func (mmSetTopic *mChatRepositoryMockSetTopic) Inspect(f func(ctx context.Context, id int64, topic string)) *mChatRepositoryMockSetTopic {
+	if mmSetTopic.mock.inspectFuncSetTopic != nil {
+		mmSetTopic.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.SetTopic")
+	}
+
+	mmSetTopic.mock.inspectFuncSetTopic = f
+
+	return mmSetTopic
+}
+
+// Return sets up results that will be returned by ChatRepository.SetTopic
+func (mmSetTopic *mChatRepositoryMockSetTopic) Return(err error) *ChatRepositoryMock {
+	if mmSetTopic.mock.funcSetTopic != nil {
+		mmSetTopic.mock.t.Fatalf("ChatRepositoryMock.SetTopic mock is already set by Set")
+	}
+
+	if mmSetTopic.defaultExpectation == nil {
+		mmSetTopic.defaultExpectation = &ChatRepositoryMockSetTopicExpectation{mock: mmSetTopic.mock}
+	}
+	mmSetTopic.defaultExpectation.results = &ChatRepositoryMockSetTopicResults{err}
+	mmSetTopic.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetTopic.mock
+}
+
+// Set uses given function f to mock the ChatRepository.SetTopic method
+func (mmSetTopic *mChatRepositoryMockSetTopic) Set(f func(ctx context.Context, id int64, topic string) (err error)) *ChatRepositoryMock {
+	if mmSetTopic.defaultExpectation != nil {
+		mmSetTopic.mock.t.Fatalf("Default expectation is already set for the ChatRepository.SetTopic method")
+	}
+
+	if len(mmSetTopic.expectations) > 0 {
+		mmSetTopic.mock.t.Fatalf("Some expectations are already set for the ChatRepository.SetTopic method")
+	}
+
+	mmSetTopic.mock.funcSetTopic = f
+	mmSetTopic.mock.funcSetTopicOrigin = minimock.CallerInfo(1)
+	return mmSetTopic.mock
+}
+
+// When sets expectation for the ChatRepository.SetTopic which will trigger the result defined by the following
+// Then helper
+func (mmSetTopic *mChatRepositoryMockSetTopic) When(ctx context.Context, id int64, topic string) *ChatRepositoryMockSetTopicExpectation {
+	if mmSetTopic.mock.funcSetTopic != nil {
+		mmSetTopic.mock.t.Fatalf("ChatRepositoryMock.SetTopic mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockSetTopicExpectation{
+		mock:               mmSetTopic.mock,
+		params:             &ChatRepositoryMockSetTopicParams{ctx, id, topic},
+		expectationOrigins: ChatRepositoryMockSetTopicExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetTopic.expectations = append(mmSetTopic.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.SetTopic return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockSetTopicExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockSetTopicResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.SetTopic should be invoked
+func (mmSetTopic *mChatRepositoryMockSetTopic) Times(n uint64) *mChatRepositoryMockSetTopic {
+	if n == 0 {
+		mmSetTopic.mock.t.Fatalf("Times of ChatRepositoryMock.SetTopic mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetTopic.expectedInvocations, n)
+	mmSetTopic.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetTopic
+}
+
+func (mmSetTopic *mChatRepositoryMockSetTopic) invocationsDone() bool {
+	if len(mmSetTopic.expectations) == 0 && mmSetTopic.defaultExpectation == nil && mmSetTopic.mock.funcSetTopic == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetTopic.mock.afterSetTopicCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetTopic.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetTopic implements mm_repository.ChatRepository
+func (mmSetTopic *ChatRepositoryMock) SetTopic(ctx context.Context, id int64, topic string) (err error) {
+	mm_atomic.AddUint64(&mmSetTopic.beforeSetTopicCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetTopic.afterSetTopicCounter, 1)
+
+	mmSetTopic.t.Helper()
+
+	if mmSetTopic.inspectFuncSetTopic != nil {
+		mmSetTopic.inspectFuncSetTopic(ctx, id, topic)
 	}
 
-	mm_params := ChatRepositoryMockDeleteParams{ctx, id}
+	mm_params := ChatRepositoryMockSetTopicParams{ctx, id, topic}
 
 	// Record call args
-	mmDelete.DeleteMock.mutex.Lock()
-	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
-	mmDelete.DeleteMock.mutex.Unlock()
+	mmSetTopic.SetTopicMock.mutex.Lock()
+	mmSetTopic.SetTopicMock.callArgs = append(mmSetTopic.SetTopicMock.callArgs, &mm_params)
+	mmSetTopic.SetTopicMock.mutex.Unlock()
 
-	for _, e := range mmDelete.DeleteMock.expectations {
+	for _, e := range mmSetTopic.SetTopicMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmDelete.DeleteMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
-		mm_want := mmDelete.DeleteMock.defaultExpectation.params
-		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+	if mmSetTopic.SetTopicMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetTopic.SetTopicMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetTopic.SetTopicMock.defaultExpectation.params
+		mm_want_ptrs := mmSetTopic.SetTopicMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatRepositoryMockDeleteParams{ctx, id}
+		mm_got := ChatRepositoryMockSetTopicParams{ctx, id, topic}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDelete.t.Errorf("ChatRepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetTopic.t.Errorf("ChatRepositoryMock.SetTopic got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetTopic.SetTopicMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDelete.t.Errorf("ChatRepositoryMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+				mmSetTopic.t.Errorf("ChatRepositoryMock.SetTopic got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetTopic.SetTopicMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.topic != nil && !minimock.Equal(*mm_want_ptrs.topic, mm_got.topic) {
+				mmSetTopic.t.Errorf("ChatRepositoryMock.SetTopic got unexpected parameter topic, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetTopic.SetTopicMock.defaultExpectation.expectationOrigins.originTopic, *mm_want_ptrs.topic, mm_got.topic, minimock.Diff(*mm_want_ptrs.topic, mm_got.topic))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDelete.t.Errorf("ChatRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetTopic.t.Errorf("ChatRepositoryMock.SetTopic got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetTopic.SetTopicMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		mm_results := mmSetTopic.SetTopicMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDelete.t.Fatal("No results are set for the ChatRepositoryMock.Delete")
+			mmSetTopic.t.Fatal("No results are set for the ChatRepositoryMock.SetTopic")
 		}
 		return (*mm_results).err
 	}
-	if mmDelete.funcDelete != nil {
-		return mmDelete.funcDelete(ctx, id)
+	if mmSetTopic.funcSetTopic != nil {
+		return mmSetTopic.funcSetTopic(ctx, id, topic)
 	}
-	mmDelete.t.Fatalf("Unexpected call to ChatRepositoryMock.Delete. %v %v", ctx, id)
+	mmSetTopic.t.Fatalf("Unexpected call to ChatRepositoryMock.SetTopic. %v %v %v", ctx, id, topic)
 	return
 }
 
-// DeleteAfterCounter returns a count of finished ChatRepositoryMock.Delete invocations
-func (mmDelete *ChatRepositoryMock) DeleteAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+// SetTopicAfterCounter returns a count of finished ChatRepositoryMock.SetTopic invocations
+func (mmSetTopic *ChatRepositoryMock) SetTopicAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetTopic.afterSetTopicCounter)
 }
 
-// DeleteBeforeCounter returns a count of ChatRepositoryMock.Delete invocations
-func (mmDelete *ChatRepositoryMock) DeleteBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+// SetTopicBeforeCounter returns a count of ChatRepositoryMock.SetTopic invocations
+func (mmSetTopic *ChatRepositoryMock) SetTopicBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetTopic.beforeSetTopicCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatRepositoryMock.Delete.
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.SetTopic.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDelete *mChatRepositoryMockDelete) Calls() []*ChatRepositoryMockDeleteParams {
-	mmDelete.mutex.RLock()
+func (mmSetTopic *mChatRepositoryMockSetTopic) Calls() []*ChatRepositoryMockSetTopicParams {
+	mmSetTopic.mutex.RLock()
 
-	argCopy := make([]*ChatRepositoryMockDeleteParams, len(mmDelete.callArgs))
-	copy(argCopy, mmDelete.callArgs)
+	argCopy := make([]*ChatRepositoryMockSetTopicParams, len(mmSetTopic.callArgs))
+	copy(argCopy, mmSetTopic.callArgs)
 
-	mmDelete.mutex.RUnlock()
+	mmSetTopic.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// MinimockSetTopicDone returns true if the count of the SetTopic invocations corresponds
 // the number of defined expectations
-func (m *ChatRepositoryMock) MinimockDeleteDone() bool {
-	if m.DeleteMock.optional {
+func (m *ChatRepositoryMock) MinimockSetTopicDone() bool {
+	if m.SetTopicMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteMock.expectations {
+	for _, e := range m.SetTopicMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteMock.invocationsDone()
+	return m.SetTopicMock.invocationsDone()
 }
 
-// MinimockDeleteInspect logs each unmet expectation
-func (m *ChatRepositoryMock) MinimockDeleteInspect() {
-	for _, e := range m.DeleteMock.expectations {
+// MinimockSetTopicInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockSetTopicInspect() {
+	for _, e := range m.SetTopicMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatRepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetTopic at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	afterSetTopicCounter := mm_atomic.LoadUint64(&m.afterSetTopicCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
-		if m.DeleteMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatRepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+	if m.SetTopicMock.defaultExpectation != nil && afterSetTopicCounter < 1 {
+		if m.SetTopicMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetTopic at\n%s", m.SetTopicMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatRepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetTopic at\n%s with params: %#v", m.SetTopicMock.defaultExpectation.expectationOrigins.origin, *m.SetTopicMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDelete != nil && afterDeleteCounter < 1 {
-		m.t.Errorf("Expected call to ChatRepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	if m.funcSetTopic != nil && afterSetTopicCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.SetTopic at\n%s", m.funcSetTopicOrigin)
 	}
 
-	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatRepositoryMock.Delete at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	if !m.SetTopicMock.invocationsDone() && afterSetTopicCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.SetTopic at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetTopicMock.expectedInvocations), m.SetTopicMock.expectedInvocationsOrigin, afterSetTopicCounter)
 	}
 }
 
-type mChatRepositoryMockGet struct {
+type mChatRepositoryMockUnmute struct {
 	optional           bool
 	mock               *ChatRepositoryMock
-	defaultExpectation *ChatRepositoryMockGetExpectation
-	expectations       []*ChatRepositoryMockGetExpectation
+	defaultExpectation *ChatRepositoryMockUnmuteExpectation
+	expectations       []*ChatRepositoryMockUnmuteExpectation
 
-	callArgs []*ChatRepositoryMockGetParams
+	callArgs []*ChatRepositoryMockUnmuteParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatRepositoryMockGetExpectation specifies expectation struct of the ChatRepository.Get
-type ChatRepositoryMockGetExpectation struct {
+// ChatRepositoryMockUnmuteExpectation specifies expectation struct of the ChatRepository.Unmute
+type ChatRepositoryMockUnmuteExpectation struct {
 	mock               *ChatRepositoryMock
-	params             *ChatRepositoryMockGetParams
-	paramPtrs          *ChatRepositoryMockGetParamPtrs
-	expectationOrigins ChatRepositoryMockGetExpectationOrigins
-	results            *ChatRepositoryMockGetResults
+	params             *ChatRepositoryMockUnmuteParams
+	paramPtrs          *ChatRepositoryMockUnmuteParamPtrs
+	expectationOrigins ChatRepositoryMockUnmuteExpectationOrigins
+	results            *ChatRepositoryMockUnmuteResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatRepositoryMockGetParams contains parameters of the ChatRepository.Get
-type ChatRepositoryMockGetParams struct {
-	ctx context.Context
-	id  int64
+// ChatRepositoryMockUnmuteParams contains parameters of the ChatRepository.Unmute
+type ChatRepositoryMockUnmuteParams struct {
+	ctx      context.Context
+	id       int64
+	username string
 }
 
-// ChatRepositoryMockGetParamPtrs contains pointers to parameters of the ChatRepository.Get
-type ChatRepositoryMockGetParamPtrs struct {
-	ctx *context.Context
-	id  *int64
+// ChatRepositoryMockUnmuteParamPtrs contains pointers to parameters of the ChatRepository.Unmute
+type ChatRepositoryMockUnmuteParamPtrs struct {
+	ctx      *context.Context
+	id       *int64
+	username *string
 }
 
-// ChatRepositoryMockGetResults contains results of the ChatRepository.Get
-type ChatRepositoryMockGetResults struct {
-	cp1 *model.Chat
+// ChatRepositoryMockUnmuteResults contains results of the ChatRepository.Unmute
+type ChatRepositoryMockUnmuteResults struct {
 	err error
 }
 
-// ChatRepositoryMockGetOrigins contains origins of expectations of the ChatRepository.Get
-type ChatRepositoryMockGetExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// ChatRepositoryMockUnmuteOrigins contains origins of expectations of the ChatRepository.Unmute
+type ChatRepositoryMockUnmuteExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originId       string
+	originUsername string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -802,292 +4900,320 @@ type ChatRepositoryMockGetExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGet *mChatRepositoryMockGet) Optional() *mChatRepositoryMockGet {
-	mmGet.optional = true
-	return mmGet
+func (mmUnmute *mChatRepositoryMockUnmute) Optional() *mChatRepositoryMockUnmute {
+	mmUnmute.optional = true
+	return mmUnmute
 }
 
-// Expect sets up expected params for ChatRepository.Get
-func (mmGet *mChatRepositoryMockGet) Expect(ctx context.Context, id int64) *mChatRepositoryMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Set")
+// Expect sets up expected params for ChatRepository.Unmute
+func (mmUnmute *mChatRepositoryMockUnmute) Expect(ctx context.Context, id int64, username string) *mChatRepositoryMockUnmute {
+	if mmUnmute.mock.funcUnmute != nil {
+		mmUnmute.mock.t.Fatalf("ChatRepositoryMock.Unmute mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &ChatRepositoryMockGetExpectation{}
+	if mmUnmute.defaultExpectation == nil {
+		mmUnmute.defaultExpectation = &ChatRepositoryMockUnmuteExpectation{}
 	}
 
-	if mmGet.defaultExpectation.paramPtrs != nil {
-		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by ExpectParams functions")
+	if mmUnmute.defaultExpectation.paramPtrs != nil {
+		mmUnmute.mock.t.Fatalf("ChatRepositoryMock.Unmute mock is already set by ExpectParams functions")
 	}
 
-	mmGet.defaultExpectation.params = &ChatRepositoryMockGetParams{ctx, id}
-	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGet.expectations {
-		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
-			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+	mmUnmute.defaultExpectation.params = &ChatRepositoryMockUnmuteParams{ctx, id, username}
+	mmUnmute.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUnmute.expectations {
+		if minimock.Equal(e.params, mmUnmute.defaultExpectation.params) {
+			mmUnmute.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnmute.defaultExpectation.params)
 		}
 	}
 
-	return mmGet
+	return mmUnmute
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatRepository.Get
-func (mmGet *mChatRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.Unmute
+func (mmUnmute *mChatRepositoryMockUnmute) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockUnmute {
+	if mmUnmute.mock.funcUnmute != nil {
+		mmUnmute.mock.t.Fatalf("ChatRepositoryMock.Unmute mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &ChatRepositoryMockGetExpectation{}
+	if mmUnmute.defaultExpectation == nil {
+		mmUnmute.defaultExpectation = &ChatRepositoryMockUnmuteExpectation{}
 	}
 
-	if mmGet.defaultExpectation.params != nil {
-		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Expect")
+	if mmUnmute.defaultExpectation.params != nil {
+		mmUnmute.mock.t.Fatalf("ChatRepositoryMock.Unmute mock is already set by Expect")
 	}
 
-	if mmGet.defaultExpectation.paramPtrs == nil {
-		mmGet.defaultExpectation.paramPtrs = &ChatRepositoryMockGetParamPtrs{}
+	if mmUnmute.defaultExpectation.paramPtrs == nil {
+		mmUnmute.defaultExpectation.paramPtrs = &ChatRepositoryMockUnmuteParamPtrs{}
 	}
-	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUnmute.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUnmute.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGet
+	return mmUnmute
 }
 
-// ExpectIdParam2 sets up expected param id for ChatRepository.Get
-func (mmGet *mChatRepositoryMockGet) ExpectIdParam2(id int64) *mChatRepositoryMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for ChatRepository.Unmute
+func (mmUnmute *mChatRepositoryMockUnmute) ExpectIdParam2(id int64) *mChatRepositoryMockUnmute {
+	if mmUnmute.mock.funcUnmute != nil {
+		mmUnmute.mock.t.Fatalf("ChatRepositoryMock.Unmute mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &ChatRepositoryMockGetExpectation{}
+	if mmUnmute.defaultExpectation == nil {
+		mmUnmute.defaultExpectation = &ChatRepositoryMockUnmuteExpectation{}
 	}
 
-	if mmGet.defaultExpectation.params != nil {
-		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Expect")
+	if mmUnmute.defaultExpectation.params != nil {
+		mmUnmute.mock.t.Fatalf("ChatRepositoryMock.Unmute mock is already set by Expect")
 	}
 
-	if mmGet.defaultExpectation.paramPtrs == nil {
-		mmGet.defaultExpectation.paramPtrs = &ChatRepositoryMockGetParamPtrs{}
+	if mmUnmute.defaultExpectation.paramPtrs == nil {
+		mmUnmute.defaultExpectation.paramPtrs = &ChatRepositoryMockUnmuteParamPtrs{}
 	}
-	mmGet.defaultExpectation.paramPtrs.id = &id
-	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmUnmute.defaultExpectation.paramPtrs.id = &id
+	mmUnmute.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmGet
+	return mmUnmute
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatRepository.Get
-func (mmGet *mChatRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mChatRepositoryMockGet {
-	if mmGet.mock.inspectFuncGet != nil {
-		mmGet.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.Get")
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.Unmute
+func (mmUnmute *mChatRepositoryMockUnmute) ExpectUsernameParam3(username string) *mChatRepositoryMockUnmute {
+	if mmUnmute.mock.funcUnmute != nil {
+		mmUnmute.mock.t.Fatalf("ChatRepositoryMock.Unmute mock is already set by Set")
 	}
 
-	mmGet.mock.inspectFuncGet = f
+	if mmUnmute.defaultExpectation == nil {
+		mmUnmute.defaultExpectation = &ChatRepositoryMockUnmuteExpectation{}
+	}
 
-	return mmGet
+	if mmUnmute.defaultExpectation.params != nil {
+		mmUnmute.mock.t.Fatalf("ChatRepositoryMock.Unmute mock is already set by Expect")
+	}
+
+	if mmUnmute.defaultExpectation.paramPtrs == nil {
+		mmUnmute.defaultExpectation.paramPtrs = &ChatRepositoryMockUnmuteParamPtrs{}
+	}
+	mmUnmute.defaultExpectation.paramPtrs.username = &username
+	mmUnmute.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmUnmute
 }
 
-// Return sets up results that will be returned by ChatRepository.Get
-func (mmGet *mChatRepositoryMockGet) Return(cp1 *model.Chat, err error) *ChatRepositoryMock {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.Unmute
+func (mmUnmute *mChatRepositoryMockUnmute) Inspect(f func(ctx context.Context, id int64, username string)) *mChatRepositoryMockUnmute {
+	if mmUnmute.mock.inspectFuncUnmute != nil {
+		mmUnmute.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.Unmute")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &ChatRepositoryMockGetExpectation{mock: mmGet.mock}
+	mmUnmute.mock.inspectFuncUnmute = f
+
+	return mmUnmute
+}
+
+// Return sets up results that will be returned by ChatRepository.Unmute
+func (mmUnmute *mChatRepositoryMockUnmute) Return(err error) *ChatRepositoryMock {
+	if mmUnmute.mock.funcUnmute != nil {
+		mmUnmute.mock.t.Fatalf("ChatRepositoryMock.Unmute mock is already set by Set")
 	}
-	mmGet.defaultExpectation.results = &ChatRepositoryMockGetResults{cp1, err}
-	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGet.mock
+
+	if mmUnmute.defaultExpectation == nil {
+		mmUnmute.defaultExpectation = &ChatRepositoryMockUnmuteExpectation{mock: mmUnmute.mock}
+	}
+	mmUnmute.defaultExpectation.results = &ChatRepositoryMockUnmuteResults{err}
+	mmUnmute.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUnmute.mock
 }
 
-// Set uses given function f to mock the ChatRepository.Get method
-func (mmGet *mChatRepositoryMockGet) Set(f func(ctx context.Context, id int64) (cp1 *model.Chat, err error)) *ChatRepositoryMock {
-	if mmGet.defaultExpectation != nil {
-		mmGet.mock.t.Fatalf("Default expectation is already set for the ChatRepository.Get method")
+// Set uses given function f to mock the ChatRepository.Unmute method
+func (mmUnmute *mChatRepositoryMockUnmute) Set(f func(ctx context.Context, id int64, username string) (err error)) *ChatRepositoryMock {
+	if mmUnmute.defaultExpectation != nil {
+		mmUnmute.mock.t.Fatalf("Default expectation is already set for the ChatRepository.Unmute method")
 	}
 
-	if len(mmGet.expectations) > 0 {
-		mmGet.mock.t.Fatalf("Some expectations are already set for the ChatRepository.Get method")
+	if len(mmUnmute.expectations) > 0 {
+		mmUnmute.mock.t.Fatalf("Some expectations are already set for the ChatRepository.Unmute method")
 	}
 
-	mmGet.mock.funcGet = f
-	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
-	return mmGet.mock
+	mmUnmute.mock.funcUnmute = f
+	mmUnmute.mock.funcUnmuteOrigin = minimock.CallerInfo(1)
+	return mmUnmute.mock
 }
 
-// When sets expectation for the ChatRepository.Get which will trigger the result defined by the following
+// When sets expectation for the ChatRepository.Unmute which will trigger the result defined by the following
 // Then helper
-func (mmGet *mChatRepositoryMockGet) When(ctx context.Context, id int64) *ChatRepositoryMockGetExpectation {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Set")
+func (mmUnmute *mChatRepositoryMockUnmute) When(ctx context.Context, id int64, username string) *ChatRepositoryMockUnmuteExpectation {
+	if mmUnmute.mock.funcUnmute != nil {
+		mmUnmute.mock.t.Fatalf("ChatRepositoryMock.Unmute mock is already set by Set")
 	}
 
-	expectation := &ChatRepositoryMockGetExpectation{
-		mock:               mmGet.mock,
-		params:             &ChatRepositoryMockGetParams{ctx, id},
-		expectationOrigins: ChatRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatRepositoryMockUnmuteExpectation{
+		mock:               mmUnmute.mock,
+		params:             &ChatRepositoryMockUnmuteParams{ctx, id, username},
+		expectationOrigins: ChatRepositoryMockUnmuteExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGet.expectations = append(mmGet.expectations, expectation)
+	mmUnmute.expectations = append(mmUnmute.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatRepository.Get return parameters for the expectation previously defined by the When method
-func (e *ChatRepositoryMockGetExpectation) Then(cp1 *model.Chat, err error) *ChatRepositoryMock {
-	e.results = &ChatRepositoryMockGetResults{cp1, err}
+// Then sets up ChatRepository.Unmute return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockUnmuteExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockUnmuteResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatRepository.Get should be invoked
-func (mmGet *mChatRepositoryMockGet) Times(n uint64) *mChatRepositoryMockGet {
+// Times sets number of times ChatRepository.Unmute should be invoked
+func (mmUnmute *mChatRepositoryMockUnmute) Times(n uint64) *mChatRepositoryMockUnmute {
 	if n == 0 {
-		mmGet.mock.t.Fatalf("Times of ChatRepositoryMock.Get mock can not be zero")
+		mmUnmute.mock.t.Fatalf("Times of ChatRepositoryMock.Unmute mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
-	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGet
+	mm_atomic.StoreUint64(&mmUnmute.expectedInvocations, n)
+	mmUnmute.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUnmute
 }
 
-func (mmGet *mChatRepositoryMockGet) invocationsDone() bool {
-	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+func (mmUnmute *mChatRepositoryMockUnmute) invocationsDone() bool {
+	if len(mmUnmute.expectations) == 0 && mmUnmute.defaultExpectation == nil && mmUnmute.mock.funcUnmute == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUnmute.mock.afterUnmuteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUnmute.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Get implements mm_repository.ChatRepository
-func (mmGet *ChatRepositoryMock) Get(ctx context.Context, id int64) (cp1 *model.Chat, err error) {
-	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
-	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+// Unmute implements mm_repository.ChatRepository
+func (mmUnmute *ChatRepositoryMock) Unmute(ctx context.Context, id int64, username string) (err error) {
+	mm_atomic.AddUint64(&mmUnmute.beforeUnmuteCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnmute.afterUnmuteCounter, 1)
 
-	mmGet.t.Helper()
+	mmUnmute.t.Helper()
 
-	if mmGet.inspectFuncGet != nil {
-		mmGet.inspectFuncGet(ctx, id)
+	if mmUnmute.inspectFuncUnmute != nil {
+		mmUnmute.inspectFuncUnmute(ctx, id, username)
 	}
 
-	mm_params := ChatRepositoryMockGetParams{ctx, id}
+	mm_params := ChatRepositoryMockUnmuteParams{ctx, id, username}
 
 	// Record call args
-	mmGet.GetMock.mutex.Lock()
-	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
-	mmGet.GetMock.mutex.Unlock()
+	mmUnmute.UnmuteMock.mutex.Lock()
+	mmUnmute.UnmuteMock.callArgs = append(mmUnmute.UnmuteMock.callArgs, &mm_params)
+	mmUnmute.UnmuteMock.mutex.Unlock()
 
-	for _, e := range mmGet.GetMock.expectations {
+	for _, e := range mmUnmute.UnmuteMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.cp1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGet.GetMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
-		mm_want := mmGet.GetMock.defaultExpectation.params
-		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+	if mmUnmute.UnmuteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnmute.UnmuteMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnmute.UnmuteMock.defaultExpectation.params
+		mm_want_ptrs := mmUnmute.UnmuteMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatRepositoryMockGetParams{ctx, id}
+		mm_got := ChatRepositoryMockUnmuteParams{ctx, id, username}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGet.t.Errorf("ChatRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUnmute.t.Errorf("ChatRepositoryMock.Unmute got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnmute.UnmuteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmGet.t.Errorf("ChatRepositoryMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+				mmUnmute.t.Errorf("ChatRepositoryMock.Unmute got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnmute.UnmuteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmUnmute.t.Errorf("ChatRepositoryMock.Unmute got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnmute.UnmuteMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGet.t.Errorf("ChatRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUnmute.t.Errorf("ChatRepositoryMock.Unmute got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUnmute.UnmuteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGet.GetMock.defaultExpectation.results
+		mm_results := mmUnmute.UnmuteMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGet.t.Fatal("No results are set for the ChatRepositoryMock.Get")
+			mmUnmute.t.Fatal("No results are set for the ChatRepositoryMock.Unmute")
 		}
-		return (*mm_results).cp1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGet.funcGet != nil {
-		return mmGet.funcGet(ctx, id)
+	if mmUnmute.funcUnmute != nil {
+		return mmUnmute.funcUnmute(ctx, id, username)
 	}
-	mmGet.t.Fatalf("Unexpected call to ChatRepositoryMock.Get. %v %v", ctx, id)
+	mmUnmute.t.Fatalf("Unexpected call to ChatRepositoryMock.Unmute. %v %v %v", ctx, id, username)
 	return
 }
 
-// GetAfterCounter returns a count of finished ChatRepositoryMock.Get invocations
-func (mmGet *ChatRepositoryMock) GetAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+// UnmuteAfterCounter returns a count of finished ChatRepositoryMock.Unmute invocations
+func (mmUnmute *ChatRepositoryMock) UnmuteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnmute.afterUnmuteCounter)
 }
 
-// GetBeforeCounter returns a count of ChatRepositoryMock.Get invocations
-func (mmGet *ChatRepositoryMock) GetBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+// UnmuteBeforeCounter returns a count of ChatRepositoryMock.Unmute invocations
+func (mmUnmute *ChatRepositoryMock) UnmuteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnmute.beforeUnmuteCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatRepositoryMock.Get.
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.Unmute.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGet *mChatRepositoryMockGet) Calls() []*ChatRepositoryMockGetParams {
-	mmGet.mutex.RLock()
+func (mmUnmute *mChatRepositoryMockUnmute) Calls() []*ChatRepositoryMockUnmuteParams {
+	mmUnmute.mutex.RLock()
 
-	argCopy := make([]*ChatRepositoryMockGetParams, len(mmGet.callArgs))
-	copy(argCopy, mmGet.callArgs)
+	argCopy := make([]*ChatRepositoryMockUnmuteParams, len(mmUnmute.callArgs))
+	copy(argCopy, mmUnmute.callArgs)
 
-	mmGet.mutex.RUnlock()
+	mmUnmute.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetDone returns true if the count of the Get invocations corresponds
+// MinimockUnmuteDone returns true if the count of the Unmute invocations corresponds
 // the number of defined expectations
-func (m *ChatRepositoryMock) MinimockGetDone() bool {
-	if m.GetMock.optional {
+func (m *ChatRepositoryMock) MinimockUnmuteDone() bool {
+	if m.UnmuteMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetMock.expectations {
+	for _, e := range m.UnmuteMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetMock.invocationsDone()
+	return m.UnmuteMock.invocationsDone()
 }
 
-// MinimockGetInspect logs each unmet expectation
-func (m *ChatRepositoryMock) MinimockGetInspect() {
-	for _, e := range m.GetMock.expectations {
+// MinimockUnmuteInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockUnmuteInspect() {
+	for _, e := range m.UnmuteMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.Unmute at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	afterUnmuteCounter := mm_atomic.LoadUint64(&m.afterUnmuteCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
-		if m.GetMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+	if m.UnmuteMock.defaultExpectation != nil && afterUnmuteCounter < 1 {
+		if m.UnmuteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Unmute at\n%s", m.UnmuteMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.Unmute at\n%s with params: %#v", m.UnmuteMock.defaultExpectation.expectationOrigins.origin, *m.UnmuteMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGet != nil && afterGetCounter < 1 {
-		m.t.Errorf("Expected call to ChatRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	if m.funcUnmute != nil && afterUnmuteCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.Unmute at\n%s", m.funcUnmuteOrigin)
 	}
 
-	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatRepositoryMock.Get at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	if !m.UnmuteMock.invocationsDone() && afterUnmuteCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.Unmute at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UnmuteMock.expectedInvocations), m.UnmuteMock.expectedInvocationsOrigin, afterUnmuteCounter)
 	}
 }
 
@@ -1095,11 +5221,33 @@ func (m *ChatRepositoryMock) MinimockGetInspect() {
 func (m *ChatRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAddUserInspect()
+
+			m.MinimockCountDeletedInspect()
+
 			m.MinimockCreateInspect()
 
 			m.MinimockDeleteInspect()
 
 			m.MinimockGetInspect()
+
+			m.MinimockIsMutedInspect()
+
+			m.MinimockListRetentionInspect()
+
+			m.MinimockMuteInspect()
+
+			m.MinimockPurgeDeletedInspect()
+
+			m.MinimockRestoreInspect()
+
+			m.MinimockSetArchivedInspect()
+
+			m.MinimockSetRetentionInspect()
+
+			m.MinimockSetTopicInspect()
+
+			m.MinimockUnmuteInspect()
 		}
 	})
 }
@@ -1123,7 +5271,18 @@ func (m *ChatRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *ChatRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddUserDone() &&
+		m.MinimockCountDeletedDone() &&
 		m.MinimockCreateDone() &&
 		m.MinimockDeleteDone() &&
-		m.MinimockGetDone()
+		m.MinimockGetDone() &&
+		m.MinimockIsMutedDone() &&
+		m.MinimockListRetentionDone() &&
+		m.MinimockMuteDone() &&
+		m.MinimockPurgeDeletedDone() &&
+		m.MinimockRestoreDone() &&
+		m.MinimockSetArchivedDone() &&
+		m.MinimockSetRetentionDone() &&
+		m.MinimockSetTopicDone() &&
+		m.MinimockUnmuteDone()
 }
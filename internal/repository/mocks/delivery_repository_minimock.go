@@ -0,0 +1,1515 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Mobo140/chat/internal/repository.DeliveryRepository -o delivery_repository_minimock.go -n DeliveryRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// DeliveryRepositoryMock implements mm_repository.DeliveryRepository
+type DeliveryRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAck          func(ctx context.Context, ack *model.DeliveryAck) (err error)
+	funcAckOrigin    string
+	inspectFuncAck   func(ctx context.Context, ack *model.DeliveryAck)
+	afterAckCounter  uint64
+	beforeAckCounter uint64
+	AckMock          mDeliveryRepositoryMockAck
+
+	funcGet          func(ctx context.Context, chatID int64, username string) (dp1 *model.DeliveryCursor, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, chatID int64, username string)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mDeliveryRepositoryMockGet
+
+	funcList          func(ctx context.Context, chatID int64) (dpa1 []*model.DeliveryCursor, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context, chatID int64)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mDeliveryRepositoryMockList
+
+	funcMarkRead          func(ctx context.Context, read *model.DeliveryAck) (err error)
+	funcMarkReadOrigin    string
+	inspectFuncMarkRead   func(ctx context.Context, read *model.DeliveryAck)
+	afterMarkReadCounter  uint64
+	beforeMarkReadCounter uint64
+	MarkReadMock          mDeliveryRepositoryMockMarkRead
+}
+
+// NewDeliveryRepositoryMock returns a mock for mm_repository.DeliveryRepository
+func NewDeliveryRepositoryMock(t minimock.Tester) *DeliveryRepositoryMock {
+	m := &DeliveryRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AckMock = mDeliveryRepositoryMockAck{mock: m}
+	m.AckMock.callArgs = []*DeliveryRepositoryMockAckParams{}
+
+	m.GetMock = mDeliveryRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*DeliveryRepositoryMockGetParams{}
+
+	m.ListMock = mDeliveryRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*DeliveryRepositoryMockListParams{}
+
+	m.MarkReadMock = mDeliveryRepositoryMockMarkRead{mock: m}
+	m.MarkReadMock.callArgs = []*DeliveryRepositoryMockMarkReadParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mDeliveryRepositoryMockAck struct {
+	optional           bool
+	mock               *DeliveryRepositoryMock
+	defaultExpectation *DeliveryRepositoryMockAckExpectation
+	expectations       []*DeliveryRepositoryMockAckExpectation
+
+	callArgs []*DeliveryRepositoryMockAckParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// DeliveryRepositoryMockAckExpectation specifies expectation struct of the DeliveryRepository.Ack
+type DeliveryRepositoryMockAckExpectation struct {
+	mock               *DeliveryRepositoryMock
+	params             *DeliveryRepositoryMockAckParams
+	paramPtrs          *DeliveryRepositoryMockAckParamPtrs
+	expectationOrigins DeliveryRepositoryMockAckExpectationOrigins
+	results            *DeliveryRepositoryMockAckResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// DeliveryRepositoryMockAckParams contains parameters of the DeliveryRepository.Ack
+type DeliveryRepositoryMockAckParams struct {
+	ctx context.Context
+	ack *model.DeliveryAck
+}
+
+// DeliveryRepositoryMockAckParamPtrs contains pointers to parameters of the DeliveryRepository.Ack
+type DeliveryRepositoryMockAckParamPtrs struct {
+	ctx *context.Context
+	ack **model.DeliveryAck
+}
+
+// DeliveryRepositoryMockAckResults contains results of the DeliveryRepository.Ack
+type DeliveryRepositoryMockAckResults struct {
+	err error
+}
+
+// DeliveryRepositoryMockAckOrigins contains origins of expectations of the DeliveryRepository.Ack
+type DeliveryRepositoryMockAckExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originAck string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAck *mDeliveryRepositoryMockAck) Optional() *mDeliveryRepositoryMockAck {
+	mmAck.optional = true
+	return mmAck
+}
+
+// Expect sets up expected params for DeliveryRepository.Ack
+func (mmAck *mDeliveryRepositoryMockAck) Expect(ctx context.Context, ack *model.DeliveryAck) *mDeliveryRepositoryMockAck {
+	if mmAck.mock.funcAck != nil {
+		mmAck.mock.t.Fatalf("DeliveryRepositoryMock.Ack mock is already set by Set")
+	}
+
+	if mmAck.defaultExpectation == nil {
+		mmAck.defaultExpectation = &DeliveryRepositoryMockAckExpectation{}
+	}
+
+	if mmAck.defaultExpectation.paramPtrs != nil {
+		mmAck.mock.t.Fatalf("DeliveryRepositoryMock.Ack mock is already set by ExpectParams functions")
+	}
+
+	mmAck.defaultExpectation.params = &DeliveryRepositoryMockAckParams{ctx, ack}
+	mmAck.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAck.expectations {
+		if minimock.Equal(e.params, mmAck.defaultExpectation.params) {
+			mmAck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAck.defaultExpectation.params)
+		}
+	}
+
+	return mmAck
+}
+
+// ExpectCtxParam1 sets up expected param ctx for DeliveryRepository.Ack
+func (mmAck *mDeliveryRepositoryMockAck) ExpectCtxParam1(ctx context.Context) *mDeliveryRepositoryMockAck {
+	if mmAck.mock.funcAck != nil {
+		mmAck.mock.t.Fatalf("DeliveryRepositoryMock.Ack mock is already set by Set")
+	}
+
+	if mmAck.defaultExpectation == nil {
+		mmAck.defaultExpectation = &DeliveryRepositoryMockAckExpectation{}
+	}
+
+	if mmAck.defaultExpectation.params != nil {
+		mmAck.mock.t.Fatalf("DeliveryRepositoryMock.Ack mock is already set by Expect")
+	}
+
+	if mmAck.defaultExpectation.paramPtrs == nil {
+		mmAck.defaultExpectation.paramPtrs = &DeliveryRepositoryMockAckParamPtrs{}
+	}
+	mmAck.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAck.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAck
+}
+
+// ExpectAckParam2 sets up expected param ack for DeliveryRepository.Ack
+func (mmAck *mDeliveryRepositoryMockAck) ExpectAckParam2(ack *model.DeliveryAck) *mDeliveryRepositoryMockAck {
+	if mmAck.mock.funcAck != nil {
+		mmAck.mock.t.Fatalf("DeliveryRepositoryMock.Ack mock is already set by Set")
+	}
+
+	if mmAck.defaultExpectation == nil {
+		mmAck.defaultExpectation = &DeliveryRepositoryMockAckExpectation{}
+	}
+
+	if mmAck.defaultExpectation.params != nil {
+		mmAck.mock.t.Fatalf("DeliveryRepositoryMock.Ack mock is already set by Expect")
+	}
+
+	if mmAck.defaultExpectation.paramPtrs == nil {
+		mmAck.defaultExpectation.paramPtrs = &DeliveryRepositoryMockAckParamPtrs{}
+	}
+	mmAck.defaultExpectation.paramPtrs.ack = &ack
+	mmAck.defaultExpectation.expectationOrigins.originAck = minimock.CallerInfo(1)
+
+	return mmAck
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeliveryRepository.Ack
+func (mmAck *mDeliveryRepositoryMockAck) Inspect(f func(ctx context.Context, ack *model.DeliveryAck)) *mDeliveryRepositoryMockAck {
+	if mmAck.mock.inspectFuncAck != nil {
+		mmAck.mock.t.Fatalf("Inspect function is already set for DeliveryRepositoryMock.Ack")
+	}
+
+	mmAck.mock.inspectFuncAck = f
+
+	return mmAck
+}
+
+// Return sets up results that will be returned by DeliveryRepository.Ack
+func (mmAck *mDeliveryRepositoryMockAck) Return(err error) *DeliveryRepositoryMock {
+	if mmAck.mock.funcAck != nil {
+		mmAck.mock.t.Fatalf("DeliveryRepositoryMock.Ack mock is already set by Set")
+	}
+
+	if mmAck.defaultExpectation == nil {
+		mmAck.defaultExpectation = &DeliveryRepositoryMockAckExpectation{mock: mmAck.mock}
+	}
+	mmAck.defaultExpectation.results = &DeliveryRepositoryMockAckResults{err}
+	mmAck.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAck.mock
+}
+
+// Set uses given function f to mock the DeliveryRepository.Ack method
+func (mmAck *mDeliveryRepositoryMockAck) Set(f func(ctx context.Context, ack *model.DeliveryAck) (err error)) *DeliveryRepositoryMock {
+	if mmAck.defaultExpectation != nil {
+		mmAck.mock.t.Fatalf("Default expectation is already set for the DeliveryRepository.Ack method")
+	}
+
+	if len(mmAck.expectations) > 0 {
+		mmAck.mock.t.Fatalf("Some expectations are already set for the DeliveryRepository.Ack method")
+	}
+
+	mmAck.mock.funcAck = f
+	mmAck.mock.funcAckOrigin = minimock.CallerInfo(1)
+	return mmAck.mock
+}
+
+// When sets expectation for the DeliveryRepository.Ack which will trigger the result defined by the following
+// Then helper
+func (mmAck *mDeliveryRepositoryMockAck) When(ctx context.Context, ack *model.DeliveryAck) *DeliveryRepositoryMockAckExpectation {
+	if mmAck.mock.funcAck != nil {
+		mmAck.mock.t.Fatalf("DeliveryRepositoryMock.Ack mock is already set by Set")
+	}
+
+	expectation := &DeliveryRepositoryMockAckExpectation{
+		mock:               mmAck.mock,
+		params:             &DeliveryRepositoryMockAckParams{ctx, ack},
+		expectationOrigins: DeliveryRepositoryMockAckExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAck.expectations = append(mmAck.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeliveryRepository.Ack return parameters for the expectation previously defined by the When method
+func (e *DeliveryRepositoryMockAckExpectation) Then(err error) *DeliveryRepositoryMock {
+	e.results = &DeliveryRepositoryMockAckResults{err}
+	return e.mock
+}
+
+// Times sets number of times DeliveryRepository.Ack should be invoked
+func (mmAck *mDeliveryRepositoryMockAck) Times(n uint64) *mDeliveryRepositoryMockAck {
+	if n == 0 {
+		mmAck.mock.t.Fatalf("Times of DeliveryRepositoryMock.Ack mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAck.expectedInvocations, n)
+	mmAck.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAck
+}
+
+func (mmAck *mDeliveryRepositoryMockAck) invocationsDone() bool {
+	if len(mmAck.expectations) == 0 && mmAck.defaultExpectation == nil && mmAck.mock.funcAck == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAck.mock.afterAckCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAck.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Ack implements mm_repository.DeliveryRepository
+func (mmAck *DeliveryRepositoryMock) Ack(ctx context.Context, ack *model.DeliveryAck) (err error) {
+	mm_atomic.AddUint64(&mmAck.beforeAckCounter, 1)
+	defer mm_atomic.AddUint64(&mmAck.afterAckCounter, 1)
+
+	mmAck.t.Helper()
+
+	if mmAck.inspectFuncAck != nil {
+		mmAck.inspectFuncAck(ctx, ack)
+	}
+
+	mm_params := DeliveryRepositoryMockAckParams{ctx, ack}
+
+	// Record call args
+	mmAck.AckMock.mutex.Lock()
+	mmAck.AckMock.callArgs = append(mmAck.AckMock.callArgs, &mm_params)
+	mmAck.AckMock.mutex.Unlock()
+
+	for _, e := range mmAck.AckMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAck.AckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAck.AckMock.defaultExpectation.Counter, 1)
+		mm_want := mmAck.AckMock.defaultExpectation.params
+		mm_want_ptrs := mmAck.AckMock.defaultExpectation.paramPtrs
+
+		mm_got := DeliveryRepositoryMockAckParams{ctx, ack}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAck.t.Errorf("DeliveryRepositoryMock.Ack got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAck.AckMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ack != nil && !minimock.Equal(*mm_want_ptrs.ack, mm_got.ack) {
+				mmAck.t.Errorf("DeliveryRepositoryMock.Ack got unexpected parameter ack, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAck.AckMock.defaultExpectation.expectationOrigins.originAck, *mm_want_ptrs.ack, mm_got.ack, minimock.Diff(*mm_want_ptrs.ack, mm_got.ack))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAck.t.Errorf("DeliveryRepositoryMock.Ack got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAck.AckMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAck.AckMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAck.t.Fatal("No results are set for the DeliveryRepositoryMock.Ack")
+		}
+		return (*mm_results).err
+	}
+	if mmAck.funcAck != nil {
+		return mmAck.funcAck(ctx, ack)
+	}
+	mmAck.t.Fatalf("Unexpected call to DeliveryRepositoryMock.Ack. %v %v", ctx, ack)
+	return
+}
+
+// AckAfterCounter returns a count of finished DeliveryRepositoryMock.Ack invocations
+func (mmAck *DeliveryRepositoryMock) AckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAck.afterAckCounter)
+}
+
+// AckBeforeCounter returns a count of DeliveryRepositoryMock.Ack invocations
+func (mmAck *DeliveryRepositoryMock) AckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAck.beforeAckCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeliveryRepositoryMock.Ack.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAck *mDeliveryRepositoryMockAck) Calls() []*DeliveryRepositoryMockAckParams {
+	mmAck.mutex.RLock()
+
+	argCopy := make([]*DeliveryRepositoryMockAckParams, len(mmAck.callArgs))
+	copy(argCopy, mmAck.callArgs)
+
+	mmAck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAckDone returns true if the count of the Ack invocations corresponds
+// the number of defined expectations
+func (m *DeliveryRepositoryMock) MinimockAckDone() bool {
+	if m.AckMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AckMock.invocationsDone()
+}
+
+// MinimockAckInspect logs each unmet expectation
+func (m *DeliveryRepositoryMock) MinimockAckInspect() {
+	for _, e := range m.AckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.Ack at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAckCounter := mm_atomic.LoadUint64(&m.afterAckCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AckMock.defaultExpectation != nil && afterAckCounter < 1 {
+		if m.AckMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.Ack at\n%s", m.AckMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.Ack at\n%s with params: %#v", m.AckMock.defaultExpectation.expectationOrigins.origin, *m.AckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAck != nil && afterAckCounter < 1 {
+		m.t.Errorf("Expected call to DeliveryRepositoryMock.Ack at\n%s", m.funcAckOrigin)
+	}
+
+	if !m.AckMock.invocationsDone() && afterAckCounter > 0 {
+		m.t.Errorf("Expected %d calls to DeliveryRepositoryMock.Ack at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AckMock.expectedInvocations), m.AckMock.expectedInvocationsOrigin, afterAckCounter)
+	}
+}
+
+type mDeliveryRepositoryMockGet struct {
+	optional           bool
+	mock               *DeliveryRepositoryMock
+	defaultExpectation *DeliveryRepositoryMockGetExpectation
+	expectations       []*DeliveryRepositoryMockGetExpectation
+
+	callArgs []*DeliveryRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// DeliveryRepositoryMockGetExpectation specifies expectation struct of the DeliveryRepository.Get
+type DeliveryRepositoryMockGetExpectation struct {
+	mock               *DeliveryRepositoryMock
+	params             *DeliveryRepositoryMockGetParams
+	paramPtrs          *DeliveryRepositoryMockGetParamPtrs
+	expectationOrigins DeliveryRepositoryMockGetExpectationOrigins
+	results            *DeliveryRepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// DeliveryRepositoryMockGetParams contains parameters of the DeliveryRepository.Get
+type DeliveryRepositoryMockGetParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+}
+
+// DeliveryRepositoryMockGetParamPtrs contains pointers to parameters of the DeliveryRepository.Get
+type DeliveryRepositoryMockGetParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+}
+
+// DeliveryRepositoryMockGetResults contains results of the DeliveryRepository.Get
+type DeliveryRepositoryMockGetResults struct {
+	dp1 *model.DeliveryCursor
+	err error
+}
+
+// DeliveryRepositoryMockGetOrigins contains origins of expectations of the DeliveryRepository.Get
+type DeliveryRepositoryMockGetExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mDeliveryRepositoryMockGet) Optional() *mDeliveryRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for DeliveryRepository.Get
+func (mmGet *mDeliveryRepositoryMockGet) Expect(ctx context.Context, chatID int64, username string) *mDeliveryRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("DeliveryRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &DeliveryRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("DeliveryRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &DeliveryRepositoryMockGetParams{ctx, chatID, username}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for DeliveryRepository.Get
+func (mmGet *mDeliveryRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mDeliveryRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("DeliveryRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &DeliveryRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("DeliveryRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &DeliveryRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for DeliveryRepository.Get
+func (mmGet *mDeliveryRepositoryMockGet) ExpectChatIDParam2(chatID int64) *mDeliveryRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("DeliveryRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &DeliveryRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("DeliveryRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &DeliveryRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.chatID = &chatID
+	mmGet.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectUsernameParam3 sets up expected param username for DeliveryRepository.Get
+func (mmGet *mDeliveryRepositoryMockGet) ExpectUsernameParam3(username string) *mDeliveryRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("DeliveryRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &DeliveryRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("DeliveryRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &DeliveryRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.username = &username
+	mmGet.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeliveryRepository.Get
+func (mmGet *mDeliveryRepositoryMockGet) Inspect(f func(ctx context.Context, chatID int64, username string)) *mDeliveryRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for DeliveryRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by DeliveryRepository.Get
+func (mmGet *mDeliveryRepositoryMockGet) Return(dp1 *model.DeliveryCursor, err error) *DeliveryRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("DeliveryRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &DeliveryRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &DeliveryRepositoryMockGetResults{dp1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the DeliveryRepository.Get method
+func (mmGet *mDeliveryRepositoryMockGet) Set(f func(ctx context.Context, chatID int64, username string) (dp1 *model.DeliveryCursor, err error)) *DeliveryRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the DeliveryRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the DeliveryRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the DeliveryRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mDeliveryRepositoryMockGet) When(ctx context.Context, chatID int64, username string) *DeliveryRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("DeliveryRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &DeliveryRepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &DeliveryRepositoryMockGetParams{ctx, chatID, username},
+		expectationOrigins: DeliveryRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeliveryRepository.Get return parameters for the expectation previously defined by the When method
+func (e *DeliveryRepositoryMockGetExpectation) Then(dp1 *model.DeliveryCursor, err error) *DeliveryRepositoryMock {
+	e.results = &DeliveryRepositoryMockGetResults{dp1, err}
+	return e.mock
+}
+
+// Times sets number of times DeliveryRepository.Get should be invoked
+func (mmGet *mDeliveryRepositoryMockGet) Times(n uint64) *mDeliveryRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of DeliveryRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mDeliveryRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repository.DeliveryRepository
+func (mmGet *DeliveryRepositoryMock) Get(ctx context.Context, chatID int64, username string) (dp1 *model.DeliveryCursor, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, chatID, username)
+	}
+
+	mm_params := DeliveryRepositoryMockGetParams{ctx, chatID, username}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.dp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := DeliveryRepositoryMockGetParams{ctx, chatID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("DeliveryRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmGet.t.Errorf("DeliveryRepositoryMock.Get got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGet.t.Errorf("DeliveryRepositoryMock.Get got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("DeliveryRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the DeliveryRepositoryMock.Get")
+		}
+		return (*mm_results).dp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, chatID, username)
+	}
+	mmGet.t.Fatalf("Unexpected call to DeliveryRepositoryMock.Get. %v %v %v", ctx, chatID, username)
+	return
+}
+
+// GetAfterCounter returns a count of finished DeliveryRepositoryMock.Get invocations
+func (mmGet *DeliveryRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of DeliveryRepositoryMock.Get invocations
+func (mmGet *DeliveryRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeliveryRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mDeliveryRepositoryMockGet) Calls() []*DeliveryRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*DeliveryRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *DeliveryRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *DeliveryRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to DeliveryRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to DeliveryRepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mDeliveryRepositoryMockList struct {
+	optional           bool
+	mock               *DeliveryRepositoryMock
+	defaultExpectation *DeliveryRepositoryMockListExpectation
+	expectations       []*DeliveryRepositoryMockListExpectation
+
+	callArgs []*DeliveryRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// DeliveryRepositoryMockListExpectation specifies expectation struct of the DeliveryRepository.List
+type DeliveryRepositoryMockListExpectation struct {
+	mock               *DeliveryRepositoryMock
+	params             *DeliveryRepositoryMockListParams
+	paramPtrs          *DeliveryRepositoryMockListParamPtrs
+	expectationOrigins DeliveryRepositoryMockListExpectationOrigins
+	results            *DeliveryRepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// DeliveryRepositoryMockListParams contains parameters of the DeliveryRepository.List
+type DeliveryRepositoryMockListParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// DeliveryRepositoryMockListParamPtrs contains pointers to parameters of the DeliveryRepository.List
+type DeliveryRepositoryMockListParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// DeliveryRepositoryMockListResults contains results of the DeliveryRepository.List
+type DeliveryRepositoryMockListResults struct {
+	dpa1 []*model.DeliveryCursor
+	err  error
+}
+
+// DeliveryRepositoryMockListOrigins contains origins of expectations of the DeliveryRepository.List
+type DeliveryRepositoryMockListExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mDeliveryRepositoryMockList) Optional() *mDeliveryRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for DeliveryRepository.List
+func (mmList *mDeliveryRepositoryMockList) Expect(ctx context.Context, chatID int64) *mDeliveryRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("DeliveryRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &DeliveryRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("DeliveryRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &DeliveryRepositoryMockListParams{ctx, chatID}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for DeliveryRepository.List
+func (mmList *mDeliveryRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mDeliveryRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("DeliveryRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &DeliveryRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("DeliveryRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &DeliveryRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for DeliveryRepository.List
+func (mmList *mDeliveryRepositoryMockList) ExpectChatIDParam2(chatID int64) *mDeliveryRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("DeliveryRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &DeliveryRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("DeliveryRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &DeliveryRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.chatID = &chatID
+	mmList.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeliveryRepository.List
+func (mmList *mDeliveryRepositoryMockList) Inspect(f func(ctx context.Context, chatID int64)) *mDeliveryRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for DeliveryRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by DeliveryRepository.List
+func (mmList *mDeliveryRepositoryMockList) Return(dpa1 []*model.DeliveryCursor, err error) *DeliveryRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("DeliveryRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &DeliveryRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &DeliveryRepositoryMockListResults{dpa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the DeliveryRepository.List method
+func (mmList *mDeliveryRepositoryMockList) Set(f func(ctx context.Context, chatID int64) (dpa1 []*model.DeliveryCursor, err error)) *DeliveryRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the DeliveryRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the DeliveryRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the DeliveryRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mDeliveryRepositoryMockList) When(ctx context.Context, chatID int64) *DeliveryRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("DeliveryRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &DeliveryRepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &DeliveryRepositoryMockListParams{ctx, chatID},
+		expectationOrigins: DeliveryRepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeliveryRepository.List return parameters for the expectation previously defined by the When method
+func (e *DeliveryRepositoryMockListExpectation) Then(dpa1 []*model.DeliveryCursor, err error) *DeliveryRepositoryMock {
+	e.results = &DeliveryRepositoryMockListResults{dpa1, err}
+	return e.mock
+}
+
+// Times sets number of times DeliveryRepository.List should be invoked
+func (mmList *mDeliveryRepositoryMockList) Times(n uint64) *mDeliveryRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of DeliveryRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mDeliveryRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_repository.DeliveryRepository
+func (mmList *DeliveryRepositoryMock) List(ctx context.Context, chatID int64) (dpa1 []*model.DeliveryCursor, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, chatID)
+	}
+
+	mm_params := DeliveryRepositoryMockListParams{ctx, chatID}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.dpa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := DeliveryRepositoryMockListParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("DeliveryRepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmList.t.Errorf("DeliveryRepositoryMock.List got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("DeliveryRepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the DeliveryRepositoryMock.List")
+		}
+		return (*mm_results).dpa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, chatID)
+	}
+	mmList.t.Fatalf("Unexpected call to DeliveryRepositoryMock.List. %v %v", ctx, chatID)
+	return
+}
+
+// ListAfterCounter returns a count of finished DeliveryRepositoryMock.List invocations
+func (mmList *DeliveryRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of DeliveryRepositoryMock.List invocations
+func (mmList *DeliveryRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeliveryRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mDeliveryRepositoryMockList) Calls() []*DeliveryRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*DeliveryRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *DeliveryRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *DeliveryRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to DeliveryRepositoryMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to DeliveryRepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mDeliveryRepositoryMockMarkRead struct {
+	optional           bool
+	mock               *DeliveryRepositoryMock
+	defaultExpectation *DeliveryRepositoryMockMarkReadExpectation
+	expectations       []*DeliveryRepositoryMockMarkReadExpectation
+
+	callArgs []*DeliveryRepositoryMockMarkReadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// DeliveryRepositoryMockMarkReadExpectation specifies expectation struct of the DeliveryRepository.MarkRead
+type DeliveryRepositoryMockMarkReadExpectation struct {
+	mock               *DeliveryRepositoryMock
+	params             *DeliveryRepositoryMockMarkReadParams
+	paramPtrs          *DeliveryRepositoryMockMarkReadParamPtrs
+	expectationOrigins DeliveryRepositoryMockMarkReadExpectationOrigins
+	results            *DeliveryRepositoryMockMarkReadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// DeliveryRepositoryMockMarkReadParams contains parameters of the DeliveryRepository.MarkRead
+type DeliveryRepositoryMockMarkReadParams struct {
+	ctx  context.Context
+	read *model.DeliveryAck
+}
+
+// DeliveryRepositoryMockMarkReadParamPtrs contains pointers to parameters of the DeliveryRepository.MarkRead
+type DeliveryRepositoryMockMarkReadParamPtrs struct {
+	ctx  *context.Context
+	read **model.DeliveryAck
+}
+
+// DeliveryRepositoryMockMarkReadResults contains results of the DeliveryRepository.MarkRead
+type DeliveryRepositoryMockMarkReadResults struct {
+	err error
+}
+
+// DeliveryRepositoryMockMarkReadOrigins contains origins of expectations of the DeliveryRepository.MarkRead
+type DeliveryRepositoryMockMarkReadExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRead string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkRead *mDeliveryRepositoryMockMarkRead) Optional() *mDeliveryRepositoryMockMarkRead {
+	mmMarkRead.optional = true
+	return mmMarkRead
+}
+
+// Expect sets up expected params for DeliveryRepository.MarkRead
+func (mmMarkRead *mDeliveryRepositoryMockMarkRead) Expect(ctx context.Context, read *model.DeliveryAck) *mDeliveryRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("DeliveryRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &DeliveryRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs != nil {
+		mmMarkRead.mock.t.Fatalf("DeliveryRepositoryMock.MarkRead mock is already set by ExpectParams functions")
+	}
+
+	mmMarkRead.defaultExpectation.params = &DeliveryRepositoryMockMarkReadParams{ctx, read}
+	mmMarkRead.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkRead.expectations {
+		if minimock.Equal(e.params, mmMarkRead.defaultExpectation.params) {
+			mmMarkRead.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkRead.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkRead
+}
+
+// ExpectCtxParam1 sets up expected param ctx for DeliveryRepository.MarkRead
+func (mmMarkRead *mDeliveryRepositoryMockMarkRead) ExpectCtxParam1(ctx context.Context) *mDeliveryRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("DeliveryRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &DeliveryRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("DeliveryRepositoryMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &DeliveryRepositoryMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkRead.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectReadParam2 sets up expected param read for DeliveryRepository.MarkRead
+func (mmMarkRead *mDeliveryRepositoryMockMarkRead) ExpectReadParam2(read *model.DeliveryAck) *mDeliveryRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("DeliveryRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &DeliveryRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("DeliveryRepositoryMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &DeliveryRepositoryMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.read = &read
+	mmMarkRead.defaultExpectation.expectationOrigins.originRead = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// Inspect accepts an inspector function that has same arguments as the DeliveryRepository.MarkRead
+func (mmMarkRead *mDeliveryRepositoryMockMarkRead) Inspect(f func(ctx context.Context, read *model.DeliveryAck)) *mDeliveryRepositoryMockMarkRead {
+	if mmMarkRead.mock.inspectFuncMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("Inspect function is already set for DeliveryRepositoryMock.MarkRead")
+	}
+
+	mmMarkRead.mock.inspectFuncMarkRead = f
+
+	return mmMarkRead
+}
+
+// Return sets up results that will be returned by DeliveryRepository.MarkRead
+func (mmMarkRead *mDeliveryRepositoryMockMarkRead) Return(err error) *DeliveryRepositoryMock {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("DeliveryRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &DeliveryRepositoryMockMarkReadExpectation{mock: mmMarkRead.mock}
+	}
+	mmMarkRead.defaultExpectation.results = &DeliveryRepositoryMockMarkReadResults{err}
+	mmMarkRead.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkRead.mock
+}
+
+// Set uses given function f to mock the DeliveryRepository.MarkRead method
+func (mmMarkRead *mDeliveryRepositoryMockMarkRead) Set(f func(ctx context.Context, read *model.DeliveryAck) (err error)) *DeliveryRepositoryMock {
+	if mmMarkRead.defaultExpectation != nil {
+		mmMarkRead.mock.t.Fatalf("Default expectation is already set for the DeliveryRepository.MarkRead method")
+	}
+
+	if len(mmMarkRead.expectations) > 0 {
+		mmMarkRead.mock.t.Fatalf("Some expectations are already set for the DeliveryRepository.MarkRead method")
+	}
+
+	mmMarkRead.mock.funcMarkRead = f
+	mmMarkRead.mock.funcMarkReadOrigin = minimock.CallerInfo(1)
+	return mmMarkRead.mock
+}
+
+// When sets expectation for the DeliveryRepository.MarkRead which will trigger the result defined by the following
+// Then helper
+func (mmMarkRead *mDeliveryRepositoryMockMarkRead) When(ctx context.Context, read *model.DeliveryAck) *DeliveryRepositoryMockMarkReadExpectation {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("DeliveryRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	expectation := &DeliveryRepositoryMockMarkReadExpectation{
+		mock:               mmMarkRead.mock,
+		params:             &DeliveryRepositoryMockMarkReadParams{ctx, read},
+		expectationOrigins: DeliveryRepositoryMockMarkReadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkRead.expectations = append(mmMarkRead.expectations, expectation)
+	return expectation
+}
+
+// Then sets up DeliveryRepository.MarkRead return parameters for the expectation previously defined by the When method
+func (e *DeliveryRepositoryMockMarkReadExpectation) Then(err error) *DeliveryRepositoryMock {
+	e.results = &DeliveryRepositoryMockMarkReadResults{err}
+	return e.mock
+}
+
+// Times sets number of times DeliveryRepository.MarkRead should be invoked
+func (mmMarkRead *mDeliveryRepositoryMockMarkRead) Times(n uint64) *mDeliveryRepositoryMockMarkRead {
+	if n == 0 {
+		mmMarkRead.mock.t.Fatalf("Times of DeliveryRepositoryMock.MarkRead mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkRead.expectedInvocations, n)
+	mmMarkRead.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkRead
+}
+
+func (mmMarkRead *mDeliveryRepositoryMockMarkRead) invocationsDone() bool {
+	if len(mmMarkRead.expectations) == 0 && mmMarkRead.defaultExpectation == nil && mmMarkRead.mock.funcMarkRead == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkRead.mock.afterMarkReadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkRead.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkRead implements mm_repository.DeliveryRepository
+func (mmMarkRead *DeliveryRepositoryMock) MarkRead(ctx context.Context, read *model.DeliveryAck) (err error) {
+	mm_atomic.AddUint64(&mmMarkRead.beforeMarkReadCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkRead.afterMarkReadCounter, 1)
+
+	mmMarkRead.t.Helper()
+
+	if mmMarkRead.inspectFuncMarkRead != nil {
+		mmMarkRead.inspectFuncMarkRead(ctx, read)
+	}
+
+	mm_params := DeliveryRepositoryMockMarkReadParams{ctx, read}
+
+	// Record call args
+	mmMarkRead.MarkReadMock.mutex.Lock()
+	mmMarkRead.MarkReadMock.callArgs = append(mmMarkRead.MarkReadMock.callArgs, &mm_params)
+	mmMarkRead.MarkReadMock.mutex.Unlock()
+
+	for _, e := range mmMarkRead.MarkReadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkRead.MarkReadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkRead.MarkReadMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkRead.MarkReadMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkRead.MarkReadMock.defaultExpectation.paramPtrs
+
+		mm_got := DeliveryRepositoryMockMarkReadParams{ctx, read}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkRead.t.Errorf("DeliveryRepositoryMock.MarkRead got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.read != nil && !minimock.Equal(*mm_want_ptrs.read, mm_got.read) {
+				mmMarkRead.t.Errorf("DeliveryRepositoryMock.MarkRead got unexpected parameter read, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originRead, *mm_want_ptrs.read, mm_got.read, minimock.Diff(*mm_want_ptrs.read, mm_got.read))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkRead.t.Errorf("DeliveryRepositoryMock.MarkRead got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkRead.MarkReadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkRead.t.Fatal("No results are set for the DeliveryRepositoryMock.MarkRead")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkRead.funcMarkRead != nil {
+		return mmMarkRead.funcMarkRead(ctx, read)
+	}
+	mmMarkRead.t.Fatalf("Unexpected call to DeliveryRepositoryMock.MarkRead. %v %v", ctx, read)
+	return
+}
+
+// MarkReadAfterCounter returns a count of finished DeliveryRepositoryMock.MarkRead invocations
+func (mmMarkRead *DeliveryRepositoryMock) MarkReadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.afterMarkReadCounter)
+}
+
+// MarkReadBeforeCounter returns a count of DeliveryRepositoryMock.MarkRead invocations
+func (mmMarkRead *DeliveryRepositoryMock) MarkReadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.beforeMarkReadCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeliveryRepositoryMock.MarkRead.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkRead *mDeliveryRepositoryMockMarkRead) Calls() []*DeliveryRepositoryMockMarkReadParams {
+	mmMarkRead.mutex.RLock()
+
+	argCopy := make([]*DeliveryRepositoryMockMarkReadParams, len(mmMarkRead.callArgs))
+	copy(argCopy, mmMarkRead.callArgs)
+
+	mmMarkRead.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkReadDone returns true if the count of the MarkRead invocations corresponds
+// the number of defined expectations
+func (m *DeliveryRepositoryMock) MinimockMarkReadDone() bool {
+	if m.MarkReadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkReadMock.invocationsDone()
+}
+
+// MinimockMarkReadInspect logs each unmet expectation
+func (m *DeliveryRepositoryMock) MinimockMarkReadInspect() {
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.MarkRead at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkReadCounter := mm_atomic.LoadUint64(&m.afterMarkReadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkReadMock.defaultExpectation != nil && afterMarkReadCounter < 1 {
+		if m.MarkReadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.MarkRead at\n%s", m.MarkReadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.MarkRead at\n%s with params: %#v", m.MarkReadMock.defaultExpectation.expectationOrigins.origin, *m.MarkReadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkRead != nil && afterMarkReadCounter < 1 {
+		m.t.Errorf("Expected call to DeliveryRepositoryMock.MarkRead at\n%s", m.funcMarkReadOrigin)
+	}
+
+	if !m.MarkReadMock.invocationsDone() && afterMarkReadCounter > 0 {
+		m.t.Errorf("Expected %d calls to DeliveryRepositoryMock.MarkRead at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkReadMock.expectedInvocations), m.MarkReadMock.expectedInvocationsOrigin, afterMarkReadCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *DeliveryRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAckInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockMarkReadInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *DeliveryRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *DeliveryRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAckDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockListDone() &&
+		m.MinimockMarkReadDone()
+}
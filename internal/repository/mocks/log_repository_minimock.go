@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/Mobo140/chat/internal/model"
@@ -19,12 +20,75 @@ type LogRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcCountUpTo          func(ctx context.Context, seq int64) (i1 int64, err error)
+	funcCountUpToOrigin    string
+	inspectFuncCountUpTo   func(ctx context.Context, seq int64)
+	afterCountUpToCounter  uint64
+	beforeCountUpToCounter uint64
+	CountUpToMock          mLogRepositoryMockCountUpTo
+
 	funcCreate          func(ctx context.Context, logEntry *model.LogEntry) (err error)
 	funcCreateOrigin    string
 	inspectFuncCreate   func(ctx context.Context, logEntry *model.LogEntry)
 	afterCreateCounter  uint64
 	beforeCreateCounter uint64
 	CreateMock          mLogRepositoryMockCreate
+
+	funcDeleteUpTo          func(ctx context.Context, seq int64, limit uint64) (i1 int64, err error)
+	funcDeleteUpToOrigin    string
+	inspectFuncDeleteUpTo   func(ctx context.Context, seq int64, limit uint64)
+	afterDeleteUpToCounter  uint64
+	beforeDeleteUpToCounter uint64
+	DeleteUpToMock          mLogRepositoryMockDeleteUpTo
+
+	funcLastBefore          func(ctx context.Context, maxAge time.Duration) (lp1 *model.LogEntry, err error)
+	funcLastBeforeOrigin    string
+	inspectFuncLastBefore   func(ctx context.Context, maxAge time.Duration)
+	afterLastBeforeCounter  uint64
+	beforeLastBeforeCounter uint64
+	LastBeforeMock          mLogRepositoryMockLastBefore
+
+	funcLastChained          func(ctx context.Context) (lp1 *model.LogEntry, err error)
+	funcLastChainedOrigin    string
+	inspectFuncLastChained   func(ctx context.Context)
+	afterLastChainedCounter  uint64
+	beforeLastChainedCounter uint64
+	LastChainedMock          mLogRepositoryMockLastChained
+
+	funcLink          func(ctx context.Context, logEntry *model.LogEntry) (err error)
+	funcLinkOrigin    string
+	inspectFuncLink   func(ctx context.Context, logEntry *model.LogEntry)
+	afterLinkCounter  uint64
+	beforeLinkCounter uint64
+	LinkMock          mLogRepositoryMockLink
+
+	funcList          func(ctx context.Context, filter *model.LogFilter) (lpa1 []*model.LogEntry, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context, filter *model.LogFilter)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mLogRepositoryMockList
+
+	funcListChain          func(ctx context.Context, afterSeq int64, limit uint64) (lpa1 []*model.LogEntry, err error)
+	funcListChainOrigin    string
+	inspectFuncListChain   func(ctx context.Context, afterSeq int64, limit uint64)
+	afterListChainCounter  uint64
+	beforeListChainCounter uint64
+	ListChainMock          mLogRepositoryMockListChain
+
+	funcListUnchained          func(ctx context.Context, limit uint64) (lpa1 []*model.LogEntry, err error)
+	funcListUnchainedOrigin    string
+	inspectFuncListUnchained   func(ctx context.Context, limit uint64)
+	afterListUnchainedCounter  uint64
+	beforeListUnchainedCounter uint64
+	ListUnchainedMock          mLogRepositoryMockListUnchained
+
+	funcLockChain          func(ctx context.Context) (err error)
+	funcLockChainOrigin    string
+	inspectFuncLockChain   func(ctx context.Context)
+	afterLockChainCounter  uint64
+	beforeLockChainCounter uint64
+	LockChainMock          mLogRepositoryMockLockChain
 }
 
 // NewLogRepositoryMock returns a mock for mm_repository.LogRepository
@@ -35,14 +99,384 @@ func NewLogRepositoryMock(t minimock.Tester) *LogRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CountUpToMock = mLogRepositoryMockCountUpTo{mock: m}
+	m.CountUpToMock.callArgs = []*LogRepositoryMockCountUpToParams{}
+
 	m.CreateMock = mLogRepositoryMockCreate{mock: m}
 	m.CreateMock.callArgs = []*LogRepositoryMockCreateParams{}
 
+	m.DeleteUpToMock = mLogRepositoryMockDeleteUpTo{mock: m}
+	m.DeleteUpToMock.callArgs = []*LogRepositoryMockDeleteUpToParams{}
+
+	m.LastBeforeMock = mLogRepositoryMockLastBefore{mock: m}
+	m.LastBeforeMock.callArgs = []*LogRepositoryMockLastBeforeParams{}
+
+	m.LastChainedMock = mLogRepositoryMockLastChained{mock: m}
+	m.LastChainedMock.callArgs = []*LogRepositoryMockLastChainedParams{}
+
+	m.LinkMock = mLogRepositoryMockLink{mock: m}
+	m.LinkMock.callArgs = []*LogRepositoryMockLinkParams{}
+
+	m.ListMock = mLogRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*LogRepositoryMockListParams{}
+
+	m.ListChainMock = mLogRepositoryMockListChain{mock: m}
+	m.ListChainMock.callArgs = []*LogRepositoryMockListChainParams{}
+
+	m.ListUnchainedMock = mLogRepositoryMockListUnchained{mock: m}
+	m.ListUnchainedMock.callArgs = []*LogRepositoryMockListUnchainedParams{}
+
+	m.LockChainMock = mLogRepositoryMockLockChain{mock: m}
+	m.LockChainMock.callArgs = []*LogRepositoryMockLockChainParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
+type mLogRepositoryMockCountUpTo struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockCountUpToExpectation
+	expectations       []*LogRepositoryMockCountUpToExpectation
+
+	callArgs []*LogRepositoryMockCountUpToParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LogRepositoryMockCountUpToExpectation specifies expectation struct of the LogRepository.CountUpTo
+type LogRepositoryMockCountUpToExpectation struct {
+	mock               *LogRepositoryMock
+	params             *LogRepositoryMockCountUpToParams
+	paramPtrs          *LogRepositoryMockCountUpToParamPtrs
+	expectationOrigins LogRepositoryMockCountUpToExpectationOrigins
+	results            *LogRepositoryMockCountUpToResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LogRepositoryMockCountUpToParams contains parameters of the LogRepository.CountUpTo
+type LogRepositoryMockCountUpToParams struct {
+	ctx context.Context
+	seq int64
+}
+
+// LogRepositoryMockCountUpToParamPtrs contains pointers to parameters of the LogRepository.CountUpTo
+type LogRepositoryMockCountUpToParamPtrs struct {
+	ctx *context.Context
+	seq *int64
+}
+
+// LogRepositoryMockCountUpToResults contains results of the LogRepository.CountUpTo
+type LogRepositoryMockCountUpToResults struct {
+	i1  int64
+	err error
+}
+
+// LogRepositoryMockCountUpToOrigins contains origins of expectations of the LogRepository.CountUpTo
+type LogRepositoryMockCountUpToExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originSeq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountUpTo *mLogRepositoryMockCountUpTo) Optional() *mLogRepositoryMockCountUpTo {
+	mmCountUpTo.optional = true
+	return mmCountUpTo
+}
+
+// Expect sets up expected params for LogRepository.CountUpTo
+func (mmCountUpTo *mLogRepositoryMockCountUpTo) Expect(ctx context.Context, seq int64) *mLogRepositoryMockCountUpTo {
+	if mmCountUpTo.mock.funcCountUpTo != nil {
+		mmCountUpTo.mock.t.Fatalf("LogRepositoryMock.CountUpTo mock is already set by Set")
+	}
+
+	if mmCountUpTo.defaultExpectation == nil {
+		mmCountUpTo.defaultExpectation = &LogRepositoryMockCountUpToExpectation{}
+	}
+
+	if mmCountUpTo.defaultExpectation.paramPtrs != nil {
+		mmCountUpTo.mock.t.Fatalf("LogRepositoryMock.CountUpTo mock is already set by ExpectParams functions")
+	}
+
+	mmCountUpTo.defaultExpectation.params = &LogRepositoryMockCountUpToParams{ctx, seq}
+	mmCountUpTo.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountUpTo.expectations {
+		if minimock.Equal(e.params, mmCountUpTo.defaultExpectation.params) {
+			mmCountUpTo.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountUpTo.defaultExpectation.params)
+		}
+	}
+
+	return mmCountUpTo
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.CountUpTo
+func (mmCountUpTo *mLogRepositoryMockCountUpTo) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockCountUpTo {
+	if mmCountUpTo.mock.funcCountUpTo != nil {
+		mmCountUpTo.mock.t.Fatalf("LogRepositoryMock.CountUpTo mock is already set by Set")
+	}
+
+	if mmCountUpTo.defaultExpectation == nil {
+		mmCountUpTo.defaultExpectation = &LogRepositoryMockCountUpToExpectation{}
+	}
+
+	if mmCountUpTo.defaultExpectation.params != nil {
+		mmCountUpTo.mock.t.Fatalf("LogRepositoryMock.CountUpTo mock is already set by Expect")
+	}
+
+	if mmCountUpTo.defaultExpectation.paramPtrs == nil {
+		mmCountUpTo.defaultExpectation.paramPtrs = &LogRepositoryMockCountUpToParamPtrs{}
+	}
+	mmCountUpTo.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountUpTo.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountUpTo
+}
+
+// ExpectSeqParam2 sets up expected param seq for LogRepository.CountUpTo
+func (mmCountUpTo *mLogRepositoryMockCountUpTo) ExpectSeqParam2(seq int64) *mLogRepositoryMockCountUpTo {
+	if mmCountUpTo.mock.funcCountUpTo != nil {
+		mmCountUpTo.mock.t.Fatalf("LogRepositoryMock.CountUpTo mock is already set by Set")
+	}
+
+	if mmCountUpTo.defaultExpectation == nil {
+		mmCountUpTo.defaultExpectation = &LogRepositoryMockCountUpToExpectation{}
+	}
+
+	if mmCountUpTo.defaultExpectation.params != nil {
+		mmCountUpTo.mock.t.Fatalf("LogRepositoryMock.CountUpTo mock is already set by Expect")
+	}
+
+	if mmCountUpTo.defaultExpectation.paramPtrs == nil {
+		mmCountUpTo.defaultExpectation.paramPtrs = &LogRepositoryMockCountUpToParamPtrs{}
+	}
+	mmCountUpTo.defaultExpectation.paramPtrs.seq = &seq
+	mmCountUpTo.defaultExpectation.expectationOrigins.originSeq = minimock.CallerInfo(1)
+
+	return mmCountUpTo
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.CountUpTo
+func (mmCountUpTo *mLogRepositoryMockCountUpTo) Inspect(f func(ctx context.Context, seq int64)) *mLogRepositoryMockCountUpTo {
+	if mmCountUpTo.mock.inspectFuncCountUpTo != nil {
+		mmCountUpTo.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.CountUpTo")
+	}
+
+	mmCountUpTo.mock.inspectFuncCountUpTo = f
+
+	return mmCountUpTo
+}
+
+// Return sets up results that will be returned by LogRepository.CountUpTo
+func (mmCountUpTo *mLogRepositoryMockCountUpTo) Return(i1 int64, err error) *LogRepositoryMock {
+	if mmCountUpTo.mock.funcCountUpTo != nil {
+		mmCountUpTo.mock.t.Fatalf("LogRepositoryMock.CountUpTo mock is already set by Set")
+	}
+
+	if mmCountUpTo.defaultExpectation == nil {
+		mmCountUpTo.defaultExpectation = &LogRepositoryMockCountUpToExpectation{mock: mmCountUpTo.mock}
+	}
+	mmCountUpTo.defaultExpectation.results = &LogRepositoryMockCountUpToResults{i1, err}
+	mmCountUpTo.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountUpTo.mock
+}
+
+// Set uses given function f to mock the LogRepository.CountUpTo method
+func (mmCountUpTo *mLogRepositoryMockCountUpTo) Set(f func(ctx context.Context, seq int64) (i1 int64, err error)) *LogRepositoryMock {
+	if mmCountUpTo.defaultExpectation != nil {
+		mmCountUpTo.mock.t.Fatalf("Default expectation is already set for the LogRepository.CountUpTo method")
+	}
+
+	if len(mmCountUpTo.expectations) > 0 {
+		mmCountUpTo.mock.t.Fatalf("Some expectations are already set for the LogRepository.CountUpTo method")
+	}
+
+	mmCountUpTo.mock.funcCountUpTo = f
+	mmCountUpTo.mock.funcCountUpToOrigin = minimock.CallerInfo(1)
+	return mmCountUpTo.mock
+}
+
+// When sets expectation for the LogRepository.CountUpTo which will trigger the result defined by the following
+// Then helper
+func (mmCountUpTo *mLogRepositoryMockCountUpTo) When(ctx context.Context, seq int64) *LogRepositoryMockCountUpToExpectation {
+	if mmCountUpTo.mock.funcCountUpTo != nil {
+		mmCountUpTo.mock.t.Fatalf("LogRepositoryMock.CountUpTo mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockCountUpToExpectation{
+		mock:               mmCountUpTo.mock,
+		params:             &LogRepositoryMockCountUpToParams{ctx, seq},
+		expectationOrigins: LogRepositoryMockCountUpToExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountUpTo.expectations = append(mmCountUpTo.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.CountUpTo return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockCountUpToExpectation) Then(i1 int64, err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockCountUpToResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.CountUpTo should be invoked
+func (mmCountUpTo *mLogRepositoryMockCountUpTo) Times(n uint64) *mLogRepositoryMockCountUpTo {
+	if n == 0 {
+		mmCountUpTo.mock.t.Fatalf("Times of LogRepositoryMock.CountUpTo mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountUpTo.expectedInvocations, n)
+	mmCountUpTo.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountUpTo
+}
+
+func (mmCountUpTo *mLogRepositoryMockCountUpTo) invocationsDone() bool {
+	if len(mmCountUpTo.expectations) == 0 && mmCountUpTo.defaultExpectation == nil && mmCountUpTo.mock.funcCountUpTo == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountUpTo.mock.afterCountUpToCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountUpTo.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountUpTo implements mm_repository.LogRepository
+func (mmCountUpTo *LogRepositoryMock) CountUpTo(ctx context.Context, seq int64) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCountUpTo.beforeCountUpToCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountUpTo.afterCountUpToCounter, 1)
+
+	mmCountUpTo.t.Helper()
+
+	if mmCountUpTo.inspectFuncCountUpTo != nil {
+		mmCountUpTo.inspectFuncCountUpTo(ctx, seq)
+	}
+
+	mm_params := LogRepositoryMockCountUpToParams{ctx, seq}
+
+	// Record call args
+	mmCountUpTo.CountUpToMock.mutex.Lock()
+	mmCountUpTo.CountUpToMock.callArgs = append(mmCountUpTo.CountUpToMock.callArgs, &mm_params)
+	mmCountUpTo.CountUpToMock.mutex.Unlock()
+
+	for _, e := range mmCountUpTo.CountUpToMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCountUpTo.CountUpToMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountUpTo.CountUpToMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountUpTo.CountUpToMock.defaultExpectation.params
+		mm_want_ptrs := mmCountUpTo.CountUpToMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockCountUpToParams{ctx, seq}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountUpTo.t.Errorf("LogRepositoryMock.CountUpTo got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountUpTo.CountUpToMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.seq != nil && !minimock.Equal(*mm_want_ptrs.seq, mm_got.seq) {
+				mmCountUpTo.t.Errorf("LogRepositoryMock.CountUpTo got unexpected parameter seq, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountUpTo.CountUpToMock.defaultExpectation.expectationOrigins.originSeq, *mm_want_ptrs.seq, mm_got.seq, minimock.Diff(*mm_want_ptrs.seq, mm_got.seq))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountUpTo.t.Errorf("LogRepositoryMock.CountUpTo got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountUpTo.CountUpToMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountUpTo.CountUpToMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountUpTo.t.Fatal("No results are set for the LogRepositoryMock.CountUpTo")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCountUpTo.funcCountUpTo != nil {
+		return mmCountUpTo.funcCountUpTo(ctx, seq)
+	}
+	mmCountUpTo.t.Fatalf("Unexpected call to LogRepositoryMock.CountUpTo. %v %v", ctx, seq)
+	return
+}
+
+// CountUpToAfterCounter returns a count of finished LogRepositoryMock.CountUpTo invocations
+func (mmCountUpTo *LogRepositoryMock) CountUpToAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountUpTo.afterCountUpToCounter)
+}
+
+// CountUpToBeforeCounter returns a count of LogRepositoryMock.CountUpTo invocations
+func (mmCountUpTo *LogRepositoryMock) CountUpToBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountUpTo.beforeCountUpToCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.CountUpTo.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountUpTo *mLogRepositoryMockCountUpTo) Calls() []*LogRepositoryMockCountUpToParams {
+	mmCountUpTo.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockCountUpToParams, len(mmCountUpTo.callArgs))
+	copy(argCopy, mmCountUpTo.callArgs)
+
+	mmCountUpTo.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountUpToDone returns true if the count of the CountUpTo invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockCountUpToDone() bool {
+	if m.CountUpToMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountUpToMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountUpToMock.invocationsDone()
+}
+
+// MinimockCountUpToInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockCountUpToInspect() {
+	for _, e := range m.CountUpToMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.CountUpTo at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountUpToCounter := mm_atomic.LoadUint64(&m.afterCountUpToCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountUpToMock.defaultExpectation != nil && afterCountUpToCounter < 1 {
+		if m.CountUpToMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LogRepositoryMock.CountUpTo at\n%s", m.CountUpToMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.CountUpTo at\n%s with params: %#v", m.CountUpToMock.defaultExpectation.expectationOrigins.origin, *m.CountUpToMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountUpTo != nil && afterCountUpToCounter < 1 {
+		m.t.Errorf("Expected call to LogRepositoryMock.CountUpTo at\n%s", m.funcCountUpToOrigin)
+	}
+
+	if !m.CountUpToMock.invocationsDone() && afterCountUpToCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.CountUpTo at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountUpToMock.expectedInvocations), m.CountUpToMock.expectedInvocationsOrigin, afterCountUpToCounter)
+	}
+}
+
 type mLogRepositoryMockCreate struct {
 	optional           bool
 	mock               *LogRepositoryMock
@@ -385,11 +819,2771 @@ func (m *LogRepositoryMock) MinimockCreateInspect() {
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *LogRepositoryMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockCreateInspect()
+type mLogRepositoryMockDeleteUpTo struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockDeleteUpToExpectation
+	expectations       []*LogRepositoryMockDeleteUpToExpectation
+
+	callArgs []*LogRepositoryMockDeleteUpToParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LogRepositoryMockDeleteUpToExpectation specifies expectation struct of the LogRepository.DeleteUpTo
+type LogRepositoryMockDeleteUpToExpectation struct {
+	mock               *LogRepositoryMock
+	params             *LogRepositoryMockDeleteUpToParams
+	paramPtrs          *LogRepositoryMockDeleteUpToParamPtrs
+	expectationOrigins LogRepositoryMockDeleteUpToExpectationOrigins
+	results            *LogRepositoryMockDeleteUpToResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LogRepositoryMockDeleteUpToParams contains parameters of the LogRepository.DeleteUpTo
+type LogRepositoryMockDeleteUpToParams struct {
+	ctx   context.Context
+	seq   int64
+	limit uint64
+}
+
+// LogRepositoryMockDeleteUpToParamPtrs contains pointers to parameters of the LogRepository.DeleteUpTo
+type LogRepositoryMockDeleteUpToParamPtrs struct {
+	ctx   *context.Context
+	seq   *int64
+	limit *uint64
+}
+
+// LogRepositoryMockDeleteUpToResults contains results of the LogRepository.DeleteUpTo
+type LogRepositoryMockDeleteUpToResults struct {
+	i1  int64
+	err error
+}
+
+// LogRepositoryMockDeleteUpToOrigins contains origins of expectations of the LogRepository.DeleteUpTo
+type LogRepositoryMockDeleteUpToExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originSeq   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUpTo *mLogRepositoryMockDeleteUpTo) Optional() *mLogRepositoryMockDeleteUpTo {
+	mmDeleteUpTo.optional = true
+	return mmDeleteUpTo
+}
+
+// Expect sets up expected params for LogRepository.DeleteUpTo
+func (mmDeleteUpTo *mLogRepositoryMockDeleteUpTo) Expect(ctx context.Context, seq int64, limit uint64) *mLogRepositoryMockDeleteUpTo {
+	if mmDeleteUpTo.mock.funcDeleteUpTo != nil {
+		mmDeleteUpTo.mock.t.Fatalf("LogRepositoryMock.DeleteUpTo mock is already set by Set")
+	}
+
+	if mmDeleteUpTo.defaultExpectation == nil {
+		mmDeleteUpTo.defaultExpectation = &LogRepositoryMockDeleteUpToExpectation{}
+	}
+
+	if mmDeleteUpTo.defaultExpectation.paramPtrs != nil {
+		mmDeleteUpTo.mock.t.Fatalf("LogRepositoryMock.DeleteUpTo mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUpTo.defaultExpectation.params = &LogRepositoryMockDeleteUpToParams{ctx, seq, limit}
+	mmDeleteUpTo.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUpTo.expectations {
+		if minimock.Equal(e.params, mmDeleteUpTo.defaultExpectation.params) {
+			mmDeleteUpTo.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUpTo.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUpTo
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.DeleteUpTo
+func (mmDeleteUpTo *mLogRepositoryMockDeleteUpTo) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockDeleteUpTo {
+	if mmDeleteUpTo.mock.funcDeleteUpTo != nil {
+		mmDeleteUpTo.mock.t.Fatalf("LogRepositoryMock.DeleteUpTo mock is already set by Set")
+	}
+
+	if mmDeleteUpTo.defaultExpectation == nil {
+		mmDeleteUpTo.defaultExpectation = &LogRepositoryMockDeleteUpToExpectation{}
+	}
+
+	if mmDeleteUpTo.defaultExpectation.params != nil {
+		mmDeleteUpTo.mock.t.Fatalf("LogRepositoryMock.DeleteUpTo mock is already set by Expect")
+	}
+
+	if mmDeleteUpTo.defaultExpectation.paramPtrs == nil {
+		mmDeleteUpTo.defaultExpectation.paramPtrs = &LogRepositoryMockDeleteUpToParamPtrs{}
+	}
+	mmDeleteUpTo.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUpTo.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUpTo
+}
+
+// ExpectSeqParam2 sets up expected param seq for LogRepository.DeleteUpTo
+func (mmDeleteUpTo *mLogRepositoryMockDeleteUpTo) ExpectSeqParam2(seq int64) *mLogRepositoryMockDeleteUpTo {
+	if mmDeleteUpTo.mock.funcDeleteUpTo != nil {
+		mmDeleteUpTo.mock.t.Fatalf("LogRepositoryMock.DeleteUpTo mock is already set by Set")
+	}
+
+	if mmDeleteUpTo.defaultExpectation == nil {
+		mmDeleteUpTo.defaultExpectation = &LogRepositoryMockDeleteUpToExpectation{}
+	}
+
+	if mmDeleteUpTo.defaultExpectation.params != nil {
+		mmDeleteUpTo.mock.t.Fatalf("LogRepositoryMock.DeleteUpTo mock is already set by Expect")
+	}
+
+	if mmDeleteUpTo.defaultExpectation.paramPtrs == nil {
+		mmDeleteUpTo.defaultExpectation.paramPtrs = &LogRepositoryMockDeleteUpToParamPtrs{}
+	}
+	mmDeleteUpTo.defaultExpectation.paramPtrs.seq = &seq
+	mmDeleteUpTo.defaultExpectation.expectationOrigins.originSeq = minimock.CallerInfo(1)
+
+	return mmDeleteUpTo
+}
+
+// ExpectLimitParam3 sets up expected param limit for LogRepository.DeleteUpTo
+func (mmDeleteUpTo *mLogRepositoryMockDeleteUpTo) ExpectLimitParam3(limit uint64) *mLogRepositoryMockDeleteUpTo {
+	if mmDeleteUpTo.mock.funcDeleteUpTo != nil {
+		mmDeleteUpTo.mock.t.Fatalf("LogRepositoryMock.DeleteUpTo mock is already set by Set")
+	}
+
+	if mmDeleteUpTo.defaultExpectation == nil {
+		mmDeleteUpTo.defaultExpectation = &LogRepositoryMockDeleteUpToExpectation{}
+	}
+
+	if mmDeleteUpTo.defaultExpectation.params != nil {
+		mmDeleteUpTo.mock.t.Fatalf("LogRepositoryMock.DeleteUpTo mock is already set by Expect")
+	}
+
+	if mmDeleteUpTo.defaultExpectation.paramPtrs == nil {
+		mmDeleteUpTo.defaultExpectation.paramPtrs = &LogRepositoryMockDeleteUpToParamPtrs{}
+	}
+	mmDeleteUpTo.defaultExpectation.paramPtrs.limit = &limit
+	mmDeleteUpTo.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmDeleteUpTo
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.DeleteUpTo
+func (mmDeleteUpTo *mLogRepositoryMockDeleteUpTo) Inspect(f func(ctx context.Context, seq int64, limit uint64)) *mLogRepositoryMockDeleteUpTo {
+	if mmDeleteUpTo.mock.inspectFuncDeleteUpTo != nil {
+		mmDeleteUpTo.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.DeleteUpTo")
+	}
+
+	mmDeleteUpTo.mock.inspectFuncDeleteUpTo = f
+
+	return mmDeleteUpTo
+}
+
+// Return sets up results that will be returned by LogRepository.DeleteUpTo
+func (mmDeleteUpTo *mLogRepositoryMockDeleteUpTo) Return(i1 int64, err error) *LogRepositoryMock {
+	if mmDeleteUpTo.mock.funcDeleteUpTo != nil {
+		mmDeleteUpTo.mock.t.Fatalf("LogRepositoryMock.DeleteUpTo mock is already set by Set")
+	}
+
+	if mmDeleteUpTo.defaultExpectation == nil {
+		mmDeleteUpTo.defaultExpectation = &LogRepositoryMockDeleteUpToExpectation{mock: mmDeleteUpTo.mock}
+	}
+	mmDeleteUpTo.defaultExpectation.results = &LogRepositoryMockDeleteUpToResults{i1, err}
+	mmDeleteUpTo.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUpTo.mock
+}
+
+// Set uses given function f to mock the LogRepository.DeleteUpTo method
+func (mmDeleteUpTo *mLogRepositoryMockDeleteUpTo) Set(f func(ctx context.Context, seq int64, limit uint64) (i1 int64, err error)) *LogRepositoryMock {
+	if mmDeleteUpTo.defaultExpectation != nil {
+		mmDeleteUpTo.mock.t.Fatalf("Default expectation is already set for the LogRepository.DeleteUpTo method")
+	}
+
+	if len(mmDeleteUpTo.expectations) > 0 {
+		mmDeleteUpTo.mock.t.Fatalf("Some expectations are already set for the LogRepository.DeleteUpTo method")
+	}
+
+	mmDeleteUpTo.mock.funcDeleteUpTo = f
+	mmDeleteUpTo.mock.funcDeleteUpToOrigin = minimock.CallerInfo(1)
+	return mmDeleteUpTo.mock
+}
+
+// When sets expectation for the LogRepository.DeleteUpTo which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUpTo *mLogRepositoryMockDeleteUpTo) When(ctx context.Context, seq int64, limit uint64) *LogRepositoryMockDeleteUpToExpectation {
+	if mmDeleteUpTo.mock.funcDeleteUpTo != nil {
+		mmDeleteUpTo.mock.t.Fatalf("LogRepositoryMock.DeleteUpTo mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockDeleteUpToExpectation{
+		mock:               mmDeleteUpTo.mock,
+		params:             &LogRepositoryMockDeleteUpToParams{ctx, seq, limit},
+		expectationOrigins: LogRepositoryMockDeleteUpToExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUpTo.expectations = append(mmDeleteUpTo.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.DeleteUpTo return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockDeleteUpToExpectation) Then(i1 int64, err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockDeleteUpToResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.DeleteUpTo should be invoked
+func (mmDeleteUpTo *mLogRepositoryMockDeleteUpTo) Times(n uint64) *mLogRepositoryMockDeleteUpTo {
+	if n == 0 {
+		mmDeleteUpTo.mock.t.Fatalf("Times of LogRepositoryMock.DeleteUpTo mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUpTo.expectedInvocations, n)
+	mmDeleteUpTo.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUpTo
+}
+
+func (mmDeleteUpTo *mLogRepositoryMockDeleteUpTo) invocationsDone() bool {
+	if len(mmDeleteUpTo.expectations) == 0 && mmDeleteUpTo.defaultExpectation == nil && mmDeleteUpTo.mock.funcDeleteUpTo == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUpTo.mock.afterDeleteUpToCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUpTo.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUpTo implements mm_repository.LogRepository
+func (mmDeleteUpTo *LogRepositoryMock) DeleteUpTo(ctx context.Context, seq int64, limit uint64) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmDeleteUpTo.beforeDeleteUpToCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUpTo.afterDeleteUpToCounter, 1)
+
+	mmDeleteUpTo.t.Helper()
+
+	if mmDeleteUpTo.inspectFuncDeleteUpTo != nil {
+		mmDeleteUpTo.inspectFuncDeleteUpTo(ctx, seq, limit)
+	}
+
+	mm_params := LogRepositoryMockDeleteUpToParams{ctx, seq, limit}
+
+	// Record call args
+	mmDeleteUpTo.DeleteUpToMock.mutex.Lock()
+	mmDeleteUpTo.DeleteUpToMock.callArgs = append(mmDeleteUpTo.DeleteUpToMock.callArgs, &mm_params)
+	mmDeleteUpTo.DeleteUpToMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUpTo.DeleteUpToMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeleteUpTo.DeleteUpToMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUpTo.DeleteUpToMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUpTo.DeleteUpToMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUpTo.DeleteUpToMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockDeleteUpToParams{ctx, seq, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUpTo.t.Errorf("LogRepositoryMock.DeleteUpTo got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUpTo.DeleteUpToMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.seq != nil && !minimock.Equal(*mm_want_ptrs.seq, mm_got.seq) {
+				mmDeleteUpTo.t.Errorf("LogRepositoryMock.DeleteUpTo got unexpected parameter seq, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUpTo.DeleteUpToMock.defaultExpectation.expectationOrigins.originSeq, *mm_want_ptrs.seq, mm_got.seq, minimock.Diff(*mm_want_ptrs.seq, mm_got.seq))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmDeleteUpTo.t.Errorf("LogRepositoryMock.DeleteUpTo got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUpTo.DeleteUpToMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUpTo.t.Errorf("LogRepositoryMock.DeleteUpTo got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUpTo.DeleteUpToMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUpTo.DeleteUpToMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUpTo.t.Fatal("No results are set for the LogRepositoryMock.DeleteUpTo")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeleteUpTo.funcDeleteUpTo != nil {
+		return mmDeleteUpTo.funcDeleteUpTo(ctx, seq, limit)
+	}
+	mmDeleteUpTo.t.Fatalf("Unexpected call to LogRepositoryMock.DeleteUpTo. %v %v %v", ctx, seq, limit)
+	return
+}
+
+// DeleteUpToAfterCounter returns a count of finished LogRepositoryMock.DeleteUpTo invocations
+func (mmDeleteUpTo *LogRepositoryMock) DeleteUpToAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUpTo.afterDeleteUpToCounter)
+}
+
+// DeleteUpToBeforeCounter returns a count of LogRepositoryMock.DeleteUpTo invocations
+func (mmDeleteUpTo *LogRepositoryMock) DeleteUpToBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUpTo.beforeDeleteUpToCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.DeleteUpTo.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUpTo *mLogRepositoryMockDeleteUpTo) Calls() []*LogRepositoryMockDeleteUpToParams {
+	mmDeleteUpTo.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockDeleteUpToParams, len(mmDeleteUpTo.callArgs))
+	copy(argCopy, mmDeleteUpTo.callArgs)
+
+	mmDeleteUpTo.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUpToDone returns true if the count of the DeleteUpTo invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockDeleteUpToDone() bool {
+	if m.DeleteUpToMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUpToMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUpToMock.invocationsDone()
+}
+
+// MinimockDeleteUpToInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockDeleteUpToInspect() {
+	for _, e := range m.DeleteUpToMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.DeleteUpTo at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUpToCounter := mm_atomic.LoadUint64(&m.afterDeleteUpToCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUpToMock.defaultExpectation != nil && afterDeleteUpToCounter < 1 {
+		if m.DeleteUpToMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LogRepositoryMock.DeleteUpTo at\n%s", m.DeleteUpToMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.DeleteUpTo at\n%s with params: %#v", m.DeleteUpToMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUpToMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUpTo != nil && afterDeleteUpToCounter < 1 {
+		m.t.Errorf("Expected call to LogRepositoryMock.DeleteUpTo at\n%s", m.funcDeleteUpToOrigin)
+	}
+
+	if !m.DeleteUpToMock.invocationsDone() && afterDeleteUpToCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.DeleteUpTo at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUpToMock.expectedInvocations), m.DeleteUpToMock.expectedInvocationsOrigin, afterDeleteUpToCounter)
+	}
+}
+
+type mLogRepositoryMockLastBefore struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockLastBeforeExpectation
+	expectations       []*LogRepositoryMockLastBeforeExpectation
+
+	callArgs []*LogRepositoryMockLastBeforeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LogRepositoryMockLastBeforeExpectation specifies expectation struct of the LogRepository.LastBefore
+type LogRepositoryMockLastBeforeExpectation struct {
+	mock               *LogRepositoryMock
+	params             *LogRepositoryMockLastBeforeParams
+	paramPtrs          *LogRepositoryMockLastBeforeParamPtrs
+	expectationOrigins LogRepositoryMockLastBeforeExpectationOrigins
+	results            *LogRepositoryMockLastBeforeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LogRepositoryMockLastBeforeParams contains parameters of the LogRepository.LastBefore
+type LogRepositoryMockLastBeforeParams struct {
+	ctx    context.Context
+	maxAge time.Duration
+}
+
+// LogRepositoryMockLastBeforeParamPtrs contains pointers to parameters of the LogRepository.LastBefore
+type LogRepositoryMockLastBeforeParamPtrs struct {
+	ctx    *context.Context
+	maxAge *time.Duration
+}
+
+// LogRepositoryMockLastBeforeResults contains results of the LogRepository.LastBefore
+type LogRepositoryMockLastBeforeResults struct {
+	lp1 *model.LogEntry
+	err error
+}
+
+// LogRepositoryMockLastBeforeOrigins contains origins of expectations of the LogRepository.LastBefore
+type LogRepositoryMockLastBeforeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originMaxAge string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLastBefore *mLogRepositoryMockLastBefore) Optional() *mLogRepositoryMockLastBefore {
+	mmLastBefore.optional = true
+	return mmLastBefore
+}
+
+// Expect sets up expected params for LogRepository.LastBefore
+func (mmLastBefore *mLogRepositoryMockLastBefore) Expect(ctx context.Context, maxAge time.Duration) *mLogRepositoryMockLastBefore {
+	if mmLastBefore.mock.funcLastBefore != nil {
+		mmLastBefore.mock.t.Fatalf("LogRepositoryMock.LastBefore mock is already set by Set")
+	}
+
+	if mmLastBefore.defaultExpectation == nil {
+		mmLastBefore.defaultExpectation = &LogRepositoryMockLastBeforeExpectation{}
+	}
+
+	if mmLastBefore.defaultExpectation.paramPtrs != nil {
+		mmLastBefore.mock.t.Fatalf("LogRepositoryMock.LastBefore mock is already set by ExpectParams functions")
+	}
+
+	mmLastBefore.defaultExpectation.params = &LogRepositoryMockLastBeforeParams{ctx, maxAge}
+	mmLastBefore.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLastBefore.expectations {
+		if minimock.Equal(e.params, mmLastBefore.defaultExpectation.params) {
+			mmLastBefore.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLastBefore.defaultExpectation.params)
+		}
+	}
+
+	return mmLastBefore
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.LastBefore
+func (mmLastBefore *mLogRepositoryMockLastBefore) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockLastBefore {
+	if mmLastBefore.mock.funcLastBefore != nil {
+		mmLastBefore.mock.t.Fatalf("LogRepositoryMock.LastBefore mock is already set by Set")
+	}
+
+	if mmLastBefore.defaultExpectation == nil {
+		mmLastBefore.defaultExpectation = &LogRepositoryMockLastBeforeExpectation{}
+	}
+
+	if mmLastBefore.defaultExpectation.params != nil {
+		mmLastBefore.mock.t.Fatalf("LogRepositoryMock.LastBefore mock is already set by Expect")
+	}
+
+	if mmLastBefore.defaultExpectation.paramPtrs == nil {
+		mmLastBefore.defaultExpectation.paramPtrs = &LogRepositoryMockLastBeforeParamPtrs{}
+	}
+	mmLastBefore.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLastBefore.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLastBefore
+}
+
+// ExpectMaxAgeParam2 sets up expected param maxAge for LogRepository.LastBefore
+func (mmLastBefore *mLogRepositoryMockLastBefore) ExpectMaxAgeParam2(maxAge time.Duration) *mLogRepositoryMockLastBefore {
+	if mmLastBefore.mock.funcLastBefore != nil {
+		mmLastBefore.mock.t.Fatalf("LogRepositoryMock.LastBefore mock is already set by Set")
+	}
+
+	if mmLastBefore.defaultExpectation == nil {
+		mmLastBefore.defaultExpectation = &LogRepositoryMockLastBeforeExpectation{}
+	}
+
+	if mmLastBefore.defaultExpectation.params != nil {
+		mmLastBefore.mock.t.Fatalf("LogRepositoryMock.LastBefore mock is already set by Expect")
+	}
+
+	if mmLastBefore.defaultExpectation.paramPtrs == nil {
+		mmLastBefore.defaultExpectation.paramPtrs = &LogRepositoryMockLastBeforeParamPtrs{}
+	}
+	mmLastBefore.defaultExpectation.paramPtrs.maxAge = &maxAge
+	mmLastBefore.defaultExpectation.expectationOrigins.originMaxAge = minimock.CallerInfo(1)
+
+	return mmLastBefore
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.LastBefore
+func (mmLastBefore *mLogRepositoryMockLastBefore) Inspect(f func(ctx context.Context, maxAge time.Duration)) *mLogRepositoryMockLastBefore {
+	if mmLastBefore.mock.inspectFuncLastBefore != nil {
+		mmLastBefore.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.LastBefore")
+	}
+
+	mmLastBefore.mock.inspectFuncLastBefore = f
+
+	return mmLastBefore
+}
+
+// Return sets up results that will be returned by LogRepository.LastBefore
+func (mmLastBefore *mLogRepositoryMockLastBefore) Return(lp1 *model.LogEntry, err error) *LogRepositoryMock {
+	if mmLastBefore.mock.funcLastBefore != nil {
+		mmLastBefore.mock.t.Fatalf("LogRepositoryMock.LastBefore mock is already set by Set")
+	}
+
+	if mmLastBefore.defaultExpectation == nil {
+		mmLastBefore.defaultExpectation = &LogRepositoryMockLastBeforeExpectation{mock: mmLastBefore.mock}
+	}
+	mmLastBefore.defaultExpectation.results = &LogRepositoryMockLastBeforeResults{lp1, err}
+	mmLastBefore.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLastBefore.mock
+}
+
+// Set uses given function f to mock the LogRepository.LastBefore method
+func (mmLastBefore *mLogRepositoryMockLastBefore) Set(f func(ctx context.Context, maxAge time.Duration) (lp1 *model.LogEntry, err error)) *LogRepositoryMock {
+	if mmLastBefore.defaultExpectation != nil {
+		mmLastBefore.mock.t.Fatalf("Default expectation is already set for the LogRepository.LastBefore method")
+	}
+
+	if len(mmLastBefore.expectations) > 0 {
+		mmLastBefore.mock.t.Fatalf("Some expectations are already set for the LogRepository.LastBefore method")
+	}
+
+	mmLastBefore.mock.funcLastBefore = f
+	mmLastBefore.mock.funcLastBeforeOrigin = minimock.CallerInfo(1)
+	return mmLastBefore.mock
+}
+
+// When sets expectation for the LogRepository.LastBefore which will trigger the result defined by the following
+// Then helper
+func (mmLastBefore *mLogRepositoryMockLastBefore) When(ctx context.Context, maxAge time.Duration) *LogRepositoryMockLastBeforeExpectation {
+	if mmLastBefore.mock.funcLastBefore != nil {
+		mmLastBefore.mock.t.Fatalf("LogRepositoryMock.LastBefore mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockLastBeforeExpectation{
+		mock:               mmLastBefore.mock,
+		params:             &LogRepositoryMockLastBeforeParams{ctx, maxAge},
+		expectationOrigins: LogRepositoryMockLastBeforeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLastBefore.expectations = append(mmLastBefore.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.LastBefore return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockLastBeforeExpectation) Then(lp1 *model.LogEntry, err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockLastBeforeResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.LastBefore should be invoked
+func (mmLastBefore *mLogRepositoryMockLastBefore) Times(n uint64) *mLogRepositoryMockLastBefore {
+	if n == 0 {
+		mmLastBefore.mock.t.Fatalf("Times of LogRepositoryMock.LastBefore mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLastBefore.expectedInvocations, n)
+	mmLastBefore.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLastBefore
+}
+
+func (mmLastBefore *mLogRepositoryMockLastBefore) invocationsDone() bool {
+	if len(mmLastBefore.expectations) == 0 && mmLastBefore.defaultExpectation == nil && mmLastBefore.mock.funcLastBefore == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLastBefore.mock.afterLastBeforeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLastBefore.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LastBefore implements mm_repository.LogRepository
+func (mmLastBefore *LogRepositoryMock) LastBefore(ctx context.Context, maxAge time.Duration) (lp1 *model.LogEntry, err error) {
+	mm_atomic.AddUint64(&mmLastBefore.beforeLastBeforeCounter, 1)
+	defer mm_atomic.AddUint64(&mmLastBefore.afterLastBeforeCounter, 1)
+
+	mmLastBefore.t.Helper()
+
+	if mmLastBefore.inspectFuncLastBefore != nil {
+		mmLastBefore.inspectFuncLastBefore(ctx, maxAge)
+	}
+
+	mm_params := LogRepositoryMockLastBeforeParams{ctx, maxAge}
+
+	// Record call args
+	mmLastBefore.LastBeforeMock.mutex.Lock()
+	mmLastBefore.LastBeforeMock.callArgs = append(mmLastBefore.LastBeforeMock.callArgs, &mm_params)
+	mmLastBefore.LastBeforeMock.mutex.Unlock()
+
+	for _, e := range mmLastBefore.LastBeforeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmLastBefore.LastBeforeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLastBefore.LastBeforeMock.defaultExpectation.Counter, 1)
+		mm_want := mmLastBefore.LastBeforeMock.defaultExpectation.params
+		mm_want_ptrs := mmLastBefore.LastBeforeMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockLastBeforeParams{ctx, maxAge}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLastBefore.t.Errorf("LogRepositoryMock.LastBefore got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLastBefore.LastBeforeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.maxAge != nil && !minimock.Equal(*mm_want_ptrs.maxAge, mm_got.maxAge) {
+				mmLastBefore.t.Errorf("LogRepositoryMock.LastBefore got unexpected parameter maxAge, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLastBefore.LastBeforeMock.defaultExpectation.expectationOrigins.originMaxAge, *mm_want_ptrs.maxAge, mm_got.maxAge, minimock.Diff(*mm_want_ptrs.maxAge, mm_got.maxAge))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLastBefore.t.Errorf("LogRepositoryMock.LastBefore got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLastBefore.LastBeforeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLastBefore.LastBeforeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLastBefore.t.Fatal("No results are set for the LogRepositoryMock.LastBefore")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmLastBefore.funcLastBefore != nil {
+		return mmLastBefore.funcLastBefore(ctx, maxAge)
+	}
+	mmLastBefore.t.Fatalf("Unexpected call to LogRepositoryMock.LastBefore. %v %v", ctx, maxAge)
+	return
+}
+
+// LastBeforeAfterCounter returns a count of finished LogRepositoryMock.LastBefore invocations
+func (mmLastBefore *LogRepositoryMock) LastBeforeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastBefore.afterLastBeforeCounter)
+}
+
+// LastBeforeBeforeCounter returns a count of LogRepositoryMock.LastBefore invocations
+func (mmLastBefore *LogRepositoryMock) LastBeforeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastBefore.beforeLastBeforeCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.LastBefore.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLastBefore *mLogRepositoryMockLastBefore) Calls() []*LogRepositoryMockLastBeforeParams {
+	mmLastBefore.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockLastBeforeParams, len(mmLastBefore.callArgs))
+	copy(argCopy, mmLastBefore.callArgs)
+
+	mmLastBefore.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLastBeforeDone returns true if the count of the LastBefore invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockLastBeforeDone() bool {
+	if m.LastBeforeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LastBeforeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LastBeforeMock.invocationsDone()
+}
+
+// MinimockLastBeforeInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockLastBeforeInspect() {
+	for _, e := range m.LastBeforeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.LastBefore at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLastBeforeCounter := mm_atomic.LoadUint64(&m.afterLastBeforeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LastBeforeMock.defaultExpectation != nil && afterLastBeforeCounter < 1 {
+		if m.LastBeforeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LogRepositoryMock.LastBefore at\n%s", m.LastBeforeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.LastBefore at\n%s with params: %#v", m.LastBeforeMock.defaultExpectation.expectationOrigins.origin, *m.LastBeforeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLastBefore != nil && afterLastBeforeCounter < 1 {
+		m.t.Errorf("Expected call to LogRepositoryMock.LastBefore at\n%s", m.funcLastBeforeOrigin)
+	}
+
+	if !m.LastBeforeMock.invocationsDone() && afterLastBeforeCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.LastBefore at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LastBeforeMock.expectedInvocations), m.LastBeforeMock.expectedInvocationsOrigin, afterLastBeforeCounter)
+	}
+}
+
+type mLogRepositoryMockLastChained struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockLastChainedExpectation
+	expectations       []*LogRepositoryMockLastChainedExpectation
+
+	callArgs []*LogRepositoryMockLastChainedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LogRepositoryMockLastChainedExpectation specifies expectation struct of the LogRepository.LastChained
+type LogRepositoryMockLastChainedExpectation struct {
+	mock               *LogRepositoryMock
+	params             *LogRepositoryMockLastChainedParams
+	paramPtrs          *LogRepositoryMockLastChainedParamPtrs
+	expectationOrigins LogRepositoryMockLastChainedExpectationOrigins
+	results            *LogRepositoryMockLastChainedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LogRepositoryMockLastChainedParams contains parameters of the LogRepository.LastChained
+type LogRepositoryMockLastChainedParams struct {
+	ctx context.Context
+}
+
+// LogRepositoryMockLastChainedParamPtrs contains pointers to parameters of the LogRepository.LastChained
+type LogRepositoryMockLastChainedParamPtrs struct {
+	ctx *context.Context
+}
+
+// LogRepositoryMockLastChainedResults contains results of the LogRepository.LastChained
+type LogRepositoryMockLastChainedResults struct {
+	lp1 *model.LogEntry
+	err error
+}
+
+// LogRepositoryMockLastChainedOrigins contains origins of expectations of the LogRepository.LastChained
+type LogRepositoryMockLastChainedExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLastChained *mLogRepositoryMockLastChained) Optional() *mLogRepositoryMockLastChained {
+	mmLastChained.optional = true
+	return mmLastChained
+}
+
+// Expect sets up expected params for LogRepository.LastChained
+func (mmLastChained *mLogRepositoryMockLastChained) Expect(ctx context.Context) *mLogRepositoryMockLastChained {
+	if mmLastChained.mock.funcLastChained != nil {
+		mmLastChained.mock.t.Fatalf("LogRepositoryMock.LastChained mock is already set by Set")
+	}
+
+	if mmLastChained.defaultExpectation == nil {
+		mmLastChained.defaultExpectation = &LogRepositoryMockLastChainedExpectation{}
+	}
+
+	if mmLastChained.defaultExpectation.paramPtrs != nil {
+		mmLastChained.mock.t.Fatalf("LogRepositoryMock.LastChained mock is already set by ExpectParams functions")
+	}
+
+	mmLastChained.defaultExpectation.params = &LogRepositoryMockLastChainedParams{ctx}
+	mmLastChained.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLastChained.expectations {
+		if minimock.Equal(e.params, mmLastChained.defaultExpectation.params) {
+			mmLastChained.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLastChained.defaultExpectation.params)
+		}
+	}
+
+	return mmLastChained
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.LastChained
+func (mmLastChained *mLogRepositoryMockLastChained) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockLastChained {
+	if mmLastChained.mock.funcLastChained != nil {
+		mmLastChained.mock.t.Fatalf("LogRepositoryMock.LastChained mock is already set by Set")
+	}
+
+	if mmLastChained.defaultExpectation == nil {
+		mmLastChained.defaultExpectation = &LogRepositoryMockLastChainedExpectation{}
+	}
+
+	if mmLastChained.defaultExpectation.params != nil {
+		mmLastChained.mock.t.Fatalf("LogRepositoryMock.LastChained mock is already set by Expect")
+	}
+
+	if mmLastChained.defaultExpectation.paramPtrs == nil {
+		mmLastChained.defaultExpectation.paramPtrs = &LogRepositoryMockLastChainedParamPtrs{}
+	}
+	mmLastChained.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLastChained.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLastChained
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.LastChained
+func (mmLastChained *mLogRepositoryMockLastChained) Inspect(f func(ctx context.Context)) *mLogRepositoryMockLastChained {
+	if mmLastChained.mock.inspectFuncLastChained != nil {
+		mmLastChained.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.LastChained")
+	}
+
+	mmLastChained.mock.inspectFuncLastChained = f
+
+	return mmLastChained
+}
+
+// Return sets up results that will be returned by LogRepository.LastChained
+func (mmLastChained *mLogRepositoryMockLastChained) Return(lp1 *model.LogEntry, err error) *LogRepositoryMock {
+	if mmLastChained.mock.funcLastChained != nil {
+		mmLastChained.mock.t.Fatalf("LogRepositoryMock.LastChained mock is already set by Set")
+	}
+
+	if mmLastChained.defaultExpectation == nil {
+		mmLastChained.defaultExpectation = &LogRepositoryMockLastChainedExpectation{mock: mmLastChained.mock}
+	}
+	mmLastChained.defaultExpectation.results = &LogRepositoryMockLastChainedResults{lp1, err}
+	mmLastChained.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLastChained.mock
+}
+
+// Set uses given function f to mock the LogRepository.LastChained method
+func (mmLastChained *mLogRepositoryMockLastChained) Set(f func(ctx context.Context) (lp1 *model.LogEntry, err error)) *LogRepositoryMock {
+	if mmLastChained.defaultExpectation != nil {
+		mmLastChained.mock.t.Fatalf("Default expectation is already set for the LogRepository.LastChained method")
+	}
+
+	if len(mmLastChained.expectations) > 0 {
+		mmLastChained.mock.t.Fatalf("Some expectations are already set for the LogRepository.LastChained method")
+	}
+
+	mmLastChained.mock.funcLastChained = f
+	mmLastChained.mock.funcLastChainedOrigin = minimock.CallerInfo(1)
+	return mmLastChained.mock
+}
+
+// When sets expectation for the LogRepository.LastChained which will trigger the result defined by the following
+// Then helper
+func (mmLastChained *mLogRepositoryMockLastChained) When(ctx context.Context) *LogRepositoryMockLastChainedExpectation {
+	if mmLastChained.mock.funcLastChained != nil {
+		mmLastChained.mock.t.Fatalf("LogRepositoryMock.LastChained mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockLastChainedExpectation{
+		mock:               mmLastChained.mock,
+		params:             &LogRepositoryMockLastChainedParams{ctx},
+		expectationOrigins: LogRepositoryMockLastChainedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLastChained.expectations = append(mmLastChained.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.LastChained return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockLastChainedExpectation) Then(lp1 *model.LogEntry, err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockLastChainedResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.LastChained should be invoked
+func (mmLastChained *mLogRepositoryMockLastChained) Times(n uint64) *mLogRepositoryMockLastChained {
+	if n == 0 {
+		mmLastChained.mock.t.Fatalf("Times of LogRepositoryMock.LastChained mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLastChained.expectedInvocations, n)
+	mmLastChained.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLastChained
+}
+
+func (mmLastChained *mLogRepositoryMockLastChained) invocationsDone() bool {
+	if len(mmLastChained.expectations) == 0 && mmLastChained.defaultExpectation == nil && mmLastChained.mock.funcLastChained == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLastChained.mock.afterLastChainedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLastChained.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LastChained implements mm_repository.LogRepository
+func (mmLastChained *LogRepositoryMock) LastChained(ctx context.Context) (lp1 *model.LogEntry, err error) {
+	mm_atomic.AddUint64(&mmLastChained.beforeLastChainedCounter, 1)
+	defer mm_atomic.AddUint64(&mmLastChained.afterLastChainedCounter, 1)
+
+	mmLastChained.t.Helper()
+
+	if mmLastChained.inspectFuncLastChained != nil {
+		mmLastChained.inspectFuncLastChained(ctx)
+	}
+
+	mm_params := LogRepositoryMockLastChainedParams{ctx}
+
+	// Record call args
+	mmLastChained.LastChainedMock.mutex.Lock()
+	mmLastChained.LastChainedMock.callArgs = append(mmLastChained.LastChainedMock.callArgs, &mm_params)
+	mmLastChained.LastChainedMock.mutex.Unlock()
+
+	for _, e := range mmLastChained.LastChainedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmLastChained.LastChainedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLastChained.LastChainedMock.defaultExpectation.Counter, 1)
+		mm_want := mmLastChained.LastChainedMock.defaultExpectation.params
+		mm_want_ptrs := mmLastChained.LastChainedMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockLastChainedParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLastChained.t.Errorf("LogRepositoryMock.LastChained got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLastChained.LastChainedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLastChained.t.Errorf("LogRepositoryMock.LastChained got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLastChained.LastChainedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLastChained.LastChainedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLastChained.t.Fatal("No results are set for the LogRepositoryMock.LastChained")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmLastChained.funcLastChained != nil {
+		return mmLastChained.funcLastChained(ctx)
+	}
+	mmLastChained.t.Fatalf("Unexpected call to LogRepositoryMock.LastChained. %v", ctx)
+	return
+}
+
+// LastChainedAfterCounter returns a count of finished LogRepositoryMock.LastChained invocations
+func (mmLastChained *LogRepositoryMock) LastChainedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastChained.afterLastChainedCounter)
+}
+
+// LastChainedBeforeCounter returns a count of LogRepositoryMock.LastChained invocations
+func (mmLastChained *LogRepositoryMock) LastChainedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastChained.beforeLastChainedCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.LastChained.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLastChained *mLogRepositoryMockLastChained) Calls() []*LogRepositoryMockLastChainedParams {
+	mmLastChained.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockLastChainedParams, len(mmLastChained.callArgs))
+	copy(argCopy, mmLastChained.callArgs)
+
+	mmLastChained.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLastChainedDone returns true if the count of the LastChained invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockLastChainedDone() bool {
+	if m.LastChainedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LastChainedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LastChainedMock.invocationsDone()
+}
+
+// MinimockLastChainedInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockLastChainedInspect() {
+	for _, e := range m.LastChainedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.LastChained at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLastChainedCounter := mm_atomic.LoadUint64(&m.afterLastChainedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LastChainedMock.defaultExpectation != nil && afterLastChainedCounter < 1 {
+		if m.LastChainedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LogRepositoryMock.LastChained at\n%s", m.LastChainedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.LastChained at\n%s with params: %#v", m.LastChainedMock.defaultExpectation.expectationOrigins.origin, *m.LastChainedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLastChained != nil && afterLastChainedCounter < 1 {
+		m.t.Errorf("Expected call to LogRepositoryMock.LastChained at\n%s", m.funcLastChainedOrigin)
+	}
+
+	if !m.LastChainedMock.invocationsDone() && afterLastChainedCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.LastChained at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LastChainedMock.expectedInvocations), m.LastChainedMock.expectedInvocationsOrigin, afterLastChainedCounter)
+	}
+}
+
+type mLogRepositoryMockLink struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockLinkExpectation
+	expectations       []*LogRepositoryMockLinkExpectation
+
+	callArgs []*LogRepositoryMockLinkParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LogRepositoryMockLinkExpectation specifies expectation struct of the LogRepository.Link
+type LogRepositoryMockLinkExpectation struct {
+	mock               *LogRepositoryMock
+	params             *LogRepositoryMockLinkParams
+	paramPtrs          *LogRepositoryMockLinkParamPtrs
+	expectationOrigins LogRepositoryMockLinkExpectationOrigins
+	results            *LogRepositoryMockLinkResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LogRepositoryMockLinkParams contains parameters of the LogRepository.Link
+type LogRepositoryMockLinkParams struct {
+	ctx      context.Context
+	logEntry *model.LogEntry
+}
+
+// LogRepositoryMockLinkParamPtrs contains pointers to parameters of the LogRepository.Link
+type LogRepositoryMockLinkParamPtrs struct {
+	ctx      *context.Context
+	logEntry **model.LogEntry
+}
+
+// LogRepositoryMockLinkResults contains results of the LogRepository.Link
+type LogRepositoryMockLinkResults struct {
+	err error
+}
+
+// LogRepositoryMockLinkOrigins contains origins of expectations of the LogRepository.Link
+type LogRepositoryMockLinkExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originLogEntry string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLink *mLogRepositoryMockLink) Optional() *mLogRepositoryMockLink {
+	mmLink.optional = true
+	return mmLink
+}
+
+// Expect sets up expected params for LogRepository.Link
+func (mmLink *mLogRepositoryMockLink) Expect(ctx context.Context, logEntry *model.LogEntry) *mLogRepositoryMockLink {
+	if mmLink.mock.funcLink != nil {
+		mmLink.mock.t.Fatalf("LogRepositoryMock.Link mock is already set by Set")
+	}
+
+	if mmLink.defaultExpectation == nil {
+		mmLink.defaultExpectation = &LogRepositoryMockLinkExpectation{}
+	}
+
+	if mmLink.defaultExpectation.paramPtrs != nil {
+		mmLink.mock.t.Fatalf("LogRepositoryMock.Link mock is already set by ExpectParams functions")
+	}
+
+	mmLink.defaultExpectation.params = &LogRepositoryMockLinkParams{ctx, logEntry}
+	mmLink.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLink.expectations {
+		if minimock.Equal(e.params, mmLink.defaultExpectation.params) {
+			mmLink.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLink.defaultExpectation.params)
+		}
+	}
+
+	return mmLink
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.Link
+func (mmLink *mLogRepositoryMockLink) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockLink {
+	if mmLink.mock.funcLink != nil {
+		mmLink.mock.t.Fatalf("LogRepositoryMock.Link mock is already set by Set")
+	}
+
+	if mmLink.defaultExpectation == nil {
+		mmLink.defaultExpectation = &LogRepositoryMockLinkExpectation{}
+	}
+
+	if mmLink.defaultExpectation.params != nil {
+		mmLink.mock.t.Fatalf("LogRepositoryMock.Link mock is already set by Expect")
+	}
+
+	if mmLink.defaultExpectation.paramPtrs == nil {
+		mmLink.defaultExpectation.paramPtrs = &LogRepositoryMockLinkParamPtrs{}
+	}
+	mmLink.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLink.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLink
+}
+
+// ExpectLogEntryParam2 sets up expected param logEntry for LogRepository.Link
+func (mmLink *mLogRepositoryMockLink) ExpectLogEntryParam2(logEntry *model.LogEntry) *mLogRepositoryMockLink {
+	if mmLink.mock.funcLink != nil {
+		mmLink.mock.t.Fatalf("LogRepositoryMock.Link mock is already set by Set")
+	}
+
+	if mmLink.defaultExpectation == nil {
+		mmLink.defaultExpectation = &LogRepositoryMockLinkExpectation{}
+	}
+
+	if mmLink.defaultExpectation.params != nil {
+		mmLink.mock.t.Fatalf("LogRepositoryMock.Link mock is already set by Expect")
+	}
+
+	if mmLink.defaultExpectation.paramPtrs == nil {
+		mmLink.defaultExpectation.paramPtrs = &LogRepositoryMockLinkParamPtrs{}
+	}
+	mmLink.defaultExpectation.paramPtrs.logEntry = &logEntry
+	mmLink.defaultExpectation.expectationOrigins.originLogEntry = minimock.CallerInfo(1)
+
+	return mmLink
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.Link
+func (mmLink *mLogRepositoryMockLink) Inspect(f func(ctx context.Context, logEntry *model.LogEntry)) *mLogRepositoryMockLink {
+	if mmLink.mock.inspectFuncLink != nil {
+		mmLink.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.Link")
+	}
+
+	mmLink.mock.inspectFuncLink = f
+
+	return mmLink
+}
+
+// Return sets up results that will be returned by LogRepository.Link
+func (mmLink *mLogRepositoryMockLink) Return(err error) *LogRepositoryMock {
+	if mmLink.mock.funcLink != nil {
+		mmLink.mock.t.Fatalf("LogRepositoryMock.Link mock is already set by Set")
+	}
+
+	if mmLink.defaultExpectation == nil {
+		mmLink.defaultExpectation = &LogRepositoryMockLinkExpectation{mock: mmLink.mock}
+	}
+	mmLink.defaultExpectation.results = &LogRepositoryMockLinkResults{err}
+	mmLink.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLink.mock
+}
+
+// Set uses given function f to mock the LogRepository.Link method
+func (mmLink *mLogRepositoryMockLink) Set(f func(ctx context.Context, logEntry *model.LogEntry) (err error)) *LogRepositoryMock {
+	if mmLink.defaultExpectation != nil {
+		mmLink.mock.t.Fatalf("Default expectation is already set for the LogRepository.Link method")
+	}
+
+	if len(mmLink.expectations) > 0 {
+		mmLink.mock.t.Fatalf("Some expectations are already set for the LogRepository.Link method")
+	}
+
+	mmLink.mock.funcLink = f
+	mmLink.mock.funcLinkOrigin = minimock.CallerInfo(1)
+	return mmLink.mock
+}
+
+// When sets expectation for the LogRepository.Link which will trigger the result defined by the following
+// Then helper
+func (mmLink *mLogRepositoryMockLink) When(ctx context.Context, logEntry *model.LogEntry) *LogRepositoryMockLinkExpectation {
+	if mmLink.mock.funcLink != nil {
+		mmLink.mock.t.Fatalf("LogRepositoryMock.Link mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockLinkExpectation{
+		mock:               mmLink.mock,
+		params:             &LogRepositoryMockLinkParams{ctx, logEntry},
+		expectationOrigins: LogRepositoryMockLinkExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLink.expectations = append(mmLink.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.Link return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockLinkExpectation) Then(err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockLinkResults{err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.Link should be invoked
+func (mmLink *mLogRepositoryMockLink) Times(n uint64) *mLogRepositoryMockLink {
+	if n == 0 {
+		mmLink.mock.t.Fatalf("Times of LogRepositoryMock.Link mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLink.expectedInvocations, n)
+	mmLink.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLink
+}
+
+func (mmLink *mLogRepositoryMockLink) invocationsDone() bool {
+	if len(mmLink.expectations) == 0 && mmLink.defaultExpectation == nil && mmLink.mock.funcLink == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLink.mock.afterLinkCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLink.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Link implements mm_repository.LogRepository
+func (mmLink *LogRepositoryMock) Link(ctx context.Context, logEntry *model.LogEntry) (err error) {
+	mm_atomic.AddUint64(&mmLink.beforeLinkCounter, 1)
+	defer mm_atomic.AddUint64(&mmLink.afterLinkCounter, 1)
+
+	mmLink.t.Helper()
+
+	if mmLink.inspectFuncLink != nil {
+		mmLink.inspectFuncLink(ctx, logEntry)
+	}
+
+	mm_params := LogRepositoryMockLinkParams{ctx, logEntry}
+
+	// Record call args
+	mmLink.LinkMock.mutex.Lock()
+	mmLink.LinkMock.callArgs = append(mmLink.LinkMock.callArgs, &mm_params)
+	mmLink.LinkMock.mutex.Unlock()
+
+	for _, e := range mmLink.LinkMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLink.LinkMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLink.LinkMock.defaultExpectation.Counter, 1)
+		mm_want := mmLink.LinkMock.defaultExpectation.params
+		mm_want_ptrs := mmLink.LinkMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockLinkParams{ctx, logEntry}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLink.t.Errorf("LogRepositoryMock.Link got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLink.LinkMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.logEntry != nil && !minimock.Equal(*mm_want_ptrs.logEntry, mm_got.logEntry) {
+				mmLink.t.Errorf("LogRepositoryMock.Link got unexpected parameter logEntry, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLink.LinkMock.defaultExpectation.expectationOrigins.originLogEntry, *mm_want_ptrs.logEntry, mm_got.logEntry, minimock.Diff(*mm_want_ptrs.logEntry, mm_got.logEntry))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLink.t.Errorf("LogRepositoryMock.Link got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLink.LinkMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLink.LinkMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLink.t.Fatal("No results are set for the LogRepositoryMock.Link")
+		}
+		return (*mm_results).err
+	}
+	if mmLink.funcLink != nil {
+		return mmLink.funcLink(ctx, logEntry)
+	}
+	mmLink.t.Fatalf("Unexpected call to LogRepositoryMock.Link. %v %v", ctx, logEntry)
+	return
+}
+
+// LinkAfterCounter returns a count of finished LogRepositoryMock.Link invocations
+func (mmLink *LogRepositoryMock) LinkAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLink.afterLinkCounter)
+}
+
+// LinkBeforeCounter returns a count of LogRepositoryMock.Link invocations
+func (mmLink *LogRepositoryMock) LinkBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLink.beforeLinkCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.Link.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLink *mLogRepositoryMockLink) Calls() []*LogRepositoryMockLinkParams {
+	mmLink.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockLinkParams, len(mmLink.callArgs))
+	copy(argCopy, mmLink.callArgs)
+
+	mmLink.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLinkDone returns true if the count of the Link invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockLinkDone() bool {
+	if m.LinkMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LinkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LinkMock.invocationsDone()
+}
+
+// MinimockLinkInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockLinkInspect() {
+	for _, e := range m.LinkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.Link at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLinkCounter := mm_atomic.LoadUint64(&m.afterLinkCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LinkMock.defaultExpectation != nil && afterLinkCounter < 1 {
+		if m.LinkMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LogRepositoryMock.Link at\n%s", m.LinkMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.Link at\n%s with params: %#v", m.LinkMock.defaultExpectation.expectationOrigins.origin, *m.LinkMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLink != nil && afterLinkCounter < 1 {
+		m.t.Errorf("Expected call to LogRepositoryMock.Link at\n%s", m.funcLinkOrigin)
+	}
+
+	if !m.LinkMock.invocationsDone() && afterLinkCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.Link at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LinkMock.expectedInvocations), m.LinkMock.expectedInvocationsOrigin, afterLinkCounter)
+	}
+}
+
+type mLogRepositoryMockList struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockListExpectation
+	expectations       []*LogRepositoryMockListExpectation
+
+	callArgs []*LogRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LogRepositoryMockListExpectation specifies expectation struct of the LogRepository.List
+type LogRepositoryMockListExpectation struct {
+	mock               *LogRepositoryMock
+	params             *LogRepositoryMockListParams
+	paramPtrs          *LogRepositoryMockListParamPtrs
+	expectationOrigins LogRepositoryMockListExpectationOrigins
+	results            *LogRepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LogRepositoryMockListParams contains parameters of the LogRepository.List
+type LogRepositoryMockListParams struct {
+	ctx    context.Context
+	filter *model.LogFilter
+}
+
+// LogRepositoryMockListParamPtrs contains pointers to parameters of the LogRepository.List
+type LogRepositoryMockListParamPtrs struct {
+	ctx    *context.Context
+	filter **model.LogFilter
+}
+
+// LogRepositoryMockListResults contains results of the LogRepository.List
+type LogRepositoryMockListResults struct {
+	lpa1 []*model.LogEntry
+	err  error
+}
+
+// LogRepositoryMockListOrigins contains origins of expectations of the LogRepository.List
+type LogRepositoryMockListExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mLogRepositoryMockList) Optional() *mLogRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for LogRepository.List
+func (mmList *mLogRepositoryMockList) Expect(ctx context.Context, filter *model.LogFilter) *mLogRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("LogRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &LogRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("LogRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &LogRepositoryMockListParams{ctx, filter}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.List
+func (mmList *mLogRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("LogRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &LogRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("LogRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &LogRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectFilterParam2 sets up expected param filter for LogRepository.List
+func (mmList *mLogRepositoryMockList) ExpectFilterParam2(filter *model.LogFilter) *mLogRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("LogRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &LogRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("LogRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &LogRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.filter = &filter
+	mmList.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.List
+func (mmList *mLogRepositoryMockList) Inspect(f func(ctx context.Context, filter *model.LogFilter)) *mLogRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by LogRepository.List
+func (mmList *mLogRepositoryMockList) Return(lpa1 []*model.LogEntry, err error) *LogRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("LogRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &LogRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &LogRepositoryMockListResults{lpa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the LogRepository.List method
+func (mmList *mLogRepositoryMockList) Set(f func(ctx context.Context, filter *model.LogFilter) (lpa1 []*model.LogEntry, err error)) *LogRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the LogRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the LogRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the LogRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mLogRepositoryMockList) When(ctx context.Context, filter *model.LogFilter) *LogRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("LogRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &LogRepositoryMockListParams{ctx, filter},
+		expectationOrigins: LogRepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.List return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockListExpectation) Then(lpa1 []*model.LogEntry, err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockListResults{lpa1, err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.List should be invoked
+func (mmList *mLogRepositoryMockList) Times(n uint64) *mLogRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of LogRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mLogRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_repository.LogRepository
+func (mmList *LogRepositoryMock) List(ctx context.Context, filter *model.LogFilter) (lpa1 []*model.LogEntry, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, filter)
+	}
+
+	mm_params := LogRepositoryMockListParams{ctx, filter}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lpa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockListParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("LogRepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmList.t.Errorf("LogRepositoryMock.List got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("LogRepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the LogRepositoryMock.List")
+		}
+		return (*mm_results).lpa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, filter)
+	}
+	mmList.t.Fatalf("Unexpected call to LogRepositoryMock.List. %v %v", ctx, filter)
+	return
+}
+
+// ListAfterCounter returns a count of finished LogRepositoryMock.List invocations
+func (mmList *LogRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of LogRepositoryMock.List invocations
+func (mmList *LogRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mLogRepositoryMockList) Calls() []*LogRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LogRepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to LogRepositoryMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mLogRepositoryMockListChain struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockListChainExpectation
+	expectations       []*LogRepositoryMockListChainExpectation
+
+	callArgs []*LogRepositoryMockListChainParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LogRepositoryMockListChainExpectation specifies expectation struct of the LogRepository.ListChain
+type LogRepositoryMockListChainExpectation struct {
+	mock               *LogRepositoryMock
+	params             *LogRepositoryMockListChainParams
+	paramPtrs          *LogRepositoryMockListChainParamPtrs
+	expectationOrigins LogRepositoryMockListChainExpectationOrigins
+	results            *LogRepositoryMockListChainResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LogRepositoryMockListChainParams contains parameters of the LogRepository.ListChain
+type LogRepositoryMockListChainParams struct {
+	ctx      context.Context
+	afterSeq int64
+	limit    uint64
+}
+
+// LogRepositoryMockListChainParamPtrs contains pointers to parameters of the LogRepository.ListChain
+type LogRepositoryMockListChainParamPtrs struct {
+	ctx      *context.Context
+	afterSeq *int64
+	limit    *uint64
+}
+
+// LogRepositoryMockListChainResults contains results of the LogRepository.ListChain
+type LogRepositoryMockListChainResults struct {
+	lpa1 []*model.LogEntry
+	err  error
+}
+
+// LogRepositoryMockListChainOrigins contains origins of expectations of the LogRepository.ListChain
+type LogRepositoryMockListChainExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originAfterSeq string
+	originLimit    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListChain *mLogRepositoryMockListChain) Optional() *mLogRepositoryMockListChain {
+	mmListChain.optional = true
+	return mmListChain
+}
+
+// Expect sets up expected params for LogRepository.ListChain
+func (mmListChain *mLogRepositoryMockListChain) Expect(ctx context.Context, afterSeq int64, limit uint64) *mLogRepositoryMockListChain {
+	if mmListChain.mock.funcListChain != nil {
+		mmListChain.mock.t.Fatalf("LogRepositoryMock.ListChain mock is already set by Set")
+	}
+
+	if mmListChain.defaultExpectation == nil {
+		mmListChain.defaultExpectation = &LogRepositoryMockListChainExpectation{}
+	}
+
+	if mmListChain.defaultExpectation.paramPtrs != nil {
+		mmListChain.mock.t.Fatalf("LogRepositoryMock.ListChain mock is already set by ExpectParams functions")
+	}
+
+	mmListChain.defaultExpectation.params = &LogRepositoryMockListChainParams{ctx, afterSeq, limit}
+	mmListChain.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListChain.expectations {
+		if minimock.Equal(e.params, mmListChain.defaultExpectation.params) {
+			mmListChain.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListChain.defaultExpectation.params)
+		}
+	}
+
+	return mmListChain
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.ListChain
+func (mmListChain *mLogRepositoryMockListChain) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockListChain {
+	if mmListChain.mock.funcListChain != nil {
+		mmListChain.mock.t.Fatalf("LogRepositoryMock.ListChain mock is already set by Set")
+	}
+
+	if mmListChain.defaultExpectation == nil {
+		mmListChain.defaultExpectation = &LogRepositoryMockListChainExpectation{}
+	}
+
+	if mmListChain.defaultExpectation.params != nil {
+		mmListChain.mock.t.Fatalf("LogRepositoryMock.ListChain mock is already set by Expect")
+	}
+
+	if mmListChain.defaultExpectation.paramPtrs == nil {
+		mmListChain.defaultExpectation.paramPtrs = &LogRepositoryMockListChainParamPtrs{}
+	}
+	mmListChain.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListChain.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListChain
+}
+
+// ExpectAfterSeqParam2 sets up expected param afterSeq for LogRepository.ListChain
+func (mmListChain *mLogRepositoryMockListChain) ExpectAfterSeqParam2(afterSeq int64) *mLogRepositoryMockListChain {
+	if mmListChain.mock.funcListChain != nil {
+		mmListChain.mock.t.Fatalf("LogRepositoryMock.ListChain mock is already set by Set")
+	}
+
+	if mmListChain.defaultExpectation == nil {
+		mmListChain.defaultExpectation = &LogRepositoryMockListChainExpectation{}
+	}
+
+	if mmListChain.defaultExpectation.params != nil {
+		mmListChain.mock.t.Fatalf("LogRepositoryMock.ListChain mock is already set by Expect")
+	}
+
+	if mmListChain.defaultExpectation.paramPtrs == nil {
+		mmListChain.defaultExpectation.paramPtrs = &LogRepositoryMockListChainParamPtrs{}
+	}
+	mmListChain.defaultExpectation.paramPtrs.afterSeq = &afterSeq
+	mmListChain.defaultExpectation.expectationOrigins.originAfterSeq = minimock.CallerInfo(1)
+
+	return mmListChain
+}
+
+// ExpectLimitParam3 sets up expected param limit for LogRepository.ListChain
+func (mmListChain *mLogRepositoryMockListChain) ExpectLimitParam3(limit uint64) *mLogRepositoryMockListChain {
+	if mmListChain.mock.funcListChain != nil {
+		mmListChain.mock.t.Fatalf("LogRepositoryMock.ListChain mock is already set by Set")
+	}
+
+	if mmListChain.defaultExpectation == nil {
+		mmListChain.defaultExpectation = &LogRepositoryMockListChainExpectation{}
+	}
+
+	if mmListChain.defaultExpectation.params != nil {
+		mmListChain.mock.t.Fatalf("LogRepositoryMock.ListChain mock is already set by Expect")
+	}
+
+	if mmListChain.defaultExpectation.paramPtrs == nil {
+		mmListChain.defaultExpectation.paramPtrs = &LogRepositoryMockListChainParamPtrs{}
+	}
+	mmListChain.defaultExpectation.paramPtrs.limit = &limit
+	mmListChain.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListChain
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.ListChain
+func (mmListChain *mLogRepositoryMockListChain) Inspect(f func(ctx context.Context, afterSeq int64, limit uint64)) *mLogRepositoryMockListChain {
+	if mmListChain.mock.inspectFuncListChain != nil {
+		mmListChain.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.ListChain")
+	}
+
+	mmListChain.mock.inspectFuncListChain = f
+
+	return mmListChain
+}
+
+// Return sets up results that will be returned by LogRepository.ListChain
+func (mmListChain *mLogRepositoryMockListChain) Return(lpa1 []*model.LogEntry, err error) *LogRepositoryMock {
+	if mmListChain.mock.funcListChain != nil {
+		mmListChain.mock.t.Fatalf("LogRepositoryMock.ListChain mock is already set by Set")
+	}
+
+	if mmListChain.defaultExpectation == nil {
+		mmListChain.defaultExpectation = &LogRepositoryMockListChainExpectation{mock: mmListChain.mock}
+	}
+	mmListChain.defaultExpectation.results = &LogRepositoryMockListChainResults{lpa1, err}
+	mmListChain.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListChain.mock
+}
+
+// Set uses given function f to mock the LogRepository.ListChain method
+func (mmListChain *mLogRepositoryMockListChain) Set(f func(ctx context.Context, afterSeq int64, limit uint64) (lpa1 []*model.LogEntry, err error)) *LogRepositoryMock {
+	if mmListChain.defaultExpectation != nil {
+		mmListChain.mock.t.Fatalf("Default expectation is already set for the LogRepository.ListChain method")
+	}
+
+	if len(mmListChain.expectations) > 0 {
+		mmListChain.mock.t.Fatalf("Some expectations are already set for the LogRepository.ListChain method")
+	}
+
+	mmListChain.mock.funcListChain = f
+	mmListChain.mock.funcListChainOrigin = minimock.CallerInfo(1)
+	return mmListChain.mock
+}
+
+// When sets expectation for the LogRepository.ListChain which will trigger the result defined by the following
+// Then helper
+func (mmListChain *mLogRepositoryMockListChain) When(ctx context.Context, afterSeq int64, limit uint64) *LogRepositoryMockListChainExpectation {
+	if mmListChain.mock.funcListChain != nil {
+		mmListChain.mock.t.Fatalf("LogRepositoryMock.ListChain mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockListChainExpectation{
+		mock:               mmListChain.mock,
+		params:             &LogRepositoryMockListChainParams{ctx, afterSeq, limit},
+		expectationOrigins: LogRepositoryMockListChainExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListChain.expectations = append(mmListChain.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.ListChain return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockListChainExpectation) Then(lpa1 []*model.LogEntry, err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockListChainResults{lpa1, err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.ListChain should be invoked
+func (mmListChain *mLogRepositoryMockListChain) Times(n uint64) *mLogRepositoryMockListChain {
+	if n == 0 {
+		mmListChain.mock.t.Fatalf("Times of LogRepositoryMock.ListChain mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListChain.expectedInvocations, n)
+	mmListChain.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListChain
+}
+
+func (mmListChain *mLogRepositoryMockListChain) invocationsDone() bool {
+	if len(mmListChain.expectations) == 0 && mmListChain.defaultExpectation == nil && mmListChain.mock.funcListChain == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListChain.mock.afterListChainCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListChain.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListChain implements mm_repository.LogRepository
+func (mmListChain *LogRepositoryMock) ListChain(ctx context.Context, afterSeq int64, limit uint64) (lpa1 []*model.LogEntry, err error) {
+	mm_atomic.AddUint64(&mmListChain.beforeListChainCounter, 1)
+	defer mm_atomic.AddUint64(&mmListChain.afterListChainCounter, 1)
+
+	mmListChain.t.Helper()
+
+	if mmListChain.inspectFuncListChain != nil {
+		mmListChain.inspectFuncListChain(ctx, afterSeq, limit)
+	}
+
+	mm_params := LogRepositoryMockListChainParams{ctx, afterSeq, limit}
+
+	// Record call args
+	mmListChain.ListChainMock.mutex.Lock()
+	mmListChain.ListChainMock.callArgs = append(mmListChain.ListChainMock.callArgs, &mm_params)
+	mmListChain.ListChainMock.mutex.Unlock()
+
+	for _, e := range mmListChain.ListChainMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lpa1, e.results.err
+		}
+	}
+
+	if mmListChain.ListChainMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListChain.ListChainMock.defaultExpectation.Counter, 1)
+		mm_want := mmListChain.ListChainMock.defaultExpectation.params
+		mm_want_ptrs := mmListChain.ListChainMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockListChainParams{ctx, afterSeq, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListChain.t.Errorf("LogRepositoryMock.ListChain got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChain.ListChainMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.afterSeq != nil && !minimock.Equal(*mm_want_ptrs.afterSeq, mm_got.afterSeq) {
+				mmListChain.t.Errorf("LogRepositoryMock.ListChain got unexpected parameter afterSeq, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChain.ListChainMock.defaultExpectation.expectationOrigins.originAfterSeq, *mm_want_ptrs.afterSeq, mm_got.afterSeq, minimock.Diff(*mm_want_ptrs.afterSeq, mm_got.afterSeq))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListChain.t.Errorf("LogRepositoryMock.ListChain got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChain.ListChainMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListChain.t.Errorf("LogRepositoryMock.ListChain got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListChain.ListChainMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListChain.ListChainMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListChain.t.Fatal("No results are set for the LogRepositoryMock.ListChain")
+		}
+		return (*mm_results).lpa1, (*mm_results).err
+	}
+	if mmListChain.funcListChain != nil {
+		return mmListChain.funcListChain(ctx, afterSeq, limit)
+	}
+	mmListChain.t.Fatalf("Unexpected call to LogRepositoryMock.ListChain. %v %v %v", ctx, afterSeq, limit)
+	return
+}
+
+// ListChainAfterCounter returns a count of finished LogRepositoryMock.ListChain invocations
+func (mmListChain *LogRepositoryMock) ListChainAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChain.afterListChainCounter)
+}
+
+// ListChainBeforeCounter returns a count of LogRepositoryMock.ListChain invocations
+func (mmListChain *LogRepositoryMock) ListChainBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChain.beforeListChainCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.ListChain.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListChain *mLogRepositoryMockListChain) Calls() []*LogRepositoryMockListChainParams {
+	mmListChain.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockListChainParams, len(mmListChain.callArgs))
+	copy(argCopy, mmListChain.callArgs)
+
+	mmListChain.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListChainDone returns true if the count of the ListChain invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockListChainDone() bool {
+	if m.ListChainMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListChainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListChainMock.invocationsDone()
+}
+
+// MinimockListChainInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockListChainInspect() {
+	for _, e := range m.ListChainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListChain at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListChainCounter := mm_atomic.LoadUint64(&m.afterListChainCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListChainMock.defaultExpectation != nil && afterListChainCounter < 1 {
+		if m.ListChainMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListChain at\n%s", m.ListChainMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListChain at\n%s with params: %#v", m.ListChainMock.defaultExpectation.expectationOrigins.origin, *m.ListChainMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListChain != nil && afterListChainCounter < 1 {
+		m.t.Errorf("Expected call to LogRepositoryMock.ListChain at\n%s", m.funcListChainOrigin)
+	}
+
+	if !m.ListChainMock.invocationsDone() && afterListChainCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.ListChain at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListChainMock.expectedInvocations), m.ListChainMock.expectedInvocationsOrigin, afterListChainCounter)
+	}
+}
+
+type mLogRepositoryMockListUnchained struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockListUnchainedExpectation
+	expectations       []*LogRepositoryMockListUnchainedExpectation
+
+	callArgs []*LogRepositoryMockListUnchainedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LogRepositoryMockListUnchainedExpectation specifies expectation struct of the LogRepository.ListUnchained
+type LogRepositoryMockListUnchainedExpectation struct {
+	mock               *LogRepositoryMock
+	params             *LogRepositoryMockListUnchainedParams
+	paramPtrs          *LogRepositoryMockListUnchainedParamPtrs
+	expectationOrigins LogRepositoryMockListUnchainedExpectationOrigins
+	results            *LogRepositoryMockListUnchainedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LogRepositoryMockListUnchainedParams contains parameters of the LogRepository.ListUnchained
+type LogRepositoryMockListUnchainedParams struct {
+	ctx   context.Context
+	limit uint64
+}
+
+// LogRepositoryMockListUnchainedParamPtrs contains pointers to parameters of the LogRepository.ListUnchained
+type LogRepositoryMockListUnchainedParamPtrs struct {
+	ctx   *context.Context
+	limit *uint64
+}
+
+// LogRepositoryMockListUnchainedResults contains results of the LogRepository.ListUnchained
+type LogRepositoryMockListUnchainedResults struct {
+	lpa1 []*model.LogEntry
+	err  error
+}
+
+// LogRepositoryMockListUnchainedOrigins contains origins of expectations of the LogRepository.ListUnchained
+type LogRepositoryMockListUnchainedExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListUnchained *mLogRepositoryMockListUnchained) Optional() *mLogRepositoryMockListUnchained {
+	mmListUnchained.optional = true
+	return mmListUnchained
+}
+
+// Expect sets up expected params for LogRepository.ListUnchained
+func (mmListUnchained *mLogRepositoryMockListUnchained) Expect(ctx context.Context, limit uint64) *mLogRepositoryMockListUnchained {
+	if mmListUnchained.mock.funcListUnchained != nil {
+		mmListUnchained.mock.t.Fatalf("LogRepositoryMock.ListUnchained mock is already set by Set")
+	}
+
+	if mmListUnchained.defaultExpectation == nil {
+		mmListUnchained.defaultExpectation = &LogRepositoryMockListUnchainedExpectation{}
+	}
+
+	if mmListUnchained.defaultExpectation.paramPtrs != nil {
+		mmListUnchained.mock.t.Fatalf("LogRepositoryMock.ListUnchained mock is already set by ExpectParams functions")
+	}
+
+	mmListUnchained.defaultExpectation.params = &LogRepositoryMockListUnchainedParams{ctx, limit}
+	mmListUnchained.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListUnchained.expectations {
+		if minimock.Equal(e.params, mmListUnchained.defaultExpectation.params) {
+			mmListUnchained.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUnchained.defaultExpectation.params)
+		}
+	}
+
+	return mmListUnchained
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.ListUnchained
+func (mmListUnchained *mLogRepositoryMockListUnchained) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockListUnchained {
+	if mmListUnchained.mock.funcListUnchained != nil {
+		mmListUnchained.mock.t.Fatalf("LogRepositoryMock.ListUnchained mock is already set by Set")
+	}
+
+	if mmListUnchained.defaultExpectation == nil {
+		mmListUnchained.defaultExpectation = &LogRepositoryMockListUnchainedExpectation{}
+	}
+
+	if mmListUnchained.defaultExpectation.params != nil {
+		mmListUnchained.mock.t.Fatalf("LogRepositoryMock.ListUnchained mock is already set by Expect")
+	}
+
+	if mmListUnchained.defaultExpectation.paramPtrs == nil {
+		mmListUnchained.defaultExpectation.paramPtrs = &LogRepositoryMockListUnchainedParamPtrs{}
+	}
+	mmListUnchained.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListUnchained.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListUnchained
+}
+
+// ExpectLimitParam2 sets up expected param limit for LogRepository.ListUnchained
+func (mmListUnchained *mLogRepositoryMockListUnchained) ExpectLimitParam2(limit uint64) *mLogRepositoryMockListUnchained {
+	if mmListUnchained.mock.funcListUnchained != nil {
+		mmListUnchained.mock.t.Fatalf("LogRepositoryMock.ListUnchained mock is already set by Set")
+	}
+
+	if mmListUnchained.defaultExpectation == nil {
+		mmListUnchained.defaultExpectation = &LogRepositoryMockListUnchainedExpectation{}
+	}
+
+	if mmListUnchained.defaultExpectation.params != nil {
+		mmListUnchained.mock.t.Fatalf("LogRepositoryMock.ListUnchained mock is already set by Expect")
+	}
+
+	if mmListUnchained.defaultExpectation.paramPtrs == nil {
+		mmListUnchained.defaultExpectation.paramPtrs = &LogRepositoryMockListUnchainedParamPtrs{}
+	}
+	mmListUnchained.defaultExpectation.paramPtrs.limit = &limit
+	mmListUnchained.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListUnchained
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.ListUnchained
+func (mmListUnchained *mLogRepositoryMockListUnchained) Inspect(f func(ctx context.Context, limit uint64)) *mLogRepositoryMockListUnchained {
+	if mmListUnchained.mock.inspectFuncListUnchained != nil {
+		mmListUnchained.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.ListUnchained")
+	}
+
+	mmListUnchained.mock.inspectFuncListUnchained = f
+
+	return mmListUnchained
+}
+
+// Return sets up results that will be returned by LogRepository.ListUnchained
+func (mmListUnchained *mLogRepositoryMockListUnchained) Return(lpa1 []*model.LogEntry, err error) *LogRepositoryMock {
+	if mmListUnchained.mock.funcListUnchained != nil {
+		mmListUnchained.mock.t.Fatalf("LogRepositoryMock.ListUnchained mock is already set by Set")
+	}
+
+	if mmListUnchained.defaultExpectation == nil {
+		mmListUnchained.defaultExpectation = &LogRepositoryMockListUnchainedExpectation{mock: mmListUnchained.mock}
+	}
+	mmListUnchained.defaultExpectation.results = &LogRepositoryMockListUnchainedResults{lpa1, err}
+	mmListUnchained.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListUnchained.mock
+}
+
+// Set uses given function f to mock the LogRepository.ListUnchained method
+func (mmListUnchained *mLogRepositoryMockListUnchained) Set(f func(ctx context.Context, limit uint64) (lpa1 []*model.LogEntry, err error)) *LogRepositoryMock {
+	if mmListUnchained.defaultExpectation != nil {
+		mmListUnchained.mock.t.Fatalf("Default expectation is already set for the LogRepository.ListUnchained method")
+	}
+
+	if len(mmListUnchained.expectations) > 0 {
+		mmListUnchained.mock.t.Fatalf("Some expectations are already set for the LogRepository.ListUnchained method")
+	}
+
+	mmListUnchained.mock.funcListUnchained = f
+	mmListUnchained.mock.funcListUnchainedOrigin = minimock.CallerInfo(1)
+	return mmListUnchained.mock
+}
+
+// When sets expectation for the LogRepository.ListUnchained which will trigger the result defined by the following
+// Then helper
+func (mmListUnchained *mLogRepositoryMockListUnchained) When(ctx context.Context, limit uint64) *LogRepositoryMockListUnchainedExpectation {
+	if mmListUnchained.mock.funcListUnchained != nil {
+		mmListUnchained.mock.t.Fatalf("LogRepositoryMock.ListUnchained mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockListUnchainedExpectation{
+		mock:               mmListUnchained.mock,
+		params:             &LogRepositoryMockListUnchainedParams{ctx, limit},
+		expectationOrigins: LogRepositoryMockListUnchainedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListUnchained.expectations = append(mmListUnchained.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.ListUnchained return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockListUnchainedExpectation) Then(lpa1 []*model.LogEntry, err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockListUnchainedResults{lpa1, err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.ListUnchained should be invoked
+func (mmListUnchained *mLogRepositoryMockListUnchained) Times(n uint64) *mLogRepositoryMockListUnchained {
+	if n == 0 {
+		mmListUnchained.mock.t.Fatalf("Times of LogRepositoryMock.ListUnchained mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListUnchained.expectedInvocations, n)
+	mmListUnchained.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListUnchained
+}
+
+func (mmListUnchained *mLogRepositoryMockListUnchained) invocationsDone() bool {
+	if len(mmListUnchained.expectations) == 0 && mmListUnchained.defaultExpectation == nil && mmListUnchained.mock.funcListUnchained == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListUnchained.mock.afterListUnchainedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListUnchained.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListUnchained implements mm_repository.LogRepository
+func (mmListUnchained *LogRepositoryMock) ListUnchained(ctx context.Context, limit uint64) (lpa1 []*model.LogEntry, err error) {
+	mm_atomic.AddUint64(&mmListUnchained.beforeListUnchainedCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUnchained.afterListUnchainedCounter, 1)
+
+	mmListUnchained.t.Helper()
+
+	if mmListUnchained.inspectFuncListUnchained != nil {
+		mmListUnchained.inspectFuncListUnchained(ctx, limit)
+	}
+
+	mm_params := LogRepositoryMockListUnchainedParams{ctx, limit}
+
+	// Record call args
+	mmListUnchained.ListUnchainedMock.mutex.Lock()
+	mmListUnchained.ListUnchainedMock.callArgs = append(mmListUnchained.ListUnchainedMock.callArgs, &mm_params)
+	mmListUnchained.ListUnchainedMock.mutex.Unlock()
+
+	for _, e := range mmListUnchained.ListUnchainedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lpa1, e.results.err
+		}
+	}
+
+	if mmListUnchained.ListUnchainedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUnchained.ListUnchainedMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUnchained.ListUnchainedMock.defaultExpectation.params
+		mm_want_ptrs := mmListUnchained.ListUnchainedMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockListUnchainedParams{ctx, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListUnchained.t.Errorf("LogRepositoryMock.ListUnchained got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUnchained.ListUnchainedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListUnchained.t.Errorf("LogRepositoryMock.ListUnchained got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUnchained.ListUnchainedMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListUnchained.t.Errorf("LogRepositoryMock.ListUnchained got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListUnchained.ListUnchainedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListUnchained.ListUnchainedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListUnchained.t.Fatal("No results are set for the LogRepositoryMock.ListUnchained")
+		}
+		return (*mm_results).lpa1, (*mm_results).err
+	}
+	if mmListUnchained.funcListUnchained != nil {
+		return mmListUnchained.funcListUnchained(ctx, limit)
+	}
+	mmListUnchained.t.Fatalf("Unexpected call to LogRepositoryMock.ListUnchained. %v %v", ctx, limit)
+	return
+}
+
+// ListUnchainedAfterCounter returns a count of finished LogRepositoryMock.ListUnchained invocations
+func (mmListUnchained *LogRepositoryMock) ListUnchainedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUnchained.afterListUnchainedCounter)
+}
+
+// ListUnchainedBeforeCounter returns a count of LogRepositoryMock.ListUnchained invocations
+func (mmListUnchained *LogRepositoryMock) ListUnchainedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUnchained.beforeListUnchainedCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.ListUnchained.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListUnchained *mLogRepositoryMockListUnchained) Calls() []*LogRepositoryMockListUnchainedParams {
+	mmListUnchained.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockListUnchainedParams, len(mmListUnchained.callArgs))
+	copy(argCopy, mmListUnchained.callArgs)
+
+	mmListUnchained.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListUnchainedDone returns true if the count of the ListUnchained invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockListUnchainedDone() bool {
+	if m.ListUnchainedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListUnchainedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListUnchainedMock.invocationsDone()
+}
+
+// MinimockListUnchainedInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockListUnchainedInspect() {
+	for _, e := range m.ListUnchainedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListUnchained at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListUnchainedCounter := mm_atomic.LoadUint64(&m.afterListUnchainedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUnchainedMock.defaultExpectation != nil && afterListUnchainedCounter < 1 {
+		if m.ListUnchainedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListUnchained at\n%s", m.ListUnchainedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListUnchained at\n%s with params: %#v", m.ListUnchainedMock.defaultExpectation.expectationOrigins.origin, *m.ListUnchainedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUnchained != nil && afterListUnchainedCounter < 1 {
+		m.t.Errorf("Expected call to LogRepositoryMock.ListUnchained at\n%s", m.funcListUnchainedOrigin)
+	}
+
+	if !m.ListUnchainedMock.invocationsDone() && afterListUnchainedCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.ListUnchained at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListUnchainedMock.expectedInvocations), m.ListUnchainedMock.expectedInvocationsOrigin, afterListUnchainedCounter)
+	}
+}
+
+type mLogRepositoryMockLockChain struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockLockChainExpectation
+	expectations       []*LogRepositoryMockLockChainExpectation
+
+	callArgs []*LogRepositoryMockLockChainParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LogRepositoryMockLockChainExpectation specifies expectation struct of the LogRepository.LockChain
+type LogRepositoryMockLockChainExpectation struct {
+	mock               *LogRepositoryMock
+	params             *LogRepositoryMockLockChainParams
+	paramPtrs          *LogRepositoryMockLockChainParamPtrs
+	expectationOrigins LogRepositoryMockLockChainExpectationOrigins
+	results            *LogRepositoryMockLockChainResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LogRepositoryMockLockChainParams contains parameters of the LogRepository.LockChain
+type LogRepositoryMockLockChainParams struct {
+	ctx context.Context
+}
+
+// LogRepositoryMockLockChainParamPtrs contains pointers to parameters of the LogRepository.LockChain
+type LogRepositoryMockLockChainParamPtrs struct {
+	ctx *context.Context
+}
+
+// LogRepositoryMockLockChainResults contains results of the LogRepository.LockChain
+type LogRepositoryMockLockChainResults struct {
+	err error
+}
+
+// LogRepositoryMockLockChainOrigins contains origins of expectations of the LogRepository.LockChain
+type LogRepositoryMockLockChainExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLockChain *mLogRepositoryMockLockChain) Optional() *mLogRepositoryMockLockChain {
+	mmLockChain.optional = true
+	return mmLockChain
+}
+
+// Expect sets up expected params for LogRepository.LockChain
+func (mmLockChain *mLogRepositoryMockLockChain) Expect(ctx context.Context) *mLogRepositoryMockLockChain {
+	if mmLockChain.mock.funcLockChain != nil {
+		mmLockChain.mock.t.Fatalf("LogRepositoryMock.LockChain mock is already set by Set")
+	}
+
+	if mmLockChain.defaultExpectation == nil {
+		mmLockChain.defaultExpectation = &LogRepositoryMockLockChainExpectation{}
+	}
+
+	if mmLockChain.defaultExpectation.paramPtrs != nil {
+		mmLockChain.mock.t.Fatalf("LogRepositoryMock.LockChain mock is already set by ExpectParams functions")
+	}
+
+	mmLockChain.defaultExpectation.params = &LogRepositoryMockLockChainParams{ctx}
+	mmLockChain.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLockChain.expectations {
+		if minimock.Equal(e.params, mmLockChain.defaultExpectation.params) {
+			mmLockChain.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockChain.defaultExpectation.params)
+		}
+	}
+
+	return mmLockChain
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.LockChain
+func (mmLockChain *mLogRepositoryMockLockChain) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockLockChain {
+	if mmLockChain.mock.funcLockChain != nil {
+		mmLockChain.mock.t.Fatalf("LogRepositoryMock.LockChain mock is already set by Set")
+	}
+
+	if mmLockChain.defaultExpectation == nil {
+		mmLockChain.defaultExpectation = &LogRepositoryMockLockChainExpectation{}
+	}
+
+	if mmLockChain.defaultExpectation.params != nil {
+		mmLockChain.mock.t.Fatalf("LogRepositoryMock.LockChain mock is already set by Expect")
+	}
+
+	if mmLockChain.defaultExpectation.paramPtrs == nil {
+		mmLockChain.defaultExpectation.paramPtrs = &LogRepositoryMockLockChainParamPtrs{}
+	}
+	mmLockChain.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLockChain.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLockChain
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.LockChain
+func (mmLockChain *mLogRepositoryMockLockChain) Inspect(f func(ctx context.Context)) *mLogRepositoryMockLockChain {
+	if mmLockChain.mock.inspectFuncLockChain != nil {
+		mmLockChain.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.LockChain")
+	}
+
+	mmLockChain.mock.inspectFuncLockChain = f
+
+	return mmLockChain
+}
+
+// Return sets up results that will be returned by LogRepository.LockChain
+func (mmLockChain *mLogRepositoryMockLockChain) Return(err error) *LogRepositoryMock {
+	if mmLockChain.mock.funcLockChain != nil {
+		mmLockChain.mock.t.Fatalf("LogRepositoryMock.LockChain mock is already set by Set")
+	}
+
+	if mmLockChain.defaultExpectation == nil {
+		mmLockChain.defaultExpectation = &LogRepositoryMockLockChainExpectation{mock: mmLockChain.mock}
+	}
+	mmLockChain.defaultExpectation.results = &LogRepositoryMockLockChainResults{err}
+	mmLockChain.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLockChain.mock
+}
+
+// Set uses given function f to mock the LogRepository.LockChain method
+func (mmLockChain *mLogRepositoryMockLockChain) Set(f func(ctx context.Context) (err error)) *LogRepositoryMock {
+	if mmLockChain.defaultExpectation != nil {
+		mmLockChain.mock.t.Fatalf("Default expectation is already set for the LogRepository.LockChain method")
+	}
+
+	if len(mmLockChain.expectations) > 0 {
+		mmLockChain.mock.t.Fatalf("Some expectations are already set for the LogRepository.LockChain method")
+	}
+
+	mmLockChain.mock.funcLockChain = f
+	mmLockChain.mock.funcLockChainOrigin = minimock.CallerInfo(1)
+	return mmLockChain.mock
+}
+
+// When sets expectation for the LogRepository.LockChain which will trigger the result defined by the following
+// Then helper
+func (mmLockChain *mLogRepositoryMockLockChain) When(ctx context.Context) *LogRepositoryMockLockChainExpectation {
+	if mmLockChain.mock.funcLockChain != nil {
+		mmLockChain.mock.t.Fatalf("LogRepositoryMock.LockChain mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockLockChainExpectation{
+		mock:               mmLockChain.mock,
+		params:             &LogRepositoryMockLockChainParams{ctx},
+		expectationOrigins: LogRepositoryMockLockChainExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLockChain.expectations = append(mmLockChain.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.LockChain return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockLockChainExpectation) Then(err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockLockChainResults{err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.LockChain should be invoked
+func (mmLockChain *mLogRepositoryMockLockChain) Times(n uint64) *mLogRepositoryMockLockChain {
+	if n == 0 {
+		mmLockChain.mock.t.Fatalf("Times of LogRepositoryMock.LockChain mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLockChain.expectedInvocations, n)
+	mmLockChain.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLockChain
+}
+
+func (mmLockChain *mLogRepositoryMockLockChain) invocationsDone() bool {
+	if len(mmLockChain.expectations) == 0 && mmLockChain.defaultExpectation == nil && mmLockChain.mock.funcLockChain == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLockChain.mock.afterLockChainCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLockChain.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LockChain implements mm_repository.LogRepository
+func (mmLockChain *LogRepositoryMock) LockChain(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmLockChain.beforeLockChainCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockChain.afterLockChainCounter, 1)
+
+	mmLockChain.t.Helper()
+
+	if mmLockChain.inspectFuncLockChain != nil {
+		mmLockChain.inspectFuncLockChain(ctx)
+	}
+
+	mm_params := LogRepositoryMockLockChainParams{ctx}
+
+	// Record call args
+	mmLockChain.LockChainMock.mutex.Lock()
+	mmLockChain.LockChainMock.callArgs = append(mmLockChain.LockChainMock.callArgs, &mm_params)
+	mmLockChain.LockChainMock.mutex.Unlock()
+
+	for _, e := range mmLockChain.LockChainMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLockChain.LockChainMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockChain.LockChainMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockChain.LockChainMock.defaultExpectation.params
+		mm_want_ptrs := mmLockChain.LockChainMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockLockChainParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockChain.t.Errorf("LogRepositoryMock.LockChain got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockChain.LockChainMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockChain.t.Errorf("LogRepositoryMock.LockChain got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLockChain.LockChainMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockChain.LockChainMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockChain.t.Fatal("No results are set for the LogRepositoryMock.LockChain")
+		}
+		return (*mm_results).err
+	}
+	if mmLockChain.funcLockChain != nil {
+		return mmLockChain.funcLockChain(ctx)
+	}
+	mmLockChain.t.Fatalf("Unexpected call to LogRepositoryMock.LockChain. %v", ctx)
+	return
+}
+
+// LockChainAfterCounter returns a count of finished LogRepositoryMock.LockChain invocations
+func (mmLockChain *LogRepositoryMock) LockChainAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockChain.afterLockChainCounter)
+}
+
+// LockChainBeforeCounter returns a count of LogRepositoryMock.LockChain invocations
+func (mmLockChain *LogRepositoryMock) LockChainBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockChain.beforeLockChainCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.LockChain.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockChain *mLogRepositoryMockLockChain) Calls() []*LogRepositoryMockLockChainParams {
+	mmLockChain.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockLockChainParams, len(mmLockChain.callArgs))
+	copy(argCopy, mmLockChain.callArgs)
+
+	mmLockChain.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockChainDone returns true if the count of the LockChain invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockLockChainDone() bool {
+	if m.LockChainMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockChainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockChainMock.invocationsDone()
+}
+
+// MinimockLockChainInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockLockChainInspect() {
+	for _, e := range m.LockChainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.LockChain at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLockChainCounter := mm_atomic.LoadUint64(&m.afterLockChainCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockChainMock.defaultExpectation != nil && afterLockChainCounter < 1 {
+		if m.LockChainMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LogRepositoryMock.LockChain at\n%s", m.LockChainMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.LockChain at\n%s with params: %#v", m.LockChainMock.defaultExpectation.expectationOrigins.origin, *m.LockChainMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockChain != nil && afterLockChainCounter < 1 {
+		m.t.Errorf("Expected call to LogRepositoryMock.LockChain at\n%s", m.funcLockChainOrigin)
+	}
+
+	if !m.LockChainMock.invocationsDone() && afterLockChainCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.LockChain at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LockChainMock.expectedInvocations), m.LockChainMock.expectedInvocationsOrigin, afterLockChainCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *LogRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCountUpToInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteUpToInspect()
+
+			m.MinimockLastBeforeInspect()
+
+			m.MinimockLastChainedInspect()
+
+			m.MinimockLinkInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockListChainInspect()
+
+			m.MinimockListUnchainedInspect()
+
+			m.MinimockLockChainInspect()
 		}
 	})
 }
@@ -413,5 +3607,14 @@ func (m *LogRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *LogRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockCreateDone()
+		m.MinimockCountUpToDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteUpToDone() &&
+		m.MinimockLastBeforeDone() &&
+		m.MinimockLastChainedDone() &&
+		m.MinimockLinkDone() &&
+		m.MinimockListDone() &&
+		m.MinimockListChainDone() &&
+		m.MinimockListUnchainedDone() &&
+		m.MinimockLockChainDone()
 }
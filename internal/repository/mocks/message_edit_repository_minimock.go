@@ -0,0 +1,1129 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Mobo140/chat/internal/repository.MessageEditRepository -o message_edit_repository_minimock.go -n MessageEditRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// MessageEditRepositoryMock implements mm_repository.MessageEditRepository
+type MessageEditRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCopy          func(ctx context.Context, edits []*model.MessageEdit) (i1 int64, err error)
+	funcCopyOrigin    string
+	inspectFuncCopy   func(ctx context.Context, edits []*model.MessageEdit)
+	afterCopyCounter  uint64
+	beforeCopyCounter uint64
+	CopyMock          mMessageEditRepositoryMockCopy
+
+	funcCreate          func(ctx context.Context, edit *model.MessageEdit) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, edit *model.MessageEdit)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mMessageEditRepositoryMockCreate
+
+	funcListByMessages          func(ctx context.Context, messageIDs []int64) (m1 map[int64][]*model.MessageEdit, err error)
+	funcListByMessagesOrigin    string
+	inspectFuncListByMessages   func(ctx context.Context, messageIDs []int64)
+	afterListByMessagesCounter  uint64
+	beforeListByMessagesCounter uint64
+	ListByMessagesMock          mMessageEditRepositoryMockListByMessages
+}
+
+// NewMessageEditRepositoryMock returns a mock for mm_repository.MessageEditRepository
+func NewMessageEditRepositoryMock(t minimock.Tester) *MessageEditRepositoryMock {
+	m := &MessageEditRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CopyMock = mMessageEditRepositoryMockCopy{mock: m}
+	m.CopyMock.callArgs = []*MessageEditRepositoryMockCopyParams{}
+
+	m.CreateMock = mMessageEditRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*MessageEditRepositoryMockCreateParams{}
+
+	m.ListByMessagesMock = mMessageEditRepositoryMockListByMessages{mock: m}
+	m.ListByMessagesMock.callArgs = []*MessageEditRepositoryMockListByMessagesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mMessageEditRepositoryMockCopy struct {
+	optional           bool
+	mock               *MessageEditRepositoryMock
+	defaultExpectation *MessageEditRepositoryMockCopyExpectation
+	expectations       []*MessageEditRepositoryMockCopyExpectation
+
+	callArgs []*MessageEditRepositoryMockCopyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageEditRepositoryMockCopyExpectation specifies expectation struct of the MessageEditRepository.Copy
+type MessageEditRepositoryMockCopyExpectation struct {
+	mock               *MessageEditRepositoryMock
+	params             *MessageEditRepositoryMockCopyParams
+	paramPtrs          *MessageEditRepositoryMockCopyParamPtrs
+	expectationOrigins MessageEditRepositoryMockCopyExpectationOrigins
+	results            *MessageEditRepositoryMockCopyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageEditRepositoryMockCopyParams contains parameters of the MessageEditRepository.Copy
+type MessageEditRepositoryMockCopyParams struct {
+	ctx   context.Context
+	edits []*model.MessageEdit
+}
+
+// MessageEditRepositoryMockCopyParamPtrs contains pointers to parameters of the MessageEditRepository.Copy
+type MessageEditRepositoryMockCopyParamPtrs struct {
+	ctx   *context.Context
+	edits *[]*model.MessageEdit
+}
+
+// MessageEditRepositoryMockCopyResults contains results of the MessageEditRepository.Copy
+type MessageEditRepositoryMockCopyResults struct {
+	i1  int64
+	err error
+}
+
+// MessageEditRepositoryMockCopyOrigins contains origins of expectations of the MessageEditRepository.Copy
+type MessageEditRepositoryMockCopyExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEdits string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCopy *mMessageEditRepositoryMockCopy) Optional() *mMessageEditRepositoryMockCopy {
+	mmCopy.optional = true
+	return mmCopy
+}
+
+// Expect sets up expected params for MessageEditRepository.Copy
+func (mmCopy *mMessageEditRepositoryMockCopy) Expect(ctx context.Context, edits []*model.MessageEdit) *mMessageEditRepositoryMockCopy {
+	if mmCopy.mock.funcCopy != nil {
+		mmCopy.mock.t.Fatalf("MessageEditRepositoryMock.Copy mock is already set by Set")
+	}
+
+	if mmCopy.defaultExpectation == nil {
+		mmCopy.defaultExpectation = &MessageEditRepositoryMockCopyExpectation{}
+	}
+
+	if mmCopy.defaultExpectation.paramPtrs != nil {
+		mmCopy.mock.t.Fatalf("MessageEditRepositoryMock.Copy mock is already set by ExpectParams functions")
+	}
+
+	mmCopy.defaultExpectation.params = &MessageEditRepositoryMockCopyParams{ctx, edits}
+	mmCopy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCopy.expectations {
+		if minimock.Equal(e.params, mmCopy.defaultExpectation.params) {
+			mmCopy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCopy.defaultExpectation.params)
+		}
+	}
+
+	return mmCopy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageEditRepository.Copy
+func (mmCopy *mMessageEditRepositoryMockCopy) ExpectCtxParam1(ctx context.Context) *mMessageEditRepositoryMockCopy {
+	if mmCopy.mock.funcCopy != nil {
+		mmCopy.mock.t.Fatalf("MessageEditRepositoryMock.Copy mock is already set by Set")
+	}
+
+	if mmCopy.defaultExpectation == nil {
+		mmCopy.defaultExpectation = &MessageEditRepositoryMockCopyExpectation{}
+	}
+
+	if mmCopy.defaultExpectation.params != nil {
+		mmCopy.mock.t.Fatalf("MessageEditRepositoryMock.Copy mock is already set by Expect")
+	}
+
+	if mmCopy.defaultExpectation.paramPtrs == nil {
+		mmCopy.defaultExpectation.paramPtrs = &MessageEditRepositoryMockCopyParamPtrs{}
+	}
+	mmCopy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCopy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCopy
+}
+
+// ExpectEditsParam2 sets up expected param edits for MessageEditRepository.Copy
+func (mmCopy *mMessageEditRepositoryMockCopy) ExpectEditsParam2(edits []*model.MessageEdit) *mMessageEditRepositoryMockCopy {
+	if mmCopy.mock.funcCopy != nil {
+		mmCopy.mock.t.Fatalf("MessageEditRepositoryMock.Copy mock is already set by Set")
+	}
+
+	if mmCopy.defaultExpectation == nil {
+		mmCopy.defaultExpectation = &MessageEditRepositoryMockCopyExpectation{}
+	}
+
+	if mmCopy.defaultExpectation.params != nil {
+		mmCopy.mock.t.Fatalf("MessageEditRepositoryMock.Copy mock is already set by Expect")
+	}
+
+	if mmCopy.defaultExpectation.paramPtrs == nil {
+		mmCopy.defaultExpectation.paramPtrs = &MessageEditRepositoryMockCopyParamPtrs{}
+	}
+	mmCopy.defaultExpectation.paramPtrs.edits = &edits
+	mmCopy.defaultExpectation.expectationOrigins.originEdits = minimock.CallerInfo(1)
+
+	return mmCopy
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageEditRepository.Copy
+func (mmCopy *mMessageEditRepositoryMockCopy) Inspect(f func(ctx context.Context, edits []*model.MessageEdit)) *mMessageEditRepositoryMockCopy {
+	if mmCopy.mock.inspectFuncCopy != nil {
+		mmCopy.mock.t.Fatalf("Inspect function is already set for MessageEditRepositoryMock.Copy")
+	}
+
+	mmCopy.mock.inspectFuncCopy = f
+
+	return mmCopy
+}
+
+// Return sets up results that will be returned by MessageEditRepository.Copy
+func (mmCopy *mMessageEditRepositoryMockCopy) Return(i1 int64, err error) *MessageEditRepositoryMock {
+	if mmCopy.mock.funcCopy != nil {
+		mmCopy.mock.t.Fatalf("MessageEditRepositoryMock.Copy mock is already set by Set")
+	}
+
+	if mmCopy.defaultExpectation == nil {
+		mmCopy.defaultExpectation = &MessageEditRepositoryMockCopyExpectation{mock: mmCopy.mock}
+	}
+	mmCopy.defaultExpectation.results = &MessageEditRepositoryMockCopyResults{i1, err}
+	mmCopy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCopy.mock
+}
+
+// Set uses given function f to mock the MessageEditRepository.Copy method
+func (mmCopy *mMessageEditRepositoryMockCopy) Set(f func(ctx context.Context, edits []*model.MessageEdit) (i1 int64, err error)) *MessageEditRepositoryMock {
+	if mmCopy.defaultExpectation != nil {
+		mmCopy.mock.t.Fatalf("Default expectation is already set for the MessageEditRepository.Copy method")
+	}
+
+	if len(mmCopy.expectations) > 0 {
+		mmCopy.mock.t.Fatalf("Some expectations are already set for the MessageEditRepository.Copy method")
+	}
+
+	mmCopy.mock.funcCopy = f
+	mmCopy.mock.funcCopyOrigin = minimock.CallerInfo(1)
+	return mmCopy.mock
+}
+
+// When sets expectation for the MessageEditRepository.Copy which will trigger the result defined by the following
+// Then helper
+func (mmCopy *mMessageEditRepositoryMockCopy) When(ctx context.Context, edits []*model.MessageEdit) *MessageEditRepositoryMockCopyExpectation {
+	if mmCopy.mock.funcCopy != nil {
+		mmCopy.mock.t.Fatalf("MessageEditRepositoryMock.Copy mock is already set by Set")
+	}
+
+	expectation := &MessageEditRepositoryMockCopyExpectation{
+		mock:               mmCopy.mock,
+		params:             &MessageEditRepositoryMockCopyParams{ctx, edits},
+		expectationOrigins: MessageEditRepositoryMockCopyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCopy.expectations = append(mmCopy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageEditRepository.Copy return parameters for the expectation previously defined by the When method
+func (e *MessageEditRepositoryMockCopyExpectation) Then(i1 int64, err error) *MessageEditRepositoryMock {
+	e.results = &MessageEditRepositoryMockCopyResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageEditRepository.Copy should be invoked
+func (mmCopy *mMessageEditRepositoryMockCopy) Times(n uint64) *mMessageEditRepositoryMockCopy {
+	if n == 0 {
+		mmCopy.mock.t.Fatalf("Times of MessageEditRepositoryMock.Copy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCopy.expectedInvocations, n)
+	mmCopy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCopy
+}
+
+func (mmCopy *mMessageEditRepositoryMockCopy) invocationsDone() bool {
+	if len(mmCopy.expectations) == 0 && mmCopy.defaultExpectation == nil && mmCopy.mock.funcCopy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCopy.mock.afterCopyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCopy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Copy implements mm_repository.MessageEditRepository
+func (mmCopy *MessageEditRepositoryMock) Copy(ctx context.Context, edits []*model.MessageEdit) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCopy.beforeCopyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCopy.afterCopyCounter, 1)
+
+	mmCopy.t.Helper()
+
+	if mmCopy.inspectFuncCopy != nil {
+		mmCopy.inspectFuncCopy(ctx, edits)
+	}
+
+	mm_params := MessageEditRepositoryMockCopyParams{ctx, edits}
+
+	// Record call args
+	mmCopy.CopyMock.mutex.Lock()
+	mmCopy.CopyMock.callArgs = append(mmCopy.CopyMock.callArgs, &mm_params)
+	mmCopy.CopyMock.mutex.Unlock()
+
+	for _, e := range mmCopy.CopyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCopy.CopyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCopy.CopyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCopy.CopyMock.defaultExpectation.params
+		mm_want_ptrs := mmCopy.CopyMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageEditRepositoryMockCopyParams{ctx, edits}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCopy.t.Errorf("MessageEditRepositoryMock.Copy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCopy.CopyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.edits != nil && !minimock.Equal(*mm_want_ptrs.edits, mm_got.edits) {
+				mmCopy.t.Errorf("MessageEditRepositoryMock.Copy got unexpected parameter edits, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCopy.CopyMock.defaultExpectation.expectationOrigins.originEdits, *mm_want_ptrs.edits, mm_got.edits, minimock.Diff(*mm_want_ptrs.edits, mm_got.edits))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCopy.t.Errorf("MessageEditRepositoryMock.Copy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCopy.CopyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCopy.CopyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCopy.t.Fatal("No results are set for the MessageEditRepositoryMock.Copy")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCopy.funcCopy != nil {
+		return mmCopy.funcCopy(ctx, edits)
+	}
+	mmCopy.t.Fatalf("Unexpected call to MessageEditRepositoryMock.Copy. %v %v", ctx, edits)
+	return
+}
+
+// CopyAfterCounter returns a count of finished MessageEditRepositoryMock.Copy invocations
+func (mmCopy *MessageEditRepositoryMock) CopyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCopy.afterCopyCounter)
+}
+
+// CopyBeforeCounter returns a count of MessageEditRepositoryMock.Copy invocations
+func (mmCopy *MessageEditRepositoryMock) CopyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCopy.beforeCopyCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageEditRepositoryMock.Copy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCopy *mMessageEditRepositoryMockCopy) Calls() []*MessageEditRepositoryMockCopyParams {
+	mmCopy.mutex.RLock()
+
+	argCopy := make([]*MessageEditRepositoryMockCopyParams, len(mmCopy.callArgs))
+	copy(argCopy, mmCopy.callArgs)
+
+	mmCopy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCopyDone returns true if the count of the Copy invocations corresponds
+// the number of defined expectations
+func (m *MessageEditRepositoryMock) MinimockCopyDone() bool {
+	if m.CopyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CopyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CopyMock.invocationsDone()
+}
+
+// MinimockCopyInspect logs each unmet expectation
+func (m *MessageEditRepositoryMock) MinimockCopyInspect() {
+	for _, e := range m.CopyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageEditRepositoryMock.Copy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCopyCounter := mm_atomic.LoadUint64(&m.afterCopyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CopyMock.defaultExpectation != nil && afterCopyCounter < 1 {
+		if m.CopyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageEditRepositoryMock.Copy at\n%s", m.CopyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageEditRepositoryMock.Copy at\n%s with params: %#v", m.CopyMock.defaultExpectation.expectationOrigins.origin, *m.CopyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCopy != nil && afterCopyCounter < 1 {
+		m.t.Errorf("Expected call to MessageEditRepositoryMock.Copy at\n%s", m.funcCopyOrigin)
+	}
+
+	if !m.CopyMock.invocationsDone() && afterCopyCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageEditRepositoryMock.Copy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CopyMock.expectedInvocations), m.CopyMock.expectedInvocationsOrigin, afterCopyCounter)
+	}
+}
+
+type mMessageEditRepositoryMockCreate struct {
+	optional           bool
+	mock               *MessageEditRepositoryMock
+	defaultExpectation *MessageEditRepositoryMockCreateExpectation
+	expectations       []*MessageEditRepositoryMockCreateExpectation
+
+	callArgs []*MessageEditRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageEditRepositoryMockCreateExpectation specifies expectation struct of the MessageEditRepository.Create
+type MessageEditRepositoryMockCreateExpectation struct {
+	mock               *MessageEditRepositoryMock
+	params             *MessageEditRepositoryMockCreateParams
+	paramPtrs          *MessageEditRepositoryMockCreateParamPtrs
+	expectationOrigins MessageEditRepositoryMockCreateExpectationOrigins
+	results            *MessageEditRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageEditRepositoryMockCreateParams contains parameters of the MessageEditRepository.Create
+type MessageEditRepositoryMockCreateParams struct {
+	ctx  context.Context
+	edit *model.MessageEdit
+}
+
+// MessageEditRepositoryMockCreateParamPtrs contains pointers to parameters of the MessageEditRepository.Create
+type MessageEditRepositoryMockCreateParamPtrs struct {
+	ctx  *context.Context
+	edit **model.MessageEdit
+}
+
+// MessageEditRepositoryMockCreateResults contains results of the MessageEditRepository.Create
+type MessageEditRepositoryMockCreateResults struct {
+	err error
+}
+
+// MessageEditRepositoryMockCreateOrigins contains origins of expectations of the MessageEditRepository.Create
+type MessageEditRepositoryMockCreateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originEdit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mMessageEditRepositoryMockCreate) Optional() *mMessageEditRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for MessageEditRepository.Create
+func (mmCreate *mMessageEditRepositoryMockCreate) Expect(ctx context.Context, edit *model.MessageEdit) *mMessageEditRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("MessageEditRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &MessageEditRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("MessageEditRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &MessageEditRepositoryMockCreateParams{ctx, edit}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageEditRepository.Create
+func (mmCreate *mMessageEditRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mMessageEditRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("MessageEditRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &MessageEditRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("MessageEditRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &MessageEditRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectEditParam2 sets up expected param edit for MessageEditRepository.Create
+func (mmCreate *mMessageEditRepositoryMockCreate) ExpectEditParam2(edit *model.MessageEdit) *mMessageEditRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("MessageEditRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &MessageEditRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("MessageEditRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &MessageEditRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.edit = &edit
+	mmCreate.defaultExpectation.expectationOrigins.originEdit = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageEditRepository.Create
+func (mmCreate *mMessageEditRepositoryMockCreate) Inspect(f func(ctx context.Context, edit *model.MessageEdit)) *mMessageEditRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for MessageEditRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by MessageEditRepository.Create
+func (mmCreate *mMessageEditRepositoryMockCreate) Return(err error) *MessageEditRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("MessageEditRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &MessageEditRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &MessageEditRepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the MessageEditRepository.Create method
+func (mmCreate *mMessageEditRepositoryMockCreate) Set(f func(ctx context.Context, edit *model.MessageEdit) (err error)) *MessageEditRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the MessageEditRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the MessageEditRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the MessageEditRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mMessageEditRepositoryMockCreate) When(ctx context.Context, edit *model.MessageEdit) *MessageEditRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("MessageEditRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &MessageEditRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &MessageEditRepositoryMockCreateParams{ctx, edit},
+		expectationOrigins: MessageEditRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageEditRepository.Create return parameters for the expectation previously defined by the When method
+func (e *MessageEditRepositoryMockCreateExpectation) Then(err error) *MessageEditRepositoryMock {
+	e.results = &MessageEditRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times MessageEditRepository.Create should be invoked
+func (mmCreate *mMessageEditRepositoryMockCreate) Times(n uint64) *mMessageEditRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of MessageEditRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mMessageEditRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_repository.MessageEditRepository
+func (mmCreate *MessageEditRepositoryMock) Create(ctx context.Context, edit *model.MessageEdit) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, edit)
+	}
+
+	mm_params := MessageEditRepositoryMockCreateParams{ctx, edit}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageEditRepositoryMockCreateParams{ctx, edit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("MessageEditRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.edit != nil && !minimock.Equal(*mm_want_ptrs.edit, mm_got.edit) {
+				mmCreate.t.Errorf("MessageEditRepositoryMock.Create got unexpected parameter edit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originEdit, *mm_want_ptrs.edit, mm_got.edit, minimock.Diff(*mm_want_ptrs.edit, mm_got.edit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("MessageEditRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the MessageEditRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, edit)
+	}
+	mmCreate.t.Fatalf("Unexpected call to MessageEditRepositoryMock.Create. %v %v", ctx, edit)
+	return
+}
+
+// CreateAfterCounter returns a count of finished MessageEditRepositoryMock.Create invocations
+func (mmCreate *MessageEditRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of MessageEditRepositoryMock.Create invocations
+func (mmCreate *MessageEditRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageEditRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mMessageEditRepositoryMockCreate) Calls() []*MessageEditRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*MessageEditRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *MessageEditRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *MessageEditRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageEditRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageEditRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageEditRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to MessageEditRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageEditRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mMessageEditRepositoryMockListByMessages struct {
+	optional           bool
+	mock               *MessageEditRepositoryMock
+	defaultExpectation *MessageEditRepositoryMockListByMessagesExpectation
+	expectations       []*MessageEditRepositoryMockListByMessagesExpectation
+
+	callArgs []*MessageEditRepositoryMockListByMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageEditRepositoryMockListByMessagesExpectation specifies expectation struct of the MessageEditRepository.ListByMessages
+type MessageEditRepositoryMockListByMessagesExpectation struct {
+	mock               *MessageEditRepositoryMock
+	params             *MessageEditRepositoryMockListByMessagesParams
+	paramPtrs          *MessageEditRepositoryMockListByMessagesParamPtrs
+	expectationOrigins MessageEditRepositoryMockListByMessagesExpectationOrigins
+	results            *MessageEditRepositoryMockListByMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageEditRepositoryMockListByMessagesParams contains parameters of the MessageEditRepository.ListByMessages
+type MessageEditRepositoryMockListByMessagesParams struct {
+	ctx        context.Context
+	messageIDs []int64
+}
+
+// MessageEditRepositoryMockListByMessagesParamPtrs contains pointers to parameters of the MessageEditRepository.ListByMessages
+type MessageEditRepositoryMockListByMessagesParamPtrs struct {
+	ctx        *context.Context
+	messageIDs *[]int64
+}
+
+// MessageEditRepositoryMockListByMessagesResults contains results of the MessageEditRepository.ListByMessages
+type MessageEditRepositoryMockListByMessagesResults struct {
+	m1  map[int64][]*model.MessageEdit
+	err error
+}
+
+// MessageEditRepositoryMockListByMessagesOrigins contains origins of expectations of the MessageEditRepository.ListByMessages
+type MessageEditRepositoryMockListByMessagesExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originMessageIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListByMessages *mMessageEditRepositoryMockListByMessages) Optional() *mMessageEditRepositoryMockListByMessages {
+	mmListByMessages.optional = true
+	return mmListByMessages
+}
+
+// Expect sets up expected params for MessageEditRepository.ListByMessages
+func (mmListByMessages *mMessageEditRepositoryMockListByMessages) Expect(ctx context.Context, messageIDs []int64) *mMessageEditRepositoryMockListByMessages {
+	if mmListByMessages.mock.funcListByMessages != nil {
+		mmListByMessages.mock.t.Fatalf("MessageEditRepositoryMock.ListByMessages mock is already set by Set")
+	}
+
+	if mmListByMessages.defaultExpectation == nil {
+		mmListByMessages.defaultExpectation = &MessageEditRepositoryMockListByMessagesExpectation{}
+	}
+
+	if mmListByMessages.defaultExpectation.paramPtrs != nil {
+		mmListByMessages.mock.t.Fatalf("MessageEditRepositoryMock.ListByMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListByMessages.defaultExpectation.params = &MessageEditRepositoryMockListByMessagesParams{ctx, messageIDs}
+	mmListByMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListByMessages.expectations {
+		if minimock.Equal(e.params, mmListByMessages.defaultExpectation.params) {
+			mmListByMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListByMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListByMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageEditRepository.ListByMessages
+func (mmListByMessages *mMessageEditRepositoryMockListByMessages) ExpectCtxParam1(ctx context.Context) *mMessageEditRepositoryMockListByMessages {
+	if mmListByMessages.mock.funcListByMessages != nil {
+		mmListByMessages.mock.t.Fatalf("MessageEditRepositoryMock.ListByMessages mock is already set by Set")
+	}
+
+	if mmListByMessages.defaultExpectation == nil {
+		mmListByMessages.defaultExpectation = &MessageEditRepositoryMockListByMessagesExpectation{}
+	}
+
+	if mmListByMessages.defaultExpectation.params != nil {
+		mmListByMessages.mock.t.Fatalf("MessageEditRepositoryMock.ListByMessages mock is already set by Expect")
+	}
+
+	if mmListByMessages.defaultExpectation.paramPtrs == nil {
+		mmListByMessages.defaultExpectation.paramPtrs = &MessageEditRepositoryMockListByMessagesParamPtrs{}
+	}
+	mmListByMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListByMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListByMessages
+}
+
+// ExpectMessageIDsParam2 sets up expected param messageIDs for MessageEditRepository.ListByMessages
+func (mmListByMessages *mMessageEditRepositoryMockListByMessages) ExpectMessageIDsParam2(messageIDs []int64) *mMessageEditRepositoryMockListByMessages {
+	if mmListByMessages.mock.funcListByMessages != nil {
+		mmListByMessages.mock.t.Fatalf("MessageEditRepositoryMock.ListByMessages mock is already set by Set")
+	}
+
+	if mmListByMessages.defaultExpectation == nil {
+		mmListByMessages.defaultExpectation = &MessageEditRepositoryMockListByMessagesExpectation{}
+	}
+
+	if mmListByMessages.defaultExpectation.params != nil {
+		mmListByMessages.mock.t.Fatalf("MessageEditRepositoryMock.ListByMessages mock is already set by Expect")
+	}
+
+	if mmListByMessages.defaultExpectation.paramPtrs == nil {
+		mmListByMessages.defaultExpectation.paramPtrs = &MessageEditRepositoryMockListByMessagesParamPtrs{}
+	}
+	mmListByMessages.defaultExpectation.paramPtrs.messageIDs = &messageIDs
+	mmListByMessages.defaultExpectation.expectationOrigins.originMessageIDs = minimock.CallerInfo(1)
+
+	return mmListByMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageEditRepository.ListByMessages
+func (mmListByMessages *mMessageEditRepositoryMockListByMessages) Inspect(f func(ctx context.Context, messageIDs []int64)) *mMessageEditRepositoryMockListByMessages {
+	if mmListByMessages.mock.inspectFuncListByMessages != nil {
+		mmListByMessages.mock.t.Fatalf("Inspect function is already set for MessageEditRepositoryMock.ListByMessages")
+	}
+
+	mmListByMessages.mock.inspectFuncListByMessages = f
+
+	return mmListByMessages
+}
+
+// Return sets up results that will be returned by MessageEditRepository.ListByMessages
+func (mmListByMessages *mMessageEditRepositoryMockListByMessages) Return(m1 map[int64][]*model.MessageEdit, err error) *MessageEditRepositoryMock {
+	if mmListByMessages.mock.funcListByMessages != nil {
+		mmListByMessages.mock.t.Fatalf("MessageEditRepositoryMock.ListByMessages mock is already set by Set")
+	}
+
+	if mmListByMessages.defaultExpectation == nil {
+		mmListByMessages.defaultExpectation = &MessageEditRepositoryMockListByMessagesExpectation{mock: mmListByMessages.mock}
+	}
+	mmListByMessages.defaultExpectation.results = &MessageEditRepositoryMockListByMessagesResults{m1, err}
+	mmListByMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListByMessages.mock
+}
+
+// Set uses given function f to mock the MessageEditRepository.ListByMessages method
+func (mmListByMessages *mMessageEditRepositoryMockListByMessages) Set(f func(ctx context.Context, messageIDs []int64) (m1 map[int64][]*model.MessageEdit, err error)) *MessageEditRepositoryMock {
+	if mmListByMessages.defaultExpectation != nil {
+		mmListByMessages.mock.t.Fatalf("Default expectation is already set for the MessageEditRepository.ListByMessages method")
+	}
+
+	if len(mmListByMessages.expectations) > 0 {
+		mmListByMessages.mock.t.Fatalf("Some expectations are already set for the MessageEditRepository.ListByMessages method")
+	}
+
+	mmListByMessages.mock.funcListByMessages = f
+	mmListByMessages.mock.funcListByMessagesOrigin = minimock.CallerInfo(1)
+	return mmListByMessages.mock
+}
+
+// When sets expectation for the MessageEditRepository.ListByMessages which will trigger the result defined by the following
+// Then helper
+func (mmListByMessages *mMessageEditRepositoryMockListByMessages) When(ctx context.Context, messageIDs []int64) *MessageEditRepositoryMockListByMessagesExpectation {
+	if mmListByMessages.mock.funcListByMessages != nil {
+		mmListByMessages.mock.t.Fatalf("MessageEditRepositoryMock.ListByMessages mock is already set by Set")
+	}
+
+	expectation := &MessageEditRepositoryMockListByMessagesExpectation{
+		mock:               mmListByMessages.mock,
+		params:             &MessageEditRepositoryMockListByMessagesParams{ctx, messageIDs},
+		expectationOrigins: MessageEditRepositoryMockListByMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListByMessages.expectations = append(mmListByMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageEditRepository.ListByMessages return parameters for the expectation previously defined by the When method
+func (e *MessageEditRepositoryMockListByMessagesExpectation) Then(m1 map[int64][]*model.MessageEdit, err error) *MessageEditRepositoryMock {
+	e.results = &MessageEditRepositoryMockListByMessagesResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageEditRepository.ListByMessages should be invoked
+func (mmListByMessages *mMessageEditRepositoryMockListByMessages) Times(n uint64) *mMessageEditRepositoryMockListByMessages {
+	if n == 0 {
+		mmListByMessages.mock.t.Fatalf("Times of MessageEditRepositoryMock.ListByMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListByMessages.expectedInvocations, n)
+	mmListByMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListByMessages
+}
+
+func (mmListByMessages *mMessageEditRepositoryMockListByMessages) invocationsDone() bool {
+	if len(mmListByMessages.expectations) == 0 && mmListByMessages.defaultExpectation == nil && mmListByMessages.mock.funcListByMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListByMessages.mock.afterListByMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListByMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListByMessages implements mm_repository.MessageEditRepository
+func (mmListByMessages *MessageEditRepositoryMock) ListByMessages(ctx context.Context, messageIDs []int64) (m1 map[int64][]*model.MessageEdit, err error) {
+	mm_atomic.AddUint64(&mmListByMessages.beforeListByMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListByMessages.afterListByMessagesCounter, 1)
+
+	mmListByMessages.t.Helper()
+
+	if mmListByMessages.inspectFuncListByMessages != nil {
+		mmListByMessages.inspectFuncListByMessages(ctx, messageIDs)
+	}
+
+	mm_params := MessageEditRepositoryMockListByMessagesParams{ctx, messageIDs}
+
+	// Record call args
+	mmListByMessages.ListByMessagesMock.mutex.Lock()
+	mmListByMessages.ListByMessagesMock.callArgs = append(mmListByMessages.ListByMessagesMock.callArgs, &mm_params)
+	mmListByMessages.ListByMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListByMessages.ListByMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmListByMessages.ListByMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListByMessages.ListByMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListByMessages.ListByMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListByMessages.ListByMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageEditRepositoryMockListByMessagesParams{ctx, messageIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListByMessages.t.Errorf("MessageEditRepositoryMock.ListByMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListByMessages.ListByMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageIDs != nil && !minimock.Equal(*mm_want_ptrs.messageIDs, mm_got.messageIDs) {
+				mmListByMessages.t.Errorf("MessageEditRepositoryMock.ListByMessages got unexpected parameter messageIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListByMessages.ListByMessagesMock.defaultExpectation.expectationOrigins.originMessageIDs, *mm_want_ptrs.messageIDs, mm_got.messageIDs, minimock.Diff(*mm_want_ptrs.messageIDs, mm_got.messageIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListByMessages.t.Errorf("MessageEditRepositoryMock.ListByMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListByMessages.ListByMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListByMessages.ListByMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListByMessages.t.Fatal("No results are set for the MessageEditRepositoryMock.ListByMessages")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmListByMessages.funcListByMessages != nil {
+		return mmListByMessages.funcListByMessages(ctx, messageIDs)
+	}
+	mmListByMessages.t.Fatalf("Unexpected call to MessageEditRepositoryMock.ListByMessages. %v %v", ctx, messageIDs)
+	return
+}
+
+// ListByMessagesAfterCounter returns a count of finished MessageEditRepositoryMock.ListByMessages invocations
+func (mmListByMessages *MessageEditRepositoryMock) ListByMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListByMessages.afterListByMessagesCounter)
+}
+
+// ListByMessagesBeforeCounter returns a count of MessageEditRepositoryMock.ListByMessages invocations
+func (mmListByMessages *MessageEditRepositoryMock) ListByMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListByMessages.beforeListByMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageEditRepositoryMock.ListByMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListByMessages *mMessageEditRepositoryMockListByMessages) Calls() []*MessageEditRepositoryMockListByMessagesParams {
+	mmListByMessages.mutex.RLock()
+
+	argCopy := make([]*MessageEditRepositoryMockListByMessagesParams, len(mmListByMessages.callArgs))
+	copy(argCopy, mmListByMessages.callArgs)
+
+	mmListByMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListByMessagesDone returns true if the count of the ListByMessages invocations corresponds
+// the number of defined expectations
+func (m *MessageEditRepositoryMock) MinimockListByMessagesDone() bool {
+	if m.ListByMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListByMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListByMessagesMock.invocationsDone()
+}
+
+// MinimockListByMessagesInspect logs each unmet expectation
+func (m *MessageEditRepositoryMock) MinimockListByMessagesInspect() {
+	for _, e := range m.ListByMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageEditRepositoryMock.ListByMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListByMessagesCounter := mm_atomic.LoadUint64(&m.afterListByMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListByMessagesMock.defaultExpectation != nil && afterListByMessagesCounter < 1 {
+		if m.ListByMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageEditRepositoryMock.ListByMessages at\n%s", m.ListByMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageEditRepositoryMock.ListByMessages at\n%s with params: %#v", m.ListByMessagesMock.defaultExpectation.expectationOrigins.origin, *m.ListByMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListByMessages != nil && afterListByMessagesCounter < 1 {
+		m.t.Errorf("Expected call to MessageEditRepositoryMock.ListByMessages at\n%s", m.funcListByMessagesOrigin)
+	}
+
+	if !m.ListByMessagesMock.invocationsDone() && afterListByMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageEditRepositoryMock.ListByMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListByMessagesMock.expectedInvocations), m.ListByMessagesMock.expectedInvocationsOrigin, afterListByMessagesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *MessageEditRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCopyInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockListByMessagesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *MessageEditRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *MessageEditRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCopyDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockListByMessagesDone()
+}
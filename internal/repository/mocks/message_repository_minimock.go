@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/Mobo140/chat/internal/model"
@@ -19,28 +20,4397 @@ type MessageRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcSendMessage          func(ctx context.Context, message *model.SendMessage) (err error)
+	funcCopy          func(ctx context.Context, chatID int64, messages []*model.Message) (i1 int64, err error)
+	funcCopyOrigin    string
+	inspectFuncCopy   func(ctx context.Context, chatID int64, messages []*model.Message)
+	afterCopyCounter  uint64
+	beforeCopyCounter uint64
+	CopyMock          mMessageRepositoryMockCopy
+
+	funcCountPurgeable          func(ctx context.Context, purge *model.MessagePurge) (i1 int64, err error)
+	funcCountPurgeableOrigin    string
+	inspectFuncCountPurgeable   func(ctx context.Context, purge *model.MessagePurge)
+	afterCountPurgeableCounter  uint64
+	beforeCountPurgeableCounter uint64
+	CountPurgeableMock          mMessageRepositoryMockCountPurgeable
+
+	funcDelete          func(ctx context.Context, id int64, deletedAt time.Time) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, id int64, deletedAt time.Time)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mMessageRepositoryMockDelete
+
+	funcGet          func(ctx context.Context, id int64) (cp1 *model.ChatMessage, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, id int64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mMessageRepositoryMockGet
+
+	funcList          func(ctx context.Context, page *model.MessagesPage) (mpa1 []*model.Message, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context, page *model.MessagesPage)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mMessageRepositoryMockList
+
+	funcListAfter          func(ctx context.Context, chatID int64, afterID int64, limit uint64) (mpa1 []*model.Message, err error)
+	funcListAfterOrigin    string
+	inspectFuncListAfter   func(ctx context.Context, chatID int64, afterID int64, limit uint64)
+	afterListAfterCounter  uint64
+	beforeListAfterCounter uint64
+	ListAfterMock          mMessageRepositoryMockListAfter
+
+	funcListByIDs          func(ctx context.Context, ids []int64) (mpa1 []*model.Message, err error)
+	funcListByIDsOrigin    string
+	inspectFuncListByIDs   func(ctx context.Context, ids []int64)
+	afterListByIDsCounter  uint64
+	beforeListByIDsCounter uint64
+	ListByIDsMock          mMessageRepositoryMockListByIDs
+
+	funcListReplies          func(ctx context.Context, page *model.ThreadPage) (mpa1 []*model.Message, err error)
+	funcListRepliesOrigin    string
+	inspectFuncListReplies   func(ctx context.Context, page *model.ThreadPage)
+	afterListRepliesCounter  uint64
+	beforeListRepliesCounter uint64
+	ListRepliesMock          mMessageRepositoryMockListReplies
+
+	funcNextIDs          func(ctx context.Context, n int) (ia1 []int64, err error)
+	funcNextIDsOrigin    string
+	inspectFuncNextIDs   func(ctx context.Context, n int)
+	afterNextIDsCounter  uint64
+	beforeNextIDsCounter uint64
+	NextIDsMock          mMessageRepositoryMockNextIDs
+
+	funcPurge          func(ctx context.Context, purge *model.MessagePurge) (i1 int64, err error)
+	funcPurgeOrigin    string
+	inspectFuncPurge   func(ctx context.Context, purge *model.MessagePurge)
+	afterPurgeCounter  uint64
+	beforePurgeCounter uint64
+	PurgeMock          mMessageRepositoryMockPurge
+
+	funcReplyCounts          func(ctx context.Context, ids []int64) (m1 map[int64]int64, err error)
+	funcReplyCountsOrigin    string
+	inspectFuncReplyCounts   func(ctx context.Context, ids []int64)
+	afterReplyCountsCounter  uint64
+	beforeReplyCountsCounter uint64
+	ReplyCountsMock          mMessageRepositoryMockReplyCounts
+
+	funcSearch          func(ctx context.Context, query *model.SearchQuery) (spa1 []*model.SearchResult, err error)
+	funcSearchOrigin    string
+	inspectFuncSearch   func(ctx context.Context, query *model.SearchQuery)
+	afterSearchCounter  uint64
+	beforeSearchCounter uint64
+	SearchMock          mMessageRepositoryMockSearch
+
+	funcSendMessage          func(ctx context.Context, message *model.SendMessage) (i1 int64, err error)
 	funcSendMessageOrigin    string
 	inspectFuncSendMessage   func(ctx context.Context, message *model.SendMessage)
 	afterSendMessageCounter  uint64
 	beforeSendMessageCounter uint64
 	SendMessageMock          mMessageRepositoryMockSendMessage
+
+	funcUpdate          func(ctx context.Context, id int64, text string, editedAt time.Time) (err error)
+	funcUpdateOrigin    string
+	inspectFuncUpdate   func(ctx context.Context, id int64, text string, editedAt time.Time)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mMessageRepositoryMockUpdate
+}
+
+// NewMessageRepositoryMock returns a mock for mm_repository.MessageRepository
+func NewMessageRepositoryMock(t minimock.Tester) *MessageRepositoryMock {
+	m := &MessageRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CopyMock = mMessageRepositoryMockCopy{mock: m}
+	m.CopyMock.callArgs = []*MessageRepositoryMockCopyParams{}
+
+	m.CountPurgeableMock = mMessageRepositoryMockCountPurgeable{mock: m}
+	m.CountPurgeableMock.callArgs = []*MessageRepositoryMockCountPurgeableParams{}
+
+	m.DeleteMock = mMessageRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*MessageRepositoryMockDeleteParams{}
+
+	m.GetMock = mMessageRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*MessageRepositoryMockGetParams{}
+
+	m.ListMock = mMessageRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*MessageRepositoryMockListParams{}
+
+	m.ListAfterMock = mMessageRepositoryMockListAfter{mock: m}
+	m.ListAfterMock.callArgs = []*MessageRepositoryMockListAfterParams{}
+
+	m.ListByIDsMock = mMessageRepositoryMockListByIDs{mock: m}
+	m.ListByIDsMock.callArgs = []*MessageRepositoryMockListByIDsParams{}
+
+	m.ListRepliesMock = mMessageRepositoryMockListReplies{mock: m}
+	m.ListRepliesMock.callArgs = []*MessageRepositoryMockListRepliesParams{}
+
+	m.NextIDsMock = mMessageRepositoryMockNextIDs{mock: m}
+	m.NextIDsMock.callArgs = []*MessageRepositoryMockNextIDsParams{}
+
+	m.PurgeMock = mMessageRepositoryMockPurge{mock: m}
+	m.PurgeMock.callArgs = []*MessageRepositoryMockPurgeParams{}
+
+	m.ReplyCountsMock = mMessageRepositoryMockReplyCounts{mock: m}
+	m.ReplyCountsMock.callArgs = []*MessageRepositoryMockReplyCountsParams{}
+
+	m.SearchMock = mMessageRepositoryMockSearch{mock: m}
+	m.SearchMock.callArgs = []*MessageRepositoryMockSearchParams{}
+
+	m.SendMessageMock = mMessageRepositoryMockSendMessage{mock: m}
+	m.SendMessageMock.callArgs = []*MessageRepositoryMockSendMessageParams{}
+
+	m.UpdateMock = mMessageRepositoryMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*MessageRepositoryMockUpdateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mMessageRepositoryMockCopy struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockCopyExpectation
+	expectations       []*MessageRepositoryMockCopyExpectation
+
+	callArgs []*MessageRepositoryMockCopyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockCopyExpectation specifies expectation struct of the MessageRepository.Copy
+type MessageRepositoryMockCopyExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockCopyParams
+	paramPtrs          *MessageRepositoryMockCopyParamPtrs
+	expectationOrigins MessageRepositoryMockCopyExpectationOrigins
+	results            *MessageRepositoryMockCopyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockCopyParams contains parameters of the MessageRepository.Copy
+type MessageRepositoryMockCopyParams struct {
+	ctx      context.Context
+	chatID   int64
+	messages []*model.Message
+}
+
+// MessageRepositoryMockCopyParamPtrs contains pointers to parameters of the MessageRepository.Copy
+type MessageRepositoryMockCopyParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	messages *[]*model.Message
+}
+
+// MessageRepositoryMockCopyResults contains results of the MessageRepository.Copy
+type MessageRepositoryMockCopyResults struct {
+	i1  int64
+	err error
+}
+
+// MessageRepositoryMockCopyOrigins contains origins of expectations of the MessageRepository.Copy
+type MessageRepositoryMockCopyExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originMessages string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCopy *mMessageRepositoryMockCopy) Optional() *mMessageRepositoryMockCopy {
+	mmCopy.optional = true
+	return mmCopy
+}
+
+// Expect sets up expected params for MessageRepository.Copy
+func (mmCopy *mMessageRepositoryMockCopy) Expect(ctx context.Context, chatID int64, messages []*model.Message) *mMessageRepositoryMockCopy {
+	if mmCopy.mock.funcCopy != nil {
+		mmCopy.mock.t.Fatalf("MessageRepositoryMock.Copy mock is already set by Set")
+	}
+
+	if mmCopy.defaultExpectation == nil {
+		mmCopy.defaultExpectation = &MessageRepositoryMockCopyExpectation{}
+	}
+
+	if mmCopy.defaultExpectation.paramPtrs != nil {
+		mmCopy.mock.t.Fatalf("MessageRepositoryMock.Copy mock is already set by ExpectParams functions")
+	}
+
+	mmCopy.defaultExpectation.params = &MessageRepositoryMockCopyParams{ctx, chatID, messages}
+	mmCopy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCopy.expectations {
+		if minimock.Equal(e.params, mmCopy.defaultExpectation.params) {
+			mmCopy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCopy.defaultExpectation.params)
+		}
+	}
+
+	return mmCopy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.Copy
+func (mmCopy *mMessageRepositoryMockCopy) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockCopy {
+	if mmCopy.mock.funcCopy != nil {
+		mmCopy.mock.t.Fatalf("MessageRepositoryMock.Copy mock is already set by Set")
+	}
+
+	if mmCopy.defaultExpectation == nil {
+		mmCopy.defaultExpectation = &MessageRepositoryMockCopyExpectation{}
+	}
+
+	if mmCopy.defaultExpectation.params != nil {
+		mmCopy.mock.t.Fatalf("MessageRepositoryMock.Copy mock is already set by Expect")
+	}
+
+	if mmCopy.defaultExpectation.paramPtrs == nil {
+		mmCopy.defaultExpectation.paramPtrs = &MessageRepositoryMockCopyParamPtrs{}
+	}
+	mmCopy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCopy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCopy
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for MessageRepository.Copy
+func (mmCopy *mMessageRepositoryMockCopy) ExpectChatIDParam2(chatID int64) *mMessageRepositoryMockCopy {
+	if mmCopy.mock.funcCopy != nil {
+		mmCopy.mock.t.Fatalf("MessageRepositoryMock.Copy mock is already set by Set")
+	}
+
+	if mmCopy.defaultExpectation == nil {
+		mmCopy.defaultExpectation = &MessageRepositoryMockCopyExpectation{}
+	}
+
+	if mmCopy.defaultExpectation.params != nil {
+		mmCopy.mock.t.Fatalf("MessageRepositoryMock.Copy mock is already set by Expect")
+	}
+
+	if mmCopy.defaultExpectation.paramPtrs == nil {
+		mmCopy.defaultExpectation.paramPtrs = &MessageRepositoryMockCopyParamPtrs{}
+	}
+	mmCopy.defaultExpectation.paramPtrs.chatID = &chatID
+	mmCopy.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmCopy
+}
+
+// ExpectMessagesParam3 sets up expected param messages for MessageRepository.Copy
+func (mmCopy *mMessageRepositoryMockCopy) ExpectMessagesParam3(messages []*model.Message) *mMessageRepositoryMockCopy {
+	if mmCopy.mock.funcCopy != nil {
+		mmCopy.mock.t.Fatalf("MessageRepositoryMock.Copy mock is already set by Set")
+	}
+
+	if mmCopy.defaultExpectation == nil {
+		mmCopy.defaultExpectation = &MessageRepositoryMockCopyExpectation{}
+	}
+
+	if mmCopy.defaultExpectation.params != nil {
+		mmCopy.mock.t.Fatalf("MessageRepositoryMock.Copy mock is already set by Expect")
+	}
+
+	if mmCopy.defaultExpectation.paramPtrs == nil {
+		mmCopy.defaultExpectation.paramPtrs = &MessageRepositoryMockCopyParamPtrs{}
+	}
+	mmCopy.defaultExpectation.paramPtrs.messages = &messages
+	mmCopy.defaultExpectation.expectationOrigins.originMessages = minimock.CallerInfo(1)
+
+	return mmCopy
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.Copy
+func (mmCopy *mMessageRepositoryMockCopy) Inspect(f func(ctx context.Context, chatID int64, messages []*model.Message)) *mMessageRepositoryMockCopy {
+	if mmCopy.mock.inspectFuncCopy != nil {
+		mmCopy.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.Copy")
+	}
+
+	mmCopy.mock.inspectFuncCopy = f
+
+	return mmCopy
+}
+
+// Return sets up results that will be returned by MessageRepository.Copy
+func (mmCopy *mMessageRepositoryMockCopy) Return(i1 int64, err error) *MessageRepositoryMock {
+	if mmCopy.mock.funcCopy != nil {
+		mmCopy.mock.t.Fatalf("MessageRepositoryMock.Copy mock is already set by Set")
+	}
+
+	if mmCopy.defaultExpectation == nil {
+		mmCopy.defaultExpectation = &MessageRepositoryMockCopyExpectation{mock: mmCopy.mock}
+	}
+	mmCopy.defaultExpectation.results = &MessageRepositoryMockCopyResults{i1, err}
+	mmCopy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCopy.mock
+}
+
+// Set uses given function f to mock the MessageRepository.Copy method
+func (mmCopy *mMessageRepositoryMockCopy) Set(f func(ctx context.Context, chatID int64, messages []*model.Message) (i1 int64, err error)) *MessageRepositoryMock {
+	if mmCopy.defaultExpectation != nil {
+		mmCopy.mock.t.Fatalf("Default expectation is already set for the MessageRepository.Copy method")
+	}
+
+	if len(mmCopy.expectations) > 0 {
+		mmCopy.mock.t.Fatalf("Some expectations are already set for the MessageRepository.Copy method")
+	}
+
+	mmCopy.mock.funcCopy = f
+	mmCopy.mock.funcCopyOrigin = minimock.CallerInfo(1)
+	return mmCopy.mock
+}
+
+// When sets expectation for the MessageRepository.Copy which will trigger the result defined by the following
+// Then helper
+func (mmCopy *mMessageRepositoryMockCopy) When(ctx context.Context, chatID int64, messages []*model.Message) *MessageRepositoryMockCopyExpectation {
+	if mmCopy.mock.funcCopy != nil {
+		mmCopy.mock.t.Fatalf("MessageRepositoryMock.Copy mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockCopyExpectation{
+		mock:               mmCopy.mock,
+		params:             &MessageRepositoryMockCopyParams{ctx, chatID, messages},
+		expectationOrigins: MessageRepositoryMockCopyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCopy.expectations = append(mmCopy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.Copy return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockCopyExpectation) Then(i1 int64, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockCopyResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.Copy should be invoked
+func (mmCopy *mMessageRepositoryMockCopy) Times(n uint64) *mMessageRepositoryMockCopy {
+	if n == 0 {
+		mmCopy.mock.t.Fatalf("Times of MessageRepositoryMock.Copy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCopy.expectedInvocations, n)
+	mmCopy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCopy
+}
+
+func (mmCopy *mMessageRepositoryMockCopy) invocationsDone() bool {
+	if len(mmCopy.expectations) == 0 && mmCopy.defaultExpectation == nil && mmCopy.mock.funcCopy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCopy.mock.afterCopyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCopy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Copy implements mm_repository.MessageRepository
+func (mmCopy *MessageRepositoryMock) Copy(ctx context.Context, chatID int64, messages []*model.Message) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCopy.beforeCopyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCopy.afterCopyCounter, 1)
+
+	mmCopy.t.Helper()
+
+	if mmCopy.inspectFuncCopy != nil {
+		mmCopy.inspectFuncCopy(ctx, chatID, messages)
+	}
+
+	mm_params := MessageRepositoryMockCopyParams{ctx, chatID, messages}
+
+	// Record call args
+	mmCopy.CopyMock.mutex.Lock()
+	mmCopy.CopyMock.callArgs = append(mmCopy.CopyMock.callArgs, &mm_params)
+	mmCopy.CopyMock.mutex.Unlock()
+
+	for _, e := range mmCopy.CopyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCopy.CopyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCopy.CopyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCopy.CopyMock.defaultExpectation.params
+		mm_want_ptrs := mmCopy.CopyMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockCopyParams{ctx, chatID, messages}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCopy.t.Errorf("MessageRepositoryMock.Copy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCopy.CopyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmCopy.t.Errorf("MessageRepositoryMock.Copy got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCopy.CopyMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messages != nil && !minimock.Equal(*mm_want_ptrs.messages, mm_got.messages) {
+				mmCopy.t.Errorf("MessageRepositoryMock.Copy got unexpected parameter messages, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCopy.CopyMock.defaultExpectation.expectationOrigins.originMessages, *mm_want_ptrs.messages, mm_got.messages, minimock.Diff(*mm_want_ptrs.messages, mm_got.messages))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCopy.t.Errorf("MessageRepositoryMock.Copy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCopy.CopyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCopy.CopyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCopy.t.Fatal("No results are set for the MessageRepositoryMock.Copy")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCopy.funcCopy != nil {
+		return mmCopy.funcCopy(ctx, chatID, messages)
+	}
+	mmCopy.t.Fatalf("Unexpected call to MessageRepositoryMock.Copy. %v %v %v", ctx, chatID, messages)
+	return
+}
+
+// CopyAfterCounter returns a count of finished MessageRepositoryMock.Copy invocations
+func (mmCopy *MessageRepositoryMock) CopyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCopy.afterCopyCounter)
+}
+
+// CopyBeforeCounter returns a count of MessageRepositoryMock.Copy invocations
+func (mmCopy *MessageRepositoryMock) CopyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCopy.beforeCopyCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.Copy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCopy *mMessageRepositoryMockCopy) Calls() []*MessageRepositoryMockCopyParams {
+	mmCopy.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockCopyParams, len(mmCopy.callArgs))
+	copy(argCopy, mmCopy.callArgs)
+
+	mmCopy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCopyDone returns true if the count of the Copy invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockCopyDone() bool {
+	if m.CopyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CopyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CopyMock.invocationsDone()
+}
+
+// MinimockCopyInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockCopyInspect() {
+	for _, e := range m.CopyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Copy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCopyCounter := mm_atomic.LoadUint64(&m.afterCopyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CopyMock.defaultExpectation != nil && afterCopyCounter < 1 {
+		if m.CopyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Copy at\n%s", m.CopyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Copy at\n%s with params: %#v", m.CopyMock.defaultExpectation.expectationOrigins.origin, *m.CopyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCopy != nil && afterCopyCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.Copy at\n%s", m.funcCopyOrigin)
+	}
+
+	if !m.CopyMock.invocationsDone() && afterCopyCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.Copy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CopyMock.expectedInvocations), m.CopyMock.expectedInvocationsOrigin, afterCopyCounter)
+	}
+}
+
+type mMessageRepositoryMockCountPurgeable struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockCountPurgeableExpectation
+	expectations       []*MessageRepositoryMockCountPurgeableExpectation
+
+	callArgs []*MessageRepositoryMockCountPurgeableParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockCountPurgeableExpectation specifies expectation struct of the MessageRepository.CountPurgeable
+type MessageRepositoryMockCountPurgeableExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockCountPurgeableParams
+	paramPtrs          *MessageRepositoryMockCountPurgeableParamPtrs
+	expectationOrigins MessageRepositoryMockCountPurgeableExpectationOrigins
+	results            *MessageRepositoryMockCountPurgeableResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockCountPurgeableParams contains parameters of the MessageRepository.CountPurgeable
+type MessageRepositoryMockCountPurgeableParams struct {
+	ctx   context.Context
+	purge *model.MessagePurge
+}
+
+// MessageRepositoryMockCountPurgeableParamPtrs contains pointers to parameters of the MessageRepository.CountPurgeable
+type MessageRepositoryMockCountPurgeableParamPtrs struct {
+	ctx   *context.Context
+	purge **model.MessagePurge
+}
+
+// MessageRepositoryMockCountPurgeableResults contains results of the MessageRepository.CountPurgeable
+type MessageRepositoryMockCountPurgeableResults struct {
+	i1  int64
+	err error
+}
+
+// MessageRepositoryMockCountPurgeableOrigins contains origins of expectations of the MessageRepository.CountPurgeable
+type MessageRepositoryMockCountPurgeableExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originPurge string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountPurgeable *mMessageRepositoryMockCountPurgeable) Optional() *mMessageRepositoryMockCountPurgeable {
+	mmCountPurgeable.optional = true
+	return mmCountPurgeable
+}
+
+// Expect sets up expected params for MessageRepository.CountPurgeable
+func (mmCountPurgeable *mMessageRepositoryMockCountPurgeable) Expect(ctx context.Context, purge *model.MessagePurge) *mMessageRepositoryMockCountPurgeable {
+	if mmCountPurgeable.mock.funcCountPurgeable != nil {
+		mmCountPurgeable.mock.t.Fatalf("MessageRepositoryMock.CountPurgeable mock is already set by Set")
+	}
+
+	if mmCountPurgeable.defaultExpectation == nil {
+		mmCountPurgeable.defaultExpectation = &MessageRepositoryMockCountPurgeableExpectation{}
+	}
+
+	if mmCountPurgeable.defaultExpectation.paramPtrs != nil {
+		mmCountPurgeable.mock.t.Fatalf("MessageRepositoryMock.CountPurgeable mock is already set by ExpectParams functions")
+	}
+
+	mmCountPurgeable.defaultExpectation.params = &MessageRepositoryMockCountPurgeableParams{ctx, purge}
+	mmCountPurgeable.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountPurgeable.expectations {
+		if minimock.Equal(e.params, mmCountPurgeable.defaultExpectation.params) {
+			mmCountPurgeable.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountPurgeable.defaultExpectation.params)
+		}
+	}
+
+	return mmCountPurgeable
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.CountPurgeable
+func (mmCountPurgeable *mMessageRepositoryMockCountPurgeable) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockCountPurgeable {
+	if mmCountPurgeable.mock.funcCountPurgeable != nil {
+		mmCountPurgeable.mock.t.Fatalf("MessageRepositoryMock.CountPurgeable mock is already set by Set")
+	}
+
+	if mmCountPurgeable.defaultExpectation == nil {
+		mmCountPurgeable.defaultExpectation = &MessageRepositoryMockCountPurgeableExpectation{}
+	}
+
+	if mmCountPurgeable.defaultExpectation.params != nil {
+		mmCountPurgeable.mock.t.Fatalf("MessageRepositoryMock.CountPurgeable mock is already set by Expect")
+	}
+
+	if mmCountPurgeable.defaultExpectation.paramPtrs == nil {
+		mmCountPurgeable.defaultExpectation.paramPtrs = &MessageRepositoryMockCountPurgeableParamPtrs{}
+	}
+	mmCountPurgeable.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountPurgeable.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountPurgeable
+}
+
+// ExpectPurgeParam2 sets up expected param purge for MessageRepository.CountPurgeable
+func (mmCountPurgeable *mMessageRepositoryMockCountPurgeable) ExpectPurgeParam2(purge *model.MessagePurge) *mMessageRepositoryMockCountPurgeable {
+	if mmCountPurgeable.mock.funcCountPurgeable != nil {
+		mmCountPurgeable.mock.t.Fatalf("MessageRepositoryMock.CountPurgeable mock is already set by Set")
+	}
+
+	if mmCountPurgeable.defaultExpectation == nil {
+		mmCountPurgeable.defaultExpectation = &MessageRepositoryMockCountPurgeableExpectation{}
+	}
+
+	if mmCountPurgeable.defaultExpectation.params != nil {
+		mmCountPurgeable.mock.t.Fatalf("MessageRepositoryMock.CountPurgeable mock is already set by Expect")
+	}
+
+	if mmCountPurgeable.defaultExpectation.paramPtrs == nil {
+		mmCountPurgeable.defaultExpectation.paramPtrs = &MessageRepositoryMockCountPurgeableParamPtrs{}
+	}
+	mmCountPurgeable.defaultExpectation.paramPtrs.purge = &purge
+	mmCountPurgeable.defaultExpectation.expectationOrigins.originPurge = minimock.CallerInfo(1)
+
+	return mmCountPurgeable
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.CountPurgeable
+func (mmCountPurgeable *mMessageRepositoryMockCountPurgeable) Inspect(f func(ctx context.Context, purge *model.MessagePurge)) *mMessageRepositoryMockCountPurgeable {
+	if mmCountPurgeable.mock.inspectFuncCountPurgeable != nil {
+		mmCountPurgeable.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.CountPurgeable")
+	}
+
+	mmCountPurgeable.mock.inspectFuncCountPurgeable = f
+
+	return mmCountPurgeable
+}
+
+// Return sets up results that will be returned by MessageRepository.CountPurgeable
+func (mmCountPurgeable *mMessageRepositoryMockCountPurgeable) Return(i1 int64, err error) *MessageRepositoryMock {
+	if mmCountPurgeable.mock.funcCountPurgeable != nil {
+		mmCountPurgeable.mock.t.Fatalf("MessageRepositoryMock.CountPurgeable mock is already set by Set")
+	}
+
+	if mmCountPurgeable.defaultExpectation == nil {
+		mmCountPurgeable.defaultExpectation = &MessageRepositoryMockCountPurgeableExpectation{mock: mmCountPurgeable.mock}
+	}
+	mmCountPurgeable.defaultExpectation.results = &MessageRepositoryMockCountPurgeableResults{i1, err}
+	mmCountPurgeable.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountPurgeable.mock
+}
+
+// Set uses given function f to mock the MessageRepository.CountPurgeable method
+func (mmCountPurgeable *mMessageRepositoryMockCountPurgeable) Set(f func(ctx context.Context, purge *model.MessagePurge) (i1 int64, err error)) *MessageRepositoryMock {
+	if mmCountPurgeable.defaultExpectation != nil {
+		mmCountPurgeable.mock.t.Fatalf("Default expectation is already set for the MessageRepository.CountPurgeable method")
+	}
+
+	if len(mmCountPurgeable.expectations) > 0 {
+		mmCountPurgeable.mock.t.Fatalf("Some expectations are already set for the MessageRepository.CountPurgeable method")
+	}
+
+	mmCountPurgeable.mock.funcCountPurgeable = f
+	mmCountPurgeable.mock.funcCountPurgeableOrigin = minimock.CallerInfo(1)
+	return mmCountPurgeable.mock
+}
+
+// When sets expectation for the MessageRepository.CountPurgeable which will trigger the result defined by the following
+// Then helper
+func (mmCountPurgeable *mMessageRepositoryMockCountPurgeable) When(ctx context.Context, purge *model.MessagePurge) *MessageRepositoryMockCountPurgeableExpectation {
+	if mmCountPurgeable.mock.funcCountPurgeable != nil {
+		mmCountPurgeable.mock.t.Fatalf("MessageRepositoryMock.CountPurgeable mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockCountPurgeableExpectation{
+		mock:               mmCountPurgeable.mock,
+		params:             &MessageRepositoryMockCountPurgeableParams{ctx, purge},
+		expectationOrigins: MessageRepositoryMockCountPurgeableExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountPurgeable.expectations = append(mmCountPurgeable.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.CountPurgeable return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockCountPurgeableExpectation) Then(i1 int64, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockCountPurgeableResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.CountPurgeable should be invoked
+func (mmCountPurgeable *mMessageRepositoryMockCountPurgeable) Times(n uint64) *mMessageRepositoryMockCountPurgeable {
+	if n == 0 {
+		mmCountPurgeable.mock.t.Fatalf("Times of MessageRepositoryMock.CountPurgeable mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountPurgeable.expectedInvocations, n)
+	mmCountPurgeable.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountPurgeable
+}
+
+func (mmCountPurgeable *mMessageRepositoryMockCountPurgeable) invocationsDone() bool {
+	if len(mmCountPurgeable.expectations) == 0 && mmCountPurgeable.defaultExpectation == nil && mmCountPurgeable.mock.funcCountPurgeable == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountPurgeable.mock.afterCountPurgeableCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountPurgeable.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountPurgeable implements mm_repository.MessageRepository
+func (mmCountPurgeable *MessageRepositoryMock) CountPurgeable(ctx context.Context, purge *model.MessagePurge) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCountPurgeable.beforeCountPurgeableCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountPurgeable.afterCountPurgeableCounter, 1)
+
+	mmCountPurgeable.t.Helper()
+
+	if mmCountPurgeable.inspectFuncCountPurgeable != nil {
+		mmCountPurgeable.inspectFuncCountPurgeable(ctx, purge)
+	}
+
+	mm_params := MessageRepositoryMockCountPurgeableParams{ctx, purge}
+
+	// Record call args
+	mmCountPurgeable.CountPurgeableMock.mutex.Lock()
+	mmCountPurgeable.CountPurgeableMock.callArgs = append(mmCountPurgeable.CountPurgeableMock.callArgs, &mm_params)
+	mmCountPurgeable.CountPurgeableMock.mutex.Unlock()
+
+	for _, e := range mmCountPurgeable.CountPurgeableMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCountPurgeable.CountPurgeableMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountPurgeable.CountPurgeableMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountPurgeable.CountPurgeableMock.defaultExpectation.params
+		mm_want_ptrs := mmCountPurgeable.CountPurgeableMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockCountPurgeableParams{ctx, purge}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountPurgeable.t.Errorf("MessageRepositoryMock.CountPurgeable got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountPurgeable.CountPurgeableMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.purge != nil && !minimock.Equal(*mm_want_ptrs.purge, mm_got.purge) {
+				mmCountPurgeable.t.Errorf("MessageRepositoryMock.CountPurgeable got unexpected parameter purge, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountPurgeable.CountPurgeableMock.defaultExpectation.expectationOrigins.originPurge, *mm_want_ptrs.purge, mm_got.purge, minimock.Diff(*mm_want_ptrs.purge, mm_got.purge))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountPurgeable.t.Errorf("MessageRepositoryMock.CountPurgeable got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountPurgeable.CountPurgeableMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountPurgeable.CountPurgeableMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountPurgeable.t.Fatal("No results are set for the MessageRepositoryMock.CountPurgeable")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCountPurgeable.funcCountPurgeable != nil {
+		return mmCountPurgeable.funcCountPurgeable(ctx, purge)
+	}
+	mmCountPurgeable.t.Fatalf("Unexpected call to MessageRepositoryMock.CountPurgeable. %v %v", ctx, purge)
+	return
+}
+
+// CountPurgeableAfterCounter returns a count of finished MessageRepositoryMock.CountPurgeable invocations
+func (mmCountPurgeable *MessageRepositoryMock) CountPurgeableAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountPurgeable.afterCountPurgeableCounter)
+}
+
+// CountPurgeableBeforeCounter returns a count of MessageRepositoryMock.CountPurgeable invocations
+func (mmCountPurgeable *MessageRepositoryMock) CountPurgeableBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountPurgeable.beforeCountPurgeableCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.CountPurgeable.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountPurgeable *mMessageRepositoryMockCountPurgeable) Calls() []*MessageRepositoryMockCountPurgeableParams {
+	mmCountPurgeable.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockCountPurgeableParams, len(mmCountPurgeable.callArgs))
+	copy(argCopy, mmCountPurgeable.callArgs)
+
+	mmCountPurgeable.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountPurgeableDone returns true if the count of the CountPurgeable invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockCountPurgeableDone() bool {
+	if m.CountPurgeableMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountPurgeableMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountPurgeableMock.invocationsDone()
+}
+
+// MinimockCountPurgeableInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockCountPurgeableInspect() {
+	for _, e := range m.CountPurgeableMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.CountPurgeable at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountPurgeableCounter := mm_atomic.LoadUint64(&m.afterCountPurgeableCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountPurgeableMock.defaultExpectation != nil && afterCountPurgeableCounter < 1 {
+		if m.CountPurgeableMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.CountPurgeable at\n%s", m.CountPurgeableMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.CountPurgeable at\n%s with params: %#v", m.CountPurgeableMock.defaultExpectation.expectationOrigins.origin, *m.CountPurgeableMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountPurgeable != nil && afterCountPurgeableCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.CountPurgeable at\n%s", m.funcCountPurgeableOrigin)
+	}
+
+	if !m.CountPurgeableMock.invocationsDone() && afterCountPurgeableCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.CountPurgeable at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountPurgeableMock.expectedInvocations), m.CountPurgeableMock.expectedInvocationsOrigin, afterCountPurgeableCounter)
+	}
+}
+
+type mMessageRepositoryMockDelete struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockDeleteExpectation
+	expectations       []*MessageRepositoryMockDeleteExpectation
+
+	callArgs []*MessageRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockDeleteExpectation specifies expectation struct of the MessageRepository.Delete
+type MessageRepositoryMockDeleteExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockDeleteParams
+	paramPtrs          *MessageRepositoryMockDeleteParamPtrs
+	expectationOrigins MessageRepositoryMockDeleteExpectationOrigins
+	results            *MessageRepositoryMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockDeleteParams contains parameters of the MessageRepository.Delete
+type MessageRepositoryMockDeleteParams struct {
+	ctx       context.Context
+	id        int64
+	deletedAt time.Time
+}
+
+// MessageRepositoryMockDeleteParamPtrs contains pointers to parameters of the MessageRepository.Delete
+type MessageRepositoryMockDeleteParamPtrs struct {
+	ctx       *context.Context
+	id        *int64
+	deletedAt *time.Time
+}
+
+// MessageRepositoryMockDeleteResults contains results of the MessageRepository.Delete
+type MessageRepositoryMockDeleteResults struct {
+	err error
+}
+
+// MessageRepositoryMockDeleteOrigins contains origins of expectations of the MessageRepository.Delete
+type MessageRepositoryMockDeleteExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originId        string
+	originDeletedAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mMessageRepositoryMockDelete) Optional() *mMessageRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for MessageRepository.Delete
+func (mmDelete *mMessageRepositoryMockDelete) Expect(ctx context.Context, id int64, deletedAt time.Time) *mMessageRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &MessageRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &MessageRepositoryMockDeleteParams{ctx, id, deletedAt}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.Delete
+func (mmDelete *mMessageRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &MessageRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &MessageRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for MessageRepository.Delete
+func (mmDelete *mMessageRepositoryMockDelete) ExpectIdParam2(id int64) *mMessageRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &MessageRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &MessageRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectDeletedAtParam3 sets up expected param deletedAt for MessageRepository.Delete
+func (mmDelete *mMessageRepositoryMockDelete) ExpectDeletedAtParam3(deletedAt time.Time) *mMessageRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &MessageRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &MessageRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.deletedAt = &deletedAt
+	mmDelete.defaultExpectation.expectationOrigins.originDeletedAt = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.Delete
+func (mmDelete *mMessageRepositoryMockDelete) Inspect(f func(ctx context.Context, id int64, deletedAt time.Time)) *mMessageRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by MessageRepository.Delete
+func (mmDelete *mMessageRepositoryMockDelete) Return(err error) *MessageRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &MessageRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &MessageRepositoryMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the MessageRepository.Delete method
+func (mmDelete *mMessageRepositoryMockDelete) Set(f func(ctx context.Context, id int64, deletedAt time.Time) (err error)) *MessageRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the MessageRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the MessageRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the MessageRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mMessageRepositoryMockDelete) When(ctx context.Context, id int64, deletedAt time.Time) *MessageRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &MessageRepositoryMockDeleteParams{ctx, id, deletedAt},
+		expectationOrigins: MessageRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockDeleteExpectation) Then(err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.Delete should be invoked
+func (mmDelete *mMessageRepositoryMockDelete) Times(n uint64) *mMessageRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of MessageRepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mMessageRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_repository.MessageRepository
+func (mmDelete *MessageRepositoryMock) Delete(ctx context.Context, id int64, deletedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id, deletedAt)
+	}
+
+	mm_params := MessageRepositoryMockDeleteParams{ctx, id, deletedAt}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockDeleteParams{ctx, id, deletedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("MessageRepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("MessageRepositoryMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.deletedAt != nil && !minimock.Equal(*mm_want_ptrs.deletedAt, mm_got.deletedAt) {
+				mmDelete.t.Errorf("MessageRepositoryMock.Delete got unexpected parameter deletedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originDeletedAt, *mm_want_ptrs.deletedAt, mm_got.deletedAt, minimock.Diff(*mm_want_ptrs.deletedAt, mm_got.deletedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("MessageRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the MessageRepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id, deletedAt)
+	}
+	mmDelete.t.Fatalf("Unexpected call to MessageRepositoryMock.Delete. %v %v %v", ctx, id, deletedAt)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished MessageRepositoryMock.Delete invocations
+func (mmDelete *MessageRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of MessageRepositoryMock.Delete invocations
+func (mmDelete *MessageRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mMessageRepositoryMockDelete) Calls() []*MessageRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mMessageRepositoryMockGet struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockGetExpectation
+	expectations       []*MessageRepositoryMockGetExpectation
+
+	callArgs []*MessageRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockGetExpectation specifies expectation struct of the MessageRepository.Get
+type MessageRepositoryMockGetExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockGetParams
+	paramPtrs          *MessageRepositoryMockGetParamPtrs
+	expectationOrigins MessageRepositoryMockGetExpectationOrigins
+	results            *MessageRepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockGetParams contains parameters of the MessageRepository.Get
+type MessageRepositoryMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// MessageRepositoryMockGetParamPtrs contains pointers to parameters of the MessageRepository.Get
+type MessageRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// MessageRepositoryMockGetResults contains results of the MessageRepository.Get
+type MessageRepositoryMockGetResults struct {
+	cp1 *model.ChatMessage
+	err error
+}
+
+// MessageRepositoryMockGetOrigins contains origins of expectations of the MessageRepository.Get
+type MessageRepositoryMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mMessageRepositoryMockGet) Optional() *mMessageRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for MessageRepository.Get
+func (mmGet *mMessageRepositoryMockGet) Expect(ctx context.Context, id int64) *mMessageRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("MessageRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &MessageRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("MessageRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &MessageRepositoryMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.Get
+func (mmGet *mMessageRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("MessageRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &MessageRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("MessageRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &MessageRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for MessageRepository.Get
+func (mmGet *mMessageRepositoryMockGet) ExpectIdParam2(id int64) *mMessageRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("MessageRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &MessageRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("MessageRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &MessageRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.Get
+func (mmGet *mMessageRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mMessageRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by MessageRepository.Get
+func (mmGet *mMessageRepositoryMockGet) Return(cp1 *model.ChatMessage, err error) *MessageRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("MessageRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &MessageRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &MessageRepositoryMockGetResults{cp1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the MessageRepository.Get method
+func (mmGet *mMessageRepositoryMockGet) Set(f func(ctx context.Context, id int64) (cp1 *model.ChatMessage, err error)) *MessageRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the MessageRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the MessageRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the MessageRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mMessageRepositoryMockGet) When(ctx context.Context, id int64) *MessageRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("MessageRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &MessageRepositoryMockGetParams{ctx, id},
+		expectationOrigins: MessageRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.Get return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockGetExpectation) Then(cp1 *model.ChatMessage, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockGetResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.Get should be invoked
+func (mmGet *mMessageRepositoryMockGet) Times(n uint64) *mMessageRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of MessageRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mMessageRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repository.MessageRepository
+func (mmGet *MessageRepositoryMock) Get(ctx context.Context, id int64) (cp1 *model.ChatMessage, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := MessageRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("MessageRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("MessageRepositoryMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("MessageRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the MessageRepositoryMock.Get")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to MessageRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished MessageRepositoryMock.Get invocations
+func (mmGet *MessageRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of MessageRepositoryMock.Get invocations
+func (mmGet *MessageRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mMessageRepositoryMockGet) Calls() []*MessageRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mMessageRepositoryMockList struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockListExpectation
+	expectations       []*MessageRepositoryMockListExpectation
+
+	callArgs []*MessageRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockListExpectation specifies expectation struct of the MessageRepository.List
+type MessageRepositoryMockListExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockListParams
+	paramPtrs          *MessageRepositoryMockListParamPtrs
+	expectationOrigins MessageRepositoryMockListExpectationOrigins
+	results            *MessageRepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockListParams contains parameters of the MessageRepository.List
+type MessageRepositoryMockListParams struct {
+	ctx  context.Context
+	page *model.MessagesPage
+}
+
+// MessageRepositoryMockListParamPtrs contains pointers to parameters of the MessageRepository.List
+type MessageRepositoryMockListParamPtrs struct {
+	ctx  *context.Context
+	page **model.MessagesPage
+}
+
+// MessageRepositoryMockListResults contains results of the MessageRepository.List
+type MessageRepositoryMockListResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// MessageRepositoryMockListOrigins contains origins of expectations of the MessageRepository.List
+type MessageRepositoryMockListExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originPage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mMessageRepositoryMockList) Optional() *mMessageRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for MessageRepository.List
+func (mmList *mMessageRepositoryMockList) Expect(ctx context.Context, page *model.MessagesPage) *mMessageRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &MessageRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &MessageRepositoryMockListParams{ctx, page}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.List
+func (mmList *mMessageRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &MessageRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &MessageRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectPageParam2 sets up expected param page for MessageRepository.List
+func (mmList *mMessageRepositoryMockList) ExpectPageParam2(page *model.MessagesPage) *mMessageRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &MessageRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &MessageRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.page = &page
+	mmList.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.List
+func (mmList *mMessageRepositoryMockList) Inspect(f func(ctx context.Context, page *model.MessagesPage)) *mMessageRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by MessageRepository.List
+func (mmList *mMessageRepositoryMockList) Return(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &MessageRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &MessageRepositoryMockListResults{mpa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the MessageRepository.List method
+func (mmList *mMessageRepositoryMockList) Set(f func(ctx context.Context, page *model.MessagesPage) (mpa1 []*model.Message, err error)) *MessageRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the MessageRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the MessageRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the MessageRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mMessageRepositoryMockList) When(ctx context.Context, page *model.MessagesPage) *MessageRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &MessageRepositoryMockListParams{ctx, page},
+		expectationOrigins: MessageRepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.List return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockListExpectation) Then(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockListResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.List should be invoked
+func (mmList *mMessageRepositoryMockList) Times(n uint64) *mMessageRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of MessageRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mMessageRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_repository.MessageRepository
+func (mmList *MessageRepositoryMock) List(ctx context.Context, page *model.MessagesPage) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, page)
+	}
+
+	mm_params := MessageRepositoryMockListParams{ctx, page}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockListParams{ctx, page}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("MessageRepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmList.t.Errorf("MessageRepositoryMock.List got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("MessageRepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the MessageRepositoryMock.List")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, page)
+	}
+	mmList.t.Fatalf("Unexpected call to MessageRepositoryMock.List. %v %v", ctx, page)
+	return
+}
+
+// ListAfterCounter returns a count of finished MessageRepositoryMock.List invocations
+func (mmList *MessageRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of MessageRepositoryMock.List invocations
+func (mmList *MessageRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mMessageRepositoryMockList) Calls() []*MessageRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mMessageRepositoryMockListAfter struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockListAfterExpectation
+	expectations       []*MessageRepositoryMockListAfterExpectation
+
+	callArgs []*MessageRepositoryMockListAfterParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockListAfterExpectation specifies expectation struct of the MessageRepository.ListAfter
+type MessageRepositoryMockListAfterExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockListAfterParams
+	paramPtrs          *MessageRepositoryMockListAfterParamPtrs
+	expectationOrigins MessageRepositoryMockListAfterExpectationOrigins
+	results            *MessageRepositoryMockListAfterResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockListAfterParams contains parameters of the MessageRepository.ListAfter
+type MessageRepositoryMockListAfterParams struct {
+	ctx     context.Context
+	chatID  int64
+	afterID int64
+	limit   uint64
+}
+
+// MessageRepositoryMockListAfterParamPtrs contains pointers to parameters of the MessageRepository.ListAfter
+type MessageRepositoryMockListAfterParamPtrs struct {
+	ctx     *context.Context
+	chatID  *int64
+	afterID *int64
+	limit   *uint64
+}
+
+// MessageRepositoryMockListAfterResults contains results of the MessageRepository.ListAfter
+type MessageRepositoryMockListAfterResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// MessageRepositoryMockListAfterOrigins contains origins of expectations of the MessageRepository.ListAfter
+type MessageRepositoryMockListAfterExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originChatID  string
+	originAfterID string
+	originLimit   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAfter *mMessageRepositoryMockListAfter) Optional() *mMessageRepositoryMockListAfter {
+	mmListAfter.optional = true
+	return mmListAfter
+}
+
+// Expect sets up expected params for MessageRepository.ListAfter
+func (mmListAfter *mMessageRepositoryMockListAfter) Expect(ctx context.Context, chatID int64, afterID int64, limit uint64) *mMessageRepositoryMockListAfter {
+	if mmListAfter.mock.funcListAfter != nil {
+		mmListAfter.mock.t.Fatalf("MessageRepositoryMock.ListAfter mock is already set by Set")
+	}
+
+	if mmListAfter.defaultExpectation == nil {
+		mmListAfter.defaultExpectation = &MessageRepositoryMockListAfterExpectation{}
+	}
+
+	if mmListAfter.defaultExpectation.paramPtrs != nil {
+		mmListAfter.mock.t.Fatalf("MessageRepositoryMock.ListAfter mock is already set by ExpectParams functions")
+	}
+
+	mmListAfter.defaultExpectation.params = &MessageRepositoryMockListAfterParams{ctx, chatID, afterID, limit}
+	mmListAfter.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListAfter.expectations {
+		if minimock.Equal(e.params, mmListAfter.defaultExpectation.params) {
+			mmListAfter.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAfter.defaultExpectation.params)
+		}
+	}
+
+	return mmListAfter
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.ListAfter
+func (mmListAfter *mMessageRepositoryMockListAfter) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockListAfter {
+	if mmListAfter.mock.funcListAfter != nil {
+		mmListAfter.mock.t.Fatalf("MessageRepositoryMock.ListAfter mock is already set by Set")
+	}
+
+	if mmListAfter.defaultExpectation == nil {
+		mmListAfter.defaultExpectation = &MessageRepositoryMockListAfterExpectation{}
+	}
+
+	if mmListAfter.defaultExpectation.params != nil {
+		mmListAfter.mock.t.Fatalf("MessageRepositoryMock.ListAfter mock is already set by Expect")
+	}
+
+	if mmListAfter.defaultExpectation.paramPtrs == nil {
+		mmListAfter.defaultExpectation.paramPtrs = &MessageRepositoryMockListAfterParamPtrs{}
+	}
+	mmListAfter.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListAfter.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListAfter
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for MessageRepository.ListAfter
+func (mmListAfter *mMessageRepositoryMockListAfter) ExpectChatIDParam2(chatID int64) *mMessageRepositoryMockListAfter {
+	if mmListAfter.mock.funcListAfter != nil {
+		mmListAfter.mock.t.Fatalf("MessageRepositoryMock.ListAfter mock is already set by Set")
+	}
+
+	if mmListAfter.defaultExpectation == nil {
+		mmListAfter.defaultExpectation = &MessageRepositoryMockListAfterExpectation{}
+	}
+
+	if mmListAfter.defaultExpectation.params != nil {
+		mmListAfter.mock.t.Fatalf("MessageRepositoryMock.ListAfter mock is already set by Expect")
+	}
+
+	if mmListAfter.defaultExpectation.paramPtrs == nil {
+		mmListAfter.defaultExpectation.paramPtrs = &MessageRepositoryMockListAfterParamPtrs{}
+	}
+	mmListAfter.defaultExpectation.paramPtrs.chatID = &chatID
+	mmListAfter.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmListAfter
+}
+
+// ExpectAfterIDParam3 sets up expected param afterID for MessageRepository.ListAfter
+func (mmListAfter *mMessageRepositoryMockListAfter) ExpectAfterIDParam3(afterID int64) *mMessageRepositoryMockListAfter {
+	if mmListAfter.mock.funcListAfter != nil {
+		mmListAfter.mock.t.Fatalf("MessageRepositoryMock.ListAfter mock is already set by Set")
+	}
+
+	if mmListAfter.defaultExpectation == nil {
+		mmListAfter.defaultExpectation = &MessageRepositoryMockListAfterExpectation{}
+	}
+
+	if mmListAfter.defaultExpectation.params != nil {
+		mmListAfter.mock.t.Fatalf("MessageRepositoryMock.ListAfter mock is already set by Expect")
+	}
+
+	if mmListAfter.defaultExpectation.paramPtrs == nil {
+		mmListAfter.defaultExpectation.paramPtrs = &MessageRepositoryMockListAfterParamPtrs{}
+	}
+	mmListAfter.defaultExpectation.paramPtrs.afterID = &afterID
+	mmListAfter.defaultExpectation.expectationOrigins.originAfterID = minimock.CallerInfo(1)
+
+	return mmListAfter
+}
+
+// ExpectLimitParam4 sets up expected param limit for MessageRepository.ListAfter
+func (mmListAfter *mMessageRepositoryMockListAfter) ExpectLimitParam4(limit uint64) *mMessageRepositoryMockListAfter {
+	if mmListAfter.mock.funcListAfter != nil {
+		mmListAfter.mock.t.Fatalf("MessageRepositoryMock.ListAfter mock is already set by Set")
+	}
+
+	if mmListAfter.defaultExpectation == nil {
+		mmListAfter.defaultExpectation = &MessageRepositoryMockListAfterExpectation{}
+	}
+
+	if mmListAfter.defaultExpectation.params != nil {
+		mmListAfter.mock.t.Fatalf("MessageRepositoryMock.ListAfter mock is already set by Expect")
+	}
+
+	if mmListAfter.defaultExpectation.paramPtrs == nil {
+		mmListAfter.defaultExpectation.paramPtrs = &MessageRepositoryMockListAfterParamPtrs{}
+	}
+	mmListAfter.defaultExpectation.paramPtrs.limit = &limit
+	mmListAfter.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListAfter
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.ListAfter
+func (mmListAfter *mMessageRepositoryMockListAfter) Inspect(f func(ctx context.Context, chatID int64, afterID int64, limit uint64)) *mMessageRepositoryMockListAfter {
+	if mmListAfter.mock.inspectFuncListAfter != nil {
+		mmListAfter.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.ListAfter")
+	}
+
+	mmListAfter.mock.inspectFuncListAfter = f
+
+	return mmListAfter
+}
+
+// Return sets up results that will be returned by MessageRepository.ListAfter
+func (mmListAfter *mMessageRepositoryMockListAfter) Return(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	if mmListAfter.mock.funcListAfter != nil {
+		mmListAfter.mock.t.Fatalf("MessageRepositoryMock.ListAfter mock is already set by Set")
+	}
+
+	if mmListAfter.defaultExpectation == nil {
+		mmListAfter.defaultExpectation = &MessageRepositoryMockListAfterExpectation{mock: mmListAfter.mock}
+	}
+	mmListAfter.defaultExpectation.results = &MessageRepositoryMockListAfterResults{mpa1, err}
+	mmListAfter.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListAfter.mock
+}
+
+// Set uses given function f to mock the MessageRepository.ListAfter method
+func (mmListAfter *mMessageRepositoryMockListAfter) Set(f func(ctx context.Context, chatID int64, afterID int64, limit uint64) (mpa1 []*model.Message, err error)) *MessageRepositoryMock {
+	if mmListAfter.defaultExpectation != nil {
+		mmListAfter.mock.t.Fatalf("Default expectation is already set for the MessageRepository.ListAfter method")
+	}
+
+	if len(mmListAfter.expectations) > 0 {
+		mmListAfter.mock.t.Fatalf("Some expectations are already set for the MessageRepository.ListAfter method")
+	}
+
+	mmListAfter.mock.funcListAfter = f
+	mmListAfter.mock.funcListAfterOrigin = minimock.CallerInfo(1)
+	return mmListAfter.mock
+}
+
+// When sets expectation for the MessageRepository.ListAfter which will trigger the result defined by the following
+// Then helper
+func (mmListAfter *mMessageRepositoryMockListAfter) When(ctx context.Context, chatID int64, afterID int64, limit uint64) *MessageRepositoryMockListAfterExpectation {
+	if mmListAfter.mock.funcListAfter != nil {
+		mmListAfter.mock.t.Fatalf("MessageRepositoryMock.ListAfter mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockListAfterExpectation{
+		mock:               mmListAfter.mock,
+		params:             &MessageRepositoryMockListAfterParams{ctx, chatID, afterID, limit},
+		expectationOrigins: MessageRepositoryMockListAfterExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListAfter.expectations = append(mmListAfter.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.ListAfter return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockListAfterExpectation) Then(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockListAfterResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.ListAfter should be invoked
+func (mmListAfter *mMessageRepositoryMockListAfter) Times(n uint64) *mMessageRepositoryMockListAfter {
+	if n == 0 {
+		mmListAfter.mock.t.Fatalf("Times of MessageRepositoryMock.ListAfter mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAfter.expectedInvocations, n)
+	mmListAfter.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListAfter
+}
+
+func (mmListAfter *mMessageRepositoryMockListAfter) invocationsDone() bool {
+	if len(mmListAfter.expectations) == 0 && mmListAfter.defaultExpectation == nil && mmListAfter.mock.funcListAfter == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAfter.mock.afterListAfterCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAfter.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAfter implements mm_repository.MessageRepository
+func (mmListAfter *MessageRepositoryMock) ListAfter(ctx context.Context, chatID int64, afterID int64, limit uint64) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmListAfter.beforeListAfterCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAfter.afterListAfterCounter, 1)
+
+	mmListAfter.t.Helper()
+
+	if mmListAfter.inspectFuncListAfter != nil {
+		mmListAfter.inspectFuncListAfter(ctx, chatID, afterID, limit)
+	}
+
+	mm_params := MessageRepositoryMockListAfterParams{ctx, chatID, afterID, limit}
+
+	// Record call args
+	mmListAfter.ListAfterMock.mutex.Lock()
+	mmListAfter.ListAfterMock.callArgs = append(mmListAfter.ListAfterMock.callArgs, &mm_params)
+	mmListAfter.ListAfterMock.mutex.Unlock()
+
+	for _, e := range mmListAfter.ListAfterMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListAfter.ListAfterMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAfter.ListAfterMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAfter.ListAfterMock.defaultExpectation.params
+		mm_want_ptrs := mmListAfter.ListAfterMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockListAfterParams{ctx, chatID, afterID, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAfter.t.Errorf("MessageRepositoryMock.ListAfter got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAfter.ListAfterMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmListAfter.t.Errorf("MessageRepositoryMock.ListAfter got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAfter.ListAfterMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.afterID != nil && !minimock.Equal(*mm_want_ptrs.afterID, mm_got.afterID) {
+				mmListAfter.t.Errorf("MessageRepositoryMock.ListAfter got unexpected parameter afterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAfter.ListAfterMock.defaultExpectation.expectationOrigins.originAfterID, *mm_want_ptrs.afterID, mm_got.afterID, minimock.Diff(*mm_want_ptrs.afterID, mm_got.afterID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListAfter.t.Errorf("MessageRepositoryMock.ListAfter got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAfter.ListAfterMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAfter.t.Errorf("MessageRepositoryMock.ListAfter got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListAfter.ListAfterMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAfter.ListAfterMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAfter.t.Fatal("No results are set for the MessageRepositoryMock.ListAfter")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListAfter.funcListAfter != nil {
+		return mmListAfter.funcListAfter(ctx, chatID, afterID, limit)
+	}
+	mmListAfter.t.Fatalf("Unexpected call to MessageRepositoryMock.ListAfter. %v %v %v %v", ctx, chatID, afterID, limit)
+	return
+}
+
+// ListAfterAfterCounter returns a count of finished MessageRepositoryMock.ListAfter invocations
+func (mmListAfter *MessageRepositoryMock) ListAfterAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAfter.afterListAfterCounter)
+}
+
+// ListAfterBeforeCounter returns a count of MessageRepositoryMock.ListAfter invocations
+func (mmListAfter *MessageRepositoryMock) ListAfterBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAfter.beforeListAfterCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.ListAfter.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAfter *mMessageRepositoryMockListAfter) Calls() []*MessageRepositoryMockListAfterParams {
+	mmListAfter.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockListAfterParams, len(mmListAfter.callArgs))
+	copy(argCopy, mmListAfter.callArgs)
+
+	mmListAfter.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAfterDone returns true if the count of the ListAfter invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockListAfterDone() bool {
+	if m.ListAfterMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAfterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAfterMock.invocationsDone()
+}
+
+// MinimockListAfterInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockListAfterInspect() {
+	for _, e := range m.ListAfterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListAfter at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListAfterCounter := mm_atomic.LoadUint64(&m.afterListAfterCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAfterMock.defaultExpectation != nil && afterListAfterCounter < 1 {
+		if m.ListAfterMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListAfter at\n%s", m.ListAfterMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListAfter at\n%s with params: %#v", m.ListAfterMock.defaultExpectation.expectationOrigins.origin, *m.ListAfterMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAfter != nil && afterListAfterCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.ListAfter at\n%s", m.funcListAfterOrigin)
+	}
+
+	if !m.ListAfterMock.invocationsDone() && afterListAfterCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.ListAfter at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAfterMock.expectedInvocations), m.ListAfterMock.expectedInvocationsOrigin, afterListAfterCounter)
+	}
+}
+
+type mMessageRepositoryMockListByIDs struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockListByIDsExpectation
+	expectations       []*MessageRepositoryMockListByIDsExpectation
+
+	callArgs []*MessageRepositoryMockListByIDsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockListByIDsExpectation specifies expectation struct of the MessageRepository.ListByIDs
+type MessageRepositoryMockListByIDsExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockListByIDsParams
+	paramPtrs          *MessageRepositoryMockListByIDsParamPtrs
+	expectationOrigins MessageRepositoryMockListByIDsExpectationOrigins
+	results            *MessageRepositoryMockListByIDsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockListByIDsParams contains parameters of the MessageRepository.ListByIDs
+type MessageRepositoryMockListByIDsParams struct {
+	ctx context.Context
+	ids []int64
+}
+
+// MessageRepositoryMockListByIDsParamPtrs contains pointers to parameters of the MessageRepository.ListByIDs
+type MessageRepositoryMockListByIDsParamPtrs struct {
+	ctx *context.Context
+	ids *[]int64
+}
+
+// MessageRepositoryMockListByIDsResults contains results of the MessageRepository.ListByIDs
+type MessageRepositoryMockListByIDsResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// MessageRepositoryMockListByIDsOrigins contains origins of expectations of the MessageRepository.ListByIDs
+type MessageRepositoryMockListByIDsExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIds string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListByIDs *mMessageRepositoryMockListByIDs) Optional() *mMessageRepositoryMockListByIDs {
+	mmListByIDs.optional = true
+	return mmListByIDs
+}
+
+// Expect sets up expected params for MessageRepository.ListByIDs
+func (mmListByIDs *mMessageRepositoryMockListByIDs) Expect(ctx context.Context, ids []int64) *mMessageRepositoryMockListByIDs {
+	if mmListByIDs.mock.funcListByIDs != nil {
+		mmListByIDs.mock.t.Fatalf("MessageRepositoryMock.ListByIDs mock is already set by Set")
+	}
+
+	if mmListByIDs.defaultExpectation == nil {
+		mmListByIDs.defaultExpectation = &MessageRepositoryMockListByIDsExpectation{}
+	}
+
+	if mmListByIDs.defaultExpectation.paramPtrs != nil {
+		mmListByIDs.mock.t.Fatalf("MessageRepositoryMock.ListByIDs mock is already set by ExpectParams functions")
+	}
+
+	mmListByIDs.defaultExpectation.params = &MessageRepositoryMockListByIDsParams{ctx, ids}
+	mmListByIDs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListByIDs.expectations {
+		if minimock.Equal(e.params, mmListByIDs.defaultExpectation.params) {
+			mmListByIDs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListByIDs.defaultExpectation.params)
+		}
+	}
+
+	return mmListByIDs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.ListByIDs
+func (mmListByIDs *mMessageRepositoryMockListByIDs) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockListByIDs {
+	if mmListByIDs.mock.funcListByIDs != nil {
+		mmListByIDs.mock.t.Fatalf("MessageRepositoryMock.ListByIDs mock is already set by Set")
+	}
+
+	if mmListByIDs.defaultExpectation == nil {
+		mmListByIDs.defaultExpectation = &MessageRepositoryMockListByIDsExpectation{}
+	}
+
+	if mmListByIDs.defaultExpectation.params != nil {
+		mmListByIDs.mock.t.Fatalf("MessageRepositoryMock.ListByIDs mock is already set by Expect")
+	}
+
+	if mmListByIDs.defaultExpectation.paramPtrs == nil {
+		mmListByIDs.defaultExpectation.paramPtrs = &MessageRepositoryMockListByIDsParamPtrs{}
+	}
+	mmListByIDs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListByIDs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListByIDs
+}
+
+// ExpectIdsParam2 sets up expected param ids for MessageRepository.ListByIDs
+func (mmListByIDs *mMessageRepositoryMockListByIDs) ExpectIdsParam2(ids []int64) *mMessageRepositoryMockListByIDs {
+	if mmListByIDs.mock.funcListByIDs != nil {
+		mmListByIDs.mock.t.Fatalf("MessageRepositoryMock.ListByIDs mock is already set by Set")
+	}
+
+	if mmListByIDs.defaultExpectation == nil {
+		mmListByIDs.defaultExpectation = &MessageRepositoryMockListByIDsExpectation{}
+	}
+
+	if mmListByIDs.defaultExpectation.params != nil {
+		mmListByIDs.mock.t.Fatalf("MessageRepositoryMock.ListByIDs mock is already set by Expect")
+	}
+
+	if mmListByIDs.defaultExpectation.paramPtrs == nil {
+		mmListByIDs.defaultExpectation.paramPtrs = &MessageRepositoryMockListByIDsParamPtrs{}
+	}
+	mmListByIDs.defaultExpectation.paramPtrs.ids = &ids
+	mmListByIDs.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmListByIDs
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.ListByIDs
+func (mmListByIDs *mMessageRepositoryMockListByIDs) Inspect(f func(ctx context.Context, ids []int64)) *mMessageRepositoryMockListByIDs {
+	if mmListByIDs.mock.inspectFuncListByIDs != nil {
+		mmListByIDs.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.ListByIDs")
+	}
+
+	mmListByIDs.mock.inspectFuncListByIDs = f
+
+	return mmListByIDs
+}
+
+// Return sets up results that will be returned by MessageRepository.ListByIDs
+func (mmListByIDs *mMessageRepositoryMockListByIDs) Return(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	if mmListByIDs.mock.funcListByIDs != nil {
+		mmListByIDs.mock.t.Fatalf("MessageRepositoryMock.ListByIDs mock is already set by Set")
+	}
+
+	if mmListByIDs.defaultExpectation == nil {
+		mmListByIDs.defaultExpectation = &MessageRepositoryMockListByIDsExpectation{mock: mmListByIDs.mock}
+	}
+	mmListByIDs.defaultExpectation.results = &MessageRepositoryMockListByIDsResults{mpa1, err}
+	mmListByIDs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListByIDs.mock
+}
+
+// Set uses given function f to mock the MessageRepository.ListByIDs method
+func (mmListByIDs *mMessageRepositoryMockListByIDs) Set(f func(ctx context.Context, ids []int64) (mpa1 []*model.Message, err error)) *MessageRepositoryMock {
+	if mmListByIDs.defaultExpectation != nil {
+		mmListByIDs.mock.t.Fatalf("Default expectation is already set for the MessageRepository.ListByIDs method")
+	}
+
+	if len(mmListByIDs.expectations) > 0 {
+		mmListByIDs.mock.t.Fatalf("Some expectations are already set for the MessageRepository.ListByIDs method")
+	}
+
+	mmListByIDs.mock.funcListByIDs = f
+	mmListByIDs.mock.funcListByIDsOrigin = minimock.CallerInfo(1)
+	return mmListByIDs.mock
+}
+
+// When sets expectation for the MessageRepository.ListByIDs which will trigger the result defined by the following
+// Then helper
+func (mmListByIDs *mMessageRepositoryMockListByIDs) When(ctx context.Context, ids []int64) *MessageRepositoryMockListByIDsExpectation {
+	if mmListByIDs.mock.funcListByIDs != nil {
+		mmListByIDs.mock.t.Fatalf("MessageRepositoryMock.ListByIDs mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockListByIDsExpectation{
+		mock:               mmListByIDs.mock,
+		params:             &MessageRepositoryMockListByIDsParams{ctx, ids},
+		expectationOrigins: MessageRepositoryMockListByIDsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListByIDs.expectations = append(mmListByIDs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.ListByIDs return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockListByIDsExpectation) Then(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockListByIDsResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.ListByIDs should be invoked
+func (mmListByIDs *mMessageRepositoryMockListByIDs) Times(n uint64) *mMessageRepositoryMockListByIDs {
+	if n == 0 {
+		mmListByIDs.mock.t.Fatalf("Times of MessageRepositoryMock.ListByIDs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListByIDs.expectedInvocations, n)
+	mmListByIDs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListByIDs
+}
+
+func (mmListByIDs *mMessageRepositoryMockListByIDs) invocationsDone() bool {
+	if len(mmListByIDs.expectations) == 0 && mmListByIDs.defaultExpectation == nil && mmListByIDs.mock.funcListByIDs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListByIDs.mock.afterListByIDsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListByIDs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListByIDs implements mm_repository.MessageRepository
+func (mmListByIDs *MessageRepositoryMock) ListByIDs(ctx context.Context, ids []int64) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmListByIDs.beforeListByIDsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListByIDs.afterListByIDsCounter, 1)
+
+	mmListByIDs.t.Helper()
+
+	if mmListByIDs.inspectFuncListByIDs != nil {
+		mmListByIDs.inspectFuncListByIDs(ctx, ids)
+	}
+
+	mm_params := MessageRepositoryMockListByIDsParams{ctx, ids}
+
+	// Record call args
+	mmListByIDs.ListByIDsMock.mutex.Lock()
+	mmListByIDs.ListByIDsMock.callArgs = append(mmListByIDs.ListByIDsMock.callArgs, &mm_params)
+	mmListByIDs.ListByIDsMock.mutex.Unlock()
+
+	for _, e := range mmListByIDs.ListByIDsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListByIDs.ListByIDsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListByIDs.ListByIDsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListByIDs.ListByIDsMock.defaultExpectation.params
+		mm_want_ptrs := mmListByIDs.ListByIDsMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockListByIDsParams{ctx, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListByIDs.t.Errorf("MessageRepositoryMock.ListByIDs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListByIDs.ListByIDsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmListByIDs.t.Errorf("MessageRepositoryMock.ListByIDs got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListByIDs.ListByIDsMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListByIDs.t.Errorf("MessageRepositoryMock.ListByIDs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListByIDs.ListByIDsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListByIDs.ListByIDsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListByIDs.t.Fatal("No results are set for the MessageRepositoryMock.ListByIDs")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListByIDs.funcListByIDs != nil {
+		return mmListByIDs.funcListByIDs(ctx, ids)
+	}
+	mmListByIDs.t.Fatalf("Unexpected call to MessageRepositoryMock.ListByIDs. %v %v", ctx, ids)
+	return
+}
+
+// ListByIDsAfterCounter returns a count of finished MessageRepositoryMock.ListByIDs invocations
+func (mmListByIDs *MessageRepositoryMock) ListByIDsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListByIDs.afterListByIDsCounter)
+}
+
+// ListByIDsBeforeCounter returns a count of MessageRepositoryMock.ListByIDs invocations
+func (mmListByIDs *MessageRepositoryMock) ListByIDsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListByIDs.beforeListByIDsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.ListByIDs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListByIDs *mMessageRepositoryMockListByIDs) Calls() []*MessageRepositoryMockListByIDsParams {
+	mmListByIDs.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockListByIDsParams, len(mmListByIDs.callArgs))
+	copy(argCopy, mmListByIDs.callArgs)
+
+	mmListByIDs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListByIDsDone returns true if the count of the ListByIDs invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockListByIDsDone() bool {
+	if m.ListByIDsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListByIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListByIDsMock.invocationsDone()
+}
+
+// MinimockListByIDsInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockListByIDsInspect() {
+	for _, e := range m.ListByIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListByIDs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListByIDsCounter := mm_atomic.LoadUint64(&m.afterListByIDsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListByIDsMock.defaultExpectation != nil && afterListByIDsCounter < 1 {
+		if m.ListByIDsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListByIDs at\n%s", m.ListByIDsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListByIDs at\n%s with params: %#v", m.ListByIDsMock.defaultExpectation.expectationOrigins.origin, *m.ListByIDsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListByIDs != nil && afterListByIDsCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.ListByIDs at\n%s", m.funcListByIDsOrigin)
+	}
+
+	if !m.ListByIDsMock.invocationsDone() && afterListByIDsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.ListByIDs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListByIDsMock.expectedInvocations), m.ListByIDsMock.expectedInvocationsOrigin, afterListByIDsCounter)
+	}
+}
+
+type mMessageRepositoryMockListReplies struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockListRepliesExpectation
+	expectations       []*MessageRepositoryMockListRepliesExpectation
+
+	callArgs []*MessageRepositoryMockListRepliesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockListRepliesExpectation specifies expectation struct of the MessageRepository.ListReplies
+type MessageRepositoryMockListRepliesExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockListRepliesParams
+	paramPtrs          *MessageRepositoryMockListRepliesParamPtrs
+	expectationOrigins MessageRepositoryMockListRepliesExpectationOrigins
+	results            *MessageRepositoryMockListRepliesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockListRepliesParams contains parameters of the MessageRepository.ListReplies
+type MessageRepositoryMockListRepliesParams struct {
+	ctx  context.Context
+	page *model.ThreadPage
+}
+
+// MessageRepositoryMockListRepliesParamPtrs contains pointers to parameters of the MessageRepository.ListReplies
+type MessageRepositoryMockListRepliesParamPtrs struct {
+	ctx  *context.Context
+	page **model.ThreadPage
+}
+
+// MessageRepositoryMockListRepliesResults contains results of the MessageRepository.ListReplies
+type MessageRepositoryMockListRepliesResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// MessageRepositoryMockListRepliesOrigins contains origins of expectations of the MessageRepository.ListReplies
+type MessageRepositoryMockListRepliesExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originPage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListReplies *mMessageRepositoryMockListReplies) Optional() *mMessageRepositoryMockListReplies {
+	mmListReplies.optional = true
+	return mmListReplies
+}
+
+// Expect sets up expected params for MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) Expect(ctx context.Context, page *model.ThreadPage) *mMessageRepositoryMockListReplies {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &MessageRepositoryMockListRepliesExpectation{}
+	}
+
+	if mmListReplies.defaultExpectation.paramPtrs != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by ExpectParams functions")
+	}
+
+	mmListReplies.defaultExpectation.params = &MessageRepositoryMockListRepliesParams{ctx, page}
+	mmListReplies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListReplies.expectations {
+		if minimock.Equal(e.params, mmListReplies.defaultExpectation.params) {
+			mmListReplies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListReplies.defaultExpectation.params)
+		}
+	}
+
+	return mmListReplies
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockListReplies {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &MessageRepositoryMockListRepliesExpectation{}
+	}
+
+	if mmListReplies.defaultExpectation.params != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Expect")
+	}
+
+	if mmListReplies.defaultExpectation.paramPtrs == nil {
+		mmListReplies.defaultExpectation.paramPtrs = &MessageRepositoryMockListRepliesParamPtrs{}
+	}
+	mmListReplies.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListReplies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListReplies
+}
+
+// ExpectPageParam2 sets up expected param page for MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) ExpectPageParam2(page *model.ThreadPage) *mMessageRepositoryMockListReplies {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &MessageRepositoryMockListRepliesExpectation{}
+	}
+
+	if mmListReplies.defaultExpectation.params != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Expect")
+	}
+
+	if mmListReplies.defaultExpectation.paramPtrs == nil {
+		mmListReplies.defaultExpectation.paramPtrs = &MessageRepositoryMockListRepliesParamPtrs{}
+	}
+	mmListReplies.defaultExpectation.paramPtrs.page = &page
+	mmListReplies.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmListReplies
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) Inspect(f func(ctx context.Context, page *model.ThreadPage)) *mMessageRepositoryMockListReplies {
+	if mmListReplies.mock.inspectFuncListReplies != nil {
+		mmListReplies.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.ListReplies")
+	}
+
+	mmListReplies.mock.inspectFuncListReplies = f
+
+	return mmListReplies
+}
+
+// Return sets up results that will be returned by MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) Return(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &MessageRepositoryMockListRepliesExpectation{mock: mmListReplies.mock}
+	}
+	mmListReplies.defaultExpectation.results = &MessageRepositoryMockListRepliesResults{mpa1, err}
+	mmListReplies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListReplies.mock
+}
+
+// Set uses given function f to mock the MessageRepository.ListReplies method
+func (mmListReplies *mMessageRepositoryMockListReplies) Set(f func(ctx context.Context, page *model.ThreadPage) (mpa1 []*model.Message, err error)) *MessageRepositoryMock {
+	if mmListReplies.defaultExpectation != nil {
+		mmListReplies.mock.t.Fatalf("Default expectation is already set for the MessageRepository.ListReplies method")
+	}
+
+	if len(mmListReplies.expectations) > 0 {
+		mmListReplies.mock.t.Fatalf("Some expectations are already set for the MessageRepository.ListReplies method")
+	}
+
+	mmListReplies.mock.funcListReplies = f
+	mmListReplies.mock.funcListRepliesOrigin = minimock.CallerInfo(1)
+	return mmListReplies.mock
+}
+
+// When sets expectation for the MessageRepository.ListReplies which will trigger the result defined by the following
+// Then helper
+func (mmListReplies *mMessageRepositoryMockListReplies) When(ctx context.Context, page *model.ThreadPage) *MessageRepositoryMockListRepliesExpectation {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockListRepliesExpectation{
+		mock:               mmListReplies.mock,
+		params:             &MessageRepositoryMockListRepliesParams{ctx, page},
+		expectationOrigins: MessageRepositoryMockListRepliesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListReplies.expectations = append(mmListReplies.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.ListReplies return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockListRepliesExpectation) Then(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockListRepliesResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.ListReplies should be invoked
+func (mmListReplies *mMessageRepositoryMockListReplies) Times(n uint64) *mMessageRepositoryMockListReplies {
+	if n == 0 {
+		mmListReplies.mock.t.Fatalf("Times of MessageRepositoryMock.ListReplies mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListReplies.expectedInvocations, n)
+	mmListReplies.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListReplies
+}
+
+func (mmListReplies *mMessageRepositoryMockListReplies) invocationsDone() bool {
+	if len(mmListReplies.expectations) == 0 && mmListReplies.defaultExpectation == nil && mmListReplies.mock.funcListReplies == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListReplies.mock.afterListRepliesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListReplies.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListReplies implements mm_repository.MessageRepository
+func (mmListReplies *MessageRepositoryMock) ListReplies(ctx context.Context, page *model.ThreadPage) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmListReplies.beforeListRepliesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListReplies.afterListRepliesCounter, 1)
+
+	mmListReplies.t.Helper()
+
+	if mmListReplies.inspectFuncListReplies != nil {
+		mmListReplies.inspectFuncListReplies(ctx, page)
+	}
+
+	mm_params := MessageRepositoryMockListRepliesParams{ctx, page}
+
+	// Record call args
+	mmListReplies.ListRepliesMock.mutex.Lock()
+	mmListReplies.ListRepliesMock.callArgs = append(mmListReplies.ListRepliesMock.callArgs, &mm_params)
+	mmListReplies.ListRepliesMock.mutex.Unlock()
+
+	for _, e := range mmListReplies.ListRepliesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListReplies.ListRepliesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListReplies.ListRepliesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListReplies.ListRepliesMock.defaultExpectation.params
+		mm_want_ptrs := mmListReplies.ListRepliesMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockListRepliesParams{ctx, page}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListReplies.t.Errorf("MessageRepositoryMock.ListReplies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReplies.ListRepliesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmListReplies.t.Errorf("MessageRepositoryMock.ListReplies got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReplies.ListRepliesMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListReplies.t.Errorf("MessageRepositoryMock.ListReplies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListReplies.ListRepliesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListReplies.ListRepliesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListReplies.t.Fatal("No results are set for the MessageRepositoryMock.ListReplies")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListReplies.funcListReplies != nil {
+		return mmListReplies.funcListReplies(ctx, page)
+	}
+	mmListReplies.t.Fatalf("Unexpected call to MessageRepositoryMock.ListReplies. %v %v", ctx, page)
+	return
+}
+
+// ListRepliesAfterCounter returns a count of finished MessageRepositoryMock.ListReplies invocations
+func (mmListReplies *MessageRepositoryMock) ListRepliesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReplies.afterListRepliesCounter)
+}
+
+// ListRepliesBeforeCounter returns a count of MessageRepositoryMock.ListReplies invocations
+func (mmListReplies *MessageRepositoryMock) ListRepliesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReplies.beforeListRepliesCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.ListReplies.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListReplies *mMessageRepositoryMockListReplies) Calls() []*MessageRepositoryMockListRepliesParams {
+	mmListReplies.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockListRepliesParams, len(mmListReplies.callArgs))
+	copy(argCopy, mmListReplies.callArgs)
+
+	mmListReplies.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRepliesDone returns true if the count of the ListReplies invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockListRepliesDone() bool {
+	if m.ListRepliesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRepliesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRepliesMock.invocationsDone()
+}
+
+// MinimockListRepliesInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockListRepliesInspect() {
+	for _, e := range m.ListRepliesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListReplies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRepliesCounter := mm_atomic.LoadUint64(&m.afterListRepliesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRepliesMock.defaultExpectation != nil && afterListRepliesCounter < 1 {
+		if m.ListRepliesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListReplies at\n%s", m.ListRepliesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListReplies at\n%s with params: %#v", m.ListRepliesMock.defaultExpectation.expectationOrigins.origin, *m.ListRepliesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListReplies != nil && afterListRepliesCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.ListReplies at\n%s", m.funcListRepliesOrigin)
+	}
+
+	if !m.ListRepliesMock.invocationsDone() && afterListRepliesCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.ListReplies at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRepliesMock.expectedInvocations), m.ListRepliesMock.expectedInvocationsOrigin, afterListRepliesCounter)
+	}
+}
+
+type mMessageRepositoryMockNextIDs struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockNextIDsExpectation
+	expectations       []*MessageRepositoryMockNextIDsExpectation
+
+	callArgs []*MessageRepositoryMockNextIDsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockNextIDsExpectation specifies expectation struct of the MessageRepository.NextIDs
+type MessageRepositoryMockNextIDsExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockNextIDsParams
+	paramPtrs          *MessageRepositoryMockNextIDsParamPtrs
+	expectationOrigins MessageRepositoryMockNextIDsExpectationOrigins
+	results            *MessageRepositoryMockNextIDsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockNextIDsParams contains parameters of the MessageRepository.NextIDs
+type MessageRepositoryMockNextIDsParams struct {
+	ctx context.Context
+	n   int
+}
+
+// MessageRepositoryMockNextIDsParamPtrs contains pointers to parameters of the MessageRepository.NextIDs
+type MessageRepositoryMockNextIDsParamPtrs struct {
+	ctx *context.Context
+	n   *int
+}
+
+// MessageRepositoryMockNextIDsResults contains results of the MessageRepository.NextIDs
+type MessageRepositoryMockNextIDsResults struct {
+	ia1 []int64
+	err error
+}
+
+// MessageRepositoryMockNextIDsOrigins contains origins of expectations of the MessageRepository.NextIDs
+type MessageRepositoryMockNextIDsExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originN   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmNextIDs *mMessageRepositoryMockNextIDs) Optional() *mMessageRepositoryMockNextIDs {
+	mmNextIDs.optional = true
+	return mmNextIDs
+}
+
+// Expect sets up expected params for MessageRepository.NextIDs
+func (mmNextIDs *mMessageRepositoryMockNextIDs) Expect(ctx context.Context, n int) *mMessageRepositoryMockNextIDs {
+	if mmNextIDs.mock.funcNextIDs != nil {
+		mmNextIDs.mock.t.Fatalf("MessageRepositoryMock.NextIDs mock is already set by Set")
+	}
+
+	if mmNextIDs.defaultExpectation == nil {
+		mmNextIDs.defaultExpectation = &MessageRepositoryMockNextIDsExpectation{}
+	}
+
+	if mmNextIDs.defaultExpectation.paramPtrs != nil {
+		mmNextIDs.mock.t.Fatalf("MessageRepositoryMock.NextIDs mock is already set by ExpectParams functions")
+	}
+
+	mmNextIDs.defaultExpectation.params = &MessageRepositoryMockNextIDsParams{ctx, n}
+	mmNextIDs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmNextIDs.expectations {
+		if minimock.Equal(e.params, mmNextIDs.defaultExpectation.params) {
+			mmNextIDs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmNextIDs.defaultExpectation.params)
+		}
+	}
+
+	return mmNextIDs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.NextIDs
+func (mmNextIDs *mMessageRepositoryMockNextIDs) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockNextIDs {
+	if mmNextIDs.mock.funcNextIDs != nil {
+		mmNextIDs.mock.t.Fatalf("MessageRepositoryMock.NextIDs mock is already set by Set")
+	}
+
+	if mmNextIDs.defaultExpectation == nil {
+		mmNextIDs.defaultExpectation = &MessageRepositoryMockNextIDsExpectation{}
+	}
+
+	if mmNextIDs.defaultExpectation.params != nil {
+		mmNextIDs.mock.t.Fatalf("MessageRepositoryMock.NextIDs mock is already set by Expect")
+	}
+
+	if mmNextIDs.defaultExpectation.paramPtrs == nil {
+		mmNextIDs.defaultExpectation.paramPtrs = &MessageRepositoryMockNextIDsParamPtrs{}
+	}
+	mmNextIDs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmNextIDs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmNextIDs
+}
+
+// ExpectNParam2 sets up expected param n for MessageRepository.NextIDs
+func (mmNextIDs *mMessageRepositoryMockNextIDs) ExpectNParam2(n int) *mMessageRepositoryMockNextIDs {
+	if mmNextIDs.mock.funcNextIDs != nil {
+		mmNextIDs.mock.t.Fatalf("MessageRepositoryMock.NextIDs mock is already set by Set")
+	}
+
+	if mmNextIDs.defaultExpectation == nil {
+		mmNextIDs.defaultExpectation = &MessageRepositoryMockNextIDsExpectation{}
+	}
+
+	if mmNextIDs.defaultExpectation.params != nil {
+		mmNextIDs.mock.t.Fatalf("MessageRepositoryMock.NextIDs mock is already set by Expect")
+	}
+
+	if mmNextIDs.defaultExpectation.paramPtrs == nil {
+		mmNextIDs.defaultExpectation.paramPtrs = &MessageRepositoryMockNextIDsParamPtrs{}
+	}
+	mmNextIDs.defaultExpectation.paramPtrs.n = &n
+	mmNextIDs.defaultExpectation.expectationOrigins.originN = minimock.CallerInfo(1)
+
+	return mmNextIDs
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.NextIDs
+func (mmNextIDs *mMessageRepositoryMockNextIDs) Inspect(f func(ctx context.Context, n int)) *mMessageRepositoryMockNextIDs {
+	if mmNextIDs.mock.inspectFuncNextIDs != nil {
+		mmNextIDs.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.NextIDs")
+	}
+
+	mmNextIDs.mock.inspectFuncNextIDs = f
+
+	return mmNextIDs
+}
+
+// Return sets up results that will be returned by MessageRepository.NextIDs
+func (mmNextIDs *mMessageRepositoryMockNextIDs) Return(ia1 []int64, err error) *MessageRepositoryMock {
+	if mmNextIDs.mock.funcNextIDs != nil {
+		mmNextIDs.mock.t.Fatalf("MessageRepositoryMock.NextIDs mock is already set by Set")
+	}
+
+	if mmNextIDs.defaultExpectation == nil {
+		mmNextIDs.defaultExpectation = &MessageRepositoryMockNextIDsExpectation{mock: mmNextIDs.mock}
+	}
+	mmNextIDs.defaultExpectation.results = &MessageRepositoryMockNextIDsResults{ia1, err}
+	mmNextIDs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmNextIDs.mock
+}
+
+// Set uses given function f to mock the MessageRepository.NextIDs method
+func (mmNextIDs *mMessageRepositoryMockNextIDs) Set(f func(ctx context.Context, n int) (ia1 []int64, err error)) *MessageRepositoryMock {
+	if mmNextIDs.defaultExpectation != nil {
+		mmNextIDs.mock.t.Fatalf("Default expectation is already set for the MessageRepository.NextIDs method")
+	}
+
+	if len(mmNextIDs.expectations) > 0 {
+		mmNextIDs.mock.t.Fatalf("Some expectations are already set for the MessageRepository.NextIDs method")
+	}
+
+	mmNextIDs.mock.funcNextIDs = f
+	mmNextIDs.mock.funcNextIDsOrigin = minimock.CallerInfo(1)
+	return mmNextIDs.mock
+}
+
+// When sets expectation for the MessageRepository.NextIDs which will trigger the result defined by the following
+// Then helper
+func (mmNextIDs *mMessageRepositoryMockNextIDs) When(ctx context.Context, n int) *MessageRepositoryMockNextIDsExpectation {
+	if mmNextIDs.mock.funcNextIDs != nil {
+		mmNextIDs.mock.t.Fatalf("MessageRepositoryMock.NextIDs mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockNextIDsExpectation{
+		mock:               mmNextIDs.mock,
+		params:             &MessageRepositoryMockNextIDsParams{ctx, n},
+		expectationOrigins: MessageRepositoryMockNextIDsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmNextIDs.expectations = append(mmNextIDs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.NextIDs return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockNextIDsExpectation) Then(ia1 []int64, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockNextIDsResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.NextIDs should be invoked
+func (mmNextIDs *mMessageRepositoryMockNextIDs) Times(n uint64) *mMessageRepositoryMockNextIDs {
+	if n == 0 {
+		mmNextIDs.mock.t.Fatalf("Times of MessageRepositoryMock.NextIDs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmNextIDs.expectedInvocations, n)
+	mmNextIDs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmNextIDs
+}
+
+func (mmNextIDs *mMessageRepositoryMockNextIDs) invocationsDone() bool {
+	if len(mmNextIDs.expectations) == 0 && mmNextIDs.defaultExpectation == nil && mmNextIDs.mock.funcNextIDs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmNextIDs.mock.afterNextIDsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmNextIDs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// NextIDs implements mm_repository.MessageRepository
+func (mmNextIDs *MessageRepositoryMock) NextIDs(ctx context.Context, n int) (ia1 []int64, err error) {
+	mm_atomic.AddUint64(&mmNextIDs.beforeNextIDsCounter, 1)
+	defer mm_atomic.AddUint64(&mmNextIDs.afterNextIDsCounter, 1)
+
+	mmNextIDs.t.Helper()
+
+	if mmNextIDs.inspectFuncNextIDs != nil {
+		mmNextIDs.inspectFuncNextIDs(ctx, n)
+	}
+
+	mm_params := MessageRepositoryMockNextIDsParams{ctx, n}
+
+	// Record call args
+	mmNextIDs.NextIDsMock.mutex.Lock()
+	mmNextIDs.NextIDsMock.callArgs = append(mmNextIDs.NextIDsMock.callArgs, &mm_params)
+	mmNextIDs.NextIDsMock.mutex.Unlock()
+
+	for _, e := range mmNextIDs.NextIDsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmNextIDs.NextIDsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmNextIDs.NextIDsMock.defaultExpectation.Counter, 1)
+		mm_want := mmNextIDs.NextIDsMock.defaultExpectation.params
+		mm_want_ptrs := mmNextIDs.NextIDsMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockNextIDsParams{ctx, n}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmNextIDs.t.Errorf("MessageRepositoryMock.NextIDs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmNextIDs.NextIDsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.n != nil && !minimock.Equal(*mm_want_ptrs.n, mm_got.n) {
+				mmNextIDs.t.Errorf("MessageRepositoryMock.NextIDs got unexpected parameter n, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmNextIDs.NextIDsMock.defaultExpectation.expectationOrigins.originN, *mm_want_ptrs.n, mm_got.n, minimock.Diff(*mm_want_ptrs.n, mm_got.n))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmNextIDs.t.Errorf("MessageRepositoryMock.NextIDs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmNextIDs.NextIDsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmNextIDs.NextIDsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmNextIDs.t.Fatal("No results are set for the MessageRepositoryMock.NextIDs")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmNextIDs.funcNextIDs != nil {
+		return mmNextIDs.funcNextIDs(ctx, n)
+	}
+	mmNextIDs.t.Fatalf("Unexpected call to MessageRepositoryMock.NextIDs. %v %v", ctx, n)
+	return
+}
+
+// NextIDsAfterCounter returns a count of finished MessageRepositoryMock.NextIDs invocations
+func (mmNextIDs *MessageRepositoryMock) NextIDsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNextIDs.afterNextIDsCounter)
+}
+
+// NextIDsBeforeCounter returns a count of MessageRepositoryMock.NextIDs invocations
+func (mmNextIDs *MessageRepositoryMock) NextIDsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNextIDs.beforeNextIDsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.NextIDs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmNextIDs *mMessageRepositoryMockNextIDs) Calls() []*MessageRepositoryMockNextIDsParams {
+	mmNextIDs.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockNextIDsParams, len(mmNextIDs.callArgs))
+	copy(argCopy, mmNextIDs.callArgs)
+
+	mmNextIDs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockNextIDsDone returns true if the count of the NextIDs invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockNextIDsDone() bool {
+	if m.NextIDsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.NextIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.NextIDsMock.invocationsDone()
+}
+
+// MinimockNextIDsInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockNextIDsInspect() {
+	for _, e := range m.NextIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.NextIDs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterNextIDsCounter := mm_atomic.LoadUint64(&m.afterNextIDsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.NextIDsMock.defaultExpectation != nil && afterNextIDsCounter < 1 {
+		if m.NextIDsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.NextIDs at\n%s", m.NextIDsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.NextIDs at\n%s with params: %#v", m.NextIDsMock.defaultExpectation.expectationOrigins.origin, *m.NextIDsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcNextIDs != nil && afterNextIDsCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.NextIDs at\n%s", m.funcNextIDsOrigin)
+	}
+
+	if !m.NextIDsMock.invocationsDone() && afterNextIDsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.NextIDs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.NextIDsMock.expectedInvocations), m.NextIDsMock.expectedInvocationsOrigin, afterNextIDsCounter)
+	}
+}
+
+type mMessageRepositoryMockPurge struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockPurgeExpectation
+	expectations       []*MessageRepositoryMockPurgeExpectation
+
+	callArgs []*MessageRepositoryMockPurgeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockPurgeExpectation specifies expectation struct of the MessageRepository.Purge
+type MessageRepositoryMockPurgeExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockPurgeParams
+	paramPtrs          *MessageRepositoryMockPurgeParamPtrs
+	expectationOrigins MessageRepositoryMockPurgeExpectationOrigins
+	results            *MessageRepositoryMockPurgeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockPurgeParams contains parameters of the MessageRepository.Purge
+type MessageRepositoryMockPurgeParams struct {
+	ctx   context.Context
+	purge *model.MessagePurge
+}
+
+// MessageRepositoryMockPurgeParamPtrs contains pointers to parameters of the MessageRepository.Purge
+type MessageRepositoryMockPurgeParamPtrs struct {
+	ctx   *context.Context
+	purge **model.MessagePurge
+}
+
+// MessageRepositoryMockPurgeResults contains results of the MessageRepository.Purge
+type MessageRepositoryMockPurgeResults struct {
+	i1  int64
+	err error
+}
+
+// MessageRepositoryMockPurgeOrigins contains origins of expectations of the MessageRepository.Purge
+type MessageRepositoryMockPurgeExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originPurge string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurge *mMessageRepositoryMockPurge) Optional() *mMessageRepositoryMockPurge {
+	mmPurge.optional = true
+	return mmPurge
+}
+
+// Expect sets up expected params for MessageRepository.Purge
+func (mmPurge *mMessageRepositoryMockPurge) Expect(ctx context.Context, purge *model.MessagePurge) *mMessageRepositoryMockPurge {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("MessageRepositoryMock.Purge mock is already set by Set")
+	}
+
+	if mmPurge.defaultExpectation == nil {
+		mmPurge.defaultExpectation = &MessageRepositoryMockPurgeExpectation{}
+	}
+
+	if mmPurge.defaultExpectation.paramPtrs != nil {
+		mmPurge.mock.t.Fatalf("MessageRepositoryMock.Purge mock is already set by ExpectParams functions")
+	}
+
+	mmPurge.defaultExpectation.params = &MessageRepositoryMockPurgeParams{ctx, purge}
+	mmPurge.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurge.expectations {
+		if minimock.Equal(e.params, mmPurge.defaultExpectation.params) {
+			mmPurge.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurge.defaultExpectation.params)
+		}
+	}
+
+	return mmPurge
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.Purge
+func (mmPurge *mMessageRepositoryMockPurge) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockPurge {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("MessageRepositoryMock.Purge mock is already set by Set")
+	}
+
+	if mmPurge.defaultExpectation == nil {
+		mmPurge.defaultExpectation = &MessageRepositoryMockPurgeExpectation{}
+	}
+
+	if mmPurge.defaultExpectation.params != nil {
+		mmPurge.mock.t.Fatalf("MessageRepositoryMock.Purge mock is already set by Expect")
+	}
+
+	if mmPurge.defaultExpectation.paramPtrs == nil {
+		mmPurge.defaultExpectation.paramPtrs = &MessageRepositoryMockPurgeParamPtrs{}
+	}
+	mmPurge.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurge.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurge
+}
+
+// ExpectPurgeParam2 sets up expected param purge for MessageRepository.Purge
+func (mmPurge *mMessageRepositoryMockPurge) ExpectPurgeParam2(purge *model.MessagePurge) *mMessageRepositoryMockPurge {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("MessageRepositoryMock.Purge mock is already set by Set")
+	}
+
+	if mmPurge.defaultExpectation == nil {
+		mmPurge.defaultExpectation = &MessageRepositoryMockPurgeExpectation{}
+	}
+
+	if mmPurge.defaultExpectation.params != nil {
+		mmPurge.mock.t.Fatalf("MessageRepositoryMock.Purge mock is already set by Expect")
+	}
+
+	if mmPurge.defaultExpectation.paramPtrs == nil {
+		mmPurge.defaultExpectation.paramPtrs = &MessageRepositoryMockPurgeParamPtrs{}
+	}
+	mmPurge.defaultExpectation.paramPtrs.purge = &purge
+	mmPurge.defaultExpectation.expectationOrigins.originPurge = minimock.CallerInfo(1)
+
+	return mmPurge
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.Purge
+func (mmPurge *mMessageRepositoryMockPurge) Inspect(f func(ctx context.Context, purge *model.MessagePurge)) *mMessageRepositoryMockPurge {
+	if mmPurge.mock.inspectFuncPurge != nil {
+		mmPurge.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.Purge")
+	}
+
+	mmPurge.mock.inspectFuncPurge = f
+
+	return mmPurge
+}
+
+// Return sets up results that will be returned by MessageRepository.Purge
+func (mmPurge *mMessageRepositoryMockPurge) Return(i1 int64, err error) *MessageRepositoryMock {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("MessageRepositoryMock.Purge mock is already set by Set")
+	}
+
+	if mmPurge.defaultExpectation == nil {
+		mmPurge.defaultExpectation = &MessageRepositoryMockPurgeExpectation{mock: mmPurge.mock}
+	}
+	mmPurge.defaultExpectation.results = &MessageRepositoryMockPurgeResults{i1, err}
+	mmPurge.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurge.mock
+}
+
+// Set uses given function f to mock the MessageRepository.Purge method
+func (mmPurge *mMessageRepositoryMockPurge) Set(f func(ctx context.Context, purge *model.MessagePurge) (i1 int64, err error)) *MessageRepositoryMock {
+	if mmPurge.defaultExpectation != nil {
+		mmPurge.mock.t.Fatalf("Default expectation is already set for the MessageRepository.Purge method")
+	}
+
+	if len(mmPurge.expectations) > 0 {
+		mmPurge.mock.t.Fatalf("Some expectations are already set for the MessageRepository.Purge method")
+	}
+
+	mmPurge.mock.funcPurge = f
+	mmPurge.mock.funcPurgeOrigin = minimock.CallerInfo(1)
+	return mmPurge.mock
+}
+
+// When sets expectation for the MessageRepository.Purge which will trigger the result defined by the following
+// Then helper
+func (mmPurge *mMessageRepositoryMockPurge) When(ctx context.Context, purge *model.MessagePurge) *MessageRepositoryMockPurgeExpectation {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("MessageRepositoryMock.Purge mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockPurgeExpectation{
+		mock:               mmPurge.mock,
+		params:             &MessageRepositoryMockPurgeParams{ctx, purge},
+		expectationOrigins: MessageRepositoryMockPurgeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurge.expectations = append(mmPurge.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.Purge return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockPurgeExpectation) Then(i1 int64, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockPurgeResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.Purge should be invoked
+func (mmPurge *mMessageRepositoryMockPurge) Times(n uint64) *mMessageRepositoryMockPurge {
+	if n == 0 {
+		mmPurge.mock.t.Fatalf("Times of MessageRepositoryMock.Purge mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurge.expectedInvocations, n)
+	mmPurge.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurge
+}
+
+func (mmPurge *mMessageRepositoryMockPurge) invocationsDone() bool {
+	if len(mmPurge.expectations) == 0 && mmPurge.defaultExpectation == nil && mmPurge.mock.funcPurge == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurge.mock.afterPurgeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurge.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Purge implements mm_repository.MessageRepository
+func (mmPurge *MessageRepositoryMock) Purge(ctx context.Context, purge *model.MessagePurge) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurge.beforePurgeCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurge.afterPurgeCounter, 1)
+
+	mmPurge.t.Helper()
+
+	if mmPurge.inspectFuncPurge != nil {
+		mmPurge.inspectFuncPurge(ctx, purge)
+	}
+
+	mm_params := MessageRepositoryMockPurgeParams{ctx, purge}
+
+	// Record call args
+	mmPurge.PurgeMock.mutex.Lock()
+	mmPurge.PurgeMock.callArgs = append(mmPurge.PurgeMock.callArgs, &mm_params)
+	mmPurge.PurgeMock.mutex.Unlock()
+
+	for _, e := range mmPurge.PurgeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurge.PurgeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurge.PurgeMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurge.PurgeMock.defaultExpectation.params
+		mm_want_ptrs := mmPurge.PurgeMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockPurgeParams{ctx, purge}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurge.t.Errorf("MessageRepositoryMock.Purge got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurge.PurgeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.purge != nil && !minimock.Equal(*mm_want_ptrs.purge, mm_got.purge) {
+				mmPurge.t.Errorf("MessageRepositoryMock.Purge got unexpected parameter purge, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurge.PurgeMock.defaultExpectation.expectationOrigins.originPurge, *mm_want_ptrs.purge, mm_got.purge, minimock.Diff(*mm_want_ptrs.purge, mm_got.purge))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurge.t.Errorf("MessageRepositoryMock.Purge got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurge.PurgeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurge.PurgeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurge.t.Fatal("No results are set for the MessageRepositoryMock.Purge")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurge.funcPurge != nil {
+		return mmPurge.funcPurge(ctx, purge)
+	}
+	mmPurge.t.Fatalf("Unexpected call to MessageRepositoryMock.Purge. %v %v", ctx, purge)
+	return
+}
+
+// PurgeAfterCounter returns a count of finished MessageRepositoryMock.Purge invocations
+func (mmPurge *MessageRepositoryMock) PurgeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurge.afterPurgeCounter)
+}
+
+// PurgeBeforeCounter returns a count of MessageRepositoryMock.Purge invocations
+func (mmPurge *MessageRepositoryMock) PurgeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurge.beforePurgeCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.Purge.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurge *mMessageRepositoryMockPurge) Calls() []*MessageRepositoryMockPurgeParams {
+	mmPurge.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockPurgeParams, len(mmPurge.callArgs))
+	copy(argCopy, mmPurge.callArgs)
+
+	mmPurge.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeDone returns true if the count of the Purge invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockPurgeDone() bool {
+	if m.PurgeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeMock.invocationsDone()
+}
+
+// MinimockPurgeInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockPurgeInspect() {
+	for _, e := range m.PurgeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Purge at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeCounter := mm_atomic.LoadUint64(&m.afterPurgeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeMock.defaultExpectation != nil && afterPurgeCounter < 1 {
+		if m.PurgeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Purge at\n%s", m.PurgeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Purge at\n%s with params: %#v", m.PurgeMock.defaultExpectation.expectationOrigins.origin, *m.PurgeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurge != nil && afterPurgeCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.Purge at\n%s", m.funcPurgeOrigin)
+	}
+
+	if !m.PurgeMock.invocationsDone() && afterPurgeCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.Purge at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeMock.expectedInvocations), m.PurgeMock.expectedInvocationsOrigin, afterPurgeCounter)
+	}
+}
+
+type mMessageRepositoryMockReplyCounts struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockReplyCountsExpectation
+	expectations       []*MessageRepositoryMockReplyCountsExpectation
+
+	callArgs []*MessageRepositoryMockReplyCountsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockReplyCountsExpectation specifies expectation struct of the MessageRepository.ReplyCounts
+type MessageRepositoryMockReplyCountsExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockReplyCountsParams
+	paramPtrs          *MessageRepositoryMockReplyCountsParamPtrs
+	expectationOrigins MessageRepositoryMockReplyCountsExpectationOrigins
+	results            *MessageRepositoryMockReplyCountsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockReplyCountsParams contains parameters of the MessageRepository.ReplyCounts
+type MessageRepositoryMockReplyCountsParams struct {
+	ctx context.Context
+	ids []int64
+}
+
+// MessageRepositoryMockReplyCountsParamPtrs contains pointers to parameters of the MessageRepository.ReplyCounts
+type MessageRepositoryMockReplyCountsParamPtrs struct {
+	ctx *context.Context
+	ids *[]int64
+}
+
+// MessageRepositoryMockReplyCountsResults contains results of the MessageRepository.ReplyCounts
+type MessageRepositoryMockReplyCountsResults struct {
+	m1  map[int64]int64
+	err error
+}
+
+// MessageRepositoryMockReplyCountsOrigins contains origins of expectations of the MessageRepository.ReplyCounts
+type MessageRepositoryMockReplyCountsExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIds string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReplyCounts *mMessageRepositoryMockReplyCounts) Optional() *mMessageRepositoryMockReplyCounts {
+	mmReplyCounts.optional = true
+	return mmReplyCounts
+}
+
+// Expect sets up expected params for MessageRepository.ReplyCounts
+func (mmReplyCounts *mMessageRepositoryMockReplyCounts) Expect(ctx context.Context, ids []int64) *mMessageRepositoryMockReplyCounts {
+	if mmReplyCounts.mock.funcReplyCounts != nil {
+		mmReplyCounts.mock.t.Fatalf("MessageRepositoryMock.ReplyCounts mock is already set by Set")
+	}
+
+	if mmReplyCounts.defaultExpectation == nil {
+		mmReplyCounts.defaultExpectation = &MessageRepositoryMockReplyCountsExpectation{}
+	}
+
+	if mmReplyCounts.defaultExpectation.paramPtrs != nil {
+		mmReplyCounts.mock.t.Fatalf("MessageRepositoryMock.ReplyCounts mock is already set by ExpectParams functions")
+	}
+
+	mmReplyCounts.defaultExpectation.params = &MessageRepositoryMockReplyCountsParams{ctx, ids}
+	mmReplyCounts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReplyCounts.expectations {
+		if minimock.Equal(e.params, mmReplyCounts.defaultExpectation.params) {
+			mmReplyCounts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReplyCounts.defaultExpectation.params)
+		}
+	}
+
+	return mmReplyCounts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.ReplyCounts
+func (mmReplyCounts *mMessageRepositoryMockReplyCounts) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockReplyCounts {
+	if mmReplyCounts.mock.funcReplyCounts != nil {
+		mmReplyCounts.mock.t.Fatalf("MessageRepositoryMock.ReplyCounts mock is already set by Set")
+	}
+
+	if mmReplyCounts.defaultExpectation == nil {
+		mmReplyCounts.defaultExpectation = &MessageRepositoryMockReplyCountsExpectation{}
+	}
+
+	if mmReplyCounts.defaultExpectation.params != nil {
+		mmReplyCounts.mock.t.Fatalf("MessageRepositoryMock.ReplyCounts mock is already set by Expect")
+	}
+
+	if mmReplyCounts.defaultExpectation.paramPtrs == nil {
+		mmReplyCounts.defaultExpectation.paramPtrs = &MessageRepositoryMockReplyCountsParamPtrs{}
+	}
+	mmReplyCounts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReplyCounts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReplyCounts
+}
+
+// ExpectIdsParam2 sets up expected param ids for MessageRepository.ReplyCounts
+func (mmReplyCounts *mMessageRepositoryMockReplyCounts) ExpectIdsParam2(ids []int64) *mMessageRepositoryMockReplyCounts {
+	if mmReplyCounts.mock.funcReplyCounts != nil {
+		mmReplyCounts.mock.t.Fatalf("MessageRepositoryMock.ReplyCounts mock is already set by Set")
+	}
+
+	if mmReplyCounts.defaultExpectation == nil {
+		mmReplyCounts.defaultExpectation = &MessageRepositoryMockReplyCountsExpectation{}
+	}
+
+	if mmReplyCounts.defaultExpectation.params != nil {
+		mmReplyCounts.mock.t.Fatalf("MessageRepositoryMock.ReplyCounts mock is already set by Expect")
+	}
+
+	if mmReplyCounts.defaultExpectation.paramPtrs == nil {
+		mmReplyCounts.defaultExpectation.paramPtrs = &MessageRepositoryMockReplyCountsParamPtrs{}
+	}
+	mmReplyCounts.defaultExpectation.paramPtrs.ids = &ids
+	mmReplyCounts.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmReplyCounts
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.ReplyCounts
+func (mmReplyCounts *mMessageRepositoryMockReplyCounts) Inspect(f func(ctx context.Context, ids []int64)) *mMessageRepositoryMockReplyCounts {
+	if mmReplyCounts.mock.inspectFuncReplyCounts != nil {
+		mmReplyCounts.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.ReplyCounts")
+	}
+
+	mmReplyCounts.mock.inspectFuncReplyCounts = f
+
+	return mmReplyCounts
+}
+
+// Return sets up results that will be returned by MessageRepository.ReplyCounts
+func (mmReplyCounts *mMessageRepositoryMockReplyCounts) Return(m1 map[int64]int64, err error) *MessageRepositoryMock {
+	if mmReplyCounts.mock.funcReplyCounts != nil {
+		mmReplyCounts.mock.t.Fatalf("MessageRepositoryMock.ReplyCounts mock is already set by Set")
+	}
+
+	if mmReplyCounts.defaultExpectation == nil {
+		mmReplyCounts.defaultExpectation = &MessageRepositoryMockReplyCountsExpectation{mock: mmReplyCounts.mock}
+	}
+	mmReplyCounts.defaultExpectation.results = &MessageRepositoryMockReplyCountsResults{m1, err}
+	mmReplyCounts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReplyCounts.mock
+}
+
+// Set uses given function f to mock the MessageRepository.ReplyCounts method
+func (mmReplyCounts *mMessageRepositoryMockReplyCounts) Set(f func(ctx context.Context, ids []int64) (m1 map[int64]int64, err error)) *MessageRepositoryMock {
+	if mmReplyCounts.defaultExpectation != nil {
+		mmReplyCounts.mock.t.Fatalf("Default expectation is already set for the MessageRepository.ReplyCounts method")
+	}
+
+	if len(mmReplyCounts.expectations) > 0 {
+		mmReplyCounts.mock.t.Fatalf("Some expectations are already set for the MessageRepository.ReplyCounts method")
+	}
+
+	mmReplyCounts.mock.funcReplyCounts = f
+	mmReplyCounts.mock.funcReplyCountsOrigin = minimock.CallerInfo(1)
+	return mmReplyCounts.mock
+}
+
+// When sets expectation for the MessageRepository.ReplyCounts which will trigger the result defined by the following
+// Then helper
+func (mmReplyCounts *mMessageRepositoryMockReplyCounts) When(ctx context.Context, ids []int64) *MessageRepositoryMockReplyCountsExpectation {
+	if mmReplyCounts.mock.funcReplyCounts != nil {
+		mmReplyCounts.mock.t.Fatalf("MessageRepositoryMock.ReplyCounts mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockReplyCountsExpectation{
+		mock:               mmReplyCounts.mock,
+		params:             &MessageRepositoryMockReplyCountsParams{ctx, ids},
+		expectationOrigins: MessageRepositoryMockReplyCountsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReplyCounts.expectations = append(mmReplyCounts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.ReplyCounts return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockReplyCountsExpectation) Then(m1 map[int64]int64, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockReplyCountsResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.ReplyCounts should be invoked
+func (mmReplyCounts *mMessageRepositoryMockReplyCounts) Times(n uint64) *mMessageRepositoryMockReplyCounts {
+	if n == 0 {
+		mmReplyCounts.mock.t.Fatalf("Times of MessageRepositoryMock.ReplyCounts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReplyCounts.expectedInvocations, n)
+	mmReplyCounts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReplyCounts
+}
+
+func (mmReplyCounts *mMessageRepositoryMockReplyCounts) invocationsDone() bool {
+	if len(mmReplyCounts.expectations) == 0 && mmReplyCounts.defaultExpectation == nil && mmReplyCounts.mock.funcReplyCounts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReplyCounts.mock.afterReplyCountsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReplyCounts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReplyCounts implements mm_repository.MessageRepository
+func (mmReplyCounts *MessageRepositoryMock) ReplyCounts(ctx context.Context, ids []int64) (m1 map[int64]int64, err error) {
+	mm_atomic.AddUint64(&mmReplyCounts.beforeReplyCountsCounter, 1)
+	defer mm_atomic.AddUint64(&mmReplyCounts.afterReplyCountsCounter, 1)
+
+	mmReplyCounts.t.Helper()
+
+	if mmReplyCounts.inspectFuncReplyCounts != nil {
+		mmReplyCounts.inspectFuncReplyCounts(ctx, ids)
+	}
+
+	mm_params := MessageRepositoryMockReplyCountsParams{ctx, ids}
+
+	// Record call args
+	mmReplyCounts.ReplyCountsMock.mutex.Lock()
+	mmReplyCounts.ReplyCountsMock.callArgs = append(mmReplyCounts.ReplyCountsMock.callArgs, &mm_params)
+	mmReplyCounts.ReplyCountsMock.mutex.Unlock()
+
+	for _, e := range mmReplyCounts.ReplyCountsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmReplyCounts.ReplyCountsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReplyCounts.ReplyCountsMock.defaultExpectation.Counter, 1)
+		mm_want := mmReplyCounts.ReplyCountsMock.defaultExpectation.params
+		mm_want_ptrs := mmReplyCounts.ReplyCountsMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockReplyCountsParams{ctx, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReplyCounts.t.Errorf("MessageRepositoryMock.ReplyCounts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplyCounts.ReplyCountsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmReplyCounts.t.Errorf("MessageRepositoryMock.ReplyCounts got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplyCounts.ReplyCountsMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReplyCounts.t.Errorf("MessageRepositoryMock.ReplyCounts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReplyCounts.ReplyCountsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReplyCounts.ReplyCountsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReplyCounts.t.Fatal("No results are set for the MessageRepositoryMock.ReplyCounts")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmReplyCounts.funcReplyCounts != nil {
+		return mmReplyCounts.funcReplyCounts(ctx, ids)
+	}
+	mmReplyCounts.t.Fatalf("Unexpected call to MessageRepositoryMock.ReplyCounts. %v %v", ctx, ids)
+	return
+}
+
+// ReplyCountsAfterCounter returns a count of finished MessageRepositoryMock.ReplyCounts invocations
+func (mmReplyCounts *MessageRepositoryMock) ReplyCountsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplyCounts.afterReplyCountsCounter)
+}
+
+// ReplyCountsBeforeCounter returns a count of MessageRepositoryMock.ReplyCounts invocations
+func (mmReplyCounts *MessageRepositoryMock) ReplyCountsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplyCounts.beforeReplyCountsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.ReplyCounts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReplyCounts *mMessageRepositoryMockReplyCounts) Calls() []*MessageRepositoryMockReplyCountsParams {
+	mmReplyCounts.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockReplyCountsParams, len(mmReplyCounts.callArgs))
+	copy(argCopy, mmReplyCounts.callArgs)
+
+	mmReplyCounts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReplyCountsDone returns true if the count of the ReplyCounts invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockReplyCountsDone() bool {
+	if m.ReplyCountsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReplyCountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReplyCountsMock.invocationsDone()
+}
+
+// MinimockReplyCountsInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockReplyCountsInspect() {
+	for _, e := range m.ReplyCountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ReplyCounts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReplyCountsCounter := mm_atomic.LoadUint64(&m.afterReplyCountsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReplyCountsMock.defaultExpectation != nil && afterReplyCountsCounter < 1 {
+		if m.ReplyCountsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ReplyCounts at\n%s", m.ReplyCountsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ReplyCounts at\n%s with params: %#v", m.ReplyCountsMock.defaultExpectation.expectationOrigins.origin, *m.ReplyCountsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReplyCounts != nil && afterReplyCountsCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.ReplyCounts at\n%s", m.funcReplyCountsOrigin)
+	}
+
+	if !m.ReplyCountsMock.invocationsDone() && afterReplyCountsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.ReplyCounts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReplyCountsMock.expectedInvocations), m.ReplyCountsMock.expectedInvocationsOrigin, afterReplyCountsCounter)
+	}
+}
+
+type mMessageRepositoryMockSearch struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockSearchExpectation
+	expectations       []*MessageRepositoryMockSearchExpectation
+
+	callArgs []*MessageRepositoryMockSearchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockSearchExpectation specifies expectation struct of the MessageRepository.Search
+type MessageRepositoryMockSearchExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockSearchParams
+	paramPtrs          *MessageRepositoryMockSearchParamPtrs
+	expectationOrigins MessageRepositoryMockSearchExpectationOrigins
+	results            *MessageRepositoryMockSearchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockSearchParams contains parameters of the MessageRepository.Search
+type MessageRepositoryMockSearchParams struct {
+	ctx   context.Context
+	query *model.SearchQuery
+}
+
+// MessageRepositoryMockSearchParamPtrs contains pointers to parameters of the MessageRepository.Search
+type MessageRepositoryMockSearchParamPtrs struct {
+	ctx   *context.Context
+	query **model.SearchQuery
+}
+
+// MessageRepositoryMockSearchResults contains results of the MessageRepository.Search
+type MessageRepositoryMockSearchResults struct {
+	spa1 []*model.SearchResult
+	err  error
+}
+
+// MessageRepositoryMockSearchOrigins contains origins of expectations of the MessageRepository.Search
+type MessageRepositoryMockSearchExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originQuery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearch *mMessageRepositoryMockSearch) Optional() *mMessageRepositoryMockSearch {
+	mmSearch.optional = true
+	return mmSearch
+}
+
+// Expect sets up expected params for MessageRepository.Search
+func (mmSearch *mMessageRepositoryMockSearch) Expect(ctx context.Context, query *model.SearchQuery) *mMessageRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &MessageRepositoryMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by ExpectParams functions")
+	}
+
+	mmSearch.defaultExpectation.params = &MessageRepositoryMockSearchParams{ctx, query}
+	mmSearch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearch.expectations {
+		if minimock.Equal(e.params, mmSearch.defaultExpectation.params) {
+			mmSearch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearch.defaultExpectation.params)
+		}
+	}
+
+	return mmSearch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.Search
+func (mmSearch *mMessageRepositoryMockSearch) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &MessageRepositoryMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &MessageRepositoryMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// ExpectQueryParam2 sets up expected param query for MessageRepository.Search
+func (mmSearch *mMessageRepositoryMockSearch) ExpectQueryParam2(query *model.SearchQuery) *mMessageRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &MessageRepositoryMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &MessageRepositoryMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.query = &query
+	mmSearch.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.Search
+func (mmSearch *mMessageRepositoryMockSearch) Inspect(f func(ctx context.Context, query *model.SearchQuery)) *mMessageRepositoryMockSearch {
+	if mmSearch.mock.inspectFuncSearch != nil {
+		mmSearch.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.Search")
+	}
+
+	mmSearch.mock.inspectFuncSearch = f
+
+	return mmSearch
+}
+
+// Return sets up results that will be returned by MessageRepository.Search
+func (mmSearch *mMessageRepositoryMockSearch) Return(spa1 []*model.SearchResult, err error) *MessageRepositoryMock {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &MessageRepositoryMockSearchExpectation{mock: mmSearch.mock}
+	}
+	mmSearch.defaultExpectation.results = &MessageRepositoryMockSearchResults{spa1, err}
+	mmSearch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearch.mock
+}
+
+// Set uses given function f to mock the MessageRepository.Search method
+func (mmSearch *mMessageRepositoryMockSearch) Set(f func(ctx context.Context, query *model.SearchQuery) (spa1 []*model.SearchResult, err error)) *MessageRepositoryMock {
+	if mmSearch.defaultExpectation != nil {
+		mmSearch.mock.t.Fatalf("Default expectation is already set for the MessageRepository.Search method")
+	}
+
+	if len(mmSearch.expectations) > 0 {
+		mmSearch.mock.t.Fatalf("Some expectations are already set for the MessageRepository.Search method")
+	}
+
+	mmSearch.mock.funcSearch = f
+	mmSearch.mock.funcSearchOrigin = minimock.CallerInfo(1)
+	return mmSearch.mock
+}
+
+// When sets expectation for the MessageRepository.Search which will trigger the result defined by the following
+// Then helper
+func (mmSearch *mMessageRepositoryMockSearch) When(ctx context.Context, query *model.SearchQuery) *MessageRepositoryMockSearchExpectation {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockSearchExpectation{
+		mock:               mmSearch.mock,
+		params:             &MessageRepositoryMockSearchParams{ctx, query},
+		expectationOrigins: MessageRepositoryMockSearchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSearch.expectations = append(mmSearch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.Search return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockSearchExpectation) Then(spa1 []*model.SearchResult, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockSearchResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.Search should be invoked
+func (mmSearch *mMessageRepositoryMockSearch) Times(n uint64) *mMessageRepositoryMockSearch {
+	if n == 0 {
+		mmSearch.mock.t.Fatalf("Times of MessageRepositoryMock.Search mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearch.expectedInvocations, n)
+	mmSearch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearch
+}
+
+func (mmSearch *mMessageRepositoryMockSearch) invocationsDone() bool {
+	if len(mmSearch.expectations) == 0 && mmSearch.defaultExpectation == nil && mmSearch.mock.funcSearch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSearch.mock.afterSearchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Search implements mm_repository.MessageRepository
+func (mmSearch *MessageRepositoryMock) Search(ctx context.Context, query *model.SearchQuery) (spa1 []*model.SearchResult, err error) {
+	mm_atomic.AddUint64(&mmSearch.beforeSearchCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearch.afterSearchCounter, 1)
+
+	mmSearch.t.Helper()
+
+	if mmSearch.inspectFuncSearch != nil {
+		mmSearch.inspectFuncSearch(ctx, query)
+	}
+
+	mm_params := MessageRepositoryMockSearchParams{ctx, query}
+
+	// Record call args
+	mmSearch.SearchMock.mutex.Lock()
+	mmSearch.SearchMock.callArgs = append(mmSearch.SearchMock.callArgs, &mm_params)
+	mmSearch.SearchMock.mutex.Unlock()
+
+	for _, e := range mmSearch.SearchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmSearch.SearchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearch.SearchMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearch.SearchMock.defaultExpectation.params
+		mm_want_ptrs := mmSearch.SearchMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockSearchParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearch.t.Errorf("MessageRepositoryMock.Search got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmSearch.t.Errorf("MessageRepositoryMock.Search got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearch.t.Errorf("MessageRepositoryMock.Search got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearch.SearchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearch.SearchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearch.t.Fatal("No results are set for the MessageRepositoryMock.Search")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmSearch.funcSearch != nil {
+		return mmSearch.funcSearch(ctx, query)
+	}
+	mmSearch.t.Fatalf("Unexpected call to MessageRepositoryMock.Search. %v %v", ctx, query)
+	return
 }
 
-// NewMessageRepositoryMock returns a mock for mm_repository.MessageRepository
-func NewMessageRepositoryMock(t minimock.Tester) *MessageRepositoryMock {
-	m := &MessageRepositoryMock{t: t}
+// SearchAfterCounter returns a count of finished MessageRepositoryMock.Search invocations
+func (mmSearch *MessageRepositoryMock) SearchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearch.afterSearchCounter)
+}
 
-	if controller, ok := t.(minimock.MockController); ok {
-		controller.RegisterMocker(m)
+// SearchBeforeCounter returns a count of MessageRepositoryMock.Search invocations
+func (mmSearch *MessageRepositoryMock) SearchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearch.beforeSearchCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.Search.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearch *mMessageRepositoryMockSearch) Calls() []*MessageRepositoryMockSearchParams {
+	mmSearch.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockSearchParams, len(mmSearch.callArgs))
+	copy(argCopy, mmSearch.callArgs)
+
+	mmSearch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchDone returns true if the count of the Search invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockSearchDone() bool {
+	if m.SearchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	m.SendMessageMock = mMessageRepositoryMockSendMessage{mock: m}
-	m.SendMessageMock.callArgs = []*MessageRepositoryMockSendMessageParams{}
+	for _, e := range m.SearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
 
-	t.Cleanup(m.MinimockFinish)
+	return m.SearchMock.invocationsDone()
+}
 
-	return m
+// MinimockSearchInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockSearchInspect() {
+	for _, e := range m.SearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Search at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSearchCounter := mm_atomic.LoadUint64(&m.afterSearchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchMock.defaultExpectation != nil && afterSearchCounter < 1 {
+		if m.SearchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Search at\n%s", m.SearchMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Search at\n%s with params: %#v", m.SearchMock.defaultExpectation.expectationOrigins.origin, *m.SearchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearch != nil && afterSearchCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.Search at\n%s", m.funcSearchOrigin)
+	}
+
+	if !m.SearchMock.invocationsDone() && afterSearchCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.Search at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchMock.expectedInvocations), m.SearchMock.expectedInvocationsOrigin, afterSearchCounter)
+	}
 }
 
 type mMessageRepositoryMockSendMessage struct {
@@ -81,6 +4451,7 @@ type MessageRepositoryMockSendMessageParamPtrs struct {
 
 // MessageRepositoryMockSendMessageResults contains results of the MessageRepository.SendMessage
 type MessageRepositoryMockSendMessageResults struct {
+	i1  int64
 	err error
 }
 
@@ -184,7 +4555,7 @@ func (mmSendMessage *mMessageRepositoryMockSendMessage) Inspect(f func(ctx conte
 }
 
 // Return sets up results that will be returned by MessageRepository.SendMessage
-func (mmSendMessage *mMessageRepositoryMockSendMessage) Return(err error) *MessageRepositoryMock {
+func (mmSendMessage *mMessageRepositoryMockSendMessage) Return(i1 int64, err error) *MessageRepositoryMock {
 	if mmSendMessage.mock.funcSendMessage != nil {
 		mmSendMessage.mock.t.Fatalf("MessageRepositoryMock.SendMessage mock is already set by Set")
 	}
@@ -192,13 +4563,13 @@ func (mmSendMessage *mMessageRepositoryMockSendMessage) Return(err error) *Messa
 	if mmSendMessage.defaultExpectation == nil {
 		mmSendMessage.defaultExpectation = &MessageRepositoryMockSendMessageExpectation{mock: mmSendMessage.mock}
 	}
-	mmSendMessage.defaultExpectation.results = &MessageRepositoryMockSendMessageResults{err}
+	mmSendMessage.defaultExpectation.results = &MessageRepositoryMockSendMessageResults{i1, err}
 	mmSendMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmSendMessage.mock
 }
 
 // Set uses given function f to mock the MessageRepository.SendMessage method
-func (mmSendMessage *mMessageRepositoryMockSendMessage) Set(f func(ctx context.Context, message *model.SendMessage) (err error)) *MessageRepositoryMock {
+func (mmSendMessage *mMessageRepositoryMockSendMessage) Set(f func(ctx context.Context, message *model.SendMessage) (i1 int64, err error)) *MessageRepositoryMock {
 	if mmSendMessage.defaultExpectation != nil {
 		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the MessageRepository.SendMessage method")
 	}
@@ -229,8 +4600,8 @@ func (mmSendMessage *mMessageRepositoryMockSendMessage) When(ctx context.Context
 }
 
 // Then sets up MessageRepository.SendMessage return parameters for the expectation previously defined by the When method
-func (e *MessageRepositoryMockSendMessageExpectation) Then(err error) *MessageRepositoryMock {
-	e.results = &MessageRepositoryMockSendMessageResults{err}
+func (e *MessageRepositoryMockSendMessageExpectation) Then(i1 int64, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockSendMessageResults{i1, err}
 	return e.mock
 }
 
@@ -256,7 +4627,7 @@ func (mmSendMessage *mMessageRepositoryMockSendMessage) invocationsDone() bool {
 }
 
 // SendMessage implements mm_repository.MessageRepository
-func (mmSendMessage *MessageRepositoryMock) SendMessage(ctx context.Context, message *model.SendMessage) (err error) {
+func (mmSendMessage *MessageRepositoryMock) SendMessage(ctx context.Context, message *model.SendMessage) (i1 int64, err error) {
 	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
 	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
 
@@ -276,7 +4647,7 @@ func (mmSendMessage *MessageRepositoryMock) SendMessage(ctx context.Context, mes
 	for _, e := range mmSendMessage.SendMessageMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.i1, e.results.err
 		}
 	}
 
@@ -308,7 +4679,7 @@ func (mmSendMessage *MessageRepositoryMock) SendMessage(ctx context.Context, mes
 		if mm_results == nil {
 			mmSendMessage.t.Fatal("No results are set for the MessageRepositoryMock.SendMessage")
 		}
-		return (*mm_results).err
+		return (*mm_results).i1, (*mm_results).err
 	}
 	if mmSendMessage.funcSendMessage != nil {
 		return mmSendMessage.funcSendMessage(ctx, message)
@@ -385,11 +4756,441 @@ func (m *MessageRepositoryMock) MinimockSendMessageInspect() {
 	}
 }
 
+type mMessageRepositoryMockUpdate struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockUpdateExpectation
+	expectations       []*MessageRepositoryMockUpdateExpectation
+
+	callArgs []*MessageRepositoryMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockUpdateExpectation specifies expectation struct of the MessageRepository.Update
+type MessageRepositoryMockUpdateExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockUpdateParams
+	paramPtrs          *MessageRepositoryMockUpdateParamPtrs
+	expectationOrigins MessageRepositoryMockUpdateExpectationOrigins
+	results            *MessageRepositoryMockUpdateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockUpdateParams contains parameters of the MessageRepository.Update
+type MessageRepositoryMockUpdateParams struct {
+	ctx      context.Context
+	id       int64
+	text     string
+	editedAt time.Time
+}
+
+// MessageRepositoryMockUpdateParamPtrs contains pointers to parameters of the MessageRepository.Update
+type MessageRepositoryMockUpdateParamPtrs struct {
+	ctx      *context.Context
+	id       *int64
+	text     *string
+	editedAt *time.Time
+}
+
+// MessageRepositoryMockUpdateResults contains results of the MessageRepository.Update
+type MessageRepositoryMockUpdateResults struct {
+	err error
+}
+
+// MessageRepositoryMockUpdateOrigins contains origins of expectations of the MessageRepository.Update
+type MessageRepositoryMockUpdateExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originId       string
+	originText     string
+	originEditedAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mMessageRepositoryMockUpdate) Optional() *mMessageRepositoryMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for MessageRepository.Update
+func (mmUpdate *mMessageRepositoryMockUpdate) Expect(ctx context.Context, id int64, text string, editedAt time.Time) *mMessageRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("MessageRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &MessageRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("MessageRepositoryMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &MessageRepositoryMockUpdateParams{ctx, id, text, editedAt}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.Update
+func (mmUpdate *mMessageRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("MessageRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &MessageRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("MessageRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &MessageRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectIdParam2 sets up expected param id for MessageRepository.Update
+func (mmUpdate *mMessageRepositoryMockUpdate) ExpectIdParam2(id int64) *mMessageRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("MessageRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &MessageRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("MessageRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &MessageRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.id = &id
+	mmUpdate.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectTextParam3 sets up expected param text for MessageRepository.Update
+func (mmUpdate *mMessageRepositoryMockUpdate) ExpectTextParam3(text string) *mMessageRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("MessageRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &MessageRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("MessageRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &MessageRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.text = &text
+	mmUpdate.defaultExpectation.expectationOrigins.originText = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectEditedAtParam4 sets up expected param editedAt for MessageRepository.Update
+func (mmUpdate *mMessageRepositoryMockUpdate) ExpectEditedAtParam4(editedAt time.Time) *mMessageRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("MessageRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &MessageRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("MessageRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &MessageRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.editedAt = &editedAt
+	mmUpdate.defaultExpectation.expectationOrigins.originEditedAt = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.Update
+func (mmUpdate *mMessageRepositoryMockUpdate) Inspect(f func(ctx context.Context, id int64, text string, editedAt time.Time)) *mMessageRepositoryMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by MessageRepository.Update
+func (mmUpdate *mMessageRepositoryMockUpdate) Return(err error) *MessageRepositoryMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("MessageRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &MessageRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &MessageRepositoryMockUpdateResults{err}
+	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the MessageRepository.Update method
+func (mmUpdate *mMessageRepositoryMockUpdate) Set(f func(ctx context.Context, id int64, text string, editedAt time.Time) (err error)) *MessageRepositoryMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the MessageRepository.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the MessageRepository.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// When sets expectation for the MessageRepository.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mMessageRepositoryMockUpdate) When(ctx context.Context, id int64, text string, editedAt time.Time) *MessageRepositoryMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("MessageRepositoryMock.Update mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockUpdateExpectation{
+		mock:               mmUpdate.mock,
+		params:             &MessageRepositoryMockUpdateParams{ctx, id, text, editedAt},
+		expectationOrigins: MessageRepositoryMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.Update return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockUpdateExpectation) Then(err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockUpdateResults{err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.Update should be invoked
+func (mmUpdate *mMessageRepositoryMockUpdate) Times(n uint64) *mMessageRepositoryMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of MessageRepositoryMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdate
+}
+
+func (mmUpdate *mMessageRepositoryMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements mm_repository.MessageRepository
+func (mmUpdate *MessageRepositoryMock) Update(ctx context.Context, id int64, text string, editedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	mmUpdate.t.Helper()
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, id, text, editedAt)
+	}
+
+	mm_params := MessageRepositoryMockUpdateParams{ctx, id, text, editedAt}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockUpdateParams{ctx, id, text, editedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("MessageRepositoryMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdate.t.Errorf("MessageRepositoryMock.Update got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.text != nil && !minimock.Equal(*mm_want_ptrs.text, mm_got.text) {
+				mmUpdate.t.Errorf("MessageRepositoryMock.Update got unexpected parameter text, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originText, *mm_want_ptrs.text, mm_got.text, minimock.Diff(*mm_want_ptrs.text, mm_got.text))
+			}
+
+			if mm_want_ptrs.editedAt != nil && !minimock.Equal(*mm_want_ptrs.editedAt, mm_got.editedAt) {
+				mmUpdate.t.Errorf("MessageRepositoryMock.Update got unexpected parameter editedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originEditedAt, *mm_want_ptrs.editedAt, mm_got.editedAt, minimock.Diff(*mm_want_ptrs.editedAt, mm_got.editedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("MessageRepositoryMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the MessageRepositoryMock.Update")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, id, text, editedAt)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to MessageRepositoryMock.Update. %v %v %v %v", ctx, id, text, editedAt)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished MessageRepositoryMock.Update invocations
+func (mmUpdate *MessageRepositoryMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of MessageRepositoryMock.Update invocations
+func (mmUpdate *MessageRepositoryMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mMessageRepositoryMockUpdate) Calls() []*MessageRepositoryMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.Update at\n%s", m.funcUpdateOrigin)
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.Update at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *MessageRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockCopyInspect()
+
+			m.MinimockCountPurgeableInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockListAfterInspect()
+
+			m.MinimockListByIDsInspect()
+
+			m.MinimockListRepliesInspect()
+
+			m.MinimockNextIDsInspect()
+
+			m.MinimockPurgeInspect()
+
+			m.MinimockReplyCountsInspect()
+
+			m.MinimockSearchInspect()
+
 			m.MinimockSendMessageInspect()
+
+			m.MinimockUpdateInspect()
 		}
 	})
 }
@@ -413,5 +5214,18 @@ func (m *MessageRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *MessageRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockSendMessageDone()
+		m.MinimockCopyDone() &&
+		m.MinimockCountPurgeableDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockListDone() &&
+		m.MinimockListAfterDone() &&
+		m.MinimockListByIDsDone() &&
+		m.MinimockListRepliesDone() &&
+		m.MinimockNextIDsDone() &&
+		m.MinimockPurgeDone() &&
+		m.MinimockReplyCountsDone() &&
+		m.MinimockSearchDone() &&
+		m.MinimockSendMessageDone() &&
+		m.MinimockUpdateDone()
 }
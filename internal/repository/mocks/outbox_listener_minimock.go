@@ -0,0 +1,447 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Mobo140/chat/internal/repository.OutboxListener -o outbox_listener_minimock.go -n OutboxListenerMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// OutboxListenerMock implements mm_repository.OutboxListener
+type OutboxListenerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListen          func(ctx context.Context, afterID int64, handle func(ctx context.Context, id int64)) (err error)
+	funcListenOrigin    string
+	inspectFuncListen   func(ctx context.Context, afterID int64, handle func(ctx context.Context, id int64))
+	afterListenCounter  uint64
+	beforeListenCounter uint64
+	ListenMock          mOutboxListenerMockListen
+}
+
+// NewOutboxListenerMock returns a mock for mm_repository.OutboxListener
+func NewOutboxListenerMock(t minimock.Tester) *OutboxListenerMock {
+	m := &OutboxListenerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListenMock = mOutboxListenerMockListen{mock: m}
+	m.ListenMock.callArgs = []*OutboxListenerMockListenParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOutboxListenerMockListen struct {
+	optional           bool
+	mock               *OutboxListenerMock
+	defaultExpectation *OutboxListenerMockListenExpectation
+	expectations       []*OutboxListenerMockListenExpectation
+
+	callArgs []*OutboxListenerMockListenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxListenerMockListenExpectation specifies expectation struct of the OutboxListener.Listen
+type OutboxListenerMockListenExpectation struct {
+	mock               *OutboxListenerMock
+	params             *OutboxListenerMockListenParams
+	paramPtrs          *OutboxListenerMockListenParamPtrs
+	expectationOrigins OutboxListenerMockListenExpectationOrigins
+	results            *OutboxListenerMockListenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxListenerMockListenParams contains parameters of the OutboxListener.Listen
+type OutboxListenerMockListenParams struct {
+	ctx     context.Context
+	afterID int64
+	handle  func(ctx context.Context, id int64)
+}
+
+// OutboxListenerMockListenParamPtrs contains pointers to parameters of the OutboxListener.Listen
+type OutboxListenerMockListenParamPtrs struct {
+	ctx     *context.Context
+	afterID *int64
+	handle  *func(ctx context.Context, id int64)
+}
+
+// OutboxListenerMockListenResults contains results of the OutboxListener.Listen
+type OutboxListenerMockListenResults struct {
+	err error
+}
+
+// OutboxListenerMockListenOrigins contains origins of expectations of the OutboxListener.Listen
+type OutboxListenerMockListenExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originAfterID string
+	originHandle  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListen *mOutboxListenerMockListen) Optional() *mOutboxListenerMockListen {
+	mmListen.optional = true
+	return mmListen
+}
+
+// Expect sets up expected params for OutboxListener.Listen
+func (mmListen *mOutboxListenerMockListen) Expect(ctx context.Context, afterID int64, handle func(ctx context.Context, id int64)) *mOutboxListenerMockListen {
+	if mmListen.mock.funcListen != nil {
+		mmListen.mock.t.Fatalf("OutboxListenerMock.Listen mock is already set by Set")
+	}
+
+	if mmListen.defaultExpectation == nil {
+		mmListen.defaultExpectation = &OutboxListenerMockListenExpectation{}
+	}
+
+	if mmListen.defaultExpectation.paramPtrs != nil {
+		mmListen.mock.t.Fatalf("OutboxListenerMock.Listen mock is already set by ExpectParams functions")
+	}
+
+	mmListen.defaultExpectation.params = &OutboxListenerMockListenParams{ctx, afterID, handle}
+	mmListen.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListen.expectations {
+		if minimock.Equal(e.params, mmListen.defaultExpectation.params) {
+			mmListen.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListen.defaultExpectation.params)
+		}
+	}
+
+	return mmListen
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxListener.Listen
+func (mmListen *mOutboxListenerMockListen) ExpectCtxParam1(ctx context.Context) *mOutboxListenerMockListen {
+	if mmListen.mock.funcListen != nil {
+		mmListen.mock.t.Fatalf("OutboxListenerMock.Listen mock is already set by Set")
+	}
+
+	if mmListen.defaultExpectation == nil {
+		mmListen.defaultExpectation = &OutboxListenerMockListenExpectation{}
+	}
+
+	if mmListen.defaultExpectation.params != nil {
+		mmListen.mock.t.Fatalf("OutboxListenerMock.Listen mock is already set by Expect")
+	}
+
+	if mmListen.defaultExpectation.paramPtrs == nil {
+		mmListen.defaultExpectation.paramPtrs = &OutboxListenerMockListenParamPtrs{}
+	}
+	mmListen.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListen.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListen
+}
+
+// ExpectAfterIDParam2 sets up expected param afterID for OutboxListener.Listen
+func (mmListen *mOutboxListenerMockListen) ExpectAfterIDParam2(afterID int64) *mOutboxListenerMockListen {
+	if mmListen.mock.funcListen != nil {
+		mmListen.mock.t.Fatalf("OutboxListenerMock.Listen mock is already set by Set")
+	}
+
+	if mmListen.defaultExpectation == nil {
+		mmListen.defaultExpectation = &OutboxListenerMockListenExpectation{}
+	}
+
+	if mmListen.defaultExpectation.params != nil {
+		mmListen.mock.t.Fatalf("OutboxListenerMock.Listen mock is already set by Expect")
+	}
+
+	if mmListen.defaultExpectation.paramPtrs == nil {
+		mmListen.defaultExpectation.paramPtrs = &OutboxListenerMockListenParamPtrs{}
+	}
+	mmListen.defaultExpectation.paramPtrs.afterID = &afterID
+	mmListen.defaultExpectation.expectationOrigins.originAfterID = minimock.CallerInfo(1)
+
+	return mmListen
+}
+
+// ExpectHandleParam3 sets up expected param handle for OutboxListener.Listen
+func (mmListen *mOutboxListenerMockListen) ExpectHandleParam3(handle func(ctx context.Context, id int64)) *mOutboxListenerMockListen {
+	if mmListen.mock.funcListen != nil {
+		mmListen.mock.t.Fatalf("OutboxListenerMock.Listen mock is already set by Set")
+	}
+
+	if mmListen.defaultExpectation == nil {
+		mmListen.defaultExpectation = &OutboxListenerMockListenExpectation{}
+	}
+
+	if mmListen.defaultExpectation.params != nil {
+		mmListen.mock.t.Fatalf("OutboxListenerMock.Listen mock is already set by Expect")
+	}
+
+	if mmListen.defaultExpectation.paramPtrs == nil {
+		mmListen.defaultExpectation.paramPtrs = &OutboxListenerMockListenParamPtrs{}
+	}
+	mmListen.defaultExpectation.paramPtrs.handle = &handle
+	mmListen.defaultExpectation.expectationOrigins.originHandle = minimock.CallerInfo(1)
+
+	return mmListen
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxListener.Listen
+func (mmListen *mOutboxListenerMockListen) Inspect(f func(ctx context.Context, afterID int64, handle func(ctx context.Context, id int64))) *mOutboxListenerMockListen {
+	if mmListen.mock.inspectFuncListen != nil {
+		mmListen.mock.t.Fatalf("Inspect function is already set for OutboxListenerMock.Listen")
+	}
+
+	mmListen.mock.inspectFuncListen = f
+
+	return mmListen
+}
+
+// Return sets up results that will be returned by OutboxListener.Listen
+func (mmListen *mOutboxListenerMockListen) Return(err error) *OutboxListenerMock {
+	if mmListen.mock.funcListen != nil {
+		mmListen.mock.t.Fatalf("OutboxListenerMock.Listen mock is already set by Set")
+	}
+
+	if mmListen.defaultExpectation == nil {
+		mmListen.defaultExpectation = &OutboxListenerMockListenExpectation{mock: mmListen.mock}
+	}
+	mmListen.defaultExpectation.results = &OutboxListenerMockListenResults{err}
+	mmListen.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListen.mock
+}
+
+// Set uses given function f to mock the OutboxListener.Listen method
+func (mmListen *mOutboxListenerMockListen) Set(f func(ctx context.Context, afterID int64, handle func(ctx context.Context, id int64)) (err error)) *OutboxListenerMock {
+	if mmListen.defaultExpectation != nil {
+		mmListen.mock.t.Fatalf("Default expectation is already set for the OutboxListener.Listen method")
+	}
+
+	if len(mmListen.expectations) > 0 {
+		mmListen.mock.t.Fatalf("Some expectations are already set for the OutboxListener.Listen method")
+	}
+
+	mmListen.mock.funcListen = f
+	mmListen.mock.funcListenOrigin = minimock.CallerInfo(1)
+	return mmListen.mock
+}
+
+// When sets expectation for the OutboxListener.Listen which will trigger the result defined by the following
+// Then helper
+func (mmListen *mOutboxListenerMockListen) When(ctx context.Context, afterID int64, handle func(ctx context.Context, id int64)) *OutboxListenerMockListenExpectation {
+	if mmListen.mock.funcListen != nil {
+		mmListen.mock.t.Fatalf("OutboxListenerMock.Listen mock is already set by Set")
+	}
+
+	expectation := &OutboxListenerMockListenExpectation{
+		mock:               mmListen.mock,
+		params:             &OutboxListenerMockListenParams{ctx, afterID, handle},
+		expectationOrigins: OutboxListenerMockListenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListen.expectations = append(mmListen.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxListener.Listen return parameters for the expectation previously defined by the When method
+func (e *OutboxListenerMockListenExpectation) Then(err error) *OutboxListenerMock {
+	e.results = &OutboxListenerMockListenResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxListener.Listen should be invoked
+func (mmListen *mOutboxListenerMockListen) Times(n uint64) *mOutboxListenerMockListen {
+	if n == 0 {
+		mmListen.mock.t.Fatalf("Times of OutboxListenerMock.Listen mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListen.expectedInvocations, n)
+	mmListen.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListen
+}
+
+func (mmListen *mOutboxListenerMockListen) invocationsDone() bool {
+	if len(mmListen.expectations) == 0 && mmListen.defaultExpectation == nil && mmListen.mock.funcListen == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListen.mock.afterListenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListen.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Listen implements mm_repository.OutboxListener
+func (mmListen *OutboxListenerMock) Listen(ctx context.Context, afterID int64, handle func(ctx context.Context, id int64)) (err error) {
+	mm_atomic.AddUint64(&mmListen.beforeListenCounter, 1)
+	defer mm_atomic.AddUint64(&mmListen.afterListenCounter, 1)
+
+	mmListen.t.Helper()
+
+	if mmListen.inspectFuncListen != nil {
+		mmListen.inspectFuncListen(ctx, afterID, handle)
+	}
+
+	mm_params := OutboxListenerMockListenParams{ctx, afterID, handle}
+
+	// Record call args
+	mmListen.ListenMock.mutex.Lock()
+	mmListen.ListenMock.callArgs = append(mmListen.ListenMock.callArgs, &mm_params)
+	mmListen.ListenMock.mutex.Unlock()
+
+	for _, e := range mmListen.ListenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmListen.ListenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListen.ListenMock.defaultExpectation.Counter, 1)
+		mm_want := mmListen.ListenMock.defaultExpectation.params
+		mm_want_ptrs := mmListen.ListenMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxListenerMockListenParams{ctx, afterID, handle}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListen.t.Errorf("OutboxListenerMock.Listen got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListen.ListenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.afterID != nil && !minimock.Equal(*mm_want_ptrs.afterID, mm_got.afterID) {
+				mmListen.t.Errorf("OutboxListenerMock.Listen got unexpected parameter afterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListen.ListenMock.defaultExpectation.expectationOrigins.originAfterID, *mm_want_ptrs.afterID, mm_got.afterID, minimock.Diff(*mm_want_ptrs.afterID, mm_got.afterID))
+			}
+
+			if mm_want_ptrs.handle != nil && !minimock.Equal(*mm_want_ptrs.handle, mm_got.handle) {
+				mmListen.t.Errorf("OutboxListenerMock.Listen got unexpected parameter handle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListen.ListenMock.defaultExpectation.expectationOrigins.originHandle, *mm_want_ptrs.handle, mm_got.handle, minimock.Diff(*mm_want_ptrs.handle, mm_got.handle))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListen.t.Errorf("OutboxListenerMock.Listen got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListen.ListenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListen.ListenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListen.t.Fatal("No results are set for the OutboxListenerMock.Listen")
+		}
+		return (*mm_results).err
+	}
+	if mmListen.funcListen != nil {
+		return mmListen.funcListen(ctx, afterID, handle)
+	}
+	mmListen.t.Fatalf("Unexpected call to OutboxListenerMock.Listen. %v %v %v", ctx, afterID, handle)
+	return
+}
+
+// ListenAfterCounter returns a count of finished OutboxListenerMock.Listen invocations
+func (mmListen *OutboxListenerMock) ListenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListen.afterListenCounter)
+}
+
+// ListenBeforeCounter returns a count of OutboxListenerMock.Listen invocations
+func (mmListen *OutboxListenerMock) ListenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListen.beforeListenCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxListenerMock.Listen.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListen *mOutboxListenerMockListen) Calls() []*OutboxListenerMockListenParams {
+	mmListen.mutex.RLock()
+
+	argCopy := make([]*OutboxListenerMockListenParams, len(mmListen.callArgs))
+	copy(argCopy, mmListen.callArgs)
+
+	mmListen.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListenDone returns true if the count of the Listen invocations corresponds
+// the number of defined expectations
+func (m *OutboxListenerMock) MinimockListenDone() bool {
+	if m.ListenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListenMock.invocationsDone()
+}
+
+// MinimockListenInspect logs each unmet expectation
+func (m *OutboxListenerMock) MinimockListenInspect() {
+	for _, e := range m.ListenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxListenerMock.Listen at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListenCounter := mm_atomic.LoadUint64(&m.afterListenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListenMock.defaultExpectation != nil && afterListenCounter < 1 {
+		if m.ListenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxListenerMock.Listen at\n%s", m.ListenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxListenerMock.Listen at\n%s with params: %#v", m.ListenMock.defaultExpectation.expectationOrigins.origin, *m.ListenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListen != nil && afterListenCounter < 1 {
+		m.t.Errorf("Expected call to OutboxListenerMock.Listen at\n%s", m.funcListenOrigin)
+	}
+
+	if !m.ListenMock.invocationsDone() && afterListenCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxListenerMock.Listen at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListenMock.expectedInvocations), m.ListenMock.expectedInvocationsOrigin, afterListenCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OutboxListenerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListenInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OutboxListenerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OutboxListenerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListenDone()
+}
@@ -0,0 +1,2553 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Mobo140/chat/internal/repository.OutboxRepository -o outbox_repository_minimock.go -n OutboxRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// OutboxRepositoryMock implements mm_repository.OutboxRepository
+type OutboxRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCountPublished          func(ctx context.Context, maxAge time.Duration) (i1 int64, err error)
+	funcCountPublishedOrigin    string
+	inspectFuncCountPublished   func(ctx context.Context, maxAge time.Duration)
+	afterCountPublishedCounter  uint64
+	beforeCountPublishedCounter uint64
+	CountPublishedMock          mOutboxRepositoryMockCountPublished
+
+	funcCreate          func(ctx context.Context, event *model.ChatEvent) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, event *model.ChatEvent)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mOutboxRepositoryMockCreate
+
+	funcDeletePublished          func(ctx context.Context, maxAge time.Duration, limit uint64) (i1 int64, err error)
+	funcDeletePublishedOrigin    string
+	inspectFuncDeletePublished   func(ctx context.Context, maxAge time.Duration, limit uint64)
+	afterDeletePublishedCounter  uint64
+	beforeDeletePublishedCounter uint64
+	DeletePublishedMock          mOutboxRepositoryMockDeletePublished
+
+	funcGet          func(ctx context.Context, id int64) (cp1 *model.ChatEvent, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, id int64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mOutboxRepositoryMockGet
+
+	funcLastPublished          func(ctx context.Context) (i1 int64, err error)
+	funcLastPublishedOrigin    string
+	inspectFuncLastPublished   func(ctx context.Context)
+	afterLastPublishedCounter  uint64
+	beforeLastPublishedCounter uint64
+	LastPublishedMock          mOutboxRepositoryMockLastPublished
+
+	funcListPending          func(ctx context.Context, limit uint64) (cpa1 []*model.ChatEvent, err error)
+	funcListPendingOrigin    string
+	inspectFuncListPending   func(ctx context.Context, limit uint64)
+	afterListPendingCounter  uint64
+	beforeListPendingCounter uint64
+	ListPendingMock          mOutboxRepositoryMockListPending
+
+	funcMarkPublished          func(ctx context.Context, ids []int64) (err error)
+	funcMarkPublishedOrigin    string
+	inspectFuncMarkPublished   func(ctx context.Context, ids []int64)
+	afterMarkPublishedCounter  uint64
+	beforeMarkPublishedCounter uint64
+	MarkPublishedMock          mOutboxRepositoryMockMarkPublished
+}
+
+// NewOutboxRepositoryMock returns a mock for mm_repository.OutboxRepository
+func NewOutboxRepositoryMock(t minimock.Tester) *OutboxRepositoryMock {
+	m := &OutboxRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CountPublishedMock = mOutboxRepositoryMockCountPublished{mock: m}
+	m.CountPublishedMock.callArgs = []*OutboxRepositoryMockCountPublishedParams{}
+
+	m.CreateMock = mOutboxRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*OutboxRepositoryMockCreateParams{}
+
+	m.DeletePublishedMock = mOutboxRepositoryMockDeletePublished{mock: m}
+	m.DeletePublishedMock.callArgs = []*OutboxRepositoryMockDeletePublishedParams{}
+
+	m.GetMock = mOutboxRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*OutboxRepositoryMockGetParams{}
+
+	m.LastPublishedMock = mOutboxRepositoryMockLastPublished{mock: m}
+	m.LastPublishedMock.callArgs = []*OutboxRepositoryMockLastPublishedParams{}
+
+	m.ListPendingMock = mOutboxRepositoryMockListPending{mock: m}
+	m.ListPendingMock.callArgs = []*OutboxRepositoryMockListPendingParams{}
+
+	m.MarkPublishedMock = mOutboxRepositoryMockMarkPublished{mock: m}
+	m.MarkPublishedMock.callArgs = []*OutboxRepositoryMockMarkPublishedParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOutboxRepositoryMockCountPublished struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockCountPublishedExpectation
+	expectations       []*OutboxRepositoryMockCountPublishedExpectation
+
+	callArgs []*OutboxRepositoryMockCountPublishedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockCountPublishedExpectation specifies expectation struct of the OutboxRepository.CountPublished
+type OutboxRepositoryMockCountPublishedExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockCountPublishedParams
+	paramPtrs          *OutboxRepositoryMockCountPublishedParamPtrs
+	expectationOrigins OutboxRepositoryMockCountPublishedExpectationOrigins
+	results            *OutboxRepositoryMockCountPublishedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockCountPublishedParams contains parameters of the OutboxRepository.CountPublished
+type OutboxRepositoryMockCountPublishedParams struct {
+	ctx    context.Context
+	maxAge time.Duration
+}
+
+// OutboxRepositoryMockCountPublishedParamPtrs contains pointers to parameters of the OutboxRepository.CountPublished
+type OutboxRepositoryMockCountPublishedParamPtrs struct {
+	ctx    *context.Context
+	maxAge *time.Duration
+}
+
+// OutboxRepositoryMockCountPublishedResults contains results of the OutboxRepository.CountPublished
+type OutboxRepositoryMockCountPublishedResults struct {
+	i1  int64
+	err error
+}
+
+// OutboxRepositoryMockCountPublishedOrigins contains origins of expectations of the OutboxRepository.CountPublished
+type OutboxRepositoryMockCountPublishedExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originMaxAge string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountPublished *mOutboxRepositoryMockCountPublished) Optional() *mOutboxRepositoryMockCountPublished {
+	mmCountPublished.optional = true
+	return mmCountPublished
+}
+
+// Expect sets up expected params for OutboxRepository.CountPublished
+func (mmCountPublished *mOutboxRepositoryMockCountPublished) Expect(ctx context.Context, maxAge time.Duration) *mOutboxRepositoryMockCountPublished {
+	if mmCountPublished.mock.funcCountPublished != nil {
+		mmCountPublished.mock.t.Fatalf("OutboxRepositoryMock.CountPublished mock is already set by Set")
+	}
+
+	if mmCountPublished.defaultExpectation == nil {
+		mmCountPublished.defaultExpectation = &OutboxRepositoryMockCountPublishedExpectation{}
+	}
+
+	if mmCountPublished.defaultExpectation.paramPtrs != nil {
+		mmCountPublished.mock.t.Fatalf("OutboxRepositoryMock.CountPublished mock is already set by ExpectParams functions")
+	}
+
+	mmCountPublished.defaultExpectation.params = &OutboxRepositoryMockCountPublishedParams{ctx, maxAge}
+	mmCountPublished.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountPublished.expectations {
+		if minimock.Equal(e.params, mmCountPublished.defaultExpectation.params) {
+			mmCountPublished.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountPublished.defaultExpectation.params)
+		}
+	}
+
+	return mmCountPublished
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.CountPublished
+func (mmCountPublished *mOutboxRepositoryMockCountPublished) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockCountPublished {
+	if mmCountPublished.mock.funcCountPublished != nil {
+		mmCountPublished.mock.t.Fatalf("OutboxRepositoryMock.CountPublished mock is already set by Set")
+	}
+
+	if mmCountPublished.defaultExpectation == nil {
+		mmCountPublished.defaultExpectation = &OutboxRepositoryMockCountPublishedExpectation{}
+	}
+
+	if mmCountPublished.defaultExpectation.params != nil {
+		mmCountPublished.mock.t.Fatalf("OutboxRepositoryMock.CountPublished mock is already set by Expect")
+	}
+
+	if mmCountPublished.defaultExpectation.paramPtrs == nil {
+		mmCountPublished.defaultExpectation.paramPtrs = &OutboxRepositoryMockCountPublishedParamPtrs{}
+	}
+	mmCountPublished.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountPublished.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountPublished
+}
+
+// ExpectMaxAgeParam2 sets up expected param maxAge for OutboxRepository.CountPublished
+func (mmCountPublished *mOutboxRepositoryMockCountPublished) ExpectMaxAgeParam2(maxAge time.Duration) *mOutboxRepositoryMockCountPublished {
+	if mmCountPublished.mock.funcCountPublished != nil {
+		mmCountPublished.mock.t.Fatalf("OutboxRepositoryMock.CountPublished mock is already set by Set")
+	}
+
+	if mmCountPublished.defaultExpectation == nil {
+		mmCountPublished.defaultExpectation = &OutboxRepositoryMockCountPublishedExpectation{}
+	}
+
+	if mmCountPublished.defaultExpectation.params != nil {
+		mmCountPublished.mock.t.Fatalf("OutboxRepositoryMock.CountPublished mock is already set by Expect")
+	}
+
+	if mmCountPublished.defaultExpectation.paramPtrs == nil {
+		mmCountPublished.defaultExpectation.paramPtrs = &OutboxRepositoryMockCountPublishedParamPtrs{}
+	}
+	mmCountPublished.defaultExpectation.paramPtrs.maxAge = &maxAge
+	mmCountPublished.defaultExpectation.expectationOrigins.originMaxAge = minimock.CallerInfo(1)
+
+	return mmCountPublished
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.CountPublished
+func (mmCountPublished *mOutboxRepositoryMockCountPublished) Inspect(f func(ctx context.Context, maxAge time.Duration)) *mOutboxRepositoryMockCountPublished {
+	if mmCountPublished.mock.inspectFuncCountPublished != nil {
+		mmCountPublished.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.CountPublished")
+	}
+
+	mmCountPublished.mock.inspectFuncCountPublished = f
+
+	return mmCountPublished
+}
+
+// Return sets up results that will be returned by OutboxRepository.CountPublished
+func (mmCountPublished *mOutboxRepositoryMockCountPublished) Return(i1 int64, err error) *OutboxRepositoryMock {
+	if mmCountPublished.mock.funcCountPublished != nil {
+		mmCountPublished.mock.t.Fatalf("OutboxRepositoryMock.CountPublished mock is already set by Set")
+	}
+
+	if mmCountPublished.defaultExpectation == nil {
+		mmCountPublished.defaultExpectation = &OutboxRepositoryMockCountPublishedExpectation{mock: mmCountPublished.mock}
+	}
+	mmCountPublished.defaultExpectation.results = &OutboxRepositoryMockCountPublishedResults{i1, err}
+	mmCountPublished.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountPublished.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.CountPublished method
+func (mmCountPublished *mOutboxRepositoryMockCountPublished) Set(f func(ctx context.Context, maxAge time.Duration) (i1 int64, err error)) *OutboxRepositoryMock {
+	if mmCountPublished.defaultExpectation != nil {
+		mmCountPublished.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.CountPublished method")
+	}
+
+	if len(mmCountPublished.expectations) > 0 {
+		mmCountPublished.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.CountPublished method")
+	}
+
+	mmCountPublished.mock.funcCountPublished = f
+	mmCountPublished.mock.funcCountPublishedOrigin = minimock.CallerInfo(1)
+	return mmCountPublished.mock
+}
+
+// When sets expectation for the OutboxRepository.CountPublished which will trigger the result defined by the following
+// Then helper
+func (mmCountPublished *mOutboxRepositoryMockCountPublished) When(ctx context.Context, maxAge time.Duration) *OutboxRepositoryMockCountPublishedExpectation {
+	if mmCountPublished.mock.funcCountPublished != nil {
+		mmCountPublished.mock.t.Fatalf("OutboxRepositoryMock.CountPublished mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockCountPublishedExpectation{
+		mock:               mmCountPublished.mock,
+		params:             &OutboxRepositoryMockCountPublishedParams{ctx, maxAge},
+		expectationOrigins: OutboxRepositoryMockCountPublishedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountPublished.expectations = append(mmCountPublished.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.CountPublished return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockCountPublishedExpectation) Then(i1 int64, err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockCountPublishedResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.CountPublished should be invoked
+func (mmCountPublished *mOutboxRepositoryMockCountPublished) Times(n uint64) *mOutboxRepositoryMockCountPublished {
+	if n == 0 {
+		mmCountPublished.mock.t.Fatalf("Times of OutboxRepositoryMock.CountPublished mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountPublished.expectedInvocations, n)
+	mmCountPublished.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountPublished
+}
+
+func (mmCountPublished *mOutboxRepositoryMockCountPublished) invocationsDone() bool {
+	if len(mmCountPublished.expectations) == 0 && mmCountPublished.defaultExpectation == nil && mmCountPublished.mock.funcCountPublished == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountPublished.mock.afterCountPublishedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountPublished.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountPublished implements mm_repository.OutboxRepository
+func (mmCountPublished *OutboxRepositoryMock) CountPublished(ctx context.Context, maxAge time.Duration) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCountPublished.beforeCountPublishedCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountPublished.afterCountPublishedCounter, 1)
+
+	mmCountPublished.t.Helper()
+
+	if mmCountPublished.inspectFuncCountPublished != nil {
+		mmCountPublished.inspectFuncCountPublished(ctx, maxAge)
+	}
+
+	mm_params := OutboxRepositoryMockCountPublishedParams{ctx, maxAge}
+
+	// Record call args
+	mmCountPublished.CountPublishedMock.mutex.Lock()
+	mmCountPublished.CountPublishedMock.callArgs = append(mmCountPublished.CountPublishedMock.callArgs, &mm_params)
+	mmCountPublished.CountPublishedMock.mutex.Unlock()
+
+	for _, e := range mmCountPublished.CountPublishedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCountPublished.CountPublishedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountPublished.CountPublishedMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountPublished.CountPublishedMock.defaultExpectation.params
+		mm_want_ptrs := mmCountPublished.CountPublishedMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockCountPublishedParams{ctx, maxAge}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountPublished.t.Errorf("OutboxRepositoryMock.CountPublished got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountPublished.CountPublishedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.maxAge != nil && !minimock.Equal(*mm_want_ptrs.maxAge, mm_got.maxAge) {
+				mmCountPublished.t.Errorf("OutboxRepositoryMock.CountPublished got unexpected parameter maxAge, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountPublished.CountPublishedMock.defaultExpectation.expectationOrigins.originMaxAge, *mm_want_ptrs.maxAge, mm_got.maxAge, minimock.Diff(*mm_want_ptrs.maxAge, mm_got.maxAge))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountPublished.t.Errorf("OutboxRepositoryMock.CountPublished got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountPublished.CountPublishedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountPublished.CountPublishedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountPublished.t.Fatal("No results are set for the OutboxRepositoryMock.CountPublished")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCountPublished.funcCountPublished != nil {
+		return mmCountPublished.funcCountPublished(ctx, maxAge)
+	}
+	mmCountPublished.t.Fatalf("Unexpected call to OutboxRepositoryMock.CountPublished. %v %v", ctx, maxAge)
+	return
+}
+
+// CountPublishedAfterCounter returns a count of finished OutboxRepositoryMock.CountPublished invocations
+func (mmCountPublished *OutboxRepositoryMock) CountPublishedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountPublished.afterCountPublishedCounter)
+}
+
+// CountPublishedBeforeCounter returns a count of OutboxRepositoryMock.CountPublished invocations
+func (mmCountPublished *OutboxRepositoryMock) CountPublishedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountPublished.beforeCountPublishedCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.CountPublished.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountPublished *mOutboxRepositoryMockCountPublished) Calls() []*OutboxRepositoryMockCountPublishedParams {
+	mmCountPublished.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockCountPublishedParams, len(mmCountPublished.callArgs))
+	copy(argCopy, mmCountPublished.callArgs)
+
+	mmCountPublished.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountPublishedDone returns true if the count of the CountPublished invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockCountPublishedDone() bool {
+	if m.CountPublishedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountPublishedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountPublishedMock.invocationsDone()
+}
+
+// MinimockCountPublishedInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockCountPublishedInspect() {
+	for _, e := range m.CountPublishedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.CountPublished at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountPublishedCounter := mm_atomic.LoadUint64(&m.afterCountPublishedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountPublishedMock.defaultExpectation != nil && afterCountPublishedCounter < 1 {
+		if m.CountPublishedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.CountPublished at\n%s", m.CountPublishedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.CountPublished at\n%s with params: %#v", m.CountPublishedMock.defaultExpectation.expectationOrigins.origin, *m.CountPublishedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountPublished != nil && afterCountPublishedCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.CountPublished at\n%s", m.funcCountPublishedOrigin)
+	}
+
+	if !m.CountPublishedMock.invocationsDone() && afterCountPublishedCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.CountPublished at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountPublishedMock.expectedInvocations), m.CountPublishedMock.expectedInvocationsOrigin, afterCountPublishedCounter)
+	}
+}
+
+type mOutboxRepositoryMockCreate struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockCreateExpectation
+	expectations       []*OutboxRepositoryMockCreateExpectation
+
+	callArgs []*OutboxRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockCreateExpectation specifies expectation struct of the OutboxRepository.Create
+type OutboxRepositoryMockCreateExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockCreateParams
+	paramPtrs          *OutboxRepositoryMockCreateParamPtrs
+	expectationOrigins OutboxRepositoryMockCreateExpectationOrigins
+	results            *OutboxRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockCreateParams contains parameters of the OutboxRepository.Create
+type OutboxRepositoryMockCreateParams struct {
+	ctx   context.Context
+	event *model.ChatEvent
+}
+
+// OutboxRepositoryMockCreateParamPtrs contains pointers to parameters of the OutboxRepository.Create
+type OutboxRepositoryMockCreateParamPtrs struct {
+	ctx   *context.Context
+	event **model.ChatEvent
+}
+
+// OutboxRepositoryMockCreateResults contains results of the OutboxRepository.Create
+type OutboxRepositoryMockCreateResults struct {
+	err error
+}
+
+// OutboxRepositoryMockCreateOrigins contains origins of expectations of the OutboxRepository.Create
+type OutboxRepositoryMockCreateExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEvent string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mOutboxRepositoryMockCreate) Optional() *mOutboxRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for OutboxRepository.Create
+func (mmCreate *mOutboxRepositoryMockCreate) Expect(ctx context.Context, event *model.ChatEvent) *mOutboxRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OutboxRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OutboxRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("OutboxRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &OutboxRepositoryMockCreateParams{ctx, event}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.Create
+func (mmCreate *mOutboxRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OutboxRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OutboxRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("OutboxRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &OutboxRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectEventParam2 sets up expected param event for OutboxRepository.Create
+func (mmCreate *mOutboxRepositoryMockCreate) ExpectEventParam2(event *model.ChatEvent) *mOutboxRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OutboxRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OutboxRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("OutboxRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &OutboxRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.event = &event
+	mmCreate.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.Create
+func (mmCreate *mOutboxRepositoryMockCreate) Inspect(f func(ctx context.Context, event *model.ChatEvent)) *mOutboxRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by OutboxRepository.Create
+func (mmCreate *mOutboxRepositoryMockCreate) Return(err error) *OutboxRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OutboxRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OutboxRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &OutboxRepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.Create method
+func (mmCreate *mOutboxRepositoryMockCreate) Set(f func(ctx context.Context, event *model.ChatEvent) (err error)) *OutboxRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the OutboxRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mOutboxRepositoryMockCreate) When(ctx context.Context, event *model.ChatEvent) *OutboxRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OutboxRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &OutboxRepositoryMockCreateParams{ctx, event},
+		expectationOrigins: OutboxRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.Create return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockCreateExpectation) Then(err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.Create should be invoked
+func (mmCreate *mOutboxRepositoryMockCreate) Times(n uint64) *mOutboxRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of OutboxRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mOutboxRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_repository.OutboxRepository
+func (mmCreate *OutboxRepositoryMock) Create(ctx context.Context, event *model.ChatEvent) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, event)
+	}
+
+	mm_params := OutboxRepositoryMockCreateParams{ctx, event}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockCreateParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("OutboxRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmCreate.t.Errorf("OutboxRepositoryMock.Create got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("OutboxRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the OutboxRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, event)
+	}
+	mmCreate.t.Fatalf("Unexpected call to OutboxRepositoryMock.Create. %v %v", ctx, event)
+	return
+}
+
+// CreateAfterCounter returns a count of finished OutboxRepositoryMock.Create invocations
+func (mmCreate *OutboxRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of OutboxRepositoryMock.Create invocations
+func (mmCreate *OutboxRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mOutboxRepositoryMockCreate) Calls() []*OutboxRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mOutboxRepositoryMockDeletePublished struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockDeletePublishedExpectation
+	expectations       []*OutboxRepositoryMockDeletePublishedExpectation
+
+	callArgs []*OutboxRepositoryMockDeletePublishedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockDeletePublishedExpectation specifies expectation struct of the OutboxRepository.DeletePublished
+type OutboxRepositoryMockDeletePublishedExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockDeletePublishedParams
+	paramPtrs          *OutboxRepositoryMockDeletePublishedParamPtrs
+	expectationOrigins OutboxRepositoryMockDeletePublishedExpectationOrigins
+	results            *OutboxRepositoryMockDeletePublishedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockDeletePublishedParams contains parameters of the OutboxRepository.DeletePublished
+type OutboxRepositoryMockDeletePublishedParams struct {
+	ctx    context.Context
+	maxAge time.Duration
+	limit  uint64
+}
+
+// OutboxRepositoryMockDeletePublishedParamPtrs contains pointers to parameters of the OutboxRepository.DeletePublished
+type OutboxRepositoryMockDeletePublishedParamPtrs struct {
+	ctx    *context.Context
+	maxAge *time.Duration
+	limit  *uint64
+}
+
+// OutboxRepositoryMockDeletePublishedResults contains results of the OutboxRepository.DeletePublished
+type OutboxRepositoryMockDeletePublishedResults struct {
+	i1  int64
+	err error
+}
+
+// OutboxRepositoryMockDeletePublishedOrigins contains origins of expectations of the OutboxRepository.DeletePublished
+type OutboxRepositoryMockDeletePublishedExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originMaxAge string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeletePublished *mOutboxRepositoryMockDeletePublished) Optional() *mOutboxRepositoryMockDeletePublished {
+	mmDeletePublished.optional = true
+	return mmDeletePublished
+}
+
+// Expect sets up expected params for OutboxRepository.DeletePublished
+func (mmDeletePublished *mOutboxRepositoryMockDeletePublished) Expect(ctx context.Context, maxAge time.Duration, limit uint64) *mOutboxRepositoryMockDeletePublished {
+	if mmDeletePublished.mock.funcDeletePublished != nil {
+		mmDeletePublished.mock.t.Fatalf("OutboxRepositoryMock.DeletePublished mock is already set by Set")
+	}
+
+	if mmDeletePublished.defaultExpectation == nil {
+		mmDeletePublished.defaultExpectation = &OutboxRepositoryMockDeletePublishedExpectation{}
+	}
+
+	if mmDeletePublished.defaultExpectation.paramPtrs != nil {
+		mmDeletePublished.mock.t.Fatalf("OutboxRepositoryMock.DeletePublished mock is already set by ExpectParams functions")
+	}
+
+	mmDeletePublished.defaultExpectation.params = &OutboxRepositoryMockDeletePublishedParams{ctx, maxAge, limit}
+	mmDeletePublished.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeletePublished.expectations {
+		if minimock.Equal(e.params, mmDeletePublished.defaultExpectation.params) {
+			mmDeletePublished.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePublished.defaultExpectation.params)
+		}
+	}
+
+	return mmDeletePublished
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.DeletePublished
+func (mmDeletePublished *mOutboxRepositoryMockDeletePublished) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockDeletePublished {
+	if mmDeletePublished.mock.funcDeletePublished != nil {
+		mmDeletePublished.mock.t.Fatalf("OutboxRepositoryMock.DeletePublished mock is already set by Set")
+	}
+
+	if mmDeletePublished.defaultExpectation == nil {
+		mmDeletePublished.defaultExpectation = &OutboxRepositoryMockDeletePublishedExpectation{}
+	}
+
+	if mmDeletePublished.defaultExpectation.params != nil {
+		mmDeletePublished.mock.t.Fatalf("OutboxRepositoryMock.DeletePublished mock is already set by Expect")
+	}
+
+	if mmDeletePublished.defaultExpectation.paramPtrs == nil {
+		mmDeletePublished.defaultExpectation.paramPtrs = &OutboxRepositoryMockDeletePublishedParamPtrs{}
+	}
+	mmDeletePublished.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeletePublished.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeletePublished
+}
+
+// ExpectMaxAgeParam2 sets up expected param maxAge for OutboxRepository.DeletePublished
+func (mmDeletePublished *mOutboxRepositoryMockDeletePublished) ExpectMaxAgeParam2(maxAge time.Duration) *mOutboxRepositoryMockDeletePublished {
+	if mmDeletePublished.mock.funcDeletePublished != nil {
+		mmDeletePublished.mock.t.Fatalf("OutboxRepositoryMock.DeletePublished mock is already set by Set")
+	}
+
+	if mmDeletePublished.defaultExpectation == nil {
+		mmDeletePublished.defaultExpectation = &OutboxRepositoryMockDeletePublishedExpectation{}
+	}
+
+	if mmDeletePublished.defaultExpectation.params != nil {
+		mmDeletePublished.mock.t.Fatalf("OutboxRepositoryMock.DeletePublished mock is already set by Expect")
+	}
+
+	if mmDeletePublished.defaultExpectation.paramPtrs == nil {
+		mmDeletePublished.defaultExpectation.paramPtrs = &OutboxRepositoryMockDeletePublishedParamPtrs{}
+	}
+	mmDeletePublished.defaultExpectation.paramPtrs.maxAge = &maxAge
+	mmDeletePublished.defaultExpectation.expectationOrigins.originMaxAge = minimock.CallerInfo(1)
+
+	return mmDeletePublished
+}
+
+// ExpectLimitParam3 sets up expected param limit for OutboxRepository.DeletePublished
+func (mmDeletePublished *mOutboxRepositoryMockDeletePublished) ExpectLimitParam3(limit uint64) *mOutboxRepositoryMockDeletePublished {
+	if mmDeletePublished.mock.funcDeletePublished != nil {
+		mmDeletePublished.mock.t.Fatalf("OutboxRepositoryMock.DeletePublished mock is already set by Set")
+	}
+
+	if mmDeletePublished.defaultExpectation == nil {
+		mmDeletePublished.defaultExpectation = &OutboxRepositoryMockDeletePublishedExpectation{}
+	}
+
+	if mmDeletePublished.defaultExpectation.params != nil {
+		mmDeletePublished.mock.t.Fatalf("OutboxRepositoryMock.DeletePublished mock is already set by Expect")
+	}
+
+	if mmDeletePublished.defaultExpectation.paramPtrs == nil {
+		mmDeletePublished.defaultExpectation.paramPtrs = &OutboxRepositoryMockDeletePublishedParamPtrs{}
+	}
+	mmDeletePublished.defaultExpectation.paramPtrs.limit = &limit
+	mmDeletePublished.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmDeletePublished
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.DeletePublished
+func (mmDeletePublished *mOutboxRepositoryMockDeletePublished) Inspect(f func(ctx context.Context, maxAge time.Duration, limit uint64)) *mOutboxRepositoryMockDeletePublished {
+	if mmDeletePublished.mock.inspectFuncDeletePublished != nil {
+		mmDeletePublished.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.DeletePublished")
+	}
+
+	mmDeletePublished.mock.inspectFuncDeletePublished = f
+
+	return mmDeletePublished
+}
+
+// Return sets up results that will be returned by OutboxRepository.DeletePublished
+func (mmDeletePublished *mOutboxRepositoryMockDeletePublished) Return(i1 int64, err error) *OutboxRepositoryMock {
+	if mmDeletePublished.mock.funcDeletePublished != nil {
+		mmDeletePublished.mock.t.Fatalf("OutboxRepositoryMock.DeletePublished mock is already set by Set")
+	}
+
+	if mmDeletePublished.defaultExpectation == nil {
+		mmDeletePublished.defaultExpectation = &OutboxRepositoryMockDeletePublishedExpectation{mock: mmDeletePublished.mock}
+	}
+	mmDeletePublished.defaultExpectation.results = &OutboxRepositoryMockDeletePublishedResults{i1, err}
+	mmDeletePublished.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeletePublished.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.DeletePublished method
+func (mmDeletePublished *mOutboxRepositoryMockDeletePublished) Set(f func(ctx context.Context, maxAge time.Duration, limit uint64) (i1 int64, err error)) *OutboxRepositoryMock {
+	if mmDeletePublished.defaultExpectation != nil {
+		mmDeletePublished.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.DeletePublished method")
+	}
+
+	if len(mmDeletePublished.expectations) > 0 {
+		mmDeletePublished.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.DeletePublished method")
+	}
+
+	mmDeletePublished.mock.funcDeletePublished = f
+	mmDeletePublished.mock.funcDeletePublishedOrigin = minimock.CallerInfo(1)
+	return mmDeletePublished.mock
+}
+
+// When sets expectation for the OutboxRepository.DeletePublished which will trigger the result defined by the following
+// Then helper
+func (mmDeletePublished *mOutboxRepositoryMockDeletePublished) When(ctx context.Context, maxAge time.Duration, limit uint64) *OutboxRepositoryMockDeletePublishedExpectation {
+	if mmDeletePublished.mock.funcDeletePublished != nil {
+		mmDeletePublished.mock.t.Fatalf("OutboxRepositoryMock.DeletePublished mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockDeletePublishedExpectation{
+		mock:               mmDeletePublished.mock,
+		params:             &OutboxRepositoryMockDeletePublishedParams{ctx, maxAge, limit},
+		expectationOrigins: OutboxRepositoryMockDeletePublishedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeletePublished.expectations = append(mmDeletePublished.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.DeletePublished return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockDeletePublishedExpectation) Then(i1 int64, err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockDeletePublishedResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.DeletePublished should be invoked
+func (mmDeletePublished *mOutboxRepositoryMockDeletePublished) Times(n uint64) *mOutboxRepositoryMockDeletePublished {
+	if n == 0 {
+		mmDeletePublished.mock.t.Fatalf("Times of OutboxRepositoryMock.DeletePublished mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeletePublished.expectedInvocations, n)
+	mmDeletePublished.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeletePublished
+}
+
+func (mmDeletePublished *mOutboxRepositoryMockDeletePublished) invocationsDone() bool {
+	if len(mmDeletePublished.expectations) == 0 && mmDeletePublished.defaultExpectation == nil && mmDeletePublished.mock.funcDeletePublished == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeletePublished.mock.afterDeletePublishedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeletePublished.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeletePublished implements mm_repository.OutboxRepository
+func (mmDeletePublished *OutboxRepositoryMock) DeletePublished(ctx context.Context, maxAge time.Duration, limit uint64) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmDeletePublished.beforeDeletePublishedCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeletePublished.afterDeletePublishedCounter, 1)
+
+	mmDeletePublished.t.Helper()
+
+	if mmDeletePublished.inspectFuncDeletePublished != nil {
+		mmDeletePublished.inspectFuncDeletePublished(ctx, maxAge, limit)
+	}
+
+	mm_params := OutboxRepositoryMockDeletePublishedParams{ctx, maxAge, limit}
+
+	// Record call args
+	mmDeletePublished.DeletePublishedMock.mutex.Lock()
+	mmDeletePublished.DeletePublishedMock.callArgs = append(mmDeletePublished.DeletePublishedMock.callArgs, &mm_params)
+	mmDeletePublished.DeletePublishedMock.mutex.Unlock()
+
+	for _, e := range mmDeletePublished.DeletePublishedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeletePublished.DeletePublishedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeletePublished.DeletePublishedMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeletePublished.DeletePublishedMock.defaultExpectation.params
+		mm_want_ptrs := mmDeletePublished.DeletePublishedMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockDeletePublishedParams{ctx, maxAge, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeletePublished.t.Errorf("OutboxRepositoryMock.DeletePublished got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePublished.DeletePublishedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.maxAge != nil && !minimock.Equal(*mm_want_ptrs.maxAge, mm_got.maxAge) {
+				mmDeletePublished.t.Errorf("OutboxRepositoryMock.DeletePublished got unexpected parameter maxAge, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePublished.DeletePublishedMock.defaultExpectation.expectationOrigins.originMaxAge, *mm_want_ptrs.maxAge, mm_got.maxAge, minimock.Diff(*mm_want_ptrs.maxAge, mm_got.maxAge))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmDeletePublished.t.Errorf("OutboxRepositoryMock.DeletePublished got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePublished.DeletePublishedMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeletePublished.t.Errorf("OutboxRepositoryMock.DeletePublished got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeletePublished.DeletePublishedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeletePublished.DeletePublishedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeletePublished.t.Fatal("No results are set for the OutboxRepositoryMock.DeletePublished")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeletePublished.funcDeletePublished != nil {
+		return mmDeletePublished.funcDeletePublished(ctx, maxAge, limit)
+	}
+	mmDeletePublished.t.Fatalf("Unexpected call to OutboxRepositoryMock.DeletePublished. %v %v %v", ctx, maxAge, limit)
+	return
+}
+
+// DeletePublishedAfterCounter returns a count of finished OutboxRepositoryMock.DeletePublished invocations
+func (mmDeletePublished *OutboxRepositoryMock) DeletePublishedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePublished.afterDeletePublishedCounter)
+}
+
+// DeletePublishedBeforeCounter returns a count of OutboxRepositoryMock.DeletePublished invocations
+func (mmDeletePublished *OutboxRepositoryMock) DeletePublishedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePublished.beforeDeletePublishedCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.DeletePublished.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeletePublished *mOutboxRepositoryMockDeletePublished) Calls() []*OutboxRepositoryMockDeletePublishedParams {
+	mmDeletePublished.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockDeletePublishedParams, len(mmDeletePublished.callArgs))
+	copy(argCopy, mmDeletePublished.callArgs)
+
+	mmDeletePublished.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeletePublishedDone returns true if the count of the DeletePublished invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockDeletePublishedDone() bool {
+	if m.DeletePublishedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeletePublishedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeletePublishedMock.invocationsDone()
+}
+
+// MinimockDeletePublishedInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockDeletePublishedInspect() {
+	for _, e := range m.DeletePublishedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.DeletePublished at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeletePublishedCounter := mm_atomic.LoadUint64(&m.afterDeletePublishedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeletePublishedMock.defaultExpectation != nil && afterDeletePublishedCounter < 1 {
+		if m.DeletePublishedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.DeletePublished at\n%s", m.DeletePublishedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.DeletePublished at\n%s with params: %#v", m.DeletePublishedMock.defaultExpectation.expectationOrigins.origin, *m.DeletePublishedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeletePublished != nil && afterDeletePublishedCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.DeletePublished at\n%s", m.funcDeletePublishedOrigin)
+	}
+
+	if !m.DeletePublishedMock.invocationsDone() && afterDeletePublishedCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.DeletePublished at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeletePublishedMock.expectedInvocations), m.DeletePublishedMock.expectedInvocationsOrigin, afterDeletePublishedCounter)
+	}
+}
+
+type mOutboxRepositoryMockGet struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockGetExpectation
+	expectations       []*OutboxRepositoryMockGetExpectation
+
+	callArgs []*OutboxRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockGetExpectation specifies expectation struct of the OutboxRepository.Get
+type OutboxRepositoryMockGetExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockGetParams
+	paramPtrs          *OutboxRepositoryMockGetParamPtrs
+	expectationOrigins OutboxRepositoryMockGetExpectationOrigins
+	results            *OutboxRepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockGetParams contains parameters of the OutboxRepository.Get
+type OutboxRepositoryMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// OutboxRepositoryMockGetParamPtrs contains pointers to parameters of the OutboxRepository.Get
+type OutboxRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// OutboxRepositoryMockGetResults contains results of the OutboxRepository.Get
+type OutboxRepositoryMockGetResults struct {
+	cp1 *model.ChatEvent
+	err error
+}
+
+// OutboxRepositoryMockGetOrigins contains origins of expectations of the OutboxRepository.Get
+type OutboxRepositoryMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mOutboxRepositoryMockGet) Optional() *mOutboxRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for OutboxRepository.Get
+func (mmGet *mOutboxRepositoryMockGet) Expect(ctx context.Context, id int64) *mOutboxRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("OutboxRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &OutboxRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("OutboxRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &OutboxRepositoryMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.Get
+func (mmGet *mOutboxRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("OutboxRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &OutboxRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("OutboxRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &OutboxRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for OutboxRepository.Get
+func (mmGet *mOutboxRepositoryMockGet) ExpectIdParam2(id int64) *mOutboxRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("OutboxRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &OutboxRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("OutboxRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &OutboxRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.Get
+func (mmGet *mOutboxRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mOutboxRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by OutboxRepository.Get
+func (mmGet *mOutboxRepositoryMockGet) Return(cp1 *model.ChatEvent, err error) *OutboxRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("OutboxRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &OutboxRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &OutboxRepositoryMockGetResults{cp1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.Get method
+func (mmGet *mOutboxRepositoryMockGet) Set(f func(ctx context.Context, id int64) (cp1 *model.ChatEvent, err error)) *OutboxRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the OutboxRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mOutboxRepositoryMockGet) When(ctx context.Context, id int64) *OutboxRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("OutboxRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &OutboxRepositoryMockGetParams{ctx, id},
+		expectationOrigins: OutboxRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.Get return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockGetExpectation) Then(cp1 *model.ChatEvent, err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockGetResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.Get should be invoked
+func (mmGet *mOutboxRepositoryMockGet) Times(n uint64) *mOutboxRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of OutboxRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mOutboxRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repository.OutboxRepository
+func (mmGet *OutboxRepositoryMock) Get(ctx context.Context, id int64) (cp1 *model.ChatEvent, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := OutboxRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("OutboxRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("OutboxRepositoryMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("OutboxRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the OutboxRepositoryMock.Get")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to OutboxRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished OutboxRepositoryMock.Get invocations
+func (mmGet *OutboxRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of OutboxRepositoryMock.Get invocations
+func (mmGet *OutboxRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mOutboxRepositoryMockGet) Calls() []*OutboxRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mOutboxRepositoryMockLastPublished struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockLastPublishedExpectation
+	expectations       []*OutboxRepositoryMockLastPublishedExpectation
+
+	callArgs []*OutboxRepositoryMockLastPublishedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockLastPublishedExpectation specifies expectation struct of the OutboxRepository.LastPublished
+type OutboxRepositoryMockLastPublishedExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockLastPublishedParams
+	paramPtrs          *OutboxRepositoryMockLastPublishedParamPtrs
+	expectationOrigins OutboxRepositoryMockLastPublishedExpectationOrigins
+	results            *OutboxRepositoryMockLastPublishedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockLastPublishedParams contains parameters of the OutboxRepository.LastPublished
+type OutboxRepositoryMockLastPublishedParams struct {
+	ctx context.Context
+}
+
+// OutboxRepositoryMockLastPublishedParamPtrs contains pointers to parameters of the OutboxRepository.LastPublished
+type OutboxRepositoryMockLastPublishedParamPtrs struct {
+	ctx *context.Context
+}
+
+// OutboxRepositoryMockLastPublishedResults contains results of the OutboxRepository.LastPublished
+type OutboxRepositoryMockLastPublishedResults struct {
+	i1  int64
+	err error
+}
+
+// OutboxRepositoryMockLastPublishedOrigins contains origins of expectations of the OutboxRepository.LastPublished
+type OutboxRepositoryMockLastPublishedExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLastPublished *mOutboxRepositoryMockLastPublished) Optional() *mOutboxRepositoryMockLastPublished {
+	mmLastPublished.optional = true
+	return mmLastPublished
+}
+
+// Expect sets up expected params for OutboxRepository.LastPublished
+func (mmLastPublished *mOutboxRepositoryMockLastPublished) Expect(ctx context.Context) *mOutboxRepositoryMockLastPublished {
+	if mmLastPublished.mock.funcLastPublished != nil {
+		mmLastPublished.mock.t.Fatalf("OutboxRepositoryMock.LastPublished mock is already set by Set")
+	}
+
+	if mmLastPublished.defaultExpectation == nil {
+		mmLastPublished.defaultExpectation = &OutboxRepositoryMockLastPublishedExpectation{}
+	}
+
+	if mmLastPublished.defaultExpectation.paramPtrs != nil {
+		mmLastPublished.mock.t.Fatalf("OutboxRepositoryMock.LastPublished mock is already set by ExpectParams functions")
+	}
+
+	mmLastPublished.defaultExpectation.params = &OutboxRepositoryMockLastPublishedParams{ctx}
+	mmLastPublished.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLastPublished.expectations {
+		if minimock.Equal(e.params, mmLastPublished.defaultExpectation.params) {
+			mmLastPublished.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLastPublished.defaultExpectation.params)
+		}
+	}
+
+	return mmLastPublished
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.LastPublished
+func (mmLastPublished *mOutboxRepositoryMockLastPublished) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockLastPublished {
+	if mmLastPublished.mock.funcLastPublished != nil {
+		mmLastPublished.mock.t.Fatalf("OutboxRepositoryMock.LastPublished mock is already set by Set")
+	}
+
+	if mmLastPublished.defaultExpectation == nil {
+		mmLastPublished.defaultExpectation = &OutboxRepositoryMockLastPublishedExpectation{}
+	}
+
+	if mmLastPublished.defaultExpectation.params != nil {
+		mmLastPublished.mock.t.Fatalf("OutboxRepositoryMock.LastPublished mock is already set by Expect")
+	}
+
+	if mmLastPublished.defaultExpectation.paramPtrs == nil {
+		mmLastPublished.defaultExpectation.paramPtrs = &OutboxRepositoryMockLastPublishedParamPtrs{}
+	}
+	mmLastPublished.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLastPublished.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLastPublished
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.LastPublished
+func (mmLastPublished *mOutboxRepositoryMockLastPublished) Inspect(f func(ctx context.Context)) *mOutboxRepositoryMockLastPublished {
+	if mmLastPublished.mock.inspectFuncLastPublished != nil {
+		mmLastPublished.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.LastPublished")
+	}
+
+	mmLastPublished.mock.inspectFuncLastPublished = f
+
+	return mmLastPublished
+}
+
+// Return sets up results that will be returned by OutboxRepository.LastPublished
+func (mmLastPublished *mOutboxRepositoryMockLastPublished) Return(i1 int64, err error) *OutboxRepositoryMock {
+	if mmLastPublished.mock.funcLastPublished != nil {
+		mmLastPublished.mock.t.Fatalf("OutboxRepositoryMock.LastPublished mock is already set by Set")
+	}
+
+	if mmLastPublished.defaultExpectation == nil {
+		mmLastPublished.defaultExpectation = &OutboxRepositoryMockLastPublishedExpectation{mock: mmLastPublished.mock}
+	}
+	mmLastPublished.defaultExpectation.results = &OutboxRepositoryMockLastPublishedResults{i1, err}
+	mmLastPublished.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLastPublished.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.LastPublished method
+func (mmLastPublished *mOutboxRepositoryMockLastPublished) Set(f func(ctx context.Context) (i1 int64, err error)) *OutboxRepositoryMock {
+	if mmLastPublished.defaultExpectation != nil {
+		mmLastPublished.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.LastPublished method")
+	}
+
+	if len(mmLastPublished.expectations) > 0 {
+		mmLastPublished.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.LastPublished method")
+	}
+
+	mmLastPublished.mock.funcLastPublished = f
+	mmLastPublished.mock.funcLastPublishedOrigin = minimock.CallerInfo(1)
+	return mmLastPublished.mock
+}
+
+// When sets expectation for the OutboxRepository.LastPublished which will trigger the result defined by the following
+// Then helper
+func (mmLastPublished *mOutboxRepositoryMockLastPublished) When(ctx context.Context) *OutboxRepositoryMockLastPublishedExpectation {
+	if mmLastPublished.mock.funcLastPublished != nil {
+		mmLastPublished.mock.t.Fatalf("OutboxRepositoryMock.LastPublished mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockLastPublishedExpectation{
+		mock:               mmLastPublished.mock,
+		params:             &OutboxRepositoryMockLastPublishedParams{ctx},
+		expectationOrigins: OutboxRepositoryMockLastPublishedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLastPublished.expectations = append(mmLastPublished.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.LastPublished return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockLastPublishedExpectation) Then(i1 int64, err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockLastPublishedResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.LastPublished should be invoked
+func (mmLastPublished *mOutboxRepositoryMockLastPublished) Times(n uint64) *mOutboxRepositoryMockLastPublished {
+	if n == 0 {
+		mmLastPublished.mock.t.Fatalf("Times of OutboxRepositoryMock.LastPublished mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLastPublished.expectedInvocations, n)
+	mmLastPublished.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLastPublished
+}
+
+func (mmLastPublished *mOutboxRepositoryMockLastPublished) invocationsDone() bool {
+	if len(mmLastPublished.expectations) == 0 && mmLastPublished.defaultExpectation == nil && mmLastPublished.mock.funcLastPublished == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLastPublished.mock.afterLastPublishedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLastPublished.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LastPublished implements mm_repository.OutboxRepository
+func (mmLastPublished *OutboxRepositoryMock) LastPublished(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmLastPublished.beforeLastPublishedCounter, 1)
+	defer mm_atomic.AddUint64(&mmLastPublished.afterLastPublishedCounter, 1)
+
+	mmLastPublished.t.Helper()
+
+	if mmLastPublished.inspectFuncLastPublished != nil {
+		mmLastPublished.inspectFuncLastPublished(ctx)
+	}
+
+	mm_params := OutboxRepositoryMockLastPublishedParams{ctx}
+
+	// Record call args
+	mmLastPublished.LastPublishedMock.mutex.Lock()
+	mmLastPublished.LastPublishedMock.callArgs = append(mmLastPublished.LastPublishedMock.callArgs, &mm_params)
+	mmLastPublished.LastPublishedMock.mutex.Unlock()
+
+	for _, e := range mmLastPublished.LastPublishedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmLastPublished.LastPublishedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLastPublished.LastPublishedMock.defaultExpectation.Counter, 1)
+		mm_want := mmLastPublished.LastPublishedMock.defaultExpectation.params
+		mm_want_ptrs := mmLastPublished.LastPublishedMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockLastPublishedParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLastPublished.t.Errorf("OutboxRepositoryMock.LastPublished got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLastPublished.LastPublishedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLastPublished.t.Errorf("OutboxRepositoryMock.LastPublished got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLastPublished.LastPublishedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLastPublished.LastPublishedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLastPublished.t.Fatal("No results are set for the OutboxRepositoryMock.LastPublished")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmLastPublished.funcLastPublished != nil {
+		return mmLastPublished.funcLastPublished(ctx)
+	}
+	mmLastPublished.t.Fatalf("Unexpected call to OutboxRepositoryMock.LastPublished. %v", ctx)
+	return
+}
+
+// LastPublishedAfterCounter returns a count of finished OutboxRepositoryMock.LastPublished invocations
+func (mmLastPublished *OutboxRepositoryMock) LastPublishedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastPublished.afterLastPublishedCounter)
+}
+
+// LastPublishedBeforeCounter returns a count of OutboxRepositoryMock.LastPublished invocations
+func (mmLastPublished *OutboxRepositoryMock) LastPublishedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastPublished.beforeLastPublishedCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.LastPublished.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLastPublished *mOutboxRepositoryMockLastPublished) Calls() []*OutboxRepositoryMockLastPublishedParams {
+	mmLastPublished.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockLastPublishedParams, len(mmLastPublished.callArgs))
+	copy(argCopy, mmLastPublished.callArgs)
+
+	mmLastPublished.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLastPublishedDone returns true if the count of the LastPublished invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockLastPublishedDone() bool {
+	if m.LastPublishedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LastPublishedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LastPublishedMock.invocationsDone()
+}
+
+// MinimockLastPublishedInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockLastPublishedInspect() {
+	for _, e := range m.LastPublishedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.LastPublished at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLastPublishedCounter := mm_atomic.LoadUint64(&m.afterLastPublishedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LastPublishedMock.defaultExpectation != nil && afterLastPublishedCounter < 1 {
+		if m.LastPublishedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.LastPublished at\n%s", m.LastPublishedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.LastPublished at\n%s with params: %#v", m.LastPublishedMock.defaultExpectation.expectationOrigins.origin, *m.LastPublishedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLastPublished != nil && afterLastPublishedCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.LastPublished at\n%s", m.funcLastPublishedOrigin)
+	}
+
+	if !m.LastPublishedMock.invocationsDone() && afterLastPublishedCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.LastPublished at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LastPublishedMock.expectedInvocations), m.LastPublishedMock.expectedInvocationsOrigin, afterLastPublishedCounter)
+	}
+}
+
+type mOutboxRepositoryMockListPending struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockListPendingExpectation
+	expectations       []*OutboxRepositoryMockListPendingExpectation
+
+	callArgs []*OutboxRepositoryMockListPendingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockListPendingExpectation specifies expectation struct of the OutboxRepository.ListPending
+type OutboxRepositoryMockListPendingExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockListPendingParams
+	paramPtrs          *OutboxRepositoryMockListPendingParamPtrs
+	expectationOrigins OutboxRepositoryMockListPendingExpectationOrigins
+	results            *OutboxRepositoryMockListPendingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockListPendingParams contains parameters of the OutboxRepository.ListPending
+type OutboxRepositoryMockListPendingParams struct {
+	ctx   context.Context
+	limit uint64
+}
+
+// OutboxRepositoryMockListPendingParamPtrs contains pointers to parameters of the OutboxRepository.ListPending
+type OutboxRepositoryMockListPendingParamPtrs struct {
+	ctx   *context.Context
+	limit *uint64
+}
+
+// OutboxRepositoryMockListPendingResults contains results of the OutboxRepository.ListPending
+type OutboxRepositoryMockListPendingResults struct {
+	cpa1 []*model.ChatEvent
+	err  error
+}
+
+// OutboxRepositoryMockListPendingOrigins contains origins of expectations of the OutboxRepository.ListPending
+type OutboxRepositoryMockListPendingExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPending *mOutboxRepositoryMockListPending) Optional() *mOutboxRepositoryMockListPending {
+	mmListPending.optional = true
+	return mmListPending
+}
+
+// Expect sets up expected params for OutboxRepository.ListPending
+func (mmListPending *mOutboxRepositoryMockListPending) Expect(ctx context.Context, limit uint64) *mOutboxRepositoryMockListPending {
+	if mmListPending.mock.funcListPending != nil {
+		mmListPending.mock.t.Fatalf("OutboxRepositoryMock.ListPending mock is already set by Set")
+	}
+
+	if mmListPending.defaultExpectation == nil {
+		mmListPending.defaultExpectation = &OutboxRepositoryMockListPendingExpectation{}
+	}
+
+	if mmListPending.defaultExpectation.paramPtrs != nil {
+		mmListPending.mock.t.Fatalf("OutboxRepositoryMock.ListPending mock is already set by ExpectParams functions")
+	}
+
+	mmListPending.defaultExpectation.params = &OutboxRepositoryMockListPendingParams{ctx, limit}
+	mmListPending.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPending.expectations {
+		if minimock.Equal(e.params, mmListPending.defaultExpectation.params) {
+			mmListPending.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPending.defaultExpectation.params)
+		}
+	}
+
+	return mmListPending
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.ListPending
+func (mmListPending *mOutboxRepositoryMockListPending) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockListPending {
+	if mmListPending.mock.funcListPending != nil {
+		mmListPending.mock.t.Fatalf("OutboxRepositoryMock.ListPending mock is already set by Set")
+	}
+
+	if mmListPending.defaultExpectation == nil {
+		mmListPending.defaultExpectation = &OutboxRepositoryMockListPendingExpectation{}
+	}
+
+	if mmListPending.defaultExpectation.params != nil {
+		mmListPending.mock.t.Fatalf("OutboxRepositoryMock.ListPending mock is already set by Expect")
+	}
+
+	if mmListPending.defaultExpectation.paramPtrs == nil {
+		mmListPending.defaultExpectation.paramPtrs = &OutboxRepositoryMockListPendingParamPtrs{}
+	}
+	mmListPending.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPending.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPending
+}
+
+// ExpectLimitParam2 sets up expected param limit for OutboxRepository.ListPending
+func (mmListPending *mOutboxRepositoryMockListPending) ExpectLimitParam2(limit uint64) *mOutboxRepositoryMockListPending {
+	if mmListPending.mock.funcListPending != nil {
+		mmListPending.mock.t.Fatalf("OutboxRepositoryMock.ListPending mock is already set by Set")
+	}
+
+	if mmListPending.defaultExpectation == nil {
+		mmListPending.defaultExpectation = &OutboxRepositoryMockListPendingExpectation{}
+	}
+
+	if mmListPending.defaultExpectation.params != nil {
+		mmListPending.mock.t.Fatalf("OutboxRepositoryMock.ListPending mock is already set by Expect")
+	}
+
+	if mmListPending.defaultExpectation.paramPtrs == nil {
+		mmListPending.defaultExpectation.paramPtrs = &OutboxRepositoryMockListPendingParamPtrs{}
+	}
+	mmListPending.defaultExpectation.paramPtrs.limit = &limit
+	mmListPending.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListPending
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.ListPending
+func (mmListPending *mOutboxRepositoryMockListPending) Inspect(f func(ctx context.Context, limit uint64)) *mOutboxRepositoryMockListPending {
+	if mmListPending.mock.inspectFuncListPending != nil {
+		mmListPending.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.ListPending")
+	}
+
+	mmListPending.mock.inspectFuncListPending = f
+
+	return mmListPending
+}
+
+// Return sets up results that will be returned by OutboxRepository.ListPending
+func (mmListPending *mOutboxRepositoryMockListPending) Return(cpa1 []*model.ChatEvent, err error) *OutboxRepositoryMock {
+	if mmListPending.mock.funcListPending != nil {
+		mmListPending.mock.t.Fatalf("OutboxRepositoryMock.ListPending mock is already set by Set")
+	}
+
+	if mmListPending.defaultExpectation == nil {
+		mmListPending.defaultExpectation = &OutboxRepositoryMockListPendingExpectation{mock: mmListPending.mock}
+	}
+	mmListPending.defaultExpectation.results = &OutboxRepositoryMockListPendingResults{cpa1, err}
+	mmListPending.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPending.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.ListPending method
+func (mmListPending *mOutboxRepositoryMockListPending) Set(f func(ctx context.Context, limit uint64) (cpa1 []*model.ChatEvent, err error)) *OutboxRepositoryMock {
+	if mmListPending.defaultExpectation != nil {
+		mmListPending.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.ListPending method")
+	}
+
+	if len(mmListPending.expectations) > 0 {
+		mmListPending.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.ListPending method")
+	}
+
+	mmListPending.mock.funcListPending = f
+	mmListPending.mock.funcListPendingOrigin = minimock.CallerInfo(1)
+	return mmListPending.mock
+}
+
+// When sets expectation for the OutboxRepository.ListPending which will trigger the result defined by the following
+// Then helper
+func (mmListPending *mOutboxRepositoryMockListPending) When(ctx context.Context, limit uint64) *OutboxRepositoryMockListPendingExpectation {
+	if mmListPending.mock.funcListPending != nil {
+		mmListPending.mock.t.Fatalf("OutboxRepositoryMock.ListPending mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockListPendingExpectation{
+		mock:               mmListPending.mock,
+		params:             &OutboxRepositoryMockListPendingParams{ctx, limit},
+		expectationOrigins: OutboxRepositoryMockListPendingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPending.expectations = append(mmListPending.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.ListPending return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockListPendingExpectation) Then(cpa1 []*model.ChatEvent, err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockListPendingResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.ListPending should be invoked
+func (mmListPending *mOutboxRepositoryMockListPending) Times(n uint64) *mOutboxRepositoryMockListPending {
+	if n == 0 {
+		mmListPending.mock.t.Fatalf("Times of OutboxRepositoryMock.ListPending mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPending.expectedInvocations, n)
+	mmListPending.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPending
+}
+
+func (mmListPending *mOutboxRepositoryMockListPending) invocationsDone() bool {
+	if len(mmListPending.expectations) == 0 && mmListPending.defaultExpectation == nil && mmListPending.mock.funcListPending == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPending.mock.afterListPendingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPending.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPending implements mm_repository.OutboxRepository
+func (mmListPending *OutboxRepositoryMock) ListPending(ctx context.Context, limit uint64) (cpa1 []*model.ChatEvent, err error) {
+	mm_atomic.AddUint64(&mmListPending.beforeListPendingCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPending.afterListPendingCounter, 1)
+
+	mmListPending.t.Helper()
+
+	if mmListPending.inspectFuncListPending != nil {
+		mmListPending.inspectFuncListPending(ctx, limit)
+	}
+
+	mm_params := OutboxRepositoryMockListPendingParams{ctx, limit}
+
+	// Record call args
+	mmListPending.ListPendingMock.mutex.Lock()
+	mmListPending.ListPendingMock.callArgs = append(mmListPending.ListPendingMock.callArgs, &mm_params)
+	mmListPending.ListPendingMock.mutex.Unlock()
+
+	for _, e := range mmListPending.ListPendingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmListPending.ListPendingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPending.ListPendingMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPending.ListPendingMock.defaultExpectation.params
+		mm_want_ptrs := mmListPending.ListPendingMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockListPendingParams{ctx, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPending.t.Errorf("OutboxRepositoryMock.ListPending got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPending.ListPendingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListPending.t.Errorf("OutboxRepositoryMock.ListPending got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPending.ListPendingMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPending.t.Errorf("OutboxRepositoryMock.ListPending got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPending.ListPendingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPending.ListPendingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPending.t.Fatal("No results are set for the OutboxRepositoryMock.ListPending")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmListPending.funcListPending != nil {
+		return mmListPending.funcListPending(ctx, limit)
+	}
+	mmListPending.t.Fatalf("Unexpected call to OutboxRepositoryMock.ListPending. %v %v", ctx, limit)
+	return
+}
+
+// ListPendingAfterCounter returns a count of finished OutboxRepositoryMock.ListPending invocations
+func (mmListPending *OutboxRepositoryMock) ListPendingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPending.afterListPendingCounter)
+}
+
+// ListPendingBeforeCounter returns a count of OutboxRepositoryMock.ListPending invocations
+func (mmListPending *OutboxRepositoryMock) ListPendingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPending.beforeListPendingCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.ListPending.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPending *mOutboxRepositoryMockListPending) Calls() []*OutboxRepositoryMockListPendingParams {
+	mmListPending.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockListPendingParams, len(mmListPending.callArgs))
+	copy(argCopy, mmListPending.callArgs)
+
+	mmListPending.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPendingDone returns true if the count of the ListPending invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockListPendingDone() bool {
+	if m.ListPendingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPendingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPendingMock.invocationsDone()
+}
+
+// MinimockListPendingInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockListPendingInspect() {
+	for _, e := range m.ListPendingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.ListPending at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPendingCounter := mm_atomic.LoadUint64(&m.afterListPendingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPendingMock.defaultExpectation != nil && afterListPendingCounter < 1 {
+		if m.ListPendingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.ListPending at\n%s", m.ListPendingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.ListPending at\n%s with params: %#v", m.ListPendingMock.defaultExpectation.expectationOrigins.origin, *m.ListPendingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPending != nil && afterListPendingCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.ListPending at\n%s", m.funcListPendingOrigin)
+	}
+
+	if !m.ListPendingMock.invocationsDone() && afterListPendingCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.ListPending at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPendingMock.expectedInvocations), m.ListPendingMock.expectedInvocationsOrigin, afterListPendingCounter)
+	}
+}
+
+type mOutboxRepositoryMockMarkPublished struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockMarkPublishedExpectation
+	expectations       []*OutboxRepositoryMockMarkPublishedExpectation
+
+	callArgs []*OutboxRepositoryMockMarkPublishedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OutboxRepositoryMockMarkPublishedExpectation specifies expectation struct of the OutboxRepository.MarkPublished
+type OutboxRepositoryMockMarkPublishedExpectation struct {
+	mock               *OutboxRepositoryMock
+	params             *OutboxRepositoryMockMarkPublishedParams
+	paramPtrs          *OutboxRepositoryMockMarkPublishedParamPtrs
+	expectationOrigins OutboxRepositoryMockMarkPublishedExpectationOrigins
+	results            *OutboxRepositoryMockMarkPublishedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OutboxRepositoryMockMarkPublishedParams contains parameters of the OutboxRepository.MarkPublished
+type OutboxRepositoryMockMarkPublishedParams struct {
+	ctx context.Context
+	ids []int64
+}
+
+// OutboxRepositoryMockMarkPublishedParamPtrs contains pointers to parameters of the OutboxRepository.MarkPublished
+type OutboxRepositoryMockMarkPublishedParamPtrs struct {
+	ctx *context.Context
+	ids *[]int64
+}
+
+// OutboxRepositoryMockMarkPublishedResults contains results of the OutboxRepository.MarkPublished
+type OutboxRepositoryMockMarkPublishedResults struct {
+	err error
+}
+
+// OutboxRepositoryMockMarkPublishedOrigins contains origins of expectations of the OutboxRepository.MarkPublished
+type OutboxRepositoryMockMarkPublishedExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIds string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkPublished *mOutboxRepositoryMockMarkPublished) Optional() *mOutboxRepositoryMockMarkPublished {
+	mmMarkPublished.optional = true
+	return mmMarkPublished
+}
+
+// Expect sets up expected params for OutboxRepository.MarkPublished
+func (mmMarkPublished *mOutboxRepositoryMockMarkPublished) Expect(ctx context.Context, ids []int64) *mOutboxRepositoryMockMarkPublished {
+	if mmMarkPublished.mock.funcMarkPublished != nil {
+		mmMarkPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkPublished mock is already set by Set")
+	}
+
+	if mmMarkPublished.defaultExpectation == nil {
+		mmMarkPublished.defaultExpectation = &OutboxRepositoryMockMarkPublishedExpectation{}
+	}
+
+	if mmMarkPublished.defaultExpectation.paramPtrs != nil {
+		mmMarkPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkPublished mock is already set by ExpectParams functions")
+	}
+
+	mmMarkPublished.defaultExpectation.params = &OutboxRepositoryMockMarkPublishedParams{ctx, ids}
+	mmMarkPublished.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkPublished.expectations {
+		if minimock.Equal(e.params, mmMarkPublished.defaultExpectation.params) {
+			mmMarkPublished.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkPublished.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkPublished
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.MarkPublished
+func (mmMarkPublished *mOutboxRepositoryMockMarkPublished) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockMarkPublished {
+	if mmMarkPublished.mock.funcMarkPublished != nil {
+		mmMarkPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkPublished mock is already set by Set")
+	}
+
+	if mmMarkPublished.defaultExpectation == nil {
+		mmMarkPublished.defaultExpectation = &OutboxRepositoryMockMarkPublishedExpectation{}
+	}
+
+	if mmMarkPublished.defaultExpectation.params != nil {
+		mmMarkPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkPublished mock is already set by Expect")
+	}
+
+	if mmMarkPublished.defaultExpectation.paramPtrs == nil {
+		mmMarkPublished.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkPublishedParamPtrs{}
+	}
+	mmMarkPublished.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkPublished.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkPublished
+}
+
+// ExpectIdsParam2 sets up expected param ids for OutboxRepository.MarkPublished
+func (mmMarkPublished *mOutboxRepositoryMockMarkPublished) ExpectIdsParam2(ids []int64) *mOutboxRepositoryMockMarkPublished {
+	if mmMarkPublished.mock.funcMarkPublished != nil {
+		mmMarkPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkPublished mock is already set by Set")
+	}
+
+	if mmMarkPublished.defaultExpectation == nil {
+		mmMarkPublished.defaultExpectation = &OutboxRepositoryMockMarkPublishedExpectation{}
+	}
+
+	if mmMarkPublished.defaultExpectation.params != nil {
+		mmMarkPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkPublished mock is already set by Expect")
+	}
+
+	if mmMarkPublished.defaultExpectation.paramPtrs == nil {
+		mmMarkPublished.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkPublishedParamPtrs{}
+	}
+	mmMarkPublished.defaultExpectation.paramPtrs.ids = &ids
+	mmMarkPublished.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmMarkPublished
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.MarkPublished
+func (mmMarkPublished *mOutboxRepositoryMockMarkPublished) Inspect(f func(ctx context.Context, ids []int64)) *mOutboxRepositoryMockMarkPublished {
+	if mmMarkPublished.mock.inspectFuncMarkPublished != nil {
+		mmMarkPublished.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.MarkPublished")
+	}
+
+	mmMarkPublished.mock.inspectFuncMarkPublished = f
+
+	return mmMarkPublished
+}
+
+// Return sets up results that will be returned by OutboxRepository.MarkPublished
+func (mmMarkPublished *mOutboxRepositoryMockMarkPublished) Return(err error) *OutboxRepositoryMock {
+	if mmMarkPublished.mock.funcMarkPublished != nil {
+		mmMarkPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkPublished mock is already set by Set")
+	}
+
+	if mmMarkPublished.defaultExpectation == nil {
+		mmMarkPublished.defaultExpectation = &OutboxRepositoryMockMarkPublishedExpectation{mock: mmMarkPublished.mock}
+	}
+	mmMarkPublished.defaultExpectation.results = &OutboxRepositoryMockMarkPublishedResults{err}
+	mmMarkPublished.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkPublished.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.MarkPublished method
+func (mmMarkPublished *mOutboxRepositoryMockMarkPublished) Set(f func(ctx context.Context, ids []int64) (err error)) *OutboxRepositoryMock {
+	if mmMarkPublished.defaultExpectation != nil {
+		mmMarkPublished.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.MarkPublished method")
+	}
+
+	if len(mmMarkPublished.expectations) > 0 {
+		mmMarkPublished.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.MarkPublished method")
+	}
+
+	mmMarkPublished.mock.funcMarkPublished = f
+	mmMarkPublished.mock.funcMarkPublishedOrigin = minimock.CallerInfo(1)
+	return mmMarkPublished.mock
+}
+
+// When sets expectation for the OutboxRepository.MarkPublished which will trigger the result defined by the following
+// Then helper
+func (mmMarkPublished *mOutboxRepositoryMockMarkPublished) When(ctx context.Context, ids []int64) *OutboxRepositoryMockMarkPublishedExpectation {
+	if mmMarkPublished.mock.funcMarkPublished != nil {
+		mmMarkPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkPublished mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockMarkPublishedExpectation{
+		mock:               mmMarkPublished.mock,
+		params:             &OutboxRepositoryMockMarkPublishedParams{ctx, ids},
+		expectationOrigins: OutboxRepositoryMockMarkPublishedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkPublished.expectations = append(mmMarkPublished.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.MarkPublished return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockMarkPublishedExpectation) Then(err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockMarkPublishedResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.MarkPublished should be invoked
+func (mmMarkPublished *mOutboxRepositoryMockMarkPublished) Times(n uint64) *mOutboxRepositoryMockMarkPublished {
+	if n == 0 {
+		mmMarkPublished.mock.t.Fatalf("Times of OutboxRepositoryMock.MarkPublished mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkPublished.expectedInvocations, n)
+	mmMarkPublished.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkPublished
+}
+
+func (mmMarkPublished *mOutboxRepositoryMockMarkPublished) invocationsDone() bool {
+	if len(mmMarkPublished.expectations) == 0 && mmMarkPublished.defaultExpectation == nil && mmMarkPublished.mock.funcMarkPublished == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkPublished.mock.afterMarkPublishedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkPublished.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkPublished implements mm_repository.OutboxRepository
+func (mmMarkPublished *OutboxRepositoryMock) MarkPublished(ctx context.Context, ids []int64) (err error) {
+	mm_atomic.AddUint64(&mmMarkPublished.beforeMarkPublishedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkPublished.afterMarkPublishedCounter, 1)
+
+	mmMarkPublished.t.Helper()
+
+	if mmMarkPublished.inspectFuncMarkPublished != nil {
+		mmMarkPublished.inspectFuncMarkPublished(ctx, ids)
+	}
+
+	mm_params := OutboxRepositoryMockMarkPublishedParams{ctx, ids}
+
+	// Record call args
+	mmMarkPublished.MarkPublishedMock.mutex.Lock()
+	mmMarkPublished.MarkPublishedMock.callArgs = append(mmMarkPublished.MarkPublishedMock.callArgs, &mm_params)
+	mmMarkPublished.MarkPublishedMock.mutex.Unlock()
+
+	for _, e := range mmMarkPublished.MarkPublishedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkPublished.MarkPublishedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkPublished.MarkPublishedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkPublished.MarkPublishedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkPublished.MarkPublishedMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockMarkPublishedParams{ctx, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkPublished.t.Errorf("OutboxRepositoryMock.MarkPublished got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkPublished.MarkPublishedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmMarkPublished.t.Errorf("OutboxRepositoryMock.MarkPublished got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkPublished.MarkPublishedMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkPublished.t.Errorf("OutboxRepositoryMock.MarkPublished got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkPublished.MarkPublishedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkPublished.MarkPublishedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkPublished.t.Fatal("No results are set for the OutboxRepositoryMock.MarkPublished")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkPublished.funcMarkPublished != nil {
+		return mmMarkPublished.funcMarkPublished(ctx, ids)
+	}
+	mmMarkPublished.t.Fatalf("Unexpected call to OutboxRepositoryMock.MarkPublished. %v %v", ctx, ids)
+	return
+}
+
+// MarkPublishedAfterCounter returns a count of finished OutboxRepositoryMock.MarkPublished invocations
+func (mmMarkPublished *OutboxRepositoryMock) MarkPublishedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkPublished.afterMarkPublishedCounter)
+}
+
+// MarkPublishedBeforeCounter returns a count of OutboxRepositoryMock.MarkPublished invocations
+func (mmMarkPublished *OutboxRepositoryMock) MarkPublishedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkPublished.beforeMarkPublishedCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.MarkPublished.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkPublished *mOutboxRepositoryMockMarkPublished) Calls() []*OutboxRepositoryMockMarkPublishedParams {
+	mmMarkPublished.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockMarkPublishedParams, len(mmMarkPublished.callArgs))
+	copy(argCopy, mmMarkPublished.callArgs)
+
+	mmMarkPublished.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkPublishedDone returns true if the count of the MarkPublished invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockMarkPublishedDone() bool {
+	if m.MarkPublishedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkPublishedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkPublishedMock.invocationsDone()
+}
+
+// MinimockMarkPublishedInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockMarkPublishedInspect() {
+	for _, e := range m.MarkPublishedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkPublished at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkPublishedCounter := mm_atomic.LoadUint64(&m.afterMarkPublishedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkPublishedMock.defaultExpectation != nil && afterMarkPublishedCounter < 1 {
+		if m.MarkPublishedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkPublished at\n%s", m.MarkPublishedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkPublished at\n%s with params: %#v", m.MarkPublishedMock.defaultExpectation.expectationOrigins.origin, *m.MarkPublishedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkPublished != nil && afterMarkPublishedCounter < 1 {
+		m.t.Errorf("Expected call to OutboxRepositoryMock.MarkPublished at\n%s", m.funcMarkPublishedOrigin)
+	}
+
+	if !m.MarkPublishedMock.invocationsDone() && afterMarkPublishedCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.MarkPublished at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkPublishedMock.expectedInvocations), m.MarkPublishedMock.expectedInvocationsOrigin, afterMarkPublishedCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OutboxRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCountPublishedInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockDeletePublishedInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockLastPublishedInspect()
+
+			m.MinimockListPendingInspect()
+
+			m.MinimockMarkPublishedInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OutboxRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OutboxRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCountPublishedDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeletePublishedDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockLastPublishedDone() &&
+		m.MinimockListPendingDone() &&
+		m.MinimockMarkPublishedDone()
+}
@@ -0,0 +1,1130 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Mobo140/chat/internal/repository.ReactionRepository -o reaction_repository_minimock.go -n ReactionRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// ReactionRepositoryMock implements mm_repository.ReactionRepository
+type ReactionRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAdd          func(ctx context.Context, reaction *model.Reaction) (b1 bool, err error)
+	funcAddOrigin    string
+	inspectFuncAdd   func(ctx context.Context, reaction *model.Reaction)
+	afterAddCounter  uint64
+	beforeAddCounter uint64
+	AddMock          mReactionRepositoryMockAdd
+
+	funcCounts          func(ctx context.Context, messageIDs []int64) (m1 map[int64][]model.ReactionCount, err error)
+	funcCountsOrigin    string
+	inspectFuncCounts   func(ctx context.Context, messageIDs []int64)
+	afterCountsCounter  uint64
+	beforeCountsCounter uint64
+	CountsMock          mReactionRepositoryMockCounts
+
+	funcRemove          func(ctx context.Context, reaction *model.Reaction) (b1 bool, err error)
+	funcRemoveOrigin    string
+	inspectFuncRemove   func(ctx context.Context, reaction *model.Reaction)
+	afterRemoveCounter  uint64
+	beforeRemoveCounter uint64
+	RemoveMock          mReactionRepositoryMockRemove
+}
+
+// NewReactionRepositoryMock returns a mock for mm_repository.ReactionRepository
+func NewReactionRepositoryMock(t minimock.Tester) *ReactionRepositoryMock {
+	m := &ReactionRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddMock = mReactionRepositoryMockAdd{mock: m}
+	m.AddMock.callArgs = []*ReactionRepositoryMockAddParams{}
+
+	m.CountsMock = mReactionRepositoryMockCounts{mock: m}
+	m.CountsMock.callArgs = []*ReactionRepositoryMockCountsParams{}
+
+	m.RemoveMock = mReactionRepositoryMockRemove{mock: m}
+	m.RemoveMock.callArgs = []*ReactionRepositoryMockRemoveParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mReactionRepositoryMockAdd struct {
+	optional           bool
+	mock               *ReactionRepositoryMock
+	defaultExpectation *ReactionRepositoryMockAddExpectation
+	expectations       []*ReactionRepositoryMockAddExpectation
+
+	callArgs []*ReactionRepositoryMockAddParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReactionRepositoryMockAddExpectation specifies expectation struct of the ReactionRepository.Add
+type ReactionRepositoryMockAddExpectation struct {
+	mock               *ReactionRepositoryMock
+	params             *ReactionRepositoryMockAddParams
+	paramPtrs          *ReactionRepositoryMockAddParamPtrs
+	expectationOrigins ReactionRepositoryMockAddExpectationOrigins
+	results            *ReactionRepositoryMockAddResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReactionRepositoryMockAddParams contains parameters of the ReactionRepository.Add
+type ReactionRepositoryMockAddParams struct {
+	ctx      context.Context
+	reaction *model.Reaction
+}
+
+// ReactionRepositoryMockAddParamPtrs contains pointers to parameters of the ReactionRepository.Add
+type ReactionRepositoryMockAddParamPtrs struct {
+	ctx      *context.Context
+	reaction **model.Reaction
+}
+
+// ReactionRepositoryMockAddResults contains results of the ReactionRepository.Add
+type ReactionRepositoryMockAddResults struct {
+	b1  bool
+	err error
+}
+
+// ReactionRepositoryMockAddOrigins contains origins of expectations of the ReactionRepository.Add
+type ReactionRepositoryMockAddExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originReaction string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAdd *mReactionRepositoryMockAdd) Optional() *mReactionRepositoryMockAdd {
+	mmAdd.optional = true
+	return mmAdd
+}
+
+// Expect sets up expected params for ReactionRepository.Add
+func (mmAdd *mReactionRepositoryMockAdd) Expect(ctx context.Context, reaction *model.Reaction) *mReactionRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("ReactionRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &ReactionRepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs != nil {
+		mmAdd.mock.t.Fatalf("ReactionRepositoryMock.Add mock is already set by ExpectParams functions")
+	}
+
+	mmAdd.defaultExpectation.params = &ReactionRepositoryMockAddParams{ctx, reaction}
+	mmAdd.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAdd.expectations {
+		if minimock.Equal(e.params, mmAdd.defaultExpectation.params) {
+			mmAdd.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAdd.defaultExpectation.params)
+		}
+	}
+
+	return mmAdd
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReactionRepository.Add
+func (mmAdd *mReactionRepositoryMockAdd) ExpectCtxParam1(ctx context.Context) *mReactionRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("ReactionRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &ReactionRepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.params != nil {
+		mmAdd.mock.t.Fatalf("ReactionRepositoryMock.Add mock is already set by Expect")
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs == nil {
+		mmAdd.defaultExpectation.paramPtrs = &ReactionRepositoryMockAddParamPtrs{}
+	}
+	mmAdd.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAdd.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAdd
+}
+
+// ExpectReactionParam2 sets up expected param reaction for ReactionRepository.Add
+func (mmAdd *mReactionRepositoryMockAdd) ExpectReactionParam2(reaction *model.Reaction) *mReactionRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("ReactionRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &ReactionRepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.params != nil {
+		mmAdd.mock.t.Fatalf("ReactionRepositoryMock.Add mock is already set by Expect")
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs == nil {
+		mmAdd.defaultExpectation.paramPtrs = &ReactionRepositoryMockAddParamPtrs{}
+	}
+	mmAdd.defaultExpectation.paramPtrs.reaction = &reaction
+	mmAdd.defaultExpectation.expectationOrigins.originReaction = minimock.CallerInfo(1)
+
+	return mmAdd
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReactionRepository.Add
+func (mmAdd *mReactionRepositoryMockAdd) Inspect(f func(ctx context.Context, reaction *model.Reaction)) *mReactionRepositoryMockAdd {
+	if mmAdd.mock.inspectFuncAdd != nil {
+		mmAdd.mock.t.Fatalf("Inspect function is already set for ReactionRepositoryMock.Add")
+	}
+
+	mmAdd.mock.inspectFuncAdd = f
+
+	return mmAdd
+}
+
+// Return sets up results that will be returned by ReactionRepository.Add
+func (mmAdd *mReactionRepositoryMockAdd) Return(b1 bool, err error) *ReactionRepositoryMock {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("ReactionRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &ReactionRepositoryMockAddExpectation{mock: mmAdd.mock}
+	}
+	mmAdd.defaultExpectation.results = &ReactionRepositoryMockAddResults{b1, err}
+	mmAdd.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAdd.mock
+}
+
+// Set uses given function f to mock the ReactionRepository.Add method
+func (mmAdd *mReactionRepositoryMockAdd) Set(f func(ctx context.Context, reaction *model.Reaction) (b1 bool, err error)) *ReactionRepositoryMock {
+	if mmAdd.defaultExpectation != nil {
+		mmAdd.mock.t.Fatalf("Default expectation is already set for the ReactionRepository.Add method")
+	}
+
+	if len(mmAdd.expectations) > 0 {
+		mmAdd.mock.t.Fatalf("Some expectations are already set for the ReactionRepository.Add method")
+	}
+
+	mmAdd.mock.funcAdd = f
+	mmAdd.mock.funcAddOrigin = minimock.CallerInfo(1)
+	return mmAdd.mock
+}
+
+// When sets expectation for the ReactionRepository.Add which will trigger the result defined by the following
+// Then helper
+func (mmAdd *mReactionRepositoryMockAdd) When(ctx context.Context, reaction *model.Reaction) *ReactionRepositoryMockAddExpectation {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("ReactionRepositoryMock.Add mock is already set by Set")
+	}
+
+	expectation := &ReactionRepositoryMockAddExpectation{
+		mock:               mmAdd.mock,
+		params:             &ReactionRepositoryMockAddParams{ctx, reaction},
+		expectationOrigins: ReactionRepositoryMockAddExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAdd.expectations = append(mmAdd.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReactionRepository.Add return parameters for the expectation previously defined by the When method
+func (e *ReactionRepositoryMockAddExpectation) Then(b1 bool, err error) *ReactionRepositoryMock {
+	e.results = &ReactionRepositoryMockAddResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ReactionRepository.Add should be invoked
+func (mmAdd *mReactionRepositoryMockAdd) Times(n uint64) *mReactionRepositoryMockAdd {
+	if n == 0 {
+		mmAdd.mock.t.Fatalf("Times of ReactionRepositoryMock.Add mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAdd.expectedInvocations, n)
+	mmAdd.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAdd
+}
+
+func (mmAdd *mReactionRepositoryMockAdd) invocationsDone() bool {
+	if len(mmAdd.expectations) == 0 && mmAdd.defaultExpectation == nil && mmAdd.mock.funcAdd == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAdd.mock.afterAddCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAdd.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Add implements mm_repository.ReactionRepository
+func (mmAdd *ReactionRepositoryMock) Add(ctx context.Context, reaction *model.Reaction) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmAdd.beforeAddCounter, 1)
+	defer mm_atomic.AddUint64(&mmAdd.afterAddCounter, 1)
+
+	mmAdd.t.Helper()
+
+	if mmAdd.inspectFuncAdd != nil {
+		mmAdd.inspectFuncAdd(ctx, reaction)
+	}
+
+	mm_params := ReactionRepositoryMockAddParams{ctx, reaction}
+
+	// Record call args
+	mmAdd.AddMock.mutex.Lock()
+	mmAdd.AddMock.callArgs = append(mmAdd.AddMock.callArgs, &mm_params)
+	mmAdd.AddMock.mutex.Unlock()
+
+	for _, e := range mmAdd.AddMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmAdd.AddMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAdd.AddMock.defaultExpectation.Counter, 1)
+		mm_want := mmAdd.AddMock.defaultExpectation.params
+		mm_want_ptrs := mmAdd.AddMock.defaultExpectation.paramPtrs
+
+		mm_got := ReactionRepositoryMockAddParams{ctx, reaction}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAdd.t.Errorf("ReactionRepositoryMock.Add got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdd.AddMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.reaction != nil && !minimock.Equal(*mm_want_ptrs.reaction, mm_got.reaction) {
+				mmAdd.t.Errorf("ReactionRepositoryMock.Add got unexpected parameter reaction, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdd.AddMock.defaultExpectation.expectationOrigins.originReaction, *mm_want_ptrs.reaction, mm_got.reaction, minimock.Diff(*mm_want_ptrs.reaction, mm_got.reaction))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAdd.t.Errorf("ReactionRepositoryMock.Add got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAdd.AddMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAdd.AddMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAdd.t.Fatal("No results are set for the ReactionRepositoryMock.Add")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmAdd.funcAdd != nil {
+		return mmAdd.funcAdd(ctx, reaction)
+	}
+	mmAdd.t.Fatalf("Unexpected call to ReactionRepositoryMock.Add. %v %v", ctx, reaction)
+	return
+}
+
+// AddAfterCounter returns a count of finished ReactionRepositoryMock.Add invocations
+func (mmAdd *ReactionRepositoryMock) AddAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdd.afterAddCounter)
+}
+
+// AddBeforeCounter returns a count of ReactionRepositoryMock.Add invocations
+func (mmAdd *ReactionRepositoryMock) AddBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdd.beforeAddCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReactionRepositoryMock.Add.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAdd *mReactionRepositoryMockAdd) Calls() []*ReactionRepositoryMockAddParams {
+	mmAdd.mutex.RLock()
+
+	argCopy := make([]*ReactionRepositoryMockAddParams, len(mmAdd.callArgs))
+	copy(argCopy, mmAdd.callArgs)
+
+	mmAdd.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddDone returns true if the count of the Add invocations corresponds
+// the number of defined expectations
+func (m *ReactionRepositoryMock) MinimockAddDone() bool {
+	if m.AddMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddMock.invocationsDone()
+}
+
+// MinimockAddInspect logs each unmet expectation
+func (m *ReactionRepositoryMock) MinimockAddInspect() {
+	for _, e := range m.AddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReactionRepositoryMock.Add at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddCounter := mm_atomic.LoadUint64(&m.afterAddCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMock.defaultExpectation != nil && afterAddCounter < 1 {
+		if m.AddMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReactionRepositoryMock.Add at\n%s", m.AddMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReactionRepositoryMock.Add at\n%s with params: %#v", m.AddMock.defaultExpectation.expectationOrigins.origin, *m.AddMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAdd != nil && afterAddCounter < 1 {
+		m.t.Errorf("Expected call to ReactionRepositoryMock.Add at\n%s", m.funcAddOrigin)
+	}
+
+	if !m.AddMock.invocationsDone() && afterAddCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReactionRepositoryMock.Add at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddMock.expectedInvocations), m.AddMock.expectedInvocationsOrigin, afterAddCounter)
+	}
+}
+
+type mReactionRepositoryMockCounts struct {
+	optional           bool
+	mock               *ReactionRepositoryMock
+	defaultExpectation *ReactionRepositoryMockCountsExpectation
+	expectations       []*ReactionRepositoryMockCountsExpectation
+
+	callArgs []*ReactionRepositoryMockCountsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReactionRepositoryMockCountsExpectation specifies expectation struct of the ReactionRepository.Counts
+type ReactionRepositoryMockCountsExpectation struct {
+	mock               *ReactionRepositoryMock
+	params             *ReactionRepositoryMockCountsParams
+	paramPtrs          *ReactionRepositoryMockCountsParamPtrs
+	expectationOrigins ReactionRepositoryMockCountsExpectationOrigins
+	results            *ReactionRepositoryMockCountsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReactionRepositoryMockCountsParams contains parameters of the ReactionRepository.Counts
+type ReactionRepositoryMockCountsParams struct {
+	ctx        context.Context
+	messageIDs []int64
+}
+
+// ReactionRepositoryMockCountsParamPtrs contains pointers to parameters of the ReactionRepository.Counts
+type ReactionRepositoryMockCountsParamPtrs struct {
+	ctx        *context.Context
+	messageIDs *[]int64
+}
+
+// ReactionRepositoryMockCountsResults contains results of the ReactionRepository.Counts
+type ReactionRepositoryMockCountsResults struct {
+	m1  map[int64][]model.ReactionCount
+	err error
+}
+
+// ReactionRepositoryMockCountsOrigins contains origins of expectations of the ReactionRepository.Counts
+type ReactionRepositoryMockCountsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originMessageIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCounts *mReactionRepositoryMockCounts) Optional() *mReactionRepositoryMockCounts {
+	mmCounts.optional = true
+	return mmCounts
+}
+
+// Expect sets up expected params for ReactionRepository.Counts
+func (mmCounts *mReactionRepositoryMockCounts) Expect(ctx context.Context, messageIDs []int64) *mReactionRepositoryMockCounts {
+	if mmCounts.mock.funcCounts != nil {
+		mmCounts.mock.t.Fatalf("ReactionRepositoryMock.Counts mock is already set by Set")
+	}
+
+	if mmCounts.defaultExpectation == nil {
+		mmCounts.defaultExpectation = &ReactionRepositoryMockCountsExpectation{}
+	}
+
+	if mmCounts.defaultExpectation.paramPtrs != nil {
+		mmCounts.mock.t.Fatalf("ReactionRepositoryMock.Counts mock is already set by ExpectParams functions")
+	}
+
+	mmCounts.defaultExpectation.params = &ReactionRepositoryMockCountsParams{ctx, messageIDs}
+	mmCounts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCounts.expectations {
+		if minimock.Equal(e.params, mmCounts.defaultExpectation.params) {
+			mmCounts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCounts.defaultExpectation.params)
+		}
+	}
+
+	return mmCounts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReactionRepository.Counts
+func (mmCounts *mReactionRepositoryMockCounts) ExpectCtxParam1(ctx context.Context) *mReactionRepositoryMockCounts {
+	if mmCounts.mock.funcCounts != nil {
+		mmCounts.mock.t.Fatalf("ReactionRepositoryMock.Counts mock is already set by Set")
+	}
+
+	if mmCounts.defaultExpectation == nil {
+		mmCounts.defaultExpectation = &ReactionRepositoryMockCountsExpectation{}
+	}
+
+	if mmCounts.defaultExpectation.params != nil {
+		mmCounts.mock.t.Fatalf("ReactionRepositoryMock.Counts mock is already set by Expect")
+	}
+
+	if mmCounts.defaultExpectation.paramPtrs == nil {
+		mmCounts.defaultExpectation.paramPtrs = &ReactionRepositoryMockCountsParamPtrs{}
+	}
+	mmCounts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCounts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCounts
+}
+
+// ExpectMessageIDsParam2 sets up expected param messageIDs for ReactionRepository.Counts
+func (mmCounts *mReactionRepositoryMockCounts) ExpectMessageIDsParam2(messageIDs []int64) *mReactionRepositoryMockCounts {
+	if mmCounts.mock.funcCounts != nil {
+		mmCounts.mock.t.Fatalf("ReactionRepositoryMock.Counts mock is already set by Set")
+	}
+
+	if mmCounts.defaultExpectation == nil {
+		mmCounts.defaultExpectation = &ReactionRepositoryMockCountsExpectation{}
+	}
+
+	if mmCounts.defaultExpectation.params != nil {
+		mmCounts.mock.t.Fatalf("ReactionRepositoryMock.Counts mock is already set by Expect")
+	}
+
+	if mmCounts.defaultExpectation.paramPtrs == nil {
+		mmCounts.defaultExpectation.paramPtrs = &ReactionRepositoryMockCountsParamPtrs{}
+	}
+	mmCounts.defaultExpectation.paramPtrs.messageIDs = &messageIDs
+	mmCounts.defaultExpectation.expectationOrigins.originMessageIDs = minimock.CallerInfo(1)
+
+	return mmCounts
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReactionRepository.Counts
+func (mmCounts *mReactionRepositoryMockCounts) Inspect(f func(ctx context.Context, messageIDs []int64)) *mReactionRepositoryMockCounts {
+	if mmCounts.mock.inspectFuncCounts != nil {
+		mmCounts.mock.t.Fatalf("Inspect function is already set for ReactionRepositoryMock.Counts")
+	}
+
+	mmCounts.mock.inspectFuncCounts = f
+
+	return mmCounts
+}
+
+// Return sets up results that will be returned by ReactionRepository.Counts
+func (mmCounts *mReactionRepositoryMockCounts) Return(m1 map[int64][]model.ReactionCount, err error) *ReactionRepositoryMock {
+	if mmCounts.mock.funcCounts != nil {
+		mmCounts.mock.t.Fatalf("ReactionRepositoryMock.Counts mock is already set by Set")
+	}
+
+	if mmCounts.defaultExpectation == nil {
+		mmCounts.defaultExpectation = &ReactionRepositoryMockCountsExpectation{mock: mmCounts.mock}
+	}
+	mmCounts.defaultExpectation.results = &ReactionRepositoryMockCountsResults{m1, err}
+	mmCounts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCounts.mock
+}
+
+// Set uses given function f to mock the ReactionRepository.Counts method
+func (mmCounts *mReactionRepositoryMockCounts) Set(f func(ctx context.Context, messageIDs []int64) (m1 map[int64][]model.ReactionCount, err error)) *ReactionRepositoryMock {
+	if mmCounts.defaultExpectation != nil {
+		mmCounts.mock.t.Fatalf("Default expectation is already set for the ReactionRepository.Counts method")
+	}
+
+	if len(mmCounts.expectations) > 0 {
+		mmCounts.mock.t.Fatalf("Some expectations are already set for the ReactionRepository.Counts method")
+	}
+
+	mmCounts.mock.funcCounts = f
+	mmCounts.mock.funcCountsOrigin = minimock.CallerInfo(1)
+	return mmCounts.mock
+}
+
+// When sets expectation for the ReactionRepository.Counts which will trigger the result defined by the following
+// Then helper
+func (mmCounts *mReactionRepositoryMockCounts) When(ctx context.Context, messageIDs []int64) *ReactionRepositoryMockCountsExpectation {
+	if mmCounts.mock.funcCounts != nil {
+		mmCounts.mock.t.Fatalf("ReactionRepositoryMock.Counts mock is already set by Set")
+	}
+
+	expectation := &ReactionRepositoryMockCountsExpectation{
+		mock:               mmCounts.mock,
+		params:             &ReactionRepositoryMockCountsParams{ctx, messageIDs},
+		expectationOrigins: ReactionRepositoryMockCountsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCounts.expectations = append(mmCounts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReactionRepository.Counts return parameters for the expectation previously defined by the When method
+func (e *ReactionRepositoryMockCountsExpectation) Then(m1 map[int64][]model.ReactionCount, err error) *ReactionRepositoryMock {
+	e.results = &ReactionRepositoryMockCountsResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times ReactionRepository.Counts should be invoked
+func (mmCounts *mReactionRepositoryMockCounts) Times(n uint64) *mReactionRepositoryMockCounts {
+	if n == 0 {
+		mmCounts.mock.t.Fatalf("Times of ReactionRepositoryMock.Counts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCounts.expectedInvocations, n)
+	mmCounts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCounts
+}
+
+func (mmCounts *mReactionRepositoryMockCounts) invocationsDone() bool {
+	if len(mmCounts.expectations) == 0 && mmCounts.defaultExpectation == nil && mmCounts.mock.funcCounts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCounts.mock.afterCountsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCounts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Counts implements mm_repository.ReactionRepository
+func (mmCounts *ReactionRepositoryMock) Counts(ctx context.Context, messageIDs []int64) (m1 map[int64][]model.ReactionCount, err error) {
+	mm_atomic.AddUint64(&mmCounts.beforeCountsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCounts.afterCountsCounter, 1)
+
+	mmCounts.t.Helper()
+
+	if mmCounts.inspectFuncCounts != nil {
+		mmCounts.inspectFuncCounts(ctx, messageIDs)
+	}
+
+	mm_params := ReactionRepositoryMockCountsParams{ctx, messageIDs}
+
+	// Record call args
+	mmCounts.CountsMock.mutex.Lock()
+	mmCounts.CountsMock.callArgs = append(mmCounts.CountsMock.callArgs, &mm_params)
+	mmCounts.CountsMock.mutex.Unlock()
+
+	for _, e := range mmCounts.CountsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmCounts.CountsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCounts.CountsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCounts.CountsMock.defaultExpectation.params
+		mm_want_ptrs := mmCounts.CountsMock.defaultExpectation.paramPtrs
+
+		mm_got := ReactionRepositoryMockCountsParams{ctx, messageIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCounts.t.Errorf("ReactionRepositoryMock.Counts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCounts.CountsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageIDs != nil && !minimock.Equal(*mm_want_ptrs.messageIDs, mm_got.messageIDs) {
+				mmCounts.t.Errorf("ReactionRepositoryMock.Counts got unexpected parameter messageIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCounts.CountsMock.defaultExpectation.expectationOrigins.originMessageIDs, *mm_want_ptrs.messageIDs, mm_got.messageIDs, minimock.Diff(*mm_want_ptrs.messageIDs, mm_got.messageIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCounts.t.Errorf("ReactionRepositoryMock.Counts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCounts.CountsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCounts.CountsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCounts.t.Fatal("No results are set for the ReactionRepositoryMock.Counts")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmCounts.funcCounts != nil {
+		return mmCounts.funcCounts(ctx, messageIDs)
+	}
+	mmCounts.t.Fatalf("Unexpected call to ReactionRepositoryMock.Counts. %v %v", ctx, messageIDs)
+	return
+}
+
+// CountsAfterCounter returns a count of finished ReactionRepositoryMock.Counts invocations
+func (mmCounts *ReactionRepositoryMock) CountsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCounts.afterCountsCounter)
+}
+
+// CountsBeforeCounter returns a count of ReactionRepositoryMock.Counts invocations
+func (mmCounts *ReactionRepositoryMock) CountsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCounts.beforeCountsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReactionRepositoryMock.Counts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCounts *mReactionRepositoryMockCounts) Calls() []*ReactionRepositoryMockCountsParams {
+	mmCounts.mutex.RLock()
+
+	argCopy := make([]*ReactionRepositoryMockCountsParams, len(mmCounts.callArgs))
+	copy(argCopy, mmCounts.callArgs)
+
+	mmCounts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountsDone returns true if the count of the Counts invocations corresponds
+// the number of defined expectations
+func (m *ReactionRepositoryMock) MinimockCountsDone() bool {
+	if m.CountsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountsMock.invocationsDone()
+}
+
+// MinimockCountsInspect logs each unmet expectation
+func (m *ReactionRepositoryMock) MinimockCountsInspect() {
+	for _, e := range m.CountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReactionRepositoryMock.Counts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountsCounter := mm_atomic.LoadUint64(&m.afterCountsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountsMock.defaultExpectation != nil && afterCountsCounter < 1 {
+		if m.CountsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReactionRepositoryMock.Counts at\n%s", m.CountsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReactionRepositoryMock.Counts at\n%s with params: %#v", m.CountsMock.defaultExpectation.expectationOrigins.origin, *m.CountsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCounts != nil && afterCountsCounter < 1 {
+		m.t.Errorf("Expected call to ReactionRepositoryMock.Counts at\n%s", m.funcCountsOrigin)
+	}
+
+	if !m.CountsMock.invocationsDone() && afterCountsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReactionRepositoryMock.Counts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountsMock.expectedInvocations), m.CountsMock.expectedInvocationsOrigin, afterCountsCounter)
+	}
+}
+
+type mReactionRepositoryMockRemove struct {
+	optional           bool
+	mock               *ReactionRepositoryMock
+	defaultExpectation *ReactionRepositoryMockRemoveExpectation
+	expectations       []*ReactionRepositoryMockRemoveExpectation
+
+	callArgs []*ReactionRepositoryMockRemoveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReactionRepositoryMockRemoveExpectation specifies expectation struct of the ReactionRepository.Remove
+type ReactionRepositoryMockRemoveExpectation struct {
+	mock               *ReactionRepositoryMock
+	params             *ReactionRepositoryMockRemoveParams
+	paramPtrs          *ReactionRepositoryMockRemoveParamPtrs
+	expectationOrigins ReactionRepositoryMockRemoveExpectationOrigins
+	results            *ReactionRepositoryMockRemoveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReactionRepositoryMockRemoveParams contains parameters of the ReactionRepository.Remove
+type ReactionRepositoryMockRemoveParams struct {
+	ctx      context.Context
+	reaction *model.Reaction
+}
+
+// ReactionRepositoryMockRemoveParamPtrs contains pointers to parameters of the ReactionRepository.Remove
+type ReactionRepositoryMockRemoveParamPtrs struct {
+	ctx      *context.Context
+	reaction **model.Reaction
+}
+
+// ReactionRepositoryMockRemoveResults contains results of the ReactionRepository.Remove
+type ReactionRepositoryMockRemoveResults struct {
+	b1  bool
+	err error
+}
+
+// ReactionRepositoryMockRemoveOrigins contains origins of expectations of the ReactionRepository.Remove
+type ReactionRepositoryMockRemoveExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originReaction string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemove *mReactionRepositoryMockRemove) Optional() *mReactionRepositoryMockRemove {
+	mmRemove.optional = true
+	return mmRemove
+}
+
+// Expect sets up expected params for ReactionRepository.Remove
+func (mmRemove *mReactionRepositoryMockRemove) Expect(ctx context.Context, reaction *model.Reaction) *mReactionRepositoryMockRemove {
+	if mmRemove.mock.funcRemove != nil {
+		mmRemove.mock.t.Fatalf("ReactionRepositoryMock.Remove mock is already set by Set")
+	}
+
+	if mmRemove.defaultExpectation == nil {
+		mmRemove.defaultExpectation = &ReactionRepositoryMockRemoveExpectation{}
+	}
+
+	if mmRemove.defaultExpectation.paramPtrs != nil {
+		mmRemove.mock.t.Fatalf("ReactionRepositoryMock.Remove mock is already set by ExpectParams functions")
+	}
+
+	mmRemove.defaultExpectation.params = &ReactionRepositoryMockRemoveParams{ctx, reaction}
+	mmRemove.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemove.expectations {
+		if minimock.Equal(e.params, mmRemove.defaultExpectation.params) {
+			mmRemove.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemove.defaultExpectation.params)
+		}
+	}
+
+	return mmRemove
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReactionRepository.Remove
+func (mmRemove *mReactionRepositoryMockRemove) ExpectCtxParam1(ctx context.Context) *mReactionRepositoryMockRemove {
+	if mmRemove.mock.funcRemove != nil {
+		mmRemove.mock.t.Fatalf("ReactionRepositoryMock.Remove mock is already set by Set")
+	}
+
+	if mmRemove.defaultExpectation == nil {
+		mmRemove.defaultExpectation = &ReactionRepositoryMockRemoveExpectation{}
+	}
+
+	if mmRemove.defaultExpectation.params != nil {
+		mmRemove.mock.t.Fatalf("ReactionRepositoryMock.Remove mock is already set by Expect")
+	}
+
+	if mmRemove.defaultExpectation.paramPtrs == nil {
+		mmRemove.defaultExpectation.paramPtrs = &ReactionRepositoryMockRemoveParamPtrs{}
+	}
+	mmRemove.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemove.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemove
+}
+
+// ExpectReactionParam2 sets up expected param reaction for ReactionRepository.Remove
+func (mmRemove *mReactionRepositoryMockRemove) ExpectReactionParam2(reaction *model.Reaction) *mReactionRepositoryMockRemove {
+	if mmRemove.mock.funcRemove != nil {
+		mmRemove.mock.t.Fatalf("ReactionRepositoryMock.Remove mock is already set by Set")
+	}
+
+	if mmRemove.defaultExpectation == nil {
+		mmRemove.defaultExpectation = &ReactionRepositoryMockRemoveExpectation{}
+	}
+
+	if mmRemove.defaultExpectation.params != nil {
+		mmRemove.mock.t.Fatalf("ReactionRepositoryMock.Remove mock is already set by Expect")
+	}
+
+	if mmRemove.defaultExpectation.paramPtrs == nil {
+		mmRemove.defaultExpectation.paramPtrs = &ReactionRepositoryMockRemoveParamPtrs{}
+	}
+	mmRemove.defaultExpectation.paramPtrs.reaction = &reaction
+	mmRemove.defaultExpectation.expectationOrigins.originReaction = minimock.CallerInfo(1)
+
+	return mmRemove
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReactionRepository.Remove
+func (mmRemove *mReactionRepositoryMockRemove) Inspect(f func(ctx context.Context, reaction *model.Reaction)) *mReactionRepositoryMockRemove {
+	if mmRemove.mock.inspectFuncRemove != nil {
+		mmRemove.mock.t.Fatalf("Inspect function is already set for ReactionRepositoryMock.Remove")
+	}
+
+	mmRemove.mock.inspectFuncRemove = f
+
+	return mmRemove
+}
+
+// Return sets up results that will be returned by ReactionRepository.Remove
+func (mmRemove *mReactionRepositoryMockRemove) Return(b1 bool, err error) *ReactionRepositoryMock {
+	if mmRemove.mock.funcRemove != nil {
+		mmRemove.mock.t.Fatalf("ReactionRepositoryMock.Remove mock is already set by Set")
+	}
+
+	if mmRemove.defaultExpectation == nil {
+		mmRemove.defaultExpectation = &ReactionRepositoryMockRemoveExpectation{mock: mmRemove.mock}
+	}
+	mmRemove.defaultExpectation.results = &ReactionRepositoryMockRemoveResults{b1, err}
+	mmRemove.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemove.mock
+}
+
+// Set uses given function f to mock the ReactionRepository.Remove method
+func (mmRemove *mReactionRepositoryMockRemove) Set(f func(ctx context.Context, reaction *model.Reaction) (b1 bool, err error)) *ReactionRepositoryMock {
+	if mmRemove.defaultExpectation != nil {
+		mmRemove.mock.t.Fatalf("Default expectation is already set for the ReactionRepository.Remove method")
+	}
+
+	if len(mmRemove.expectations) > 0 {
+		mmRemove.mock.t.Fatalf("Some expectations are already set for the ReactionRepository.Remove method")
+	}
+
+	mmRemove.mock.funcRemove = f
+	mmRemove.mock.funcRemoveOrigin = minimock.CallerInfo(1)
+	return mmRemove.mock
+}
+
+// When sets expectation for the ReactionRepository.Remove which will trigger the result defined by the following
+// Then helper
+func (mmRemove *mReactionRepositoryMockRemove) When(ctx context.Context, reaction *model.Reaction) *ReactionRepositoryMockRemoveExpectation {
+	if mmRemove.mock.funcRemove != nil {
+		mmRemove.mock.t.Fatalf("ReactionRepositoryMock.Remove mock is already set by Set")
+	}
+
+	expectation := &ReactionRepositoryMockRemoveExpectation{
+		mock:               mmRemove.mock,
+		params:             &ReactionRepositoryMockRemoveParams{ctx, reaction},
+		expectationOrigins: ReactionRepositoryMockRemoveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemove.expectations = append(mmRemove.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReactionRepository.Remove return parameters for the expectation previously defined by the When method
+func (e *ReactionRepositoryMockRemoveExpectation) Then(b1 bool, err error) *ReactionRepositoryMock {
+	e.results = &ReactionRepositoryMockRemoveResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ReactionRepository.Remove should be invoked
+func (mmRemove *mReactionRepositoryMockRemove) Times(n uint64) *mReactionRepositoryMockRemove {
+	if n == 0 {
+		mmRemove.mock.t.Fatalf("Times of ReactionRepositoryMock.Remove mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemove.expectedInvocations, n)
+	mmRemove.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemove
+}
+
+func (mmRemove *mReactionRepositoryMockRemove) invocationsDone() bool {
+	if len(mmRemove.expectations) == 0 && mmRemove.defaultExpectation == nil && mmRemove.mock.funcRemove == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemove.mock.afterRemoveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemove.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Remove implements mm_repository.ReactionRepository
+func (mmRemove *ReactionRepositoryMock) Remove(ctx context.Context, reaction *model.Reaction) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmRemove.beforeRemoveCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemove.afterRemoveCounter, 1)
+
+	mmRemove.t.Helper()
+
+	if mmRemove.inspectFuncRemove != nil {
+		mmRemove.inspectFuncRemove(ctx, reaction)
+	}
+
+	mm_params := ReactionRepositoryMockRemoveParams{ctx, reaction}
+
+	// Record call args
+	mmRemove.RemoveMock.mutex.Lock()
+	mmRemove.RemoveMock.callArgs = append(mmRemove.RemoveMock.callArgs, &mm_params)
+	mmRemove.RemoveMock.mutex.Unlock()
+
+	for _, e := range mmRemove.RemoveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmRemove.RemoveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemove.RemoveMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemove.RemoveMock.defaultExpectation.params
+		mm_want_ptrs := mmRemove.RemoveMock.defaultExpectation.paramPtrs
+
+		mm_got := ReactionRepositoryMockRemoveParams{ctx, reaction}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemove.t.Errorf("ReactionRepositoryMock.Remove got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemove.RemoveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.reaction != nil && !minimock.Equal(*mm_want_ptrs.reaction, mm_got.reaction) {
+				mmRemove.t.Errorf("ReactionRepositoryMock.Remove got unexpected parameter reaction, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemove.RemoveMock.defaultExpectation.expectationOrigins.originReaction, *mm_want_ptrs.reaction, mm_got.reaction, minimock.Diff(*mm_want_ptrs.reaction, mm_got.reaction))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemove.t.Errorf("ReactionRepositoryMock.Remove got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemove.RemoveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemove.RemoveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemove.t.Fatal("No results are set for the ReactionRepositoryMock.Remove")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmRemove.funcRemove != nil {
+		return mmRemove.funcRemove(ctx, reaction)
+	}
+	mmRemove.t.Fatalf("Unexpected call to ReactionRepositoryMock.Remove. %v %v", ctx, reaction)
+	return
+}
+
+// RemoveAfterCounter returns a count of finished ReactionRepositoryMock.Remove invocations
+func (mmRemove *ReactionRepositoryMock) RemoveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemove.afterRemoveCounter)
+}
+
+// RemoveBeforeCounter returns a count of ReactionRepositoryMock.Remove invocations
+func (mmRemove *ReactionRepositoryMock) RemoveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemove.beforeRemoveCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReactionRepositoryMock.Remove.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemove *mReactionRepositoryMockRemove) Calls() []*ReactionRepositoryMockRemoveParams {
+	mmRemove.mutex.RLock()
+
+	argCopy := make([]*ReactionRepositoryMockRemoveParams, len(mmRemove.callArgs))
+	copy(argCopy, mmRemove.callArgs)
+
+	mmRemove.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveDone returns true if the count of the Remove invocations corresponds
+// the number of defined expectations
+func (m *ReactionRepositoryMock) MinimockRemoveDone() bool {
+	if m.RemoveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveMock.invocationsDone()
+}
+
+// MinimockRemoveInspect logs each unmet expectation
+func (m *ReactionRepositoryMock) MinimockRemoveInspect() {
+	for _, e := range m.RemoveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReactionRepositoryMock.Remove at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveCounter := mm_atomic.LoadUint64(&m.afterRemoveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveMock.defaultExpectation != nil && afterRemoveCounter < 1 {
+		if m.RemoveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReactionRepositoryMock.Remove at\n%s", m.RemoveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReactionRepositoryMock.Remove at\n%s with params: %#v", m.RemoveMock.defaultExpectation.expectationOrigins.origin, *m.RemoveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemove != nil && afterRemoveCounter < 1 {
+		m.t.Errorf("Expected call to ReactionRepositoryMock.Remove at\n%s", m.funcRemoveOrigin)
+	}
+
+	if !m.RemoveMock.invocationsDone() && afterRemoveCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReactionRepositoryMock.Remove at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveMock.expectedInvocations), m.RemoveMock.expectedInvocationsOrigin, afterRemoveCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ReactionRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddInspect()
+
+			m.MinimockCountsInspect()
+
+			m.MinimockRemoveInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ReactionRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ReactionRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddDone() &&
+		m.MinimockCountsDone() &&
+		m.MinimockRemoveDone()
+}
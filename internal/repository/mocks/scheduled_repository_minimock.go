@@ -0,0 +1,2227 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Mobo140/chat/internal/repository.ScheduledRepository -o scheduled_repository_minimock.go -n ScheduledRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// ScheduledRepositoryMock implements mm_repository.ScheduledRepository
+type ScheduledRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, message *model.ScheduledMessage) (i1 int64, err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, message *model.ScheduledMessage)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mScheduledRepositoryMockCreate
+
+	funcDelete          func(ctx context.Context, id int64) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, id int64)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mScheduledRepositoryMockDelete
+
+	funcFail          func(ctx context.Context, id int64, reason string) (err error)
+	funcFailOrigin    string
+	inspectFuncFail   func(ctx context.Context, id int64, reason string)
+	afterFailCounter  uint64
+	beforeFailCounter uint64
+	FailMock          mScheduledRepositoryMockFail
+
+	funcGet          func(ctx context.Context, id int64) (sp1 *model.ScheduledMessage, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, id int64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mScheduledRepositoryMockGet
+
+	funcList          func(ctx context.Context, filter *model.ScheduledFilter) (spa1 []*model.ScheduledMessage, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context, filter *model.ScheduledFilter)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mScheduledRepositoryMockList
+
+	funcListDue          func(ctx context.Context, limit uint64) (spa1 []*model.ScheduledMessage, err error)
+	funcListDueOrigin    string
+	inspectFuncListDue   func(ctx context.Context, limit uint64)
+	afterListDueCounter  uint64
+	beforeListDueCounter uint64
+	ListDueMock          mScheduledRepositoryMockListDue
+}
+
+// NewScheduledRepositoryMock returns a mock for mm_repository.ScheduledRepository
+func NewScheduledRepositoryMock(t minimock.Tester) *ScheduledRepositoryMock {
+	m := &ScheduledRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mScheduledRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*ScheduledRepositoryMockCreateParams{}
+
+	m.DeleteMock = mScheduledRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*ScheduledRepositoryMockDeleteParams{}
+
+	m.FailMock = mScheduledRepositoryMockFail{mock: m}
+	m.FailMock.callArgs = []*ScheduledRepositoryMockFailParams{}
+
+	m.GetMock = mScheduledRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*ScheduledRepositoryMockGetParams{}
+
+	m.ListMock = mScheduledRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*ScheduledRepositoryMockListParams{}
+
+	m.ListDueMock = mScheduledRepositoryMockListDue{mock: m}
+	m.ListDueMock.callArgs = []*ScheduledRepositoryMockListDueParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mScheduledRepositoryMockCreate struct {
+	optional           bool
+	mock               *ScheduledRepositoryMock
+	defaultExpectation *ScheduledRepositoryMockCreateExpectation
+	expectations       []*ScheduledRepositoryMockCreateExpectation
+
+	callArgs []*ScheduledRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ScheduledRepositoryMockCreateExpectation specifies expectation struct of the ScheduledRepository.Create
+type ScheduledRepositoryMockCreateExpectation struct {
+	mock               *ScheduledRepositoryMock
+	params             *ScheduledRepositoryMockCreateParams
+	paramPtrs          *ScheduledRepositoryMockCreateParamPtrs
+	expectationOrigins ScheduledRepositoryMockCreateExpectationOrigins
+	results            *ScheduledRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ScheduledRepositoryMockCreateParams contains parameters of the ScheduledRepository.Create
+type ScheduledRepositoryMockCreateParams struct {
+	ctx     context.Context
+	message *model.ScheduledMessage
+}
+
+// ScheduledRepositoryMockCreateParamPtrs contains pointers to parameters of the ScheduledRepository.Create
+type ScheduledRepositoryMockCreateParamPtrs struct {
+	ctx     *context.Context
+	message **model.ScheduledMessage
+}
+
+// ScheduledRepositoryMockCreateResults contains results of the ScheduledRepository.Create
+type ScheduledRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// ScheduledRepositoryMockCreateOrigins contains origins of expectations of the ScheduledRepository.Create
+type ScheduledRepositoryMockCreateExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originMessage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mScheduledRepositoryMockCreate) Optional() *mScheduledRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for ScheduledRepository.Create
+func (mmCreate *mScheduledRepositoryMockCreate) Expect(ctx context.Context, message *model.ScheduledMessage) *mScheduledRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ScheduledRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ScheduledRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("ScheduledRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &ScheduledRepositoryMockCreateParams{ctx, message}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledRepository.Create
+func (mmCreate *mScheduledRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mScheduledRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ScheduledRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ScheduledRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("ScheduledRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &ScheduledRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectMessageParam2 sets up expected param message for ScheduledRepository.Create
+func (mmCreate *mScheduledRepositoryMockCreate) ExpectMessageParam2(message *model.ScheduledMessage) *mScheduledRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ScheduledRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ScheduledRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("ScheduledRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &ScheduledRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.message = &message
+	mmCreate.defaultExpectation.expectationOrigins.originMessage = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledRepository.Create
+func (mmCreate *mScheduledRepositoryMockCreate) Inspect(f func(ctx context.Context, message *model.ScheduledMessage)) *mScheduledRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for ScheduledRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by ScheduledRepository.Create
+func (mmCreate *mScheduledRepositoryMockCreate) Return(i1 int64, err error) *ScheduledRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ScheduledRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ScheduledRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &ScheduledRepositoryMockCreateResults{i1, err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the ScheduledRepository.Create method
+func (mmCreate *mScheduledRepositoryMockCreate) Set(f func(ctx context.Context, message *model.ScheduledMessage) (i1 int64, err error)) *ScheduledRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the ScheduledRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the ScheduledRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the ScheduledRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mScheduledRepositoryMockCreate) When(ctx context.Context, message *model.ScheduledMessage) *ScheduledRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ScheduledRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &ScheduledRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &ScheduledRepositoryMockCreateParams{ctx, message},
+		expectationOrigins: ScheduledRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledRepository.Create return parameters for the expectation previously defined by the When method
+func (e *ScheduledRepositoryMockCreateExpectation) Then(i1 int64, err error) *ScheduledRepositoryMock {
+	e.results = &ScheduledRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledRepository.Create should be invoked
+func (mmCreate *mScheduledRepositoryMockCreate) Times(n uint64) *mScheduledRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of ScheduledRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mScheduledRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_repository.ScheduledRepository
+func (mmCreate *ScheduledRepositoryMock) Create(ctx context.Context, message *model.ScheduledMessage) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, message)
+	}
+
+	mm_params := ScheduledRepositoryMockCreateParams{ctx, message}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledRepositoryMockCreateParams{ctx, message}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("ScheduledRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
+				mmCreate.t.Errorf("ScheduledRepositoryMock.Create got unexpected parameter message, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originMessage, *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("ScheduledRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the ScheduledRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, message)
+	}
+	mmCreate.t.Fatalf("Unexpected call to ScheduledRepositoryMock.Create. %v %v", ctx, message)
+	return
+}
+
+// CreateAfterCounter returns a count of finished ScheduledRepositoryMock.Create invocations
+func (mmCreate *ScheduledRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of ScheduledRepositoryMock.Create invocations
+func (mmCreate *ScheduledRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mScheduledRepositoryMockCreate) Calls() []*ScheduledRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*ScheduledRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *ScheduledRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *ScheduledRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to ScheduledRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mScheduledRepositoryMockDelete struct {
+	optional           bool
+	mock               *ScheduledRepositoryMock
+	defaultExpectation *ScheduledRepositoryMockDeleteExpectation
+	expectations       []*ScheduledRepositoryMockDeleteExpectation
+
+	callArgs []*ScheduledRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ScheduledRepositoryMockDeleteExpectation specifies expectation struct of the ScheduledRepository.Delete
+type ScheduledRepositoryMockDeleteExpectation struct {
+	mock               *ScheduledRepositoryMock
+	params             *ScheduledRepositoryMockDeleteParams
+	paramPtrs          *ScheduledRepositoryMockDeleteParamPtrs
+	expectationOrigins ScheduledRepositoryMockDeleteExpectationOrigins
+	results            *ScheduledRepositoryMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ScheduledRepositoryMockDeleteParams contains parameters of the ScheduledRepository.Delete
+type ScheduledRepositoryMockDeleteParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ScheduledRepositoryMockDeleteParamPtrs contains pointers to parameters of the ScheduledRepository.Delete
+type ScheduledRepositoryMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ScheduledRepositoryMockDeleteResults contains results of the ScheduledRepository.Delete
+type ScheduledRepositoryMockDeleteResults struct {
+	err error
+}
+
+// ScheduledRepositoryMockDeleteOrigins contains origins of expectations of the ScheduledRepository.Delete
+type ScheduledRepositoryMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mScheduledRepositoryMockDelete) Optional() *mScheduledRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for ScheduledRepository.Delete
+func (mmDelete *mScheduledRepositoryMockDelete) Expect(ctx context.Context, id int64) *mScheduledRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ScheduledRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ScheduledRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("ScheduledRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &ScheduledRepositoryMockDeleteParams{ctx, id}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledRepository.Delete
+func (mmDelete *mScheduledRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mScheduledRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ScheduledRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ScheduledRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("ScheduledRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &ScheduledRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for ScheduledRepository.Delete
+func (mmDelete *mScheduledRepositoryMockDelete) ExpectIdParam2(id int64) *mScheduledRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ScheduledRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ScheduledRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("ScheduledRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &ScheduledRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledRepository.Delete
+func (mmDelete *mScheduledRepositoryMockDelete) Inspect(f func(ctx context.Context, id int64)) *mScheduledRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for ScheduledRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by ScheduledRepository.Delete
+func (mmDelete *mScheduledRepositoryMockDelete) Return(err error) *ScheduledRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ScheduledRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ScheduledRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &ScheduledRepositoryMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the ScheduledRepository.Delete method
+func (mmDelete *mScheduledRepositoryMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *ScheduledRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the ScheduledRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the ScheduledRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the ScheduledRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mScheduledRepositoryMockDelete) When(ctx context.Context, id int64) *ScheduledRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ScheduledRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &ScheduledRepositoryMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &ScheduledRepositoryMockDeleteParams{ctx, id},
+		expectationOrigins: ScheduledRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *ScheduledRepositoryMockDeleteExpectation) Then(err error) *ScheduledRepositoryMock {
+	e.results = &ScheduledRepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledRepository.Delete should be invoked
+func (mmDelete *mScheduledRepositoryMockDelete) Times(n uint64) *mScheduledRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of ScheduledRepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mScheduledRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_repository.ScheduledRepository
+func (mmDelete *ScheduledRepositoryMock) Delete(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := ScheduledRepositoryMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledRepositoryMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("ScheduledRepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("ScheduledRepositoryMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("ScheduledRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the ScheduledRepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to ScheduledRepositoryMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished ScheduledRepositoryMock.Delete invocations
+func (mmDelete *ScheduledRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of ScheduledRepositoryMock.Delete invocations
+func (mmDelete *ScheduledRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mScheduledRepositoryMockDelete) Calls() []*ScheduledRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*ScheduledRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *ScheduledRepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *ScheduledRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to ScheduledRepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledRepositoryMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mScheduledRepositoryMockFail struct {
+	optional           bool
+	mock               *ScheduledRepositoryMock
+	defaultExpectation *ScheduledRepositoryMockFailExpectation
+	expectations       []*ScheduledRepositoryMockFailExpectation
+
+	callArgs []*ScheduledRepositoryMockFailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ScheduledRepositoryMockFailExpectation specifies expectation struct of the ScheduledRepository.Fail
+type ScheduledRepositoryMockFailExpectation struct {
+	mock               *ScheduledRepositoryMock
+	params             *ScheduledRepositoryMockFailParams
+	paramPtrs          *ScheduledRepositoryMockFailParamPtrs
+	expectationOrigins ScheduledRepositoryMockFailExpectationOrigins
+	results            *ScheduledRepositoryMockFailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ScheduledRepositoryMockFailParams contains parameters of the ScheduledRepository.Fail
+type ScheduledRepositoryMockFailParams struct {
+	ctx    context.Context
+	id     int64
+	reason string
+}
+
+// ScheduledRepositoryMockFailParamPtrs contains pointers to parameters of the ScheduledRepository.Fail
+type ScheduledRepositoryMockFailParamPtrs struct {
+	ctx    *context.Context
+	id     *int64
+	reason *string
+}
+
+// ScheduledRepositoryMockFailResults contains results of the ScheduledRepository.Fail
+type ScheduledRepositoryMockFailResults struct {
+	err error
+}
+
+// ScheduledRepositoryMockFailOrigins contains origins of expectations of the ScheduledRepository.Fail
+type ScheduledRepositoryMockFailExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originId     string
+	originReason string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFail *mScheduledRepositoryMockFail) Optional() *mScheduledRepositoryMockFail {
+	mmFail.optional = true
+	return mmFail
+}
+
+// Expect sets up expected params for ScheduledRepository.Fail
+func (mmFail *mScheduledRepositoryMockFail) Expect(ctx context.Context, id int64, reason string) *mScheduledRepositoryMockFail {
+	if mmFail.mock.funcFail != nil {
+		mmFail.mock.t.Fatalf("ScheduledRepositoryMock.Fail mock is already set by Set")
+	}
+
+	if mmFail.defaultExpectation == nil {
+		mmFail.defaultExpectation = &ScheduledRepositoryMockFailExpectation{}
+	}
+
+	if mmFail.defaultExpectation.paramPtrs != nil {
+		mmFail.mock.t.Fatalf("ScheduledRepositoryMock.Fail mock is already set by ExpectParams functions")
+	}
+
+	mmFail.defaultExpectation.params = &ScheduledRepositoryMockFailParams{ctx, id, reason}
+	mmFail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFail.expectations {
+		if minimock.Equal(e.params, mmFail.defaultExpectation.params) {
+			mmFail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFail.defaultExpectation.params)
+		}
+	}
+
+	return mmFail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledRepository.Fail
+func (mmFail *mScheduledRepositoryMockFail) ExpectCtxParam1(ctx context.Context) *mScheduledRepositoryMockFail {
+	if mmFail.mock.funcFail != nil {
+		mmFail.mock.t.Fatalf("ScheduledRepositoryMock.Fail mock is already set by Set")
+	}
+
+	if mmFail.defaultExpectation == nil {
+		mmFail.defaultExpectation = &ScheduledRepositoryMockFailExpectation{}
+	}
+
+	if mmFail.defaultExpectation.params != nil {
+		mmFail.mock.t.Fatalf("ScheduledRepositoryMock.Fail mock is already set by Expect")
+	}
+
+	if mmFail.defaultExpectation.paramPtrs == nil {
+		mmFail.defaultExpectation.paramPtrs = &ScheduledRepositoryMockFailParamPtrs{}
+	}
+	mmFail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFail
+}
+
+// ExpectIdParam2 sets up expected param id for ScheduledRepository.Fail
+func (mmFail *mScheduledRepositoryMockFail) ExpectIdParam2(id int64) *mScheduledRepositoryMockFail {
+	if mmFail.mock.funcFail != nil {
+		mmFail.mock.t.Fatalf("ScheduledRepositoryMock.Fail mock is already set by Set")
+	}
+
+	if mmFail.defaultExpectation == nil {
+		mmFail.defaultExpectation = &ScheduledRepositoryMockFailExpectation{}
+	}
+
+	if mmFail.defaultExpectation.params != nil {
+		mmFail.mock.t.Fatalf("ScheduledRepositoryMock.Fail mock is already set by Expect")
+	}
+
+	if mmFail.defaultExpectation.paramPtrs == nil {
+		mmFail.defaultExpectation.paramPtrs = &ScheduledRepositoryMockFailParamPtrs{}
+	}
+	mmFail.defaultExpectation.paramPtrs.id = &id
+	mmFail.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmFail
+}
+
+// ExpectReasonParam3 sets up expected param reason for ScheduledRepository.Fail
+func (mmFail *mScheduledRepositoryMockFail) ExpectReasonParam3(reason string) *mScheduledRepositoryMockFail {
+	if mmFail.mock.funcFail != nil {
+		mmFail.mock.t.Fatalf("ScheduledRepositoryMock.Fail mock is already set by Set")
+	}
+
+	if mmFail.defaultExpectation == nil {
+		mmFail.defaultExpectation = &ScheduledRepositoryMockFailExpectation{}
+	}
+
+	if mmFail.defaultExpectation.params != nil {
+		mmFail.mock.t.Fatalf("ScheduledRepositoryMock.Fail mock is already set by Expect")
+	}
+
+	if mmFail.defaultExpectation.paramPtrs == nil {
+		mmFail.defaultExpectation.paramPtrs = &ScheduledRepositoryMockFailParamPtrs{}
+	}
+	mmFail.defaultExpectation.paramPtrs.reason = &reason
+	mmFail.defaultExpectation.expectationOrigins.originReason = minimock.CallerInfo(1)
+
+	return mmFail
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledRepository.Fail
+func (mmFail *mScheduledRepositoryMockFail) Inspect(f func(ctx context.Context, id int64, reason string)) *mScheduledRepositoryMockFail {
+	if mmFail.mock.inspectFuncFail != nil {
+		mmFail.mock.t.Fatalf("Inspect function is already set for ScheduledRepositoryMock.Fail")
+	}
+
+	mmFail.mock.inspectFuncFail = f
+
+	return mmFail
+}
+
+// Return sets up results that will be returned by ScheduledRepository.Fail
+func (mmFail *mScheduledRepositoryMockFail) Return(err error) *ScheduledRepositoryMock {
+	if mmFail.mock.funcFail != nil {
+		mmFail.mock.t.Fatalf("ScheduledRepositoryMock.Fail mock is already set by Set")
+	}
+
+	if mmFail.defaultExpectation == nil {
+		mmFail.defaultExpectation = &ScheduledRepositoryMockFailExpectation{mock: mmFail.mock}
+	}
+	mmFail.defaultExpectation.results = &ScheduledRepositoryMockFailResults{err}
+	mmFail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFail.mock
+}
+
+// Set uses given function f to mock the ScheduledRepository.Fail method
+func (mmFail *mScheduledRepositoryMockFail) Set(f func(ctx context.Context, id int64, reason string) (err error)) *ScheduledRepositoryMock {
+	if mmFail.defaultExpectation != nil {
+		mmFail.mock.t.Fatalf("Default expectation is already set for the ScheduledRepository.Fail method")
+	}
+
+	if len(mmFail.expectations) > 0 {
+		mmFail.mock.t.Fatalf("Some expectations are already set for the ScheduledRepository.Fail method")
+	}
+
+	mmFail.mock.funcFail = f
+	mmFail.mock.funcFailOrigin = minimock.CallerInfo(1)
+	return mmFail.mock
+}
+
+// When sets expectation for the ScheduledRepository.Fail which will trigger the result defined by the following
+// Then helper
+func (mmFail *mScheduledRepositoryMockFail) When(ctx context.Context, id int64, reason string) *ScheduledRepositoryMockFailExpectation {
+	if mmFail.mock.funcFail != nil {
+		mmFail.mock.t.Fatalf("ScheduledRepositoryMock.Fail mock is already set by Set")
+	}
+
+	expectation := &ScheduledRepositoryMockFailExpectation{
+		mock:               mmFail.mock,
+		params:             &ScheduledRepositoryMockFailParams{ctx, id, reason},
+		expectationOrigins: ScheduledRepositoryMockFailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFail.expectations = append(mmFail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledRepository.Fail return parameters for the expectation previously defined by the When method
+func (e *ScheduledRepositoryMockFailExpectation) Then(err error) *ScheduledRepositoryMock {
+	e.results = &ScheduledRepositoryMockFailResults{err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledRepository.Fail should be invoked
+func (mmFail *mScheduledRepositoryMockFail) Times(n uint64) *mScheduledRepositoryMockFail {
+	if n == 0 {
+		mmFail.mock.t.Fatalf("Times of ScheduledRepositoryMock.Fail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFail.expectedInvocations, n)
+	mmFail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFail
+}
+
+func (mmFail *mScheduledRepositoryMockFail) invocationsDone() bool {
+	if len(mmFail.expectations) == 0 && mmFail.defaultExpectation == nil && mmFail.mock.funcFail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFail.mock.afterFailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Fail implements mm_repository.ScheduledRepository
+func (mmFail *ScheduledRepositoryMock) Fail(ctx context.Context, id int64, reason string) (err error) {
+	mm_atomic.AddUint64(&mmFail.beforeFailCounter, 1)
+	defer mm_atomic.AddUint64(&mmFail.afterFailCounter, 1)
+
+	mmFail.t.Helper()
+
+	if mmFail.inspectFuncFail != nil {
+		mmFail.inspectFuncFail(ctx, id, reason)
+	}
+
+	mm_params := ScheduledRepositoryMockFailParams{ctx, id, reason}
+
+	// Record call args
+	mmFail.FailMock.mutex.Lock()
+	mmFail.FailMock.callArgs = append(mmFail.FailMock.callArgs, &mm_params)
+	mmFail.FailMock.mutex.Unlock()
+
+	for _, e := range mmFail.FailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmFail.FailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFail.FailMock.defaultExpectation.Counter, 1)
+		mm_want := mmFail.FailMock.defaultExpectation.params
+		mm_want_ptrs := mmFail.FailMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledRepositoryMockFailParams{ctx, id, reason}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFail.t.Errorf("ScheduledRepositoryMock.Fail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFail.FailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmFail.t.Errorf("ScheduledRepositoryMock.Fail got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFail.FailMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.reason != nil && !minimock.Equal(*mm_want_ptrs.reason, mm_got.reason) {
+				mmFail.t.Errorf("ScheduledRepositoryMock.Fail got unexpected parameter reason, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFail.FailMock.defaultExpectation.expectationOrigins.originReason, *mm_want_ptrs.reason, mm_got.reason, minimock.Diff(*mm_want_ptrs.reason, mm_got.reason))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFail.t.Errorf("ScheduledRepositoryMock.Fail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFail.FailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFail.FailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFail.t.Fatal("No results are set for the ScheduledRepositoryMock.Fail")
+		}
+		return (*mm_results).err
+	}
+	if mmFail.funcFail != nil {
+		return mmFail.funcFail(ctx, id, reason)
+	}
+	mmFail.t.Fatalf("Unexpected call to ScheduledRepositoryMock.Fail. %v %v %v", ctx, id, reason)
+	return
+}
+
+// FailAfterCounter returns a count of finished ScheduledRepositoryMock.Fail invocations
+func (mmFail *ScheduledRepositoryMock) FailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFail.afterFailCounter)
+}
+
+// FailBeforeCounter returns a count of ScheduledRepositoryMock.Fail invocations
+func (mmFail *ScheduledRepositoryMock) FailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFail.beforeFailCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledRepositoryMock.Fail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFail *mScheduledRepositoryMockFail) Calls() []*ScheduledRepositoryMockFailParams {
+	mmFail.mutex.RLock()
+
+	argCopy := make([]*ScheduledRepositoryMockFailParams, len(mmFail.callArgs))
+	copy(argCopy, mmFail.callArgs)
+
+	mmFail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFailDone returns true if the count of the Fail invocations corresponds
+// the number of defined expectations
+func (m *ScheduledRepositoryMock) MinimockFailDone() bool {
+	if m.FailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FailMock.invocationsDone()
+}
+
+// MinimockFailInspect logs each unmet expectation
+func (m *ScheduledRepositoryMock) MinimockFailInspect() {
+	for _, e := range m.FailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.Fail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFailCounter := mm_atomic.LoadUint64(&m.afterFailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FailMock.defaultExpectation != nil && afterFailCounter < 1 {
+		if m.FailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.Fail at\n%s", m.FailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.Fail at\n%s with params: %#v", m.FailMock.defaultExpectation.expectationOrigins.origin, *m.FailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFail != nil && afterFailCounter < 1 {
+		m.t.Errorf("Expected call to ScheduledRepositoryMock.Fail at\n%s", m.funcFailOrigin)
+	}
+
+	if !m.FailMock.invocationsDone() && afterFailCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledRepositoryMock.Fail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FailMock.expectedInvocations), m.FailMock.expectedInvocationsOrigin, afterFailCounter)
+	}
+}
+
+type mScheduledRepositoryMockGet struct {
+	optional           bool
+	mock               *ScheduledRepositoryMock
+	defaultExpectation *ScheduledRepositoryMockGetExpectation
+	expectations       []*ScheduledRepositoryMockGetExpectation
+
+	callArgs []*ScheduledRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ScheduledRepositoryMockGetExpectation specifies expectation struct of the ScheduledRepository.Get
+type ScheduledRepositoryMockGetExpectation struct {
+	mock               *ScheduledRepositoryMock
+	params             *ScheduledRepositoryMockGetParams
+	paramPtrs          *ScheduledRepositoryMockGetParamPtrs
+	expectationOrigins ScheduledRepositoryMockGetExpectationOrigins
+	results            *ScheduledRepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ScheduledRepositoryMockGetParams contains parameters of the ScheduledRepository.Get
+type ScheduledRepositoryMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ScheduledRepositoryMockGetParamPtrs contains pointers to parameters of the ScheduledRepository.Get
+type ScheduledRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ScheduledRepositoryMockGetResults contains results of the ScheduledRepository.Get
+type ScheduledRepositoryMockGetResults struct {
+	sp1 *model.ScheduledMessage
+	err error
+}
+
+// ScheduledRepositoryMockGetOrigins contains origins of expectations of the ScheduledRepository.Get
+type ScheduledRepositoryMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mScheduledRepositoryMockGet) Optional() *mScheduledRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for ScheduledRepository.Get
+func (mmGet *mScheduledRepositoryMockGet) Expect(ctx context.Context, id int64) *mScheduledRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ScheduledRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ScheduledRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("ScheduledRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &ScheduledRepositoryMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledRepository.Get
+func (mmGet *mScheduledRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mScheduledRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ScheduledRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ScheduledRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("ScheduledRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &ScheduledRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for ScheduledRepository.Get
+func (mmGet *mScheduledRepositoryMockGet) ExpectIdParam2(id int64) *mScheduledRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ScheduledRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ScheduledRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("ScheduledRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &ScheduledRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledRepository.Get
+func (mmGet *mScheduledRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mScheduledRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for ScheduledRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by ScheduledRepository.Get
+func (mmGet *mScheduledRepositoryMockGet) Return(sp1 *model.ScheduledMessage, err error) *ScheduledRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ScheduledRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ScheduledRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &ScheduledRepositoryMockGetResults{sp1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the ScheduledRepository.Get method
+func (mmGet *mScheduledRepositoryMockGet) Set(f func(ctx context.Context, id int64) (sp1 *model.ScheduledMessage, err error)) *ScheduledRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the ScheduledRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the ScheduledRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the ScheduledRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mScheduledRepositoryMockGet) When(ctx context.Context, id int64) *ScheduledRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ScheduledRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &ScheduledRepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &ScheduledRepositoryMockGetParams{ctx, id},
+		expectationOrigins: ScheduledRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledRepository.Get return parameters for the expectation previously defined by the When method
+func (e *ScheduledRepositoryMockGetExpectation) Then(sp1 *model.ScheduledMessage, err error) *ScheduledRepositoryMock {
+	e.results = &ScheduledRepositoryMockGetResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledRepository.Get should be invoked
+func (mmGet *mScheduledRepositoryMockGet) Times(n uint64) *mScheduledRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of ScheduledRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mScheduledRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repository.ScheduledRepository
+func (mmGet *ScheduledRepositoryMock) Get(ctx context.Context, id int64) (sp1 *model.ScheduledMessage, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := ScheduledRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("ScheduledRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("ScheduledRepositoryMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("ScheduledRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the ScheduledRepositoryMock.Get")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to ScheduledRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished ScheduledRepositoryMock.Get invocations
+func (mmGet *ScheduledRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of ScheduledRepositoryMock.Get invocations
+func (mmGet *ScheduledRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mScheduledRepositoryMockGet) Calls() []*ScheduledRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*ScheduledRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *ScheduledRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *ScheduledRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to ScheduledRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledRepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mScheduledRepositoryMockList struct {
+	optional           bool
+	mock               *ScheduledRepositoryMock
+	defaultExpectation *ScheduledRepositoryMockListExpectation
+	expectations       []*ScheduledRepositoryMockListExpectation
+
+	callArgs []*ScheduledRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ScheduledRepositoryMockListExpectation specifies expectation struct of the ScheduledRepository.List
+type ScheduledRepositoryMockListExpectation struct {
+	mock               *ScheduledRepositoryMock
+	params             *ScheduledRepositoryMockListParams
+	paramPtrs          *ScheduledRepositoryMockListParamPtrs
+	expectationOrigins ScheduledRepositoryMockListExpectationOrigins
+	results            *ScheduledRepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ScheduledRepositoryMockListParams contains parameters of the ScheduledRepository.List
+type ScheduledRepositoryMockListParams struct {
+	ctx    context.Context
+	filter *model.ScheduledFilter
+}
+
+// ScheduledRepositoryMockListParamPtrs contains pointers to parameters of the ScheduledRepository.List
+type ScheduledRepositoryMockListParamPtrs struct {
+	ctx    *context.Context
+	filter **model.ScheduledFilter
+}
+
+// ScheduledRepositoryMockListResults contains results of the ScheduledRepository.List
+type ScheduledRepositoryMockListResults struct {
+	spa1 []*model.ScheduledMessage
+	err  error
+}
+
+// ScheduledRepositoryMockListOrigins contains origins of expectations of the ScheduledRepository.List
+type ScheduledRepositoryMockListExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mScheduledRepositoryMockList) Optional() *mScheduledRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for ScheduledRepository.List
+func (mmList *mScheduledRepositoryMockList) Expect(ctx context.Context, filter *model.ScheduledFilter) *mScheduledRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ScheduledRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ScheduledRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("ScheduledRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &ScheduledRepositoryMockListParams{ctx, filter}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledRepository.List
+func (mmList *mScheduledRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mScheduledRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ScheduledRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ScheduledRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("ScheduledRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &ScheduledRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectFilterParam2 sets up expected param filter for ScheduledRepository.List
+func (mmList *mScheduledRepositoryMockList) ExpectFilterParam2(filter *model.ScheduledFilter) *mScheduledRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ScheduledRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ScheduledRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("ScheduledRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &ScheduledRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.filter = &filter
+	mmList.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledRepository.List
+func (mmList *mScheduledRepositoryMockList) Inspect(f func(ctx context.Context, filter *model.ScheduledFilter)) *mScheduledRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for ScheduledRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by ScheduledRepository.List
+func (mmList *mScheduledRepositoryMockList) Return(spa1 []*model.ScheduledMessage, err error) *ScheduledRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ScheduledRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ScheduledRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &ScheduledRepositoryMockListResults{spa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the ScheduledRepository.List method
+func (mmList *mScheduledRepositoryMockList) Set(f func(ctx context.Context, filter *model.ScheduledFilter) (spa1 []*model.ScheduledMessage, err error)) *ScheduledRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the ScheduledRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the ScheduledRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the ScheduledRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mScheduledRepositoryMockList) When(ctx context.Context, filter *model.ScheduledFilter) *ScheduledRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ScheduledRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &ScheduledRepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &ScheduledRepositoryMockListParams{ctx, filter},
+		expectationOrigins: ScheduledRepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledRepository.List return parameters for the expectation previously defined by the When method
+func (e *ScheduledRepositoryMockListExpectation) Then(spa1 []*model.ScheduledMessage, err error) *ScheduledRepositoryMock {
+	e.results = &ScheduledRepositoryMockListResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledRepository.List should be invoked
+func (mmList *mScheduledRepositoryMockList) Times(n uint64) *mScheduledRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of ScheduledRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mScheduledRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_repository.ScheduledRepository
+func (mmList *ScheduledRepositoryMock) List(ctx context.Context, filter *model.ScheduledFilter) (spa1 []*model.ScheduledMessage, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, filter)
+	}
+
+	mm_params := ScheduledRepositoryMockListParams{ctx, filter}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledRepositoryMockListParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("ScheduledRepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmList.t.Errorf("ScheduledRepositoryMock.List got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("ScheduledRepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the ScheduledRepositoryMock.List")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, filter)
+	}
+	mmList.t.Fatalf("Unexpected call to ScheduledRepositoryMock.List. %v %v", ctx, filter)
+	return
+}
+
+// ListAfterCounter returns a count of finished ScheduledRepositoryMock.List invocations
+func (mmList *ScheduledRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of ScheduledRepositoryMock.List invocations
+func (mmList *ScheduledRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mScheduledRepositoryMockList) Calls() []*ScheduledRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*ScheduledRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *ScheduledRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *ScheduledRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to ScheduledRepositoryMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledRepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mScheduledRepositoryMockListDue struct {
+	optional           bool
+	mock               *ScheduledRepositoryMock
+	defaultExpectation *ScheduledRepositoryMockListDueExpectation
+	expectations       []*ScheduledRepositoryMockListDueExpectation
+
+	callArgs []*ScheduledRepositoryMockListDueParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ScheduledRepositoryMockListDueExpectation specifies expectation struct of the ScheduledRepository.ListDue
+type ScheduledRepositoryMockListDueExpectation struct {
+	mock               *ScheduledRepositoryMock
+	params             *ScheduledRepositoryMockListDueParams
+	paramPtrs          *ScheduledRepositoryMockListDueParamPtrs
+	expectationOrigins ScheduledRepositoryMockListDueExpectationOrigins
+	results            *ScheduledRepositoryMockListDueResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ScheduledRepositoryMockListDueParams contains parameters of the ScheduledRepository.ListDue
+type ScheduledRepositoryMockListDueParams struct {
+	ctx   context.Context
+	limit uint64
+}
+
+// ScheduledRepositoryMockListDueParamPtrs contains pointers to parameters of the ScheduledRepository.ListDue
+type ScheduledRepositoryMockListDueParamPtrs struct {
+	ctx   *context.Context
+	limit *uint64
+}
+
+// ScheduledRepositoryMockListDueResults contains results of the ScheduledRepository.ListDue
+type ScheduledRepositoryMockListDueResults struct {
+	spa1 []*model.ScheduledMessage
+	err  error
+}
+
+// ScheduledRepositoryMockListDueOrigins contains origins of expectations of the ScheduledRepository.ListDue
+type ScheduledRepositoryMockListDueExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListDue *mScheduledRepositoryMockListDue) Optional() *mScheduledRepositoryMockListDue {
+	mmListDue.optional = true
+	return mmListDue
+}
+
+// Expect sets up expected params for ScheduledRepository.ListDue
+func (mmListDue *mScheduledRepositoryMockListDue) Expect(ctx context.Context, limit uint64) *mScheduledRepositoryMockListDue {
+	if mmListDue.mock.funcListDue != nil {
+		mmListDue.mock.t.Fatalf("ScheduledRepositoryMock.ListDue mock is already set by Set")
+	}
+
+	if mmListDue.defaultExpectation == nil {
+		mmListDue.defaultExpectation = &ScheduledRepositoryMockListDueExpectation{}
+	}
+
+	if mmListDue.defaultExpectation.paramPtrs != nil {
+		mmListDue.mock.t.Fatalf("ScheduledRepositoryMock.ListDue mock is already set by ExpectParams functions")
+	}
+
+	mmListDue.defaultExpectation.params = &ScheduledRepositoryMockListDueParams{ctx, limit}
+	mmListDue.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListDue.expectations {
+		if minimock.Equal(e.params, mmListDue.defaultExpectation.params) {
+			mmListDue.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListDue.defaultExpectation.params)
+		}
+	}
+
+	return mmListDue
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledRepository.ListDue
+func (mmListDue *mScheduledRepositoryMockListDue) ExpectCtxParam1(ctx context.Context) *mScheduledRepositoryMockListDue {
+	if mmListDue.mock.funcListDue != nil {
+		mmListDue.mock.t.Fatalf("ScheduledRepositoryMock.ListDue mock is already set by Set")
+	}
+
+	if mmListDue.defaultExpectation == nil {
+		mmListDue.defaultExpectation = &ScheduledRepositoryMockListDueExpectation{}
+	}
+
+	if mmListDue.defaultExpectation.params != nil {
+		mmListDue.mock.t.Fatalf("ScheduledRepositoryMock.ListDue mock is already set by Expect")
+	}
+
+	if mmListDue.defaultExpectation.paramPtrs == nil {
+		mmListDue.defaultExpectation.paramPtrs = &ScheduledRepositoryMockListDueParamPtrs{}
+	}
+	mmListDue.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListDue.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListDue
+}
+
+// ExpectLimitParam2 sets up expected param limit for ScheduledRepository.ListDue
+func (mmListDue *mScheduledRepositoryMockListDue) ExpectLimitParam2(limit uint64) *mScheduledRepositoryMockListDue {
+	if mmListDue.mock.funcListDue != nil {
+		mmListDue.mock.t.Fatalf("ScheduledRepositoryMock.ListDue mock is already set by Set")
+	}
+
+	if mmListDue.defaultExpectation == nil {
+		mmListDue.defaultExpectation = &ScheduledRepositoryMockListDueExpectation{}
+	}
+
+	if mmListDue.defaultExpectation.params != nil {
+		mmListDue.mock.t.Fatalf("ScheduledRepositoryMock.ListDue mock is already set by Expect")
+	}
+
+	if mmListDue.defaultExpectation.paramPtrs == nil {
+		mmListDue.defaultExpectation.paramPtrs = &ScheduledRepositoryMockListDueParamPtrs{}
+	}
+	mmListDue.defaultExpectation.paramPtrs.limit = &limit
+	mmListDue.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListDue
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledRepository.ListDue
+func (mmListDue *mScheduledRepositoryMockListDue) Inspect(f func(ctx context.Context, limit uint64)) *mScheduledRepositoryMockListDue {
+	if mmListDue.mock.inspectFuncListDue != nil {
+		mmListDue.mock.t.Fatalf("Inspect function is already set for ScheduledRepositoryMock.ListDue")
+	}
+
+	mmListDue.mock.inspectFuncListDue = f
+
+	return mmListDue
+}
+
+// Return sets up results that will be returned by ScheduledRepository.ListDue
+func (mmListDue *mScheduledRepositoryMockListDue) Return(spa1 []*model.ScheduledMessage, err error) *ScheduledRepositoryMock {
+	if mmListDue.mock.funcListDue != nil {
+		mmListDue.mock.t.Fatalf("ScheduledRepositoryMock.ListDue mock is already set by Set")
+	}
+
+	if mmListDue.defaultExpectation == nil {
+		mmListDue.defaultExpectation = &ScheduledRepositoryMockListDueExpectation{mock: mmListDue.mock}
+	}
+	mmListDue.defaultExpectation.results = &ScheduledRepositoryMockListDueResults{spa1, err}
+	mmListDue.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListDue.mock
+}
+
+// Set uses given function f to mock the ScheduledRepository.ListDue method
+func (mmListDue *mScheduledRepositoryMockListDue) Set(f func(ctx context.Context, limit uint64) (spa1 []*model.ScheduledMessage, err error)) *ScheduledRepositoryMock {
+	if mmListDue.defaultExpectation != nil {
+		mmListDue.mock.t.Fatalf("Default expectation is already set for the ScheduledRepository.ListDue method")
+	}
+
+	if len(mmListDue.expectations) > 0 {
+		mmListDue.mock.t.Fatalf("Some expectations are already set for the ScheduledRepository.ListDue method")
+	}
+
+	mmListDue.mock.funcListDue = f
+	mmListDue.mock.funcListDueOrigin = minimock.CallerInfo(1)
+	return mmListDue.mock
+}
+
+// When sets expectation for the ScheduledRepository.ListDue which will trigger the result defined by the following
+// Then helper
+func (mmListDue *mScheduledRepositoryMockListDue) When(ctx context.Context, limit uint64) *ScheduledRepositoryMockListDueExpectation {
+	if mmListDue.mock.funcListDue != nil {
+		mmListDue.mock.t.Fatalf("ScheduledRepositoryMock.ListDue mock is already set by Set")
+	}
+
+	expectation := &ScheduledRepositoryMockListDueExpectation{
+		mock:               mmListDue.mock,
+		params:             &ScheduledRepositoryMockListDueParams{ctx, limit},
+		expectationOrigins: ScheduledRepositoryMockListDueExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListDue.expectations = append(mmListDue.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledRepository.ListDue return parameters for the expectation previously defined by the When method
+func (e *ScheduledRepositoryMockListDueExpectation) Then(spa1 []*model.ScheduledMessage, err error) *ScheduledRepositoryMock {
+	e.results = &ScheduledRepositoryMockListDueResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledRepository.ListDue should be invoked
+func (mmListDue *mScheduledRepositoryMockListDue) Times(n uint64) *mScheduledRepositoryMockListDue {
+	if n == 0 {
+		mmListDue.mock.t.Fatalf("Times of ScheduledRepositoryMock.ListDue mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListDue.expectedInvocations, n)
+	mmListDue.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListDue
+}
+
+func (mmListDue *mScheduledRepositoryMockListDue) invocationsDone() bool {
+	if len(mmListDue.expectations) == 0 && mmListDue.defaultExpectation == nil && mmListDue.mock.funcListDue == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListDue.mock.afterListDueCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListDue.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListDue implements mm_repository.ScheduledRepository
+func (mmListDue *ScheduledRepositoryMock) ListDue(ctx context.Context, limit uint64) (spa1 []*model.ScheduledMessage, err error) {
+	mm_atomic.AddUint64(&mmListDue.beforeListDueCounter, 1)
+	defer mm_atomic.AddUint64(&mmListDue.afterListDueCounter, 1)
+
+	mmListDue.t.Helper()
+
+	if mmListDue.inspectFuncListDue != nil {
+		mmListDue.inspectFuncListDue(ctx, limit)
+	}
+
+	mm_params := ScheduledRepositoryMockListDueParams{ctx, limit}
+
+	// Record call args
+	mmListDue.ListDueMock.mutex.Lock()
+	mmListDue.ListDueMock.callArgs = append(mmListDue.ListDueMock.callArgs, &mm_params)
+	mmListDue.ListDueMock.mutex.Unlock()
+
+	for _, e := range mmListDue.ListDueMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmListDue.ListDueMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListDue.ListDueMock.defaultExpectation.Counter, 1)
+		mm_want := mmListDue.ListDueMock.defaultExpectation.params
+		mm_want_ptrs := mmListDue.ListDueMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledRepositoryMockListDueParams{ctx, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListDue.t.Errorf("ScheduledRepositoryMock.ListDue got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDue.ListDueMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListDue.t.Errorf("ScheduledRepositoryMock.ListDue got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDue.ListDueMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListDue.t.Errorf("ScheduledRepositoryMock.ListDue got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListDue.ListDueMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListDue.ListDueMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListDue.t.Fatal("No results are set for the ScheduledRepositoryMock.ListDue")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmListDue.funcListDue != nil {
+		return mmListDue.funcListDue(ctx, limit)
+	}
+	mmListDue.t.Fatalf("Unexpected call to ScheduledRepositoryMock.ListDue. %v %v", ctx, limit)
+	return
+}
+
+// ListDueAfterCounter returns a count of finished ScheduledRepositoryMock.ListDue invocations
+func (mmListDue *ScheduledRepositoryMock) ListDueAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDue.afterListDueCounter)
+}
+
+// ListDueBeforeCounter returns a count of ScheduledRepositoryMock.ListDue invocations
+func (mmListDue *ScheduledRepositoryMock) ListDueBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDue.beforeListDueCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledRepositoryMock.ListDue.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListDue *mScheduledRepositoryMockListDue) Calls() []*ScheduledRepositoryMockListDueParams {
+	mmListDue.mutex.RLock()
+
+	argCopy := make([]*ScheduledRepositoryMockListDueParams, len(mmListDue.callArgs))
+	copy(argCopy, mmListDue.callArgs)
+
+	mmListDue.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDueDone returns true if the count of the ListDue invocations corresponds
+// the number of defined expectations
+func (m *ScheduledRepositoryMock) MinimockListDueDone() bool {
+	if m.ListDueMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListDueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListDueMock.invocationsDone()
+}
+
+// MinimockListDueInspect logs each unmet expectation
+func (m *ScheduledRepositoryMock) MinimockListDueInspect() {
+	for _, e := range m.ListDueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.ListDue at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListDueCounter := mm_atomic.LoadUint64(&m.afterListDueCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListDueMock.defaultExpectation != nil && afterListDueCounter < 1 {
+		if m.ListDueMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.ListDue at\n%s", m.ListDueMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.ListDue at\n%s with params: %#v", m.ListDueMock.defaultExpectation.expectationOrigins.origin, *m.ListDueMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListDue != nil && afterListDueCounter < 1 {
+		m.t.Errorf("Expected call to ScheduledRepositoryMock.ListDue at\n%s", m.funcListDueOrigin)
+	}
+
+	if !m.ListDueMock.invocationsDone() && afterListDueCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledRepositoryMock.ListDue at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListDueMock.expectedInvocations), m.ListDueMock.expectedInvocationsOrigin, afterListDueCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ScheduledRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockFailInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockListDueInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ScheduledRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ScheduledRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockFailDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockListDone() &&
+		m.MinimockListDueDone()
+}
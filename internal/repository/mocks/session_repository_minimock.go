@@ -0,0 +1,1515 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Mobo140/chat/internal/repository.SessionRepository -o session_repository_minimock.go -n SessionRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// SessionRepositoryMock implements mm_repository.SessionRepository
+type SessionRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, session *model.Session) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, session *model.Session)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mSessionRepositoryMockCreate
+
+	funcDelete          func(ctx context.Context, id string) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, id string)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mSessionRepositoryMockDelete
+
+	funcList          func(ctx context.Context, filter *model.SessionFilter) (spa1 []*model.Session, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context, filter *model.SessionFilter)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mSessionRepositoryMockList
+
+	funcTouch          func(ctx context.Context, id string, lastActiveAt time.Time) (err error)
+	funcTouchOrigin    string
+	inspectFuncTouch   func(ctx context.Context, id string, lastActiveAt time.Time)
+	afterTouchCounter  uint64
+	beforeTouchCounter uint64
+	TouchMock          mSessionRepositoryMockTouch
+}
+
+// NewSessionRepositoryMock returns a mock for mm_repository.SessionRepository
+func NewSessionRepositoryMock(t minimock.Tester) *SessionRepositoryMock {
+	m := &SessionRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mSessionRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*SessionRepositoryMockCreateParams{}
+
+	m.DeleteMock = mSessionRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*SessionRepositoryMockDeleteParams{}
+
+	m.ListMock = mSessionRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*SessionRepositoryMockListParams{}
+
+	m.TouchMock = mSessionRepositoryMockTouch{mock: m}
+	m.TouchMock.callArgs = []*SessionRepositoryMockTouchParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mSessionRepositoryMockCreate struct {
+	optional           bool
+	mock               *SessionRepositoryMock
+	defaultExpectation *SessionRepositoryMockCreateExpectation
+	expectations       []*SessionRepositoryMockCreateExpectation
+
+	callArgs []*SessionRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SessionRepositoryMockCreateExpectation specifies expectation struct of the SessionRepository.Create
+type SessionRepositoryMockCreateExpectation struct {
+	mock               *SessionRepositoryMock
+	params             *SessionRepositoryMockCreateParams
+	paramPtrs          *SessionRepositoryMockCreateParamPtrs
+	expectationOrigins SessionRepositoryMockCreateExpectationOrigins
+	results            *SessionRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SessionRepositoryMockCreateParams contains parameters of the SessionRepository.Create
+type SessionRepositoryMockCreateParams struct {
+	ctx     context.Context
+	session *model.Session
+}
+
+// SessionRepositoryMockCreateParamPtrs contains pointers to parameters of the SessionRepository.Create
+type SessionRepositoryMockCreateParamPtrs struct {
+	ctx     *context.Context
+	session **model.Session
+}
+
+// SessionRepositoryMockCreateResults contains results of the SessionRepository.Create
+type SessionRepositoryMockCreateResults struct {
+	err error
+}
+
+// SessionRepositoryMockCreateOrigins contains origins of expectations of the SessionRepository.Create
+type SessionRepositoryMockCreateExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originSession string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mSessionRepositoryMockCreate) Optional() *mSessionRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for SessionRepository.Create
+func (mmCreate *mSessionRepositoryMockCreate) Expect(ctx context.Context, session *model.Session) *mSessionRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("SessionRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &SessionRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("SessionRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &SessionRepositoryMockCreateParams{ctx, session}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SessionRepository.Create
+func (mmCreate *mSessionRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mSessionRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("SessionRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &SessionRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("SessionRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &SessionRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectSessionParam2 sets up expected param session for SessionRepository.Create
+func (mmCreate *mSessionRepositoryMockCreate) ExpectSessionParam2(session *model.Session) *mSessionRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("SessionRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &SessionRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("SessionRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &SessionRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.session = &session
+	mmCreate.defaultExpectation.expectationOrigins.originSession = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the SessionRepository.Create
+func (mmCreate *mSessionRepositoryMockCreate) Inspect(f func(ctx context.Context, session *model.Session)) *mSessionRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for SessionRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by SessionRepository.Create
+func (mmCreate *mSessionRepositoryMockCreate) Return(err error) *SessionRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("SessionRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &SessionRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &SessionRepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the SessionRepository.Create method
+func (mmCreate *mSessionRepositoryMockCreate) Set(f func(ctx context.Context, session *model.Session) (err error)) *SessionRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the SessionRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the SessionRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the SessionRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mSessionRepositoryMockCreate) When(ctx context.Context, session *model.Session) *SessionRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("SessionRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &SessionRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &SessionRepositoryMockCreateParams{ctx, session},
+		expectationOrigins: SessionRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SessionRepository.Create return parameters for the expectation previously defined by the When method
+func (e *SessionRepositoryMockCreateExpectation) Then(err error) *SessionRepositoryMock {
+	e.results = &SessionRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times SessionRepository.Create should be invoked
+func (mmCreate *mSessionRepositoryMockCreate) Times(n uint64) *mSessionRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of SessionRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mSessionRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_repository.SessionRepository
+func (mmCreate *SessionRepositoryMock) Create(ctx context.Context, session *model.Session) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, session)
+	}
+
+	mm_params := SessionRepositoryMockCreateParams{ctx, session}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := SessionRepositoryMockCreateParams{ctx, session}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("SessionRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.session != nil && !minimock.Equal(*mm_want_ptrs.session, mm_got.session) {
+				mmCreate.t.Errorf("SessionRepositoryMock.Create got unexpected parameter session, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originSession, *mm_want_ptrs.session, mm_got.session, minimock.Diff(*mm_want_ptrs.session, mm_got.session))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("SessionRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the SessionRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, session)
+	}
+	mmCreate.t.Fatalf("Unexpected call to SessionRepositoryMock.Create. %v %v", ctx, session)
+	return
+}
+
+// CreateAfterCounter returns a count of finished SessionRepositoryMock.Create invocations
+func (mmCreate *SessionRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of SessionRepositoryMock.Create invocations
+func (mmCreate *SessionRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to SessionRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mSessionRepositoryMockCreate) Calls() []*SessionRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*SessionRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *SessionRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *SessionRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to SessionRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to SessionRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mSessionRepositoryMockDelete struct {
+	optional           bool
+	mock               *SessionRepositoryMock
+	defaultExpectation *SessionRepositoryMockDeleteExpectation
+	expectations       []*SessionRepositoryMockDeleteExpectation
+
+	callArgs []*SessionRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SessionRepositoryMockDeleteExpectation specifies expectation struct of the SessionRepository.Delete
+type SessionRepositoryMockDeleteExpectation struct {
+	mock               *SessionRepositoryMock
+	params             *SessionRepositoryMockDeleteParams
+	paramPtrs          *SessionRepositoryMockDeleteParamPtrs
+	expectationOrigins SessionRepositoryMockDeleteExpectationOrigins
+	results            *SessionRepositoryMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SessionRepositoryMockDeleteParams contains parameters of the SessionRepository.Delete
+type SessionRepositoryMockDeleteParams struct {
+	ctx context.Context
+	id  string
+}
+
+// SessionRepositoryMockDeleteParamPtrs contains pointers to parameters of the SessionRepository.Delete
+type SessionRepositoryMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// SessionRepositoryMockDeleteResults contains results of the SessionRepository.Delete
+type SessionRepositoryMockDeleteResults struct {
+	err error
+}
+
+// SessionRepositoryMockDeleteOrigins contains origins of expectations of the SessionRepository.Delete
+type SessionRepositoryMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mSessionRepositoryMockDelete) Optional() *mSessionRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for SessionRepository.Delete
+func (mmDelete *mSessionRepositoryMockDelete) Expect(ctx context.Context, id string) *mSessionRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("SessionRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &SessionRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("SessionRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &SessionRepositoryMockDeleteParams{ctx, id}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SessionRepository.Delete
+func (mmDelete *mSessionRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mSessionRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("SessionRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &SessionRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("SessionRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &SessionRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for SessionRepository.Delete
+func (mmDelete *mSessionRepositoryMockDelete) ExpectIdParam2(id string) *mSessionRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("SessionRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &SessionRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("SessionRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &SessionRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the SessionRepository.Delete
+func (mmDelete *mSessionRepositoryMockDelete) Inspect(f func(ctx context.Context, id string)) *mSessionRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for SessionRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by SessionRepository.Delete
+func (mmDelete *mSessionRepositoryMockDelete) Return(err error) *SessionRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("SessionRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &SessionRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &SessionRepositoryMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the SessionRepository.Delete method
+func (mmDelete *mSessionRepositoryMockDelete) Set(f func(ctx context.Context, id string) (err error)) *SessionRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the SessionRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the SessionRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the SessionRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mSessionRepositoryMockDelete) When(ctx context.Context, id string) *SessionRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("SessionRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &SessionRepositoryMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &SessionRepositoryMockDeleteParams{ctx, id},
+		expectationOrigins: SessionRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SessionRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *SessionRepositoryMockDeleteExpectation) Then(err error) *SessionRepositoryMock {
+	e.results = &SessionRepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times SessionRepository.Delete should be invoked
+func (mmDelete *mSessionRepositoryMockDelete) Times(n uint64) *mSessionRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of SessionRepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mSessionRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_repository.SessionRepository
+func (mmDelete *SessionRepositoryMock) Delete(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := SessionRepositoryMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := SessionRepositoryMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("SessionRepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("SessionRepositoryMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("SessionRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the SessionRepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to SessionRepositoryMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished SessionRepositoryMock.Delete invocations
+func (mmDelete *SessionRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of SessionRepositoryMock.Delete invocations
+func (mmDelete *SessionRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to SessionRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mSessionRepositoryMockDelete) Calls() []*SessionRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*SessionRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *SessionRepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *SessionRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to SessionRepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to SessionRepositoryMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mSessionRepositoryMockList struct {
+	optional           bool
+	mock               *SessionRepositoryMock
+	defaultExpectation *SessionRepositoryMockListExpectation
+	expectations       []*SessionRepositoryMockListExpectation
+
+	callArgs []*SessionRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SessionRepositoryMockListExpectation specifies expectation struct of the SessionRepository.List
+type SessionRepositoryMockListExpectation struct {
+	mock               *SessionRepositoryMock
+	params             *SessionRepositoryMockListParams
+	paramPtrs          *SessionRepositoryMockListParamPtrs
+	expectationOrigins SessionRepositoryMockListExpectationOrigins
+	results            *SessionRepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SessionRepositoryMockListParams contains parameters of the SessionRepository.List
+type SessionRepositoryMockListParams struct {
+	ctx    context.Context
+	filter *model.SessionFilter
+}
+
+// SessionRepositoryMockListParamPtrs contains pointers to parameters of the SessionRepository.List
+type SessionRepositoryMockListParamPtrs struct {
+	ctx    *context.Context
+	filter **model.SessionFilter
+}
+
+// SessionRepositoryMockListResults contains results of the SessionRepository.List
+type SessionRepositoryMockListResults struct {
+	spa1 []*model.Session
+	err  error
+}
+
+// SessionRepositoryMockListOrigins contains origins of expectations of the SessionRepository.List
+type SessionRepositoryMockListExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mSessionRepositoryMockList) Optional() *mSessionRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for SessionRepository.List
+func (mmList *mSessionRepositoryMockList) Expect(ctx context.Context, filter *model.SessionFilter) *mSessionRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("SessionRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &SessionRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("SessionRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &SessionRepositoryMockListParams{ctx, filter}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SessionRepository.List
+func (mmList *mSessionRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mSessionRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("SessionRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &SessionRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("SessionRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &SessionRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectFilterParam2 sets up expected param filter for SessionRepository.List
+func (mmList *mSessionRepositoryMockList) ExpectFilterParam2(filter *model.SessionFilter) *mSessionRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("SessionRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &SessionRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("SessionRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &SessionRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.filter = &filter
+	mmList.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the SessionRepository.List
+func (mmList *mSessionRepositoryMockList) Inspect(f func(ctx context.Context, filter *model.SessionFilter)) *mSessionRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for SessionRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by SessionRepository.List
+func (mmList *mSessionRepositoryMockList) Return(spa1 []*model.Session, err error) *SessionRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("SessionRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &SessionRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &SessionRepositoryMockListResults{spa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the SessionRepository.List method
+func (mmList *mSessionRepositoryMockList) Set(f func(ctx context.Context, filter *model.SessionFilter) (spa1 []*model.Session, err error)) *SessionRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the SessionRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the SessionRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the SessionRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mSessionRepositoryMockList) When(ctx context.Context, filter *model.SessionFilter) *SessionRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("SessionRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &SessionRepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &SessionRepositoryMockListParams{ctx, filter},
+		expectationOrigins: SessionRepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SessionRepository.List return parameters for the expectation previously defined by the When method
+func (e *SessionRepositoryMockListExpectation) Then(spa1 []*model.Session, err error) *SessionRepositoryMock {
+	e.results = &SessionRepositoryMockListResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times SessionRepository.List should be invoked
+func (mmList *mSessionRepositoryMockList) Times(n uint64) *mSessionRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of SessionRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mSessionRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_repository.SessionRepository
+func (mmList *SessionRepositoryMock) List(ctx context.Context, filter *model.SessionFilter) (spa1 []*model.Session, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, filter)
+	}
+
+	mm_params := SessionRepositoryMockListParams{ctx, filter}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := SessionRepositoryMockListParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("SessionRepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmList.t.Errorf("SessionRepositoryMock.List got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("SessionRepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the SessionRepositoryMock.List")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, filter)
+	}
+	mmList.t.Fatalf("Unexpected call to SessionRepositoryMock.List. %v %v", ctx, filter)
+	return
+}
+
+// ListAfterCounter returns a count of finished SessionRepositoryMock.List invocations
+func (mmList *SessionRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of SessionRepositoryMock.List invocations
+func (mmList *SessionRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to SessionRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mSessionRepositoryMockList) Calls() []*SessionRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*SessionRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *SessionRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *SessionRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SessionRepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SessionRepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SessionRepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to SessionRepositoryMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to SessionRepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mSessionRepositoryMockTouch struct {
+	optional           bool
+	mock               *SessionRepositoryMock
+	defaultExpectation *SessionRepositoryMockTouchExpectation
+	expectations       []*SessionRepositoryMockTouchExpectation
+
+	callArgs []*SessionRepositoryMockTouchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SessionRepositoryMockTouchExpectation specifies expectation struct of the SessionRepository.Touch
+type SessionRepositoryMockTouchExpectation struct {
+	mock               *SessionRepositoryMock
+	params             *SessionRepositoryMockTouchParams
+	paramPtrs          *SessionRepositoryMockTouchParamPtrs
+	expectationOrigins SessionRepositoryMockTouchExpectationOrigins
+	results            *SessionRepositoryMockTouchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SessionRepositoryMockTouchParams contains parameters of the SessionRepository.Touch
+type SessionRepositoryMockTouchParams struct {
+	ctx          context.Context
+	id           string
+	lastActiveAt time.Time
+}
+
+// SessionRepositoryMockTouchParamPtrs contains pointers to parameters of the SessionRepository.Touch
+type SessionRepositoryMockTouchParamPtrs struct {
+	ctx          *context.Context
+	id           *string
+	lastActiveAt *time.Time
+}
+
+// SessionRepositoryMockTouchResults contains results of the SessionRepository.Touch
+type SessionRepositoryMockTouchResults struct {
+	err error
+}
+
+// SessionRepositoryMockTouchOrigins contains origins of expectations of the SessionRepository.Touch
+type SessionRepositoryMockTouchExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originId           string
+	originLastActiveAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTouch *mSessionRepositoryMockTouch) Optional() *mSessionRepositoryMockTouch {
+	mmTouch.optional = true
+	return mmTouch
+}
+
+// Expect sets up expected params for SessionRepository.Touch
+func (mmTouch *mSessionRepositoryMockTouch) Expect(ctx context.Context, id string, lastActiveAt time.Time) *mSessionRepositoryMockTouch {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Set")
+	}
+
+	if mmTouch.defaultExpectation == nil {
+		mmTouch.defaultExpectation = &SessionRepositoryMockTouchExpectation{}
+	}
+
+	if mmTouch.defaultExpectation.paramPtrs != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by ExpectParams functions")
+	}
+
+	mmTouch.defaultExpectation.params = &SessionRepositoryMockTouchParams{ctx, id, lastActiveAt}
+	mmTouch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTouch.expectations {
+		if minimock.Equal(e.params, mmTouch.defaultExpectation.params) {
+			mmTouch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTouch.defaultExpectation.params)
+		}
+	}
+
+	return mmTouch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SessionRepository.Touch
+func (mmTouch *mSessionRepositoryMockTouch) ExpectCtxParam1(ctx context.Context) *mSessionRepositoryMockTouch {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Set")
+	}
+
+	if mmTouch.defaultExpectation == nil {
+		mmTouch.defaultExpectation = &SessionRepositoryMockTouchExpectation{}
+	}
+
+	if mmTouch.defaultExpectation.params != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Expect")
+	}
+
+	if mmTouch.defaultExpectation.paramPtrs == nil {
+		mmTouch.defaultExpectation.paramPtrs = &SessionRepositoryMockTouchParamPtrs{}
+	}
+	mmTouch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTouch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmTouch
+}
+
+// ExpectIdParam2 sets up expected param id for SessionRepository.Touch
+func (mmTouch *mSessionRepositoryMockTouch) ExpectIdParam2(id string) *mSessionRepositoryMockTouch {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Set")
+	}
+
+	if mmTouch.defaultExpectation == nil {
+		mmTouch.defaultExpectation = &SessionRepositoryMockTouchExpectation{}
+	}
+
+	if mmTouch.defaultExpectation.params != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Expect")
+	}
+
+	if mmTouch.defaultExpectation.paramPtrs == nil {
+		mmTouch.defaultExpectation.paramPtrs = &SessionRepositoryMockTouchParamPtrs{}
+	}
+	mmTouch.defaultExpectation.paramPtrs.id = &id
+	mmTouch.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmTouch
+}
+
+// ExpectLastActiveAtParam3 sets up expected param lastActiveAt for SessionRepository.Touch
+func (mmTouch *mSessionRepositoryMockTouch) ExpectLastActiveAtParam3(lastActiveAt time.Time) *mSessionRepositoryMockTouch {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Set")
+	}
+
+	if mmTouch.defaultExpectation == nil {
+		mmTouch.defaultExpectation = &SessionRepositoryMockTouchExpectation{}
+	}
+
+	if mmTouch.defaultExpectation.params != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Expect")
+	}
+
+	if mmTouch.defaultExpectation.paramPtrs == nil {
+		mmTouch.defaultExpectation.paramPtrs = &SessionRepositoryMockTouchParamPtrs{}
+	}
+	mmTouch.defaultExpectation.paramPtrs.lastActiveAt = &lastActiveAt
+	mmTouch.defaultExpectation.expectationOrigins.originLastActiveAt = minimock.CallerInfo(1)
+
+	return mmTouch
+}
+
+// Inspect accepts an inspector function that has same arguments as the SessionRepository.Touch
+func (mmTouch *mSessionRepositoryMockTouch) Inspect(f func(ctx context.Context, id string, lastActiveAt time.Time)) *mSessionRepositoryMockTouch {
+	if mmTouch.mock.inspectFuncTouch != nil {
+		mmTouch.mock.t.Fatalf("Inspect function is already set for SessionRepositoryMock.Touch")
+	}
+
+	mmTouch.mock.inspectFuncTouch = f
+
+	return mmTouch
+}
+
+// Return sets up results that will be returned by SessionRepository.Touch
+func (mmTouch *mSessionRepositoryMockTouch) Return(err error) *SessionRepositoryMock {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Set")
+	}
+
+	if mmTouch.defaultExpectation == nil {
+		mmTouch.defaultExpectation = &SessionRepositoryMockTouchExpectation{mock: mmTouch.mock}
+	}
+	mmTouch.defaultExpectation.results = &SessionRepositoryMockTouchResults{err}
+	mmTouch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTouch.mock
+}
+
+// Set uses given function f to mock the SessionRepository.Touch method
+func (mmTouch *mSessionRepositoryMockTouch) Set(f func(ctx context.Context, id string, lastActiveAt time.Time) (err error)) *SessionRepositoryMock {
+	if mmTouch.defaultExpectation != nil {
+		mmTouch.mock.t.Fatalf("Default expectation is already set for the SessionRepository.Touch method")
+	}
+
+	if len(mmTouch.expectations) > 0 {
+		mmTouch.mock.t.Fatalf("Some expectations are already set for the SessionRepository.Touch method")
+	}
+
+	mmTouch.mock.funcTouch = f
+	mmTouch.mock.funcTouchOrigin = minimock.CallerInfo(1)
+	return mmTouch.mock
+}
+
+// When sets expectation for the SessionRepository.Touch which will trigger the result defined by the following
+// Then helper
+func (mmTouch *mSessionRepositoryMockTouch) When(ctx context.Context, id string, lastActiveAt time.Time) *SessionRepositoryMockTouchExpectation {
+	if mmTouch.mock.funcTouch != nil {
+		mmTouch.mock.t.Fatalf("SessionRepositoryMock.Touch mock is already set by Set")
+	}
+
+	expectation := &SessionRepositoryMockTouchExpectation{
+		mock:               mmTouch.mock,
+		params:             &SessionRepositoryMockTouchParams{ctx, id, lastActiveAt},
+		expectationOrigins: SessionRepositoryMockTouchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmTouch.expectations = append(mmTouch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SessionRepository.Touch return parameters for the expectation previously defined by the When method
+func (e *SessionRepositoryMockTouchExpectation) Then(err error) *SessionRepositoryMock {
+	e.results = &SessionRepositoryMockTouchResults{err}
+	return e.mock
+}
+
+// Times sets number of times SessionRepository.Touch should be invoked
+func (mmTouch *mSessionRepositoryMockTouch) Times(n uint64) *mSessionRepositoryMockTouch {
+	if n == 0 {
+		mmTouch.mock.t.Fatalf("Times of SessionRepositoryMock.Touch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTouch.expectedInvocations, n)
+	mmTouch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTouch
+}
+
+func (mmTouch *mSessionRepositoryMockTouch) invocationsDone() bool {
+	if len(mmTouch.expectations) == 0 && mmTouch.defaultExpectation == nil && mmTouch.mock.funcTouch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTouch.mock.afterTouchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTouch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Touch implements mm_repository.SessionRepository
+func (mmTouch *SessionRepositoryMock) Touch(ctx context.Context, id string, lastActiveAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmTouch.beforeTouchCounter, 1)
+	defer mm_atomic.AddUint64(&mmTouch.afterTouchCounter, 1)
+
+	mmTouch.t.Helper()
+
+	if mmTouch.inspectFuncTouch != nil {
+		mmTouch.inspectFuncTouch(ctx, id, lastActiveAt)
+	}
+
+	mm_params := SessionRepositoryMockTouchParams{ctx, id, lastActiveAt}
+
+	// Record call args
+	mmTouch.TouchMock.mutex.Lock()
+	mmTouch.TouchMock.callArgs = append(mmTouch.TouchMock.callArgs, &mm_params)
+	mmTouch.TouchMock.mutex.Unlock()
+
+	for _, e := range mmTouch.TouchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmTouch.TouchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTouch.TouchMock.defaultExpectation.Counter, 1)
+		mm_want := mmTouch.TouchMock.defaultExpectation.params
+		mm_want_ptrs := mmTouch.TouchMock.defaultExpectation.paramPtrs
+
+		mm_got := SessionRepositoryMockTouchParams{ctx, id, lastActiveAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTouch.t.Errorf("SessionRepositoryMock.Touch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTouch.TouchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmTouch.t.Errorf("SessionRepositoryMock.Touch got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTouch.TouchMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.lastActiveAt != nil && !minimock.Equal(*mm_want_ptrs.lastActiveAt, mm_got.lastActiveAt) {
+				mmTouch.t.Errorf("SessionRepositoryMock.Touch got unexpected parameter lastActiveAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTouch.TouchMock.defaultExpectation.expectationOrigins.originLastActiveAt, *mm_want_ptrs.lastActiveAt, mm_got.lastActiveAt, minimock.Diff(*mm_want_ptrs.lastActiveAt, mm_got.lastActiveAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTouch.t.Errorf("SessionRepositoryMock.Touch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTouch.TouchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTouch.TouchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTouch.t.Fatal("No results are set for the SessionRepositoryMock.Touch")
+		}
+		return (*mm_results).err
+	}
+	if mmTouch.funcTouch != nil {
+		return mmTouch.funcTouch(ctx, id, lastActiveAt)
+	}
+	mmTouch.t.Fatalf("Unexpected call to SessionRepositoryMock.Touch. %v %v %v", ctx, id, lastActiveAt)
+	return
+}
+
+// TouchAfterCounter returns a count of finished SessionRepositoryMock.Touch invocations
+func (mmTouch *SessionRepositoryMock) TouchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouch.afterTouchCounter)
+}
+
+// TouchBeforeCounter returns a count of SessionRepositoryMock.Touch invocations
+func (mmTouch *SessionRepositoryMock) TouchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouch.beforeTouchCounter)
+}
+
+// Calls returns a list of arguments used in each call to SessionRepositoryMock.Touch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTouch *mSessionRepositoryMockTouch) Calls() []*SessionRepositoryMockTouchParams {
+	mmTouch.mutex.RLock()
+
+	argCopy := make([]*SessionRepositoryMockTouchParams, len(mmTouch.callArgs))
+	copy(argCopy, mmTouch.callArgs)
+
+	mmTouch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTouchDone returns true if the count of the Touch invocations corresponds
+// the number of defined expectations
+func (m *SessionRepositoryMock) MinimockTouchDone() bool {
+	if m.TouchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TouchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TouchMock.invocationsDone()
+}
+
+// MinimockTouchInspect logs each unmet expectation
+func (m *SessionRepositoryMock) MinimockTouchInspect() {
+	for _, e := range m.TouchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Touch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterTouchCounter := mm_atomic.LoadUint64(&m.afterTouchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TouchMock.defaultExpectation != nil && afterTouchCounter < 1 {
+		if m.TouchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Touch at\n%s", m.TouchMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SessionRepositoryMock.Touch at\n%s with params: %#v", m.TouchMock.defaultExpectation.expectationOrigins.origin, *m.TouchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTouch != nil && afterTouchCounter < 1 {
+		m.t.Errorf("Expected call to SessionRepositoryMock.Touch at\n%s", m.funcTouchOrigin)
+	}
+
+	if !m.TouchMock.invocationsDone() && afterTouchCounter > 0 {
+		m.t.Errorf("Expected %d calls to SessionRepositoryMock.Touch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TouchMock.expectedInvocations), m.TouchMock.expectedInvocationsOrigin, afterTouchCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SessionRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockTouchInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SessionRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *SessionRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockListDone() &&
+		m.MinimockTouchDone()
+}
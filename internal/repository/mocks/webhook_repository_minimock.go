@@ -0,0 +1,4452 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Mobo140/chat/internal/repository.WebhookRepository -o webhook_repository_minimock.go -n WebhookRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// WebhookRepositoryMock implements mm_repository.WebhookRepository
+type WebhookRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcClaim          func(ctx context.Context, ids []int64, lease time.Duration) (err error)
+	funcClaimOrigin    string
+	inspectFuncClaim   func(ctx context.Context, ids []int64, lease time.Duration)
+	afterClaimCounter  uint64
+	beforeClaimCounter uint64
+	ClaimMock          mWebhookRepositoryMockClaim
+
+	funcComplete          func(ctx context.Context, id int64) (err error)
+	funcCompleteOrigin    string
+	inspectFuncComplete   func(ctx context.Context, id int64)
+	afterCompleteCounter  uint64
+	beforeCompleteCounter uint64
+	CompleteMock          mWebhookRepositoryMockComplete
+
+	funcCreate          func(ctx context.Context, webhook *model.Webhook) (i1 int64, err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, webhook *model.Webhook)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mWebhookRepositoryMockCreate
+
+	funcCreateDeliveries          func(ctx context.Context, deliveries []*model.WebhookDelivery) (err error)
+	funcCreateDeliveriesOrigin    string
+	inspectFuncCreateDeliveries   func(ctx context.Context, deliveries []*model.WebhookDelivery)
+	afterCreateDeliveriesCounter  uint64
+	beforeCreateDeliveriesCounter uint64
+	CreateDeliveriesMock          mWebhookRepositoryMockCreateDeliveries
+
+	funcDeadLetter          func(ctx context.Context, delivery *model.WebhookDelivery, lastError string) (err error)
+	funcDeadLetterOrigin    string
+	inspectFuncDeadLetter   func(ctx context.Context, delivery *model.WebhookDelivery, lastError string)
+	afterDeadLetterCounter  uint64
+	beforeDeadLetterCounter uint64
+	DeadLetterMock          mWebhookRepositoryMockDeadLetter
+
+	funcDelete          func(ctx context.Context, id int64) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, id int64)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mWebhookRepositoryMockDelete
+
+	funcList          func(ctx context.Context, chatID int64) (wpa1 []*model.Webhook, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context, chatID int64)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mWebhookRepositoryMockList
+
+	funcListAttempts          func(ctx context.Context, filter *model.WebhookAttemptFilter) (wpa1 []*model.WebhookAttempt, err error)
+	funcListAttemptsOrigin    string
+	inspectFuncListAttempts   func(ctx context.Context, filter *model.WebhookAttemptFilter)
+	afterListAttemptsCounter  uint64
+	beforeListAttemptsCounter uint64
+	ListAttemptsMock          mWebhookRepositoryMockListAttempts
+
+	funcListDue          func(ctx context.Context, limit uint64) (wpa1 []*model.WebhookDelivery, err error)
+	funcListDueOrigin    string
+	inspectFuncListDue   func(ctx context.Context, limit uint64)
+	afterListDueCounter  uint64
+	beforeListDueCounter uint64
+	ListDueMock          mWebhookRepositoryMockListDue
+
+	funcListSubscribed          func(ctx context.Context, chatID int64) (wpa1 []*model.Webhook, err error)
+	funcListSubscribedOrigin    string
+	inspectFuncListSubscribed   func(ctx context.Context, chatID int64)
+	afterListSubscribedCounter  uint64
+	beforeListSubscribedCounter uint64
+	ListSubscribedMock          mWebhookRepositoryMockListSubscribed
+
+	funcLogAttempt          func(ctx context.Context, attempt *model.WebhookAttempt) (err error)
+	funcLogAttemptOrigin    string
+	inspectFuncLogAttempt   func(ctx context.Context, attempt *model.WebhookAttempt)
+	afterLogAttemptCounter  uint64
+	beforeLogAttemptCounter uint64
+	LogAttemptMock          mWebhookRepositoryMockLogAttempt
+
+	funcReschedule          func(ctx context.Context, id int64, attempts int, delay time.Duration) (err error)
+	funcRescheduleOrigin    string
+	inspectFuncReschedule   func(ctx context.Context, id int64, attempts int, delay time.Duration)
+	afterRescheduleCounter  uint64
+	beforeRescheduleCounter uint64
+	RescheduleMock          mWebhookRepositoryMockReschedule
+}
+
+// NewWebhookRepositoryMock returns a mock for mm_repository.WebhookRepository
+func NewWebhookRepositoryMock(t minimock.Tester) *WebhookRepositoryMock {
+	m := &WebhookRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ClaimMock = mWebhookRepositoryMockClaim{mock: m}
+	m.ClaimMock.callArgs = []*WebhookRepositoryMockClaimParams{}
+
+	m.CompleteMock = mWebhookRepositoryMockComplete{mock: m}
+	m.CompleteMock.callArgs = []*WebhookRepositoryMockCompleteParams{}
+
+	m.CreateMock = mWebhookRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*WebhookRepositoryMockCreateParams{}
+
+	m.CreateDeliveriesMock = mWebhookRepositoryMockCreateDeliveries{mock: m}
+	m.CreateDeliveriesMock.callArgs = []*WebhookRepositoryMockCreateDeliveriesParams{}
+
+	m.DeadLetterMock = mWebhookRepositoryMockDeadLetter{mock: m}
+	m.DeadLetterMock.callArgs = []*WebhookRepositoryMockDeadLetterParams{}
+
+	m.DeleteMock = mWebhookRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*WebhookRepositoryMockDeleteParams{}
+
+	m.ListMock = mWebhookRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*WebhookRepositoryMockListParams{}
+
+	m.ListAttemptsMock = mWebhookRepositoryMockListAttempts{mock: m}
+	m.ListAttemptsMock.callArgs = []*WebhookRepositoryMockListAttemptsParams{}
+
+	m.ListDueMock = mWebhookRepositoryMockListDue{mock: m}
+	m.ListDueMock.callArgs = []*WebhookRepositoryMockListDueParams{}
+
+	m.ListSubscribedMock = mWebhookRepositoryMockListSubscribed{mock: m}
+	m.ListSubscribedMock.callArgs = []*WebhookRepositoryMockListSubscribedParams{}
+
+	m.LogAttemptMock = mWebhookRepositoryMockLogAttempt{mock: m}
+	m.LogAttemptMock.callArgs = []*WebhookRepositoryMockLogAttemptParams{}
+
+	m.RescheduleMock = mWebhookRepositoryMockReschedule{mock: m}
+	m.RescheduleMock.callArgs = []*WebhookRepositoryMockRescheduleParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mWebhookRepositoryMockClaim struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockClaimExpectation
+	expectations       []*WebhookRepositoryMockClaimExpectation
+
+	callArgs []*WebhookRepositoryMockClaimParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WebhookRepositoryMockClaimExpectation specifies expectation struct of the WebhookRepository.Claim
+type WebhookRepositoryMockClaimExpectation struct {
+	mock               *WebhookRepositoryMock
+	params             *WebhookRepositoryMockClaimParams
+	paramPtrs          *WebhookRepositoryMockClaimParamPtrs
+	expectationOrigins WebhookRepositoryMockClaimExpectationOrigins
+	results            *WebhookRepositoryMockClaimResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WebhookRepositoryMockClaimParams contains parameters of the WebhookRepository.Claim
+type WebhookRepositoryMockClaimParams struct {
+	ctx   context.Context
+	ids   []int64
+	lease time.Duration
+}
+
+// WebhookRepositoryMockClaimParamPtrs contains pointers to parameters of the WebhookRepository.Claim
+type WebhookRepositoryMockClaimParamPtrs struct {
+	ctx   *context.Context
+	ids   *[]int64
+	lease *time.Duration
+}
+
+// WebhookRepositoryMockClaimResults contains results of the WebhookRepository.Claim
+type WebhookRepositoryMockClaimResults struct {
+	err error
+}
+
+// WebhookRepositoryMockClaimOrigins contains origins of expectations of the WebhookRepository.Claim
+type WebhookRepositoryMockClaimExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originIds   string
+	originLease string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClaim *mWebhookRepositoryMockClaim) Optional() *mWebhookRepositoryMockClaim {
+	mmClaim.optional = true
+	return mmClaim
+}
+
+// Expect sets up expected params for WebhookRepository.Claim
+func (mmClaim *mWebhookRepositoryMockClaim) Expect(ctx context.Context, ids []int64, lease time.Duration) *mWebhookRepositoryMockClaim {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("WebhookRepositoryMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &WebhookRepositoryMockClaimExpectation{}
+	}
+
+	if mmClaim.defaultExpectation.paramPtrs != nil {
+		mmClaim.mock.t.Fatalf("WebhookRepositoryMock.Claim mock is already set by ExpectParams functions")
+	}
+
+	mmClaim.defaultExpectation.params = &WebhookRepositoryMockClaimParams{ctx, ids, lease}
+	mmClaim.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClaim.expectations {
+		if minimock.Equal(e.params, mmClaim.defaultExpectation.params) {
+			mmClaim.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaim.defaultExpectation.params)
+		}
+	}
+
+	return mmClaim
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.Claim
+func (mmClaim *mWebhookRepositoryMockClaim) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockClaim {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("WebhookRepositoryMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &WebhookRepositoryMockClaimExpectation{}
+	}
+
+	if mmClaim.defaultExpectation.params != nil {
+		mmClaim.mock.t.Fatalf("WebhookRepositoryMock.Claim mock is already set by Expect")
+	}
+
+	if mmClaim.defaultExpectation.paramPtrs == nil {
+		mmClaim.defaultExpectation.paramPtrs = &WebhookRepositoryMockClaimParamPtrs{}
+	}
+	mmClaim.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClaim.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClaim
+}
+
+// ExpectIdsParam2 sets up expected param ids for WebhookRepository.Claim
+func (mmClaim *mWebhookRepositoryMockClaim) ExpectIdsParam2(ids []int64) *mWebhookRepositoryMockClaim {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("WebhookRepositoryMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &WebhookRepositoryMockClaimExpectation{}
+	}
+
+	if mmClaim.defaultExpectation.params != nil {
+		mmClaim.mock.t.Fatalf("WebhookRepositoryMock.Claim mock is already set by Expect")
+	}
+
+	if mmClaim.defaultExpectation.paramPtrs == nil {
+		mmClaim.defaultExpectation.paramPtrs = &WebhookRepositoryMockClaimParamPtrs{}
+	}
+	mmClaim.defaultExpectation.paramPtrs.ids = &ids
+	mmClaim.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmClaim
+}
+
+// ExpectLeaseParam3 sets up expected param lease for WebhookRepository.Claim
+func (mmClaim *mWebhookRepositoryMockClaim) ExpectLeaseParam3(lease time.Duration) *mWebhookRepositoryMockClaim {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("WebhookRepositoryMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &WebhookRepositoryMockClaimExpectation{}
+	}
+
+	if mmClaim.defaultExpectation.params != nil {
+		mmClaim.mock.t.Fatalf("WebhookRepositoryMock.Claim mock is already set by Expect")
+	}
+
+	if mmClaim.defaultExpectation.paramPtrs == nil {
+		mmClaim.defaultExpectation.paramPtrs = &WebhookRepositoryMockClaimParamPtrs{}
+	}
+	mmClaim.defaultExpectation.paramPtrs.lease = &lease
+	mmClaim.defaultExpectation.expectationOrigins.originLease = minimock.CallerInfo(1)
+
+	return mmClaim
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.Claim
+func (mmClaim *mWebhookRepositoryMockClaim) Inspect(f func(ctx context.Context, ids []int64, lease time.Duration)) *mWebhookRepositoryMockClaim {
+	if mmClaim.mock.inspectFuncClaim != nil {
+		mmClaim.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.Claim")
+	}
+
+	mmClaim.mock.inspectFuncClaim = f
+
+	return mmClaim
+}
+
+// Return sets up results that will be returned by WebhookRepository.Claim
+func (mmClaim *mWebhookRepositoryMockClaim) Return(err error) *WebhookRepositoryMock {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("WebhookRepositoryMock.Claim mock is already set by Set")
+	}
+
+	if mmClaim.defaultExpectation == nil {
+		mmClaim.defaultExpectation = &WebhookRepositoryMockClaimExpectation{mock: mmClaim.mock}
+	}
+	mmClaim.defaultExpectation.results = &WebhookRepositoryMockClaimResults{err}
+	mmClaim.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClaim.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.Claim method
+func (mmClaim *mWebhookRepositoryMockClaim) Set(f func(ctx context.Context, ids []int64, lease time.Duration) (err error)) *WebhookRepositoryMock {
+	if mmClaim.defaultExpectation != nil {
+		mmClaim.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.Claim method")
+	}
+
+	if len(mmClaim.expectations) > 0 {
+		mmClaim.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.Claim method")
+	}
+
+	mmClaim.mock.funcClaim = f
+	mmClaim.mock.funcClaimOrigin = minimock.CallerInfo(1)
+	return mmClaim.mock
+}
+
+// When sets expectation for the WebhookRepository.Claim which will trigger the result defined by the following
+// Then helper
+func (mmClaim *mWebhookRepositoryMockClaim) When(ctx context.Context, ids []int64, lease time.Duration) *WebhookRepositoryMockClaimExpectation {
+	if mmClaim.mock.funcClaim != nil {
+		mmClaim.mock.t.Fatalf("WebhookRepositoryMock.Claim mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockClaimExpectation{
+		mock:               mmClaim.mock,
+		params:             &WebhookRepositoryMockClaimParams{ctx, ids, lease},
+		expectationOrigins: WebhookRepositoryMockClaimExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClaim.expectations = append(mmClaim.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.Claim return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockClaimExpectation) Then(err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockClaimResults{err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.Claim should be invoked
+func (mmClaim *mWebhookRepositoryMockClaim) Times(n uint64) *mWebhookRepositoryMockClaim {
+	if n == 0 {
+		mmClaim.mock.t.Fatalf("Times of WebhookRepositoryMock.Claim mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClaim.expectedInvocations, n)
+	mmClaim.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClaim
+}
+
+func (mmClaim *mWebhookRepositoryMockClaim) invocationsDone() bool {
+	if len(mmClaim.expectations) == 0 && mmClaim.defaultExpectation == nil && mmClaim.mock.funcClaim == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClaim.mock.afterClaimCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClaim.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Claim implements mm_repository.WebhookRepository
+func (mmClaim *WebhookRepositoryMock) Claim(ctx context.Context, ids []int64, lease time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmClaim.beforeClaimCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaim.afterClaimCounter, 1)
+
+	mmClaim.t.Helper()
+
+	if mmClaim.inspectFuncClaim != nil {
+		mmClaim.inspectFuncClaim(ctx, ids, lease)
+	}
+
+	mm_params := WebhookRepositoryMockClaimParams{ctx, ids, lease}
+
+	// Record call args
+	mmClaim.ClaimMock.mutex.Lock()
+	mmClaim.ClaimMock.callArgs = append(mmClaim.ClaimMock.callArgs, &mm_params)
+	mmClaim.ClaimMock.mutex.Unlock()
+
+	for _, e := range mmClaim.ClaimMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmClaim.ClaimMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaim.ClaimMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaim.ClaimMock.defaultExpectation.params
+		mm_want_ptrs := mmClaim.ClaimMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockClaimParams{ctx, ids, lease}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClaim.t.Errorf("WebhookRepositoryMock.Claim got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaim.ClaimMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmClaim.t.Errorf("WebhookRepositoryMock.Claim got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaim.ClaimMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+			if mm_want_ptrs.lease != nil && !minimock.Equal(*mm_want_ptrs.lease, mm_got.lease) {
+				mmClaim.t.Errorf("WebhookRepositoryMock.Claim got unexpected parameter lease, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaim.ClaimMock.defaultExpectation.expectationOrigins.originLease, *mm_want_ptrs.lease, mm_got.lease, minimock.Diff(*mm_want_ptrs.lease, mm_got.lease))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClaim.t.Errorf("WebhookRepositoryMock.Claim got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClaim.ClaimMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClaim.ClaimMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClaim.t.Fatal("No results are set for the WebhookRepositoryMock.Claim")
+		}
+		return (*mm_results).err
+	}
+	if mmClaim.funcClaim != nil {
+		return mmClaim.funcClaim(ctx, ids, lease)
+	}
+	mmClaim.t.Fatalf("Unexpected call to WebhookRepositoryMock.Claim. %v %v %v", ctx, ids, lease)
+	return
+}
+
+// ClaimAfterCounter returns a count of finished WebhookRepositoryMock.Claim invocations
+func (mmClaim *WebhookRepositoryMock) ClaimAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaim.afterClaimCounter)
+}
+
+// ClaimBeforeCounter returns a count of WebhookRepositoryMock.Claim invocations
+func (mmClaim *WebhookRepositoryMock) ClaimBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaim.beforeClaimCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.Claim.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClaim *mWebhookRepositoryMockClaim) Calls() []*WebhookRepositoryMockClaimParams {
+	mmClaim.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockClaimParams, len(mmClaim.callArgs))
+	copy(argCopy, mmClaim.callArgs)
+
+	mmClaim.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClaimDone returns true if the count of the Claim invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockClaimDone() bool {
+	if m.ClaimMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClaimMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClaimMock.invocationsDone()
+}
+
+// MinimockClaimInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockClaimInspect() {
+	for _, e := range m.ClaimMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.Claim at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClaimCounter := mm_atomic.LoadUint64(&m.afterClaimCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClaimMock.defaultExpectation != nil && afterClaimCounter < 1 {
+		if m.ClaimMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.Claim at\n%s", m.ClaimMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.Claim at\n%s with params: %#v", m.ClaimMock.defaultExpectation.expectationOrigins.origin, *m.ClaimMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClaim != nil && afterClaimCounter < 1 {
+		m.t.Errorf("Expected call to WebhookRepositoryMock.Claim at\n%s", m.funcClaimOrigin)
+	}
+
+	if !m.ClaimMock.invocationsDone() && afterClaimCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.Claim at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClaimMock.expectedInvocations), m.ClaimMock.expectedInvocationsOrigin, afterClaimCounter)
+	}
+}
+
+type mWebhookRepositoryMockComplete struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockCompleteExpectation
+	expectations       []*WebhookRepositoryMockCompleteExpectation
+
+	callArgs []*WebhookRepositoryMockCompleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WebhookRepositoryMockCompleteExpectation specifies expectation struct of the WebhookRepository.Complete
+type WebhookRepositoryMockCompleteExpectation struct {
+	mock               *WebhookRepositoryMock
+	params             *WebhookRepositoryMockCompleteParams
+	paramPtrs          *WebhookRepositoryMockCompleteParamPtrs
+	expectationOrigins WebhookRepositoryMockCompleteExpectationOrigins
+	results            *WebhookRepositoryMockCompleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WebhookRepositoryMockCompleteParams contains parameters of the WebhookRepository.Complete
+type WebhookRepositoryMockCompleteParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// WebhookRepositoryMockCompleteParamPtrs contains pointers to parameters of the WebhookRepository.Complete
+type WebhookRepositoryMockCompleteParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// WebhookRepositoryMockCompleteResults contains results of the WebhookRepository.Complete
+type WebhookRepositoryMockCompleteResults struct {
+	err error
+}
+
+// WebhookRepositoryMockCompleteOrigins contains origins of expectations of the WebhookRepository.Complete
+type WebhookRepositoryMockCompleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmComplete *mWebhookRepositoryMockComplete) Optional() *mWebhookRepositoryMockComplete {
+	mmComplete.optional = true
+	return mmComplete
+}
+
+// Expect sets up expected params for WebhookRepository.Complete
+func (mmComplete *mWebhookRepositoryMockComplete) Expect(ctx context.Context, id int64) *mWebhookRepositoryMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("WebhookRepositoryMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &WebhookRepositoryMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs != nil {
+		mmComplete.mock.t.Fatalf("WebhookRepositoryMock.Complete mock is already set by ExpectParams functions")
+	}
+
+	mmComplete.defaultExpectation.params = &WebhookRepositoryMockCompleteParams{ctx, id}
+	mmComplete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmComplete.expectations {
+		if minimock.Equal(e.params, mmComplete.defaultExpectation.params) {
+			mmComplete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmComplete.defaultExpectation.params)
+		}
+	}
+
+	return mmComplete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.Complete
+func (mmComplete *mWebhookRepositoryMockComplete) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("WebhookRepositoryMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &WebhookRepositoryMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.params != nil {
+		mmComplete.mock.t.Fatalf("WebhookRepositoryMock.Complete mock is already set by Expect")
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs == nil {
+		mmComplete.defaultExpectation.paramPtrs = &WebhookRepositoryMockCompleteParamPtrs{}
+	}
+	mmComplete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmComplete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmComplete
+}
+
+// ExpectIdParam2 sets up expected param id for WebhookRepository.Complete
+func (mmComplete *mWebhookRepositoryMockComplete) ExpectIdParam2(id int64) *mWebhookRepositoryMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("WebhookRepositoryMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &WebhookRepositoryMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.params != nil {
+		mmComplete.mock.t.Fatalf("WebhookRepositoryMock.Complete mock is already set by Expect")
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs == nil {
+		mmComplete.defaultExpectation.paramPtrs = &WebhookRepositoryMockCompleteParamPtrs{}
+	}
+	mmComplete.defaultExpectation.paramPtrs.id = &id
+	mmComplete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmComplete
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.Complete
+func (mmComplete *mWebhookRepositoryMockComplete) Inspect(f func(ctx context.Context, id int64)) *mWebhookRepositoryMockComplete {
+	if mmComplete.mock.inspectFuncComplete != nil {
+		mmComplete.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.Complete")
+	}
+
+	mmComplete.mock.inspectFuncComplete = f
+
+	return mmComplete
+}
+
+// Return sets up results that will be returned by WebhookRepository.Complete
+func (mmComplete *mWebhookRepositoryMockComplete) Return(err error) *WebhookRepositoryMock {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("WebhookRepositoryMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &WebhookRepositoryMockCompleteExpectation{mock: mmComplete.mock}
+	}
+	mmComplete.defaultExpectation.results = &WebhookRepositoryMockCompleteResults{err}
+	mmComplete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmComplete.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.Complete method
+func (mmComplete *mWebhookRepositoryMockComplete) Set(f func(ctx context.Context, id int64) (err error)) *WebhookRepositoryMock {
+	if mmComplete.defaultExpectation != nil {
+		mmComplete.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.Complete method")
+	}
+
+	if len(mmComplete.expectations) > 0 {
+		mmComplete.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.Complete method")
+	}
+
+	mmComplete.mock.funcComplete = f
+	mmComplete.mock.funcCompleteOrigin = minimock.CallerInfo(1)
+	return mmComplete.mock
+}
+
+// When sets expectation for the WebhookRepository.Complete which will trigger the result defined by the following
+// Then helper
+func (mmComplete *mWebhookRepositoryMockComplete) When(ctx context.Context, id int64) *WebhookRepositoryMockCompleteExpectation {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("WebhookRepositoryMock.Complete mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockCompleteExpectation{
+		mock:               mmComplete.mock,
+		params:             &WebhookRepositoryMockCompleteParams{ctx, id},
+		expectationOrigins: WebhookRepositoryMockCompleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmComplete.expectations = append(mmComplete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.Complete return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockCompleteExpectation) Then(err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockCompleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.Complete should be invoked
+func (mmComplete *mWebhookRepositoryMockComplete) Times(n uint64) *mWebhookRepositoryMockComplete {
+	if n == 0 {
+		mmComplete.mock.t.Fatalf("Times of WebhookRepositoryMock.Complete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmComplete.expectedInvocations, n)
+	mmComplete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmComplete
+}
+
+func (mmComplete *mWebhookRepositoryMockComplete) invocationsDone() bool {
+	if len(mmComplete.expectations) == 0 && mmComplete.defaultExpectation == nil && mmComplete.mock.funcComplete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmComplete.mock.afterCompleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmComplete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Complete implements mm_repository.WebhookRepository
+func (mmComplete *WebhookRepositoryMock) Complete(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmComplete.beforeCompleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmComplete.afterCompleteCounter, 1)
+
+	mmComplete.t.Helper()
+
+	if mmComplete.inspectFuncComplete != nil {
+		mmComplete.inspectFuncComplete(ctx, id)
+	}
+
+	mm_params := WebhookRepositoryMockCompleteParams{ctx, id}
+
+	// Record call args
+	mmComplete.CompleteMock.mutex.Lock()
+	mmComplete.CompleteMock.callArgs = append(mmComplete.CompleteMock.callArgs, &mm_params)
+	mmComplete.CompleteMock.mutex.Unlock()
+
+	for _, e := range mmComplete.CompleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmComplete.CompleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmComplete.CompleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmComplete.CompleteMock.defaultExpectation.params
+		mm_want_ptrs := mmComplete.CompleteMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockCompleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmComplete.t.Errorf("WebhookRepositoryMock.Complete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmComplete.CompleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmComplete.t.Errorf("WebhookRepositoryMock.Complete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmComplete.CompleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmComplete.t.Errorf("WebhookRepositoryMock.Complete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmComplete.CompleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmComplete.CompleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmComplete.t.Fatal("No results are set for the WebhookRepositoryMock.Complete")
+		}
+		return (*mm_results).err
+	}
+	if mmComplete.funcComplete != nil {
+		return mmComplete.funcComplete(ctx, id)
+	}
+	mmComplete.t.Fatalf("Unexpected call to WebhookRepositoryMock.Complete. %v %v", ctx, id)
+	return
+}
+
+// CompleteAfterCounter returns a count of finished WebhookRepositoryMock.Complete invocations
+func (mmComplete *WebhookRepositoryMock) CompleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmComplete.afterCompleteCounter)
+}
+
+// CompleteBeforeCounter returns a count of WebhookRepositoryMock.Complete invocations
+func (mmComplete *WebhookRepositoryMock) CompleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmComplete.beforeCompleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.Complete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmComplete *mWebhookRepositoryMockComplete) Calls() []*WebhookRepositoryMockCompleteParams {
+	mmComplete.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockCompleteParams, len(mmComplete.callArgs))
+	copy(argCopy, mmComplete.callArgs)
+
+	mmComplete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCompleteDone returns true if the count of the Complete invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockCompleteDone() bool {
+	if m.CompleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CompleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CompleteMock.invocationsDone()
+}
+
+// MinimockCompleteInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockCompleteInspect() {
+	for _, e := range m.CompleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.Complete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCompleteCounter := mm_atomic.LoadUint64(&m.afterCompleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CompleteMock.defaultExpectation != nil && afterCompleteCounter < 1 {
+		if m.CompleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.Complete at\n%s", m.CompleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.Complete at\n%s with params: %#v", m.CompleteMock.defaultExpectation.expectationOrigins.origin, *m.CompleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcComplete != nil && afterCompleteCounter < 1 {
+		m.t.Errorf("Expected call to WebhookRepositoryMock.Complete at\n%s", m.funcCompleteOrigin)
+	}
+
+	if !m.CompleteMock.invocationsDone() && afterCompleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.Complete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CompleteMock.expectedInvocations), m.CompleteMock.expectedInvocationsOrigin, afterCompleteCounter)
+	}
+}
+
+type mWebhookRepositoryMockCreate struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockCreateExpectation
+	expectations       []*WebhookRepositoryMockCreateExpectation
+
+	callArgs []*WebhookRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WebhookRepositoryMockCreateExpectation specifies expectation struct of the WebhookRepository.Create
+type WebhookRepositoryMockCreateExpectation struct {
+	mock               *WebhookRepositoryMock
+	params             *WebhookRepositoryMockCreateParams
+	paramPtrs          *WebhookRepositoryMockCreateParamPtrs
+	expectationOrigins WebhookRepositoryMockCreateExpectationOrigins
+	results            *WebhookRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WebhookRepositoryMockCreateParams contains parameters of the WebhookRepository.Create
+type WebhookRepositoryMockCreateParams struct {
+	ctx     context.Context
+	webhook *model.Webhook
+}
+
+// WebhookRepositoryMockCreateParamPtrs contains pointers to parameters of the WebhookRepository.Create
+type WebhookRepositoryMockCreateParamPtrs struct {
+	ctx     *context.Context
+	webhook **model.Webhook
+}
+
+// WebhookRepositoryMockCreateResults contains results of the WebhookRepository.Create
+type WebhookRepositoryMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// WebhookRepositoryMockCreateOrigins contains origins of expectations of the WebhookRepository.Create
+type WebhookRepositoryMockCreateExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originWebhook string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mWebhookRepositoryMockCreate) Optional() *mWebhookRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for WebhookRepository.Create
+func (mmCreate *mWebhookRepositoryMockCreate) Expect(ctx context.Context, webhook *model.Webhook) *mWebhookRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("WebhookRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &WebhookRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("WebhookRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &WebhookRepositoryMockCreateParams{ctx, webhook}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.Create
+func (mmCreate *mWebhookRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("WebhookRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &WebhookRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("WebhookRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &WebhookRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectWebhookParam2 sets up expected param webhook for WebhookRepository.Create
+func (mmCreate *mWebhookRepositoryMockCreate) ExpectWebhookParam2(webhook *model.Webhook) *mWebhookRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("WebhookRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &WebhookRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("WebhookRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &WebhookRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.webhook = &webhook
+	mmCreate.defaultExpectation.expectationOrigins.originWebhook = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.Create
+func (mmCreate *mWebhookRepositoryMockCreate) Inspect(f func(ctx context.Context, webhook *model.Webhook)) *mWebhookRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by WebhookRepository.Create
+func (mmCreate *mWebhookRepositoryMockCreate) Return(i1 int64, err error) *WebhookRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("WebhookRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &WebhookRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &WebhookRepositoryMockCreateResults{i1, err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.Create method
+func (mmCreate *mWebhookRepositoryMockCreate) Set(f func(ctx context.Context, webhook *model.Webhook) (i1 int64, err error)) *WebhookRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the WebhookRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mWebhookRepositoryMockCreate) When(ctx context.Context, webhook *model.Webhook) *WebhookRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("WebhookRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &WebhookRepositoryMockCreateParams{ctx, webhook},
+		expectationOrigins: WebhookRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.Create return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockCreateExpectation) Then(i1 int64, err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.Create should be invoked
+func (mmCreate *mWebhookRepositoryMockCreate) Times(n uint64) *mWebhookRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of WebhookRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mWebhookRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_repository.WebhookRepository
+func (mmCreate *WebhookRepositoryMock) Create(ctx context.Context, webhook *model.Webhook) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, webhook)
+	}
+
+	mm_params := WebhookRepositoryMockCreateParams{ctx, webhook}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockCreateParams{ctx, webhook}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("WebhookRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.webhook != nil && !minimock.Equal(*mm_want_ptrs.webhook, mm_got.webhook) {
+				mmCreate.t.Errorf("WebhookRepositoryMock.Create got unexpected parameter webhook, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originWebhook, *mm_want_ptrs.webhook, mm_got.webhook, minimock.Diff(*mm_want_ptrs.webhook, mm_got.webhook))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("WebhookRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the WebhookRepositoryMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, webhook)
+	}
+	mmCreate.t.Fatalf("Unexpected call to WebhookRepositoryMock.Create. %v %v", ctx, webhook)
+	return
+}
+
+// CreateAfterCounter returns a count of finished WebhookRepositoryMock.Create invocations
+func (mmCreate *WebhookRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of WebhookRepositoryMock.Create invocations
+func (mmCreate *WebhookRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mWebhookRepositoryMockCreate) Calls() []*WebhookRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to WebhookRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mWebhookRepositoryMockCreateDeliveries struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockCreateDeliveriesExpectation
+	expectations       []*WebhookRepositoryMockCreateDeliveriesExpectation
+
+	callArgs []*WebhookRepositoryMockCreateDeliveriesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WebhookRepositoryMockCreateDeliveriesExpectation specifies expectation struct of the WebhookRepository.CreateDeliveries
+type WebhookRepositoryMockCreateDeliveriesExpectation struct {
+	mock               *WebhookRepositoryMock
+	params             *WebhookRepositoryMockCreateDeliveriesParams
+	paramPtrs          *WebhookRepositoryMockCreateDeliveriesParamPtrs
+	expectationOrigins WebhookRepositoryMockCreateDeliveriesExpectationOrigins
+	results            *WebhookRepositoryMockCreateDeliveriesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WebhookRepositoryMockCreateDeliveriesParams contains parameters of the WebhookRepository.CreateDeliveries
+type WebhookRepositoryMockCreateDeliveriesParams struct {
+	ctx        context.Context
+	deliveries []*model.WebhookDelivery
+}
+
+// WebhookRepositoryMockCreateDeliveriesParamPtrs contains pointers to parameters of the WebhookRepository.CreateDeliveries
+type WebhookRepositoryMockCreateDeliveriesParamPtrs struct {
+	ctx        *context.Context
+	deliveries *[]*model.WebhookDelivery
+}
+
+// WebhookRepositoryMockCreateDeliveriesResults contains results of the WebhookRepository.CreateDeliveries
+type WebhookRepositoryMockCreateDeliveriesResults struct {
+	err error
+}
+
+// WebhookRepositoryMockCreateDeliveriesOrigins contains origins of expectations of the WebhookRepository.CreateDeliveries
+type WebhookRepositoryMockCreateDeliveriesExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originDeliveries string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) Optional() *mWebhookRepositoryMockCreateDeliveries {
+	mmCreateDeliveries.optional = true
+	return mmCreateDeliveries
+}
+
+// Expect sets up expected params for WebhookRepository.CreateDeliveries
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) Expect(ctx context.Context, deliveries []*model.WebhookDelivery) *mWebhookRepositoryMockCreateDeliveries {
+	if mmCreateDeliveries.mock.funcCreateDeliveries != nil {
+		mmCreateDeliveries.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveries mock is already set by Set")
+	}
+
+	if mmCreateDeliveries.defaultExpectation == nil {
+		mmCreateDeliveries.defaultExpectation = &WebhookRepositoryMockCreateDeliveriesExpectation{}
+	}
+
+	if mmCreateDeliveries.defaultExpectation.paramPtrs != nil {
+		mmCreateDeliveries.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveries mock is already set by ExpectParams functions")
+	}
+
+	mmCreateDeliveries.defaultExpectation.params = &WebhookRepositoryMockCreateDeliveriesParams{ctx, deliveries}
+	mmCreateDeliveries.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateDeliveries.expectations {
+		if minimock.Equal(e.params, mmCreateDeliveries.defaultExpectation.params) {
+			mmCreateDeliveries.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateDeliveries.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateDeliveries
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.CreateDeliveries
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockCreateDeliveries {
+	if mmCreateDeliveries.mock.funcCreateDeliveries != nil {
+		mmCreateDeliveries.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveries mock is already set by Set")
+	}
+
+	if mmCreateDeliveries.defaultExpectation == nil {
+		mmCreateDeliveries.defaultExpectation = &WebhookRepositoryMockCreateDeliveriesExpectation{}
+	}
+
+	if mmCreateDeliveries.defaultExpectation.params != nil {
+		mmCreateDeliveries.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveries mock is already set by Expect")
+	}
+
+	if mmCreateDeliveries.defaultExpectation.paramPtrs == nil {
+		mmCreateDeliveries.defaultExpectation.paramPtrs = &WebhookRepositoryMockCreateDeliveriesParamPtrs{}
+	}
+	mmCreateDeliveries.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateDeliveries.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateDeliveries
+}
+
+// ExpectDeliveriesParam2 sets up expected param deliveries for WebhookRepository.CreateDeliveries
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) ExpectDeliveriesParam2(deliveries []*model.WebhookDelivery) *mWebhookRepositoryMockCreateDeliveries {
+	if mmCreateDeliveries.mock.funcCreateDeliveries != nil {
+		mmCreateDeliveries.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveries mock is already set by Set")
+	}
+
+	if mmCreateDeliveries.defaultExpectation == nil {
+		mmCreateDeliveries.defaultExpectation = &WebhookRepositoryMockCreateDeliveriesExpectation{}
+	}
+
+	if mmCreateDeliveries.defaultExpectation.params != nil {
+		mmCreateDeliveries.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveries mock is already set by Expect")
+	}
+
+	if mmCreateDeliveries.defaultExpectation.paramPtrs == nil {
+		mmCreateDeliveries.defaultExpectation.paramPtrs = &WebhookRepositoryMockCreateDeliveriesParamPtrs{}
+	}
+	mmCreateDeliveries.defaultExpectation.paramPtrs.deliveries = &deliveries
+	mmCreateDeliveries.defaultExpectation.expectationOrigins.originDeliveries = minimock.CallerInfo(1)
+
+	return mmCreateDeliveries
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.CreateDeliveries
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) Inspect(f func(ctx context.Context, deliveries []*model.WebhookDelivery)) *mWebhookRepositoryMockCreateDeliveries {
+	if mmCreateDeliveries.mock.inspectFuncCreateDeliveries != nil {
+		mmCreateDeliveries.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.CreateDeliveries")
+	}
+
+	mmCreateDeliveries.mock.inspectFuncCreateDeliveries = f
+
+	return mmCreateDeliveries
+}
+
+// Return sets up results that will be returned by WebhookRepository.CreateDeliveries
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) Return(err error) *WebhookRepositoryMock {
+	if mmCreateDeliveries.mock.funcCreateDeliveries != nil {
+		mmCreateDeliveries.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveries mock is already set by Set")
+	}
+
+	if mmCreateDeliveries.defaultExpectation == nil {
+		mmCreateDeliveries.defaultExpectation = &WebhookRepositoryMockCreateDeliveriesExpectation{mock: mmCreateDeliveries.mock}
+	}
+	mmCreateDeliveries.defaultExpectation.results = &WebhookRepositoryMockCreateDeliveriesResults{err}
+	mmCreateDeliveries.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateDeliveries.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.CreateDeliveries method
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) Set(f func(ctx context.Context, deliveries []*model.WebhookDelivery) (err error)) *WebhookRepositoryMock {
+	if mmCreateDeliveries.defaultExpectation != nil {
+		mmCreateDeliveries.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.CreateDeliveries method")
+	}
+
+	if len(mmCreateDeliveries.expectations) > 0 {
+		mmCreateDeliveries.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.CreateDeliveries method")
+	}
+
+	mmCreateDeliveries.mock.funcCreateDeliveries = f
+	mmCreateDeliveries.mock.funcCreateDeliveriesOrigin = minimock.CallerInfo(1)
+	return mmCreateDeliveries.mock
+}
+
+// When sets expectation for the WebhookRepository.CreateDeliveries which will trigger the result defined by the following
+// Then helper
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) When(ctx context.Context, deliveries []*model.WebhookDelivery) *WebhookRepositoryMockCreateDeliveriesExpectation {
+	if mmCreateDeliveries.mock.funcCreateDeliveries != nil {
+		mmCreateDeliveries.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveries mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockCreateDeliveriesExpectation{
+		mock:               mmCreateDeliveries.mock,
+		params:             &WebhookRepositoryMockCreateDeliveriesParams{ctx, deliveries},
+		expectationOrigins: WebhookRepositoryMockCreateDeliveriesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateDeliveries.expectations = append(mmCreateDeliveries.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.CreateDeliveries return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockCreateDeliveriesExpectation) Then(err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockCreateDeliveriesResults{err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.CreateDeliveries should be invoked
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) Times(n uint64) *mWebhookRepositoryMockCreateDeliveries {
+	if n == 0 {
+		mmCreateDeliveries.mock.t.Fatalf("Times of WebhookRepositoryMock.CreateDeliveries mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateDeliveries.expectedInvocations, n)
+	mmCreateDeliveries.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateDeliveries
+}
+
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) invocationsDone() bool {
+	if len(mmCreateDeliveries.expectations) == 0 && mmCreateDeliveries.defaultExpectation == nil && mmCreateDeliveries.mock.funcCreateDeliveries == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateDeliveries.mock.afterCreateDeliveriesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateDeliveries.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateDeliveries implements mm_repository.WebhookRepository
+func (mmCreateDeliveries *WebhookRepositoryMock) CreateDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) (err error) {
+	mm_atomic.AddUint64(&mmCreateDeliveries.beforeCreateDeliveriesCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateDeliveries.afterCreateDeliveriesCounter, 1)
+
+	mmCreateDeliveries.t.Helper()
+
+	if mmCreateDeliveries.inspectFuncCreateDeliveries != nil {
+		mmCreateDeliveries.inspectFuncCreateDeliveries(ctx, deliveries)
+	}
+
+	mm_params := WebhookRepositoryMockCreateDeliveriesParams{ctx, deliveries}
+
+	// Record call args
+	mmCreateDeliveries.CreateDeliveriesMock.mutex.Lock()
+	mmCreateDeliveries.CreateDeliveriesMock.callArgs = append(mmCreateDeliveries.CreateDeliveriesMock.callArgs, &mm_params)
+	mmCreateDeliveries.CreateDeliveriesMock.mutex.Unlock()
+
+	for _, e := range mmCreateDeliveries.CreateDeliveriesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateDeliveries.CreateDeliveriesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateDeliveries.CreateDeliveriesMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateDeliveries.CreateDeliveriesMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateDeliveries.CreateDeliveriesMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockCreateDeliveriesParams{ctx, deliveries}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateDeliveries.t.Errorf("WebhookRepositoryMock.CreateDeliveries got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateDeliveries.CreateDeliveriesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.deliveries != nil && !minimock.Equal(*mm_want_ptrs.deliveries, mm_got.deliveries) {
+				mmCreateDeliveries.t.Errorf("WebhookRepositoryMock.CreateDeliveries got unexpected parameter deliveries, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateDeliveries.CreateDeliveriesMock.defaultExpectation.expectationOrigins.originDeliveries, *mm_want_ptrs.deliveries, mm_got.deliveries, minimock.Diff(*mm_want_ptrs.deliveries, mm_got.deliveries))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateDeliveries.t.Errorf("WebhookRepositoryMock.CreateDeliveries got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateDeliveries.CreateDeliveriesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateDeliveries.CreateDeliveriesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateDeliveries.t.Fatal("No results are set for the WebhookRepositoryMock.CreateDeliveries")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateDeliveries.funcCreateDeliveries != nil {
+		return mmCreateDeliveries.funcCreateDeliveries(ctx, deliveries)
+	}
+	mmCreateDeliveries.t.Fatalf("Unexpected call to WebhookRepositoryMock.CreateDeliveries. %v %v", ctx, deliveries)
+	return
+}
+
+// CreateDeliveriesAfterCounter returns a count of finished WebhookRepositoryMock.CreateDeliveries invocations
+func (mmCreateDeliveries *WebhookRepositoryMock) CreateDeliveriesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateDeliveries.afterCreateDeliveriesCounter)
+}
+
+// CreateDeliveriesBeforeCounter returns a count of WebhookRepositoryMock.CreateDeliveries invocations
+func (mmCreateDeliveries *WebhookRepositoryMock) CreateDeliveriesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateDeliveries.beforeCreateDeliveriesCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.CreateDeliveries.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) Calls() []*WebhookRepositoryMockCreateDeliveriesParams {
+	mmCreateDeliveries.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockCreateDeliveriesParams, len(mmCreateDeliveries.callArgs))
+	copy(argCopy, mmCreateDeliveries.callArgs)
+
+	mmCreateDeliveries.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDeliveriesDone returns true if the count of the CreateDeliveries invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockCreateDeliveriesDone() bool {
+	if m.CreateDeliveriesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateDeliveriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateDeliveriesMock.invocationsDone()
+}
+
+// MinimockCreateDeliveriesInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockCreateDeliveriesInspect() {
+	for _, e := range m.CreateDeliveriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.CreateDeliveries at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateDeliveriesCounter := mm_atomic.LoadUint64(&m.afterCreateDeliveriesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateDeliveriesMock.defaultExpectation != nil && afterCreateDeliveriesCounter < 1 {
+		if m.CreateDeliveriesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.CreateDeliveries at\n%s", m.CreateDeliveriesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.CreateDeliveries at\n%s with params: %#v", m.CreateDeliveriesMock.defaultExpectation.expectationOrigins.origin, *m.CreateDeliveriesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateDeliveries != nil && afterCreateDeliveriesCounter < 1 {
+		m.t.Errorf("Expected call to WebhookRepositoryMock.CreateDeliveries at\n%s", m.funcCreateDeliveriesOrigin)
+	}
+
+	if !m.CreateDeliveriesMock.invocationsDone() && afterCreateDeliveriesCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.CreateDeliveries at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateDeliveriesMock.expectedInvocations), m.CreateDeliveriesMock.expectedInvocationsOrigin, afterCreateDeliveriesCounter)
+	}
+}
+
+type mWebhookRepositoryMockDeadLetter struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockDeadLetterExpectation
+	expectations       []*WebhookRepositoryMockDeadLetterExpectation
+
+	callArgs []*WebhookRepositoryMockDeadLetterParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WebhookRepositoryMockDeadLetterExpectation specifies expectation struct of the WebhookRepository.DeadLetter
+type WebhookRepositoryMockDeadLetterExpectation struct {
+	mock               *WebhookRepositoryMock
+	params             *WebhookRepositoryMockDeadLetterParams
+	paramPtrs          *WebhookRepositoryMockDeadLetterParamPtrs
+	expectationOrigins WebhookRepositoryMockDeadLetterExpectationOrigins
+	results            *WebhookRepositoryMockDeadLetterResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WebhookRepositoryMockDeadLetterParams contains parameters of the WebhookRepository.DeadLetter
+type WebhookRepositoryMockDeadLetterParams struct {
+	ctx       context.Context
+	delivery  *model.WebhookDelivery
+	lastError string
+}
+
+// WebhookRepositoryMockDeadLetterParamPtrs contains pointers to parameters of the WebhookRepository.DeadLetter
+type WebhookRepositoryMockDeadLetterParamPtrs struct {
+	ctx       *context.Context
+	delivery  **model.WebhookDelivery
+	lastError *string
+}
+
+// WebhookRepositoryMockDeadLetterResults contains results of the WebhookRepository.DeadLetter
+type WebhookRepositoryMockDeadLetterResults struct {
+	err error
+}
+
+// WebhookRepositoryMockDeadLetterOrigins contains origins of expectations of the WebhookRepository.DeadLetter
+type WebhookRepositoryMockDeadLetterExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originDelivery  string
+	originLastError string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeadLetter *mWebhookRepositoryMockDeadLetter) Optional() *mWebhookRepositoryMockDeadLetter {
+	mmDeadLetter.optional = true
+	return mmDeadLetter
+}
+
+// Expect sets up expected params for WebhookRepository.DeadLetter
+func (mmDeadLetter *mWebhookRepositoryMockDeadLetter) Expect(ctx context.Context, delivery *model.WebhookDelivery, lastError string) *mWebhookRepositoryMockDeadLetter {
+	if mmDeadLetter.mock.funcDeadLetter != nil {
+		mmDeadLetter.mock.t.Fatalf("WebhookRepositoryMock.DeadLetter mock is already set by Set")
+	}
+
+	if mmDeadLetter.defaultExpectation == nil {
+		mmDeadLetter.defaultExpectation = &WebhookRepositoryMockDeadLetterExpectation{}
+	}
+
+	if mmDeadLetter.defaultExpectation.paramPtrs != nil {
+		mmDeadLetter.mock.t.Fatalf("WebhookRepositoryMock.DeadLetter mock is already set by ExpectParams functions")
+	}
+
+	mmDeadLetter.defaultExpectation.params = &WebhookRepositoryMockDeadLetterParams{ctx, delivery, lastError}
+	mmDeadLetter.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeadLetter.expectations {
+		if minimock.Equal(e.params, mmDeadLetter.defaultExpectation.params) {
+			mmDeadLetter.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeadLetter.defaultExpectation.params)
+		}
+	}
+
+	return mmDeadLetter
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.DeadLetter
+func (mmDeadLetter *mWebhookRepositoryMockDeadLetter) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockDeadLetter {
+	if mmDeadLetter.mock.funcDeadLetter != nil {
+		mmDeadLetter.mock.t.Fatalf("WebhookRepositoryMock.DeadLetter mock is already set by Set")
+	}
+
+	if mmDeadLetter.defaultExpectation == nil {
+		mmDeadLetter.defaultExpectation = &WebhookRepositoryMockDeadLetterExpectation{}
+	}
+
+	if mmDeadLetter.defaultExpectation.params != nil {
+		mmDeadLetter.mock.t.Fatalf("WebhookRepositoryMock.DeadLetter mock is already set by Expect")
+	}
+
+	if mmDeadLetter.defaultExpectation.paramPtrs == nil {
+		mmDeadLetter.defaultExpectation.paramPtrs = &WebhookRepositoryMockDeadLetterParamPtrs{}
+	}
+	mmDeadLetter.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeadLetter.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeadLetter
+}
+
+// ExpectDeliveryParam2 sets up expected param delivery for WebhookRepository.DeadLetter
+func (mmDeadLetter *mWebhookRepositoryMockDeadLetter) ExpectDeliveryParam2(delivery *model.WebhookDelivery) *mWebhookRepositoryMockDeadLetter {
+	if mmDeadLetter.mock.funcDeadLetter != nil {
+		mmDeadLetter.mock.t.Fatalf("WebhookRepositoryMock.DeadLetter mock is already set by Set")
+	}
+
+	if mmDeadLetter.defaultExpectation == nil {
+		mmDeadLetter.defaultExpectation = &WebhookRepositoryMockDeadLetterExpectation{}
+	}
+
+	if mmDeadLetter.defaultExpectation.params != nil {
+		mmDeadLetter.mock.t.Fatalf("WebhookRepositoryMock.DeadLetter mock is already set by Expect")
+	}
+
+	if mmDeadLetter.defaultExpectation.paramPtrs == nil {
+		mmDeadLetter.defaultExpectation.paramPtrs = &WebhookRepositoryMockDeadLetterParamPtrs{}
+	}
+	mmDeadLetter.defaultExpectation.paramPtrs.delivery = &delivery
+	mmDeadLetter.defaultExpectation.expectationOrigins.originDelivery = minimock.CallerInfo(1)
+
+	return mmDeadLetter
+}
+
+// ExpectLastErrorParam3 sets up expected param lastError for WebhookRepository.DeadLetter
+func (mmDeadLetter *mWebhookRepositoryMockDeadLetter) ExpectLastErrorParam3(lastError string) *mWebhookRepositoryMockDeadLetter {
+	if mmDeadLetter.mock.funcDeadLetter != nil {
+		mmDeadLetter.mock.t.Fatalf("WebhookRepositoryMock.DeadLetter mock is already set by Set")
+	}
+
+	if mmDeadLetter.defaultExpectation == nil {
+		mmDeadLetter.defaultExpectation = &WebhookRepositoryMockDeadLetterExpectation{}
+	}
+
+	if mmDeadLetter.defaultExpectation.params != nil {
+		mmDeadLetter.mock.t.Fatalf("WebhookRepositoryMock.DeadLetter mock is already set by Expect")
+	}
+
+	if mmDeadLetter.defaultExpectation.paramPtrs == nil {
+		mmDeadLetter.defaultExpectation.paramPtrs = &WebhookRepositoryMockDeadLetterParamPtrs{}
+	}
+	mmDeadLetter.defaultExpectation.paramPtrs.lastError = &lastError
+	mmDeadLetter.defaultExpectation.expectationOrigins.originLastError = minimock.CallerInfo(1)
+
+	return mmDeadLetter
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.DeadLetter
+func (mmDeadLetter *mWebhookRepositoryMockDeadLetter) Inspect(f func(ctx context.Context, delivery *model.WebhookDelivery, lastError string)) *mWebhookRepositoryMockDeadLetter {
+	if mmDeadLetter.mock.inspectFuncDeadLetter != nil {
+		mmDeadLetter.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.DeadLetter")
+	}
+
+	mmDeadLetter.mock.inspectFuncDeadLetter = f
+
+	return mmDeadLetter
+}
+
+// Return sets up results that will be returned by WebhookRepository.DeadLetter
+func (mmDeadLetter *mWebhookRepositoryMockDeadLetter) Return(err error) *WebhookRepositoryMock {
+	if mmDeadLetter.mock.funcDeadLetter != nil {
+		mmDeadLetter.mock.t.Fatalf("WebhookRepositoryMock.DeadLetter mock is already set by Set")
+	}
+
+	if mmDeadLetter.defaultExpectation == nil {
+		mmDeadLetter.defaultExpectation = &WebhookRepositoryMockDeadLetterExpectation{mock: mmDeadLetter.mock}
+	}
+	mmDeadLetter.defaultExpectation.results = &WebhookRepositoryMockDeadLetterResults{err}
+	mmDeadLetter.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeadLetter.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.DeadLetter method
+func (mmDeadLetter *mWebhookRepositoryMockDeadLetter) Set(f func(ctx context.Context, delivery *model.WebhookDelivery, lastError string) (err error)) *WebhookRepositoryMock {
+	if mmDeadLetter.defaultExpectation != nil {
+		mmDeadLetter.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.DeadLetter method")
+	}
+
+	if len(mmDeadLetter.expectations) > 0 {
+		mmDeadLetter.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.DeadLetter method")
+	}
+
+	mmDeadLetter.mock.funcDeadLetter = f
+	mmDeadLetter.mock.funcDeadLetterOrigin = minimock.CallerInfo(1)
+	return mmDeadLetter.mock
+}
+
+// When sets expectation for the WebhookRepository.DeadLetter which will trigger the result defined by the following
+// Then helper
+func (mmDeadLetter *mWebhookRepositoryMockDeadLetter) When(ctx context.Context, delivery *model.WebhookDelivery, lastError string) *WebhookRepositoryMockDeadLetterExpectation {
+	if mmDeadLetter.mock.funcDeadLetter != nil {
+		mmDeadLetter.mock.t.Fatalf("WebhookRepositoryMock.DeadLetter mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockDeadLetterExpectation{
+		mock:               mmDeadLetter.mock,
+		params:             &WebhookRepositoryMockDeadLetterParams{ctx, delivery, lastError},
+		expectationOrigins: WebhookRepositoryMockDeadLetterExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeadLetter.expectations = append(mmDeadLetter.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.DeadLetter return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockDeadLetterExpectation) Then(err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockDeadLetterResults{err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.DeadLetter should be invoked
+func (mmDeadLetter *mWebhookRepositoryMockDeadLetter) Times(n uint64) *mWebhookRepositoryMockDeadLetter {
+	if n == 0 {
+		mmDeadLetter.mock.t.Fatalf("Times of WebhookRepositoryMock.DeadLetter mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeadLetter.expectedInvocations, n)
+	mmDeadLetter.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeadLetter
+}
+
+func (mmDeadLetter *mWebhookRepositoryMockDeadLetter) invocationsDone() bool {
+	if len(mmDeadLetter.expectations) == 0 && mmDeadLetter.defaultExpectation == nil && mmDeadLetter.mock.funcDeadLetter == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeadLetter.mock.afterDeadLetterCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeadLetter.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeadLetter implements mm_repository.WebhookRepository
+func (mmDeadLetter *WebhookRepositoryMock) DeadLetter(ctx context.Context, delivery *model.WebhookDelivery, lastError string) (err error) {
+	mm_atomic.AddUint64(&mmDeadLetter.beforeDeadLetterCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeadLetter.afterDeadLetterCounter, 1)
+
+	mmDeadLetter.t.Helper()
+
+	if mmDeadLetter.inspectFuncDeadLetter != nil {
+		mmDeadLetter.inspectFuncDeadLetter(ctx, delivery, lastError)
+	}
+
+	mm_params := WebhookRepositoryMockDeadLetterParams{ctx, delivery, lastError}
+
+	// Record call args
+	mmDeadLetter.DeadLetterMock.mutex.Lock()
+	mmDeadLetter.DeadLetterMock.callArgs = append(mmDeadLetter.DeadLetterMock.callArgs, &mm_params)
+	mmDeadLetter.DeadLetterMock.mutex.Unlock()
+
+	for _, e := range mmDeadLetter.DeadLetterMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeadLetter.DeadLetterMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeadLetter.DeadLetterMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeadLetter.DeadLetterMock.defaultExpectation.params
+		mm_want_ptrs := mmDeadLetter.DeadLetterMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockDeadLetterParams{ctx, delivery, lastError}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeadLetter.t.Errorf("WebhookRepositoryMock.DeadLetter got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeadLetter.DeadLetterMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.delivery != nil && !minimock.Equal(*mm_want_ptrs.delivery, mm_got.delivery) {
+				mmDeadLetter.t.Errorf("WebhookRepositoryMock.DeadLetter got unexpected parameter delivery, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeadLetter.DeadLetterMock.defaultExpectation.expectationOrigins.originDelivery, *mm_want_ptrs.delivery, mm_got.delivery, minimock.Diff(*mm_want_ptrs.delivery, mm_got.delivery))
+			}
+
+			if mm_want_ptrs.lastError != nil && !minimock.Equal(*mm_want_ptrs.lastError, mm_got.lastError) {
+				mmDeadLetter.t.Errorf("WebhookRepositoryMock.DeadLetter got unexpected parameter lastError, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeadLetter.DeadLetterMock.defaultExpectation.expectationOrigins.originLastError, *mm_want_ptrs.lastError, mm_got.lastError, minimock.Diff(*mm_want_ptrs.lastError, mm_got.lastError))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeadLetter.t.Errorf("WebhookRepositoryMock.DeadLetter got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeadLetter.DeadLetterMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeadLetter.DeadLetterMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeadLetter.t.Fatal("No results are set for the WebhookRepositoryMock.DeadLetter")
+		}
+		return (*mm_results).err
+	}
+	if mmDeadLetter.funcDeadLetter != nil {
+		return mmDeadLetter.funcDeadLetter(ctx, delivery, lastError)
+	}
+	mmDeadLetter.t.Fatalf("Unexpected call to WebhookRepositoryMock.DeadLetter. %v %v %v", ctx, delivery, lastError)
+	return
+}
+
+// DeadLetterAfterCounter returns a count of finished WebhookRepositoryMock.DeadLetter invocations
+func (mmDeadLetter *WebhookRepositoryMock) DeadLetterAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeadLetter.afterDeadLetterCounter)
+}
+
+// DeadLetterBeforeCounter returns a count of WebhookRepositoryMock.DeadLetter invocations
+func (mmDeadLetter *WebhookRepositoryMock) DeadLetterBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeadLetter.beforeDeadLetterCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.DeadLetter.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeadLetter *mWebhookRepositoryMockDeadLetter) Calls() []*WebhookRepositoryMockDeadLetterParams {
+	mmDeadLetter.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockDeadLetterParams, len(mmDeadLetter.callArgs))
+	copy(argCopy, mmDeadLetter.callArgs)
+
+	mmDeadLetter.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeadLetterDone returns true if the count of the DeadLetter invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockDeadLetterDone() bool {
+	if m.DeadLetterMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeadLetterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeadLetterMock.invocationsDone()
+}
+
+// MinimockDeadLetterInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockDeadLetterInspect() {
+	for _, e := range m.DeadLetterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.DeadLetter at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeadLetterCounter := mm_atomic.LoadUint64(&m.afterDeadLetterCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeadLetterMock.defaultExpectation != nil && afterDeadLetterCounter < 1 {
+		if m.DeadLetterMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.DeadLetter at\n%s", m.DeadLetterMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.DeadLetter at\n%s with params: %#v", m.DeadLetterMock.defaultExpectation.expectationOrigins.origin, *m.DeadLetterMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeadLetter != nil && afterDeadLetterCounter < 1 {
+		m.t.Errorf("Expected call to WebhookRepositoryMock.DeadLetter at\n%s", m.funcDeadLetterOrigin)
+	}
+
+	if !m.DeadLetterMock.invocationsDone() && afterDeadLetterCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.DeadLetter at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeadLetterMock.expectedInvocations), m.DeadLetterMock.expectedInvocationsOrigin, afterDeadLetterCounter)
+	}
+}
+
+type mWebhookRepositoryMockDelete struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockDeleteExpectation
+	expectations       []*WebhookRepositoryMockDeleteExpectation
+
+	callArgs []*WebhookRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WebhookRepositoryMockDeleteExpectation specifies expectation struct of the WebhookRepository.Delete
+type WebhookRepositoryMockDeleteExpectation struct {
+	mock               *WebhookRepositoryMock
+	params             *WebhookRepositoryMockDeleteParams
+	paramPtrs          *WebhookRepositoryMockDeleteParamPtrs
+	expectationOrigins WebhookRepositoryMockDeleteExpectationOrigins
+	results            *WebhookRepositoryMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WebhookRepositoryMockDeleteParams contains parameters of the WebhookRepository.Delete
+type WebhookRepositoryMockDeleteParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// WebhookRepositoryMockDeleteParamPtrs contains pointers to parameters of the WebhookRepository.Delete
+type WebhookRepositoryMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// WebhookRepositoryMockDeleteResults contains results of the WebhookRepository.Delete
+type WebhookRepositoryMockDeleteResults struct {
+	err error
+}
+
+// WebhookRepositoryMockDeleteOrigins contains origins of expectations of the WebhookRepository.Delete
+type WebhookRepositoryMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mWebhookRepositoryMockDelete) Optional() *mWebhookRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for WebhookRepository.Delete
+func (mmDelete *mWebhookRepositoryMockDelete) Expect(ctx context.Context, id int64) *mWebhookRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("WebhookRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &WebhookRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("WebhookRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &WebhookRepositoryMockDeleteParams{ctx, id}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.Delete
+func (mmDelete *mWebhookRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("WebhookRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &WebhookRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("WebhookRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &WebhookRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for WebhookRepository.Delete
+func (mmDelete *mWebhookRepositoryMockDelete) ExpectIdParam2(id int64) *mWebhookRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("WebhookRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &WebhookRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("WebhookRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &WebhookRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.Delete
+func (mmDelete *mWebhookRepositoryMockDelete) Inspect(f func(ctx context.Context, id int64)) *mWebhookRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by WebhookRepository.Delete
+func (mmDelete *mWebhookRepositoryMockDelete) Return(err error) *WebhookRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("WebhookRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &WebhookRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &WebhookRepositoryMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.Delete method
+func (mmDelete *mWebhookRepositoryMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *WebhookRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the WebhookRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mWebhookRepositoryMockDelete) When(ctx context.Context, id int64) *WebhookRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("WebhookRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &WebhookRepositoryMockDeleteParams{ctx, id},
+		expectationOrigins: WebhookRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockDeleteExpectation) Then(err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.Delete should be invoked
+func (mmDelete *mWebhookRepositoryMockDelete) Times(n uint64) *mWebhookRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of WebhookRepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mWebhookRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_repository.WebhookRepository
+func (mmDelete *WebhookRepositoryMock) Delete(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := WebhookRepositoryMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("WebhookRepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("WebhookRepositoryMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("WebhookRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the WebhookRepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to WebhookRepositoryMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished WebhookRepositoryMock.Delete invocations
+func (mmDelete *WebhookRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of WebhookRepositoryMock.Delete invocations
+func (mmDelete *WebhookRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mWebhookRepositoryMockDelete) Calls() []*WebhookRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to WebhookRepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mWebhookRepositoryMockList struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockListExpectation
+	expectations       []*WebhookRepositoryMockListExpectation
+
+	callArgs []*WebhookRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WebhookRepositoryMockListExpectation specifies expectation struct of the WebhookRepository.List
+type WebhookRepositoryMockListExpectation struct {
+	mock               *WebhookRepositoryMock
+	params             *WebhookRepositoryMockListParams
+	paramPtrs          *WebhookRepositoryMockListParamPtrs
+	expectationOrigins WebhookRepositoryMockListExpectationOrigins
+	results            *WebhookRepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WebhookRepositoryMockListParams contains parameters of the WebhookRepository.List
+type WebhookRepositoryMockListParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// WebhookRepositoryMockListParamPtrs contains pointers to parameters of the WebhookRepository.List
+type WebhookRepositoryMockListParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// WebhookRepositoryMockListResults contains results of the WebhookRepository.List
+type WebhookRepositoryMockListResults struct {
+	wpa1 []*model.Webhook
+	err  error
+}
+
+// WebhookRepositoryMockListOrigins contains origins of expectations of the WebhookRepository.List
+type WebhookRepositoryMockListExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mWebhookRepositoryMockList) Optional() *mWebhookRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for WebhookRepository.List
+func (mmList *mWebhookRepositoryMockList) Expect(ctx context.Context, chatID int64) *mWebhookRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("WebhookRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &WebhookRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("WebhookRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &WebhookRepositoryMockListParams{ctx, chatID}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.List
+func (mmList *mWebhookRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("WebhookRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &WebhookRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("WebhookRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &WebhookRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for WebhookRepository.List
+func (mmList *mWebhookRepositoryMockList) ExpectChatIDParam2(chatID int64) *mWebhookRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("WebhookRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &WebhookRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("WebhookRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &WebhookRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.chatID = &chatID
+	mmList.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.List
+func (mmList *mWebhookRepositoryMockList) Inspect(f func(ctx context.Context, chatID int64)) *mWebhookRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by WebhookRepository.List
+func (mmList *mWebhookRepositoryMockList) Return(wpa1 []*model.Webhook, err error) *WebhookRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("WebhookRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &WebhookRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &WebhookRepositoryMockListResults{wpa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.List method
+func (mmList *mWebhookRepositoryMockList) Set(f func(ctx context.Context, chatID int64) (wpa1 []*model.Webhook, err error)) *WebhookRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the WebhookRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mWebhookRepositoryMockList) When(ctx context.Context, chatID int64) *WebhookRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("WebhookRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &WebhookRepositoryMockListParams{ctx, chatID},
+		expectationOrigins: WebhookRepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.List return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockListExpectation) Then(wpa1 []*model.Webhook, err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockListResults{wpa1, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.List should be invoked
+func (mmList *mWebhookRepositoryMockList) Times(n uint64) *mWebhookRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of WebhookRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mWebhookRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_repository.WebhookRepository
+func (mmList *WebhookRepositoryMock) List(ctx context.Context, chatID int64) (wpa1 []*model.Webhook, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, chatID)
+	}
+
+	mm_params := WebhookRepositoryMockListParams{ctx, chatID}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.wpa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockListParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("WebhookRepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmList.t.Errorf("WebhookRepositoryMock.List got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("WebhookRepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the WebhookRepositoryMock.List")
+		}
+		return (*mm_results).wpa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, chatID)
+	}
+	mmList.t.Fatalf("Unexpected call to WebhookRepositoryMock.List. %v %v", ctx, chatID)
+	return
+}
+
+// ListAfterCounter returns a count of finished WebhookRepositoryMock.List invocations
+func (mmList *WebhookRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of WebhookRepositoryMock.List invocations
+func (mmList *WebhookRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mWebhookRepositoryMockList) Calls() []*WebhookRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to WebhookRepositoryMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mWebhookRepositoryMockListAttempts struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockListAttemptsExpectation
+	expectations       []*WebhookRepositoryMockListAttemptsExpectation
+
+	callArgs []*WebhookRepositoryMockListAttemptsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WebhookRepositoryMockListAttemptsExpectation specifies expectation struct of the WebhookRepository.ListAttempts
+type WebhookRepositoryMockListAttemptsExpectation struct {
+	mock               *WebhookRepositoryMock
+	params             *WebhookRepositoryMockListAttemptsParams
+	paramPtrs          *WebhookRepositoryMockListAttemptsParamPtrs
+	expectationOrigins WebhookRepositoryMockListAttemptsExpectationOrigins
+	results            *WebhookRepositoryMockListAttemptsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WebhookRepositoryMockListAttemptsParams contains parameters of the WebhookRepository.ListAttempts
+type WebhookRepositoryMockListAttemptsParams struct {
+	ctx    context.Context
+	filter *model.WebhookAttemptFilter
+}
+
+// WebhookRepositoryMockListAttemptsParamPtrs contains pointers to parameters of the WebhookRepository.ListAttempts
+type WebhookRepositoryMockListAttemptsParamPtrs struct {
+	ctx    *context.Context
+	filter **model.WebhookAttemptFilter
+}
+
+// WebhookRepositoryMockListAttemptsResults contains results of the WebhookRepository.ListAttempts
+type WebhookRepositoryMockListAttemptsResults struct {
+	wpa1 []*model.WebhookAttempt
+	err  error
+}
+
+// WebhookRepositoryMockListAttemptsOrigins contains origins of expectations of the WebhookRepository.ListAttempts
+type WebhookRepositoryMockListAttemptsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAttempts *mWebhookRepositoryMockListAttempts) Optional() *mWebhookRepositoryMockListAttempts {
+	mmListAttempts.optional = true
+	return mmListAttempts
+}
+
+// Expect sets up expected params for WebhookRepository.ListAttempts
+func (mmListAttempts *mWebhookRepositoryMockListAttempts) Expect(ctx context.Context, filter *model.WebhookAttemptFilter) *mWebhookRepositoryMockListAttempts {
+	if mmListAttempts.mock.funcListAttempts != nil {
+		mmListAttempts.mock.t.Fatalf("WebhookRepositoryMock.ListAttempts mock is already set by Set")
+	}
+
+	if mmListAttempts.defaultExpectation == nil {
+		mmListAttempts.defaultExpectation = &WebhookRepositoryMockListAttemptsExpectation{}
+	}
+
+	if mmListAttempts.defaultExpectation.paramPtrs != nil {
+		mmListAttempts.mock.t.Fatalf("WebhookRepositoryMock.ListAttempts mock is already set by ExpectParams functions")
+	}
+
+	mmListAttempts.defaultExpectation.params = &WebhookRepositoryMockListAttemptsParams{ctx, filter}
+	mmListAttempts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListAttempts.expectations {
+		if minimock.Equal(e.params, mmListAttempts.defaultExpectation.params) {
+			mmListAttempts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAttempts.defaultExpectation.params)
+		}
+	}
+
+	return mmListAttempts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.ListAttempts
+func (mmListAttempts *mWebhookRepositoryMockListAttempts) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockListAttempts {
+	if mmListAttempts.mock.funcListAttempts != nil {
+		mmListAttempts.mock.t.Fatalf("WebhookRepositoryMock.ListAttempts mock is already set by Set")
+	}
+
+	if mmListAttempts.defaultExpectation == nil {
+		mmListAttempts.defaultExpectation = &WebhookRepositoryMockListAttemptsExpectation{}
+	}
+
+	if mmListAttempts.defaultExpectation.params != nil {
+		mmListAttempts.mock.t.Fatalf("WebhookRepositoryMock.ListAttempts mock is already set by Expect")
+	}
+
+	if mmListAttempts.defaultExpectation.paramPtrs == nil {
+		mmListAttempts.defaultExpectation.paramPtrs = &WebhookRepositoryMockListAttemptsParamPtrs{}
+	}
+	mmListAttempts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListAttempts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListAttempts
+}
+
+// ExpectFilterParam2 sets up expected param filter for WebhookRepository.ListAttempts
+func (mmListAttempts *mWebhookRepositoryMockListAttempts) ExpectFilterParam2(filter *model.WebhookAttemptFilter) *mWebhookRepositoryMockListAttempts {
+	if mmListAttempts.mock.funcListAttempts != nil {
+		mmListAttempts.mock.t.Fatalf("WebhookRepositoryMock.ListAttempts mock is already set by Set")
+	}
+
+	if mmListAttempts.defaultExpectation == nil {
+		mmListAttempts.defaultExpectation = &WebhookRepositoryMockListAttemptsExpectation{}
+	}
+
+	if mmListAttempts.defaultExpectation.params != nil {
+		mmListAttempts.mock.t.Fatalf("WebhookRepositoryMock.ListAttempts mock is already set by Expect")
+	}
+
+	if mmListAttempts.defaultExpectation.paramPtrs == nil {
+		mmListAttempts.defaultExpectation.paramPtrs = &WebhookRepositoryMockListAttemptsParamPtrs{}
+	}
+	mmListAttempts.defaultExpectation.paramPtrs.filter = &filter
+	mmListAttempts.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListAttempts
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.ListAttempts
+func (mmListAttempts *mWebhookRepositoryMockListAttempts) Inspect(f func(ctx context.Context, filter *model.WebhookAttemptFilter)) *mWebhookRepositoryMockListAttempts {
+	if mmListAttempts.mock.inspectFuncListAttempts != nil {
+		mmListAttempts.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.ListAttempts")
+	}
+
+	mmListAttempts.mock.inspectFuncListAttempts = f
+
+	return mmListAttempts
+}
+
+// Return sets up results that will be returned by WebhookRepository.ListAttempts
+func (mmListAttempts *mWebhookRepositoryMockListAttempts) Return(wpa1 []*model.WebhookAttempt, err error) *WebhookRepositoryMock {
+	if mmListAttempts.mock.funcListAttempts != nil {
+		mmListAttempts.mock.t.Fatalf("WebhookRepositoryMock.ListAttempts mock is already set by Set")
+	}
+
+	if mmListAttempts.defaultExpectation == nil {
+		mmListAttempts.defaultExpectation = &WebhookRepositoryMockListAttemptsExpectation{mock: mmListAttempts.mock}
+	}
+	mmListAttempts.defaultExpectation.results = &WebhookRepositoryMockListAttemptsResults{wpa1, err}
+	mmListAttempts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListAttempts.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.ListAttempts method
+func (mmListAttempts *mWebhookRepositoryMockListAttempts) Set(f func(ctx context.Context, filter *model.WebhookAttemptFilter) (wpa1 []*model.WebhookAttempt, err error)) *WebhookRepositoryMock {
+	if mmListAttempts.defaultExpectation != nil {
+		mmListAttempts.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.ListAttempts method")
+	}
+
+	if len(mmListAttempts.expectations) > 0 {
+		mmListAttempts.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.ListAttempts method")
+	}
+
+	mmListAttempts.mock.funcListAttempts = f
+	mmListAttempts.mock.funcListAttemptsOrigin = minimock.CallerInfo(1)
+	return mmListAttempts.mock
+}
+
+// When sets expectation for the WebhookRepository.ListAttempts which will trigger the result defined by the following
+// Then helper
+func (mmListAttempts *mWebhookRepositoryMockListAttempts) When(ctx context.Context, filter *model.WebhookAttemptFilter) *WebhookRepositoryMockListAttemptsExpectation {
+	if mmListAttempts.mock.funcListAttempts != nil {
+		mmListAttempts.mock.t.Fatalf("WebhookRepositoryMock.ListAttempts mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockListAttemptsExpectation{
+		mock:               mmListAttempts.mock,
+		params:             &WebhookRepositoryMockListAttemptsParams{ctx, filter},
+		expectationOrigins: WebhookRepositoryMockListAttemptsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListAttempts.expectations = append(mmListAttempts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.ListAttempts return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockListAttemptsExpectation) Then(wpa1 []*model.WebhookAttempt, err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockListAttemptsResults{wpa1, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.ListAttempts should be invoked
+func (mmListAttempts *mWebhookRepositoryMockListAttempts) Times(n uint64) *mWebhookRepositoryMockListAttempts {
+	if n == 0 {
+		mmListAttempts.mock.t.Fatalf("Times of WebhookRepositoryMock.ListAttempts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAttempts.expectedInvocations, n)
+	mmListAttempts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListAttempts
+}
+
+func (mmListAttempts *mWebhookRepositoryMockListAttempts) invocationsDone() bool {
+	if len(mmListAttempts.expectations) == 0 && mmListAttempts.defaultExpectation == nil && mmListAttempts.mock.funcListAttempts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAttempts.mock.afterListAttemptsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAttempts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAttempts implements mm_repository.WebhookRepository
+func (mmListAttempts *WebhookRepositoryMock) ListAttempts(ctx context.Context, filter *model.WebhookAttemptFilter) (wpa1 []*model.WebhookAttempt, err error) {
+	mm_atomic.AddUint64(&mmListAttempts.beforeListAttemptsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAttempts.afterListAttemptsCounter, 1)
+
+	mmListAttempts.t.Helper()
+
+	if mmListAttempts.inspectFuncListAttempts != nil {
+		mmListAttempts.inspectFuncListAttempts(ctx, filter)
+	}
+
+	mm_params := WebhookRepositoryMockListAttemptsParams{ctx, filter}
+
+	// Record call args
+	mmListAttempts.ListAttemptsMock.mutex.Lock()
+	mmListAttempts.ListAttemptsMock.callArgs = append(mmListAttempts.ListAttemptsMock.callArgs, &mm_params)
+	mmListAttempts.ListAttemptsMock.mutex.Unlock()
+
+	for _, e := range mmListAttempts.ListAttemptsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.wpa1, e.results.err
+		}
+	}
+
+	if mmListAttempts.ListAttemptsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAttempts.ListAttemptsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAttempts.ListAttemptsMock.defaultExpectation.params
+		mm_want_ptrs := mmListAttempts.ListAttemptsMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockListAttemptsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAttempts.t.Errorf("WebhookRepositoryMock.ListAttempts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAttempts.ListAttemptsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListAttempts.t.Errorf("WebhookRepositoryMock.ListAttempts got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAttempts.ListAttemptsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAttempts.t.Errorf("WebhookRepositoryMock.ListAttempts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListAttempts.ListAttemptsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAttempts.ListAttemptsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAttempts.t.Fatal("No results are set for the WebhookRepositoryMock.ListAttempts")
+		}
+		return (*mm_results).wpa1, (*mm_results).err
+	}
+	if mmListAttempts.funcListAttempts != nil {
+		return mmListAttempts.funcListAttempts(ctx, filter)
+	}
+	mmListAttempts.t.Fatalf("Unexpected call to WebhookRepositoryMock.ListAttempts. %v %v", ctx, filter)
+	return
+}
+
+// ListAttemptsAfterCounter returns a count of finished WebhookRepositoryMock.ListAttempts invocations
+func (mmListAttempts *WebhookRepositoryMock) ListAttemptsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAttempts.afterListAttemptsCounter)
+}
+
+// ListAttemptsBeforeCounter returns a count of WebhookRepositoryMock.ListAttempts invocations
+func (mmListAttempts *WebhookRepositoryMock) ListAttemptsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAttempts.beforeListAttemptsCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.ListAttempts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAttempts *mWebhookRepositoryMockListAttempts) Calls() []*WebhookRepositoryMockListAttemptsParams {
+	mmListAttempts.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockListAttemptsParams, len(mmListAttempts.callArgs))
+	copy(argCopy, mmListAttempts.callArgs)
+
+	mmListAttempts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAttemptsDone returns true if the count of the ListAttempts invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockListAttemptsDone() bool {
+	if m.ListAttemptsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAttemptsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAttemptsMock.invocationsDone()
+}
+
+// MinimockListAttemptsInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockListAttemptsInspect() {
+	for _, e := range m.ListAttemptsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.ListAttempts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListAttemptsCounter := mm_atomic.LoadUint64(&m.afterListAttemptsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAttemptsMock.defaultExpectation != nil && afterListAttemptsCounter < 1 {
+		if m.ListAttemptsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.ListAttempts at\n%s", m.ListAttemptsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.ListAttempts at\n%s with params: %#v", m.ListAttemptsMock.defaultExpectation.expectationOrigins.origin, *m.ListAttemptsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAttempts != nil && afterListAttemptsCounter < 1 {
+		m.t.Errorf("Expected call to WebhookRepositoryMock.ListAttempts at\n%s", m.funcListAttemptsOrigin)
+	}
+
+	if !m.ListAttemptsMock.invocationsDone() && afterListAttemptsCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.ListAttempts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAttemptsMock.expectedInvocations), m.ListAttemptsMock.expectedInvocationsOrigin, afterListAttemptsCounter)
+	}
+}
+
+type mWebhookRepositoryMockListDue struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockListDueExpectation
+	expectations       []*WebhookRepositoryMockListDueExpectation
+
+	callArgs []*WebhookRepositoryMockListDueParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WebhookRepositoryMockListDueExpectation specifies expectation struct of the WebhookRepository.ListDue
+type WebhookRepositoryMockListDueExpectation struct {
+	mock               *WebhookRepositoryMock
+	params             *WebhookRepositoryMockListDueParams
+	paramPtrs          *WebhookRepositoryMockListDueParamPtrs
+	expectationOrigins WebhookRepositoryMockListDueExpectationOrigins
+	results            *WebhookRepositoryMockListDueResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WebhookRepositoryMockListDueParams contains parameters of the WebhookRepository.ListDue
+type WebhookRepositoryMockListDueParams struct {
+	ctx   context.Context
+	limit uint64
+}
+
+// WebhookRepositoryMockListDueParamPtrs contains pointers to parameters of the WebhookRepository.ListDue
+type WebhookRepositoryMockListDueParamPtrs struct {
+	ctx   *context.Context
+	limit *uint64
+}
+
+// WebhookRepositoryMockListDueResults contains results of the WebhookRepository.ListDue
+type WebhookRepositoryMockListDueResults struct {
+	wpa1 []*model.WebhookDelivery
+	err  error
+}
+
+// WebhookRepositoryMockListDueOrigins contains origins of expectations of the WebhookRepository.ListDue
+type WebhookRepositoryMockListDueExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListDue *mWebhookRepositoryMockListDue) Optional() *mWebhookRepositoryMockListDue {
+	mmListDue.optional = true
+	return mmListDue
+}
+
+// Expect sets up expected params for WebhookRepository.ListDue
+func (mmListDue *mWebhookRepositoryMockListDue) Expect(ctx context.Context, limit uint64) *mWebhookRepositoryMockListDue {
+	if mmListDue.mock.funcListDue != nil {
+		mmListDue.mock.t.Fatalf("WebhookRepositoryMock.ListDue mock is already set by Set")
+	}
+
+	if mmListDue.defaultExpectation == nil {
+		mmListDue.defaultExpectation = &WebhookRepositoryMockListDueExpectation{}
+	}
+
+	if mmListDue.defaultExpectation.paramPtrs != nil {
+		mmListDue.mock.t.Fatalf("WebhookRepositoryMock.ListDue mock is already set by ExpectParams functions")
+	}
+
+	mmListDue.defaultExpectation.params = &WebhookRepositoryMockListDueParams{ctx, limit}
+	mmListDue.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListDue.expectations {
+		if minimock.Equal(e.params, mmListDue.defaultExpectation.params) {
+			mmListDue.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListDue.defaultExpectation.params)
+		}
+	}
+
+	return mmListDue
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.ListDue
+func (mmListDue *mWebhookRepositoryMockListDue) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockListDue {
+	if mmListDue.mock.funcListDue != nil {
+		mmListDue.mock.t.Fatalf("WebhookRepositoryMock.ListDue mock is already set by Set")
+	}
+
+	if mmListDue.defaultExpectation == nil {
+		mmListDue.defaultExpectation = &WebhookRepositoryMockListDueExpectation{}
+	}
+
+	if mmListDue.defaultExpectation.params != nil {
+		mmListDue.mock.t.Fatalf("WebhookRepositoryMock.ListDue mock is already set by Expect")
+	}
+
+	if mmListDue.defaultExpectation.paramPtrs == nil {
+		mmListDue.defaultExpectation.paramPtrs = &WebhookRepositoryMockListDueParamPtrs{}
+	}
+	mmListDue.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListDue.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListDue
+}
+
+// ExpectLimitParam2 sets up expected param limit for WebhookRepository.ListDue
+func (mmListDue *mWebhookRepositoryMockListDue) ExpectLimitParam2(limit uint64) *mWebhookRepositoryMockListDue {
+	if mmListDue.mock.funcListDue != nil {
+		mmListDue.mock.t.Fatalf("WebhookRepositoryMock.ListDue mock is already set by Set")
+	}
+
+	if mmListDue.defaultExpectation == nil {
+		mmListDue.defaultExpectation = &WebhookRepositoryMockListDueExpectation{}
+	}
+
+	if mmListDue.defaultExpectation.params != nil {
+		mmListDue.mock.t.Fatalf("WebhookRepositoryMock.ListDue mock is already set by Expect")
+	}
+
+	if mmListDue.defaultExpectation.paramPtrs == nil {
+		mmListDue.defaultExpectation.paramPtrs = &WebhookRepositoryMockListDueParamPtrs{}
+	}
+	mmListDue.defaultExpectation.paramPtrs.limit = &limit
+	mmListDue.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListDue
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.ListDue
+func (mmListDue *mWebhookRepositoryMockListDue) Inspect(f func(ctx context.Context, limit uint64)) *mWebhookRepositoryMockListDue {
+	if mmListDue.mock.inspectFuncListDue != nil {
+		mmListDue.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.ListDue")
+	}
+
+	mmListDue.mock.inspectFuncListDue = f
+
+	return mmListDue
+}
+
+// Return sets up results that will be returned by WebhookRepository.ListDue
+func (mmListDue *mWebhookRepositoryMockListDue) Return(wpa1 []*model.WebhookDelivery, err error) *WebhookRepositoryMock {
+	if mmListDue.mock.funcListDue != nil {
+		mmListDue.mock.t.Fatalf("WebhookRepositoryMock.ListDue mock is already set by Set")
+	}
+
+	if mmListDue.defaultExpectation == nil {
+		mmListDue.defaultExpectation = &WebhookRepositoryMockListDueExpectation{mock: mmListDue.mock}
+	}
+	mmListDue.defaultExpectation.results = &WebhookRepositoryMockListDueResults{wpa1, err}
+	mmListDue.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListDue.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.ListDue method
+func (mmListDue *mWebhookRepositoryMockListDue) Set(f func(ctx context.Context, limit uint64) (wpa1 []*model.WebhookDelivery, err error)) *WebhookRepositoryMock {
+	if mmListDue.defaultExpectation != nil {
+		mmListDue.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.ListDue method")
+	}
+
+	if len(mmListDue.expectations) > 0 {
+		mmListDue.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.ListDue method")
+	}
+
+	mmListDue.mock.funcListDue = f
+	mmListDue.mock.funcListDueOrigin = minimock.CallerInfo(1)
+	return mmListDue.mock
+}
+
+// When sets expectation for the WebhookRepository.ListDue which will trigger the result defined by the following
+// Then helper
+func (mmListDue *mWebhookRepositoryMockListDue) When(ctx context.Context, limit uint64) *WebhookRepositoryMockListDueExpectation {
+	if mmListDue.mock.funcListDue != nil {
+		mmListDue.mock.t.Fatalf("WebhookRepositoryMock.ListDue mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockListDueExpectation{
+		mock:               mmListDue.mock,
+		params:             &WebhookRepositoryMockListDueParams{ctx, limit},
+		expectationOrigins: WebhookRepositoryMockListDueExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListDue.expectations = append(mmListDue.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.ListDue return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockListDueExpectation) Then(wpa1 []*model.WebhookDelivery, err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockListDueResults{wpa1, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.ListDue should be invoked
+func (mmListDue *mWebhookRepositoryMockListDue) Times(n uint64) *mWebhookRepositoryMockListDue {
+	if n == 0 {
+		mmListDue.mock.t.Fatalf("Times of WebhookRepositoryMock.ListDue mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListDue.expectedInvocations, n)
+	mmListDue.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListDue
+}
+
+func (mmListDue *mWebhookRepositoryMockListDue) invocationsDone() bool {
+	if len(mmListDue.expectations) == 0 && mmListDue.defaultExpectation == nil && mmListDue.mock.funcListDue == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListDue.mock.afterListDueCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListDue.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListDue implements mm_repository.WebhookRepository
+func (mmListDue *WebhookRepositoryMock) ListDue(ctx context.Context, limit uint64) (wpa1 []*model.WebhookDelivery, err error) {
+	mm_atomic.AddUint64(&mmListDue.beforeListDueCounter, 1)
+	defer mm_atomic.AddUint64(&mmListDue.afterListDueCounter, 1)
+
+	mmListDue.t.Helper()
+
+	if mmListDue.inspectFuncListDue != nil {
+		mmListDue.inspectFuncListDue(ctx, limit)
+	}
+
+	mm_params := WebhookRepositoryMockListDueParams{ctx, limit}
+
+	// Record call args
+	mmListDue.ListDueMock.mutex.Lock()
+	mmListDue.ListDueMock.callArgs = append(mmListDue.ListDueMock.callArgs, &mm_params)
+	mmListDue.ListDueMock.mutex.Unlock()
+
+	for _, e := range mmListDue.ListDueMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.wpa1, e.results.err
+		}
+	}
+
+	if mmListDue.ListDueMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListDue.ListDueMock.defaultExpectation.Counter, 1)
+		mm_want := mmListDue.ListDueMock.defaultExpectation.params
+		mm_want_ptrs := mmListDue.ListDueMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockListDueParams{ctx, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListDue.t.Errorf("WebhookRepositoryMock.ListDue got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDue.ListDueMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListDue.t.Errorf("WebhookRepositoryMock.ListDue got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDue.ListDueMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListDue.t.Errorf("WebhookRepositoryMock.ListDue got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListDue.ListDueMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListDue.ListDueMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListDue.t.Fatal("No results are set for the WebhookRepositoryMock.ListDue")
+		}
+		return (*mm_results).wpa1, (*mm_results).err
+	}
+	if mmListDue.funcListDue != nil {
+		return mmListDue.funcListDue(ctx, limit)
+	}
+	mmListDue.t.Fatalf("Unexpected call to WebhookRepositoryMock.ListDue. %v %v", ctx, limit)
+	return
+}
+
+// ListDueAfterCounter returns a count of finished WebhookRepositoryMock.ListDue invocations
+func (mmListDue *WebhookRepositoryMock) ListDueAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDue.afterListDueCounter)
+}
+
+// ListDueBeforeCounter returns a count of WebhookRepositoryMock.ListDue invocations
+func (mmListDue *WebhookRepositoryMock) ListDueBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDue.beforeListDueCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.ListDue.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListDue *mWebhookRepositoryMockListDue) Calls() []*WebhookRepositoryMockListDueParams {
+	mmListDue.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockListDueParams, len(mmListDue.callArgs))
+	copy(argCopy, mmListDue.callArgs)
+
+	mmListDue.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDueDone returns true if the count of the ListDue invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockListDueDone() bool {
+	if m.ListDueMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListDueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListDueMock.invocationsDone()
+}
+
+// MinimockListDueInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockListDueInspect() {
+	for _, e := range m.ListDueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.ListDue at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListDueCounter := mm_atomic.LoadUint64(&m.afterListDueCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListDueMock.defaultExpectation != nil && afterListDueCounter < 1 {
+		if m.ListDueMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.ListDue at\n%s", m.ListDueMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.ListDue at\n%s with params: %#v", m.ListDueMock.defaultExpectation.expectationOrigins.origin, *m.ListDueMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListDue != nil && afterListDueCounter < 1 {
+		m.t.Errorf("Expected call to WebhookRepositoryMock.ListDue at\n%s", m.funcListDueOrigin)
+	}
+
+	if !m.ListDueMock.invocationsDone() && afterListDueCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.ListDue at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListDueMock.expectedInvocations), m.ListDueMock.expectedInvocationsOrigin, afterListDueCounter)
+	}
+}
+
+type mWebhookRepositoryMockListSubscribed struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockListSubscribedExpectation
+	expectations       []*WebhookRepositoryMockListSubscribedExpectation
+
+	callArgs []*WebhookRepositoryMockListSubscribedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WebhookRepositoryMockListSubscribedExpectation specifies expectation struct of the WebhookRepository.ListSubscribed
+type WebhookRepositoryMockListSubscribedExpectation struct {
+	mock               *WebhookRepositoryMock
+	params             *WebhookRepositoryMockListSubscribedParams
+	paramPtrs          *WebhookRepositoryMockListSubscribedParamPtrs
+	expectationOrigins WebhookRepositoryMockListSubscribedExpectationOrigins
+	results            *WebhookRepositoryMockListSubscribedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WebhookRepositoryMockListSubscribedParams contains parameters of the WebhookRepository.ListSubscribed
+type WebhookRepositoryMockListSubscribedParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// WebhookRepositoryMockListSubscribedParamPtrs contains pointers to parameters of the WebhookRepository.ListSubscribed
+type WebhookRepositoryMockListSubscribedParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// WebhookRepositoryMockListSubscribedResults contains results of the WebhookRepository.ListSubscribed
+type WebhookRepositoryMockListSubscribedResults struct {
+	wpa1 []*model.Webhook
+	err  error
+}
+
+// WebhookRepositoryMockListSubscribedOrigins contains origins of expectations of the WebhookRepository.ListSubscribed
+type WebhookRepositoryMockListSubscribedExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListSubscribed *mWebhookRepositoryMockListSubscribed) Optional() *mWebhookRepositoryMockListSubscribed {
+	mmListSubscribed.optional = true
+	return mmListSubscribed
+}
+
+// Expect sets up expected params for WebhookRepository.ListSubscribed
+func (mmListSubscribed *mWebhookRepositoryMockListSubscribed) Expect(ctx context.Context, chatID int64) *mWebhookRepositoryMockListSubscribed {
+	if mmListSubscribed.mock.funcListSubscribed != nil {
+		mmListSubscribed.mock.t.Fatalf("WebhookRepositoryMock.ListSubscribed mock is already set by Set")
+	}
+
+	if mmListSubscribed.defaultExpectation == nil {
+		mmListSubscribed.defaultExpectation = &WebhookRepositoryMockListSubscribedExpectation{}
+	}
+
+	if mmListSubscribed.defaultExpectation.paramPtrs != nil {
+		mmListSubscribed.mock.t.Fatalf("WebhookRepositoryMock.ListSubscribed mock is already set by ExpectParams functions")
+	}
+
+	mmListSubscribed.defaultExpectation.params = &WebhookRepositoryMockListSubscribedParams{ctx, chatID}
+	mmListSubscribed.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListSubscribed.expectations {
+		if minimock.Equal(e.params, mmListSubscribed.defaultExpectation.params) {
+			mmListSubscribed.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListSubscribed.defaultExpectation.params)
+		}
+	}
+
+	return mmListSubscribed
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.ListSubscribed
+func (mmListSubscribed *mWebhookRepositoryMockListSubscribed) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockListSubscribed {
+	if mmListSubscribed.mock.funcListSubscribed != nil {
+		mmListSubscribed.mock.t.Fatalf("WebhookRepositoryMock.ListSubscribed mock is already set by Set")
+	}
+
+	if mmListSubscribed.defaultExpectation == nil {
+		mmListSubscribed.defaultExpectation = &WebhookRepositoryMockListSubscribedExpectation{}
+	}
+
+	if mmListSubscribed.defaultExpectation.params != nil {
+		mmListSubscribed.mock.t.Fatalf("WebhookRepositoryMock.ListSubscribed mock is already set by Expect")
+	}
+
+	if mmListSubscribed.defaultExpectation.paramPtrs == nil {
+		mmListSubscribed.defaultExpectation.paramPtrs = &WebhookRepositoryMockListSubscribedParamPtrs{}
+	}
+	mmListSubscribed.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListSubscribed.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListSubscribed
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for WebhookRepository.ListSubscribed
+func (mmListSubscribed *mWebhookRepositoryMockListSubscribed) ExpectChatIDParam2(chatID int64) *mWebhookRepositoryMockListSubscribed {
+	if mmListSubscribed.mock.funcListSubscribed != nil {
+		mmListSubscribed.mock.t.Fatalf("WebhookRepositoryMock.ListSubscribed mock is already set by Set")
+	}
+
+	if mmListSubscribed.defaultExpectation == nil {
+		mmListSubscribed.defaultExpectation = &WebhookRepositoryMockListSubscribedExpectation{}
+	}
+
+	if mmListSubscribed.defaultExpectation.params != nil {
+		mmListSubscribed.mock.t.Fatalf("WebhookRepositoryMock.ListSubscribed mock is already set by Expect")
+	}
+
+	if mmListSubscribed.defaultExpectation.paramPtrs == nil {
+		mmListSubscribed.defaultExpectation.paramPtrs = &WebhookRepositoryMockListSubscribedParamPtrs{}
+	}
+	mmListSubscribed.defaultExpectation.paramPtrs.chatID = &chatID
+	mmListSubscribed.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmListSubscribed
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.ListSubscribed
+func (mmListSubscribed *mWebhookRepositoryMockListSubscribed) Inspect(f func(ctx context.Context, chatID int64)) *mWebhookRepositoryMockListSubscribed {
+	if mmListSubscribed.mock.inspectFuncListSubscribed != nil {
+		mmListSubscribed.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.ListSubscribed")
+	}
+
+	mmListSubscribed.mock.inspectFuncListSubscribed = f
+
+	return mmListSubscribed
+}
+
+// Return sets up results that will be returned by WebhookRepository.ListSubscribed
+func (mmListSubscribed *mWebhookRepositoryMockListSubscribed) Return(wpa1 []*model.Webhook, err error) *WebhookRepositoryMock {
+	if mmListSubscribed.mock.funcListSubscribed != nil {
+		mmListSubscribed.mock.t.Fatalf("WebhookRepositoryMock.ListSubscribed mock is already set by Set")
+	}
+
+	if mmListSubscribed.defaultExpectation == nil {
+		mmListSubscribed.defaultExpectation = &WebhookRepositoryMockListSubscribedExpectation{mock: mmListSubscribed.mock}
+	}
+	mmListSubscribed.defaultExpectation.results = &WebhookRepositoryMockListSubscribedResults{wpa1, err}
+	mmListSubscribed.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListSubscribed.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.ListSubscribed method
+func (mmListSubscribed *mWebhookRepositoryMockListSubscribed) Set(f func(ctx context.Context, chatID int64) (wpa1 []*model.Webhook, err error)) *WebhookRepositoryMock {
+	if mmListSubscribed.defaultExpectation != nil {
+		mmListSubscribed.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.ListSubscribed method")
+	}
+
+	if len(mmListSubscribed.expectations) > 0 {
+		mmListSubscribed.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.ListSubscribed method")
+	}
+
+	mmListSubscribed.mock.funcListSubscribed = f
+	mmListSubscribed.mock.funcListSubscribedOrigin = minimock.CallerInfo(1)
+	return mmListSubscribed.mock
+}
+
+// When sets expectation for the WebhookRepository.ListSubscribed which will trigger the result defined by the following
+// Then helper
+func (mmListSubscribed *mWebhookRepositoryMockListSubscribed) When(ctx context.Context, chatID int64) *WebhookRepositoryMockListSubscribedExpectation {
+	if mmListSubscribed.mock.funcListSubscribed != nil {
+		mmListSubscribed.mock.t.Fatalf("WebhookRepositoryMock.ListSubscribed mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockListSubscribedExpectation{
+		mock:               mmListSubscribed.mock,
+		params:             &WebhookRepositoryMockListSubscribedParams{ctx, chatID},
+		expectationOrigins: WebhookRepositoryMockListSubscribedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListSubscribed.expectations = append(mmListSubscribed.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.ListSubscribed return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockListSubscribedExpectation) Then(wpa1 []*model.Webhook, err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockListSubscribedResults{wpa1, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.ListSubscribed should be invoked
+func (mmListSubscribed *mWebhookRepositoryMockListSubscribed) Times(n uint64) *mWebhookRepositoryMockListSubscribed {
+	if n == 0 {
+		mmListSubscribed.mock.t.Fatalf("Times of WebhookRepositoryMock.ListSubscribed mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListSubscribed.expectedInvocations, n)
+	mmListSubscribed.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListSubscribed
+}
+
+func (mmListSubscribed *mWebhookRepositoryMockListSubscribed) invocationsDone() bool {
+	if len(mmListSubscribed.expectations) == 0 && mmListSubscribed.defaultExpectation == nil && mmListSubscribed.mock.funcListSubscribed == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListSubscribed.mock.afterListSubscribedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListSubscribed.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListSubscribed implements mm_repository.WebhookRepository
+func (mmListSubscribed *WebhookRepositoryMock) ListSubscribed(ctx context.Context, chatID int64) (wpa1 []*model.Webhook, err error) {
+	mm_atomic.AddUint64(&mmListSubscribed.beforeListSubscribedCounter, 1)
+	defer mm_atomic.AddUint64(&mmListSubscribed.afterListSubscribedCounter, 1)
+
+	mmListSubscribed.t.Helper()
+
+	if mmListSubscribed.inspectFuncListSubscribed != nil {
+		mmListSubscribed.inspectFuncListSubscribed(ctx, chatID)
+	}
+
+	mm_params := WebhookRepositoryMockListSubscribedParams{ctx, chatID}
+
+	// Record call args
+	mmListSubscribed.ListSubscribedMock.mutex.Lock()
+	mmListSubscribed.ListSubscribedMock.callArgs = append(mmListSubscribed.ListSubscribedMock.callArgs, &mm_params)
+	mmListSubscribed.ListSubscribedMock.mutex.Unlock()
+
+	for _, e := range mmListSubscribed.ListSubscribedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.wpa1, e.results.err
+		}
+	}
+
+	if mmListSubscribed.ListSubscribedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListSubscribed.ListSubscribedMock.defaultExpectation.Counter, 1)
+		mm_want := mmListSubscribed.ListSubscribedMock.defaultExpectation.params
+		mm_want_ptrs := mmListSubscribed.ListSubscribedMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockListSubscribedParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListSubscribed.t.Errorf("WebhookRepositoryMock.ListSubscribed got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSubscribed.ListSubscribedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmListSubscribed.t.Errorf("WebhookRepositoryMock.ListSubscribed got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSubscribed.ListSubscribedMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListSubscribed.t.Errorf("WebhookRepositoryMock.ListSubscribed got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListSubscribed.ListSubscribedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListSubscribed.ListSubscribedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListSubscribed.t.Fatal("No results are set for the WebhookRepositoryMock.ListSubscribed")
+		}
+		return (*mm_results).wpa1, (*mm_results).err
+	}
+	if mmListSubscribed.funcListSubscribed != nil {
+		return mmListSubscribed.funcListSubscribed(ctx, chatID)
+	}
+	mmListSubscribed.t.Fatalf("Unexpected call to WebhookRepositoryMock.ListSubscribed. %v %v", ctx, chatID)
+	return
+}
+
+// ListSubscribedAfterCounter returns a count of finished WebhookRepositoryMock.ListSubscribed invocations
+func (mmListSubscribed *WebhookRepositoryMock) ListSubscribedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSubscribed.afterListSubscribedCounter)
+}
+
+// ListSubscribedBeforeCounter returns a count of WebhookRepositoryMock.ListSubscribed invocations
+func (mmListSubscribed *WebhookRepositoryMock) ListSubscribedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSubscribed.beforeListSubscribedCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.ListSubscribed.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListSubscribed *mWebhookRepositoryMockListSubscribed) Calls() []*WebhookRepositoryMockListSubscribedParams {
+	mmListSubscribed.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockListSubscribedParams, len(mmListSubscribed.callArgs))
+	copy(argCopy, mmListSubscribed.callArgs)
+
+	mmListSubscribed.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListSubscribedDone returns true if the count of the ListSubscribed invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockListSubscribedDone() bool {
+	if m.ListSubscribedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListSubscribedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListSubscribedMock.invocationsDone()
+}
+
+// MinimockListSubscribedInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockListSubscribedInspect() {
+	for _, e := range m.ListSubscribedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.ListSubscribed at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListSubscribedCounter := mm_atomic.LoadUint64(&m.afterListSubscribedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSubscribedMock.defaultExpectation != nil && afterListSubscribedCounter < 1 {
+		if m.ListSubscribedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.ListSubscribed at\n%s", m.ListSubscribedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.ListSubscribed at\n%s with params: %#v", m.ListSubscribedMock.defaultExpectation.expectationOrigins.origin, *m.ListSubscribedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSubscribed != nil && afterListSubscribedCounter < 1 {
+		m.t.Errorf("Expected call to WebhookRepositoryMock.ListSubscribed at\n%s", m.funcListSubscribedOrigin)
+	}
+
+	if !m.ListSubscribedMock.invocationsDone() && afterListSubscribedCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.ListSubscribed at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListSubscribedMock.expectedInvocations), m.ListSubscribedMock.expectedInvocationsOrigin, afterListSubscribedCounter)
+	}
+}
+
+type mWebhookRepositoryMockLogAttempt struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockLogAttemptExpectation
+	expectations       []*WebhookRepositoryMockLogAttemptExpectation
+
+	callArgs []*WebhookRepositoryMockLogAttemptParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WebhookRepositoryMockLogAttemptExpectation specifies expectation struct of the WebhookRepository.LogAttempt
+type WebhookRepositoryMockLogAttemptExpectation struct {
+	mock               *WebhookRepositoryMock
+	params             *WebhookRepositoryMockLogAttemptParams
+	paramPtrs          *WebhookRepositoryMockLogAttemptParamPtrs
+	expectationOrigins WebhookRepositoryMockLogAttemptExpectationOrigins
+	results            *WebhookRepositoryMockLogAttemptResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WebhookRepositoryMockLogAttemptParams contains parameters of the WebhookRepository.LogAttempt
+type WebhookRepositoryMockLogAttemptParams struct {
+	ctx     context.Context
+	attempt *model.WebhookAttempt
+}
+
+// WebhookRepositoryMockLogAttemptParamPtrs contains pointers to parameters of the WebhookRepository.LogAttempt
+type WebhookRepositoryMockLogAttemptParamPtrs struct {
+	ctx     *context.Context
+	attempt **model.WebhookAttempt
+}
+
+// WebhookRepositoryMockLogAttemptResults contains results of the WebhookRepository.LogAttempt
+type WebhookRepositoryMockLogAttemptResults struct {
+	err error
+}
+
+// WebhookRepositoryMockLogAttemptOrigins contains origins of expectations of the WebhookRepository.LogAttempt
+type WebhookRepositoryMockLogAttemptExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originAttempt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogAttempt *mWebhookRepositoryMockLogAttempt) Optional() *mWebhookRepositoryMockLogAttempt {
+	mmLogAttempt.optional = true
+	return mmLogAttempt
+}
+
+// Expect sets up expected params for WebhookRepository.LogAttempt
+func (mmLogAttempt *mWebhookRepositoryMockLogAttempt) Expect(ctx context.Context, attempt *model.WebhookAttempt) *mWebhookRepositoryMockLogAttempt {
+	if mmLogAttempt.mock.funcLogAttempt != nil {
+		mmLogAttempt.mock.t.Fatalf("WebhookRepositoryMock.LogAttempt mock is already set by Set")
+	}
+
+	if mmLogAttempt.defaultExpectation == nil {
+		mmLogAttempt.defaultExpectation = &WebhookRepositoryMockLogAttemptExpectation{}
+	}
+
+	if mmLogAttempt.defaultExpectation.paramPtrs != nil {
+		mmLogAttempt.mock.t.Fatalf("WebhookRepositoryMock.LogAttempt mock is already set by ExpectParams functions")
+	}
+
+	mmLogAttempt.defaultExpectation.params = &WebhookRepositoryMockLogAttemptParams{ctx, attempt}
+	mmLogAttempt.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogAttempt.expectations {
+		if minimock.Equal(e.params, mmLogAttempt.defaultExpectation.params) {
+			mmLogAttempt.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogAttempt.defaultExpectation.params)
+		}
+	}
+
+	return mmLogAttempt
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.LogAttempt
+func (mmLogAttempt *mWebhookRepositoryMockLogAttempt) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockLogAttempt {
+	if mmLogAttempt.mock.funcLogAttempt != nil {
+		mmLogAttempt.mock.t.Fatalf("WebhookRepositoryMock.LogAttempt mock is already set by Set")
+	}
+
+	if mmLogAttempt.defaultExpectation == nil {
+		mmLogAttempt.defaultExpectation = &WebhookRepositoryMockLogAttemptExpectation{}
+	}
+
+	if mmLogAttempt.defaultExpectation.params != nil {
+		mmLogAttempt.mock.t.Fatalf("WebhookRepositoryMock.LogAttempt mock is already set by Expect")
+	}
+
+	if mmLogAttempt.defaultExpectation.paramPtrs == nil {
+		mmLogAttempt.defaultExpectation.paramPtrs = &WebhookRepositoryMockLogAttemptParamPtrs{}
+	}
+	mmLogAttempt.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogAttempt.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogAttempt
+}
+
+// ExpectAttemptParam2 sets up expected param attempt for WebhookRepository.LogAttempt
+func (mmLogAttempt *mWebhookRepositoryMockLogAttempt) ExpectAttemptParam2(attempt *model.WebhookAttempt) *mWebhookRepositoryMockLogAttempt {
+	if mmLogAttempt.mock.funcLogAttempt != nil {
+		mmLogAttempt.mock.t.Fatalf("WebhookRepositoryMock.LogAttempt mock is already set by Set")
+	}
+
+	if mmLogAttempt.defaultExpectation == nil {
+		mmLogAttempt.defaultExpectation = &WebhookRepositoryMockLogAttemptExpectation{}
+	}
+
+	if mmLogAttempt.defaultExpectation.params != nil {
+		mmLogAttempt.mock.t.Fatalf("WebhookRepositoryMock.LogAttempt mock is already set by Expect")
+	}
+
+	if mmLogAttempt.defaultExpectation.paramPtrs == nil {
+		mmLogAttempt.defaultExpectation.paramPtrs = &WebhookRepositoryMockLogAttemptParamPtrs{}
+	}
+	mmLogAttempt.defaultExpectation.paramPtrs.attempt = &attempt
+	mmLogAttempt.defaultExpectation.expectationOrigins.originAttempt = minimock.CallerInfo(1)
+
+	return mmLogAttempt
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.LogAttempt
+func (mmLogAttempt *mWebhookRepositoryMockLogAttempt) Inspect(f func(ctx context.Context, attempt *model.WebhookAttempt)) *mWebhookRepositoryMockLogAttempt {
+	if mmLogAttempt.mock.inspectFuncLogAttempt != nil {
+		mmLogAttempt.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.LogAttempt")
+	}
+
+	mmLogAttempt.mock.inspectFuncLogAttempt = f
+
+	return mmLogAttempt
+}
+
+// Return sets up results that will be returned by WebhookRepository.LogAttempt
+func (mmLogAttempt *mWebhookRepositoryMockLogAttempt) Return(err error) *WebhookRepositoryMock {
+	if mmLogAttempt.mock.funcLogAttempt != nil {
+		mmLogAttempt.mock.t.Fatalf("WebhookRepositoryMock.LogAttempt mock is already set by Set")
+	}
+
+	if mmLogAttempt.defaultExpectation == nil {
+		mmLogAttempt.defaultExpectation = &WebhookRepositoryMockLogAttemptExpectation{mock: mmLogAttempt.mock}
+	}
+	mmLogAttempt.defaultExpectation.results = &WebhookRepositoryMockLogAttemptResults{err}
+	mmLogAttempt.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogAttempt.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.LogAttempt method
+func (mmLogAttempt *mWebhookRepositoryMockLogAttempt) Set(f func(ctx context.Context, attempt *model.WebhookAttempt) (err error)) *WebhookRepositoryMock {
+	if mmLogAttempt.defaultExpectation != nil {
+		mmLogAttempt.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.LogAttempt method")
+	}
+
+	if len(mmLogAttempt.expectations) > 0 {
+		mmLogAttempt.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.LogAttempt method")
+	}
+
+	mmLogAttempt.mock.funcLogAttempt = f
+	mmLogAttempt.mock.funcLogAttemptOrigin = minimock.CallerInfo(1)
+	return mmLogAttempt.mock
+}
+
+// When sets expectation for the WebhookRepository.LogAttempt which will trigger the result defined by the following
+// Then helper
+func (mmLogAttempt *mWebhookRepositoryMockLogAttempt) When(ctx context.Context, attempt *model.WebhookAttempt) *WebhookRepositoryMockLogAttemptExpectation {
+	if mmLogAttempt.mock.funcLogAttempt != nil {
+		mmLogAttempt.mock.t.Fatalf("WebhookRepositoryMock.LogAttempt mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockLogAttemptExpectation{
+		mock:               mmLogAttempt.mock,
+		params:             &WebhookRepositoryMockLogAttemptParams{ctx, attempt},
+		expectationOrigins: WebhookRepositoryMockLogAttemptExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogAttempt.expectations = append(mmLogAttempt.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.LogAttempt return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockLogAttemptExpectation) Then(err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockLogAttemptResults{err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.LogAttempt should be invoked
+func (mmLogAttempt *mWebhookRepositoryMockLogAttempt) Times(n uint64) *mWebhookRepositoryMockLogAttempt {
+	if n == 0 {
+		mmLogAttempt.mock.t.Fatalf("Times of WebhookRepositoryMock.LogAttempt mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogAttempt.expectedInvocations, n)
+	mmLogAttempt.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogAttempt
+}
+
+func (mmLogAttempt *mWebhookRepositoryMockLogAttempt) invocationsDone() bool {
+	if len(mmLogAttempt.expectations) == 0 && mmLogAttempt.defaultExpectation == nil && mmLogAttempt.mock.funcLogAttempt == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogAttempt.mock.afterLogAttemptCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogAttempt.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LogAttempt implements mm_repository.WebhookRepository
+func (mmLogAttempt *WebhookRepositoryMock) LogAttempt(ctx context.Context, attempt *model.WebhookAttempt) (err error) {
+	mm_atomic.AddUint64(&mmLogAttempt.beforeLogAttemptCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogAttempt.afterLogAttemptCounter, 1)
+
+	mmLogAttempt.t.Helper()
+
+	if mmLogAttempt.inspectFuncLogAttempt != nil {
+		mmLogAttempt.inspectFuncLogAttempt(ctx, attempt)
+	}
+
+	mm_params := WebhookRepositoryMockLogAttemptParams{ctx, attempt}
+
+	// Record call args
+	mmLogAttempt.LogAttemptMock.mutex.Lock()
+	mmLogAttempt.LogAttemptMock.callArgs = append(mmLogAttempt.LogAttemptMock.callArgs, &mm_params)
+	mmLogAttempt.LogAttemptMock.mutex.Unlock()
+
+	for _, e := range mmLogAttempt.LogAttemptMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLogAttempt.LogAttemptMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogAttempt.LogAttemptMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogAttempt.LogAttemptMock.defaultExpectation.params
+		mm_want_ptrs := mmLogAttempt.LogAttemptMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockLogAttemptParams{ctx, attempt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogAttempt.t.Errorf("WebhookRepositoryMock.LogAttempt got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogAttempt.LogAttemptMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.attempt != nil && !minimock.Equal(*mm_want_ptrs.attempt, mm_got.attempt) {
+				mmLogAttempt.t.Errorf("WebhookRepositoryMock.LogAttempt got unexpected parameter attempt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogAttempt.LogAttemptMock.defaultExpectation.expectationOrigins.originAttempt, *mm_want_ptrs.attempt, mm_got.attempt, minimock.Diff(*mm_want_ptrs.attempt, mm_got.attempt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogAttempt.t.Errorf("WebhookRepositoryMock.LogAttempt got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogAttempt.LogAttemptMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogAttempt.LogAttemptMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogAttempt.t.Fatal("No results are set for the WebhookRepositoryMock.LogAttempt")
+		}
+		return (*mm_results).err
+	}
+	if mmLogAttempt.funcLogAttempt != nil {
+		return mmLogAttempt.funcLogAttempt(ctx, attempt)
+	}
+	mmLogAttempt.t.Fatalf("Unexpected call to WebhookRepositoryMock.LogAttempt. %v %v", ctx, attempt)
+	return
+}
+
+// LogAttemptAfterCounter returns a count of finished WebhookRepositoryMock.LogAttempt invocations
+func (mmLogAttempt *WebhookRepositoryMock) LogAttemptAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogAttempt.afterLogAttemptCounter)
+}
+
+// LogAttemptBeforeCounter returns a count of WebhookRepositoryMock.LogAttempt invocations
+func (mmLogAttempt *WebhookRepositoryMock) LogAttemptBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogAttempt.beforeLogAttemptCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.LogAttempt.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogAttempt *mWebhookRepositoryMockLogAttempt) Calls() []*WebhookRepositoryMockLogAttemptParams {
+	mmLogAttempt.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockLogAttemptParams, len(mmLogAttempt.callArgs))
+	copy(argCopy, mmLogAttempt.callArgs)
+
+	mmLogAttempt.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLogAttemptDone returns true if the count of the LogAttempt invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockLogAttemptDone() bool {
+	if m.LogAttemptMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LogAttemptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LogAttemptMock.invocationsDone()
+}
+
+// MinimockLogAttemptInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockLogAttemptInspect() {
+	for _, e := range m.LogAttemptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.LogAttempt at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLogAttemptCounter := mm_atomic.LoadUint64(&m.afterLogAttemptCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LogAttemptMock.defaultExpectation != nil && afterLogAttemptCounter < 1 {
+		if m.LogAttemptMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.LogAttempt at\n%s", m.LogAttemptMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.LogAttempt at\n%s with params: %#v", m.LogAttemptMock.defaultExpectation.expectationOrigins.origin, *m.LogAttemptMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogAttempt != nil && afterLogAttemptCounter < 1 {
+		m.t.Errorf("Expected call to WebhookRepositoryMock.LogAttempt at\n%s", m.funcLogAttemptOrigin)
+	}
+
+	if !m.LogAttemptMock.invocationsDone() && afterLogAttemptCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.LogAttempt at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LogAttemptMock.expectedInvocations), m.LogAttemptMock.expectedInvocationsOrigin, afterLogAttemptCounter)
+	}
+}
+
+type mWebhookRepositoryMockReschedule struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockRescheduleExpectation
+	expectations       []*WebhookRepositoryMockRescheduleExpectation
+
+	callArgs []*WebhookRepositoryMockRescheduleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WebhookRepositoryMockRescheduleExpectation specifies expectation struct of the WebhookRepository.Reschedule
+type WebhookRepositoryMockRescheduleExpectation struct {
+	mock               *WebhookRepositoryMock
+	params             *WebhookRepositoryMockRescheduleParams
+	paramPtrs          *WebhookRepositoryMockRescheduleParamPtrs
+	expectationOrigins WebhookRepositoryMockRescheduleExpectationOrigins
+	results            *WebhookRepositoryMockRescheduleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WebhookRepositoryMockRescheduleParams contains parameters of the WebhookRepository.Reschedule
+type WebhookRepositoryMockRescheduleParams struct {
+	ctx      context.Context
+	id       int64
+	attempts int
+	delay    time.Duration
+}
+
+// WebhookRepositoryMockRescheduleParamPtrs contains pointers to parameters of the WebhookRepository.Reschedule
+type WebhookRepositoryMockRescheduleParamPtrs struct {
+	ctx      *context.Context
+	id       *int64
+	attempts *int
+	delay    *time.Duration
+}
+
+// WebhookRepositoryMockRescheduleResults contains results of the WebhookRepository.Reschedule
+type WebhookRepositoryMockRescheduleResults struct {
+	err error
+}
+
+// WebhookRepositoryMockRescheduleOrigins contains origins of expectations of the WebhookRepository.Reschedule
+type WebhookRepositoryMockRescheduleExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originId       string
+	originAttempts string
+	originDelay    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReschedule *mWebhookRepositoryMockReschedule) Optional() *mWebhookRepositoryMockReschedule {
+	mmReschedule.optional = true
+	return mmReschedule
+}
+
+// Expect sets up expected params for WebhookRepository.Reschedule
+func (mmReschedule *mWebhookRepositoryMockReschedule) Expect(ctx context.Context, id int64, attempts int, delay time.Duration) *mWebhookRepositoryMockReschedule {
+	if mmReschedule.mock.funcReschedule != nil {
+		mmReschedule.mock.t.Fatalf("WebhookRepositoryMock.Reschedule mock is already set by Set")
+	}
+
+	if mmReschedule.defaultExpectation == nil {
+		mmReschedule.defaultExpectation = &WebhookRepositoryMockRescheduleExpectation{}
+	}
+
+	if mmReschedule.defaultExpectation.paramPtrs != nil {
+		mmReschedule.mock.t.Fatalf("WebhookRepositoryMock.Reschedule mock is already set by ExpectParams functions")
+	}
+
+	mmReschedule.defaultExpectation.params = &WebhookRepositoryMockRescheduleParams{ctx, id, attempts, delay}
+	mmReschedule.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReschedule.expectations {
+		if minimock.Equal(e.params, mmReschedule.defaultExpectation.params) {
+			mmReschedule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReschedule.defaultExpectation.params)
+		}
+	}
+
+	return mmReschedule
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.Reschedule
+func (mmReschedule *mWebhookRepositoryMockReschedule) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockReschedule {
+	if mmReschedule.mock.funcReschedule != nil {
+		mmReschedule.mock.t.Fatalf("WebhookRepositoryMock.Reschedule mock is already set by Set")
+	}
+
+	if mmReschedule.defaultExpectation == nil {
+		mmReschedule.defaultExpectation = &WebhookRepositoryMockRescheduleExpectation{}
+	}
+
+	if mmReschedule.defaultExpectation.params != nil {
+		mmReschedule.mock.t.Fatalf("WebhookRepositoryMock.Reschedule mock is already set by Expect")
+	}
+
+	if mmReschedule.defaultExpectation.paramPtrs == nil {
+		mmReschedule.defaultExpectation.paramPtrs = &WebhookRepositoryMockRescheduleParamPtrs{}
+	}
+	mmReschedule.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReschedule.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReschedule
+}
+
+// ExpectIdParam2 sets up expected param id for WebhookRepository.Reschedule
+func (mmReschedule *mWebhookRepositoryMockReschedule) ExpectIdParam2(id int64) *mWebhookRepositoryMockReschedule {
+	if mmReschedule.mock.funcReschedule != nil {
+		mmReschedule.mock.t.Fatalf("WebhookRepositoryMock.Reschedule mock is already set by Set")
+	}
+
+	if mmReschedule.defaultExpectation == nil {
+		mmReschedule.defaultExpectation = &WebhookRepositoryMockRescheduleExpectation{}
+	}
+
+	if mmReschedule.defaultExpectation.params != nil {
+		mmReschedule.mock.t.Fatalf("WebhookRepositoryMock.Reschedule mock is already set by Expect")
+	}
+
+	if mmReschedule.defaultExpectation.paramPtrs == nil {
+		mmReschedule.defaultExpectation.paramPtrs = &WebhookRepositoryMockRescheduleParamPtrs{}
+	}
+	mmReschedule.defaultExpectation.paramPtrs.id = &id
+	mmReschedule.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmReschedule
+}
+
+// ExpectAttemptsParam3 sets up expected param attempts for WebhookRepository.Reschedule
+func (mmReschedule *mWebhookRepositoryMockReschedule) ExpectAttemptsParam3(attempts int) *mWebhookRepositoryMockReschedule {
+	if mmReschedule.mock.funcReschedule != nil {
+		mmReschedule.mock.t.Fatalf("WebhookRepositoryMock.Reschedule mock is already set by Set")
+	}
+
+	if mmReschedule.defaultExpectation == nil {
+		mmReschedule.defaultExpectation = &WebhookRepositoryMockRescheduleExpectation{}
+	}
+
+	if mmReschedule.defaultExpectation.params != nil {
+		mmReschedule.mock.t.Fatalf("WebhookRepositoryMock.Reschedule mock is already set by Expect")
+	}
+
+	if mmReschedule.defaultExpectation.paramPtrs == nil {
+		mmReschedule.defaultExpectation.paramPtrs = &WebhookRepositoryMockRescheduleParamPtrs{}
+	}
+	mmReschedule.defaultExpectation.paramPtrs.attempts = &attempts
+	mmReschedule.defaultExpectation.expectationOrigins.originAttempts = minimock.CallerInfo(1)
+
+	return mmReschedule
+}
+
+// ExpectDelayParam4 sets up expected param delay for WebhookRepository.Reschedule
+func (mmReschedule *mWebhookRepositoryMockReschedule) ExpectDelayParam4(delay time.Duration) *mWebhookRepositoryMockReschedule {
+	if mmReschedule.mock.funcReschedule != nil {
+		mmReschedule.mock.t.Fatalf("WebhookRepositoryMock.Reschedule mock is already set by Set")
+	}
+
+	if mmReschedule.defaultExpectation == nil {
+		mmReschedule.defaultExpectation = &WebhookRepositoryMockRescheduleExpectation{}
+	}
+
+	if mmReschedule.defaultExpectation.params != nil {
+		mmReschedule.mock.t.Fatalf("WebhookRepositoryMock.Reschedule mock is already set by Expect")
+	}
+
+	if mmReschedule.defaultExpectation.paramPtrs == nil {
+		mmReschedule.defaultExpectation.paramPtrs = &WebhookRepositoryMockRescheduleParamPtrs{}
+	}
+	mmReschedule.defaultExpectation.paramPtrs.delay = &delay
+	mmReschedule.defaultExpectation.expectationOrigins.originDelay = minimock.CallerInfo(1)
+
+	return mmReschedule
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.Reschedule
+func (mmReschedule *mWebhookRepositoryMockReschedule) Inspect(f func(ctx context.Context, id int64, attempts int, delay time.Duration)) *mWebhookRepositoryMockReschedule {
+	if mmReschedule.mock.inspectFuncReschedule != nil {
+		mmReschedule.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.Reschedule")
+	}
+
+	mmReschedule.mock.inspectFuncReschedule = f
+
+	return mmReschedule
+}
+
+// Return sets up results that will be returned by WebhookRepository.Reschedule
+func (mmReschedule *mWebhookRepositoryMockReschedule) Return(err error) *WebhookRepositoryMock {
+	if mmReschedule.mock.funcReschedule != nil {
+		mmReschedule.mock.t.Fatalf("WebhookRepositoryMock.Reschedule mock is already set by Set")
+	}
+
+	if mmReschedule.defaultExpectation == nil {
+		mmReschedule.defaultExpectation = &WebhookRepositoryMockRescheduleExpectation{mock: mmReschedule.mock}
+	}
+	mmReschedule.defaultExpectation.results = &WebhookRepositoryMockRescheduleResults{err}
+	mmReschedule.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReschedule.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.Reschedule method
+func (mmReschedule *mWebhookRepositoryMockReschedule) Set(f func(ctx context.Context, id int64, attempts int, delay time.Duration) (err error)) *WebhookRepositoryMock {
+	if mmReschedule.defaultExpectation != nil {
+		mmReschedule.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.Reschedule method")
+	}
+
+	if len(mmReschedule.expectations) > 0 {
+		mmReschedule.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.Reschedule method")
+	}
+
+	mmReschedule.mock.funcReschedule = f
+	mmReschedule.mock.funcRescheduleOrigin = minimock.CallerInfo(1)
+	return mmReschedule.mock
+}
+
+// When sets expectation for the WebhookRepository.Reschedule which will trigger the result defined by the following
+// Then helper
+func (mmReschedule *mWebhookRepositoryMockReschedule) When(ctx context.Context, id int64, attempts int, delay time.Duration) *WebhookRepositoryMockRescheduleExpectation {
+	if mmReschedule.mock.funcReschedule != nil {
+		mmReschedule.mock.t.Fatalf("WebhookRepositoryMock.Reschedule mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockRescheduleExpectation{
+		mock:               mmReschedule.mock,
+		params:             &WebhookRepositoryMockRescheduleParams{ctx, id, attempts, delay},
+		expectationOrigins: WebhookRepositoryMockRescheduleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReschedule.expectations = append(mmReschedule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.Reschedule return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockRescheduleExpectation) Then(err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockRescheduleResults{err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.Reschedule should be invoked
+func (mmReschedule *mWebhookRepositoryMockReschedule) Times(n uint64) *mWebhookRepositoryMockReschedule {
+	if n == 0 {
+		mmReschedule.mock.t.Fatalf("Times of WebhookRepositoryMock.Reschedule mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReschedule.expectedInvocations, n)
+	mmReschedule.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReschedule
+}
+
+func (mmReschedule *mWebhookRepositoryMockReschedule) invocationsDone() bool {
+	if len(mmReschedule.expectations) == 0 && mmReschedule.defaultExpectation == nil && mmReschedule.mock.funcReschedule == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReschedule.mock.afterRescheduleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReschedule.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Reschedule implements mm_repository.WebhookRepository
+func (mmReschedule *WebhookRepositoryMock) Reschedule(ctx context.Context, id int64, attempts int, delay time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmReschedule.beforeRescheduleCounter, 1)
+	defer mm_atomic.AddUint64(&mmReschedule.afterRescheduleCounter, 1)
+
+	mmReschedule.t.Helper()
+
+	if mmReschedule.inspectFuncReschedule != nil {
+		mmReschedule.inspectFuncReschedule(ctx, id, attempts, delay)
+	}
+
+	mm_params := WebhookRepositoryMockRescheduleParams{ctx, id, attempts, delay}
+
+	// Record call args
+	mmReschedule.RescheduleMock.mutex.Lock()
+	mmReschedule.RescheduleMock.callArgs = append(mmReschedule.RescheduleMock.callArgs, &mm_params)
+	mmReschedule.RescheduleMock.mutex.Unlock()
+
+	for _, e := range mmReschedule.RescheduleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReschedule.RescheduleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReschedule.RescheduleMock.defaultExpectation.Counter, 1)
+		mm_want := mmReschedule.RescheduleMock.defaultExpectation.params
+		mm_want_ptrs := mmReschedule.RescheduleMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockRescheduleParams{ctx, id, attempts, delay}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReschedule.t.Errorf("WebhookRepositoryMock.Reschedule got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReschedule.RescheduleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmReschedule.t.Errorf("WebhookRepositoryMock.Reschedule got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReschedule.RescheduleMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.attempts != nil && !minimock.Equal(*mm_want_ptrs.attempts, mm_got.attempts) {
+				mmReschedule.t.Errorf("WebhookRepositoryMock.Reschedule got unexpected parameter attempts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReschedule.RescheduleMock.defaultExpectation.expectationOrigins.originAttempts, *mm_want_ptrs.attempts, mm_got.attempts, minimock.Diff(*mm_want_ptrs.attempts, mm_got.attempts))
+			}
+
+			if mm_want_ptrs.delay != nil && !minimock.Equal(*mm_want_ptrs.delay, mm_got.delay) {
+				mmReschedule.t.Errorf("WebhookRepositoryMock.Reschedule got unexpected parameter delay, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReschedule.RescheduleMock.defaultExpectation.expectationOrigins.originDelay, *mm_want_ptrs.delay, mm_got.delay, minimock.Diff(*mm_want_ptrs.delay, mm_got.delay))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReschedule.t.Errorf("WebhookRepositoryMock.Reschedule got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReschedule.RescheduleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReschedule.RescheduleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReschedule.t.Fatal("No results are set for the WebhookRepositoryMock.Reschedule")
+		}
+		return (*mm_results).err
+	}
+	if mmReschedule.funcReschedule != nil {
+		return mmReschedule.funcReschedule(ctx, id, attempts, delay)
+	}
+	mmReschedule.t.Fatalf("Unexpected call to WebhookRepositoryMock.Reschedule. %v %v %v %v", ctx, id, attempts, delay)
+	return
+}
+
+// RescheduleAfterCounter returns a count of finished WebhookRepositoryMock.Reschedule invocations
+func (mmReschedule *WebhookRepositoryMock) RescheduleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReschedule.afterRescheduleCounter)
+}
+
+// RescheduleBeforeCounter returns a count of WebhookRepositoryMock.Reschedule invocations
+func (mmReschedule *WebhookRepositoryMock) RescheduleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReschedule.beforeRescheduleCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.Reschedule.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReschedule *mWebhookRepositoryMockReschedule) Calls() []*WebhookRepositoryMockRescheduleParams {
+	mmReschedule.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockRescheduleParams, len(mmReschedule.callArgs))
+	copy(argCopy, mmReschedule.callArgs)
+
+	mmReschedule.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRescheduleDone returns true if the count of the Reschedule invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockRescheduleDone() bool {
+	if m.RescheduleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RescheduleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RescheduleMock.invocationsDone()
+}
+
+// MinimockRescheduleInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockRescheduleInspect() {
+	for _, e := range m.RescheduleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.Reschedule at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRescheduleCounter := mm_atomic.LoadUint64(&m.afterRescheduleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RescheduleMock.defaultExpectation != nil && afterRescheduleCounter < 1 {
+		if m.RescheduleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.Reschedule at\n%s", m.RescheduleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.Reschedule at\n%s with params: %#v", m.RescheduleMock.defaultExpectation.expectationOrigins.origin, *m.RescheduleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReschedule != nil && afterRescheduleCounter < 1 {
+		m.t.Errorf("Expected call to WebhookRepositoryMock.Reschedule at\n%s", m.funcRescheduleOrigin)
+	}
+
+	if !m.RescheduleMock.invocationsDone() && afterRescheduleCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.Reschedule at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RescheduleMock.expectedInvocations), m.RescheduleMock.expectedInvocationsOrigin, afterRescheduleCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *WebhookRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockClaimInspect()
+
+			m.MinimockCompleteInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockCreateDeliveriesInspect()
+
+			m.MinimockDeadLetterInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockListAttemptsInspect()
+
+			m.MinimockListDueInspect()
+
+			m.MinimockListSubscribedInspect()
+
+			m.MinimockLogAttemptInspect()
+
+			m.MinimockRescheduleInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *WebhookRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *WebhookRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockClaimDone() &&
+		m.MinimockCompleteDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockCreateDeliveriesDone() &&
+		m.MinimockDeadLetterDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockListDone() &&
+		m.MinimockListAttemptsDone() &&
+		m.MinimockListDueDone() &&
+		m.MinimockListSubscribedDone() &&
+		m.MinimockLogAttemptDone() &&
+		m.MinimockRescheduleDone()
+}
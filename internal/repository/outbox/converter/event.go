@@ -0,0 +1,108 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Mobo140/chat/internal/model"
+	modelRepo "github.com/Mobo140/chat/internal/repository/outbox/model"
+)
+
+// ToPayloadFromService encodes the event data stored in the payload column.
+func ToPayloadFromService(event *model.ChatEvent) ([]byte, error) {
+	payload := modelRepo.Payload{
+		Username:      event.Username,
+		ReadMessageID: event.ReadMessageID,
+	}
+
+	if event.Message != nil {
+		payload.Message = &modelRepo.Message{
+			ID:        event.Message.ID,
+			From:      event.Message.From,
+			Text:      event.Message.Text,
+			CreatedAt: event.Message.CreatedAt,
+			EditedAt:  event.Message.EditedAt,
+			DeletedAt: event.Message.DeletedAt,
+			ReplyToID: event.Message.ReplyToID,
+		}
+	}
+
+	if event.Reaction != nil {
+		payload.Reaction = &modelRepo.Reaction{
+			MessageID: event.Reaction.MessageID,
+			Username:  event.Reaction.Username,
+			Emoji:     event.Reaction.Emoji,
+		}
+	}
+
+	if event.Chat != nil {
+		payload.Chat = &modelRepo.Chat{
+			Usernames: event.Chat.Usernames,
+			Admins:    event.Chat.Admins,
+		}
+	}
+
+	return json.Marshal(payload)
+}
+
+func ToEventFromRepo(event *modelRepo.Event) (*model.ChatEvent, error) {
+	var payload modelRepo.Payload
+
+	err := json.Unmarshal(event.Payload, &payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode payload of event %d: %v", event.ID, err)
+	}
+
+	res := &model.ChatEvent{
+		ID:            event.ID,
+		ChatID:        event.ChatID,
+		Type:          model.ChatEventType(event.Type),
+		Username:      payload.Username,
+		ReadMessageID: payload.ReadMessageID,
+		CreatedAt:     event.CreatedAt,
+	}
+
+	if payload.Message != nil {
+		res.Message = &model.Message{
+			ID:        payload.Message.ID,
+			From:      payload.Message.From,
+			Text:      payload.Message.Text,
+			CreatedAt: payload.Message.CreatedAt,
+			EditedAt:  payload.Message.EditedAt,
+			DeletedAt: payload.Message.DeletedAt,
+			ReplyToID: payload.Message.ReplyToID,
+		}
+	}
+
+	if payload.Reaction != nil {
+		res.Reaction = &model.Reaction{
+			ChatID:    event.ChatID,
+			MessageID: payload.Reaction.MessageID,
+			Username:  payload.Reaction.Username,
+			Emoji:     payload.Reaction.Emoji,
+		}
+	}
+
+	if payload.Chat != nil {
+		res.Chat = &model.ChatInfo{
+			Usernames: payload.Chat.Usernames,
+			Admins:    payload.Chat.Admins,
+		}
+	}
+
+	return res, nil
+}
+
+func ToEventsFromRepo(events []*modelRepo.Event) ([]*model.ChatEvent, error) {
+	res := make([]*model.ChatEvent, 0, len(events))
+	for _, event := range events {
+		converted, err := ToEventFromRepo(event)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, converted)
+	}
+
+	return res, nil
+}
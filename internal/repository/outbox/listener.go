@@ -0,0 +1,113 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+)
+
+var _ repository.OutboxListener = (*listener)(nil)
+
+// listener holds a connection of its own: a pooled one would drop the LISTEN
+// when it goes back to the pool.
+type listener struct {
+	dsn string
+}
+
+func NewListener(dsn string) *listener { //nolint:revive // it's ok
+	return &listener{dsn: dsn}
+}
+
+// Listen notifies handle of the events published after afterID: the ones
+// published while no connection listened are read once listening, then the
+// notifications are waited for. An event with an ID up to afterID published
+// while no connection listened is missed.
+func (l *listener) Listen(ctx context.Context, afterID int64, handle func(ctx context.Context, id int64)) error {
+	conn, err := pgx.Connect(ctx, l.dsn)
+	if err != nil {
+		return fmt.Errorf("failed to connect: %v", err)
+	}
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+PublishedChannel)
+	if err != nil {
+		return fmt.Errorf("failed to listen: %v", err)
+	}
+
+	missed, err := listPublishedAfter(ctx, conn, afterID)
+	if err != nil {
+		return err
+	}
+
+	// The events published since LISTEN are both read and notified.
+	handled := make(map[int64]struct{}, len(missed))
+	for _, id := range missed {
+		handled[id] = struct{}{}
+		handle(ctx, id)
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		id, err := strconv.ParseInt(notification.Payload, 10, 64)
+		if err != nil {
+			logger.Warn("invalid outbox notification", zap.String("payload", notification.Payload))
+
+			continue
+		}
+
+		if _, ok := handled[id]; ok {
+			delete(handled, id)
+
+			continue
+		}
+
+		handle(ctx, id)
+	}
+}
+
+func listPublishedAfter(ctx context.Context, conn *pgx.Conn, afterID int64) ([]int64, error) {
+	query, args, err := sq.Select(idColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Gt{idColumn: afterID}).
+		Where(sq.NotEq{publishedAtColumn: nil}).
+		OrderBy(idColumn).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select published events: %v", err)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan published event: %v", err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to select published events: %v", rows.Err())
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,42 @@
+package model
+
+import "time"
+
+type Event struct {
+	ID        int64     `db:"id"`
+	ChatID    int64     `db:"chat_id"`
+	Type      string    `db:"event_type"`
+	Payload   []byte    `db:"payload"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Payload is the JSON stored in the payload column.
+type Payload struct {
+	Username string    `json:"username"`
+	Message  *Message  `json:"message,omitempty"`
+	Reaction *Reaction `json:"reaction,omitempty"`
+	Chat     *Chat     `json:"chat,omitempty"`
+
+	ReadMessageID int64 `json:"read_message_id,omitempty"`
+}
+
+type Message struct {
+	ID        int64      `json:"id"`
+	From      string     `json:"from"`
+	Text      string     `json:"text"`
+	CreatedAt time.Time  `json:"created_at"`
+	EditedAt  *time.Time `json:"edited_at,omitempty"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	ReplyToID int64      `json:"reply_to_message_id,omitempty"`
+}
+
+type Reaction struct {
+	MessageID int64  `json:"message_id"`
+	Username  string `json:"username"`
+	Emoji     string `json:"emoji"`
+}
+
+type Chat struct {
+	Usernames []string `json:"usernames"`
+	Admins    []string `json:"admins"`
+}
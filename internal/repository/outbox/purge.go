@@ -0,0 +1,98 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Mobo140/platform_common/pkg/db"
+)
+
+// LastPublished returns the ID of the last published event, zero if none.
+func (o *outboxRepo) LastPublished(ctx context.Context) (int64, error) {
+	builder := sq.Select("COALESCE(MAX(" + idColumn + "), 0)").
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.NotEq{publishedAtColumn: nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		Name:     "outbox_repository.last_published",
+		QueryRow: query,
+	}
+
+	var id int64
+
+	err = o.db.DB().ScanOneContext(ctx, &id, q, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to select last published event: %v", err)
+	}
+
+	return id, nil
+}
+
+// CountPublished counts the events published more than maxAge ago.
+func (o *outboxRepo) CountPublished(ctx context.Context, maxAge time.Duration) (int64, error) {
+	builder := sq.Select("COUNT(*)").
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(publishedBefore(maxAge))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		Name:     "outbox_repository.count_published",
+		QueryRow: query,
+	}
+
+	var count int64
+
+	err = o.db.DB().ScanOneContext(ctx, &count, q, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count published events: %v", err)
+	}
+
+	return count, nil
+}
+
+// DeletePublished deletes up to limit of the events published more than
+// maxAge ago and returns how many were deleted.
+func (o *outboxRepo) DeletePublished(ctx context.Context, maxAge time.Duration, limit uint64) (int64, error) {
+	batch := sq.Select(idColumn).
+		From(tableName).
+		Where(publishedBefore(maxAge)).
+		Limit(limit)
+
+	builder := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Expr(idColumn+" IN (?)", batch))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		Name:     "outbox_repository.delete_published",
+		QueryRow: query,
+	}
+
+	tag, err := o.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete published events: %v", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func publishedBefore(maxAge time.Duration) sq.Sqlizer {
+	return sq.Expr(publishedAtColumn+" < NOW() - ? * INTERVAL '1 millisecond'", maxAge.Milliseconds())
+}
@@ -0,0 +1,166 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/repository/outbox/converter"
+	modelRepo "github.com/Mobo140/chat/internal/repository/outbox/model"
+	"github.com/Mobo140/platform_common/pkg/db"
+)
+
+var _ repository.OutboxRepository = (*outboxRepo)(nil)
+
+const (
+	tableName         = "outbox"
+	idColumn          = "id"
+	chatColumn        = "chat_id"
+	typeColumn        = "event_type"
+	payloadColumn     = "payload"
+	createdAtColumn   = "created_at"
+	publishedAtColumn = "published_at"
+
+	// PublishedChannel is the notification channel of the published events,
+	// the payload is the event ID.
+	PublishedChannel = "outbox_published"
+)
+
+type outboxRepo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) *outboxRepo { //nolint:revive // it's ok
+	return &outboxRepo{db: db}
+}
+
+// Create writes the event to the outbox, in the transaction of the change.
+func (o *outboxRepo) Create(ctx context.Context, event *model.ChatEvent) error {
+	payload, err := converter.ToPayloadFromService(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event payload: %v", err)
+	}
+
+	builder := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatColumn, typeColumn, payloadColumn).
+		Values(event.ChatID, string(event.Type), string(payload)).
+		Suffix("RETURNING " + idColumn)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		Name:     "outbox_repository.create",
+		QueryRow: query,
+	}
+
+	err = o.db.DB().ScanOneContext(ctx, &event.ID, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert event: %v", err)
+	}
+
+	return nil
+}
+
+// Get returns the event, published or not.
+func (o *outboxRepo) Get(ctx context.Context, id int64) (*model.ChatEvent, error) {
+	builder := sq.Select(idColumn, chatColumn, typeColumn, payloadColumn, createdAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		Name:     "outbox_repository.get",
+		QueryRow: query,
+	}
+
+	var event modelRepo.Event
+
+	err = o.db.DB().ScanOneContext(ctx, &event, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select event: %v", err)
+	}
+
+	return converter.ToEventFromRepo(&event)
+}
+
+// ListPending returns the oldest unpublished events and locks them until the
+// end of the transaction. Events locked by another relay are skipped.
+func (o *outboxRepo) ListPending(ctx context.Context, limit uint64) ([]*model.ChatEvent, error) {
+	builder := sq.Select(idColumn, chatColumn, typeColumn, payloadColumn, createdAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{publishedAtColumn: nil}).
+		OrderBy(idColumn).
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		Name:     "outbox_repository.list_pending",
+		QueryRow: query,
+	}
+
+	var events []*modelRepo.Event
+
+	err = o.db.DB().ScanAllContext(ctx, &events, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select events: %v", err)
+	}
+
+	return converter.ToEventsFromRepo(events)
+}
+
+// MarkPublished marks the events as delivered.
+func (o *outboxRepo) MarkPublished(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	builder := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(publishedAtColumn, sq.Expr("NOW()")).
+		Where(sq.Eq{idColumn: ids})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		Name:     "outbox_repository.mark_published",
+		QueryRow: query,
+	}
+
+	_, err = o.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to mark events published: %v", err)
+	}
+
+	// The notifications are sent on commit, in order.
+	q = db.Query{
+		Name:     "outbox_repository.notify_published",
+		QueryRow: "SELECT pg_notify($1, id::text) FROM unnest($2::bigint[]) WITH ORDINALITY AS t(id, n) ORDER BY n",
+	}
+
+	_, err = o.db.DB().ExecContext(ctx, q, PublishedChannel, ids)
+	if err != nil {
+		return fmt.Errorf("failed to notify published events: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,7 @@
+package model
+
+type ReactionCount struct {
+	MessageID int64  `db:"message_id"`
+	Emoji     string `db:"emoji"`
+	Count     int64  `db:"count"`
+}
@@ -0,0 +1,125 @@
+package reaction
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	modelRepo "github.com/Mobo140/chat/internal/repository/reaction/model"
+	"github.com/Mobo140/platform_common/pkg/db"
+)
+
+var _ repository.ReactionRepository = (*reactionRepo)(nil)
+
+const (
+	tableName       = "message_reactions"
+	messageIDColumn = "message_id"
+	usernameColumn  = "username"
+	emojiColumn     = "emoji"
+)
+
+type reactionRepo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) *reactionRepo { //nolint:revive // it's ok
+	return &reactionRepo{db: db}
+}
+
+// Add stores the reaction, adding the same reaction twice is a no-op that
+// reports false.
+func (r *reactionRepo) Add(ctx context.Context, reaction *model.Reaction) (bool, error) {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, usernameColumn, emojiColumn).
+		Values(reaction.MessageID, reaction.Username, reaction.Emoji).
+		Suffix("ON CONFLICT (message_id, username, emoji) DO NOTHING")
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "reaction_repository.add",
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert reaction: %v", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// Remove deletes the reaction, it reports false when there was none.
+func (r *reactionRepo) Remove(ctx context.Context, reaction *model.Reaction) (bool, error) {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{
+			messageIDColumn: reaction.MessageID,
+			usernameColumn:  reaction.Username,
+			emojiColumn:     reaction.Emoji,
+		})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "reaction_repository.remove",
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete reaction: %v", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// Counts aggregates the reactions of the messages by emoji.
+func (r *reactionRepo) Counts(ctx context.Context, messageIDs []int64) (map[int64][]model.ReactionCount, error) {
+	counts := make(map[int64][]model.ReactionCount, len(messageIDs))
+	if len(messageIDs) == 0 {
+		return counts, nil
+	}
+
+	builderSelect := sq.Select(messageIDColumn, emojiColumn, "COUNT(*) AS count").
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{messageIDColumn: messageIDs}).
+		GroupBy(messageIDColumn, emojiColumn).
+		OrderBy(messageIDColumn, "count DESC", emojiColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "reaction_repository.counts",
+	}
+
+	var rows []modelRepo.ReactionCount
+
+	err = r.db.DB().ScanAllContext(ctx, &rows, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select reactions: %v", err)
+	}
+
+	for _, row := range rows {
+		counts[row.MessageID] = append(counts[row.MessageID], model.ReactionCount{
+			Emoji: row.Emoji,
+			Count: row.Count,
+		})
+	}
+
+	return counts, nil
+}
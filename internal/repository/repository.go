@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/Mobo140/chat/internal/model"
 )
@@ -10,14 +11,146 @@ type ChatRepository interface {
 	Create(ctx context.Context, chat *model.ChatInfo) (int64, error)
 	Get(ctx context.Context, id int64) (*model.Chat, error)
 	// Update(ctx context.Context, info *model.UpdateInfo) error
+	// Delete hides the chat from the reads, Restore brings it back until it is purged.
 	Delete(ctx context.Context, id int64) error
+	Restore(ctx context.Context, id int64) error
+	SetArchived(ctx context.Context, id int64, archived bool) error
+	// PurgeDeleted deletes for good a chat deleted more than grace ago, it returns 0 if there is none.
+	PurgeDeleted(ctx context.Context, grace time.Duration) (int64, error)
+	CountDeleted(ctx context.Context, grace time.Duration) (int64, error)
+	SetTopic(ctx context.Context, id int64, topic string) error
+	// AddUser returns false if the user is in the chat already.
+	AddUser(ctx context.Context, id int64, username string) (bool, error)
+	Mute(ctx context.Context, id int64, username string, duration time.Duration) error
+	Unmute(ctx context.Context, id int64, username string) error
+	IsMuted(ctx context.Context, id int64, username string) (bool, error)
+	SetRetention(ctx context.Context, retention *model.ChatRetention) error
+	ListRetention(ctx context.Context, afterID int64, limit uint64) ([]*model.ChatRetention, error)
 }
 
 type MessageRepository interface {
-	SendMessage(ctx context.Context, message *model.SendMessage) error
+	SendMessage(ctx context.Context, message *model.SendMessage) (int64, error)
+	Get(ctx context.Context, id int64) (*model.ChatMessage, error)
+	List(ctx context.Context, page *model.MessagesPage) ([]*model.Message, error)
+	ListAfter(ctx context.Context, chatID, afterID int64, limit uint64) ([]*model.Message, error)
+	ListReplies(ctx context.Context, page *model.ThreadPage) ([]*model.Message, error)
+	ListByIDs(ctx context.Context, ids []int64) ([]*model.Message, error)
+	ReplyCounts(ctx context.Context, ids []int64) (map[int64]int64, error)
+	Search(ctx context.Context, query *model.SearchQuery) ([]*model.SearchResult, error)
+	Update(ctx context.Context, id int64, text string, editedAt time.Time) error
+	Delete(ctx context.Context, id int64, deletedAt time.Time) error
+	CountPurgeable(ctx context.Context, purge *model.MessagePurge) (int64, error)
+	// Purge deletes a batch of messages beyond the retention for good.
+	Purge(ctx context.Context, purge *model.MessagePurge) (int64, error)
+	NextIDs(ctx context.Context, n int) ([]int64, error)
+	// Copy inserts the messages as they are, in a transaction.
+	Copy(ctx context.Context, chatID int64, messages []*model.Message) (int64, error)
 	// GetMessagesByChatID()
 }
 
+type MessageEditRepository interface {
+	Create(ctx context.Context, edit *model.MessageEdit) error
+	// ListByMessages returns the previous versions of the messages from the oldest.
+	ListByMessages(ctx context.Context, messageIDs []int64) (map[int64][]*model.MessageEdit, error)
+	// Copy inserts the edits as they are, in a transaction.
+	Copy(ctx context.Context, edits []*model.MessageEdit) (int64, error)
+}
+
+type ReactionRepository interface {
+	// Add reports whether the reaction was new.
+	Add(ctx context.Context, reaction *model.Reaction) (bool, error)
+	// Remove reports whether there was a reaction to remove.
+	Remove(ctx context.Context, reaction *model.Reaction) (bool, error)
+	Counts(ctx context.Context, messageIDs []int64) (map[int64][]model.ReactionCount, error)
+}
+
+// LogRepository stores the audit log. Entries are created unchained, the
+// chainer links them to the hash chain in a transaction holding LockChain.
 type LogRepository interface {
 	Create(ctx context.Context, logEntry *model.LogEntry) error
+	List(ctx context.Context, filter *model.LogFilter) ([]*model.LogEntry, error)
+	LockChain(ctx context.Context) error
+	LastChained(ctx context.Context) (*model.LogEntry, error)
+	ListUnchained(ctx context.Context, limit uint64) ([]*model.LogEntry, error)
+	Link(ctx context.Context, logEntry *model.LogEntry) error
+	ListChain(ctx context.Context, afterSeq int64, limit uint64) ([]*model.LogEntry, error)
+	// LastBefore returns the latest chained entry older than maxAge.
+	LastBefore(ctx context.Context, maxAge time.Duration) (*model.LogEntry, error)
+	CountUpTo(ctx context.Context, seq int64) (int64, error)
+	DeleteUpTo(ctx context.Context, seq int64, limit uint64) (int64, error)
+}
+
+type OutboxRepository interface {
+	Create(ctx context.Context, event *model.ChatEvent) error
+	Get(ctx context.Context, id int64) (*model.ChatEvent, error)
+	ListPending(ctx context.Context, limit uint64) ([]*model.ChatEvent, error)
+	// MarkPublished marks the events as delivered and notifies the listeners
+	// of every instance once the transaction commits.
+	MarkPublished(ctx context.Context, ids []int64) error
+	LastPublished(ctx context.Context) (int64, error)
+	CountPublished(ctx context.Context, maxAge time.Duration) (int64, error)
+	DeletePublished(ctx context.Context, maxAge time.Duration, limit uint64) (int64, error)
+}
+
+// OutboxListener receives the IDs of the published outbox events, on every
+// instance.
+type OutboxListener interface {
+	// Listen calls handle with the ID of every event published after afterID,
+	// the ones published before the connection first, until the context is
+	// done or the connection fails.
+	Listen(ctx context.Context, afterID int64, handle func(ctx context.Context, id int64)) error
+}
+
+type WebhookRepository interface {
+	Create(ctx context.Context, webhook *model.Webhook) (int64, error)
+	List(ctx context.Context, chatID int64) ([]*model.Webhook, error)
+	ListSubscribed(ctx context.Context, chatID int64) ([]*model.Webhook, error)
+	Delete(ctx context.Context, id int64) error
+	CreateDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error
+	ListDue(ctx context.Context, limit uint64) ([]*model.WebhookDelivery, error)
+	// Claim moves the next attempt of the deliveries lease ahead.
+	Claim(ctx context.Context, ids []int64, lease time.Duration) error
+	Reschedule(ctx context.Context, id int64, attempts int, delay time.Duration) error
+	Complete(ctx context.Context, id int64) error
+	DeadLetter(ctx context.Context, delivery *model.WebhookDelivery, lastError string) error
+	LogAttempt(ctx context.Context, attempt *model.WebhookAttempt) error
+	ListAttempts(ctx context.Context, filter *model.WebhookAttemptFilter) ([]*model.WebhookAttempt, error)
+}
+
+type BotRepository interface {
+	Create(ctx context.Context, bot *model.Bot) (int64, error)
+	Get(ctx context.Context, id int64) (*model.Bot, error)
+	// GetByKey returns the bot of a live key.
+	GetByKey(ctx context.Context, keyHash string) (*model.Bot, error)
+	CreateKey(ctx context.Context, botID int64, keyHash string) error
+	RevokeKeys(ctx context.Context, botID int64) error
+}
+
+type ScheduledRepository interface {
+	Create(ctx context.Context, message *model.ScheduledMessage) (int64, error)
+	Get(ctx context.Context, id int64) (*model.ScheduledMessage, error)
+	List(ctx context.Context, filter *model.ScheduledFilter) ([]*model.ScheduledMessage, error)
+	// ListDue locks the due messages until the end of the transaction.
+	ListDue(ctx context.Context, limit uint64) ([]*model.ScheduledMessage, error)
+	Delete(ctx context.Context, id int64) error
+	Fail(ctx context.Context, id int64, reason string) error
+}
+
+// DeliveryRepository keeps the delivered cursors of the chat members.
+type DeliveryRepository interface {
+	Ack(ctx context.Context, ack *model.DeliveryAck) error
+	// MarkRead moves the read cursor of the member, and the delivered one with it.
+	MarkRead(ctx context.Context, read *model.DeliveryAck) error
+	// Get returns the cursors of the member, zero ones if none.
+	Get(ctx context.Context, chatID int64, username string) (*model.DeliveryCursor, error)
+	List(ctx context.Context, chatID int64) ([]*model.DeliveryCursor, error)
+}
+
+// SessionRepository stores the streams connected to every instance.
+type SessionRepository interface {
+	Create(ctx context.Context, session *model.Session) error
+	// Touch moves the last activity of the session.
+	Touch(ctx context.Context, id string, lastActiveAt time.Time) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, filter *model.SessionFilter) ([]*model.Session, error)
 }
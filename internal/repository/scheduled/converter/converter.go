@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"github.com/Mobo140/chat/internal/model"
+	modelRepo "github.com/Mobo140/chat/internal/repository/scheduled/model"
+)
+
+func ToScheduledMessageFromRepo(message *modelRepo.ScheduledMessage) *model.ScheduledMessage {
+	res := &model.ScheduledMessage{
+		ID:     message.ID,
+		ChatID: message.ChatID,
+		Message: model.Message{
+			From: message.From,
+			Text: message.Text,
+		},
+		ScheduledAt: message.ScheduledAt,
+		CreatedAt:   message.CreatedAt,
+	}
+
+	if message.ReplyToID != nil {
+		res.Message.ReplyToID = *message.ReplyToID
+	}
+
+	if message.Error != nil {
+		res.Error = *message.Error
+	}
+
+	return res
+}
+
+func ToScheduledMessagesFromRepo(messages []*modelRepo.ScheduledMessage) []*model.ScheduledMessage {
+	res := make([]*model.ScheduledMessage, 0, len(messages))
+	for _, message := range messages {
+		res = append(res, ToScheduledMessageFromRepo(message))
+	}
+
+	return res
+}
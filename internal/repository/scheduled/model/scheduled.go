@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type ScheduledMessage struct {
+	ID          int64     `db:"id"`
+	ChatID      int64     `db:"chat_id"`
+	From        string    `db:"from_user"`
+	Text        string    `db:"text"`
+	ReplyToID   *int64    `db:"reply_to_message_id"`
+	ScheduledAt time.Time `db:"scheduled_at"`
+	CreatedAt   time.Time `db:"created_at"`
+	Error       *string   `db:"error"`
+}
@@ -0,0 +1,216 @@
+package scheduled
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/repository/scheduled/converter"
+	modelRepo "github.com/Mobo140/chat/internal/repository/scheduled/model"
+	"github.com/Mobo140/platform_common/pkg/db"
+	"github.com/jackc/pgx/v4"
+)
+
+var _ repository.ScheduledRepository = (*scheduledRepo)(nil)
+
+const (
+	tableName = "scheduled_messages"
+
+	idColumn          = "id"
+	chatIDColumn      = "chat_id"
+	fromUserColumn    = "from_user"
+	textColumn        = "text"
+	replyToColumn     = "reply_to_message_id"
+	scheduledAtColumn = "scheduled_at"
+	createdAtColumn   = "created_at"
+	errorColumn       = "error"
+)
+
+var columns = []string{
+	idColumn,
+	chatIDColumn,
+	fromUserColumn,
+	textColumn,
+	replyToColumn,
+	scheduledAtColumn,
+	createdAtColumn,
+	errorColumn,
+}
+
+type scheduledRepo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) *scheduledRepo { //nolint:revive // it's ok
+	return &scheduledRepo{db: db}
+}
+
+func (r *scheduledRepo) Create(ctx context.Context, message *model.ScheduledMessage) (int64, error) {
+	var replyToID *int64
+	if message.Message.ReplyToID != 0 {
+		replyToID = &message.Message.ReplyToID
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, fromUserColumn, textColumn, replyToColumn, scheduledAtColumn).
+		Values(message.ChatID, message.Message.From, message.Message.Text, replyToID, message.ScheduledAt).
+		Suffix("RETURNING " + idColumn)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "scheduled_repository.create",
+	}
+
+	var id int64
+
+	err = r.db.DB().ScanOneContext(ctx, &id, q, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert scheduled message: %v", err)
+	}
+
+	return id, nil
+}
+
+func (r *scheduledRepo) Get(ctx context.Context, id int64) (*model.ScheduledMessage, error) {
+	builderSelect := sq.Select(columns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id}).
+		Limit(1)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "scheduled_repository.get",
+	}
+
+	var message modelRepo.ScheduledMessage
+
+	err = r.db.DB().ScanOneContext(ctx, &message, q, args...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrScheduledNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to select scheduled message: %v", err)
+	}
+
+	return converter.ToScheduledMessageFromRepo(&message), nil
+}
+
+// List returns the scheduled messages of the user in the chat, the next first.
+func (r *scheduledRepo) List(ctx context.Context, filter *model.ScheduledFilter) ([]*model.ScheduledMessage, error) {
+	builderSelect := sq.Select(columns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: filter.ChatID, fromUserColumn: filter.Username}).
+		OrderBy(scheduledAtColumn, idColumn)
+
+	return r.list(ctx, "scheduled_repository.list", builderSelect)
+}
+
+// ListDue returns the pending messages due by now and locks them until the
+// end of the transaction. Messages locked by another scheduler are skipped,
+// so every replica may run one.
+func (r *scheduledRepo) ListDue(ctx context.Context, limit uint64) ([]*model.ScheduledMessage, error) {
+	builderSelect := sq.Select(columns...).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{errorColumn: nil}).
+		Where(sq.Expr(scheduledAtColumn+" <= NOW()")).
+		OrderBy(scheduledAtColumn, idColumn).
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	return r.list(ctx, "scheduled_repository.list_due", builderSelect)
+}
+
+func (r *scheduledRepo) Delete(ctx context.Context, id int64) error {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "scheduled_repository.delete",
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete scheduled message: %v", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrScheduledNotFound
+	}
+
+	return nil
+}
+
+// Fail keeps the message with the reason it could not be sent.
+func (r *scheduledRepo) Fail(ctx context.Context, id int64, reason string) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(errorColumn, reason).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "scheduled_repository.fail",
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to mark scheduled message failed: %v", err)
+	}
+
+	return nil
+}
+
+func (r *scheduledRepo) list(
+	ctx context.Context,
+	name string,
+	builderSelect sq.SelectBuilder,
+) ([]*model.ScheduledMessage, error) {
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     name,
+	}
+
+	var messages []*modelRepo.ScheduledMessage
+
+	err = r.db.DB().ScanAllContext(ctx, &messages, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select scheduled messages: %v", err)
+	}
+
+	return converter.ToScheduledMessagesFromRepo(messages), nil
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+type Session struct {
+	ID           string    `db:"id"`
+	ChatID       int64     `db:"chat_id"`
+	Username     string    `db:"username"`
+	DeviceID     string    `db:"device_id"`
+	ConnectedAt  time.Time `db:"connected_at"`
+	LastActiveAt time.Time `db:"last_active_at"`
+}
@@ -0,0 +1,154 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	modelRepo "github.com/Mobo140/chat/internal/repository/session/model"
+	"github.com/Mobo140/platform_common/pkg/db"
+)
+
+var _ repository.SessionRepository = (*sessionRepo)(nil)
+
+const (
+	tableName          = "chat_sessions"
+	idColumn           = "id"
+	chatIDColumn       = "chat_id"
+	usernameColumn     = "username"
+	deviceIDColumn     = "device_id"
+	connectedAtColumn  = "connected_at"
+	lastActiveAtColumn = "last_active_at"
+)
+
+type sessionRepo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) *sessionRepo { //nolint:revive // it's ok
+	return &sessionRepo{db: db}
+}
+
+func (r *sessionRepo) Create(ctx context.Context, session *model.Session) error {
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(idColumn, chatIDColumn, usernameColumn, deviceIDColumn, connectedAtColumn, lastActiveAtColumn).
+		Values(session.ID, session.ChatID, session.Username, session.DeviceID, session.ConnectedAt,
+			session.LastActiveAt)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "session_repository.create",
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert session: %v", err)
+	}
+
+	return nil
+}
+
+func (r *sessionRepo) Touch(ctx context.Context, id string, lastActiveAt time.Time) error {
+	builderUpdate := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(lastActiveAtColumn, lastActiveAt).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "session_repository.touch",
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update session: %v", err)
+	}
+
+	return nil
+}
+
+func (r *sessionRepo) Delete(ctx context.Context, id string) error {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "session_repository.delete",
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %v", err)
+	}
+
+	return nil
+}
+
+// List returns the sessions of the filter from the oldest.
+func (r *sessionRepo) List(ctx context.Context, filter *model.SessionFilter) ([]*model.Session, error) {
+	where := sq.And{
+		sq.Eq{usernameColumn: filter.Username},
+		sq.GtOrEq{lastActiveAtColumn: filter.ActiveSince},
+	}
+	if filter.ChatID != 0 {
+		where = append(where, sq.Eq{chatIDColumn: filter.ChatID})
+	}
+
+	builderSelect := sq.Select(idColumn, chatIDColumn, usernameColumn, deviceIDColumn, connectedAtColumn,
+		lastActiveAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(where).
+		OrderBy(connectedAtColumn, idColumn)
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "session_repository.list",
+	}
+
+	var sessions []*modelRepo.Session
+
+	err = r.db.DB().ScanAllContext(ctx, &sessions, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select sessions: %v", err)
+	}
+
+	res := make([]*model.Session, 0, len(sessions))
+	for _, session := range sessions {
+		res = append(res, &model.Session{
+			ID:           session.ID,
+			ChatID:       session.ChatID,
+			Username:     session.Username,
+			DeviceID:     session.DeviceID,
+			ConnectedAt:  session.ConnectedAt,
+			LastActiveAt: session.LastActiveAt,
+		})
+	}
+
+	return res, nil
+}
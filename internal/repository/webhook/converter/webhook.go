@@ -0,0 +1,85 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	modelRepo "github.com/Mobo140/chat/internal/repository/webhook/model"
+)
+
+func ToWebhookFromRepo(webhook *modelRepo.Webhook) *model.Webhook {
+	res := &model.Webhook{
+		ID:         webhook.ID,
+		URL:        webhook.URL,
+		Secret:     webhook.Secret,
+		EventTypes: make([]model.ChatEventType, 0, len(webhook.EventTypes)),
+		CreatedAt:  webhook.CreatedAt,
+	}
+
+	if webhook.ChatID != nil {
+		res.ChatID = *webhook.ChatID
+	}
+
+	for _, eventType := range webhook.EventTypes {
+		res.EventTypes = append(res.EventTypes, model.ChatEventType(eventType))
+	}
+
+	return res
+}
+
+func ToWebhooksFromRepo(webhooks []*modelRepo.Webhook) []*model.Webhook {
+	res := make([]*model.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		res = append(res, ToWebhookFromRepo(webhook))
+	}
+
+	return res
+}
+
+// ToEventTypesFromService returns the event types as stored in the TEXT[] column.
+func ToEventTypesFromService(eventTypes []model.ChatEventType) []string {
+	res := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		res = append(res, string(eventType))
+	}
+
+	return res
+}
+
+func ToDeliveriesFromRepo(deliveries []*modelRepo.Delivery) []*model.WebhookDelivery {
+	res := make([]*model.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		res = append(res, &model.WebhookDelivery{
+			ID:        delivery.ID,
+			WebhookID: delivery.WebhookID,
+			EventID:   delivery.EventID,
+			EventType: model.ChatEventType(delivery.EventType),
+			Payload:   delivery.Payload,
+			Attempts:  delivery.Attempts,
+			URL:       delivery.URL,
+			Secret:    delivery.Secret,
+		})
+	}
+
+	return res
+}
+
+func ToAttemptsFromRepo(attempts []*modelRepo.Attempt) []*model.WebhookAttempt {
+	res := make([]*model.WebhookAttempt, 0, len(attempts))
+	for _, attempt := range attempts {
+		res = append(res, &model.WebhookAttempt{
+			ID:         attempt.ID,
+			WebhookID:  attempt.WebhookID,
+			EventID:    attempt.EventID,
+			EventType:  model.ChatEventType(attempt.EventType),
+			Attempt:    attempt.Attempt,
+			StatusCode: attempt.StatusCode,
+			Error:      attempt.Error,
+			Duration:   time.Duration(attempt.DurationMs) * time.Millisecond,
+			DeadLetter: attempt.DeadLetter,
+			CreatedAt:  attempt.CreatedAt,
+		})
+	}
+
+	return res
+}
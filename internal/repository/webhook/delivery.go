@@ -0,0 +1,311 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository/webhook/converter"
+	modelRepo "github.com/Mobo140/chat/internal/repository/webhook/model"
+	"github.com/Mobo140/platform_common/pkg/db"
+)
+
+const (
+	deliveriesTable  = "webhook_deliveries"
+	deadLettersTable = "webhook_dead_letters"
+	logsTable        = "webhook_delivery_logs"
+
+	webhookIDColumn     = "webhook_id"
+	eventIDColumn       = "event_id"
+	eventTypeColumn     = "event_type"
+	payloadColumn       = "payload"
+	attemptsColumn      = "attempts"
+	nextAttemptAtColumn = "next_attempt_at"
+	lastErrorColumn     = "last_error"
+	attemptColumn       = "attempt"
+	statusCodeColumn    = "status_code"
+	errorColumn         = "error"
+	durationColumn      = "duration_ms"
+	deadLetterColumn    = "dead_letter"
+)
+
+// CreateDeliveries queues the deliveries. A delivery of an event that is
+// already queued for the webhook is skipped, so publishing an event again
+// does not deliver it twice.
+func (r *webhookRepo) CreateDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	builderInsert := sq.Insert(deliveriesTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(webhookIDColumn, eventIDColumn, eventTypeColumn, payloadColumn).
+		Suffix("ON CONFLICT (" + webhookIDColumn + ", " + eventIDColumn + ") DO NOTHING")
+
+	for _, delivery := range deliveries {
+		builderInsert = builderInsert.Values(
+			delivery.WebhookID,
+			delivery.EventID,
+			string(delivery.EventType),
+			string(delivery.Payload),
+		)
+	}
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "webhook_repository.create_deliveries",
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert deliveries: %v", err)
+	}
+
+	return nil
+}
+
+// ListDue returns the deliveries whose attempt is due and locks them until
+// the end of the transaction. Deliveries locked by another sender are skipped.
+func (r *webhookRepo) ListDue(ctx context.Context, limit uint64) ([]*model.WebhookDelivery, error) {
+	builderSelect := sq.Select(
+		"d."+idColumn,
+		"d."+webhookIDColumn,
+		"d."+eventIDColumn,
+		"d."+eventTypeColumn,
+		"d."+payloadColumn,
+		"d."+attemptsColumn,
+		"w."+urlColumn,
+		"w."+secretColumn,
+	).
+		From(deliveriesTable + " d").
+		Join(tableName + " w ON w." + idColumn + " = d." + webhookIDColumn).
+		PlaceholderFormat(sq.Dollar).
+		Where("d." + nextAttemptAtColumn + " <= NOW()").
+		OrderBy("d." + idColumn).
+		Limit(limit).
+		Suffix("FOR UPDATE OF d SKIP LOCKED")
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "webhook_repository.list_due",
+	}
+
+	var deliveries []*modelRepo.Delivery
+
+	err = r.db.DB().ScanAllContext(ctx, &deliveries, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select deliveries: %v", err)
+	}
+
+	return converter.ToDeliveriesFromRepo(deliveries), nil
+}
+
+// Claim moves the next attempt of the deliveries lease ahead, the senders
+// skip them until the attempt is recorded or the lease is over.
+func (r *webhookRepo) Claim(ctx context.Context, ids []int64, lease time.Duration) error {
+	builderUpdate := sq.Update(deliveriesTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(nextAttemptAtColumn, sq.Expr("NOW() + ? * INTERVAL '1 millisecond'", lease.Milliseconds())).
+		Where(sq.Eq{idColumn: ids})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "webhook_repository.claim",
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to claim deliveries: %v", err)
+	}
+
+	return nil
+}
+
+// Reschedule records a failed attempt and moves the next one delay ahead.
+func (r *webhookRepo) Reschedule(ctx context.Context, id int64, attempts int, delay time.Duration) error {
+	builderUpdate := sq.Update(deliveriesTable).
+		PlaceholderFormat(sq.Dollar).
+		Set(attemptsColumn, attempts).
+		Set(nextAttemptAtColumn, sq.Expr("NOW() + ? * INTERVAL '1 millisecond'", delay.Milliseconds())).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderUpdate.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "webhook_repository.reschedule",
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to reschedule delivery: %v", err)
+	}
+
+	return nil
+}
+
+// Complete removes a delivered delivery.
+func (r *webhookRepo) Complete(ctx context.Context, id int64) error {
+	return r.deleteDelivery(ctx, "webhook_repository.complete", id)
+}
+
+// DeadLetter moves the delivery to the dead letters, it is not retried anymore.
+func (r *webhookRepo) DeadLetter(ctx context.Context, delivery *model.WebhookDelivery, lastError string) error {
+	builderInsert := sq.Insert(deadLettersTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(webhookIDColumn, eventIDColumn, eventTypeColumn, payloadColumn, attemptsColumn, lastErrorColumn).
+		Values(
+			delivery.WebhookID,
+			delivery.EventID,
+			string(delivery.EventType),
+			string(delivery.Payload),
+			delivery.Attempts,
+			lastError,
+		)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "webhook_repository.dead_letter",
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert dead letter: %v", err)
+	}
+
+	return r.deleteDelivery(ctx, "webhook_repository.delete_dead_letter", delivery.ID)
+}
+
+func (r *webhookRepo) deleteDelivery(ctx context.Context, name string, id int64) error {
+	builderDelete := sq.Delete(deliveriesTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     name,
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete delivery: %v", err)
+	}
+
+	return nil
+}
+
+func (r *webhookRepo) LogAttempt(ctx context.Context, attempt *model.WebhookAttempt) error {
+	builderInsert := sq.Insert(logsTable).
+		PlaceholderFormat(sq.Dollar).
+		Columns(
+			webhookIDColumn,
+			eventIDColumn,
+			eventTypeColumn,
+			attemptColumn,
+			statusCodeColumn,
+			errorColumn,
+			durationColumn,
+			deadLetterColumn,
+		).
+		Values(
+			attempt.WebhookID,
+			attempt.EventID,
+			string(attempt.EventType),
+			attempt.Attempt,
+			attempt.StatusCode,
+			attempt.Error,
+			attempt.Duration.Milliseconds(),
+			attempt.DeadLetter,
+		)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "webhook_repository.log_attempt",
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert delivery log: %v", err)
+	}
+
+	return nil
+}
+
+// ListAttempts returns the delivery log of a webhook from the newest attempt.
+func (r *webhookRepo) ListAttempts(ctx context.Context, filter *model.WebhookAttemptFilter) ([]*model.WebhookAttempt, error) {
+	builderSelect := sq.Select(
+		idColumn,
+		webhookIDColumn,
+		eventIDColumn,
+		eventTypeColumn,
+		attemptColumn,
+		statusCodeColumn,
+		errorColumn,
+		durationColumn,
+		deadLetterColumn,
+		createdAtColumn,
+	).
+		From(logsTable).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{webhookIDColumn: filter.WebhookID}).
+		OrderBy(idColumn + " DESC").
+		Limit(filter.Limit)
+
+	if filter.BeforeID > 0 {
+		builderSelect = builderSelect.Where(sq.Lt{idColumn: filter.BeforeID})
+	}
+
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "webhook_repository.list_attempts",
+	}
+
+	var attempts []*modelRepo.Attempt
+
+	err = r.db.DB().ScanAllContext(ctx, &attempts, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select delivery logs: %v", err)
+	}
+
+	return converter.ToAttemptsFromRepo(attempts), nil
+}
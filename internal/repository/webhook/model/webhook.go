@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+type Webhook struct {
+	ID         int64     `db:"id"`
+	ChatID     *int64    `db:"chat_id"`
+	URL        string    `db:"url"`
+	Secret     string    `db:"secret"`
+	EventTypes []string  `db:"event_types"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+type Delivery struct {
+	ID        int64  `db:"id"`
+	WebhookID int64  `db:"webhook_id"`
+	EventID   int64  `db:"event_id"`
+	EventType string `db:"event_type"`
+	Payload   []byte `db:"payload"`
+	Attempts  int    `db:"attempts"`
+	URL       string `db:"url"`
+	Secret    string `db:"secret"`
+}
+
+type Attempt struct {
+	ID         int64     `db:"id"`
+	WebhookID  int64     `db:"webhook_id"`
+	EventID    int64     `db:"event_id"`
+	EventType  string    `db:"event_type"`
+	Attempt    int       `db:"attempt"`
+	StatusCode int       `db:"status_code"`
+	Error      string    `db:"error"`
+	DurationMs int64     `db:"duration_ms"`
+	DeadLetter bool      `db:"dead_letter"`
+	CreatedAt  time.Time `db:"created_at"`
+}
@@ -0,0 +1,139 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/repository/webhook/converter"
+	modelRepo "github.com/Mobo140/chat/internal/repository/webhook/model"
+	"github.com/Mobo140/platform_common/pkg/db"
+)
+
+var _ repository.WebhookRepository = (*webhookRepo)(nil)
+
+const (
+	tableName = "webhooks"
+
+	idColumn         = "id"
+	chatIDColumn     = "chat_id"
+	urlColumn        = "url"
+	secretColumn     = "secret"
+	eventTypesColumn = "event_types"
+	createdAtColumn  = "created_at"
+)
+
+type webhookRepo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) *webhookRepo { //nolint:revive // it's ok
+	return &webhookRepo{db: db}
+}
+
+func (r *webhookRepo) Create(ctx context.Context, webhook *model.Webhook) (int64, error) {
+	var chatID *int64
+	if webhook.ChatID != 0 {
+		chatID = &webhook.ChatID
+	}
+
+	builderInsert := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, urlColumn, secretColumn, eventTypesColumn).
+		Values(chatID, webhook.URL, webhook.Secret, converter.ToEventTypesFromService(webhook.EventTypes)).
+		Suffix("RETURNING " + idColumn)
+
+	query, args, err := builderInsert.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "webhook_repository.create",
+	}
+
+	var id int64
+
+	err = r.db.DB().ScanOneContext(ctx, &id, q, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert webhook: %v", err)
+	}
+
+	return id, nil
+}
+
+// List returns the webhooks of the chat, all of them if chatID is zero.
+func (r *webhookRepo) List(ctx context.Context, chatID int64) ([]*model.Webhook, error) {
+	builderSelect := r.selectWebhooks()
+	if chatID != 0 {
+		builderSelect = builderSelect.Where(sq.Eq{chatIDColumn: chatID})
+	}
+
+	return r.list(ctx, "webhook_repository.list", builderSelect)
+}
+
+// ListSubscribed returns the webhooks of the chat and the ones of every chat.
+func (r *webhookRepo) ListSubscribed(ctx context.Context, chatID int64) ([]*model.Webhook, error) {
+	builderSelect := r.selectWebhooks().
+		Where(sq.Or{sq.Eq{chatIDColumn: chatID}, sq.Eq{chatIDColumn: nil}})
+
+	return r.list(ctx, "webhook_repository.list_subscribed", builderSelect)
+}
+
+func (r *webhookRepo) Delete(ctx context.Context, id int64) error {
+	builderDelete := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builderDelete.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     "webhook_repository.delete",
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %v", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (r *webhookRepo) selectWebhooks() sq.SelectBuilder {
+	return sq.Select(idColumn, chatIDColumn, urlColumn, secretColumn, eventTypesColumn, createdAtColumn).
+		From(tableName).
+		PlaceholderFormat(sq.Dollar).
+		OrderBy(idColumn)
+}
+
+func (r *webhookRepo) list(ctx context.Context, name string, builderSelect sq.SelectBuilder) ([]*model.Webhook, error) {
+	query, args, err := builderSelect.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	q := db.Query{
+		QueryRow: query,
+		Name:     name,
+	}
+
+	var webhooks []*modelRepo.Webhook
+
+	err = r.db.DB().ScanAllContext(ctx, &webhooks, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select webhooks: %v", err)
+	}
+
+	return converter.ToWebhooksFromRepo(webhooks), nil
+}
@@ -0,0 +1,133 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/service"
+	"github.com/Mobo140/platform_common/pkg/db"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"go.uber.org/zap"
+)
+
+var _ service.AuditChainer = (*chainer)(nil)
+
+// chainer links the committed audit entries to the hash chain, keyed with
+// the chain key. The requests only insert their entries, the chainers of the
+// instances take turns on the chain lock in short transactions of their own.
+type chainer struct {
+	logRepository repository.LogRepository
+	txManager     db.TxManager
+	key           []byte
+	batchSize     uint64
+	interval      time.Duration
+}
+
+func NewChainer( //nolint:revive // it's ok
+	logRepository repository.LogRepository,
+	txManager db.TxManager,
+	key []byte,
+	batchSize uint64,
+	interval time.Duration,
+) *chainer {
+	return &chainer{
+		logRepository: logRepository,
+		txManager:     txManager,
+		key:           key,
+		batchSize:     batchSize,
+		interval:      interval,
+	}
+}
+
+// Run chains the entries every interval until the context is done.
+func (c *chainer) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		err := c.Chain(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Error("failed to chain audit logs", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Chain links every entry committed so far, a batch per transaction.
+func (c *chainer) Chain(ctx context.Context) error {
+	for {
+		n, err := c.chainBatch(ctx)
+		if err != nil {
+			return err
+		}
+
+		if uint64(n) < c.batchSize {
+			return nil
+		}
+	}
+}
+
+func (c *chainer) chainBatch(ctx context.Context) (int, error) {
+	var chained int
+	err := c.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		errTx := c.logRepository.LockChain(ctx)
+		if errTx != nil {
+			return errTx
+		}
+
+		var (
+			seq      int64
+			prevHash string
+		)
+
+		last, errTx := c.logRepository.LastChained(ctx)
+		switch {
+		case errors.Is(errTx, model.ErrLogNotFound):
+		case errTx != nil:
+			return errTx
+		default:
+			seq, prevHash = last.ChainSeq, last.Hash
+		}
+
+		entries, errTx := c.logRepository.ListUnchained(ctx, c.batchSize)
+		if errTx != nil {
+			return errTx
+		}
+
+		for _, entry := range entries {
+			seq++
+			entry.ChainSeq = seq
+			entry.PrevHash = prevHash
+
+			entry.Hash, errTx = entry.ComputeHash(c.key)
+			if errTx != nil {
+				return fmt.Errorf("failed to hash log %d: %v", entry.ID, errTx)
+			}
+
+			errTx = c.logRepository.Link(ctx, entry)
+			if errTx != nil {
+				return errTx
+			}
+
+			prevHash = entry.Hash
+		}
+
+		chained = len(entries)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return chained, nil
+}
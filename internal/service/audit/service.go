@@ -0,0 +1,136 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/service"
+)
+
+var _ service.AuditService = (*serv)(nil)
+
+const chainBatchSize = 1000
+
+type serv struct {
+	logRepository repository.LogRepository
+	key           []byte
+}
+
+func NewService(logRepository repository.LogRepository, key []byte) *serv { //nolint:revive // it's ok
+	return &serv{
+		logRepository: logRepository,
+		key:           key,
+	}
+}
+
+// Verify walks the audit log hash chain and reports the first broken link,
+// the hashes are checked with the chain key.
+// The chain may start after entries removed by the retention: its first
+// entry must then be vouched for by a purge record, written before them.
+func (s *serv) Verify(ctx context.Context) (*model.ChainReport, error) {
+	report := &model.ChainReport{}
+
+	var (
+		afterSeq int64
+		prevHash string
+		chained  bool
+		first    *model.LogEntry
+		vouched  bool
+	)
+
+	for {
+		entries, err := s.logRepository.ListChain(ctx, afterSeq, chainBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			afterSeq = entry.ChainSeq
+
+			// Entries written before the keyed chain was introduced have no hash.
+			if !chained && entry.Hash == "" {
+				report.Unchained++
+
+				continue
+			}
+
+			if !chained {
+				chained = true
+				first = entry
+				prevHash = entry.PrevHash
+				vouched = entry.PrevHash == ""
+			}
+
+			if entry.PrevHash != prevHash {
+				report.BrokenID = entry.ID
+				report.Reason = "previous hash does not match the previous entry"
+
+				return report, nil
+			}
+
+			hash, err := entry.ComputeHash(s.key)
+			if err != nil {
+				return nil, fmt.Errorf("failed to hash log %d: %v", entry.ID, err)
+			}
+
+			if hash != entry.Hash {
+				report.BrokenID = entry.ID
+				report.Reason = "content does not match the hash"
+
+				return report, nil
+			}
+
+			if !vouched && vouches(entry, first) {
+				vouched = true
+				report.PurgedUpTo = detailInt(entry.Details, model.PurgeUpToDetail)
+			}
+
+			prevHash = entry.Hash
+			report.Checked++
+		}
+
+		if len(entries) < chainBatchSize {
+			break
+		}
+	}
+
+	if chained && !vouched {
+		report.BrokenID = first.ID
+		report.Reason = "entries before it were removed without a purge record"
+	}
+
+	return report, nil
+}
+
+// vouches reports whether the entry is a purge record of the entries before
+// first: the purge is either complete, first follows the last purged entry,
+// or still in progress, first is one of the purged entries.
+func vouches(entry *model.LogEntry, first *model.LogEntry) bool {
+	if entry.Action != model.LogActionPurgeLogs {
+		return false
+	}
+
+	anchor, _ := entry.Details[model.PurgeAnchorDetail].(string)
+
+	return anchor == first.PrevHash || first.ChainSeq <= detailInt(entry.Details, model.PurgeUpToDetail)
+}
+
+// detailInt reads an integer detail, decoded as a json.Number from the database.
+func detailInt(details model.LogDetails, key string) int64 {
+	switch value := details[key].(type) {
+	case json.Number:
+		n, err := value.Int64()
+		if err != nil {
+			return 0
+		}
+
+		return n
+	case int64:
+		return value
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	repositoryMocks "github.com/Mobo140/chat/internal/repository/mocks"
+	auditService "github.com/Mobo140/chat/internal/service/audit"
+	repositoryTx "github.com/Mobo140/platform_common/pkg/db"
+	dbTxMocks "github.com/Mobo140/platform_common/pkg/db/mocks"
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChain(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+
+		repositoryErr = fmt.Errorf("repository error")
+		key           = []byte(gofakeit.UUID())
+
+		// unchained returns count entries as inserted by the requests.
+		unchained = func(firstID int64, count int) []*model.LogEntry {
+			entries := make([]*model.LogEntry, 0, count)
+			for i := 0; i < count; i++ {
+				entries = append(entries, &model.LogEntry{
+					ID:        firstID + int64(i),
+					ChatID:    gofakeit.Int64(),
+					Action:    model.LogActionSendMessage,
+					Actor:     gofakeit.Username(),
+					CreatedAt: time.Now(),
+				})
+			}
+
+			return entries
+		}
+	)
+
+	tests := []struct {
+		name    string
+		last    *model.LogEntry
+		batches [][]*model.LogEntry
+		linkErr error
+		want    []int64
+		err     error
+	}{
+		{
+			name:    "first entries",
+			batches: [][]*model.LogEntry{unchained(1, 2)},
+			want:    []int64{1, 2},
+		},
+		{
+			name:    "after the last chained entry in batches",
+			last:    &model.LogEntry{ID: 10, ChainSeq: 8, Hash: "last"},
+			batches: [][]*model.LogEntry{unchained(12, 2), unchained(11, 1)},
+			want:    []int64{9, 10, 11},
+		},
+		{
+			name:    "link error",
+			batches: [][]*model.LogEntry{unchained(1, 2)},
+			linkErr: repositoryErr,
+			err:     repositoryErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+
+			var (
+				locked bool
+				last   = tt.last
+				seqs   []int64
+			)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				locked = false
+
+				return f(ctx)
+			})
+			logRepo.LockChainMock.Set(func(_ context.Context) error {
+				locked = true
+
+				return nil
+			})
+			logRepo.LastChainedMock.Set(func(_ context.Context) (*model.LogEntry, error) {
+				require.True(t, locked)
+
+				if last == nil {
+					return nil, model.ErrLogNotFound
+				}
+
+				return last, nil
+			})
+
+			batches := tt.batches
+			logRepo.ListUnchainedMock.Set(func(_ context.Context, limit uint64) ([]*model.LogEntry, error) {
+				require.EqualValues(t, 2, limit)
+
+				if len(batches) == 0 {
+					return nil, nil
+				}
+
+				batch := batches[0]
+				batches = batches[1:]
+
+				return batch, nil
+			})
+
+			prevHash := ""
+			if last != nil {
+				prevHash = last.Hash
+			}
+
+			logRepo.LinkMock.Set(func(_ context.Context, entry *model.LogEntry) error {
+				if tt.linkErr != nil {
+					return tt.linkErr
+				}
+
+				require.Equal(t, prevHash, entry.PrevHash)
+
+				hash, err := entry.ComputeHash(key)
+				require.NoError(t, err)
+				require.Equal(t, hash, entry.Hash)
+
+				prevHash = entry.Hash
+				last = entry
+				seqs = append(seqs, entry.ChainSeq)
+
+				return nil
+			})
+
+			chainer := auditService.NewChainer(logRepo, txManager, key, 2, time.Hour)
+
+			err := chainer.Chain(ctxValue)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, seqs)
+		})
+	}
+}
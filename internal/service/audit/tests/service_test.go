@@ -0,0 +1,204 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	repositoryMocks "github.com/Mobo140/chat/internal/repository/mocks"
+	auditService "github.com/Mobo140/chat/internal/service/audit"
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		repositoryErr = fmt.Errorf("repository error")
+		key           = []byte(gofakeit.UUID())
+
+		// rechain hashes the entries again with the key, as a forger without
+		// the chain key would.
+		rechain = func(entries []*model.LogEntry, key []byte) []*model.LogEntry {
+			prevHash := ""
+			for _, entry := range entries[1:] {
+				entry.PrevHash = prevHash
+				entry.Hash, _ = entry.ComputeHash(key)
+				prevHash = entry.Hash
+			}
+
+			return entries
+		}
+
+		// chain builds a legacy entry followed by three chained ones.
+		chain = func(t *testing.T) []*model.LogEntry {
+			entries := []*model.LogEntry{
+				{
+					ID:       1,
+					ChatID:   1,
+					Action:   model.LogActionUnknown,
+					Details:  model.LogDetails{"activity": "Create chat"},
+					ChainSeq: 1,
+				},
+			}
+
+			prevHash := ""
+			for id := int64(2); id <= 4; id++ {
+				entry := &model.LogEntry{
+					ID:        id,
+					ChatID:    1,
+					MessageID: id * 10,
+					Action:    model.LogActionSendMessage,
+					Actor:     gofakeit.Username(),
+					Details:   model.LogDetails{"text": gofakeit.Color(), "reply_to_message_id": int64(0)},
+					TraceID:   gofakeit.UUID(),
+					CreatedAt: time.Now(),
+					ChainSeq:  id,
+					PrevHash:  prevHash,
+				}
+
+				hash, err := entry.ComputeHash(key)
+				require.NoError(t, err)
+
+				entry.Hash = hash
+				prevHash = hash
+				entries = append(entries, entry)
+			}
+
+			return entries
+		}
+	)
+
+	tests := []struct {
+		name   string
+		tamper func(entries []*model.LogEntry) []*model.LogEntry
+		want   *model.ChainReport
+		err    error
+	}{
+		{
+			name:   "intact chain",
+			tamper: func(entries []*model.LogEntry) []*model.LogEntry { return entries },
+			want:   &model.ChainReport{Checked: 3, Unchained: 1},
+		},
+		{
+			name: "altered entry",
+			tamper: func(entries []*model.LogEntry) []*model.LogEntry {
+				entries[2].Actor = "intruder"
+
+				return entries
+			},
+			want: &model.ChainReport{
+				Checked:   1,
+				Unchained: 1,
+				BrokenID:  3,
+				Reason:    "content does not match the hash",
+			},
+		},
+		{
+			name: "removed entry",
+			tamper: func(entries []*model.LogEntry) []*model.LogEntry {
+				return append(entries[:2], entries[3])
+			},
+			want: &model.ChainReport{
+				Checked:   1,
+				Unchained: 1,
+				BrokenID:  4,
+				Reason:    "previous hash does not match the previous entry",
+			},
+		},
+		{
+			name: "rehashed entry",
+			tamper: func(entries []*model.LogEntry) []*model.LogEntry {
+				entries[1].Actor = "intruder"
+				entries[1].Hash, _ = entries[1].ComputeHash(key)
+
+				return entries
+			},
+			want: &model.ChainReport{
+				Checked:   1,
+				Unchained: 1,
+				BrokenID:  3,
+				Reason:    "previous hash does not match the previous entry",
+			},
+		},
+		{
+			name: "rechained without the key",
+			tamper: func(entries []*model.LogEntry) []*model.LogEntry {
+				entries[2].Actor = "intruder"
+
+				return rechain(entries, []byte("guessed key"))
+			},
+			want: &model.ChainReport{
+				Unchained: 1,
+				BrokenID:  2,
+				Reason:    "content does not match the hash",
+			},
+		},
+		{
+			name: "purged prefix",
+			tamper: func(entries []*model.LogEntry) []*model.LogEntry {
+				purge := &model.LogEntry{
+					ID:       5,
+					ChainSeq: 5,
+					Action:   model.LogActionPurgeLogs,
+					Actor:    model.RetentionActor,
+					Details: model.LogDetails{
+						model.PurgeUpToDetail:   json.Number("2"),
+						model.PurgeAnchorDetail: entries[1].Hash,
+					},
+					CreatedAt: time.Now(),
+					PrevHash:  entries[3].Hash,
+				}
+				purge.Hash, _ = purge.ComputeHash(key)
+
+				return append(entries[2:], purge)
+			},
+			want: &model.ChainReport{Checked: 3, PurgedUpTo: 2},
+		},
+		{
+			name: "removed prefix",
+			tamper: func(entries []*model.LogEntry) []*model.LogEntry {
+				return entries[2:]
+			},
+			want: &model.ChainReport{
+				Checked:  2,
+				BrokenID: 3,
+				Reason:   "entries before it were removed without a purge record",
+			},
+		},
+		{
+			name:   "logRepo error",
+			tamper: func(_ []*model.LogEntry) []*model.LogEntry { return nil },
+			err:    repositoryErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			if tt.err != nil {
+				logRepo.ListChainMock.Return(nil, tt.err)
+			} else {
+				logRepo.ListChainMock.Expect(ctxValue, 0, 1000).Return(tt.tamper(chain(t)), nil)
+			}
+
+			service := auditService.NewService(logRepo, key)
+
+			report, err := service.Verify(ctxValue)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, report)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	repositoryMocks "github.com/Mobo140/chat/internal/repository/mocks"
+	auditService "github.com/Mobo140/chat/internal/service/audit"
+	repositoryTx "github.com/Mobo140/platform_common/pkg/db"
+	dbTxMocks "github.com/Mobo140/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		count     int
+		batchSize int
+		// failing is the entry the repository refuses.
+		failing int64
+		batches []int
+		written []int64
+	}{
+		{
+			name:      "flush on close",
+			count:     3,
+			batchSize: 10,
+			batches:   []int{3},
+		},
+		{
+			name:      "flush full batches",
+			count:     5,
+			batchSize: 2,
+			batches:   []int{2, 2, 1},
+		},
+		{
+			name:      "nothing to flush",
+			count:     0,
+			batchSize: 2,
+			batches:   nil,
+		},
+		{
+			name:      "write one by one after a batch error",
+			count:     3,
+			batchSize: 10,
+			failing:   2,
+			batches:   []int{2},
+			written:   []int64{1, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+
+			var (
+				mu      sync.Mutex
+				inTx    bool
+				batches []int
+				pending []int64
+				written []int64
+			)
+
+			if tt.count > 0 {
+				txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+					mu.Lock()
+					defer mu.Unlock()
+
+					inTx = true
+					defer func() { inTx = false }()
+
+					batches = append(batches, 0)
+					pending = nil
+
+					err := f(ctx)
+					if err == nil {
+						written = append(written, pending...)
+					}
+
+					return err
+				})
+				logRepo.CreateMock.Set(func(_ context.Context, logEntry *model.LogEntry) error {
+					require.False(t, logEntry.CreatedAt.IsZero())
+
+					if inTx {
+						batches[len(batches)-1]++
+					}
+
+					if logEntry.ChatID == tt.failing {
+						return fmt.Errorf("log error")
+					}
+
+					if inTx {
+						pending = append(pending, logEntry.ChatID)
+					} else {
+						written = append(written, logEntry.ChatID)
+					}
+
+					return nil
+				})
+			}
+
+			writer := auditService.NewWriter(logRepo, txManager, tt.count+1, tt.batchSize, time.Hour)
+
+			var want []int64
+			for i := 1; i <= tt.count; i++ {
+				writer.Write(&model.LogEntry{ChatID: int64(i), Action: model.LogActionGetChat})
+				want = append(want, int64(i))
+			}
+
+			require.NoError(t, writer.Close())
+			if tt.written != nil {
+				want = tt.written
+			}
+
+			require.Equal(t, tt.batches, batches)
+			require.Equal(t, want, written)
+		})
+	}
+}
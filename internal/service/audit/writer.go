@@ -0,0 +1,148 @@
+package audit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/service"
+	"github.com/Mobo140/platform_common/pkg/db"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"go.uber.org/zap"
+)
+
+var _ service.AuditWriter = (*writer)(nil)
+
+// writer buffers audit entries and writes them in batches, one transaction
+// per batch, one by one if the batch fails. When the buffer is full new
+// entries are dropped rather than slowing down the requests.
+type writer struct {
+	logRepository repository.LogRepository
+	txManager     db.TxManager
+	batchSize     int
+	flushInterval time.Duration
+
+	mu      sync.RWMutex
+	closed  bool
+	entries chan *model.LogEntry
+	done    chan struct{}
+}
+
+func NewWriter( //nolint:revive // it's ok
+	logRepository repository.LogRepository,
+	txManager db.TxManager,
+	bufferSize int,
+	batchSize int,
+	flushInterval time.Duration,
+) *writer {
+	w := &writer{
+		logRepository: logRepository,
+		txManager:     txManager,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		entries:       make(chan *model.LogEntry, bufferSize),
+		done:          make(chan struct{}),
+	}
+
+	go w.run()
+
+	return w
+}
+
+// Write queues the entry without blocking. The entry is timestamped now, not
+// when it is written.
+func (w *writer) Write(logEntry *model.LogEntry) {
+	if logEntry.CreatedAt.IsZero() {
+		logEntry.CreatedAt = time.Now()
+	}
+
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.closed {
+		logger.Warn("audit writer is closed, log dropped", zap.String("action", string(logEntry.Action)))
+
+		return
+	}
+
+	select {
+	case w.entries <- logEntry:
+	default:
+		logger.Warn("audit buffer is full, log dropped", zap.String("action", string(logEntry.Action)))
+	}
+}
+
+// Close stops accepting entries and waits until the buffered ones are written.
+func (w *writer) Close() error {
+	w.mu.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.entries)
+	}
+	w.mu.Unlock()
+
+	<-w.done
+
+	return nil
+}
+
+func (w *writer) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*model.LogEntry, 0, w.batchSize)
+	for {
+		select {
+		case logEntry, ok := <-w.entries:
+			if !ok {
+				w.flush(batch)
+
+				return
+			}
+
+			batch = append(batch, logEntry)
+			if len(batch) >= w.batchSize {
+				w.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			w.flush(batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+func (w *writer) flush(batch []*model.LogEntry) {
+	if len(batch) == 0 {
+		return
+	}
+
+	err := w.txManager.ReadCommited(context.Background(), func(ctx context.Context) error {
+		for _, logEntry := range batch {
+			errTx := w.logRepository.Create(ctx, logEntry)
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		return nil
+	})
+	if err == nil {
+		return
+	}
+
+	logger.Warn("failed to write audit logs, writing them one by one", zap.Int("count", len(batch)), zap.Error(err))
+
+	// The batch is rolled back as a whole, only the failing entries are dropped.
+	for _, logEntry := range batch {
+		err = w.logRepository.Create(context.Background(), logEntry)
+		if err != nil {
+			logger.Error("failed to write audit log",
+				zap.String("action", string(logEntry.Action)), zap.Int64("chat_id", logEntry.ChatID), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package bot
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/service"
+	"github.com/Mobo140/platform_common/pkg/db"
+)
+
+var _ service.BotService = (*serv)(nil)
+
+const (
+	// keyPrefix tells the API keys apart from the user tokens.
+	keyPrefix = "cbk_"
+	keySize   = 32
+
+	defaultRateLimit = 60
+)
+
+type serv struct {
+	botRepository repository.BotRepository
+	txManager     db.TxManager
+}
+
+func NewService(botRepository repository.BotRepository, txManager db.TxManager) *serv { //nolint:revive // it's ok
+	return &serv{
+		botRepository: botRepository,
+		txManager:     txManager,
+	}
+}
+
+// Create registers the bot and issues its first key.
+func (s *serv) Create(ctx context.Context, bot *model.Bot) (string, error) {
+	if bot.RateLimit == 0 {
+		bot.RateLimit = defaultRateLimit
+	}
+
+	key, hash, err := newKey()
+	if err != nil {
+		return "", err
+	}
+
+	err = s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		var errTx error
+
+		bot.ID, errTx = s.botRepository.Create(ctx, bot)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.botRepository.CreateKey(ctx, bot.ID, hash)
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
+
+// RotateKey issues a new key and revokes the old ones at once.
+func (s *serv) RotateKey(ctx context.Context, id int64) (string, error) {
+	key, hash, err := newKey()
+	if err != nil {
+		return "", err
+	}
+
+	err = s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		_, errTx := s.botRepository.Get(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.botRepository.RevokeKeys(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.botRepository.CreateKey(ctx, id, hash)
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
+
+// RevokeKeys locks the bot out until a key is rotated in.
+func (s *serv) RevokeKeys(ctx context.Context, id int64) error {
+	_, err := s.botRepository.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.botRepository.RevokeKeys(ctx, id)
+}
+
+func (s *serv) Authenticate(ctx context.Context, apiKey string) (*model.Bot, error) {
+	if !strings.HasPrefix(apiKey, keyPrefix) {
+		return nil, model.ErrInvalidAPIKey
+	}
+
+	return s.botRepository.GetByKey(ctx, HashKey(apiKey))
+}
+
+// HashKey is what is stored of a key. The keys are random, a plain SHA-256
+// is enough to make a leaked table useless.
+func HashKey(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func newKey() (string, string, error) {
+	buf := make([]byte, keySize)
+
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate api key: %v", err)
+	}
+
+	key := keyPrefix + hex.EncodeToString(buf)
+
+	return key, HashKey(key), nil
+}
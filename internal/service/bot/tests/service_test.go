@@ -0,0 +1,204 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Mobo140/chat/internal/model"
+	repositoryMocks "github.com/Mobo140/chat/internal/repository/mocks"
+	botService "github.com/Mobo140/chat/internal/service/bot"
+	repositoryTx "github.com/Mobo140/platform_common/pkg/db"
+	dbTxMocks "github.com/Mobo140/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		id       = int64(3)
+
+		repositoryErr = fmt.Errorf("repository error")
+	)
+
+	tests := []struct {
+		name      string
+		createErr error
+		rateLimit int
+		err       error
+	}{
+		{
+			name:      "success case",
+			rateLimit: 60,
+		},
+		{
+			name:      "name taken",
+			createErr: model.ErrBotExists,
+			err:       model.ErrBotExists,
+		},
+		{
+			name:      "repository error",
+			createErr: repositoryErr,
+			err:       repositoryErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			botRepo := repositoryMocks.NewBotRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+
+			var hash string
+			botRepo.CreateMock.Set(func(_ context.Context, bot *model.Bot) (int64, error) {
+				require.Equal(t, 60, bot.RateLimit)
+
+				return id, tt.createErr
+			})
+			if tt.createErr == nil {
+				botRepo.CreateKeyMock.Set(func(_ context.Context, botID int64, keyHash string) error {
+					require.Equal(t, id, botID)
+					hash = keyHash
+
+					return nil
+				})
+			}
+
+			service := botService.NewService(botRepo, txManager)
+
+			bot := &model.Bot{Name: "deploy"}
+
+			key, err := service.Create(ctxValue, bot)
+			require.Equal(t, tt.err, err)
+			if tt.err == nil {
+				require.Equal(t, id, bot.ID)
+				require.Equal(t, botService.HashKey(key), hash)
+			}
+		})
+	}
+}
+
+func TestRotateKey(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		id       = int64(3)
+	)
+
+	tests := []struct {
+		name   string
+		getErr error
+		err    error
+	}{
+		{
+			name: "success case",
+		},
+		{
+			name:   "bot not found",
+			getErr: model.ErrBotNotFound,
+			err:    model.ErrBotNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			botRepo := repositoryMocks.NewBotRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+			botRepo.GetMock.Expect(ctxValue, id).Return(&model.Bot{ID: id}, tt.getErr)
+			if tt.getErr == nil {
+				botRepo.RevokeKeysMock.Expect(ctxValue, id).Return(nil)
+				botRepo.CreateKeyMock.Return(nil)
+			}
+
+			service := botService.NewService(botRepo, txManager)
+
+			key, err := service.RotateKey(ctxValue, id)
+			require.Equal(t, tt.err, err)
+			if tt.err == nil {
+				require.NotEmpty(t, key)
+			}
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		key      = "cbk_" + strings.Repeat("ab", 32)
+		bot      = &model.Bot{ID: 3, Name: "deploy"}
+	)
+
+	tests := []struct {
+		name   string
+		key    string
+		getErr error
+		want   *model.Bot
+		err    error
+	}{
+		{
+			name: "success case",
+			key:  key,
+			want: bot,
+		},
+		{
+			name:   "revoked key",
+			key:    key,
+			getErr: model.ErrInvalidAPIKey,
+			err:    model.ErrInvalidAPIKey,
+		},
+		{
+			name: "user token",
+			key:  "eyJhbGciOiJIUzI1NiJ9",
+			err:  model.ErrInvalidAPIKey,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			botRepo := repositoryMocks.NewBotRepositoryMock(mc)
+
+			if tt.want != nil || tt.getErr != nil {
+				var got *model.Bot
+				if tt.getErr == nil {
+					got = tt.want
+				}
+				botRepo.GetByKeyMock.Expect(ctxValue, botService.HashKey(tt.key)).Return(got, tt.getErr)
+			}
+
+			service := botService.NewService(botRepo, nil)
+
+			got, err := service.Authenticate(ctxValue, tt.key)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
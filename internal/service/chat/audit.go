@@ -0,0 +1,81 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber/jaeger-client-go"
+)
+
+func (s *serv) ListAuditLog(ctx context.Context, filter *model.LogFilter) ([]*model.LogEntry, error) {
+	if filter.Limit == 0 {
+		filter.Limit = defaultPageSize
+	}
+
+	var entries []*model.LogEntry
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		var errTx error
+
+		entries, errTx = s.logRepository.List(ctx, filter)
+		if errTx != nil {
+			return errTx
+		}
+
+		logEntry := model.LogEntry{
+			ChatID: filter.ChatID,
+			Action: model.LogActionListAuditLog,
+			Details: model.LogDetails{
+				"actor":     filter.Actor,
+				"action":    filter.Action,
+				"before_id": filter.BeforeID,
+			},
+		}
+
+		errTx = s.audit(ctx, &logEntry)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// audit writes the entry to the audit log, tagged with the trace of the request,
+// if the policy audits its action. Mutations are logged in their transaction,
+// reads are handed to the background writer.
+func (s *serv) audit(ctx context.Context, logEntry *model.LogEntry) error {
+	if !s.auditPolicy.Audited(logEntry.Action) {
+		return nil
+	}
+
+	logEntry.TraceID = traceID(ctx)
+
+	if logEntry.Action.IsRead() {
+		s.auditWriter.Write(logEntry)
+
+		return nil
+	}
+
+	return s.logRepository.Create(ctx, logEntry)
+}
+
+func traceID(ctx context.Context) string {
+	span := opentracing.SpanFromContext(ctx)
+	if span == nil {
+		return ""
+	}
+
+	spanContext, ok := span.Context().(jaeger.SpanContext)
+	if !ok {
+		return ""
+	}
+
+	return spanContext.TraceID().String()
+}
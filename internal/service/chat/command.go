@@ -0,0 +1,72 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+// RunCommand runs a slash command for a member of the chat. The command is
+// not stored as a message, the reply goes to the caller only. The writes of
+// the command and its audit entry are in one transaction, an external command
+// runs before it.
+func (s *serv) RunCommand(ctx context.Context, call *model.CommandCall) (string, error) {
+	if bot, ok := model.BotFromContext(ctx); ok {
+		call.Caller = bot.Username()
+	}
+
+	chat, err := s.getWritableChat(ctx, call.ChatID)
+	if err != nil {
+		return "", err
+	}
+
+	role := chat.Role(call.Caller)
+	if role == model.ChatRoleNone {
+		return "", model.ErrPermissionDenied
+	}
+
+	handler, err := s.commands.Resolve(call, role)
+	if err != nil {
+		return "", err
+	}
+
+	call.Chat = chat
+
+	logEntry := model.LogEntry{
+		ChatID: call.ChatID,
+		Action: model.LogActionRunCommand,
+		Actor:  call.Caller,
+		Details: model.LogDetails{
+			"command": call.Name,
+			"args":    call.Args,
+		},
+	}
+
+	external := handler.Spec().External
+
+	var reply string
+	if external {
+		reply, err = handler.Run(ctx, call)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		if !external {
+			var errTx error
+
+			reply, errTx = handler.Run(ctx, call)
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		return s.audit(ctx, &logEntry)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return reply, nil
+}
@@ -0,0 +1,134 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+func (s *serv) AckDelivery(ctx context.Context, ack *model.DeliveryAck) error {
+	err := s.checkAck(ctx, ack)
+	if err != nil {
+		return err
+	}
+
+	return s.deliveryRepository.Ack(ctx, ack)
+}
+
+// MarkRead moves the read cursor, the messages_read event tells the streams
+// of the chat on every server.
+func (s *serv) MarkRead(ctx context.Context, read *model.DeliveryAck) error {
+	return s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		errTx := s.checkAck(ctx, read)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.deliveryRepository.MarkRead(ctx, read)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.outboxRepository.Create(ctx, &model.ChatEvent{
+			ChatID:        read.ChatID,
+			Type:          model.ChatEventMessagesRead,
+			Username:      read.Username,
+			ReadMessageID: read.MessageID,
+		})
+	})
+}
+
+func (s *serv) GetDeliveryCursor(ctx context.Context, chatID int64, username string) (*model.DeliveryCursor, error) {
+	return s.deliveryRepository.Get(ctx, chatID, username)
+}
+
+// ListUndelivered returns the messages after the delivered cursor as they
+// are: the deleted ones are left to the caller, it pages on the last ID. A
+// member without a cursor never acknowledged a message, the queue starts at
+// the first message of the chat.
+func (s *serv) ListUndelivered(ctx context.Context, page *model.UndeliveredPage) ([]*model.Message, error) {
+	cursor, err := s.deliveryRepository.Get(ctx, page.ChatID, page.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	afterID := cursor.MessageID
+	if page.AfterID > afterID {
+		afterID = page.AfterID
+	}
+
+	return s.messageRepository.ListAfter(ctx, page.ChatID, afterID, page.Limit)
+}
+
+// ListAfter returns the messages as they are, like ListUndelivered.
+func (s *serv) ListAfter(ctx context.Context, chatID, afterID int64, limit uint64) ([]*model.Message, error) {
+	return s.messageRepository.ListAfter(ctx, chatID, afterID, limit)
+}
+
+func (s *serv) GetDeliveryStatus(ctx context.Context, chatID, messageID int64) ([]*model.MemberDelivery, error) {
+	chatMessage, err := s.messageRepository.Get(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if chatMessage.ChatID != chatID || chatMessage.Message.DeletedAt != nil {
+		return nil, model.ErrMessageNotFound
+	}
+
+	chat, err := s.chatRepository.Get(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursors, err := s.deliveryRepository.List(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	byUsername := make(map[string]*model.DeliveryCursor, len(cursors))
+	for _, cursor := range cursors {
+		byUsername[cursor.Username] = cursor
+	}
+
+	members := make([]*model.MemberDelivery, 0, len(chat.Info.Usernames))
+	for _, username := range chat.Info.Usernames {
+		if username == chatMessage.Message.From {
+			continue
+		}
+
+		member := &model.MemberDelivery{Username: username}
+		if cursor, ok := byUsername[username]; ok {
+			member.Delivered = cursor.MessageID >= messageID
+			member.Read = cursor.ReadMessageID >= messageID
+		}
+
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+// checkAck checks the member acknowledges a message of its chat. A deleted
+// message still moves the cursors, the member got it before, so does an
+// archived chat: its messages are still read.
+func (s *serv) checkAck(ctx context.Context, ack *model.DeliveryAck) error {
+	chat, err := s.chatRepository.Get(ctx, ack.ChatID)
+	if err != nil {
+		return err
+	}
+
+	if chat.Role(ack.Username) == model.ChatRoleNone {
+		return model.ErrPermissionDenied
+	}
+
+	chatMessage, err := s.messageRepository.Get(ctx, ack.MessageID)
+	if err != nil {
+		return err
+	}
+
+	if chatMessage.ChatID != ack.ChatID {
+		return model.ErrMessageNotFound
+	}
+
+	return nil
+}
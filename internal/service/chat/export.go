@@ -0,0 +1,112 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+// exportBatchSize is how many messages or log entries an export reads at once.
+const exportBatchSize = 500
+
+// ExportChat emits the chat, its messages with their edits and reactions and
+// its audit log. They are read page by page, the export is never held in
+// memory as a whole.
+func (s *serv) ExportChat(ctx context.Context, chatID int64, emit func(record *model.ExportRecord) error) error {
+	chat, err := s.chatRepository.Get(ctx, chatID)
+	if err != nil {
+		return err
+	}
+
+	logEntry := model.LogEntry{
+		ChatID: chatID,
+		Action: model.LogActionExportChat,
+	}
+
+	err = s.audit(ctx, &logEntry)
+	if err != nil {
+		return err
+	}
+
+	err = emit(&model.ExportRecord{Chat: chat})
+	if err != nil {
+		return err
+	}
+
+	err = s.exportMessages(ctx, chatID, emit)
+	if err != nil {
+		return err
+	}
+
+	return s.exportAuditLog(ctx, chatID, emit)
+}
+
+func (s *serv) exportMessages(ctx context.Context, chatID int64, emit func(record *model.ExportRecord) error) error {
+	var afterID int64
+
+	for {
+		messages, err := s.messageRepository.ListAfter(ctx, chatID, afterID, exportBatchSize)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]int64, 0, len(messages))
+		for _, message := range messages {
+			ids = append(ids, message.ID)
+		}
+
+		edits, err := s.editRepository.ListByMessages(ctx, ids)
+		if err != nil {
+			return err
+		}
+
+		reactions, err := s.reactionRepository.Counts(ctx, ids)
+		if err != nil {
+			return err
+		}
+
+		for _, message := range messages {
+			afterID = message.ID
+			message.Reactions = reactions[message.ID]
+
+			err = emit(&model.ExportRecord{Message: &model.ExportMessage{
+				Message: *message,
+				Edits:   edits[message.ID],
+			}})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(messages) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *serv) exportAuditLog(ctx context.Context, chatID int64, emit func(record *model.ExportRecord) error) error {
+	filter := &model.LogFilter{
+		ChatID: chatID,
+		Limit:  exportBatchSize,
+	}
+
+	for {
+		entries, err := s.logRepository.List(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			filter.BeforeID = entry.ID
+
+			err = emit(&model.ExportRecord{Audit: entry})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(entries) < exportBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+// importBatchSize is how many messages an import copies at once.
+const importBatchSize = 1000
+
+// ImportChat creates a chat from the lines of an export: the chat with its
+// members, then its messages with their authors, timestamps and edits. The
+// messages get new ids, the replies follow them. An invalid line is skipped
+// and reported, the import fails as a whole only if the lines do not start
+// with the chat or reading them fails. The lines are read and checked before
+// the transaction, it is not held open while the client sends the file.
+func (s *serv) ImportChat(ctx context.Context, next func() (*model.ImportLine, error)) (*model.ImportReport, error) {
+	report := &model.ImportReport{}
+	imp := &chatImport{serv: s, report: report, ids: make(map[int64]int64)}
+
+	for {
+		line, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		err = imp.add(line)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if imp.chat == nil {
+		return nil, fmt.Errorf("%w: no chat record", model.ErrInvalidImport)
+	}
+
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		errTx := imp.createChat(ctx)
+		if errTx != nil {
+			return errTx
+		}
+
+		for len(imp.pending) > 0 {
+			errTx = imp.flush(ctx)
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		logEntry := model.LogEntry{
+			ChatID: report.ChatID,
+			Action: model.LogActionImportChat,
+			Details: model.LogDetails{
+				"messages":     report.Messages,
+				"edits":        report.Edits,
+				"failed_lines": report.FailedLines,
+			},
+		}
+
+		return s.audit(ctx, &logEntry)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// chatImport holds the checked records of an import to copy them in batches.
+type chatImport struct {
+	serv   *serv
+	report *model.ImportReport
+	chat   *model.Chat
+	// ids maps the ids of the source to the new ones.
+	ids     map[int64]int64
+	pending []pendingMessage
+}
+
+type pendingMessage struct {
+	line    int64
+	message *model.ExportMessage
+}
+
+func (i *chatImport) add(line *model.ImportLine) error {
+	if line.Err != nil {
+		i.report.Fail(line.Number, line.Err.Error())
+
+		return nil
+	}
+
+	record := line.Record
+
+	if record.Chat != nil {
+		if i.chat != nil {
+			i.report.Fail(line.Number, "second chat record")
+
+			return nil
+		}
+
+		i.chat = record.Chat
+
+		return nil
+	}
+
+	if i.chat == nil {
+		return fmt.Errorf("%w: line %d comes before the chat record", model.ErrInvalidImport, line.Number)
+	}
+
+	if record.Audit != nil {
+		i.report.SkippedAudit++
+
+		return nil
+	}
+
+	message := record.Message
+
+	switch _, seen := i.ids[message.ID]; {
+	case message.ID <= 0:
+		i.report.Fail(line.Number, "message without id")
+
+		return nil
+	case seen:
+		i.report.Fail(line.Number, fmt.Sprintf("duplicate message %d", message.ID))
+
+		return nil
+	case message.From == "" || message.CreatedAt.IsZero():
+		i.report.Fail(line.Number, "message without author or time")
+
+		return nil
+	}
+
+	for _, reaction := range message.Reactions {
+		i.report.SkippedReactions += reaction.Count
+	}
+
+	// The new id is known once reserved, mark the source one as taken.
+	i.ids[message.ID] = 0
+	i.pending = append(i.pending, pendingMessage{line: line.Number, message: message})
+
+	return nil
+}
+
+func (i *chatImport) createChat(ctx context.Context) error {
+	id, err := i.serv.chatRepository.Create(ctx, &i.chat.Info)
+	if err != nil {
+		return err
+	}
+
+	if i.chat.Topic != "" {
+		err = i.serv.chatRepository.SetTopic(ctx, id, i.chat.Topic)
+		if err != nil {
+			return err
+		}
+	}
+
+	i.report.ChatID = id
+
+	return nil
+}
+
+// flush copies a batch of the pending messages with new ids, then their edits.
+func (i *chatImport) flush(ctx context.Context) error {
+	batch := i.pending[:min(len(i.pending), importBatchSize)]
+
+	ids, err := i.serv.messageRepository.NextIDs(ctx, len(batch))
+	if err != nil {
+		return err
+	}
+
+	messages := make([]*model.Message, 0, len(batch))
+
+	var edits []*model.MessageEdit
+
+	for n, pending := range batch {
+		message := pending.message.Message
+		i.ids[message.ID] = ids[n]
+		message.ID = ids[n]
+
+		if message.ReplyToID != 0 {
+			// A reply comes after the message, unless it was not imported.
+			replyToID := i.ids[message.ReplyToID]
+			if replyToID == 0 {
+				i.report.Warn(pending.line, fmt.Sprintf("reply to unknown message %d, imported without it", message.ReplyToID))
+			}
+
+			message.ReplyToID = replyToID
+		}
+
+		messages = append(messages, &message)
+
+		for _, edit := range pending.message.Edits {
+			edits = append(edits, &model.MessageEdit{
+				MessageID: message.ID,
+				Text:      edit.Text,
+				EditedBy:  edit.EditedBy,
+				EditedAt:  edit.EditedAt,
+			})
+		}
+	}
+
+	copied, err := i.serv.messageRepository.Copy(ctx, i.report.ChatID, messages)
+	if err != nil {
+		return err
+	}
+
+	i.report.Messages += copied
+
+	if len(edits) > 0 {
+		copied, err = i.serv.editRepository.Copy(ctx, edits)
+		if err != nil {
+			return err
+		}
+
+		i.report.Edits += copied
+	}
+
+	i.pending = i.pending[len(batch):]
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+// Archive makes the chat read only. The chat_archived event closes its
+// streams.
+func (s *serv) Archive(ctx context.Context, id int64) error {
+	return s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		errTx := s.chatRepository.SetArchived(ctx, id, true)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.outboxRepository.Create(ctx, &model.ChatEvent{
+			ChatID: id,
+			Type:   model.ChatEventChatArchived,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		logEntry := model.LogEntry{
+			ChatID: id,
+			Action: model.LogActionArchiveChat,
+		}
+
+		return s.audit(ctx, &logEntry)
+	})
+}
+
+func (s *serv) Unarchive(ctx context.Context, id int64) error {
+	return s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		errTx := s.chatRepository.SetArchived(ctx, id, false)
+		if errTx != nil {
+			return errTx
+		}
+
+		logEntry := model.LogEntry{
+			ChatID: id,
+			Action: model.LogActionUnarchiveChat,
+		}
+
+		return s.audit(ctx, &logEntry)
+	})
+}
+
+// Restore brings back a deleted chat with its history, until the purger
+// deletes it for good.
+func (s *serv) Restore(ctx context.Context, id int64) error {
+	return s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		errTx := s.chatRepository.Restore(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		logEntry := model.LogEntry{
+			ChatID: id,
+			Action: model.LogActionRestoreChat,
+		}
+
+		return s.audit(ctx, &logEntry)
+	})
+}
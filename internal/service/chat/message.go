@@ -0,0 +1,248 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+const defaultPageSize = 50
+
+func (s *serv) ListMessages(ctx context.Context, page *model.MessagesPage) ([]*model.Message, error) {
+	err := checkBotScope(ctx, page.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if page.Limit == 0 {
+		page.Limit = defaultPageSize
+	}
+
+	var messages []*model.Message
+	err = s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		var errTx error
+
+		messages, errTx = s.messageRepository.List(ctx, page)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.decorate(ctx, messages)
+		if errTx != nil {
+			return errTx
+		}
+
+		logEntry := model.LogEntry{
+			ChatID:  page.ChatID,
+			Action:  model.LogActionListMessages,
+			Details: model.LogDetails{"before_id": page.BeforeID},
+		}
+
+		errTx = s.audit(ctx, &logEntry)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// decorate attaches reaction counts, reply counts and parent previews to the messages.
+func (s *serv) decorate(ctx context.Context, messages []*model.Message) error {
+	ids := make([]int64, 0, len(messages))
+	parentIDs := make([]int64, 0, len(messages))
+	for _, message := range messages {
+		ids = append(ids, message.ID)
+
+		if message.ReplyToID != 0 {
+			parentIDs = append(parentIDs, message.ReplyToID)
+		}
+	}
+
+	reactions, err := s.reactionRepository.Counts(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	replies, err := s.messageRepository.ReplyCounts(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	parents, err := s.messageRepository.ListByIDs(ctx, parentIDs)
+	if err != nil {
+		return err
+	}
+
+	previews := make(map[int64]*model.MessagePreview, len(parents))
+	for _, parent := range parents {
+		previews[parent.ID] = &model.MessagePreview{
+			ID:      parent.ID,
+			From:    parent.From,
+			Text:    parent.Text,
+			Deleted: parent.DeletedAt != nil,
+		}
+	}
+
+	for _, message := range messages {
+		message.Reactions = reactions[message.ID]
+		message.ReplyCount = replies[message.ID]
+		message.Parent = previews[message.ReplyToID]
+	}
+
+	return nil
+}
+
+func (s *serv) EditMessage(ctx context.Context, edit *model.EditMessage) (*model.Message, error) {
+	var message *model.Message
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		chatMessage, errTx := s.getMessage(ctx, edit.ChatID, edit.MessageID, edit.Username)
+		if errTx != nil {
+			return errTx
+		}
+
+		editedAt := time.Now()
+
+		errTx = s.editRepository.Create(ctx, &model.MessageEdit{
+			MessageID: edit.MessageID,
+			Text:      chatMessage.Message.Text,
+			EditedBy:  edit.Username,
+			EditedAt:  editedAt,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.messageRepository.Update(ctx, edit.MessageID, edit.Text, editedAt)
+		if errTx != nil {
+			return errTx
+		}
+
+		message = &chatMessage.Message
+		message.Text = edit.Text
+		message.EditedAt = &editedAt
+
+		errTx = s.outboxRepository.Create(ctx, &model.ChatEvent{
+			ChatID:   edit.ChatID,
+			Type:     model.ChatEventMessageEdited,
+			Username: edit.Username,
+			Message:  message,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		logEntry := model.LogEntry{
+			ChatID:    edit.ChatID,
+			MessageID: edit.MessageID,
+			Action:    model.LogActionEditMessage,
+			Actor:     edit.Username,
+			Details:   model.LogDetails{"text": edit.Text},
+		}
+
+		errTx = s.audit(ctx, &logEntry)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+func (s *serv) DeleteMessage(ctx context.Context, deletion *model.DeleteMessage) (*model.Message, error) {
+	var message *model.Message
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		chatMessage, errTx := s.getMessage(ctx, deletion.ChatID, deletion.MessageID, deletion.Username)
+		if errTx != nil {
+			return errTx
+		}
+
+		deletedAt := time.Now()
+
+		errTx = s.editRepository.Create(ctx, &model.MessageEdit{
+			MessageID: deletion.MessageID,
+			Text:      chatMessage.Message.Text,
+			EditedBy:  deletion.Username,
+			EditedAt:  deletedAt,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.messageRepository.Delete(ctx, deletion.MessageID, deletedAt)
+		if errTx != nil {
+			return errTx
+		}
+
+		message = &chatMessage.Message
+		message.Text = ""
+		message.DeletedAt = &deletedAt
+
+		errTx = s.outboxRepository.Create(ctx, &model.ChatEvent{
+			ChatID:   deletion.ChatID,
+			Type:     model.ChatEventMessageDeleted,
+			Username: deletion.Username,
+			Message:  message,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		logEntry := model.LogEntry{
+			ChatID:    deletion.ChatID,
+			MessageID: deletion.MessageID,
+			Action:    model.LogActionDeleteMessage,
+			Actor:     deletion.Username,
+		}
+
+		errTx = s.audit(ctx, &logEntry)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// getMessage returns a live message of the chat if the user may change it:
+// only the author and the chat admins can, while the chat is not archived.
+func (s *serv) getMessage(ctx context.Context, chatID, messageID int64, username string) (*model.ChatMessage, error) {
+	chat, err := s.getWritableChat(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	chatMessage, err := s.messageRepository.Get(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if chatMessage.ChatID != chatID || chatMessage.Message.DeletedAt != nil {
+		return nil, model.ErrMessageNotFound
+	}
+
+	if chatMessage.Message.From != username && !chat.IsAdmin(username) {
+		return nil, model.ErrPermissionDenied
+	}
+
+	return chatMessage, nil
+}
@@ -0,0 +1,131 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+func (s *serv) AddReaction(ctx context.Context, reaction *model.Reaction) error {
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		_, errTx := s.getWritableChat(ctx, reaction.ChatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.checkMessage(ctx, reaction.ChatID, reaction.MessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		changed, errTx := s.reactionRepository.Add(ctx, reaction)
+		if errTx != nil {
+			return errTx
+		}
+
+		// Nothing changed, there is nothing to tell or to audit.
+		if !changed {
+			return nil
+		}
+
+		errTx = s.outboxRepository.Create(ctx, &model.ChatEvent{
+			ChatID:   reaction.ChatID,
+			Type:     model.ChatEventReactionAdded,
+			Username: reaction.Username,
+			Reaction: reaction,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		logEntry := model.LogEntry{
+			ChatID:    reaction.ChatID,
+			MessageID: reaction.MessageID,
+			Action:    model.LogActionAddReaction,
+			Actor:     reaction.Username,
+			Details:   model.LogDetails{"emoji": reaction.Emoji},
+		}
+
+		errTx = s.audit(ctx, &logEntry)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *serv) RemoveReaction(ctx context.Context, reaction *model.Reaction) error {
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		_, errTx := s.getWritableChat(ctx, reaction.ChatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.checkMessage(ctx, reaction.ChatID, reaction.MessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		changed, errTx := s.reactionRepository.Remove(ctx, reaction)
+		if errTx != nil {
+			return errTx
+		}
+
+		// Nothing changed, there is nothing to tell or to audit.
+		if !changed {
+			return nil
+		}
+
+		errTx = s.outboxRepository.Create(ctx, &model.ChatEvent{
+			ChatID:   reaction.ChatID,
+			Type:     model.ChatEventReactionRemoved,
+			Username: reaction.Username,
+			Reaction: reaction,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		logEntry := model.LogEntry{
+			ChatID:    reaction.ChatID,
+			MessageID: reaction.MessageID,
+			Action:    model.LogActionRemoveReaction,
+			Actor:     reaction.Username,
+			Details:   model.LogDetails{"emoji": reaction.Emoji},
+		}
+
+		errTx = s.audit(ctx, &logEntry)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkMessage makes sure the message is a live message of the chat.
+func (s *serv) checkMessage(ctx context.Context, chatID, messageID int64) error {
+	chatMessage, err := s.messageRepository.Get(ctx, messageID)
+	if err != nil {
+		return err
+	}
+
+	if chatMessage.ChatID != chatID || chatMessage.Message.DeletedAt != nil {
+		return model.ErrMessageNotFound
+	}
+
+	return nil
+}
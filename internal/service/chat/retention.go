@@ -0,0 +1,35 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+// SetRetention overrides the global retention of the chat, the purger applies
+// it on its next run.
+func (s *serv) SetRetention(ctx context.Context, retention *model.ChatRetention) error {
+	return s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		errTx := s.chatRepository.SetRetention(ctx, retention)
+		if errTx != nil {
+			return errTx
+		}
+
+		details := model.LogDetails{}
+		if retention.MaxAge != nil {
+			details["max_age_seconds"] = int64(retention.MaxAge.Seconds())
+		}
+
+		if retention.MaxCount != nil {
+			details["max_count"] = *retention.MaxCount
+		}
+
+		logEntry := model.LogEntry{
+			ChatID:  retention.ChatID,
+			Action:  model.LogActionSetRetention,
+			Details: details,
+		}
+
+		return s.audit(ctx, &logEntry)
+	})
+}
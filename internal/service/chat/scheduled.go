@@ -0,0 +1,156 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+// ScheduleMessage stores the message to be sent at its ScheduledAt. The sender
+// and the replied message are checked now, the mute when it is sent.
+func (s *serv) ScheduleMessage(ctx context.Context, scheduled *model.ScheduledMessage) (int64, error) {
+	now := time.Now()
+	if !scheduled.ScheduledAt.After(now) || scheduled.ScheduledAt.After(now.Add(model.MaxScheduleAhead)) {
+		return 0, model.ErrInvalidSchedule
+	}
+
+	message := &model.SendMessage{
+		ChatID:  scheduled.ChatID,
+		Message: scheduled.Message,
+	}
+
+	err := checkSender(ctx, message)
+	if err != nil {
+		return 0, err
+	}
+
+	scheduled.Message = message.Message
+
+	var id int64
+	err = s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		_, errTx := s.getWritableChat(ctx, scheduled.ChatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.checkReply(ctx, message)
+		if errTx != nil {
+			return errTx
+		}
+
+		id, errTx = s.scheduledRepository.Create(ctx, scheduled)
+		if errTx != nil {
+			return errTx
+		}
+
+		logEntry := model.LogEntry{
+			ChatID: scheduled.ChatID,
+			Action: model.LogActionScheduleMessage,
+			Actor:  scheduled.Message.From,
+			Details: model.LogDetails{
+				"scheduled_id":        id,
+				"text":                scheduled.Message.Text,
+				"scheduled_at":        scheduled.ScheduledAt,
+				"reply_to_message_id": scheduled.Message.ReplyToID,
+			},
+		}
+
+		return s.audit(ctx, &logEntry)
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (s *serv) ListScheduled(ctx context.Context, filter *model.ScheduledFilter) ([]*model.ScheduledMessage, error) {
+	messages, err := s.scheduledRepository.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	logEntry := model.LogEntry{
+		ChatID: filter.ChatID,
+		Action: model.LogActionListScheduled,
+		Actor:  filter.Username,
+	}
+
+	err = s.audit(ctx, &logEntry)
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func (s *serv) CancelScheduled(ctx context.Context, cancel *model.CancelScheduled) error {
+	return s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		scheduled, errTx := s.scheduledRepository.Get(ctx, cancel.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if scheduled.ChatID != cancel.ChatID {
+			return model.ErrScheduledNotFound
+		}
+
+		if scheduled.Message.From != cancel.Username {
+			chat, errChat := s.chatRepository.Get(ctx, cancel.ChatID)
+			if errChat != nil {
+				return errChat
+			}
+
+			if !chat.IsAdmin(cancel.Username) {
+				return model.ErrPermissionDenied
+			}
+		}
+
+		errTx = s.scheduledRepository.Delete(ctx, cancel.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		logEntry := model.LogEntry{
+			ChatID: cancel.ChatID,
+			Action: model.LogActionCancelScheduled,
+			Actor:  cancel.Username,
+			Details: model.LogDetails{
+				"scheduled_id": cancel.ID,
+				"from":         scheduled.Message.From,
+			},
+		}
+
+		return s.audit(ctx, &logEntry)
+	})
+}
+
+// DeliverScheduled sends a due message on behalf of its author, whose sender
+// was checked when it was scheduled, and drops it from the schedule.
+func (s *serv) DeliverScheduled(ctx context.Context, scheduled *model.ScheduledMessage) (int64, error) {
+	var id int64
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		message := &model.SendMessage{
+			ChatID:  scheduled.ChatID,
+			Message: scheduled.Message,
+		}
+		message.Message.CreatedAt = time.Now()
+
+		var errTx error
+
+		id, errTx = s.send(ctx, message)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.scheduledRepository.Delete(ctx, scheduled.ID)
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
@@ -0,0 +1,56 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+func (s *serv) SearchMessages(ctx context.Context, query *model.SearchQuery) ([]*model.SearchResult, error) {
+	if query.Limit == 0 {
+		query.Limit = defaultPageSize
+	}
+
+	var results []*model.SearchResult
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		var errTx error
+
+		results, errTx = s.messageRepository.Search(ctx, query)
+		if errTx != nil {
+			return errTx
+		}
+
+		messages := make([]*model.Message, 0, len(results))
+		for _, result := range results {
+			messages = append(messages, result.Message)
+		}
+
+		errTx = s.decorate(ctx, messages)
+		if errTx != nil {
+			return errTx
+		}
+
+		logEntry := model.LogEntry{
+			ChatID: query.ChatID,
+			Action: model.LogActionSearchMessages,
+			Actor:  query.Username,
+			Details: model.LogDetails{
+				"query": query.Query,
+				"from":  query.From,
+			},
+		}
+
+		errTx = s.audit(ctx, &logEntry)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -2,12 +2,12 @@ package chat
 
 import (
 	"context"
-	"fmt"
-	"strings"
+	"errors"
 
 	"github.com/Mobo140/chat/internal/model"
 	"github.com/Mobo140/chat/internal/repository"
 	"github.com/Mobo140/chat/internal/service"
+	"github.com/Mobo140/chat/internal/service/command"
 	"github.com/Mobo140/platform_common/pkg/db"
 )
 
@@ -18,23 +18,50 @@ const (
 )
 
 type serv struct {
-	chatRepository    repository.ChatRepository
-	messageRepository repository.MessageRepository
-	logRepository     repository.LogRepository
-	txManager         db.TxManager
+	chatRepository      repository.ChatRepository
+	messageRepository   repository.MessageRepository
+	editRepository      repository.MessageEditRepository
+	reactionRepository  repository.ReactionRepository
+	logRepository       repository.LogRepository
+	outboxRepository    repository.OutboxRepository
+	scheduledRepository repository.ScheduledRepository
+	deliveryRepository  repository.DeliveryRepository
+	sessionRepository   repository.SessionRepository
+	txManager           db.TxManager
+	auditWriter         service.AuditWriter
+	auditPolicy         model.AuditPolicy
+	commands            *command.Registry
 }
 
 func NewService(
 	chatRepository repository.ChatRepository,
 	messageRepository repository.MessageRepository,
+	editRepository repository.MessageEditRepository,
+	reactionRepository repository.ReactionRepository,
 	logRepository repository.LogRepository,
+	outboxRepository repository.OutboxRepository,
+	scheduledRepository repository.ScheduledRepository,
+	deliveryRepository repository.DeliveryRepository,
+	sessionRepository repository.SessionRepository,
 	txManager db.TxManager,
+	auditWriter service.AuditWriter,
+	auditPolicy model.AuditPolicy,
+	commands *command.Registry,
 ) *serv { //nolint:revive // it's ok
 	return &serv{
-		chatRepository:    chatRepository,
-		messageRepository: messageRepository,
-		logRepository:     logRepository,
-		txManager:         txManager,
+		chatRepository:      chatRepository,
+		messageRepository:   messageRepository,
+		editRepository:      editRepository,
+		reactionRepository:  reactionRepository,
+		logRepository:       logRepository,
+		outboxRepository:    outboxRepository,
+		scheduledRepository: scheduledRepository,
+		deliveryRepository:  deliveryRepository,
+		sessionRepository:   sessionRepository,
+		txManager:           txManager,
+		auditWriter:         auditWriter,
+		auditPolicy:         auditPolicy,
+		commands:            commands,
 	}
 }
 
@@ -48,12 +75,25 @@ func (s *serv) Create(ctx context.Context, info *model.ChatInfo) (int64, error)
 			return errTx
 		}
 
+		errTx = s.outboxRepository.Create(ctx, &model.ChatEvent{
+			ChatID: id,
+			Type:   model.ChatEventChatCreated,
+			Chat:   info,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
 		logEntry := model.LogEntry{
-			ChatID:   id,
-			Activity: fmt.Sprintf("Create chat: usernames:%s", strings.Join(info.Usernames, ", ")),
+			ChatID: id,
+			Action: model.LogActionCreateChat,
+			Details: model.LogDetails{
+				"usernames": info.Usernames,
+				"admins":    info.Admins,
+			},
 		}
 
-		errTx = s.logRepository.Create(ctx, &logEntry)
+		errTx = s.audit(ctx, &logEntry)
 		if errTx != nil {
 			return errTx
 		}
@@ -69,28 +109,18 @@ func (s *serv) Create(ctx context.Context, info *model.ChatInfo) (int64, error)
 }
 
 func (s *serv) Get(ctx context.Context, id int64) (*model.Chat, error) {
-	var chat *model.Chat
-	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
-		var errTx error
-
-		chat, errTx = s.chatRepository.Get(ctx, id)
-		if errTx != nil {
-			return errTx
-		}
-
-		logEntry := model.LogEntry{
-			ChatID:   id,
-			Activity: fmt.Sprintf("Get chat: Id:%d, Usernames:%s", id, strings.Join(chat.Info.Usernames, ", ")),
-		}
-
-		errTx = s.logRepository.Create(ctx, &logEntry)
-		if errTx != nil {
-			return errTx
-		}
+	chat, err := s.chatRepository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
 
-		return nil
-	})
+	logEntry := model.LogEntry{
+		ChatID:  id,
+		Action:  model.LogActionGetChat,
+		Details: model.LogDetails{"usernames": chat.Info.Usernames},
+	}
 
+	err = s.audit(ctx, &logEntry)
 	if err != nil {
 		return nil, err
 	}
@@ -107,12 +137,20 @@ func (s *serv) Delete(ctx context.Context, id int64) error {
 			return errTx
 		}
 
+		errTx = s.outboxRepository.Create(ctx, &model.ChatEvent{
+			ChatID: id,
+			Type:   model.ChatEventChatDeleted,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
 		logEntry := model.LogEntry{
-			ChatID:   id,
-			Activity: fmt.Sprintf("Delete chat: ID=%d", id),
+			ChatID: id,
+			Action: model.LogActionDeleteChat,
 		}
 
-		errTx = s.logRepository.Create(ctx, &logEntry)
+		errTx = s.audit(ctx, &logEntry)
 		if errTx != nil {
 			return errTx
 		}
@@ -127,37 +165,143 @@ func (s *serv) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (s *serv) SendMessage(ctx context.Context, message *model.SendMessage) error {
-	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+func (s *serv) SendMessage(ctx context.Context, message *model.SendMessage) (int64, error) {
+	err := checkSender(ctx, message)
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	err = s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
 		var errTx error
 
-		errTx = s.messageRepository.SendMessage(ctx, message)
-		if errTx != nil {
-			return errTx
-		}
+		id, errTx = s.send(ctx, message)
 
-		logEntry := model.LogEntry{
-			ChatID: message.ChatID,
-			Activity: fmt.Sprintf(
-				"Send message to chat: ChatID:%d, From:%s, Text:%s, CreatedAt:%s",
-				message.ChatID,
-				message.Message.From,
-				message.Message.Text,
-				message.Message.CreatedAt,
-			),
-		}
+		return errTx
+	})
 
-		errTx = s.logRepository.Create(ctx, &logEntry)
-		if errTx != nil {
-			return errTx
-		}
+	if err != nil {
+		return 0, err
+	}
 
-		return nil
+	return id, nil
+}
+
+// send stores the message of a checked sender and queues its event, in the
+// transaction of the caller.
+func (s *serv) send(ctx context.Context, message *model.SendMessage) (int64, error) {
+	_, err := s.getWritableChat(ctx, message.ChatID)
+	if err != nil {
+		return 0, err
+	}
+
+	muted, err := s.chatRepository.IsMuted(ctx, message.ChatID, message.Message.From)
+	if err != nil {
+		return 0, err
+	}
+
+	if muted {
+		return 0, model.ErrMuted
+	}
+
+	err = s.checkReply(ctx, message)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := s.messageRepository.SendMessage(ctx, message)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := message.Message
+	sent.ID = id
+
+	err = s.outboxRepository.Create(ctx, &model.ChatEvent{
+		ChatID:   message.ChatID,
+		Type:     model.ChatEventMessageSent,
+		Username: sent.From,
+		Message:  &sent,
 	})
+	if err != nil {
+		return 0, err
+	}
+
+	logEntry := model.LogEntry{
+		ChatID:    message.ChatID,
+		MessageID: id,
+		Action:    model.LogActionSendMessage,
+		Actor:     message.Message.From,
+		Details: model.LogDetails{
+			"text":                message.Message.Text,
+			"reply_to_message_id": message.Message.ReplyToID,
+		},
+	}
 
+	err = s.audit(ctx, &logEntry)
 	if err != nil {
-		return err
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// checkSender sends the messages of a bot from its name, in the chats of its
+// scope only, and keeps users from posing as bots.
+func checkSender(ctx context.Context, message *model.SendMessage) error {
+	bot, ok := model.BotFromContext(ctx)
+	if !ok {
+		if model.IsBotUsername(message.Message.From) {
+			return model.ErrPermissionDenied
+		}
+
+		return nil
+	}
+
+	if !bot.InScope(message.ChatID) {
+		return model.ErrPermissionDenied
+	}
+
+	message.Message.From = bot.Username()
+
+	return nil
+}
+
+// checkBotScope keeps a bot out of the chats beyond its scope.
+func checkBotScope(ctx context.Context, chatID int64) error {
+	bot, ok := model.BotFromContext(ctx)
+	if ok && !bot.InScope(chatID) {
+		return model.ErrPermissionDenied
 	}
 
 	return nil
 }
+
+// checkReply makes sure the replied message is a live message of the same chat.
+func (s *serv) checkReply(ctx context.Context, message *model.SendMessage) error {
+	if message.Message.ReplyToID == 0 {
+		return nil
+	}
+
+	err := s.checkMessage(ctx, message.ChatID, message.Message.ReplyToID)
+	if errors.Is(err, model.ErrMessageNotFound) {
+		return model.ErrInvalidReply
+	}
+
+	return err
+}
+
+// getWritableChat returns the chat if it takes changes, an archived chat is
+// read only.
+func (s *serv) getWritableChat(ctx context.Context, chatID int64) (*model.Chat, error) {
+	chat, err := s.chatRepository.Get(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if chat.ArchivedAt != nil {
+		return nil, model.ErrChatArchived
+	}
+
+	return chat, nil
+}
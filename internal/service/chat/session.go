@@ -0,0 +1,24 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+func (s *serv) OpenSession(ctx context.Context, session *model.Session) error {
+	return s.sessionRepository.Create(ctx, session)
+}
+
+func (s *serv) TouchSession(ctx context.Context, id string, lastActiveAt time.Time) error {
+	return s.sessionRepository.Touch(ctx, id, lastActiveAt)
+}
+
+func (s *serv) CloseSession(ctx context.Context, id string) error {
+	return s.sessionRepository.Delete(ctx, id)
+}
+
+func (s *serv) ListSessions(ctx context.Context, filter *model.SessionFilter) ([]*model.Session, error) {
+	return s.sessionRepository.List(ctx, filter)
+}
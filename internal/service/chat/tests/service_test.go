@@ -3,8 +3,9 @@ package tests
 import (
 	"context"
 	"fmt"
-	"strings"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit"
 	"github.com/gojuno/minimock/v3"
@@ -13,6 +14,8 @@ import (
 	"github.com/Mobo140/chat/internal/model"
 	repositoryMocks "github.com/Mobo140/chat/internal/repository/mocks"
 	chatService "github.com/Mobo140/chat/internal/service/chat"
+	"github.com/Mobo140/chat/internal/service/command"
+	serviceMocks "github.com/Mobo140/chat/internal/service/mocks"
 	repositoryTx "github.com/Mobo140/platform_common/pkg/db"
 	dbTxMocks "github.com/Mobo140/platform_common/pkg/db/mocks"
 )
@@ -24,6 +27,7 @@ func TestCreate(t *testing.T) {
 		chatRepo *repositoryMocks.ChatRepositoryMock,
 		messageRepo *repositoryMocks.MessageRepositoryMock,
 		logRepo *repositoryMocks.LogRepositoryMock,
+		outboxRepo *repositoryMocks.OutboxRepositoryMock,
 		txManager *dbTxMocks.TxManagerMock,
 	)
 
@@ -50,8 +54,18 @@ func TestCreate(t *testing.T) {
 		}
 
 		logEntry = &model.LogEntry{
-			ChatID:   id,
-			Activity: fmt.Sprintf("Create chat: usernames:%s", strings.Join(info.Usernames, ", ")),
+			ChatID: id,
+			Action: model.LogActionCreateChat,
+			Details: model.LogDetails{
+				"usernames": info.Usernames,
+				"admins":    info.Admins,
+			},
+		}
+
+		event = &model.ChatEvent{
+			ChatID: id,
+			Type:   model.ChatEventChatCreated,
+			Chat:   info,
 		}
 
 		unknownChat = (int64)(-1)
@@ -74,9 +88,11 @@ func TestCreate(t *testing.T) {
 			setupMocks: func(chatRepo *repositoryMocks.ChatRepositoryMock,
 				_ *repositoryMocks.MessageRepositoryMock,
 				logRepo *repositoryMocks.LogRepositoryMock,
+				outboxRepo *repositoryMocks.OutboxRepositoryMock,
 				txManager *dbTxMocks.TxManagerMock,
 			) {
 				chatRepo.CreateMock.Expect(ctxValue, info).Return(id, nil)
+				outboxRepo.CreateMock.Expect(ctxValue, event).Return(nil)
 				logRepo.CreateMock.Expect(ctxValue, logEntry).Return(nil)
 				txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
 					return f(ctx)
@@ -93,6 +109,7 @@ func TestCreate(t *testing.T) {
 			setupMocks: func(_ *repositoryMocks.ChatRepositoryMock,
 				_ *repositoryMocks.MessageRepositoryMock,
 				_ *repositoryMocks.LogRepositoryMock,
+				_ *repositoryMocks.OutboxRepositoryMock,
 				txManager *dbTxMocks.TxManagerMock,
 			) {
 				txManager.ReadCommitedMock.Set(func(_ context.Context, _ repositoryTx.Handler) error {
@@ -110,6 +127,7 @@ func TestCreate(t *testing.T) {
 			setupMocks: func(chatRepo *repositoryMocks.ChatRepositoryMock,
 				_ *repositoryMocks.MessageRepositoryMock,
 				_ *repositoryMocks.LogRepositoryMock,
+				_ *repositoryMocks.OutboxRepositoryMock,
 				txManager *dbTxMocks.TxManagerMock,
 			) {
 				chatRepo.CreateMock.Expect(ctxValue, info).Return(unknownChat, repositoryErr)
@@ -128,9 +146,11 @@ func TestCreate(t *testing.T) {
 			setupMocks: func(chatRepo *repositoryMocks.ChatRepositoryMock,
 				_ *repositoryMocks.MessageRepositoryMock,
 				logRepo *repositoryMocks.LogRepositoryMock,
+				outboxRepo *repositoryMocks.OutboxRepositoryMock,
 				txManager *dbTxMocks.TxManagerMock,
 			) {
 				chatRepo.CreateMock.Expect(ctxValue, info).Return(id, nil)
+				outboxRepo.CreateMock.Expect(ctxValue, event).Return(nil)
 				logRepo.CreateMock.Expect(ctxValue, logEntry).Return(logErr)
 				txManager.ReadCommitedMock.Set(func(ctxValue context.Context, f repositoryTx.Handler) error {
 					return f(ctxValue)
@@ -147,13 +167,20 @@ func TestCreate(t *testing.T) {
 
 			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
 			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
 			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
 			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
 
 			// Настройка моков в соответствии с тестами
-			tt.setupMocks(chatRepo, messageRepo, logRepo, txManager)
+			tt.setupMocks(chatRepo, messageRepo, logRepo, outboxRepo, txManager)
 
-			service := chatService.NewService(chatRepo, messageRepo, logRepo, txManager)
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
 
 			gotID, err := service.Create(ctxValue, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -167,9 +194,7 @@ func TestGet(t *testing.T) {
 
 	type setupMocks func(
 		chatRepo *repositoryMocks.ChatRepositoryMock,
-		messageRepo *repositoryMocks.MessageRepositoryMock,
-		logRepo *repositoryMocks.LogRepositoryMock,
-		txManager *dbTxMocks.TxManagerMock,
+		auditWriter *serviceMocks.AuditWriterMock,
 	)
 
 	type args struct {
@@ -183,9 +208,7 @@ func TestGet(t *testing.T) {
 		id        = gofakeit.Int64()
 		usernames = []string{gofakeit.Username()}
 
-		repositoryErr  = fmt.Errorf("update userRepo error")
-		logErr         = fmt.Errorf("update log error")
-		transactionErr = fmt.Errorf("transaction error")
+		repositoryErr = fmt.Errorf("update userRepo error")
 
 		chat = &model.Chat{
 			ID: id,
@@ -195,89 +218,61 @@ func TestGet(t *testing.T) {
 		}
 
 		logEntry = &model.LogEntry{
-			ChatID:   id,
-			Activity: fmt.Sprintf("Get chat: Id:%d, Usernames:%s", id, strings.Join(chat.Info.Usernames, ", ")),
+			ChatID:  id,
+			Action:  model.LogActionGetChat,
+			Details: model.LogDetails{"usernames": chat.Info.Usernames},
 		}
 	)
 
 	tests := []struct {
 		name       string
 		setupMocks setupMocks
+		policy     model.AuditPolicy
 		args       args
 		want       *model.Chat
 		err        error
 	}{
 		{
-			name: "success case",
-			want: chat,
+			name:   "success case",
+			want:   chat,
+			policy: model.NewAuditPolicy(),
 			args: args{
 				req: id,
 			},
 			err: nil,
 			setupMocks: func(chatRepo *repositoryMocks.ChatRepositoryMock,
-				_ *repositoryMocks.MessageRepositoryMock,
-				logRepo *repositoryMocks.LogRepositoryMock,
-				txManager *dbTxMocks.TxManagerMock,
+				auditWriter *serviceMocks.AuditWriterMock,
 			) {
 				chatRepo.GetMock.Expect(ctxValue, id).Return(chat, nil)
-				logRepo.CreateMock.Expect(ctxValue, logEntry).Return(nil)
-				txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
-					return f(ctx)
-				})
+				auditWriter.WriteMock.Expect(logEntry).Return()
 			},
 		},
 		{
-			name: "transaction error",
-			want: nil,
+			name:   "action not audited",
+			want:   chat,
+			policy: model.NewAuditPolicy(model.LogActionCreateChat, model.LogActionDeleteChat),
 			args: args{
 				req: id,
 			},
-			err: transactionErr,
-			setupMocks: func(_ *repositoryMocks.ChatRepositoryMock,
-				_ *repositoryMocks.MessageRepositoryMock,
-				_ *repositoryMocks.LogRepositoryMock,
-				txManager *dbTxMocks.TxManagerMock,
+			err: nil,
+			setupMocks: func(chatRepo *repositoryMocks.ChatRepositoryMock,
+				_ *serviceMocks.AuditWriterMock,
 			) {
-				txManager.ReadCommitedMock.Set(func(_ context.Context, _ repositoryTx.Handler) error {
-					return transactionErr
-				})
+				chatRepo.GetMock.Expect(ctxValue, id).Return(chat, nil)
 			},
 		},
 		{
-			name: "chatRepo error",
-			want: nil,
+			name:   "chatRepo error",
+			want:   nil,
+			policy: model.NewAuditPolicy(),
 			args: args{
 				req: id,
 			},
 			err: repositoryErr,
-			setupMocks: func(userRepo *repositoryMocks.ChatRepositoryMock,
-				_ *repositoryMocks.MessageRepositoryMock,
-				_ *repositoryMocks.LogRepositoryMock,
-				txManager *dbTxMocks.TxManagerMock,
-			) {
-				userRepo.GetMock.Expect(ctxValue, id).Return(nil, repositoryErr)
-				txManager.ReadCommitedMock.Set(func(ctxValue context.Context, f repositoryTx.Handler) error {
-					return f(ctxValue)
-				})
-			},
-		},
-		{
-			name: "creating log in db error",
-			want: nil,
-			args: args{
-				req: id,
-			},
-			err: logErr,
-			setupMocks: func(userRepo *repositoryMocks.ChatRepositoryMock,
-				_ *repositoryMocks.MessageRepositoryMock,
-				logRepo *repositoryMocks.LogRepositoryMock,
-				txManager *dbTxMocks.TxManagerMock,
+			setupMocks: func(chatRepo *repositoryMocks.ChatRepositoryMock,
+				_ *serviceMocks.AuditWriterMock,
 			) {
-				userRepo.GetMock.Expect(ctxValue, id).Return(chat, nil)
-				logRepo.CreateMock.Expect(ctxValue, logEntry).Return(logErr)
-				txManager.ReadCommitedMock.Set(func(ctxValue context.Context, f repositoryTx.Handler) error {
-					return f(ctxValue)
-				})
+				chatRepo.GetMock.Expect(ctxValue, id).Return(nil, repositoryErr)
 			},
 		},
 	}
@@ -290,13 +285,20 @@ func TestGet(t *testing.T) {
 
 			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
 			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
 			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
 			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
 
 			// Настройка моков в соответствии с тестами
-			tt.setupMocks(chatRepo, messageRepo, logRepo, txManager)
+			tt.setupMocks(chatRepo, auditWriter)
 
-			service := chatService.NewService(chatRepo, messageRepo, logRepo, txManager)
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, tt.policy, nil)
 
 			gotID, err := service.Get(ctxValue, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -312,6 +314,7 @@ func TestDelete(t *testing.T) {
 		userRepo *repositoryMocks.ChatRepositoryMock,
 		messageRepo *repositoryMocks.MessageRepositoryMock,
 		logRepo *repositoryMocks.LogRepositoryMock,
+		outboxRepo *repositoryMocks.OutboxRepositoryMock,
 		txManager *dbTxMocks.TxManagerMock,
 	)
 
@@ -330,8 +333,13 @@ func TestDelete(t *testing.T) {
 		transactionErr = fmt.Errorf("transaction error")
 
 		logEntry = &model.LogEntry{
-			ChatID:   id,
-			Activity: fmt.Sprintf("Delete chat: ID=%d", id),
+			ChatID: id,
+			Action: model.LogActionDeleteChat,
+		}
+
+		event = &model.ChatEvent{
+			ChatID: id,
+			Type:   model.ChatEventChatDeleted,
 		}
 	)
 
@@ -350,9 +358,11 @@ func TestDelete(t *testing.T) {
 			setupMocks: func(chatRepo *repositoryMocks.ChatRepositoryMock,
 				_ *repositoryMocks.MessageRepositoryMock,
 				logRepo *repositoryMocks.LogRepositoryMock,
+				outboxRepo *repositoryMocks.OutboxRepositoryMock,
 				txManager *dbTxMocks.TxManagerMock,
 			) {
 				chatRepo.DeleteMock.Expect(ctxValue, id).Return(nil)
+				outboxRepo.CreateMock.Expect(ctxValue, event).Return(nil)
 				logRepo.CreateMock.Expect(ctxValue, logEntry).Return(nil)
 				txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
 					return f(ctx)
@@ -368,6 +378,7 @@ func TestDelete(t *testing.T) {
 			setupMocks: func(_ *repositoryMocks.ChatRepositoryMock,
 				_ *repositoryMocks.MessageRepositoryMock,
 				_ *repositoryMocks.LogRepositoryMock,
+				_ *repositoryMocks.OutboxRepositoryMock,
 				txManager *dbTxMocks.TxManagerMock,
 			) {
 				txManager.ReadCommitedMock.Set(func(_ context.Context, _ repositoryTx.Handler) error {
@@ -384,6 +395,7 @@ func TestDelete(t *testing.T) {
 			setupMocks: func(chatRepo *repositoryMocks.ChatRepositoryMock,
 				_ *repositoryMocks.MessageRepositoryMock,
 				_ *repositoryMocks.LogRepositoryMock,
+				_ *repositoryMocks.OutboxRepositoryMock,
 				txManager *dbTxMocks.TxManagerMock,
 			) {
 				chatRepo.DeleteMock.Expect(ctxValue, id).Return(repositoryErr)
@@ -401,9 +413,11 @@ func TestDelete(t *testing.T) {
 			setupMocks: func(userRepo *repositoryMocks.ChatRepositoryMock,
 				_ *repositoryMocks.MessageRepositoryMock,
 				logRepo *repositoryMocks.LogRepositoryMock,
+				outboxRepo *repositoryMocks.OutboxRepositoryMock,
 				txManager *dbTxMocks.TxManagerMock,
 			) {
 				userRepo.DeleteMock.Expect(ctxValue, id).Return(nil)
+				outboxRepo.CreateMock.Expect(ctxValue, event).Return(nil)
 				logRepo.CreateMock.Expect(ctxValue, logEntry).Return(logErr)
 				txManager.ReadCommitedMock.Set(func(ctxValue context.Context, f repositoryTx.Handler) error {
 					return f(ctxValue)
@@ -420,13 +434,20 @@ func TestDelete(t *testing.T) {
 
 			userRepo := repositoryMocks.NewChatRepositoryMock(mc)
 			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
 			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
 			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
 
 			// Настройка моков в соответствии с тестами
-			tt.setupMocks(userRepo, messageRepo, logRepo, txManager)
+			tt.setupMocks(userRepo, messageRepo, logRepo, outboxRepo, txManager)
 
-			service := chatService.NewService(userRepo, messageRepo, logRepo, txManager)
+			service := chatService.NewService(userRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
 
 			err := service.Delete(ctxValue, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -452,22 +473,27 @@ func TestSendMessage(t *testing.T) {
 		ctxValue = context.Background()
 		mc       = minimock.NewController(t)
 
-		id   = gofakeit.Int64()
-		from = gofakeit.Name()
-		text = gofakeit.Color()
+		id        = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		from      = gofakeit.Name()
+		text      = gofakeit.Color()
 
 		repositoryErr  = fmt.Errorf("sendMessage messageRepo error")
 		logErr         = fmt.Errorf("sendMessage log error")
+		outboxErr      = fmt.Errorf("sendMessage outbox error")
 		transactionErr = fmt.Errorf("transaction error")
 
+		archivedAt = time.Now()
+
 		logEntry = &model.LogEntry{
-			ChatID: id,
-			Activity: fmt.Sprintf(
-				"Send message to chat: ChatID:%d, From:%s, Text:%s",
-				id,
-				from,
-				text,
-			),
+			ChatID:    id,
+			MessageID: messageID,
+			Action:    model.LogActionSendMessage,
+			Actor:     from,
+			Details: model.LogDetails{
+				"text":                text,
+				"reply_to_message_id": int64(0),
+			},
 		}
 
 		message = &model.SendMessage{
@@ -485,17 +511,36 @@ func TestSendMessage(t *testing.T) {
 				Text: text,
 			},
 		}
+
+		event = &model.ChatEvent{
+			ChatID:   id,
+			Type:     model.ChatEventMessageSent,
+			Username: from,
+			Message: &model.Message{
+				ID:   messageID,
+				From: from,
+				Text: text,
+			},
+		}
 	)
 
 	tests := []struct {
 		name       string
 		setupMocks setupMocks
+		ctx        context.Context
 		args       args
+		event      *model.ChatEvent
+		outboxErr  error
+		muted      bool
+		archivedAt *time.Time
+		want       int64
 		err        error
 	}{
 		{
-			name: "success case",
-			err:  nil,
+			name:  "success case",
+			want:  messageID,
+			err:   nil,
+			event: event,
 			args: args{
 				req: req,
 			},
@@ -504,7 +549,7 @@ func TestSendMessage(t *testing.T) {
 				logRepo *repositoryMocks.LogRepositoryMock,
 				txManager *dbTxMocks.TxManagerMock,
 			) {
-				messageRepo.SendMessageMock.Expect(ctxValue, message).Return(nil)
+				messageRepo.SendMessageMock.Expect(ctxValue, message).Return(messageID, nil)
 				logRepo.CreateMock.Expect(ctxValue, logEntry).Return(nil)
 				txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
 					return f(ctx)
@@ -538,15 +583,35 @@ func TestSendMessage(t *testing.T) {
 				_ *repositoryMocks.LogRepositoryMock,
 				txManager *dbTxMocks.TxManagerMock,
 			) {
-				messageRepo.SendMessageMock.Expect(ctxValue, message).Return(repositoryErr)
+				messageRepo.SendMessageMock.Expect(ctxValue, message).Return(0, repositoryErr)
 				txManager.ReadCommitedMock.Set(func(ctxValue context.Context, f repositoryTx.Handler) error {
 					return f(ctxValue)
 				})
 			},
 		},
 		{
-			name: "creating log in db error",
-			err:  logErr,
+			name:      "outbox error",
+			err:       outboxErr,
+			event:     event,
+			outboxErr: outboxErr,
+			args: args{
+				req: req,
+			},
+			setupMocks: func(_ *repositoryMocks.ChatRepositoryMock,
+				messageRepo *repositoryMocks.MessageRepositoryMock,
+				_ *repositoryMocks.LogRepositoryMock,
+				txManager *dbTxMocks.TxManagerMock,
+			) {
+				messageRepo.SendMessageMock.Expect(ctxValue, message).Return(messageID, nil)
+				txManager.ReadCommitedMock.Set(func(ctxValue context.Context, f repositoryTx.Handler) error {
+					return f(ctxValue)
+				})
+			},
+		},
+		{
+			name:  "creating log in db error",
+			err:   logErr,
+			event: event,
 			args: args{
 				req: req,
 			},
@@ -555,13 +620,77 @@ func TestSendMessage(t *testing.T) {
 				logRepo *repositoryMocks.LogRepositoryMock,
 				txManager *dbTxMocks.TxManagerMock,
 			) {
-				messageRepo.SendMessageMock.Expect(ctxValue, message).Return(nil)
+				messageRepo.SendMessageMock.Expect(ctxValue, message).Return(messageID, nil)
 				logRepo.CreateMock.Expect(ctxValue, logEntry).Return(logErr)
 				txManager.ReadCommitedMock.Set(func(ctxValue context.Context, f repositoryTx.Handler) error {
 					return f(ctxValue)
 				})
 			},
 		},
+		{
+			name:  "muted user",
+			err:   model.ErrMuted,
+			muted: true,
+			args: args{
+				req: req,
+			},
+			setupMocks: func(_ *repositoryMocks.ChatRepositoryMock,
+				_ *repositoryMocks.MessageRepositoryMock,
+				_ *repositoryMocks.LogRepositoryMock,
+				txManager *dbTxMocks.TxManagerMock,
+			) {
+				txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+					return f(ctx)
+				})
+			},
+		},
+		{
+			name:       "archived chat",
+			err:        model.ErrChatArchived,
+			archivedAt: &archivedAt,
+			args: args{
+				req: req,
+			},
+			setupMocks: func(_ *repositoryMocks.ChatRepositoryMock,
+				_ *repositoryMocks.MessageRepositoryMock,
+				_ *repositoryMocks.LogRepositoryMock,
+				txManager *dbTxMocks.TxManagerMock,
+			) {
+				txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+					return f(ctx)
+				})
+			},
+		},
+		{
+			name: "bot out of its scope",
+			err:  model.ErrPermissionDenied,
+			ctx:  model.ContextWithBot(ctxValue, &model.Bot{Name: "deploy", ChatIDs: []int64{id + 1}}),
+			args: args{
+				req: req,
+			},
+			setupMocks: func(_ *repositoryMocks.ChatRepositoryMock,
+				_ *repositoryMocks.MessageRepositoryMock,
+				_ *repositoryMocks.LogRepositoryMock,
+				_ *dbTxMocks.TxManagerMock,
+			) {
+			},
+		},
+		{
+			name: "user posing as a bot",
+			err:  model.ErrPermissionDenied,
+			args: args{
+				req: &model.SendMessage{
+					ChatID:  id,
+					Message: model.Message{From: "deploy[bot]", Text: text},
+				},
+			},
+			setupMocks: func(_ *repositoryMocks.ChatRepositoryMock,
+				_ *repositoryMocks.MessageRepositoryMock,
+				_ *repositoryMocks.LogRepositoryMock,
+				_ *dbTxMocks.TxManagerMock,
+			) {
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -572,16 +701,2278 @@ func TestSendMessage(t *testing.T) {
 
 			userRepo := repositoryMocks.NewChatRepositoryMock(mc)
 			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
 			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
 			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
 
 			// Настройка моков в соответствии с тестами
 			tt.setupMocks(userRepo, messageRepo, logRepo, txManager)
+			userRepo.GetMock.Optional().Expect(ctxValue, id).Return(&model.Chat{ID: id, ArchivedAt: tt.archivedAt}, nil)
+			userRepo.IsMutedMock.Optional().Expect(ctxValue, id, from).Return(tt.muted, nil)
+
+			if tt.event != nil {
+				outboxRepo.CreateMock.Expect(ctxValue, tt.event).Return(tt.outboxErr)
+			}
 
-			service := chatService.NewService(userRepo, messageRepo, logRepo, txManager)
+			service := chatService.NewService(userRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
 
-			err := service.SendMessage(ctxValue, tt.args.req)
+			ctx := ctxValue
+			if tt.ctx != nil {
+				ctx = tt.ctx
+			}
+
+			id, err := service.SendMessage(ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, id)
 		})
 	}
 }
+
+func TestEditMessage(t *testing.T) {
+	t.Parallel()
+
+	type setupMocks func(
+		chatRepo *repositoryMocks.ChatRepositoryMock,
+		messageRepo *repositoryMocks.MessageRepositoryMock,
+		editRepo *repositoryMocks.MessageEditRepositoryMock,
+		logRepo *repositoryMocks.LogRepositoryMock,
+		txManager *dbTxMocks.TxManagerMock,
+	)
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		author    = gofakeit.Username()
+		admin     = gofakeit.Username()
+		stranger  = gofakeit.Username()
+		oldText   = gofakeit.Color()
+		newText   = gofakeit.Color()
+
+		repositoryErr = fmt.Errorf("get message error")
+
+		stored = func() *model.ChatMessage {
+			return &model.ChatMessage{
+				ChatID: chatID,
+				Message: model.Message{
+					ID:   messageID,
+					From: author,
+					Text: oldText,
+				},
+			}
+		}
+
+		chat = &model.Chat{
+			ID: chatID,
+			Info: model.ChatInfo{
+				Usernames: []string{author, admin, stranger},
+				Admins:    []string{admin},
+			},
+		}
+
+		archivedAt = time.Now()
+		archived   = &model.Chat{
+			ID:         chatID,
+			Info:       chat.Info,
+			ArchivedAt: &archivedAt,
+		}
+
+		edit = func(username string) *model.EditMessage {
+			return &model.EditMessage{
+				ChatID:    chatID,
+				MessageID: messageID,
+				Username:  username,
+				Text:      newText,
+			}
+		}
+
+		logEntry = func(username string) *model.LogEntry {
+			return &model.LogEntry{
+				ChatID:    chatID,
+				MessageID: messageID,
+				Action:    model.LogActionEditMessage,
+				Actor:     username,
+				Details:   model.LogDetails{"text": newText},
+			}
+		}
+
+		inTx = func(txManager *dbTxMocks.TxManagerMock) {
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+		}
+
+		expectEdit = func(
+			messageRepo *repositoryMocks.MessageRepositoryMock,
+			editRepo *repositoryMocks.MessageEditRepositoryMock,
+			logRepo *repositoryMocks.LogRepositoryMock,
+			username string,
+		) {
+			editRepo.CreateMock.Set(func(_ context.Context, edit *model.MessageEdit) error {
+				require.Equal(t, messageID, edit.MessageID)
+				require.Equal(t, oldText, edit.Text)
+				require.Equal(t, username, edit.EditedBy)
+
+				return nil
+			})
+			messageRepo.UpdateMock.ExpectIdParam2(messageID).ExpectTextParam3(newText).Return(nil)
+			logRepo.CreateMock.Expect(ctxValue, logEntry(username)).Return(nil)
+		}
+	)
+
+	tests := []struct {
+		name       string
+		req        *model.EditMessage
+		setupMocks setupMocks
+		err        error
+	}{
+		{
+			name: "author edits",
+			req:  edit(author),
+			setupMocks: func(chatRepo *repositoryMocks.ChatRepositoryMock,
+				messageRepo *repositoryMocks.MessageRepositoryMock,
+				editRepo *repositoryMocks.MessageEditRepositoryMock,
+				logRepo *repositoryMocks.LogRepositoryMock,
+				txManager *dbTxMocks.TxManagerMock,
+			) {
+				messageRepo.GetMock.Expect(ctxValue, messageID).Return(stored(), nil)
+				expectEdit(messageRepo, editRepo, logRepo, author)
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(chat, nil)
+				inTx(txManager)
+			},
+		},
+		{
+			name: "admin edits",
+			req:  edit(admin),
+			setupMocks: func(chatRepo *repositoryMocks.ChatRepositoryMock,
+				messageRepo *repositoryMocks.MessageRepositoryMock,
+				editRepo *repositoryMocks.MessageEditRepositoryMock,
+				logRepo *repositoryMocks.LogRepositoryMock,
+				txManager *dbTxMocks.TxManagerMock,
+			) {
+				messageRepo.GetMock.Expect(ctxValue, messageID).Return(stored(), nil)
+				expectEdit(messageRepo, editRepo, logRepo, admin)
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(chat, nil)
+				inTx(txManager)
+			},
+		},
+		{
+			name: "stranger is denied",
+			req:  edit(stranger),
+			err:  model.ErrPermissionDenied,
+			setupMocks: func(chatRepo *repositoryMocks.ChatRepositoryMock,
+				messageRepo *repositoryMocks.MessageRepositoryMock,
+				_ *repositoryMocks.MessageEditRepositoryMock,
+				_ *repositoryMocks.LogRepositoryMock,
+				txManager *dbTxMocks.TxManagerMock,
+			) {
+				messageRepo.GetMock.Expect(ctxValue, messageID).Return(stored(), nil)
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(chat, nil)
+				inTx(txManager)
+			},
+		},
+		{
+			name: "message of another chat",
+			req:  edit(author),
+			err:  model.ErrMessageNotFound,
+			setupMocks: func(chatRepo *repositoryMocks.ChatRepositoryMock,
+				messageRepo *repositoryMocks.MessageRepositoryMock,
+				_ *repositoryMocks.MessageEditRepositoryMock,
+				_ *repositoryMocks.LogRepositoryMock,
+				txManager *dbTxMocks.TxManagerMock,
+			) {
+				message := stored()
+				message.ChatID = chatID + 1
+				messageRepo.GetMock.Expect(ctxValue, messageID).Return(message, nil)
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(chat, nil)
+				inTx(txManager)
+			},
+		},
+		{
+			name: "deleted message",
+			req:  edit(author),
+			err:  model.ErrMessageNotFound,
+			setupMocks: func(chatRepo *repositoryMocks.ChatRepositoryMock,
+				messageRepo *repositoryMocks.MessageRepositoryMock,
+				_ *repositoryMocks.MessageEditRepositoryMock,
+				_ *repositoryMocks.LogRepositoryMock,
+				txManager *dbTxMocks.TxManagerMock,
+			) {
+				deletedAt := time.Now()
+				message := stored()
+				message.Message.DeletedAt = &deletedAt
+				messageRepo.GetMock.Expect(ctxValue, messageID).Return(message, nil)
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(chat, nil)
+				inTx(txManager)
+			},
+		},
+		{
+			name: "archived chat",
+			req:  edit(author),
+			err:  model.ErrChatArchived,
+			setupMocks: func(chatRepo *repositoryMocks.ChatRepositoryMock,
+				_ *repositoryMocks.MessageRepositoryMock,
+				_ *repositoryMocks.MessageEditRepositoryMock,
+				_ *repositoryMocks.LogRepositoryMock,
+				txManager *dbTxMocks.TxManagerMock,
+			) {
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(archived, nil)
+				inTx(txManager)
+			},
+		},
+		{
+			name: "messageRepo error",
+			req:  edit(author),
+			err:  repositoryErr,
+			setupMocks: func(chatRepo *repositoryMocks.ChatRepositoryMock,
+				messageRepo *repositoryMocks.MessageRepositoryMock,
+				_ *repositoryMocks.MessageEditRepositoryMock,
+				_ *repositoryMocks.LogRepositoryMock,
+				txManager *dbTxMocks.TxManagerMock,
+			) {
+				messageRepo.GetMock.Expect(ctxValue, messageID).Return(nil, repositoryErr)
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(chat, nil)
+				inTx(txManager)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			tt.setupMocks(chatRepo, messageRepo, editRepo, logRepo, txManager)
+
+			if tt.err == nil {
+				outboxRepo.CreateMock.Set(func(_ context.Context, event *model.ChatEvent) error {
+					require.Equal(t, model.ChatEventMessageEdited, event.Type)
+					require.Equal(t, tt.req.Username, event.Username)
+					require.Equal(t, newText, event.Message.Text)
+
+					return nil
+				})
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			message, err := service.EditMessage(ctxValue, tt.req)
+			require.Equal(t, tt.err, err)
+
+			if tt.err == nil {
+				require.Equal(t, newText, message.Text)
+				require.NotNil(t, message.EditedAt)
+			}
+		})
+	}
+}
+
+func TestDeleteMessage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		author    = gofakeit.Username()
+		stranger  = gofakeit.Username()
+		text      = gofakeit.Color()
+
+		stored = func() *model.ChatMessage {
+			return &model.ChatMessage{
+				ChatID: chatID,
+				Message: model.Message{
+					ID:   messageID,
+					From: author,
+					Text: text,
+				},
+			}
+		}
+
+		chat = &model.Chat{
+			ID: chatID,
+			Info: model.ChatInfo{
+				Usernames: []string{author, stranger},
+			},
+		}
+	)
+
+	tests := []struct {
+		name     string
+		username string
+		archived bool
+		err      error
+	}{
+		{
+			name:     "author deletes",
+			username: author,
+		},
+		{
+			name:     "stranger is denied",
+			username: stranger,
+			err:      model.ErrPermissionDenied,
+		},
+		{
+			name:     "archived chat",
+			username: author,
+			archived: true,
+			err:      model.ErrChatArchived,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			if tt.archived {
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(chatOf(chatID, true), nil)
+			} else {
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(chat, nil)
+				messageRepo.GetMock.Expect(ctxValue, messageID).Return(stored(), nil)
+			}
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+
+			if tt.err == nil {
+				editRepo.CreateMock.Set(func(_ context.Context, edit *model.MessageEdit) error {
+					require.Equal(t, text, edit.Text)
+
+					return nil
+				})
+				messageRepo.DeleteMock.ExpectIdParam2(messageID).Return(nil)
+				logRepo.CreateMock.Return(nil)
+				outboxRepo.CreateMock.Set(func(_ context.Context, event *model.ChatEvent) error {
+					require.Equal(t, model.ChatEventMessageDeleted, event.Type)
+					require.Equal(t, tt.username, event.Username)
+					require.NotNil(t, event.Message.DeletedAt)
+
+					return nil
+				})
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			message, err := service.DeleteMessage(ctxValue, &model.DeleteMessage{
+				ChatID:    chatID,
+				MessageID: messageID,
+				Username:  tt.username,
+			})
+			require.Equal(t, tt.err, err)
+
+			if tt.err == nil {
+				require.Empty(t, message.Text)
+				require.NotNil(t, message.DeletedAt)
+			}
+		})
+	}
+}
+
+func TestListMessages(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID   = gofakeit.Int64()
+		beforeID = gofakeit.Int64()
+
+		repositoryErr = fmt.Errorf("repository error")
+		parentFrom    = gofakeit.Username()
+
+		messages = func() []*model.Message {
+			return []*model.Message{
+				{ID: 2, From: gofakeit.Username(), Text: gofakeit.Color(), ReplyToID: 1},
+				{ID: 1, From: parentFrom, Text: gofakeit.Color()},
+			}
+		}
+
+		counts = map[int64][]model.ReactionCount{
+			2: {{Emoji: "👍", Count: 3}},
+		}
+	)
+
+	tests := []struct {
+		name     string
+		page     *model.MessagesPage
+		limit    uint64
+		listErr  error
+		err      error
+		reaction []model.ReactionCount
+	}{
+		{
+			name:     "success case",
+			page:     &model.MessagesPage{ChatID: chatID, BeforeID: beforeID, Limit: 10},
+			limit:    10,
+			reaction: counts[2],
+		},
+		{
+			name:     "default limit",
+			page:     &model.MessagesPage{ChatID: chatID},
+			limit:    50,
+			reaction: counts[2],
+		},
+		{
+			name:    "messageRepo error",
+			page:    &model.MessagesPage{ChatID: chatID},
+			limit:   50,
+			listErr: repositoryErr,
+			err:     repositoryErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+			messageRepo.ListMock.Set(func(_ context.Context, page *model.MessagesPage) ([]*model.Message, error) {
+				require.Equal(t, tt.limit, page.Limit)
+
+				if tt.listErr != nil {
+					return nil, tt.listErr
+				}
+
+				return messages(), nil
+			})
+
+			if tt.err == nil {
+				reactionRepo.CountsMock.Expect(ctxValue, []int64{2, 1}).Return(counts, nil)
+				messageRepo.ReplyCountsMock.Expect(ctxValue, []int64{2, 1}).Return(map[int64]int64{1: 1}, nil)
+				messageRepo.ListByIDsMock.Expect(ctxValue, []int64{1}).Return([]*model.Message{
+					{ID: 1, From: parentFrom},
+				}, nil)
+				auditWriter.WriteMock.Return()
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			res, err := service.ListMessages(ctxValue, tt.page)
+			require.Equal(t, tt.err, err)
+
+			if tt.err == nil {
+				require.Len(t, res, 2)
+				require.Equal(t, tt.reaction, res[0].Reactions)
+				require.Empty(t, res[1].Reactions)
+				require.Equal(t, &model.MessagePreview{ID: 1, From: parentFrom}, res[0].Parent)
+				require.Equal(t, int64(1), res[1].ReplyCount)
+			}
+		})
+	}
+}
+
+func TestAddReaction(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+
+		reaction = &model.Reaction{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Username:  gofakeit.Username(),
+			Emoji:     "🔥",
+		}
+
+		stored = func(chatID int64, deleted bool) *model.ChatMessage {
+			message := &model.ChatMessage{
+				ChatID:  chatID,
+				Message: model.Message{ID: messageID},
+			}
+
+			if deleted {
+				deletedAt := time.Now()
+				message.Message.DeletedAt = &deletedAt
+			}
+
+			return message
+		}
+	)
+
+	tests := []struct {
+		name      string
+		message   *model.ChatMessage
+		archived  bool
+		duplicate bool
+		err       error
+	}{
+		{
+			name:    "success case",
+			message: stored(chatID, false),
+		},
+		{
+			name:      "duplicate reaction",
+			message:   stored(chatID, false),
+			duplicate: true,
+		},
+		{
+			name:    "message of another chat",
+			message: stored(chatID+1, false),
+			err:     model.ErrMessageNotFound,
+		},
+		{
+			name:    "deleted message",
+			message: stored(chatID, true),
+			err:     model.ErrMessageNotFound,
+		},
+		{
+			name:     "archived chat",
+			archived: true,
+			err:      model.ErrChatArchived,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+			chatRepo.GetMock.Expect(ctxValue, chatID).Return(chatOf(chatID, tt.archived), nil)
+			if !tt.archived {
+				messageRepo.GetMock.Expect(ctxValue, messageID).Return(tt.message, nil)
+			}
+
+			if tt.err == nil {
+				reactionRepo.AddMock.Expect(ctxValue, reaction).Return(!tt.duplicate, nil)
+			}
+
+			// A duplicate changes nothing, it emits no event and no audit entry.
+			if tt.err == nil && !tt.duplicate {
+				logRepo.CreateMock.Return(nil)
+				outboxRepo.CreateMock.Expect(ctxValue, &model.ChatEvent{
+					ChatID:   chatID,
+					Type:     model.ChatEventReactionAdded,
+					Username: reaction.Username,
+					Reaction: reaction,
+				}).Return(nil)
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			err := service.AddReaction(ctxValue, reaction)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestRemoveReaction(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+
+		reaction = &model.Reaction{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Username:  gofakeit.Username(),
+			Emoji:     "🔥",
+		}
+	)
+
+	tests := []struct {
+		name     string
+		archived bool
+		missing  bool
+		err      error
+	}{
+		{
+			name: "success case",
+		},
+		{
+			name:    "no reaction to remove",
+			missing: true,
+		},
+		{
+			name:     "archived chat",
+			archived: true,
+			err:      model.ErrChatArchived,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+			chatRepo.GetMock.Expect(ctxValue, chatID).Return(chatOf(chatID, tt.archived), nil)
+
+			if tt.err == nil {
+				messageRepo.GetMock.Expect(ctxValue, messageID).Return(&model.ChatMessage{
+					ChatID:  chatID,
+					Message: model.Message{ID: messageID},
+				}, nil)
+				reactionRepo.RemoveMock.Expect(ctxValue, reaction).Return(!tt.missing, nil)
+			}
+
+			if tt.err == nil && !tt.missing {
+				logRepo.CreateMock.Return(nil)
+				outboxRepo.CreateMock.Expect(ctxValue, &model.ChatEvent{
+					ChatID:   chatID,
+					Type:     model.ChatEventReactionRemoved,
+					Username: reaction.Username,
+					Reaction: reaction,
+				}).Return(nil)
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			err := service.RemoveReaction(ctxValue, reaction)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+// chatOf returns a live chat, or an archived one.
+func chatOf(chatID int64, archived bool) *model.Chat {
+	chat := &model.Chat{ID: chatID}
+	if archived {
+		archivedAt := time.Now()
+		chat.ArchivedAt = &archivedAt
+	}
+
+	return chat
+}
+
+func TestSendReply(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		parentID  = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+
+		reply = &model.SendMessage{
+			ChatID: chatID,
+			Message: model.Message{
+				From:      gofakeit.Username(),
+				Text:      gofakeit.Color(),
+				ReplyToID: parentID,
+			},
+		}
+	)
+
+	tests := []struct {
+		name   string
+		parent *model.ChatMessage
+		err    error
+	}{
+		{
+			name:   "success case",
+			parent: &model.ChatMessage{ChatID: chatID, Message: model.Message{ID: parentID}},
+		},
+		{
+			name:   "parent of another chat",
+			parent: &model.ChatMessage{ChatID: chatID + 1, Message: model.Message{ID: parentID}},
+			err:    model.ErrInvalidReply,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			chatRepo.GetMock.Optional().Return(&model.Chat{}, nil)
+			chatRepo.IsMutedMock.Return(false, nil)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+			messageRepo.GetMock.Expect(ctxValue, parentID).Return(tt.parent, nil)
+
+			if tt.err == nil {
+				messageRepo.SendMessageMock.Expect(ctxValue, reply).Return(messageID, nil)
+				logRepo.CreateMock.Return(nil)
+				outboxRepo.CreateMock.Set(func(_ context.Context, event *model.ChatEvent) error {
+					require.Equal(t, messageID, event.Message.ID)
+					require.Equal(t, parentID, event.Message.ReplyToID)
+
+					return nil
+				})
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			id, err := service.SendMessage(ctxValue, reply)
+			require.Equal(t, tt.err, err)
+
+			if tt.err == nil {
+				require.Equal(t, messageID, id)
+			}
+		})
+	}
+}
+
+func TestListThread(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID   = gofakeit.Int64()
+		parentID = gofakeit.Int64()
+		replyID  = parentID + 1
+		from     = gofakeit.Username()
+
+		page = &model.ThreadPage{
+			ChatID:    chatID,
+			MessageID: parentID,
+		}
+	)
+
+	tests := []struct {
+		name   string
+		chatID int64
+		err    error
+	}{
+		{
+			name:   "success case",
+			chatID: chatID,
+		},
+		{
+			name:   "message of another chat",
+			chatID: chatID + 1,
+			err:    model.ErrMessageNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+			messageRepo.GetMock.Expect(ctxValue, parentID).Return(&model.ChatMessage{
+				ChatID:  tt.chatID,
+				Message: model.Message{ID: parentID, From: from},
+			}, nil)
+
+			if tt.err == nil {
+				messageRepo.ListRepliesMock.Set(func(_ context.Context, page *model.ThreadPage) ([]*model.Message, error) {
+					require.Equal(t, uint64(50), page.Limit)
+
+					return []*model.Message{{ID: replyID, ReplyToID: parentID}}, nil
+				})
+				reactionRepo.CountsMock.Return(map[int64][]model.ReactionCount{}, nil)
+				messageRepo.ReplyCountsMock.Expect(ctxValue, []int64{parentID, replyID}).
+					Return(map[int64]int64{parentID: 1}, nil)
+				messageRepo.ListByIDsMock.Expect(ctxValue, []int64{parentID}).
+					Return([]*model.Message{{ID: parentID, From: from}}, nil)
+				auditWriter.WriteMock.Return()
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			thread, err := service.ListThread(ctxValue, &model.ThreadPage{
+				ChatID:    page.ChatID,
+				MessageID: page.MessageID,
+			})
+			require.Equal(t, tt.err, err)
+
+			if tt.err == nil {
+				require.Equal(t, int64(1), thread.Parent.ReplyCount)
+				require.Len(t, thread.Replies, 1)
+				require.Equal(t, &model.MessagePreview{ID: parentID, From: from}, thread.Replies[0].Parent)
+			}
+		})
+	}
+}
+
+func TestSearchMessages(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		username  = gofakeit.Username()
+		text      = gofakeit.Color()
+
+		repositoryErr = fmt.Errorf("repository error")
+	)
+
+	tests := []struct {
+		name      string
+		searchErr error
+		err       error
+	}{
+		{
+			name: "success case",
+		},
+		{
+			name:      "messageRepo error",
+			searchErr: repositoryErr,
+			err:       repositoryErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+			messageRepo.SearchMock.Set(func(_ context.Context, query *model.SearchQuery) ([]*model.SearchResult, error) {
+				require.Equal(t, uint64(50), query.Limit)
+				require.Equal(t, username, query.Username)
+
+				if tt.searchErr != nil {
+					return nil, tt.searchErr
+				}
+
+				return []*model.SearchResult{
+					{
+						ChatID:  chatID,
+						Message: &model.Message{ID: messageID, Text: text},
+						Snippet: "<b>" + text + "</b>",
+					},
+				}, nil
+			})
+
+			if tt.err == nil {
+				reactionRepo.CountsMock.Expect(ctxValue, []int64{messageID}).
+					Return(map[int64][]model.ReactionCount{messageID: {{Emoji: "👍", Count: 1}}}, nil)
+				messageRepo.ReplyCountsMock.Return(map[int64]int64{}, nil)
+				messageRepo.ListByIDsMock.Return(nil, nil)
+				auditWriter.WriteMock.Return()
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			results, err := service.SearchMessages(ctxValue, &model.SearchQuery{
+				Username: username,
+				Query:    text,
+			})
+			require.Equal(t, tt.err, err)
+
+			if tt.err == nil {
+				require.Len(t, results, 1)
+				require.Equal(t, chatID, results[0].ChatID)
+				require.Len(t, results[0].Message.Reactions, 1)
+			}
+		})
+	}
+}
+
+func TestListAuditLog(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		actor  = gofakeit.Username()
+
+		repositoryErr = fmt.Errorf("repository error")
+
+		entries = []*model.LogEntry{
+			{
+				ID:     2,
+				ChatID: chatID,
+				Action: model.LogActionDeleteChat,
+				Actor:  actor,
+			},
+		}
+	)
+
+	tests := []struct {
+		name    string
+		listErr error
+		err     error
+	}{
+		{
+			name: "success case",
+		},
+		{
+			name:    "logRepo error",
+			listErr: repositoryErr,
+			err:     repositoryErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+			logRepo.ListMock.Set(func(_ context.Context, filter *model.LogFilter) ([]*model.LogEntry, error) {
+				require.Equal(t, uint64(50), filter.Limit)
+				require.Equal(t, model.LogActionDeleteChat, filter.Action)
+
+				return entries, tt.listErr
+			})
+
+			if tt.err == nil {
+				auditWriter.WriteMock.Set(func(logEntry *model.LogEntry) {
+					require.Equal(t, model.LogActionListAuditLog, logEntry.Action)
+				})
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			res, err := service.ListAuditLog(ctxValue, &model.LogFilter{
+				ChatID: chatID,
+				Action: model.LogActionDeleteChat,
+			})
+			require.Equal(t, tt.err, err)
+
+			if tt.err == nil {
+				require.Equal(t, entries, res)
+			}
+		})
+	}
+}
+
+func TestRunCommand(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		admin  = gofakeit.Username()
+		member = gofakeit.Username()
+
+		chat = &model.Chat{
+			ID:   chatID,
+			Info: model.ChatInfo{Usernames: []string{admin, member}, Admins: []string{admin}},
+		}
+
+		handlerErr = fmt.Errorf("handler error")
+	)
+
+	tests := []struct {
+		name     string
+		caller   string
+		archived bool
+		external bool
+		runErr   error
+		reply    string
+		err      error
+	}{
+		{
+			name:   "success case",
+			caller: member,
+			reply:  "pong",
+		},
+		{
+			name:   "not a member",
+			caller: gofakeit.Username(),
+			err:    model.ErrPermissionDenied,
+		},
+		{
+			name:     "archived chat",
+			caller:   member,
+			archived: true,
+			err:      model.ErrChatArchived,
+		},
+		{
+			name:     "external command runs out of the transaction",
+			caller:   member,
+			external: true,
+			reply:    "pong",
+		},
+		{
+			name:   "handler error",
+			caller: member,
+			runErr: handlerErr,
+			err:    handlerErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+			handler := serviceMocks.NewCommandHandlerMock(mc)
+
+			handler.SpecMock.Return(model.CommandSpec{Name: "ping", Role: model.ChatRoleMember, External: tt.external})
+			if tt.archived {
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(chatOf(chatID, true), nil)
+			} else {
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(chat, nil)
+			}
+
+			if tt.err != model.ErrPermissionDenied && !tt.archived {
+				var inTx bool
+				txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+					inTx = true
+					defer func() { inTx = false }()
+
+					return f(ctx)
+				})
+				handler.RunMock.Set(func(_ context.Context, call *model.CommandCall) (string, error) {
+					require.Equal(t, !tt.external, inTx)
+					require.Equal(t, "ping", call.Name)
+					require.Equal(t, chat, call.Chat)
+
+					return tt.reply, tt.runErr
+				})
+			}
+
+			if tt.err == nil {
+				logRepo.CreateMock.Set(func(_ context.Context, logEntry *model.LogEntry) error {
+					require.Equal(t, model.LogActionRunCommand, logEntry.Action)
+					require.Equal(t, tt.caller, logEntry.Actor)
+
+					return nil
+				})
+			}
+
+			registry, err := command.NewRegistry(handler)
+			require.NoError(t, err)
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), registry)
+
+			reply, err := service.RunCommand(ctxValue, &model.CommandCall{
+				ChatID: chatID,
+				Caller: tt.caller,
+				Text:   "/ping",
+			})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.reply, reply)
+		})
+	}
+}
+
+func TestScheduleMessage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID      = gofakeit.Int64()
+		scheduledID = gofakeit.Int64()
+		from        = gofakeit.Username()
+		text        = gofakeit.Color()
+	)
+
+	tests := []struct {
+		name        string
+		from        string
+		scheduledAt time.Time
+		archived    bool
+		err         error
+	}{
+		{
+			name:        "success case",
+			from:        from,
+			scheduledAt: time.Now().Add(time.Hour),
+		},
+		{
+			name:        "in the past",
+			from:        from,
+			scheduledAt: time.Now().Add(-time.Minute),
+			err:         model.ErrInvalidSchedule,
+		},
+		{
+			name:        "too far ahead",
+			from:        from,
+			scheduledAt: time.Now().Add(model.MaxScheduleAhead + time.Hour),
+			err:         model.ErrInvalidSchedule,
+		},
+		{
+			name:        "archived chat",
+			from:        from,
+			scheduledAt: time.Now().Add(time.Hour),
+			archived:    true,
+			err:         model.ErrChatArchived,
+		},
+		{
+			name:        "user posing as a bot",
+			from:        "deploy[bot]",
+			scheduledAt: time.Now().Add(time.Hour),
+			err:         model.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			scheduled := &model.ScheduledMessage{
+				ChatID:      chatID,
+				Message:     model.Message{From: tt.from, Text: text},
+				ScheduledAt: tt.scheduledAt,
+			}
+
+			if tt.err == nil || tt.archived {
+				txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+					return f(ctx)
+				})
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(chatOf(chatID, tt.archived), nil)
+			}
+
+			if tt.err == nil {
+				scheduledRepo.CreateMock.Expect(ctxValue, scheduled).Return(scheduledID, nil)
+				logRepo.CreateMock.Set(func(_ context.Context, logEntry *model.LogEntry) error {
+					require.Equal(t, model.LogActionScheduleMessage, logEntry.Action)
+					require.Equal(t, scheduledID, logEntry.Details["scheduled_id"])
+
+					return nil
+				})
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			id, err := service.ScheduleMessage(ctxValue, scheduled)
+			require.Equal(t, tt.err, err)
+
+			if tt.err == nil {
+				require.Equal(t, scheduledID, id)
+			}
+		})
+	}
+}
+
+func TestCancelScheduled(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID      = gofakeit.Int64()
+		scheduledID = gofakeit.Int64()
+		author      = gofakeit.Username()
+		admin       = gofakeit.Username()
+		member      = gofakeit.Username()
+
+		scheduled = &model.ScheduledMessage{
+			ID:      scheduledID,
+			ChatID:  chatID,
+			Message: model.Message{From: author, Text: gofakeit.Color()},
+		}
+		chat = &model.Chat{
+			ID:   chatID,
+			Info: model.ChatInfo{Usernames: []string{author, admin, member}, Admins: []string{admin}},
+		}
+	)
+
+	tests := []struct {
+		name     string
+		chatID   int64
+		username string
+		getChat  bool
+		err      error
+	}{
+		{
+			name:     "success case",
+			chatID:   chatID,
+			username: author,
+		},
+		{
+			name:     "chat admin",
+			chatID:   chatID,
+			username: admin,
+			getChat:  true,
+		},
+		{
+			name:     "another member",
+			chatID:   chatID,
+			username: member,
+			getChat:  true,
+			err:      model.ErrPermissionDenied,
+		},
+		{
+			name:     "another chat",
+			chatID:   chatID + 1,
+			username: author,
+			err:      model.ErrScheduledNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+			scheduledRepo.GetMock.Expect(ctxValue, scheduledID).Return(scheduled, nil)
+
+			if tt.getChat {
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(chat, nil)
+			}
+
+			if tt.err == nil {
+				scheduledRepo.DeleteMock.Expect(ctxValue, scheduledID).Return(nil)
+				logRepo.CreateMock.Set(func(_ context.Context, logEntry *model.LogEntry) error {
+					require.Equal(t, model.LogActionCancelScheduled, logEntry.Action)
+					require.Equal(t, tt.username, logEntry.Actor)
+
+					return nil
+				})
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			err := service.CancelScheduled(ctxValue, &model.CancelScheduled{
+				ChatID:   tt.chatID,
+				ID:       scheduledID,
+				Username: tt.username,
+			})
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestDeliverScheduled(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID      = gofakeit.Int64()
+		scheduledID = gofakeit.Int64()
+		messageID   = gofakeit.Int64()
+
+		scheduled = &model.ScheduledMessage{
+			ID:      scheduledID,
+			ChatID:  chatID,
+			Message: model.Message{From: "deploy[bot]", Text: gofakeit.Color()},
+		}
+	)
+
+	tests := []struct {
+		name  string
+		muted bool
+		err   error
+	}{
+		{
+			name: "success case",
+		},
+		{
+			name:  "muted author",
+			muted: true,
+			err:   model.ErrMuted,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+			chatRepo.GetMock.Optional().Expect(ctxValue, chatID).Return(&model.Chat{ID: chatID}, nil)
+			chatRepo.IsMutedMock.Expect(ctxValue, chatID, scheduled.Message.From).Return(tt.muted, nil)
+
+			if tt.err == nil {
+				messageRepo.SendMessageMock.Set(func(_ context.Context, message *model.SendMessage) (int64, error) {
+					require.Equal(t, scheduled.Message.From, message.Message.From)
+					require.False(t, message.Message.CreatedAt.IsZero())
+
+					return messageID, nil
+				})
+				outboxRepo.CreateMock.Set(func(_ context.Context, event *model.ChatEvent) error {
+					require.Equal(t, model.ChatEventMessageSent, event.Type)
+					require.Equal(t, messageID, event.Message.ID)
+
+					return nil
+				})
+				logRepo.CreateMock.Return(nil)
+				scheduledRepo.DeleteMock.Expect(ctxValue, scheduledID).Return(nil)
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			id, err := service.DeliverScheduled(ctxValue, scheduled)
+			require.Equal(t, tt.err, err)
+
+			if tt.err == nil {
+				require.Equal(t, messageID, id)
+			}
+		})
+	}
+}
+
+func TestSetRetention(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID   = gofakeit.Int64()
+		maxAge   = 7 * 24 * time.Hour
+		maxCount = int64(1000)
+	)
+
+	tests := []struct {
+		name      string
+		retention *model.ChatRetention
+		setErr    error
+		details   model.LogDetails
+		err       error
+	}{
+		{
+			name:      "success case",
+			retention: &model.ChatRetention{ChatID: chatID, MaxAge: &maxAge, MaxCount: &maxCount},
+			details:   model.LogDetails{"max_age_seconds": int64(604800), "max_count": maxCount},
+		},
+		{
+			name:      "inherited limits",
+			retention: &model.ChatRetention{ChatID: chatID},
+			details:   model.LogDetails{},
+		},
+		{
+			name:      "unknown chat",
+			retention: &model.ChatRetention{ChatID: chatID},
+			setErr:    model.ErrChatNotFound,
+			err:       model.ErrChatNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+			chatRepo.SetRetentionMock.Expect(ctxValue, tt.retention).Return(tt.setErr)
+
+			if tt.err == nil {
+				logRepo.CreateMock.Expect(ctxValue, &model.LogEntry{
+					ChatID:  chatID,
+					Action:  model.LogActionSetRetention,
+					Details: tt.details,
+				}).Return(nil)
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			err := service.SetRetention(ctxValue, tt.retention)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestExportChat(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+
+		repositoryErr = fmt.Errorf("repository error")
+
+		chat = &model.Chat{
+			ID:   chatID,
+			Info: model.ChatInfo{Usernames: []string{gofakeit.Username()}},
+		}
+		messages = []*model.Message{
+			{ID: 1, From: gofakeit.Username(), Text: gofakeit.Color()},
+			{ID: 2, From: gofakeit.Username(), Text: gofakeit.Color()},
+		}
+		edits = map[int64][]*model.MessageEdit{
+			2: {{MessageID: 2, Text: gofakeit.Color()}},
+		}
+		reactions = map[int64][]model.ReactionCount{
+			1: {{Emoji: "👍", Count: 1}},
+		}
+		entries = []*model.LogEntry{
+			{ID: 10, ChatID: chatID, Action: model.LogActionSendMessage},
+		}
+	)
+
+	tests := []struct {
+		name    string
+		chatErr error
+		listErr error
+		want    []*model.ExportRecord
+		err     error
+	}{
+		{
+			name: "success case",
+			want: []*model.ExportRecord{
+				{Chat: chat},
+				{Message: &model.ExportMessage{
+					Message: model.Message{ID: 1, From: messages[0].From, Text: messages[0].Text, Reactions: reactions[1]},
+				}},
+				{Message: &model.ExportMessage{
+					Message: model.Message{ID: 2, From: messages[1].From, Text: messages[1].Text},
+					Edits:   edits[2],
+				}},
+				{Audit: entries[0]},
+			},
+		},
+		{
+			name:    "unknown chat",
+			chatErr: model.ErrChatNotFound,
+			err:     model.ErrChatNotFound,
+		},
+		{
+			name:    "messageRepo error",
+			listErr: repositoryErr,
+			want:    []*model.ExportRecord{{Chat: chat}},
+			err:     repositoryErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			if tt.chatErr != nil {
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(nil, tt.chatErr)
+			} else {
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(chat, nil)
+				auditWriter.WriteMock.Expect(&model.LogEntry{ChatID: chatID, Action: model.LogActionExportChat}).Return()
+			}
+
+			if tt.listErr != nil {
+				messageRepo.ListAfterMock.Expect(ctxValue, chatID, 0, 500).Return(nil, tt.listErr)
+			}
+
+			if tt.err == nil {
+				// Copies: the service sets the reactions on the listed messages.
+				listed := []*model.Message{{}, {}}
+				*listed[0], *listed[1] = *messages[0], *messages[1]
+
+				messageRepo.ListAfterMock.Expect(ctxValue, chatID, 0, 500).Return(listed, nil)
+				editRepo.ListByMessagesMock.Expect(ctxValue, []int64{1, 2}).Return(edits, nil)
+				reactionRepo.CountsMock.Expect(ctxValue, []int64{1, 2}).Return(reactions, nil)
+				logRepo.ListMock.Expect(ctxValue, &model.LogFilter{ChatID: chatID, Limit: 500}).Return(entries, nil)
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			var got []*model.ExportRecord
+			err := service.ExportChat(ctxValue, chatID, func(record *model.ExportRecord) error {
+				got = append(got, record)
+
+				return nil
+			})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestImportChat(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		readErr = fmt.Errorf("stream error")
+
+		createdAt = time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+		author    = gofakeit.Username()
+
+		chat = &model.Chat{
+			ID:    77,
+			Info:  model.ChatInfo{Usernames: []string{author}, Admins: []string{author}},
+			Topic: "release",
+		}
+		message = func(id, replyToID int64) *model.ExportRecord {
+			return &model.ExportRecord{Message: &model.ExportMessage{
+				Message: model.Message{ID: id, From: author, Text: "text", CreatedAt: createdAt, ReplyToID: replyToID},
+			}}
+		}
+		edited = func() *model.ExportRecord {
+			record := message(10, 0)
+			record.Message.Edits = []*model.MessageEdit{
+				{MessageID: 10, Text: "old", EditedBy: author, EditedAt: createdAt},
+			}
+
+			return record
+		}
+		reacted = func() *model.ExportRecord {
+			record := message(11, 10)
+			record.Message.Reactions = []model.ReactionCount{{Emoji: "👍", Count: 2}, {Emoji: "🎉", Count: 1}}
+
+			return record
+		}
+	)
+
+	tests := []struct {
+		name  string
+		lines []*model.ImportLine
+		err   error
+		// imported is false if the lines fail before the chat is created.
+		imported bool
+		want     *model.ImportReport
+	}{
+		{
+			name: "success case",
+			lines: []*model.ImportLine{
+				{Number: 1, Record: &model.ExportRecord{Chat: chat}},
+				{Number: 2, Record: edited()},
+				{Number: 3, Err: fmt.Errorf("invalid character")},
+				{Number: 4, Record: reacted()},
+				{Number: 5, Record: message(12, 99)},
+				{Number: 6, Record: message(10, 0)},
+				{Number: 7, Record: &model.ExportRecord{Audit: &model.LogEntry{ID: 1}}},
+			},
+			imported: true,
+			want: &model.ImportReport{
+				ChatID:      5,
+				Messages:    3,
+				Edits:       1,
+				FailedLines: 2,
+				Errors: []model.ImportError{
+					{Line: 3, Message: "invalid character"},
+					{Line: 6, Message: "duplicate message 10"},
+					{Line: 5, Message: "reply to unknown message 99, imported without it"},
+				},
+				SkippedAudit:     1,
+				SkippedReactions: 3,
+			},
+		},
+		{
+			name:  "message before the chat",
+			lines: []*model.ImportLine{{Number: 1, Record: message(10, 0)}},
+			err:   model.ErrInvalidImport,
+		},
+		{
+			name: "read error",
+			err:  readErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			// The transaction opens once every line is read.
+			read := false
+
+			if tt.imported {
+				txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+					require.True(t, read)
+
+					return f(ctx)
+				})
+				chatRepo.CreateMock.Expect(ctxValue, &chat.Info).Return(5, nil)
+				chatRepo.SetTopicMock.Expect(ctxValue, 5, "release").Return(nil)
+				messageRepo.NextIDsMock.Expect(ctxValue, 3).Return([]int64{100, 101, 102}, nil)
+				messageRepo.CopyMock.Set(func(_ context.Context, chatID int64, messages []*model.Message) (int64, error) {
+					require.EqualValues(t, 5, chatID)
+					require.Len(t, messages, 3)
+					require.Equal(t, []int64{100, 101, 102}, []int64{messages[0].ID, messages[1].ID, messages[2].ID})
+					require.Equal(t, []int64{0, 100, 0},
+						[]int64{messages[0].ReplyToID, messages[1].ReplyToID, messages[2].ReplyToID})
+					require.Equal(t, createdAt, messages[1].CreatedAt)
+					require.Equal(t, author, messages[1].From)
+
+					return 3, nil
+				})
+				editRepo.CopyMock.Expect(ctxValue, []*model.MessageEdit{
+					{MessageID: 100, Text: "old", EditedBy: author, EditedAt: createdAt},
+				}).Return(1, nil)
+				logRepo.CreateMock.Set(func(_ context.Context, logEntry *model.LogEntry) error {
+					require.Equal(t, model.LogActionImportChat, logEntry.Action)
+					require.EqualValues(t, 5, logEntry.ChatID)
+
+					return nil
+				})
+			}
+
+			lines := tt.lines
+			next := func() (*model.ImportLine, error) {
+				if len(lines) == 0 {
+					if tt.name == "read error" {
+						return nil, readErr
+					}
+
+					read = true
+
+					return nil, io.EOF
+				}
+
+				line := lines[0]
+				lines = lines[1:]
+
+				return line, nil
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			report, err := service.ImportChat(ctxValue, next)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.want, report)
+		})
+	}
+}
+
+func TestArchive(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		id = gofakeit.Int64()
+	)
+
+	tests := []struct {
+		name     string
+		repoErr  error
+		archived bool
+		err      error
+	}{
+		{
+			name:     "success case",
+			archived: true,
+		},
+		{
+			name:    "chat not found",
+			repoErr: model.ErrChatNotFound,
+			err:     model.ErrChatNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+			chatRepo.SetArchivedMock.Expect(ctxValue, id, true).Return(tt.repoErr)
+
+			if tt.archived {
+				// The event closes the streams of the chat.
+				outboxRepo.CreateMock.Expect(ctxValue, &model.ChatEvent{
+					ChatID: id,
+					Type:   model.ChatEventChatArchived,
+				}).Return(nil)
+				logRepo.CreateMock.Expect(ctxValue, &model.LogEntry{
+					ChatID: id,
+					Action: model.LogActionArchiveChat,
+				}).Return(nil)
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			err := service.Archive(ctxValue, id)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestRestore(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		id = gofakeit.Int64()
+	)
+
+	tests := []struct {
+		name     string
+		repoErr  error
+		restored bool
+		err      error
+	}{
+		{
+			name:     "success case",
+			restored: true,
+		},
+		{
+			name:    "purged chat",
+			repoErr: model.ErrChatNotFound,
+			err:     model.ErrChatNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+			chatRepo.RestoreMock.Expect(ctxValue, id).Return(tt.repoErr)
+
+			if tt.restored {
+				logRepo.CreateMock.Expect(ctxValue, &model.LogEntry{
+					ChatID: id,
+					Action: model.LogActionRestoreChat,
+				}).Return(nil)
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			err := service.Restore(ctxValue, id)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestAckDelivery(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		username  = gofakeit.Username()
+
+		ack = &model.DeliveryAck{
+			ChatID:    chatID,
+			Username:  username,
+			MessageID: messageID,
+		}
+	)
+
+	tests := []struct {
+		name          string
+		members       []string
+		messageChatID int64
+		read          bool
+		archived      bool
+		chatErr       error
+		acked         bool
+		err           error
+	}{
+		{
+			name:          "success case",
+			members:       []string{username},
+			messageChatID: chatID,
+			acked:         true,
+		},
+		{
+			name:          "mark read case",
+			members:       []string{username},
+			messageChatID: chatID,
+			read:          true,
+			acked:         true,
+		},
+		{
+			name:    "not a member",
+			members: []string{gofakeit.Username()},
+			err:     model.ErrPermissionDenied,
+		},
+		{
+			name:          "archived chat",
+			members:       []string{username},
+			messageChatID: chatID,
+			archived:      true,
+			acked:         true,
+		},
+		{
+			name:          "mark read on an archived chat",
+			members:       []string{username},
+			messageChatID: chatID,
+			read:          true,
+			archived:      true,
+			acked:         true,
+		},
+		{
+			name:    "deleted chat",
+			chatErr: model.ErrChatNotFound,
+			err:     model.ErrChatNotFound,
+		},
+		{
+			name:          "message of another chat",
+			members:       []string{username},
+			messageChatID: chatID + 1,
+			err:           model.ErrMessageNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			// The read goes to the outbox with the cursor.
+			if tt.read {
+				txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+					return f(ctx)
+				})
+			}
+
+			if tt.chatErr != nil {
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(nil, tt.chatErr)
+			} else {
+				chat := chatOf(chatID, tt.archived)
+				chat.Info = model.ChatInfo{Usernames: tt.members}
+				chatRepo.GetMock.Expect(ctxValue, chatID).Return(chat, nil)
+			}
+
+			if tt.messageChatID != 0 {
+				messageRepo.GetMock.Expect(ctxValue, messageID).Return(&model.ChatMessage{
+					ChatID:  tt.messageChatID,
+					Message: model.Message{ID: messageID},
+				}, nil)
+			}
+
+			switch {
+			case tt.acked && tt.read:
+				deliveryRepo.MarkReadMock.Expect(ctxValue, ack).Return(nil)
+				outboxRepo.CreateMock.Expect(ctxValue, &model.ChatEvent{
+					ChatID:        chatID,
+					Type:          model.ChatEventMessagesRead,
+					Username:      username,
+					ReadMessageID: messageID,
+				}).Return(nil)
+			case tt.acked:
+				deliveryRepo.AckMock.Expect(ctxValue, ack).Return(nil)
+			}
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			var err error
+			if tt.read {
+				err = service.MarkRead(ctxValue, ack)
+			} else {
+				err = service.AckDelivery(ctxValue, ack)
+			}
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestListUndelivered(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID   = gofakeit.Int64()
+		username = gofakeit.Username()
+
+		messages = []*model.Message{{ID: 11}, {ID: 12}}
+	)
+
+	tests := []struct {
+		name     string
+		cursor   int64
+		afterID  int64
+		listFrom int64
+		want     []*model.Message
+	}{
+		{
+			name:     "after the cursor",
+			cursor:   10,
+			listFrom: 10,
+			want:     messages,
+		},
+		{
+			name:     "next page",
+			cursor:   10,
+			afterID:  20,
+			listFrom: 20,
+			want:     messages,
+		},
+		{
+			name: "no cursor",
+			want: messages,
+		},
+		{
+			name:     "no cursor next page",
+			afterID:  12,
+			listFrom: 12,
+			want:     messages,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+			reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+			deliveryRepo.GetMock.Expect(ctxValue, chatID, username).Return(&model.DeliveryCursor{
+				Username:  username,
+				MessageID: tt.cursor,
+			}, nil)
+
+			// A member without a cursor gets the messages from the first one.
+			messageRepo.ListAfterMock.Expect(ctxValue, chatID, tt.listFrom, 100).Return(messages, nil)
+
+			service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+				deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+			got, err := service.ListUndelivered(ctxValue, &model.UndeliveredPage{
+				ChatID:   chatID,
+				Username: username,
+				AfterID:  tt.afterID,
+				Limit:    100,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetDeliveryStatus(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		chatID    = gofakeit.Int64()
+		messageID = int64(42)
+
+		sender    = "sender"
+		reader    = "reader"
+		delivered = "delivered"
+		offline   = "offline"
+		newbie    = "newbie"
+	)
+
+	chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+	messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+	editRepo := repositoryMocks.NewMessageEditRepositoryMock(mc)
+	reactionRepo := repositoryMocks.NewReactionRepositoryMock(mc)
+	logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+	outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+	scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+	deliveryRepo := repositoryMocks.NewDeliveryRepositoryMock(mc)
+	txManager := dbTxMocks.NewTxManagerMock(mc)
+	auditWriter := serviceMocks.NewAuditWriterMock(mc)
+
+	messageRepo.GetMock.Expect(ctxValue, messageID).Return(&model.ChatMessage{
+		ChatID:  chatID,
+		Message: model.Message{ID: messageID, From: sender},
+	}, nil)
+	chatRepo.GetMock.Expect(ctxValue, chatID).Return(&model.Chat{
+		ID:   chatID,
+		Info: model.ChatInfo{Usernames: []string{sender, reader, delivered, offline, newbie}},
+	}, nil)
+	deliveryRepo.ListMock.Expect(ctxValue, chatID).Return([]*model.DeliveryCursor{
+		{Username: reader, MessageID: messageID + 1, ReadMessageID: messageID},
+		{Username: delivered, MessageID: messageID, ReadMessageID: messageID - 1},
+		{Username: offline, MessageID: messageID - 1},
+	}, nil)
+
+	service := chatService.NewService(chatRepo, messageRepo, editRepo, reactionRepo, logRepo, outboxRepo, scheduledRepo,
+		deliveryRepo, nil, txManager, auditWriter, model.NewAuditPolicy(), nil)
+
+	members, err := service.GetDeliveryStatus(ctxValue, chatID, messageID)
+	require.NoError(t, err)
+	require.Equal(t, []*model.MemberDelivery{
+		{Username: reader, Delivered: true, Read: true},
+		{Username: delivered, Delivered: true},
+		{Username: offline},
+		{Username: newbie},
+	}, members)
+}
@@ -0,0 +1,65 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+func (s *serv) ListThread(ctx context.Context, page *model.ThreadPage) (*model.Thread, error) {
+	err := checkBotScope(ctx, page.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if page.Limit == 0 {
+		page.Limit = defaultPageSize
+	}
+
+	var thread *model.Thread
+	err = s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		parent, errTx := s.messageRepository.Get(ctx, page.MessageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if parent.ChatID != page.ChatID {
+			return model.ErrMessageNotFound
+		}
+
+		replies, errTx := s.messageRepository.ListReplies(ctx, page)
+		if errTx != nil {
+			return errTx
+		}
+
+		thread = &model.Thread{
+			Parent:  &parent.Message,
+			Replies: replies,
+		}
+
+		errTx = s.decorate(ctx, append([]*model.Message{thread.Parent}, replies...))
+		if errTx != nil {
+			return errTx
+		}
+
+		logEntry := model.LogEntry{
+			ChatID:    page.ChatID,
+			MessageID: page.MessageID,
+			Action:    model.LogActionListThread,
+			Details:   model.LogDetails{"after_id": page.AfterID},
+		}
+
+		errTx = s.audit(ctx, &logEntry)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return thread, nil
+}
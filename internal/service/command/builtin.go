@@ -0,0 +1,233 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/service"
+)
+
+const (
+	maxTopicLength = 256
+
+	defaultMuteDuration = time.Hour
+	maxMuteDuration     = 30 * 24 * time.Hour
+)
+
+// Builtins returns the commands that come with the service.
+func Builtins(
+	chatRepository repository.ChatRepository,
+	outboxRepository repository.OutboxRepository,
+	scheduledRepository repository.ScheduledRepository,
+) []service.CommandHandler {
+	return []service.CommandHandler{
+		&topic{chatRepository: chatRepository},
+		&invite{chatRepository: chatRepository, outboxRepository: outboxRepository},
+		&mute{chatRepository: chatRepository},
+		&unmute{chatRepository: chatRepository},
+		&remind{scheduledRepository: scheduledRepository},
+	}
+}
+
+type help struct {
+	registry *Registry
+}
+
+func (h *help) Spec() model.CommandSpec {
+	return model.CommandSpec{
+		Name:        "help",
+		Usage:       "/help",
+		Description: "list the commands you may run",
+		Role:        model.ChatRoleMember,
+		MaxArgs:     0,
+	}
+}
+
+func (h *help) Run(_ context.Context, call *model.CommandCall) (string, error) {
+	var b strings.Builder
+	for _, spec := range h.registry.specs(call.Chat.Role(call.Caller)) {
+		fmt.Fprintf(&b, "%s - %s\n", spec.Usage, spec.Description)
+	}
+
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+type topic struct {
+	chatRepository repository.ChatRepository
+}
+
+func (t *topic) Spec() model.CommandSpec {
+	return model.CommandSpec{
+		Name:        "topic",
+		Usage:       "/topic <text>",
+		Description: "set the topic of the chat",
+		Role:        model.ChatRoleAdmin,
+		MinArgs:     1,
+		MaxArgs:     -1,
+	}
+}
+
+func (t *topic) Run(ctx context.Context, call *model.CommandCall) (string, error) {
+	text := strings.Join(call.Args, " ")
+	if utf8.RuneCountInString(text) > maxTopicLength {
+		return "", fmt.Errorf("%w: topic is longer than %d characters", model.ErrInvalidCommand, maxTopicLength)
+	}
+
+	err := t.chatRepository.SetTopic(ctx, call.ChatID, text)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Topic set to %q", text), nil
+}
+
+type invite struct {
+	chatRepository   repository.ChatRepository
+	outboxRepository repository.OutboxRepository
+}
+
+func (i *invite) Spec() model.CommandSpec {
+	return model.CommandSpec{
+		Name:        "invite",
+		Usage:       "/invite <username>",
+		Description: "add a user to the chat",
+		Role:        model.ChatRoleAdmin,
+		MinArgs:     1,
+		MaxArgs:     1,
+	}
+}
+
+func (i *invite) Run(ctx context.Context, call *model.CommandCall) (string, error) {
+	username := call.Args[0]
+
+	added, err := i.chatRepository.AddUser(ctx, call.ChatID, username)
+	if err != nil {
+		return "", err
+	}
+
+	if !added {
+		return fmt.Sprintf("%s is in the chat already", username), nil
+	}
+
+	err = i.outboxRepository.Create(ctx, &model.ChatEvent{
+		ChatID:   call.ChatID,
+		Type:     model.ChatEventMemberAdded,
+		Username: call.Caller,
+		Chat:     &model.ChatInfo{Usernames: []string{username}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s joined the chat", username), nil
+}
+
+type mute struct {
+	chatRepository repository.ChatRepository
+}
+
+func (m *mute) Spec() model.CommandSpec {
+	return model.CommandSpec{
+		Name:        "mute",
+		Usage:       "/mute <username> [duration]",
+		Description: "keep a user from sending messages, for an hour by default",
+		Role:        model.ChatRoleAdmin,
+		MinArgs:     1,
+		MaxArgs:     2,
+	}
+}
+
+func (m *mute) Run(ctx context.Context, call *model.CommandCall) (string, error) {
+	username := call.Args[0]
+
+	switch call.Chat.Role(username) {
+	case model.ChatRoleNone:
+		return "", fmt.Errorf("%w: %s is not in the chat", model.ErrInvalidCommand, username)
+	case model.ChatRoleAdmin:
+		return "", fmt.Errorf("%w: admins cannot be muted", model.ErrPermissionDenied)
+	}
+
+	duration := defaultMuteDuration
+	if len(call.Args) > 1 {
+		var err error
+
+		duration, err = time.ParseDuration(call.Args[1])
+		if err != nil || duration <= 0 || duration > maxMuteDuration {
+			return "", fmt.Errorf("%w: duration must be like 30m or 2h, up to %s",
+				model.ErrInvalidCommand, maxMuteDuration)
+		}
+	}
+
+	err := m.chatRepository.Mute(ctx, call.ChatID, username, duration)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s is muted for %s", username, duration), nil
+}
+
+type unmute struct {
+	chatRepository repository.ChatRepository
+}
+
+func (u *unmute) Spec() model.CommandSpec {
+	return model.CommandSpec{
+		Name:        "unmute",
+		Usage:       "/unmute <username>",
+		Description: "lift the mute of a user",
+		Role:        model.ChatRoleAdmin,
+		MinArgs:     1,
+		MaxArgs:     1,
+	}
+}
+
+func (u *unmute) Run(ctx context.Context, call *model.CommandCall) (string, error) {
+	err := u.chatRepository.Unmute(ctx, call.ChatID, call.Args[0])
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s is unmuted", call.Args[0]), nil
+}
+
+type remind struct {
+	scheduledRepository repository.ScheduledRepository
+}
+
+func (r *remind) Spec() model.CommandSpec {
+	return model.CommandSpec{
+		Name:        "remind",
+		Usage:       "/remind <duration> <text>",
+		Description: "post a reminder to the chat later",
+		Role:        model.ChatRoleMember,
+		MinArgs:     2,
+		MaxArgs:     -1,
+	}
+}
+
+func (r *remind) Run(ctx context.Context, call *model.CommandCall) (string, error) {
+	duration, err := time.ParseDuration(call.Args[0])
+	if err != nil || duration <= 0 || duration > model.MaxScheduleAhead {
+		return "", fmt.Errorf("%w: duration must be like 30m or 2h, up to %s",
+			model.ErrInvalidCommand, model.MaxScheduleAhead)
+	}
+
+	id, err := r.scheduledRepository.Create(ctx, &model.ScheduledMessage{
+		ChatID: call.ChatID,
+		Message: model.Message{
+			From: call.Caller,
+			Text: "Reminder: " + strings.Join(call.Args[1:], " "),
+		},
+		ScheduledAt: time.Now().Add(duration),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Reminder %d is set for %s from now", id, duration), nil
+}
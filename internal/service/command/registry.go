@@ -0,0 +1,120 @@
+package command
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/service"
+)
+
+// Registry holds the slash commands by name.
+type Registry struct {
+	handlers map[string]service.CommandHandler
+}
+
+// NewRegistry registers the handlers, /help among them.
+func NewRegistry(handlers ...service.CommandHandler) (*Registry, error) {
+	r := &Registry{
+		handlers: make(map[string]service.CommandHandler, len(handlers)+1),
+	}
+
+	for _, handler := range append(handlers, &help{registry: r}) {
+		name := handler.Spec().Name
+		if _, ok := r.handlers[name]; ok {
+			return nil, fmt.Errorf("command /%s registered twice", name)
+		}
+
+		r.handlers[name] = handler
+	}
+
+	return r, nil
+}
+
+// Resolve parses the call and returns its handler if the role may run it
+// with the given arguments.
+func (r *Registry) Resolve(call *model.CommandCall, role model.ChatRole) (service.CommandHandler, error) {
+	name, args, err := parse(call.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	handler, ok := r.handlers[name]
+	if !ok {
+		return nil, fmt.Errorf("%w /%s, see /help", model.ErrUnknownCommand, name)
+	}
+
+	spec := handler.Spec()
+	if role < spec.Role {
+		return nil, fmt.Errorf("%w: /%s is for chat admins", model.ErrPermissionDenied, name)
+	}
+
+	if len(args) < spec.MinArgs || (spec.MaxArgs >= 0 && len(args) > spec.MaxArgs) {
+		return nil, fmt.Errorf("%w: usage %s", model.ErrInvalidCommand, spec.Usage)
+	}
+
+	call.Name = name
+	call.Args = args
+
+	return handler, nil
+}
+
+// specs returns the specs of the commands the role may run, by name.
+func (r *Registry) specs(role model.ChatRole) []model.CommandSpec {
+	specs := make([]model.CommandSpec, 0, len(r.handlers))
+	for _, handler := range r.handlers {
+		spec := handler.Spec()
+		if spec.Role <= role {
+			specs = append(specs, spec)
+		}
+	}
+
+	sort.Slice(specs, func(i, j int) bool {
+		return specs[i].Name < specs[j].Name
+	})
+
+	return specs
+}
+
+// parse splits the command into its name and arguments. Double quotes
+// group words into one argument.
+func parse(text string) (string, []string, error) {
+	var (
+		fields  []string
+		field   strings.Builder
+		quoted  bool
+		inField bool
+	)
+
+	for _, r := range strings.TrimPrefix(text, "/") {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inField = true
+		case !quoted && (r == ' ' || r == '\t' || r == '\n'):
+			if inField {
+				fields = append(fields, field.String())
+				field.Reset()
+				inField = false
+			}
+		default:
+			field.WriteRune(r)
+			inField = true
+		}
+	}
+
+	if quoted {
+		return "", nil, fmt.Errorf("%w: unclosed quote", model.ErrInvalidCommand)
+	}
+
+	if inField {
+		fields = append(fields, field.String())
+	}
+
+	if len(fields) == 0 || fields[0] == "" {
+		return "", nil, fmt.Errorf("%w: no command name", model.ErrInvalidCommand)
+	}
+
+	return strings.ToLower(fields[0]), fields[1:], nil
+}
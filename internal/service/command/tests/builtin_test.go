@@ -0,0 +1,216 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	repositoryMocks "github.com/Mobo140/chat/internal/repository/mocks"
+	"github.com/Mobo140/chat/internal/service/command"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMute(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		chatID   = int64(7)
+
+		chat = &model.Chat{
+			ID:   chatID,
+			Info: model.ChatInfo{Usernames: []string{"bob", "alice"}, Admins: []string{"alice"}},
+		}
+	)
+
+	tests := []struct {
+		name     string
+		text     string
+		duration time.Duration
+		want     string
+		err      error
+	}{
+		{
+			name:     "success case",
+			text:     "/mute bob",
+			duration: time.Hour,
+			want:     "bob is muted for 1h0m0s",
+		},
+		{
+			name:     "with duration",
+			text:     "/mute bob 30m",
+			duration: 30 * time.Minute,
+			want:     "bob is muted for 30m0s",
+		},
+		{
+			name: "bad duration",
+			text: "/mute bob forever",
+			err:  model.ErrInvalidCommand,
+		},
+		{
+			name: "admin",
+			text: "/mute alice",
+			err:  model.ErrPermissionDenied,
+		},
+		{
+			name: "not in the chat",
+			text: "/mute carol",
+			err:  model.ErrInvalidCommand,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+
+			if tt.err == nil {
+				chatRepo.MuteMock.Expect(ctxValue, chatID, "bob", tt.duration).Return(nil)
+			}
+
+			registry, err := command.NewRegistry(command.Builtins(chatRepo, outboxRepo, scheduledRepo)...)
+			require.NoError(t, err)
+
+			call := &model.CommandCall{ChatID: chatID, Caller: "alice", Text: tt.text, Chat: chat}
+
+			handler, err := registry.Resolve(call, model.ChatRoleAdmin)
+			require.NoError(t, err)
+
+			reply, err := handler.Run(ctxValue, call)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.want, reply)
+		})
+	}
+}
+
+func TestInvite(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		chatID   = int64(7)
+	)
+
+	tests := []struct {
+		name  string
+		added bool
+		want  string
+	}{
+		{
+			name:  "success case",
+			added: true,
+			want:  "carol joined the chat",
+		},
+		{
+			name: "already in the chat",
+			want: "carol is in the chat already",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			chatRepo.AddUserMock.Expect(ctxValue, chatID, "carol").Return(tt.added, nil)
+			if tt.added {
+				outboxRepo.CreateMock.Expect(ctxValue, &model.ChatEvent{
+					ChatID:   chatID,
+					Type:     model.ChatEventMemberAdded,
+					Username: "alice",
+					Chat:     &model.ChatInfo{Usernames: []string{"carol"}},
+				}).Return(nil)
+			}
+
+			registry, err := command.NewRegistry(command.Builtins(chatRepo, outboxRepo, scheduledRepo)...)
+			require.NoError(t, err)
+
+			call := &model.CommandCall{ChatID: chatID, Caller: "alice", Text: "/invite carol"}
+
+			handler, err := registry.Resolve(call, model.ChatRoleAdmin)
+			require.NoError(t, err)
+
+			reply, err := handler.Run(ctxValue, call)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, reply)
+		})
+	}
+}
+
+func TestRemind(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		chatID   = int64(7)
+	)
+
+	tests := []struct {
+		name     string
+		text     string
+		duration time.Duration
+		want     string
+		err      error
+	}{
+		{
+			name:     "success case",
+			text:     "/remind 30m stand-up in five",
+			duration: 30 * time.Minute,
+			want:     "Reminder 3 is set for 30m0s from now",
+		},
+		{
+			name: "bad duration",
+			text: "/remind later stand-up",
+			err:  model.ErrInvalidCommand,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+
+			if tt.err == nil {
+				scheduledRepo.CreateMock.Set(func(_ context.Context, message *model.ScheduledMessage) (int64, error) {
+					require.Equal(t, chatID, message.ChatID)
+					require.Equal(t, "bob", message.Message.From)
+					require.Equal(t, "Reminder: stand-up in five", message.Message.Text)
+					require.WithinDuration(t, time.Now().Add(tt.duration), message.ScheduledAt, time.Minute)
+
+					return 3, nil
+				})
+			}
+
+			registry, err := command.NewRegistry(command.Builtins(chatRepo, outboxRepo, scheduledRepo)...)
+			require.NoError(t, err)
+
+			call := &model.CommandCall{ChatID: chatID, Caller: "bob", Text: tt.text}
+
+			handler, err := registry.Resolve(call, model.ChatRoleMember)
+			require.NoError(t, err)
+
+			reply, err := handler.Run(ctxValue, call)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.want, reply)
+		})
+	}
+}
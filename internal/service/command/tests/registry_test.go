@@ -0,0 +1,148 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Mobo140/chat/internal/model"
+	repositoryMocks "github.com/Mobo140/chat/internal/repository/mocks"
+	"github.com/Mobo140/chat/internal/service/command"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	registry, err := command.NewRegistry(command.Builtins(
+		repositoryMocks.NewChatRepositoryMock(mc),
+		repositoryMocks.NewOutboxRepositoryMock(mc),
+		repositoryMocks.NewScheduledRepositoryMock(mc),
+	)...)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		text     string
+		role     model.ChatRole
+		wantName string
+		wantArgs []string
+		err      error
+	}{
+		{
+			name:     "success case",
+			text:     "/topic Release  planning",
+			role:     model.ChatRoleAdmin,
+			wantName: "topic",
+			wantArgs: []string{"Release", "planning"},
+		},
+		{
+			name:     "quoted argument",
+			text:     `/TOPIC "Release  planning" now`,
+			role:     model.ChatRoleAdmin,
+			wantName: "topic",
+			wantArgs: []string{"Release  planning", "now"},
+		},
+		{
+			name:     "help for members",
+			text:     "/help",
+			role:     model.ChatRoleMember,
+			wantName: "help",
+			wantArgs: []string{},
+		},
+		{
+			name: "unclosed quote",
+			text: `/topic "Release`,
+			role: model.ChatRoleAdmin,
+			err:  model.ErrInvalidCommand,
+		},
+		{
+			name: "unknown command",
+			text: "/deploy",
+			role: model.ChatRoleAdmin,
+			err:  model.ErrUnknownCommand,
+		},
+		{
+			name: "admin command run by a member",
+			text: "/mute bob",
+			role: model.ChatRoleMember,
+			err:  model.ErrPermissionDenied,
+		},
+		{
+			name: "too many arguments",
+			text: "/invite bob alice",
+			role: model.ChatRoleAdmin,
+			err:  model.ErrInvalidCommand,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			call := &model.CommandCall{Text: tt.text}
+
+			handler, err := registry.Resolve(call, tt.role)
+			require.ErrorIs(t, err, tt.err)
+			if tt.err != nil {
+				return
+			}
+
+			require.Equal(t, tt.wantName, handler.Spec().Name)
+			require.Equal(t, tt.wantName, call.Name)
+			require.Equal(t, tt.wantArgs, call.Args)
+		})
+	}
+}
+
+func TestHelp(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	registry, err := command.NewRegistry(command.Builtins(
+		repositoryMocks.NewChatRepositoryMock(mc),
+		repositoryMocks.NewOutboxRepositoryMock(mc),
+		repositoryMocks.NewScheduledRepositoryMock(mc),
+	)...)
+	require.NoError(t, err)
+
+	chat := &model.Chat{Info: model.ChatInfo{Usernames: []string{"bob", "alice"}, Admins: []string{"alice"}}}
+
+	call := &model.CommandCall{Caller: "bob", Text: "/help", Chat: chat}
+	handler, err := registry.Resolve(call, model.ChatRoleMember)
+	require.NoError(t, err)
+
+	reply, err := handler.Run(context.Background(), call)
+	require.NoError(t, err)
+	require.Equal(t, "/help - list the commands you may run\n"+
+		"/remind <duration> <text> - post a reminder to the chat later", reply)
+
+	call = &model.CommandCall{Caller: "alice", Text: "/help", Chat: chat}
+	handler, err = registry.Resolve(call, model.ChatRoleAdmin)
+	require.NoError(t, err)
+
+	reply, err = handler.Run(context.Background(), call)
+	require.NoError(t, err)
+	require.Contains(t, reply, "/mute <username> [duration]")
+	require.Contains(t, reply, "/topic <text>")
+}
+
+func TestNewRegistryDuplicate(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+	outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+	scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+
+	handlers := append(command.Builtins(chatRepo, outboxRepo, scheduledRepo),
+		command.Builtins(chatRepo, outboxRepo, scheduledRepo)[0])
+
+	_, err := command.NewRegistry(handlers...)
+	require.Error(t, err)
+}
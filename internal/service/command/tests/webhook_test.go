@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/service/command"
+	"github.com/Mobo140/chat/internal/service/webhook"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookCommand(t *testing.T) {
+	t.Parallel()
+
+	const secret = "secret"
+
+	tests := []struct {
+		name       string
+		statusCode int
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "success case",
+			statusCode: http.StatusOK,
+			want:       "deploying v1.2 to staging",
+		},
+		{
+			name:       "handler error",
+			statusCode: http.StatusBadGateway,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.True(t, webhook.Verify(
+					secret,
+					r.Header.Get(webhook.TimestampHeader),
+					body,
+					r.Header.Get(webhook.SignatureHeader),
+				))
+
+				var payload map[string]interface{}
+				require.NoError(t, json.Unmarshal(body, &payload))
+				require.Equal(t, "deploy", payload["command"])
+				require.Equal(t, []interface{}{"v1.2", "staging"}, payload["args"])
+				require.Equal(t, "bob", payload["caller"])
+
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte("deploying v1.2 to staging\n"))
+			}))
+			defer server.Close()
+
+			handler := command.NewWebhookCommand("deploy", server.URL, secret, time.Second)
+
+			reply, err := handler.Run(context.Background(), &model.CommandCall{
+				ChatID: 7,
+				Caller: "bob",
+				Text:   "/deploy v1.2 staging",
+				Name:   "deploy",
+				Args:   []string{"v1.2", "staging"},
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, reply)
+		})
+	}
+}
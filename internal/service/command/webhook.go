@@ -0,0 +1,105 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/service"
+	"github.com/Mobo140/chat/internal/service/webhook"
+)
+
+var _ service.CommandHandler = (*webhookCommand)(nil)
+
+const (
+	commandEvent = "command"
+
+	maxReplySize = 4 << 10
+)
+
+// webhookCommand hands the command to an outside service: the call is POSTed
+// as JSON, signed like the webhook deliveries, and the plain text response
+// body is the reply.
+type webhookCommand struct {
+	name   string
+	url    string
+	secret string
+	client *http.Client
+}
+
+func NewWebhookCommand(name string, url string, secret string, timeout time.Duration) *webhookCommand { //nolint:revive // it's ok
+	return &webhookCommand{
+		name:   name,
+		url:    url,
+		secret: secret,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (w *webhookCommand) Spec() model.CommandSpec {
+	return model.CommandSpec{
+		Name:        w.name,
+		Usage:       "/" + w.name + " [args]",
+		Description: "handled by " + w.url,
+		Role:        model.ChatRoleMember,
+		MaxArgs:     -1,
+		External:    true,
+	}
+}
+
+type commandPayload struct {
+	Command string   `json:"command"`
+	Args    []string `json:"args"`
+	ChatID  int64    `json:"chat_id"`
+	Caller  string   `json:"caller"`
+	Text    string   `json:"text"`
+}
+
+func (w *webhookCommand) Run(ctx context.Context, call *model.CommandCall) (string, error) {
+	body, err := json.Marshal(commandPayload{
+		Command: call.Name,
+		Args:    call.Args,
+		ChatID:  call.ChatID,
+		Caller:  call.Caller,
+		Text:    call.Text,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode command: %v", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to build command request: %v", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhook.EventHeader, commandEvent)
+	req.Header.Set(webhook.TimestampHeader, timestamp)
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign(w.secret, timestamp, body))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("command /%s failed: %v", w.name, err)
+	}
+	defer resp.Body.Close()
+
+	reply, err := io.ReadAll(io.LimitReader(resp.Body, maxReplySize))
+	if err != nil {
+		return "", fmt.Errorf("command /%s failed: %v", w.name, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("command /%s failed: unexpected status %d", w.name, resp.StatusCode)
+	}
+
+	return strings.TrimSpace(string(reply)), nil
+}
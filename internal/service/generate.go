@@ -2,3 +2,10 @@ package service
 
 //go:generate sh -c "rm -rf mocks && mkdir -p mocks"
 //go:generate minimock -i ChatService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i AuditService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i AuditWriter -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i AuditChainer -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i EventPublisher -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i WebhookService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i BotService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i CommandHandler -o ./mocks/ -s "_minimock.go"
@@ -0,0 +1,680 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Mobo140/chat/internal/service.AuditChainer -o audit_chainer_minimock.go -n AuditChainerMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditChainerMock implements mm_service.AuditChainer
+type AuditChainerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcChain          func(ctx context.Context) (err error)
+	funcChainOrigin    string
+	inspectFuncChain   func(ctx context.Context)
+	afterChainCounter  uint64
+	beforeChainCounter uint64
+	ChainMock          mAuditChainerMockChain
+
+	funcRun          func(ctx context.Context)
+	funcRunOrigin    string
+	inspectFuncRun   func(ctx context.Context)
+	afterRunCounter  uint64
+	beforeRunCounter uint64
+	RunMock          mAuditChainerMockRun
+}
+
+// NewAuditChainerMock returns a mock for mm_service.AuditChainer
+func NewAuditChainerMock(t minimock.Tester) *AuditChainerMock {
+	m := &AuditChainerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ChainMock = mAuditChainerMockChain{mock: m}
+	m.ChainMock.callArgs = []*AuditChainerMockChainParams{}
+
+	m.RunMock = mAuditChainerMockRun{mock: m}
+	m.RunMock.callArgs = []*AuditChainerMockRunParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditChainerMockChain struct {
+	optional           bool
+	mock               *AuditChainerMock
+	defaultExpectation *AuditChainerMockChainExpectation
+	expectations       []*AuditChainerMockChainExpectation
+
+	callArgs []*AuditChainerMockChainParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditChainerMockChainExpectation specifies expectation struct of the AuditChainer.Chain
+type AuditChainerMockChainExpectation struct {
+	mock               *AuditChainerMock
+	params             *AuditChainerMockChainParams
+	paramPtrs          *AuditChainerMockChainParamPtrs
+	expectationOrigins AuditChainerMockChainExpectationOrigins
+	results            *AuditChainerMockChainResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditChainerMockChainParams contains parameters of the AuditChainer.Chain
+type AuditChainerMockChainParams struct {
+	ctx context.Context
+}
+
+// AuditChainerMockChainParamPtrs contains pointers to parameters of the AuditChainer.Chain
+type AuditChainerMockChainParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditChainerMockChainResults contains results of the AuditChainer.Chain
+type AuditChainerMockChainResults struct {
+	err error
+}
+
+// AuditChainerMockChainOrigins contains origins of expectations of the AuditChainer.Chain
+type AuditChainerMockChainExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmChain *mAuditChainerMockChain) Optional() *mAuditChainerMockChain {
+	mmChain.optional = true
+	return mmChain
+}
+
+// Expect sets up expected params for AuditChainer.Chain
+func (mmChain *mAuditChainerMockChain) Expect(ctx context.Context) *mAuditChainerMockChain {
+	if mmChain.mock.funcChain != nil {
+		mmChain.mock.t.Fatalf("AuditChainerMock.Chain mock is already set by Set")
+	}
+
+	if mmChain.defaultExpectation == nil {
+		mmChain.defaultExpectation = &AuditChainerMockChainExpectation{}
+	}
+
+	if mmChain.defaultExpectation.paramPtrs != nil {
+		mmChain.mock.t.Fatalf("AuditChainerMock.Chain mock is already set by ExpectParams functions")
+	}
+
+	mmChain.defaultExpectation.params = &AuditChainerMockChainParams{ctx}
+	mmChain.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmChain.expectations {
+		if minimock.Equal(e.params, mmChain.defaultExpectation.params) {
+			mmChain.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmChain.defaultExpectation.params)
+		}
+	}
+
+	return mmChain
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditChainer.Chain
+func (mmChain *mAuditChainerMockChain) ExpectCtxParam1(ctx context.Context) *mAuditChainerMockChain {
+	if mmChain.mock.funcChain != nil {
+		mmChain.mock.t.Fatalf("AuditChainerMock.Chain mock is already set by Set")
+	}
+
+	if mmChain.defaultExpectation == nil {
+		mmChain.defaultExpectation = &AuditChainerMockChainExpectation{}
+	}
+
+	if mmChain.defaultExpectation.params != nil {
+		mmChain.mock.t.Fatalf("AuditChainerMock.Chain mock is already set by Expect")
+	}
+
+	if mmChain.defaultExpectation.paramPtrs == nil {
+		mmChain.defaultExpectation.paramPtrs = &AuditChainerMockChainParamPtrs{}
+	}
+	mmChain.defaultExpectation.paramPtrs.ctx = &ctx
+	mmChain.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmChain
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditChainer.Chain
+func (mmChain *mAuditChainerMockChain) Inspect(f func(ctx context.Context)) *mAuditChainerMockChain {
+	if mmChain.mock.inspectFuncChain != nil {
+		mmChain.mock.t.Fatalf("Inspect function is already set for AuditChainerMock.Chain")
+	}
+
+	mmChain.mock.inspectFuncChain = f
+
+	return mmChain
+}
+
+// Return sets up results that will be returned by AuditChainer.Chain
+func (mmChain *mAuditChainerMockChain) Return(err error) *AuditChainerMock {
+	if mmChain.mock.funcChain != nil {
+		mmChain.mock.t.Fatalf("AuditChainerMock.Chain mock is already set by Set")
+	}
+
+	if mmChain.defaultExpectation == nil {
+		mmChain.defaultExpectation = &AuditChainerMockChainExpectation{mock: mmChain.mock}
+	}
+	mmChain.defaultExpectation.results = &AuditChainerMockChainResults{err}
+	mmChain.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmChain.mock
+}
+
+// Set uses given function f to mock the AuditChainer.Chain method
+func (mmChain *mAuditChainerMockChain) Set(f func(ctx context.Context) (err error)) *AuditChainerMock {
+	if mmChain.defaultExpectation != nil {
+		mmChain.mock.t.Fatalf("Default expectation is already set for the AuditChainer.Chain method")
+	}
+
+	if len(mmChain.expectations) > 0 {
+		mmChain.mock.t.Fatalf("Some expectations are already set for the AuditChainer.Chain method")
+	}
+
+	mmChain.mock.funcChain = f
+	mmChain.mock.funcChainOrigin = minimock.CallerInfo(1)
+	return mmChain.mock
+}
+
+// When sets expectation for the AuditChainer.Chain which will trigger the result defined by the following
+// Then helper
+func (mmChain *mAuditChainerMockChain) When(ctx context.Context) *AuditChainerMockChainExpectation {
+	if mmChain.mock.funcChain != nil {
+		mmChain.mock.t.Fatalf("AuditChainerMock.Chain mock is already set by Set")
+	}
+
+	expectation := &AuditChainerMockChainExpectation{
+		mock:               mmChain.mock,
+		params:             &AuditChainerMockChainParams{ctx},
+		expectationOrigins: AuditChainerMockChainExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmChain.expectations = append(mmChain.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditChainer.Chain return parameters for the expectation previously defined by the When method
+func (e *AuditChainerMockChainExpectation) Then(err error) *AuditChainerMock {
+	e.results = &AuditChainerMockChainResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuditChainer.Chain should be invoked
+func (mmChain *mAuditChainerMockChain) Times(n uint64) *mAuditChainerMockChain {
+	if n == 0 {
+		mmChain.mock.t.Fatalf("Times of AuditChainerMock.Chain mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmChain.expectedInvocations, n)
+	mmChain.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmChain
+}
+
+func (mmChain *mAuditChainerMockChain) invocationsDone() bool {
+	if len(mmChain.expectations) == 0 && mmChain.defaultExpectation == nil && mmChain.mock.funcChain == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmChain.mock.afterChainCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmChain.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Chain implements mm_service.AuditChainer
+func (mmChain *AuditChainerMock) Chain(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmChain.beforeChainCounter, 1)
+	defer mm_atomic.AddUint64(&mmChain.afterChainCounter, 1)
+
+	mmChain.t.Helper()
+
+	if mmChain.inspectFuncChain != nil {
+		mmChain.inspectFuncChain(ctx)
+	}
+
+	mm_params := AuditChainerMockChainParams{ctx}
+
+	// Record call args
+	mmChain.ChainMock.mutex.Lock()
+	mmChain.ChainMock.callArgs = append(mmChain.ChainMock.callArgs, &mm_params)
+	mmChain.ChainMock.mutex.Unlock()
+
+	for _, e := range mmChain.ChainMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmChain.ChainMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmChain.ChainMock.defaultExpectation.Counter, 1)
+		mm_want := mmChain.ChainMock.defaultExpectation.params
+		mm_want_ptrs := mmChain.ChainMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditChainerMockChainParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmChain.t.Errorf("AuditChainerMock.Chain got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChain.ChainMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmChain.t.Errorf("AuditChainerMock.Chain got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmChain.ChainMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmChain.ChainMock.defaultExpectation.results
+		if mm_results == nil {
+			mmChain.t.Fatal("No results are set for the AuditChainerMock.Chain")
+		}
+		return (*mm_results).err
+	}
+	if mmChain.funcChain != nil {
+		return mmChain.funcChain(ctx)
+	}
+	mmChain.t.Fatalf("Unexpected call to AuditChainerMock.Chain. %v", ctx)
+	return
+}
+
+// ChainAfterCounter returns a count of finished AuditChainerMock.Chain invocations
+func (mmChain *AuditChainerMock) ChainAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChain.afterChainCounter)
+}
+
+// ChainBeforeCounter returns a count of AuditChainerMock.Chain invocations
+func (mmChain *AuditChainerMock) ChainBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChain.beforeChainCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditChainerMock.Chain.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmChain *mAuditChainerMockChain) Calls() []*AuditChainerMockChainParams {
+	mmChain.mutex.RLock()
+
+	argCopy := make([]*AuditChainerMockChainParams, len(mmChain.callArgs))
+	copy(argCopy, mmChain.callArgs)
+
+	mmChain.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockChainDone returns true if the count of the Chain invocations corresponds
+// the number of defined expectations
+func (m *AuditChainerMock) MinimockChainDone() bool {
+	if m.ChainMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ChainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ChainMock.invocationsDone()
+}
+
+// MinimockChainInspect logs each unmet expectation
+func (m *AuditChainerMock) MinimockChainInspect() {
+	for _, e := range m.ChainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditChainerMock.Chain at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterChainCounter := mm_atomic.LoadUint64(&m.afterChainCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ChainMock.defaultExpectation != nil && afterChainCounter < 1 {
+		if m.ChainMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditChainerMock.Chain at\n%s", m.ChainMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditChainerMock.Chain at\n%s with params: %#v", m.ChainMock.defaultExpectation.expectationOrigins.origin, *m.ChainMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcChain != nil && afterChainCounter < 1 {
+		m.t.Errorf("Expected call to AuditChainerMock.Chain at\n%s", m.funcChainOrigin)
+	}
+
+	if !m.ChainMock.invocationsDone() && afterChainCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditChainerMock.Chain at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ChainMock.expectedInvocations), m.ChainMock.expectedInvocationsOrigin, afterChainCounter)
+	}
+}
+
+type mAuditChainerMockRun struct {
+	optional           bool
+	mock               *AuditChainerMock
+	defaultExpectation *AuditChainerMockRunExpectation
+	expectations       []*AuditChainerMockRunExpectation
+
+	callArgs []*AuditChainerMockRunParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditChainerMockRunExpectation specifies expectation struct of the AuditChainer.Run
+type AuditChainerMockRunExpectation struct {
+	mock               *AuditChainerMock
+	params             *AuditChainerMockRunParams
+	paramPtrs          *AuditChainerMockRunParamPtrs
+	expectationOrigins AuditChainerMockRunExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// AuditChainerMockRunParams contains parameters of the AuditChainer.Run
+type AuditChainerMockRunParams struct {
+	ctx context.Context
+}
+
+// AuditChainerMockRunParamPtrs contains pointers to parameters of the AuditChainer.Run
+type AuditChainerMockRunParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditChainerMockRunOrigins contains origins of expectations of the AuditChainer.Run
+type AuditChainerMockRunExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRun *mAuditChainerMockRun) Optional() *mAuditChainerMockRun {
+	mmRun.optional = true
+	return mmRun
+}
+
+// Expect sets up expected params for AuditChainer.Run
+func (mmRun *mAuditChainerMockRun) Expect(ctx context.Context) *mAuditChainerMockRun {
+	if mmRun.mock.funcRun != nil {
+		mmRun.mock.t.Fatalf("AuditChainerMock.Run mock is already set by Set")
+	}
+
+	if mmRun.defaultExpectation == nil {
+		mmRun.defaultExpectation = &AuditChainerMockRunExpectation{}
+	}
+
+	if mmRun.defaultExpectation.paramPtrs != nil {
+		mmRun.mock.t.Fatalf("AuditChainerMock.Run mock is already set by ExpectParams functions")
+	}
+
+	mmRun.defaultExpectation.params = &AuditChainerMockRunParams{ctx}
+	mmRun.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRun.expectations {
+		if minimock.Equal(e.params, mmRun.defaultExpectation.params) {
+			mmRun.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRun.defaultExpectation.params)
+		}
+	}
+
+	return mmRun
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditChainer.Run
+func (mmRun *mAuditChainerMockRun) ExpectCtxParam1(ctx context.Context) *mAuditChainerMockRun {
+	if mmRun.mock.funcRun != nil {
+		mmRun.mock.t.Fatalf("AuditChainerMock.Run mock is already set by Set")
+	}
+
+	if mmRun.defaultExpectation == nil {
+		mmRun.defaultExpectation = &AuditChainerMockRunExpectation{}
+	}
+
+	if mmRun.defaultExpectation.params != nil {
+		mmRun.mock.t.Fatalf("AuditChainerMock.Run mock is already set by Expect")
+	}
+
+	if mmRun.defaultExpectation.paramPtrs == nil {
+		mmRun.defaultExpectation.paramPtrs = &AuditChainerMockRunParamPtrs{}
+	}
+	mmRun.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRun.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRun
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditChainer.Run
+func (mmRun *mAuditChainerMockRun) Inspect(f func(ctx context.Context)) *mAuditChainerMockRun {
+	if mmRun.mock.inspectFuncRun != nil {
+		mmRun.mock.t.Fatalf("Inspect function is already set for AuditChainerMock.Run")
+	}
+
+	mmRun.mock.inspectFuncRun = f
+
+	return mmRun
+}
+
+// Return sets up results that will be returned by AuditChainer.Run
+func (mmRun *mAuditChainerMockRun) Return() *AuditChainerMock {
+	if mmRun.mock.funcRun != nil {
+		mmRun.mock.t.Fatalf("AuditChainerMock.Run mock is already set by Set")
+	}
+
+	if mmRun.defaultExpectation == nil {
+		mmRun.defaultExpectation = &AuditChainerMockRunExpectation{mock: mmRun.mock}
+	}
+
+	mmRun.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRun.mock
+}
+
+// Set uses given function f to mock the AuditChainer.Run method
+func (mmRun *mAuditChainerMockRun) Set(f func(ctx context.Context)) *AuditChainerMock {
+	if mmRun.defaultExpectation != nil {
+		mmRun.mock.t.Fatalf("Default expectation is already set for the AuditChainer.Run method")
+	}
+
+	if len(mmRun.expectations) > 0 {
+		mmRun.mock.t.Fatalf("Some expectations are already set for the AuditChainer.Run method")
+	}
+
+	mmRun.mock.funcRun = f
+	mmRun.mock.funcRunOrigin = minimock.CallerInfo(1)
+	return mmRun.mock
+}
+
+// Times sets number of times AuditChainer.Run should be invoked
+func (mmRun *mAuditChainerMockRun) Times(n uint64) *mAuditChainerMockRun {
+	if n == 0 {
+		mmRun.mock.t.Fatalf("Times of AuditChainerMock.Run mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRun.expectedInvocations, n)
+	mmRun.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRun
+}
+
+func (mmRun *mAuditChainerMockRun) invocationsDone() bool {
+	if len(mmRun.expectations) == 0 && mmRun.defaultExpectation == nil && mmRun.mock.funcRun == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRun.mock.afterRunCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRun.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Run implements mm_service.AuditChainer
+func (mmRun *AuditChainerMock) Run(ctx context.Context) {
+	mm_atomic.AddUint64(&mmRun.beforeRunCounter, 1)
+	defer mm_atomic.AddUint64(&mmRun.afterRunCounter, 1)
+
+	mmRun.t.Helper()
+
+	if mmRun.inspectFuncRun != nil {
+		mmRun.inspectFuncRun(ctx)
+	}
+
+	mm_params := AuditChainerMockRunParams{ctx}
+
+	// Record call args
+	mmRun.RunMock.mutex.Lock()
+	mmRun.RunMock.callArgs = append(mmRun.RunMock.callArgs, &mm_params)
+	mmRun.RunMock.mutex.Unlock()
+
+	for _, e := range mmRun.RunMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmRun.RunMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRun.RunMock.defaultExpectation.Counter, 1)
+		mm_want := mmRun.RunMock.defaultExpectation.params
+		mm_want_ptrs := mmRun.RunMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditChainerMockRunParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRun.t.Errorf("AuditChainerMock.Run got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRun.RunMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRun.t.Errorf("AuditChainerMock.Run got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRun.RunMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmRun.funcRun != nil {
+		mmRun.funcRun(ctx)
+		return
+	}
+	mmRun.t.Fatalf("Unexpected call to AuditChainerMock.Run. %v", ctx)
+
+}
+
+// RunAfterCounter returns a count of finished AuditChainerMock.Run invocations
+func (mmRun *AuditChainerMock) RunAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRun.afterRunCounter)
+}
+
+// RunBeforeCounter returns a count of AuditChainerMock.Run invocations
+func (mmRun *AuditChainerMock) RunBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRun.beforeRunCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditChainerMock.Run.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRun *mAuditChainerMockRun) Calls() []*AuditChainerMockRunParams {
+	mmRun.mutex.RLock()
+
+	argCopy := make([]*AuditChainerMockRunParams, len(mmRun.callArgs))
+	copy(argCopy, mmRun.callArgs)
+
+	mmRun.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRunDone returns true if the count of the Run invocations corresponds
+// the number of defined expectations
+func (m *AuditChainerMock) MinimockRunDone() bool {
+	if m.RunMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RunMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RunMock.invocationsDone()
+}
+
+// MinimockRunInspect logs each unmet expectation
+func (m *AuditChainerMock) MinimockRunInspect() {
+	for _, e := range m.RunMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditChainerMock.Run at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRunCounter := mm_atomic.LoadUint64(&m.afterRunCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RunMock.defaultExpectation != nil && afterRunCounter < 1 {
+		if m.RunMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditChainerMock.Run at\n%s", m.RunMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditChainerMock.Run at\n%s with params: %#v", m.RunMock.defaultExpectation.expectationOrigins.origin, *m.RunMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRun != nil && afterRunCounter < 1 {
+		m.t.Errorf("Expected call to AuditChainerMock.Run at\n%s", m.funcRunOrigin)
+	}
+
+	if !m.RunMock.invocationsDone() && afterRunCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditChainerMock.Run at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RunMock.expectedInvocations), m.RunMock.expectedInvocationsOrigin, afterRunCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditChainerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockChainInspect()
+
+			m.MinimockRunInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditChainerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditChainerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockChainDone() &&
+		m.MinimockRunDone()
+}
@@ -0,0 +1,387 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Mobo140/chat/internal/service.AuditService -o audit_service_minimock.go -n AuditServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditServiceMock implements mm_service.AuditService
+type AuditServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcVerify          func(ctx context.Context) (cp1 *model.ChainReport, err error)
+	funcVerifyOrigin    string
+	inspectFuncVerify   func(ctx context.Context)
+	afterVerifyCounter  uint64
+	beforeVerifyCounter uint64
+	VerifyMock          mAuditServiceMockVerify
+}
+
+// NewAuditServiceMock returns a mock for mm_service.AuditService
+func NewAuditServiceMock(t minimock.Tester) *AuditServiceMock {
+	m := &AuditServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.VerifyMock = mAuditServiceMockVerify{mock: m}
+	m.VerifyMock.callArgs = []*AuditServiceMockVerifyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditServiceMockVerify struct {
+	optional           bool
+	mock               *AuditServiceMock
+	defaultExpectation *AuditServiceMockVerifyExpectation
+	expectations       []*AuditServiceMockVerifyExpectation
+
+	callArgs []*AuditServiceMockVerifyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditServiceMockVerifyExpectation specifies expectation struct of the AuditService.Verify
+type AuditServiceMockVerifyExpectation struct {
+	mock               *AuditServiceMock
+	params             *AuditServiceMockVerifyParams
+	paramPtrs          *AuditServiceMockVerifyParamPtrs
+	expectationOrigins AuditServiceMockVerifyExpectationOrigins
+	results            *AuditServiceMockVerifyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditServiceMockVerifyParams contains parameters of the AuditService.Verify
+type AuditServiceMockVerifyParams struct {
+	ctx context.Context
+}
+
+// AuditServiceMockVerifyParamPtrs contains pointers to parameters of the AuditService.Verify
+type AuditServiceMockVerifyParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditServiceMockVerifyResults contains results of the AuditService.Verify
+type AuditServiceMockVerifyResults struct {
+	cp1 *model.ChainReport
+	err error
+}
+
+// AuditServiceMockVerifyOrigins contains origins of expectations of the AuditService.Verify
+type AuditServiceMockVerifyExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmVerify *mAuditServiceMockVerify) Optional() *mAuditServiceMockVerify {
+	mmVerify.optional = true
+	return mmVerify
+}
+
+// Expect sets up expected params for AuditService.Verify
+func (mmVerify *mAuditServiceMockVerify) Expect(ctx context.Context) *mAuditServiceMockVerify {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("AuditServiceMock.Verify mock is already set by Set")
+	}
+
+	if mmVerify.defaultExpectation == nil {
+		mmVerify.defaultExpectation = &AuditServiceMockVerifyExpectation{}
+	}
+
+	if mmVerify.defaultExpectation.paramPtrs != nil {
+		mmVerify.mock.t.Fatalf("AuditServiceMock.Verify mock is already set by ExpectParams functions")
+	}
+
+	mmVerify.defaultExpectation.params = &AuditServiceMockVerifyParams{ctx}
+	mmVerify.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmVerify.expectations {
+		if minimock.Equal(e.params, mmVerify.defaultExpectation.params) {
+			mmVerify.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVerify.defaultExpectation.params)
+		}
+	}
+
+	return mmVerify
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditService.Verify
+func (mmVerify *mAuditServiceMockVerify) ExpectCtxParam1(ctx context.Context) *mAuditServiceMockVerify {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("AuditServiceMock.Verify mock is already set by Set")
+	}
+
+	if mmVerify.defaultExpectation == nil {
+		mmVerify.defaultExpectation = &AuditServiceMockVerifyExpectation{}
+	}
+
+	if mmVerify.defaultExpectation.params != nil {
+		mmVerify.mock.t.Fatalf("AuditServiceMock.Verify mock is already set by Expect")
+	}
+
+	if mmVerify.defaultExpectation.paramPtrs == nil {
+		mmVerify.defaultExpectation.paramPtrs = &AuditServiceMockVerifyParamPtrs{}
+	}
+	mmVerify.defaultExpectation.paramPtrs.ctx = &ctx
+	mmVerify.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmVerify
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditService.Verify
+func (mmVerify *mAuditServiceMockVerify) Inspect(f func(ctx context.Context)) *mAuditServiceMockVerify {
+	if mmVerify.mock.inspectFuncVerify != nil {
+		mmVerify.mock.t.Fatalf("Inspect function is already set for AuditServiceMock.Verify")
+	}
+
+	mmVerify.mock.inspectFuncVerify = f
+
+	return mmVerify
+}
+
+// Return sets up results that will be returned by AuditService.Verify
+func (mmVerify *mAuditServiceMockVerify) Return(cp1 *model.ChainReport, err error) *AuditServiceMock {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("AuditServiceMock.Verify mock is already set by Set")
+	}
+
+	if mmVerify.defaultExpectation == nil {
+		mmVerify.defaultExpectation = &AuditServiceMockVerifyExpectation{mock: mmVerify.mock}
+	}
+	mmVerify.defaultExpectation.results = &AuditServiceMockVerifyResults{cp1, err}
+	mmVerify.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmVerify.mock
+}
+
+// Set uses given function f to mock the AuditService.Verify method
+func (mmVerify *mAuditServiceMockVerify) Set(f func(ctx context.Context) (cp1 *model.ChainReport, err error)) *AuditServiceMock {
+	if mmVerify.defaultExpectation != nil {
+		mmVerify.mock.t.Fatalf("Default expectation is already set for the AuditService.Verify method")
+	}
+
+	if len(mmVerify.expectations) > 0 {
+		mmVerify.mock.t.Fatalf("Some expectations are already set for the AuditService.Verify method")
+	}
+
+	mmVerify.mock.funcVerify = f
+	mmVerify.mock.funcVerifyOrigin = minimock.CallerInfo(1)
+	return mmVerify.mock
+}
+
+// When sets expectation for the AuditService.Verify which will trigger the result defined by the following
+// Then helper
+func (mmVerify *mAuditServiceMockVerify) When(ctx context.Context) *AuditServiceMockVerifyExpectation {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("AuditServiceMock.Verify mock is already set by Set")
+	}
+
+	expectation := &AuditServiceMockVerifyExpectation{
+		mock:               mmVerify.mock,
+		params:             &AuditServiceMockVerifyParams{ctx},
+		expectationOrigins: AuditServiceMockVerifyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmVerify.expectations = append(mmVerify.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditService.Verify return parameters for the expectation previously defined by the When method
+func (e *AuditServiceMockVerifyExpectation) Then(cp1 *model.ChainReport, err error) *AuditServiceMock {
+	e.results = &AuditServiceMockVerifyResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditService.Verify should be invoked
+func (mmVerify *mAuditServiceMockVerify) Times(n uint64) *mAuditServiceMockVerify {
+	if n == 0 {
+		mmVerify.mock.t.Fatalf("Times of AuditServiceMock.Verify mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmVerify.expectedInvocations, n)
+	mmVerify.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmVerify
+}
+
+func (mmVerify *mAuditServiceMockVerify) invocationsDone() bool {
+	if len(mmVerify.expectations) == 0 && mmVerify.defaultExpectation == nil && mmVerify.mock.funcVerify == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmVerify.mock.afterVerifyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVerify.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Verify implements mm_service.AuditService
+func (mmVerify *AuditServiceMock) Verify(ctx context.Context) (cp1 *model.ChainReport, err error) {
+	mm_atomic.AddUint64(&mmVerify.beforeVerifyCounter, 1)
+	defer mm_atomic.AddUint64(&mmVerify.afterVerifyCounter, 1)
+
+	mmVerify.t.Helper()
+
+	if mmVerify.inspectFuncVerify != nil {
+		mmVerify.inspectFuncVerify(ctx)
+	}
+
+	mm_params := AuditServiceMockVerifyParams{ctx}
+
+	// Record call args
+	mmVerify.VerifyMock.mutex.Lock()
+	mmVerify.VerifyMock.callArgs = append(mmVerify.VerifyMock.callArgs, &mm_params)
+	mmVerify.VerifyMock.mutex.Unlock()
+
+	for _, e := range mmVerify.VerifyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmVerify.VerifyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVerify.VerifyMock.defaultExpectation.Counter, 1)
+		mm_want := mmVerify.VerifyMock.defaultExpectation.params
+		mm_want_ptrs := mmVerify.VerifyMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditServiceMockVerifyParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmVerify.t.Errorf("AuditServiceMock.Verify got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerify.VerifyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVerify.t.Errorf("AuditServiceMock.Verify got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmVerify.VerifyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVerify.VerifyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVerify.t.Fatal("No results are set for the AuditServiceMock.Verify")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmVerify.funcVerify != nil {
+		return mmVerify.funcVerify(ctx)
+	}
+	mmVerify.t.Fatalf("Unexpected call to AuditServiceMock.Verify. %v", ctx)
+	return
+}
+
+// VerifyAfterCounter returns a count of finished AuditServiceMock.Verify invocations
+func (mmVerify *AuditServiceMock) VerifyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerify.afterVerifyCounter)
+}
+
+// VerifyBeforeCounter returns a count of AuditServiceMock.Verify invocations
+func (mmVerify *AuditServiceMock) VerifyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerify.beforeVerifyCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditServiceMock.Verify.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVerify *mAuditServiceMockVerify) Calls() []*AuditServiceMockVerifyParams {
+	mmVerify.mutex.RLock()
+
+	argCopy := make([]*AuditServiceMockVerifyParams, len(mmVerify.callArgs))
+	copy(argCopy, mmVerify.callArgs)
+
+	mmVerify.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVerifyDone returns true if the count of the Verify invocations corresponds
+// the number of defined expectations
+func (m *AuditServiceMock) MinimockVerifyDone() bool {
+	if m.VerifyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.VerifyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.VerifyMock.invocationsDone()
+}
+
+// MinimockVerifyInspect logs each unmet expectation
+func (m *AuditServiceMock) MinimockVerifyInspect() {
+	for _, e := range m.VerifyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditServiceMock.Verify at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterVerifyCounter := mm_atomic.LoadUint64(&m.afterVerifyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VerifyMock.defaultExpectation != nil && afterVerifyCounter < 1 {
+		if m.VerifyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditServiceMock.Verify at\n%s", m.VerifyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditServiceMock.Verify at\n%s with params: %#v", m.VerifyMock.defaultExpectation.expectationOrigins.origin, *m.VerifyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVerify != nil && afterVerifyCounter < 1 {
+		m.t.Errorf("Expected call to AuditServiceMock.Verify at\n%s", m.funcVerifyOrigin)
+	}
+
+	if !m.VerifyMock.invocationsDone() && afterVerifyCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditServiceMock.Verify at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.VerifyMock.expectedInvocations), m.VerifyMock.expectedInvocationsOrigin, afterVerifyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockVerifyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockVerifyDone()
+}
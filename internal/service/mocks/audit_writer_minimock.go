@@ -0,0 +1,554 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Mobo140/chat/internal/service.AuditWriter -o audit_writer_minimock.go -n AuditWriterMock -p mocks
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditWriterMock implements mm_service.AuditWriter
+type AuditWriterMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcClose          func() (err error)
+	funcCloseOrigin    string
+	inspectFuncClose   func()
+	afterCloseCounter  uint64
+	beforeCloseCounter uint64
+	CloseMock          mAuditWriterMockClose
+
+	funcWrite          func(logEntry *model.LogEntry)
+	funcWriteOrigin    string
+	inspectFuncWrite   func(logEntry *model.LogEntry)
+	afterWriteCounter  uint64
+	beforeWriteCounter uint64
+	WriteMock          mAuditWriterMockWrite
+}
+
+// NewAuditWriterMock returns a mock for mm_service.AuditWriter
+func NewAuditWriterMock(t minimock.Tester) *AuditWriterMock {
+	m := &AuditWriterMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CloseMock = mAuditWriterMockClose{mock: m}
+
+	m.WriteMock = mAuditWriterMockWrite{mock: m}
+	m.WriteMock.callArgs = []*AuditWriterMockWriteParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditWriterMockClose struct {
+	optional           bool
+	mock               *AuditWriterMock
+	defaultExpectation *AuditWriterMockCloseExpectation
+	expectations       []*AuditWriterMockCloseExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditWriterMockCloseExpectation specifies expectation struct of the AuditWriter.Close
+type AuditWriterMockCloseExpectation struct {
+	mock *AuditWriterMock
+
+	results      *AuditWriterMockCloseResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// AuditWriterMockCloseResults contains results of the AuditWriter.Close
+type AuditWriterMockCloseResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClose *mAuditWriterMockClose) Optional() *mAuditWriterMockClose {
+	mmClose.optional = true
+	return mmClose
+}
+
+// Expect sets up expected params for AuditWriter.Close
+func (mmClose *mAuditWriterMockClose) Expect() *mAuditWriterMockClose {
+	if mmClose.mock.funcClose != nil {
+		mmClose.mock.t.Fatalf("AuditWriterMock.Close mock is already set by Set")
+	}
+
+	if mmClose.defaultExpectation == nil {
+		mmClose.defaultExpectation = &AuditWriterMockCloseExpectation{}
+	}
+
+	return mmClose
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditWriter.Close
+func (mmClose *mAuditWriterMockClose) Inspect(f func()) *mAuditWriterMockClose {
+	if mmClose.mock.inspectFuncClose != nil {
+		mmClose.mock.t.Fatalf("Inspect function is already set for AuditWriterMock.Close")
+	}
+
+	mmClose.mock.inspectFuncClose = f
+
+	return mmClose
+}
+
+// Return sets up results that will be returned by AuditWriter.Close
+func (mmClose *mAuditWriterMockClose) Return(err error) *AuditWriterMock {
+	if mmClose.mock.funcClose != nil {
+		mmClose.mock.t.Fatalf("AuditWriterMock.Close mock is already set by Set")
+	}
+
+	if mmClose.defaultExpectation == nil {
+		mmClose.defaultExpectation = &AuditWriterMockCloseExpectation{mock: mmClose.mock}
+	}
+	mmClose.defaultExpectation.results = &AuditWriterMockCloseResults{err}
+	mmClose.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClose.mock
+}
+
+// Set uses given function f to mock the AuditWriter.Close method
+func (mmClose *mAuditWriterMockClose) Set(f func() (err error)) *AuditWriterMock {
+	if mmClose.defaultExpectation != nil {
+		mmClose.mock.t.Fatalf("Default expectation is already set for the AuditWriter.Close method")
+	}
+
+	if len(mmClose.expectations) > 0 {
+		mmClose.mock.t.Fatalf("Some expectations are already set for the AuditWriter.Close method")
+	}
+
+	mmClose.mock.funcClose = f
+	mmClose.mock.funcCloseOrigin = minimock.CallerInfo(1)
+	return mmClose.mock
+}
+
+// Times sets number of times AuditWriter.Close should be invoked
+func (mmClose *mAuditWriterMockClose) Times(n uint64) *mAuditWriterMockClose {
+	if n == 0 {
+		mmClose.mock.t.Fatalf("Times of AuditWriterMock.Close mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClose.expectedInvocations, n)
+	mmClose.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClose
+}
+
+func (mmClose *mAuditWriterMockClose) invocationsDone() bool {
+	if len(mmClose.expectations) == 0 && mmClose.defaultExpectation == nil && mmClose.mock.funcClose == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClose.mock.afterCloseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClose.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Close implements mm_service.AuditWriter
+func (mmClose *AuditWriterMock) Close() (err error) {
+	mm_atomic.AddUint64(&mmClose.beforeCloseCounter, 1)
+	defer mm_atomic.AddUint64(&mmClose.afterCloseCounter, 1)
+
+	mmClose.t.Helper()
+
+	if mmClose.inspectFuncClose != nil {
+		mmClose.inspectFuncClose()
+	}
+
+	if mmClose.CloseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClose.CloseMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmClose.CloseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClose.t.Fatal("No results are set for the AuditWriterMock.Close")
+		}
+		return (*mm_results).err
+	}
+	if mmClose.funcClose != nil {
+		return mmClose.funcClose()
+	}
+	mmClose.t.Fatalf("Unexpected call to AuditWriterMock.Close.")
+	return
+}
+
+// CloseAfterCounter returns a count of finished AuditWriterMock.Close invocations
+func (mmClose *AuditWriterMock) CloseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClose.afterCloseCounter)
+}
+
+// CloseBeforeCounter returns a count of AuditWriterMock.Close invocations
+func (mmClose *AuditWriterMock) CloseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClose.beforeCloseCounter)
+}
+
+// MinimockCloseDone returns true if the count of the Close invocations corresponds
+// the number of defined expectations
+func (m *AuditWriterMock) MinimockCloseDone() bool {
+	if m.CloseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CloseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CloseMock.invocationsDone()
+}
+
+// MinimockCloseInspect logs each unmet expectation
+func (m *AuditWriterMock) MinimockCloseInspect() {
+	for _, e := range m.CloseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to AuditWriterMock.Close")
+		}
+	}
+
+	afterCloseCounter := mm_atomic.LoadUint64(&m.afterCloseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CloseMock.defaultExpectation != nil && afterCloseCounter < 1 {
+		m.t.Errorf("Expected call to AuditWriterMock.Close at\n%s", m.CloseMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClose != nil && afterCloseCounter < 1 {
+		m.t.Errorf("Expected call to AuditWriterMock.Close at\n%s", m.funcCloseOrigin)
+	}
+
+	if !m.CloseMock.invocationsDone() && afterCloseCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditWriterMock.Close at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CloseMock.expectedInvocations), m.CloseMock.expectedInvocationsOrigin, afterCloseCounter)
+	}
+}
+
+type mAuditWriterMockWrite struct {
+	optional           bool
+	mock               *AuditWriterMock
+	defaultExpectation *AuditWriterMockWriteExpectation
+	expectations       []*AuditWriterMockWriteExpectation
+
+	callArgs []*AuditWriterMockWriteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditWriterMockWriteExpectation specifies expectation struct of the AuditWriter.Write
+type AuditWriterMockWriteExpectation struct {
+	mock               *AuditWriterMock
+	params             *AuditWriterMockWriteParams
+	paramPtrs          *AuditWriterMockWriteParamPtrs
+	expectationOrigins AuditWriterMockWriteExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// AuditWriterMockWriteParams contains parameters of the AuditWriter.Write
+type AuditWriterMockWriteParams struct {
+	logEntry *model.LogEntry
+}
+
+// AuditWriterMockWriteParamPtrs contains pointers to parameters of the AuditWriter.Write
+type AuditWriterMockWriteParamPtrs struct {
+	logEntry **model.LogEntry
+}
+
+// AuditWriterMockWriteOrigins contains origins of expectations of the AuditWriter.Write
+type AuditWriterMockWriteExpectationOrigins struct {
+	origin         string
+	originLogEntry string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWrite *mAuditWriterMockWrite) Optional() *mAuditWriterMockWrite {
+	mmWrite.optional = true
+	return mmWrite
+}
+
+// Expect sets up expected params for AuditWriter.Write
+func (mmWrite *mAuditWriterMockWrite) Expect(logEntry *model.LogEntry) *mAuditWriterMockWrite {
+	if mmWrite.mock.funcWrite != nil {
+		mmWrite.mock.t.Fatalf("AuditWriterMock.Write mock is already set by Set")
+	}
+
+	if mmWrite.defaultExpectation == nil {
+		mmWrite.defaultExpectation = &AuditWriterMockWriteExpectation{}
+	}
+
+	if mmWrite.defaultExpectation.paramPtrs != nil {
+		mmWrite.mock.t.Fatalf("AuditWriterMock.Write mock is already set by ExpectParams functions")
+	}
+
+	mmWrite.defaultExpectation.params = &AuditWriterMockWriteParams{logEntry}
+	mmWrite.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWrite.expectations {
+		if minimock.Equal(e.params, mmWrite.defaultExpectation.params) {
+			mmWrite.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWrite.defaultExpectation.params)
+		}
+	}
+
+	return mmWrite
+}
+
+// ExpectLogEntryParam1 sets up expected param logEntry for AuditWriter.Write
+func (mmWrite *mAuditWriterMockWrite) ExpectLogEntryParam1(logEntry *model.LogEntry) *mAuditWriterMockWrite {
+	if mmWrite.mock.funcWrite != nil {
+		mmWrite.mock.t.Fatalf("AuditWriterMock.Write mock is already set by Set")
+	}
+
+	if mmWrite.defaultExpectation == nil {
+		mmWrite.defaultExpectation = &AuditWriterMockWriteExpectation{}
+	}
+
+	if mmWrite.defaultExpectation.params != nil {
+		mmWrite.mock.t.Fatalf("AuditWriterMock.Write mock is already set by Expect")
+	}
+
+	if mmWrite.defaultExpectation.paramPtrs == nil {
+		mmWrite.defaultExpectation.paramPtrs = &AuditWriterMockWriteParamPtrs{}
+	}
+	mmWrite.defaultExpectation.paramPtrs.logEntry = &logEntry
+	mmWrite.defaultExpectation.expectationOrigins.originLogEntry = minimock.CallerInfo(1)
+
+	return mmWrite
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditWriter.Write
+func (mmWrite *mAuditWriterMockWrite) Inspect(f func(logEntry *model.LogEntry)) *mAuditWriterMockWrite {
+	if mmWrite.mock.inspectFuncWrite != nil {
+		mmWrite.mock.t.Fatalf("Inspect function is already set for AuditWriterMock.Write")
+	}
+
+	mmWrite.mock.inspectFuncWrite = f
+
+	return mmWrite
+}
+
+// Return sets up results that will be returned by AuditWriter.Write
+func (mmWrite *mAuditWriterMockWrite) Return() *AuditWriterMock {
+	if mmWrite.mock.funcWrite != nil {
+		mmWrite.mock.t.Fatalf("AuditWriterMock.Write mock is already set by Set")
+	}
+
+	if mmWrite.defaultExpectation == nil {
+		mmWrite.defaultExpectation = &AuditWriterMockWriteExpectation{mock: mmWrite.mock}
+	}
+
+	mmWrite.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWrite.mock
+}
+
+// Set uses given function f to mock the AuditWriter.Write method
+func (mmWrite *mAuditWriterMockWrite) Set(f func(logEntry *model.LogEntry)) *AuditWriterMock {
+	if mmWrite.defaultExpectation != nil {
+		mmWrite.mock.t.Fatalf("Default expectation is already set for the AuditWriter.Write method")
+	}
+
+	if len(mmWrite.expectations) > 0 {
+		mmWrite.mock.t.Fatalf("Some expectations are already set for the AuditWriter.Write method")
+	}
+
+	mmWrite.mock.funcWrite = f
+	mmWrite.mock.funcWriteOrigin = minimock.CallerInfo(1)
+	return mmWrite.mock
+}
+
+// Times sets number of times AuditWriter.Write should be invoked
+func (mmWrite *mAuditWriterMockWrite) Times(n uint64) *mAuditWriterMockWrite {
+	if n == 0 {
+		mmWrite.mock.t.Fatalf("Times of AuditWriterMock.Write mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWrite.expectedInvocations, n)
+	mmWrite.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWrite
+}
+
+func (mmWrite *mAuditWriterMockWrite) invocationsDone() bool {
+	if len(mmWrite.expectations) == 0 && mmWrite.defaultExpectation == nil && mmWrite.mock.funcWrite == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWrite.mock.afterWriteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWrite.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Write implements mm_service.AuditWriter
+func (mmWrite *AuditWriterMock) Write(logEntry *model.LogEntry) {
+	mm_atomic.AddUint64(&mmWrite.beforeWriteCounter, 1)
+	defer mm_atomic.AddUint64(&mmWrite.afterWriteCounter, 1)
+
+	mmWrite.t.Helper()
+
+	if mmWrite.inspectFuncWrite != nil {
+		mmWrite.inspectFuncWrite(logEntry)
+	}
+
+	mm_params := AuditWriterMockWriteParams{logEntry}
+
+	// Record call args
+	mmWrite.WriteMock.mutex.Lock()
+	mmWrite.WriteMock.callArgs = append(mmWrite.WriteMock.callArgs, &mm_params)
+	mmWrite.WriteMock.mutex.Unlock()
+
+	for _, e := range mmWrite.WriteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmWrite.WriteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWrite.WriteMock.defaultExpectation.Counter, 1)
+		mm_want := mmWrite.WriteMock.defaultExpectation.params
+		mm_want_ptrs := mmWrite.WriteMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditWriterMockWriteParams{logEntry}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.logEntry != nil && !minimock.Equal(*mm_want_ptrs.logEntry, mm_got.logEntry) {
+				mmWrite.t.Errorf("AuditWriterMock.Write got unexpected parameter logEntry, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWrite.WriteMock.defaultExpectation.expectationOrigins.originLogEntry, *mm_want_ptrs.logEntry, mm_got.logEntry, minimock.Diff(*mm_want_ptrs.logEntry, mm_got.logEntry))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWrite.t.Errorf("AuditWriterMock.Write got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWrite.WriteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmWrite.funcWrite != nil {
+		mmWrite.funcWrite(logEntry)
+		return
+	}
+	mmWrite.t.Fatalf("Unexpected call to AuditWriterMock.Write. %v", logEntry)
+
+}
+
+// WriteAfterCounter returns a count of finished AuditWriterMock.Write invocations
+func (mmWrite *AuditWriterMock) WriteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWrite.afterWriteCounter)
+}
+
+// WriteBeforeCounter returns a count of AuditWriterMock.Write invocations
+func (mmWrite *AuditWriterMock) WriteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWrite.beforeWriteCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditWriterMock.Write.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWrite *mAuditWriterMockWrite) Calls() []*AuditWriterMockWriteParams {
+	mmWrite.mutex.RLock()
+
+	argCopy := make([]*AuditWriterMockWriteParams, len(mmWrite.callArgs))
+	copy(argCopy, mmWrite.callArgs)
+
+	mmWrite.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWriteDone returns true if the count of the Write invocations corresponds
+// the number of defined expectations
+func (m *AuditWriterMock) MinimockWriteDone() bool {
+	if m.WriteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WriteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WriteMock.invocationsDone()
+}
+
+// MinimockWriteInspect logs each unmet expectation
+func (m *AuditWriterMock) MinimockWriteInspect() {
+	for _, e := range m.WriteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditWriterMock.Write at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterWriteCounter := mm_atomic.LoadUint64(&m.afterWriteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WriteMock.defaultExpectation != nil && afterWriteCounter < 1 {
+		if m.WriteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditWriterMock.Write at\n%s", m.WriteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditWriterMock.Write at\n%s with params: %#v", m.WriteMock.defaultExpectation.expectationOrigins.origin, *m.WriteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWrite != nil && afterWriteCounter < 1 {
+		m.t.Errorf("Expected call to AuditWriterMock.Write at\n%s", m.funcWriteOrigin)
+	}
+
+	if !m.WriteMock.invocationsDone() && afterWriteCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditWriterMock.Write at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WriteMock.expectedInvocations), m.WriteMock.expectedInvocationsOrigin, afterWriteCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditWriterMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCloseInspect()
+
+			m.MinimockWriteInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditWriterMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditWriterMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCloseDone() &&
+		m.MinimockWriteDone()
+}
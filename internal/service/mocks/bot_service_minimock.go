@@ -0,0 +1,1485 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Mobo140/chat/internal/service.BotService -o bot_service_minimock.go -n BotServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// BotServiceMock implements mm_service.BotService
+type BotServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAuthenticate          func(ctx context.Context, apiKey string) (bp1 *model.Bot, err error)
+	funcAuthenticateOrigin    string
+	inspectFuncAuthenticate   func(ctx context.Context, apiKey string)
+	afterAuthenticateCounter  uint64
+	beforeAuthenticateCounter uint64
+	AuthenticateMock          mBotServiceMockAuthenticate
+
+	funcCreate          func(ctx context.Context, bot *model.Bot) (s1 string, err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, bot *model.Bot)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mBotServiceMockCreate
+
+	funcRevokeKeys          func(ctx context.Context, id int64) (err error)
+	funcRevokeKeysOrigin    string
+	inspectFuncRevokeKeys   func(ctx context.Context, id int64)
+	afterRevokeKeysCounter  uint64
+	beforeRevokeKeysCounter uint64
+	RevokeKeysMock          mBotServiceMockRevokeKeys
+
+	funcRotateKey          func(ctx context.Context, id int64) (s1 string, err error)
+	funcRotateKeyOrigin    string
+	inspectFuncRotateKey   func(ctx context.Context, id int64)
+	afterRotateKeyCounter  uint64
+	beforeRotateKeyCounter uint64
+	RotateKeyMock          mBotServiceMockRotateKey
+}
+
+// NewBotServiceMock returns a mock for mm_service.BotService
+func NewBotServiceMock(t minimock.Tester) *BotServiceMock {
+	m := &BotServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AuthenticateMock = mBotServiceMockAuthenticate{mock: m}
+	m.AuthenticateMock.callArgs = []*BotServiceMockAuthenticateParams{}
+
+	m.CreateMock = mBotServiceMockCreate{mock: m}
+	m.CreateMock.callArgs = []*BotServiceMockCreateParams{}
+
+	m.RevokeKeysMock = mBotServiceMockRevokeKeys{mock: m}
+	m.RevokeKeysMock.callArgs = []*BotServiceMockRevokeKeysParams{}
+
+	m.RotateKeyMock = mBotServiceMockRotateKey{mock: m}
+	m.RotateKeyMock.callArgs = []*BotServiceMockRotateKeyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mBotServiceMockAuthenticate struct {
+	optional           bool
+	mock               *BotServiceMock
+	defaultExpectation *BotServiceMockAuthenticateExpectation
+	expectations       []*BotServiceMockAuthenticateExpectation
+
+	callArgs []*BotServiceMockAuthenticateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BotServiceMockAuthenticateExpectation specifies expectation struct of the BotService.Authenticate
+type BotServiceMockAuthenticateExpectation struct {
+	mock               *BotServiceMock
+	params             *BotServiceMockAuthenticateParams
+	paramPtrs          *BotServiceMockAuthenticateParamPtrs
+	expectationOrigins BotServiceMockAuthenticateExpectationOrigins
+	results            *BotServiceMockAuthenticateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BotServiceMockAuthenticateParams contains parameters of the BotService.Authenticate
+type BotServiceMockAuthenticateParams struct {
+	ctx    context.Context
+	apiKey string
+}
+
+// BotServiceMockAuthenticateParamPtrs contains pointers to parameters of the BotService.Authenticate
+type BotServiceMockAuthenticateParamPtrs struct {
+	ctx    *context.Context
+	apiKey *string
+}
+
+// BotServiceMockAuthenticateResults contains results of the BotService.Authenticate
+type BotServiceMockAuthenticateResults struct {
+	bp1 *model.Bot
+	err error
+}
+
+// BotServiceMockAuthenticateOrigins contains origins of expectations of the BotService.Authenticate
+type BotServiceMockAuthenticateExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originApiKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAuthenticate *mBotServiceMockAuthenticate) Optional() *mBotServiceMockAuthenticate {
+	mmAuthenticate.optional = true
+	return mmAuthenticate
+}
+
+// Expect sets up expected params for BotService.Authenticate
+func (mmAuthenticate *mBotServiceMockAuthenticate) Expect(ctx context.Context, apiKey string) *mBotServiceMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("BotServiceMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &BotServiceMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs != nil {
+		mmAuthenticate.mock.t.Fatalf("BotServiceMock.Authenticate mock is already set by ExpectParams functions")
+	}
+
+	mmAuthenticate.defaultExpectation.params = &BotServiceMockAuthenticateParams{ctx, apiKey}
+	mmAuthenticate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAuthenticate.expectations {
+		if minimock.Equal(e.params, mmAuthenticate.defaultExpectation.params) {
+			mmAuthenticate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAuthenticate.defaultExpectation.params)
+		}
+	}
+
+	return mmAuthenticate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotService.Authenticate
+func (mmAuthenticate *mBotServiceMockAuthenticate) ExpectCtxParam1(ctx context.Context) *mBotServiceMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("BotServiceMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &BotServiceMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("BotServiceMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &BotServiceMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAuthenticate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAuthenticate
+}
+
+// ExpectApiKeyParam2 sets up expected param apiKey for BotService.Authenticate
+func (mmAuthenticate *mBotServiceMockAuthenticate) ExpectApiKeyParam2(apiKey string) *mBotServiceMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("BotServiceMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &BotServiceMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("BotServiceMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &BotServiceMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.apiKey = &apiKey
+	mmAuthenticate.defaultExpectation.expectationOrigins.originApiKey = minimock.CallerInfo(1)
+
+	return mmAuthenticate
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotService.Authenticate
+func (mmAuthenticate *mBotServiceMockAuthenticate) Inspect(f func(ctx context.Context, apiKey string)) *mBotServiceMockAuthenticate {
+	if mmAuthenticate.mock.inspectFuncAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("Inspect function is already set for BotServiceMock.Authenticate")
+	}
+
+	mmAuthenticate.mock.inspectFuncAuthenticate = f
+
+	return mmAuthenticate
+}
+
+// Return sets up results that will be returned by BotService.Authenticate
+func (mmAuthenticate *mBotServiceMockAuthenticate) Return(bp1 *model.Bot, err error) *BotServiceMock {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("BotServiceMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &BotServiceMockAuthenticateExpectation{mock: mmAuthenticate.mock}
+	}
+	mmAuthenticate.defaultExpectation.results = &BotServiceMockAuthenticateResults{bp1, err}
+	mmAuthenticate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAuthenticate.mock
+}
+
+// Set uses given function f to mock the BotService.Authenticate method
+func (mmAuthenticate *mBotServiceMockAuthenticate) Set(f func(ctx context.Context, apiKey string) (bp1 *model.Bot, err error)) *BotServiceMock {
+	if mmAuthenticate.defaultExpectation != nil {
+		mmAuthenticate.mock.t.Fatalf("Default expectation is already set for the BotService.Authenticate method")
+	}
+
+	if len(mmAuthenticate.expectations) > 0 {
+		mmAuthenticate.mock.t.Fatalf("Some expectations are already set for the BotService.Authenticate method")
+	}
+
+	mmAuthenticate.mock.funcAuthenticate = f
+	mmAuthenticate.mock.funcAuthenticateOrigin = minimock.CallerInfo(1)
+	return mmAuthenticate.mock
+}
+
+// When sets expectation for the BotService.Authenticate which will trigger the result defined by the following
+// Then helper
+func (mmAuthenticate *mBotServiceMockAuthenticate) When(ctx context.Context, apiKey string) *BotServiceMockAuthenticateExpectation {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("BotServiceMock.Authenticate mock is already set by Set")
+	}
+
+	expectation := &BotServiceMockAuthenticateExpectation{
+		mock:               mmAuthenticate.mock,
+		params:             &BotServiceMockAuthenticateParams{ctx, apiKey},
+		expectationOrigins: BotServiceMockAuthenticateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAuthenticate.expectations = append(mmAuthenticate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotService.Authenticate return parameters for the expectation previously defined by the When method
+func (e *BotServiceMockAuthenticateExpectation) Then(bp1 *model.Bot, err error) *BotServiceMock {
+	e.results = &BotServiceMockAuthenticateResults{bp1, err}
+	return e.mock
+}
+
+// Times sets number of times BotService.Authenticate should be invoked
+func (mmAuthenticate *mBotServiceMockAuthenticate) Times(n uint64) *mBotServiceMockAuthenticate {
+	if n == 0 {
+		mmAuthenticate.mock.t.Fatalf("Times of BotServiceMock.Authenticate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAuthenticate.expectedInvocations, n)
+	mmAuthenticate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAuthenticate
+}
+
+func (mmAuthenticate *mBotServiceMockAuthenticate) invocationsDone() bool {
+	if len(mmAuthenticate.expectations) == 0 && mmAuthenticate.defaultExpectation == nil && mmAuthenticate.mock.funcAuthenticate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAuthenticate.mock.afterAuthenticateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAuthenticate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Authenticate implements mm_service.BotService
+func (mmAuthenticate *BotServiceMock) Authenticate(ctx context.Context, apiKey string) (bp1 *model.Bot, err error) {
+	mm_atomic.AddUint64(&mmAuthenticate.beforeAuthenticateCounter, 1)
+	defer mm_atomic.AddUint64(&mmAuthenticate.afterAuthenticateCounter, 1)
+
+	mmAuthenticate.t.Helper()
+
+	if mmAuthenticate.inspectFuncAuthenticate != nil {
+		mmAuthenticate.inspectFuncAuthenticate(ctx, apiKey)
+	}
+
+	mm_params := BotServiceMockAuthenticateParams{ctx, apiKey}
+
+	// Record call args
+	mmAuthenticate.AuthenticateMock.mutex.Lock()
+	mmAuthenticate.AuthenticateMock.callArgs = append(mmAuthenticate.AuthenticateMock.callArgs, &mm_params)
+	mmAuthenticate.AuthenticateMock.mutex.Unlock()
+
+	for _, e := range mmAuthenticate.AuthenticateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.bp1, e.results.err
+		}
+	}
+
+	if mmAuthenticate.AuthenticateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAuthenticate.AuthenticateMock.defaultExpectation.Counter, 1)
+		mm_want := mmAuthenticate.AuthenticateMock.defaultExpectation.params
+		mm_want_ptrs := mmAuthenticate.AuthenticateMock.defaultExpectation.paramPtrs
+
+		mm_got := BotServiceMockAuthenticateParams{ctx, apiKey}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAuthenticate.t.Errorf("BotServiceMock.Authenticate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticate.AuthenticateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.apiKey != nil && !minimock.Equal(*mm_want_ptrs.apiKey, mm_got.apiKey) {
+				mmAuthenticate.t.Errorf("BotServiceMock.Authenticate got unexpected parameter apiKey, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticate.AuthenticateMock.defaultExpectation.expectationOrigins.originApiKey, *mm_want_ptrs.apiKey, mm_got.apiKey, minimock.Diff(*mm_want_ptrs.apiKey, mm_got.apiKey))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAuthenticate.t.Errorf("BotServiceMock.Authenticate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAuthenticate.AuthenticateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAuthenticate.AuthenticateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAuthenticate.t.Fatal("No results are set for the BotServiceMock.Authenticate")
+		}
+		return (*mm_results).bp1, (*mm_results).err
+	}
+	if mmAuthenticate.funcAuthenticate != nil {
+		return mmAuthenticate.funcAuthenticate(ctx, apiKey)
+	}
+	mmAuthenticate.t.Fatalf("Unexpected call to BotServiceMock.Authenticate. %v %v", ctx, apiKey)
+	return
+}
+
+// AuthenticateAfterCounter returns a count of finished BotServiceMock.Authenticate invocations
+func (mmAuthenticate *BotServiceMock) AuthenticateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticate.afterAuthenticateCounter)
+}
+
+// AuthenticateBeforeCounter returns a count of BotServiceMock.Authenticate invocations
+func (mmAuthenticate *BotServiceMock) AuthenticateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticate.beforeAuthenticateCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotServiceMock.Authenticate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAuthenticate *mBotServiceMockAuthenticate) Calls() []*BotServiceMockAuthenticateParams {
+	mmAuthenticate.mutex.RLock()
+
+	argCopy := make([]*BotServiceMockAuthenticateParams, len(mmAuthenticate.callArgs))
+	copy(argCopy, mmAuthenticate.callArgs)
+
+	mmAuthenticate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAuthenticateDone returns true if the count of the Authenticate invocations corresponds
+// the number of defined expectations
+func (m *BotServiceMock) MinimockAuthenticateDone() bool {
+	if m.AuthenticateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AuthenticateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AuthenticateMock.invocationsDone()
+}
+
+// MinimockAuthenticateInspect logs each unmet expectation
+func (m *BotServiceMock) MinimockAuthenticateInspect() {
+	for _, e := range m.AuthenticateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotServiceMock.Authenticate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAuthenticateCounter := mm_atomic.LoadUint64(&m.afterAuthenticateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AuthenticateMock.defaultExpectation != nil && afterAuthenticateCounter < 1 {
+		if m.AuthenticateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BotServiceMock.Authenticate at\n%s", m.AuthenticateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BotServiceMock.Authenticate at\n%s with params: %#v", m.AuthenticateMock.defaultExpectation.expectationOrigins.origin, *m.AuthenticateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAuthenticate != nil && afterAuthenticateCounter < 1 {
+		m.t.Errorf("Expected call to BotServiceMock.Authenticate at\n%s", m.funcAuthenticateOrigin)
+	}
+
+	if !m.AuthenticateMock.invocationsDone() && afterAuthenticateCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotServiceMock.Authenticate at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AuthenticateMock.expectedInvocations), m.AuthenticateMock.expectedInvocationsOrigin, afterAuthenticateCounter)
+	}
+}
+
+type mBotServiceMockCreate struct {
+	optional           bool
+	mock               *BotServiceMock
+	defaultExpectation *BotServiceMockCreateExpectation
+	expectations       []*BotServiceMockCreateExpectation
+
+	callArgs []*BotServiceMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BotServiceMockCreateExpectation specifies expectation struct of the BotService.Create
+type BotServiceMockCreateExpectation struct {
+	mock               *BotServiceMock
+	params             *BotServiceMockCreateParams
+	paramPtrs          *BotServiceMockCreateParamPtrs
+	expectationOrigins BotServiceMockCreateExpectationOrigins
+	results            *BotServiceMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BotServiceMockCreateParams contains parameters of the BotService.Create
+type BotServiceMockCreateParams struct {
+	ctx context.Context
+	bot *model.Bot
+}
+
+// BotServiceMockCreateParamPtrs contains pointers to parameters of the BotService.Create
+type BotServiceMockCreateParamPtrs struct {
+	ctx *context.Context
+	bot **model.Bot
+}
+
+// BotServiceMockCreateResults contains results of the BotService.Create
+type BotServiceMockCreateResults struct {
+	s1  string
+	err error
+}
+
+// BotServiceMockCreateOrigins contains origins of expectations of the BotService.Create
+type BotServiceMockCreateExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originBot string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mBotServiceMockCreate) Optional() *mBotServiceMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for BotService.Create
+func (mmCreate *mBotServiceMockCreate) Expect(ctx context.Context, bot *model.Bot) *mBotServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("BotServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &BotServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("BotServiceMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &BotServiceMockCreateParams{ctx, bot}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotService.Create
+func (mmCreate *mBotServiceMockCreate) ExpectCtxParam1(ctx context.Context) *mBotServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("BotServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &BotServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("BotServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &BotServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectBotParam2 sets up expected param bot for BotService.Create
+func (mmCreate *mBotServiceMockCreate) ExpectBotParam2(bot *model.Bot) *mBotServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("BotServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &BotServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("BotServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &BotServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.bot = &bot
+	mmCreate.defaultExpectation.expectationOrigins.originBot = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotService.Create
+func (mmCreate *mBotServiceMockCreate) Inspect(f func(ctx context.Context, bot *model.Bot)) *mBotServiceMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for BotServiceMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by BotService.Create
+func (mmCreate *mBotServiceMockCreate) Return(s1 string, err error) *BotServiceMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("BotServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &BotServiceMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &BotServiceMockCreateResults{s1, err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the BotService.Create method
+func (mmCreate *mBotServiceMockCreate) Set(f func(ctx context.Context, bot *model.Bot) (s1 string, err error)) *BotServiceMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the BotService.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the BotService.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the BotService.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mBotServiceMockCreate) When(ctx context.Context, bot *model.Bot) *BotServiceMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("BotServiceMock.Create mock is already set by Set")
+	}
+
+	expectation := &BotServiceMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &BotServiceMockCreateParams{ctx, bot},
+		expectationOrigins: BotServiceMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotService.Create return parameters for the expectation previously defined by the When method
+func (e *BotServiceMockCreateExpectation) Then(s1 string, err error) *BotServiceMock {
+	e.results = &BotServiceMockCreateResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times BotService.Create should be invoked
+func (mmCreate *mBotServiceMockCreate) Times(n uint64) *mBotServiceMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of BotServiceMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mBotServiceMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_service.BotService
+func (mmCreate *BotServiceMock) Create(ctx context.Context, bot *model.Bot) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, bot)
+	}
+
+	mm_params := BotServiceMockCreateParams{ctx, bot}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := BotServiceMockCreateParams{ctx, bot}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("BotServiceMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.bot != nil && !minimock.Equal(*mm_want_ptrs.bot, mm_got.bot) {
+				mmCreate.t.Errorf("BotServiceMock.Create got unexpected parameter bot, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originBot, *mm_want_ptrs.bot, mm_got.bot, minimock.Diff(*mm_want_ptrs.bot, mm_got.bot))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("BotServiceMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the BotServiceMock.Create")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, bot)
+	}
+	mmCreate.t.Fatalf("Unexpected call to BotServiceMock.Create. %v %v", ctx, bot)
+	return
+}
+
+// CreateAfterCounter returns a count of finished BotServiceMock.Create invocations
+func (mmCreate *BotServiceMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of BotServiceMock.Create invocations
+func (mmCreate *BotServiceMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotServiceMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mBotServiceMockCreate) Calls() []*BotServiceMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*BotServiceMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *BotServiceMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *BotServiceMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotServiceMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BotServiceMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BotServiceMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to BotServiceMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotServiceMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mBotServiceMockRevokeKeys struct {
+	optional           bool
+	mock               *BotServiceMock
+	defaultExpectation *BotServiceMockRevokeKeysExpectation
+	expectations       []*BotServiceMockRevokeKeysExpectation
+
+	callArgs []*BotServiceMockRevokeKeysParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BotServiceMockRevokeKeysExpectation specifies expectation struct of the BotService.RevokeKeys
+type BotServiceMockRevokeKeysExpectation struct {
+	mock               *BotServiceMock
+	params             *BotServiceMockRevokeKeysParams
+	paramPtrs          *BotServiceMockRevokeKeysParamPtrs
+	expectationOrigins BotServiceMockRevokeKeysExpectationOrigins
+	results            *BotServiceMockRevokeKeysResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BotServiceMockRevokeKeysParams contains parameters of the BotService.RevokeKeys
+type BotServiceMockRevokeKeysParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// BotServiceMockRevokeKeysParamPtrs contains pointers to parameters of the BotService.RevokeKeys
+type BotServiceMockRevokeKeysParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// BotServiceMockRevokeKeysResults contains results of the BotService.RevokeKeys
+type BotServiceMockRevokeKeysResults struct {
+	err error
+}
+
+// BotServiceMockRevokeKeysOrigins contains origins of expectations of the BotService.RevokeKeys
+type BotServiceMockRevokeKeysExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeKeys *mBotServiceMockRevokeKeys) Optional() *mBotServiceMockRevokeKeys {
+	mmRevokeKeys.optional = true
+	return mmRevokeKeys
+}
+
+// Expect sets up expected params for BotService.RevokeKeys
+func (mmRevokeKeys *mBotServiceMockRevokeKeys) Expect(ctx context.Context, id int64) *mBotServiceMockRevokeKeys {
+	if mmRevokeKeys.mock.funcRevokeKeys != nil {
+		mmRevokeKeys.mock.t.Fatalf("BotServiceMock.RevokeKeys mock is already set by Set")
+	}
+
+	if mmRevokeKeys.defaultExpectation == nil {
+		mmRevokeKeys.defaultExpectation = &BotServiceMockRevokeKeysExpectation{}
+	}
+
+	if mmRevokeKeys.defaultExpectation.paramPtrs != nil {
+		mmRevokeKeys.mock.t.Fatalf("BotServiceMock.RevokeKeys mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeKeys.defaultExpectation.params = &BotServiceMockRevokeKeysParams{ctx, id}
+	mmRevokeKeys.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeKeys.expectations {
+		if minimock.Equal(e.params, mmRevokeKeys.defaultExpectation.params) {
+			mmRevokeKeys.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeKeys.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeKeys
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotService.RevokeKeys
+func (mmRevokeKeys *mBotServiceMockRevokeKeys) ExpectCtxParam1(ctx context.Context) *mBotServiceMockRevokeKeys {
+	if mmRevokeKeys.mock.funcRevokeKeys != nil {
+		mmRevokeKeys.mock.t.Fatalf("BotServiceMock.RevokeKeys mock is already set by Set")
+	}
+
+	if mmRevokeKeys.defaultExpectation == nil {
+		mmRevokeKeys.defaultExpectation = &BotServiceMockRevokeKeysExpectation{}
+	}
+
+	if mmRevokeKeys.defaultExpectation.params != nil {
+		mmRevokeKeys.mock.t.Fatalf("BotServiceMock.RevokeKeys mock is already set by Expect")
+	}
+
+	if mmRevokeKeys.defaultExpectation.paramPtrs == nil {
+		mmRevokeKeys.defaultExpectation.paramPtrs = &BotServiceMockRevokeKeysParamPtrs{}
+	}
+	mmRevokeKeys.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeKeys.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeKeys
+}
+
+// ExpectIdParam2 sets up expected param id for BotService.RevokeKeys
+func (mmRevokeKeys *mBotServiceMockRevokeKeys) ExpectIdParam2(id int64) *mBotServiceMockRevokeKeys {
+	if mmRevokeKeys.mock.funcRevokeKeys != nil {
+		mmRevokeKeys.mock.t.Fatalf("BotServiceMock.RevokeKeys mock is already set by Set")
+	}
+
+	if mmRevokeKeys.defaultExpectation == nil {
+		mmRevokeKeys.defaultExpectation = &BotServiceMockRevokeKeysExpectation{}
+	}
+
+	if mmRevokeKeys.defaultExpectation.params != nil {
+		mmRevokeKeys.mock.t.Fatalf("BotServiceMock.RevokeKeys mock is already set by Expect")
+	}
+
+	if mmRevokeKeys.defaultExpectation.paramPtrs == nil {
+		mmRevokeKeys.defaultExpectation.paramPtrs = &BotServiceMockRevokeKeysParamPtrs{}
+	}
+	mmRevokeKeys.defaultExpectation.paramPtrs.id = &id
+	mmRevokeKeys.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRevokeKeys
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotService.RevokeKeys
+func (mmRevokeKeys *mBotServiceMockRevokeKeys) Inspect(f func(ctx context.Context, id int64)) *mBotServiceMockRevokeKeys {
+	if mmRevokeKeys.mock.inspectFuncRevokeKeys != nil {
+		mmRevokeKeys.mock.t.Fatalf("Inspect function is already set for BotServiceMock.RevokeKeys")
+	}
+
+	mmRevokeKeys.mock.inspectFuncRevokeKeys = f
+
+	return mmRevokeKeys
+}
+
+// Return sets up results that will be returned by BotService.RevokeKeys
+func (mmRevokeKeys *mBotServiceMockRevokeKeys) Return(err error) *BotServiceMock {
+	if mmRevokeKeys.mock.funcRevokeKeys != nil {
+		mmRevokeKeys.mock.t.Fatalf("BotServiceMock.RevokeKeys mock is already set by Set")
+	}
+
+	if mmRevokeKeys.defaultExpectation == nil {
+		mmRevokeKeys.defaultExpectation = &BotServiceMockRevokeKeysExpectation{mock: mmRevokeKeys.mock}
+	}
+	mmRevokeKeys.defaultExpectation.results = &BotServiceMockRevokeKeysResults{err}
+	mmRevokeKeys.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeKeys.mock
+}
+
+// Set uses given function f to mock the BotService.RevokeKeys method
+func (mmRevokeKeys *mBotServiceMockRevokeKeys) Set(f func(ctx context.Context, id int64) (err error)) *BotServiceMock {
+	if mmRevokeKeys.defaultExpectation != nil {
+		mmRevokeKeys.mock.t.Fatalf("Default expectation is already set for the BotService.RevokeKeys method")
+	}
+
+	if len(mmRevokeKeys.expectations) > 0 {
+		mmRevokeKeys.mock.t.Fatalf("Some expectations are already set for the BotService.RevokeKeys method")
+	}
+
+	mmRevokeKeys.mock.funcRevokeKeys = f
+	mmRevokeKeys.mock.funcRevokeKeysOrigin = minimock.CallerInfo(1)
+	return mmRevokeKeys.mock
+}
+
+// When sets expectation for the BotService.RevokeKeys which will trigger the result defined by the following
+// Then helper
+func (mmRevokeKeys *mBotServiceMockRevokeKeys) When(ctx context.Context, id int64) *BotServiceMockRevokeKeysExpectation {
+	if mmRevokeKeys.mock.funcRevokeKeys != nil {
+		mmRevokeKeys.mock.t.Fatalf("BotServiceMock.RevokeKeys mock is already set by Set")
+	}
+
+	expectation := &BotServiceMockRevokeKeysExpectation{
+		mock:               mmRevokeKeys.mock,
+		params:             &BotServiceMockRevokeKeysParams{ctx, id},
+		expectationOrigins: BotServiceMockRevokeKeysExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeKeys.expectations = append(mmRevokeKeys.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotService.RevokeKeys return parameters for the expectation previously defined by the When method
+func (e *BotServiceMockRevokeKeysExpectation) Then(err error) *BotServiceMock {
+	e.results = &BotServiceMockRevokeKeysResults{err}
+	return e.mock
+}
+
+// Times sets number of times BotService.RevokeKeys should be invoked
+func (mmRevokeKeys *mBotServiceMockRevokeKeys) Times(n uint64) *mBotServiceMockRevokeKeys {
+	if n == 0 {
+		mmRevokeKeys.mock.t.Fatalf("Times of BotServiceMock.RevokeKeys mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeKeys.expectedInvocations, n)
+	mmRevokeKeys.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeKeys
+}
+
+func (mmRevokeKeys *mBotServiceMockRevokeKeys) invocationsDone() bool {
+	if len(mmRevokeKeys.expectations) == 0 && mmRevokeKeys.defaultExpectation == nil && mmRevokeKeys.mock.funcRevokeKeys == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeKeys.mock.afterRevokeKeysCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeKeys.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeKeys implements mm_service.BotService
+func (mmRevokeKeys *BotServiceMock) RevokeKeys(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmRevokeKeys.beforeRevokeKeysCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeKeys.afterRevokeKeysCounter, 1)
+
+	mmRevokeKeys.t.Helper()
+
+	if mmRevokeKeys.inspectFuncRevokeKeys != nil {
+		mmRevokeKeys.inspectFuncRevokeKeys(ctx, id)
+	}
+
+	mm_params := BotServiceMockRevokeKeysParams{ctx, id}
+
+	// Record call args
+	mmRevokeKeys.RevokeKeysMock.mutex.Lock()
+	mmRevokeKeys.RevokeKeysMock.callArgs = append(mmRevokeKeys.RevokeKeysMock.callArgs, &mm_params)
+	mmRevokeKeys.RevokeKeysMock.mutex.Unlock()
+
+	for _, e := range mmRevokeKeys.RevokeKeysMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeKeys.RevokeKeysMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeKeys.RevokeKeysMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeKeys.RevokeKeysMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeKeys.RevokeKeysMock.defaultExpectation.paramPtrs
+
+		mm_got := BotServiceMockRevokeKeysParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeKeys.t.Errorf("BotServiceMock.RevokeKeys got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeKeys.RevokeKeysMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRevokeKeys.t.Errorf("BotServiceMock.RevokeKeys got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeKeys.RevokeKeysMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeKeys.t.Errorf("BotServiceMock.RevokeKeys got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeKeys.RevokeKeysMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeKeys.RevokeKeysMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeKeys.t.Fatal("No results are set for the BotServiceMock.RevokeKeys")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeKeys.funcRevokeKeys != nil {
+		return mmRevokeKeys.funcRevokeKeys(ctx, id)
+	}
+	mmRevokeKeys.t.Fatalf("Unexpected call to BotServiceMock.RevokeKeys. %v %v", ctx, id)
+	return
+}
+
+// RevokeKeysAfterCounter returns a count of finished BotServiceMock.RevokeKeys invocations
+func (mmRevokeKeys *BotServiceMock) RevokeKeysAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeKeys.afterRevokeKeysCounter)
+}
+
+// RevokeKeysBeforeCounter returns a count of BotServiceMock.RevokeKeys invocations
+func (mmRevokeKeys *BotServiceMock) RevokeKeysBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeKeys.beforeRevokeKeysCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotServiceMock.RevokeKeys.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeKeys *mBotServiceMockRevokeKeys) Calls() []*BotServiceMockRevokeKeysParams {
+	mmRevokeKeys.mutex.RLock()
+
+	argCopy := make([]*BotServiceMockRevokeKeysParams, len(mmRevokeKeys.callArgs))
+	copy(argCopy, mmRevokeKeys.callArgs)
+
+	mmRevokeKeys.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeKeysDone returns true if the count of the RevokeKeys invocations corresponds
+// the number of defined expectations
+func (m *BotServiceMock) MinimockRevokeKeysDone() bool {
+	if m.RevokeKeysMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeKeysMock.invocationsDone()
+}
+
+// MinimockRevokeKeysInspect logs each unmet expectation
+func (m *BotServiceMock) MinimockRevokeKeysInspect() {
+	for _, e := range m.RevokeKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotServiceMock.RevokeKeys at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeKeysCounter := mm_atomic.LoadUint64(&m.afterRevokeKeysCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeKeysMock.defaultExpectation != nil && afterRevokeKeysCounter < 1 {
+		if m.RevokeKeysMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BotServiceMock.RevokeKeys at\n%s", m.RevokeKeysMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BotServiceMock.RevokeKeys at\n%s with params: %#v", m.RevokeKeysMock.defaultExpectation.expectationOrigins.origin, *m.RevokeKeysMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeKeys != nil && afterRevokeKeysCounter < 1 {
+		m.t.Errorf("Expected call to BotServiceMock.RevokeKeys at\n%s", m.funcRevokeKeysOrigin)
+	}
+
+	if !m.RevokeKeysMock.invocationsDone() && afterRevokeKeysCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotServiceMock.RevokeKeys at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeKeysMock.expectedInvocations), m.RevokeKeysMock.expectedInvocationsOrigin, afterRevokeKeysCounter)
+	}
+}
+
+type mBotServiceMockRotateKey struct {
+	optional           bool
+	mock               *BotServiceMock
+	defaultExpectation *BotServiceMockRotateKeyExpectation
+	expectations       []*BotServiceMockRotateKeyExpectation
+
+	callArgs []*BotServiceMockRotateKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BotServiceMockRotateKeyExpectation specifies expectation struct of the BotService.RotateKey
+type BotServiceMockRotateKeyExpectation struct {
+	mock               *BotServiceMock
+	params             *BotServiceMockRotateKeyParams
+	paramPtrs          *BotServiceMockRotateKeyParamPtrs
+	expectationOrigins BotServiceMockRotateKeyExpectationOrigins
+	results            *BotServiceMockRotateKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BotServiceMockRotateKeyParams contains parameters of the BotService.RotateKey
+type BotServiceMockRotateKeyParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// BotServiceMockRotateKeyParamPtrs contains pointers to parameters of the BotService.RotateKey
+type BotServiceMockRotateKeyParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// BotServiceMockRotateKeyResults contains results of the BotService.RotateKey
+type BotServiceMockRotateKeyResults struct {
+	s1  string
+	err error
+}
+
+// BotServiceMockRotateKeyOrigins contains origins of expectations of the BotService.RotateKey
+type BotServiceMockRotateKeyExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRotateKey *mBotServiceMockRotateKey) Optional() *mBotServiceMockRotateKey {
+	mmRotateKey.optional = true
+	return mmRotateKey
+}
+
+// Expect sets up expected params for BotService.RotateKey
+func (mmRotateKey *mBotServiceMockRotateKey) Expect(ctx context.Context, id int64) *mBotServiceMockRotateKey {
+	if mmRotateKey.mock.funcRotateKey != nil {
+		mmRotateKey.mock.t.Fatalf("BotServiceMock.RotateKey mock is already set by Set")
+	}
+
+	if mmRotateKey.defaultExpectation == nil {
+		mmRotateKey.defaultExpectation = &BotServiceMockRotateKeyExpectation{}
+	}
+
+	if mmRotateKey.defaultExpectation.paramPtrs != nil {
+		mmRotateKey.mock.t.Fatalf("BotServiceMock.RotateKey mock is already set by ExpectParams functions")
+	}
+
+	mmRotateKey.defaultExpectation.params = &BotServiceMockRotateKeyParams{ctx, id}
+	mmRotateKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRotateKey.expectations {
+		if minimock.Equal(e.params, mmRotateKey.defaultExpectation.params) {
+			mmRotateKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRotateKey.defaultExpectation.params)
+		}
+	}
+
+	return mmRotateKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotService.RotateKey
+func (mmRotateKey *mBotServiceMockRotateKey) ExpectCtxParam1(ctx context.Context) *mBotServiceMockRotateKey {
+	if mmRotateKey.mock.funcRotateKey != nil {
+		mmRotateKey.mock.t.Fatalf("BotServiceMock.RotateKey mock is already set by Set")
+	}
+
+	if mmRotateKey.defaultExpectation == nil {
+		mmRotateKey.defaultExpectation = &BotServiceMockRotateKeyExpectation{}
+	}
+
+	if mmRotateKey.defaultExpectation.params != nil {
+		mmRotateKey.mock.t.Fatalf("BotServiceMock.RotateKey mock is already set by Expect")
+	}
+
+	if mmRotateKey.defaultExpectation.paramPtrs == nil {
+		mmRotateKey.defaultExpectation.paramPtrs = &BotServiceMockRotateKeyParamPtrs{}
+	}
+	mmRotateKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRotateKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRotateKey
+}
+
+// ExpectIdParam2 sets up expected param id for BotService.RotateKey
+func (mmRotateKey *mBotServiceMockRotateKey) ExpectIdParam2(id int64) *mBotServiceMockRotateKey {
+	if mmRotateKey.mock.funcRotateKey != nil {
+		mmRotateKey.mock.t.Fatalf("BotServiceMock.RotateKey mock is already set by Set")
+	}
+
+	if mmRotateKey.defaultExpectation == nil {
+		mmRotateKey.defaultExpectation = &BotServiceMockRotateKeyExpectation{}
+	}
+
+	if mmRotateKey.defaultExpectation.params != nil {
+		mmRotateKey.mock.t.Fatalf("BotServiceMock.RotateKey mock is already set by Expect")
+	}
+
+	if mmRotateKey.defaultExpectation.paramPtrs == nil {
+		mmRotateKey.defaultExpectation.paramPtrs = &BotServiceMockRotateKeyParamPtrs{}
+	}
+	mmRotateKey.defaultExpectation.paramPtrs.id = &id
+	mmRotateKey.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRotateKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotService.RotateKey
+func (mmRotateKey *mBotServiceMockRotateKey) Inspect(f func(ctx context.Context, id int64)) *mBotServiceMockRotateKey {
+	if mmRotateKey.mock.inspectFuncRotateKey != nil {
+		mmRotateKey.mock.t.Fatalf("Inspect function is already set for BotServiceMock.RotateKey")
+	}
+
+	mmRotateKey.mock.inspectFuncRotateKey = f
+
+	return mmRotateKey
+}
+
+// Return sets up results that will be returned by BotService.RotateKey
+func (mmRotateKey *mBotServiceMockRotateKey) Return(s1 string, err error) *BotServiceMock {
+	if mmRotateKey.mock.funcRotateKey != nil {
+		mmRotateKey.mock.t.Fatalf("BotServiceMock.RotateKey mock is already set by Set")
+	}
+
+	if mmRotateKey.defaultExpectation == nil {
+		mmRotateKey.defaultExpectation = &BotServiceMockRotateKeyExpectation{mock: mmRotateKey.mock}
+	}
+	mmRotateKey.defaultExpectation.results = &BotServiceMockRotateKeyResults{s1, err}
+	mmRotateKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRotateKey.mock
+}
+
+// Set uses given function f to mock the BotService.RotateKey method
+func (mmRotateKey *mBotServiceMockRotateKey) Set(f func(ctx context.Context, id int64) (s1 string, err error)) *BotServiceMock {
+	if mmRotateKey.defaultExpectation != nil {
+		mmRotateKey.mock.t.Fatalf("Default expectation is already set for the BotService.RotateKey method")
+	}
+
+	if len(mmRotateKey.expectations) > 0 {
+		mmRotateKey.mock.t.Fatalf("Some expectations are already set for the BotService.RotateKey method")
+	}
+
+	mmRotateKey.mock.funcRotateKey = f
+	mmRotateKey.mock.funcRotateKeyOrigin = minimock.CallerInfo(1)
+	return mmRotateKey.mock
+}
+
+// When sets expectation for the BotService.RotateKey which will trigger the result defined by the following
+// Then helper
+func (mmRotateKey *mBotServiceMockRotateKey) When(ctx context.Context, id int64) *BotServiceMockRotateKeyExpectation {
+	if mmRotateKey.mock.funcRotateKey != nil {
+		mmRotateKey.mock.t.Fatalf("BotServiceMock.RotateKey mock is already set by Set")
+	}
+
+	expectation := &BotServiceMockRotateKeyExpectation{
+		mock:               mmRotateKey.mock,
+		params:             &BotServiceMockRotateKeyParams{ctx, id},
+		expectationOrigins: BotServiceMockRotateKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRotateKey.expectations = append(mmRotateKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotService.RotateKey return parameters for the expectation previously defined by the When method
+func (e *BotServiceMockRotateKeyExpectation) Then(s1 string, err error) *BotServiceMock {
+	e.results = &BotServiceMockRotateKeyResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times BotService.RotateKey should be invoked
+func (mmRotateKey *mBotServiceMockRotateKey) Times(n uint64) *mBotServiceMockRotateKey {
+	if n == 0 {
+		mmRotateKey.mock.t.Fatalf("Times of BotServiceMock.RotateKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRotateKey.expectedInvocations, n)
+	mmRotateKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRotateKey
+}
+
+func (mmRotateKey *mBotServiceMockRotateKey) invocationsDone() bool {
+	if len(mmRotateKey.expectations) == 0 && mmRotateKey.defaultExpectation == nil && mmRotateKey.mock.funcRotateKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRotateKey.mock.afterRotateKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRotateKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RotateKey implements mm_service.BotService
+func (mmRotateKey *BotServiceMock) RotateKey(ctx context.Context, id int64) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmRotateKey.beforeRotateKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmRotateKey.afterRotateKeyCounter, 1)
+
+	mmRotateKey.t.Helper()
+
+	if mmRotateKey.inspectFuncRotateKey != nil {
+		mmRotateKey.inspectFuncRotateKey(ctx, id)
+	}
+
+	mm_params := BotServiceMockRotateKeyParams{ctx, id}
+
+	// Record call args
+	mmRotateKey.RotateKeyMock.mutex.Lock()
+	mmRotateKey.RotateKeyMock.callArgs = append(mmRotateKey.RotateKeyMock.callArgs, &mm_params)
+	mmRotateKey.RotateKeyMock.mutex.Unlock()
+
+	for _, e := range mmRotateKey.RotateKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmRotateKey.RotateKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRotateKey.RotateKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmRotateKey.RotateKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmRotateKey.RotateKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := BotServiceMockRotateKeyParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRotateKey.t.Errorf("BotServiceMock.RotateKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRotateKey.RotateKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRotateKey.t.Errorf("BotServiceMock.RotateKey got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRotateKey.RotateKeyMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRotateKey.t.Errorf("BotServiceMock.RotateKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRotateKey.RotateKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRotateKey.RotateKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRotateKey.t.Fatal("No results are set for the BotServiceMock.RotateKey")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmRotateKey.funcRotateKey != nil {
+		return mmRotateKey.funcRotateKey(ctx, id)
+	}
+	mmRotateKey.t.Fatalf("Unexpected call to BotServiceMock.RotateKey. %v %v", ctx, id)
+	return
+}
+
+// RotateKeyAfterCounter returns a count of finished BotServiceMock.RotateKey invocations
+func (mmRotateKey *BotServiceMock) RotateKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRotateKey.afterRotateKeyCounter)
+}
+
+// RotateKeyBeforeCounter returns a count of BotServiceMock.RotateKey invocations
+func (mmRotateKey *BotServiceMock) RotateKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRotateKey.beforeRotateKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotServiceMock.RotateKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRotateKey *mBotServiceMockRotateKey) Calls() []*BotServiceMockRotateKeyParams {
+	mmRotateKey.mutex.RLock()
+
+	argCopy := make([]*BotServiceMockRotateKeyParams, len(mmRotateKey.callArgs))
+	copy(argCopy, mmRotateKey.callArgs)
+
+	mmRotateKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRotateKeyDone returns true if the count of the RotateKey invocations corresponds
+// the number of defined expectations
+func (m *BotServiceMock) MinimockRotateKeyDone() bool {
+	if m.RotateKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RotateKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RotateKeyMock.invocationsDone()
+}
+
+// MinimockRotateKeyInspect logs each unmet expectation
+func (m *BotServiceMock) MinimockRotateKeyInspect() {
+	for _, e := range m.RotateKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotServiceMock.RotateKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRotateKeyCounter := mm_atomic.LoadUint64(&m.afterRotateKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RotateKeyMock.defaultExpectation != nil && afterRotateKeyCounter < 1 {
+		if m.RotateKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BotServiceMock.RotateKey at\n%s", m.RotateKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BotServiceMock.RotateKey at\n%s with params: %#v", m.RotateKeyMock.defaultExpectation.expectationOrigins.origin, *m.RotateKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRotateKey != nil && afterRotateKeyCounter < 1 {
+		m.t.Errorf("Expected call to BotServiceMock.RotateKey at\n%s", m.funcRotateKeyOrigin)
+	}
+
+	if !m.RotateKeyMock.invocationsDone() && afterRotateKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotServiceMock.RotateKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RotateKeyMock.expectedInvocations), m.RotateKeyMock.expectedInvocationsOrigin, afterRotateKeyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *BotServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAuthenticateInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockRevokeKeysInspect()
+
+			m.MinimockRotateKeyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *BotServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *BotServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAuthenticateDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockRevokeKeysDone() &&
+		m.MinimockRotateKeyDone()
+}
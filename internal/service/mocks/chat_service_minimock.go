@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/Mobo140/chat/internal/model"
@@ -19,6 +20,41 @@ type ChatServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAckDelivery          func(ctx context.Context, ack *model.DeliveryAck) (err error)
+	funcAckDeliveryOrigin    string
+	inspectFuncAckDelivery   func(ctx context.Context, ack *model.DeliveryAck)
+	afterAckDeliveryCounter  uint64
+	beforeAckDeliveryCounter uint64
+	AckDeliveryMock          mChatServiceMockAckDelivery
+
+	funcAddReaction          func(ctx context.Context, reaction *model.Reaction) (err error)
+	funcAddReactionOrigin    string
+	inspectFuncAddReaction   func(ctx context.Context, reaction *model.Reaction)
+	afterAddReactionCounter  uint64
+	beforeAddReactionCounter uint64
+	AddReactionMock          mChatServiceMockAddReaction
+
+	funcArchive          func(ctx context.Context, id int64) (err error)
+	funcArchiveOrigin    string
+	inspectFuncArchive   func(ctx context.Context, id int64)
+	afterArchiveCounter  uint64
+	beforeArchiveCounter uint64
+	ArchiveMock          mChatServiceMockArchive
+
+	funcCancelScheduled          func(ctx context.Context, cancel *model.CancelScheduled) (err error)
+	funcCancelScheduledOrigin    string
+	inspectFuncCancelScheduled   func(ctx context.Context, cancel *model.CancelScheduled)
+	afterCancelScheduledCounter  uint64
+	beforeCancelScheduledCounter uint64
+	CancelScheduledMock          mChatServiceMockCancelScheduled
+
+	funcCloseSession          func(ctx context.Context, id string) (err error)
+	funcCloseSessionOrigin    string
+	inspectFuncCloseSession   func(ctx context.Context, id string)
+	afterCloseSessionCounter  uint64
+	beforeCloseSessionCounter uint64
+	CloseSessionMock          mChatServiceMockCloseSession
+
 	funcCreate          func(ctx context.Context, chat *model.ChatInfo) (i1 int64, err error)
 	funcCreateOrigin    string
 	inspectFuncCreate   func(ctx context.Context, chat *model.ChatInfo)
@@ -33,6 +69,34 @@ type ChatServiceMock struct {
 	beforeDeleteCounter uint64
 	DeleteMock          mChatServiceMockDelete
 
+	funcDeleteMessage          func(ctx context.Context, deletion *model.DeleteMessage) (mp1 *model.Message, err error)
+	funcDeleteMessageOrigin    string
+	inspectFuncDeleteMessage   func(ctx context.Context, deletion *model.DeleteMessage)
+	afterDeleteMessageCounter  uint64
+	beforeDeleteMessageCounter uint64
+	DeleteMessageMock          mChatServiceMockDeleteMessage
+
+	funcDeliverScheduled          func(ctx context.Context, message *model.ScheduledMessage) (i1 int64, err error)
+	funcDeliverScheduledOrigin    string
+	inspectFuncDeliverScheduled   func(ctx context.Context, message *model.ScheduledMessage)
+	afterDeliverScheduledCounter  uint64
+	beforeDeliverScheduledCounter uint64
+	DeliverScheduledMock          mChatServiceMockDeliverScheduled
+
+	funcEditMessage          func(ctx context.Context, edit *model.EditMessage) (mp1 *model.Message, err error)
+	funcEditMessageOrigin    string
+	inspectFuncEditMessage   func(ctx context.Context, edit *model.EditMessage)
+	afterEditMessageCounter  uint64
+	beforeEditMessageCounter uint64
+	EditMessageMock          mChatServiceMockEditMessage
+
+	funcExportChat          func(ctx context.Context, chatID int64, emit func(record *model.ExportRecord) error) (err error)
+	funcExportChatOrigin    string
+	inspectFuncExportChat   func(ctx context.Context, chatID int64, emit func(record *model.ExportRecord) error)
+	afterExportChatCounter  uint64
+	beforeExportChatCounter uint64
+	ExportChatMock          mChatServiceMockExportChat
+
 	funcGet          func(ctx context.Context, id int64) (cp1 *model.Chat, err error)
 	funcGetOrigin    string
 	inspectFuncGet   func(ctx context.Context, id int64)
@@ -40,12 +104,152 @@ type ChatServiceMock struct {
 	beforeGetCounter uint64
 	GetMock          mChatServiceMockGet
 
-	funcSendMessage          func(ctx context.Context, message *model.SendMessage) (err error)
+	funcGetDeliveryCursor          func(ctx context.Context, chatID int64, username string) (dp1 *model.DeliveryCursor, err error)
+	funcGetDeliveryCursorOrigin    string
+	inspectFuncGetDeliveryCursor   func(ctx context.Context, chatID int64, username string)
+	afterGetDeliveryCursorCounter  uint64
+	beforeGetDeliveryCursorCounter uint64
+	GetDeliveryCursorMock          mChatServiceMockGetDeliveryCursor
+
+	funcGetDeliveryStatus          func(ctx context.Context, chatID int64, messageID int64) (mpa1 []*model.MemberDelivery, err error)
+	funcGetDeliveryStatusOrigin    string
+	inspectFuncGetDeliveryStatus   func(ctx context.Context, chatID int64, messageID int64)
+	afterGetDeliveryStatusCounter  uint64
+	beforeGetDeliveryStatusCounter uint64
+	GetDeliveryStatusMock          mChatServiceMockGetDeliveryStatus
+
+	funcImportChat          func(ctx context.Context, next func() (*model.ImportLine, error)) (ip1 *model.ImportReport, err error)
+	funcImportChatOrigin    string
+	inspectFuncImportChat   func(ctx context.Context, next func() (*model.ImportLine, error))
+	afterImportChatCounter  uint64
+	beforeImportChatCounter uint64
+	ImportChatMock          mChatServiceMockImportChat
+
+	funcListAfter          func(ctx context.Context, chatID int64, afterID int64, limit uint64) (mpa1 []*model.Message, err error)
+	funcListAfterOrigin    string
+	inspectFuncListAfter   func(ctx context.Context, chatID int64, afterID int64, limit uint64)
+	afterListAfterCounter  uint64
+	beforeListAfterCounter uint64
+	ListAfterMock          mChatServiceMockListAfter
+
+	funcListAuditLog          func(ctx context.Context, filter *model.LogFilter) (lpa1 []*model.LogEntry, err error)
+	funcListAuditLogOrigin    string
+	inspectFuncListAuditLog   func(ctx context.Context, filter *model.LogFilter)
+	afterListAuditLogCounter  uint64
+	beforeListAuditLogCounter uint64
+	ListAuditLogMock          mChatServiceMockListAuditLog
+
+	funcListMessages          func(ctx context.Context, page *model.MessagesPage) (mpa1 []*model.Message, err error)
+	funcListMessagesOrigin    string
+	inspectFuncListMessages   func(ctx context.Context, page *model.MessagesPage)
+	afterListMessagesCounter  uint64
+	beforeListMessagesCounter uint64
+	ListMessagesMock          mChatServiceMockListMessages
+
+	funcListScheduled          func(ctx context.Context, filter *model.ScheduledFilter) (spa1 []*model.ScheduledMessage, err error)
+	funcListScheduledOrigin    string
+	inspectFuncListScheduled   func(ctx context.Context, filter *model.ScheduledFilter)
+	afterListScheduledCounter  uint64
+	beforeListScheduledCounter uint64
+	ListScheduledMock          mChatServiceMockListScheduled
+
+	funcListSessions          func(ctx context.Context, filter *model.SessionFilter) (spa1 []*model.Session, err error)
+	funcListSessionsOrigin    string
+	inspectFuncListSessions   func(ctx context.Context, filter *model.SessionFilter)
+	afterListSessionsCounter  uint64
+	beforeListSessionsCounter uint64
+	ListSessionsMock          mChatServiceMockListSessions
+
+	funcListThread          func(ctx context.Context, page *model.ThreadPage) (tp1 *model.Thread, err error)
+	funcListThreadOrigin    string
+	inspectFuncListThread   func(ctx context.Context, page *model.ThreadPage)
+	afterListThreadCounter  uint64
+	beforeListThreadCounter uint64
+	ListThreadMock          mChatServiceMockListThread
+
+	funcListUndelivered          func(ctx context.Context, page *model.UndeliveredPage) (mpa1 []*model.Message, err error)
+	funcListUndeliveredOrigin    string
+	inspectFuncListUndelivered   func(ctx context.Context, page *model.UndeliveredPage)
+	afterListUndeliveredCounter  uint64
+	beforeListUndeliveredCounter uint64
+	ListUndeliveredMock          mChatServiceMockListUndelivered
+
+	funcMarkRead          func(ctx context.Context, read *model.DeliveryAck) (err error)
+	funcMarkReadOrigin    string
+	inspectFuncMarkRead   func(ctx context.Context, read *model.DeliveryAck)
+	afterMarkReadCounter  uint64
+	beforeMarkReadCounter uint64
+	MarkReadMock          mChatServiceMockMarkRead
+
+	funcOpenSession          func(ctx context.Context, session *model.Session) (err error)
+	funcOpenSessionOrigin    string
+	inspectFuncOpenSession   func(ctx context.Context, session *model.Session)
+	afterOpenSessionCounter  uint64
+	beforeOpenSessionCounter uint64
+	OpenSessionMock          mChatServiceMockOpenSession
+
+	funcRemoveReaction          func(ctx context.Context, reaction *model.Reaction) (err error)
+	funcRemoveReactionOrigin    string
+	inspectFuncRemoveReaction   func(ctx context.Context, reaction *model.Reaction)
+	afterRemoveReactionCounter  uint64
+	beforeRemoveReactionCounter uint64
+	RemoveReactionMock          mChatServiceMockRemoveReaction
+
+	funcRestore          func(ctx context.Context, id int64) (err error)
+	funcRestoreOrigin    string
+	inspectFuncRestore   func(ctx context.Context, id int64)
+	afterRestoreCounter  uint64
+	beforeRestoreCounter uint64
+	RestoreMock          mChatServiceMockRestore
+
+	funcRunCommand          func(ctx context.Context, call *model.CommandCall) (s1 string, err error)
+	funcRunCommandOrigin    string
+	inspectFuncRunCommand   func(ctx context.Context, call *model.CommandCall)
+	afterRunCommandCounter  uint64
+	beforeRunCommandCounter uint64
+	RunCommandMock          mChatServiceMockRunCommand
+
+	funcScheduleMessage          func(ctx context.Context, message *model.ScheduledMessage) (i1 int64, err error)
+	funcScheduleMessageOrigin    string
+	inspectFuncScheduleMessage   func(ctx context.Context, message *model.ScheduledMessage)
+	afterScheduleMessageCounter  uint64
+	beforeScheduleMessageCounter uint64
+	ScheduleMessageMock          mChatServiceMockScheduleMessage
+
+	funcSearchMessages          func(ctx context.Context, query *model.SearchQuery) (spa1 []*model.SearchResult, err error)
+	funcSearchMessagesOrigin    string
+	inspectFuncSearchMessages   func(ctx context.Context, query *model.SearchQuery)
+	afterSearchMessagesCounter  uint64
+	beforeSearchMessagesCounter uint64
+	SearchMessagesMock          mChatServiceMockSearchMessages
+
+	funcSendMessage          func(ctx context.Context, message *model.SendMessage) (i1 int64, err error)
 	funcSendMessageOrigin    string
 	inspectFuncSendMessage   func(ctx context.Context, message *model.SendMessage)
 	afterSendMessageCounter  uint64
 	beforeSendMessageCounter uint64
 	SendMessageMock          mChatServiceMockSendMessage
+
+	funcSetRetention          func(ctx context.Context, retention *model.ChatRetention) (err error)
+	funcSetRetentionOrigin    string
+	inspectFuncSetRetention   func(ctx context.Context, retention *model.ChatRetention)
+	afterSetRetentionCounter  uint64
+	beforeSetRetentionCounter uint64
+	SetRetentionMock          mChatServiceMockSetRetention
+
+	funcTouchSession          func(ctx context.Context, id string, lastActiveAt time.Time) (err error)
+	funcTouchSessionOrigin    string
+	inspectFuncTouchSession   func(ctx context.Context, id string, lastActiveAt time.Time)
+	afterTouchSessionCounter  uint64
+	beforeTouchSessionCounter uint64
+	TouchSessionMock          mChatServiceMockTouchSession
+
+	funcUnarchive          func(ctx context.Context, id int64) (err error)
+	funcUnarchiveOrigin    string
+	inspectFuncUnarchive   func(ctx context.Context, id int64)
+	afterUnarchiveCounter  uint64
+	beforeUnarchiveCounter uint64
+	UnarchiveMock          mChatServiceMockUnarchive
 }
 
 // NewChatServiceMock returns a mock for mm_service.ChatService
@@ -56,70 +260,156 @@ func NewChatServiceMock(t minimock.Tester) *ChatServiceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AckDeliveryMock = mChatServiceMockAckDelivery{mock: m}
+	m.AckDeliveryMock.callArgs = []*ChatServiceMockAckDeliveryParams{}
+
+	m.AddReactionMock = mChatServiceMockAddReaction{mock: m}
+	m.AddReactionMock.callArgs = []*ChatServiceMockAddReactionParams{}
+
+	m.ArchiveMock = mChatServiceMockArchive{mock: m}
+	m.ArchiveMock.callArgs = []*ChatServiceMockArchiveParams{}
+
+	m.CancelScheduledMock = mChatServiceMockCancelScheduled{mock: m}
+	m.CancelScheduledMock.callArgs = []*ChatServiceMockCancelScheduledParams{}
+
+	m.CloseSessionMock = mChatServiceMockCloseSession{mock: m}
+	m.CloseSessionMock.callArgs = []*ChatServiceMockCloseSessionParams{}
+
 	m.CreateMock = mChatServiceMockCreate{mock: m}
 	m.CreateMock.callArgs = []*ChatServiceMockCreateParams{}
 
 	m.DeleteMock = mChatServiceMockDelete{mock: m}
 	m.DeleteMock.callArgs = []*ChatServiceMockDeleteParams{}
 
+	m.DeleteMessageMock = mChatServiceMockDeleteMessage{mock: m}
+	m.DeleteMessageMock.callArgs = []*ChatServiceMockDeleteMessageParams{}
+
+	m.DeliverScheduledMock = mChatServiceMockDeliverScheduled{mock: m}
+	m.DeliverScheduledMock.callArgs = []*ChatServiceMockDeliverScheduledParams{}
+
+	m.EditMessageMock = mChatServiceMockEditMessage{mock: m}
+	m.EditMessageMock.callArgs = []*ChatServiceMockEditMessageParams{}
+
+	m.ExportChatMock = mChatServiceMockExportChat{mock: m}
+	m.ExportChatMock.callArgs = []*ChatServiceMockExportChatParams{}
+
 	m.GetMock = mChatServiceMockGet{mock: m}
 	m.GetMock.callArgs = []*ChatServiceMockGetParams{}
 
+	m.GetDeliveryCursorMock = mChatServiceMockGetDeliveryCursor{mock: m}
+	m.GetDeliveryCursorMock.callArgs = []*ChatServiceMockGetDeliveryCursorParams{}
+
+	m.GetDeliveryStatusMock = mChatServiceMockGetDeliveryStatus{mock: m}
+	m.GetDeliveryStatusMock.callArgs = []*ChatServiceMockGetDeliveryStatusParams{}
+
+	m.ImportChatMock = mChatServiceMockImportChat{mock: m}
+	m.ImportChatMock.callArgs = []*ChatServiceMockImportChatParams{}
+
+	m.ListAfterMock = mChatServiceMockListAfter{mock: m}
+	m.ListAfterMock.callArgs = []*ChatServiceMockListAfterParams{}
+
+	m.ListAuditLogMock = mChatServiceMockListAuditLog{mock: m}
+	m.ListAuditLogMock.callArgs = []*ChatServiceMockListAuditLogParams{}
+
+	m.ListMessagesMock = mChatServiceMockListMessages{mock: m}
+	m.ListMessagesMock.callArgs = []*ChatServiceMockListMessagesParams{}
+
+	m.ListScheduledMock = mChatServiceMockListScheduled{mock: m}
+	m.ListScheduledMock.callArgs = []*ChatServiceMockListScheduledParams{}
+
+	m.ListSessionsMock = mChatServiceMockListSessions{mock: m}
+	m.ListSessionsMock.callArgs = []*ChatServiceMockListSessionsParams{}
+
+	m.ListThreadMock = mChatServiceMockListThread{mock: m}
+	m.ListThreadMock.callArgs = []*ChatServiceMockListThreadParams{}
+
+	m.ListUndeliveredMock = mChatServiceMockListUndelivered{mock: m}
+	m.ListUndeliveredMock.callArgs = []*ChatServiceMockListUndeliveredParams{}
+
+	m.MarkReadMock = mChatServiceMockMarkRead{mock: m}
+	m.MarkReadMock.callArgs = []*ChatServiceMockMarkReadParams{}
+
+	m.OpenSessionMock = mChatServiceMockOpenSession{mock: m}
+	m.OpenSessionMock.callArgs = []*ChatServiceMockOpenSessionParams{}
+
+	m.RemoveReactionMock = mChatServiceMockRemoveReaction{mock: m}
+	m.RemoveReactionMock.callArgs = []*ChatServiceMockRemoveReactionParams{}
+
+	m.RestoreMock = mChatServiceMockRestore{mock: m}
+	m.RestoreMock.callArgs = []*ChatServiceMockRestoreParams{}
+
+	m.RunCommandMock = mChatServiceMockRunCommand{mock: m}
+	m.RunCommandMock.callArgs = []*ChatServiceMockRunCommandParams{}
+
+	m.ScheduleMessageMock = mChatServiceMockScheduleMessage{mock: m}
+	m.ScheduleMessageMock.callArgs = []*ChatServiceMockScheduleMessageParams{}
+
+	m.SearchMessagesMock = mChatServiceMockSearchMessages{mock: m}
+	m.SearchMessagesMock.callArgs = []*ChatServiceMockSearchMessagesParams{}
+
 	m.SendMessageMock = mChatServiceMockSendMessage{mock: m}
 	m.SendMessageMock.callArgs = []*ChatServiceMockSendMessageParams{}
 
+	m.SetRetentionMock = mChatServiceMockSetRetention{mock: m}
+	m.SetRetentionMock.callArgs = []*ChatServiceMockSetRetentionParams{}
+
+	m.TouchSessionMock = mChatServiceMockTouchSession{mock: m}
+	m.TouchSessionMock.callArgs = []*ChatServiceMockTouchSessionParams{}
+
+	m.UnarchiveMock = mChatServiceMockUnarchive{mock: m}
+	m.UnarchiveMock.callArgs = []*ChatServiceMockUnarchiveParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mChatServiceMockCreate struct {
+type mChatServiceMockAckDelivery struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockCreateExpectation
-	expectations       []*ChatServiceMockCreateExpectation
+	defaultExpectation *ChatServiceMockAckDeliveryExpectation
+	expectations       []*ChatServiceMockAckDeliveryExpectation
 
-	callArgs []*ChatServiceMockCreateParams
+	callArgs []*ChatServiceMockAckDeliveryParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatServiceMockCreateExpectation specifies expectation struct of the ChatService.Create
-type ChatServiceMockCreateExpectation struct {
+// ChatServiceMockAckDeliveryExpectation specifies expectation struct of the ChatService.AckDelivery
+type ChatServiceMockAckDeliveryExpectation struct {
 	mock               *ChatServiceMock
-	params             *ChatServiceMockCreateParams
-	paramPtrs          *ChatServiceMockCreateParamPtrs
-	expectationOrigins ChatServiceMockCreateExpectationOrigins
-	results            *ChatServiceMockCreateResults
+	params             *ChatServiceMockAckDeliveryParams
+	paramPtrs          *ChatServiceMockAckDeliveryParamPtrs
+	expectationOrigins ChatServiceMockAckDeliveryExpectationOrigins
+	results            *ChatServiceMockAckDeliveryResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatServiceMockCreateParams contains parameters of the ChatService.Create
-type ChatServiceMockCreateParams struct {
-	ctx  context.Context
-	chat *model.ChatInfo
+// ChatServiceMockAckDeliveryParams contains parameters of the ChatService.AckDelivery
+type ChatServiceMockAckDeliveryParams struct {
+	ctx context.Context
+	ack *model.DeliveryAck
 }
 
-// ChatServiceMockCreateParamPtrs contains pointers to parameters of the ChatService.Create
-type ChatServiceMockCreateParamPtrs struct {
-	ctx  *context.Context
-	chat **model.ChatInfo
+// ChatServiceMockAckDeliveryParamPtrs contains pointers to parameters of the ChatService.AckDelivery
+type ChatServiceMockAckDeliveryParamPtrs struct {
+	ctx *context.Context
+	ack **model.DeliveryAck
 }
 
-// ChatServiceMockCreateResults contains results of the ChatService.Create
-type ChatServiceMockCreateResults struct {
-	i1  int64
+// ChatServiceMockAckDeliveryResults contains results of the ChatService.AckDelivery
+type ChatServiceMockAckDeliveryResults struct {
 	err error
 }
 
-// ChatServiceMockCreateOrigins contains origins of expectations of the ChatService.Create
-type ChatServiceMockCreateExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originChat string
+// ChatServiceMockAckDeliveryOrigins contains origins of expectations of the ChatService.AckDelivery
+type ChatServiceMockAckDeliveryExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originAck string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -127,341 +417,341 @@ type ChatServiceMockCreateExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreate *mChatServiceMockCreate) Optional() *mChatServiceMockCreate {
-	mmCreate.optional = true
-	return mmCreate
+func (mmAckDelivery *mChatServiceMockAckDelivery) Optional() *mChatServiceMockAckDelivery {
+	mmAckDelivery.optional = true
+	return mmAckDelivery
 }
 
-// Expect sets up expected params for ChatService.Create
-func (mmCreate *mChatServiceMockCreate) Expect(ctx context.Context, chat *model.ChatInfo) *mChatServiceMockCreate {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+// Expect sets up expected params for ChatService.AckDelivery
+func (mmAckDelivery *mChatServiceMockAckDelivery) Expect(ctx context.Context, ack *model.DeliveryAck) *mChatServiceMockAckDelivery {
+	if mmAckDelivery.mock.funcAckDelivery != nil {
+		mmAckDelivery.mock.t.Fatalf("ChatServiceMock.AckDelivery mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &ChatServiceMockCreateExpectation{}
+	if mmAckDelivery.defaultExpectation == nil {
+		mmAckDelivery.defaultExpectation = &ChatServiceMockAckDeliveryExpectation{}
 	}
 
-	if mmCreate.defaultExpectation.paramPtrs != nil {
-		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by ExpectParams functions")
+	if mmAckDelivery.defaultExpectation.paramPtrs != nil {
+		mmAckDelivery.mock.t.Fatalf("ChatServiceMock.AckDelivery mock is already set by ExpectParams functions")
 	}
 
-	mmCreate.defaultExpectation.params = &ChatServiceMockCreateParams{ctx, chat}
-	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreate.expectations {
-		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
-			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+	mmAckDelivery.defaultExpectation.params = &ChatServiceMockAckDeliveryParams{ctx, ack}
+	mmAckDelivery.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAckDelivery.expectations {
+		if minimock.Equal(e.params, mmAckDelivery.defaultExpectation.params) {
+			mmAckDelivery.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAckDelivery.defaultExpectation.params)
 		}
 	}
 
-	return mmCreate
+	return mmAckDelivery
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.Create
-func (mmCreate *mChatServiceMockCreate) ExpectCtxParam1(ctx context.Context) *mChatServiceMockCreate {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.AckDelivery
+func (mmAckDelivery *mChatServiceMockAckDelivery) ExpectCtxParam1(ctx context.Context) *mChatServiceMockAckDelivery {
+	if mmAckDelivery.mock.funcAckDelivery != nil {
+		mmAckDelivery.mock.t.Fatalf("ChatServiceMock.AckDelivery mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &ChatServiceMockCreateExpectation{}
+	if mmAckDelivery.defaultExpectation == nil {
+		mmAckDelivery.defaultExpectation = &ChatServiceMockAckDeliveryExpectation{}
 	}
 
-	if mmCreate.defaultExpectation.params != nil {
-		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Expect")
+	if mmAckDelivery.defaultExpectation.params != nil {
+		mmAckDelivery.mock.t.Fatalf("ChatServiceMock.AckDelivery mock is already set by Expect")
 	}
 
-	if mmCreate.defaultExpectation.paramPtrs == nil {
-		mmCreate.defaultExpectation.paramPtrs = &ChatServiceMockCreateParamPtrs{}
+	if mmAckDelivery.defaultExpectation.paramPtrs == nil {
+		mmAckDelivery.defaultExpectation.paramPtrs = &ChatServiceMockAckDeliveryParamPtrs{}
 	}
-	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAckDelivery.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAckDelivery.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreate
+	return mmAckDelivery
 }
 
-// ExpectChatParam2 sets up expected param chat for ChatService.Create
-func (mmCreate *mChatServiceMockCreate) ExpectChatParam2(chat *model.ChatInfo) *mChatServiceMockCreate {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+// ExpectAckParam2 sets up expected param ack for ChatService.AckDelivery
+func (mmAckDelivery *mChatServiceMockAckDelivery) ExpectAckParam2(ack *model.DeliveryAck) *mChatServiceMockAckDelivery {
+	if mmAckDelivery.mock.funcAckDelivery != nil {
+		mmAckDelivery.mock.t.Fatalf("ChatServiceMock.AckDelivery mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &ChatServiceMockCreateExpectation{}
+	if mmAckDelivery.defaultExpectation == nil {
+		mmAckDelivery.defaultExpectation = &ChatServiceMockAckDeliveryExpectation{}
 	}
 
-	if mmCreate.defaultExpectation.params != nil {
-		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Expect")
+	if mmAckDelivery.defaultExpectation.params != nil {
+		mmAckDelivery.mock.t.Fatalf("ChatServiceMock.AckDelivery mock is already set by Expect")
 	}
 
-	if mmCreate.defaultExpectation.paramPtrs == nil {
-		mmCreate.defaultExpectation.paramPtrs = &ChatServiceMockCreateParamPtrs{}
+	if mmAckDelivery.defaultExpectation.paramPtrs == nil {
+		mmAckDelivery.defaultExpectation.paramPtrs = &ChatServiceMockAckDeliveryParamPtrs{}
 	}
-	mmCreate.defaultExpectation.paramPtrs.chat = &chat
-	mmCreate.defaultExpectation.expectationOrigins.originChat = minimock.CallerInfo(1)
+	mmAckDelivery.defaultExpectation.paramPtrs.ack = &ack
+	mmAckDelivery.defaultExpectation.expectationOrigins.originAck = minimock.CallerInfo(1)
 
-	return mmCreate
+	return mmAckDelivery
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.Create
-func (mmCreate *mChatServiceMockCreate) Inspect(f func(ctx context.Context, chat *model.ChatInfo)) *mChatServiceMockCreate {
-	if mmCreate.mock.inspectFuncCreate != nil {
-		mmCreate.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Create")
+// Inspect accepts an inspector function that has same arguments as the ChatService.AckDelivery
+func (mmAckDelivery *mChatServiceMockAckDelivery) Inspect(f func(ctx context.Context, ack *model.DeliveryAck)) *mChatServiceMockAckDelivery {
+	if mmAckDelivery.mock.inspectFuncAckDelivery != nil {
+		mmAckDelivery.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.AckDelivery")
 	}
 
-	mmCreate.mock.inspectFuncCreate = f
+	mmAckDelivery.mock.inspectFuncAckDelivery = f
 
-	return mmCreate
+	return mmAckDelivery
 }
 
-// Return sets up results that will be returned by ChatService.Create
-func (mmCreate *mChatServiceMockCreate) Return(i1 int64, err error) *ChatServiceMock {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+// Return sets up results that will be returned by ChatService.AckDelivery
+func (mmAckDelivery *mChatServiceMockAckDelivery) Return(err error) *ChatServiceMock {
+	if mmAckDelivery.mock.funcAckDelivery != nil {
+		mmAckDelivery.mock.t.Fatalf("ChatServiceMock.AckDelivery mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &ChatServiceMockCreateExpectation{mock: mmCreate.mock}
+	if mmAckDelivery.defaultExpectation == nil {
+		mmAckDelivery.defaultExpectation = &ChatServiceMockAckDeliveryExpectation{mock: mmAckDelivery.mock}
 	}
-	mmCreate.defaultExpectation.results = &ChatServiceMockCreateResults{i1, err}
-	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreate.mock
+	mmAckDelivery.defaultExpectation.results = &ChatServiceMockAckDeliveryResults{err}
+	mmAckDelivery.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAckDelivery.mock
 }
 
-// Set uses given function f to mock the ChatService.Create method
-func (mmCreate *mChatServiceMockCreate) Set(f func(ctx context.Context, chat *model.ChatInfo) (i1 int64, err error)) *ChatServiceMock {
-	if mmCreate.defaultExpectation != nil {
-		mmCreate.mock.t.Fatalf("Default expectation is already set for the ChatService.Create method")
+// Set uses given function f to mock the ChatService.AckDelivery method
+func (mmAckDelivery *mChatServiceMockAckDelivery) Set(f func(ctx context.Context, ack *model.DeliveryAck) (err error)) *ChatServiceMock {
+	if mmAckDelivery.defaultExpectation != nil {
+		mmAckDelivery.mock.t.Fatalf("Default expectation is already set for the ChatService.AckDelivery method")
 	}
 
-	if len(mmCreate.expectations) > 0 {
-		mmCreate.mock.t.Fatalf("Some expectations are already set for the ChatService.Create method")
+	if len(mmAckDelivery.expectations) > 0 {
+		mmAckDelivery.mock.t.Fatalf("Some expectations are already set for the ChatService.AckDelivery method")
 	}
 
-	mmCreate.mock.funcCreate = f
-	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
-	return mmCreate.mock
+	mmAckDelivery.mock.funcAckDelivery = f
+	mmAckDelivery.mock.funcAckDeliveryOrigin = minimock.CallerInfo(1)
+	return mmAckDelivery.mock
 }
 
-// When sets expectation for the ChatService.Create which will trigger the result defined by the following
+// When sets expectation for the ChatService.AckDelivery which will trigger the result defined by the following
 // Then helper
-func (mmCreate *mChatServiceMockCreate) When(ctx context.Context, chat *model.ChatInfo) *ChatServiceMockCreateExpectation {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+func (mmAckDelivery *mChatServiceMockAckDelivery) When(ctx context.Context, ack *model.DeliveryAck) *ChatServiceMockAckDeliveryExpectation {
+	if mmAckDelivery.mock.funcAckDelivery != nil {
+		mmAckDelivery.mock.t.Fatalf("ChatServiceMock.AckDelivery mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockCreateExpectation{
-		mock:               mmCreate.mock,
-		params:             &ChatServiceMockCreateParams{ctx, chat},
-		expectationOrigins: ChatServiceMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatServiceMockAckDeliveryExpectation{
+		mock:               mmAckDelivery.mock,
+		params:             &ChatServiceMockAckDeliveryParams{ctx, ack},
+		expectationOrigins: ChatServiceMockAckDeliveryExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	mmAckDelivery.expectations = append(mmAckDelivery.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.Create return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockCreateExpectation) Then(i1 int64, err error) *ChatServiceMock {
-	e.results = &ChatServiceMockCreateResults{i1, err}
+// Then sets up ChatService.AckDelivery return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockAckDeliveryExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockAckDeliveryResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.Create should be invoked
-func (mmCreate *mChatServiceMockCreate) Times(n uint64) *mChatServiceMockCreate {
+// Times sets number of times ChatService.AckDelivery should be invoked
+func (mmAckDelivery *mChatServiceMockAckDelivery) Times(n uint64) *mChatServiceMockAckDelivery {
 	if n == 0 {
-		mmCreate.mock.t.Fatalf("Times of ChatServiceMock.Create mock can not be zero")
+		mmAckDelivery.mock.t.Fatalf("Times of ChatServiceMock.AckDelivery mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
-	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreate
+	mm_atomic.StoreUint64(&mmAckDelivery.expectedInvocations, n)
+	mmAckDelivery.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAckDelivery
 }
 
-func (mmCreate *mChatServiceMockCreate) invocationsDone() bool {
-	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+func (mmAckDelivery *mChatServiceMockAckDelivery) invocationsDone() bool {
+	if len(mmAckDelivery.expectations) == 0 && mmAckDelivery.defaultExpectation == nil && mmAckDelivery.mock.funcAckDelivery == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAckDelivery.mock.afterAckDeliveryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAckDelivery.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Create implements mm_service.ChatService
-func (mmCreate *ChatServiceMock) Create(ctx context.Context, chat *model.ChatInfo) (i1 int64, err error) {
-	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+// AckDelivery implements mm_service.ChatService
+func (mmAckDelivery *ChatServiceMock) AckDelivery(ctx context.Context, ack *model.DeliveryAck) (err error) {
+	mm_atomic.AddUint64(&mmAckDelivery.beforeAckDeliveryCounter, 1)
+	defer mm_atomic.AddUint64(&mmAckDelivery.afterAckDeliveryCounter, 1)
 
-	mmCreate.t.Helper()
+	mmAckDelivery.t.Helper()
 
-	if mmCreate.inspectFuncCreate != nil {
-		mmCreate.inspectFuncCreate(ctx, chat)
+	if mmAckDelivery.inspectFuncAckDelivery != nil {
+		mmAckDelivery.inspectFuncAckDelivery(ctx, ack)
 	}
 
-	mm_params := ChatServiceMockCreateParams{ctx, chat}
+	mm_params := ChatServiceMockAckDeliveryParams{ctx, ack}
 
 	// Record call args
-	mmCreate.CreateMock.mutex.Lock()
-	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
-	mmCreate.CreateMock.mutex.Unlock()
+	mmAckDelivery.AckDeliveryMock.mutex.Lock()
+	mmAckDelivery.AckDeliveryMock.callArgs = append(mmAckDelivery.AckDeliveryMock.callArgs, &mm_params)
+	mmAckDelivery.AckDeliveryMock.mutex.Unlock()
 
-	for _, e := range mmCreate.CreateMock.expectations {
+	for _, e := range mmAckDelivery.AckDeliveryMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.i1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmCreate.CreateMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreate.CreateMock.defaultExpectation.params
-		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+	if mmAckDelivery.AckDeliveryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAckDelivery.AckDeliveryMock.defaultExpectation.Counter, 1)
+		mm_want := mmAckDelivery.AckDeliveryMock.defaultExpectation.params
+		mm_want_ptrs := mmAckDelivery.AckDeliveryMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockCreateParams{ctx, chat}
+		mm_got := ChatServiceMockAckDeliveryParams{ctx, ack}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreate.t.Errorf("ChatServiceMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAckDelivery.t.Errorf("ChatServiceMock.AckDelivery got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAckDelivery.AckDeliveryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.chat != nil && !minimock.Equal(*mm_want_ptrs.chat, mm_got.chat) {
-				mmCreate.t.Errorf("ChatServiceMock.Create got unexpected parameter chat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originChat, *mm_want_ptrs.chat, mm_got.chat, minimock.Diff(*mm_want_ptrs.chat, mm_got.chat))
+			if mm_want_ptrs.ack != nil && !minimock.Equal(*mm_want_ptrs.ack, mm_got.ack) {
+				mmAckDelivery.t.Errorf("ChatServiceMock.AckDelivery got unexpected parameter ack, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAckDelivery.AckDeliveryMock.defaultExpectation.expectationOrigins.originAck, *mm_want_ptrs.ack, mm_got.ack, minimock.Diff(*mm_want_ptrs.ack, mm_got.ack))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreate.t.Errorf("ChatServiceMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAckDelivery.t.Errorf("ChatServiceMock.AckDelivery got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAckDelivery.AckDeliveryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		mm_results := mmAckDelivery.AckDeliveryMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreate.t.Fatal("No results are set for the ChatServiceMock.Create")
+			mmAckDelivery.t.Fatal("No results are set for the ChatServiceMock.AckDelivery")
 		}
-		return (*mm_results).i1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmCreate.funcCreate != nil {
-		return mmCreate.funcCreate(ctx, chat)
+	if mmAckDelivery.funcAckDelivery != nil {
+		return mmAckDelivery.funcAckDelivery(ctx, ack)
 	}
-	mmCreate.t.Fatalf("Unexpected call to ChatServiceMock.Create. %v %v", ctx, chat)
+	mmAckDelivery.t.Fatalf("Unexpected call to ChatServiceMock.AckDelivery. %v %v", ctx, ack)
 	return
 }
 
-// CreateAfterCounter returns a count of finished ChatServiceMock.Create invocations
-func (mmCreate *ChatServiceMock) CreateAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+// AckDeliveryAfterCounter returns a count of finished ChatServiceMock.AckDelivery invocations
+func (mmAckDelivery *ChatServiceMock) AckDeliveryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAckDelivery.afterAckDeliveryCounter)
 }
 
-// CreateBeforeCounter returns a count of ChatServiceMock.Create invocations
-func (mmCreate *ChatServiceMock) CreateBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+// AckDeliveryBeforeCounter returns a count of ChatServiceMock.AckDelivery invocations
+func (mmAckDelivery *ChatServiceMock) AckDeliveryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAckDelivery.beforeAckDeliveryCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.Create.
+// Calls returns a list of arguments used in each call to ChatServiceMock.AckDelivery.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreate *mChatServiceMockCreate) Calls() []*ChatServiceMockCreateParams {
-	mmCreate.mutex.RLock()
+func (mmAckDelivery *mChatServiceMockAckDelivery) Calls() []*ChatServiceMockAckDeliveryParams {
+	mmAckDelivery.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockCreateParams, len(mmCreate.callArgs))
-	copy(argCopy, mmCreate.callArgs)
+	argCopy := make([]*ChatServiceMockAckDeliveryParams, len(mmAckDelivery.callArgs))
+	copy(argCopy, mmAckDelivery.callArgs)
 
-	mmCreate.mutex.RUnlock()
+	mmAckDelivery.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// MinimockAckDeliveryDone returns true if the count of the AckDelivery invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockCreateDone() bool {
-	if m.CreateMock.optional {
+func (m *ChatServiceMock) MinimockAckDeliveryDone() bool {
+	if m.AckDeliveryMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateMock.expectations {
+	for _, e := range m.AckDeliveryMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateMock.invocationsDone()
+	return m.AckDeliveryMock.invocationsDone()
 }
 
-// MinimockCreateInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockCreateInspect() {
-	for _, e := range m.CreateMock.expectations {
+// MinimockAckDeliveryInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockAckDeliveryInspect() {
+	for _, e := range m.AckDeliveryMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.AckDelivery at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	afterAckDeliveryCounter := mm_atomic.LoadUint64(&m.afterAckDeliveryCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
-		if m.CreateMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatServiceMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+	if m.AckDeliveryMock.defaultExpectation != nil && afterAckDeliveryCounter < 1 {
+		if m.AckDeliveryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.AckDelivery at\n%s", m.AckDeliveryMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.AckDelivery at\n%s with params: %#v", m.AckDeliveryMock.defaultExpectation.expectationOrigins.origin, *m.AckDeliveryMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreate != nil && afterCreateCounter < 1 {
-		m.t.Errorf("Expected call to ChatServiceMock.Create at\n%s", m.funcCreateOrigin)
+	if m.funcAckDelivery != nil && afterAckDeliveryCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.AckDelivery at\n%s", m.funcAckDeliveryOrigin)
 	}
 
-	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.Create at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	if !m.AckDeliveryMock.invocationsDone() && afterAckDeliveryCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.AckDelivery at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AckDeliveryMock.expectedInvocations), m.AckDeliveryMock.expectedInvocationsOrigin, afterAckDeliveryCounter)
 	}
 }
 
-type mChatServiceMockDelete struct {
+type mChatServiceMockAddReaction struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockDeleteExpectation
-	expectations       []*ChatServiceMockDeleteExpectation
+	defaultExpectation *ChatServiceMockAddReactionExpectation
+	expectations       []*ChatServiceMockAddReactionExpectation
 
-	callArgs []*ChatServiceMockDeleteParams
+	callArgs []*ChatServiceMockAddReactionParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatServiceMockDeleteExpectation specifies expectation struct of the ChatService.Delete
-type ChatServiceMockDeleteExpectation struct {
+// ChatServiceMockAddReactionExpectation specifies expectation struct of the ChatService.AddReaction
+type ChatServiceMockAddReactionExpectation struct {
 	mock               *ChatServiceMock
-	params             *ChatServiceMockDeleteParams
-	paramPtrs          *ChatServiceMockDeleteParamPtrs
-	expectationOrigins ChatServiceMockDeleteExpectationOrigins
-	results            *ChatServiceMockDeleteResults
+	params             *ChatServiceMockAddReactionParams
+	paramPtrs          *ChatServiceMockAddReactionParamPtrs
+	expectationOrigins ChatServiceMockAddReactionExpectationOrigins
+	results            *ChatServiceMockAddReactionResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatServiceMockDeleteParams contains parameters of the ChatService.Delete
-type ChatServiceMockDeleteParams struct {
-	ctx context.Context
-	id  int64
+// ChatServiceMockAddReactionParams contains parameters of the ChatService.AddReaction
+type ChatServiceMockAddReactionParams struct {
+	ctx      context.Context
+	reaction *model.Reaction
 }
 
-// ChatServiceMockDeleteParamPtrs contains pointers to parameters of the ChatService.Delete
-type ChatServiceMockDeleteParamPtrs struct {
-	ctx *context.Context
-	id  *int64
+// ChatServiceMockAddReactionParamPtrs contains pointers to parameters of the ChatService.AddReaction
+type ChatServiceMockAddReactionParamPtrs struct {
+	ctx      *context.Context
+	reaction **model.Reaction
 }
 
-// ChatServiceMockDeleteResults contains results of the ChatService.Delete
-type ChatServiceMockDeleteResults struct {
+// ChatServiceMockAddReactionResults contains results of the ChatService.AddReaction
+type ChatServiceMockAddReactionResults struct {
 	err error
 }
 
-// ChatServiceMockDeleteOrigins contains origins of expectations of the ChatService.Delete
-type ChatServiceMockDeleteExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// ChatServiceMockAddReactionOrigins contains origins of expectations of the ChatService.AddReaction
+type ChatServiceMockAddReactionExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originReaction string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -469,339 +759,338 @@ type ChatServiceMockDeleteExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDelete *mChatServiceMockDelete) Optional() *mChatServiceMockDelete {
-	mmDelete.optional = true
-	return mmDelete
+func (mmAddReaction *mChatServiceMockAddReaction) Optional() *mChatServiceMockAddReaction {
+	mmAddReaction.optional = true
+	return mmAddReaction
 }
 
-// Expect sets up expected params for ChatService.Delete
-func (mmDelete *mChatServiceMockDelete) Expect(ctx context.Context, id int64) *mChatServiceMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+// Expect sets up expected params for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) Expect(ctx context.Context, reaction *model.Reaction) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{}
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs != nil {
-		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by ExpectParams functions")
+	if mmAddReaction.defaultExpectation.paramPtrs != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by ExpectParams functions")
 	}
 
-	mmDelete.defaultExpectation.params = &ChatServiceMockDeleteParams{ctx, id}
-	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDelete.expectations {
-		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
-			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+	mmAddReaction.defaultExpectation.params = &ChatServiceMockAddReactionParams{ctx, reaction}
+	mmAddReaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddReaction.expectations {
+		if minimock.Equal(e.params, mmAddReaction.defaultExpectation.params) {
+			mmAddReaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddReaction.defaultExpectation.params)
 		}
 	}
 
-	return mmDelete
+	return mmAddReaction
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.Delete
-func (mmDelete *mChatServiceMockDelete) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) ExpectCtxParam1(ctx context.Context) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{}
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.params != nil {
-		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Expect")
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Expect")
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs == nil {
-		mmDelete.defaultExpectation.paramPtrs = &ChatServiceMockDeleteParamPtrs{}
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatServiceMockAddReactionParamPtrs{}
 	}
-	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddReaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddReaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDelete
+	return mmAddReaction
 }
 
-// ExpectIdParam2 sets up expected param id for ChatService.Delete
-func (mmDelete *mChatServiceMockDelete) ExpectIdParam2(id int64) *mChatServiceMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+// ExpectReactionParam2 sets up expected param reaction for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) ExpectReactionParam2(reaction *model.Reaction) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{}
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.params != nil {
-		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Expect")
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Expect")
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs == nil {
-		mmDelete.defaultExpectation.paramPtrs = &ChatServiceMockDeleteParamPtrs{}
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatServiceMockAddReactionParamPtrs{}
 	}
-	mmDelete.defaultExpectation.paramPtrs.id = &id
-	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmAddReaction.defaultExpectation.paramPtrs.reaction = &reaction
+	mmAddReaction.defaultExpectation.expectationOrigins.originReaction = minimock.CallerInfo(1)
 
-	return mmDelete
+	return mmAddReaction
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.Delete
-func (mmDelete *mChatServiceMockDelete) Inspect(f func(ctx context.Context, id int64)) *mChatServiceMockDelete {
-	if mmDelete.mock.inspectFuncDelete != nil {
-		mmDelete.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Delete")
+// Inspect accepts an inspector function that has same arguments as the ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) Inspect(f func(ctx context.Context, reaction *model.Reaction)) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.inspectFuncAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.AddReaction")
 	}
 
-	mmDelete.mock.inspectFuncDelete = f
+	mmAddReaction.mock.inspectFuncAddReaction = f
 
-	return mmDelete
+	return mmAddReaction
 }
 
-// Return sets up results that will be returned by ChatService.Delete
-func (mmDelete *mChatServiceMockDelete) Return(err error) *ChatServiceMock {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+// Return sets up results that will be returned by ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) Return(err error) *ChatServiceMock {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{mock: mmDelete.mock}
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{mock: mmAddReaction.mock}
 	}
-	mmDelete.defaultExpectation.results = &ChatServiceMockDeleteResults{err}
-	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDelete.mock
+	mmAddReaction.defaultExpectation.results = &ChatServiceMockAddReactionResults{err}
+	mmAddReaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddReaction.mock
 }
 
-// Set uses given function f to mock the ChatService.Delete method
-func (mmDelete *mChatServiceMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *ChatServiceMock {
-	if mmDelete.defaultExpectation != nil {
-		mmDelete.mock.t.Fatalf("Default expectation is already set for the ChatService.Delete method")
+// Set uses given function f to mock the ChatService.AddReaction method
+func (mmAddReaction *mChatServiceMockAddReaction) Set(f func(ctx context.Context, reaction *model.Reaction) (err error)) *ChatServiceMock {
+	if mmAddReaction.defaultExpectation != nil {
+		mmAddReaction.mock.t.Fatalf("Default expectation is already set for the ChatService.AddReaction method")
 	}
 
-	if len(mmDelete.expectations) > 0 {
-		mmDelete.mock.t.Fatalf("Some expectations are already set for the ChatService.Delete method")
+	if len(mmAddReaction.expectations) > 0 {
+		mmAddReaction.mock.t.Fatalf("Some expectations are already set for the ChatService.AddReaction method")
 	}
 
-	mmDelete.mock.funcDelete = f
-	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
-	return mmDelete.mock
+	mmAddReaction.mock.funcAddReaction = f
+	mmAddReaction.mock.funcAddReactionOrigin = minimock.CallerInfo(1)
+	return mmAddReaction.mock
 }
 
-// When sets expectation for the ChatService.Delete which will trigger the result defined by the following
+// When sets expectation for the ChatService.AddReaction which will trigger the result defined by the following
 // Then helper
-func (mmDelete *mChatServiceMockDelete) When(ctx context.Context, id int64) *ChatServiceMockDeleteExpectation {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+func (mmAddReaction *mChatServiceMockAddReaction) When(ctx context.Context, reaction *model.Reaction) *ChatServiceMockAddReactionExpectation {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockDeleteExpectation{
-		mock:               mmDelete.mock,
-		params:             &ChatServiceMockDeleteParams{ctx, id},
-		expectationOrigins: ChatServiceMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatServiceMockAddReactionExpectation{
+		mock:               mmAddReaction.mock,
+		params:             &ChatServiceMockAddReactionParams{ctx, reaction},
+		expectationOrigins: ChatServiceMockAddReactionExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	mmAddReaction.expectations = append(mmAddReaction.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.Delete return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockDeleteExpectation) Then(err error) *ChatServiceMock {
-	e.results = &ChatServiceMockDeleteResults{err}
+// Then sets up ChatService.AddReaction return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockAddReactionExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockAddReactionResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.Delete should be invoked
-func (mmDelete *mChatServiceMockDelete) Times(n uint64) *mChatServiceMockDelete {
+// Times sets number of times ChatService.AddReaction should be invoked
+func (mmAddReaction *mChatServiceMockAddReaction) Times(n uint64) *mChatServiceMockAddReaction {
 	if n == 0 {
-		mmDelete.mock.t.Fatalf("Times of ChatServiceMock.Delete mock can not be zero")
+		mmAddReaction.mock.t.Fatalf("Times of ChatServiceMock.AddReaction mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
-	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDelete
+	mm_atomic.StoreUint64(&mmAddReaction.expectedInvocations, n)
+	mmAddReaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddReaction
 }
 
-func (mmDelete *mChatServiceMockDelete) invocationsDone() bool {
-	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+func (mmAddReaction *mChatServiceMockAddReaction) invocationsDone() bool {
+	if len(mmAddReaction.expectations) == 0 && mmAddReaction.defaultExpectation == nil && mmAddReaction.mock.funcAddReaction == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddReaction.mock.afterAddReactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddReaction.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Delete implements mm_service.ChatService
-func (mmDelete *ChatServiceMock) Delete(ctx context.Context, id int64) (err error) {
-	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
-	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+// AddReaction implements mm_service.ChatService
+func (mmAddReaction *ChatServiceMock) AddReaction(ctx context.Context, reaction *model.Reaction) (err error) {
+	mm_atomic.AddUint64(&mmAddReaction.beforeAddReactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddReaction.afterAddReactionCounter, 1)
 
-	mmDelete.t.Helper()
+	mmAddReaction.t.Helper()
 
-	if mmDelete.inspectFuncDelete != nil {
-		mmDelete.inspectFuncDelete(ctx, id)
+	if mmAddReaction.inspectFuncAddReaction != nil {
+		mmAddReaction.inspectFuncAddReaction(ctx, reaction)
 	}
 
-	mm_params := ChatServiceMockDeleteParams{ctx, id}
+	mm_params := ChatServiceMockAddReactionParams{ctx, reaction}
 
 	// Record call args
-	mmDelete.DeleteMock.mutex.Lock()
-	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
-	mmDelete.DeleteMock.mutex.Unlock()
+	mmAddReaction.AddReactionMock.mutex.Lock()
+	mmAddReaction.AddReactionMock.callArgs = append(mmAddReaction.AddReactionMock.callArgs, &mm_params)
+	mmAddReaction.AddReactionMock.mutex.Unlock()
 
-	for _, e := range mmDelete.DeleteMock.expectations {
+	for _, e := range mmAddReaction.AddReactionMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmDelete.DeleteMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
-		mm_want := mmDelete.DeleteMock.defaultExpectation.params
-		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+	if mmAddReaction.AddReactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddReaction.AddReactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddReaction.AddReactionMock.defaultExpectation.params
+		mm_want_ptrs := mmAddReaction.AddReactionMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockDeleteParams{ctx, id}
+		mm_got := ChatServiceMockAddReactionParams{ctx, reaction}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDelete.t.Errorf("ChatServiceMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDelete.t.Errorf("ChatServiceMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.reaction != nil && !minimock.Equal(*mm_want_ptrs.reaction, mm_got.reaction) {
+				mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameter reaction, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originReaction, *mm_want_ptrs.reaction, mm_got.reaction, minimock.Diff(*mm_want_ptrs.reaction, mm_got.reaction))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDelete.t.Errorf("ChatServiceMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		mm_results := mmAddReaction.AddReactionMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDelete.t.Fatal("No results are set for the ChatServiceMock.Delete")
+			mmAddReaction.t.Fatal("No results are set for the ChatServiceMock.AddReaction")
 		}
 		return (*mm_results).err
 	}
-	if mmDelete.funcDelete != nil {
-		return mmDelete.funcDelete(ctx, id)
+	if mmAddReaction.funcAddReaction != nil {
+		return mmAddReaction.funcAddReaction(ctx, reaction)
 	}
-	mmDelete.t.Fatalf("Unexpected call to ChatServiceMock.Delete. %v %v", ctx, id)
+	mmAddReaction.t.Fatalf("Unexpected call to ChatServiceMock.AddReaction. %v %v", ctx, reaction)
 	return
 }
 
-// DeleteAfterCounter returns a count of finished ChatServiceMock.Delete invocations
-func (mmDelete *ChatServiceMock) DeleteAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+// AddReactionAfterCounter returns a count of finished ChatServiceMock.AddReaction invocations
+func (mmAddReaction *ChatServiceMock) AddReactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReaction.afterAddReactionCounter)
 }
 
-// DeleteBeforeCounter returns a count of ChatServiceMock.Delete invocations
-func (mmDelete *ChatServiceMock) DeleteBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+// AddReactionBeforeCounter returns a count of ChatServiceMock.AddReaction invocations
+func (mmAddReaction *ChatServiceMock) AddReactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReaction.beforeAddReactionCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.Delete.
+// Calls returns a list of arguments used in each call to ChatServiceMock.AddReaction.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDelete *mChatServiceMockDelete) Calls() []*ChatServiceMockDeleteParams {
-	mmDelete.mutex.RLock()
+func (mmAddReaction *mChatServiceMockAddReaction) Calls() []*ChatServiceMockAddReactionParams {
+	mmAddReaction.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockDeleteParams, len(mmDelete.callArgs))
-	copy(argCopy, mmDelete.callArgs)
+	argCopy := make([]*ChatServiceMockAddReactionParams, len(mmAddReaction.callArgs))
+	copy(argCopy, mmAddReaction.callArgs)
 
-	mmDelete.mutex.RUnlock()
+	mmAddReaction.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// MinimockAddReactionDone returns true if the count of the AddReaction invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockDeleteDone() bool {
-	if m.DeleteMock.optional {
+func (m *ChatServiceMock) MinimockAddReactionDone() bool {
+	if m.AddReactionMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteMock.expectations {
+	for _, e := range m.AddReactionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteMock.invocationsDone()
+	return m.AddReactionMock.invocationsDone()
 }
 
-// MinimockDeleteInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockDeleteInspect() {
-	for _, e := range m.DeleteMock.expectations {
+// MinimockAddReactionInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockAddReactionInspect() {
+	for _, e := range m.AddReactionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.AddReaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	afterAddReactionCounter := mm_atomic.LoadUint64(&m.afterAddReactionCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
-		if m.DeleteMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatServiceMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+	if m.AddReactionMock.defaultExpectation != nil && afterAddReactionCounter < 1 {
+		if m.AddReactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.AddReaction at\n%s", m.AddReactionMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.AddReaction at\n%s with params: %#v", m.AddReactionMock.defaultExpectation.expectationOrigins.origin, *m.AddReactionMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDelete != nil && afterDeleteCounter < 1 {
-		m.t.Errorf("Expected call to ChatServiceMock.Delete at\n%s", m.funcDeleteOrigin)
+	if m.funcAddReaction != nil && afterAddReactionCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.AddReaction at\n%s", m.funcAddReactionOrigin)
 	}
 
-	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.Delete at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	if !m.AddReactionMock.invocationsDone() && afterAddReactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.AddReaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddReactionMock.expectedInvocations), m.AddReactionMock.expectedInvocationsOrigin, afterAddReactionCounter)
 	}
 }
 
-type mChatServiceMockGet struct {
+type mChatServiceMockArchive struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockGetExpectation
-	expectations       []*ChatServiceMockGetExpectation
+	defaultExpectation *ChatServiceMockArchiveExpectation
+	expectations       []*ChatServiceMockArchiveExpectation
 
-	callArgs []*ChatServiceMockGetParams
+	callArgs []*ChatServiceMockArchiveParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatServiceMockGetExpectation specifies expectation struct of the ChatService.Get
-type ChatServiceMockGetExpectation struct {
+// ChatServiceMockArchiveExpectation specifies expectation struct of the ChatService.Archive
+type ChatServiceMockArchiveExpectation struct {
 	mock               *ChatServiceMock
-	params             *ChatServiceMockGetParams
-	paramPtrs          *ChatServiceMockGetParamPtrs
-	expectationOrigins ChatServiceMockGetExpectationOrigins
-	results            *ChatServiceMockGetResults
+	params             *ChatServiceMockArchiveParams
+	paramPtrs          *ChatServiceMockArchiveParamPtrs
+	expectationOrigins ChatServiceMockArchiveExpectationOrigins
+	results            *ChatServiceMockArchiveResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatServiceMockGetParams contains parameters of the ChatService.Get
-type ChatServiceMockGetParams struct {
+// ChatServiceMockArchiveParams contains parameters of the ChatService.Archive
+type ChatServiceMockArchiveParams struct {
 	ctx context.Context
 	id  int64
 }
 
-// ChatServiceMockGetParamPtrs contains pointers to parameters of the ChatService.Get
-type ChatServiceMockGetParamPtrs struct {
+// ChatServiceMockArchiveParamPtrs contains pointers to parameters of the ChatService.Archive
+type ChatServiceMockArchiveParamPtrs struct {
 	ctx *context.Context
 	id  *int64
 }
 
-// ChatServiceMockGetResults contains results of the ChatService.Get
-type ChatServiceMockGetResults struct {
-	cp1 *model.Chat
+// ChatServiceMockArchiveResults contains results of the ChatService.Archive
+type ChatServiceMockArchiveResults struct {
 	err error
 }
 
-// ChatServiceMockGetOrigins contains origins of expectations of the ChatService.Get
-type ChatServiceMockGetExpectationOrigins struct {
+// ChatServiceMockArchiveOrigins contains origins of expectations of the ChatService.Archive
+type ChatServiceMockArchiveExpectationOrigins struct {
 	origin    string
 	originCtx string
 	originId  string
@@ -812,341 +1101,10464 @@ type ChatServiceMockGetExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGet *mChatServiceMockGet) Optional() *mChatServiceMockGet {
-	mmGet.optional = true
-	return mmGet
+func (mmArchive *mChatServiceMockArchive) Optional() *mChatServiceMockArchive {
+	mmArchive.optional = true
+	return mmArchive
 }
 
-// Expect sets up expected params for ChatService.Get
-func (mmGet *mChatServiceMockGet) Expect(ctx context.Context, id int64) *mChatServiceMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("ChatServiceMock.Get mock is already set by Set")
+// Expect sets up expected params for ChatService.Archive
+func (mmArchive *mChatServiceMockArchive) Expect(ctx context.Context, id int64) *mChatServiceMockArchive {
+	if mmArchive.mock.funcArchive != nil {
+		mmArchive.mock.t.Fatalf("ChatServiceMock.Archive mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &ChatServiceMockGetExpectation{}
+	if mmArchive.defaultExpectation == nil {
+		mmArchive.defaultExpectation = &ChatServiceMockArchiveExpectation{}
 	}
 
-	if mmGet.defaultExpectation.paramPtrs != nil {
-		mmGet.mock.t.Fatalf("ChatServiceMock.Get mock is already set by ExpectParams functions")
+	if mmArchive.defaultExpectation.paramPtrs != nil {
+		mmArchive.mock.t.Fatalf("ChatServiceMock.Archive mock is already set by ExpectParams functions")
 	}
 
-	mmGet.defaultExpectation.params = &ChatServiceMockGetParams{ctx, id}
-	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGet.expectations {
-		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
-			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+	mmArchive.defaultExpectation.params = &ChatServiceMockArchiveParams{ctx, id}
+	mmArchive.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmArchive.expectations {
+		if minimock.Equal(e.params, mmArchive.defaultExpectation.params) {
+			mmArchive.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmArchive.defaultExpectation.params)
 		}
 	}
 
-	return mmGet
+	return mmArchive
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.Get
-func (mmGet *mChatServiceMockGet) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("ChatServiceMock.Get mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.Archive
+func (mmArchive *mChatServiceMockArchive) ExpectCtxParam1(ctx context.Context) *mChatServiceMockArchive {
+	if mmArchive.mock.funcArchive != nil {
+		mmArchive.mock.t.Fatalf("ChatServiceMock.Archive mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &ChatServiceMockGetExpectation{}
+	if mmArchive.defaultExpectation == nil {
+		mmArchive.defaultExpectation = &ChatServiceMockArchiveExpectation{}
 	}
 
-	if mmGet.defaultExpectation.params != nil {
-		mmGet.mock.t.Fatalf("ChatServiceMock.Get mock is already set by Expect")
+	if mmArchive.defaultExpectation.params != nil {
+		mmArchive.mock.t.Fatalf("ChatServiceMock.Archive mock is already set by Expect")
 	}
 
-	if mmGet.defaultExpectation.paramPtrs == nil {
-		mmGet.defaultExpectation.paramPtrs = &ChatServiceMockGetParamPtrs{}
+	if mmArchive.defaultExpectation.paramPtrs == nil {
+		mmArchive.defaultExpectation.paramPtrs = &ChatServiceMockArchiveParamPtrs{}
 	}
-	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmArchive.defaultExpectation.paramPtrs.ctx = &ctx
+	mmArchive.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGet
+	return mmArchive
 }
 
-// ExpectIdParam2 sets up expected param id for ChatService.Get
-func (mmGet *mChatServiceMockGet) ExpectIdParam2(id int64) *mChatServiceMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("ChatServiceMock.Get mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for ChatService.Archive
+func (mmArchive *mChatServiceMockArchive) ExpectIdParam2(id int64) *mChatServiceMockArchive {
+	if mmArchive.mock.funcArchive != nil {
+		mmArchive.mock.t.Fatalf("ChatServiceMock.Archive mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &ChatServiceMockGetExpectation{}
+	if mmArchive.defaultExpectation == nil {
+		mmArchive.defaultExpectation = &ChatServiceMockArchiveExpectation{}
 	}
 
-	if mmGet.defaultExpectation.params != nil {
-		mmGet.mock.t.Fatalf("ChatServiceMock.Get mock is already set by Expect")
+	if mmArchive.defaultExpectation.params != nil {
+		mmArchive.mock.t.Fatalf("ChatServiceMock.Archive mock is already set by Expect")
 	}
 
-	if mmGet.defaultExpectation.paramPtrs == nil {
-		mmGet.defaultExpectation.paramPtrs = &ChatServiceMockGetParamPtrs{}
+	if mmArchive.defaultExpectation.paramPtrs == nil {
+		mmArchive.defaultExpectation.paramPtrs = &ChatServiceMockArchiveParamPtrs{}
 	}
-	mmGet.defaultExpectation.paramPtrs.id = &id
-	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmArchive.defaultExpectation.paramPtrs.id = &id
+	mmArchive.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmGet
+	return mmArchive
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.Get
-func (mmGet *mChatServiceMockGet) Inspect(f func(ctx context.Context, id int64)) *mChatServiceMockGet {
-	if mmGet.mock.inspectFuncGet != nil {
-		mmGet.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Get")
+// Inspect accepts an inspector function that has same arguments as the ChatService.Archive
+func (mmArchive *mChatServiceMockArchive) Inspect(f func(ctx context.Context, id int64)) *mChatServiceMockArchive {
+	if mmArchive.mock.inspectFuncArchive != nil {
+		mmArchive.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Archive")
 	}
 
-	mmGet.mock.inspectFuncGet = f
+	mmArchive.mock.inspectFuncArchive = f
 
-	return mmGet
+	return mmArchive
 }
 
-// Return sets up results that will be returned by ChatService.Get
-func (mmGet *mChatServiceMockGet) Return(cp1 *model.Chat, err error) *ChatServiceMock {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("ChatServiceMock.Get mock is already set by Set")
+// Return sets up results that will be returned by ChatService.Archive
+func (mmArchive *mChatServiceMockArchive) Return(err error) *ChatServiceMock {
+	if mmArchive.mock.funcArchive != nil {
+		mmArchive.mock.t.Fatalf("ChatServiceMock.Archive mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &ChatServiceMockGetExpectation{mock: mmGet.mock}
+	if mmArchive.defaultExpectation == nil {
+		mmArchive.defaultExpectation = &ChatServiceMockArchiveExpectation{mock: mmArchive.mock}
 	}
-	mmGet.defaultExpectation.results = &ChatServiceMockGetResults{cp1, err}
-	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGet.mock
+	mmArchive.defaultExpectation.results = &ChatServiceMockArchiveResults{err}
+	mmArchive.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmArchive.mock
 }
 
-// Set uses given function f to mock the ChatService.Get method
-func (mmGet *mChatServiceMockGet) Set(f func(ctx context.Context, id int64) (cp1 *model.Chat, err error)) *ChatServiceMock {
-	if mmGet.defaultExpectation != nil {
-		mmGet.mock.t.Fatalf("Default expectation is already set for the ChatService.Get method")
+// Set uses given function f to mock the ChatService.Archive method
+func (mmArchive *mChatServiceMockArchive) Set(f func(ctx context.Context, id int64) (err error)) *ChatServiceMock {
+	if mmArchive.defaultExpectation != nil {
+		mmArchive.mock.t.Fatalf("Default expectation is already set for the ChatService.Archive method")
 	}
 
-	if len(mmGet.expectations) > 0 {
-		mmGet.mock.t.Fatalf("Some expectations are already set for the ChatService.Get method")
+	if len(mmArchive.expectations) > 0 {
+		mmArchive.mock.t.Fatalf("Some expectations are already set for the ChatService.Archive method")
 	}
 
-	mmGet.mock.funcGet = f
-	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
-	return mmGet.mock
+	mmArchive.mock.funcArchive = f
+	mmArchive.mock.funcArchiveOrigin = minimock.CallerInfo(1)
+	return mmArchive.mock
 }
 
-// When sets expectation for the ChatService.Get which will trigger the result defined by the following
+// When sets expectation for the ChatService.Archive which will trigger the result defined by the following
 // Then helper
-func (mmGet *mChatServiceMockGet) When(ctx context.Context, id int64) *ChatServiceMockGetExpectation {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("ChatServiceMock.Get mock is already set by Set")
+func (mmArchive *mChatServiceMockArchive) When(ctx context.Context, id int64) *ChatServiceMockArchiveExpectation {
+	if mmArchive.mock.funcArchive != nil {
+		mmArchive.mock.t.Fatalf("ChatServiceMock.Archive mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockGetExpectation{
-		mock:               mmGet.mock,
-		params:             &ChatServiceMockGetParams{ctx, id},
-		expectationOrigins: ChatServiceMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatServiceMockArchiveExpectation{
+		mock:               mmArchive.mock,
+		params:             &ChatServiceMockArchiveParams{ctx, id},
+		expectationOrigins: ChatServiceMockArchiveExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGet.expectations = append(mmGet.expectations, expectation)
+	mmArchive.expectations = append(mmArchive.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.Get return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockGetExpectation) Then(cp1 *model.Chat, err error) *ChatServiceMock {
-	e.results = &ChatServiceMockGetResults{cp1, err}
+// Then sets up ChatService.Archive return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockArchiveExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockArchiveResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.Get should be invoked
-func (mmGet *mChatServiceMockGet) Times(n uint64) *mChatServiceMockGet {
+// Times sets number of times ChatService.Archive should be invoked
+func (mmArchive *mChatServiceMockArchive) Times(n uint64) *mChatServiceMockArchive {
 	if n == 0 {
-		mmGet.mock.t.Fatalf("Times of ChatServiceMock.Get mock can not be zero")
+		mmArchive.mock.t.Fatalf("Times of ChatServiceMock.Archive mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
-	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGet
+	mm_atomic.StoreUint64(&mmArchive.expectedInvocations, n)
+	mmArchive.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmArchive
 }
 
-func (mmGet *mChatServiceMockGet) invocationsDone() bool {
-	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+func (mmArchive *mChatServiceMockArchive) invocationsDone() bool {
+	if len(mmArchive.expectations) == 0 && mmArchive.defaultExpectation == nil && mmArchive.mock.funcArchive == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmArchive.mock.afterArchiveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmArchive.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Get implements mm_service.ChatService
-func (mmGet *ChatServiceMock) Get(ctx context.Context, id int64) (cp1 *model.Chat, err error) {
-	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
-	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+// Archive implements mm_service.ChatService
+func (mmArchive *ChatServiceMock) Archive(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmArchive.beforeArchiveCounter, 1)
+	defer mm_atomic.AddUint64(&mmArchive.afterArchiveCounter, 1)
 
-	mmGet.t.Helper()
+	mmArchive.t.Helper()
 
-	if mmGet.inspectFuncGet != nil {
-		mmGet.inspectFuncGet(ctx, id)
+	if mmArchive.inspectFuncArchive != nil {
+		mmArchive.inspectFuncArchive(ctx, id)
 	}
 
-	mm_params := ChatServiceMockGetParams{ctx, id}
+	mm_params := ChatServiceMockArchiveParams{ctx, id}
 
 	// Record call args
-	mmGet.GetMock.mutex.Lock()
-	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
-	mmGet.GetMock.mutex.Unlock()
+	mmArchive.ArchiveMock.mutex.Lock()
+	mmArchive.ArchiveMock.callArgs = append(mmArchive.ArchiveMock.callArgs, &mm_params)
+	mmArchive.ArchiveMock.mutex.Unlock()
 
-	for _, e := range mmGet.GetMock.expectations {
+	for _, e := range mmArchive.ArchiveMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.cp1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGet.GetMock.defaultExpectation != nil {
+	if mmArchive.ArchiveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmArchive.ArchiveMock.defaultExpectation.Counter, 1)
+		mm_want := mmArchive.ArchiveMock.defaultExpectation.params
+		mm_want_ptrs := mmArchive.ArchiveMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockArchiveParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmArchive.t.Errorf("ChatServiceMock.Archive got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmArchive.ArchiveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmArchive.t.Errorf("ChatServiceMock.Archive got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmArchive.ArchiveMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmArchive.t.Errorf("ChatServiceMock.Archive got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmArchive.ArchiveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmArchive.ArchiveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmArchive.t.Fatal("No results are set for the ChatServiceMock.Archive")
+		}
+		return (*mm_results).err
+	}
+	if mmArchive.funcArchive != nil {
+		return mmArchive.funcArchive(ctx, id)
+	}
+	mmArchive.t.Fatalf("Unexpected call to ChatServiceMock.Archive. %v %v", ctx, id)
+	return
+}
+
+// ArchiveAfterCounter returns a count of finished ChatServiceMock.Archive invocations
+func (mmArchive *ChatServiceMock) ArchiveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmArchive.afterArchiveCounter)
+}
+
+// ArchiveBeforeCounter returns a count of ChatServiceMock.Archive invocations
+func (mmArchive *ChatServiceMock) ArchiveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmArchive.beforeArchiveCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.Archive.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmArchive *mChatServiceMockArchive) Calls() []*ChatServiceMockArchiveParams {
+	mmArchive.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockArchiveParams, len(mmArchive.callArgs))
+	copy(argCopy, mmArchive.callArgs)
+
+	mmArchive.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockArchiveDone returns true if the count of the Archive invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockArchiveDone() bool {
+	if m.ArchiveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ArchiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ArchiveMock.invocationsDone()
+}
+
+// MinimockArchiveInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockArchiveInspect() {
+	for _, e := range m.ArchiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.Archive at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterArchiveCounter := mm_atomic.LoadUint64(&m.afterArchiveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ArchiveMock.defaultExpectation != nil && afterArchiveCounter < 1 {
+		if m.ArchiveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.Archive at\n%s", m.ArchiveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.Archive at\n%s with params: %#v", m.ArchiveMock.defaultExpectation.expectationOrigins.origin, *m.ArchiveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcArchive != nil && afterArchiveCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.Archive at\n%s", m.funcArchiveOrigin)
+	}
+
+	if !m.ArchiveMock.invocationsDone() && afterArchiveCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.Archive at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ArchiveMock.expectedInvocations), m.ArchiveMock.expectedInvocationsOrigin, afterArchiveCounter)
+	}
+}
+
+type mChatServiceMockCancelScheduled struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockCancelScheduledExpectation
+	expectations       []*ChatServiceMockCancelScheduledExpectation
+
+	callArgs []*ChatServiceMockCancelScheduledParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockCancelScheduledExpectation specifies expectation struct of the ChatService.CancelScheduled
+type ChatServiceMockCancelScheduledExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockCancelScheduledParams
+	paramPtrs          *ChatServiceMockCancelScheduledParamPtrs
+	expectationOrigins ChatServiceMockCancelScheduledExpectationOrigins
+	results            *ChatServiceMockCancelScheduledResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockCancelScheduledParams contains parameters of the ChatService.CancelScheduled
+type ChatServiceMockCancelScheduledParams struct {
+	ctx    context.Context
+	cancel *model.CancelScheduled
+}
+
+// ChatServiceMockCancelScheduledParamPtrs contains pointers to parameters of the ChatService.CancelScheduled
+type ChatServiceMockCancelScheduledParamPtrs struct {
+	ctx    *context.Context
+	cancel **model.CancelScheduled
+}
+
+// ChatServiceMockCancelScheduledResults contains results of the ChatService.CancelScheduled
+type ChatServiceMockCancelScheduledResults struct {
+	err error
+}
+
+// ChatServiceMockCancelScheduledOrigins contains origins of expectations of the ChatService.CancelScheduled
+type ChatServiceMockCancelScheduledExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originCancel string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCancelScheduled *mChatServiceMockCancelScheduled) Optional() *mChatServiceMockCancelScheduled {
+	mmCancelScheduled.optional = true
+	return mmCancelScheduled
+}
+
+// Expect sets up expected params for ChatService.CancelScheduled
+func (mmCancelScheduled *mChatServiceMockCancelScheduled) Expect(ctx context.Context, cancel *model.CancelScheduled) *mChatServiceMockCancelScheduled {
+	if mmCancelScheduled.mock.funcCancelScheduled != nil {
+		mmCancelScheduled.mock.t.Fatalf("ChatServiceMock.CancelScheduled mock is already set by Set")
+	}
+
+	if mmCancelScheduled.defaultExpectation == nil {
+		mmCancelScheduled.defaultExpectation = &ChatServiceMockCancelScheduledExpectation{}
+	}
+
+	if mmCancelScheduled.defaultExpectation.paramPtrs != nil {
+		mmCancelScheduled.mock.t.Fatalf("ChatServiceMock.CancelScheduled mock is already set by ExpectParams functions")
+	}
+
+	mmCancelScheduled.defaultExpectation.params = &ChatServiceMockCancelScheduledParams{ctx, cancel}
+	mmCancelScheduled.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCancelScheduled.expectations {
+		if minimock.Equal(e.params, mmCancelScheduled.defaultExpectation.params) {
+			mmCancelScheduled.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCancelScheduled.defaultExpectation.params)
+		}
+	}
+
+	return mmCancelScheduled
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.CancelScheduled
+func (mmCancelScheduled *mChatServiceMockCancelScheduled) ExpectCtxParam1(ctx context.Context) *mChatServiceMockCancelScheduled {
+	if mmCancelScheduled.mock.funcCancelScheduled != nil {
+		mmCancelScheduled.mock.t.Fatalf("ChatServiceMock.CancelScheduled mock is already set by Set")
+	}
+
+	if mmCancelScheduled.defaultExpectation == nil {
+		mmCancelScheduled.defaultExpectation = &ChatServiceMockCancelScheduledExpectation{}
+	}
+
+	if mmCancelScheduled.defaultExpectation.params != nil {
+		mmCancelScheduled.mock.t.Fatalf("ChatServiceMock.CancelScheduled mock is already set by Expect")
+	}
+
+	if mmCancelScheduled.defaultExpectation.paramPtrs == nil {
+		mmCancelScheduled.defaultExpectation.paramPtrs = &ChatServiceMockCancelScheduledParamPtrs{}
+	}
+	mmCancelScheduled.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCancelScheduled.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCancelScheduled
+}
+
+// ExpectCancelParam2 sets up expected param cancel for ChatService.CancelScheduled
+func (mmCancelScheduled *mChatServiceMockCancelScheduled) ExpectCancelParam2(cancel *model.CancelScheduled) *mChatServiceMockCancelScheduled {
+	if mmCancelScheduled.mock.funcCancelScheduled != nil {
+		mmCancelScheduled.mock.t.Fatalf("ChatServiceMock.CancelScheduled mock is already set by Set")
+	}
+
+	if mmCancelScheduled.defaultExpectation == nil {
+		mmCancelScheduled.defaultExpectation = &ChatServiceMockCancelScheduledExpectation{}
+	}
+
+	if mmCancelScheduled.defaultExpectation.params != nil {
+		mmCancelScheduled.mock.t.Fatalf("ChatServiceMock.CancelScheduled mock is already set by Expect")
+	}
+
+	if mmCancelScheduled.defaultExpectation.paramPtrs == nil {
+		mmCancelScheduled.defaultExpectation.paramPtrs = &ChatServiceMockCancelScheduledParamPtrs{}
+	}
+	mmCancelScheduled.defaultExpectation.paramPtrs.cancel = &cancel
+	mmCancelScheduled.defaultExpectation.expectationOrigins.originCancel = minimock.CallerInfo(1)
+
+	return mmCancelScheduled
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.CancelScheduled
+func (mmCancelScheduled *mChatServiceMockCancelScheduled) Inspect(f func(ctx context.Context, cancel *model.CancelScheduled)) *mChatServiceMockCancelScheduled {
+	if mmCancelScheduled.mock.inspectFuncCancelScheduled != nil {
+		mmCancelScheduled.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.CancelScheduled")
+	}
+
+	mmCancelScheduled.mock.inspectFuncCancelScheduled = f
+
+	return mmCancelScheduled
+}
+
+// Return sets up results that will be returned by ChatService.CancelScheduled
+func (mmCancelScheduled *mChatServiceMockCancelScheduled) Return(err error) *ChatServiceMock {
+	if mmCancelScheduled.mock.funcCancelScheduled != nil {
+		mmCancelScheduled.mock.t.Fatalf("ChatServiceMock.CancelScheduled mock is already set by Set")
+	}
+
+	if mmCancelScheduled.defaultExpectation == nil {
+		mmCancelScheduled.defaultExpectation = &ChatServiceMockCancelScheduledExpectation{mock: mmCancelScheduled.mock}
+	}
+	mmCancelScheduled.defaultExpectation.results = &ChatServiceMockCancelScheduledResults{err}
+	mmCancelScheduled.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCancelScheduled.mock
+}
+
+// Set uses given function f to mock the ChatService.CancelScheduled method
+func (mmCancelScheduled *mChatServiceMockCancelScheduled) Set(f func(ctx context.Context, cancel *model.CancelScheduled) (err error)) *ChatServiceMock {
+	if mmCancelScheduled.defaultExpectation != nil {
+		mmCancelScheduled.mock.t.Fatalf("Default expectation is already set for the ChatService.CancelScheduled method")
+	}
+
+	if len(mmCancelScheduled.expectations) > 0 {
+		mmCancelScheduled.mock.t.Fatalf("Some expectations are already set for the ChatService.CancelScheduled method")
+	}
+
+	mmCancelScheduled.mock.funcCancelScheduled = f
+	mmCancelScheduled.mock.funcCancelScheduledOrigin = minimock.CallerInfo(1)
+	return mmCancelScheduled.mock
+}
+
+// When sets expectation for the ChatService.CancelScheduled which will trigger the result defined by the following
+// Then helper
+func (mmCancelScheduled *mChatServiceMockCancelScheduled) When(ctx context.Context, cancel *model.CancelScheduled) *ChatServiceMockCancelScheduledExpectation {
+	if mmCancelScheduled.mock.funcCancelScheduled != nil {
+		mmCancelScheduled.mock.t.Fatalf("ChatServiceMock.CancelScheduled mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockCancelScheduledExpectation{
+		mock:               mmCancelScheduled.mock,
+		params:             &ChatServiceMockCancelScheduledParams{ctx, cancel},
+		expectationOrigins: ChatServiceMockCancelScheduledExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCancelScheduled.expectations = append(mmCancelScheduled.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.CancelScheduled return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockCancelScheduledExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockCancelScheduledResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.CancelScheduled should be invoked
+func (mmCancelScheduled *mChatServiceMockCancelScheduled) Times(n uint64) *mChatServiceMockCancelScheduled {
+	if n == 0 {
+		mmCancelScheduled.mock.t.Fatalf("Times of ChatServiceMock.CancelScheduled mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCancelScheduled.expectedInvocations, n)
+	mmCancelScheduled.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCancelScheduled
+}
+
+func (mmCancelScheduled *mChatServiceMockCancelScheduled) invocationsDone() bool {
+	if len(mmCancelScheduled.expectations) == 0 && mmCancelScheduled.defaultExpectation == nil && mmCancelScheduled.mock.funcCancelScheduled == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCancelScheduled.mock.afterCancelScheduledCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCancelScheduled.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CancelScheduled implements mm_service.ChatService
+func (mmCancelScheduled *ChatServiceMock) CancelScheduled(ctx context.Context, cancel *model.CancelScheduled) (err error) {
+	mm_atomic.AddUint64(&mmCancelScheduled.beforeCancelScheduledCounter, 1)
+	defer mm_atomic.AddUint64(&mmCancelScheduled.afterCancelScheduledCounter, 1)
+
+	mmCancelScheduled.t.Helper()
+
+	if mmCancelScheduled.inspectFuncCancelScheduled != nil {
+		mmCancelScheduled.inspectFuncCancelScheduled(ctx, cancel)
+	}
+
+	mm_params := ChatServiceMockCancelScheduledParams{ctx, cancel}
+
+	// Record call args
+	mmCancelScheduled.CancelScheduledMock.mutex.Lock()
+	mmCancelScheduled.CancelScheduledMock.callArgs = append(mmCancelScheduled.CancelScheduledMock.callArgs, &mm_params)
+	mmCancelScheduled.CancelScheduledMock.mutex.Unlock()
+
+	for _, e := range mmCancelScheduled.CancelScheduledMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCancelScheduled.CancelScheduledMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCancelScheduled.CancelScheduledMock.defaultExpectation.Counter, 1)
+		mm_want := mmCancelScheduled.CancelScheduledMock.defaultExpectation.params
+		mm_want_ptrs := mmCancelScheduled.CancelScheduledMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockCancelScheduledParams{ctx, cancel}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCancelScheduled.t.Errorf("ChatServiceMock.CancelScheduled got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancelScheduled.CancelScheduledMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cancel != nil && !minimock.Equal(*mm_want_ptrs.cancel, mm_got.cancel) {
+				mmCancelScheduled.t.Errorf("ChatServiceMock.CancelScheduled got unexpected parameter cancel, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancelScheduled.CancelScheduledMock.defaultExpectation.expectationOrigins.originCancel, *mm_want_ptrs.cancel, mm_got.cancel, minimock.Diff(*mm_want_ptrs.cancel, mm_got.cancel))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCancelScheduled.t.Errorf("ChatServiceMock.CancelScheduled got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCancelScheduled.CancelScheduledMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCancelScheduled.CancelScheduledMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCancelScheduled.t.Fatal("No results are set for the ChatServiceMock.CancelScheduled")
+		}
+		return (*mm_results).err
+	}
+	if mmCancelScheduled.funcCancelScheduled != nil {
+		return mmCancelScheduled.funcCancelScheduled(ctx, cancel)
+	}
+	mmCancelScheduled.t.Fatalf("Unexpected call to ChatServiceMock.CancelScheduled. %v %v", ctx, cancel)
+	return
+}
+
+// CancelScheduledAfterCounter returns a count of finished ChatServiceMock.CancelScheduled invocations
+func (mmCancelScheduled *ChatServiceMock) CancelScheduledAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelScheduled.afterCancelScheduledCounter)
+}
+
+// CancelScheduledBeforeCounter returns a count of ChatServiceMock.CancelScheduled invocations
+func (mmCancelScheduled *ChatServiceMock) CancelScheduledBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelScheduled.beforeCancelScheduledCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.CancelScheduled.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCancelScheduled *mChatServiceMockCancelScheduled) Calls() []*ChatServiceMockCancelScheduledParams {
+	mmCancelScheduled.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockCancelScheduledParams, len(mmCancelScheduled.callArgs))
+	copy(argCopy, mmCancelScheduled.callArgs)
+
+	mmCancelScheduled.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCancelScheduledDone returns true if the count of the CancelScheduled invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockCancelScheduledDone() bool {
+	if m.CancelScheduledMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CancelScheduledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CancelScheduledMock.invocationsDone()
+}
+
+// MinimockCancelScheduledInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockCancelScheduledInspect() {
+	for _, e := range m.CancelScheduledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.CancelScheduled at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCancelScheduledCounter := mm_atomic.LoadUint64(&m.afterCancelScheduledCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CancelScheduledMock.defaultExpectation != nil && afterCancelScheduledCounter < 1 {
+		if m.CancelScheduledMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.CancelScheduled at\n%s", m.CancelScheduledMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.CancelScheduled at\n%s with params: %#v", m.CancelScheduledMock.defaultExpectation.expectationOrigins.origin, *m.CancelScheduledMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCancelScheduled != nil && afterCancelScheduledCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.CancelScheduled at\n%s", m.funcCancelScheduledOrigin)
+	}
+
+	if !m.CancelScheduledMock.invocationsDone() && afterCancelScheduledCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.CancelScheduled at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CancelScheduledMock.expectedInvocations), m.CancelScheduledMock.expectedInvocationsOrigin, afterCancelScheduledCounter)
+	}
+}
+
+type mChatServiceMockCloseSession struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockCloseSessionExpectation
+	expectations       []*ChatServiceMockCloseSessionExpectation
+
+	callArgs []*ChatServiceMockCloseSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockCloseSessionExpectation specifies expectation struct of the ChatService.CloseSession
+type ChatServiceMockCloseSessionExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockCloseSessionParams
+	paramPtrs          *ChatServiceMockCloseSessionParamPtrs
+	expectationOrigins ChatServiceMockCloseSessionExpectationOrigins
+	results            *ChatServiceMockCloseSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockCloseSessionParams contains parameters of the ChatService.CloseSession
+type ChatServiceMockCloseSessionParams struct {
+	ctx context.Context
+	id  string
+}
+
+// ChatServiceMockCloseSessionParamPtrs contains pointers to parameters of the ChatService.CloseSession
+type ChatServiceMockCloseSessionParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// ChatServiceMockCloseSessionResults contains results of the ChatService.CloseSession
+type ChatServiceMockCloseSessionResults struct {
+	err error
+}
+
+// ChatServiceMockCloseSessionOrigins contains origins of expectations of the ChatService.CloseSession
+type ChatServiceMockCloseSessionExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCloseSession *mChatServiceMockCloseSession) Optional() *mChatServiceMockCloseSession {
+	mmCloseSession.optional = true
+	return mmCloseSession
+}
+
+// Expect sets up expected params for ChatService.CloseSession
+func (mmCloseSession *mChatServiceMockCloseSession) Expect(ctx context.Context, id string) *mChatServiceMockCloseSession {
+	if mmCloseSession.mock.funcCloseSession != nil {
+		mmCloseSession.mock.t.Fatalf("ChatServiceMock.CloseSession mock is already set by Set")
+	}
+
+	if mmCloseSession.defaultExpectation == nil {
+		mmCloseSession.defaultExpectation = &ChatServiceMockCloseSessionExpectation{}
+	}
+
+	if mmCloseSession.defaultExpectation.paramPtrs != nil {
+		mmCloseSession.mock.t.Fatalf("ChatServiceMock.CloseSession mock is already set by ExpectParams functions")
+	}
+
+	mmCloseSession.defaultExpectation.params = &ChatServiceMockCloseSessionParams{ctx, id}
+	mmCloseSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCloseSession.expectations {
+		if minimock.Equal(e.params, mmCloseSession.defaultExpectation.params) {
+			mmCloseSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCloseSession.defaultExpectation.params)
+		}
+	}
+
+	return mmCloseSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.CloseSession
+func (mmCloseSession *mChatServiceMockCloseSession) ExpectCtxParam1(ctx context.Context) *mChatServiceMockCloseSession {
+	if mmCloseSession.mock.funcCloseSession != nil {
+		mmCloseSession.mock.t.Fatalf("ChatServiceMock.CloseSession mock is already set by Set")
+	}
+
+	if mmCloseSession.defaultExpectation == nil {
+		mmCloseSession.defaultExpectation = &ChatServiceMockCloseSessionExpectation{}
+	}
+
+	if mmCloseSession.defaultExpectation.params != nil {
+		mmCloseSession.mock.t.Fatalf("ChatServiceMock.CloseSession mock is already set by Expect")
+	}
+
+	if mmCloseSession.defaultExpectation.paramPtrs == nil {
+		mmCloseSession.defaultExpectation.paramPtrs = &ChatServiceMockCloseSessionParamPtrs{}
+	}
+	mmCloseSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCloseSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCloseSession
+}
+
+// ExpectIdParam2 sets up expected param id for ChatService.CloseSession
+func (mmCloseSession *mChatServiceMockCloseSession) ExpectIdParam2(id string) *mChatServiceMockCloseSession {
+	if mmCloseSession.mock.funcCloseSession != nil {
+		mmCloseSession.mock.t.Fatalf("ChatServiceMock.CloseSession mock is already set by Set")
+	}
+
+	if mmCloseSession.defaultExpectation == nil {
+		mmCloseSession.defaultExpectation = &ChatServiceMockCloseSessionExpectation{}
+	}
+
+	if mmCloseSession.defaultExpectation.params != nil {
+		mmCloseSession.mock.t.Fatalf("ChatServiceMock.CloseSession mock is already set by Expect")
+	}
+
+	if mmCloseSession.defaultExpectation.paramPtrs == nil {
+		mmCloseSession.defaultExpectation.paramPtrs = &ChatServiceMockCloseSessionParamPtrs{}
+	}
+	mmCloseSession.defaultExpectation.paramPtrs.id = &id
+	mmCloseSession.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmCloseSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.CloseSession
+func (mmCloseSession *mChatServiceMockCloseSession) Inspect(f func(ctx context.Context, id string)) *mChatServiceMockCloseSession {
+	if mmCloseSession.mock.inspectFuncCloseSession != nil {
+		mmCloseSession.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.CloseSession")
+	}
+
+	mmCloseSession.mock.inspectFuncCloseSession = f
+
+	return mmCloseSession
+}
+
+// Return sets up results that will be returned by ChatService.CloseSession
+func (mmCloseSession *mChatServiceMockCloseSession) Return(err error) *ChatServiceMock {
+	if mmCloseSession.mock.funcCloseSession != nil {
+		mmCloseSession.mock.t.Fatalf("ChatServiceMock.CloseSession mock is already set by Set")
+	}
+
+	if mmCloseSession.defaultExpectation == nil {
+		mmCloseSession.defaultExpectation = &ChatServiceMockCloseSessionExpectation{mock: mmCloseSession.mock}
+	}
+	mmCloseSession.defaultExpectation.results = &ChatServiceMockCloseSessionResults{err}
+	mmCloseSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCloseSession.mock
+}
+
+// Set uses given function f to mock the ChatService.CloseSession method
+func (mmCloseSession *mChatServiceMockCloseSession) Set(f func(ctx context.Context, id string) (err error)) *ChatServiceMock {
+	if mmCloseSession.defaultExpectation != nil {
+		mmCloseSession.mock.t.Fatalf("Default expectation is already set for the ChatService.CloseSession method")
+	}
+
+	if len(mmCloseSession.expectations) > 0 {
+		mmCloseSession.mock.t.Fatalf("Some expectations are already set for the ChatService.CloseSession method")
+	}
+
+	mmCloseSession.mock.funcCloseSession = f
+	mmCloseSession.mock.funcCloseSessionOrigin = minimock.CallerInfo(1)
+	return mmCloseSession.mock
+}
+
+// When sets expectation for the ChatService.CloseSession which will trigger the result defined by the following
+// Then helper
+func (mmCloseSession *mChatServiceMockCloseSession) When(ctx context.Context, id string) *ChatServiceMockCloseSessionExpectation {
+	if mmCloseSession.mock.funcCloseSession != nil {
+		mmCloseSession.mock.t.Fatalf("ChatServiceMock.CloseSession mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockCloseSessionExpectation{
+		mock:               mmCloseSession.mock,
+		params:             &ChatServiceMockCloseSessionParams{ctx, id},
+		expectationOrigins: ChatServiceMockCloseSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCloseSession.expectations = append(mmCloseSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.CloseSession return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockCloseSessionExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockCloseSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.CloseSession should be invoked
+func (mmCloseSession *mChatServiceMockCloseSession) Times(n uint64) *mChatServiceMockCloseSession {
+	if n == 0 {
+		mmCloseSession.mock.t.Fatalf("Times of ChatServiceMock.CloseSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCloseSession.expectedInvocations, n)
+	mmCloseSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCloseSession
+}
+
+func (mmCloseSession *mChatServiceMockCloseSession) invocationsDone() bool {
+	if len(mmCloseSession.expectations) == 0 && mmCloseSession.defaultExpectation == nil && mmCloseSession.mock.funcCloseSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCloseSession.mock.afterCloseSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCloseSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CloseSession implements mm_service.ChatService
+func (mmCloseSession *ChatServiceMock) CloseSession(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmCloseSession.beforeCloseSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmCloseSession.afterCloseSessionCounter, 1)
+
+	mmCloseSession.t.Helper()
+
+	if mmCloseSession.inspectFuncCloseSession != nil {
+		mmCloseSession.inspectFuncCloseSession(ctx, id)
+	}
+
+	mm_params := ChatServiceMockCloseSessionParams{ctx, id}
+
+	// Record call args
+	mmCloseSession.CloseSessionMock.mutex.Lock()
+	mmCloseSession.CloseSessionMock.callArgs = append(mmCloseSession.CloseSessionMock.callArgs, &mm_params)
+	mmCloseSession.CloseSessionMock.mutex.Unlock()
+
+	for _, e := range mmCloseSession.CloseSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCloseSession.CloseSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCloseSession.CloseSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmCloseSession.CloseSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmCloseSession.CloseSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockCloseSessionParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCloseSession.t.Errorf("ChatServiceMock.CloseSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCloseSession.CloseSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmCloseSession.t.Errorf("ChatServiceMock.CloseSession got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCloseSession.CloseSessionMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCloseSession.t.Errorf("ChatServiceMock.CloseSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCloseSession.CloseSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCloseSession.CloseSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCloseSession.t.Fatal("No results are set for the ChatServiceMock.CloseSession")
+		}
+		return (*mm_results).err
+	}
+	if mmCloseSession.funcCloseSession != nil {
+		return mmCloseSession.funcCloseSession(ctx, id)
+	}
+	mmCloseSession.t.Fatalf("Unexpected call to ChatServiceMock.CloseSession. %v %v", ctx, id)
+	return
+}
+
+// CloseSessionAfterCounter returns a count of finished ChatServiceMock.CloseSession invocations
+func (mmCloseSession *ChatServiceMock) CloseSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCloseSession.afterCloseSessionCounter)
+}
+
+// CloseSessionBeforeCounter returns a count of ChatServiceMock.CloseSession invocations
+func (mmCloseSession *ChatServiceMock) CloseSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCloseSession.beforeCloseSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.CloseSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCloseSession *mChatServiceMockCloseSession) Calls() []*ChatServiceMockCloseSessionParams {
+	mmCloseSession.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockCloseSessionParams, len(mmCloseSession.callArgs))
+	copy(argCopy, mmCloseSession.callArgs)
+
+	mmCloseSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCloseSessionDone returns true if the count of the CloseSession invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockCloseSessionDone() bool {
+	if m.CloseSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CloseSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CloseSessionMock.invocationsDone()
+}
+
+// MinimockCloseSessionInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockCloseSessionInspect() {
+	for _, e := range m.CloseSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.CloseSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCloseSessionCounter := mm_atomic.LoadUint64(&m.afterCloseSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CloseSessionMock.defaultExpectation != nil && afterCloseSessionCounter < 1 {
+		if m.CloseSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.CloseSession at\n%s", m.CloseSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.CloseSession at\n%s with params: %#v", m.CloseSessionMock.defaultExpectation.expectationOrigins.origin, *m.CloseSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCloseSession != nil && afterCloseSessionCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.CloseSession at\n%s", m.funcCloseSessionOrigin)
+	}
+
+	if !m.CloseSessionMock.invocationsDone() && afterCloseSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.CloseSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CloseSessionMock.expectedInvocations), m.CloseSessionMock.expectedInvocationsOrigin, afterCloseSessionCounter)
+	}
+}
+
+type mChatServiceMockCreate struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockCreateExpectation
+	expectations       []*ChatServiceMockCreateExpectation
+
+	callArgs []*ChatServiceMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockCreateExpectation specifies expectation struct of the ChatService.Create
+type ChatServiceMockCreateExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockCreateParams
+	paramPtrs          *ChatServiceMockCreateParamPtrs
+	expectationOrigins ChatServiceMockCreateExpectationOrigins
+	results            *ChatServiceMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockCreateParams contains parameters of the ChatService.Create
+type ChatServiceMockCreateParams struct {
+	ctx  context.Context
+	chat *model.ChatInfo
+}
+
+// ChatServiceMockCreateParamPtrs contains pointers to parameters of the ChatService.Create
+type ChatServiceMockCreateParamPtrs struct {
+	ctx  *context.Context
+	chat **model.ChatInfo
+}
+
+// ChatServiceMockCreateResults contains results of the ChatService.Create
+type ChatServiceMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// ChatServiceMockCreateOrigins contains origins of expectations of the ChatService.Create
+type ChatServiceMockCreateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originChat string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mChatServiceMockCreate) Optional() *mChatServiceMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for ChatService.Create
+func (mmCreate *mChatServiceMockCreate) Expect(ctx context.Context, chat *model.ChatInfo) *mChatServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ChatServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &ChatServiceMockCreateParams{ctx, chat}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.Create
+func (mmCreate *mChatServiceMockCreate) ExpectCtxParam1(ctx context.Context) *mChatServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ChatServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &ChatServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectChatParam2 sets up expected param chat for ChatService.Create
+func (mmCreate *mChatServiceMockCreate) ExpectChatParam2(chat *model.ChatInfo) *mChatServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ChatServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &ChatServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.chat = &chat
+	mmCreate.defaultExpectation.expectationOrigins.originChat = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.Create
+func (mmCreate *mChatServiceMockCreate) Inspect(f func(ctx context.Context, chat *model.ChatInfo)) *mChatServiceMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by ChatService.Create
+func (mmCreate *mChatServiceMockCreate) Return(i1 int64, err error) *ChatServiceMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ChatServiceMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &ChatServiceMockCreateResults{i1, err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the ChatService.Create method
+func (mmCreate *mChatServiceMockCreate) Set(f func(ctx context.Context, chat *model.ChatInfo) (i1 int64, err error)) *ChatServiceMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the ChatService.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the ChatService.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the ChatService.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mChatServiceMockCreate) When(ctx context.Context, chat *model.ChatInfo) *ChatServiceMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &ChatServiceMockCreateParams{ctx, chat},
+		expectationOrigins: ChatServiceMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.Create return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockCreateExpectation) Then(i1 int64, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.Create should be invoked
+func (mmCreate *mChatServiceMockCreate) Times(n uint64) *mChatServiceMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of ChatServiceMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mChatServiceMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_service.ChatService
+func (mmCreate *ChatServiceMock) Create(ctx context.Context, chat *model.ChatInfo) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, chat)
+	}
+
+	mm_params := ChatServiceMockCreateParams{ctx, chat}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockCreateParams{ctx, chat}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("ChatServiceMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chat != nil && !minimock.Equal(*mm_want_ptrs.chat, mm_got.chat) {
+				mmCreate.t.Errorf("ChatServiceMock.Create got unexpected parameter chat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originChat, *mm_want_ptrs.chat, mm_got.chat, minimock.Diff(*mm_want_ptrs.chat, mm_got.chat))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("ChatServiceMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the ChatServiceMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, chat)
+	}
+	mmCreate.t.Fatalf("Unexpected call to ChatServiceMock.Create. %v %v", ctx, chat)
+	return
+}
+
+// CreateAfterCounter returns a count of finished ChatServiceMock.Create invocations
+func (mmCreate *ChatServiceMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of ChatServiceMock.Create invocations
+func (mmCreate *ChatServiceMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mChatServiceMockCreate) Calls() []*ChatServiceMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mChatServiceMockDelete struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockDeleteExpectation
+	expectations       []*ChatServiceMockDeleteExpectation
+
+	callArgs []*ChatServiceMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockDeleteExpectation specifies expectation struct of the ChatService.Delete
+type ChatServiceMockDeleteExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockDeleteParams
+	paramPtrs          *ChatServiceMockDeleteParamPtrs
+	expectationOrigins ChatServiceMockDeleteExpectationOrigins
+	results            *ChatServiceMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockDeleteParams contains parameters of the ChatService.Delete
+type ChatServiceMockDeleteParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ChatServiceMockDeleteParamPtrs contains pointers to parameters of the ChatService.Delete
+type ChatServiceMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ChatServiceMockDeleteResults contains results of the ChatService.Delete
+type ChatServiceMockDeleteResults struct {
+	err error
+}
+
+// ChatServiceMockDeleteOrigins contains origins of expectations of the ChatService.Delete
+type ChatServiceMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mChatServiceMockDelete) Optional() *mChatServiceMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for ChatService.Delete
+func (mmDelete *mChatServiceMockDelete) Expect(ctx context.Context, id int64) *mChatServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &ChatServiceMockDeleteParams{ctx, id}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.Delete
+func (mmDelete *mChatServiceMockDelete) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &ChatServiceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for ChatService.Delete
+func (mmDelete *mChatServiceMockDelete) ExpectIdParam2(id int64) *mChatServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &ChatServiceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.Delete
+func (mmDelete *mChatServiceMockDelete) Inspect(f func(ctx context.Context, id int64)) *mChatServiceMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by ChatService.Delete
+func (mmDelete *mChatServiceMockDelete) Return(err error) *ChatServiceMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &ChatServiceMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the ChatService.Delete method
+func (mmDelete *mChatServiceMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *ChatServiceMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the ChatService.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the ChatService.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the ChatService.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mChatServiceMockDelete) When(ctx context.Context, id int64) *ChatServiceMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &ChatServiceMockDeleteParams{ctx, id},
+		expectationOrigins: ChatServiceMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.Delete return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockDeleteExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.Delete should be invoked
+func (mmDelete *mChatServiceMockDelete) Times(n uint64) *mChatServiceMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of ChatServiceMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mChatServiceMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_service.ChatService
+func (mmDelete *ChatServiceMock) Delete(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := ChatServiceMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("ChatServiceMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("ChatServiceMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("ChatServiceMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the ChatServiceMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to ChatServiceMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished ChatServiceMock.Delete invocations
+func (mmDelete *ChatServiceMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of ChatServiceMock.Delete invocations
+func (mmDelete *ChatServiceMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mChatServiceMockDelete) Calls() []*ChatServiceMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mChatServiceMockDeleteMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockDeleteMessageExpectation
+	expectations       []*ChatServiceMockDeleteMessageExpectation
+
+	callArgs []*ChatServiceMockDeleteMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockDeleteMessageExpectation specifies expectation struct of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockDeleteMessageParams
+	paramPtrs          *ChatServiceMockDeleteMessageParamPtrs
+	expectationOrigins ChatServiceMockDeleteMessageExpectationOrigins
+	results            *ChatServiceMockDeleteMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockDeleteMessageParams contains parameters of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageParams struct {
+	ctx      context.Context
+	deletion *model.DeleteMessage
+}
+
+// ChatServiceMockDeleteMessageParamPtrs contains pointers to parameters of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageParamPtrs struct {
+	ctx      *context.Context
+	deletion **model.DeleteMessage
+}
+
+// ChatServiceMockDeleteMessageResults contains results of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// ChatServiceMockDeleteMessageOrigins contains origins of expectations of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originDeletion string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Optional() *mChatServiceMockDeleteMessage {
+	mmDeleteMessage.optional = true
+	return mmDeleteMessage
+}
+
+// Expect sets up expected params for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Expect(ctx context.Context, deletion *model.DeleteMessage) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteMessage.defaultExpectation.params = &ChatServiceMockDeleteMessageParams{ctx, deletion}
+	mmDeleteMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteMessage.expectations {
+		if minimock.Equal(e.params, mmDeleteMessage.defaultExpectation.params) {
+			mmDeleteMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatServiceMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// ExpectDeletionParam2 sets up expected param deletion for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) ExpectDeletionParam2(deletion *model.DeleteMessage) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatServiceMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.deletion = &deletion
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originDeletion = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Inspect(f func(ctx context.Context, deletion *model.DeleteMessage)) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.DeleteMessage")
+	}
+
+	mmDeleteMessage.mock.inspectFuncDeleteMessage = f
+
+	return mmDeleteMessage
+}
+
+// Return sets up results that will be returned by ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Return(mp1 *model.Message, err error) *ChatServiceMock {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{mock: mmDeleteMessage.mock}
+	}
+	mmDeleteMessage.defaultExpectation.results = &ChatServiceMockDeleteMessageResults{mp1, err}
+	mmDeleteMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.DeleteMessage method
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Set(f func(ctx context.Context, deletion *model.DeleteMessage) (mp1 *model.Message, err error)) *ChatServiceMock {
+	if mmDeleteMessage.defaultExpectation != nil {
+		mmDeleteMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.DeleteMessage method")
+	}
+
+	if len(mmDeleteMessage.expectations) > 0 {
+		mmDeleteMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.DeleteMessage method")
+	}
+
+	mmDeleteMessage.mock.funcDeleteMessage = f
+	mmDeleteMessage.mock.funcDeleteMessageOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage.mock
+}
+
+// When sets expectation for the ChatService.DeleteMessage which will trigger the result defined by the following
+// Then helper
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) When(ctx context.Context, deletion *model.DeleteMessage) *ChatServiceMockDeleteMessageExpectation {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockDeleteMessageExpectation{
+		mock:               mmDeleteMessage.mock,
+		params:             &ChatServiceMockDeleteMessageParams{ctx, deletion},
+		expectationOrigins: ChatServiceMockDeleteMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteMessage.expectations = append(mmDeleteMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.DeleteMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockDeleteMessageExpectation) Then(mp1 *model.Message, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockDeleteMessageResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.DeleteMessage should be invoked
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Times(n uint64) *mChatServiceMockDeleteMessage {
+	if n == 0 {
+		mmDeleteMessage.mock.t.Fatalf("Times of ChatServiceMock.DeleteMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteMessage.expectedInvocations, n)
+	mmDeleteMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage
+}
+
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) invocationsDone() bool {
+	if len(mmDeleteMessage.expectations) == 0 && mmDeleteMessage.defaultExpectation == nil && mmDeleteMessage.mock.funcDeleteMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.mock.afterDeleteMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteMessage implements mm_service.ChatService
+func (mmDeleteMessage *ChatServiceMock) DeleteMessage(ctx context.Context, deletion *model.DeleteMessage) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmDeleteMessage.beforeDeleteMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteMessage.afterDeleteMessageCounter, 1)
+
+	mmDeleteMessage.t.Helper()
+
+	if mmDeleteMessage.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.inspectFuncDeleteMessage(ctx, deletion)
+	}
+
+	mm_params := ChatServiceMockDeleteMessageParams{ctx, deletion}
+
+	// Record call args
+	mmDeleteMessage.DeleteMessageMock.mutex.Lock()
+	mmDeleteMessage.DeleteMessageMock.callArgs = append(mmDeleteMessage.DeleteMessageMock.callArgs, &mm_params)
+	mmDeleteMessage.DeleteMessageMock.mutex.Unlock()
+
+	for _, e := range mmDeleteMessage.DeleteMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmDeleteMessage.DeleteMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteMessage.DeleteMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteMessage.DeleteMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteMessage.DeleteMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockDeleteMessageParams{ctx, deletion}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.deletion != nil && !minimock.Equal(*mm_want_ptrs.deletion, mm_got.deletion) {
+				mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameter deletion, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originDeletion, *mm_want_ptrs.deletion, mm_got.deletion, minimock.Diff(*mm_want_ptrs.deletion, mm_got.deletion))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteMessage.DeleteMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteMessage.t.Fatal("No results are set for the ChatServiceMock.DeleteMessage")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmDeleteMessage.funcDeleteMessage != nil {
+		return mmDeleteMessage.funcDeleteMessage(ctx, deletion)
+	}
+	mmDeleteMessage.t.Fatalf("Unexpected call to ChatServiceMock.DeleteMessage. %v %v", ctx, deletion)
+	return
+}
+
+// DeleteMessageAfterCounter returns a count of finished ChatServiceMock.DeleteMessage invocations
+func (mmDeleteMessage *ChatServiceMock) DeleteMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.afterDeleteMessageCounter)
+}
+
+// DeleteMessageBeforeCounter returns a count of ChatServiceMock.DeleteMessage invocations
+func (mmDeleteMessage *ChatServiceMock) DeleteMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.beforeDeleteMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.DeleteMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Calls() []*ChatServiceMockDeleteMessageParams {
+	mmDeleteMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockDeleteMessageParams, len(mmDeleteMessage.callArgs))
+	copy(argCopy, mmDeleteMessage.callArgs)
+
+	mmDeleteMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteMessageDone returns true if the count of the DeleteMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockDeleteMessageDone() bool {
+	if m.DeleteMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMessageMock.invocationsDone()
+}
+
+// MinimockDeleteMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockDeleteMessageInspect() {
+	for _, e := range m.DeleteMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteMessageCounter := mm_atomic.LoadUint64(&m.afterDeleteMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMessageMock.defaultExpectation != nil && afterDeleteMessageCounter < 1 {
+		if m.DeleteMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage at\n%s", m.DeleteMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage at\n%s with params: %#v", m.DeleteMessageMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteMessage != nil && afterDeleteMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage at\n%s", m.funcDeleteMessageOrigin)
+	}
+
+	if !m.DeleteMessageMock.invocationsDone() && afterDeleteMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.DeleteMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMessageMock.expectedInvocations), m.DeleteMessageMock.expectedInvocationsOrigin, afterDeleteMessageCounter)
+	}
+}
+
+type mChatServiceMockDeliverScheduled struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockDeliverScheduledExpectation
+	expectations       []*ChatServiceMockDeliverScheduledExpectation
+
+	callArgs []*ChatServiceMockDeliverScheduledParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockDeliverScheduledExpectation specifies expectation struct of the ChatService.DeliverScheduled
+type ChatServiceMockDeliverScheduledExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockDeliverScheduledParams
+	paramPtrs          *ChatServiceMockDeliverScheduledParamPtrs
+	expectationOrigins ChatServiceMockDeliverScheduledExpectationOrigins
+	results            *ChatServiceMockDeliverScheduledResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockDeliverScheduledParams contains parameters of the ChatService.DeliverScheduled
+type ChatServiceMockDeliverScheduledParams struct {
+	ctx     context.Context
+	message *model.ScheduledMessage
+}
+
+// ChatServiceMockDeliverScheduledParamPtrs contains pointers to parameters of the ChatService.DeliverScheduled
+type ChatServiceMockDeliverScheduledParamPtrs struct {
+	ctx     *context.Context
+	message **model.ScheduledMessage
+}
+
+// ChatServiceMockDeliverScheduledResults contains results of the ChatService.DeliverScheduled
+type ChatServiceMockDeliverScheduledResults struct {
+	i1  int64
+	err error
+}
+
+// ChatServiceMockDeliverScheduledOrigins contains origins of expectations of the ChatService.DeliverScheduled
+type ChatServiceMockDeliverScheduledExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originMessage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeliverScheduled *mChatServiceMockDeliverScheduled) Optional() *mChatServiceMockDeliverScheduled {
+	mmDeliverScheduled.optional = true
+	return mmDeliverScheduled
+}
+
+// Expect sets up expected params for ChatService.DeliverScheduled
+func (mmDeliverScheduled *mChatServiceMockDeliverScheduled) Expect(ctx context.Context, message *model.ScheduledMessage) *mChatServiceMockDeliverScheduled {
+	if mmDeliverScheduled.mock.funcDeliverScheduled != nil {
+		mmDeliverScheduled.mock.t.Fatalf("ChatServiceMock.DeliverScheduled mock is already set by Set")
+	}
+
+	if mmDeliverScheduled.defaultExpectation == nil {
+		mmDeliverScheduled.defaultExpectation = &ChatServiceMockDeliverScheduledExpectation{}
+	}
+
+	if mmDeliverScheduled.defaultExpectation.paramPtrs != nil {
+		mmDeliverScheduled.mock.t.Fatalf("ChatServiceMock.DeliverScheduled mock is already set by ExpectParams functions")
+	}
+
+	mmDeliverScheduled.defaultExpectation.params = &ChatServiceMockDeliverScheduledParams{ctx, message}
+	mmDeliverScheduled.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeliverScheduled.expectations {
+		if minimock.Equal(e.params, mmDeliverScheduled.defaultExpectation.params) {
+			mmDeliverScheduled.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeliverScheduled.defaultExpectation.params)
+		}
+	}
+
+	return mmDeliverScheduled
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.DeliverScheduled
+func (mmDeliverScheduled *mChatServiceMockDeliverScheduled) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDeliverScheduled {
+	if mmDeliverScheduled.mock.funcDeliverScheduled != nil {
+		mmDeliverScheduled.mock.t.Fatalf("ChatServiceMock.DeliverScheduled mock is already set by Set")
+	}
+
+	if mmDeliverScheduled.defaultExpectation == nil {
+		mmDeliverScheduled.defaultExpectation = &ChatServiceMockDeliverScheduledExpectation{}
+	}
+
+	if mmDeliverScheduled.defaultExpectation.params != nil {
+		mmDeliverScheduled.mock.t.Fatalf("ChatServiceMock.DeliverScheduled mock is already set by Expect")
+	}
+
+	if mmDeliverScheduled.defaultExpectation.paramPtrs == nil {
+		mmDeliverScheduled.defaultExpectation.paramPtrs = &ChatServiceMockDeliverScheduledParamPtrs{}
+	}
+	mmDeliverScheduled.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeliverScheduled.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeliverScheduled
+}
+
+// ExpectMessageParam2 sets up expected param message for ChatService.DeliverScheduled
+func (mmDeliverScheduled *mChatServiceMockDeliverScheduled) ExpectMessageParam2(message *model.ScheduledMessage) *mChatServiceMockDeliverScheduled {
+	if mmDeliverScheduled.mock.funcDeliverScheduled != nil {
+		mmDeliverScheduled.mock.t.Fatalf("ChatServiceMock.DeliverScheduled mock is already set by Set")
+	}
+
+	if mmDeliverScheduled.defaultExpectation == nil {
+		mmDeliverScheduled.defaultExpectation = &ChatServiceMockDeliverScheduledExpectation{}
+	}
+
+	if mmDeliverScheduled.defaultExpectation.params != nil {
+		mmDeliverScheduled.mock.t.Fatalf("ChatServiceMock.DeliverScheduled mock is already set by Expect")
+	}
+
+	if mmDeliverScheduled.defaultExpectation.paramPtrs == nil {
+		mmDeliverScheduled.defaultExpectation.paramPtrs = &ChatServiceMockDeliverScheduledParamPtrs{}
+	}
+	mmDeliverScheduled.defaultExpectation.paramPtrs.message = &message
+	mmDeliverScheduled.defaultExpectation.expectationOrigins.originMessage = minimock.CallerInfo(1)
+
+	return mmDeliverScheduled
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.DeliverScheduled
+func (mmDeliverScheduled *mChatServiceMockDeliverScheduled) Inspect(f func(ctx context.Context, message *model.ScheduledMessage)) *mChatServiceMockDeliverScheduled {
+	if mmDeliverScheduled.mock.inspectFuncDeliverScheduled != nil {
+		mmDeliverScheduled.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.DeliverScheduled")
+	}
+
+	mmDeliverScheduled.mock.inspectFuncDeliverScheduled = f
+
+	return mmDeliverScheduled
+}
+
+// Return sets up results that will be returned by ChatService.DeliverScheduled
+func (mmDeliverScheduled *mChatServiceMockDeliverScheduled) Return(i1 int64, err error) *ChatServiceMock {
+	if mmDeliverScheduled.mock.funcDeliverScheduled != nil {
+		mmDeliverScheduled.mock.t.Fatalf("ChatServiceMock.DeliverScheduled mock is already set by Set")
+	}
+
+	if mmDeliverScheduled.defaultExpectation == nil {
+		mmDeliverScheduled.defaultExpectation = &ChatServiceMockDeliverScheduledExpectation{mock: mmDeliverScheduled.mock}
+	}
+	mmDeliverScheduled.defaultExpectation.results = &ChatServiceMockDeliverScheduledResults{i1, err}
+	mmDeliverScheduled.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeliverScheduled.mock
+}
+
+// Set uses given function f to mock the ChatService.DeliverScheduled method
+func (mmDeliverScheduled *mChatServiceMockDeliverScheduled) Set(f func(ctx context.Context, message *model.ScheduledMessage) (i1 int64, err error)) *ChatServiceMock {
+	if mmDeliverScheduled.defaultExpectation != nil {
+		mmDeliverScheduled.mock.t.Fatalf("Default expectation is already set for the ChatService.DeliverScheduled method")
+	}
+
+	if len(mmDeliverScheduled.expectations) > 0 {
+		mmDeliverScheduled.mock.t.Fatalf("Some expectations are already set for the ChatService.DeliverScheduled method")
+	}
+
+	mmDeliverScheduled.mock.funcDeliverScheduled = f
+	mmDeliverScheduled.mock.funcDeliverScheduledOrigin = minimock.CallerInfo(1)
+	return mmDeliverScheduled.mock
+}
+
+// When sets expectation for the ChatService.DeliverScheduled which will trigger the result defined by the following
+// Then helper
+func (mmDeliverScheduled *mChatServiceMockDeliverScheduled) When(ctx context.Context, message *model.ScheduledMessage) *ChatServiceMockDeliverScheduledExpectation {
+	if mmDeliverScheduled.mock.funcDeliverScheduled != nil {
+		mmDeliverScheduled.mock.t.Fatalf("ChatServiceMock.DeliverScheduled mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockDeliverScheduledExpectation{
+		mock:               mmDeliverScheduled.mock,
+		params:             &ChatServiceMockDeliverScheduledParams{ctx, message},
+		expectationOrigins: ChatServiceMockDeliverScheduledExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeliverScheduled.expectations = append(mmDeliverScheduled.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.DeliverScheduled return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockDeliverScheduledExpectation) Then(i1 int64, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockDeliverScheduledResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.DeliverScheduled should be invoked
+func (mmDeliverScheduled *mChatServiceMockDeliverScheduled) Times(n uint64) *mChatServiceMockDeliverScheduled {
+	if n == 0 {
+		mmDeliverScheduled.mock.t.Fatalf("Times of ChatServiceMock.DeliverScheduled mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeliverScheduled.expectedInvocations, n)
+	mmDeliverScheduled.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeliverScheduled
+}
+
+func (mmDeliverScheduled *mChatServiceMockDeliverScheduled) invocationsDone() bool {
+	if len(mmDeliverScheduled.expectations) == 0 && mmDeliverScheduled.defaultExpectation == nil && mmDeliverScheduled.mock.funcDeliverScheduled == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeliverScheduled.mock.afterDeliverScheduledCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeliverScheduled.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeliverScheduled implements mm_service.ChatService
+func (mmDeliverScheduled *ChatServiceMock) DeliverScheduled(ctx context.Context, message *model.ScheduledMessage) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmDeliverScheduled.beforeDeliverScheduledCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeliverScheduled.afterDeliverScheduledCounter, 1)
+
+	mmDeliverScheduled.t.Helper()
+
+	if mmDeliverScheduled.inspectFuncDeliverScheduled != nil {
+		mmDeliverScheduled.inspectFuncDeliverScheduled(ctx, message)
+	}
+
+	mm_params := ChatServiceMockDeliverScheduledParams{ctx, message}
+
+	// Record call args
+	mmDeliverScheduled.DeliverScheduledMock.mutex.Lock()
+	mmDeliverScheduled.DeliverScheduledMock.callArgs = append(mmDeliverScheduled.DeliverScheduledMock.callArgs, &mm_params)
+	mmDeliverScheduled.DeliverScheduledMock.mutex.Unlock()
+
+	for _, e := range mmDeliverScheduled.DeliverScheduledMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeliverScheduled.DeliverScheduledMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeliverScheduled.DeliverScheduledMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeliverScheduled.DeliverScheduledMock.defaultExpectation.params
+		mm_want_ptrs := mmDeliverScheduled.DeliverScheduledMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockDeliverScheduledParams{ctx, message}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeliverScheduled.t.Errorf("ChatServiceMock.DeliverScheduled got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeliverScheduled.DeliverScheduledMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
+				mmDeliverScheduled.t.Errorf("ChatServiceMock.DeliverScheduled got unexpected parameter message, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeliverScheduled.DeliverScheduledMock.defaultExpectation.expectationOrigins.originMessage, *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeliverScheduled.t.Errorf("ChatServiceMock.DeliverScheduled got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeliverScheduled.DeliverScheduledMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeliverScheduled.DeliverScheduledMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeliverScheduled.t.Fatal("No results are set for the ChatServiceMock.DeliverScheduled")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeliverScheduled.funcDeliverScheduled != nil {
+		return mmDeliverScheduled.funcDeliverScheduled(ctx, message)
+	}
+	mmDeliverScheduled.t.Fatalf("Unexpected call to ChatServiceMock.DeliverScheduled. %v %v", ctx, message)
+	return
+}
+
+// DeliverScheduledAfterCounter returns a count of finished ChatServiceMock.DeliverScheduled invocations
+func (mmDeliverScheduled *ChatServiceMock) DeliverScheduledAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeliverScheduled.afterDeliverScheduledCounter)
+}
+
+// DeliverScheduledBeforeCounter returns a count of ChatServiceMock.DeliverScheduled invocations
+func (mmDeliverScheduled *ChatServiceMock) DeliverScheduledBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeliverScheduled.beforeDeliverScheduledCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.DeliverScheduled.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeliverScheduled *mChatServiceMockDeliverScheduled) Calls() []*ChatServiceMockDeliverScheduledParams {
+	mmDeliverScheduled.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockDeliverScheduledParams, len(mmDeliverScheduled.callArgs))
+	copy(argCopy, mmDeliverScheduled.callArgs)
+
+	mmDeliverScheduled.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeliverScheduledDone returns true if the count of the DeliverScheduled invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockDeliverScheduledDone() bool {
+	if m.DeliverScheduledMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeliverScheduledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeliverScheduledMock.invocationsDone()
+}
+
+// MinimockDeliverScheduledInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockDeliverScheduledInspect() {
+	for _, e := range m.DeliverScheduledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.DeliverScheduled at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeliverScheduledCounter := mm_atomic.LoadUint64(&m.afterDeliverScheduledCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeliverScheduledMock.defaultExpectation != nil && afterDeliverScheduledCounter < 1 {
+		if m.DeliverScheduledMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.DeliverScheduled at\n%s", m.DeliverScheduledMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.DeliverScheduled at\n%s with params: %#v", m.DeliverScheduledMock.defaultExpectation.expectationOrigins.origin, *m.DeliverScheduledMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeliverScheduled != nil && afterDeliverScheduledCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.DeliverScheduled at\n%s", m.funcDeliverScheduledOrigin)
+	}
+
+	if !m.DeliverScheduledMock.invocationsDone() && afterDeliverScheduledCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.DeliverScheduled at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeliverScheduledMock.expectedInvocations), m.DeliverScheduledMock.expectedInvocationsOrigin, afterDeliverScheduledCounter)
+	}
+}
+
+type mChatServiceMockEditMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockEditMessageExpectation
+	expectations       []*ChatServiceMockEditMessageExpectation
+
+	callArgs []*ChatServiceMockEditMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockEditMessageExpectation specifies expectation struct of the ChatService.EditMessage
+type ChatServiceMockEditMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockEditMessageParams
+	paramPtrs          *ChatServiceMockEditMessageParamPtrs
+	expectationOrigins ChatServiceMockEditMessageExpectationOrigins
+	results            *ChatServiceMockEditMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockEditMessageParams contains parameters of the ChatService.EditMessage
+type ChatServiceMockEditMessageParams struct {
+	ctx  context.Context
+	edit *model.EditMessage
+}
+
+// ChatServiceMockEditMessageParamPtrs contains pointers to parameters of the ChatService.EditMessage
+type ChatServiceMockEditMessageParamPtrs struct {
+	ctx  *context.Context
+	edit **model.EditMessage
+}
+
+// ChatServiceMockEditMessageResults contains results of the ChatService.EditMessage
+type ChatServiceMockEditMessageResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// ChatServiceMockEditMessageOrigins contains origins of expectations of the ChatService.EditMessage
+type ChatServiceMockEditMessageExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originEdit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEditMessage *mChatServiceMockEditMessage) Optional() *mChatServiceMockEditMessage {
+	mmEditMessage.optional = true
+	return mmEditMessage
+}
+
+// Expect sets up expected params for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) Expect(ctx context.Context, edit *model.EditMessage) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by ExpectParams functions")
+	}
+
+	mmEditMessage.defaultExpectation.params = &ChatServiceMockEditMessageParams{ctx, edit}
+	mmEditMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEditMessage.expectations {
+		if minimock.Equal(e.params, mmEditMessage.defaultExpectation.params) {
+			mmEditMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEditMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmEditMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatServiceMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEditMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// ExpectEditParam2 sets up expected param edit for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) ExpectEditParam2(edit *model.EditMessage) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatServiceMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.edit = &edit
+	mmEditMessage.defaultExpectation.expectationOrigins.originEdit = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) Inspect(f func(ctx context.Context, edit *model.EditMessage)) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.inspectFuncEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.EditMessage")
+	}
+
+	mmEditMessage.mock.inspectFuncEditMessage = f
+
+	return mmEditMessage
+}
+
+// Return sets up results that will be returned by ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) Return(mp1 *model.Message, err error) *ChatServiceMock {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{mock: mmEditMessage.mock}
+	}
+	mmEditMessage.defaultExpectation.results = &ChatServiceMockEditMessageResults{mp1, err}
+	mmEditMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEditMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.EditMessage method
+func (mmEditMessage *mChatServiceMockEditMessage) Set(f func(ctx context.Context, edit *model.EditMessage) (mp1 *model.Message, err error)) *ChatServiceMock {
+	if mmEditMessage.defaultExpectation != nil {
+		mmEditMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.EditMessage method")
+	}
+
+	if len(mmEditMessage.expectations) > 0 {
+		mmEditMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.EditMessage method")
+	}
+
+	mmEditMessage.mock.funcEditMessage = f
+	mmEditMessage.mock.funcEditMessageOrigin = minimock.CallerInfo(1)
+	return mmEditMessage.mock
+}
+
+// When sets expectation for the ChatService.EditMessage which will trigger the result defined by the following
+// Then helper
+func (mmEditMessage *mChatServiceMockEditMessage) When(ctx context.Context, edit *model.EditMessage) *ChatServiceMockEditMessageExpectation {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockEditMessageExpectation{
+		mock:               mmEditMessage.mock,
+		params:             &ChatServiceMockEditMessageParams{ctx, edit},
+		expectationOrigins: ChatServiceMockEditMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEditMessage.expectations = append(mmEditMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.EditMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockEditMessageExpectation) Then(mp1 *model.Message, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockEditMessageResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.EditMessage should be invoked
+func (mmEditMessage *mChatServiceMockEditMessage) Times(n uint64) *mChatServiceMockEditMessage {
+	if n == 0 {
+		mmEditMessage.mock.t.Fatalf("Times of ChatServiceMock.EditMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEditMessage.expectedInvocations, n)
+	mmEditMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEditMessage
+}
+
+func (mmEditMessage *mChatServiceMockEditMessage) invocationsDone() bool {
+	if len(mmEditMessage.expectations) == 0 && mmEditMessage.defaultExpectation == nil && mmEditMessage.mock.funcEditMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEditMessage.mock.afterEditMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEditMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EditMessage implements mm_service.ChatService
+func (mmEditMessage *ChatServiceMock) EditMessage(ctx context.Context, edit *model.EditMessage) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmEditMessage.beforeEditMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmEditMessage.afterEditMessageCounter, 1)
+
+	mmEditMessage.t.Helper()
+
+	if mmEditMessage.inspectFuncEditMessage != nil {
+		mmEditMessage.inspectFuncEditMessage(ctx, edit)
+	}
+
+	mm_params := ChatServiceMockEditMessageParams{ctx, edit}
+
+	// Record call args
+	mmEditMessage.EditMessageMock.mutex.Lock()
+	mmEditMessage.EditMessageMock.callArgs = append(mmEditMessage.EditMessageMock.callArgs, &mm_params)
+	mmEditMessage.EditMessageMock.mutex.Unlock()
+
+	for _, e := range mmEditMessage.EditMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmEditMessage.EditMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEditMessage.EditMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmEditMessage.EditMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmEditMessage.EditMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockEditMessageParams{ctx, edit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.edit != nil && !minimock.Equal(*mm_want_ptrs.edit, mm_got.edit) {
+				mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameter edit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originEdit, *mm_want_ptrs.edit, mm_got.edit, minimock.Diff(*mm_want_ptrs.edit, mm_got.edit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEditMessage.EditMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEditMessage.t.Fatal("No results are set for the ChatServiceMock.EditMessage")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmEditMessage.funcEditMessage != nil {
+		return mmEditMessage.funcEditMessage(ctx, edit)
+	}
+	mmEditMessage.t.Fatalf("Unexpected call to ChatServiceMock.EditMessage. %v %v", ctx, edit)
+	return
+}
+
+// EditMessageAfterCounter returns a count of finished ChatServiceMock.EditMessage invocations
+func (mmEditMessage *ChatServiceMock) EditMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEditMessage.afterEditMessageCounter)
+}
+
+// EditMessageBeforeCounter returns a count of ChatServiceMock.EditMessage invocations
+func (mmEditMessage *ChatServiceMock) EditMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEditMessage.beforeEditMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.EditMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEditMessage *mChatServiceMockEditMessage) Calls() []*ChatServiceMockEditMessageParams {
+	mmEditMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockEditMessageParams, len(mmEditMessage.callArgs))
+	copy(argCopy, mmEditMessage.callArgs)
+
+	mmEditMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEditMessageDone returns true if the count of the EditMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockEditMessageDone() bool {
+	if m.EditMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EditMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EditMessageMock.invocationsDone()
+}
+
+// MinimockEditMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockEditMessageInspect() {
+	for _, e := range m.EditMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.EditMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEditMessageCounter := mm_atomic.LoadUint64(&m.afterEditMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EditMessageMock.defaultExpectation != nil && afterEditMessageCounter < 1 {
+		if m.EditMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.EditMessage at\n%s", m.EditMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.EditMessage at\n%s with params: %#v", m.EditMessageMock.defaultExpectation.expectationOrigins.origin, *m.EditMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEditMessage != nil && afterEditMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.EditMessage at\n%s", m.funcEditMessageOrigin)
+	}
+
+	if !m.EditMessageMock.invocationsDone() && afterEditMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.EditMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EditMessageMock.expectedInvocations), m.EditMessageMock.expectedInvocationsOrigin, afterEditMessageCounter)
+	}
+}
+
+type mChatServiceMockExportChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockExportChatExpectation
+	expectations       []*ChatServiceMockExportChatExpectation
+
+	callArgs []*ChatServiceMockExportChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockExportChatExpectation specifies expectation struct of the ChatService.ExportChat
+type ChatServiceMockExportChatExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockExportChatParams
+	paramPtrs          *ChatServiceMockExportChatParamPtrs
+	expectationOrigins ChatServiceMockExportChatExpectationOrigins
+	results            *ChatServiceMockExportChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockExportChatParams contains parameters of the ChatService.ExportChat
+type ChatServiceMockExportChatParams struct {
+	ctx    context.Context
+	chatID int64
+	emit   func(record *model.ExportRecord) error
+}
+
+// ChatServiceMockExportChatParamPtrs contains pointers to parameters of the ChatService.ExportChat
+type ChatServiceMockExportChatParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	emit   *func(record *model.ExportRecord) error
+}
+
+// ChatServiceMockExportChatResults contains results of the ChatService.ExportChat
+type ChatServiceMockExportChatResults struct {
+	err error
+}
+
+// ChatServiceMockExportChatOrigins contains origins of expectations of the ChatService.ExportChat
+type ChatServiceMockExportChatExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+	originEmit   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExportChat *mChatServiceMockExportChat) Optional() *mChatServiceMockExportChat {
+	mmExportChat.optional = true
+	return mmExportChat
+}
+
+// Expect sets up expected params for ChatService.ExportChat
+func (mmExportChat *mChatServiceMockExportChat) Expect(ctx context.Context, chatID int64, emit func(record *model.ExportRecord) error) *mChatServiceMockExportChat {
+	if mmExportChat.mock.funcExportChat != nil {
+		mmExportChat.mock.t.Fatalf("ChatServiceMock.ExportChat mock is already set by Set")
+	}
+
+	if mmExportChat.defaultExpectation == nil {
+		mmExportChat.defaultExpectation = &ChatServiceMockExportChatExpectation{}
+	}
+
+	if mmExportChat.defaultExpectation.paramPtrs != nil {
+		mmExportChat.mock.t.Fatalf("ChatServiceMock.ExportChat mock is already set by ExpectParams functions")
+	}
+
+	mmExportChat.defaultExpectation.params = &ChatServiceMockExportChatParams{ctx, chatID, emit}
+	mmExportChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExportChat.expectations {
+		if minimock.Equal(e.params, mmExportChat.defaultExpectation.params) {
+			mmExportChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExportChat.defaultExpectation.params)
+		}
+	}
+
+	return mmExportChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ExportChat
+func (mmExportChat *mChatServiceMockExportChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockExportChat {
+	if mmExportChat.mock.funcExportChat != nil {
+		mmExportChat.mock.t.Fatalf("ChatServiceMock.ExportChat mock is already set by Set")
+	}
+
+	if mmExportChat.defaultExpectation == nil {
+		mmExportChat.defaultExpectation = &ChatServiceMockExportChatExpectation{}
+	}
+
+	if mmExportChat.defaultExpectation.params != nil {
+		mmExportChat.mock.t.Fatalf("ChatServiceMock.ExportChat mock is already set by Expect")
+	}
+
+	if mmExportChat.defaultExpectation.paramPtrs == nil {
+		mmExportChat.defaultExpectation.paramPtrs = &ChatServiceMockExportChatParamPtrs{}
+	}
+	mmExportChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExportChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExportChat
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.ExportChat
+func (mmExportChat *mChatServiceMockExportChat) ExpectChatIDParam2(chatID int64) *mChatServiceMockExportChat {
+	if mmExportChat.mock.funcExportChat != nil {
+		mmExportChat.mock.t.Fatalf("ChatServiceMock.ExportChat mock is already set by Set")
+	}
+
+	if mmExportChat.defaultExpectation == nil {
+		mmExportChat.defaultExpectation = &ChatServiceMockExportChatExpectation{}
+	}
+
+	if mmExportChat.defaultExpectation.params != nil {
+		mmExportChat.mock.t.Fatalf("ChatServiceMock.ExportChat mock is already set by Expect")
+	}
+
+	if mmExportChat.defaultExpectation.paramPtrs == nil {
+		mmExportChat.defaultExpectation.paramPtrs = &ChatServiceMockExportChatParamPtrs{}
+	}
+	mmExportChat.defaultExpectation.paramPtrs.chatID = &chatID
+	mmExportChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmExportChat
+}
+
+// ExpectEmitParam3 sets up expected param emit for ChatService.ExportChat
+func (mmExportChat *mChatServiceMockExportChat) ExpectEmitParam3(emit func(record *model.ExportRecord) error) *mChatServiceMockExportChat {
+	if mmExportChat.mock.funcExportChat != nil {
+		mmExportChat.mock.t.Fatalf("ChatServiceMock.ExportChat mock is already set by Set")
+	}
+
+	if mmExportChat.defaultExpectation == nil {
+		mmExportChat.defaultExpectation = &ChatServiceMockExportChatExpectation{}
+	}
+
+	if mmExportChat.defaultExpectation.params != nil {
+		mmExportChat.mock.t.Fatalf("ChatServiceMock.ExportChat mock is already set by Expect")
+	}
+
+	if mmExportChat.defaultExpectation.paramPtrs == nil {
+		mmExportChat.defaultExpectation.paramPtrs = &ChatServiceMockExportChatParamPtrs{}
+	}
+	mmExportChat.defaultExpectation.paramPtrs.emit = &emit
+	mmExportChat.defaultExpectation.expectationOrigins.originEmit = minimock.CallerInfo(1)
+
+	return mmExportChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ExportChat
+func (mmExportChat *mChatServiceMockExportChat) Inspect(f func(ctx context.Context, chatID int64, emit func(record *model.ExportRecord) error)) *mChatServiceMockExportChat {
+	if mmExportChat.mock.inspectFuncExportChat != nil {
+		mmExportChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ExportChat")
+	}
+
+	mmExportChat.mock.inspectFuncExportChat = f
+
+	return mmExportChat
+}
+
+// Return sets up results that will be returned by ChatService.ExportChat
+func (mmExportChat *mChatServiceMockExportChat) Return(err error) *ChatServiceMock {
+	if mmExportChat.mock.funcExportChat != nil {
+		mmExportChat.mock.t.Fatalf("ChatServiceMock.ExportChat mock is already set by Set")
+	}
+
+	if mmExportChat.defaultExpectation == nil {
+		mmExportChat.defaultExpectation = &ChatServiceMockExportChatExpectation{mock: mmExportChat.mock}
+	}
+	mmExportChat.defaultExpectation.results = &ChatServiceMockExportChatResults{err}
+	mmExportChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExportChat.mock
+}
+
+// Set uses given function f to mock the ChatService.ExportChat method
+func (mmExportChat *mChatServiceMockExportChat) Set(f func(ctx context.Context, chatID int64, emit func(record *model.ExportRecord) error) (err error)) *ChatServiceMock {
+	if mmExportChat.defaultExpectation != nil {
+		mmExportChat.mock.t.Fatalf("Default expectation is already set for the ChatService.ExportChat method")
+	}
+
+	if len(mmExportChat.expectations) > 0 {
+		mmExportChat.mock.t.Fatalf("Some expectations are already set for the ChatService.ExportChat method")
+	}
+
+	mmExportChat.mock.funcExportChat = f
+	mmExportChat.mock.funcExportChatOrigin = minimock.CallerInfo(1)
+	return mmExportChat.mock
+}
+
+// When sets expectation for the ChatService.ExportChat which will trigger the result defined by the following
+// Then helper
+func (mmExportChat *mChatServiceMockExportChat) When(ctx context.Context, chatID int64, emit func(record *model.ExportRecord) error) *ChatServiceMockExportChatExpectation {
+	if mmExportChat.mock.funcExportChat != nil {
+		mmExportChat.mock.t.Fatalf("ChatServiceMock.ExportChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockExportChatExpectation{
+		mock:               mmExportChat.mock,
+		params:             &ChatServiceMockExportChatParams{ctx, chatID, emit},
+		expectationOrigins: ChatServiceMockExportChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExportChat.expectations = append(mmExportChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ExportChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockExportChatExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockExportChatResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ExportChat should be invoked
+func (mmExportChat *mChatServiceMockExportChat) Times(n uint64) *mChatServiceMockExportChat {
+	if n == 0 {
+		mmExportChat.mock.t.Fatalf("Times of ChatServiceMock.ExportChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExportChat.expectedInvocations, n)
+	mmExportChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExportChat
+}
+
+func (mmExportChat *mChatServiceMockExportChat) invocationsDone() bool {
+	if len(mmExportChat.expectations) == 0 && mmExportChat.defaultExpectation == nil && mmExportChat.mock.funcExportChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExportChat.mock.afterExportChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExportChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExportChat implements mm_service.ChatService
+func (mmExportChat *ChatServiceMock) ExportChat(ctx context.Context, chatID int64, emit func(record *model.ExportRecord) error) (err error) {
+	mm_atomic.AddUint64(&mmExportChat.beforeExportChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmExportChat.afterExportChatCounter, 1)
+
+	mmExportChat.t.Helper()
+
+	if mmExportChat.inspectFuncExportChat != nil {
+		mmExportChat.inspectFuncExportChat(ctx, chatID, emit)
+	}
+
+	mm_params := ChatServiceMockExportChatParams{ctx, chatID, emit}
+
+	// Record call args
+	mmExportChat.ExportChatMock.mutex.Lock()
+	mmExportChat.ExportChatMock.callArgs = append(mmExportChat.ExportChatMock.callArgs, &mm_params)
+	mmExportChat.ExportChatMock.mutex.Unlock()
+
+	for _, e := range mmExportChat.ExportChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmExportChat.ExportChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExportChat.ExportChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmExportChat.ExportChatMock.defaultExpectation.params
+		mm_want_ptrs := mmExportChat.ExportChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockExportChatParams{ctx, chatID, emit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExportChat.t.Errorf("ChatServiceMock.ExportChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportChat.ExportChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmExportChat.t.Errorf("ChatServiceMock.ExportChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportChat.ExportChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.emit != nil && !minimock.Equal(*mm_want_ptrs.emit, mm_got.emit) {
+				mmExportChat.t.Errorf("ChatServiceMock.ExportChat got unexpected parameter emit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportChat.ExportChatMock.defaultExpectation.expectationOrigins.originEmit, *mm_want_ptrs.emit, mm_got.emit, minimock.Diff(*mm_want_ptrs.emit, mm_got.emit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExportChat.t.Errorf("ChatServiceMock.ExportChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExportChat.ExportChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExportChat.ExportChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExportChat.t.Fatal("No results are set for the ChatServiceMock.ExportChat")
+		}
+		return (*mm_results).err
+	}
+	if mmExportChat.funcExportChat != nil {
+		return mmExportChat.funcExportChat(ctx, chatID, emit)
+	}
+	mmExportChat.t.Fatalf("Unexpected call to ChatServiceMock.ExportChat. %v %v %v", ctx, chatID, emit)
+	return
+}
+
+// ExportChatAfterCounter returns a count of finished ChatServiceMock.ExportChat invocations
+func (mmExportChat *ChatServiceMock) ExportChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportChat.afterExportChatCounter)
+}
+
+// ExportChatBeforeCounter returns a count of ChatServiceMock.ExportChat invocations
+func (mmExportChat *ChatServiceMock) ExportChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportChat.beforeExportChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ExportChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExportChat *mChatServiceMockExportChat) Calls() []*ChatServiceMockExportChatParams {
+	mmExportChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockExportChatParams, len(mmExportChat.callArgs))
+	copy(argCopy, mmExportChat.callArgs)
+
+	mmExportChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportChatDone returns true if the count of the ExportChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockExportChatDone() bool {
+	if m.ExportChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportChatMock.invocationsDone()
+}
+
+// MinimockExportChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockExportChatInspect() {
+	for _, e := range m.ExportChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ExportChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExportChatCounter := mm_atomic.LoadUint64(&m.afterExportChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportChatMock.defaultExpectation != nil && afterExportChatCounter < 1 {
+		if m.ExportChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ExportChat at\n%s", m.ExportChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ExportChat at\n%s with params: %#v", m.ExportChatMock.defaultExpectation.expectationOrigins.origin, *m.ExportChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExportChat != nil && afterExportChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ExportChat at\n%s", m.funcExportChatOrigin)
+	}
+
+	if !m.ExportChatMock.invocationsDone() && afterExportChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ExportChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportChatMock.expectedInvocations), m.ExportChatMock.expectedInvocationsOrigin, afterExportChatCounter)
+	}
+}
+
+type mChatServiceMockGet struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetExpectation
+	expectations       []*ChatServiceMockGetExpectation
+
+	callArgs []*ChatServiceMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockGetExpectation specifies expectation struct of the ChatService.Get
+type ChatServiceMockGetExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockGetParams
+	paramPtrs          *ChatServiceMockGetParamPtrs
+	expectationOrigins ChatServiceMockGetExpectationOrigins
+	results            *ChatServiceMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockGetParams contains parameters of the ChatService.Get
+type ChatServiceMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ChatServiceMockGetParamPtrs contains pointers to parameters of the ChatService.Get
+type ChatServiceMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ChatServiceMockGetResults contains results of the ChatService.Get
+type ChatServiceMockGetResults struct {
+	cp1 *model.Chat
+	err error
+}
+
+// ChatServiceMockGetOrigins contains origins of expectations of the ChatService.Get
+type ChatServiceMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mChatServiceMockGet) Optional() *mChatServiceMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for ChatService.Get
+func (mmGet *mChatServiceMockGet) Expect(ctx context.Context, id int64) *mChatServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ChatServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ChatServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("ChatServiceMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &ChatServiceMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.Get
+func (mmGet *mChatServiceMockGet) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ChatServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ChatServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("ChatServiceMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &ChatServiceMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for ChatService.Get
+func (mmGet *mChatServiceMockGet) ExpectIdParam2(id int64) *mChatServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ChatServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ChatServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("ChatServiceMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &ChatServiceMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.Get
+func (mmGet *mChatServiceMockGet) Inspect(f func(ctx context.Context, id int64)) *mChatServiceMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by ChatService.Get
+func (mmGet *mChatServiceMockGet) Return(cp1 *model.Chat, err error) *ChatServiceMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ChatServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ChatServiceMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &ChatServiceMockGetResults{cp1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the ChatService.Get method
+func (mmGet *mChatServiceMockGet) Set(f func(ctx context.Context, id int64) (cp1 *model.Chat, err error)) *ChatServiceMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the ChatService.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the ChatService.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the ChatService.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mChatServiceMockGet) When(ctx context.Context, id int64) *ChatServiceMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ChatServiceMock.Get mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &ChatServiceMockGetParams{ctx, id},
+		expectationOrigins: ChatServiceMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.Get return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetExpectation) Then(cp1 *model.Chat, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.Get should be invoked
+func (mmGet *mChatServiceMockGet) Times(n uint64) *mChatServiceMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of ChatServiceMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mChatServiceMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_service.ChatService
+func (mmGet *ChatServiceMock) Get(ctx context.Context, id int64) (cp1 *model.Chat, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := ChatServiceMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
 		mm_want := mmGet.GetMock.defaultExpectation.params
 		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockGetParams{ctx, id}
+		mm_got := ChatServiceMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("ChatServiceMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("ChatServiceMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("ChatServiceMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the ChatServiceMock.Get")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to ChatServiceMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished ChatServiceMock.Get invocations
+func (mmGet *ChatServiceMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of ChatServiceMock.Get invocations
+func (mmGet *ChatServiceMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mChatServiceMockGet) Calls() []*ChatServiceMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mChatServiceMockGetDeliveryCursor struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetDeliveryCursorExpectation
+	expectations       []*ChatServiceMockGetDeliveryCursorExpectation
+
+	callArgs []*ChatServiceMockGetDeliveryCursorParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockGetDeliveryCursorExpectation specifies expectation struct of the ChatService.GetDeliveryCursor
+type ChatServiceMockGetDeliveryCursorExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockGetDeliveryCursorParams
+	paramPtrs          *ChatServiceMockGetDeliveryCursorParamPtrs
+	expectationOrigins ChatServiceMockGetDeliveryCursorExpectationOrigins
+	results            *ChatServiceMockGetDeliveryCursorResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockGetDeliveryCursorParams contains parameters of the ChatService.GetDeliveryCursor
+type ChatServiceMockGetDeliveryCursorParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+}
+
+// ChatServiceMockGetDeliveryCursorParamPtrs contains pointers to parameters of the ChatService.GetDeliveryCursor
+type ChatServiceMockGetDeliveryCursorParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+}
+
+// ChatServiceMockGetDeliveryCursorResults contains results of the ChatService.GetDeliveryCursor
+type ChatServiceMockGetDeliveryCursorResults struct {
+	dp1 *model.DeliveryCursor
+	err error
+}
+
+// ChatServiceMockGetDeliveryCursorOrigins contains origins of expectations of the ChatService.GetDeliveryCursor
+type ChatServiceMockGetDeliveryCursorExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetDeliveryCursor *mChatServiceMockGetDeliveryCursor) Optional() *mChatServiceMockGetDeliveryCursor {
+	mmGetDeliveryCursor.optional = true
+	return mmGetDeliveryCursor
+}
+
+// Expect sets up expected params for ChatService.GetDeliveryCursor
+func (mmGetDeliveryCursor *mChatServiceMockGetDeliveryCursor) Expect(ctx context.Context, chatID int64, username string) *mChatServiceMockGetDeliveryCursor {
+	if mmGetDeliveryCursor.mock.funcGetDeliveryCursor != nil {
+		mmGetDeliveryCursor.mock.t.Fatalf("ChatServiceMock.GetDeliveryCursor mock is already set by Set")
+	}
+
+	if mmGetDeliveryCursor.defaultExpectation == nil {
+		mmGetDeliveryCursor.defaultExpectation = &ChatServiceMockGetDeliveryCursorExpectation{}
+	}
+
+	if mmGetDeliveryCursor.defaultExpectation.paramPtrs != nil {
+		mmGetDeliveryCursor.mock.t.Fatalf("ChatServiceMock.GetDeliveryCursor mock is already set by ExpectParams functions")
+	}
+
+	mmGetDeliveryCursor.defaultExpectation.params = &ChatServiceMockGetDeliveryCursorParams{ctx, chatID, username}
+	mmGetDeliveryCursor.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetDeliveryCursor.expectations {
+		if minimock.Equal(e.params, mmGetDeliveryCursor.defaultExpectation.params) {
+			mmGetDeliveryCursor.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDeliveryCursor.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDeliveryCursor
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetDeliveryCursor
+func (mmGetDeliveryCursor *mChatServiceMockGetDeliveryCursor) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetDeliveryCursor {
+	if mmGetDeliveryCursor.mock.funcGetDeliveryCursor != nil {
+		mmGetDeliveryCursor.mock.t.Fatalf("ChatServiceMock.GetDeliveryCursor mock is already set by Set")
+	}
+
+	if mmGetDeliveryCursor.defaultExpectation == nil {
+		mmGetDeliveryCursor.defaultExpectation = &ChatServiceMockGetDeliveryCursorExpectation{}
+	}
+
+	if mmGetDeliveryCursor.defaultExpectation.params != nil {
+		mmGetDeliveryCursor.mock.t.Fatalf("ChatServiceMock.GetDeliveryCursor mock is already set by Expect")
+	}
+
+	if mmGetDeliveryCursor.defaultExpectation.paramPtrs == nil {
+		mmGetDeliveryCursor.defaultExpectation.paramPtrs = &ChatServiceMockGetDeliveryCursorParamPtrs{}
+	}
+	mmGetDeliveryCursor.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetDeliveryCursor.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetDeliveryCursor
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.GetDeliveryCursor
+func (mmGetDeliveryCursor *mChatServiceMockGetDeliveryCursor) ExpectChatIDParam2(chatID int64) *mChatServiceMockGetDeliveryCursor {
+	if mmGetDeliveryCursor.mock.funcGetDeliveryCursor != nil {
+		mmGetDeliveryCursor.mock.t.Fatalf("ChatServiceMock.GetDeliveryCursor mock is already set by Set")
+	}
+
+	if mmGetDeliveryCursor.defaultExpectation == nil {
+		mmGetDeliveryCursor.defaultExpectation = &ChatServiceMockGetDeliveryCursorExpectation{}
+	}
+
+	if mmGetDeliveryCursor.defaultExpectation.params != nil {
+		mmGetDeliveryCursor.mock.t.Fatalf("ChatServiceMock.GetDeliveryCursor mock is already set by Expect")
+	}
+
+	if mmGetDeliveryCursor.defaultExpectation.paramPtrs == nil {
+		mmGetDeliveryCursor.defaultExpectation.paramPtrs = &ChatServiceMockGetDeliveryCursorParamPtrs{}
+	}
+	mmGetDeliveryCursor.defaultExpectation.paramPtrs.chatID = &chatID
+	mmGetDeliveryCursor.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmGetDeliveryCursor
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatService.GetDeliveryCursor
+func (mmGetDeliveryCursor *mChatServiceMockGetDeliveryCursor) ExpectUsernameParam3(username string) *mChatServiceMockGetDeliveryCursor {
+	if mmGetDeliveryCursor.mock.funcGetDeliveryCursor != nil {
+		mmGetDeliveryCursor.mock.t.Fatalf("ChatServiceMock.GetDeliveryCursor mock is already set by Set")
+	}
+
+	if mmGetDeliveryCursor.defaultExpectation == nil {
+		mmGetDeliveryCursor.defaultExpectation = &ChatServiceMockGetDeliveryCursorExpectation{}
+	}
+
+	if mmGetDeliveryCursor.defaultExpectation.params != nil {
+		mmGetDeliveryCursor.mock.t.Fatalf("ChatServiceMock.GetDeliveryCursor mock is already set by Expect")
+	}
+
+	if mmGetDeliveryCursor.defaultExpectation.paramPtrs == nil {
+		mmGetDeliveryCursor.defaultExpectation.paramPtrs = &ChatServiceMockGetDeliveryCursorParamPtrs{}
+	}
+	mmGetDeliveryCursor.defaultExpectation.paramPtrs.username = &username
+	mmGetDeliveryCursor.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetDeliveryCursor
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetDeliveryCursor
+func (mmGetDeliveryCursor *mChatServiceMockGetDeliveryCursor) Inspect(f func(ctx context.Context, chatID int64, username string)) *mChatServiceMockGetDeliveryCursor {
+	if mmGetDeliveryCursor.mock.inspectFuncGetDeliveryCursor != nil {
+		mmGetDeliveryCursor.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetDeliveryCursor")
+	}
+
+	mmGetDeliveryCursor.mock.inspectFuncGetDeliveryCursor = f
+
+	return mmGetDeliveryCursor
+}
+
+// Return sets up results that will be returned by ChatService.GetDeliveryCursor
+func (mmGetDeliveryCursor *mChatServiceMockGetDeliveryCursor) Return(dp1 *model.DeliveryCursor, err error) *ChatServiceMock {
+	if mmGetDeliveryCursor.mock.funcGetDeliveryCursor != nil {
+		mmGetDeliveryCursor.mock.t.Fatalf("ChatServiceMock.GetDeliveryCursor mock is already set by Set")
+	}
+
+	if mmGetDeliveryCursor.defaultExpectation == nil {
+		mmGetDeliveryCursor.defaultExpectation = &ChatServiceMockGetDeliveryCursorExpectation{mock: mmGetDeliveryCursor.mock}
+	}
+	mmGetDeliveryCursor.defaultExpectation.results = &ChatServiceMockGetDeliveryCursorResults{dp1, err}
+	mmGetDeliveryCursor.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetDeliveryCursor.mock
+}
+
+// Set uses given function f to mock the ChatService.GetDeliveryCursor method
+func (mmGetDeliveryCursor *mChatServiceMockGetDeliveryCursor) Set(f func(ctx context.Context, chatID int64, username string) (dp1 *model.DeliveryCursor, err error)) *ChatServiceMock {
+	if mmGetDeliveryCursor.defaultExpectation != nil {
+		mmGetDeliveryCursor.mock.t.Fatalf("Default expectation is already set for the ChatService.GetDeliveryCursor method")
+	}
+
+	if len(mmGetDeliveryCursor.expectations) > 0 {
+		mmGetDeliveryCursor.mock.t.Fatalf("Some expectations are already set for the ChatService.GetDeliveryCursor method")
+	}
+
+	mmGetDeliveryCursor.mock.funcGetDeliveryCursor = f
+	mmGetDeliveryCursor.mock.funcGetDeliveryCursorOrigin = minimock.CallerInfo(1)
+	return mmGetDeliveryCursor.mock
+}
+
+// When sets expectation for the ChatService.GetDeliveryCursor which will trigger the result defined by the following
+// Then helper
+func (mmGetDeliveryCursor *mChatServiceMockGetDeliveryCursor) When(ctx context.Context, chatID int64, username string) *ChatServiceMockGetDeliveryCursorExpectation {
+	if mmGetDeliveryCursor.mock.funcGetDeliveryCursor != nil {
+		mmGetDeliveryCursor.mock.t.Fatalf("ChatServiceMock.GetDeliveryCursor mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetDeliveryCursorExpectation{
+		mock:               mmGetDeliveryCursor.mock,
+		params:             &ChatServiceMockGetDeliveryCursorParams{ctx, chatID, username},
+		expectationOrigins: ChatServiceMockGetDeliveryCursorExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetDeliveryCursor.expectations = append(mmGetDeliveryCursor.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetDeliveryCursor return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetDeliveryCursorExpectation) Then(dp1 *model.DeliveryCursor, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetDeliveryCursorResults{dp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetDeliveryCursor should be invoked
+func (mmGetDeliveryCursor *mChatServiceMockGetDeliveryCursor) Times(n uint64) *mChatServiceMockGetDeliveryCursor {
+	if n == 0 {
+		mmGetDeliveryCursor.mock.t.Fatalf("Times of ChatServiceMock.GetDeliveryCursor mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetDeliveryCursor.expectedInvocations, n)
+	mmGetDeliveryCursor.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetDeliveryCursor
+}
+
+func (mmGetDeliveryCursor *mChatServiceMockGetDeliveryCursor) invocationsDone() bool {
+	if len(mmGetDeliveryCursor.expectations) == 0 && mmGetDeliveryCursor.defaultExpectation == nil && mmGetDeliveryCursor.mock.funcGetDeliveryCursor == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetDeliveryCursor.mock.afterGetDeliveryCursorCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetDeliveryCursor.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetDeliveryCursor implements mm_service.ChatService
+func (mmGetDeliveryCursor *ChatServiceMock) GetDeliveryCursor(ctx context.Context, chatID int64, username string) (dp1 *model.DeliveryCursor, err error) {
+	mm_atomic.AddUint64(&mmGetDeliveryCursor.beforeGetDeliveryCursorCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDeliveryCursor.afterGetDeliveryCursorCounter, 1)
+
+	mmGetDeliveryCursor.t.Helper()
+
+	if mmGetDeliveryCursor.inspectFuncGetDeliveryCursor != nil {
+		mmGetDeliveryCursor.inspectFuncGetDeliveryCursor(ctx, chatID, username)
+	}
+
+	mm_params := ChatServiceMockGetDeliveryCursorParams{ctx, chatID, username}
+
+	// Record call args
+	mmGetDeliveryCursor.GetDeliveryCursorMock.mutex.Lock()
+	mmGetDeliveryCursor.GetDeliveryCursorMock.callArgs = append(mmGetDeliveryCursor.GetDeliveryCursorMock.callArgs, &mm_params)
+	mmGetDeliveryCursor.GetDeliveryCursorMock.mutex.Unlock()
+
+	for _, e := range mmGetDeliveryCursor.GetDeliveryCursorMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.dp1, e.results.err
+		}
+	}
+
+	if mmGetDeliveryCursor.GetDeliveryCursorMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDeliveryCursor.GetDeliveryCursorMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDeliveryCursor.GetDeliveryCursorMock.defaultExpectation.params
+		mm_want_ptrs := mmGetDeliveryCursor.GetDeliveryCursorMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetDeliveryCursorParams{ctx, chatID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetDeliveryCursor.t.Errorf("ChatServiceMock.GetDeliveryCursor got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDeliveryCursor.GetDeliveryCursorMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmGetDeliveryCursor.t.Errorf("ChatServiceMock.GetDeliveryCursor got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDeliveryCursor.GetDeliveryCursorMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetDeliveryCursor.t.Errorf("ChatServiceMock.GetDeliveryCursor got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDeliveryCursor.GetDeliveryCursorMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDeliveryCursor.t.Errorf("ChatServiceMock.GetDeliveryCursor got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetDeliveryCursor.GetDeliveryCursorMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDeliveryCursor.GetDeliveryCursorMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDeliveryCursor.t.Fatal("No results are set for the ChatServiceMock.GetDeliveryCursor")
+		}
+		return (*mm_results).dp1, (*mm_results).err
+	}
+	if mmGetDeliveryCursor.funcGetDeliveryCursor != nil {
+		return mmGetDeliveryCursor.funcGetDeliveryCursor(ctx, chatID, username)
+	}
+	mmGetDeliveryCursor.t.Fatalf("Unexpected call to ChatServiceMock.GetDeliveryCursor. %v %v %v", ctx, chatID, username)
+	return
+}
+
+// GetDeliveryCursorAfterCounter returns a count of finished ChatServiceMock.GetDeliveryCursor invocations
+func (mmGetDeliveryCursor *ChatServiceMock) GetDeliveryCursorAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDeliveryCursor.afterGetDeliveryCursorCounter)
+}
+
+// GetDeliveryCursorBeforeCounter returns a count of ChatServiceMock.GetDeliveryCursor invocations
+func (mmGetDeliveryCursor *ChatServiceMock) GetDeliveryCursorBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDeliveryCursor.beforeGetDeliveryCursorCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetDeliveryCursor.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDeliveryCursor *mChatServiceMockGetDeliveryCursor) Calls() []*ChatServiceMockGetDeliveryCursorParams {
+	mmGetDeliveryCursor.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetDeliveryCursorParams, len(mmGetDeliveryCursor.callArgs))
+	copy(argCopy, mmGetDeliveryCursor.callArgs)
+
+	mmGetDeliveryCursor.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDeliveryCursorDone returns true if the count of the GetDeliveryCursor invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetDeliveryCursorDone() bool {
+	if m.GetDeliveryCursorMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetDeliveryCursorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetDeliveryCursorMock.invocationsDone()
+}
+
+// MinimockGetDeliveryCursorInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetDeliveryCursorInspect() {
+	for _, e := range m.GetDeliveryCursorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetDeliveryCursor at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetDeliveryCursorCounter := mm_atomic.LoadUint64(&m.afterGetDeliveryCursorCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDeliveryCursorMock.defaultExpectation != nil && afterGetDeliveryCursorCounter < 1 {
+		if m.GetDeliveryCursorMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.GetDeliveryCursor at\n%s", m.GetDeliveryCursorMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetDeliveryCursor at\n%s with params: %#v", m.GetDeliveryCursorMock.defaultExpectation.expectationOrigins.origin, *m.GetDeliveryCursorMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDeliveryCursor != nil && afterGetDeliveryCursorCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.GetDeliveryCursor at\n%s", m.funcGetDeliveryCursorOrigin)
+	}
+
+	if !m.GetDeliveryCursorMock.invocationsDone() && afterGetDeliveryCursorCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetDeliveryCursor at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetDeliveryCursorMock.expectedInvocations), m.GetDeliveryCursorMock.expectedInvocationsOrigin, afterGetDeliveryCursorCounter)
+	}
+}
+
+type mChatServiceMockGetDeliveryStatus struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetDeliveryStatusExpectation
+	expectations       []*ChatServiceMockGetDeliveryStatusExpectation
+
+	callArgs []*ChatServiceMockGetDeliveryStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockGetDeliveryStatusExpectation specifies expectation struct of the ChatService.GetDeliveryStatus
+type ChatServiceMockGetDeliveryStatusExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockGetDeliveryStatusParams
+	paramPtrs          *ChatServiceMockGetDeliveryStatusParamPtrs
+	expectationOrigins ChatServiceMockGetDeliveryStatusExpectationOrigins
+	results            *ChatServiceMockGetDeliveryStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockGetDeliveryStatusParams contains parameters of the ChatService.GetDeliveryStatus
+type ChatServiceMockGetDeliveryStatusParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID int64
+}
+
+// ChatServiceMockGetDeliveryStatusParamPtrs contains pointers to parameters of the ChatService.GetDeliveryStatus
+type ChatServiceMockGetDeliveryStatusParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *int64
+}
+
+// ChatServiceMockGetDeliveryStatusResults contains results of the ChatService.GetDeliveryStatus
+type ChatServiceMockGetDeliveryStatusResults struct {
+	mpa1 []*model.MemberDelivery
+	err  error
+}
+
+// ChatServiceMockGetDeliveryStatusOrigins contains origins of expectations of the ChatService.GetDeliveryStatus
+type ChatServiceMockGetDeliveryStatusExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetDeliveryStatus *mChatServiceMockGetDeliveryStatus) Optional() *mChatServiceMockGetDeliveryStatus {
+	mmGetDeliveryStatus.optional = true
+	return mmGetDeliveryStatus
+}
+
+// Expect sets up expected params for ChatService.GetDeliveryStatus
+func (mmGetDeliveryStatus *mChatServiceMockGetDeliveryStatus) Expect(ctx context.Context, chatID int64, messageID int64) *mChatServiceMockGetDeliveryStatus {
+	if mmGetDeliveryStatus.mock.funcGetDeliveryStatus != nil {
+		mmGetDeliveryStatus.mock.t.Fatalf("ChatServiceMock.GetDeliveryStatus mock is already set by Set")
+	}
+
+	if mmGetDeliveryStatus.defaultExpectation == nil {
+		mmGetDeliveryStatus.defaultExpectation = &ChatServiceMockGetDeliveryStatusExpectation{}
+	}
+
+	if mmGetDeliveryStatus.defaultExpectation.paramPtrs != nil {
+		mmGetDeliveryStatus.mock.t.Fatalf("ChatServiceMock.GetDeliveryStatus mock is already set by ExpectParams functions")
+	}
+
+	mmGetDeliveryStatus.defaultExpectation.params = &ChatServiceMockGetDeliveryStatusParams{ctx, chatID, messageID}
+	mmGetDeliveryStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetDeliveryStatus.expectations {
+		if minimock.Equal(e.params, mmGetDeliveryStatus.defaultExpectation.params) {
+			mmGetDeliveryStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDeliveryStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDeliveryStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetDeliveryStatus
+func (mmGetDeliveryStatus *mChatServiceMockGetDeliveryStatus) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetDeliveryStatus {
+	if mmGetDeliveryStatus.mock.funcGetDeliveryStatus != nil {
+		mmGetDeliveryStatus.mock.t.Fatalf("ChatServiceMock.GetDeliveryStatus mock is already set by Set")
+	}
+
+	if mmGetDeliveryStatus.defaultExpectation == nil {
+		mmGetDeliveryStatus.defaultExpectation = &ChatServiceMockGetDeliveryStatusExpectation{}
+	}
+
+	if mmGetDeliveryStatus.defaultExpectation.params != nil {
+		mmGetDeliveryStatus.mock.t.Fatalf("ChatServiceMock.GetDeliveryStatus mock is already set by Expect")
+	}
+
+	if mmGetDeliveryStatus.defaultExpectation.paramPtrs == nil {
+		mmGetDeliveryStatus.defaultExpectation.paramPtrs = &ChatServiceMockGetDeliveryStatusParamPtrs{}
+	}
+	mmGetDeliveryStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetDeliveryStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetDeliveryStatus
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.GetDeliveryStatus
+func (mmGetDeliveryStatus *mChatServiceMockGetDeliveryStatus) ExpectChatIDParam2(chatID int64) *mChatServiceMockGetDeliveryStatus {
+	if mmGetDeliveryStatus.mock.funcGetDeliveryStatus != nil {
+		mmGetDeliveryStatus.mock.t.Fatalf("ChatServiceMock.GetDeliveryStatus mock is already set by Set")
+	}
+
+	if mmGetDeliveryStatus.defaultExpectation == nil {
+		mmGetDeliveryStatus.defaultExpectation = &ChatServiceMockGetDeliveryStatusExpectation{}
+	}
+
+	if mmGetDeliveryStatus.defaultExpectation.params != nil {
+		mmGetDeliveryStatus.mock.t.Fatalf("ChatServiceMock.GetDeliveryStatus mock is already set by Expect")
+	}
+
+	if mmGetDeliveryStatus.defaultExpectation.paramPtrs == nil {
+		mmGetDeliveryStatus.defaultExpectation.paramPtrs = &ChatServiceMockGetDeliveryStatusParamPtrs{}
+	}
+	mmGetDeliveryStatus.defaultExpectation.paramPtrs.chatID = &chatID
+	mmGetDeliveryStatus.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmGetDeliveryStatus
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.GetDeliveryStatus
+func (mmGetDeliveryStatus *mChatServiceMockGetDeliveryStatus) ExpectMessageIDParam3(messageID int64) *mChatServiceMockGetDeliveryStatus {
+	if mmGetDeliveryStatus.mock.funcGetDeliveryStatus != nil {
+		mmGetDeliveryStatus.mock.t.Fatalf("ChatServiceMock.GetDeliveryStatus mock is already set by Set")
+	}
+
+	if mmGetDeliveryStatus.defaultExpectation == nil {
+		mmGetDeliveryStatus.defaultExpectation = &ChatServiceMockGetDeliveryStatusExpectation{}
+	}
+
+	if mmGetDeliveryStatus.defaultExpectation.params != nil {
+		mmGetDeliveryStatus.mock.t.Fatalf("ChatServiceMock.GetDeliveryStatus mock is already set by Expect")
+	}
+
+	if mmGetDeliveryStatus.defaultExpectation.paramPtrs == nil {
+		mmGetDeliveryStatus.defaultExpectation.paramPtrs = &ChatServiceMockGetDeliveryStatusParamPtrs{}
+	}
+	mmGetDeliveryStatus.defaultExpectation.paramPtrs.messageID = &messageID
+	mmGetDeliveryStatus.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmGetDeliveryStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetDeliveryStatus
+func (mmGetDeliveryStatus *mChatServiceMockGetDeliveryStatus) Inspect(f func(ctx context.Context, chatID int64, messageID int64)) *mChatServiceMockGetDeliveryStatus {
+	if mmGetDeliveryStatus.mock.inspectFuncGetDeliveryStatus != nil {
+		mmGetDeliveryStatus.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetDeliveryStatus")
+	}
+
+	mmGetDeliveryStatus.mock.inspectFuncGetDeliveryStatus = f
+
+	return mmGetDeliveryStatus
+}
+
+// Return sets up results that will be returned by ChatService.GetDeliveryStatus
+func (mmGetDeliveryStatus *mChatServiceMockGetDeliveryStatus) Return(mpa1 []*model.MemberDelivery, err error) *ChatServiceMock {
+	if mmGetDeliveryStatus.mock.funcGetDeliveryStatus != nil {
+		mmGetDeliveryStatus.mock.t.Fatalf("ChatServiceMock.GetDeliveryStatus mock is already set by Set")
+	}
+
+	if mmGetDeliveryStatus.defaultExpectation == nil {
+		mmGetDeliveryStatus.defaultExpectation = &ChatServiceMockGetDeliveryStatusExpectation{mock: mmGetDeliveryStatus.mock}
+	}
+	mmGetDeliveryStatus.defaultExpectation.results = &ChatServiceMockGetDeliveryStatusResults{mpa1, err}
+	mmGetDeliveryStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetDeliveryStatus.mock
+}
+
+// Set uses given function f to mock the ChatService.GetDeliveryStatus method
+func (mmGetDeliveryStatus *mChatServiceMockGetDeliveryStatus) Set(f func(ctx context.Context, chatID int64, messageID int64) (mpa1 []*model.MemberDelivery, err error)) *ChatServiceMock {
+	if mmGetDeliveryStatus.defaultExpectation != nil {
+		mmGetDeliveryStatus.mock.t.Fatalf("Default expectation is already set for the ChatService.GetDeliveryStatus method")
+	}
+
+	if len(mmGetDeliveryStatus.expectations) > 0 {
+		mmGetDeliveryStatus.mock.t.Fatalf("Some expectations are already set for the ChatService.GetDeliveryStatus method")
+	}
+
+	mmGetDeliveryStatus.mock.funcGetDeliveryStatus = f
+	mmGetDeliveryStatus.mock.funcGetDeliveryStatusOrigin = minimock.CallerInfo(1)
+	return mmGetDeliveryStatus.mock
+}
+
+// When sets expectation for the ChatService.GetDeliveryStatus which will trigger the result defined by the following
+// Then helper
+func (mmGetDeliveryStatus *mChatServiceMockGetDeliveryStatus) When(ctx context.Context, chatID int64, messageID int64) *ChatServiceMockGetDeliveryStatusExpectation {
+	if mmGetDeliveryStatus.mock.funcGetDeliveryStatus != nil {
+		mmGetDeliveryStatus.mock.t.Fatalf("ChatServiceMock.GetDeliveryStatus mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetDeliveryStatusExpectation{
+		mock:               mmGetDeliveryStatus.mock,
+		params:             &ChatServiceMockGetDeliveryStatusParams{ctx, chatID, messageID},
+		expectationOrigins: ChatServiceMockGetDeliveryStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetDeliveryStatus.expectations = append(mmGetDeliveryStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetDeliveryStatus return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetDeliveryStatusExpectation) Then(mpa1 []*model.MemberDelivery, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetDeliveryStatusResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetDeliveryStatus should be invoked
+func (mmGetDeliveryStatus *mChatServiceMockGetDeliveryStatus) Times(n uint64) *mChatServiceMockGetDeliveryStatus {
+	if n == 0 {
+		mmGetDeliveryStatus.mock.t.Fatalf("Times of ChatServiceMock.GetDeliveryStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetDeliveryStatus.expectedInvocations, n)
+	mmGetDeliveryStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetDeliveryStatus
+}
+
+func (mmGetDeliveryStatus *mChatServiceMockGetDeliveryStatus) invocationsDone() bool {
+	if len(mmGetDeliveryStatus.expectations) == 0 && mmGetDeliveryStatus.defaultExpectation == nil && mmGetDeliveryStatus.mock.funcGetDeliveryStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetDeliveryStatus.mock.afterGetDeliveryStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetDeliveryStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetDeliveryStatus implements mm_service.ChatService
+func (mmGetDeliveryStatus *ChatServiceMock) GetDeliveryStatus(ctx context.Context, chatID int64, messageID int64) (mpa1 []*model.MemberDelivery, err error) {
+	mm_atomic.AddUint64(&mmGetDeliveryStatus.beforeGetDeliveryStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDeliveryStatus.afterGetDeliveryStatusCounter, 1)
+
+	mmGetDeliveryStatus.t.Helper()
+
+	if mmGetDeliveryStatus.inspectFuncGetDeliveryStatus != nil {
+		mmGetDeliveryStatus.inspectFuncGetDeliveryStatus(ctx, chatID, messageID)
+	}
+
+	mm_params := ChatServiceMockGetDeliveryStatusParams{ctx, chatID, messageID}
+
+	// Record call args
+	mmGetDeliveryStatus.GetDeliveryStatusMock.mutex.Lock()
+	mmGetDeliveryStatus.GetDeliveryStatusMock.callArgs = append(mmGetDeliveryStatus.GetDeliveryStatusMock.callArgs, &mm_params)
+	mmGetDeliveryStatus.GetDeliveryStatusMock.mutex.Unlock()
+
+	for _, e := range mmGetDeliveryStatus.GetDeliveryStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmGetDeliveryStatus.GetDeliveryStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDeliveryStatus.GetDeliveryStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDeliveryStatus.GetDeliveryStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmGetDeliveryStatus.GetDeliveryStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetDeliveryStatusParams{ctx, chatID, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetDeliveryStatus.t.Errorf("ChatServiceMock.GetDeliveryStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDeliveryStatus.GetDeliveryStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmGetDeliveryStatus.t.Errorf("ChatServiceMock.GetDeliveryStatus got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDeliveryStatus.GetDeliveryStatusMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmGetDeliveryStatus.t.Errorf("ChatServiceMock.GetDeliveryStatus got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDeliveryStatus.GetDeliveryStatusMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDeliveryStatus.t.Errorf("ChatServiceMock.GetDeliveryStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetDeliveryStatus.GetDeliveryStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDeliveryStatus.GetDeliveryStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDeliveryStatus.t.Fatal("No results are set for the ChatServiceMock.GetDeliveryStatus")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmGetDeliveryStatus.funcGetDeliveryStatus != nil {
+		return mmGetDeliveryStatus.funcGetDeliveryStatus(ctx, chatID, messageID)
+	}
+	mmGetDeliveryStatus.t.Fatalf("Unexpected call to ChatServiceMock.GetDeliveryStatus. %v %v %v", ctx, chatID, messageID)
+	return
+}
+
+// GetDeliveryStatusAfterCounter returns a count of finished ChatServiceMock.GetDeliveryStatus invocations
+func (mmGetDeliveryStatus *ChatServiceMock) GetDeliveryStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDeliveryStatus.afterGetDeliveryStatusCounter)
+}
+
+// GetDeliveryStatusBeforeCounter returns a count of ChatServiceMock.GetDeliveryStatus invocations
+func (mmGetDeliveryStatus *ChatServiceMock) GetDeliveryStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDeliveryStatus.beforeGetDeliveryStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetDeliveryStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDeliveryStatus *mChatServiceMockGetDeliveryStatus) Calls() []*ChatServiceMockGetDeliveryStatusParams {
+	mmGetDeliveryStatus.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetDeliveryStatusParams, len(mmGetDeliveryStatus.callArgs))
+	copy(argCopy, mmGetDeliveryStatus.callArgs)
+
+	mmGetDeliveryStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDeliveryStatusDone returns true if the count of the GetDeliveryStatus invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetDeliveryStatusDone() bool {
+	if m.GetDeliveryStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetDeliveryStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetDeliveryStatusMock.invocationsDone()
+}
+
+// MinimockGetDeliveryStatusInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetDeliveryStatusInspect() {
+	for _, e := range m.GetDeliveryStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetDeliveryStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetDeliveryStatusCounter := mm_atomic.LoadUint64(&m.afterGetDeliveryStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDeliveryStatusMock.defaultExpectation != nil && afterGetDeliveryStatusCounter < 1 {
+		if m.GetDeliveryStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.GetDeliveryStatus at\n%s", m.GetDeliveryStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetDeliveryStatus at\n%s with params: %#v", m.GetDeliveryStatusMock.defaultExpectation.expectationOrigins.origin, *m.GetDeliveryStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDeliveryStatus != nil && afterGetDeliveryStatusCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.GetDeliveryStatus at\n%s", m.funcGetDeliveryStatusOrigin)
+	}
+
+	if !m.GetDeliveryStatusMock.invocationsDone() && afterGetDeliveryStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetDeliveryStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetDeliveryStatusMock.expectedInvocations), m.GetDeliveryStatusMock.expectedInvocationsOrigin, afterGetDeliveryStatusCounter)
+	}
+}
+
+type mChatServiceMockImportChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockImportChatExpectation
+	expectations       []*ChatServiceMockImportChatExpectation
+
+	callArgs []*ChatServiceMockImportChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockImportChatExpectation specifies expectation struct of the ChatService.ImportChat
+type ChatServiceMockImportChatExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockImportChatParams
+	paramPtrs          *ChatServiceMockImportChatParamPtrs
+	expectationOrigins ChatServiceMockImportChatExpectationOrigins
+	results            *ChatServiceMockImportChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockImportChatParams contains parameters of the ChatService.ImportChat
+type ChatServiceMockImportChatParams struct {
+	ctx  context.Context
+	next func() (*model.ImportLine, error)
+}
+
+// ChatServiceMockImportChatParamPtrs contains pointers to parameters of the ChatService.ImportChat
+type ChatServiceMockImportChatParamPtrs struct {
+	ctx  *context.Context
+	next *func() (*model.ImportLine, error)
+}
+
+// ChatServiceMockImportChatResults contains results of the ChatService.ImportChat
+type ChatServiceMockImportChatResults struct {
+	ip1 *model.ImportReport
+	err error
+}
+
+// ChatServiceMockImportChatOrigins contains origins of expectations of the ChatService.ImportChat
+type ChatServiceMockImportChatExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originNext string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmImportChat *mChatServiceMockImportChat) Optional() *mChatServiceMockImportChat {
+	mmImportChat.optional = true
+	return mmImportChat
+}
+
+// Expect sets up expected params for ChatService.ImportChat
+func (mmImportChat *mChatServiceMockImportChat) Expect(ctx context.Context, next func() (*model.ImportLine, error)) *mChatServiceMockImportChat {
+	if mmImportChat.mock.funcImportChat != nil {
+		mmImportChat.mock.t.Fatalf("ChatServiceMock.ImportChat mock is already set by Set")
+	}
+
+	if mmImportChat.defaultExpectation == nil {
+		mmImportChat.defaultExpectation = &ChatServiceMockImportChatExpectation{}
+	}
+
+	if mmImportChat.defaultExpectation.paramPtrs != nil {
+		mmImportChat.mock.t.Fatalf("ChatServiceMock.ImportChat mock is already set by ExpectParams functions")
+	}
+
+	mmImportChat.defaultExpectation.params = &ChatServiceMockImportChatParams{ctx, next}
+	mmImportChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmImportChat.expectations {
+		if minimock.Equal(e.params, mmImportChat.defaultExpectation.params) {
+			mmImportChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmImportChat.defaultExpectation.params)
+		}
+	}
+
+	return mmImportChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ImportChat
+func (mmImportChat *mChatServiceMockImportChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockImportChat {
+	if mmImportChat.mock.funcImportChat != nil {
+		mmImportChat.mock.t.Fatalf("ChatServiceMock.ImportChat mock is already set by Set")
+	}
+
+	if mmImportChat.defaultExpectation == nil {
+		mmImportChat.defaultExpectation = &ChatServiceMockImportChatExpectation{}
+	}
+
+	if mmImportChat.defaultExpectation.params != nil {
+		mmImportChat.mock.t.Fatalf("ChatServiceMock.ImportChat mock is already set by Expect")
+	}
+
+	if mmImportChat.defaultExpectation.paramPtrs == nil {
+		mmImportChat.defaultExpectation.paramPtrs = &ChatServiceMockImportChatParamPtrs{}
+	}
+	mmImportChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmImportChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmImportChat
+}
+
+// ExpectNextParam2 sets up expected param next for ChatService.ImportChat
+func (mmImportChat *mChatServiceMockImportChat) ExpectNextParam2(next func() (*model.ImportLine, error)) *mChatServiceMockImportChat {
+	if mmImportChat.mock.funcImportChat != nil {
+		mmImportChat.mock.t.Fatalf("ChatServiceMock.ImportChat mock is already set by Set")
+	}
+
+	if mmImportChat.defaultExpectation == nil {
+		mmImportChat.defaultExpectation = &ChatServiceMockImportChatExpectation{}
+	}
+
+	if mmImportChat.defaultExpectation.params != nil {
+		mmImportChat.mock.t.Fatalf("ChatServiceMock.ImportChat mock is already set by Expect")
+	}
+
+	if mmImportChat.defaultExpectation.paramPtrs == nil {
+		mmImportChat.defaultExpectation.paramPtrs = &ChatServiceMockImportChatParamPtrs{}
+	}
+	mmImportChat.defaultExpectation.paramPtrs.next = &next
+	mmImportChat.defaultExpectation.expectationOrigins.originNext = minimock.CallerInfo(1)
+
+	return mmImportChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ImportChat
+func (mmImportChat *mChatServiceMockImportChat) Inspect(f func(ctx context.Context, next func() (*model.ImportLine, error))) *mChatServiceMockImportChat {
+	if mmImportChat.mock.inspectFuncImportChat != nil {
+		mmImportChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ImportChat")
+	}
+
+	mmImportChat.mock.inspectFuncImportChat = f
+
+	return mmImportChat
+}
+
+// Return sets up results that will be returned by ChatService.ImportChat
+func (mmImportChat *mChatServiceMockImportChat) Return(ip1 *model.ImportReport, err error) *ChatServiceMock {
+	if mmImportChat.mock.funcImportChat != nil {
+		mmImportChat.mock.t.Fatalf("ChatServiceMock.ImportChat mock is already set by Set")
+	}
+
+	if mmImportChat.defaultExpectation == nil {
+		mmImportChat.defaultExpectation = &ChatServiceMockImportChatExpectation{mock: mmImportChat.mock}
+	}
+	mmImportChat.defaultExpectation.results = &ChatServiceMockImportChatResults{ip1, err}
+	mmImportChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmImportChat.mock
+}
+
+// Set uses given function f to mock the ChatService.ImportChat method
+func (mmImportChat *mChatServiceMockImportChat) Set(f func(ctx context.Context, next func() (*model.ImportLine, error)) (ip1 *model.ImportReport, err error)) *ChatServiceMock {
+	if mmImportChat.defaultExpectation != nil {
+		mmImportChat.mock.t.Fatalf("Default expectation is already set for the ChatService.ImportChat method")
+	}
+
+	if len(mmImportChat.expectations) > 0 {
+		mmImportChat.mock.t.Fatalf("Some expectations are already set for the ChatService.ImportChat method")
+	}
+
+	mmImportChat.mock.funcImportChat = f
+	mmImportChat.mock.funcImportChatOrigin = minimock.CallerInfo(1)
+	return mmImportChat.mock
+}
+
+// When sets expectation for the ChatService.ImportChat which will trigger the result defined by the following
+// Then helper
+func (mmImportChat *mChatServiceMockImportChat) When(ctx context.Context, next func() (*model.ImportLine, error)) *ChatServiceMockImportChatExpectation {
+	if mmImportChat.mock.funcImportChat != nil {
+		mmImportChat.mock.t.Fatalf("ChatServiceMock.ImportChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockImportChatExpectation{
+		mock:               mmImportChat.mock,
+		params:             &ChatServiceMockImportChatParams{ctx, next},
+		expectationOrigins: ChatServiceMockImportChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmImportChat.expectations = append(mmImportChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ImportChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockImportChatExpectation) Then(ip1 *model.ImportReport, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockImportChatResults{ip1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ImportChat should be invoked
+func (mmImportChat *mChatServiceMockImportChat) Times(n uint64) *mChatServiceMockImportChat {
+	if n == 0 {
+		mmImportChat.mock.t.Fatalf("Times of ChatServiceMock.ImportChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmImportChat.expectedInvocations, n)
+	mmImportChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmImportChat
+}
+
+func (mmImportChat *mChatServiceMockImportChat) invocationsDone() bool {
+	if len(mmImportChat.expectations) == 0 && mmImportChat.defaultExpectation == nil && mmImportChat.mock.funcImportChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmImportChat.mock.afterImportChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmImportChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ImportChat implements mm_service.ChatService
+func (mmImportChat *ChatServiceMock) ImportChat(ctx context.Context, next func() (*model.ImportLine, error)) (ip1 *model.ImportReport, err error) {
+	mm_atomic.AddUint64(&mmImportChat.beforeImportChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmImportChat.afterImportChatCounter, 1)
+
+	mmImportChat.t.Helper()
+
+	if mmImportChat.inspectFuncImportChat != nil {
+		mmImportChat.inspectFuncImportChat(ctx, next)
+	}
+
+	mm_params := ChatServiceMockImportChatParams{ctx, next}
+
+	// Record call args
+	mmImportChat.ImportChatMock.mutex.Lock()
+	mmImportChat.ImportChatMock.callArgs = append(mmImportChat.ImportChatMock.callArgs, &mm_params)
+	mmImportChat.ImportChatMock.mutex.Unlock()
+
+	for _, e := range mmImportChat.ImportChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ip1, e.results.err
+		}
+	}
+
+	if mmImportChat.ImportChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmImportChat.ImportChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmImportChat.ImportChatMock.defaultExpectation.params
+		mm_want_ptrs := mmImportChat.ImportChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockImportChatParams{ctx, next}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmImportChat.t.Errorf("ChatServiceMock.ImportChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportChat.ImportChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.next != nil && !minimock.Equal(*mm_want_ptrs.next, mm_got.next) {
+				mmImportChat.t.Errorf("ChatServiceMock.ImportChat got unexpected parameter next, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportChat.ImportChatMock.defaultExpectation.expectationOrigins.originNext, *mm_want_ptrs.next, mm_got.next, minimock.Diff(*mm_want_ptrs.next, mm_got.next))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmImportChat.t.Errorf("ChatServiceMock.ImportChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmImportChat.ImportChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmImportChat.ImportChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmImportChat.t.Fatal("No results are set for the ChatServiceMock.ImportChat")
+		}
+		return (*mm_results).ip1, (*mm_results).err
+	}
+	if mmImportChat.funcImportChat != nil {
+		return mmImportChat.funcImportChat(ctx, next)
+	}
+	mmImportChat.t.Fatalf("Unexpected call to ChatServiceMock.ImportChat. %v %v", ctx, next)
+	return
+}
+
+// ImportChatAfterCounter returns a count of finished ChatServiceMock.ImportChat invocations
+func (mmImportChat *ChatServiceMock) ImportChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImportChat.afterImportChatCounter)
+}
+
+// ImportChatBeforeCounter returns a count of ChatServiceMock.ImportChat invocations
+func (mmImportChat *ChatServiceMock) ImportChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImportChat.beforeImportChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ImportChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmImportChat *mChatServiceMockImportChat) Calls() []*ChatServiceMockImportChatParams {
+	mmImportChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockImportChatParams, len(mmImportChat.callArgs))
+	copy(argCopy, mmImportChat.callArgs)
+
+	mmImportChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockImportChatDone returns true if the count of the ImportChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockImportChatDone() bool {
+	if m.ImportChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ImportChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ImportChatMock.invocationsDone()
+}
+
+// MinimockImportChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockImportChatInspect() {
+	for _, e := range m.ImportChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ImportChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterImportChatCounter := mm_atomic.LoadUint64(&m.afterImportChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ImportChatMock.defaultExpectation != nil && afterImportChatCounter < 1 {
+		if m.ImportChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ImportChat at\n%s", m.ImportChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ImportChat at\n%s with params: %#v", m.ImportChatMock.defaultExpectation.expectationOrigins.origin, *m.ImportChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcImportChat != nil && afterImportChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ImportChat at\n%s", m.funcImportChatOrigin)
+	}
+
+	if !m.ImportChatMock.invocationsDone() && afterImportChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ImportChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ImportChatMock.expectedInvocations), m.ImportChatMock.expectedInvocationsOrigin, afterImportChatCounter)
+	}
+}
+
+type mChatServiceMockListAfter struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListAfterExpectation
+	expectations       []*ChatServiceMockListAfterExpectation
+
+	callArgs []*ChatServiceMockListAfterParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListAfterExpectation specifies expectation struct of the ChatService.ListAfter
+type ChatServiceMockListAfterExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListAfterParams
+	paramPtrs          *ChatServiceMockListAfterParamPtrs
+	expectationOrigins ChatServiceMockListAfterExpectationOrigins
+	results            *ChatServiceMockListAfterResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListAfterParams contains parameters of the ChatService.ListAfter
+type ChatServiceMockListAfterParams struct {
+	ctx     context.Context
+	chatID  int64
+	afterID int64
+	limit   uint64
+}
+
+// ChatServiceMockListAfterParamPtrs contains pointers to parameters of the ChatService.ListAfter
+type ChatServiceMockListAfterParamPtrs struct {
+	ctx     *context.Context
+	chatID  *int64
+	afterID *int64
+	limit   *uint64
+}
+
+// ChatServiceMockListAfterResults contains results of the ChatService.ListAfter
+type ChatServiceMockListAfterResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// ChatServiceMockListAfterOrigins contains origins of expectations of the ChatService.ListAfter
+type ChatServiceMockListAfterExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originChatID  string
+	originAfterID string
+	originLimit   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAfter *mChatServiceMockListAfter) Optional() *mChatServiceMockListAfter {
+	mmListAfter.optional = true
+	return mmListAfter
+}
+
+// Expect sets up expected params for ChatService.ListAfter
+func (mmListAfter *mChatServiceMockListAfter) Expect(ctx context.Context, chatID int64, afterID int64, limit uint64) *mChatServiceMockListAfter {
+	if mmListAfter.mock.funcListAfter != nil {
+		mmListAfter.mock.t.Fatalf("ChatServiceMock.ListAfter mock is already set by Set")
+	}
+
+	if mmListAfter.defaultExpectation == nil {
+		mmListAfter.defaultExpectation = &ChatServiceMockListAfterExpectation{}
+	}
+
+	if mmListAfter.defaultExpectation.paramPtrs != nil {
+		mmListAfter.mock.t.Fatalf("ChatServiceMock.ListAfter mock is already set by ExpectParams functions")
+	}
+
+	mmListAfter.defaultExpectation.params = &ChatServiceMockListAfterParams{ctx, chatID, afterID, limit}
+	mmListAfter.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListAfter.expectations {
+		if minimock.Equal(e.params, mmListAfter.defaultExpectation.params) {
+			mmListAfter.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAfter.defaultExpectation.params)
+		}
+	}
+
+	return mmListAfter
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListAfter
+func (mmListAfter *mChatServiceMockListAfter) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListAfter {
+	if mmListAfter.mock.funcListAfter != nil {
+		mmListAfter.mock.t.Fatalf("ChatServiceMock.ListAfter mock is already set by Set")
+	}
+
+	if mmListAfter.defaultExpectation == nil {
+		mmListAfter.defaultExpectation = &ChatServiceMockListAfterExpectation{}
+	}
+
+	if mmListAfter.defaultExpectation.params != nil {
+		mmListAfter.mock.t.Fatalf("ChatServiceMock.ListAfter mock is already set by Expect")
+	}
+
+	if mmListAfter.defaultExpectation.paramPtrs == nil {
+		mmListAfter.defaultExpectation.paramPtrs = &ChatServiceMockListAfterParamPtrs{}
+	}
+	mmListAfter.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListAfter.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListAfter
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.ListAfter
+func (mmListAfter *mChatServiceMockListAfter) ExpectChatIDParam2(chatID int64) *mChatServiceMockListAfter {
+	if mmListAfter.mock.funcListAfter != nil {
+		mmListAfter.mock.t.Fatalf("ChatServiceMock.ListAfter mock is already set by Set")
+	}
+
+	if mmListAfter.defaultExpectation == nil {
+		mmListAfter.defaultExpectation = &ChatServiceMockListAfterExpectation{}
+	}
+
+	if mmListAfter.defaultExpectation.params != nil {
+		mmListAfter.mock.t.Fatalf("ChatServiceMock.ListAfter mock is already set by Expect")
+	}
+
+	if mmListAfter.defaultExpectation.paramPtrs == nil {
+		mmListAfter.defaultExpectation.paramPtrs = &ChatServiceMockListAfterParamPtrs{}
+	}
+	mmListAfter.defaultExpectation.paramPtrs.chatID = &chatID
+	mmListAfter.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmListAfter
+}
+
+// ExpectAfterIDParam3 sets up expected param afterID for ChatService.ListAfter
+func (mmListAfter *mChatServiceMockListAfter) ExpectAfterIDParam3(afterID int64) *mChatServiceMockListAfter {
+	if mmListAfter.mock.funcListAfter != nil {
+		mmListAfter.mock.t.Fatalf("ChatServiceMock.ListAfter mock is already set by Set")
+	}
+
+	if mmListAfter.defaultExpectation == nil {
+		mmListAfter.defaultExpectation = &ChatServiceMockListAfterExpectation{}
+	}
+
+	if mmListAfter.defaultExpectation.params != nil {
+		mmListAfter.mock.t.Fatalf("ChatServiceMock.ListAfter mock is already set by Expect")
+	}
+
+	if mmListAfter.defaultExpectation.paramPtrs == nil {
+		mmListAfter.defaultExpectation.paramPtrs = &ChatServiceMockListAfterParamPtrs{}
+	}
+	mmListAfter.defaultExpectation.paramPtrs.afterID = &afterID
+	mmListAfter.defaultExpectation.expectationOrigins.originAfterID = minimock.CallerInfo(1)
+
+	return mmListAfter
+}
+
+// ExpectLimitParam4 sets up expected param limit for ChatService.ListAfter
+func (mmListAfter *mChatServiceMockListAfter) ExpectLimitParam4(limit uint64) *mChatServiceMockListAfter {
+	if mmListAfter.mock.funcListAfter != nil {
+		mmListAfter.mock.t.Fatalf("ChatServiceMock.ListAfter mock is already set by Set")
+	}
+
+	if mmListAfter.defaultExpectation == nil {
+		mmListAfter.defaultExpectation = &ChatServiceMockListAfterExpectation{}
+	}
+
+	if mmListAfter.defaultExpectation.params != nil {
+		mmListAfter.mock.t.Fatalf("ChatServiceMock.ListAfter mock is already set by Expect")
+	}
+
+	if mmListAfter.defaultExpectation.paramPtrs == nil {
+		mmListAfter.defaultExpectation.paramPtrs = &ChatServiceMockListAfterParamPtrs{}
+	}
+	mmListAfter.defaultExpectation.paramPtrs.limit = &limit
+	mmListAfter.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListAfter
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListAfter
+func (mmListAfter *mChatServiceMockListAfter) Inspect(f func(ctx context.Context, chatID int64, afterID int64, limit uint64)) *mChatServiceMockListAfter {
+	if mmListAfter.mock.inspectFuncListAfter != nil {
+		mmListAfter.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListAfter")
+	}
+
+	mmListAfter.mock.inspectFuncListAfter = f
+
+	return mmListAfter
+}
+
+// Return sets up results that will be returned by ChatService.ListAfter
+func (mmListAfter *mChatServiceMockListAfter) Return(mpa1 []*model.Message, err error) *ChatServiceMock {
+	if mmListAfter.mock.funcListAfter != nil {
+		mmListAfter.mock.t.Fatalf("ChatServiceMock.ListAfter mock is already set by Set")
+	}
+
+	if mmListAfter.defaultExpectation == nil {
+		mmListAfter.defaultExpectation = &ChatServiceMockListAfterExpectation{mock: mmListAfter.mock}
+	}
+	mmListAfter.defaultExpectation.results = &ChatServiceMockListAfterResults{mpa1, err}
+	mmListAfter.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListAfter.mock
+}
+
+// Set uses given function f to mock the ChatService.ListAfter method
+func (mmListAfter *mChatServiceMockListAfter) Set(f func(ctx context.Context, chatID int64, afterID int64, limit uint64) (mpa1 []*model.Message, err error)) *ChatServiceMock {
+	if mmListAfter.defaultExpectation != nil {
+		mmListAfter.mock.t.Fatalf("Default expectation is already set for the ChatService.ListAfter method")
+	}
+
+	if len(mmListAfter.expectations) > 0 {
+		mmListAfter.mock.t.Fatalf("Some expectations are already set for the ChatService.ListAfter method")
+	}
+
+	mmListAfter.mock.funcListAfter = f
+	mmListAfter.mock.funcListAfterOrigin = minimock.CallerInfo(1)
+	return mmListAfter.mock
+}
+
+// When sets expectation for the ChatService.ListAfter which will trigger the result defined by the following
+// Then helper
+func (mmListAfter *mChatServiceMockListAfter) When(ctx context.Context, chatID int64, afterID int64, limit uint64) *ChatServiceMockListAfterExpectation {
+	if mmListAfter.mock.funcListAfter != nil {
+		mmListAfter.mock.t.Fatalf("ChatServiceMock.ListAfter mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListAfterExpectation{
+		mock:               mmListAfter.mock,
+		params:             &ChatServiceMockListAfterParams{ctx, chatID, afterID, limit},
+		expectationOrigins: ChatServiceMockListAfterExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListAfter.expectations = append(mmListAfter.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListAfter return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListAfterExpectation) Then(mpa1 []*model.Message, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListAfterResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListAfter should be invoked
+func (mmListAfter *mChatServiceMockListAfter) Times(n uint64) *mChatServiceMockListAfter {
+	if n == 0 {
+		mmListAfter.mock.t.Fatalf("Times of ChatServiceMock.ListAfter mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAfter.expectedInvocations, n)
+	mmListAfter.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListAfter
+}
+
+func (mmListAfter *mChatServiceMockListAfter) invocationsDone() bool {
+	if len(mmListAfter.expectations) == 0 && mmListAfter.defaultExpectation == nil && mmListAfter.mock.funcListAfter == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAfter.mock.afterListAfterCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAfter.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAfter implements mm_service.ChatService
+func (mmListAfter *ChatServiceMock) ListAfter(ctx context.Context, chatID int64, afterID int64, limit uint64) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmListAfter.beforeListAfterCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAfter.afterListAfterCounter, 1)
+
+	mmListAfter.t.Helper()
+
+	if mmListAfter.inspectFuncListAfter != nil {
+		mmListAfter.inspectFuncListAfter(ctx, chatID, afterID, limit)
+	}
+
+	mm_params := ChatServiceMockListAfterParams{ctx, chatID, afterID, limit}
+
+	// Record call args
+	mmListAfter.ListAfterMock.mutex.Lock()
+	mmListAfter.ListAfterMock.callArgs = append(mmListAfter.ListAfterMock.callArgs, &mm_params)
+	mmListAfter.ListAfterMock.mutex.Unlock()
+
+	for _, e := range mmListAfter.ListAfterMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListAfter.ListAfterMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAfter.ListAfterMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAfter.ListAfterMock.defaultExpectation.params
+		mm_want_ptrs := mmListAfter.ListAfterMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListAfterParams{ctx, chatID, afterID, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAfter.t.Errorf("ChatServiceMock.ListAfter got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAfter.ListAfterMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmListAfter.t.Errorf("ChatServiceMock.ListAfter got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAfter.ListAfterMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.afterID != nil && !minimock.Equal(*mm_want_ptrs.afterID, mm_got.afterID) {
+				mmListAfter.t.Errorf("ChatServiceMock.ListAfter got unexpected parameter afterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAfter.ListAfterMock.defaultExpectation.expectationOrigins.originAfterID, *mm_want_ptrs.afterID, mm_got.afterID, minimock.Diff(*mm_want_ptrs.afterID, mm_got.afterID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListAfter.t.Errorf("ChatServiceMock.ListAfter got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAfter.ListAfterMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAfter.t.Errorf("ChatServiceMock.ListAfter got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListAfter.ListAfterMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAfter.ListAfterMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAfter.t.Fatal("No results are set for the ChatServiceMock.ListAfter")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListAfter.funcListAfter != nil {
+		return mmListAfter.funcListAfter(ctx, chatID, afterID, limit)
+	}
+	mmListAfter.t.Fatalf("Unexpected call to ChatServiceMock.ListAfter. %v %v %v %v", ctx, chatID, afterID, limit)
+	return
+}
+
+// ListAfterAfterCounter returns a count of finished ChatServiceMock.ListAfter invocations
+func (mmListAfter *ChatServiceMock) ListAfterAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAfter.afterListAfterCounter)
+}
+
+// ListAfterBeforeCounter returns a count of ChatServiceMock.ListAfter invocations
+func (mmListAfter *ChatServiceMock) ListAfterBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAfter.beforeListAfterCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListAfter.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAfter *mChatServiceMockListAfter) Calls() []*ChatServiceMockListAfterParams {
+	mmListAfter.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListAfterParams, len(mmListAfter.callArgs))
+	copy(argCopy, mmListAfter.callArgs)
+
+	mmListAfter.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAfterDone returns true if the count of the ListAfter invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListAfterDone() bool {
+	if m.ListAfterMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAfterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAfterMock.invocationsDone()
+}
+
+// MinimockListAfterInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListAfterInspect() {
+	for _, e := range m.ListAfterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListAfter at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListAfterCounter := mm_atomic.LoadUint64(&m.afterListAfterCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAfterMock.defaultExpectation != nil && afterListAfterCounter < 1 {
+		if m.ListAfterMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListAfter at\n%s", m.ListAfterMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListAfter at\n%s with params: %#v", m.ListAfterMock.defaultExpectation.expectationOrigins.origin, *m.ListAfterMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAfter != nil && afterListAfterCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListAfter at\n%s", m.funcListAfterOrigin)
+	}
+
+	if !m.ListAfterMock.invocationsDone() && afterListAfterCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListAfter at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAfterMock.expectedInvocations), m.ListAfterMock.expectedInvocationsOrigin, afterListAfterCounter)
+	}
+}
+
+type mChatServiceMockListAuditLog struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListAuditLogExpectation
+	expectations       []*ChatServiceMockListAuditLogExpectation
+
+	callArgs []*ChatServiceMockListAuditLogParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListAuditLogExpectation specifies expectation struct of the ChatService.ListAuditLog
+type ChatServiceMockListAuditLogExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListAuditLogParams
+	paramPtrs          *ChatServiceMockListAuditLogParamPtrs
+	expectationOrigins ChatServiceMockListAuditLogExpectationOrigins
+	results            *ChatServiceMockListAuditLogResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListAuditLogParams contains parameters of the ChatService.ListAuditLog
+type ChatServiceMockListAuditLogParams struct {
+	ctx    context.Context
+	filter *model.LogFilter
+}
+
+// ChatServiceMockListAuditLogParamPtrs contains pointers to parameters of the ChatService.ListAuditLog
+type ChatServiceMockListAuditLogParamPtrs struct {
+	ctx    *context.Context
+	filter **model.LogFilter
+}
+
+// ChatServiceMockListAuditLogResults contains results of the ChatService.ListAuditLog
+type ChatServiceMockListAuditLogResults struct {
+	lpa1 []*model.LogEntry
+	err  error
+}
+
+// ChatServiceMockListAuditLogOrigins contains origins of expectations of the ChatService.ListAuditLog
+type ChatServiceMockListAuditLogExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAuditLog *mChatServiceMockListAuditLog) Optional() *mChatServiceMockListAuditLog {
+	mmListAuditLog.optional = true
+	return mmListAuditLog
+}
+
+// Expect sets up expected params for ChatService.ListAuditLog
+func (mmListAuditLog *mChatServiceMockListAuditLog) Expect(ctx context.Context, filter *model.LogFilter) *mChatServiceMockListAuditLog {
+	if mmListAuditLog.mock.funcListAuditLog != nil {
+		mmListAuditLog.mock.t.Fatalf("ChatServiceMock.ListAuditLog mock is already set by Set")
+	}
+
+	if mmListAuditLog.defaultExpectation == nil {
+		mmListAuditLog.defaultExpectation = &ChatServiceMockListAuditLogExpectation{}
+	}
+
+	if mmListAuditLog.defaultExpectation.paramPtrs != nil {
+		mmListAuditLog.mock.t.Fatalf("ChatServiceMock.ListAuditLog mock is already set by ExpectParams functions")
+	}
+
+	mmListAuditLog.defaultExpectation.params = &ChatServiceMockListAuditLogParams{ctx, filter}
+	mmListAuditLog.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListAuditLog.expectations {
+		if minimock.Equal(e.params, mmListAuditLog.defaultExpectation.params) {
+			mmListAuditLog.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAuditLog.defaultExpectation.params)
+		}
+	}
+
+	return mmListAuditLog
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListAuditLog
+func (mmListAuditLog *mChatServiceMockListAuditLog) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListAuditLog {
+	if mmListAuditLog.mock.funcListAuditLog != nil {
+		mmListAuditLog.mock.t.Fatalf("ChatServiceMock.ListAuditLog mock is already set by Set")
+	}
+
+	if mmListAuditLog.defaultExpectation == nil {
+		mmListAuditLog.defaultExpectation = &ChatServiceMockListAuditLogExpectation{}
+	}
+
+	if mmListAuditLog.defaultExpectation.params != nil {
+		mmListAuditLog.mock.t.Fatalf("ChatServiceMock.ListAuditLog mock is already set by Expect")
+	}
+
+	if mmListAuditLog.defaultExpectation.paramPtrs == nil {
+		mmListAuditLog.defaultExpectation.paramPtrs = &ChatServiceMockListAuditLogParamPtrs{}
+	}
+	mmListAuditLog.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListAuditLog.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListAuditLog
+}
+
+// ExpectFilterParam2 sets up expected param filter for ChatService.ListAuditLog
+func (mmListAuditLog *mChatServiceMockListAuditLog) ExpectFilterParam2(filter *model.LogFilter) *mChatServiceMockListAuditLog {
+	if mmListAuditLog.mock.funcListAuditLog != nil {
+		mmListAuditLog.mock.t.Fatalf("ChatServiceMock.ListAuditLog mock is already set by Set")
+	}
+
+	if mmListAuditLog.defaultExpectation == nil {
+		mmListAuditLog.defaultExpectation = &ChatServiceMockListAuditLogExpectation{}
+	}
+
+	if mmListAuditLog.defaultExpectation.params != nil {
+		mmListAuditLog.mock.t.Fatalf("ChatServiceMock.ListAuditLog mock is already set by Expect")
+	}
+
+	if mmListAuditLog.defaultExpectation.paramPtrs == nil {
+		mmListAuditLog.defaultExpectation.paramPtrs = &ChatServiceMockListAuditLogParamPtrs{}
+	}
+	mmListAuditLog.defaultExpectation.paramPtrs.filter = &filter
+	mmListAuditLog.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListAuditLog
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListAuditLog
+func (mmListAuditLog *mChatServiceMockListAuditLog) Inspect(f func(ctx context.Context, filter *model.LogFilter)) *mChatServiceMockListAuditLog {
+	if mmListAuditLog.mock.inspectFuncListAuditLog != nil {
+		mmListAuditLog.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListAuditLog")
+	}
+
+	mmListAuditLog.mock.inspectFuncListAuditLog = f
+
+	return mmListAuditLog
+}
+
+// Return sets up results that will be returned by ChatService.ListAuditLog
+func (mmListAuditLog *mChatServiceMockListAuditLog) Return(lpa1 []*model.LogEntry, err error) *ChatServiceMock {
+	if mmListAuditLog.mock.funcListAuditLog != nil {
+		mmListAuditLog.mock.t.Fatalf("ChatServiceMock.ListAuditLog mock is already set by Set")
+	}
+
+	if mmListAuditLog.defaultExpectation == nil {
+		mmListAuditLog.defaultExpectation = &ChatServiceMockListAuditLogExpectation{mock: mmListAuditLog.mock}
+	}
+	mmListAuditLog.defaultExpectation.results = &ChatServiceMockListAuditLogResults{lpa1, err}
+	mmListAuditLog.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListAuditLog.mock
+}
+
+// Set uses given function f to mock the ChatService.ListAuditLog method
+func (mmListAuditLog *mChatServiceMockListAuditLog) Set(f func(ctx context.Context, filter *model.LogFilter) (lpa1 []*model.LogEntry, err error)) *ChatServiceMock {
+	if mmListAuditLog.defaultExpectation != nil {
+		mmListAuditLog.mock.t.Fatalf("Default expectation is already set for the ChatService.ListAuditLog method")
+	}
+
+	if len(mmListAuditLog.expectations) > 0 {
+		mmListAuditLog.mock.t.Fatalf("Some expectations are already set for the ChatService.ListAuditLog method")
+	}
+
+	mmListAuditLog.mock.funcListAuditLog = f
+	mmListAuditLog.mock.funcListAuditLogOrigin = minimock.CallerInfo(1)
+	return mmListAuditLog.mock
+}
+
+// When sets expectation for the ChatService.ListAuditLog which will trigger the result defined by the following
+// Then helper
+func (mmListAuditLog *mChatServiceMockListAuditLog) When(ctx context.Context, filter *model.LogFilter) *ChatServiceMockListAuditLogExpectation {
+	if mmListAuditLog.mock.funcListAuditLog != nil {
+		mmListAuditLog.mock.t.Fatalf("ChatServiceMock.ListAuditLog mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListAuditLogExpectation{
+		mock:               mmListAuditLog.mock,
+		params:             &ChatServiceMockListAuditLogParams{ctx, filter},
+		expectationOrigins: ChatServiceMockListAuditLogExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListAuditLog.expectations = append(mmListAuditLog.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListAuditLog return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListAuditLogExpectation) Then(lpa1 []*model.LogEntry, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListAuditLogResults{lpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListAuditLog should be invoked
+func (mmListAuditLog *mChatServiceMockListAuditLog) Times(n uint64) *mChatServiceMockListAuditLog {
+	if n == 0 {
+		mmListAuditLog.mock.t.Fatalf("Times of ChatServiceMock.ListAuditLog mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAuditLog.expectedInvocations, n)
+	mmListAuditLog.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListAuditLog
+}
+
+func (mmListAuditLog *mChatServiceMockListAuditLog) invocationsDone() bool {
+	if len(mmListAuditLog.expectations) == 0 && mmListAuditLog.defaultExpectation == nil && mmListAuditLog.mock.funcListAuditLog == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAuditLog.mock.afterListAuditLogCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAuditLog.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAuditLog implements mm_service.ChatService
+func (mmListAuditLog *ChatServiceMock) ListAuditLog(ctx context.Context, filter *model.LogFilter) (lpa1 []*model.LogEntry, err error) {
+	mm_atomic.AddUint64(&mmListAuditLog.beforeListAuditLogCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAuditLog.afterListAuditLogCounter, 1)
+
+	mmListAuditLog.t.Helper()
+
+	if mmListAuditLog.inspectFuncListAuditLog != nil {
+		mmListAuditLog.inspectFuncListAuditLog(ctx, filter)
+	}
+
+	mm_params := ChatServiceMockListAuditLogParams{ctx, filter}
+
+	// Record call args
+	mmListAuditLog.ListAuditLogMock.mutex.Lock()
+	mmListAuditLog.ListAuditLogMock.callArgs = append(mmListAuditLog.ListAuditLogMock.callArgs, &mm_params)
+	mmListAuditLog.ListAuditLogMock.mutex.Unlock()
+
+	for _, e := range mmListAuditLog.ListAuditLogMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lpa1, e.results.err
+		}
+	}
+
+	if mmListAuditLog.ListAuditLogMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAuditLog.ListAuditLogMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAuditLog.ListAuditLogMock.defaultExpectation.params
+		mm_want_ptrs := mmListAuditLog.ListAuditLogMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListAuditLogParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAuditLog.t.Errorf("ChatServiceMock.ListAuditLog got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAuditLog.ListAuditLogMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListAuditLog.t.Errorf("ChatServiceMock.ListAuditLog got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAuditLog.ListAuditLogMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAuditLog.t.Errorf("ChatServiceMock.ListAuditLog got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListAuditLog.ListAuditLogMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAuditLog.ListAuditLogMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAuditLog.t.Fatal("No results are set for the ChatServiceMock.ListAuditLog")
+		}
+		return (*mm_results).lpa1, (*mm_results).err
+	}
+	if mmListAuditLog.funcListAuditLog != nil {
+		return mmListAuditLog.funcListAuditLog(ctx, filter)
+	}
+	mmListAuditLog.t.Fatalf("Unexpected call to ChatServiceMock.ListAuditLog. %v %v", ctx, filter)
+	return
+}
+
+// ListAuditLogAfterCounter returns a count of finished ChatServiceMock.ListAuditLog invocations
+func (mmListAuditLog *ChatServiceMock) ListAuditLogAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditLog.afterListAuditLogCounter)
+}
+
+// ListAuditLogBeforeCounter returns a count of ChatServiceMock.ListAuditLog invocations
+func (mmListAuditLog *ChatServiceMock) ListAuditLogBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditLog.beforeListAuditLogCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListAuditLog.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAuditLog *mChatServiceMockListAuditLog) Calls() []*ChatServiceMockListAuditLogParams {
+	mmListAuditLog.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListAuditLogParams, len(mmListAuditLog.callArgs))
+	copy(argCopy, mmListAuditLog.callArgs)
+
+	mmListAuditLog.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAuditLogDone returns true if the count of the ListAuditLog invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListAuditLogDone() bool {
+	if m.ListAuditLogMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAuditLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAuditLogMock.invocationsDone()
+}
+
+// MinimockListAuditLogInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListAuditLogInspect() {
+	for _, e := range m.ListAuditLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListAuditLog at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListAuditLogCounter := mm_atomic.LoadUint64(&m.afterListAuditLogCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAuditLogMock.defaultExpectation != nil && afterListAuditLogCounter < 1 {
+		if m.ListAuditLogMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListAuditLog at\n%s", m.ListAuditLogMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListAuditLog at\n%s with params: %#v", m.ListAuditLogMock.defaultExpectation.expectationOrigins.origin, *m.ListAuditLogMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAuditLog != nil && afterListAuditLogCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListAuditLog at\n%s", m.funcListAuditLogOrigin)
+	}
+
+	if !m.ListAuditLogMock.invocationsDone() && afterListAuditLogCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListAuditLog at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAuditLogMock.expectedInvocations), m.ListAuditLogMock.expectedInvocationsOrigin, afterListAuditLogCounter)
+	}
+}
+
+type mChatServiceMockListMessages struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListMessagesExpectation
+	expectations       []*ChatServiceMockListMessagesExpectation
+
+	callArgs []*ChatServiceMockListMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListMessagesExpectation specifies expectation struct of the ChatService.ListMessages
+type ChatServiceMockListMessagesExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListMessagesParams
+	paramPtrs          *ChatServiceMockListMessagesParamPtrs
+	expectationOrigins ChatServiceMockListMessagesExpectationOrigins
+	results            *ChatServiceMockListMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListMessagesParams contains parameters of the ChatService.ListMessages
+type ChatServiceMockListMessagesParams struct {
+	ctx  context.Context
+	page *model.MessagesPage
+}
+
+// ChatServiceMockListMessagesParamPtrs contains pointers to parameters of the ChatService.ListMessages
+type ChatServiceMockListMessagesParamPtrs struct {
+	ctx  *context.Context
+	page **model.MessagesPage
+}
+
+// ChatServiceMockListMessagesResults contains results of the ChatService.ListMessages
+type ChatServiceMockListMessagesResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// ChatServiceMockListMessagesOrigins contains origins of expectations of the ChatService.ListMessages
+type ChatServiceMockListMessagesExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originPage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMessages *mChatServiceMockListMessages) Optional() *mChatServiceMockListMessages {
+	mmListMessages.optional = true
+	return mmListMessages
+}
+
+// Expect sets up expected params for ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) Expect(ctx context.Context, page *model.MessagesPage) *mChatServiceMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListMessages.defaultExpectation.params = &ChatServiceMockListMessagesParams{ctx, page}
+	mmListMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListMessages.expectations {
+		if minimock.Equal(e.params, mmListMessages.defaultExpectation.params) {
+			mmListMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &ChatServiceMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListMessages
+}
+
+// ExpectPageParam2 sets up expected param page for ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) ExpectPageParam2(page *model.MessagesPage) *mChatServiceMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &ChatServiceMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.page = &page
+	mmListMessages.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmListMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) Inspect(f func(ctx context.Context, page *model.MessagesPage)) *mChatServiceMockListMessages {
+	if mmListMessages.mock.inspectFuncListMessages != nil {
+		mmListMessages.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListMessages")
+	}
+
+	mmListMessages.mock.inspectFuncListMessages = f
+
+	return mmListMessages
+}
+
+// Return sets up results that will be returned by ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) Return(mpa1 []*model.Message, err error) *ChatServiceMock {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{mock: mmListMessages.mock}
+	}
+	mmListMessages.defaultExpectation.results = &ChatServiceMockListMessagesResults{mpa1, err}
+	mmListMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListMessages.mock
+}
+
+// Set uses given function f to mock the ChatService.ListMessages method
+func (mmListMessages *mChatServiceMockListMessages) Set(f func(ctx context.Context, page *model.MessagesPage) (mpa1 []*model.Message, err error)) *ChatServiceMock {
+	if mmListMessages.defaultExpectation != nil {
+		mmListMessages.mock.t.Fatalf("Default expectation is already set for the ChatService.ListMessages method")
+	}
+
+	if len(mmListMessages.expectations) > 0 {
+		mmListMessages.mock.t.Fatalf("Some expectations are already set for the ChatService.ListMessages method")
+	}
+
+	mmListMessages.mock.funcListMessages = f
+	mmListMessages.mock.funcListMessagesOrigin = minimock.CallerInfo(1)
+	return mmListMessages.mock
+}
+
+// When sets expectation for the ChatService.ListMessages which will trigger the result defined by the following
+// Then helper
+func (mmListMessages *mChatServiceMockListMessages) When(ctx context.Context, page *model.MessagesPage) *ChatServiceMockListMessagesExpectation {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListMessagesExpectation{
+		mock:               mmListMessages.mock,
+		params:             &ChatServiceMockListMessagesParams{ctx, page},
+		expectationOrigins: ChatServiceMockListMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListMessages.expectations = append(mmListMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListMessages return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListMessagesExpectation) Then(mpa1 []*model.Message, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListMessagesResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListMessages should be invoked
+func (mmListMessages *mChatServiceMockListMessages) Times(n uint64) *mChatServiceMockListMessages {
+	if n == 0 {
+		mmListMessages.mock.t.Fatalf("Times of ChatServiceMock.ListMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMessages.expectedInvocations, n)
+	mmListMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListMessages
+}
+
+func (mmListMessages *mChatServiceMockListMessages) invocationsDone() bool {
+	if len(mmListMessages.expectations) == 0 && mmListMessages.defaultExpectation == nil && mmListMessages.mock.funcListMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMessages.mock.afterListMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMessages implements mm_service.ChatService
+func (mmListMessages *ChatServiceMock) ListMessages(ctx context.Context, page *model.MessagesPage) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmListMessages.beforeListMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMessages.afterListMessagesCounter, 1)
+
+	mmListMessages.t.Helper()
+
+	if mmListMessages.inspectFuncListMessages != nil {
+		mmListMessages.inspectFuncListMessages(ctx, page)
+	}
+
+	mm_params := ChatServiceMockListMessagesParams{ctx, page}
+
+	// Record call args
+	mmListMessages.ListMessagesMock.mutex.Lock()
+	mmListMessages.ListMessagesMock.callArgs = append(mmListMessages.ListMessagesMock.callArgs, &mm_params)
+	mmListMessages.ListMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListMessages.ListMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListMessages.ListMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMessages.ListMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMessages.ListMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListMessages.ListMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListMessagesParams{ctx, page}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMessages.t.Errorf("ChatServiceMock.ListMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessages.ListMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmListMessages.t.Errorf("ChatServiceMock.ListMessages got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessages.ListMessagesMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMessages.t.Errorf("ChatServiceMock.ListMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListMessages.ListMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMessages.ListMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMessages.t.Fatal("No results are set for the ChatServiceMock.ListMessages")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListMessages.funcListMessages != nil {
+		return mmListMessages.funcListMessages(ctx, page)
+	}
+	mmListMessages.t.Fatalf("Unexpected call to ChatServiceMock.ListMessages. %v %v", ctx, page)
+	return
+}
+
+// ListMessagesAfterCounter returns a count of finished ChatServiceMock.ListMessages invocations
+func (mmListMessages *ChatServiceMock) ListMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessages.afterListMessagesCounter)
+}
+
+// ListMessagesBeforeCounter returns a count of ChatServiceMock.ListMessages invocations
+func (mmListMessages *ChatServiceMock) ListMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessages.beforeListMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMessages *mChatServiceMockListMessages) Calls() []*ChatServiceMockListMessagesParams {
+	mmListMessages.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListMessagesParams, len(mmListMessages.callArgs))
+	copy(argCopy, mmListMessages.callArgs)
+
+	mmListMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMessagesDone returns true if the count of the ListMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListMessagesDone() bool {
+	if m.ListMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMessagesMock.invocationsDone()
+}
+
+// MinimockListMessagesInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListMessagesInspect() {
+	for _, e := range m.ListMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListMessagesCounter := mm_atomic.LoadUint64(&m.afterListMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMessagesMock.defaultExpectation != nil && afterListMessagesCounter < 1 {
+		if m.ListMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMessages at\n%s", m.ListMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMessages at\n%s with params: %#v", m.ListMessagesMock.defaultExpectation.expectationOrigins.origin, *m.ListMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMessages != nil && afterListMessagesCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListMessages at\n%s", m.funcListMessagesOrigin)
+	}
+
+	if !m.ListMessagesMock.invocationsDone() && afterListMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMessagesMock.expectedInvocations), m.ListMessagesMock.expectedInvocationsOrigin, afterListMessagesCounter)
+	}
+}
+
+type mChatServiceMockListScheduled struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListScheduledExpectation
+	expectations       []*ChatServiceMockListScheduledExpectation
+
+	callArgs []*ChatServiceMockListScheduledParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListScheduledExpectation specifies expectation struct of the ChatService.ListScheduled
+type ChatServiceMockListScheduledExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListScheduledParams
+	paramPtrs          *ChatServiceMockListScheduledParamPtrs
+	expectationOrigins ChatServiceMockListScheduledExpectationOrigins
+	results            *ChatServiceMockListScheduledResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListScheduledParams contains parameters of the ChatService.ListScheduled
+type ChatServiceMockListScheduledParams struct {
+	ctx    context.Context
+	filter *model.ScheduledFilter
+}
+
+// ChatServiceMockListScheduledParamPtrs contains pointers to parameters of the ChatService.ListScheduled
+type ChatServiceMockListScheduledParamPtrs struct {
+	ctx    *context.Context
+	filter **model.ScheduledFilter
+}
+
+// ChatServiceMockListScheduledResults contains results of the ChatService.ListScheduled
+type ChatServiceMockListScheduledResults struct {
+	spa1 []*model.ScheduledMessage
+	err  error
+}
+
+// ChatServiceMockListScheduledOrigins contains origins of expectations of the ChatService.ListScheduled
+type ChatServiceMockListScheduledExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListScheduled *mChatServiceMockListScheduled) Optional() *mChatServiceMockListScheduled {
+	mmListScheduled.optional = true
+	return mmListScheduled
+}
+
+// Expect sets up expected params for ChatService.ListScheduled
+func (mmListScheduled *mChatServiceMockListScheduled) Expect(ctx context.Context, filter *model.ScheduledFilter) *mChatServiceMockListScheduled {
+	if mmListScheduled.mock.funcListScheduled != nil {
+		mmListScheduled.mock.t.Fatalf("ChatServiceMock.ListScheduled mock is already set by Set")
+	}
+
+	if mmListScheduled.defaultExpectation == nil {
+		mmListScheduled.defaultExpectation = &ChatServiceMockListScheduledExpectation{}
+	}
+
+	if mmListScheduled.defaultExpectation.paramPtrs != nil {
+		mmListScheduled.mock.t.Fatalf("ChatServiceMock.ListScheduled mock is already set by ExpectParams functions")
+	}
+
+	mmListScheduled.defaultExpectation.params = &ChatServiceMockListScheduledParams{ctx, filter}
+	mmListScheduled.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListScheduled.expectations {
+		if minimock.Equal(e.params, mmListScheduled.defaultExpectation.params) {
+			mmListScheduled.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListScheduled.defaultExpectation.params)
+		}
+	}
+
+	return mmListScheduled
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListScheduled
+func (mmListScheduled *mChatServiceMockListScheduled) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListScheduled {
+	if mmListScheduled.mock.funcListScheduled != nil {
+		mmListScheduled.mock.t.Fatalf("ChatServiceMock.ListScheduled mock is already set by Set")
+	}
+
+	if mmListScheduled.defaultExpectation == nil {
+		mmListScheduled.defaultExpectation = &ChatServiceMockListScheduledExpectation{}
+	}
+
+	if mmListScheduled.defaultExpectation.params != nil {
+		mmListScheduled.mock.t.Fatalf("ChatServiceMock.ListScheduled mock is already set by Expect")
+	}
+
+	if mmListScheduled.defaultExpectation.paramPtrs == nil {
+		mmListScheduled.defaultExpectation.paramPtrs = &ChatServiceMockListScheduledParamPtrs{}
+	}
+	mmListScheduled.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListScheduled.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListScheduled
+}
+
+// ExpectFilterParam2 sets up expected param filter for ChatService.ListScheduled
+func (mmListScheduled *mChatServiceMockListScheduled) ExpectFilterParam2(filter *model.ScheduledFilter) *mChatServiceMockListScheduled {
+	if mmListScheduled.mock.funcListScheduled != nil {
+		mmListScheduled.mock.t.Fatalf("ChatServiceMock.ListScheduled mock is already set by Set")
+	}
+
+	if mmListScheduled.defaultExpectation == nil {
+		mmListScheduled.defaultExpectation = &ChatServiceMockListScheduledExpectation{}
+	}
+
+	if mmListScheduled.defaultExpectation.params != nil {
+		mmListScheduled.mock.t.Fatalf("ChatServiceMock.ListScheduled mock is already set by Expect")
+	}
+
+	if mmListScheduled.defaultExpectation.paramPtrs == nil {
+		mmListScheduled.defaultExpectation.paramPtrs = &ChatServiceMockListScheduledParamPtrs{}
+	}
+	mmListScheduled.defaultExpectation.paramPtrs.filter = &filter
+	mmListScheduled.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListScheduled
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListScheduled
+func (mmListScheduled *mChatServiceMockListScheduled) Inspect(f func(ctx context.Context, filter *model.ScheduledFilter)) *mChatServiceMockListScheduled {
+	if mmListScheduled.mock.inspectFuncListScheduled != nil {
+		mmListScheduled.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListScheduled")
+	}
+
+	mmListScheduled.mock.inspectFuncListScheduled = f
+
+	return mmListScheduled
+}
+
+// Return sets up results that will be returned by ChatService.ListScheduled
+func (mmListScheduled *mChatServiceMockListScheduled) Return(spa1 []*model.ScheduledMessage, err error) *ChatServiceMock {
+	if mmListScheduled.mock.funcListScheduled != nil {
+		mmListScheduled.mock.t.Fatalf("ChatServiceMock.ListScheduled mock is already set by Set")
+	}
+
+	if mmListScheduled.defaultExpectation == nil {
+		mmListScheduled.defaultExpectation = &ChatServiceMockListScheduledExpectation{mock: mmListScheduled.mock}
+	}
+	mmListScheduled.defaultExpectation.results = &ChatServiceMockListScheduledResults{spa1, err}
+	mmListScheduled.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListScheduled.mock
+}
+
+// Set uses given function f to mock the ChatService.ListScheduled method
+func (mmListScheduled *mChatServiceMockListScheduled) Set(f func(ctx context.Context, filter *model.ScheduledFilter) (spa1 []*model.ScheduledMessage, err error)) *ChatServiceMock {
+	if mmListScheduled.defaultExpectation != nil {
+		mmListScheduled.mock.t.Fatalf("Default expectation is already set for the ChatService.ListScheduled method")
+	}
+
+	if len(mmListScheduled.expectations) > 0 {
+		mmListScheduled.mock.t.Fatalf("Some expectations are already set for the ChatService.ListScheduled method")
+	}
+
+	mmListScheduled.mock.funcListScheduled = f
+	mmListScheduled.mock.funcListScheduledOrigin = minimock.CallerInfo(1)
+	return mmListScheduled.mock
+}
+
+// When sets expectation for the ChatService.ListScheduled which will trigger the result defined by the following
+// Then helper
+func (mmListScheduled *mChatServiceMockListScheduled) When(ctx context.Context, filter *model.ScheduledFilter) *ChatServiceMockListScheduledExpectation {
+	if mmListScheduled.mock.funcListScheduled != nil {
+		mmListScheduled.mock.t.Fatalf("ChatServiceMock.ListScheduled mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListScheduledExpectation{
+		mock:               mmListScheduled.mock,
+		params:             &ChatServiceMockListScheduledParams{ctx, filter},
+		expectationOrigins: ChatServiceMockListScheduledExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListScheduled.expectations = append(mmListScheduled.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListScheduled return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListScheduledExpectation) Then(spa1 []*model.ScheduledMessage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListScheduledResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListScheduled should be invoked
+func (mmListScheduled *mChatServiceMockListScheduled) Times(n uint64) *mChatServiceMockListScheduled {
+	if n == 0 {
+		mmListScheduled.mock.t.Fatalf("Times of ChatServiceMock.ListScheduled mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListScheduled.expectedInvocations, n)
+	mmListScheduled.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListScheduled
+}
+
+func (mmListScheduled *mChatServiceMockListScheduled) invocationsDone() bool {
+	if len(mmListScheduled.expectations) == 0 && mmListScheduled.defaultExpectation == nil && mmListScheduled.mock.funcListScheduled == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListScheduled.mock.afterListScheduledCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListScheduled.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListScheduled implements mm_service.ChatService
+func (mmListScheduled *ChatServiceMock) ListScheduled(ctx context.Context, filter *model.ScheduledFilter) (spa1 []*model.ScheduledMessage, err error) {
+	mm_atomic.AddUint64(&mmListScheduled.beforeListScheduledCounter, 1)
+	defer mm_atomic.AddUint64(&mmListScheduled.afterListScheduledCounter, 1)
+
+	mmListScheduled.t.Helper()
+
+	if mmListScheduled.inspectFuncListScheduled != nil {
+		mmListScheduled.inspectFuncListScheduled(ctx, filter)
+	}
+
+	mm_params := ChatServiceMockListScheduledParams{ctx, filter}
+
+	// Record call args
+	mmListScheduled.ListScheduledMock.mutex.Lock()
+	mmListScheduled.ListScheduledMock.callArgs = append(mmListScheduled.ListScheduledMock.callArgs, &mm_params)
+	mmListScheduled.ListScheduledMock.mutex.Unlock()
+
+	for _, e := range mmListScheduled.ListScheduledMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmListScheduled.ListScheduledMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListScheduled.ListScheduledMock.defaultExpectation.Counter, 1)
+		mm_want := mmListScheduled.ListScheduledMock.defaultExpectation.params
+		mm_want_ptrs := mmListScheduled.ListScheduledMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListScheduledParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListScheduled.t.Errorf("ChatServiceMock.ListScheduled got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListScheduled.ListScheduledMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListScheduled.t.Errorf("ChatServiceMock.ListScheduled got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListScheduled.ListScheduledMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListScheduled.t.Errorf("ChatServiceMock.ListScheduled got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListScheduled.ListScheduledMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListScheduled.ListScheduledMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListScheduled.t.Fatal("No results are set for the ChatServiceMock.ListScheduled")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmListScheduled.funcListScheduled != nil {
+		return mmListScheduled.funcListScheduled(ctx, filter)
+	}
+	mmListScheduled.t.Fatalf("Unexpected call to ChatServiceMock.ListScheduled. %v %v", ctx, filter)
+	return
+}
+
+// ListScheduledAfterCounter returns a count of finished ChatServiceMock.ListScheduled invocations
+func (mmListScheduled *ChatServiceMock) ListScheduledAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListScheduled.afterListScheduledCounter)
+}
+
+// ListScheduledBeforeCounter returns a count of ChatServiceMock.ListScheduled invocations
+func (mmListScheduled *ChatServiceMock) ListScheduledBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListScheduled.beforeListScheduledCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListScheduled.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListScheduled *mChatServiceMockListScheduled) Calls() []*ChatServiceMockListScheduledParams {
+	mmListScheduled.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListScheduledParams, len(mmListScheduled.callArgs))
+	copy(argCopy, mmListScheduled.callArgs)
+
+	mmListScheduled.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListScheduledDone returns true if the count of the ListScheduled invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListScheduledDone() bool {
+	if m.ListScheduledMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListScheduledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListScheduledMock.invocationsDone()
+}
+
+// MinimockListScheduledInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListScheduledInspect() {
+	for _, e := range m.ListScheduledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListScheduled at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListScheduledCounter := mm_atomic.LoadUint64(&m.afterListScheduledCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListScheduledMock.defaultExpectation != nil && afterListScheduledCounter < 1 {
+		if m.ListScheduledMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListScheduled at\n%s", m.ListScheduledMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListScheduled at\n%s with params: %#v", m.ListScheduledMock.defaultExpectation.expectationOrigins.origin, *m.ListScheduledMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListScheduled != nil && afterListScheduledCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListScheduled at\n%s", m.funcListScheduledOrigin)
+	}
+
+	if !m.ListScheduledMock.invocationsDone() && afterListScheduledCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListScheduled at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListScheduledMock.expectedInvocations), m.ListScheduledMock.expectedInvocationsOrigin, afterListScheduledCounter)
+	}
+}
+
+type mChatServiceMockListSessions struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListSessionsExpectation
+	expectations       []*ChatServiceMockListSessionsExpectation
+
+	callArgs []*ChatServiceMockListSessionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListSessionsExpectation specifies expectation struct of the ChatService.ListSessions
+type ChatServiceMockListSessionsExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListSessionsParams
+	paramPtrs          *ChatServiceMockListSessionsParamPtrs
+	expectationOrigins ChatServiceMockListSessionsExpectationOrigins
+	results            *ChatServiceMockListSessionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListSessionsParams contains parameters of the ChatService.ListSessions
+type ChatServiceMockListSessionsParams struct {
+	ctx    context.Context
+	filter *model.SessionFilter
+}
+
+// ChatServiceMockListSessionsParamPtrs contains pointers to parameters of the ChatService.ListSessions
+type ChatServiceMockListSessionsParamPtrs struct {
+	ctx    *context.Context
+	filter **model.SessionFilter
+}
+
+// ChatServiceMockListSessionsResults contains results of the ChatService.ListSessions
+type ChatServiceMockListSessionsResults struct {
+	spa1 []*model.Session
+	err  error
+}
+
+// ChatServiceMockListSessionsOrigins contains origins of expectations of the ChatService.ListSessions
+type ChatServiceMockListSessionsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListSessions *mChatServiceMockListSessions) Optional() *mChatServiceMockListSessions {
+	mmListSessions.optional = true
+	return mmListSessions
+}
+
+// Expect sets up expected params for ChatService.ListSessions
+func (mmListSessions *mChatServiceMockListSessions) Expect(ctx context.Context, filter *model.SessionFilter) *mChatServiceMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("ChatServiceMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &ChatServiceMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs != nil {
+		mmListSessions.mock.t.Fatalf("ChatServiceMock.ListSessions mock is already set by ExpectParams functions")
+	}
+
+	mmListSessions.defaultExpectation.params = &ChatServiceMockListSessionsParams{ctx, filter}
+	mmListSessions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListSessions.expectations {
+		if minimock.Equal(e.params, mmListSessions.defaultExpectation.params) {
+			mmListSessions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListSessions.defaultExpectation.params)
+		}
+	}
+
+	return mmListSessions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListSessions
+func (mmListSessions *mChatServiceMockListSessions) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("ChatServiceMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &ChatServiceMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.params != nil {
+		mmListSessions.mock.t.Fatalf("ChatServiceMock.ListSessions mock is already set by Expect")
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs == nil {
+		mmListSessions.defaultExpectation.paramPtrs = &ChatServiceMockListSessionsParamPtrs{}
+	}
+	mmListSessions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListSessions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListSessions
+}
+
+// ExpectFilterParam2 sets up expected param filter for ChatService.ListSessions
+func (mmListSessions *mChatServiceMockListSessions) ExpectFilterParam2(filter *model.SessionFilter) *mChatServiceMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("ChatServiceMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &ChatServiceMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.params != nil {
+		mmListSessions.mock.t.Fatalf("ChatServiceMock.ListSessions mock is already set by Expect")
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs == nil {
+		mmListSessions.defaultExpectation.paramPtrs = &ChatServiceMockListSessionsParamPtrs{}
+	}
+	mmListSessions.defaultExpectation.paramPtrs.filter = &filter
+	mmListSessions.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListSessions
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListSessions
+func (mmListSessions *mChatServiceMockListSessions) Inspect(f func(ctx context.Context, filter *model.SessionFilter)) *mChatServiceMockListSessions {
+	if mmListSessions.mock.inspectFuncListSessions != nil {
+		mmListSessions.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListSessions")
+	}
+
+	mmListSessions.mock.inspectFuncListSessions = f
+
+	return mmListSessions
+}
+
+// Return sets up results that will be returned by ChatService.ListSessions
+func (mmListSessions *mChatServiceMockListSessions) Return(spa1 []*model.Session, err error) *ChatServiceMock {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("ChatServiceMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &ChatServiceMockListSessionsExpectation{mock: mmListSessions.mock}
+	}
+	mmListSessions.defaultExpectation.results = &ChatServiceMockListSessionsResults{spa1, err}
+	mmListSessions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListSessions.mock
+}
+
+// Set uses given function f to mock the ChatService.ListSessions method
+func (mmListSessions *mChatServiceMockListSessions) Set(f func(ctx context.Context, filter *model.SessionFilter) (spa1 []*model.Session, err error)) *ChatServiceMock {
+	if mmListSessions.defaultExpectation != nil {
+		mmListSessions.mock.t.Fatalf("Default expectation is already set for the ChatService.ListSessions method")
+	}
+
+	if len(mmListSessions.expectations) > 0 {
+		mmListSessions.mock.t.Fatalf("Some expectations are already set for the ChatService.ListSessions method")
+	}
+
+	mmListSessions.mock.funcListSessions = f
+	mmListSessions.mock.funcListSessionsOrigin = minimock.CallerInfo(1)
+	return mmListSessions.mock
+}
+
+// When sets expectation for the ChatService.ListSessions which will trigger the result defined by the following
+// Then helper
+func (mmListSessions *mChatServiceMockListSessions) When(ctx context.Context, filter *model.SessionFilter) *ChatServiceMockListSessionsExpectation {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("ChatServiceMock.ListSessions mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListSessionsExpectation{
+		mock:               mmListSessions.mock,
+		params:             &ChatServiceMockListSessionsParams{ctx, filter},
+		expectationOrigins: ChatServiceMockListSessionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListSessions.expectations = append(mmListSessions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListSessions return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListSessionsExpectation) Then(spa1 []*model.Session, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListSessionsResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListSessions should be invoked
+func (mmListSessions *mChatServiceMockListSessions) Times(n uint64) *mChatServiceMockListSessions {
+	if n == 0 {
+		mmListSessions.mock.t.Fatalf("Times of ChatServiceMock.ListSessions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListSessions.expectedInvocations, n)
+	mmListSessions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListSessions
+}
+
+func (mmListSessions *mChatServiceMockListSessions) invocationsDone() bool {
+	if len(mmListSessions.expectations) == 0 && mmListSessions.defaultExpectation == nil && mmListSessions.mock.funcListSessions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListSessions.mock.afterListSessionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListSessions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListSessions implements mm_service.ChatService
+func (mmListSessions *ChatServiceMock) ListSessions(ctx context.Context, filter *model.SessionFilter) (spa1 []*model.Session, err error) {
+	mm_atomic.AddUint64(&mmListSessions.beforeListSessionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListSessions.afterListSessionsCounter, 1)
+
+	mmListSessions.t.Helper()
+
+	if mmListSessions.inspectFuncListSessions != nil {
+		mmListSessions.inspectFuncListSessions(ctx, filter)
+	}
+
+	mm_params := ChatServiceMockListSessionsParams{ctx, filter}
+
+	// Record call args
+	mmListSessions.ListSessionsMock.mutex.Lock()
+	mmListSessions.ListSessionsMock.callArgs = append(mmListSessions.ListSessionsMock.callArgs, &mm_params)
+	mmListSessions.ListSessionsMock.mutex.Unlock()
+
+	for _, e := range mmListSessions.ListSessionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmListSessions.ListSessionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListSessions.ListSessionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListSessions.ListSessionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListSessions.ListSessionsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListSessionsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListSessions.t.Errorf("ChatServiceMock.ListSessions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListSessions.t.Errorf("ChatServiceMock.ListSessions got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListSessions.t.Errorf("ChatServiceMock.ListSessions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListSessions.ListSessionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListSessions.t.Fatal("No results are set for the ChatServiceMock.ListSessions")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmListSessions.funcListSessions != nil {
+		return mmListSessions.funcListSessions(ctx, filter)
+	}
+	mmListSessions.t.Fatalf("Unexpected call to ChatServiceMock.ListSessions. %v %v", ctx, filter)
+	return
+}
+
+// ListSessionsAfterCounter returns a count of finished ChatServiceMock.ListSessions invocations
+func (mmListSessions *ChatServiceMock) ListSessionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSessions.afterListSessionsCounter)
+}
+
+// ListSessionsBeforeCounter returns a count of ChatServiceMock.ListSessions invocations
+func (mmListSessions *ChatServiceMock) ListSessionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSessions.beforeListSessionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListSessions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListSessions *mChatServiceMockListSessions) Calls() []*ChatServiceMockListSessionsParams {
+	mmListSessions.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListSessionsParams, len(mmListSessions.callArgs))
+	copy(argCopy, mmListSessions.callArgs)
+
+	mmListSessions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListSessionsDone returns true if the count of the ListSessions invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListSessionsDone() bool {
+	if m.ListSessionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListSessionsMock.invocationsDone()
+}
+
+// MinimockListSessionsInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListSessionsInspect() {
+	for _, e := range m.ListSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListSessions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListSessionsCounter := mm_atomic.LoadUint64(&m.afterListSessionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSessionsMock.defaultExpectation != nil && afterListSessionsCounter < 1 {
+		if m.ListSessionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListSessions at\n%s", m.ListSessionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListSessions at\n%s with params: %#v", m.ListSessionsMock.defaultExpectation.expectationOrigins.origin, *m.ListSessionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSessions != nil && afterListSessionsCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListSessions at\n%s", m.funcListSessionsOrigin)
+	}
+
+	if !m.ListSessionsMock.invocationsDone() && afterListSessionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListSessions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListSessionsMock.expectedInvocations), m.ListSessionsMock.expectedInvocationsOrigin, afterListSessionsCounter)
+	}
+}
+
+type mChatServiceMockListThread struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListThreadExpectation
+	expectations       []*ChatServiceMockListThreadExpectation
+
+	callArgs []*ChatServiceMockListThreadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListThreadExpectation specifies expectation struct of the ChatService.ListThread
+type ChatServiceMockListThreadExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListThreadParams
+	paramPtrs          *ChatServiceMockListThreadParamPtrs
+	expectationOrigins ChatServiceMockListThreadExpectationOrigins
+	results            *ChatServiceMockListThreadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListThreadParams contains parameters of the ChatService.ListThread
+type ChatServiceMockListThreadParams struct {
+	ctx  context.Context
+	page *model.ThreadPage
+}
+
+// ChatServiceMockListThreadParamPtrs contains pointers to parameters of the ChatService.ListThread
+type ChatServiceMockListThreadParamPtrs struct {
+	ctx  *context.Context
+	page **model.ThreadPage
+}
+
+// ChatServiceMockListThreadResults contains results of the ChatService.ListThread
+type ChatServiceMockListThreadResults struct {
+	tp1 *model.Thread
+	err error
+}
+
+// ChatServiceMockListThreadOrigins contains origins of expectations of the ChatService.ListThread
+type ChatServiceMockListThreadExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originPage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListThread *mChatServiceMockListThread) Optional() *mChatServiceMockListThread {
+	mmListThread.optional = true
+	return mmListThread
+}
+
+// Expect sets up expected params for ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) Expect(ctx context.Context, page *model.ThreadPage) *mChatServiceMockListThread {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{}
+	}
+
+	if mmListThread.defaultExpectation.paramPtrs != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by ExpectParams functions")
+	}
+
+	mmListThread.defaultExpectation.params = &ChatServiceMockListThreadParams{ctx, page}
+	mmListThread.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListThread.expectations {
+		if minimock.Equal(e.params, mmListThread.defaultExpectation.params) {
+			mmListThread.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListThread.defaultExpectation.params)
+		}
+	}
+
+	return mmListThread
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListThread {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{}
+	}
+
+	if mmListThread.defaultExpectation.params != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Expect")
+	}
+
+	if mmListThread.defaultExpectation.paramPtrs == nil {
+		mmListThread.defaultExpectation.paramPtrs = &ChatServiceMockListThreadParamPtrs{}
+	}
+	mmListThread.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListThread.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListThread
+}
+
+// ExpectPageParam2 sets up expected param page for ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) ExpectPageParam2(page *model.ThreadPage) *mChatServiceMockListThread {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{}
+	}
+
+	if mmListThread.defaultExpectation.params != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Expect")
+	}
+
+	if mmListThread.defaultExpectation.paramPtrs == nil {
+		mmListThread.defaultExpectation.paramPtrs = &ChatServiceMockListThreadParamPtrs{}
+	}
+	mmListThread.defaultExpectation.paramPtrs.page = &page
+	mmListThread.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmListThread
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) Inspect(f func(ctx context.Context, page *model.ThreadPage)) *mChatServiceMockListThread {
+	if mmListThread.mock.inspectFuncListThread != nil {
+		mmListThread.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListThread")
+	}
+
+	mmListThread.mock.inspectFuncListThread = f
+
+	return mmListThread
+}
+
+// Return sets up results that will be returned by ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) Return(tp1 *model.Thread, err error) *ChatServiceMock {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{mock: mmListThread.mock}
+	}
+	mmListThread.defaultExpectation.results = &ChatServiceMockListThreadResults{tp1, err}
+	mmListThread.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListThread.mock
+}
+
+// Set uses given function f to mock the ChatService.ListThread method
+func (mmListThread *mChatServiceMockListThread) Set(f func(ctx context.Context, page *model.ThreadPage) (tp1 *model.Thread, err error)) *ChatServiceMock {
+	if mmListThread.defaultExpectation != nil {
+		mmListThread.mock.t.Fatalf("Default expectation is already set for the ChatService.ListThread method")
+	}
+
+	if len(mmListThread.expectations) > 0 {
+		mmListThread.mock.t.Fatalf("Some expectations are already set for the ChatService.ListThread method")
+	}
+
+	mmListThread.mock.funcListThread = f
+	mmListThread.mock.funcListThreadOrigin = minimock.CallerInfo(1)
+	return mmListThread.mock
+}
+
+// When sets expectation for the ChatService.ListThread which will trigger the result defined by the following
+// Then helper
+func (mmListThread *mChatServiceMockListThread) When(ctx context.Context, page *model.ThreadPage) *ChatServiceMockListThreadExpectation {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListThreadExpectation{
+		mock:               mmListThread.mock,
+		params:             &ChatServiceMockListThreadParams{ctx, page},
+		expectationOrigins: ChatServiceMockListThreadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListThread.expectations = append(mmListThread.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListThread return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListThreadExpectation) Then(tp1 *model.Thread, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListThreadResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListThread should be invoked
+func (mmListThread *mChatServiceMockListThread) Times(n uint64) *mChatServiceMockListThread {
+	if n == 0 {
+		mmListThread.mock.t.Fatalf("Times of ChatServiceMock.ListThread mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListThread.expectedInvocations, n)
+	mmListThread.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListThread
+}
+
+func (mmListThread *mChatServiceMockListThread) invocationsDone() bool {
+	if len(mmListThread.expectations) == 0 && mmListThread.defaultExpectation == nil && mmListThread.mock.funcListThread == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListThread.mock.afterListThreadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListThread.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListThread implements mm_service.ChatService
+func (mmListThread *ChatServiceMock) ListThread(ctx context.Context, page *model.ThreadPage) (tp1 *model.Thread, err error) {
+	mm_atomic.AddUint64(&mmListThread.beforeListThreadCounter, 1)
+	defer mm_atomic.AddUint64(&mmListThread.afterListThreadCounter, 1)
+
+	mmListThread.t.Helper()
+
+	if mmListThread.inspectFuncListThread != nil {
+		mmListThread.inspectFuncListThread(ctx, page)
+	}
+
+	mm_params := ChatServiceMockListThreadParams{ctx, page}
+
+	// Record call args
+	mmListThread.ListThreadMock.mutex.Lock()
+	mmListThread.ListThreadMock.callArgs = append(mmListThread.ListThreadMock.callArgs, &mm_params)
+	mmListThread.ListThreadMock.mutex.Unlock()
+
+	for _, e := range mmListThread.ListThreadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmListThread.ListThreadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListThread.ListThreadMock.defaultExpectation.Counter, 1)
+		mm_want := mmListThread.ListThreadMock.defaultExpectation.params
+		mm_want_ptrs := mmListThread.ListThreadMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListThreadParams{ctx, page}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListThread.t.Errorf("ChatServiceMock.ListThread got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListThread.ListThreadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmListThread.t.Errorf("ChatServiceMock.ListThread got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListThread.ListThreadMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListThread.t.Errorf("ChatServiceMock.ListThread got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListThread.ListThreadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListThread.ListThreadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListThread.t.Fatal("No results are set for the ChatServiceMock.ListThread")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmListThread.funcListThread != nil {
+		return mmListThread.funcListThread(ctx, page)
+	}
+	mmListThread.t.Fatalf("Unexpected call to ChatServiceMock.ListThread. %v %v", ctx, page)
+	return
+}
+
+// ListThreadAfterCounter returns a count of finished ChatServiceMock.ListThread invocations
+func (mmListThread *ChatServiceMock) ListThreadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListThread.afterListThreadCounter)
+}
+
+// ListThreadBeforeCounter returns a count of ChatServiceMock.ListThread invocations
+func (mmListThread *ChatServiceMock) ListThreadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListThread.beforeListThreadCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListThread.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListThread *mChatServiceMockListThread) Calls() []*ChatServiceMockListThreadParams {
+	mmListThread.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListThreadParams, len(mmListThread.callArgs))
+	copy(argCopy, mmListThread.callArgs)
+
+	mmListThread.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListThreadDone returns true if the count of the ListThread invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListThreadDone() bool {
+	if m.ListThreadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListThreadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListThreadMock.invocationsDone()
+}
+
+// MinimockListThreadInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListThreadInspect() {
+	for _, e := range m.ListThreadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListThread at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListThreadCounter := mm_atomic.LoadUint64(&m.afterListThreadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListThreadMock.defaultExpectation != nil && afterListThreadCounter < 1 {
+		if m.ListThreadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListThread at\n%s", m.ListThreadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListThread at\n%s with params: %#v", m.ListThreadMock.defaultExpectation.expectationOrigins.origin, *m.ListThreadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListThread != nil && afterListThreadCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListThread at\n%s", m.funcListThreadOrigin)
+	}
+
+	if !m.ListThreadMock.invocationsDone() && afterListThreadCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListThread at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListThreadMock.expectedInvocations), m.ListThreadMock.expectedInvocationsOrigin, afterListThreadCounter)
+	}
+}
+
+type mChatServiceMockListUndelivered struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListUndeliveredExpectation
+	expectations       []*ChatServiceMockListUndeliveredExpectation
+
+	callArgs []*ChatServiceMockListUndeliveredParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListUndeliveredExpectation specifies expectation struct of the ChatService.ListUndelivered
+type ChatServiceMockListUndeliveredExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListUndeliveredParams
+	paramPtrs          *ChatServiceMockListUndeliveredParamPtrs
+	expectationOrigins ChatServiceMockListUndeliveredExpectationOrigins
+	results            *ChatServiceMockListUndeliveredResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListUndeliveredParams contains parameters of the ChatService.ListUndelivered
+type ChatServiceMockListUndeliveredParams struct {
+	ctx  context.Context
+	page *model.UndeliveredPage
+}
+
+// ChatServiceMockListUndeliveredParamPtrs contains pointers to parameters of the ChatService.ListUndelivered
+type ChatServiceMockListUndeliveredParamPtrs struct {
+	ctx  *context.Context
+	page **model.UndeliveredPage
+}
+
+// ChatServiceMockListUndeliveredResults contains results of the ChatService.ListUndelivered
+type ChatServiceMockListUndeliveredResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// ChatServiceMockListUndeliveredOrigins contains origins of expectations of the ChatService.ListUndelivered
+type ChatServiceMockListUndeliveredExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originPage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListUndelivered *mChatServiceMockListUndelivered) Optional() *mChatServiceMockListUndelivered {
+	mmListUndelivered.optional = true
+	return mmListUndelivered
+}
+
+// Expect sets up expected params for ChatService.ListUndelivered
+func (mmListUndelivered *mChatServiceMockListUndelivered) Expect(ctx context.Context, page *model.UndeliveredPage) *mChatServiceMockListUndelivered {
+	if mmListUndelivered.mock.funcListUndelivered != nil {
+		mmListUndelivered.mock.t.Fatalf("ChatServiceMock.ListUndelivered mock is already set by Set")
+	}
+
+	if mmListUndelivered.defaultExpectation == nil {
+		mmListUndelivered.defaultExpectation = &ChatServiceMockListUndeliveredExpectation{}
+	}
+
+	if mmListUndelivered.defaultExpectation.paramPtrs != nil {
+		mmListUndelivered.mock.t.Fatalf("ChatServiceMock.ListUndelivered mock is already set by ExpectParams functions")
+	}
+
+	mmListUndelivered.defaultExpectation.params = &ChatServiceMockListUndeliveredParams{ctx, page}
+	mmListUndelivered.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListUndelivered.expectations {
+		if minimock.Equal(e.params, mmListUndelivered.defaultExpectation.params) {
+			mmListUndelivered.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUndelivered.defaultExpectation.params)
+		}
+	}
+
+	return mmListUndelivered
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListUndelivered
+func (mmListUndelivered *mChatServiceMockListUndelivered) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListUndelivered {
+	if mmListUndelivered.mock.funcListUndelivered != nil {
+		mmListUndelivered.mock.t.Fatalf("ChatServiceMock.ListUndelivered mock is already set by Set")
+	}
+
+	if mmListUndelivered.defaultExpectation == nil {
+		mmListUndelivered.defaultExpectation = &ChatServiceMockListUndeliveredExpectation{}
+	}
+
+	if mmListUndelivered.defaultExpectation.params != nil {
+		mmListUndelivered.mock.t.Fatalf("ChatServiceMock.ListUndelivered mock is already set by Expect")
+	}
+
+	if mmListUndelivered.defaultExpectation.paramPtrs == nil {
+		mmListUndelivered.defaultExpectation.paramPtrs = &ChatServiceMockListUndeliveredParamPtrs{}
+	}
+	mmListUndelivered.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListUndelivered.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListUndelivered
+}
+
+// ExpectPageParam2 sets up expected param page for ChatService.ListUndelivered
+func (mmListUndelivered *mChatServiceMockListUndelivered) ExpectPageParam2(page *model.UndeliveredPage) *mChatServiceMockListUndelivered {
+	if mmListUndelivered.mock.funcListUndelivered != nil {
+		mmListUndelivered.mock.t.Fatalf("ChatServiceMock.ListUndelivered mock is already set by Set")
+	}
+
+	if mmListUndelivered.defaultExpectation == nil {
+		mmListUndelivered.defaultExpectation = &ChatServiceMockListUndeliveredExpectation{}
+	}
+
+	if mmListUndelivered.defaultExpectation.params != nil {
+		mmListUndelivered.mock.t.Fatalf("ChatServiceMock.ListUndelivered mock is already set by Expect")
+	}
+
+	if mmListUndelivered.defaultExpectation.paramPtrs == nil {
+		mmListUndelivered.defaultExpectation.paramPtrs = &ChatServiceMockListUndeliveredParamPtrs{}
+	}
+	mmListUndelivered.defaultExpectation.paramPtrs.page = &page
+	mmListUndelivered.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmListUndelivered
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListUndelivered
+func (mmListUndelivered *mChatServiceMockListUndelivered) Inspect(f func(ctx context.Context, page *model.UndeliveredPage)) *mChatServiceMockListUndelivered {
+	if mmListUndelivered.mock.inspectFuncListUndelivered != nil {
+		mmListUndelivered.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListUndelivered")
+	}
+
+	mmListUndelivered.mock.inspectFuncListUndelivered = f
+
+	return mmListUndelivered
+}
+
+// Return sets up results that will be returned by ChatService.ListUndelivered
+func (mmListUndelivered *mChatServiceMockListUndelivered) Return(mpa1 []*model.Message, err error) *ChatServiceMock {
+	if mmListUndelivered.mock.funcListUndelivered != nil {
+		mmListUndelivered.mock.t.Fatalf("ChatServiceMock.ListUndelivered mock is already set by Set")
+	}
+
+	if mmListUndelivered.defaultExpectation == nil {
+		mmListUndelivered.defaultExpectation = &ChatServiceMockListUndeliveredExpectation{mock: mmListUndelivered.mock}
+	}
+	mmListUndelivered.defaultExpectation.results = &ChatServiceMockListUndeliveredResults{mpa1, err}
+	mmListUndelivered.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListUndelivered.mock
+}
+
+// Set uses given function f to mock the ChatService.ListUndelivered method
+func (mmListUndelivered *mChatServiceMockListUndelivered) Set(f func(ctx context.Context, page *model.UndeliveredPage) (mpa1 []*model.Message, err error)) *ChatServiceMock {
+	if mmListUndelivered.defaultExpectation != nil {
+		mmListUndelivered.mock.t.Fatalf("Default expectation is already set for the ChatService.ListUndelivered method")
+	}
+
+	if len(mmListUndelivered.expectations) > 0 {
+		mmListUndelivered.mock.t.Fatalf("Some expectations are already set for the ChatService.ListUndelivered method")
+	}
+
+	mmListUndelivered.mock.funcListUndelivered = f
+	mmListUndelivered.mock.funcListUndeliveredOrigin = minimock.CallerInfo(1)
+	return mmListUndelivered.mock
+}
+
+// When sets expectation for the ChatService.ListUndelivered which will trigger the result defined by the following
+// Then helper
+func (mmListUndelivered *mChatServiceMockListUndelivered) When(ctx context.Context, page *model.UndeliveredPage) *ChatServiceMockListUndeliveredExpectation {
+	if mmListUndelivered.mock.funcListUndelivered != nil {
+		mmListUndelivered.mock.t.Fatalf("ChatServiceMock.ListUndelivered mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListUndeliveredExpectation{
+		mock:               mmListUndelivered.mock,
+		params:             &ChatServiceMockListUndeliveredParams{ctx, page},
+		expectationOrigins: ChatServiceMockListUndeliveredExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListUndelivered.expectations = append(mmListUndelivered.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListUndelivered return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListUndeliveredExpectation) Then(mpa1 []*model.Message, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListUndeliveredResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListUndelivered should be invoked
+func (mmListUndelivered *mChatServiceMockListUndelivered) Times(n uint64) *mChatServiceMockListUndelivered {
+	if n == 0 {
+		mmListUndelivered.mock.t.Fatalf("Times of ChatServiceMock.ListUndelivered mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListUndelivered.expectedInvocations, n)
+	mmListUndelivered.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListUndelivered
+}
+
+func (mmListUndelivered *mChatServiceMockListUndelivered) invocationsDone() bool {
+	if len(mmListUndelivered.expectations) == 0 && mmListUndelivered.defaultExpectation == nil && mmListUndelivered.mock.funcListUndelivered == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListUndelivered.mock.afterListUndeliveredCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListUndelivered.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListUndelivered implements mm_service.ChatService
+func (mmListUndelivered *ChatServiceMock) ListUndelivered(ctx context.Context, page *model.UndeliveredPage) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmListUndelivered.beforeListUndeliveredCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUndelivered.afterListUndeliveredCounter, 1)
+
+	mmListUndelivered.t.Helper()
+
+	if mmListUndelivered.inspectFuncListUndelivered != nil {
+		mmListUndelivered.inspectFuncListUndelivered(ctx, page)
+	}
+
+	mm_params := ChatServiceMockListUndeliveredParams{ctx, page}
+
+	// Record call args
+	mmListUndelivered.ListUndeliveredMock.mutex.Lock()
+	mmListUndelivered.ListUndeliveredMock.callArgs = append(mmListUndelivered.ListUndeliveredMock.callArgs, &mm_params)
+	mmListUndelivered.ListUndeliveredMock.mutex.Unlock()
+
+	for _, e := range mmListUndelivered.ListUndeliveredMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListUndelivered.ListUndeliveredMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUndelivered.ListUndeliveredMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUndelivered.ListUndeliveredMock.defaultExpectation.params
+		mm_want_ptrs := mmListUndelivered.ListUndeliveredMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListUndeliveredParams{ctx, page}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListUndelivered.t.Errorf("ChatServiceMock.ListUndelivered got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUndelivered.ListUndeliveredMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmListUndelivered.t.Errorf("ChatServiceMock.ListUndelivered got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListUndelivered.ListUndeliveredMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListUndelivered.t.Errorf("ChatServiceMock.ListUndelivered got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListUndelivered.ListUndeliveredMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListUndelivered.ListUndeliveredMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListUndelivered.t.Fatal("No results are set for the ChatServiceMock.ListUndelivered")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListUndelivered.funcListUndelivered != nil {
+		return mmListUndelivered.funcListUndelivered(ctx, page)
+	}
+	mmListUndelivered.t.Fatalf("Unexpected call to ChatServiceMock.ListUndelivered. %v %v", ctx, page)
+	return
+}
+
+// ListUndeliveredAfterCounter returns a count of finished ChatServiceMock.ListUndelivered invocations
+func (mmListUndelivered *ChatServiceMock) ListUndeliveredAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUndelivered.afterListUndeliveredCounter)
+}
+
+// ListUndeliveredBeforeCounter returns a count of ChatServiceMock.ListUndelivered invocations
+func (mmListUndelivered *ChatServiceMock) ListUndeliveredBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUndelivered.beforeListUndeliveredCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListUndelivered.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListUndelivered *mChatServiceMockListUndelivered) Calls() []*ChatServiceMockListUndeliveredParams {
+	mmListUndelivered.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListUndeliveredParams, len(mmListUndelivered.callArgs))
+	copy(argCopy, mmListUndelivered.callArgs)
+
+	mmListUndelivered.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListUndeliveredDone returns true if the count of the ListUndelivered invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListUndeliveredDone() bool {
+	if m.ListUndeliveredMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListUndeliveredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListUndeliveredMock.invocationsDone()
+}
+
+// MinimockListUndeliveredInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListUndeliveredInspect() {
+	for _, e := range m.ListUndeliveredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListUndelivered at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListUndeliveredCounter := mm_atomic.LoadUint64(&m.afterListUndeliveredCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUndeliveredMock.defaultExpectation != nil && afterListUndeliveredCounter < 1 {
+		if m.ListUndeliveredMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListUndelivered at\n%s", m.ListUndeliveredMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListUndelivered at\n%s with params: %#v", m.ListUndeliveredMock.defaultExpectation.expectationOrigins.origin, *m.ListUndeliveredMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUndelivered != nil && afterListUndeliveredCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListUndelivered at\n%s", m.funcListUndeliveredOrigin)
+	}
+
+	if !m.ListUndeliveredMock.invocationsDone() && afterListUndeliveredCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListUndelivered at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListUndeliveredMock.expectedInvocations), m.ListUndeliveredMock.expectedInvocationsOrigin, afterListUndeliveredCounter)
+	}
+}
+
+type mChatServiceMockMarkRead struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockMarkReadExpectation
+	expectations       []*ChatServiceMockMarkReadExpectation
+
+	callArgs []*ChatServiceMockMarkReadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockMarkReadExpectation specifies expectation struct of the ChatService.MarkRead
+type ChatServiceMockMarkReadExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockMarkReadParams
+	paramPtrs          *ChatServiceMockMarkReadParamPtrs
+	expectationOrigins ChatServiceMockMarkReadExpectationOrigins
+	results            *ChatServiceMockMarkReadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockMarkReadParams contains parameters of the ChatService.MarkRead
+type ChatServiceMockMarkReadParams struct {
+	ctx  context.Context
+	read *model.DeliveryAck
+}
+
+// ChatServiceMockMarkReadParamPtrs contains pointers to parameters of the ChatService.MarkRead
+type ChatServiceMockMarkReadParamPtrs struct {
+	ctx  *context.Context
+	read **model.DeliveryAck
+}
+
+// ChatServiceMockMarkReadResults contains results of the ChatService.MarkRead
+type ChatServiceMockMarkReadResults struct {
+	err error
+}
+
+// ChatServiceMockMarkReadOrigins contains origins of expectations of the ChatService.MarkRead
+type ChatServiceMockMarkReadExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRead string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkRead *mChatServiceMockMarkRead) Optional() *mChatServiceMockMarkRead {
+	mmMarkRead.optional = true
+	return mmMarkRead
+}
+
+// Expect sets up expected params for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) Expect(ctx context.Context, read *model.DeliveryAck) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by ExpectParams functions")
+	}
+
+	mmMarkRead.defaultExpectation.params = &ChatServiceMockMarkReadParams{ctx, read}
+	mmMarkRead.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkRead.expectations {
+		if minimock.Equal(e.params, mmMarkRead.defaultExpectation.params) {
+			mmMarkRead.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkRead.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkRead
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) ExpectCtxParam1(ctx context.Context) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatServiceMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkRead.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectReadParam2 sets up expected param read for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) ExpectReadParam2(read *model.DeliveryAck) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatServiceMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.read = &read
+	mmMarkRead.defaultExpectation.expectationOrigins.originRead = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) Inspect(f func(ctx context.Context, read *model.DeliveryAck)) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.inspectFuncMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.MarkRead")
+	}
+
+	mmMarkRead.mock.inspectFuncMarkRead = f
+
+	return mmMarkRead
+}
+
+// Return sets up results that will be returned by ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) Return(err error) *ChatServiceMock {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{mock: mmMarkRead.mock}
+	}
+	mmMarkRead.defaultExpectation.results = &ChatServiceMockMarkReadResults{err}
+	mmMarkRead.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkRead.mock
+}
+
+// Set uses given function f to mock the ChatService.MarkRead method
+func (mmMarkRead *mChatServiceMockMarkRead) Set(f func(ctx context.Context, read *model.DeliveryAck) (err error)) *ChatServiceMock {
+	if mmMarkRead.defaultExpectation != nil {
+		mmMarkRead.mock.t.Fatalf("Default expectation is already set for the ChatService.MarkRead method")
+	}
+
+	if len(mmMarkRead.expectations) > 0 {
+		mmMarkRead.mock.t.Fatalf("Some expectations are already set for the ChatService.MarkRead method")
+	}
+
+	mmMarkRead.mock.funcMarkRead = f
+	mmMarkRead.mock.funcMarkReadOrigin = minimock.CallerInfo(1)
+	return mmMarkRead.mock
+}
+
+// When sets expectation for the ChatService.MarkRead which will trigger the result defined by the following
+// Then helper
+func (mmMarkRead *mChatServiceMockMarkRead) When(ctx context.Context, read *model.DeliveryAck) *ChatServiceMockMarkReadExpectation {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockMarkReadExpectation{
+		mock:               mmMarkRead.mock,
+		params:             &ChatServiceMockMarkReadParams{ctx, read},
+		expectationOrigins: ChatServiceMockMarkReadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkRead.expectations = append(mmMarkRead.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.MarkRead return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockMarkReadExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockMarkReadResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.MarkRead should be invoked
+func (mmMarkRead *mChatServiceMockMarkRead) Times(n uint64) *mChatServiceMockMarkRead {
+	if n == 0 {
+		mmMarkRead.mock.t.Fatalf("Times of ChatServiceMock.MarkRead mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkRead.expectedInvocations, n)
+	mmMarkRead.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkRead
+}
+
+func (mmMarkRead *mChatServiceMockMarkRead) invocationsDone() bool {
+	if len(mmMarkRead.expectations) == 0 && mmMarkRead.defaultExpectation == nil && mmMarkRead.mock.funcMarkRead == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkRead.mock.afterMarkReadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkRead.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkRead implements mm_service.ChatService
+func (mmMarkRead *ChatServiceMock) MarkRead(ctx context.Context, read *model.DeliveryAck) (err error) {
+	mm_atomic.AddUint64(&mmMarkRead.beforeMarkReadCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkRead.afterMarkReadCounter, 1)
+
+	mmMarkRead.t.Helper()
+
+	if mmMarkRead.inspectFuncMarkRead != nil {
+		mmMarkRead.inspectFuncMarkRead(ctx, read)
+	}
+
+	mm_params := ChatServiceMockMarkReadParams{ctx, read}
+
+	// Record call args
+	mmMarkRead.MarkReadMock.mutex.Lock()
+	mmMarkRead.MarkReadMock.callArgs = append(mmMarkRead.MarkReadMock.callArgs, &mm_params)
+	mmMarkRead.MarkReadMock.mutex.Unlock()
+
+	for _, e := range mmMarkRead.MarkReadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkRead.MarkReadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkRead.MarkReadMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkRead.MarkReadMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkRead.MarkReadMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockMarkReadParams{ctx, read}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.read != nil && !minimock.Equal(*mm_want_ptrs.read, mm_got.read) {
+				mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameter read, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originRead, *mm_want_ptrs.read, mm_got.read, minimock.Diff(*mm_want_ptrs.read, mm_got.read))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkRead.MarkReadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkRead.t.Fatal("No results are set for the ChatServiceMock.MarkRead")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkRead.funcMarkRead != nil {
+		return mmMarkRead.funcMarkRead(ctx, read)
+	}
+	mmMarkRead.t.Fatalf("Unexpected call to ChatServiceMock.MarkRead. %v %v", ctx, read)
+	return
+}
+
+// MarkReadAfterCounter returns a count of finished ChatServiceMock.MarkRead invocations
+func (mmMarkRead *ChatServiceMock) MarkReadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.afterMarkReadCounter)
+}
+
+// MarkReadBeforeCounter returns a count of ChatServiceMock.MarkRead invocations
+func (mmMarkRead *ChatServiceMock) MarkReadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.beforeMarkReadCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.MarkRead.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkRead *mChatServiceMockMarkRead) Calls() []*ChatServiceMockMarkReadParams {
+	mmMarkRead.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockMarkReadParams, len(mmMarkRead.callArgs))
+	copy(argCopy, mmMarkRead.callArgs)
+
+	mmMarkRead.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkReadDone returns true if the count of the MarkRead invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockMarkReadDone() bool {
+	if m.MarkReadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkReadMock.invocationsDone()
+}
+
+// MinimockMarkReadInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockMarkReadInspect() {
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.MarkRead at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkReadCounter := mm_atomic.LoadUint64(&m.afterMarkReadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkReadMock.defaultExpectation != nil && afterMarkReadCounter < 1 {
+		if m.MarkReadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.MarkRead at\n%s", m.MarkReadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.MarkRead at\n%s with params: %#v", m.MarkReadMock.defaultExpectation.expectationOrigins.origin, *m.MarkReadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkRead != nil && afterMarkReadCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.MarkRead at\n%s", m.funcMarkReadOrigin)
+	}
+
+	if !m.MarkReadMock.invocationsDone() && afterMarkReadCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.MarkRead at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkReadMock.expectedInvocations), m.MarkReadMock.expectedInvocationsOrigin, afterMarkReadCounter)
+	}
+}
+
+type mChatServiceMockOpenSession struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockOpenSessionExpectation
+	expectations       []*ChatServiceMockOpenSessionExpectation
+
+	callArgs []*ChatServiceMockOpenSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockOpenSessionExpectation specifies expectation struct of the ChatService.OpenSession
+type ChatServiceMockOpenSessionExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockOpenSessionParams
+	paramPtrs          *ChatServiceMockOpenSessionParamPtrs
+	expectationOrigins ChatServiceMockOpenSessionExpectationOrigins
+	results            *ChatServiceMockOpenSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockOpenSessionParams contains parameters of the ChatService.OpenSession
+type ChatServiceMockOpenSessionParams struct {
+	ctx     context.Context
+	session *model.Session
+}
+
+// ChatServiceMockOpenSessionParamPtrs contains pointers to parameters of the ChatService.OpenSession
+type ChatServiceMockOpenSessionParamPtrs struct {
+	ctx     *context.Context
+	session **model.Session
+}
+
+// ChatServiceMockOpenSessionResults contains results of the ChatService.OpenSession
+type ChatServiceMockOpenSessionResults struct {
+	err error
+}
+
+// ChatServiceMockOpenSessionOrigins contains origins of expectations of the ChatService.OpenSession
+type ChatServiceMockOpenSessionExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originSession string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmOpenSession *mChatServiceMockOpenSession) Optional() *mChatServiceMockOpenSession {
+	mmOpenSession.optional = true
+	return mmOpenSession
+}
+
+// Expect sets up expected params for ChatService.OpenSession
+func (mmOpenSession *mChatServiceMockOpenSession) Expect(ctx context.Context, session *model.Session) *mChatServiceMockOpenSession {
+	if mmOpenSession.mock.funcOpenSession != nil {
+		mmOpenSession.mock.t.Fatalf("ChatServiceMock.OpenSession mock is already set by Set")
+	}
+
+	if mmOpenSession.defaultExpectation == nil {
+		mmOpenSession.defaultExpectation = &ChatServiceMockOpenSessionExpectation{}
+	}
+
+	if mmOpenSession.defaultExpectation.paramPtrs != nil {
+		mmOpenSession.mock.t.Fatalf("ChatServiceMock.OpenSession mock is already set by ExpectParams functions")
+	}
+
+	mmOpenSession.defaultExpectation.params = &ChatServiceMockOpenSessionParams{ctx, session}
+	mmOpenSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmOpenSession.expectations {
+		if minimock.Equal(e.params, mmOpenSession.defaultExpectation.params) {
+			mmOpenSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOpenSession.defaultExpectation.params)
+		}
+	}
+
+	return mmOpenSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.OpenSession
+func (mmOpenSession *mChatServiceMockOpenSession) ExpectCtxParam1(ctx context.Context) *mChatServiceMockOpenSession {
+	if mmOpenSession.mock.funcOpenSession != nil {
+		mmOpenSession.mock.t.Fatalf("ChatServiceMock.OpenSession mock is already set by Set")
+	}
+
+	if mmOpenSession.defaultExpectation == nil {
+		mmOpenSession.defaultExpectation = &ChatServiceMockOpenSessionExpectation{}
+	}
+
+	if mmOpenSession.defaultExpectation.params != nil {
+		mmOpenSession.mock.t.Fatalf("ChatServiceMock.OpenSession mock is already set by Expect")
+	}
+
+	if mmOpenSession.defaultExpectation.paramPtrs == nil {
+		mmOpenSession.defaultExpectation.paramPtrs = &ChatServiceMockOpenSessionParamPtrs{}
+	}
+	mmOpenSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmOpenSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmOpenSession
+}
+
+// ExpectSessionParam2 sets up expected param session for ChatService.OpenSession
+func (mmOpenSession *mChatServiceMockOpenSession) ExpectSessionParam2(session *model.Session) *mChatServiceMockOpenSession {
+	if mmOpenSession.mock.funcOpenSession != nil {
+		mmOpenSession.mock.t.Fatalf("ChatServiceMock.OpenSession mock is already set by Set")
+	}
+
+	if mmOpenSession.defaultExpectation == nil {
+		mmOpenSession.defaultExpectation = &ChatServiceMockOpenSessionExpectation{}
+	}
+
+	if mmOpenSession.defaultExpectation.params != nil {
+		mmOpenSession.mock.t.Fatalf("ChatServiceMock.OpenSession mock is already set by Expect")
+	}
+
+	if mmOpenSession.defaultExpectation.paramPtrs == nil {
+		mmOpenSession.defaultExpectation.paramPtrs = &ChatServiceMockOpenSessionParamPtrs{}
+	}
+	mmOpenSession.defaultExpectation.paramPtrs.session = &session
+	mmOpenSession.defaultExpectation.expectationOrigins.originSession = minimock.CallerInfo(1)
+
+	return mmOpenSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.OpenSession
+func (mmOpenSession *mChatServiceMockOpenSession) Inspect(f func(ctx context.Context, session *model.Session)) *mChatServiceMockOpenSession {
+	if mmOpenSession.mock.inspectFuncOpenSession != nil {
+		mmOpenSession.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.OpenSession")
+	}
+
+	mmOpenSession.mock.inspectFuncOpenSession = f
+
+	return mmOpenSession
+}
+
+// Return sets up results that will be returned by ChatService.OpenSession
+func (mmOpenSession *mChatServiceMockOpenSession) Return(err error) *ChatServiceMock {
+	if mmOpenSession.mock.funcOpenSession != nil {
+		mmOpenSession.mock.t.Fatalf("ChatServiceMock.OpenSession mock is already set by Set")
+	}
+
+	if mmOpenSession.defaultExpectation == nil {
+		mmOpenSession.defaultExpectation = &ChatServiceMockOpenSessionExpectation{mock: mmOpenSession.mock}
+	}
+	mmOpenSession.defaultExpectation.results = &ChatServiceMockOpenSessionResults{err}
+	mmOpenSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmOpenSession.mock
+}
+
+// Set uses given function f to mock the ChatService.OpenSession method
+func (mmOpenSession *mChatServiceMockOpenSession) Set(f func(ctx context.Context, session *model.Session) (err error)) *ChatServiceMock {
+	if mmOpenSession.defaultExpectation != nil {
+		mmOpenSession.mock.t.Fatalf("Default expectation is already set for the ChatService.OpenSession method")
+	}
+
+	if len(mmOpenSession.expectations) > 0 {
+		mmOpenSession.mock.t.Fatalf("Some expectations are already set for the ChatService.OpenSession method")
+	}
+
+	mmOpenSession.mock.funcOpenSession = f
+	mmOpenSession.mock.funcOpenSessionOrigin = minimock.CallerInfo(1)
+	return mmOpenSession.mock
+}
+
+// When sets expectation for the ChatService.OpenSession which will trigger the result defined by the following
+// Then helper
+func (mmOpenSession *mChatServiceMockOpenSession) When(ctx context.Context, session *model.Session) *ChatServiceMockOpenSessionExpectation {
+	if mmOpenSession.mock.funcOpenSession != nil {
+		mmOpenSession.mock.t.Fatalf("ChatServiceMock.OpenSession mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockOpenSessionExpectation{
+		mock:               mmOpenSession.mock,
+		params:             &ChatServiceMockOpenSessionParams{ctx, session},
+		expectationOrigins: ChatServiceMockOpenSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmOpenSession.expectations = append(mmOpenSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.OpenSession return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockOpenSessionExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockOpenSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.OpenSession should be invoked
+func (mmOpenSession *mChatServiceMockOpenSession) Times(n uint64) *mChatServiceMockOpenSession {
+	if n == 0 {
+		mmOpenSession.mock.t.Fatalf("Times of ChatServiceMock.OpenSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmOpenSession.expectedInvocations, n)
+	mmOpenSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmOpenSession
+}
+
+func (mmOpenSession *mChatServiceMockOpenSession) invocationsDone() bool {
+	if len(mmOpenSession.expectations) == 0 && mmOpenSession.defaultExpectation == nil && mmOpenSession.mock.funcOpenSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmOpenSession.mock.afterOpenSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmOpenSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// OpenSession implements mm_service.ChatService
+func (mmOpenSession *ChatServiceMock) OpenSession(ctx context.Context, session *model.Session) (err error) {
+	mm_atomic.AddUint64(&mmOpenSession.beforeOpenSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmOpenSession.afterOpenSessionCounter, 1)
+
+	mmOpenSession.t.Helper()
+
+	if mmOpenSession.inspectFuncOpenSession != nil {
+		mmOpenSession.inspectFuncOpenSession(ctx, session)
+	}
+
+	mm_params := ChatServiceMockOpenSessionParams{ctx, session}
+
+	// Record call args
+	mmOpenSession.OpenSessionMock.mutex.Lock()
+	mmOpenSession.OpenSessionMock.callArgs = append(mmOpenSession.OpenSessionMock.callArgs, &mm_params)
+	mmOpenSession.OpenSessionMock.mutex.Unlock()
+
+	for _, e := range mmOpenSession.OpenSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmOpenSession.OpenSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOpenSession.OpenSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmOpenSession.OpenSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmOpenSession.OpenSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockOpenSessionParams{ctx, session}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmOpenSession.t.Errorf("ChatServiceMock.OpenSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenSession.OpenSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.session != nil && !minimock.Equal(*mm_want_ptrs.session, mm_got.session) {
+				mmOpenSession.t.Errorf("ChatServiceMock.OpenSession got unexpected parameter session, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenSession.OpenSessionMock.defaultExpectation.expectationOrigins.originSession, *mm_want_ptrs.session, mm_got.session, minimock.Diff(*mm_want_ptrs.session, mm_got.session))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOpenSession.t.Errorf("ChatServiceMock.OpenSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmOpenSession.OpenSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmOpenSession.OpenSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOpenSession.t.Fatal("No results are set for the ChatServiceMock.OpenSession")
+		}
+		return (*mm_results).err
+	}
+	if mmOpenSession.funcOpenSession != nil {
+		return mmOpenSession.funcOpenSession(ctx, session)
+	}
+	mmOpenSession.t.Fatalf("Unexpected call to ChatServiceMock.OpenSession. %v %v", ctx, session)
+	return
+}
+
+// OpenSessionAfterCounter returns a count of finished ChatServiceMock.OpenSession invocations
+func (mmOpenSession *ChatServiceMock) OpenSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpenSession.afterOpenSessionCounter)
+}
+
+// OpenSessionBeforeCounter returns a count of ChatServiceMock.OpenSession invocations
+func (mmOpenSession *ChatServiceMock) OpenSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpenSession.beforeOpenSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.OpenSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmOpenSession *mChatServiceMockOpenSession) Calls() []*ChatServiceMockOpenSessionParams {
+	mmOpenSession.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockOpenSessionParams, len(mmOpenSession.callArgs))
+	copy(argCopy, mmOpenSession.callArgs)
+
+	mmOpenSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockOpenSessionDone returns true if the count of the OpenSession invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockOpenSessionDone() bool {
+	if m.OpenSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.OpenSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.OpenSessionMock.invocationsDone()
+}
+
+// MinimockOpenSessionInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockOpenSessionInspect() {
+	for _, e := range m.OpenSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.OpenSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterOpenSessionCounter := mm_atomic.LoadUint64(&m.afterOpenSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OpenSessionMock.defaultExpectation != nil && afterOpenSessionCounter < 1 {
+		if m.OpenSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.OpenSession at\n%s", m.OpenSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.OpenSession at\n%s with params: %#v", m.OpenSessionMock.defaultExpectation.expectationOrigins.origin, *m.OpenSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOpenSession != nil && afterOpenSessionCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.OpenSession at\n%s", m.funcOpenSessionOrigin)
+	}
+
+	if !m.OpenSessionMock.invocationsDone() && afterOpenSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.OpenSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.OpenSessionMock.expectedInvocations), m.OpenSessionMock.expectedInvocationsOrigin, afterOpenSessionCounter)
+	}
+}
+
+type mChatServiceMockRemoveReaction struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockRemoveReactionExpectation
+	expectations       []*ChatServiceMockRemoveReactionExpectation
+
+	callArgs []*ChatServiceMockRemoveReactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockRemoveReactionExpectation specifies expectation struct of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockRemoveReactionParams
+	paramPtrs          *ChatServiceMockRemoveReactionParamPtrs
+	expectationOrigins ChatServiceMockRemoveReactionExpectationOrigins
+	results            *ChatServiceMockRemoveReactionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockRemoveReactionParams contains parameters of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionParams struct {
+	ctx      context.Context
+	reaction *model.Reaction
+}
+
+// ChatServiceMockRemoveReactionParamPtrs contains pointers to parameters of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionParamPtrs struct {
+	ctx      *context.Context
+	reaction **model.Reaction
+}
+
+// ChatServiceMockRemoveReactionResults contains results of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionResults struct {
+	err error
+}
+
+// ChatServiceMockRemoveReactionOrigins contains origins of expectations of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originReaction string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Optional() *mChatServiceMockRemoveReaction {
+	mmRemoveReaction.optional = true
+	return mmRemoveReaction
+}
+
+// Expect sets up expected params for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Expect(ctx context.Context, reaction *model.Reaction) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveReaction.defaultExpectation.params = &ChatServiceMockRemoveReactionParams{ctx, reaction}
+	mmRemoveReaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveReaction.expectations {
+		if minimock.Equal(e.params, mmRemoveReaction.defaultExpectation.params) {
+			mmRemoveReaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveReaction.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveReaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) ExpectCtxParam1(ctx context.Context) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatServiceMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectReactionParam2 sets up expected param reaction for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) ExpectReactionParam2(reaction *model.Reaction) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatServiceMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.reaction = &reaction
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originReaction = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Inspect(f func(ctx context.Context, reaction *model.Reaction)) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.inspectFuncRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.RemoveReaction")
+	}
+
+	mmRemoveReaction.mock.inspectFuncRemoveReaction = f
+
+	return mmRemoveReaction
+}
+
+// Return sets up results that will be returned by ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Return(err error) *ChatServiceMock {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{mock: mmRemoveReaction.mock}
+	}
+	mmRemoveReaction.defaultExpectation.results = &ChatServiceMockRemoveReactionResults{err}
+	mmRemoveReaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction.mock
+}
+
+// Set uses given function f to mock the ChatService.RemoveReaction method
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Set(f func(ctx context.Context, reaction *model.Reaction) (err error)) *ChatServiceMock {
+	if mmRemoveReaction.defaultExpectation != nil {
+		mmRemoveReaction.mock.t.Fatalf("Default expectation is already set for the ChatService.RemoveReaction method")
+	}
+
+	if len(mmRemoveReaction.expectations) > 0 {
+		mmRemoveReaction.mock.t.Fatalf("Some expectations are already set for the ChatService.RemoveReaction method")
+	}
+
+	mmRemoveReaction.mock.funcRemoveReaction = f
+	mmRemoveReaction.mock.funcRemoveReactionOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction.mock
+}
+
+// When sets expectation for the ChatService.RemoveReaction which will trigger the result defined by the following
+// Then helper
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) When(ctx context.Context, reaction *model.Reaction) *ChatServiceMockRemoveReactionExpectation {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockRemoveReactionExpectation{
+		mock:               mmRemoveReaction.mock,
+		params:             &ChatServiceMockRemoveReactionParams{ctx, reaction},
+		expectationOrigins: ChatServiceMockRemoveReactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveReaction.expectations = append(mmRemoveReaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.RemoveReaction return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockRemoveReactionExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockRemoveReactionResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.RemoveReaction should be invoked
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Times(n uint64) *mChatServiceMockRemoveReaction {
+	if n == 0 {
+		mmRemoveReaction.mock.t.Fatalf("Times of ChatServiceMock.RemoveReaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveReaction.expectedInvocations, n)
+	mmRemoveReaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction
+}
+
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) invocationsDone() bool {
+	if len(mmRemoveReaction.expectations) == 0 && mmRemoveReaction.defaultExpectation == nil && mmRemoveReaction.mock.funcRemoveReaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveReaction.mock.afterRemoveReactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveReaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveReaction implements mm_service.ChatService
+func (mmRemoveReaction *ChatServiceMock) RemoveReaction(ctx context.Context, reaction *model.Reaction) (err error) {
+	mm_atomic.AddUint64(&mmRemoveReaction.beforeRemoveReactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveReaction.afterRemoveReactionCounter, 1)
+
+	mmRemoveReaction.t.Helper()
+
+	if mmRemoveReaction.inspectFuncRemoveReaction != nil {
+		mmRemoveReaction.inspectFuncRemoveReaction(ctx, reaction)
+	}
+
+	mm_params := ChatServiceMockRemoveReactionParams{ctx, reaction}
+
+	// Record call args
+	mmRemoveReaction.RemoveReactionMock.mutex.Lock()
+	mmRemoveReaction.RemoveReactionMock.callArgs = append(mmRemoveReaction.RemoveReactionMock.callArgs, &mm_params)
+	mmRemoveReaction.RemoveReactionMock.mutex.Unlock()
+
+	for _, e := range mmRemoveReaction.RemoveReactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveReaction.RemoveReactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveReaction.RemoveReactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveReaction.RemoveReactionMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveReaction.RemoveReactionMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockRemoveReactionParams{ctx, reaction}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.reaction != nil && !minimock.Equal(*mm_want_ptrs.reaction, mm_got.reaction) {
+				mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameter reaction, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originReaction, *mm_want_ptrs.reaction, mm_got.reaction, minimock.Diff(*mm_want_ptrs.reaction, mm_got.reaction))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveReaction.RemoveReactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveReaction.t.Fatal("No results are set for the ChatServiceMock.RemoveReaction")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveReaction.funcRemoveReaction != nil {
+		return mmRemoveReaction.funcRemoveReaction(ctx, reaction)
+	}
+	mmRemoveReaction.t.Fatalf("Unexpected call to ChatServiceMock.RemoveReaction. %v %v", ctx, reaction)
+	return
+}
+
+// RemoveReactionAfterCounter returns a count of finished ChatServiceMock.RemoveReaction invocations
+func (mmRemoveReaction *ChatServiceMock) RemoveReactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReaction.afterRemoveReactionCounter)
+}
+
+// RemoveReactionBeforeCounter returns a count of ChatServiceMock.RemoveReaction invocations
+func (mmRemoveReaction *ChatServiceMock) RemoveReactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReaction.beforeRemoveReactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.RemoveReaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Calls() []*ChatServiceMockRemoveReactionParams {
+	mmRemoveReaction.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockRemoveReactionParams, len(mmRemoveReaction.callArgs))
+	copy(argCopy, mmRemoveReaction.callArgs)
+
+	mmRemoveReaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveReactionDone returns true if the count of the RemoveReaction invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockRemoveReactionDone() bool {
+	if m.RemoveReactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveReactionMock.invocationsDone()
+}
+
+// MinimockRemoveReactionInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockRemoveReactionInspect() {
+	for _, e := range m.RemoveReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveReaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveReactionCounter := mm_atomic.LoadUint64(&m.afterRemoveReactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveReactionMock.defaultExpectation != nil && afterRemoveReactionCounter < 1 {
+		if m.RemoveReactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveReaction at\n%s", m.RemoveReactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveReaction at\n%s with params: %#v", m.RemoveReactionMock.defaultExpectation.expectationOrigins.origin, *m.RemoveReactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveReaction != nil && afterRemoveReactionCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.RemoveReaction at\n%s", m.funcRemoveReactionOrigin)
+	}
+
+	if !m.RemoveReactionMock.invocationsDone() && afterRemoveReactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.RemoveReaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveReactionMock.expectedInvocations), m.RemoveReactionMock.expectedInvocationsOrigin, afterRemoveReactionCounter)
+	}
+}
+
+type mChatServiceMockRestore struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockRestoreExpectation
+	expectations       []*ChatServiceMockRestoreExpectation
+
+	callArgs []*ChatServiceMockRestoreParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockRestoreExpectation specifies expectation struct of the ChatService.Restore
+type ChatServiceMockRestoreExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockRestoreParams
+	paramPtrs          *ChatServiceMockRestoreParamPtrs
+	expectationOrigins ChatServiceMockRestoreExpectationOrigins
+	results            *ChatServiceMockRestoreResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockRestoreParams contains parameters of the ChatService.Restore
+type ChatServiceMockRestoreParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ChatServiceMockRestoreParamPtrs contains pointers to parameters of the ChatService.Restore
+type ChatServiceMockRestoreParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ChatServiceMockRestoreResults contains results of the ChatService.Restore
+type ChatServiceMockRestoreResults struct {
+	err error
+}
+
+// ChatServiceMockRestoreOrigins contains origins of expectations of the ChatService.Restore
+type ChatServiceMockRestoreExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRestore *mChatServiceMockRestore) Optional() *mChatServiceMockRestore {
+	mmRestore.optional = true
+	return mmRestore
+}
+
+// Expect sets up expected params for ChatService.Restore
+func (mmRestore *mChatServiceMockRestore) Expect(ctx context.Context, id int64) *mChatServiceMockRestore {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("ChatServiceMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &ChatServiceMockRestoreExpectation{}
+	}
+
+	if mmRestore.defaultExpectation.paramPtrs != nil {
+		mmRestore.mock.t.Fatalf("ChatServiceMock.Restore mock is already set by ExpectParams functions")
+	}
+
+	mmRestore.defaultExpectation.params = &ChatServiceMockRestoreParams{ctx, id}
+	mmRestore.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRestore.expectations {
+		if minimock.Equal(e.params, mmRestore.defaultExpectation.params) {
+			mmRestore.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestore.defaultExpectation.params)
+		}
+	}
+
+	return mmRestore
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.Restore
+func (mmRestore *mChatServiceMockRestore) ExpectCtxParam1(ctx context.Context) *mChatServiceMockRestore {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("ChatServiceMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &ChatServiceMockRestoreExpectation{}
+	}
+
+	if mmRestore.defaultExpectation.params != nil {
+		mmRestore.mock.t.Fatalf("ChatServiceMock.Restore mock is already set by Expect")
+	}
+
+	if mmRestore.defaultExpectation.paramPtrs == nil {
+		mmRestore.defaultExpectation.paramPtrs = &ChatServiceMockRestoreParamPtrs{}
+	}
+	mmRestore.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRestore.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRestore
+}
+
+// ExpectIdParam2 sets up expected param id for ChatService.Restore
+func (mmRestore *mChatServiceMockRestore) ExpectIdParam2(id int64) *mChatServiceMockRestore {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("ChatServiceMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &ChatServiceMockRestoreExpectation{}
+	}
+
+	if mmRestore.defaultExpectation.params != nil {
+		mmRestore.mock.t.Fatalf("ChatServiceMock.Restore mock is already set by Expect")
+	}
+
+	if mmRestore.defaultExpectation.paramPtrs == nil {
+		mmRestore.defaultExpectation.paramPtrs = &ChatServiceMockRestoreParamPtrs{}
+	}
+	mmRestore.defaultExpectation.paramPtrs.id = &id
+	mmRestore.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRestore
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.Restore
+func (mmRestore *mChatServiceMockRestore) Inspect(f func(ctx context.Context, id int64)) *mChatServiceMockRestore {
+	if mmRestore.mock.inspectFuncRestore != nil {
+		mmRestore.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Restore")
+	}
+
+	mmRestore.mock.inspectFuncRestore = f
+
+	return mmRestore
+}
+
+// Return sets up results that will be returned by ChatService.Restore
+func (mmRestore *mChatServiceMockRestore) Return(err error) *ChatServiceMock {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("ChatServiceMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &ChatServiceMockRestoreExpectation{mock: mmRestore.mock}
+	}
+	mmRestore.defaultExpectation.results = &ChatServiceMockRestoreResults{err}
+	mmRestore.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRestore.mock
+}
+
+// Set uses given function f to mock the ChatService.Restore method
+func (mmRestore *mChatServiceMockRestore) Set(f func(ctx context.Context, id int64) (err error)) *ChatServiceMock {
+	if mmRestore.defaultExpectation != nil {
+		mmRestore.mock.t.Fatalf("Default expectation is already set for the ChatService.Restore method")
+	}
+
+	if len(mmRestore.expectations) > 0 {
+		mmRestore.mock.t.Fatalf("Some expectations are already set for the ChatService.Restore method")
+	}
+
+	mmRestore.mock.funcRestore = f
+	mmRestore.mock.funcRestoreOrigin = minimock.CallerInfo(1)
+	return mmRestore.mock
+}
+
+// When sets expectation for the ChatService.Restore which will trigger the result defined by the following
+// Then helper
+func (mmRestore *mChatServiceMockRestore) When(ctx context.Context, id int64) *ChatServiceMockRestoreExpectation {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("ChatServiceMock.Restore mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockRestoreExpectation{
+		mock:               mmRestore.mock,
+		params:             &ChatServiceMockRestoreParams{ctx, id},
+		expectationOrigins: ChatServiceMockRestoreExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRestore.expectations = append(mmRestore.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.Restore return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockRestoreExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockRestoreResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.Restore should be invoked
+func (mmRestore *mChatServiceMockRestore) Times(n uint64) *mChatServiceMockRestore {
+	if n == 0 {
+		mmRestore.mock.t.Fatalf("Times of ChatServiceMock.Restore mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRestore.expectedInvocations, n)
+	mmRestore.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRestore
+}
+
+func (mmRestore *mChatServiceMockRestore) invocationsDone() bool {
+	if len(mmRestore.expectations) == 0 && mmRestore.defaultExpectation == nil && mmRestore.mock.funcRestore == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRestore.mock.afterRestoreCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRestore.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Restore implements mm_service.ChatService
+func (mmRestore *ChatServiceMock) Restore(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmRestore.beforeRestoreCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestore.afterRestoreCounter, 1)
+
+	mmRestore.t.Helper()
+
+	if mmRestore.inspectFuncRestore != nil {
+		mmRestore.inspectFuncRestore(ctx, id)
+	}
+
+	mm_params := ChatServiceMockRestoreParams{ctx, id}
+
+	// Record call args
+	mmRestore.RestoreMock.mutex.Lock()
+	mmRestore.RestoreMock.callArgs = append(mmRestore.RestoreMock.callArgs, &mm_params)
+	mmRestore.RestoreMock.mutex.Unlock()
+
+	for _, e := range mmRestore.RestoreMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRestore.RestoreMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestore.RestoreMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestore.RestoreMock.defaultExpectation.params
+		mm_want_ptrs := mmRestore.RestoreMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockRestoreParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRestore.t.Errorf("ChatServiceMock.Restore got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestore.RestoreMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRestore.t.Errorf("ChatServiceMock.Restore got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestore.RestoreMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestore.t.Errorf("ChatServiceMock.Restore got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRestore.RestoreMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRestore.RestoreMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRestore.t.Fatal("No results are set for the ChatServiceMock.Restore")
+		}
+		return (*mm_results).err
+	}
+	if mmRestore.funcRestore != nil {
+		return mmRestore.funcRestore(ctx, id)
+	}
+	mmRestore.t.Fatalf("Unexpected call to ChatServiceMock.Restore. %v %v", ctx, id)
+	return
+}
+
+// RestoreAfterCounter returns a count of finished ChatServiceMock.Restore invocations
+func (mmRestore *ChatServiceMock) RestoreAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestore.afterRestoreCounter)
+}
+
+// RestoreBeforeCounter returns a count of ChatServiceMock.Restore invocations
+func (mmRestore *ChatServiceMock) RestoreBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestore.beforeRestoreCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.Restore.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestore *mChatServiceMockRestore) Calls() []*ChatServiceMockRestoreParams {
+	mmRestore.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockRestoreParams, len(mmRestore.callArgs))
+	copy(argCopy, mmRestore.callArgs)
+
+	mmRestore.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreDone returns true if the count of the Restore invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockRestoreDone() bool {
+	if m.RestoreMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RestoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RestoreMock.invocationsDone()
+}
+
+// MinimockRestoreInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockRestoreInspect() {
+	for _, e := range m.RestoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.Restore at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRestoreCounter := mm_atomic.LoadUint64(&m.afterRestoreCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreMock.defaultExpectation != nil && afterRestoreCounter < 1 {
+		if m.RestoreMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.Restore at\n%s", m.RestoreMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.Restore at\n%s with params: %#v", m.RestoreMock.defaultExpectation.expectationOrigins.origin, *m.RestoreMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestore != nil && afterRestoreCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.Restore at\n%s", m.funcRestoreOrigin)
+	}
+
+	if !m.RestoreMock.invocationsDone() && afterRestoreCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.Restore at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RestoreMock.expectedInvocations), m.RestoreMock.expectedInvocationsOrigin, afterRestoreCounter)
+	}
+}
+
+type mChatServiceMockRunCommand struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockRunCommandExpectation
+	expectations       []*ChatServiceMockRunCommandExpectation
+
+	callArgs []*ChatServiceMockRunCommandParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockRunCommandExpectation specifies expectation struct of the ChatService.RunCommand
+type ChatServiceMockRunCommandExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockRunCommandParams
+	paramPtrs          *ChatServiceMockRunCommandParamPtrs
+	expectationOrigins ChatServiceMockRunCommandExpectationOrigins
+	results            *ChatServiceMockRunCommandResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockRunCommandParams contains parameters of the ChatService.RunCommand
+type ChatServiceMockRunCommandParams struct {
+	ctx  context.Context
+	call *model.CommandCall
+}
+
+// ChatServiceMockRunCommandParamPtrs contains pointers to parameters of the ChatService.RunCommand
+type ChatServiceMockRunCommandParamPtrs struct {
+	ctx  *context.Context
+	call **model.CommandCall
+}
+
+// ChatServiceMockRunCommandResults contains results of the ChatService.RunCommand
+type ChatServiceMockRunCommandResults struct {
+	s1  string
+	err error
+}
+
+// ChatServiceMockRunCommandOrigins contains origins of expectations of the ChatService.RunCommand
+type ChatServiceMockRunCommandExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originCall string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRunCommand *mChatServiceMockRunCommand) Optional() *mChatServiceMockRunCommand {
+	mmRunCommand.optional = true
+	return mmRunCommand
+}
+
+// Expect sets up expected params for ChatService.RunCommand
+func (mmRunCommand *mChatServiceMockRunCommand) Expect(ctx context.Context, call *model.CommandCall) *mChatServiceMockRunCommand {
+	if mmRunCommand.mock.funcRunCommand != nil {
+		mmRunCommand.mock.t.Fatalf("ChatServiceMock.RunCommand mock is already set by Set")
+	}
+
+	if mmRunCommand.defaultExpectation == nil {
+		mmRunCommand.defaultExpectation = &ChatServiceMockRunCommandExpectation{}
+	}
+
+	if mmRunCommand.defaultExpectation.paramPtrs != nil {
+		mmRunCommand.mock.t.Fatalf("ChatServiceMock.RunCommand mock is already set by ExpectParams functions")
+	}
+
+	mmRunCommand.defaultExpectation.params = &ChatServiceMockRunCommandParams{ctx, call}
+	mmRunCommand.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRunCommand.expectations {
+		if minimock.Equal(e.params, mmRunCommand.defaultExpectation.params) {
+			mmRunCommand.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRunCommand.defaultExpectation.params)
+		}
+	}
+
+	return mmRunCommand
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.RunCommand
+func (mmRunCommand *mChatServiceMockRunCommand) ExpectCtxParam1(ctx context.Context) *mChatServiceMockRunCommand {
+	if mmRunCommand.mock.funcRunCommand != nil {
+		mmRunCommand.mock.t.Fatalf("ChatServiceMock.RunCommand mock is already set by Set")
+	}
+
+	if mmRunCommand.defaultExpectation == nil {
+		mmRunCommand.defaultExpectation = &ChatServiceMockRunCommandExpectation{}
+	}
+
+	if mmRunCommand.defaultExpectation.params != nil {
+		mmRunCommand.mock.t.Fatalf("ChatServiceMock.RunCommand mock is already set by Expect")
+	}
+
+	if mmRunCommand.defaultExpectation.paramPtrs == nil {
+		mmRunCommand.defaultExpectation.paramPtrs = &ChatServiceMockRunCommandParamPtrs{}
+	}
+	mmRunCommand.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRunCommand.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRunCommand
+}
+
+// ExpectCallParam2 sets up expected param call for ChatService.RunCommand
+func (mmRunCommand *mChatServiceMockRunCommand) ExpectCallParam2(call *model.CommandCall) *mChatServiceMockRunCommand {
+	if mmRunCommand.mock.funcRunCommand != nil {
+		mmRunCommand.mock.t.Fatalf("ChatServiceMock.RunCommand mock is already set by Set")
+	}
+
+	if mmRunCommand.defaultExpectation == nil {
+		mmRunCommand.defaultExpectation = &ChatServiceMockRunCommandExpectation{}
+	}
+
+	if mmRunCommand.defaultExpectation.params != nil {
+		mmRunCommand.mock.t.Fatalf("ChatServiceMock.RunCommand mock is already set by Expect")
+	}
+
+	if mmRunCommand.defaultExpectation.paramPtrs == nil {
+		mmRunCommand.defaultExpectation.paramPtrs = &ChatServiceMockRunCommandParamPtrs{}
+	}
+	mmRunCommand.defaultExpectation.paramPtrs.call = &call
+	mmRunCommand.defaultExpectation.expectationOrigins.originCall = minimock.CallerInfo(1)
+
+	return mmRunCommand
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.RunCommand
+func (mmRunCommand *mChatServiceMockRunCommand) Inspect(f func(ctx context.Context, call *model.CommandCall)) *mChatServiceMockRunCommand {
+	if mmRunCommand.mock.inspectFuncRunCommand != nil {
+		mmRunCommand.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.RunCommand")
+	}
+
+	mmRunCommand.mock.inspectFuncRunCommand = f
+
+	return mmRunCommand
+}
+
+// Return sets up results that will be returned by ChatService.RunCommand
+func (mmRunCommand *mChatServiceMockRunCommand) Return(s1 string, err error) *ChatServiceMock {
+	if mmRunCommand.mock.funcRunCommand != nil {
+		mmRunCommand.mock.t.Fatalf("ChatServiceMock.RunCommand mock is already set by Set")
+	}
+
+	if mmRunCommand.defaultExpectation == nil {
+		mmRunCommand.defaultExpectation = &ChatServiceMockRunCommandExpectation{mock: mmRunCommand.mock}
+	}
+	mmRunCommand.defaultExpectation.results = &ChatServiceMockRunCommandResults{s1, err}
+	mmRunCommand.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRunCommand.mock
+}
+
+// Set uses given function f to mock the ChatService.RunCommand method
+func (mmRunCommand *mChatServiceMockRunCommand) Set(f func(ctx context.Context, call *model.CommandCall) (s1 string, err error)) *ChatServiceMock {
+	if mmRunCommand.defaultExpectation != nil {
+		mmRunCommand.mock.t.Fatalf("Default expectation is already set for the ChatService.RunCommand method")
+	}
+
+	if len(mmRunCommand.expectations) > 0 {
+		mmRunCommand.mock.t.Fatalf("Some expectations are already set for the ChatService.RunCommand method")
+	}
+
+	mmRunCommand.mock.funcRunCommand = f
+	mmRunCommand.mock.funcRunCommandOrigin = minimock.CallerInfo(1)
+	return mmRunCommand.mock
+}
+
+// When sets expectation for the ChatService.RunCommand which will trigger the result defined by the following
+// Then helper
+func (mmRunCommand *mChatServiceMockRunCommand) When(ctx context.Context, call *model.CommandCall) *ChatServiceMockRunCommandExpectation {
+	if mmRunCommand.mock.funcRunCommand != nil {
+		mmRunCommand.mock.t.Fatalf("ChatServiceMock.RunCommand mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockRunCommandExpectation{
+		mock:               mmRunCommand.mock,
+		params:             &ChatServiceMockRunCommandParams{ctx, call},
+		expectationOrigins: ChatServiceMockRunCommandExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRunCommand.expectations = append(mmRunCommand.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.RunCommand return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockRunCommandExpectation) Then(s1 string, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockRunCommandResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.RunCommand should be invoked
+func (mmRunCommand *mChatServiceMockRunCommand) Times(n uint64) *mChatServiceMockRunCommand {
+	if n == 0 {
+		mmRunCommand.mock.t.Fatalf("Times of ChatServiceMock.RunCommand mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRunCommand.expectedInvocations, n)
+	mmRunCommand.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRunCommand
+}
+
+func (mmRunCommand *mChatServiceMockRunCommand) invocationsDone() bool {
+	if len(mmRunCommand.expectations) == 0 && mmRunCommand.defaultExpectation == nil && mmRunCommand.mock.funcRunCommand == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRunCommand.mock.afterRunCommandCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRunCommand.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RunCommand implements mm_service.ChatService
+func (mmRunCommand *ChatServiceMock) RunCommand(ctx context.Context, call *model.CommandCall) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmRunCommand.beforeRunCommandCounter, 1)
+	defer mm_atomic.AddUint64(&mmRunCommand.afterRunCommandCounter, 1)
+
+	mmRunCommand.t.Helper()
+
+	if mmRunCommand.inspectFuncRunCommand != nil {
+		mmRunCommand.inspectFuncRunCommand(ctx, call)
+	}
+
+	mm_params := ChatServiceMockRunCommandParams{ctx, call}
+
+	// Record call args
+	mmRunCommand.RunCommandMock.mutex.Lock()
+	mmRunCommand.RunCommandMock.callArgs = append(mmRunCommand.RunCommandMock.callArgs, &mm_params)
+	mmRunCommand.RunCommandMock.mutex.Unlock()
+
+	for _, e := range mmRunCommand.RunCommandMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmRunCommand.RunCommandMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRunCommand.RunCommandMock.defaultExpectation.Counter, 1)
+		mm_want := mmRunCommand.RunCommandMock.defaultExpectation.params
+		mm_want_ptrs := mmRunCommand.RunCommandMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockRunCommandParams{ctx, call}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRunCommand.t.Errorf("ChatServiceMock.RunCommand got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRunCommand.RunCommandMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.call != nil && !minimock.Equal(*mm_want_ptrs.call, mm_got.call) {
+				mmRunCommand.t.Errorf("ChatServiceMock.RunCommand got unexpected parameter call, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRunCommand.RunCommandMock.defaultExpectation.expectationOrigins.originCall, *mm_want_ptrs.call, mm_got.call, minimock.Diff(*mm_want_ptrs.call, mm_got.call))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRunCommand.t.Errorf("ChatServiceMock.RunCommand got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRunCommand.RunCommandMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRunCommand.RunCommandMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRunCommand.t.Fatal("No results are set for the ChatServiceMock.RunCommand")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmRunCommand.funcRunCommand != nil {
+		return mmRunCommand.funcRunCommand(ctx, call)
+	}
+	mmRunCommand.t.Fatalf("Unexpected call to ChatServiceMock.RunCommand. %v %v", ctx, call)
+	return
+}
+
+// RunCommandAfterCounter returns a count of finished ChatServiceMock.RunCommand invocations
+func (mmRunCommand *ChatServiceMock) RunCommandAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRunCommand.afterRunCommandCounter)
+}
+
+// RunCommandBeforeCounter returns a count of ChatServiceMock.RunCommand invocations
+func (mmRunCommand *ChatServiceMock) RunCommandBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRunCommand.beforeRunCommandCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.RunCommand.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRunCommand *mChatServiceMockRunCommand) Calls() []*ChatServiceMockRunCommandParams {
+	mmRunCommand.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockRunCommandParams, len(mmRunCommand.callArgs))
+	copy(argCopy, mmRunCommand.callArgs)
+
+	mmRunCommand.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRunCommandDone returns true if the count of the RunCommand invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockRunCommandDone() bool {
+	if m.RunCommandMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RunCommandMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RunCommandMock.invocationsDone()
+}
+
+// MinimockRunCommandInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockRunCommandInspect() {
+	for _, e := range m.RunCommandMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.RunCommand at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRunCommandCounter := mm_atomic.LoadUint64(&m.afterRunCommandCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RunCommandMock.defaultExpectation != nil && afterRunCommandCounter < 1 {
+		if m.RunCommandMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.RunCommand at\n%s", m.RunCommandMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.RunCommand at\n%s with params: %#v", m.RunCommandMock.defaultExpectation.expectationOrigins.origin, *m.RunCommandMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRunCommand != nil && afterRunCommandCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.RunCommand at\n%s", m.funcRunCommandOrigin)
+	}
+
+	if !m.RunCommandMock.invocationsDone() && afterRunCommandCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.RunCommand at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RunCommandMock.expectedInvocations), m.RunCommandMock.expectedInvocationsOrigin, afterRunCommandCounter)
+	}
+}
+
+type mChatServiceMockScheduleMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockScheduleMessageExpectation
+	expectations       []*ChatServiceMockScheduleMessageExpectation
+
+	callArgs []*ChatServiceMockScheduleMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockScheduleMessageExpectation specifies expectation struct of the ChatService.ScheduleMessage
+type ChatServiceMockScheduleMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockScheduleMessageParams
+	paramPtrs          *ChatServiceMockScheduleMessageParamPtrs
+	expectationOrigins ChatServiceMockScheduleMessageExpectationOrigins
+	results            *ChatServiceMockScheduleMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockScheduleMessageParams contains parameters of the ChatService.ScheduleMessage
+type ChatServiceMockScheduleMessageParams struct {
+	ctx     context.Context
+	message *model.ScheduledMessage
+}
+
+// ChatServiceMockScheduleMessageParamPtrs contains pointers to parameters of the ChatService.ScheduleMessage
+type ChatServiceMockScheduleMessageParamPtrs struct {
+	ctx     *context.Context
+	message **model.ScheduledMessage
+}
+
+// ChatServiceMockScheduleMessageResults contains results of the ChatService.ScheduleMessage
+type ChatServiceMockScheduleMessageResults struct {
+	i1  int64
+	err error
+}
+
+// ChatServiceMockScheduleMessageOrigins contains origins of expectations of the ChatService.ScheduleMessage
+type ChatServiceMockScheduleMessageExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originMessage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Optional() *mChatServiceMockScheduleMessage {
+	mmScheduleMessage.optional = true
+	return mmScheduleMessage
+}
+
+// Expect sets up expected params for ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Expect(ctx context.Context, message *model.ScheduledMessage) *mChatServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ChatServiceMockScheduleMessageExpectation{}
+	}
+
+	if mmScheduleMessage.defaultExpectation.paramPtrs != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by ExpectParams functions")
+	}
+
+	mmScheduleMessage.defaultExpectation.params = &ChatServiceMockScheduleMessageParams{ctx, message}
+	mmScheduleMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmScheduleMessage.expectations {
+		if minimock.Equal(e.params, mmScheduleMessage.defaultExpectation.params) {
+			mmScheduleMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmScheduleMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmScheduleMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ChatServiceMockScheduleMessageExpectation{}
+	}
+
+	if mmScheduleMessage.defaultExpectation.params != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Expect")
+	}
+
+	if mmScheduleMessage.defaultExpectation.paramPtrs == nil {
+		mmScheduleMessage.defaultExpectation.paramPtrs = &ChatServiceMockScheduleMessageParamPtrs{}
+	}
+	mmScheduleMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmScheduleMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmScheduleMessage
+}
+
+// ExpectMessageParam2 sets up expected param message for ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) ExpectMessageParam2(message *model.ScheduledMessage) *mChatServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ChatServiceMockScheduleMessageExpectation{}
+	}
+
+	if mmScheduleMessage.defaultExpectation.params != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Expect")
+	}
+
+	if mmScheduleMessage.defaultExpectation.paramPtrs == nil {
+		mmScheduleMessage.defaultExpectation.paramPtrs = &ChatServiceMockScheduleMessageParamPtrs{}
+	}
+	mmScheduleMessage.defaultExpectation.paramPtrs.message = &message
+	mmScheduleMessage.defaultExpectation.expectationOrigins.originMessage = minimock.CallerInfo(1)
+
+	return mmScheduleMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Inspect(f func(ctx context.Context, message *model.ScheduledMessage)) *mChatServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.inspectFuncScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ScheduleMessage")
+	}
+
+	mmScheduleMessage.mock.inspectFuncScheduleMessage = f
+
+	return mmScheduleMessage
+}
+
+// Return sets up results that will be returned by ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Return(i1 int64, err error) *ChatServiceMock {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ChatServiceMockScheduleMessageExpectation{mock: mmScheduleMessage.mock}
+	}
+	mmScheduleMessage.defaultExpectation.results = &ChatServiceMockScheduleMessageResults{i1, err}
+	mmScheduleMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmScheduleMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.ScheduleMessage method
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Set(f func(ctx context.Context, message *model.ScheduledMessage) (i1 int64, err error)) *ChatServiceMock {
+	if mmScheduleMessage.defaultExpectation != nil {
+		mmScheduleMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.ScheduleMessage method")
+	}
+
+	if len(mmScheduleMessage.expectations) > 0 {
+		mmScheduleMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.ScheduleMessage method")
+	}
+
+	mmScheduleMessage.mock.funcScheduleMessage = f
+	mmScheduleMessage.mock.funcScheduleMessageOrigin = minimock.CallerInfo(1)
+	return mmScheduleMessage.mock
+}
+
+// When sets expectation for the ChatService.ScheduleMessage which will trigger the result defined by the following
+// Then helper
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) When(ctx context.Context, message *model.ScheduledMessage) *ChatServiceMockScheduleMessageExpectation {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockScheduleMessageExpectation{
+		mock:               mmScheduleMessage.mock,
+		params:             &ChatServiceMockScheduleMessageParams{ctx, message},
+		expectationOrigins: ChatServiceMockScheduleMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmScheduleMessage.expectations = append(mmScheduleMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ScheduleMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockScheduleMessageExpectation) Then(i1 int64, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockScheduleMessageResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ScheduleMessage should be invoked
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Times(n uint64) *mChatServiceMockScheduleMessage {
+	if n == 0 {
+		mmScheduleMessage.mock.t.Fatalf("Times of ChatServiceMock.ScheduleMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmScheduleMessage.expectedInvocations, n)
+	mmScheduleMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmScheduleMessage
+}
+
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) invocationsDone() bool {
+	if len(mmScheduleMessage.expectations) == 0 && mmScheduleMessage.defaultExpectation == nil && mmScheduleMessage.mock.funcScheduleMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmScheduleMessage.mock.afterScheduleMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmScheduleMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ScheduleMessage implements mm_service.ChatService
+func (mmScheduleMessage *ChatServiceMock) ScheduleMessage(ctx context.Context, message *model.ScheduledMessage) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmScheduleMessage.beforeScheduleMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmScheduleMessage.afterScheduleMessageCounter, 1)
+
+	mmScheduleMessage.t.Helper()
+
+	if mmScheduleMessage.inspectFuncScheduleMessage != nil {
+		mmScheduleMessage.inspectFuncScheduleMessage(ctx, message)
+	}
+
+	mm_params := ChatServiceMockScheduleMessageParams{ctx, message}
+
+	// Record call args
+	mmScheduleMessage.ScheduleMessageMock.mutex.Lock()
+	mmScheduleMessage.ScheduleMessageMock.callArgs = append(mmScheduleMessage.ScheduleMessageMock.callArgs, &mm_params)
+	mmScheduleMessage.ScheduleMessageMock.mutex.Unlock()
+
+	for _, e := range mmScheduleMessage.ScheduleMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmScheduleMessage.ScheduleMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScheduleMessage.ScheduleMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmScheduleMessage.ScheduleMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmScheduleMessage.ScheduleMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockScheduleMessageParams{ctx, message}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmScheduleMessage.t.Errorf("ChatServiceMock.ScheduleMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmScheduleMessage.ScheduleMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
+				mmScheduleMessage.t.Errorf("ChatServiceMock.ScheduleMessage got unexpected parameter message, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmScheduleMessage.ScheduleMessageMock.defaultExpectation.expectationOrigins.originMessage, *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmScheduleMessage.t.Errorf("ChatServiceMock.ScheduleMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmScheduleMessage.ScheduleMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmScheduleMessage.ScheduleMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScheduleMessage.t.Fatal("No results are set for the ChatServiceMock.ScheduleMessage")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmScheduleMessage.funcScheduleMessage != nil {
+		return mmScheduleMessage.funcScheduleMessage(ctx, message)
+	}
+	mmScheduleMessage.t.Fatalf("Unexpected call to ChatServiceMock.ScheduleMessage. %v %v", ctx, message)
+	return
+}
+
+// ScheduleMessageAfterCounter returns a count of finished ChatServiceMock.ScheduleMessage invocations
+func (mmScheduleMessage *ChatServiceMock) ScheduleMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScheduleMessage.afterScheduleMessageCounter)
+}
+
+// ScheduleMessageBeforeCounter returns a count of ChatServiceMock.ScheduleMessage invocations
+func (mmScheduleMessage *ChatServiceMock) ScheduleMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScheduleMessage.beforeScheduleMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ScheduleMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Calls() []*ChatServiceMockScheduleMessageParams {
+	mmScheduleMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockScheduleMessageParams, len(mmScheduleMessage.callArgs))
+	copy(argCopy, mmScheduleMessage.callArgs)
+
+	mmScheduleMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockScheduleMessageDone returns true if the count of the ScheduleMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockScheduleMessageDone() bool {
+	if m.ScheduleMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ScheduleMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ScheduleMessageMock.invocationsDone()
+}
+
+// MinimockScheduleMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockScheduleMessageInspect() {
+	for _, e := range m.ScheduleMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ScheduleMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterScheduleMessageCounter := mm_atomic.LoadUint64(&m.afterScheduleMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScheduleMessageMock.defaultExpectation != nil && afterScheduleMessageCounter < 1 {
+		if m.ScheduleMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ScheduleMessage at\n%s", m.ScheduleMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ScheduleMessage at\n%s with params: %#v", m.ScheduleMessageMock.defaultExpectation.expectationOrigins.origin, *m.ScheduleMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScheduleMessage != nil && afterScheduleMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ScheduleMessage at\n%s", m.funcScheduleMessageOrigin)
+	}
+
+	if !m.ScheduleMessageMock.invocationsDone() && afterScheduleMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ScheduleMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ScheduleMessageMock.expectedInvocations), m.ScheduleMessageMock.expectedInvocationsOrigin, afterScheduleMessageCounter)
+	}
+}
+
+type mChatServiceMockSearchMessages struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSearchMessagesExpectation
+	expectations       []*ChatServiceMockSearchMessagesExpectation
+
+	callArgs []*ChatServiceMockSearchMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockSearchMessagesExpectation specifies expectation struct of the ChatService.SearchMessages
+type ChatServiceMockSearchMessagesExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockSearchMessagesParams
+	paramPtrs          *ChatServiceMockSearchMessagesParamPtrs
+	expectationOrigins ChatServiceMockSearchMessagesExpectationOrigins
+	results            *ChatServiceMockSearchMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockSearchMessagesParams contains parameters of the ChatService.SearchMessages
+type ChatServiceMockSearchMessagesParams struct {
+	ctx   context.Context
+	query *model.SearchQuery
+}
+
+// ChatServiceMockSearchMessagesParamPtrs contains pointers to parameters of the ChatService.SearchMessages
+type ChatServiceMockSearchMessagesParamPtrs struct {
+	ctx   *context.Context
+	query **model.SearchQuery
+}
+
+// ChatServiceMockSearchMessagesResults contains results of the ChatService.SearchMessages
+type ChatServiceMockSearchMessagesResults struct {
+	spa1 []*model.SearchResult
+	err  error
+}
+
+// ChatServiceMockSearchMessagesOrigins contains origins of expectations of the ChatService.SearchMessages
+type ChatServiceMockSearchMessagesExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originQuery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearchMessages *mChatServiceMockSearchMessages) Optional() *mChatServiceMockSearchMessages {
+	mmSearchMessages.optional = true
+	return mmSearchMessages
+}
+
+// Expect sets up expected params for ChatService.SearchMessages
+func (mmSearchMessages *mChatServiceMockSearchMessages) Expect(ctx context.Context, query *model.SearchQuery) *mChatServiceMockSearchMessages {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Set")
+	}
+
+	if mmSearchMessages.defaultExpectation == nil {
+		mmSearchMessages.defaultExpectation = &ChatServiceMockSearchMessagesExpectation{}
+	}
+
+	if mmSearchMessages.defaultExpectation.paramPtrs != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by ExpectParams functions")
+	}
+
+	mmSearchMessages.defaultExpectation.params = &ChatServiceMockSearchMessagesParams{ctx, query}
+	mmSearchMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearchMessages.expectations {
+		if minimock.Equal(e.params, mmSearchMessages.defaultExpectation.params) {
+			mmSearchMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearchMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmSearchMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SearchMessages
+func (mmSearchMessages *mChatServiceMockSearchMessages) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSearchMessages {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Set")
+	}
+
+	if mmSearchMessages.defaultExpectation == nil {
+		mmSearchMessages.defaultExpectation = &ChatServiceMockSearchMessagesExpectation{}
+	}
+
+	if mmSearchMessages.defaultExpectation.params != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Expect")
+	}
+
+	if mmSearchMessages.defaultExpectation.paramPtrs == nil {
+		mmSearchMessages.defaultExpectation.paramPtrs = &ChatServiceMockSearchMessagesParamPtrs{}
+	}
+	mmSearchMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearchMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSearchMessages
+}
+
+// ExpectQueryParam2 sets up expected param query for ChatService.SearchMessages
+func (mmSearchMessages *mChatServiceMockSearchMessages) ExpectQueryParam2(query *model.SearchQuery) *mChatServiceMockSearchMessages {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Set")
+	}
+
+	if mmSearchMessages.defaultExpectation == nil {
+		mmSearchMessages.defaultExpectation = &ChatServiceMockSearchMessagesExpectation{}
+	}
+
+	if mmSearchMessages.defaultExpectation.params != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Expect")
+	}
+
+	if mmSearchMessages.defaultExpectation.paramPtrs == nil {
+		mmSearchMessages.defaultExpectation.paramPtrs = &ChatServiceMockSearchMessagesParamPtrs{}
+	}
+	mmSearchMessages.defaultExpectation.paramPtrs.query = &query
+	mmSearchMessages.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmSearchMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SearchMessages
+func (mmSearchMessages *mChatServiceMockSearchMessages) Inspect(f func(ctx context.Context, query *model.SearchQuery)) *mChatServiceMockSearchMessages {
+	if mmSearchMessages.mock.inspectFuncSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SearchMessages")
+	}
+
+	mmSearchMessages.mock.inspectFuncSearchMessages = f
+
+	return mmSearchMessages
+}
+
+// Return sets up results that will be returned by ChatService.SearchMessages
+func (mmSearchMessages *mChatServiceMockSearchMessages) Return(spa1 []*model.SearchResult, err error) *ChatServiceMock {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Set")
+	}
+
+	if mmSearchMessages.defaultExpectation == nil {
+		mmSearchMessages.defaultExpectation = &ChatServiceMockSearchMessagesExpectation{mock: mmSearchMessages.mock}
+	}
+	mmSearchMessages.defaultExpectation.results = &ChatServiceMockSearchMessagesResults{spa1, err}
+	mmSearchMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearchMessages.mock
+}
+
+// Set uses given function f to mock the ChatService.SearchMessages method
+func (mmSearchMessages *mChatServiceMockSearchMessages) Set(f func(ctx context.Context, query *model.SearchQuery) (spa1 []*model.SearchResult, err error)) *ChatServiceMock {
+	if mmSearchMessages.defaultExpectation != nil {
+		mmSearchMessages.mock.t.Fatalf("Default expectation is already set for the ChatService.SearchMessages method")
+	}
+
+	if len(mmSearchMessages.expectations) > 0 {
+		mmSearchMessages.mock.t.Fatalf("Some expectations are already set for the ChatService.SearchMessages method")
+	}
+
+	mmSearchMessages.mock.funcSearchMessages = f
+	mmSearchMessages.mock.funcSearchMessagesOrigin = minimock.CallerInfo(1)
+	return mmSearchMessages.mock
+}
+
+// When sets expectation for the ChatService.SearchMessages which will trigger the result defined by the following
+// Then helper
+func (mmSearchMessages *mChatServiceMockSearchMessages) When(ctx context.Context, query *model.SearchQuery) *ChatServiceMockSearchMessagesExpectation {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockSearchMessagesExpectation{
+		mock:               mmSearchMessages.mock,
+		params:             &ChatServiceMockSearchMessagesParams{ctx, query},
+		expectationOrigins: ChatServiceMockSearchMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSearchMessages.expectations = append(mmSearchMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.SearchMessages return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSearchMessagesExpectation) Then(spa1 []*model.SearchResult, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSearchMessagesResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.SearchMessages should be invoked
+func (mmSearchMessages *mChatServiceMockSearchMessages) Times(n uint64) *mChatServiceMockSearchMessages {
+	if n == 0 {
+		mmSearchMessages.mock.t.Fatalf("Times of ChatServiceMock.SearchMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearchMessages.expectedInvocations, n)
+	mmSearchMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearchMessages
+}
+
+func (mmSearchMessages *mChatServiceMockSearchMessages) invocationsDone() bool {
+	if len(mmSearchMessages.expectations) == 0 && mmSearchMessages.defaultExpectation == nil && mmSearchMessages.mock.funcSearchMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSearchMessages.mock.afterSearchMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearchMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SearchMessages implements mm_service.ChatService
+func (mmSearchMessages *ChatServiceMock) SearchMessages(ctx context.Context, query *model.SearchQuery) (spa1 []*model.SearchResult, err error) {
+	mm_atomic.AddUint64(&mmSearchMessages.beforeSearchMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearchMessages.afterSearchMessagesCounter, 1)
+
+	mmSearchMessages.t.Helper()
+
+	if mmSearchMessages.inspectFuncSearchMessages != nil {
+		mmSearchMessages.inspectFuncSearchMessages(ctx, query)
+	}
+
+	mm_params := ChatServiceMockSearchMessagesParams{ctx, query}
+
+	// Record call args
+	mmSearchMessages.SearchMessagesMock.mutex.Lock()
+	mmSearchMessages.SearchMessagesMock.callArgs = append(mmSearchMessages.SearchMessagesMock.callArgs, &mm_params)
+	mmSearchMessages.SearchMessagesMock.mutex.Unlock()
+
+	for _, e := range mmSearchMessages.SearchMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmSearchMessages.SearchMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearchMessages.SearchMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearchMessages.SearchMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmSearchMessages.SearchMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSearchMessagesParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearchMessages.t.Errorf("ChatServiceMock.SearchMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchMessages.SearchMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmSearchMessages.t.Errorf("ChatServiceMock.SearchMessages got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchMessages.SearchMessagesMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearchMessages.t.Errorf("ChatServiceMock.SearchMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearchMessages.SearchMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearchMessages.SearchMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearchMessages.t.Fatal("No results are set for the ChatServiceMock.SearchMessages")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmSearchMessages.funcSearchMessages != nil {
+		return mmSearchMessages.funcSearchMessages(ctx, query)
+	}
+	mmSearchMessages.t.Fatalf("Unexpected call to ChatServiceMock.SearchMessages. %v %v", ctx, query)
+	return
+}
+
+// SearchMessagesAfterCounter returns a count of finished ChatServiceMock.SearchMessages invocations
+func (mmSearchMessages *ChatServiceMock) SearchMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchMessages.afterSearchMessagesCounter)
+}
+
+// SearchMessagesBeforeCounter returns a count of ChatServiceMock.SearchMessages invocations
+func (mmSearchMessages *ChatServiceMock) SearchMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchMessages.beforeSearchMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.SearchMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearchMessages *mChatServiceMockSearchMessages) Calls() []*ChatServiceMockSearchMessagesParams {
+	mmSearchMessages.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSearchMessagesParams, len(mmSearchMessages.callArgs))
+	copy(argCopy, mmSearchMessages.callArgs)
+
+	mmSearchMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchMessagesDone returns true if the count of the SearchMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSearchMessagesDone() bool {
+	if m.SearchMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SearchMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SearchMessagesMock.invocationsDone()
+}
+
+// MinimockSearchMessagesInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSearchMessagesInspect() {
+	for _, e := range m.SearchMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.SearchMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSearchMessagesCounter := mm_atomic.LoadUint64(&m.afterSearchMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchMessagesMock.defaultExpectation != nil && afterSearchMessagesCounter < 1 {
+		if m.SearchMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.SearchMessages at\n%s", m.SearchMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.SearchMessages at\n%s with params: %#v", m.SearchMessagesMock.defaultExpectation.expectationOrigins.origin, *m.SearchMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearchMessages != nil && afterSearchMessagesCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.SearchMessages at\n%s", m.funcSearchMessagesOrigin)
+	}
+
+	if !m.SearchMessagesMock.invocationsDone() && afterSearchMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SearchMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchMessagesMock.expectedInvocations), m.SearchMessagesMock.expectedInvocationsOrigin, afterSearchMessagesCounter)
+	}
+}
+
+type mChatServiceMockSendMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSendMessageExpectation
+	expectations       []*ChatServiceMockSendMessageExpectation
+
+	callArgs []*ChatServiceMockSendMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockSendMessageExpectation specifies expectation struct of the ChatService.SendMessage
+type ChatServiceMockSendMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockSendMessageParams
+	paramPtrs          *ChatServiceMockSendMessageParamPtrs
+	expectationOrigins ChatServiceMockSendMessageExpectationOrigins
+	results            *ChatServiceMockSendMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockSendMessageParams contains parameters of the ChatService.SendMessage
+type ChatServiceMockSendMessageParams struct {
+	ctx     context.Context
+	message *model.SendMessage
+}
+
+// ChatServiceMockSendMessageParamPtrs contains pointers to parameters of the ChatService.SendMessage
+type ChatServiceMockSendMessageParamPtrs struct {
+	ctx     *context.Context
+	message **model.SendMessage
+}
+
+// ChatServiceMockSendMessageResults contains results of the ChatService.SendMessage
+type ChatServiceMockSendMessageResults struct {
+	i1  int64
+	err error
+}
+
+// ChatServiceMockSendMessageOrigins contains origins of expectations of the ChatService.SendMessage
+type ChatServiceMockSendMessageExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originMessage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendMessage *mChatServiceMockSendMessage) Optional() *mChatServiceMockSendMessage {
+	mmSendMessage.optional = true
+	return mmSendMessage
+}
+
+// Expect sets up expected params for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Expect(ctx context.Context, message *model.SendMessage) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by ExpectParams functions")
+	}
+
+	mmSendMessage.defaultExpectation.params = &ChatServiceMockSendMessageParams{ctx, message}
+	mmSendMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSendMessage.expectations {
+		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
+			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmSendMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSendMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSendMessage
+}
+
+// ExpectMessageParam2 sets up expected param message for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) ExpectMessageParam2(message *model.SendMessage) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.message = &message
+	mmSendMessage.defaultExpectation.expectationOrigins.originMessage = minimock.CallerInfo(1)
+
+	return mmSendMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Inspect(f func(ctx context.Context, message *model.SendMessage)) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.inspectFuncSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SendMessage")
+	}
+
+	mmSendMessage.mock.inspectFuncSendMessage = f
+
+	return mmSendMessage
+}
+
+// Return sets up results that will be returned by ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Return(i1 int64, err error) *ChatServiceMock {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{mock: mmSendMessage.mock}
+	}
+	mmSendMessage.defaultExpectation.results = &ChatServiceMockSendMessageResults{i1, err}
+	mmSendMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSendMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.SendMessage method
+func (mmSendMessage *mChatServiceMockSendMessage) Set(f func(ctx context.Context, message *model.SendMessage) (i1 int64, err error)) *ChatServiceMock {
+	if mmSendMessage.defaultExpectation != nil {
+		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.SendMessage method")
+	}
+
+	if len(mmSendMessage.expectations) > 0 {
+		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.SendMessage method")
+	}
+
+	mmSendMessage.mock.funcSendMessage = f
+	mmSendMessage.mock.funcSendMessageOrigin = minimock.CallerInfo(1)
+	return mmSendMessage.mock
+}
+
+// When sets expectation for the ChatService.SendMessage which will trigger the result defined by the following
+// Then helper
+func (mmSendMessage *mChatServiceMockSendMessage) When(ctx context.Context, message *model.SendMessage) *ChatServiceMockSendMessageExpectation {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockSendMessageExpectation{
+		mock:               mmSendMessage.mock,
+		params:             &ChatServiceMockSendMessageParams{ctx, message},
+		expectationOrigins: ChatServiceMockSendMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.SendMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSendMessageExpectation) Then(i1 int64, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSendMessageResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.SendMessage should be invoked
+func (mmSendMessage *mChatServiceMockSendMessage) Times(n uint64) *mChatServiceMockSendMessage {
+	if n == 0 {
+		mmSendMessage.mock.t.Fatalf("Times of ChatServiceMock.SendMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
+	mmSendMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSendMessage
+}
+
+func (mmSendMessage *mChatServiceMockSendMessage) invocationsDone() bool {
+	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendMessage implements mm_service.ChatService
+func (mmSendMessage *ChatServiceMock) SendMessage(ctx context.Context, message *model.SendMessage) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+
+	mmSendMessage.t.Helper()
+
+	if mmSendMessage.inspectFuncSendMessage != nil {
+		mmSendMessage.inspectFuncSendMessage(ctx, message)
+	}
+
+	mm_params := ChatServiceMockSendMessageParams{ctx, message}
+
+	// Record call args
+	mmSendMessage.SendMessageMock.mutex.Lock()
+	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
+	mmSendMessage.SendMessageMock.mutex.Unlock()
+
+	for _, e := range mmSendMessage.SendMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSendMessageParams{ctx, message}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
+				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter message, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originMessage, *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSendMessage.t.Fatal("No results are set for the ChatServiceMock.SendMessage")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmSendMessage.funcSendMessage != nil {
+		return mmSendMessage.funcSendMessage(ctx, message)
+	}
+	mmSendMessage.t.Fatalf("Unexpected call to ChatServiceMock.SendMessage. %v %v", ctx, message)
+	return
+}
+
+// SendMessageAfterCounter returns a count of finished ChatServiceMock.SendMessage invocations
+func (mmSendMessage *ChatServiceMock) SendMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+}
+
+// SendMessageBeforeCounter returns a count of ChatServiceMock.SendMessage invocations
+func (mmSendMessage *ChatServiceMock) SendMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.SendMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendMessage *mChatServiceMockSendMessage) Calls() []*ChatServiceMockSendMessageParams {
+	mmSendMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSendMessageParams, len(mmSendMessage.callArgs))
+	copy(argCopy, mmSendMessage.callArgs)
+
+	mmSendMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSendMessageDone() bool {
+	if m.SendMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendMessageMock.invocationsDone()
+}
+
+// MinimockSendMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSendMessageInspect() {
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
+		if m.SendMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s", m.SendMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s with params: %#v", m.SendMessageMock.defaultExpectation.expectationOrigins.origin, *m.SendMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s", m.funcSendMessageOrigin)
+	}
+
+	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SendMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), m.SendMessageMock.expectedInvocationsOrigin, afterSendMessageCounter)
+	}
+}
+
+type mChatServiceMockSetRetention struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSetRetentionExpectation
+	expectations       []*ChatServiceMockSetRetentionExpectation
+
+	callArgs []*ChatServiceMockSetRetentionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockSetRetentionExpectation specifies expectation struct of the ChatService.SetRetention
+type ChatServiceMockSetRetentionExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockSetRetentionParams
+	paramPtrs          *ChatServiceMockSetRetentionParamPtrs
+	expectationOrigins ChatServiceMockSetRetentionExpectationOrigins
+	results            *ChatServiceMockSetRetentionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockSetRetentionParams contains parameters of the ChatService.SetRetention
+type ChatServiceMockSetRetentionParams struct {
+	ctx       context.Context
+	retention *model.ChatRetention
+}
+
+// ChatServiceMockSetRetentionParamPtrs contains pointers to parameters of the ChatService.SetRetention
+type ChatServiceMockSetRetentionParamPtrs struct {
+	ctx       *context.Context
+	retention **model.ChatRetention
+}
+
+// ChatServiceMockSetRetentionResults contains results of the ChatService.SetRetention
+type ChatServiceMockSetRetentionResults struct {
+	err error
+}
+
+// ChatServiceMockSetRetentionOrigins contains origins of expectations of the ChatService.SetRetention
+type ChatServiceMockSetRetentionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originRetention string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetRetention *mChatServiceMockSetRetention) Optional() *mChatServiceMockSetRetention {
+	mmSetRetention.optional = true
+	return mmSetRetention
+}
+
+// Expect sets up expected params for ChatService.SetRetention
+func (mmSetRetention *mChatServiceMockSetRetention) Expect(ctx context.Context, retention *model.ChatRetention) *mChatServiceMockSetRetention {
+	if mmSetRetention.mock.funcSetRetention != nil {
+		mmSetRetention.mock.t.Fatalf("ChatServiceMock.SetRetention mock is already set by Set")
+	}
+
+	if mmSetRetention.defaultExpectation == nil {
+		mmSetRetention.defaultExpectation = &ChatServiceMockSetRetentionExpectation{}
+	}
+
+	if mmSetRetention.defaultExpectation.paramPtrs != nil {
+		mmSetRetention.mock.t.Fatalf("ChatServiceMock.SetRetention mock is already set by ExpectParams functions")
+	}
+
+	mmSetRetention.defaultExpectation.params = &ChatServiceMockSetRetentionParams{ctx, retention}
+	mmSetRetention.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetRetention.expectations {
+		if minimock.Equal(e.params, mmSetRetention.defaultExpectation.params) {
+			mmSetRetention.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetRetention.defaultExpectation.params)
+		}
+	}
+
+	return mmSetRetention
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SetRetention
+func (mmSetRetention *mChatServiceMockSetRetention) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSetRetention {
+	if mmSetRetention.mock.funcSetRetention != nil {
+		mmSetRetention.mock.t.Fatalf("ChatServiceMock.SetRetention mock is already set by Set")
+	}
+
+	if mmSetRetention.defaultExpectation == nil {
+		mmSetRetention.defaultExpectation = &ChatServiceMockSetRetentionExpectation{}
+	}
+
+	if mmSetRetention.defaultExpectation.params != nil {
+		mmSetRetention.mock.t.Fatalf("ChatServiceMock.SetRetention mock is already set by Expect")
+	}
+
+	if mmSetRetention.defaultExpectation.paramPtrs == nil {
+		mmSetRetention.defaultExpectation.paramPtrs = &ChatServiceMockSetRetentionParamPtrs{}
+	}
+	mmSetRetention.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetRetention.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetRetention
+}
+
+// ExpectRetentionParam2 sets up expected param retention for ChatService.SetRetention
+func (mmSetRetention *mChatServiceMockSetRetention) ExpectRetentionParam2(retention *model.ChatRetention) *mChatServiceMockSetRetention {
+	if mmSetRetention.mock.funcSetRetention != nil {
+		mmSetRetention.mock.t.Fatalf("ChatServiceMock.SetRetention mock is already set by Set")
+	}
+
+	if mmSetRetention.defaultExpectation == nil {
+		mmSetRetention.defaultExpectation = &ChatServiceMockSetRetentionExpectation{}
+	}
+
+	if mmSetRetention.defaultExpectation.params != nil {
+		mmSetRetention.mock.t.Fatalf("ChatServiceMock.SetRetention mock is already set by Expect")
+	}
+
+	if mmSetRetention.defaultExpectation.paramPtrs == nil {
+		mmSetRetention.defaultExpectation.paramPtrs = &ChatServiceMockSetRetentionParamPtrs{}
+	}
+	mmSetRetention.defaultExpectation.paramPtrs.retention = &retention
+	mmSetRetention.defaultExpectation.expectationOrigins.originRetention = minimock.CallerInfo(1)
+
+	return mmSetRetention
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SetRetention
+func (mmSetRetention *mChatServiceMockSetRetention) Inspect(f func(ctx context.Context, retention *model.ChatRetention)) *mChatServiceMockSetRetention {
+	if mmSetRetention.mock.inspectFuncSetRetention != nil {
+		mmSetRetention.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SetRetention")
+	}
+
+	mmSetRetention.mock.inspectFuncSetRetention = f
+
+	return mmSetRetention
+}
+
+// Return sets up results that will be returned by ChatService.SetRetention
+func (mmSetRetention *mChatServiceMockSetRetention) Return(err error) *ChatServiceMock {
+	if mmSetRetention.mock.funcSetRetention != nil {
+		mmSetRetention.mock.t.Fatalf("ChatServiceMock.SetRetention mock is already set by Set")
+	}
+
+	if mmSetRetention.defaultExpectation == nil {
+		mmSetRetention.defaultExpectation = &ChatServiceMockSetRetentionExpectation{mock: mmSetRetention.mock}
+	}
+	mmSetRetention.defaultExpectation.results = &ChatServiceMockSetRetentionResults{err}
+	mmSetRetention.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetRetention.mock
+}
+
+// Set uses given function f to mock the ChatService.SetRetention method
+func (mmSetRetention *mChatServiceMockSetRetention) Set(f func(ctx context.Context, retention *model.ChatRetention) (err error)) *ChatServiceMock {
+	if mmSetRetention.defaultExpectation != nil {
+		mmSetRetention.mock.t.Fatalf("Default expectation is already set for the ChatService.SetRetention method")
+	}
+
+	if len(mmSetRetention.expectations) > 0 {
+		mmSetRetention.mock.t.Fatalf("Some expectations are already set for the ChatService.SetRetention method")
+	}
+
+	mmSetRetention.mock.funcSetRetention = f
+	mmSetRetention.mock.funcSetRetentionOrigin = minimock.CallerInfo(1)
+	return mmSetRetention.mock
+}
+
+// When sets expectation for the ChatService.SetRetention which will trigger the result defined by the following
+// Then helper
+func (mmSetRetention *mChatServiceMockSetRetention) When(ctx context.Context, retention *model.ChatRetention) *ChatServiceMockSetRetentionExpectation {
+	if mmSetRetention.mock.funcSetRetention != nil {
+		mmSetRetention.mock.t.Fatalf("ChatServiceMock.SetRetention mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockSetRetentionExpectation{
+		mock:               mmSetRetention.mock,
+		params:             &ChatServiceMockSetRetentionParams{ctx, retention},
+		expectationOrigins: ChatServiceMockSetRetentionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetRetention.expectations = append(mmSetRetention.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.SetRetention return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSetRetentionExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSetRetentionResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.SetRetention should be invoked
+func (mmSetRetention *mChatServiceMockSetRetention) Times(n uint64) *mChatServiceMockSetRetention {
+	if n == 0 {
+		mmSetRetention.mock.t.Fatalf("Times of ChatServiceMock.SetRetention mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetRetention.expectedInvocations, n)
+	mmSetRetention.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetRetention
+}
+
+func (mmSetRetention *mChatServiceMockSetRetention) invocationsDone() bool {
+	if len(mmSetRetention.expectations) == 0 && mmSetRetention.defaultExpectation == nil && mmSetRetention.mock.funcSetRetention == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetRetention.mock.afterSetRetentionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetRetention.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetRetention implements mm_service.ChatService
+func (mmSetRetention *ChatServiceMock) SetRetention(ctx context.Context, retention *model.ChatRetention) (err error) {
+	mm_atomic.AddUint64(&mmSetRetention.beforeSetRetentionCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetRetention.afterSetRetentionCounter, 1)
+
+	mmSetRetention.t.Helper()
+
+	if mmSetRetention.inspectFuncSetRetention != nil {
+		mmSetRetention.inspectFuncSetRetention(ctx, retention)
+	}
+
+	mm_params := ChatServiceMockSetRetentionParams{ctx, retention}
+
+	// Record call args
+	mmSetRetention.SetRetentionMock.mutex.Lock()
+	mmSetRetention.SetRetentionMock.callArgs = append(mmSetRetention.SetRetentionMock.callArgs, &mm_params)
+	mmSetRetention.SetRetentionMock.mutex.Unlock()
+
+	for _, e := range mmSetRetention.SetRetentionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetRetention.SetRetentionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetRetention.SetRetentionMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetRetention.SetRetentionMock.defaultExpectation.params
+		mm_want_ptrs := mmSetRetention.SetRetentionMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSetRetentionParams{ctx, retention}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetRetention.t.Errorf("ChatServiceMock.SetRetention got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetRetention.SetRetentionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.retention != nil && !minimock.Equal(*mm_want_ptrs.retention, mm_got.retention) {
+				mmSetRetention.t.Errorf("ChatServiceMock.SetRetention got unexpected parameter retention, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetRetention.SetRetentionMock.defaultExpectation.expectationOrigins.originRetention, *mm_want_ptrs.retention, mm_got.retention, minimock.Diff(*mm_want_ptrs.retention, mm_got.retention))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetRetention.t.Errorf("ChatServiceMock.SetRetention got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetRetention.SetRetentionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetRetention.SetRetentionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetRetention.t.Fatal("No results are set for the ChatServiceMock.SetRetention")
+		}
+		return (*mm_results).err
+	}
+	if mmSetRetention.funcSetRetention != nil {
+		return mmSetRetention.funcSetRetention(ctx, retention)
+	}
+	mmSetRetention.t.Fatalf("Unexpected call to ChatServiceMock.SetRetention. %v %v", ctx, retention)
+	return
+}
+
+// SetRetentionAfterCounter returns a count of finished ChatServiceMock.SetRetention invocations
+func (mmSetRetention *ChatServiceMock) SetRetentionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetRetention.afterSetRetentionCounter)
+}
+
+// SetRetentionBeforeCounter returns a count of ChatServiceMock.SetRetention invocations
+func (mmSetRetention *ChatServiceMock) SetRetentionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetRetention.beforeSetRetentionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.SetRetention.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetRetention *mChatServiceMockSetRetention) Calls() []*ChatServiceMockSetRetentionParams {
+	mmSetRetention.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSetRetentionParams, len(mmSetRetention.callArgs))
+	copy(argCopy, mmSetRetention.callArgs)
+
+	mmSetRetention.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetRetentionDone returns true if the count of the SetRetention invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSetRetentionDone() bool {
+	if m.SetRetentionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetRetentionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetRetentionMock.invocationsDone()
+}
+
+// MinimockSetRetentionInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSetRetentionInspect() {
+	for _, e := range m.SetRetentionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.SetRetention at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetRetentionCounter := mm_atomic.LoadUint64(&m.afterSetRetentionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetRetentionMock.defaultExpectation != nil && afterSetRetentionCounter < 1 {
+		if m.SetRetentionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.SetRetention at\n%s", m.SetRetentionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.SetRetention at\n%s with params: %#v", m.SetRetentionMock.defaultExpectation.expectationOrigins.origin, *m.SetRetentionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetRetention != nil && afterSetRetentionCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.SetRetention at\n%s", m.funcSetRetentionOrigin)
+	}
+
+	if !m.SetRetentionMock.invocationsDone() && afterSetRetentionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SetRetention at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetRetentionMock.expectedInvocations), m.SetRetentionMock.expectedInvocationsOrigin, afterSetRetentionCounter)
+	}
+}
+
+type mChatServiceMockTouchSession struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockTouchSessionExpectation
+	expectations       []*ChatServiceMockTouchSessionExpectation
+
+	callArgs []*ChatServiceMockTouchSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockTouchSessionExpectation specifies expectation struct of the ChatService.TouchSession
+type ChatServiceMockTouchSessionExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockTouchSessionParams
+	paramPtrs          *ChatServiceMockTouchSessionParamPtrs
+	expectationOrigins ChatServiceMockTouchSessionExpectationOrigins
+	results            *ChatServiceMockTouchSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockTouchSessionParams contains parameters of the ChatService.TouchSession
+type ChatServiceMockTouchSessionParams struct {
+	ctx          context.Context
+	id           string
+	lastActiveAt time.Time
+}
+
+// ChatServiceMockTouchSessionParamPtrs contains pointers to parameters of the ChatService.TouchSession
+type ChatServiceMockTouchSessionParamPtrs struct {
+	ctx          *context.Context
+	id           *string
+	lastActiveAt *time.Time
+}
+
+// ChatServiceMockTouchSessionResults contains results of the ChatService.TouchSession
+type ChatServiceMockTouchSessionResults struct {
+	err error
+}
+
+// ChatServiceMockTouchSessionOrigins contains origins of expectations of the ChatService.TouchSession
+type ChatServiceMockTouchSessionExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originId           string
+	originLastActiveAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTouchSession *mChatServiceMockTouchSession) Optional() *mChatServiceMockTouchSession {
+	mmTouchSession.optional = true
+	return mmTouchSession
+}
+
+// Expect sets up expected params for ChatService.TouchSession
+func (mmTouchSession *mChatServiceMockTouchSession) Expect(ctx context.Context, id string, lastActiveAt time.Time) *mChatServiceMockTouchSession {
+	if mmTouchSession.mock.funcTouchSession != nil {
+		mmTouchSession.mock.t.Fatalf("ChatServiceMock.TouchSession mock is already set by Set")
+	}
+
+	if mmTouchSession.defaultExpectation == nil {
+		mmTouchSession.defaultExpectation = &ChatServiceMockTouchSessionExpectation{}
+	}
+
+	if mmTouchSession.defaultExpectation.paramPtrs != nil {
+		mmTouchSession.mock.t.Fatalf("ChatServiceMock.TouchSession mock is already set by ExpectParams functions")
+	}
+
+	mmTouchSession.defaultExpectation.params = &ChatServiceMockTouchSessionParams{ctx, id, lastActiveAt}
+	mmTouchSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTouchSession.expectations {
+		if minimock.Equal(e.params, mmTouchSession.defaultExpectation.params) {
+			mmTouchSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTouchSession.defaultExpectation.params)
+		}
+	}
+
+	return mmTouchSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.TouchSession
+func (mmTouchSession *mChatServiceMockTouchSession) ExpectCtxParam1(ctx context.Context) *mChatServiceMockTouchSession {
+	if mmTouchSession.mock.funcTouchSession != nil {
+		mmTouchSession.mock.t.Fatalf("ChatServiceMock.TouchSession mock is already set by Set")
+	}
+
+	if mmTouchSession.defaultExpectation == nil {
+		mmTouchSession.defaultExpectation = &ChatServiceMockTouchSessionExpectation{}
+	}
+
+	if mmTouchSession.defaultExpectation.params != nil {
+		mmTouchSession.mock.t.Fatalf("ChatServiceMock.TouchSession mock is already set by Expect")
+	}
+
+	if mmTouchSession.defaultExpectation.paramPtrs == nil {
+		mmTouchSession.defaultExpectation.paramPtrs = &ChatServiceMockTouchSessionParamPtrs{}
+	}
+	mmTouchSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTouchSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmTouchSession
+}
+
+// ExpectIdParam2 sets up expected param id for ChatService.TouchSession
+func (mmTouchSession *mChatServiceMockTouchSession) ExpectIdParam2(id string) *mChatServiceMockTouchSession {
+	if mmTouchSession.mock.funcTouchSession != nil {
+		mmTouchSession.mock.t.Fatalf("ChatServiceMock.TouchSession mock is already set by Set")
+	}
+
+	if mmTouchSession.defaultExpectation == nil {
+		mmTouchSession.defaultExpectation = &ChatServiceMockTouchSessionExpectation{}
+	}
+
+	if mmTouchSession.defaultExpectation.params != nil {
+		mmTouchSession.mock.t.Fatalf("ChatServiceMock.TouchSession mock is already set by Expect")
+	}
+
+	if mmTouchSession.defaultExpectation.paramPtrs == nil {
+		mmTouchSession.defaultExpectation.paramPtrs = &ChatServiceMockTouchSessionParamPtrs{}
+	}
+	mmTouchSession.defaultExpectation.paramPtrs.id = &id
+	mmTouchSession.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmTouchSession
+}
+
+// ExpectLastActiveAtParam3 sets up expected param lastActiveAt for ChatService.TouchSession
+func (mmTouchSession *mChatServiceMockTouchSession) ExpectLastActiveAtParam3(lastActiveAt time.Time) *mChatServiceMockTouchSession {
+	if mmTouchSession.mock.funcTouchSession != nil {
+		mmTouchSession.mock.t.Fatalf("ChatServiceMock.TouchSession mock is already set by Set")
+	}
+
+	if mmTouchSession.defaultExpectation == nil {
+		mmTouchSession.defaultExpectation = &ChatServiceMockTouchSessionExpectation{}
+	}
+
+	if mmTouchSession.defaultExpectation.params != nil {
+		mmTouchSession.mock.t.Fatalf("ChatServiceMock.TouchSession mock is already set by Expect")
+	}
+
+	if mmTouchSession.defaultExpectation.paramPtrs == nil {
+		mmTouchSession.defaultExpectation.paramPtrs = &ChatServiceMockTouchSessionParamPtrs{}
+	}
+	mmTouchSession.defaultExpectation.paramPtrs.lastActiveAt = &lastActiveAt
+	mmTouchSession.defaultExpectation.expectationOrigins.originLastActiveAt = minimock.CallerInfo(1)
+
+	return mmTouchSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.TouchSession
+func (mmTouchSession *mChatServiceMockTouchSession) Inspect(f func(ctx context.Context, id string, lastActiveAt time.Time)) *mChatServiceMockTouchSession {
+	if mmTouchSession.mock.inspectFuncTouchSession != nil {
+		mmTouchSession.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.TouchSession")
+	}
+
+	mmTouchSession.mock.inspectFuncTouchSession = f
+
+	return mmTouchSession
+}
+
+// Return sets up results that will be returned by ChatService.TouchSession
+func (mmTouchSession *mChatServiceMockTouchSession) Return(err error) *ChatServiceMock {
+	if mmTouchSession.mock.funcTouchSession != nil {
+		mmTouchSession.mock.t.Fatalf("ChatServiceMock.TouchSession mock is already set by Set")
+	}
+
+	if mmTouchSession.defaultExpectation == nil {
+		mmTouchSession.defaultExpectation = &ChatServiceMockTouchSessionExpectation{mock: mmTouchSession.mock}
+	}
+	mmTouchSession.defaultExpectation.results = &ChatServiceMockTouchSessionResults{err}
+	mmTouchSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTouchSession.mock
+}
+
+// Set uses given function f to mock the ChatService.TouchSession method
+func (mmTouchSession *mChatServiceMockTouchSession) Set(f func(ctx context.Context, id string, lastActiveAt time.Time) (err error)) *ChatServiceMock {
+	if mmTouchSession.defaultExpectation != nil {
+		mmTouchSession.mock.t.Fatalf("Default expectation is already set for the ChatService.TouchSession method")
+	}
+
+	if len(mmTouchSession.expectations) > 0 {
+		mmTouchSession.mock.t.Fatalf("Some expectations are already set for the ChatService.TouchSession method")
+	}
+
+	mmTouchSession.mock.funcTouchSession = f
+	mmTouchSession.mock.funcTouchSessionOrigin = minimock.CallerInfo(1)
+	return mmTouchSession.mock
+}
+
+// When sets expectation for the ChatService.TouchSession which will trigger the result defined by the following
+// Then helper
+func (mmTouchSession *mChatServiceMockTouchSession) When(ctx context.Context, id string, lastActiveAt time.Time) *ChatServiceMockTouchSessionExpectation {
+	if mmTouchSession.mock.funcTouchSession != nil {
+		mmTouchSession.mock.t.Fatalf("ChatServiceMock.TouchSession mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockTouchSessionExpectation{
+		mock:               mmTouchSession.mock,
+		params:             &ChatServiceMockTouchSessionParams{ctx, id, lastActiveAt},
+		expectationOrigins: ChatServiceMockTouchSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmTouchSession.expectations = append(mmTouchSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.TouchSession return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockTouchSessionExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockTouchSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.TouchSession should be invoked
+func (mmTouchSession *mChatServiceMockTouchSession) Times(n uint64) *mChatServiceMockTouchSession {
+	if n == 0 {
+		mmTouchSession.mock.t.Fatalf("Times of ChatServiceMock.TouchSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTouchSession.expectedInvocations, n)
+	mmTouchSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTouchSession
+}
+
+func (mmTouchSession *mChatServiceMockTouchSession) invocationsDone() bool {
+	if len(mmTouchSession.expectations) == 0 && mmTouchSession.defaultExpectation == nil && mmTouchSession.mock.funcTouchSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTouchSession.mock.afterTouchSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTouchSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TouchSession implements mm_service.ChatService
+func (mmTouchSession *ChatServiceMock) TouchSession(ctx context.Context, id string, lastActiveAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmTouchSession.beforeTouchSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmTouchSession.afterTouchSessionCounter, 1)
+
+	mmTouchSession.t.Helper()
+
+	if mmTouchSession.inspectFuncTouchSession != nil {
+		mmTouchSession.inspectFuncTouchSession(ctx, id, lastActiveAt)
+	}
+
+	mm_params := ChatServiceMockTouchSessionParams{ctx, id, lastActiveAt}
+
+	// Record call args
+	mmTouchSession.TouchSessionMock.mutex.Lock()
+	mmTouchSession.TouchSessionMock.callArgs = append(mmTouchSession.TouchSessionMock.callArgs, &mm_params)
+	mmTouchSession.TouchSessionMock.mutex.Unlock()
+
+	for _, e := range mmTouchSession.TouchSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmTouchSession.TouchSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTouchSession.TouchSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmTouchSession.TouchSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmTouchSession.TouchSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockTouchSessionParams{ctx, id, lastActiveAt}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGet.t.Errorf("ChatServiceMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmTouchSession.t.Errorf("ChatServiceMock.TouchSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTouchSession.TouchSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmGet.t.Errorf("ChatServiceMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+				mmTouchSession.t.Errorf("ChatServiceMock.TouchSession got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTouchSession.TouchSessionMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.lastActiveAt != nil && !minimock.Equal(*mm_want_ptrs.lastActiveAt, mm_got.lastActiveAt) {
+				mmTouchSession.t.Errorf("ChatServiceMock.TouchSession got unexpected parameter lastActiveAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTouchSession.TouchSessionMock.defaultExpectation.expectationOrigins.originLastActiveAt, *mm_want_ptrs.lastActiveAt, mm_got.lastActiveAt, minimock.Diff(*mm_want_ptrs.lastActiveAt, mm_got.lastActiveAt))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGet.t.Errorf("ChatServiceMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmTouchSession.t.Errorf("ChatServiceMock.TouchSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTouchSession.TouchSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGet.GetMock.defaultExpectation.results
+		mm_results := mmTouchSession.TouchSessionMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGet.t.Fatal("No results are set for the ChatServiceMock.Get")
+			mmTouchSession.t.Fatal("No results are set for the ChatServiceMock.TouchSession")
 		}
-		return (*mm_results).cp1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGet.funcGet != nil {
-		return mmGet.funcGet(ctx, id)
+	if mmTouchSession.funcTouchSession != nil {
+		return mmTouchSession.funcTouchSession(ctx, id, lastActiveAt)
 	}
-	mmGet.t.Fatalf("Unexpected call to ChatServiceMock.Get. %v %v", ctx, id)
+	mmTouchSession.t.Fatalf("Unexpected call to ChatServiceMock.TouchSession. %v %v %v", ctx, id, lastActiveAt)
 	return
 }
 
-// GetAfterCounter returns a count of finished ChatServiceMock.Get invocations
-func (mmGet *ChatServiceMock) GetAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+// TouchSessionAfterCounter returns a count of finished ChatServiceMock.TouchSession invocations
+func (mmTouchSession *ChatServiceMock) TouchSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouchSession.afterTouchSessionCounter)
 }
 
-// GetBeforeCounter returns a count of ChatServiceMock.Get invocations
-func (mmGet *ChatServiceMock) GetBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+// TouchSessionBeforeCounter returns a count of ChatServiceMock.TouchSession invocations
+func (mmTouchSession *ChatServiceMock) TouchSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouchSession.beforeTouchSessionCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.Get.
+// Calls returns a list of arguments used in each call to ChatServiceMock.TouchSession.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGet *mChatServiceMockGet) Calls() []*ChatServiceMockGetParams {
-	mmGet.mutex.RLock()
+func (mmTouchSession *mChatServiceMockTouchSession) Calls() []*ChatServiceMockTouchSessionParams {
+	mmTouchSession.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockGetParams, len(mmGet.callArgs))
-	copy(argCopy, mmGet.callArgs)
+	argCopy := make([]*ChatServiceMockTouchSessionParams, len(mmTouchSession.callArgs))
+	copy(argCopy, mmTouchSession.callArgs)
 
-	mmGet.mutex.RUnlock()
+	mmTouchSession.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetDone returns true if the count of the Get invocations corresponds
+// MinimockTouchSessionDone returns true if the count of the TouchSession invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockGetDone() bool {
-	if m.GetMock.optional {
+func (m *ChatServiceMock) MinimockTouchSessionDone() bool {
+	if m.TouchSessionMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetMock.expectations {
+	for _, e := range m.TouchSessionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetMock.invocationsDone()
+	return m.TouchSessionMock.invocationsDone()
 }
 
-// MinimockGetInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockGetInspect() {
-	for _, e := range m.GetMock.expectations {
+// MinimockTouchSessionInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockTouchSessionInspect() {
+	for _, e := range m.TouchSessionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.TouchSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	afterTouchSessionCounter := mm_atomic.LoadUint64(&m.afterTouchSessionCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
-		if m.GetMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatServiceMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+	if m.TouchSessionMock.defaultExpectation != nil && afterTouchSessionCounter < 1 {
+		if m.TouchSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.TouchSession at\n%s", m.TouchSessionMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.TouchSession at\n%s with params: %#v", m.TouchSessionMock.defaultExpectation.expectationOrigins.origin, *m.TouchSessionMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGet != nil && afterGetCounter < 1 {
-		m.t.Errorf("Expected call to ChatServiceMock.Get at\n%s", m.funcGetOrigin)
+	if m.funcTouchSession != nil && afterTouchSessionCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.TouchSession at\n%s", m.funcTouchSessionOrigin)
 	}
 
-	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.Get at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	if !m.TouchSessionMock.invocationsDone() && afterTouchSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.TouchSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TouchSessionMock.expectedInvocations), m.TouchSessionMock.expectedInvocationsOrigin, afterTouchSessionCounter)
 	}
 }
 
-type mChatServiceMockSendMessage struct {
+type mChatServiceMockUnarchive struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockSendMessageExpectation
-	expectations       []*ChatServiceMockSendMessageExpectation
+	defaultExpectation *ChatServiceMockUnarchiveExpectation
+	expectations       []*ChatServiceMockUnarchiveExpectation
 
-	callArgs []*ChatServiceMockSendMessageParams
+	callArgs []*ChatServiceMockUnarchiveParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatServiceMockSendMessageExpectation specifies expectation struct of the ChatService.SendMessage
-type ChatServiceMockSendMessageExpectation struct {
+// ChatServiceMockUnarchiveExpectation specifies expectation struct of the ChatService.Unarchive
+type ChatServiceMockUnarchiveExpectation struct {
 	mock               *ChatServiceMock
-	params             *ChatServiceMockSendMessageParams
-	paramPtrs          *ChatServiceMockSendMessageParamPtrs
-	expectationOrigins ChatServiceMockSendMessageExpectationOrigins
-	results            *ChatServiceMockSendMessageResults
+	params             *ChatServiceMockUnarchiveParams
+	paramPtrs          *ChatServiceMockUnarchiveParamPtrs
+	expectationOrigins ChatServiceMockUnarchiveExpectationOrigins
+	results            *ChatServiceMockUnarchiveResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatServiceMockSendMessageParams contains parameters of the ChatService.SendMessage
-type ChatServiceMockSendMessageParams struct {
-	ctx     context.Context
-	message *model.SendMessage
+// ChatServiceMockUnarchiveParams contains parameters of the ChatService.Unarchive
+type ChatServiceMockUnarchiveParams struct {
+	ctx context.Context
+	id  int64
 }
 
-// ChatServiceMockSendMessageParamPtrs contains pointers to parameters of the ChatService.SendMessage
-type ChatServiceMockSendMessageParamPtrs struct {
-	ctx     *context.Context
-	message **model.SendMessage
+// ChatServiceMockUnarchiveParamPtrs contains pointers to parameters of the ChatService.Unarchive
+type ChatServiceMockUnarchiveParamPtrs struct {
+	ctx *context.Context
+	id  *int64
 }
 
-// ChatServiceMockSendMessageResults contains results of the ChatService.SendMessage
-type ChatServiceMockSendMessageResults struct {
+// ChatServiceMockUnarchiveResults contains results of the ChatService.Unarchive
+type ChatServiceMockUnarchiveResults struct {
 	err error
 }
 
-// ChatServiceMockSendMessageOrigins contains origins of expectations of the ChatService.SendMessage
-type ChatServiceMockSendMessageExpectationOrigins struct {
-	origin        string
-	originCtx     string
-	originMessage string
+// ChatServiceMockUnarchiveOrigins contains origins of expectations of the ChatService.Unarchive
+type ChatServiceMockUnarchiveExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1154,292 +11566,292 @@ type ChatServiceMockSendMessageExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSendMessage *mChatServiceMockSendMessage) Optional() *mChatServiceMockSendMessage {
-	mmSendMessage.optional = true
-	return mmSendMessage
+func (mmUnarchive *mChatServiceMockUnarchive) Optional() *mChatServiceMockUnarchive {
+	mmUnarchive.optional = true
+	return mmUnarchive
 }
 
-// Expect sets up expected params for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Expect(ctx context.Context, message *model.SendMessage) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// Expect sets up expected params for ChatService.Unarchive
+func (mmUnarchive *mChatServiceMockUnarchive) Expect(ctx context.Context, id int64) *mChatServiceMockUnarchive {
+	if mmUnarchive.mock.funcUnarchive != nil {
+		mmUnarchive.mock.t.Fatalf("ChatServiceMock.Unarchive mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmUnarchive.defaultExpectation == nil {
+		mmUnarchive.defaultExpectation = &ChatServiceMockUnarchiveExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by ExpectParams functions")
+	if mmUnarchive.defaultExpectation.paramPtrs != nil {
+		mmUnarchive.mock.t.Fatalf("ChatServiceMock.Unarchive mock is already set by ExpectParams functions")
 	}
 
-	mmSendMessage.defaultExpectation.params = &ChatServiceMockSendMessageParams{ctx, message}
-	mmSendMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmSendMessage.expectations {
-		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
-			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+	mmUnarchive.defaultExpectation.params = &ChatServiceMockUnarchiveParams{ctx, id}
+	mmUnarchive.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUnarchive.expectations {
+		if minimock.Equal(e.params, mmUnarchive.defaultExpectation.params) {
+			mmUnarchive.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnarchive.defaultExpectation.params)
 		}
 	}
 
-	return mmSendMessage
+	return mmUnarchive
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.Unarchive
+func (mmUnarchive *mChatServiceMockUnarchive) ExpectCtxParam1(ctx context.Context) *mChatServiceMockUnarchive {
+	if mmUnarchive.mock.funcUnarchive != nil {
+		mmUnarchive.mock.t.Fatalf("ChatServiceMock.Unarchive mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmUnarchive.defaultExpectation == nil {
+		mmUnarchive.defaultExpectation = &ChatServiceMockUnarchiveExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	if mmUnarchive.defaultExpectation.params != nil {
+		mmUnarchive.mock.t.Fatalf("ChatServiceMock.Unarchive mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	if mmUnarchive.defaultExpectation.paramPtrs == nil {
+		mmUnarchive.defaultExpectation.paramPtrs = &ChatServiceMockUnarchiveParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
-	mmSendMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUnarchive.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUnarchive.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmSendMessage
+	return mmUnarchive
 }
 
-// ExpectMessageParam2 sets up expected param message for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) ExpectMessageParam2(message *model.SendMessage) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for ChatService.Unarchive
+func (mmUnarchive *mChatServiceMockUnarchive) ExpectIdParam2(id int64) *mChatServiceMockUnarchive {
+	if mmUnarchive.mock.funcUnarchive != nil {
+		mmUnarchive.mock.t.Fatalf("ChatServiceMock.Unarchive mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmUnarchive.defaultExpectation == nil {
+		mmUnarchive.defaultExpectation = &ChatServiceMockUnarchiveExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	if mmUnarchive.defaultExpectation.params != nil {
+		mmUnarchive.mock.t.Fatalf("ChatServiceMock.Unarchive mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	if mmUnarchive.defaultExpectation.paramPtrs == nil {
+		mmUnarchive.defaultExpectation.paramPtrs = &ChatServiceMockUnarchiveParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.message = &message
-	mmSendMessage.defaultExpectation.expectationOrigins.originMessage = minimock.CallerInfo(1)
+	mmUnarchive.defaultExpectation.paramPtrs.id = &id
+	mmUnarchive.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmSendMessage
+	return mmUnarchive
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Inspect(f func(ctx context.Context, message *model.SendMessage)) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.inspectFuncSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SendMessage")
+// Inspect accepts an inspector function that has same arguments as the ChatService.Unarchive
+func (mmUnarchive *mChatServiceMockUnarchive) Inspect(f func(ctx context.Context, id int64)) *mChatServiceMockUnarchive {
+	if mmUnarchive.mock.inspectFuncUnarchive != nil {
+		mmUnarchive.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Unarchive")
 	}
 
-	mmSendMessage.mock.inspectFuncSendMessage = f
+	mmUnarchive.mock.inspectFuncUnarchive = f
 
-	return mmSendMessage
+	return mmUnarchive
 }
 
-// Return sets up results that will be returned by ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Return(err error) *ChatServiceMock {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// Return sets up results that will be returned by ChatService.Unarchive
+func (mmUnarchive *mChatServiceMockUnarchive) Return(err error) *ChatServiceMock {
+	if mmUnarchive.mock.funcUnarchive != nil {
+		mmUnarchive.mock.t.Fatalf("ChatServiceMock.Unarchive mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{mock: mmSendMessage.mock}
+	if mmUnarchive.defaultExpectation == nil {
+		mmUnarchive.defaultExpectation = &ChatServiceMockUnarchiveExpectation{mock: mmUnarchive.mock}
 	}
-	mmSendMessage.defaultExpectation.results = &ChatServiceMockSendMessageResults{err}
-	mmSendMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmSendMessage.mock
+	mmUnarchive.defaultExpectation.results = &ChatServiceMockUnarchiveResults{err}
+	mmUnarchive.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUnarchive.mock
 }
 
-// Set uses given function f to mock the ChatService.SendMessage method
-func (mmSendMessage *mChatServiceMockSendMessage) Set(f func(ctx context.Context, message *model.SendMessage) (err error)) *ChatServiceMock {
-	if mmSendMessage.defaultExpectation != nil {
-		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.SendMessage method")
+// Set uses given function f to mock the ChatService.Unarchive method
+func (mmUnarchive *mChatServiceMockUnarchive) Set(f func(ctx context.Context, id int64) (err error)) *ChatServiceMock {
+	if mmUnarchive.defaultExpectation != nil {
+		mmUnarchive.mock.t.Fatalf("Default expectation is already set for the ChatService.Unarchive method")
 	}
 
-	if len(mmSendMessage.expectations) > 0 {
-		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.SendMessage method")
+	if len(mmUnarchive.expectations) > 0 {
+		mmUnarchive.mock.t.Fatalf("Some expectations are already set for the ChatService.Unarchive method")
 	}
 
-	mmSendMessage.mock.funcSendMessage = f
-	mmSendMessage.mock.funcSendMessageOrigin = minimock.CallerInfo(1)
-	return mmSendMessage.mock
+	mmUnarchive.mock.funcUnarchive = f
+	mmUnarchive.mock.funcUnarchiveOrigin = minimock.CallerInfo(1)
+	return mmUnarchive.mock
 }
 
-// When sets expectation for the ChatService.SendMessage which will trigger the result defined by the following
+// When sets expectation for the ChatService.Unarchive which will trigger the result defined by the following
 // Then helper
-func (mmSendMessage *mChatServiceMockSendMessage) When(ctx context.Context, message *model.SendMessage) *ChatServiceMockSendMessageExpectation {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+func (mmUnarchive *mChatServiceMockUnarchive) When(ctx context.Context, id int64) *ChatServiceMockUnarchiveExpectation {
+	if mmUnarchive.mock.funcUnarchive != nil {
+		mmUnarchive.mock.t.Fatalf("ChatServiceMock.Unarchive mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockSendMessageExpectation{
-		mock:               mmSendMessage.mock,
-		params:             &ChatServiceMockSendMessageParams{ctx, message},
-		expectationOrigins: ChatServiceMockSendMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatServiceMockUnarchiveExpectation{
+		mock:               mmUnarchive.mock,
+		params:             &ChatServiceMockUnarchiveParams{ctx, id},
+		expectationOrigins: ChatServiceMockUnarchiveExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	mmUnarchive.expectations = append(mmUnarchive.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.SendMessage return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockSendMessageExpectation) Then(err error) *ChatServiceMock {
-	e.results = &ChatServiceMockSendMessageResults{err}
+// Then sets up ChatService.Unarchive return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockUnarchiveExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockUnarchiveResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.SendMessage should be invoked
-func (mmSendMessage *mChatServiceMockSendMessage) Times(n uint64) *mChatServiceMockSendMessage {
+// Times sets number of times ChatService.Unarchive should be invoked
+func (mmUnarchive *mChatServiceMockUnarchive) Times(n uint64) *mChatServiceMockUnarchive {
 	if n == 0 {
-		mmSendMessage.mock.t.Fatalf("Times of ChatServiceMock.SendMessage mock can not be zero")
+		mmUnarchive.mock.t.Fatalf("Times of ChatServiceMock.Unarchive mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
-	mmSendMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmSendMessage
+	mm_atomic.StoreUint64(&mmUnarchive.expectedInvocations, n)
+	mmUnarchive.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUnarchive
 }
 
-func (mmSendMessage *mChatServiceMockSendMessage) invocationsDone() bool {
-	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+func (mmUnarchive *mChatServiceMockUnarchive) invocationsDone() bool {
+	if len(mmUnarchive.expectations) == 0 && mmUnarchive.defaultExpectation == nil && mmUnarchive.mock.funcUnarchive == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUnarchive.mock.afterUnarchiveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUnarchive.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// SendMessage implements mm_service.ChatService
-func (mmSendMessage *ChatServiceMock) SendMessage(ctx context.Context, message *model.SendMessage) (err error) {
-	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
-	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+// Unarchive implements mm_service.ChatService
+func (mmUnarchive *ChatServiceMock) Unarchive(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmUnarchive.beforeUnarchiveCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnarchive.afterUnarchiveCounter, 1)
 
-	mmSendMessage.t.Helper()
+	mmUnarchive.t.Helper()
 
-	if mmSendMessage.inspectFuncSendMessage != nil {
-		mmSendMessage.inspectFuncSendMessage(ctx, message)
+	if mmUnarchive.inspectFuncUnarchive != nil {
+		mmUnarchive.inspectFuncUnarchive(ctx, id)
 	}
 
-	mm_params := ChatServiceMockSendMessageParams{ctx, message}
+	mm_params := ChatServiceMockUnarchiveParams{ctx, id}
 
 	// Record call args
-	mmSendMessage.SendMessageMock.mutex.Lock()
-	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
-	mmSendMessage.SendMessageMock.mutex.Unlock()
+	mmUnarchive.UnarchiveMock.mutex.Lock()
+	mmUnarchive.UnarchiveMock.callArgs = append(mmUnarchive.UnarchiveMock.callArgs, &mm_params)
+	mmUnarchive.UnarchiveMock.mutex.Unlock()
 
-	for _, e := range mmSendMessage.SendMessageMock.expectations {
+	for _, e := range mmUnarchive.UnarchiveMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
-		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
-		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+	if mmUnarchive.UnarchiveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnarchive.UnarchiveMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnarchive.UnarchiveMock.defaultExpectation.params
+		mm_want_ptrs := mmUnarchive.UnarchiveMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockSendMessageParams{ctx, message}
+		mm_got := ChatServiceMockUnarchiveParams{ctx, id}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUnarchive.t.Errorf("ChatServiceMock.Unarchive got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnarchive.UnarchiveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
-				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter message, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originMessage, *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUnarchive.t.Errorf("ChatServiceMock.Unarchive got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnarchive.UnarchiveMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUnarchive.t.Errorf("ChatServiceMock.Unarchive got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUnarchive.UnarchiveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		mm_results := mmUnarchive.UnarchiveMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSendMessage.t.Fatal("No results are set for the ChatServiceMock.SendMessage")
+			mmUnarchive.t.Fatal("No results are set for the ChatServiceMock.Unarchive")
 		}
 		return (*mm_results).err
 	}
-	if mmSendMessage.funcSendMessage != nil {
-		return mmSendMessage.funcSendMessage(ctx, message)
+	if mmUnarchive.funcUnarchive != nil {
+		return mmUnarchive.funcUnarchive(ctx, id)
 	}
-	mmSendMessage.t.Fatalf("Unexpected call to ChatServiceMock.SendMessage. %v %v", ctx, message)
+	mmUnarchive.t.Fatalf("Unexpected call to ChatServiceMock.Unarchive. %v %v", ctx, id)
 	return
 }
 
-// SendMessageAfterCounter returns a count of finished ChatServiceMock.SendMessage invocations
-func (mmSendMessage *ChatServiceMock) SendMessageAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+// UnarchiveAfterCounter returns a count of finished ChatServiceMock.Unarchive invocations
+func (mmUnarchive *ChatServiceMock) UnarchiveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnarchive.afterUnarchiveCounter)
 }
 
-// SendMessageBeforeCounter returns a count of ChatServiceMock.SendMessage invocations
-func (mmSendMessage *ChatServiceMock) SendMessageBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+// UnarchiveBeforeCounter returns a count of ChatServiceMock.Unarchive invocations
+func (mmUnarchive *ChatServiceMock) UnarchiveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnarchive.beforeUnarchiveCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.SendMessage.
+// Calls returns a list of arguments used in each call to ChatServiceMock.Unarchive.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSendMessage *mChatServiceMockSendMessage) Calls() []*ChatServiceMockSendMessageParams {
-	mmSendMessage.mutex.RLock()
+func (mmUnarchive *mChatServiceMockUnarchive) Calls() []*ChatServiceMockUnarchiveParams {
+	mmUnarchive.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockSendMessageParams, len(mmSendMessage.callArgs))
-	copy(argCopy, mmSendMessage.callArgs)
+	argCopy := make([]*ChatServiceMockUnarchiveParams, len(mmUnarchive.callArgs))
+	copy(argCopy, mmUnarchive.callArgs)
 
-	mmSendMessage.mutex.RUnlock()
+	mmUnarchive.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// MinimockUnarchiveDone returns true if the count of the Unarchive invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockSendMessageDone() bool {
-	if m.SendMessageMock.optional {
+func (m *ChatServiceMock) MinimockUnarchiveDone() bool {
+	if m.UnarchiveMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.SendMessageMock.expectations {
+	for _, e := range m.UnarchiveMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.SendMessageMock.invocationsDone()
+	return m.UnarchiveMock.invocationsDone()
 }
 
-// MinimockSendMessageInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockSendMessageInspect() {
-	for _, e := range m.SendMessageMock.expectations {
+// MinimockUnarchiveInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockUnarchiveInspect() {
+	for _, e := range m.UnarchiveMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.Unarchive at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	afterUnarchiveCounter := mm_atomic.LoadUint64(&m.afterUnarchiveCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
-		if m.SendMessageMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s", m.SendMessageMock.defaultExpectation.returnOrigin)
+	if m.UnarchiveMock.defaultExpectation != nil && afterUnarchiveCounter < 1 {
+		if m.UnarchiveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.Unarchive at\n%s", m.UnarchiveMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s with params: %#v", m.SendMessageMock.defaultExpectation.expectationOrigins.origin, *m.SendMessageMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.Unarchive at\n%s with params: %#v", m.UnarchiveMock.defaultExpectation.expectationOrigins.origin, *m.UnarchiveMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
-		m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s", m.funcSendMessageOrigin)
+	if m.funcUnarchive != nil && afterUnarchiveCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.Unarchive at\n%s", m.funcUnarchiveOrigin)
 	}
 
-	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.SendMessage at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), m.SendMessageMock.expectedInvocationsOrigin, afterSendMessageCounter)
+	if !m.UnarchiveMock.invocationsDone() && afterUnarchiveCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.Unarchive at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UnarchiveMock.expectedInvocations), m.UnarchiveMock.expectedInvocationsOrigin, afterUnarchiveCounter)
 	}
 }
 
@@ -1447,13 +11859,71 @@ func (m *ChatServiceMock) MinimockSendMessageInspect() {
 func (m *ChatServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAckDeliveryInspect()
+
+			m.MinimockAddReactionInspect()
+
+			m.MinimockArchiveInspect()
+
+			m.MinimockCancelScheduledInspect()
+
+			m.MinimockCloseSessionInspect()
+
 			m.MinimockCreateInspect()
 
 			m.MinimockDeleteInspect()
 
+			m.MinimockDeleteMessageInspect()
+
+			m.MinimockDeliverScheduledInspect()
+
+			m.MinimockEditMessageInspect()
+
+			m.MinimockExportChatInspect()
+
 			m.MinimockGetInspect()
 
+			m.MinimockGetDeliveryCursorInspect()
+
+			m.MinimockGetDeliveryStatusInspect()
+
+			m.MinimockImportChatInspect()
+
+			m.MinimockListAfterInspect()
+
+			m.MinimockListAuditLogInspect()
+
+			m.MinimockListMessagesInspect()
+
+			m.MinimockListScheduledInspect()
+
+			m.MinimockListSessionsInspect()
+
+			m.MinimockListThreadInspect()
+
+			m.MinimockListUndeliveredInspect()
+
+			m.MinimockMarkReadInspect()
+
+			m.MinimockOpenSessionInspect()
+
+			m.MinimockRemoveReactionInspect()
+
+			m.MinimockRestoreInspect()
+
+			m.MinimockRunCommandInspect()
+
+			m.MinimockScheduleMessageInspect()
+
+			m.MinimockSearchMessagesInspect()
+
 			m.MinimockSendMessageInspect()
+
+			m.MinimockSetRetentionInspect()
+
+			m.MinimockTouchSessionInspect()
+
+			m.MinimockUnarchiveInspect()
 		}
 	})
 }
@@ -1477,8 +11947,37 @@ func (m *ChatServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *ChatServiceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAckDeliveryDone() &&
+		m.MinimockAddReactionDone() &&
+		m.MinimockArchiveDone() &&
+		m.MinimockCancelScheduledDone() &&
+		m.MinimockCloseSessionDone() &&
 		m.MinimockCreateDone() &&
 		m.MinimockDeleteDone() &&
+		m.MinimockDeleteMessageDone() &&
+		m.MinimockDeliverScheduledDone() &&
+		m.MinimockEditMessageDone() &&
+		m.MinimockExportChatDone() &&
 		m.MinimockGetDone() &&
-		m.MinimockSendMessageDone()
+		m.MinimockGetDeliveryCursorDone() &&
+		m.MinimockGetDeliveryStatusDone() &&
+		m.MinimockImportChatDone() &&
+		m.MinimockListAfterDone() &&
+		m.MinimockListAuditLogDone() &&
+		m.MinimockListMessagesDone() &&
+		m.MinimockListScheduledDone() &&
+		m.MinimockListSessionsDone() &&
+		m.MinimockListThreadDone() &&
+		m.MinimockListUndeliveredDone() &&
+		m.MinimockMarkReadDone() &&
+		m.MinimockOpenSessionDone() &&
+		m.MinimockRemoveReactionDone() &&
+		m.MinimockRestoreDone() &&
+		m.MinimockRunCommandDone() &&
+		m.MinimockScheduleMessageDone() &&
+		m.MinimockSearchMessagesDone() &&
+		m.MinimockSendMessageDone() &&
+		m.MinimockSetRetentionDone() &&
+		m.MinimockTouchSessionDone() &&
+		m.MinimockUnarchiveDone()
 }
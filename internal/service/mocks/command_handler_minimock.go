@@ -0,0 +1,616 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Mobo140/chat/internal/service.CommandHandler -o command_handler_minimock.go -n CommandHandlerMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// CommandHandlerMock implements mm_service.CommandHandler
+type CommandHandlerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcRun          func(ctx context.Context, call *model.CommandCall) (s1 string, err error)
+	funcRunOrigin    string
+	inspectFuncRun   func(ctx context.Context, call *model.CommandCall)
+	afterRunCounter  uint64
+	beforeRunCounter uint64
+	RunMock          mCommandHandlerMockRun
+
+	funcSpec          func() (c1 model.CommandSpec)
+	funcSpecOrigin    string
+	inspectFuncSpec   func()
+	afterSpecCounter  uint64
+	beforeSpecCounter uint64
+	SpecMock          mCommandHandlerMockSpec
+}
+
+// NewCommandHandlerMock returns a mock for mm_service.CommandHandler
+func NewCommandHandlerMock(t minimock.Tester) *CommandHandlerMock {
+	m := &CommandHandlerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.RunMock = mCommandHandlerMockRun{mock: m}
+	m.RunMock.callArgs = []*CommandHandlerMockRunParams{}
+
+	m.SpecMock = mCommandHandlerMockSpec{mock: m}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCommandHandlerMockRun struct {
+	optional           bool
+	mock               *CommandHandlerMock
+	defaultExpectation *CommandHandlerMockRunExpectation
+	expectations       []*CommandHandlerMockRunExpectation
+
+	callArgs []*CommandHandlerMockRunParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CommandHandlerMockRunExpectation specifies expectation struct of the CommandHandler.Run
+type CommandHandlerMockRunExpectation struct {
+	mock               *CommandHandlerMock
+	params             *CommandHandlerMockRunParams
+	paramPtrs          *CommandHandlerMockRunParamPtrs
+	expectationOrigins CommandHandlerMockRunExpectationOrigins
+	results            *CommandHandlerMockRunResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CommandHandlerMockRunParams contains parameters of the CommandHandler.Run
+type CommandHandlerMockRunParams struct {
+	ctx  context.Context
+	call *model.CommandCall
+}
+
+// CommandHandlerMockRunParamPtrs contains pointers to parameters of the CommandHandler.Run
+type CommandHandlerMockRunParamPtrs struct {
+	ctx  *context.Context
+	call **model.CommandCall
+}
+
+// CommandHandlerMockRunResults contains results of the CommandHandler.Run
+type CommandHandlerMockRunResults struct {
+	s1  string
+	err error
+}
+
+// CommandHandlerMockRunOrigins contains origins of expectations of the CommandHandler.Run
+type CommandHandlerMockRunExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originCall string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRun *mCommandHandlerMockRun) Optional() *mCommandHandlerMockRun {
+	mmRun.optional = true
+	return mmRun
+}
+
+// Expect sets up expected params for CommandHandler.Run
+func (mmRun *mCommandHandlerMockRun) Expect(ctx context.Context, call *model.CommandCall) *mCommandHandlerMockRun {
+	if mmRun.mock.funcRun != nil {
+		mmRun.mock.t.Fatalf("CommandHandlerMock.Run mock is already set by Set")
+	}
+
+	if mmRun.defaultExpectation == nil {
+		mmRun.defaultExpectation = &CommandHandlerMockRunExpectation{}
+	}
+
+	if mmRun.defaultExpectation.paramPtrs != nil {
+		mmRun.mock.t.Fatalf("CommandHandlerMock.Run mock is already set by ExpectParams functions")
+	}
+
+	mmRun.defaultExpectation.params = &CommandHandlerMockRunParams{ctx, call}
+	mmRun.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRun.expectations {
+		if minimock.Equal(e.params, mmRun.defaultExpectation.params) {
+			mmRun.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRun.defaultExpectation.params)
+		}
+	}
+
+	return mmRun
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CommandHandler.Run
+func (mmRun *mCommandHandlerMockRun) ExpectCtxParam1(ctx context.Context) *mCommandHandlerMockRun {
+	if mmRun.mock.funcRun != nil {
+		mmRun.mock.t.Fatalf("CommandHandlerMock.Run mock is already set by Set")
+	}
+
+	if mmRun.defaultExpectation == nil {
+		mmRun.defaultExpectation = &CommandHandlerMockRunExpectation{}
+	}
+
+	if mmRun.defaultExpectation.params != nil {
+		mmRun.mock.t.Fatalf("CommandHandlerMock.Run mock is already set by Expect")
+	}
+
+	if mmRun.defaultExpectation.paramPtrs == nil {
+		mmRun.defaultExpectation.paramPtrs = &CommandHandlerMockRunParamPtrs{}
+	}
+	mmRun.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRun.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRun
+}
+
+// ExpectCallParam2 sets up expected param call for CommandHandler.Run
+func (mmRun *mCommandHandlerMockRun) ExpectCallParam2(call *model.CommandCall) *mCommandHandlerMockRun {
+	if mmRun.mock.funcRun != nil {
+		mmRun.mock.t.Fatalf("CommandHandlerMock.Run mock is already set by Set")
+	}
+
+	if mmRun.defaultExpectation == nil {
+		mmRun.defaultExpectation = &CommandHandlerMockRunExpectation{}
+	}
+
+	if mmRun.defaultExpectation.params != nil {
+		mmRun.mock.t.Fatalf("CommandHandlerMock.Run mock is already set by Expect")
+	}
+
+	if mmRun.defaultExpectation.paramPtrs == nil {
+		mmRun.defaultExpectation.paramPtrs = &CommandHandlerMockRunParamPtrs{}
+	}
+	mmRun.defaultExpectation.paramPtrs.call = &call
+	mmRun.defaultExpectation.expectationOrigins.originCall = minimock.CallerInfo(1)
+
+	return mmRun
+}
+
+// Inspect accepts an inspector function that has same arguments as the CommandHandler.Run
+func (mmRun *mCommandHandlerMockRun) Inspect(f func(ctx context.Context, call *model.CommandCall)) *mCommandHandlerMockRun {
+	if mmRun.mock.inspectFuncRun != nil {
+		mmRun.mock.t.Fatalf("Inspect function is already set for CommandHandlerMock.Run")
+	}
+
+	mmRun.mock.inspectFuncRun = f
+
+	return mmRun
+}
+
+// Return sets up results that will be returned by CommandHandler.Run
+func (mmRun *mCommandHandlerMockRun) Return(s1 string, err error) *CommandHandlerMock {
+	if mmRun.mock.funcRun != nil {
+		mmRun.mock.t.Fatalf("CommandHandlerMock.Run mock is already set by Set")
+	}
+
+	if mmRun.defaultExpectation == nil {
+		mmRun.defaultExpectation = &CommandHandlerMockRunExpectation{mock: mmRun.mock}
+	}
+	mmRun.defaultExpectation.results = &CommandHandlerMockRunResults{s1, err}
+	mmRun.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRun.mock
+}
+
+// Set uses given function f to mock the CommandHandler.Run method
+func (mmRun *mCommandHandlerMockRun) Set(f func(ctx context.Context, call *model.CommandCall) (s1 string, err error)) *CommandHandlerMock {
+	if mmRun.defaultExpectation != nil {
+		mmRun.mock.t.Fatalf("Default expectation is already set for the CommandHandler.Run method")
+	}
+
+	if len(mmRun.expectations) > 0 {
+		mmRun.mock.t.Fatalf("Some expectations are already set for the CommandHandler.Run method")
+	}
+
+	mmRun.mock.funcRun = f
+	mmRun.mock.funcRunOrigin = minimock.CallerInfo(1)
+	return mmRun.mock
+}
+
+// When sets expectation for the CommandHandler.Run which will trigger the result defined by the following
+// Then helper
+func (mmRun *mCommandHandlerMockRun) When(ctx context.Context, call *model.CommandCall) *CommandHandlerMockRunExpectation {
+	if mmRun.mock.funcRun != nil {
+		mmRun.mock.t.Fatalf("CommandHandlerMock.Run mock is already set by Set")
+	}
+
+	expectation := &CommandHandlerMockRunExpectation{
+		mock:               mmRun.mock,
+		params:             &CommandHandlerMockRunParams{ctx, call},
+		expectationOrigins: CommandHandlerMockRunExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRun.expectations = append(mmRun.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CommandHandler.Run return parameters for the expectation previously defined by the When method
+func (e *CommandHandlerMockRunExpectation) Then(s1 string, err error) *CommandHandlerMock {
+	e.results = &CommandHandlerMockRunResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times CommandHandler.Run should be invoked
+func (mmRun *mCommandHandlerMockRun) Times(n uint64) *mCommandHandlerMockRun {
+	if n == 0 {
+		mmRun.mock.t.Fatalf("Times of CommandHandlerMock.Run mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRun.expectedInvocations, n)
+	mmRun.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRun
+}
+
+func (mmRun *mCommandHandlerMockRun) invocationsDone() bool {
+	if len(mmRun.expectations) == 0 && mmRun.defaultExpectation == nil && mmRun.mock.funcRun == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRun.mock.afterRunCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRun.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Run implements mm_service.CommandHandler
+func (mmRun *CommandHandlerMock) Run(ctx context.Context, call *model.CommandCall) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmRun.beforeRunCounter, 1)
+	defer mm_atomic.AddUint64(&mmRun.afterRunCounter, 1)
+
+	mmRun.t.Helper()
+
+	if mmRun.inspectFuncRun != nil {
+		mmRun.inspectFuncRun(ctx, call)
+	}
+
+	mm_params := CommandHandlerMockRunParams{ctx, call}
+
+	// Record call args
+	mmRun.RunMock.mutex.Lock()
+	mmRun.RunMock.callArgs = append(mmRun.RunMock.callArgs, &mm_params)
+	mmRun.RunMock.mutex.Unlock()
+
+	for _, e := range mmRun.RunMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmRun.RunMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRun.RunMock.defaultExpectation.Counter, 1)
+		mm_want := mmRun.RunMock.defaultExpectation.params
+		mm_want_ptrs := mmRun.RunMock.defaultExpectation.paramPtrs
+
+		mm_got := CommandHandlerMockRunParams{ctx, call}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRun.t.Errorf("CommandHandlerMock.Run got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRun.RunMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.call != nil && !minimock.Equal(*mm_want_ptrs.call, mm_got.call) {
+				mmRun.t.Errorf("CommandHandlerMock.Run got unexpected parameter call, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRun.RunMock.defaultExpectation.expectationOrigins.originCall, *mm_want_ptrs.call, mm_got.call, minimock.Diff(*mm_want_ptrs.call, mm_got.call))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRun.t.Errorf("CommandHandlerMock.Run got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRun.RunMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRun.RunMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRun.t.Fatal("No results are set for the CommandHandlerMock.Run")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmRun.funcRun != nil {
+		return mmRun.funcRun(ctx, call)
+	}
+	mmRun.t.Fatalf("Unexpected call to CommandHandlerMock.Run. %v %v", ctx, call)
+	return
+}
+
+// RunAfterCounter returns a count of finished CommandHandlerMock.Run invocations
+func (mmRun *CommandHandlerMock) RunAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRun.afterRunCounter)
+}
+
+// RunBeforeCounter returns a count of CommandHandlerMock.Run invocations
+func (mmRun *CommandHandlerMock) RunBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRun.beforeRunCounter)
+}
+
+// Calls returns a list of arguments used in each call to CommandHandlerMock.Run.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRun *mCommandHandlerMockRun) Calls() []*CommandHandlerMockRunParams {
+	mmRun.mutex.RLock()
+
+	argCopy := make([]*CommandHandlerMockRunParams, len(mmRun.callArgs))
+	copy(argCopy, mmRun.callArgs)
+
+	mmRun.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRunDone returns true if the count of the Run invocations corresponds
+// the number of defined expectations
+func (m *CommandHandlerMock) MinimockRunDone() bool {
+	if m.RunMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RunMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RunMock.invocationsDone()
+}
+
+// MinimockRunInspect logs each unmet expectation
+func (m *CommandHandlerMock) MinimockRunInspect() {
+	for _, e := range m.RunMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CommandHandlerMock.Run at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRunCounter := mm_atomic.LoadUint64(&m.afterRunCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RunMock.defaultExpectation != nil && afterRunCounter < 1 {
+		if m.RunMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CommandHandlerMock.Run at\n%s", m.RunMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CommandHandlerMock.Run at\n%s with params: %#v", m.RunMock.defaultExpectation.expectationOrigins.origin, *m.RunMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRun != nil && afterRunCounter < 1 {
+		m.t.Errorf("Expected call to CommandHandlerMock.Run at\n%s", m.funcRunOrigin)
+	}
+
+	if !m.RunMock.invocationsDone() && afterRunCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommandHandlerMock.Run at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RunMock.expectedInvocations), m.RunMock.expectedInvocationsOrigin, afterRunCounter)
+	}
+}
+
+type mCommandHandlerMockSpec struct {
+	optional           bool
+	mock               *CommandHandlerMock
+	defaultExpectation *CommandHandlerMockSpecExpectation
+	expectations       []*CommandHandlerMockSpecExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CommandHandlerMockSpecExpectation specifies expectation struct of the CommandHandler.Spec
+type CommandHandlerMockSpecExpectation struct {
+	mock *CommandHandlerMock
+
+	results      *CommandHandlerMockSpecResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// CommandHandlerMockSpecResults contains results of the CommandHandler.Spec
+type CommandHandlerMockSpecResults struct {
+	c1 model.CommandSpec
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSpec *mCommandHandlerMockSpec) Optional() *mCommandHandlerMockSpec {
+	mmSpec.optional = true
+	return mmSpec
+}
+
+// Expect sets up expected params for CommandHandler.Spec
+func (mmSpec *mCommandHandlerMockSpec) Expect() *mCommandHandlerMockSpec {
+	if mmSpec.mock.funcSpec != nil {
+		mmSpec.mock.t.Fatalf("CommandHandlerMock.Spec mock is already set by Set")
+	}
+
+	if mmSpec.defaultExpectation == nil {
+		mmSpec.defaultExpectation = &CommandHandlerMockSpecExpectation{}
+	}
+
+	return mmSpec
+}
+
+// Inspect accepts an inspector function that has same arguments as the CommandHandler.Spec
+func (mmSpec *mCommandHandlerMockSpec) Inspect(f func()) *mCommandHandlerMockSpec {
+	if mmSpec.mock.inspectFuncSpec != nil {
+		mmSpec.mock.t.Fatalf("Inspect function is already set for CommandHandlerMock.Spec")
+	}
+
+	mmSpec.mock.inspectFuncSpec = f
+
+	return mmSpec
+}
+
+// Return sets up results that will be returned by CommandHandler.Spec
+func (mmSpec *mCommandHandlerMockSpec) Return(c1 model.CommandSpec) *CommandHandlerMock {
+	if mmSpec.mock.funcSpec != nil {
+		mmSpec.mock.t.Fatalf("CommandHandlerMock.Spec mock is already set by Set")
+	}
+
+	if mmSpec.defaultExpectation == nil {
+		mmSpec.defaultExpectation = &CommandHandlerMockSpecExpectation{mock: mmSpec.mock}
+	}
+	mmSpec.defaultExpectation.results = &CommandHandlerMockSpecResults{c1}
+	mmSpec.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSpec.mock
+}
+
+// Set uses given function f to mock the CommandHandler.Spec method
+func (mmSpec *mCommandHandlerMockSpec) Set(f func() (c1 model.CommandSpec)) *CommandHandlerMock {
+	if mmSpec.defaultExpectation != nil {
+		mmSpec.mock.t.Fatalf("Default expectation is already set for the CommandHandler.Spec method")
+	}
+
+	if len(mmSpec.expectations) > 0 {
+		mmSpec.mock.t.Fatalf("Some expectations are already set for the CommandHandler.Spec method")
+	}
+
+	mmSpec.mock.funcSpec = f
+	mmSpec.mock.funcSpecOrigin = minimock.CallerInfo(1)
+	return mmSpec.mock
+}
+
+// Times sets number of times CommandHandler.Spec should be invoked
+func (mmSpec *mCommandHandlerMockSpec) Times(n uint64) *mCommandHandlerMockSpec {
+	if n == 0 {
+		mmSpec.mock.t.Fatalf("Times of CommandHandlerMock.Spec mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSpec.expectedInvocations, n)
+	mmSpec.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSpec
+}
+
+func (mmSpec *mCommandHandlerMockSpec) invocationsDone() bool {
+	if len(mmSpec.expectations) == 0 && mmSpec.defaultExpectation == nil && mmSpec.mock.funcSpec == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSpec.mock.afterSpecCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSpec.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Spec implements mm_service.CommandHandler
+func (mmSpec *CommandHandlerMock) Spec() (c1 model.CommandSpec) {
+	mm_atomic.AddUint64(&mmSpec.beforeSpecCounter, 1)
+	defer mm_atomic.AddUint64(&mmSpec.afterSpecCounter, 1)
+
+	mmSpec.t.Helper()
+
+	if mmSpec.inspectFuncSpec != nil {
+		mmSpec.inspectFuncSpec()
+	}
+
+	if mmSpec.SpecMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSpec.SpecMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmSpec.SpecMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSpec.t.Fatal("No results are set for the CommandHandlerMock.Spec")
+		}
+		return (*mm_results).c1
+	}
+	if mmSpec.funcSpec != nil {
+		return mmSpec.funcSpec()
+	}
+	mmSpec.t.Fatalf("Unexpected call to CommandHandlerMock.Spec.")
+	return
+}
+
+// SpecAfterCounter returns a count of finished CommandHandlerMock.Spec invocations
+func (mmSpec *CommandHandlerMock) SpecAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSpec.afterSpecCounter)
+}
+
+// SpecBeforeCounter returns a count of CommandHandlerMock.Spec invocations
+func (mmSpec *CommandHandlerMock) SpecBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSpec.beforeSpecCounter)
+}
+
+// MinimockSpecDone returns true if the count of the Spec invocations corresponds
+// the number of defined expectations
+func (m *CommandHandlerMock) MinimockSpecDone() bool {
+	if m.SpecMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SpecMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SpecMock.invocationsDone()
+}
+
+// MinimockSpecInspect logs each unmet expectation
+func (m *CommandHandlerMock) MinimockSpecInspect() {
+	for _, e := range m.SpecMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to CommandHandlerMock.Spec")
+		}
+	}
+
+	afterSpecCounter := mm_atomic.LoadUint64(&m.afterSpecCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SpecMock.defaultExpectation != nil && afterSpecCounter < 1 {
+		m.t.Errorf("Expected call to CommandHandlerMock.Spec at\n%s", m.SpecMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSpec != nil && afterSpecCounter < 1 {
+		m.t.Errorf("Expected call to CommandHandlerMock.Spec at\n%s", m.funcSpecOrigin)
+	}
+
+	if !m.SpecMock.invocationsDone() && afterSpecCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommandHandlerMock.Spec at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SpecMock.expectedInvocations), m.SpecMock.expectedInvocationsOrigin, afterSpecCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CommandHandlerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockRunInspect()
+
+			m.MinimockSpecInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CommandHandlerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CommandHandlerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockRunDone() &&
+		m.MinimockSpecDone()
+}
@@ -0,0 +1,1485 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.1). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Mobo140/chat/internal/service.WebhookService -o webhook_service_minimock.go -n WebhookServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// WebhookServiceMock implements mm_service.WebhookService
+type WebhookServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, webhook *model.Webhook) (wp1 *model.Webhook, err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, webhook *model.Webhook)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mWebhookServiceMockCreate
+
+	funcDelete          func(ctx context.Context, id int64) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, id int64)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mWebhookServiceMockDelete
+
+	funcList          func(ctx context.Context, chatID int64) (wpa1 []*model.Webhook, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context, chatID int64)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mWebhookServiceMockList
+
+	funcListAttempts          func(ctx context.Context, filter *model.WebhookAttemptFilter) (wpa1 []*model.WebhookAttempt, err error)
+	funcListAttemptsOrigin    string
+	inspectFuncListAttempts   func(ctx context.Context, filter *model.WebhookAttemptFilter)
+	afterListAttemptsCounter  uint64
+	beforeListAttemptsCounter uint64
+	ListAttemptsMock          mWebhookServiceMockListAttempts
+}
+
+// NewWebhookServiceMock returns a mock for mm_service.WebhookService
+func NewWebhookServiceMock(t minimock.Tester) *WebhookServiceMock {
+	m := &WebhookServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mWebhookServiceMockCreate{mock: m}
+	m.CreateMock.callArgs = []*WebhookServiceMockCreateParams{}
+
+	m.DeleteMock = mWebhookServiceMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*WebhookServiceMockDeleteParams{}
+
+	m.ListMock = mWebhookServiceMockList{mock: m}
+	m.ListMock.callArgs = []*WebhookServiceMockListParams{}
+
+	m.ListAttemptsMock = mWebhookServiceMockListAttempts{mock: m}
+	m.ListAttemptsMock.callArgs = []*WebhookServiceMockListAttemptsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mWebhookServiceMockCreate struct {
+	optional           bool
+	mock               *WebhookServiceMock
+	defaultExpectation *WebhookServiceMockCreateExpectation
+	expectations       []*WebhookServiceMockCreateExpectation
+
+	callArgs []*WebhookServiceMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WebhookServiceMockCreateExpectation specifies expectation struct of the WebhookService.Create
+type WebhookServiceMockCreateExpectation struct {
+	mock               *WebhookServiceMock
+	params             *WebhookServiceMockCreateParams
+	paramPtrs          *WebhookServiceMockCreateParamPtrs
+	expectationOrigins WebhookServiceMockCreateExpectationOrigins
+	results            *WebhookServiceMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WebhookServiceMockCreateParams contains parameters of the WebhookService.Create
+type WebhookServiceMockCreateParams struct {
+	ctx     context.Context
+	webhook *model.Webhook
+}
+
+// WebhookServiceMockCreateParamPtrs contains pointers to parameters of the WebhookService.Create
+type WebhookServiceMockCreateParamPtrs struct {
+	ctx     *context.Context
+	webhook **model.Webhook
+}
+
+// WebhookServiceMockCreateResults contains results of the WebhookService.Create
+type WebhookServiceMockCreateResults struct {
+	wp1 *model.Webhook
+	err error
+}
+
+// WebhookServiceMockCreateOrigins contains origins of expectations of the WebhookService.Create
+type WebhookServiceMockCreateExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originWebhook string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mWebhookServiceMockCreate) Optional() *mWebhookServiceMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for WebhookService.Create
+func (mmCreate *mWebhookServiceMockCreate) Expect(ctx context.Context, webhook *model.Webhook) *mWebhookServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("WebhookServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &WebhookServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("WebhookServiceMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &WebhookServiceMockCreateParams{ctx, webhook}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookService.Create
+func (mmCreate *mWebhookServiceMockCreate) ExpectCtxParam1(ctx context.Context) *mWebhookServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("WebhookServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &WebhookServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("WebhookServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &WebhookServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectWebhookParam2 sets up expected param webhook for WebhookService.Create
+func (mmCreate *mWebhookServiceMockCreate) ExpectWebhookParam2(webhook *model.Webhook) *mWebhookServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("WebhookServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &WebhookServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("WebhookServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &WebhookServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.webhook = &webhook
+	mmCreate.defaultExpectation.expectationOrigins.originWebhook = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookService.Create
+func (mmCreate *mWebhookServiceMockCreate) Inspect(f func(ctx context.Context, webhook *model.Webhook)) *mWebhookServiceMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for WebhookServiceMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by WebhookService.Create
+func (mmCreate *mWebhookServiceMockCreate) Return(wp1 *model.Webhook, err error) *WebhookServiceMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("WebhookServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &WebhookServiceMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &WebhookServiceMockCreateResults{wp1, err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the WebhookService.Create method
+func (mmCreate *mWebhookServiceMockCreate) Set(f func(ctx context.Context, webhook *model.Webhook) (wp1 *model.Webhook, err error)) *WebhookServiceMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the WebhookService.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the WebhookService.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the WebhookService.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mWebhookServiceMockCreate) When(ctx context.Context, webhook *model.Webhook) *WebhookServiceMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("WebhookServiceMock.Create mock is already set by Set")
+	}
+
+	expectation := &WebhookServiceMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &WebhookServiceMockCreateParams{ctx, webhook},
+		expectationOrigins: WebhookServiceMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookService.Create return parameters for the expectation previously defined by the When method
+func (e *WebhookServiceMockCreateExpectation) Then(wp1 *model.Webhook, err error) *WebhookServiceMock {
+	e.results = &WebhookServiceMockCreateResults{wp1, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookService.Create should be invoked
+func (mmCreate *mWebhookServiceMockCreate) Times(n uint64) *mWebhookServiceMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of WebhookServiceMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mWebhookServiceMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_service.WebhookService
+func (mmCreate *WebhookServiceMock) Create(ctx context.Context, webhook *model.Webhook) (wp1 *model.Webhook, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, webhook)
+	}
+
+	mm_params := WebhookServiceMockCreateParams{ctx, webhook}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.wp1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookServiceMockCreateParams{ctx, webhook}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("WebhookServiceMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.webhook != nil && !minimock.Equal(*mm_want_ptrs.webhook, mm_got.webhook) {
+				mmCreate.t.Errorf("WebhookServiceMock.Create got unexpected parameter webhook, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originWebhook, *mm_want_ptrs.webhook, mm_got.webhook, minimock.Diff(*mm_want_ptrs.webhook, mm_got.webhook))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("WebhookServiceMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the WebhookServiceMock.Create")
+		}
+		return (*mm_results).wp1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, webhook)
+	}
+	mmCreate.t.Fatalf("Unexpected call to WebhookServiceMock.Create. %v %v", ctx, webhook)
+	return
+}
+
+// CreateAfterCounter returns a count of finished WebhookServiceMock.Create invocations
+func (mmCreate *WebhookServiceMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of WebhookServiceMock.Create invocations
+func (mmCreate *WebhookServiceMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookServiceMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mWebhookServiceMockCreate) Calls() []*WebhookServiceMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*WebhookServiceMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *WebhookServiceMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *WebhookServiceMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookServiceMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WebhookServiceMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WebhookServiceMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to WebhookServiceMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookServiceMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mWebhookServiceMockDelete struct {
+	optional           bool
+	mock               *WebhookServiceMock
+	defaultExpectation *WebhookServiceMockDeleteExpectation
+	expectations       []*WebhookServiceMockDeleteExpectation
+
+	callArgs []*WebhookServiceMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WebhookServiceMockDeleteExpectation specifies expectation struct of the WebhookService.Delete
+type WebhookServiceMockDeleteExpectation struct {
+	mock               *WebhookServiceMock
+	params             *WebhookServiceMockDeleteParams
+	paramPtrs          *WebhookServiceMockDeleteParamPtrs
+	expectationOrigins WebhookServiceMockDeleteExpectationOrigins
+	results            *WebhookServiceMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WebhookServiceMockDeleteParams contains parameters of the WebhookService.Delete
+type WebhookServiceMockDeleteParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// WebhookServiceMockDeleteParamPtrs contains pointers to parameters of the WebhookService.Delete
+type WebhookServiceMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// WebhookServiceMockDeleteResults contains results of the WebhookService.Delete
+type WebhookServiceMockDeleteResults struct {
+	err error
+}
+
+// WebhookServiceMockDeleteOrigins contains origins of expectations of the WebhookService.Delete
+type WebhookServiceMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mWebhookServiceMockDelete) Optional() *mWebhookServiceMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for WebhookService.Delete
+func (mmDelete *mWebhookServiceMockDelete) Expect(ctx context.Context, id int64) *mWebhookServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("WebhookServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &WebhookServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("WebhookServiceMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &WebhookServiceMockDeleteParams{ctx, id}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookService.Delete
+func (mmDelete *mWebhookServiceMockDelete) ExpectCtxParam1(ctx context.Context) *mWebhookServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("WebhookServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &WebhookServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("WebhookServiceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &WebhookServiceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for WebhookService.Delete
+func (mmDelete *mWebhookServiceMockDelete) ExpectIdParam2(id int64) *mWebhookServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("WebhookServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &WebhookServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("WebhookServiceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &WebhookServiceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookService.Delete
+func (mmDelete *mWebhookServiceMockDelete) Inspect(f func(ctx context.Context, id int64)) *mWebhookServiceMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for WebhookServiceMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by WebhookService.Delete
+func (mmDelete *mWebhookServiceMockDelete) Return(err error) *WebhookServiceMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("WebhookServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &WebhookServiceMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &WebhookServiceMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the WebhookService.Delete method
+func (mmDelete *mWebhookServiceMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *WebhookServiceMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the WebhookService.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the WebhookService.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the WebhookService.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mWebhookServiceMockDelete) When(ctx context.Context, id int64) *WebhookServiceMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("WebhookServiceMock.Delete mock is already set by Set")
+	}
+
+	expectation := &WebhookServiceMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &WebhookServiceMockDeleteParams{ctx, id},
+		expectationOrigins: WebhookServiceMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookService.Delete return parameters for the expectation previously defined by the When method
+func (e *WebhookServiceMockDeleteExpectation) Then(err error) *WebhookServiceMock {
+	e.results = &WebhookServiceMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times WebhookService.Delete should be invoked
+func (mmDelete *mWebhookServiceMockDelete) Times(n uint64) *mWebhookServiceMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of WebhookServiceMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mWebhookServiceMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_service.WebhookService
+func (mmDelete *WebhookServiceMock) Delete(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := WebhookServiceMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookServiceMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("WebhookServiceMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("WebhookServiceMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("WebhookServiceMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the WebhookServiceMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to WebhookServiceMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished WebhookServiceMock.Delete invocations
+func (mmDelete *WebhookServiceMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of WebhookServiceMock.Delete invocations
+func (mmDelete *WebhookServiceMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookServiceMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mWebhookServiceMockDelete) Calls() []*WebhookServiceMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*WebhookServiceMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *WebhookServiceMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *WebhookServiceMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookServiceMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WebhookServiceMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WebhookServiceMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to WebhookServiceMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookServiceMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mWebhookServiceMockList struct {
+	optional           bool
+	mock               *WebhookServiceMock
+	defaultExpectation *WebhookServiceMockListExpectation
+	expectations       []*WebhookServiceMockListExpectation
+
+	callArgs []*WebhookServiceMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WebhookServiceMockListExpectation specifies expectation struct of the WebhookService.List
+type WebhookServiceMockListExpectation struct {
+	mock               *WebhookServiceMock
+	params             *WebhookServiceMockListParams
+	paramPtrs          *WebhookServiceMockListParamPtrs
+	expectationOrigins WebhookServiceMockListExpectationOrigins
+	results            *WebhookServiceMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WebhookServiceMockListParams contains parameters of the WebhookService.List
+type WebhookServiceMockListParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// WebhookServiceMockListParamPtrs contains pointers to parameters of the WebhookService.List
+type WebhookServiceMockListParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// WebhookServiceMockListResults contains results of the WebhookService.List
+type WebhookServiceMockListResults struct {
+	wpa1 []*model.Webhook
+	err  error
+}
+
+// WebhookServiceMockListOrigins contains origins of expectations of the WebhookService.List
+type WebhookServiceMockListExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mWebhookServiceMockList) Optional() *mWebhookServiceMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for WebhookService.List
+func (mmList *mWebhookServiceMockList) Expect(ctx context.Context, chatID int64) *mWebhookServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("WebhookServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &WebhookServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("WebhookServiceMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &WebhookServiceMockListParams{ctx, chatID}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookService.List
+func (mmList *mWebhookServiceMockList) ExpectCtxParam1(ctx context.Context) *mWebhookServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("WebhookServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &WebhookServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("WebhookServiceMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &WebhookServiceMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for WebhookService.List
+func (mmList *mWebhookServiceMockList) ExpectChatIDParam2(chatID int64) *mWebhookServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("WebhookServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &WebhookServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("WebhookServiceMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &WebhookServiceMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.chatID = &chatID
+	mmList.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookService.List
+func (mmList *mWebhookServiceMockList) Inspect(f func(ctx context.Context, chatID int64)) *mWebhookServiceMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for WebhookServiceMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by WebhookService.List
+func (mmList *mWebhookServiceMockList) Return(wpa1 []*model.Webhook, err error) *WebhookServiceMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("WebhookServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &WebhookServiceMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &WebhookServiceMockListResults{wpa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the WebhookService.List method
+func (mmList *mWebhookServiceMockList) Set(f func(ctx context.Context, chatID int64) (wpa1 []*model.Webhook, err error)) *WebhookServiceMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the WebhookService.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the WebhookService.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the WebhookService.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mWebhookServiceMockList) When(ctx context.Context, chatID int64) *WebhookServiceMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("WebhookServiceMock.List mock is already set by Set")
+	}
+
+	expectation := &WebhookServiceMockListExpectation{
+		mock:               mmList.mock,
+		params:             &WebhookServiceMockListParams{ctx, chatID},
+		expectationOrigins: WebhookServiceMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookService.List return parameters for the expectation previously defined by the When method
+func (e *WebhookServiceMockListExpectation) Then(wpa1 []*model.Webhook, err error) *WebhookServiceMock {
+	e.results = &WebhookServiceMockListResults{wpa1, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookService.List should be invoked
+func (mmList *mWebhookServiceMockList) Times(n uint64) *mWebhookServiceMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of WebhookServiceMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mWebhookServiceMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_service.WebhookService
+func (mmList *WebhookServiceMock) List(ctx context.Context, chatID int64) (wpa1 []*model.Webhook, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, chatID)
+	}
+
+	mm_params := WebhookServiceMockListParams{ctx, chatID}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.wpa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookServiceMockListParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("WebhookServiceMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmList.t.Errorf("WebhookServiceMock.List got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("WebhookServiceMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the WebhookServiceMock.List")
+		}
+		return (*mm_results).wpa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, chatID)
+	}
+	mmList.t.Fatalf("Unexpected call to WebhookServiceMock.List. %v %v", ctx, chatID)
+	return
+}
+
+// ListAfterCounter returns a count of finished WebhookServiceMock.List invocations
+func (mmList *WebhookServiceMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of WebhookServiceMock.List invocations
+func (mmList *WebhookServiceMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookServiceMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mWebhookServiceMockList) Calls() []*WebhookServiceMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*WebhookServiceMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *WebhookServiceMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *WebhookServiceMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookServiceMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WebhookServiceMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WebhookServiceMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to WebhookServiceMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookServiceMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mWebhookServiceMockListAttempts struct {
+	optional           bool
+	mock               *WebhookServiceMock
+	defaultExpectation *WebhookServiceMockListAttemptsExpectation
+	expectations       []*WebhookServiceMockListAttemptsExpectation
+
+	callArgs []*WebhookServiceMockListAttemptsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// WebhookServiceMockListAttemptsExpectation specifies expectation struct of the WebhookService.ListAttempts
+type WebhookServiceMockListAttemptsExpectation struct {
+	mock               *WebhookServiceMock
+	params             *WebhookServiceMockListAttemptsParams
+	paramPtrs          *WebhookServiceMockListAttemptsParamPtrs
+	expectationOrigins WebhookServiceMockListAttemptsExpectationOrigins
+	results            *WebhookServiceMockListAttemptsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// WebhookServiceMockListAttemptsParams contains parameters of the WebhookService.ListAttempts
+type WebhookServiceMockListAttemptsParams struct {
+	ctx    context.Context
+	filter *model.WebhookAttemptFilter
+}
+
+// WebhookServiceMockListAttemptsParamPtrs contains pointers to parameters of the WebhookService.ListAttempts
+type WebhookServiceMockListAttemptsParamPtrs struct {
+	ctx    *context.Context
+	filter **model.WebhookAttemptFilter
+}
+
+// WebhookServiceMockListAttemptsResults contains results of the WebhookService.ListAttempts
+type WebhookServiceMockListAttemptsResults struct {
+	wpa1 []*model.WebhookAttempt
+	err  error
+}
+
+// WebhookServiceMockListAttemptsOrigins contains origins of expectations of the WebhookService.ListAttempts
+type WebhookServiceMockListAttemptsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAttempts *mWebhookServiceMockListAttempts) Optional() *mWebhookServiceMockListAttempts {
+	mmListAttempts.optional = true
+	return mmListAttempts
+}
+
+// Expect sets up expected params for WebhookService.ListAttempts
+func (mmListAttempts *mWebhookServiceMockListAttempts) Expect(ctx context.Context, filter *model.WebhookAttemptFilter) *mWebhookServiceMockListAttempts {
+	if mmListAttempts.mock.funcListAttempts != nil {
+		mmListAttempts.mock.t.Fatalf("WebhookServiceMock.ListAttempts mock is already set by Set")
+	}
+
+	if mmListAttempts.defaultExpectation == nil {
+		mmListAttempts.defaultExpectation = &WebhookServiceMockListAttemptsExpectation{}
+	}
+
+	if mmListAttempts.defaultExpectation.paramPtrs != nil {
+		mmListAttempts.mock.t.Fatalf("WebhookServiceMock.ListAttempts mock is already set by ExpectParams functions")
+	}
+
+	mmListAttempts.defaultExpectation.params = &WebhookServiceMockListAttemptsParams{ctx, filter}
+	mmListAttempts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListAttempts.expectations {
+		if minimock.Equal(e.params, mmListAttempts.defaultExpectation.params) {
+			mmListAttempts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAttempts.defaultExpectation.params)
+		}
+	}
+
+	return mmListAttempts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookService.ListAttempts
+func (mmListAttempts *mWebhookServiceMockListAttempts) ExpectCtxParam1(ctx context.Context) *mWebhookServiceMockListAttempts {
+	if mmListAttempts.mock.funcListAttempts != nil {
+		mmListAttempts.mock.t.Fatalf("WebhookServiceMock.ListAttempts mock is already set by Set")
+	}
+
+	if mmListAttempts.defaultExpectation == nil {
+		mmListAttempts.defaultExpectation = &WebhookServiceMockListAttemptsExpectation{}
+	}
+
+	if mmListAttempts.defaultExpectation.params != nil {
+		mmListAttempts.mock.t.Fatalf("WebhookServiceMock.ListAttempts mock is already set by Expect")
+	}
+
+	if mmListAttempts.defaultExpectation.paramPtrs == nil {
+		mmListAttempts.defaultExpectation.paramPtrs = &WebhookServiceMockListAttemptsParamPtrs{}
+	}
+	mmListAttempts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListAttempts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListAttempts
+}
+
+// ExpectFilterParam2 sets up expected param filter for WebhookService.ListAttempts
+func (mmListAttempts *mWebhookServiceMockListAttempts) ExpectFilterParam2(filter *model.WebhookAttemptFilter) *mWebhookServiceMockListAttempts {
+	if mmListAttempts.mock.funcListAttempts != nil {
+		mmListAttempts.mock.t.Fatalf("WebhookServiceMock.ListAttempts mock is already set by Set")
+	}
+
+	if mmListAttempts.defaultExpectation == nil {
+		mmListAttempts.defaultExpectation = &WebhookServiceMockListAttemptsExpectation{}
+	}
+
+	if mmListAttempts.defaultExpectation.params != nil {
+		mmListAttempts.mock.t.Fatalf("WebhookServiceMock.ListAttempts mock is already set by Expect")
+	}
+
+	if mmListAttempts.defaultExpectation.paramPtrs == nil {
+		mmListAttempts.defaultExpectation.paramPtrs = &WebhookServiceMockListAttemptsParamPtrs{}
+	}
+	mmListAttempts.defaultExpectation.paramPtrs.filter = &filter
+	mmListAttempts.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListAttempts
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookService.ListAttempts
+func (mmListAttempts *mWebhookServiceMockListAttempts) Inspect(f func(ctx context.Context, filter *model.WebhookAttemptFilter)) *mWebhookServiceMockListAttempts {
+	if mmListAttempts.mock.inspectFuncListAttempts != nil {
+		mmListAttempts.mock.t.Fatalf("Inspect function is already set for WebhookServiceMock.ListAttempts")
+	}
+
+	mmListAttempts.mock.inspectFuncListAttempts = f
+
+	return mmListAttempts
+}
+
+// Return sets up results that will be returned by WebhookService.ListAttempts
+func (mmListAttempts *mWebhookServiceMockListAttempts) Return(wpa1 []*model.WebhookAttempt, err error) *WebhookServiceMock {
+	if mmListAttempts.mock.funcListAttempts != nil {
+		mmListAttempts.mock.t.Fatalf("WebhookServiceMock.ListAttempts mock is already set by Set")
+	}
+
+	if mmListAttempts.defaultExpectation == nil {
+		mmListAttempts.defaultExpectation = &WebhookServiceMockListAttemptsExpectation{mock: mmListAttempts.mock}
+	}
+	mmListAttempts.defaultExpectation.results = &WebhookServiceMockListAttemptsResults{wpa1, err}
+	mmListAttempts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListAttempts.mock
+}
+
+// Set uses given function f to mock the WebhookService.ListAttempts method
+func (mmListAttempts *mWebhookServiceMockListAttempts) Set(f func(ctx context.Context, filter *model.WebhookAttemptFilter) (wpa1 []*model.WebhookAttempt, err error)) *WebhookServiceMock {
+	if mmListAttempts.defaultExpectation != nil {
+		mmListAttempts.mock.t.Fatalf("Default expectation is already set for the WebhookService.ListAttempts method")
+	}
+
+	if len(mmListAttempts.expectations) > 0 {
+		mmListAttempts.mock.t.Fatalf("Some expectations are already set for the WebhookService.ListAttempts method")
+	}
+
+	mmListAttempts.mock.funcListAttempts = f
+	mmListAttempts.mock.funcListAttemptsOrigin = minimock.CallerInfo(1)
+	return mmListAttempts.mock
+}
+
+// When sets expectation for the WebhookService.ListAttempts which will trigger the result defined by the following
+// Then helper
+func (mmListAttempts *mWebhookServiceMockListAttempts) When(ctx context.Context, filter *model.WebhookAttemptFilter) *WebhookServiceMockListAttemptsExpectation {
+	if mmListAttempts.mock.funcListAttempts != nil {
+		mmListAttempts.mock.t.Fatalf("WebhookServiceMock.ListAttempts mock is already set by Set")
+	}
+
+	expectation := &WebhookServiceMockListAttemptsExpectation{
+		mock:               mmListAttempts.mock,
+		params:             &WebhookServiceMockListAttemptsParams{ctx, filter},
+		expectationOrigins: WebhookServiceMockListAttemptsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListAttempts.expectations = append(mmListAttempts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookService.ListAttempts return parameters for the expectation previously defined by the When method
+func (e *WebhookServiceMockListAttemptsExpectation) Then(wpa1 []*model.WebhookAttempt, err error) *WebhookServiceMock {
+	e.results = &WebhookServiceMockListAttemptsResults{wpa1, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookService.ListAttempts should be invoked
+func (mmListAttempts *mWebhookServiceMockListAttempts) Times(n uint64) *mWebhookServiceMockListAttempts {
+	if n == 0 {
+		mmListAttempts.mock.t.Fatalf("Times of WebhookServiceMock.ListAttempts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAttempts.expectedInvocations, n)
+	mmListAttempts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListAttempts
+}
+
+func (mmListAttempts *mWebhookServiceMockListAttempts) invocationsDone() bool {
+	if len(mmListAttempts.expectations) == 0 && mmListAttempts.defaultExpectation == nil && mmListAttempts.mock.funcListAttempts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAttempts.mock.afterListAttemptsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAttempts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAttempts implements mm_service.WebhookService
+func (mmListAttempts *WebhookServiceMock) ListAttempts(ctx context.Context, filter *model.WebhookAttemptFilter) (wpa1 []*model.WebhookAttempt, err error) {
+	mm_atomic.AddUint64(&mmListAttempts.beforeListAttemptsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAttempts.afterListAttemptsCounter, 1)
+
+	mmListAttempts.t.Helper()
+
+	if mmListAttempts.inspectFuncListAttempts != nil {
+		mmListAttempts.inspectFuncListAttempts(ctx, filter)
+	}
+
+	mm_params := WebhookServiceMockListAttemptsParams{ctx, filter}
+
+	// Record call args
+	mmListAttempts.ListAttemptsMock.mutex.Lock()
+	mmListAttempts.ListAttemptsMock.callArgs = append(mmListAttempts.ListAttemptsMock.callArgs, &mm_params)
+	mmListAttempts.ListAttemptsMock.mutex.Unlock()
+
+	for _, e := range mmListAttempts.ListAttemptsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.wpa1, e.results.err
+		}
+	}
+
+	if mmListAttempts.ListAttemptsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAttempts.ListAttemptsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAttempts.ListAttemptsMock.defaultExpectation.params
+		mm_want_ptrs := mmListAttempts.ListAttemptsMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookServiceMockListAttemptsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAttempts.t.Errorf("WebhookServiceMock.ListAttempts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAttempts.ListAttemptsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListAttempts.t.Errorf("WebhookServiceMock.ListAttempts got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAttempts.ListAttemptsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAttempts.t.Errorf("WebhookServiceMock.ListAttempts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListAttempts.ListAttemptsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAttempts.ListAttemptsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAttempts.t.Fatal("No results are set for the WebhookServiceMock.ListAttempts")
+		}
+		return (*mm_results).wpa1, (*mm_results).err
+	}
+	if mmListAttempts.funcListAttempts != nil {
+		return mmListAttempts.funcListAttempts(ctx, filter)
+	}
+	mmListAttempts.t.Fatalf("Unexpected call to WebhookServiceMock.ListAttempts. %v %v", ctx, filter)
+	return
+}
+
+// ListAttemptsAfterCounter returns a count of finished WebhookServiceMock.ListAttempts invocations
+func (mmListAttempts *WebhookServiceMock) ListAttemptsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAttempts.afterListAttemptsCounter)
+}
+
+// ListAttemptsBeforeCounter returns a count of WebhookServiceMock.ListAttempts invocations
+func (mmListAttempts *WebhookServiceMock) ListAttemptsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAttempts.beforeListAttemptsCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookServiceMock.ListAttempts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAttempts *mWebhookServiceMockListAttempts) Calls() []*WebhookServiceMockListAttemptsParams {
+	mmListAttempts.mutex.RLock()
+
+	argCopy := make([]*WebhookServiceMockListAttemptsParams, len(mmListAttempts.callArgs))
+	copy(argCopy, mmListAttempts.callArgs)
+
+	mmListAttempts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAttemptsDone returns true if the count of the ListAttempts invocations corresponds
+// the number of defined expectations
+func (m *WebhookServiceMock) MinimockListAttemptsDone() bool {
+	if m.ListAttemptsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAttemptsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAttemptsMock.invocationsDone()
+}
+
+// MinimockListAttemptsInspect logs each unmet expectation
+func (m *WebhookServiceMock) MinimockListAttemptsInspect() {
+	for _, e := range m.ListAttemptsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookServiceMock.ListAttempts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListAttemptsCounter := mm_atomic.LoadUint64(&m.afterListAttemptsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAttemptsMock.defaultExpectation != nil && afterListAttemptsCounter < 1 {
+		if m.ListAttemptsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to WebhookServiceMock.ListAttempts at\n%s", m.ListAttemptsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to WebhookServiceMock.ListAttempts at\n%s with params: %#v", m.ListAttemptsMock.defaultExpectation.expectationOrigins.origin, *m.ListAttemptsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAttempts != nil && afterListAttemptsCounter < 1 {
+		m.t.Errorf("Expected call to WebhookServiceMock.ListAttempts at\n%s", m.funcListAttemptsOrigin)
+	}
+
+	if !m.ListAttemptsMock.invocationsDone() && afterListAttemptsCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookServiceMock.ListAttempts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAttemptsMock.expectedInvocations), m.ListAttemptsMock.expectedInvocationsOrigin, afterListAttemptsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *WebhookServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockListAttemptsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *WebhookServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *WebhookServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockListDone() &&
+		m.MinimockListAttemptsDone()
+}
@@ -0,0 +1,98 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/service"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"go.uber.org/zap"
+)
+
+var _ service.OutboxBroadcaster = (*broadcaster)(nil)
+
+// broadcaster publishes the events marked published by the relay of any
+// instance to the publishers of this one, the chat streams connected here.
+// A publisher failing an event only misses it: the streams catch up on
+// messages through their delivery cursors when they reconnect. A lost
+// connection resumes after the last event seen.
+type broadcaster struct {
+	outboxRepository repository.OutboxRepository
+	listener         repository.OutboxListener
+	publishers       []service.EventPublisher
+	retryInterval    time.Duration
+
+	// lastID is the last event seen, -1 until the first connection.
+	lastID int64
+}
+
+func NewBroadcaster( //nolint:revive // it's ok
+	outboxRepository repository.OutboxRepository,
+	listener repository.OutboxListener,
+	publishers []service.EventPublisher,
+	retryInterval time.Duration,
+) *broadcaster {
+	return &broadcaster{
+		outboxRepository: outboxRepository,
+		listener:         listener,
+		publishers:       publishers,
+		retryInterval:    retryInterval,
+		lastID:           -1,
+	}
+}
+
+// Run listens until the context is done, a lost connection is opened again
+// after the retry interval. The first connection starts after the events
+// published before Run, no stream is connected to get them.
+func (b *broadcaster) Run(ctx context.Context) {
+	for {
+		err := b.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		logger.Error("outbox listener stopped, listening again", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(b.retryInterval):
+		}
+	}
+}
+
+func (b *broadcaster) listen(ctx context.Context) error {
+	if b.lastID < 0 {
+		lastID, err := b.outboxRepository.LastPublished(ctx)
+		if err != nil {
+			return err
+		}
+
+		b.lastID = lastID
+	}
+
+	return b.listener.Listen(ctx, b.lastID, b.publish)
+}
+
+func (b *broadcaster) publish(ctx context.Context, id int64) {
+	b.lastID = max(b.lastID, id)
+
+	event, err := b.outboxRepository.Get(ctx, id)
+	if err != nil {
+		logger.Error("failed to get published event", zap.Int64("id", id), zap.Error(err))
+
+		return
+	}
+
+	for _, publisher := range b.publishers {
+		err = publisher.Publish(ctx, event)
+		if err != nil {
+			logger.Warn("failed to broadcast event",
+				zap.Int64("id", event.ID),
+				zap.Int64("chat_id", event.ChatID),
+				zap.Error(err),
+			)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/service"
+	"github.com/Mobo140/platform_common/pkg/db"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"go.uber.org/zap"
+)
+
+var _ service.OutboxRelay = (*relay)(nil)
+
+// relay moves the outbox events to the publishers. An event is marked published
+// only after every publisher accepted it, so it is delivered at least once.
+// The relays of the instances share the events, the broadcaster of every
+// instance then gets the published ones.
+type relay struct {
+	outboxRepository repository.OutboxRepository
+	txManager        db.TxManager
+	publishers       []service.EventPublisher
+	batchSize        uint64
+	pollInterval     time.Duration
+}
+
+func NewRelay( //nolint:revive // it's ok
+	outboxRepository repository.OutboxRepository,
+	txManager db.TxManager,
+	publishers []service.EventPublisher,
+	batchSize uint64,
+	pollInterval time.Duration,
+) *relay {
+	return &relay{
+		outboxRepository: outboxRepository,
+		txManager:        txManager,
+		publishers:       publishers,
+		batchSize:        batchSize,
+		pollInterval:     pollInterval,
+	}
+}
+
+// Run polls the outbox until the context is done. Full batches are followed
+// by the next one right away to drain a backlog.
+func (r *relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		published, err := r.Relay(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Error("failed to relay outbox events", zap.Error(err))
+		}
+
+		if err == nil && uint64(published) == r.batchSize {
+			if ctx.Err() != nil {
+				return
+			}
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Relay publishes a batch of pending events and returns how many were
+// published. After a failed event the later events of its chat stay pending,
+// keeping the order of each chat.
+func (r *relay) Relay(ctx context.Context) (int, error) {
+	var published int
+	err := r.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		events, errTx := r.outboxRepository.ListPending(ctx, r.batchSize)
+		if errTx != nil {
+			return errTx
+		}
+
+		ids := make([]int64, 0, len(events))
+		failed := make(map[int64]struct{})
+		for _, event := range events {
+			if _, ok := failed[event.ChatID]; ok {
+				continue
+			}
+
+			errTx = r.publish(ctx, event)
+			if errTx != nil {
+				logger.Warn("failed to publish event",
+					zap.Int64("id", event.ID),
+					zap.Int64("chat_id", event.ChatID),
+					zap.Error(errTx),
+				)
+
+				failed[event.ChatID] = struct{}{}
+
+				continue
+			}
+
+			ids = append(ids, event.ID)
+		}
+
+		errTx = r.outboxRepository.MarkPublished(ctx, ids)
+		if errTx != nil {
+			return errTx
+		}
+
+		published = len(ids)
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return published, nil
+}
+
+func (r *relay) publish(ctx context.Context, event *model.ChatEvent) error {
+	for _, publisher := range r.publishers {
+		err := publisher.Publish(ctx, event)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
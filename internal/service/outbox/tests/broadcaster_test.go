@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	repositoryMocks "github.com/Mobo140/chat/internal/repository/mocks"
+	"github.com/Mobo140/chat/internal/service"
+	serviceMocks "github.com/Mobo140/chat/internal/service/mocks"
+	outboxService "github.com/Mobo140/chat/internal/service/outbox"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroadcaster(t *testing.T) {
+	t.Parallel()
+
+	var (
+		repositoryErr = fmt.Errorf("repository error")
+		publishErr    = fmt.Errorf("chat channel is full")
+		listenErr     = fmt.Errorf("connection lost")
+	)
+
+	// Every connection handles its events, all but the last one are lost.
+	tests := []struct {
+		name        string
+		connections [][]int64
+		afterIDs    []int64
+		published   []int64
+	}{
+		{
+			name:        "success case",
+			connections: [][]int64{{1, 2, 3}},
+			afterIDs:    []int64{0},
+			published:   []int64{1, 3},
+		},
+		{
+			name:        "listens again after a lost connection",
+			connections: [][]int64{{}, {1, 2, 3}},
+			afterIDs:    []int64{0, 0},
+			published:   []int64{1, 3},
+		},
+		{
+			name:        "resumes after the last event seen",
+			connections: [][]int64{{1, 2}, {3}},
+			afterIDs:    []int64{0, 2},
+			published:   []int64{1, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			mc := minimock.NewController(t)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			listener := repositoryMocks.NewOutboxListenerMock(mc)
+			failing := serviceMocks.NewEventPublisherMock(mc)
+			publisher := serviceMocks.NewEventPublisherMock(mc)
+
+			// Event 2 is gone, the failing publisher does not stop the others.
+			outboxRepo.GetMock.Set(func(_ context.Context, id int64) (*model.ChatEvent, error) {
+				if id == 2 {
+					return nil, repositoryErr
+				}
+
+				return &model.ChatEvent{ID: id, ChatID: 10, Type: model.ChatEventMessageSent}, nil
+			})
+			failing.PublishMock.Return(publishErr)
+
+			var published []int64
+			publisher.PublishMock.Set(func(_ context.Context, event *model.ChatEvent) error {
+				published = append(published, event.ID)
+
+				return nil
+			})
+
+			outboxRepo.LastPublishedMock.Expect(ctx).Return(0, nil)
+
+			var calls atomic.Int32
+			listener.ListenMock.Set(func(ctx context.Context, afterID int64, handle func(ctx context.Context, id int64)) error {
+				call := int(calls.Add(1)) - 1
+				require.Equal(t, tt.afterIDs[call], afterID)
+
+				for _, id := range tt.connections[call] {
+					handle(ctx, id)
+				}
+
+				if call < len(tt.connections)-1 {
+					return listenErr
+				}
+				cancel()
+
+				return ctx.Err()
+			})
+
+			broadcaster := outboxService.NewBroadcaster(outboxRepo, listener,
+				[]service.EventPublisher{failing, publisher}, time.Millisecond)
+
+			broadcaster.Run(ctx)
+
+			require.Equal(t, tt.published, published)
+			require.Equal(t, int32(len(tt.connections)), calls.Load())
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Mobo140/chat/internal/model"
+	repositoryMocks "github.com/Mobo140/chat/internal/repository/mocks"
+	"github.com/Mobo140/chat/internal/service"
+	serviceMocks "github.com/Mobo140/chat/internal/service/mocks"
+	outboxService "github.com/Mobo140/chat/internal/service/outbox"
+	repositoryTx "github.com/Mobo140/platform_common/pkg/db"
+	dbTxMocks "github.com/Mobo140/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelay(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+
+		repositoryErr = fmt.Errorf("repository error")
+		publishErr    = fmt.Errorf("chat channel is full")
+
+		events = func() []*model.ChatEvent {
+			return []*model.ChatEvent{
+				{ID: 1, ChatID: 10, Type: model.ChatEventMessageSent},
+				{ID: 2, ChatID: 20, Type: model.ChatEventMessageSent},
+				{ID: 3, ChatID: 10, Type: model.ChatEventMessageEdited},
+				{ID: 4, ChatID: 20, Type: model.ChatEventReactionAdded},
+			}
+		}
+	)
+
+	tests := []struct {
+		name      string
+		events    []*model.ChatEvent
+		listErr   error
+		failed    int64
+		published []int64
+		want      int
+		err       error
+	}{
+		{
+			name:      "success case",
+			events:    events(),
+			published: []int64{1, 2, 3, 4},
+			want:      4,
+		},
+		{
+			name:      "failed chat keeps its order",
+			events:    events(),
+			failed:    2,
+			published: []int64{1, 3},
+			want:      2,
+		},
+		{
+			name:      "nothing pending",
+			events:    nil,
+			published: []int64{},
+			want:      0,
+		},
+		{
+			name:    "repository error",
+			listErr: repositoryErr,
+			err:     repositoryErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+			publisher := serviceMocks.NewEventPublisherMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+			outboxRepo.ListPendingMock.Expect(ctxValue, 100).Return(tt.events, tt.listErr)
+
+			if len(tt.events) > 0 {
+				publisher.PublishMock.Set(func(_ context.Context, event *model.ChatEvent) error {
+					if event.ID == tt.failed {
+						return publishErr
+					}
+
+					return nil
+				})
+			}
+
+			if tt.err == nil {
+				outboxRepo.MarkPublishedMock.Expect(ctxValue, tt.published).Return(nil)
+			}
+
+			relay := outboxService.NewRelay(outboxRepo, txManager, []service.EventPublisher{publisher}, 100, 0)
+
+			published, err := relay.Relay(ctxValue)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, published)
+		})
+	}
+}
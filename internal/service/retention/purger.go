@@ -0,0 +1,340 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/service"
+	"github.com/Mobo140/platform_common/pkg/db"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"go.uber.org/zap"
+)
+
+var _ service.Purger = (*purger)(nil)
+
+// Options are the global retention settings of the purger.
+type Options struct {
+	// Messages is the retention of the chats without an override.
+	Messages model.Retention
+	// LogMaxAge is how long the audit log is kept, zero keeps it all.
+	LogMaxAge time.Duration
+	BatchSize uint64
+	Interval  time.Duration
+	// DryRun only counts what would be deleted.
+	DryRun bool
+	// ChatGrace is how long the deleted chats are kept for a restore.
+	ChatGrace time.Duration
+	// OutboxMaxAge is how long the published outbox events are kept, for the
+	// broadcasters resuming after a lost connection. Zero keeps them all.
+	OutboxMaxAge time.Duration
+}
+
+// purger deletes the chats deleted more than ChatGrace ago, the messages and
+// the audit log entries beyond the retention and the old published outbox
+// events.
+// Every batch is a statement of its own, so no lock is held for long, and an
+// interrupted run is finished by the next one.
+type purger struct {
+	chatRepository    repository.ChatRepository
+	messageRepository repository.MessageRepository
+	logRepository     repository.LogRepository
+	outboxRepository  repository.OutboxRepository
+	auditChainer      service.AuditChainer
+	txManager         db.TxManager
+	auditPolicy       model.AuditPolicy
+	options           Options
+}
+
+func NewPurger( //nolint:revive // it's ok
+	chatRepository repository.ChatRepository,
+	messageRepository repository.MessageRepository,
+	logRepository repository.LogRepository,
+	outboxRepository repository.OutboxRepository,
+	auditChainer service.AuditChainer,
+	txManager db.TxManager,
+	auditPolicy model.AuditPolicy,
+	options Options,
+) *purger {
+	return &purger{
+		chatRepository:    chatRepository,
+		messageRepository: messageRepository,
+		logRepository:     logRepository,
+		outboxRepository:  outboxRepository,
+		auditChainer:      auditChainer,
+		txManager:         txManager,
+		auditPolicy:       auditPolicy,
+		options:           options,
+	}
+}
+
+// Run purges every interval until the context is done.
+func (p *purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		report, err := p.Purge(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Error("failed to purge", zap.Error(err))
+		}
+
+		if err == nil {
+			logger.Info("purged",
+				zap.Bool("dry_run", report.DryRun),
+				zap.Int64("deleted_chats", report.DeletedChats),
+				zap.Int("chats", report.Chats),
+				zap.Int64("messages", report.Messages),
+				zap.Int64("logs", report.Logs),
+				zap.Int64("outbox_events", report.OutboxEvents),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge deletes the chats past the grace period, the messages of every chat
+// beyond its retention, the audit log entries older than LogMaxAge, then the
+// outbox events published more than OutboxMaxAge ago.
+func (p *purger) Purge(ctx context.Context) (*model.PurgeReport, error) {
+	report := &model.PurgeReport{DryRun: p.options.DryRun}
+
+	err := p.purgeChats(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.purgeMessages(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.purgeLogs(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.purgeOutbox(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// purgeChats deletes the deleted chats one by one, a chat restored meanwhile
+// is skipped. The purge of each is recorded in the audit log.
+func (p *purger) purgeChats(ctx context.Context, report *model.PurgeReport) error {
+	if p.options.DryRun {
+		var err error
+
+		report.DeletedChats, err = p.chatRepository.CountDeleted(ctx, p.options.ChatGrace)
+
+		return err
+	}
+
+	for {
+		var chatID int64
+
+		err := p.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+			var errTx error
+
+			chatID, errTx = p.chatRepository.PurgeDeleted(ctx, p.options.ChatGrace)
+			if errTx != nil || chatID == 0 || !p.auditPolicy.Audited(model.LogActionPurgeChat) {
+				return errTx
+			}
+
+			return p.logRepository.Create(ctx, &model.LogEntry{
+				ChatID: chatID,
+				Action: model.LogActionPurgeChat,
+				Actor:  model.RetentionActor,
+				Details: model.LogDetails{
+					"grace_seconds": int64(p.options.ChatGrace.Seconds()),
+				},
+			})
+		})
+		if err != nil {
+			return err
+		}
+
+		if chatID == 0 {
+			return nil
+		}
+
+		report.DeletedChats++
+	}
+}
+
+func (p *purger) purgeMessages(ctx context.Context, report *model.PurgeReport) error {
+	var afterID int64
+
+	for {
+		chats, err := p.chatRepository.ListRetention(ctx, afterID, p.options.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, chat := range chats {
+			afterID = chat.ChatID
+
+			retention := chat.Apply(p.options.Messages)
+			if !retention.Enabled() {
+				continue
+			}
+
+			deleted, err := p.purgeChat(ctx, &model.MessagePurge{
+				ChatID:    chat.ChatID,
+				Retention: retention,
+				Limit:     p.options.BatchSize,
+			})
+			if err != nil {
+				return err
+			}
+
+			if deleted > 0 {
+				report.Chats++
+				report.Messages += deleted
+			}
+		}
+
+		if uint64(len(chats)) < p.options.BatchSize {
+			return nil
+		}
+	}
+}
+
+// purgeChat deletes the messages of a chat batch by batch and records how
+// many were deleted in the audit log.
+func (p *purger) purgeChat(ctx context.Context, purge *model.MessagePurge) (int64, error) {
+	if p.options.DryRun {
+		return p.messageRepository.CountPurgeable(ctx, purge)
+	}
+
+	var deleted int64
+
+	for {
+		n, err := p.messageRepository.Purge(ctx, purge)
+		if err != nil {
+			return 0, err
+		}
+
+		deleted += n
+
+		if uint64(n) < purge.Limit {
+			break
+		}
+	}
+
+	if deleted == 0 || !p.auditPolicy.Audited(model.LogActionPurgeMessages) {
+		return deleted, nil
+	}
+
+	err := p.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		return p.logRepository.Create(ctx, &model.LogEntry{
+			ChatID: purge.ChatID,
+			Action: model.LogActionPurgeMessages,
+			Actor:  model.RetentionActor,
+			Details: model.LogDetails{
+				"deleted":         deleted,
+				"max_age_seconds": int64(purge.Retention.MaxAge.Seconds()),
+				"max_count":       purge.Retention.MaxCount,
+			},
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
+
+// purgeLogs deletes the prefix of the audit log older than LogMaxAge. The
+// purge record is written and chained first, whatever the audit policy: the
+// verifier accepts the chain starting after the purged prefix only if it is there.
+func (p *purger) purgeLogs(ctx context.Context, report *model.PurgeReport) error {
+	if p.options.LogMaxAge == 0 {
+		return nil
+	}
+
+	boundary, err := p.logRepository.LastBefore(ctx, p.options.LogMaxAge)
+	if errors.Is(err, model.ErrLogNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if p.options.DryRun {
+		report.Logs, err = p.logRepository.CountUpTo(ctx, boundary.ChainSeq)
+
+		return err
+	}
+
+	err = p.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		return p.logRepository.Create(ctx, &model.LogEntry{
+			Action: model.LogActionPurgeLogs,
+			Actor:  model.RetentionActor,
+			Details: model.LogDetails{
+				model.PurgeUpToDetail:   boundary.ChainSeq,
+				model.PurgeAnchorDetail: boundary.Hash,
+			},
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	err = p.auditChainer.Chain(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		n, err := p.logRepository.DeleteUpTo(ctx, boundary.ChainSeq, p.options.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		report.Logs += n
+
+		if uint64(n) < p.options.BatchSize {
+			return nil
+		}
+	}
+}
+
+// purgeOutbox deletes the outbox events published more than OutboxMaxAge ago.
+func (p *purger) purgeOutbox(ctx context.Context, report *model.PurgeReport) error {
+	if p.options.OutboxMaxAge == 0 {
+		return nil
+	}
+
+	if p.options.DryRun {
+		var err error
+
+		report.OutboxEvents, err = p.outboxRepository.CountPublished(ctx, p.options.OutboxMaxAge)
+
+		return err
+	}
+
+	for {
+		n, err := p.outboxRepository.DeletePublished(ctx, p.options.OutboxMaxAge, p.options.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		report.OutboxEvents += n
+
+		if uint64(n) < p.options.BatchSize {
+			return nil
+		}
+	}
+}
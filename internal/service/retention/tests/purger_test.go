@@ -0,0 +1,297 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	repositoryMocks "github.com/Mobo140/chat/internal/repository/mocks"
+	serviceMocks "github.com/Mobo140/chat/internal/service/mocks"
+	retentionService "github.com/Mobo140/chat/internal/service/retention"
+	repositoryTx "github.com/Mobo140/platform_common/pkg/db"
+	dbTxMocks "github.com/Mobo140/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurge(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+
+		repositoryErr = fmt.Errorf("repository error")
+
+		day      = 24 * time.Hour
+		keepAll  = int64(0)
+		maxCount = int64(50)
+
+		global = model.Retention{MaxAge: 30 * day}
+
+		// Chat 1 inherits the global retention, chat 2 keeps the last 50
+		// messages as well and chat 3 keeps everything.
+		chats = []*model.ChatRetention{
+			{ChatID: 1},
+			{ChatID: 2, MaxCount: &maxCount},
+		}
+		lastChat = []*model.ChatRetention{
+			{ChatID: 3, MaxAge: new(time.Duration), MaxCount: &keepAll},
+		}
+
+		boundary = &model.LogEntry{ID: 9, ChainSeq: 7, Hash: "anchor"}
+
+		grace = 30 * day
+	)
+
+	tests := []struct {
+		name         string
+		dryRun       bool
+		logMaxAge    time.Duration
+		outboxMaxAge time.Duration
+		purgeErr     error
+		boundary     *model.LogEntry
+		want         *model.PurgeReport
+		audited      []model.LogAction
+		err          error
+	}{
+		{
+			name: "messages purge case",
+			want: &model.PurgeReport{DeletedChats: 2, Chats: 2, Messages: 6},
+			audited: []model.LogAction{
+				model.LogActionPurgeChat,
+				model.LogActionPurgeChat,
+				model.LogActionPurgeMessages,
+				model.LogActionPurgeMessages,
+			},
+		},
+		{
+			name:      "logs purge case",
+			logMaxAge: 90 * day,
+			boundary:  boundary,
+			want:      &model.PurgeReport{DeletedChats: 2, Chats: 2, Messages: 6, Logs: 3},
+			audited: []model.LogAction{
+				model.LogActionPurgeChat,
+				model.LogActionPurgeChat,
+				model.LogActionPurgeMessages,
+				model.LogActionPurgeMessages,
+				model.LogActionPurgeLogs,
+			},
+		},
+		{
+			name:      "no old logs case",
+			logMaxAge: 90 * day,
+			want:      &model.PurgeReport{DeletedChats: 2, Chats: 2, Messages: 6},
+			audited: []model.LogAction{
+				model.LogActionPurgeChat,
+				model.LogActionPurgeChat,
+				model.LogActionPurgeMessages,
+				model.LogActionPurgeMessages,
+			},
+		},
+		{
+			name:         "outbox purge case",
+			outboxMaxAge: day,
+			want:         &model.PurgeReport{DeletedChats: 2, Chats: 2, Messages: 6, OutboxEvents: 3},
+			audited: []model.LogAction{
+				model.LogActionPurgeChat,
+				model.LogActionPurgeChat,
+				model.LogActionPurgeMessages,
+				model.LogActionPurgeMessages,
+			},
+		},
+		{
+			name:         "dry run case",
+			dryRun:       true,
+			logMaxAge:    90 * day,
+			outboxMaxAge: day,
+			boundary:     boundary,
+			want: &model.PurgeReport{
+				DryRun:       true,
+				DeletedChats: 3,
+				Chats:        2,
+				Messages:     15,
+				Logs:         4,
+				OutboxEvents: 5,
+			},
+		},
+		{
+			name:     "messageRepo error",
+			purgeErr: repositoryErr,
+			err:      repositoryErr,
+			audited:  []model.LogAction{model.LogActionPurgeChat, model.LogActionPurgeChat},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			chatRepo := repositoryMocks.NewChatRepositoryMock(mc)
+			messageRepo := repositoryMocks.NewMessageRepositoryMock(mc)
+			logRepo := repositoryMocks.NewLogRepositoryMock(mc)
+			outboxRepo := repositoryMocks.NewOutboxRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+
+			txManager.ReadCommitedMock.Optional().Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+
+			chatRepo.ListRetentionMock.Set(func(_ context.Context, afterID int64, limit uint64) ([]*model.ChatRetention, error) {
+				require.EqualValues(t, 2, limit)
+
+				if afterID == 0 {
+					return chats, nil
+				}
+
+				require.EqualValues(t, 2, afterID)
+
+				return lastChat, nil
+			})
+
+			// The retention of chat 1 is the global one, chat 2 adds its count.
+			check := func(purge *model.MessagePurge) {
+				want := model.Retention{MaxAge: global.MaxAge}
+				if purge.ChatID == 2 {
+					want.MaxCount = maxCount
+				}
+
+				require.Equal(t, want, purge.Retention)
+				require.EqualValues(t, 2, purge.Limit)
+			}
+
+			if tt.dryRun {
+				chatRepo.CountDeletedMock.Expect(ctxValue, grace).Return(3, nil)
+			} else {
+				// Chats 41 and 42 are past the grace period.
+				purged := []int64{41, 42, 0}
+				chatRepo.PurgeDeletedMock.Set(func(_ context.Context, d time.Duration) (int64, error) {
+					require.Equal(t, grace, d)
+
+					id := purged[0]
+					purged = purged[1:]
+
+					return id, nil
+				})
+			}
+
+			if tt.dryRun {
+				messageRepo.CountPurgeableMock.Set(func(_ context.Context, purge *model.MessagePurge) (int64, error) {
+					check(purge)
+
+					return purge.ChatID * 5, nil
+				})
+			} else {
+				// Chat 1 has 5 messages to delete in batches of 2, chat 2 has one.
+				left := map[int64]int64{1: 5, 2: 1}
+				messageRepo.PurgeMock.Set(func(_ context.Context, purge *model.MessagePurge) (int64, error) {
+					if tt.purgeErr != nil {
+						return 0, tt.purgeErr
+					}
+
+					check(purge)
+
+					n := min(left[purge.ChatID], 2)
+					left[purge.ChatID] -= n
+
+					return n, nil
+				})
+			}
+
+			var audited []model.LogAction
+			logRepo.CreateMock.Optional().Set(func(_ context.Context, entry *model.LogEntry) error {
+				require.Equal(t, model.RetentionActor, entry.Actor)
+				audited = append(audited, entry.Action)
+
+				if entry.Action == model.LogActionPurgeChat {
+					require.Contains(t, []int64{41, 42}, entry.ChatID)
+				}
+
+				if entry.Action == model.LogActionPurgeLogs {
+					require.Equal(t, model.LogDetails{
+						model.PurgeUpToDetail:   int64(7),
+						model.PurgeAnchorDetail: "anchor",
+					}, entry.Details)
+				}
+
+				return nil
+			})
+
+			if tt.logMaxAge > 0 {
+				if tt.boundary != nil {
+					logRepo.LastBeforeMock.Expect(ctxValue, tt.logMaxAge).Return(tt.boundary, nil)
+				} else {
+					logRepo.LastBeforeMock.Expect(ctxValue, tt.logMaxAge).Return(nil, model.ErrLogNotFound)
+				}
+			}
+
+			if tt.boundary != nil && tt.dryRun {
+				logRepo.CountUpToMock.Expect(ctxValue, 7).Return(4, nil)
+			}
+
+			auditChainer := serviceMocks.NewAuditChainerMock(mc)
+
+			var chained bool
+			if tt.boundary != nil && !tt.dryRun {
+				auditChainer.ChainMock.Set(func(_ context.Context) error {
+					require.Len(t, audited, 5)
+					chained = true
+
+					return nil
+				})
+
+				// The purge record is chained first, then batches of 2 and 1.
+				deleted := []int64{2, 1}
+				logRepo.DeleteUpToMock.Set(func(_ context.Context, seq int64, limit uint64) (int64, error) {
+					require.True(t, chained)
+					require.EqualValues(t, 7, seq)
+					require.EqualValues(t, 2, limit)
+
+					n := deleted[0]
+					deleted = deleted[1:]
+
+					return n, nil
+				})
+			}
+
+			if tt.outboxMaxAge > 0 && tt.dryRun {
+				outboxRepo.CountPublishedMock.Expect(ctxValue, tt.outboxMaxAge).Return(5, nil)
+			}
+
+			if tt.outboxMaxAge > 0 && !tt.dryRun {
+				// Three published events are old enough, in batches of 2 and 1.
+				deleted := []int64{2, 1}
+				outboxRepo.DeletePublishedMock.Set(func(_ context.Context, maxAge time.Duration, limit uint64) (int64, error) {
+					require.Equal(t, tt.outboxMaxAge, maxAge)
+					require.EqualValues(t, 2, limit)
+
+					n := deleted[0]
+					deleted = deleted[1:]
+
+					return n, nil
+				})
+			}
+
+			purger := retentionService.NewPurger(chatRepo, messageRepo, logRepo, outboxRepo, auditChainer, txManager,
+				model.NewAuditPolicy(),
+				retentionService.Options{
+					Messages:     global,
+					LogMaxAge:    tt.logMaxAge,
+					BatchSize:    2,
+					Interval:     time.Hour,
+					DryRun:       tt.dryRun,
+					ChatGrace:    grace,
+					OutboxMaxAge: tt.outboxMaxAge,
+				})
+
+			report, err := purger.Purge(ctxValue)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, report)
+			require.Equal(t, tt.audited, audited)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/service"
+	"github.com/Mobo140/platform_common/pkg/db"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"go.uber.org/zap"
+)
+
+var _ service.Scheduler = (*scheduler)(nil)
+
+// scheduler sends the due scheduled messages. The due messages are locked
+// with SKIP LOCKED, so the schedulers of several replicas share the work and
+// every message is sent once.
+type scheduler struct {
+	scheduledRepository repository.ScheduledRepository
+	chatService         service.ChatService
+	txManager           db.TxManager
+	batchSize           uint64
+	pollInterval        time.Duration
+}
+
+func NewScheduler( //nolint:revive // it's ok
+	scheduledRepository repository.ScheduledRepository,
+	chatService service.ChatService,
+	txManager db.TxManager,
+	batchSize uint64,
+	pollInterval time.Duration,
+) *scheduler {
+	return &scheduler{
+		scheduledRepository: scheduledRepository,
+		chatService:         chatService,
+		txManager:           txManager,
+		batchSize:           batchSize,
+		pollInterval:        pollInterval,
+	}
+}
+
+// Run polls the schedule until the context is done. Full batches are followed
+// by the next one right away to catch up.
+func (s *scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		handled, err := s.Send(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Error("failed to send scheduled messages", zap.Error(err))
+		}
+
+		if err == nil && uint64(handled) == s.batchSize {
+			if ctx.Err() != nil {
+				return
+			}
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Send sends a batch of due messages and returns how many were handled.
+// A message the chat rejects, e.g. from a muted user or to an archived or
+// deleted chat, is kept with the error instead of being retried: it would
+// fail the whole batch on every poll.
+func (s *scheduler) Send(ctx context.Context) (int, error) {
+	var handled int
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		messages, errTx := s.scheduledRepository.ListDue(ctx, s.batchSize)
+		if errTx != nil {
+			return errTx
+		}
+
+		for _, message := range messages {
+			_, errTx = s.chatService.DeliverScheduled(ctx, message)
+			if rejected(errTx) {
+				logger.Warn("scheduled message rejected",
+					zap.Int64("id", message.ID),
+					zap.Int64("chat_id", message.ChatID),
+					zap.Error(errTx),
+				)
+
+				errTx = s.scheduledRepository.Fail(ctx, message.ID, errTx.Error())
+			}
+
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		handled = len(messages)
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return handled, nil
+}
+
+// rejected reports whether the chat refused the message for good. These
+// errors come before any write, the transaction is still usable.
+func rejected(err error) bool {
+	return errors.Is(err, model.ErrMuted) ||
+		errors.Is(err, model.ErrInvalidReply) ||
+		errors.Is(err, model.ErrChatArchived) ||
+		errors.Is(err, model.ErrChatNotFound)
+}
@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Mobo140/chat/internal/model"
+	repositoryMocks "github.com/Mobo140/chat/internal/repository/mocks"
+	serviceMocks "github.com/Mobo140/chat/internal/service/mocks"
+	schedulerService "github.com/Mobo140/chat/internal/service/scheduler"
+	repositoryTx "github.com/Mobo140/platform_common/pkg/db"
+	dbTxMocks "github.com/Mobo140/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+
+		repositoryErr = fmt.Errorf("repository error")
+
+		messages = func() []*model.ScheduledMessage {
+			return []*model.ScheduledMessage{
+				{ID: 1, ChatID: 10, Message: model.Message{From: "bob", Text: "stand-up"}},
+				{ID: 2, ChatID: 20, Message: model.Message{From: "alice", Text: "deploy"}},
+			}
+		}
+	)
+
+	tests := []struct {
+		name       string
+		messages   []*model.ScheduledMessage
+		listErr    error
+		deliverErr map[int64]error
+		failed     map[int64]string
+		want       int
+		err        error
+	}{
+		{
+			name:     "success case",
+			messages: messages(),
+			want:     2,
+		},
+		{
+			name:       "rejected message is kept with the error",
+			messages:   messages(),
+			deliverErr: map[int64]error{2: model.ErrMuted},
+			failed:     map[int64]string{2: model.ErrMuted.Error()},
+			want:       2,
+		},
+		{
+			name:       "message to an archived chat is kept with the error",
+			messages:   messages(),
+			deliverErr: map[int64]error{1: model.ErrChatArchived},
+			failed:     map[int64]string{1: model.ErrChatArchived.Error()},
+			want:       2,
+		},
+		{
+			name:       "message to a deleted chat is kept with the error",
+			messages:   messages(),
+			deliverErr: map[int64]error{2: model.ErrChatNotFound},
+			failed:     map[int64]string{2: model.ErrChatNotFound.Error()},
+			want:       2,
+		},
+		{
+			name:       "delivery error",
+			messages:   messages(),
+			deliverErr: map[int64]error{1: repositoryErr},
+			err:        repositoryErr,
+		},
+		{
+			name:    "repository error",
+			listErr: repositoryErr,
+			err:     repositoryErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			scheduledRepo := repositoryMocks.NewScheduledRepositoryMock(mc)
+			chatService := serviceMocks.NewChatServiceMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+			scheduledRepo.ListDueMock.Expect(ctxValue, 100).Return(tt.messages, tt.listErr)
+
+			if len(tt.messages) > 0 {
+				chatService.DeliverScheduledMock.Set(func(_ context.Context, message *model.ScheduledMessage) (int64, error) {
+					return message.ID * 100, tt.deliverErr[message.ID]
+				})
+			}
+
+			if len(tt.failed) > 0 {
+				scheduledRepo.FailMock.Set(func(_ context.Context, id int64, reason string) error {
+					require.Equal(t, tt.failed[id], reason)
+
+					return nil
+				})
+			}
+
+			scheduler := schedulerService.NewScheduler(scheduledRepo, chatService, txManager, 100, 0)
+
+			handled, err := scheduler.Send(ctxValue)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, handled)
+		})
+	}
+}
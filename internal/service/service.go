@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/Mobo140/chat/internal/model"
 )
@@ -9,8 +10,124 @@ import (
 type ChatService interface {
 	Create(ctx context.Context, chat *model.ChatInfo) (int64, error)
 	Get(ctx context.Context, id int64) (*model.Chat, error)
+	// Delete hides the chat, it can be restored until the purger deletes it after the grace period.
 	Delete(ctx context.Context, id int64) error
+	Restore(ctx context.Context, id int64) error
+	// Archive makes the chat read only until Unarchive.
+	Archive(ctx context.Context, id int64) error
+	Unarchive(ctx context.Context, id int64) error
+	SetRetention(ctx context.Context, retention *model.ChatRetention) error
 	// Update(ctx context.Context, info *model.UpdateInfo) error
-	SendMessage(ctx context.Context, message *model.SendMessage) error
+	SendMessage(ctx context.Context, message *model.SendMessage) (int64, error)
+	EditMessage(ctx context.Context, edit *model.EditMessage) (*model.Message, error)
+	DeleteMessage(ctx context.Context, deletion *model.DeleteMessage) (*model.Message, error)
+	ListMessages(ctx context.Context, page *model.MessagesPage) ([]*model.Message, error)
+	ListThread(ctx context.Context, page *model.ThreadPage) (*model.Thread, error)
+	SearchMessages(ctx context.Context, query *model.SearchQuery) ([]*model.SearchResult, error)
+	AddReaction(ctx context.Context, reaction *model.Reaction) error
+	RemoveReaction(ctx context.Context, reaction *model.Reaction) error
+	ListAuditLog(ctx context.Context, filter *model.LogFilter) ([]*model.LogEntry, error)
+	// ExportChat emits the records of the chat export in order.
+	ExportChat(ctx context.Context, chatID int64, emit func(record *model.ExportRecord) error) error
+	// ImportChat creates a chat from the lines of an export, next returns io.EOF after the last one.
+	ImportChat(ctx context.Context, next func() (*model.ImportLine, error)) (*model.ImportReport, error)
+	// RunCommand runs a slash command and returns the reply to the caller.
+	RunCommand(ctx context.Context, call *model.CommandCall) (string, error)
+	ScheduleMessage(ctx context.Context, message *model.ScheduledMessage) (int64, error)
+	ListScheduled(ctx context.Context, filter *model.ScheduledFilter) ([]*model.ScheduledMessage, error)
+	CancelScheduled(ctx context.Context, cancel *model.CancelScheduled) error
+	// DeliverScheduled sends a due scheduled message, called by the Scheduler.
+	DeliverScheduled(ctx context.Context, message *model.ScheduledMessage) (int64, error)
+	// AckDelivery moves the delivered cursor of the member forward.
+	AckDelivery(ctx context.Context, ack *model.DeliveryAck) error
+	// MarkRead moves the read cursor of the member forward, the delivered one with it.
+	MarkRead(ctx context.Context, read *model.DeliveryAck) error
+	// GetDeliveryCursor returns the cursors of the member, zero ones if none.
+	GetDeliveryCursor(ctx context.Context, chatID int64, username string) (*model.DeliveryCursor, error)
+	// ListUndelivered returns a page of the messages the member did not acknowledge, from the oldest.
+	ListUndelivered(ctx context.Context, page *model.UndeliveredPage) ([]*model.Message, error)
+	// ListAfter returns a page of the messages newer than afterID, from the oldest, to resume a stream.
+	ListAfter(ctx context.Context, chatID, afterID int64, limit uint64) ([]*model.Message, error)
+	// GetDeliveryStatus tells which members but the sender got and read the message.
+	GetDeliveryStatus(ctx context.Context, chatID, messageID int64) ([]*model.MemberDelivery, error)
+	// OpenSession records a stream connected to this instance until CloseSession.
+	OpenSession(ctx context.Context, session *model.Session) error
+	TouchSession(ctx context.Context, id string, lastActiveAt time.Time) error
+	CloseSession(ctx context.Context, id string) error
+	// ListSessions returns the live sessions of the user on every instance, from the oldest.
+	ListSessions(ctx context.Context, filter *model.SessionFilter) ([]*model.Session, error)
 	// GetMessagesByChatID()
 }
+
+type AuditService interface {
+	Verify(ctx context.Context) (*model.ChainReport, error)
+}
+
+// AuditWriter writes audit entries in the background, in batches.
+type AuditWriter interface {
+	Write(logEntry *model.LogEntry)
+	Close() error
+}
+
+// AuditChainer links the committed audit entries to the hash chain until the
+// context is done.
+type AuditChainer interface {
+	Run(ctx context.Context)
+	// Chain links the entries committed so far.
+	Chain(ctx context.Context) error
+}
+
+// EventPublisher delivers chat events to the subscribers.
+type EventPublisher interface {
+	Publish(ctx context.Context, event *model.ChatEvent) error
+}
+
+// OutboxRelay publishes the outbox events until the context is done.
+type OutboxRelay interface {
+	Run(ctx context.Context)
+}
+
+// OutboxBroadcaster publishes the events relayed by any instance to the chat
+// streams of this one until the context is done.
+type OutboxBroadcaster interface {
+	Run(ctx context.Context)
+}
+
+type WebhookService interface {
+	Create(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error)
+	List(ctx context.Context, chatID int64) ([]*model.Webhook, error)
+	Delete(ctx context.Context, id int64) error
+	ListAttempts(ctx context.Context, filter *model.WebhookAttemptFilter) ([]*model.WebhookAttempt, error)
+}
+
+// CommandHandler is a slash command. The chat service checks the role of the
+// caller and the number of arguments against the spec before Run.
+type CommandHandler interface {
+	Spec() model.CommandSpec
+	Run(ctx context.Context, call *model.CommandCall) (string, error)
+}
+
+// BotService manages the bots and their API keys. The keys are returned only
+// when issued, the service keeps their hashes.
+type BotService interface {
+	Create(ctx context.Context, bot *model.Bot) (string, error)
+	RotateKey(ctx context.Context, id int64) (string, error)
+	RevokeKeys(ctx context.Context, id int64) error
+	Authenticate(ctx context.Context, apiKey string) (*model.Bot, error)
+}
+
+// Scheduler sends the due scheduled messages until the context is done.
+type Scheduler interface {
+	Run(ctx context.Context)
+}
+
+// WebhookSender delivers the queued webhook deliveries until the context is done.
+type WebhookSender interface {
+	Run(ctx context.Context)
+}
+
+// Purger deletes the deleted chats, the messages and the audit log beyond the retention.
+type Purger interface {
+	Run(ctx context.Context)
+	Purge(ctx context.Context) (*model.PurgeReport, error)
+}
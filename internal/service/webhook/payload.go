@@ -0,0 +1,78 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+)
+
+// payload is the JSON body of a delivery.
+type payload struct {
+	ID        int64            `json:"id"`
+	Type      string           `json:"type"`
+	ChatID    int64            `json:"chat_id"`
+	CreatedAt time.Time        `json:"created_at"`
+	Username  string           `json:"username,omitempty"`
+	Message   *messagePayload  `json:"message,omitempty"`
+	Reaction  *reactionPayload `json:"reaction,omitempty"`
+	Chat      *chatPayload     `json:"chat,omitempty"`
+}
+
+type messagePayload struct {
+	ID               int64      `json:"id"`
+	From             string     `json:"from"`
+	Text             string     `json:"text"`
+	CreatedAt        time.Time  `json:"created_at"`
+	EditedAt         *time.Time `json:"edited_at,omitempty"`
+	DeletedAt        *time.Time `json:"deleted_at,omitempty"`
+	ReplyToMessageID int64      `json:"reply_to_message_id,omitempty"`
+}
+
+type reactionPayload struct {
+	MessageID int64  `json:"message_id"`
+	Emoji     string `json:"emoji"`
+}
+
+type chatPayload struct {
+	Usernames []string `json:"usernames"`
+	Admins    []string `json:"admins"`
+}
+
+func encodePayload(event *model.ChatEvent) ([]byte, error) {
+	res := payload{
+		ID:        event.ID,
+		Type:      string(event.Type),
+		ChatID:    event.ChatID,
+		CreatedAt: event.CreatedAt,
+		Username:  event.Username,
+	}
+
+	if event.Message != nil {
+		res.Message = &messagePayload{
+			ID:               event.Message.ID,
+			From:             event.Message.From,
+			Text:             event.Message.Text,
+			CreatedAt:        event.Message.CreatedAt,
+			EditedAt:         event.Message.EditedAt,
+			DeletedAt:        event.Message.DeletedAt,
+			ReplyToMessageID: event.Message.ReplyToID,
+		}
+	}
+
+	if event.Reaction != nil {
+		res.Reaction = &reactionPayload{
+			MessageID: event.Reaction.MessageID,
+			Emoji:     event.Reaction.Emoji,
+		}
+	}
+
+	if event.Chat != nil {
+		res.Chat = &chatPayload{
+			Usernames: event.Chat.Usernames,
+			Admins:    event.Chat.Admins,
+		}
+	}
+
+	return json.Marshal(res)
+}
@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/service"
+)
+
+var _ service.EventPublisher = (*publisher)(nil)
+
+// publisher queues a delivery of the event for every subscribed webhook.
+// It runs in the transaction of the outbox relay, the sender does the rest.
+type publisher struct {
+	webhookRepository repository.WebhookRepository
+}
+
+func NewPublisher(webhookRepository repository.WebhookRepository) *publisher { //nolint:revive // it's ok
+	return &publisher{webhookRepository: webhookRepository}
+}
+
+func (p *publisher) Publish(ctx context.Context, event *model.ChatEvent) error {
+	webhooks, err := p.webhookRepository.ListSubscribed(ctx, event.ChatID)
+	if err != nil {
+		return err
+	}
+
+	var body []byte
+	deliveries := make([]*model.WebhookDelivery, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		if !webhook.Subscribed(event.Type) {
+			continue
+		}
+
+		if body == nil {
+			body, err = encodePayload(event)
+			if err != nil {
+				return fmt.Errorf("failed to encode webhook payload: %v", err)
+			}
+		}
+
+		deliveries = append(deliveries, &model.WebhookDelivery{
+			WebhookID: webhook.ID,
+			EventID:   event.ID,
+			EventType: event.Type,
+			Payload:   body,
+		})
+	}
+
+	return p.webhookRepository.CreateDeliveries(ctx, deliveries)
+}
@@ -0,0 +1,252 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/service"
+	"github.com/Mobo140/platform_common/pkg/db"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"go.uber.org/zap"
+)
+
+var _ service.WebhookSender = (*sender)(nil)
+
+const maxResponseSize = 64 << 10
+
+// SenderOptions tune the delivery of a sender.
+type SenderOptions struct {
+	BatchSize    uint64
+	PollInterval time.Duration
+	Timeout      time.Duration
+	// MaxAttempts is the number of attempts before an event goes to the dead letters.
+	MaxAttempts int
+	// BaseBackoff is the delay after the first failed attempt, it doubles
+	// after every next one up to MaxBackoff.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// AllowPrivateNetworks lets the webhooks be on the loopback and private
+	// networks, for the local setups.
+	AllowPrivateNetworks bool
+}
+
+// sender POSTs the queued deliveries to the webhooks, retries failed ones
+// with an exponential backoff and logs every attempt.
+type sender struct {
+	webhookRepository repository.WebhookRepository
+	txManager         db.TxManager
+	client            *http.Client
+	opts              SenderOptions
+}
+
+func NewSender( //nolint:revive // it's ok
+	webhookRepository repository.WebhookRepository,
+	txManager db.TxManager,
+	opts SenderOptions,
+) *sender {
+	return &sender{
+		webhookRepository: webhookRepository,
+		txManager:         txManager,
+		client:            newClient(opts),
+		opts:              opts,
+	}
+}
+
+// Run sends the due deliveries until the context is done.
+func (s *sender) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		sent, err := s.Send(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Error("failed to send webhooks", zap.Error(err))
+		}
+
+		if err == nil && uint64(sent) == s.opts.BatchSize {
+			if ctx.Err() != nil {
+				return
+			}
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// result is the outcome of one attempt.
+type result struct {
+	statusCode int
+	err        error
+	duration   time.Duration
+}
+
+// Send makes one attempt for a batch of due deliveries and returns the batch size.
+// The deliveries are claimed in a transaction, the requests go out
+// concurrently after its commit and the outcome of each is recorded in a
+// transaction of its own.
+func (s *sender) Send(ctx context.Context) (int, error) {
+	deliveries, err := s.claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	results := make([]result, len(deliveries))
+
+	var wg sync.WaitGroup
+	for n, delivery := range deliveries {
+		wg.Add(1)
+
+		go func(n int, delivery *model.WebhookDelivery) {
+			defer wg.Done()
+
+			results[n] = s.post(ctx, delivery)
+		}(n, delivery)
+	}
+	wg.Wait()
+
+	var errs []error
+	for n, delivery := range deliveries {
+		err = s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+			return s.record(ctx, delivery, results[n])
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return 0, errors.Join(errs...)
+	}
+
+	return len(deliveries), nil
+}
+
+// claim locks the due deliveries and moves their next attempt past the lease,
+// the other senders skip them until then.
+func (s *sender) claim(ctx context.Context) ([]*model.WebhookDelivery, error) {
+	var deliveries []*model.WebhookDelivery
+	err := s.txManager.ReadCommited(ctx, func(ctx context.Context) error {
+		var errTx error
+		deliveries, errTx = s.webhookRepository.ListDue(ctx, s.opts.BatchSize)
+		if errTx != nil || len(deliveries) == 0 {
+			return errTx
+		}
+
+		ids := make([]int64, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			ids = append(ids, delivery.ID)
+		}
+
+		return s.webhookRepository.Claim(ctx, ids, s.lease())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (s *sender) post(ctx context.Context, delivery *model.WebhookDelivery) result {
+	start := time.Now()
+	timestamp := strconv.FormatInt(start.Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return result{err: err}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(delivery.EventType))
+	req.Header.Set(EventIDHeader, strconv.FormatInt(delivery.EventID, 10))
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(delivery.Secret, timestamp, delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return result{err: err, duration: time.Since(start)}
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseSize))
+
+	res := result{statusCode: resp.StatusCode, duration: time.Since(start)}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		res.err = fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return res
+}
+
+// record logs the attempt and completes, reschedules or dead letters the delivery.
+func (s *sender) record(ctx context.Context, delivery *model.WebhookDelivery, res result) error {
+	delivery.Attempts++
+
+	attempt := &model.WebhookAttempt{
+		WebhookID:  delivery.WebhookID,
+		EventID:    delivery.EventID,
+		EventType:  delivery.EventType,
+		Attempt:    delivery.Attempts,
+		StatusCode: res.statusCode,
+		Duration:   res.duration,
+	}
+
+	if res.err != nil {
+		attempt.Error = res.err.Error()
+		attempt.DeadLetter = delivery.Attempts >= s.opts.MaxAttempts
+	}
+
+	err := s.webhookRepository.LogAttempt(ctx, attempt)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case res.err == nil:
+		return s.webhookRepository.Complete(ctx, delivery.ID)
+	case attempt.DeadLetter:
+		logger.Warn("webhook delivery dead lettered",
+			zap.Int64("webhook_id", delivery.WebhookID),
+			zap.Int64("event_id", delivery.EventID),
+			zap.Error(res.err),
+		)
+
+		return s.webhookRepository.DeadLetter(ctx, delivery, attempt.Error)
+	default:
+		return s.webhookRepository.Reschedule(ctx, delivery.ID, delivery.Attempts, s.backoff(delivery.Attempts))
+	}
+}
+
+// lease is how long the claimed deliveries wait for their outcome, they are
+// due again after it if the sender stopped before recording it.
+func (s *sender) lease() time.Duration {
+	return 2 * s.opts.Timeout
+}
+
+// backoff is the delay after the given number of failed attempts.
+func (s *sender) backoff(attempts int) time.Duration {
+	delay := s.opts.BaseBackoff
+	for n := 1; n < attempts && delay < s.opts.MaxBackoff; n++ {
+		delay *= 2
+	}
+
+	if delay > s.opts.MaxBackoff {
+		return s.opts.MaxBackoff
+	}
+
+	return delay
+}
@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/chat/internal/repository"
+	"github.com/Mobo140/chat/internal/service"
+)
+
+var _ service.WebhookService = (*serv)(nil)
+
+const (
+	secretSize      = 32
+	defaultPageSize = 50
+)
+
+type serv struct {
+	webhookRepository repository.WebhookRepository
+}
+
+func NewService(webhookRepository repository.WebhookRepository) *serv { //nolint:revive // it's ok
+	return &serv{webhookRepository: webhookRepository}
+}
+
+// Create subscribes the webhook and generates its signing secret.
+func (s *serv) Create(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	err := validate(webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := make([]byte, secretSize)
+
+	_, err = rand.Read(secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate webhook secret: %v", err)
+	}
+
+	webhook.Secret = hex.EncodeToString(secret)
+
+	webhook.ID, err = s.webhookRepository.Create(ctx, webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func (s *serv) List(ctx context.Context, chatID int64) ([]*model.Webhook, error) {
+	return s.webhookRepository.List(ctx, chatID)
+}
+
+func (s *serv) Delete(ctx context.Context, id int64) error {
+	return s.webhookRepository.Delete(ctx, id)
+}
+
+func (s *serv) ListAttempts(ctx context.Context, filter *model.WebhookAttemptFilter) ([]*model.WebhookAttempt, error) {
+	if filter.Limit == 0 {
+		filter.Limit = defaultPageSize
+	}
+
+	return s.webhookRepository.ListAttempts(ctx, filter)
+}
+
+// validate accepts absolute http(s) URLs and known event types.
+func validate(webhook *model.Webhook) error {
+	u, err := url.Parse(webhook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http or https URL", model.ErrInvalidWebhook)
+	}
+
+	for _, eventType := range webhook.EventTypes {
+		if !eventType.Valid() {
+			return fmt.Errorf("%w: unknown event type %q", model.ErrInvalidWebhook, eventType)
+		}
+	}
+
+	return nil
+}
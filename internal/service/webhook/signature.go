@@ -0,0 +1,34 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Delivery headers.
+const (
+	EventHeader     = "X-Chat-Event"
+	EventIDHeader   = "X-Chat-Event-Id"
+	TimestampHeader = "X-Chat-Timestamp"
+	SignatureHeader = "X-Chat-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+// Sign returns the signature header of a delivery: the HMAC-SHA256 of the
+// timestamp and the body joined with a dot. The timestamp is signed too, so a
+// receiver can reject replayed deliveries.
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature header of a delivery.
+func Verify(secret string, timestamp string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
@@ -0,0 +1,90 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Mobo140/chat/internal/model"
+	repositoryMocks "github.com/Mobo140/chat/internal/repository/mocks"
+	webhookService "github.com/Mobo140/chat/internal/service/webhook"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+
+		repositoryErr = fmt.Errorf("repository error")
+
+		event = &model.ChatEvent{
+			ID:       7,
+			ChatID:   10,
+			Type:     model.ChatEventReactionAdded,
+			Username: "bob",
+		}
+	)
+
+	tests := []struct {
+		name     string
+		webhooks []*model.Webhook
+		listErr  error
+		want     []int64
+		err      error
+	}{
+		{
+			name: "success case",
+			webhooks: []*model.Webhook{
+				{ID: 1},
+				{ID: 2, EventTypes: []model.ChatEventType{model.ChatEventMessageSent}},
+				{ID: 3, ChatID: 10, EventTypes: []model.ChatEventType{model.ChatEventReactionAdded}},
+			},
+			want: []int64{1, 3},
+		},
+		{
+			name: "no subscribers",
+			want: []int64{},
+		},
+		{
+			name:    "repository error",
+			listErr: repositoryErr,
+			err:     repositoryErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			webhookRepo := repositoryMocks.NewWebhookRepositoryMock(mc)
+
+			webhookRepo.ListSubscribedMock.Expect(ctxValue, event.ChatID).Return(tt.webhooks, tt.listErr)
+			if tt.err == nil {
+				webhookRepo.CreateDeliveriesMock.Set(func(_ context.Context, deliveries []*model.WebhookDelivery) error {
+					got := make([]int64, 0, len(deliveries))
+					for _, delivery := range deliveries {
+						require.Equal(t, event.ID, delivery.EventID)
+						require.Equal(t, event.Type, delivery.EventType)
+						require.NotEmpty(t, delivery.Payload)
+
+						got = append(got, delivery.WebhookID)
+					}
+					require.Equal(t, tt.want, got)
+
+					return nil
+				})
+			}
+
+			publisher := webhookService.NewPublisher(webhookRepo)
+
+			err := publisher.Publish(ctxValue, event)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
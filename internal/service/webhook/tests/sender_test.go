@@ -0,0 +1,206 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	repositoryMocks "github.com/Mobo140/chat/internal/repository/mocks"
+	webhookService "github.com/Mobo140/chat/internal/service/webhook"
+	repositoryTx "github.com/Mobo140/platform_common/pkg/db"
+	dbTxMocks "github.com/Mobo140/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	const secret = "secret"
+
+	var (
+		ctxValue = context.Background()
+		payload  = []byte(`{"id":7,"type":"message_sent"}`)
+
+		opts = webhookService.SenderOptions{
+			BatchSize:    10,
+			PollInterval: time.Second,
+			Timeout:      time.Second,
+			MaxAttempts:  3,
+			BaseBackoff:  time.Second,
+			MaxBackoff:   3 * time.Second,
+
+			AllowPrivateNetworks: true,
+		}
+	)
+
+	tests := []struct {
+		name       string
+		statusCode int
+		attempts   int
+		completed  bool
+		delay      time.Duration
+		deadLetter bool
+	}{
+		{
+			name:       "success case",
+			statusCode: http.StatusNoContent,
+			completed:  true,
+		},
+		{
+			name:       "failed attempt is rescheduled",
+			statusCode: http.StatusInternalServerError,
+			delay:      time.Second,
+		},
+		{
+			name:       "backoff doubles up to the max",
+			statusCode: http.StatusBadGateway,
+			attempts:   1,
+			delay:      2 * time.Second,
+		},
+		{
+			name:       "redirect is not followed",
+			statusCode: http.StatusFound,
+			delay:      time.Second,
+		},
+		{
+			name:       "last attempt goes to the dead letters",
+			statusCode: http.StatusInternalServerError,
+			attempts:   2,
+			deadLetter: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/hook", r.URL.Path)
+
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.Equal(t, payload, body)
+				require.Equal(t, string(model.ChatEventMessageSent), r.Header.Get(webhookService.EventHeader))
+				require.Equal(t, "7", r.Header.Get(webhookService.EventIDHeader))
+				require.True(t, webhookService.Verify(
+					secret,
+					r.Header.Get(webhookService.TimestampHeader),
+					body,
+					r.Header.Get(webhookService.SignatureHeader),
+				))
+
+				w.Header().Set("Location", "/elsewhere")
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			delivery := &model.WebhookDelivery{
+				ID:        1,
+				WebhookID: 2,
+				EventID:   7,
+				EventType: model.ChatEventMessageSent,
+				Payload:   payload,
+				Attempts:  tt.attempts,
+				URL:       server.URL + "/hook",
+				Secret:    secret,
+			}
+
+			mc := minimock.NewController(t)
+			webhookRepo := repositoryMocks.NewWebhookRepositoryMock(mc)
+			txManager := dbTxMocks.NewTxManagerMock(mc)
+
+			txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+				return f(ctx)
+			})
+			webhookRepo.ListDueMock.Expect(ctxValue, opts.BatchSize).Return([]*model.WebhookDelivery{delivery}, nil)
+			webhookRepo.ClaimMock.Expect(ctxValue, []int64{delivery.ID}, 2*opts.Timeout).Return(nil)
+			webhookRepo.LogAttemptMock.Set(func(_ context.Context, attempt *model.WebhookAttempt) error {
+				require.Equal(t, tt.attempts+1, attempt.Attempt)
+				require.Equal(t, tt.statusCode, attempt.StatusCode)
+				require.Equal(t, tt.deadLetter, attempt.DeadLetter)
+				require.Equal(t, tt.completed, attempt.Error == "")
+
+				return nil
+			})
+
+			switch {
+			case tt.completed:
+				webhookRepo.CompleteMock.Expect(ctxValue, delivery.ID).Return(nil)
+			case tt.deadLetter:
+				webhookRepo.DeadLetterMock.Expect(ctxValue, delivery,
+					"unexpected status "+strconv.Itoa(tt.statusCode)).Return(nil)
+			default:
+				webhookRepo.RescheduleMock.Expect(ctxValue, delivery.ID, tt.attempts+1, tt.delay).Return(nil)
+			}
+
+			sender := webhookService.NewSender(webhookRepo, txManager, opts)
+
+			sent, err := sender.Send(ctxValue)
+			require.NoError(t, err)
+			require.Equal(t, 1, sent)
+		})
+	}
+}
+
+func TestSendPrivateDestination(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		mc       = minimock.NewController(t)
+
+		opts = webhookService.SenderOptions{
+			BatchSize:    10,
+			PollInterval: time.Second,
+			Timeout:      time.Second,
+			MaxAttempts:  3,
+			BaseBackoff:  time.Second,
+			MaxBackoff:   3 * time.Second,
+		}
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		t.Error("the private destination was called")
+	}))
+	defer server.Close()
+
+	delivery := &model.WebhookDelivery{
+		ID:        1,
+		WebhookID: 2,
+		EventID:   7,
+		EventType: model.ChatEventMessageSent,
+		Payload:   []byte(`{}`),
+		URL:       server.URL,
+		Secret:    "secret",
+	}
+
+	webhookRepo := repositoryMocks.NewWebhookRepositoryMock(mc)
+	txManager := dbTxMocks.NewTxManagerMock(mc)
+
+	txManager.ReadCommitedMock.Set(func(ctx context.Context, f repositoryTx.Handler) error {
+		return f(ctx)
+	})
+	webhookRepo.ListDueMock.Expect(ctxValue, opts.BatchSize).Return([]*model.WebhookDelivery{delivery}, nil)
+	webhookRepo.ClaimMock.Expect(ctxValue, []int64{delivery.ID}, 2*opts.Timeout).Return(nil)
+	webhookRepo.LogAttemptMock.Set(func(_ context.Context, attempt *model.WebhookAttempt) error {
+		require.Zero(t, attempt.StatusCode)
+		require.Contains(t, attempt.Error, "forbidden webhook destination")
+
+		return nil
+	})
+	webhookRepo.RescheduleMock.Expect(ctxValue, delivery.ID, 1, opts.BaseBackoff).Return(nil)
+
+	sender := webhookService.NewSender(webhookRepo, txManager, opts)
+
+	sent, err := sender.Send(ctxValue)
+	require.NoError(t, err)
+	require.Equal(t, 1, sent)
+}
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Mobo140/chat/internal/model"
+	repositoryMocks "github.com/Mobo140/chat/internal/repository/mocks"
+	webhookService "github.com/Mobo140/chat/internal/service/webhook"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctxValue = context.Background()
+		id       = int64(5)
+	)
+
+	tests := []struct {
+		name    string
+		webhook *model.Webhook
+		err     error
+	}{
+		{
+			name: "success case",
+			webhook: &model.Webhook{
+				ChatID:     10,
+				URL:        "https://example.com/hook",
+				EventTypes: []model.ChatEventType{model.ChatEventMessageSent},
+			},
+		},
+		{
+			name:    "not an http url",
+			webhook: &model.Webhook{URL: "ftp://example.com/hook"},
+			err:     model.ErrInvalidWebhook,
+		},
+		{
+			name: "unknown event type",
+			webhook: &model.Webhook{
+				URL:        "https://example.com/hook",
+				EventTypes: []model.ChatEventType{"message_read"},
+			},
+			err: model.ErrInvalidWebhook,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			webhookRepo := repositoryMocks.NewWebhookRepositoryMock(mc)
+
+			if tt.err == nil {
+				webhookRepo.CreateMock.Set(func(_ context.Context, webhook *model.Webhook) (int64, error) {
+					require.Len(t, webhook.Secret, 64)
+
+					return id, nil
+				})
+			}
+
+			service := webhookService.NewService(webhookRepo)
+
+			got, err := service.Create(ctxValue, tt.webhook)
+			require.ErrorIs(t, err, tt.err)
+			if tt.err == nil {
+				require.Equal(t, id, got.ID)
+				require.NotEmpty(t, got.Secret)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+const (
+	// EventsPath is the route of the chat Server-Sent Events on the gateway
+	// mux.
+	EventsPath = "/chat/v1/{id}/events"
+
+	// LastEventIDHeader resumes the events after the message, EventSource
+	// sets it when it reconnects.
+	LastEventIDHeader = "Last-Event-ID"
+
+	// LastEventIDQueryParam resumes the first connection, EventSource can't
+	// set headers.
+	LastEventIDQueryParam = "last_event_id"
+)
+
+var (
+	dataPrefix   = []byte("data: ")
+	eventsHeader = http.Header{
+		"Content-Type":  {"text/event-stream"},
+		"Cache-Control": {"no-cache"},
+		// Proxies must not buffer the stream.
+		"X-Accel-Buffering": {"no"},
+	}
+)
+
+type eventsHandler struct {
+	client desc.ChatV1Client
+}
+
+// NewEventsHandler streams the ConnectChatEvents of the chat as Server-Sent
+// Events, a ChatEvent in JSON per event. The query connects: device_id, as
+// the user of the access token, username must be that user if set. The
+// messages carry their ID as the event ID, a stream resumed from it gets the
+// messages stored since first. A refused stream is an HTTP error, the status
+// of an accepted one ends the events with an error event.
+func NewEventsHandler(client desc.ChatV1Client) runtime.HandlerFunc {
+	h := &eventsHandler{client: client}
+
+	return h.serve
+}
+
+func (h *eventsHandler) serve(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	req, err := connectFromRequest(r, pathParams)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	token := accessToken(r)
+	if len(token) == 0 {
+		http.Error(w, "access token not found", http.StatusUnauthorized)
+
+		return
+	}
+
+	ctx, cancel := context.WithCancel(metadata.AppendToOutgoingContext(r.Context(), authorizationHeader, token))
+	defer cancel()
+
+	stream, err := h.client.ConnectChatEvents(ctx, req)
+	if err == nil {
+		err = accepted(stream)
+	}
+
+	if err != nil {
+		st := status.Convert(err)
+		http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+
+		return
+	}
+
+	for key, values := range eventsHeader {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+
+	err = rc.Flush()
+	if err != nil {
+		logger.Warn("Failed to flush Server-Sent Events", zap.Error(err))
+
+		return
+	}
+
+	logger.Info("Server-Sent Events connected to chat",
+		zap.String("chat_id", req.GetChatId()),
+		zap.String("username", req.GetUsername()),
+		zap.Int64("after_message_id", req.GetAfterMessageId()),
+	)
+
+	err = h.relay(rc, w, stream)
+	if err != nil && r.Context().Err() == nil {
+		_ = writeError(rc, w, err)
+	}
+}
+
+// accepted waits for the headers of the stream, ConnectChatEvents sends them
+// once the access is granted. A stream ending without headers was refused, it
+// returns the status.
+func accepted(stream desc.ChatV1_ConnectChatEventsClient) error {
+	md, err := stream.Header()
+	if err != nil || md != nil {
+		return err
+	}
+
+	_, err = stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.Unavailable, "stream closed")
+	}
+
+	return err
+}
+
+// relay writes the events of the stream until the stream ends, it returns the
+// status of the stream, nil if it ended well.
+func (h *eventsHandler) relay(
+	rc *http.ResponseController,
+	w io.Writer,
+	stream desc.ChatV1_ConnectChatEventsClient,
+) error {
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		data, err := marshaler.Marshal(event)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		var id string
+		if message := event.GetMessage(); message != nil {
+			id = strconv.FormatInt(message.GetId(), 10)
+		}
+
+		err = writeEvent(rc, w, id, "", data)
+		if err != nil {
+			logger.Warn("Failed to write Server-Sent Event",
+				zap.Int64("chat_id", event.GetChatId()),
+				zap.Error(err),
+			)
+
+			// The client is gone, there is no one to tell.
+			return nil
+		}
+	}
+}
+
+// writeError ends the events with the status of the stream.
+func writeError(rc *http.ResponseController, w io.Writer, err error) error {
+	data, errMarshal := marshaler.Marshal(status.Convert(err).Proto())
+	if errMarshal != nil {
+		return errMarshal
+	}
+
+	return writeEvent(rc, w, "", "error", data)
+}
+
+// writeEvent writes one event and flushes it. The JSON holds no line breaks,
+// data is a single line.
+func writeEvent(rc *http.ResponseController, w io.Writer, id, name string, data []byte) error {
+	_ = rc.SetWriteDeadline(time.Now().Add(writeTimeout))
+
+	var buf bytes.Buffer
+	if len(id) > 0 {
+		fmt.Fprintf(&buf, "id: %s\n", id)
+	}
+
+	if len(name) > 0 {
+		fmt.Fprintf(&buf, "event: %s\n", name)
+	}
+
+	buf.Write(dataPrefix)
+	buf.Write(data)
+	buf.WriteString("\n\n")
+
+	_, err := w.Write(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return rc.Flush()
+}
+
+func connectFromRequest(r *http.Request, pathParams map[string]string) (*desc.ConnectChatRequest, error) {
+	_, err := strconv.ParseInt(pathParams["id"], 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid chat id")
+	}
+
+	query := r.URL.Query()
+
+	lastEventID := r.Header.Get(LastEventIDHeader)
+	if len(lastEventID) == 0 {
+		lastEventID = query.Get(LastEventIDQueryParam)
+	}
+
+	var afterID int64
+	if len(lastEventID) > 0 {
+		afterID, err = strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid last event id")
+		}
+	}
+
+	req := &desc.ConnectChatRequest{
+		ChatId:         pathParams["id"],
+		Username:       query.Get("username"),
+		DeviceId:       query.Get("device_id"),
+		AfterMessageId: afterID,
+	}
+
+	if len(req.GetUsername()) == 0 {
+		return nil, errors.New("username is required")
+	}
+
+	err = req.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
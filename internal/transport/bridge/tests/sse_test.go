@@ -0,0 +1,250 @@
+package tests
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	clientMocks "github.com/Mobo140/chat/internal/client/mocks"
+	"github.com/Mobo140/chat/internal/model"
+	serviceMocks "github.com/Mobo140/chat/internal/service/mocks"
+	"github.com/Mobo140/chat/internal/transport/bridge"
+	chatHandler "github.com/Mobo140/chat/internal/transport/handlers/chat"
+	"github.com/gojuno/minimock/v3"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const chatID int64 = 1
+
+type sseEvent struct {
+	id   string
+	data string
+}
+
+func TestEvents(t *testing.T) {
+	t.Parallel()
+
+	messages := func(ids ...int64) []*model.Message {
+		res := make([]*model.Message, 0, len(ids))
+		for _, id := range ids {
+			res = append(res, &model.Message{ID: id, From: "author", Text: "text"})
+		}
+
+		return res
+	}
+
+	message := func(id string) sseEvent {
+		return sseEvent{
+			id: id,
+			data: `{"chatId":"1","message":{"from":"author","text":"text","id":"` + id + `",` +
+				`"createdAt":"0001-01-01T00:00:00Z"}}`,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		chatID     string
+		query      url.Values
+		header     http.Header
+		mock       func(chatService *serviceMocks.ChatServiceMock)
+		publish    []int64
+		httpStatus int
+		events     []sseEvent
+	}{
+		{
+			name:   "connect case",
+			chatID: "1",
+			query:  url.Values{"username": {"alice"}},
+			header: http.Header{"Authorization": {validToken}},
+			mock: func(chatService *serviceMocks.ChatServiceMock) {
+				chatService.ListUndeliveredMock.Return(messages(1, 2), nil)
+			},
+			publish: []int64{3},
+			events:  []sseEvent{message("1"), message("2"), message("3")},
+		},
+		{
+			name:   "last event id header case",
+			chatID: "1",
+			query:  url.Values{"username": {"alice"}, bridge.TokenQueryParam: {"valid"}},
+			header: http.Header{bridge.LastEventIDHeader: {"2"}},
+			mock: func(chatService *serviceMocks.ChatServiceMock) {
+				chatService.ListAfterMock.Expect(minimock.AnyContext, chatID, 2, 100).Return(messages(3), nil)
+			},
+			events: []sseEvent{message("3")},
+		},
+		{
+			name:   "last event id query case",
+			chatID: "1",
+			query: url.Values{"username": {"alice"}, bridge.TokenQueryParam: {"valid"},
+				bridge.LastEventIDQueryParam: {"3"}},
+			mock: func(chatService *serviceMocks.ChatServiceMock) {
+				chatService.ListAfterMock.Expect(minimock.AnyContext, chatID, 3, 100).Return(nil, nil)
+			},
+			publish: []int64{4},
+			events:  []sseEvent{message("4")},
+		},
+		{
+			name:       "access denied case",
+			chatID:     "1",
+			query:      url.Values{"username": {"alice"}, bridge.TokenQueryParam: {"invalid"}},
+			httpStatus: http.StatusForbidden,
+		},
+		{
+			name:   "chat not found case",
+			chatID: "2",
+			query:  url.Values{"username": {"alice"}, bridge.TokenQueryParam: {"valid"}},
+			mock: func(chatService *serviceMocks.ChatServiceMock) {
+				chatService.GetMock.Expect(minimock.AnyContext, 2).Return(nil, model.ErrChatNotFound)
+			},
+			httpStatus: http.StatusNotFound,
+		},
+		{
+			name:       "no token case",
+			chatID:     "1",
+			query:      url.Values{"username": {"alice"}},
+			httpStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid chat case",
+			chatID:     "chat",
+			query:      url.Values{"username": {"alice"}, bridge.TokenQueryParam: {"valid"}},
+			httpStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "no username case",
+			chatID:     "1",
+			query:      url.Values{bridge.TokenQueryParam: {"valid"}},
+			httpStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid last event id case",
+			chatID:     "1",
+			query:      url.Values{"username": {"alice"}, bridge.TokenQueryParam: {"valid"}},
+			header:     http.Header{bridge.LastEventIDHeader: {"-"}},
+			httpStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			mc := minimock.NewController(t)
+
+			chatService := serviceMocks.NewChatServiceMock(mc)
+			chatService.GetMock.Optional().Return(&model.Chat{
+				ID:   chatID,
+				Info: model.ChatInfo{Usernames: []string{"alice"}},
+			}, nil)
+			chatService.GetDeliveryCursorMock.Optional().Return(&model.DeliveryCursor{}, nil)
+			chatService.OpenSessionMock.Optional().Return(nil)
+			chatService.TouchSessionMock.Optional().Return(nil)
+			chatService.CloseSessionMock.Optional().Return(nil)
+			if tt.mock != nil {
+				tt.mock(chatService)
+			}
+
+			handler := chatHandler.NewImplementation(chatService, nil, nil, checkToken(mc),
+				chatHandler.StreamOptions{})
+
+			mux := runtime.NewServeMux()
+			require.NoError(t, mux.HandlePath(http.MethodGet, bridge.EventsPath,
+				bridge.NewEventsHandler(newChatClient(t, handler))))
+
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+				server.URL+"/chat/v1/"+tt.chatID+"/events?"+tt.query.Encode(), nil)
+			require.NoError(t, err)
+
+			for key, values := range tt.header {
+				req.Header[key] = values
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			if tt.httpStatus != 0 {
+				require.Equal(t, tt.httpStatus, resp.StatusCode)
+
+				return
+			}
+
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+			reader := bufio.NewReader(resp.Body)
+
+			for i, want := range tt.events {
+				if i == len(tt.events)-len(tt.publish) {
+					for _, id := range tt.publish {
+						require.NoError(t, handler.Publish(ctx, &model.ChatEvent{
+							ChatID:  chatID,
+							Type:    model.ChatEventMessageSent,
+							Message: &model.Message{ID: id, From: "author", Text: "text"},
+						}))
+					}
+				}
+
+				got := readEvent(t, reader)
+				require.Equal(t, want.id, got.id)
+				require.JSONEq(t, want.data, got.data)
+			}
+		})
+	}
+}
+
+// readEvent reads the next event of the stream, up to its blank line.
+func readEvent(t *testing.T, reader *bufio.Reader) sseEvent {
+	var event sseEvent
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+
+		line = strings.TrimSuffix(line, "\n")
+		if len(line) == 0 {
+			return event
+		}
+
+		field, value, _ := strings.Cut(line, ": ")
+		switch field {
+		case "id":
+			event.id = value
+		case "data":
+			event.data = value
+		}
+	}
+}
+
+// checkToken grants the valid token only, like the access service. The token
+// is alice's.
+func checkToken(mc *minimock.Controller) *clientMocks.AccessServiceClientMock {
+	mock := clientMocks.NewAccessServiceClientMock(mc)
+	mock.CallerMock.Optional().Return("alice", nil)
+	mock.CheckMock.Optional().Set(func(ctx context.Context, endpoint string) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		if auth := md.Get("authorization"); endpoint != "/chat_v1.ChatV1/ConnectChatEvents" ||
+			len(auth) == 0 || auth[0] != validToken {
+			return status.Error(codes.PermissionDenied, "access denied")
+		}
+
+		return nil
+	})
+
+	return mock
+}
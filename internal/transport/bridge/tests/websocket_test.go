@@ -0,0 +1,238 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/Mobo140/chat/internal/transport/bridge"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+const (
+	validToken    = "Bearer valid"
+	allowedOrigin = "https://chat.example.com"
+)
+
+func TestWebSocket(t *testing.T) {
+	t.Parallel()
+
+	server := newBridgeServer(t)
+
+	tests := []struct {
+		name       string
+		query      url.Values
+		header     http.Header
+		protocols  []string
+		frame      string
+		httpStatus int
+		closeCode  int
+		text       string
+	}{
+		{
+			name:   "header token case",
+			query:  url.Values{"chat_id": {"1"}, "username": {"alice"}},
+			header: http.Header{"Authorization": {validToken}},
+			frame:  `{"message": {"text": "hello"}}`,
+			text:   "hello",
+		},
+		{
+			name:      "protocol token case",
+			query:     url.Values{"chat_id": {"1"}, "username": {"alice"}},
+			header:    http.Header{"Origin": {allowedOrigin}},
+			protocols: []string{bridge.Subprotocol, bridge.TokenProtocolPrefix + "valid"},
+			frame:     `{"message": {"text": "hello"}}`,
+			text:      "hello",
+		},
+		{
+			name:   "same origin case",
+			query:  url.Values{"chat_id": {"1"}, "username": {"alice"}},
+			header: http.Header{"Authorization": {validToken}, "Origin": {server.URL}},
+			frame:  `{"message": {"text": "hello"}}`,
+			text:   "hello",
+		},
+		{
+			name:       "query token case",
+			query:      url.Values{"chat_id": {"1"}, "username": {"alice"}, bridge.TokenQueryParam: {"valid"}},
+			httpStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "no token case",
+			query:      url.Values{"chat_id": {"1"}, "username": {"alice"}},
+			httpStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "forbidden origin case",
+			query:      url.Values{"chat_id": {"1"}, "username": {"alice"}},
+			header:     http.Header{"Origin": {"https://evil.example.com"}},
+			protocols:  []string{bridge.Subprotocol, bridge.TokenProtocolPrefix + "valid"},
+			httpStatus: http.StatusForbidden,
+		},
+		{
+			name:       "invalid chat case",
+			query:      url.Values{"chat_id": {"chat"}, "username": {"alice"}},
+			header:     http.Header{"Authorization": {validToken}},
+			httpStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "access denied case",
+			query:     url.Values{"chat_id": {"1"}, "username": {"alice"}},
+			protocols: []string{bridge.Subprotocol, bridge.TokenProtocolPrefix + "invalid"},
+			closeCode: websocket.ClosePolicyViolation,
+		},
+		{
+			name:      "invalid frame case",
+			query:     url.Values{"chat_id": {"1"}, "username": {"alice"}},
+			header:    http.Header{"Authorization": {validToken}},
+			frame:     `{"message": `,
+			closeCode: websocket.CloseInvalidFramePayloadData,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + bridge.WebSocketPath + "?" + tt.query.Encode()
+
+			dialer := websocket.Dialer{Subprotocols: tt.protocols}
+
+			conn, resp, err := dialer.Dial(wsURL, tt.header)
+			if resp != nil && resp.Body != nil {
+				defer resp.Body.Close()
+			}
+
+			if tt.httpStatus != 0 {
+				require.Error(t, err)
+				require.Equal(t, tt.httpStatus, resp.StatusCode)
+
+				return
+			}
+
+			require.NoError(t, err)
+			defer conn.Close()
+
+			if len(tt.protocols) > 0 {
+				require.Equal(t, bridge.Subprotocol, conn.Subprotocol())
+			}
+
+			if len(tt.frame) > 0 {
+				require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(tt.frame)))
+			}
+
+			_, data, err := conn.ReadMessage()
+			if tt.closeCode != 0 {
+				var closeErr *websocket.CloseError
+				require.ErrorAs(t, err, &closeErr)
+				require.Equal(t, tt.closeCode, closeErr.Code)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			event := &desc.ChatEvent{}
+			require.NoError(t, protojson.Unmarshal(data, event))
+			require.Equal(t, int64(1), event.GetChatId())
+			require.Equal(t, "alice", event.GetMessage().GetFrom())
+			require.Equal(t, tt.text, event.GetMessage().GetText())
+
+			// The client closing the socket ends the stream.
+			require.NoError(t, conn.WriteMessage(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")))
+
+			_, _, err = conn.ReadMessage()
+			require.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure))
+		})
+	}
+}
+
+// newBridgeServer serves the bridge to an in-memory chat server echoing the
+// messages of the stream.
+func newBridgeServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(bridge.NewWebSocketHandler(newChatClient(t, &echoServer{}), []string{allowedOrigin}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// newChatClient connects to the chat server served in memory.
+func newChatClient(t *testing.T, chatServer desc.ChatV1Server) desc.ChatV1Client {
+	listener := bufconn.Listen(1 << 20)
+
+	grpcServer := grpc.NewServer()
+	desc.RegisterChatV1Server(grpcServer, chatServer)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return desc.NewChatV1Client(conn)
+}
+
+type echoServer struct {
+	desc.UnimplementedChatV1Server
+}
+
+func (s *echoServer) Chat(stream desc.ChatV1_ChatServer) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	if auth := md.Get("authorization"); len(auth) == 0 || auth[0] != validToken {
+		return status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	join := req.GetJoin()
+
+	for {
+		req, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		message := req.GetMessage()
+		message.From = join.GetUsername()
+
+		err = stream.Send(&desc.ChatEvent{
+			ChatId:  join.GetChatId(),
+			Payload: &desc.ChatEvent_Message{Message: message},
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
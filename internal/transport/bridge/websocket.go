@@ -0,0 +1,344 @@
+package bridge
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+const (
+	// WebSocketPath is the route of the chat WebSocket on the gateway mux.
+	WebSocketPath = "/chat/v1/ws"
+
+	// TokenQueryParam carries the access token of the browsers on the
+	// events, an EventSource can't set the Authorization header.
+	TokenQueryParam = "access_token"
+
+	// Subprotocol is the protocol of the chat WebSocket, the browsers offer
+	// it with the TokenProtocolPrefix one: they can't set the Authorization
+	// header of a WebSocket, and a token of the URL would end in the logs.
+	Subprotocol = "chat.v1"
+	// TokenProtocolPrefix is followed by the access token in the offered
+	// protocols of the WebSocket.
+	TokenProtocolPrefix = "access_token."
+
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+
+	maxFrameSize = 64 << 10
+	writeTimeout = 10 * time.Second
+
+	// maxCloseReason is the longest reason a close frame holds.
+	maxCloseReason = 123
+)
+
+var (
+	marshaler   = protojson.MarshalOptions{}
+	unmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+type webSocketHandler struct {
+	client   desc.ChatV1Client
+	origins  map[string]struct{}
+	upgrader websocket.Upgrader
+}
+
+// NewWebSocketHandler bridges a WebSocket to the Chat stream of the client.
+// The query joins the chat: chat_id and device_id, as the user of the access
+// token, username must be that user if set. Every text frame of the socket is
+// a ChatRequest in JSON, every ChatEvent of the stream is written as one. The
+// stream status closes the socket. The browsers may open it from the origins
+// only, or from the host of the bridge.
+func NewWebSocketHandler(client desc.ChatV1Client, origins []string) http.Handler {
+	h := &webSocketHandler{
+		client:  client,
+		origins: make(map[string]struct{}, len(origins)),
+	}
+
+	for _, origin := range origins {
+		h.origins[strings.ToLower(origin)] = struct{}{}
+	}
+
+	h.upgrader = websocket.Upgrader{
+		Subprotocols: []string{Subprotocol},
+		CheckOrigin:  h.checkOrigin,
+	}
+
+	return h
+}
+
+func (h *webSocketHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	join, err := joinFromQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	token := webSocketToken(r)
+	if len(token) == 0 {
+		http.Error(w, "access token not found", http.StatusUnauthorized)
+
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade replied with the error already.
+		logger.Warn("Failed to upgrade to WebSocket", zap.Error(err))
+
+		return
+	}
+	defer conn.Close()
+
+	conn.SetReadLimit(maxFrameSize)
+
+	sock := &socket{conn: conn}
+
+	ctx, cancel := context.WithCancel(metadata.AppendToOutgoingContext(context.Background(),
+		authorizationHeader, token))
+	defer cancel()
+
+	stream, err := h.client.Chat(ctx)
+	if err != nil {
+		sock.close(err)
+
+		return
+	}
+
+	err = stream.Send(&desc.ChatRequest{Payload: &desc.ChatRequest_Join{Join: join}})
+	if err != nil {
+		sock.close(recvError(stream, err))
+
+		return
+	}
+
+	logger.Info("WebSocket joined chat",
+		zap.Int64("chat_id", join.GetChatId()),
+		zap.String("username", join.GetUsername()),
+	)
+
+	go forward(sock, stream, cancel)
+
+	sock.close(relay(sock, stream))
+}
+
+// forward sends the frames of the socket to the stream until the socket is
+// closed, then it closes the sending side of the stream.
+func forward(sock *socket, stream desc.ChatV1_ChatClient, cancel context.CancelFunc) {
+	for {
+		messageType, data, err := sock.conn.ReadMessage()
+		if err != nil {
+			_ = stream.CloseSend()
+
+			return
+		}
+
+		if messageType != websocket.TextMessage {
+			continue
+		}
+
+		req := &desc.ChatRequest{}
+
+		err = unmarshaler.Unmarshal(data, req)
+		if err != nil {
+			sock.close(status.Errorf(codes.InvalidArgument, "invalid frame: %v", err))
+			cancel()
+
+			return
+		}
+
+		err = stream.Send(req)
+		if err != nil {
+			// The stream is over, relay gets its status.
+			return
+		}
+	}
+}
+
+// relay writes the events of the stream to the socket until the stream ends,
+// it returns the status of the stream, nil if it ended well.
+func relay(sock *socket, stream desc.ChatV1_ChatClient) error {
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		err = sock.write(event)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// recvError returns the status of a stream that failed a send, the send
+// error only tells the stream is over.
+func recvError(stream desc.ChatV1_ChatClient, err error) error {
+	if !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	_, err = stream.Recv()
+
+	return err
+}
+
+// socket serializes the writes to the connection, the close frame is written
+// once.
+type socket struct {
+	conn *websocket.Conn
+
+	mu     sync.Mutex
+	closed bool
+}
+
+func (s *socket) write(event *desc.ChatEvent) error {
+	data, err := marshaler.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	err = s.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err != nil {
+		return err
+	}
+
+	return s.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+// close writes the close frame of the stream status err.
+func (s *socket) close(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+
+	code, reason := closeCode(err)
+	if len(reason) > maxCloseReason {
+		reason = reason[:maxCloseReason]
+	}
+
+	_ = s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason),
+		time.Now().Add(writeTimeout))
+}
+
+// closeCode maps the stream status to the close code of the socket.
+func closeCode(err error) (int, string) {
+	if err == nil {
+		return websocket.CloseNormalClosure, ""
+	}
+
+	st := status.Convert(err)
+
+	switch st.Code() {
+	case codes.Unauthenticated, codes.PermissionDenied, codes.NotFound, codes.FailedPrecondition:
+		return websocket.ClosePolicyViolation, st.Message()
+	case codes.InvalidArgument:
+		return websocket.CloseInvalidFramePayloadData, st.Message()
+	case codes.ResourceExhausted, codes.Unavailable, codes.Aborted:
+		return websocket.CloseTryAgainLater, st.Message()
+	default:
+		return websocket.CloseInternalServerErr, st.Message()
+	}
+}
+
+func joinFromQuery(r *http.Request) (*desc.JoinChat, error) {
+	query := r.URL.Query()
+
+	chatID, err := strconv.ParseInt(query.Get("chat_id"), 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid chat_id")
+	}
+
+	join := &desc.JoinChat{
+		ChatId:   chatID,
+		Username: query.Get("username"),
+		DeviceId: query.Get("device_id"),
+	}
+
+	err = join.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return join, nil
+}
+
+// checkOrigin allows the clients without an origin, they are not browsers,
+// the allowed origins and the host of the bridge.
+func (h *webSocketHandler) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if len(origin) == 0 {
+		return true
+	}
+
+	if _, ok := h.origins[strings.ToLower(origin)]; ok {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+
+	return err == nil && strings.EqualFold(u.Host, r.Host)
+}
+
+// webSocketToken returns the Authorization header, or the token of the
+// offered protocols as a bearer one.
+func webSocketToken(r *http.Request) string {
+	if header := r.Header.Get(authorizationHeader); len(header) > 0 {
+		return header
+	}
+
+	for _, protocol := range websocket.Subprotocols(r) {
+		if token, ok := strings.CutPrefix(protocol, TokenProtocolPrefix); ok && len(token) > 0 {
+			return bearerPrefix + token
+		}
+	}
+
+	return ""
+}
+
+// accessToken returns the Authorization header, or the token of the query
+// as a bearer one.
+func accessToken(r *http.Request) string {
+	if header := r.Header.Get(authorizationHeader); len(header) > 0 {
+		return header
+	}
+
+	token := r.URL.Query().Get(TokenQueryParam)
+	if len(token) == 0 || strings.HasPrefix(token, bearerPrefix) {
+		return token
+	}
+
+	return bearerPrefix + token
+}
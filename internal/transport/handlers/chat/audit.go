@@ -0,0 +1,55 @@
+package chat
+
+import (
+	"context"
+
+	conv "github.com/Mobo140/chat/internal/converter"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+// ListAuditLog is for the support staff: the access check lets only admins in.
+func (i *Implementation) ListAuditLog(ctx context.Context, req *desc.ListAuditLogRequest) (*desc.ListAuditLogResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListAuditLog")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/ListAuditLog")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	filter := conv.ToLogFilterFromDesc(req)
+	if filter.Action != "" && !filter.Action.Valid() {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown action %q", req.GetAction())
+	}
+
+	entries, err := i.chatAPIService.ListAuditLog(ctx, filter)
+	if err != nil {
+		logger.Error("Failed to list audit log", zap.Int64("chat_id", req.GetChatId()), zap.Error(err))
+
+		return nil, err
+	}
+
+	logger.Info("List audit log: ", zap.Int64("chat_id", req.GetChatId()), zap.Int("count", len(entries)))
+
+	res, err := conv.ToAuditLogEntriesFromService(entries)
+	if err != nil {
+		logger.Error("Failed to convert audit log entries", zap.Error(err))
+
+		return nil, status.Error(codes.Internal, "failed to convert audit log entries")
+	}
+
+	return &desc.ListAuditLogResponse{
+		Entries: res,
+	}, nil
+}
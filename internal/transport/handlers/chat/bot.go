@@ -0,0 +1,116 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+func (i *Implementation) CreateBot(ctx context.Context, req *desc.CreateBotRequest) (*desc.CreateBotResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CreateBot")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/CreateBot")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	bot := &model.Bot{
+		Name:      req.GetName(),
+		ChatIDs:   req.GetChatIds(),
+		RateLimit: int(req.GetRateLimit()),
+	}
+
+	key, err := i.botService.Create(ctx, bot)
+	if err != nil {
+		logger.Error("Failed to create bot", zap.String("name", req.GetName()), zap.Error(err))
+
+		return nil, botStatus(err)
+	}
+
+	logger.Info("Create bot: ", zap.Int64("id", bot.ID), zap.String("name", bot.Name))
+
+	return &desc.CreateBotResponse{
+		Id:     bot.ID,
+		ApiKey: key,
+	}, nil
+}
+
+func (i *Implementation) RotateBotKey(ctx context.Context, req *desc.RotateBotKeyRequest) (*desc.RotateBotKeyResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RotateBotKey")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/RotateBotKey")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	key, err := i.botService.RotateKey(ctx, req.GetBotId())
+	if err != nil {
+		logger.Error("Failed to rotate bot key", zap.Int64("bot_id", req.GetBotId()), zap.Error(err))
+
+		return nil, botStatus(err)
+	}
+
+	logger.Info("Rotate bot key: ", zap.Int64("bot_id", req.GetBotId()))
+
+	return &desc.RotateBotKeyResponse{
+		ApiKey: key,
+	}, nil
+}
+
+func (i *Implementation) RevokeBotKeys(ctx context.Context, req *desc.RevokeBotKeysRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RevokeBotKeys")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/RevokeBotKeys")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	err = i.botService.RevokeKeys(ctx, req.GetBotId())
+	if err != nil {
+		logger.Error("Failed to revoke bot keys", zap.Int64("bot_id", req.GetBotId()), zap.Error(err))
+
+		return nil, botStatus(err)
+	}
+
+	logger.Info("Revoke bot keys: ", zap.Int64("bot_id", req.GetBotId()))
+
+	return &emptypb.Empty{}, nil
+}
+
+func botStatus(err error) error {
+	switch {
+	case errors.Is(err, model.ErrBotNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrBotExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	default:
+		return err
+	}
+}
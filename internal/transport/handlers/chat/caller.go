@@ -0,0 +1,27 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// caller returns the username of the authenticated caller. The username a
+// request names, still sent by the older clients, has to be the caller's.
+func (i *Implementation) caller(ctx context.Context, requested string) (string, error) {
+	username, err := i.accessServiceClient.Caller(ctx)
+	if err != nil {
+		logger.Error("Failed to get the caller", zap.Error(err))
+
+		return "", err
+	}
+
+	if len(requested) > 0 && requested != username {
+		return "", status.Error(codes.PermissionDenied, "the username is not the caller's")
+	}
+
+	return username, nil
+}
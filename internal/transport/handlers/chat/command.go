@@ -0,0 +1,96 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+// runCommand runs the slash command of the message instead of sending it.
+func (i *Implementation) runCommand(ctx context.Context, chatID int64, msg *desc.Message) (*desc.CommandResponse, error) {
+	logger.Info("Running command...",
+		zap.Int64("chat_id", chatID),
+		zap.String("username", msg.GetFrom()),
+		zap.String("text", msg.GetText()),
+	)
+
+	call := &model.CommandCall{
+		ChatID: chatID,
+		Caller: msg.GetFrom(),
+		Text:   msg.GetText(),
+	}
+
+	reply, err := i.chatAPIService.RunCommand(ctx, call)
+	if err != nil {
+		logger.Error("Failed to run command",
+			zap.Int64("chat_id", chatID),
+			zap.String("text", msg.GetText()),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	logger.Info("Command run: ", zap.Int64("chat_id", chatID), zap.String("command", call.Name))
+
+	return &desc.CommandResponse{
+		Command: call.Name,
+		Text:    reply,
+	}, nil
+}
+
+// commandFailure is the reply to a failed command on a stream. The caller
+// sees why a command was refused, the internal errors are only logged.
+func commandFailure(text string, err error) *desc.CommandResponse {
+	res := &desc.CommandResponse{
+		Command: commandName(text),
+		Text:    "command failed",
+		Failed:  true,
+	}
+
+	if userCommandError(err) {
+		res.Text = err.Error()
+	}
+
+	return res
+}
+
+func commandStatus(err error) error {
+	switch {
+	case errors.Is(err, model.ErrUnknownCommand), errors.Is(err, model.ErrInvalidCommand):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, model.ErrChatNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrChatArchived):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
+
+func userCommandError(err error) bool {
+	return errors.Is(err, model.ErrUnknownCommand) ||
+		errors.Is(err, model.ErrInvalidCommand) ||
+		errors.Is(err, model.ErrPermissionDenied) ||
+		errors.Is(err, model.ErrChatNotFound) ||
+		errors.Is(err, model.ErrChatArchived)
+}
+
+func commandName(text string) string {
+	fields := strings.Fields(strings.TrimPrefix(text, "/"))
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return strings.ToLower(fields[0])
+}
@@ -0,0 +1,260 @@
+package chat
+
+import (
+	"context"
+	"strconv"
+
+	conv "github.com/Mobo140/chat/internal/converter"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+const redeliveryBatchSize = 100
+
+func (i *Implementation) AckMessages(ctx context.Context, req *desc.AckMessagesRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "AckMessages")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/AckMessages")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	err = i.ackDelivery(ctx, req.GetChatId(), "", &desc.AckEvent{
+		Username:      req.GetUsername(),
+		LastMessageId: req.GetLastMessageId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) MarkRead(ctx context.Context, req *desc.MarkReadRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MarkRead")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/MarkRead")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	err = i.markRead(ctx, req.GetChatId(), &desc.ReadEvent{
+		Username:      req.GetUsername(),
+		LastMessageId: req.GetLastMessageId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) GetDeliveryStatus(
+	ctx context.Context,
+	req *desc.GetDeliveryStatusRequest,
+) (*desc.GetDeliveryStatusResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GetDeliveryStatus")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/GetDeliveryStatus")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	members, err := i.chatAPIService.GetDeliveryStatus(ctx, req.GetChatId(), req.GetMessageId())
+	if err != nil {
+		logger.Error("Failed to get delivery status",
+			zap.Int64("chat_id", req.GetChatId()),
+			zap.Int64("message_id", req.GetMessageId()),
+			zap.Error(err),
+		)
+
+		return nil, messageStatus(err)
+	}
+
+	return &desc.GetDeliveryStatusResponse{
+		Members: conv.ToMemberDeliveriesFromService(members),
+	}, nil
+}
+
+// ackDelivery moves the delivered cursor of the user and tells the streams of
+// the chat but the session the ack comes from, the senders see their messages
+// delivered.
+func (i *Implementation) ackDelivery(
+	ctx context.Context,
+	chatID int64,
+	fromSessionID string,
+	ack *desc.AckEvent,
+) error {
+	err := i.chatAPIService.AckDelivery(ctx, &model.DeliveryAck{
+		ChatID:    chatID,
+		Username:  ack.GetUsername(),
+		MessageID: ack.GetLastMessageId(),
+	})
+	if err != nil {
+		logger.Error("Failed to ack delivery",
+			zap.Int64("chat_id", chatID),
+			zap.String("username", ack.GetUsername()),
+			zap.Int64("last_message_id", ack.GetLastMessageId()),
+			zap.Error(err),
+		)
+
+		return messageStatus(err)
+	}
+
+	i.publish(ctx, strconv.FormatInt(chatID, 10), fromSessionID, &desc.ChatEvent{
+		ChatId:  chatID,
+		Payload: &desc.ChatEvent_Ack{Ack: ack},
+	})
+
+	return nil
+}
+
+// markRead moves the read cursor of the user. The outbox tells the streams
+// of the chat on every server, the other devices of the user and the session
+// the read comes from too.
+func (i *Implementation) markRead(ctx context.Context, chatID int64, read *desc.ReadEvent) error {
+	err := i.chatAPIService.MarkRead(ctx, &model.DeliveryAck{
+		ChatID:    chatID,
+		Username:  read.GetUsername(),
+		MessageID: read.GetLastMessageId(),
+	})
+	if err != nil {
+		logger.Error("Failed to mark messages read",
+			zap.Int64("chat_id", chatID),
+			zap.String("username", read.GetUsername()),
+			zap.Int64("last_message_id", read.GetLastMessageId()),
+			zap.Error(err),
+		)
+
+		return messageStatus(err)
+	}
+
+	return nil
+}
+
+// redeliver sends the stream the messages its user did not acknowledge, or
+// the ones after the message the session resumes from, from the oldest, and
+// returns the last one: the queued messages up to it are sent already. The
+// messages of the user are sent too, they may come from another device.
+func (i *Implementation) redeliver(ctx context.Context, chatID string, sub *subscriber) (int64, error) {
+	username := sub.session.username
+
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	lastID := sub.session.resumeAfterID
+	for {
+		var messages []*model.Message
+		if sub.session.resumeAfterID > 0 {
+			messages, err = i.chatAPIService.ListAfter(ctx, id, lastID, redeliveryBatchSize)
+		} else {
+			messages, err = i.chatAPIService.ListUndelivered(ctx, &model.UndeliveredPage{
+				ChatID:   id,
+				Username: username,
+				AfterID:  lastID,
+				Limit:    redeliveryBatchSize,
+			})
+		}
+
+		if err != nil {
+			logger.Error("Failed to list undelivered messages",
+				zap.String("chat_id", chatID),
+				zap.String("username", username),
+				zap.Error(err),
+			)
+
+			return 0, err
+		}
+
+		for _, message := range messages {
+			lastID = message.ID
+
+			if message.DeletedAt != nil {
+				continue
+			}
+
+			event := &desc.ChatEvent{
+				ChatId:  id,
+				Payload: &desc.ChatEvent_Message{Message: conv.ToMessageFromService(message)},
+			}
+
+			err = sub.stream.Send(event)
+			if err != nil {
+				return 0, err
+			}
+
+			sub.sent(event)
+		}
+
+		if len(messages) < redeliveryBatchSize {
+			return lastID, nil
+		}
+	}
+}
+
+// syncRead sends the stream the read state of its user, shared by the devices.
+func (i *Implementation) syncRead(ctx context.Context, chatID string, sub *subscriber) error {
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := i.chatAPIService.GetDeliveryCursor(ctx, id, sub.session.username)
+	if err != nil {
+		logger.Error("Failed to get read state",
+			zap.String("chat_id", chatID),
+			zap.String("username", sub.session.username),
+			zap.Error(err),
+		)
+
+		return err
+	}
+
+	if cursor.ReadMessageID == 0 {
+		return nil
+	}
+
+	event := &desc.ChatEvent{
+		ChatId: id,
+		Payload: &desc.ChatEvent_Read{
+			Read: &desc.ReadEvent{
+				Username:      sub.session.username,
+				LastMessageId: cursor.ReadMessageID,
+			},
+		},
+	}
+
+	err = sub.stream.Send(event)
+	if err != nil {
+		return err
+	}
+
+	sub.sent(event)
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package chat
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+
+	"github.com/Mobo140/chat/internal/export"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+const (
+	// ContentDispositionHeader names the downloaded file, the gateway passes
+	// it on as the HTTP header.
+	ContentDispositionHeader = "content-disposition"
+
+	// exportChunkSize is the size of the file chunks sent on the stream.
+	exportChunkSize = 32 << 10
+)
+
+// ExportChat streams the chat history as a file, chunk by chunk as it is read.
+func (i *Implementation) ExportChat(req *desc.ExportChatRequest, stream desc.ChatV1_ExportChatServer) error {
+	span, ctx := opentracing.StartSpanFromContext(stream.Context(), "ExportChat")
+	defer span.Finish()
+
+	err := req.Validate()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	logger.Info("Checking the access token...")
+
+	err = i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/ExportChat")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return err
+	}
+	logger.Info("Access granted")
+
+	format := model.ExportFormat(req.GetFormat())
+	if format == "" {
+		format = model.ExportFormatJSONL
+	}
+
+	err = stream.SetHeader(metadata.Pairs(ContentDispositionHeader,
+		fmt.Sprintf("attachment; filename=%q", export.FileName(req.GetChatId(), format))))
+	if err != nil {
+		return err
+	}
+
+	body := bufio.NewWriterSize(&bodyWriter{stream: stream, contentType: export.ContentType(format)}, exportChunkSize)
+
+	encoder, err := export.NewEncoder(format, body)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = i.chatAPIService.ExportChat(ctx, req.GetChatId(), encoder.Encode)
+	if err == nil {
+		err = encoder.Close()
+	}
+
+	if err == nil {
+		err = body.Flush()
+	}
+
+	if err != nil {
+		logger.Error("Failed to export chat", zap.Int64("chat_id", req.GetChatId()), zap.Error(err))
+
+		if errors.Is(err, model.ErrChatNotFound) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+
+		return err
+	}
+
+	logger.Info("Export chat: ", zap.Int64("chat_id", req.GetChatId()), zap.String("format", string(format)))
+
+	return nil
+}
+
+// bodyWriter sends every write as a chunk of the file.
+type bodyWriter struct {
+	stream      desc.ChatV1_ExportChatServer
+	contentType string
+}
+
+func (w *bodyWriter) Write(p []byte) (int, error) {
+	err := w.stream.Send(&httpbody.HttpBody{
+		ContentType: w.contentType,
+		Data:        append([]byte(nil), p...),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
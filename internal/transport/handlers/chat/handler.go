@@ -2,9 +2,11 @@ package chat
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	cl "github.com/Mobo140/chat/internal/client"
 	conv "github.com/Mobo140/chat/internal/converter"
@@ -13,31 +15,46 @@ import (
 	"github.com/Mobo140/platform_common/pkg/logger"
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	desc "github.com/Mobo140/chat/pkg/chat_v1"
 )
 
+var _ service.EventPublisher = (*Implementation)(nil)
+
+const publishTimeout = time.Second
+
 type Implementation struct {
 	desc.UnimplementedChatV1Server
 	chatAPIService      service.ChatService
+	webhookService      service.WebhookService
+	botService          service.BotService
 	accessServiceClient cl.AccessServiceClient
 
 	chats  map[string]*Chat
 	mxChat sync.RWMutex
 
-	channels  map[string]chan *desc.Message
-	mxChannel sync.RWMutex
+	streams StreamOptions
 }
 
-func NewImplementation(chatService service.ChatService, accessServiceClient cl.AccessServiceClient) *Implementation {
+func NewImplementation(
+	chatService service.ChatService,
+	webhookService service.WebhookService,
+	botService service.BotService,
+	accessServiceClient cl.AccessServiceClient,
+	streams StreamOptions,
+) *Implementation {
 	return &Implementation{
 		chatAPIService:      chatService,
+		webhookService:      webhookService,
+		botService:          botService,
 		accessServiceClient: accessServiceClient,
 		chats:               make(map[string]*Chat),
-		channels:            make(map[string]chan *desc.Message),
+		streams:             streams.withDefaults(),
 	}
 }
 
@@ -47,7 +64,7 @@ func (i *Implementation) Create(ctx context.Context, req *desc.CreateRequest) (*
 
 	logger.Info("Creating chat...", zap.Any("info", req.GetInfo()))
 
-	info, err := conv.ToChatInfoFromDesc(req.Info)
+	info, err := conv.ToChatInfoFromDesc(req.GetInfo())
 	if err != nil {
 		logger.Error("Failed to convert to chat info from desc", zap.Error(err))
 
@@ -61,8 +78,6 @@ func (i *Implementation) Create(ctx context.Context, req *desc.CreateRequest) (*
 		return nil, err
 	}
 
-	i.channels[strconv.FormatInt(id, 10)] = make(chan *desc.Message, 100)
-
 	logger.Info("Create chat: ", zap.Int64("id", id))
 
 	return &desc.CreateResponse{Id: id}, nil
@@ -100,130 +115,364 @@ func (i *Implementation) Delete(ctx context.Context, req *desc.DeleteRequest) (*
 	if err != nil {
 		logger.Error("Failed to delete chat by id", zap.Int64("id", req.GetId()), zap.Error(err))
 
-		return nil, err
+		return nil, chatStatus(err)
 	}
 
+	// The streams on the other instances are closed by the chat_deleted event.
+	i.closeChat(strconv.FormatInt(req.GetId(), 10), chatStatus(model.ErrChatNotFound))
+
 	logger.Info("Delete chat: ", zap.Int64("id", req.GetId()))
 
 	return &emptypb.Empty{}, nil
 }
 
-func (i *Implementation) ConnectChat(req *desc.ConnectChatRequest, stream desc.ChatV1_ConnectChatServer) (err error) {
-	span, _ := opentracing.StartSpanFromContext(stream.Context(), "ConnectChat")
+// ConnectChat streams the messages of the chat only, the clients of the other
+// events connect with ConnectChatEvents.
+func (i *Implementation) ConnectChat(req *desc.ConnectChatRequest, stream desc.ChatV1_ConnectChatServer) error {
+	return i.connect(req, stream, messageStream{stream: stream}, "ConnectChat")
+}
+
+func (i *Implementation) ConnectChatEvents(
+	req *desc.ConnectChatRequest,
+	stream desc.ChatV1_ConnectChatEventsServer,
+) error {
+	return i.connect(req, stream, stream, "ConnectChatEvents")
+}
+
+// connect is ConnectChat or ConnectChatEvents, named by method: events are
+// sent to the stream after the headers of the server stream.
+func (i *Implementation) connect(
+	req *desc.ConnectChatRequest,
+	serverStream grpc.ServerStream,
+	stream eventStream,
+	method string,
+) (err error) {
+	span, ctx := opentracing.StartSpanFromContext(serverStream.Context(), method)
 	defer span.Finish()
 
+	logger.Info("Checking the access token...")
+
+	err = i.accessServiceClient.Check(forwardMetadata(ctx), "/chat_v1.ChatV1/"+method)
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return err
+	}
+	logger.Info("Access granted")
+
 	logger.Info("Attempting to connect to chat...",
 		zap.String("chat_id", req.GetChatId()),
 		zap.String("username", req.GetUsername()))
 
-	i.mxChannel.RLock()
-	chatChan, ok := i.channels[req.GetChatId()]
-	i.mxChannel.RUnlock()
+	err = req.Validate()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	if !ok {
-		chatID, err := strconv.ParseInt(req.GetChatId(), 10, 64)
-		if err != nil {
-			return err
-		}
+	req.Username, err = i.caller(ctx, req.GetUsername())
+	if err != nil {
+		return err
+	}
 
-		chat, err := i.chatAPIService.Get(stream.Context(), chatID)
-		if err != nil {
-			logger.Error("Failed to get to chat by id", zap.Int64("id", chatID), zap.Error(err))
+	chatID, err := strconv.ParseInt(req.GetChatId(), 10, 64)
+	if err != nil {
+		return err
+	}
 
-			return err
-		}
+	// The chat is read even if it has streams here, only its members connect.
+	chat, err := i.chatAPIService.Get(ctx, chatID)
+	if err != nil {
+		logger.Error("Failed to get to chat by id", zap.Int64("id", chatID), zap.Error(err))
 
-		if chat == nil {
-			logger.Error("Chat not found", zap.String("chat_id", req.GetChatId()))
+		return chatStatus(err)
+	}
 
-			return err
-		}
+	if chat == nil {
+		logger.Error("Chat not found", zap.String("chat_id", req.GetChatId()))
 
-		i.channels[req.GetChatId()] = make(chan *desc.Message, 100)
+		return chatStatus(model.ErrChatNotFound)
 	}
 
-	i.mxChat.Lock()
-	if _, okChat := i.chats[req.GetChatId()]; !okChat {
-		logger.Info("Creating new chat instance", zap.String("chat_id", req.GetChatId()))
-		i.chats[req.GetChatId()] = NewChat()
+	if chat.ArchivedAt != nil {
+		return chatStatus(model.ErrChatArchived)
 	}
-	i.mxChat.Unlock()
 
-	i.chats[req.GetChatId()].m.Lock()
-	i.chats[req.GetChatId()].streams[req.GetUsername()] = stream
-	i.chats[req.GetChatId()].m.Unlock()
+	if chat.Role(req.GetUsername()) == model.ChatRoleNone {
+		return messageStatus(model.ErrPermissionDenied)
+	}
+
+	// The headers tell the client the stream is accepted before the first
+	// event, a quiet chat may have none for a while.
+	err = serverStream.SendHeader(metadata.MD{})
+	if err != nil {
+		return err
+	}
+
+	sess := newSession(req.GetUsername(), req.GetDeviceId())
+	sess.resumeAfterID = req.GetAfterMessageId()
+
+	return i.listen(ctx, req.GetChatId(), sess, stream)
+}
+
+// listen registers the stream in the chat and sends it the events of its
+// queue until the context is done or the stream is closed. The messages the
+// user did not acknowledge and the read state of the user come first, a quiet
+// stream gets heartbeats.
+func (i *Implementation) listen(ctx context.Context, chatID string, sess session, stream eventStream) error {
+	chat, sub := i.join(chatID, sess, stream)
+	defer sub.close(nil)
+	defer i.leave(chatID, chat, sub)
+
+	i.openSession(ctx, chatID, sub)
+	defer i.closeSession(ctx, chatID, sub)
+
+	username := sess.username
 
 	logger.Info("Successfully connected to chat",
-		zap.String("chat_id", req.GetChatId()),
-		zap.String("username", req.GetUsername()))
+		zap.String("chat_id", chatID),
+		zap.String("username", username),
+		zap.String("session_id", sess.id))
+
+	// The messages sent meanwhile are both redelivered and queued.
+	redeliveredID, err := i.redeliver(ctx, chatID, sub)
+	if err != nil {
+		return err
+	}
+
+	err = i.syncRead(ctx, chatID, sub)
+	if err != nil {
+		return err
+	}
+
+	go i.watch(ctx, chatID, chat, sub)
+
+	heartbeat := time.NewTicker(i.streams.HeartbeatInterval)
+	defer heartbeat.Stop()
 
 	for {
+		// A closed stream gets no more events, even if some are queued.
+		select {
+		case <-sub.done:
+			return sub.err
+		default:
+		}
+
 		select {
-		case msg, okCh := <-chatChan:
-			if !okCh {
-				logger.Info("Chat channel closed",
-					zap.String("chat_id", req.GetChatId()),
-					zap.String("username", req.GetUsername()),
-					zap.Any("message", msg),
+		case event := <-sub.queue:
+			if message := event.GetMessage(); message != nil && message.GetId() <= redeliveredID {
+				continue
+			}
+
+			err := stream.Send(event)
+			if err != nil {
+				logger.Error("Failed to send event to stream",
+					zap.String("chat_id", chatID),
+					zap.String("to_username", username),
+					zap.Error(err),
+					zap.Any("event", event),
 				)
 
-				return nil
+				return err
 			}
 
-			logger.Info("Received message in chat",
-				zap.String("chat_id", req.GetChatId()),
-				zap.Any("message", msg),
-			)
+			sub.sent(event)
+			heartbeat.Reset(i.streams.HeartbeatInterval)
 
-			for username, st := range i.chats[req.GetChatId()].streams {
-				if username == req.GetUsername() {
-					continue
-				}
+		case <-heartbeat.C:
+			event := heartbeatEvent(chatID, i.streams.HeartbeatInterval)
 
-				logger.Info("Attempting to send message to user",
+			err := stream.Send(event)
+			if err != nil {
+				logger.Error("Failed to send heartbeat to stream",
+					zap.String("chat_id", chatID),
 					zap.String("to_username", username),
-					zap.String("from_username", req.GetUsername()),
-					zap.Any("message", msg),
+					zap.Error(err),
 				)
 
-				if err := st.Send(msg); err != nil {
-					logger.Error("Failed to send message to stream",
-						zap.String("to_username", username),
-						zap.String("from_username", req.GetUsername()),
-						zap.Error(err),
-						zap.Any("message", msg),
-					)
-					return err
-				}
-
-				logger.Info("Successfully sent message to user",
-					zap.String("to_username", username),
-					zap.String("from_username", req.GetUsername()),
-					zap.Any("message", msg),
-				)
+				return err
 			}
 
-		case <-stream.Context().Done():
-			logger.Info("Disconnecting from chat",
-				zap.String("chat_id", req.GetChatId()),
-				zap.String("username", req.GetUsername()),
+			sub.sent(event)
+
+		case <-sub.done:
+			logger.Info("Stream closed",
+				zap.String("chat_id", chatID),
+				zap.String("username", username),
+				zap.Error(sub.err),
 			)
 
-			i.chats[req.GetChatId()].m.Lock()
-			delete(i.chats[req.GetChatId()].streams, req.GetUsername())
-			i.chats[req.GetChatId()].m.Unlock()
+			return sub.err
+
+		case <-ctx.Done():
+			logger.Info("Disconnecting from chat",
+				zap.String("chat_id", chatID),
+				zap.String("username", username),
+			)
 
 			return nil
 		}
 	}
 }
-func (i *Implementation) SendMessage(ctx context.Context, req *desc.SendMessageRequest) (*emptypb.Empty, error) {
+
+// watch removes the stream from the chat once its client misses heartbeats:
+// the client of a Chat stream sent no frame, not even a heartbeat answer, a
+// ConnectChat stream is stuck in a send. The stuck send returns when keepalive
+// closes the dead connection.
+func (i *Implementation) watch(ctx context.Context, chatID string, chat *Chat, sub *subscriber) {
+	ticker := time.NewTicker(i.streams.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if sub.silence() <= i.streams.staleAfter() {
+				i.touchSession(ctx, chatID, sub)
+
+				continue
+			}
+
+			streamMetrics.Add(missedHeartbeatsMetric, 1)
+
+			logger.Warn("Stream missed heartbeats, removing it from chat",
+				zap.String("chat_id", chatID),
+				zap.String("username", sub.session.username),
+				zap.String("session_id", sub.session.id),
+				zap.Int("missed_heartbeats", i.streams.MissedHeartbeats),
+			)
+
+			i.leave(chatID, chat, sub)
+			sub.close(status.Error(codes.Unavailable, "stream missed heartbeats"))
+
+			return
+		case <-sub.done:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// join adds the stream to the chat, creating the chat state for the first
+// one. Joins and leaves are serialized by mxChat, so a stream never joins a
+// chat being torn down. A new stream of a device replaces the old one.
+func (i *Implementation) join(chatID string, sess session, stream eventStream) (*Chat, *subscriber) {
+	i.mxChat.Lock()
+	defer i.mxChat.Unlock()
+
+	chat, okChat := i.chats[chatID]
+	if !okChat {
+		logger.Info("Creating new chat instance", zap.String("chat_id", chatID))
+
+		chat = NewChat()
+		i.chats[chatID] = chat
+	}
+
+	sub := newSubscriber(sess, stream, i.streams.QueueSize)
+
+	chat.m.Lock()
+	replaced := chat.streams[sess.key()]
+	chat.streams[sess.key()] = sub
+	chat.m.Unlock()
+
+	if replaced != nil {
+		replaced.close(status.Error(codes.Aborted, "replaced by a new stream of the device"))
+	}
+
+	return chat, sub
+}
+
+// leave removes the stream from the chat, the last one to leave tears the
+// chat state down: idle chats keep no memory.
+func (i *Implementation) leave(chatID string, chat *Chat, sub *subscriber) {
+	i.mxChat.Lock()
+	defer i.mxChat.Unlock()
+
+	chat.m.Lock()
+	// A newer stream of the device replaces this one.
+	if chat.streams[sub.session.key()] == sub {
+		delete(chat.streams, sub.session.key())
+	}
+	empty := len(chat.streams) == 0
+	chat.m.Unlock()
+
+	if !empty || i.chats[chatID] != chat {
+		return
+	}
+
+	logger.Info("Last stream left, removing chat instance", zap.String("chat_id", chatID))
+
+	delete(i.chats, chatID)
+}
+
+// fanout queues the event for every stream of the chat, the streams of the
+// author included: the devices of a user stay in sync. Only the session the
+// event comes from, if any, is skipped. Only the block policy fails, when ctx
+// is done first: the streams queued before may get the event again on a retry.
+func (i *Implementation) fanout(
+	ctx context.Context,
+	chatID string,
+	fromSessionID string,
+	event *desc.ChatEvent,
+	overflow model.OverflowPolicy,
+) error {
+	i.mxChat.RLock()
+	chat, ok := i.chats[chatID]
+	i.mxChat.RUnlock()
+
+	if !ok {
+		return nil
+	}
+
+	chat.m.RLock()
+	subs := make([]*subscriber, 0, len(chat.streams))
+	for _, sub := range chat.streams {
+		if sub.session.id != fromSessionID {
+			subs = append(subs, sub)
+		}
+	}
+	chat.m.RUnlock()
+
+	for _, sub := range subs {
+		err := i.enqueue(ctx, sub, event, overflow)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendTo queues the event for the session only, if it is still connected.
+func (i *Implementation) sendTo(ctx context.Context, chatID string, sess session, event *desc.ChatEvent) error {
+	i.mxChat.RLock()
+	chat, ok := i.chats[chatID]
+	i.mxChat.RUnlock()
+
+	if !ok {
+		return nil
+	}
+
+	chat.m.RLock()
+	sub, ok := chat.streams[sess.key()]
+	chat.m.RUnlock()
+
+	if !ok || sub.session.id != sess.id {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+
+	return i.enqueue(ctx, sub, event, i.streams.Overflow)
+}
+
+func (i *Implementation) SendMessage(ctx context.Context, req *desc.SendMessageRequest) (*desc.SendMessageResponse, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "SendMessage")
 	defer span.Finish()
 
 	logger.Info("Checking the access token...")
 
-	err := i.accessServiceClient.Check(ctx, "/user_v1.ChatV1/SendMessage")
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/SendMessage")
 	if err != nil {
 		logger.Error("Failed to check the access token", zap.Error(err))
 
@@ -231,70 +480,165 @@ func (i *Implementation) SendMessage(ctx context.Context, req *desc.SendMessageR
 	}
 	logger.Info("Access granted")
 
-	chatID := strconv.FormatInt(req.GetChatId(), 10)
-	logger.Info("Getting chat channel...", zap.String("chat_id", chatID))
-
 	// Проверяем существование чата в базе
 	_, err = i.chatAPIService.Get(ctx, req.GetChatId())
 	if err != nil {
-		logger.Error("Chat not found in database", zap.Int64("chat_id", req.GetChatId()), zap.Error(err))
+		logger.Error("Failed to get chat", zap.Int64("chat_id", req.GetChatId()), zap.Error(err))
 
-		return nil, status.Errorf(codes.NotFound, "chat not found in database")
+		if errors.Is(err, model.ErrChatNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, "failed to get chat")
 	}
 
-	// Получаем или создаем канал для чата
-	i.mxChannel.Lock()
-	chatChan, ok := i.channels[chatID]
-	if !ok {
-		logger.Info("Creating new channel for existing chat", zap.String("chat_id", chatID))
+	msg := req.GetMessage()
+	if msg != nil && req.GetReplyToMessageId() != 0 {
+		msg.ReplyToMessageId = req.GetReplyToMessageId()
+	}
+
+	if req.GetScheduledAt() != nil {
+		if model.IsCommand(msg.GetText()) {
+			return nil, status.Error(codes.InvalidArgument, "commands cannot be scheduled")
+		}
+
+		scheduledID, err := i.scheduleMessage(ctx, req.GetChatId(), msg, req.GetScheduledAt().AsTime())
+		if err != nil {
+			return nil, err
+		}
+
+		return &desc.SendMessageResponse{ScheduledId: scheduledID}, nil
+	}
+
+	if model.IsCommand(msg.GetText()) {
+		reply, err := i.runCommand(ctx, req.GetChatId(), msg)
+		if err != nil {
+			return nil, commandStatus(err)
+		}
 
-		chatChan = make(chan *desc.Message, 100)
-		i.channels[chatID] = chatChan
+		return &desc.SendMessageResponse{CommandResponse: reply}, nil
 	}
-	i.mxChannel.Unlock()
+
+	id, err := i.sendMessage(ctx, req.GetChatId(), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.SendMessageResponse{Id: id}, nil
+}
+
+// sendMessage persists the message and publishes it to the chat subscribers.
+func (i *Implementation) sendMessage(ctx context.Context, chatID int64, msg *desc.Message) (int64, error) {
+	id := strconv.FormatInt(chatID, 10)
 
 	logger.Info("Sending message to chat...",
-		zap.String("chat_id", chatID),
-		zap.Any("message", req.GetMessage()),
+		zap.String("chat_id", id),
+		zap.Any("message", msg),
 	)
 
-	messageInfo, err := conv.ToMessageFromDesc(req.Message)
+	messageInfo, err := conv.ToMessageFromDesc(msg)
 	if err != nil {
 		logger.Error("Failed to convert message to desc",
-			zap.String("chat_id", chatID),
-			zap.Any("message", req.GetMessage()),
+			zap.String("chat_id", id),
+			zap.Any("message", msg),
 			zap.Error(err),
 		)
 
-		return nil, err
+		return 0, err
 	}
 
 	message := &model.SendMessage{
-		ChatID: req.GetChatId(),
+		ChatID: chatID,
 		Message: model.Message{
 			From:      messageInfo.From,
-			Text:      messageInfo.Text,
+			Text:      model.UnescapeCommand(messageInfo.Text),
 			CreatedAt: messageInfo.CreatedAt,
+			ReplyToID: messageInfo.ReplyToID,
 		},
 	}
 
-	err = i.chatAPIService.SendMessage(ctx, message)
+	messageID, err := i.chatAPIService.SendMessage(ctx, message)
 	if err != nil {
 		logger.Error("Failed to send message to chat",
-			zap.String("chat_id", chatID),
-			zap.Any("message", req.GetMessage()),
+			zap.String("chat_id", id),
+			zap.Any("message", msg),
 			zap.Error(err),
 		)
 
-		return nil, err
+		return 0, messageStatus(err)
 	}
 
-	chatChan <- req.GetMessage()
+	msg.Id = messageID
 
 	logger.Info("Message sent successfully",
-		zap.String("chat_id", chatID),
-		zap.Any("message", req.GetMessage()),
+		zap.String("chat_id", id),
+		zap.Any("message", msg),
 	)
 
-	return &emptypb.Empty{}, nil
+	return messageID, nil
+}
+
+// publish queues an event of a client for the chat subscribers but the session
+// it comes from, none if empty. The events of the clients are transient, one
+// not queued in time is dropped.
+func (i *Implementation) publish(ctx context.Context, chatID string, fromSessionID string, event *desc.ChatEvent) {
+	ctx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+
+	err := i.fanout(ctx, chatID, fromSessionID, event, i.streams.Overflow)
+	if err != nil {
+		logger.Warn("Failed to publish event", zap.String("chat_id", chatID), zap.Error(err))
+	}
+}
+
+// Publish delivers an outbox event to the chat subscribers without waiting for
+// any: with the block policy a stream whose queue is full is disconnected.
+// Events of the chats nobody is connected to and events the streams have no
+// frame for, like chat_created, are skipped. The chat_deleted and
+// chat_archived events close the streams of the chat.
+func (i *Implementation) Publish(ctx context.Context, event *model.ChatEvent) error {
+	switch event.Type {
+	case model.ChatEventChatDeleted:
+		i.closeChat(strconv.FormatInt(event.ChatID, 10), chatStatus(model.ErrChatNotFound))
+
+		return nil
+	case model.ChatEventChatArchived:
+		i.closeChat(strconv.FormatInt(event.ChatID, 10), chatStatus(model.ErrChatArchived))
+
+		return nil
+	}
+
+	chatEvent := conv.ToChatEventFromService(event)
+	if chatEvent == nil {
+		return nil
+	}
+
+	return i.fanout(ctx, strconv.FormatInt(event.ChatID, 10), "", chatEvent, i.streams.outboxOverflow())
+}
+
+// closeChat ends the streams of the chat with err and forgets the chat, the
+// next stream checks the chat again.
+func (i *Implementation) closeChat(chatID string, err error) {
+	i.mxChat.Lock()
+	chat, ok := i.chats[chatID]
+	delete(i.chats, chatID)
+	i.mxChat.Unlock()
+
+	if ok {
+		logger.Info("Closing chat streams", zap.String("chat_id", chatID), zap.Error(err))
+
+		chat.close(err)
+	}
+}
+
+// chatStatus maps the chat errors to the gRPC codes.
+func chatStatus(err error) error {
+	switch {
+	case errors.Is(err, model.ErrChatNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrChatArchived):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
 }
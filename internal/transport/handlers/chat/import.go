@@ -0,0 +1,80 @@
+package chat
+
+import (
+	"errors"
+	"io"
+
+	conv "github.com/Mobo140/chat/internal/converter"
+	"github.com/Mobo140/chat/internal/export"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+// ImportChat reads the file from the stream as the service asks for lines.
+func (i *Implementation) ImportChat(stream desc.ChatV1_ImportChatServer) error {
+	span, ctx := opentracing.StartSpanFromContext(stream.Context(), "ImportChat")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/ImportChat")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return err
+	}
+	logger.Info("Access granted")
+
+	decoder := export.NewDecoder(&chunkReader{stream: stream})
+
+	report, err := i.chatAPIService.ImportChat(ctx, decoder.Next)
+	if err != nil {
+		logger.Error("Failed to import chat", zap.Error(err))
+
+		if errors.Is(err, model.ErrInvalidImport) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return err
+	}
+
+	logger.Info("Import chat: ",
+		zap.Int64("chat_id", report.ChatID),
+		zap.Int64("messages", report.Messages),
+		zap.Int64("failed_lines", report.FailedLines),
+	)
+
+	return stream.SendAndClose(conv.ToImportChatResponseFromService(report))
+}
+
+// chunkReader reads the chunks of the file from the stream.
+type chunkReader struct {
+	stream desc.ChatV1_ImportChatServer
+	chunk  []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return 0, io.EOF
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		r.chunk = req.GetData()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+
+	return n, nil
+}
@@ -0,0 +1,96 @@
+package chat
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+func (i *Implementation) Restore(ctx context.Context, req *desc.RestoreRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Restore chat")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/Restore")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	err = i.chatAPIService.Restore(ctx, req.GetId())
+	if err != nil {
+		logger.Error("Failed to restore chat", zap.Int64("id", req.GetId()), zap.Error(err))
+
+		return nil, chatStatus(err)
+	}
+
+	logger.Info("Restore chat: ", zap.Int64("id", req.GetId()))
+
+	return &emptypb.Empty{}, nil
+}
+
+// Archive makes the chat read only and closes its streams, the chat_archived
+// event closes them on the other instances.
+func (i *Implementation) Archive(ctx context.Context, req *desc.ArchiveRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Archive chat")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/Archive")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	err = i.chatAPIService.Archive(ctx, req.GetId())
+	if err != nil {
+		logger.Error("Failed to archive chat", zap.Int64("id", req.GetId()), zap.Error(err))
+
+		return nil, chatStatus(err)
+	}
+
+	i.closeChat(strconv.FormatInt(req.GetId(), 10), chatStatus(model.ErrChatArchived))
+
+	logger.Info("Archive chat: ", zap.Int64("id", req.GetId()))
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) Unarchive(ctx context.Context, req *desc.UnarchiveRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Unarchive chat")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/Unarchive")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	err = i.chatAPIService.Unarchive(ctx, req.GetId())
+	if err != nil {
+		logger.Error("Failed to unarchive chat", zap.Int64("id", req.GetId()), zap.Error(err))
+
+		return nil, chatStatus(err)
+	}
+
+	logger.Info("Unarchive chat: ", zap.Int64("id", req.GetId()))
+
+	return &emptypb.Empty{}, nil
+}
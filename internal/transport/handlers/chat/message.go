@@ -0,0 +1,180 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	conv "github.com/Mobo140/chat/internal/converter"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+func (i *Implementation) ListMessages(ctx context.Context, req *desc.ListMessagesRequest) (*desc.ListMessagesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListMessages")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/ListMessages")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	messages, err := i.chatAPIService.ListMessages(ctx, &model.MessagesPage{
+		ChatID:   req.GetChatId(),
+		BeforeID: req.GetBeforeId(),
+		Limit:    uint64(req.GetLimit()),
+	})
+	if err != nil {
+		logger.Error("Failed to list messages", zap.Int64("chat_id", req.GetChatId()), zap.Error(err))
+
+		return nil, messageStatus(err)
+	}
+
+	logger.Info("List messages: ", zap.Int64("chat_id", req.GetChatId()), zap.Int("count", len(messages)))
+
+	return &desc.ListMessagesResponse{
+		Messages: conv.ToMessagesFromService(messages),
+	}, nil
+}
+
+func (i *Implementation) ListThread(ctx context.Context, req *desc.ListThreadRequest) (*desc.ListThreadResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListThread")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/ListThread")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	thread, err := i.chatAPIService.ListThread(ctx, &model.ThreadPage{
+		ChatID:    req.GetChatId(),
+		MessageID: req.GetMessageId(),
+		AfterID:   req.GetAfterId(),
+		Limit:     uint64(req.GetLimit()),
+	})
+	if err != nil {
+		logger.Error("Failed to list thread", zap.Int64("message_id", req.GetMessageId()), zap.Error(err))
+
+		return nil, messageStatus(err)
+	}
+
+	logger.Info("List thread: ", zap.Int64("message_id", req.GetMessageId()), zap.Int("count", len(thread.Replies)))
+
+	return &desc.ListThreadResponse{
+		Parent:  conv.ToMessageFromService(thread.Parent),
+		Replies: conv.ToMessagesFromService(thread.Replies),
+	}, nil
+}
+
+func (i *Implementation) EditMessage(ctx context.Context, req *desc.EditMessageRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "EditMessage")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/EditMessage")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	username, err := i.caller(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatAPIService.EditMessage(ctx, &model.EditMessage{
+		ChatID:    req.GetChatId(),
+		MessageID: req.GetMessageId(),
+		Username:  username,
+		Text:      req.GetText(),
+	})
+	if err != nil {
+		logger.Error("Failed to edit message",
+			zap.Int64("chat_id", req.GetChatId()),
+			zap.Int64("message_id", req.GetMessageId()),
+			zap.Error(err),
+		)
+
+		return nil, messageStatus(err)
+	}
+
+	logger.Info("Edit message: ", zap.Int64("message_id", req.GetMessageId()))
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) DeleteMessage(ctx context.Context, req *desc.DeleteMessageRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DeleteMessage")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/DeleteMessage")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	username, err := i.caller(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.chatAPIService.DeleteMessage(ctx, &model.DeleteMessage{
+		ChatID:    req.GetChatId(),
+		MessageID: req.GetMessageId(),
+		Username:  username,
+	})
+	if err != nil {
+		logger.Error("Failed to delete message",
+			zap.Int64("chat_id", req.GetChatId()),
+			zap.Int64("message_id", req.GetMessageId()),
+			zap.Error(err),
+		)
+
+		return nil, messageStatus(err)
+	}
+
+	logger.Info("Delete message: ", zap.Int64("message_id", req.GetMessageId()))
+
+	return &emptypb.Empty{}, nil
+}
+
+// messageStatus maps service errors of message operations to gRPC statuses.
+func messageStatus(err error) error {
+	switch {
+	case errors.Is(err, model.ErrMessageNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrPermissionDenied), errors.Is(err, model.ErrMuted):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, model.ErrInvalidReply), errors.Is(err, model.ErrInvalidSchedule):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrScheduledNotFound), errors.Is(err, model.ErrChatNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrChatArchived):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
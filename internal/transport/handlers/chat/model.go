@@ -1,18 +1,190 @@
 package chat
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	desc "github.com/Mobo140/chat/pkg/chat_v1"
 )
 
+// eventStream is a server side of ConnectChatEvents or Chat streams.
+type eventStream interface {
+	Send(*desc.ChatEvent) error
+}
+
+// messageStream is a server side of a ConnectChat stream, it takes the
+// messages of the events and drops the rest.
+type messageStream struct {
+	stream desc.ChatV1_ConnectChatServer
+}
+
+func (s messageStream) Send(event *desc.ChatEvent) error {
+	if event.GetMessage() == nil {
+		return nil
+	}
+
+	return s.stream.Send(event.GetMessage())
+}
+
+// Chat is the state of a chat with connected streams, it is removed when the
+// last stream leaves.
 type Chat struct {
-	streams map[string]desc.ChatV1_ConnectChatServer
+	// streams are keyed by session.key: a user may connect from several
+	// devices.
+	streams map[string]*subscriber
 	m       sync.RWMutex
 }
 
 func NewChat() *Chat {
 	return &Chat{
-		streams: make(map[string]desc.ChatV1_ConnectChatServer),
+		streams: make(map[string]*subscriber),
+	}
+}
+
+// close ends every stream of the chat with err.
+func (c *Chat) close(err error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+
+	for _, sub := range c.streams {
+		sub.close(err)
+	}
+}
+
+// session is a stream of a user on a device.
+type session struct {
+	id       string
+	username string
+	// deviceID is the session ID if the client did not name the device.
+	deviceID string
+	// resumeAfterID replays the messages after it, not after the delivered
+	// cursor.
+	resumeAfterID int64
+	// lastHeardAt is when the client last sent a frame, in unix nanoseconds.
+	// Only Chat streams have it, the client of a ConnectChat or a
+	// ConnectChatEvents stream can't answer.
+	lastHeardAt *atomic.Int64
+}
+
+func newSession(username string, deviceID string) session {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+
+	sess := session{
+		id:       hex.EncodeToString(buf),
+		username: username,
+		deviceID: deviceID,
+	}
+	if len(sess.deviceID) == 0 {
+		sess.deviceID = sess.id
+	}
+
+	return sess
+}
+
+// listenClient makes the session track the frames of its client.
+func (s *session) listenClient() {
+	s.lastHeardAt = new(atomic.Int64)
+	s.heard()
+}
+
+// heard records a frame of the client.
+func (s session) heard() {
+	if s.lastHeardAt != nil {
+		s.lastHeardAt.Store(time.Now().UnixNano())
+	}
+}
+
+// key identifies the device of the user, a new stream of the device replaces
+// the old one.
+func (s session) key() string {
+	return s.username + "/" + s.deviceID
+}
+
+// subscriber is a stream connected to a chat with its queue of events.
+type subscriber struct {
+	session     session
+	connectedAt time.Time
+
+	stream eventStream
+	queue  chan *desc.ChatEvent
+
+	// lastMessageID is the last message sent to the stream, the resume point
+	// of a disconnected slow stream.
+	lastMessageID atomic.Int64
+	// lastSentAt is when the stream last took an event or a heartbeat, in
+	// unix nanoseconds.
+	lastSentAt atomic.Int64
+
+	// done ends the stream with err.
+	done      chan struct{}
+	err       error
+	closeOnce sync.Once
+}
+
+func newSubscriber(sess session, stream eventStream, queueSize int) *subscriber {
+	sub := &subscriber{
+		session:     sess,
+		connectedAt: time.Now(),
+		stream:      stream,
+		queue:       make(chan *desc.ChatEvent, queueSize),
+		done:        make(chan struct{}),
+	}
+	sub.lastSentAt.Store(time.Now().UnixNano())
+
+	return sub
+}
+
+// sent records the event sent to the stream.
+func (s *subscriber) sent(event *desc.ChatEvent) {
+	s.lastSentAt.Store(time.Now().UnixNano())
+
+	if message := event.GetMessage(); message != nil {
+		s.lastMessageID.Store(message.GetId())
+	}
+}
+
+// lastActiveAt is when the client last sent a frame. A successful send only
+// means the transport buffered the event, a client that stopped reading still
+// takes them for a while, so the sends count for the ConnectChat streams only:
+// their client can't answer, keepalive closes their dead connections.
+func (s *subscriber) lastActiveAt() time.Time {
+	if s.session.lastHeardAt != nil {
+		return time.Unix(0, s.session.lastHeardAt.Load())
+	}
+
+	return time.Unix(0, s.lastSentAt.Load())
+}
+
+// silence returns the time since the stream was last active.
+func (s *subscriber) silence() time.Duration {
+	return time.Since(s.lastActiveAt())
+}
+
+// close ends the stream with err, the first call wins.
+func (s *subscriber) close(err error) {
+	s.closeOnce.Do(func() {
+		s.err = err
+		close(s.done)
+	})
+}
+
+func heartbeatEvent(chatID string, interval time.Duration) *desc.ChatEvent {
+	id, _ := strconv.ParseInt(chatID, 10, 64)
+
+	return &desc.ChatEvent{
+		ChatId: id,
+		Payload: &desc.ChatEvent_Heartbeat{
+			Heartbeat: &desc.Heartbeat{
+				SentAt:          timestamppb.Now(),
+				IntervalSeconds: int64(interval / time.Second),
+			},
+		},
 	}
 }
@@ -0,0 +1,167 @@
+package chat
+
+import (
+	"context"
+	"expvar"
+	"strconv"
+	"time"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+const (
+	defaultQueueSize         = 100
+	defaultHeartbeatInterval = 15 * time.Second
+	defaultMissedHeartbeats  = 3
+
+	// SlowConsumerReason is the reason of the error a slow stream is
+	// disconnected with, its metadata holds ResumeAfterMessageIDKey.
+	SlowConsumerReason      = "SLOW_CONSUMER"
+	ResumeAfterMessageIDKey = "resume_after_message_id"
+)
+
+// streamMetrics count what the full queues and the dead streams did, published
+// with expvar as chat_streams.
+var streamMetrics = expvar.NewMap("chat_streams")
+
+const (
+	droppedEventsMetric      = "dropped_events"
+	disconnectedStreamMetric = "disconnected_streams"
+	publishTimeoutsMetric    = "publish_timeouts"
+	missedHeartbeatsMetric   = "missed_heartbeats"
+)
+
+// StreamOptions bound the event queue of every stream. A slow stream only
+// fills its own queue, the others keep getting the events.
+type StreamOptions struct {
+	QueueSize int
+	// Overflow is what a full queue does with a new event.
+	Overflow model.OverflowPolicy
+
+	// HeartbeatInterval is the longest silence of a stream, a stream whose
+	// client misses MissedHeartbeats heartbeats in a row is removed from its
+	// chat.
+	HeartbeatInterval time.Duration
+	MissedHeartbeats  int
+}
+
+// outboxOverflow is the policy of the outbox events: the broadcaster serves
+// every chat of the server, it waits for no stream. A stream blocking it is
+// disconnected instead, it resumes after the last message it got.
+func (o StreamOptions) outboxOverflow() model.OverflowPolicy {
+	if o.Overflow == model.OverflowBlock {
+		return model.OverflowDisconnect
+	}
+
+	return o.Overflow
+}
+
+// staleAfter is the silence of a stream that missed its heartbeats.
+func (o StreamOptions) staleAfter() time.Duration {
+	return time.Duration(o.MissedHeartbeats+1) * o.HeartbeatInterval
+}
+
+func (o StreamOptions) withDefaults() StreamOptions {
+	if o.QueueSize <= 0 {
+		o.QueueSize = defaultQueueSize
+	}
+
+	if !o.Overflow.Valid() {
+		o.Overflow = model.OverflowDropOldest
+	}
+
+	if o.HeartbeatInterval <= 0 {
+		o.HeartbeatInterval = defaultHeartbeatInterval
+	}
+
+	if o.MissedHeartbeats <= 0 {
+		o.MissedHeartbeats = defaultMissedHeartbeats
+	}
+
+	return o
+}
+
+// enqueue queues the event for the stream, applying the overflow policy if
+// the queue is full. Only the block policy fails, when ctx is done first.
+func (i *Implementation) enqueue(
+	ctx context.Context,
+	sub *subscriber,
+	event *desc.ChatEvent,
+	overflow model.OverflowPolicy,
+) error {
+	select {
+	case sub.queue <- event:
+		return nil
+	case <-sub.done:
+		return nil
+	default:
+	}
+
+	switch overflow {
+	case model.OverflowBlock:
+		select {
+		case sub.queue <- event:
+			return nil
+		case <-sub.done:
+			return nil
+		case <-ctx.Done():
+			streamMetrics.Add(publishTimeoutsMetric, 1)
+
+			return ctx.Err()
+		}
+	case model.OverflowDisconnect:
+		streamMetrics.Add(disconnectedStreamMetric, 1)
+
+		lastMessageID := sub.lastMessageID.Load()
+
+		logger.Warn("Disconnecting slow stream", zap.Int64("resume_after_message_id", lastMessageID))
+
+		sub.close(slowConsumerStatus(lastMessageID))
+	case model.OverflowDropOldest:
+		dropOldest(sub, event)
+	}
+
+	return nil
+}
+
+// dropOldest queues the event in place of the oldest one. The stream may take
+// events meanwhile, then nothing is dropped.
+func dropOldest(sub *subscriber, event *desc.ChatEvent) {
+	for {
+		select {
+		case sub.queue <- event:
+			return
+		default:
+		}
+
+		select {
+		case <-sub.queue:
+			streamMetrics.Add(droppedEventsMetric, 1)
+		default:
+		}
+	}
+}
+
+// slowConsumerStatus tells the client to reconnect and to fetch the messages
+// after the last one it got.
+func slowConsumerStatus(lastMessageID int64) error {
+	st := status.New(codes.ResourceExhausted, "stream is too slow to keep up with the chat")
+
+	withInfo, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   SlowConsumerReason,
+		Domain:   desc.ChatV1_ServiceDesc.ServiceName,
+		Metadata: map[string]string{ResumeAfterMessageIDKey: strconv.FormatInt(lastMessageID, 10)},
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return withInfo.Err()
+}
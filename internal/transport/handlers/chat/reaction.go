@@ -0,0 +1,73 @@
+package chat
+
+import (
+	"context"
+
+	conv "github.com/Mobo140/chat/internal/converter"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+func (i *Implementation) AddReaction(ctx context.Context, req *desc.ReactionRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "AddReaction")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/AddReaction")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	err = i.chatAPIService.AddReaction(ctx, conv.ToReactionFromDesc(req))
+	if err != nil {
+		logger.Error("Failed to add reaction",
+			zap.Int64("message_id", req.GetMessageId()),
+			zap.String("emoji", req.GetEmoji()),
+			zap.Error(err),
+		)
+
+		return nil, messageStatus(err)
+	}
+
+	logger.Info("Add reaction: ", zap.Int64("message_id", req.GetMessageId()), zap.String("emoji", req.GetEmoji()))
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) RemoveReaction(ctx context.Context, req *desc.ReactionRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RemoveReaction")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/RemoveReaction")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	err = i.chatAPIService.RemoveReaction(ctx, conv.ToReactionFromDesc(req))
+	if err != nil {
+		logger.Error("Failed to remove reaction",
+			zap.Int64("message_id", req.GetMessageId()),
+			zap.String("emoji", req.GetEmoji()),
+			zap.Error(err),
+		)
+
+		return nil, messageStatus(err)
+	}
+
+	logger.Info("Remove reaction: ", zap.Int64("message_id", req.GetMessageId()), zap.String("emoji", req.GetEmoji()))
+
+	return &emptypb.Empty{}, nil
+}
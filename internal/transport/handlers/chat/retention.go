@@ -0,0 +1,48 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	conv "github.com/Mobo140/chat/internal/converter"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+// SetRetention is for the support staff: the access check lets only admins in.
+func (i *Implementation) SetRetention(ctx context.Context, req *desc.SetRetentionRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SetRetention")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/SetRetention")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	err = i.chatAPIService.SetRetention(ctx, conv.ToChatRetentionFromDesc(req))
+	if err != nil {
+		logger.Error("Failed to set retention", zap.Int64("chat_id", req.GetChatId()), zap.Error(err))
+
+		if errors.Is(err, model.ErrChatNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, err
+	}
+
+	logger.Info("Set retention: ", zap.Int64("chat_id", req.GetChatId()))
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,124 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	conv "github.com/Mobo140/chat/internal/converter"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+// scheduleMessage stores the message to be sent at scheduledAt. The scheduler
+// sends it through the outbox like any other message.
+func (i *Implementation) scheduleMessage(
+	ctx context.Context,
+	chatID int64,
+	msg *desc.Message,
+	scheduledAt time.Time,
+) (int64, error) {
+	logger.Info("Scheduling message...",
+		zap.Int64("chat_id", chatID),
+		zap.Any("message", msg),
+		zap.Time("scheduled_at", scheduledAt),
+	)
+
+	messageInfo, err := conv.ToMessageFromDesc(msg)
+	if err != nil {
+		logger.Error("Failed to convert message to desc", zap.Int64("chat_id", chatID), zap.Error(err))
+
+		return 0, err
+	}
+
+	id, err := i.chatAPIService.ScheduleMessage(ctx, &model.ScheduledMessage{
+		ChatID: chatID,
+		Message: model.Message{
+			From:      messageInfo.From,
+			Text:      model.UnescapeCommand(messageInfo.Text),
+			ReplyToID: messageInfo.ReplyToID,
+		},
+		ScheduledAt: scheduledAt,
+	})
+	if err != nil {
+		logger.Error("Failed to schedule message", zap.Int64("chat_id", chatID), zap.Error(err))
+
+		return 0, messageStatus(err)
+	}
+
+	logger.Info("Message scheduled: ", zap.Int64("id", id))
+
+	return id, nil
+}
+
+func (i *Implementation) ListScheduled(
+	ctx context.Context,
+	req *desc.ListScheduledRequest,
+) (*desc.ListScheduledResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListScheduled")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/ListScheduled")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	messages, err := i.chatAPIService.ListScheduled(ctx, &model.ScheduledFilter{
+		ChatID:   req.GetChatId(),
+		Username: req.GetUsername(),
+	})
+	if err != nil {
+		logger.Error("Failed to list scheduled messages", zap.Int64("chat_id", req.GetChatId()), zap.Error(err))
+
+		return nil, messageStatus(err)
+	}
+
+	logger.Info("List scheduled messages: ", zap.Int64("chat_id", req.GetChatId()), zap.Int("count", len(messages)))
+
+	return &desc.ListScheduledResponse{
+		Messages: conv.ToScheduledMessagesFromService(messages),
+	}, nil
+}
+
+func (i *Implementation) CancelScheduled(ctx context.Context, req *desc.CancelScheduledRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CancelScheduled")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/CancelScheduled")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	err = i.chatAPIService.CancelScheduled(ctx, &model.CancelScheduled{
+		ChatID:   req.GetChatId(),
+		ID:       req.GetId(),
+		Username: req.GetUsername(),
+	})
+	if err != nil {
+		logger.Error("Failed to cancel scheduled message",
+			zap.Int64("chat_id", req.GetChatId()),
+			zap.Int64("id", req.GetId()),
+			zap.Error(err),
+		)
+
+		return nil, messageStatus(err)
+	}
+
+	logger.Info("Cancel scheduled message: ", zap.Int64("id", req.GetId()))
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,99 @@
+package chat
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+
+	conv "github.com/Mobo140/chat/internal/converter"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+func (i *Implementation) SearchMessages(ctx context.Context, req *desc.SearchMessagesRequest) (*desc.SearchMessagesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SearchMessages")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/SearchMessages")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	beforeID, err := decodeCursor(req.GetCursor())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	username, err := i.caller(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	query := &model.SearchQuery{
+		Username: username,
+		Query:    req.GetQuery(),
+		ChatID:   req.GetChatId(),
+		From:     req.GetFrom(),
+		BeforeID: beforeID,
+		Limit:    uint64(req.GetLimit()),
+	}
+
+	if req.GetSince() != nil {
+		since := req.GetSince().AsTime()
+		query.Since = &since
+	}
+
+	if req.GetUntil() != nil {
+		until := req.GetUntil().AsTime()
+		query.Until = &until
+	}
+
+	results, err := i.chatAPIService.SearchMessages(ctx, query)
+	if err != nil {
+		logger.Error("Failed to search messages", zap.String("username", username), zap.Error(err))
+
+		return nil, err
+	}
+
+	logger.Info("Search messages: ", zap.String("username", username), zap.Int("count", len(results)))
+
+	res := &desc.SearchMessagesResponse{
+		Results: conv.ToSearchResultsFromService(results),
+	}
+
+	// A full page may be followed by another one, the service fills in the default limit.
+	if len(results) > 0 && uint64(len(results)) == query.Limit {
+		res.NextCursor = encodeCursor(results[len(results)-1].Message.ID)
+	}
+
+	return res, nil
+}
+
+// encodeCursor hides the message id the next page starts before.
+func encodeCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+func decodeCursor(cursor string) (int64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(string(raw), 10, 64)
+}
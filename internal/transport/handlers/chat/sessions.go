@@ -0,0 +1,101 @@
+package chat
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	conv "github.com/Mobo140/chat/internal/converter"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+func (i *Implementation) ListSessions(
+	ctx context.Context,
+	req *desc.ListSessionsRequest,
+) (*desc.ListSessionsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListSessions")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/ListSessions")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	// The rows of the servers that died stop moving, they are stale like a
+	// stream that missed its heartbeats.
+	sessions, err := i.chatAPIService.ListSessions(ctx, &model.SessionFilter{
+		Username:    req.GetUsername(),
+		ChatID:      req.GetChatId(),
+		ActiveSince: time.Now().Add(-i.streams.staleAfter()),
+	})
+	if err != nil {
+		logger.Error("Failed to list sessions", zap.String("username", req.GetUsername()), zap.Error(err))
+
+		return nil, err
+	}
+
+	logger.Info("List sessions: ", zap.String("username", req.GetUsername()), zap.Int("count", len(sessions)))
+
+	return &desc.ListSessionsResponse{Sessions: conv.ToSessionsFromService(sessions)}, nil
+}
+
+// openSession records the stream for ListSessions on every server. The
+// record is best effort, the stream goes on without it.
+func (i *Implementation) openSession(ctx context.Context, chatID string, sub *subscriber) {
+	id, _ := strconv.ParseInt(chatID, 10, 64)
+
+	err := i.chatAPIService.OpenSession(ctx, &model.Session{
+		ID:           sub.session.id,
+		ChatID:       id,
+		Username:     sub.session.username,
+		DeviceID:     sub.session.deviceID,
+		ConnectedAt:  sub.connectedAt,
+		LastActiveAt: sub.lastActiveAt(),
+	})
+	if err != nil {
+		logger.Warn("Failed to record session",
+			zap.String("chat_id", chatID),
+			zap.String("session_id", sub.session.id),
+			zap.Error(err),
+		)
+	}
+}
+
+// touchSession records the last activity of the stream, once a heartbeat
+// interval.
+func (i *Implementation) touchSession(ctx context.Context, chatID string, sub *subscriber) {
+	err := i.chatAPIService.TouchSession(ctx, sub.session.id, sub.lastActiveAt())
+	if err != nil {
+		logger.Warn("Failed to record session activity",
+			zap.String("chat_id", chatID),
+			zap.String("session_id", sub.session.id),
+			zap.Error(err),
+		)
+	}
+}
+
+// closeSession removes the record of the stream, also when the stream ended
+// with its context.
+func (i *Implementation) closeSession(ctx context.Context, chatID string, sub *subscriber) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), publishTimeout)
+	defer cancel()
+
+	err := i.chatAPIService.CloseSession(ctx, sub.session.id)
+	if err != nil {
+		logger.Warn("Failed to remove session",
+			zap.String("chat_id", chatID),
+			zap.String("session_id", sub.session.id),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,261 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+const chatEndpoint = "/chat_v1.ChatV1/Chat"
+
+// Chat is a bidirectional chat session. The access token is checked once,
+// the first frame must join the chat as a member, the caller of the token,
+// the following ones are sent to it.
+func (i *Implementation) Chat(stream desc.ChatV1_ChatServer) error {
+	span, ctx := opentracing.StartSpanFromContext(stream.Context(), "Chat")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(forwardMetadata(ctx), chatEndpoint)
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return err
+	}
+	logger.Info("Access granted")
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	join := req.GetJoin()
+	if join == nil {
+		return status.Error(codes.InvalidArgument, "first frame must join the chat")
+	}
+
+	err = join.Validate()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	join.Username, err = i.caller(ctx, join.GetUsername())
+	if err != nil {
+		return err
+	}
+
+	chat, err := i.chatAPIService.Get(ctx, join.GetChatId())
+	if err != nil {
+		logger.Error("Failed to get chat", zap.Int64("chat_id", join.GetChatId()), zap.Error(err))
+
+		if errors.Is(err, model.ErrChatNotFound) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+
+		return status.Error(codes.Internal, "failed to get chat")
+	}
+
+	if chat.ArchivedAt != nil {
+		return chatStatus(model.ErrChatArchived)
+	}
+
+	if chat.Role(join.GetUsername()) == model.ChatRoleNone {
+		return messageStatus(model.ErrPermissionDenied)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sess := newSession(join.GetUsername(), join.GetDeviceId())
+	sess.listenClient()
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- i.receive(ctx, stream, join, sess)
+		cancel()
+	}()
+
+	err = i.listen(ctx, strconv.FormatInt(join.GetChatId(), 10), sess, stream)
+
+	select {
+	case errRecv := <-errCh:
+		if errRecv != nil {
+			return errRecv
+		}
+	default:
+	}
+
+	return err
+}
+
+// receive handles the client frames until the client closes the stream. The
+// events of the client go to every other session of the chat, a refused frame
+// gets an error frame back.
+func (i *Implementation) receive(
+	ctx context.Context,
+	stream desc.ChatV1_ChatServer,
+	join *desc.JoinChat,
+	sess session,
+) error {
+	chatID := strconv.FormatInt(join.GetChatId(), 10)
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		sess.heard()
+
+		event := &desc.ChatEvent{ChatId: join.GetChatId()}
+
+		switch payload := req.GetPayload().(type) {
+		case *desc.ChatRequest_Message:
+			msg := payload.Message
+			msg.From = join.GetUsername()
+
+			if msg.GetCreatedAt() == nil {
+				msg.CreatedAt = timestamppb.Now()
+			}
+
+			err = msg.Validate()
+			if err != nil {
+				err = i.reject(ctx, chatID, sess, event, status.Error(codes.InvalidArgument, err.Error()))
+				if err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if model.IsCommand(msg.GetText()) {
+				reply, errCmd := i.runCommand(ctx, join.GetChatId(), msg)
+				if errCmd != nil {
+					reply = commandFailure(msg.GetText(), errCmd)
+				}
+
+				event.Payload = &desc.ChatEvent_CommandResponse{CommandResponse: reply}
+
+				err = i.sendTo(ctx, chatID, sess, event)
+				if err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			_, err = i.sendMessage(ctx, join.GetChatId(), msg)
+			if err != nil {
+				err = i.reject(ctx, chatID, sess, event, err)
+				if err != nil {
+					return err
+				}
+			}
+
+			continue
+		case *desc.ChatRequest_Ack:
+			payload.Ack.Username = join.GetUsername()
+
+			if payload.Ack.GetLastMessageId() > 0 {
+				err = i.ackDelivery(ctx, join.GetChatId(), sess.id, payload.Ack)
+				if err != nil {
+					err = i.reject(ctx, chatID, sess, event, err)
+					if err != nil {
+						return err
+					}
+				}
+
+				continue
+			}
+
+			event.Payload = &desc.ChatEvent_Ack{Ack: payload.Ack}
+		case *desc.ChatRequest_Typing:
+			payload.Typing.Username = join.GetUsername()
+			event.Payload = &desc.ChatEvent_Typing{Typing: payload.Typing}
+		case *desc.ChatRequest_Heartbeat:
+			continue
+		case *desc.ChatRequest_Read:
+			payload.Read.Username = join.GetUsername()
+
+			if payload.Read.GetLastMessageId() > 0 {
+				err = i.markRead(ctx, join.GetChatId(), payload.Read)
+				if err != nil {
+					err = i.reject(ctx, chatID, sess, event, err)
+					if err != nil {
+						return err
+					}
+				}
+
+				continue
+			}
+
+			event.Payload = &desc.ChatEvent_Read{Read: payload.Read}
+		default:
+			return status.Error(codes.InvalidArgument, "unexpected frame")
+		}
+
+		i.publish(ctx, chatID, sess.id, event)
+	}
+}
+
+// reject tells the session its frame was refused by an error frame and keeps
+// the stream open. Other errors are internal or of the transport, it returns
+// them to end the stream.
+func (i *Implementation) reject(
+	ctx context.Context,
+	chatID string,
+	sess session,
+	event *desc.ChatEvent,
+	err error,
+) error {
+	st, ok := status.FromError(err)
+	if !ok || !refusal(st.Code()) {
+		return err
+	}
+
+	event.Payload = &desc.ChatEvent_Error{Error: &desc.FrameError{
+		Code:    int32(st.Code()),
+		Message: st.Message(),
+	}}
+
+	return i.sendTo(ctx, chatID, sess, event)
+}
+
+// refusal reports whether the code refuses a frame for what it holds, as
+// messageStatus maps the business errors.
+func refusal(code codes.Code) bool {
+	switch code {
+	case codes.InvalidArgument, codes.NotFound, codes.PermissionDenied, codes.FailedPrecondition:
+		return true
+	default:
+		return false
+	}
+}
+
+// forwardMetadata passes the caller's metadata (access token) to outgoing calls,
+// streams are not covered by the unary tracing interceptor that does it.
+func forwardMetadata(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
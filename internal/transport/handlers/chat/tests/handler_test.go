@@ -3,8 +3,17 @@ package tests
 import (
 	"context"
 	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/Mobo140/chat/internal/client"
+	clientMocks "github.com/Mobo140/chat/internal/client/mocks"
 	"github.com/Mobo140/chat/internal/model"
 	"github.com/Mobo140/chat/internal/service"
 	serviceMocks "github.com/Mobo140/chat/internal/service/mocks"
@@ -13,7 +22,15 @@ import (
 	"github.com/brianvoe/gofakeit"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -67,7 +84,7 @@ func TestCreate(t *testing.T) {
 				req: req,
 			},
 			setupMocks: func(mock *serviceMocks.ChatServiceMock) {
-				mock.CreateMock.Expect(ctx, info).Return(id, nil)
+				mock.CreateMock.Expect(minimock.AnyContext, info).Return(id, nil)
 			},
 			expectedResp: res,
 			expectedErr:  nil,
@@ -89,7 +106,7 @@ func TestCreate(t *testing.T) {
 				req: req,
 			},
 			setupMocks: func(mock *serviceMocks.ChatServiceMock) {
-				mock.CreateMock.Expect(ctx, info).Return(0, serviceErr)
+				mock.CreateMock.Expect(minimock.AnyContext, info).Return(0, serviceErr)
 			},
 			expectedResp: nil,
 			expectedErr:  serviceErr,
@@ -106,7 +123,7 @@ func TestCreate(t *testing.T) {
 			// Настраиваем мок
 			tt.setupMocks(mockService)
 			// Создаем handler
-			handler := chatHandler.NewImplementation(mockService, nil)
+			handler := chatHandler.NewImplementation(mockService, nil, nil, nil, chatHandler.StreamOptions{})
 
 			// Выполняем тест
 			resp, err := handler.Create(ctx, tt.args.req)
@@ -138,6 +155,7 @@ func TestGet(t *testing.T) {
 
 		id        = gofakeit.Int64()
 		usernames = []string{gofakeit.Username(), gofakeit.Username()}
+		maxCount  = int64(1000)
 
 		serviceErr = fmt.Errorf("service error")
 
@@ -146,6 +164,7 @@ func TestGet(t *testing.T) {
 			Info: model.ChatInfo{
 				Usernames: usernames,
 			},
+			Retention: model.ChatRetention{ChatID: id, MaxCount: &maxCount},
 		}
 
 		req = &desc.GetRequest{
@@ -158,6 +177,7 @@ func TestGet(t *testing.T) {
 				Info: &desc.ChatInfo{
 					Usernames: usernames,
 				},
+				Retention: &desc.Retention{MaxCount: &maxCount},
 			},
 		}
 	)
@@ -175,7 +195,7 @@ func TestGet(t *testing.T) {
 				req: req,
 			},
 			setupMocks: func(mock *serviceMocks.ChatServiceMock) {
-				mock.GetMock.Expect(ctx, id).Return(chat, nil)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(chat, nil)
 			},
 			expectedResp: res,
 			expectedErr:  nil,
@@ -186,7 +206,7 @@ func TestGet(t *testing.T) {
 				req: req,
 			},
 			setupMocks: func(mock *serviceMocks.ChatServiceMock) {
-				mock.GetMock.Expect(ctx, id).Return(nil, serviceErr)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(nil, serviceErr)
 			},
 			expectedResp: nil,
 			expectedErr:  serviceErr,
@@ -200,7 +220,7 @@ func TestGet(t *testing.T) {
 
 			mockService := serviceMocks.NewChatServiceMock(mc)
 			tt.setupMocks(mockService)
-			handler := chatHandler.NewImplementation(mockService, nil)
+			handler := chatHandler.NewImplementation(mockService, nil, nil, nil, chatHandler.StreamOptions{})
 
 			resp, err := handler.Get(ctx, tt.args.req)
 			if tt.expectedErr != nil {
@@ -251,7 +271,7 @@ func TestDelete(t *testing.T) {
 			},
 			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
 				mock := serviceMocks.NewChatServiceMock(mc)
-				mock.DeleteMock.Expect(ctx, id).Return(nil)
+				mock.DeleteMock.Expect(minimock.AnyContext, id).Return(nil)
 				return mock
 			},
 			want: res,
@@ -266,7 +286,7 @@ func TestDelete(t *testing.T) {
 			err:  serviceErr,
 			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
 				mock := serviceMocks.NewChatServiceMock(mc)
-				mock.DeleteMock.Expect(ctx, id).Return(serviceErr)
+				mock.DeleteMock.Expect(minimock.AnyContext, id).Return(serviceErr)
 				return mock
 			},
 		},
@@ -278,7 +298,7 @@ func TestDelete(t *testing.T) {
 			t.Parallel()
 
 			chatServiceMock := tt.chatServiceMock(mc)
-			handler := chatHandler.NewImplementation(chatServiceMock, nil)
+			handler := chatHandler.NewImplementation(chatServiceMock, nil, nil, nil, chatHandler.StreamOptions{})
 
 			response, err := handler.Delete(ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -290,6 +310,7 @@ func TestDelete(t *testing.T) {
 func TestSendMessage(t *testing.T) {
 	t.Parallel()
 	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+	type accessClientMockFunc func(mc *minimock.Controller) client.AccessServiceClient
 
 	type args struct {
 		req *desc.SendMessageRequest
@@ -303,34 +324,56 @@ func TestSendMessage(t *testing.T) {
 		from = gofakeit.Name()
 		text = gofakeit.Color()
 
+		createdAt = timestamppb.Now()
+		messageID = gofakeit.Int64()
+
+		scheduledAt = timestamppb.New(time.Now().Add(time.Hour))
+		scheduledID = gofakeit.Int64()
+
 		serviceErr  = fmt.Errorf("service update error")
 		converseErr = fmt.Errorf("message is empty")
+		accessErr   = fmt.Errorf("access denied")
 
 		req = &desc.SendMessageRequest{
 			ChatId: id,
 			Message: &desc.Message{
-				From: from,
-				Text: text,
+				From:      from,
+				Text:      text,
+				CreatedAt: createdAt,
 			},
 		}
 
 		message = &model.SendMessage{
 			ChatID: value,
 			Message: model.Message{
-				From: from,
-				Text: text,
+				From:      from,
+				Text:      text,
+				CreatedAt: createdAt.AsTime(),
 			},
 		}
 
-		res = &emptypb.Empty{}
+		chat = &model.Chat{
+			ID: id,
+		}
+
+		res = &desc.SendMessageResponse{
+			Id: messageID,
+		}
 	)
 
+	accessGranted := func(mc *minimock.Controller) client.AccessServiceClient {
+		mock := clientMocks.NewAccessServiceClientMock(mc)
+		mock.CheckMock.Return(nil)
+		return mock
+	}
+
 	tests := []struct {
-		name            string
-		args            args
-		chatServiceMock chatServiceMockFunc
-		want            *emptypb.Empty
-		err             error
+		name             string
+		args             args
+		chatServiceMock  chatServiceMockFunc
+		accessClientMock accessClientMockFunc
+		want             *desc.SendMessageResponse
+		err              error
 	}{
 		{
 			name: "success case",
@@ -339,11 +382,36 @@ func TestSendMessage(t *testing.T) {
 			},
 			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
 				mock := serviceMocks.NewChatServiceMock(mc)
-				mock.SendMessageMock.Expect(ctx, message).Return(nil)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(chat, nil)
+				mock.SendMessageMock.Expect(minimock.AnyContext, message).Return(messageID, nil)
 				return mock
 			},
-			want: res,
-			err:  nil,
+			accessClientMock: accessGranted,
+			want:             res,
+			err:              nil,
+		},
+		{
+			name: "scheduled message case",
+			args: args{
+				req: &desc.SendMessageRequest{
+					ChatId:      id,
+					Message:     req.GetMessage(),
+					ScheduledAt: scheduledAt,
+				},
+			},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(chat, nil)
+				mock.ScheduleMessageMock.Expect(minimock.AnyContext, &model.ScheduledMessage{
+					ChatID:      id,
+					Message:     model.Message{From: from, Text: text},
+					ScheduledAt: scheduledAt.AsTime(),
+				}).Return(scheduledID, nil)
+				return mock
+			},
+			accessClientMock: accessGranted,
+			want:             &desc.SendMessageResponse{ScheduledId: scheduledID},
+			err:              nil,
 		},
 		{
 			name: "service error case",
@@ -354,9 +422,39 @@ func TestSendMessage(t *testing.T) {
 			err:  serviceErr,
 			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
 				mock := serviceMocks.NewChatServiceMock(mc)
-				mock.SendMessageMock.Expect(ctx, message).Return(serviceErr)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(chat, nil)
+				mock.SendMessageMock.Expect(minimock.AnyContext, message).Return(0, serviceErr)
+				return mock
+			},
+			accessClientMock: accessGranted,
+		},
+		{
+			name: "chat not found case",
+			args: args{
+				req: req,
+			},
+			want: nil,
+			err:  status.Error(codes.NotFound, model.ErrChatNotFound.Error()),
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(nil, model.ErrChatNotFound)
+				return mock
+			},
+			accessClientMock: accessGranted,
+		},
+		{
+			name: "chat lookup error case",
+			args: args{
+				req: req,
+			},
+			want: nil,
+			err:  status.Error(codes.Internal, "failed to get chat"),
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(nil, serviceErr)
 				return mock
 			},
+			accessClientMock: accessGranted,
 		},
 		{
 			name: "empty message case",
@@ -370,6 +468,25 @@ func TestSendMessage(t *testing.T) {
 			err:  converseErr,
 			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
 				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(chat, nil)
+				return mock
+			},
+			accessClientMock: accessGranted,
+		},
+		{
+			name: "access denied case",
+			args: args{
+				req: req,
+			},
+			want: nil,
+			err:  accessErr,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				return mock
+			},
+			accessClientMock: func(mc *minimock.Controller) client.AccessServiceClient {
+				mock := clientMocks.NewAccessServiceClientMock(mc)
+				mock.CheckMock.Return(accessErr)
 				return mock
 			},
 		},
@@ -381,7 +498,8 @@ func TestSendMessage(t *testing.T) {
 			t.Parallel()
 
 			chatServiceMock := tt.chatServiceMock(mc)
-			handler := chatHandler.NewImplementation(chatServiceMock, nil)
+			accessClientMock := tt.accessClientMock(mc)
+			handler := chatHandler.NewImplementation(chatServiceMock, nil, nil, accessClientMock, chatHandler.StreamOptions{})
 
 			response, err := handler.SendMessage(ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -389,3 +507,1884 @@ func TestSendMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestChat(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+	type accessClientMockFunc func(mc *minimock.Controller) client.AccessServiceClient
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id       = value
+		username = gofakeit.Username()
+		text     = gofakeit.Color()
+
+		createdAt = timestamppb.Now()
+
+		serviceErr = fmt.Errorf("service error")
+		accessErr  = fmt.Errorf("access denied")
+
+		join = &desc.ChatRequest{
+			Payload: &desc.ChatRequest_Join{
+				Join: &desc.JoinChat{
+					ChatId:   id,
+					Username: username,
+				},
+			},
+		}
+
+		messageReq = &desc.ChatRequest{
+			Payload: &desc.ChatRequest_Message{
+				Message: &desc.Message{
+					From:      gofakeit.Username(),
+					Text:      text,
+					CreatedAt: createdAt,
+				},
+			},
+		}
+
+		message = &model.SendMessage{
+			ChatID: id,
+			Message: model.Message{
+				From:      username,
+				Text:      text,
+				CreatedAt: createdAt.AsTime(),
+			},
+		}
+
+		chat = &model.Chat{
+			ID:   id,
+			Info: model.ChatInfo{Usernames: []string{username}},
+		}
+
+		archivedAt = time.Now()
+	)
+
+	accessGranted := func(mc *minimock.Controller) client.AccessServiceClient {
+		mock := clientMocks.NewAccessServiceClientMock(mc)
+		mock.CheckMock.Return(nil)
+		mock.CallerMock.Optional().Return(username, nil)
+		return mock
+	}
+
+	tests := []struct {
+		name             string
+		frames           []*desc.ChatRequest
+		chatServiceMock  chatServiceMockFunc
+		accessClientMock accessClientMockFunc
+		code             codes.Code
+		err              error
+	}{
+		{
+			name:   "success case",
+			frames: []*desc.ChatRequest{join, messageReq},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(chat, nil)
+				mock.ListUndeliveredMock.Optional().Return(nil, nil)
+				mock.GetDeliveryCursorMock.Optional().Return(&model.DeliveryCursor{}, nil)
+				allowSessions(mock)
+				mock.SendMessageMock.Expect(minimock.AnyContext, message).Return(gofakeit.Int64(), nil)
+				return mock
+			},
+			accessClientMock: accessGranted,
+		},
+		{
+			name:   "access denied case",
+			frames: []*desc.ChatRequest{join},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+			accessClientMock: func(mc *minimock.Controller) client.AccessServiceClient {
+				mock := clientMocks.NewAccessServiceClientMock(mc)
+				mock.CheckMock.Return(accessErr)
+				return mock
+			},
+			err: accessErr,
+		},
+		{
+			name:   "first frame is not join case",
+			frames: []*desc.ChatRequest{messageReq},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+			accessClientMock: accessGranted,
+			code:             codes.InvalidArgument,
+		},
+		{
+			name:   "chat not found case",
+			frames: []*desc.ChatRequest{join},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(nil, model.ErrChatNotFound)
+				return mock
+			},
+			accessClientMock: accessGranted,
+			code:             codes.NotFound,
+		},
+		{
+			name:   "chat lookup error case",
+			frames: []*desc.ChatRequest{join},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(nil, serviceErr)
+				return mock
+			},
+			accessClientMock: accessGranted,
+			code:             codes.Internal,
+		},
+		{
+			name:   "not a member case",
+			frames: []*desc.ChatRequest{join},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(&model.Chat{ID: id}, nil)
+				return mock
+			},
+			accessClientMock: accessGranted,
+			code:             codes.PermissionDenied,
+		},
+		{
+			name: "username of another caller case",
+			frames: []*desc.ChatRequest{{
+				Payload: &desc.ChatRequest_Join{
+					Join: &desc.JoinChat{
+						ChatId:   id,
+						Username: "intruder",
+					},
+				},
+			}},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+			accessClientMock: accessGranted,
+			code:             codes.PermissionDenied,
+		},
+		{
+			name:   "archived chat case",
+			frames: []*desc.ChatRequest{join},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(&model.Chat{ID: id, ArchivedAt: &archivedAt}, nil)
+				return mock
+			},
+			accessClientMock: accessGranted,
+			code:             codes.FailedPrecondition,
+		},
+		{
+			name:   "service error case",
+			frames: []*desc.ChatRequest{join, messageReq},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(chat, nil)
+				mock.ListUndeliveredMock.Optional().Return(nil, nil)
+				mock.GetDeliveryCursorMock.Optional().Return(&model.DeliveryCursor{}, nil)
+				allowSessions(mock)
+				mock.SendMessageMock.Expect(minimock.AnyContext, message).Return(0, serviceErr)
+				return mock
+			},
+			accessClientMock: accessGranted,
+			err:              serviceErr,
+		},
+		{
+			name:   "muted case",
+			frames: []*desc.ChatRequest{join, messageReq},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.GetMock.Expect(minimock.AnyContext, id).Return(chat, nil)
+				mock.ListUndeliveredMock.Optional().Return(nil, nil)
+				mock.GetDeliveryCursorMock.Optional().Return(&model.DeliveryCursor{}, nil)
+				allowSessions(mock)
+				mock.SendMessageMock.Expect(minimock.AnyContext, message).Return(0, model.ErrMuted)
+				return mock
+			},
+			accessClientMock: accessGranted,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := chatHandler.NewImplementation(tt.chatServiceMock(mc), nil, nil, tt.accessClientMock(mc),
+				chatHandler.StreamOptions{})
+
+			err := handler.Chat(newChatStream(ctx, cloneFrames(tt.frames)...))
+
+			switch {
+			case tt.code != codes.OK:
+				require.Equal(t, tt.code, status.Code(err))
+			case tt.err != nil:
+				require.Equal(t, tt.err, err)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestChatRefusedFrame(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		mc       = minimock.NewController(t)
+		chatID   = value
+		username = member
+	)
+
+	frame := func(text string) *desc.ChatRequest {
+		return &desc.ChatRequest{
+			Payload: &desc.ChatRequest_Message{
+				Message: &desc.Message{Text: text},
+			},
+		}
+	}
+
+	// The archived chat refuses the first message, the stream stays open for
+	// the second one.
+	chatService := serviceMocks.NewChatServiceMock(mc)
+	chatService.GetMock.Expect(minimock.AnyContext, chatID).Return(memberChat(chatID), nil)
+	chatService.ListUndeliveredMock.Optional().Return(nil, nil)
+	chatService.GetDeliveryCursorMock.Optional().Return(&model.DeliveryCursor{}, nil)
+	allowSessions(chatService)
+	chatService.SendMessageMock.Set(func(_ context.Context, message *model.SendMessage) (int64, error) {
+		if message.Message.Text == "refused" {
+			return 0, model.ErrChatArchived
+		}
+
+		return 1, nil
+	})
+
+	handler := chatHandler.NewImplementation(chatService, nil, nil, allowAccess(mc),
+		chatHandler.StreamOptions{})
+
+	stream := newOpenChatStream(ctx,
+		&desc.ChatRequest{Payload: &desc.ChatRequest_Join{Join: &desc.JoinChat{ChatId: chatID, Username: username}}},
+		frame("refused"),
+		frame(strings.Repeat("x", 31)),
+		frame("sent"),
+	)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- handler.Chat(stream)
+	}()
+
+	require.Eventually(t, func() bool {
+		return chatService.SendMessageAfterCounter() == 2
+	}, time.Second, 10*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		stream.mu.Lock()
+		defer stream.mu.Unlock()
+
+		return len(stream.sent) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	stream.mu.Lock()
+	require.Equal(t, int32(codes.FailedPrecondition), stream.sent[0].GetError().GetCode())
+	require.Equal(t, model.ErrChatArchived.Error(), stream.sent[0].GetError().GetMessage())
+	require.Equal(t, int32(codes.InvalidArgument), stream.sent[1].GetError().GetCode())
+	stream.mu.Unlock()
+
+	// The stream ends with the context, not with the refusals.
+	cancel()
+	<-errCh
+}
+
+func TestEditMessage(t *testing.T) {
+	t.Parallel()
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = value
+		messageID = gofakeit.Int64()
+		username  = gofakeit.Username()
+		text      = gofakeit.Color()
+		editedAt  = time.Now()
+
+		serviceErr = fmt.Errorf("service error")
+
+		req = &desc.EditMessageRequest{
+			ChatId:    chatID,
+			MessageId: messageID,
+			Username:  username,
+			Text:      text,
+		}
+
+		edit = &model.EditMessage{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Username:  username,
+			Text:      text,
+		}
+
+		message = &model.Message{
+			ID:       messageID,
+			From:     username,
+			Text:     text,
+			EditedAt: &editedAt,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		caller          string
+		chatServiceMock chatServiceMockFunc
+		want            *emptypb.Empty
+		code            codes.Code
+		err             error
+	}{
+		{
+			name: "success case",
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.EditMessageMock.Expect(minimock.AnyContext, edit).Return(message, nil)
+				return mock
+			},
+			want: &emptypb.Empty{},
+		},
+		{
+			name: "message not found case",
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.EditMessageMock.Expect(minimock.AnyContext, edit).Return(nil, model.ErrMessageNotFound)
+				return mock
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "permission denied case",
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.EditMessageMock.Expect(minimock.AnyContext, edit).Return(nil, model.ErrPermissionDenied)
+				return mock
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "service error case",
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.EditMessageMock.Expect(minimock.AnyContext, edit).Return(nil, serviceErr)
+				return mock
+			},
+			err: serviceErr,
+		},
+		{
+			name:   "username of another caller case",
+			caller: "intruder",
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+			code: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			caller := username
+			if tt.caller != "" {
+				caller = tt.caller
+			}
+
+			accessClientMock := clientMocks.NewAccessServiceClientMock(mc)
+			accessClientMock.CheckMock.Return(nil)
+			accessClientMock.CallerMock.Return(caller, nil)
+			handler := chatHandler.NewImplementation(tt.chatServiceMock(mc), nil, nil, accessClientMock,
+				chatHandler.StreamOptions{})
+
+			response, err := handler.EditMessage(ctx, req)
+			require.Equal(t, tt.want, response)
+
+			switch {
+			case tt.code != codes.OK:
+				require.Equal(t, tt.code, status.Code(err))
+			default:
+				require.Equal(t, tt.err, err)
+			}
+		})
+	}
+}
+
+func TestDeleteMessage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = value
+		messageID = gofakeit.Int64()
+		username  = gofakeit.Username()
+		deletedAt = time.Now()
+
+		req = &desc.DeleteMessageRequest{
+			ChatId:    chatID,
+			MessageId: messageID,
+		}
+
+		deletion = &model.DeleteMessage{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Username:  username,
+		}
+	)
+
+	chatServiceMock := serviceMocks.NewChatServiceMock(mc)
+	chatServiceMock.DeleteMessageMock.Expect(minimock.AnyContext, deletion).Return(&model.Message{
+		ID:        messageID,
+		From:      username,
+		DeletedAt: &deletedAt,
+	}, nil)
+
+	accessClientMock := clientMocks.NewAccessServiceClientMock(mc)
+	accessClientMock.CheckMock.Return(nil)
+	accessClientMock.CallerMock.Return(username, nil)
+
+	handler := chatHandler.NewImplementation(chatServiceMock, nil, nil, accessClientMock, chatHandler.StreamOptions{})
+
+	response, err := handler.DeleteMessage(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, &emptypb.Empty{}, response)
+}
+
+func TestListMessages(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = value
+		createdAt = time.Now()
+		from      = gofakeit.Username()
+		text      = gofakeit.Color()
+
+		req = &desc.ListMessagesRequest{
+			ChatId:   chatID,
+			BeforeId: 10,
+			Limit:    5,
+		}
+
+		page = &model.MessagesPage{
+			ChatID:   chatID,
+			BeforeID: 10,
+			Limit:    5,
+		}
+
+		res = &desc.ListMessagesResponse{
+			Messages: []*desc.Message{
+				{
+					Id:        9,
+					From:      from,
+					Text:      text,
+					CreatedAt: timestamppb.New(createdAt),
+					Reactions: []*desc.ReactionCount{{Emoji: "👍", Count: 2}},
+				},
+			},
+		}
+	)
+
+	chatServiceMock := serviceMocks.NewChatServiceMock(mc)
+	chatServiceMock.ListMessagesMock.Expect(minimock.AnyContext, page).Return([]*model.Message{
+		{
+			ID:        9,
+			From:      from,
+			Text:      text,
+			CreatedAt: createdAt,
+			Reactions: []model.ReactionCount{{Emoji: "👍", Count: 2}},
+		},
+	}, nil)
+
+	accessClientMock := clientMocks.NewAccessServiceClientMock(mc)
+	accessClientMock.CheckMock.Return(nil)
+
+	handler := chatHandler.NewImplementation(chatServiceMock, nil, nil, accessClientMock, chatHandler.StreamOptions{})
+
+	response, err := handler.ListMessages(ctx, req)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(res, response))
+}
+
+func TestListThread(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = value
+		messageID = gofakeit.Int64()
+		from      = gofakeit.Username()
+
+		req = &desc.ListThreadRequest{
+			ChatId:    chatID,
+			MessageId: messageID,
+		}
+
+		page = &model.ThreadPage{
+			ChatID:    chatID,
+			MessageID: messageID,
+		}
+	)
+
+	chatServiceMock := serviceMocks.NewChatServiceMock(mc)
+	chatServiceMock.ListThreadMock.Expect(minimock.AnyContext, page).Return(&model.Thread{
+		Parent: &model.Message{ID: messageID, From: from, ReplyCount: 1},
+		Replies: []*model.Message{
+			{
+				ID:        messageID + 1,
+				ReplyToID: messageID,
+				Parent:    &model.MessagePreview{ID: messageID, From: from},
+			},
+		},
+	}, nil)
+
+	accessClientMock := clientMocks.NewAccessServiceClientMock(mc)
+	accessClientMock.CheckMock.Return(nil)
+
+	handler := chatHandler.NewImplementation(chatServiceMock, nil, nil, accessClientMock, chatHandler.StreamOptions{})
+
+	response, err := handler.ListThread(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), response.GetParent().GetReplyCount())
+	require.Len(t, response.GetReplies(), 1)
+	require.Equal(t, messageID, response.GetReplies()[0].GetReplyToMessageId())
+	require.Equal(t, from, response.GetReplies()[0].GetParent().GetFrom())
+}
+
+func TestSearchMessages(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID   = value
+		username = gofakeit.Username()
+		text     = gofakeit.Color()
+	)
+
+	tests := []struct {
+		name       string
+		caller     string
+		cursor     string
+		limit      int64
+		beforeID   int64
+		nextCursor string
+		code       codes.Code
+	}{
+		{
+			name:       "full page has next cursor",
+			caller:     username,
+			limit:      1,
+			nextCursor: "Nw",
+			code:       codes.OK,
+		},
+		{
+			name:     "cursor is decoded",
+			caller:   username,
+			cursor:   "MTA",
+			limit:    2,
+			beforeID: 10,
+			code:     codes.OK,
+		},
+		{
+			name:   "invalid cursor",
+			caller: username,
+			cursor: "!",
+			code:   codes.InvalidArgument,
+		},
+		{
+			name:   "username of another caller case",
+			caller: gofakeit.Username(),
+			code:   codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatServiceMock := serviceMocks.NewChatServiceMock(mc)
+			if tt.code == codes.OK {
+				chatServiceMock.SearchMessagesMock.Set(func(_ context.Context, query *model.SearchQuery) ([]*model.SearchResult, error) {
+					require.Equal(t, username, query.Username)
+					require.Equal(t, tt.beforeID, query.BeforeID)
+					require.Equal(t, chatID, query.ChatID)
+
+					return []*model.SearchResult{
+						{
+							ChatID:  chatID,
+							Message: &model.Message{ID: 7, Text: text},
+							Snippet: "<b>" + text + "</b>",
+						},
+					}, nil
+				})
+			}
+
+			accessClientMock := clientMocks.NewAccessServiceClientMock(mc)
+			accessClientMock.CheckMock.Return(nil)
+			accessClientMock.CallerMock.Optional().Return(tt.caller, nil)
+
+			handler := chatHandler.NewImplementation(chatServiceMock, nil, nil, accessClientMock, chatHandler.StreamOptions{})
+
+			response, err := handler.SearchMessages(ctx, &desc.SearchMessagesRequest{
+				Username: username,
+				Query:    text,
+				ChatId:   chatID,
+				Cursor:   tt.cursor,
+				Limit:    tt.limit,
+			})
+			require.Equal(t, tt.code, status.Code(err))
+
+			if tt.code == codes.OK {
+				require.Len(t, response.GetResults(), 1)
+				require.Equal(t, "<b>"+text+"</b>", response.GetResults()[0].GetSnippet())
+				require.Equal(t, tt.nextCursor, response.GetNextCursor())
+			}
+		})
+	}
+}
+
+func TestListAuditLog(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = value
+		messageID = gofakeit.Int64()
+		actor     = gofakeit.Username()
+		traceID   = gofakeit.UUID()
+		since     = time.Now().Add(-time.Hour)
+		createdAt = time.Now()
+
+		accessErr = status.Error(codes.PermissionDenied, "access denied")
+
+		details, _ = structpb.NewStruct(map[string]any{"text": "hi"})
+
+		res = &desc.ListAuditLogResponse{
+			Entries: []*desc.AuditLogEntry{
+				{
+					Id:        1,
+					ChatId:    chatID,
+					MessageId: messageID,
+					Action:    string(model.LogActionDeleteMessage),
+					Actor:     actor,
+					Details:   details,
+					TraceId:   traceID,
+					CreatedAt: timestamppb.New(createdAt),
+				},
+			},
+		}
+	)
+
+	tests := []struct {
+		name      string
+		action    string
+		accessErr error
+		want      *desc.ListAuditLogResponse
+		code      codes.Code
+	}{
+		{
+			name:   "success case",
+			action: string(model.LogActionDeleteMessage),
+			want:   res,
+			code:   codes.OK,
+		},
+		{
+			name:      "not an admin",
+			action:    string(model.LogActionDeleteMessage),
+			accessErr: accessErr,
+			code:      codes.PermissionDenied,
+		},
+		{
+			name:   "unknown action",
+			action: "drop_database",
+			code:   codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatServiceMock := serviceMocks.NewChatServiceMock(mc)
+			if tt.code == codes.OK {
+				chatServiceMock.ListAuditLogMock.Set(func(_ context.Context, got *model.LogFilter) ([]*model.LogEntry, error) {
+					require.Equal(t, chatID, got.ChatID)
+					require.Equal(t, messageID, got.MessageID)
+					require.Equal(t, actor, got.Actor)
+					require.Equal(t, model.LogActionDeleteMessage, got.Action)
+					require.True(t, since.Equal(*got.Since))
+					require.Nil(t, got.Until)
+					require.Equal(t, uint64(10), got.Limit)
+
+					return []*model.LogEntry{
+						{
+							ID:        1,
+							ChatID:    chatID,
+							MessageID: messageID,
+							Action:    model.LogActionDeleteMessage,
+							Actor:     actor,
+							Details:   model.LogDetails{"text": "hi"},
+							TraceID:   traceID,
+							CreatedAt: createdAt,
+						},
+					}, nil
+				})
+			}
+
+			accessClientMock := clientMocks.NewAccessServiceClientMock(mc)
+			accessClientMock.CheckMock.Expect(minimock.AnyContext, "/chat_v1.ChatV1/ListAuditLog").Return(tt.accessErr)
+
+			handler := chatHandler.NewImplementation(chatServiceMock, nil, nil, accessClientMock, chatHandler.StreamOptions{})
+
+			response, err := handler.ListAuditLog(ctx, &desc.ListAuditLogRequest{
+				ChatId:    chatID,
+				MessageId: messageID,
+				Actor:     actor,
+				Action:    tt.action,
+				Since:     timestamppb.New(since),
+				Limit:     10,
+			})
+			require.Equal(t, tt.code, status.Code(err))
+
+			if tt.want != nil {
+				require.True(t, proto.Equal(tt.want, response))
+			}
+		})
+	}
+}
+
+func TestAddReaction(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID    = value
+		messageID = gofakeit.Int64()
+		username  = gofakeit.Username()
+
+		req = &desc.ReactionRequest{
+			ChatId:    chatID,
+			MessageId: messageID,
+			Username:  username,
+			Emoji:     "🔥",
+		}
+
+		reaction = &model.Reaction{
+			ChatID:    chatID,
+			MessageID: messageID,
+			Username:  username,
+			Emoji:     "🔥",
+		}
+	)
+
+	tests := []struct {
+		name       string
+		serviceErr error
+		code       codes.Code
+	}{
+		{
+			name: "success case",
+			code: codes.OK,
+		},
+		{
+			name:       "message not found",
+			serviceErr: model.ErrMessageNotFound,
+			code:       codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chatServiceMock := serviceMocks.NewChatServiceMock(mc)
+			chatServiceMock.AddReactionMock.Expect(minimock.AnyContext, reaction).Return(tt.serviceErr)
+
+			accessClientMock := clientMocks.NewAccessServiceClientMock(mc)
+			accessClientMock.CheckMock.Return(nil)
+
+			handler := chatHandler.NewImplementation(chatServiceMock, nil, nil, accessClientMock, chatHandler.StreamOptions{})
+
+			_, err := handler.AddReaction(ctx, req)
+			require.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		event = &model.ChatEvent{
+			ID:       gofakeit.Int64(),
+			ChatID:   value,
+			Type:     model.ChatEventMessageSent,
+			Username: gofakeit.Username(),
+			Message: &model.Message{
+				ID:   gofakeit.Int64(),
+				From: gofakeit.Username(),
+				Text: gofakeit.Color(),
+			},
+		}
+	)
+
+	handler := chatHandler.NewImplementation(serviceMocks.NewChatServiceMock(mc), nil, nil, clientMocks.NewAccessServiceClientMock(mc),
+		chatHandler.StreamOptions{})
+
+	require.NoError(t, handler.Publish(ctx, &model.ChatEvent{ChatID: value, Type: "unknown"}))
+
+	// Nobody is connected: the events are dropped, no channel fills up.
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	for n := 0; n < 200; n++ {
+		require.NoError(t, handler.Publish(canceled, event))
+	}
+}
+
+func TestPublishRead(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		mc       = minimock.NewController(t)
+		chatID   = gofakeit.Int64()
+		username = member
+	)
+
+	chatService := serviceMocks.NewChatServiceMock(mc)
+	chatService.GetMock.Expect(minimock.AnyContext, chatID).Return(memberChat(chatID), nil)
+	chatService.ListUndeliveredMock.Optional().Return(nil, nil)
+	chatService.GetDeliveryCursorMock.Optional().Return(&model.DeliveryCursor{}, nil)
+	allowSessions(chatService)
+
+	handler := chatHandler.NewImplementation(chatService, nil, nil, allowAccess(mc),
+		chatHandler.StreamOptions{})
+
+	stream := newChatStream(ctx)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- handler.ConnectChatEvents(&desc.ConnectChatRequest{
+			ChatId:   strconv.FormatInt(chatID, 10),
+			Username: username,
+		}, stream)
+	}()
+
+	// The read of another server comes through the outbox.
+	require.Eventually(t, func() bool {
+		require.NoError(t, handler.Publish(ctx, &model.ChatEvent{
+			ChatID:        chatID,
+			Type:          model.ChatEventMessagesRead,
+			Username:      username,
+			ReadMessageID: 7,
+		}))
+
+		stream.mu.Lock()
+		defer stream.mu.Unlock()
+
+		return len(stream.sent) > 0
+	}, time.Second, 10*time.Millisecond)
+
+	stream.mu.Lock()
+	require.Equal(t, username, stream.sent[0].GetRead().GetUsername())
+	require.Equal(t, int64(7), stream.sent[0].GetRead().GetLastMessageId())
+	stream.mu.Unlock()
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
+
+func TestConnectChat(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		mc     = minimock.NewController(t)
+		chatID = gofakeit.Int64()
+		text   = gofakeit.Color()
+	)
+
+	chatService := serviceMocks.NewChatServiceMock(mc)
+	chatService.GetMock.Expect(minimock.AnyContext, chatID).Return(memberChat(chatID), nil)
+	chatService.ListUndeliveredMock.Optional().Return(nil, nil)
+	chatService.GetDeliveryCursorMock.Optional().Return(&model.DeliveryCursor{}, nil)
+	allowSessions(chatService)
+
+	handler := chatHandler.NewImplementation(chatService, nil, nil, allowAccess(mc),
+		chatHandler.StreamOptions{})
+
+	stream := &messageStream{ctx: ctx}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- handler.ConnectChat(&desc.ConnectChatRequest{
+			ChatId: strconv.FormatInt(chatID, 10),
+		}, stream)
+	}()
+
+	// The stream of the messages drops the other events.
+	require.Eventually(t, func() bool {
+		select {
+		case err := <-errCh:
+			t.Fatal(err)
+		default:
+		}
+		require.NoError(t, handler.Publish(ctx, &model.ChatEvent{
+			ChatID:        chatID,
+			Type:          model.ChatEventMessagesRead,
+			Username:      gofakeit.Username(),
+			ReadMessageID: 7,
+		}))
+		require.NoError(t, handler.Publish(ctx, &model.ChatEvent{
+			ChatID:   chatID,
+			Type:     model.ChatEventMessageSent,
+			Username: gofakeit.Username(),
+			Message:  &model.Message{ID: 1, From: gofakeit.Username(), Text: text},
+		}))
+
+		stream.mu.Lock()
+		defer stream.mu.Unlock()
+
+		return len(stream.sent) > 0
+	}, time.Second, 10*time.Millisecond)
+
+	stream.mu.Lock()
+	for _, message := range stream.sent {
+		require.Equal(t, text, message.GetText())
+	}
+	stream.mu.Unlock()
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
+
+func TestDeleteClosesStreams(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+	)
+
+	chatService := serviceMocks.NewChatServiceMock(mc)
+	chatService.GetMock.Expect(minimock.AnyContext, chatID).Return(memberChat(chatID), nil)
+	chatService.ListUndeliveredMock.Optional().Return(nil, nil)
+	chatService.GetDeliveryCursorMock.Optional().Return(&model.DeliveryCursor{}, nil)
+	allowSessions(chatService)
+	chatService.DeleteMock.Expect(minimock.AnyContext, chatID).Return(nil)
+
+	handler := chatHandler.NewImplementation(chatService, nil, nil, allowAccess(mc),
+		chatHandler.StreamOptions{})
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- handler.ConnectChatEvents(&desc.ConnectChatRequest{
+			ChatId: strconv.FormatInt(chatID, 10),
+		}, newChatStream(ctx))
+	}()
+
+	var err error
+	require.Eventually(t, func() bool {
+		_, errDelete := handler.Delete(ctx, &desc.DeleteRequest{Id: chatID})
+		require.NoError(t, errDelete)
+
+		select {
+		case err = <-errCh:
+			return true
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestLastStreamLeaves(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+	)
+
+	// The chat is checked again after the last stream left: its state is gone.
+	chatService := serviceMocks.NewChatServiceMock(mc)
+	chatService.GetMock.Expect(minimock.AnyContext, chatID).Times(2).Return(memberChat(chatID), nil)
+	chatService.ListUndeliveredMock.Optional().Return(nil, nil)
+	chatService.GetDeliveryCursorMock.Optional().Return(&model.DeliveryCursor{}, nil)
+	allowSessions(chatService)
+
+	handler := chatHandler.NewImplementation(chatService, nil, nil, allowAccess(mc),
+		chatHandler.StreamOptions{})
+
+	for n := 0; n < 2; n++ {
+		streamCtx, cancel := context.WithCancel(ctx)
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- handler.ConnectChatEvents(&desc.ConnectChatRequest{
+				ChatId: strconv.FormatInt(chatID, 10),
+			}, newChatStream(streamCtx))
+		}()
+
+		cancel()
+		require.NoError(t, <-errCh)
+	}
+}
+
+func TestPublishClosesStreams(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		eventType model.ChatEventType
+		code      codes.Code
+	}{
+		{
+			name:      "deleted chat case",
+			eventType: model.ChatEventChatDeleted,
+			code:      codes.NotFound,
+		},
+		{
+			name:      "archived chat case",
+			eventType: model.ChatEventChatArchived,
+			code:      codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mc := minimock.NewController(t)
+			chatID := gofakeit.Int64()
+
+			chatService := serviceMocks.NewChatServiceMock(mc)
+			chatService.GetMock.Expect(minimock.AnyContext, chatID).Return(memberChat(chatID), nil)
+			chatService.ListUndeliveredMock.Optional().Return(nil, nil)
+			chatService.GetDeliveryCursorMock.Optional().Return(&model.DeliveryCursor{}, nil)
+			allowSessions(chatService)
+
+			handler := chatHandler.NewImplementation(chatService, nil, nil, allowAccess(mc),
+				chatHandler.StreamOptions{})
+
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- handler.ConnectChatEvents(&desc.ConnectChatRequest{
+					ChatId: strconv.FormatInt(chatID, 10),
+				}, newChatStream(ctx))
+			}()
+
+			// The event closes the streams connected by then, it is repeated
+			// until the stream is in.
+			var err error
+			require.Eventually(t, func() bool {
+				require.NoError(t, handler.Publish(ctx, &model.ChatEvent{ChatID: chatID, Type: tt.eventType}))
+
+				select {
+				case err = <-errCh:
+					return true
+				default:
+					return false
+				}
+			}, time.Second, 10*time.Millisecond)
+
+			require.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestStreamOverflow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		overflow model.OverflowPolicy
+	}{
+		{
+			name:     "drop oldest case",
+			overflow: model.OverflowDropOldest,
+		},
+		{
+			name:     "disconnect case",
+			overflow: model.OverflowDisconnect,
+		},
+		{
+			name:     "block case",
+			overflow: model.OverflowBlock,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			mc := minimock.NewController(t)
+			chatID := gofakeit.Int64()
+
+			chatService := serviceMocks.NewChatServiceMock(mc)
+			chatService.GetMock.Expect(minimock.AnyContext, chatID).Return(memberChat(chatID), nil)
+			chatService.ListUndeliveredMock.Optional().Return(nil, nil)
+			chatService.GetDeliveryCursorMock.Optional().Return(&model.DeliveryCursor{}, nil)
+			allowSessions(chatService)
+
+			handler := chatHandler.NewImplementation(chatService, nil, nil, allowAccess(mc),
+				chatHandler.StreamOptions{QueueSize: 1, Overflow: tt.overflow})
+
+			stream := newSlowStream(ctx)
+
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- handler.ConnectChatEvents(&desc.ConnectChatRequest{
+					ChatId: strconv.FormatInt(chatID, 10),
+				}, stream)
+			}()
+
+			message := func(id int64) *model.ChatEvent {
+				return &model.ChatEvent{
+					ChatID:  chatID,
+					Type:    model.ChatEventMessageSent,
+					Message: &model.Message{ID: id, From: "author", Text: gofakeit.Color()},
+				}
+			}
+
+			// Message 1 is sent at once, the stream is stuck on message 2 and
+			// its queue holds one more.
+			require.Eventually(t, func() bool {
+				require.NoError(t, handler.Publish(ctx, message(1)))
+
+				return stream.joined()
+			}, time.Second, 10*time.Millisecond)
+
+			var publishErr error
+			for id := int64(2); id <= 5; id++ {
+				if err := handler.Publish(ctx, message(id)); err != nil {
+					publishErr = err
+				}
+			}
+
+			close(stream.release)
+
+			switch tt.overflow {
+			case model.OverflowDropOldest:
+				require.NoError(t, publishErr)
+				require.Eventually(t, func() bool {
+					return stream.lastID() == 5
+				}, time.Second, 10*time.Millisecond)
+			case model.OverflowDisconnect, model.OverflowBlock:
+				// The broadcaster waits for no stream, it disconnects a
+				// blocking one.
+				require.NoError(t, publishErr)
+
+				err := <-errCh
+				st := status.Convert(err)
+				require.Equal(t, codes.ResourceExhausted, st.Code())
+				require.Len(t, st.Details(), 1)
+
+				info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+				require.True(t, ok)
+				require.Equal(t, chatHandler.SlowConsumerReason, info.GetReason())
+				require.Equal(t, "1", info.GetMetadata()[chatHandler.ResumeAfterMessageIDKey])
+			}
+		})
+	}
+}
+
+func TestHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mc := minimock.NewController(t)
+	chatID := gofakeit.Int64()
+
+	chatService := serviceMocks.NewChatServiceMock(mc)
+	chatService.GetMock.Expect(minimock.AnyContext, chatID).Return(memberChat(chatID), nil)
+	chatService.ListUndeliveredMock.Optional().Return(nil, nil)
+	chatService.GetDeliveryCursorMock.Optional().Return(&model.DeliveryCursor{}, nil)
+	allowSessions(chatService)
+
+	handler := chatHandler.NewImplementation(chatService, nil, nil, allowAccess(mc),
+		chatHandler.StreamOptions{HeartbeatInterval: 10 * time.Millisecond})
+
+	stream := newChatStream(ctx)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- handler.ConnectChatEvents(&desc.ConnectChatRequest{
+			ChatId: strconv.FormatInt(chatID, 10),
+		}, stream)
+	}()
+
+	require.Eventually(t, func() bool {
+		stream.mu.Lock()
+		defer stream.mu.Unlock()
+
+		for _, event := range stream.sent {
+			if event.GetHeartbeat() != nil && event.GetChatId() == chatID {
+				return true
+			}
+		}
+
+		return false
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
+
+func TestMissedHeartbeats(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mc := minimock.NewController(t)
+	chatID := gofakeit.Int64()
+
+	chatService := serviceMocks.NewChatServiceMock(mc)
+	chatService.GetMock.Expect(minimock.AnyContext, chatID).Return(memberChat(chatID), nil)
+	chatService.ListUndeliveredMock.Optional().Return(nil, nil)
+	chatService.GetDeliveryCursorMock.Optional().Return(&model.DeliveryCursor{}, nil)
+	allowSessions(chatService)
+
+	handler := chatHandler.NewImplementation(chatService, nil, nil, allowAccess(mc),
+		chatHandler.StreamOptions{HeartbeatInterval: 10 * time.Millisecond, MissedHeartbeats: 2})
+
+	// The stream is stuck on its first heartbeat until release.
+	stream := newSlowStream(ctx)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- handler.ConnectChatEvents(&desc.ConnectChatRequest{
+			ChatId: strconv.FormatInt(chatID, 10),
+		}, stream)
+	}()
+
+	// The stuck stream misses many more heartbeats than allowed before it
+	// is released, it is removed meanwhile.
+	time.Sleep(20 * 10 * time.Millisecond)
+	close(stream.release)
+
+	select {
+	case err := <-errCh:
+		require.Equal(t, codes.Unavailable, status.Code(err))
+	case <-time.After(time.Second):
+		t.Fatal("stuck stream kept its place")
+	}
+}
+
+func TestMissedHeartbeatAnswers(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mc := minimock.NewController(t)
+
+	chatService := serviceMocks.NewChatServiceMock(mc)
+	chatService.GetMock.Expect(minimock.AnyContext, value).Return(memberChat(value), nil)
+	chatService.ListUndeliveredMock.Optional().Return(nil, nil)
+	chatService.GetDeliveryCursorMock.Optional().Return(&model.DeliveryCursor{}, nil)
+	allowSessions(chatService)
+
+	handler := chatHandler.NewImplementation(chatService, nil, nil, allowAccess(mc),
+		chatHandler.StreamOptions{HeartbeatInterval: 10 * time.Millisecond, MissedHeartbeats: 2})
+
+	stream := newPingStream(ctx)
+	stream.answer.Store(true)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- handler.Chat(stream)
+	}()
+
+	stream.frames <- &desc.ChatRequest{Payload: &desc.ChatRequest_Join{Join: &desc.JoinChat{ChatId: value}}}
+
+	// The answering client outlives the missed heartbeats.
+	require.Eventually(t, func() bool {
+		return stream.heartbeats.Load() > 10
+	}, time.Second, 10*time.Millisecond)
+	require.Empty(t, errCh)
+
+	// The client stops reading and answering, the sends still go through.
+	stream.answer.Store(false)
+
+	select {
+	case err := <-errCh:
+		require.Equal(t, codes.Unavailable, status.Code(err))
+	case <-time.After(time.Second):
+		t.Fatal("silent client kept its stream")
+	}
+}
+
+func TestRedelivery(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		mc       = minimock.NewController(t)
+		chatID   = gofakeit.Int64()
+		username = member
+
+		deletedAt = time.Now()
+	)
+
+	// The deleted messages are skipped, the queued ones up to the last
+	// redelivered message are sent already. The read state comes next.
+	chatService := serviceMocks.NewChatServiceMock(mc)
+	chatService.GetMock.Expect(minimock.AnyContext, chatID).Return(memberChat(chatID), nil)
+	chatService.ListUndeliveredMock.Expect(minimock.AnyContext, &model.UndeliveredPage{
+		ChatID:   chatID,
+		Username: username,
+		Limit:    100,
+	}).Return([]*model.Message{
+		{ID: 5, From: "author", Text: gofakeit.Color()},
+		{ID: 6, From: username, Text: gofakeit.Color()},
+		{ID: 7, From: "author", DeletedAt: &deletedAt},
+	}, nil)
+	chatService.GetDeliveryCursorMock.Expect(minimock.AnyContext, chatID, username).Return(&model.DeliveryCursor{
+		Username:      username,
+		MessageID:     4,
+		ReadMessageID: 3,
+	}, nil)
+	allowSessions(chatService)
+
+	handler := chatHandler.NewImplementation(chatService, nil, nil, allowAccess(mc),
+		chatHandler.StreamOptions{})
+
+	stream := newChatStream(ctx)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- handler.ConnectChatEvents(&desc.ConnectChatRequest{
+			ChatId:   strconv.FormatInt(chatID, 10),
+			Username: username,
+		}, stream)
+	}()
+
+	sentIDs := func() []int64 {
+		stream.mu.Lock()
+		defer stream.mu.Unlock()
+
+		ids := make([]int64, 0, len(stream.sent))
+		for _, event := range stream.sent {
+			ids = append(ids, event.GetMessage().GetId())
+		}
+
+		return ids
+	}
+
+	require.Eventually(t, func() bool {
+		return len(sentIDs()) == 3
+	}, time.Second, 10*time.Millisecond)
+
+	for _, id := range []int64{7, 8} {
+		require.NoError(t, handler.Publish(ctx, &model.ChatEvent{
+			ChatID:  chatID,
+			Type:    model.ChatEventMessageSent,
+			Message: &model.Message{ID: id, From: "author", Text: gofakeit.Color()},
+		}))
+	}
+
+	require.Eventually(t, func() bool {
+		return len(sentIDs()) == 4
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, []int64{5, 6, 0, 8}, sentIDs())
+
+	stream.mu.Lock()
+	require.Equal(t, int64(3), stream.sent[2].GetRead().GetLastMessageId())
+	stream.mu.Unlock()
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
+
+func TestResume(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		mc       = minimock.NewController(t)
+		chatID   = gofakeit.Int64()
+		username = member
+	)
+
+	// The stored messages after the resumed one are replayed by pages
+	// instead of the ones after the delivered cursor.
+	page := make([]*model.Message, 0, 100)
+	for id := int64(11); id <= 110; id++ {
+		page = append(page, &model.Message{ID: id, From: "author", Text: gofakeit.Color()})
+	}
+
+	chatService := serviceMocks.NewChatServiceMock(mc)
+	chatService.GetMock.Expect(minimock.AnyContext, chatID).Return(memberChat(chatID), nil)
+	chatService.ListAfterMock.When(minimock.AnyContext, chatID, 10, 100).Then(page, nil)
+	chatService.ListAfterMock.When(minimock.AnyContext, chatID, 110, 100).Then([]*model.Message{
+		{ID: 111, From: username, Text: gofakeit.Color()},
+	}, nil)
+	chatService.GetDeliveryCursorMock.Expect(minimock.AnyContext, chatID, username).Return(&model.DeliveryCursor{}, nil)
+	allowSessions(chatService)
+
+	handler := chatHandler.NewImplementation(chatService, nil, nil, allowAccess(mc),
+		chatHandler.StreamOptions{})
+
+	stream := newChatStream(ctx)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- handler.ConnectChatEvents(&desc.ConnectChatRequest{
+			ChatId:         strconv.FormatInt(chatID, 10),
+			Username:       username,
+			AfterMessageId: 10,
+		}, stream)
+	}()
+
+	sentIDs := func() []int64 {
+		stream.mu.Lock()
+		defer stream.mu.Unlock()
+
+		ids := make([]int64, 0, len(stream.sent))
+		for _, event := range stream.sent {
+			ids = append(ids, event.GetMessage().GetId())
+		}
+
+		return ids
+	}
+
+	require.Eventually(t, func() bool {
+		return len(sentIDs()) == 101
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, handler.Publish(ctx, &model.ChatEvent{
+		ChatID:  chatID,
+		Type:    model.ChatEventMessageSent,
+		Message: &model.Message{ID: 112, From: "author", Text: gofakeit.Color()},
+	}))
+
+	require.Eventually(t, func() bool {
+		return len(sentIDs()) == 102
+	}, time.Second, 10*time.Millisecond)
+
+	ids := sentIDs()
+	require.Equal(t, int64(11), ids[0])
+	require.Equal(t, int64(111), ids[100])
+	require.Equal(t, int64(112), ids[101])
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
+
+func TestResumeInvalid(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	handler := chatHandler.NewImplementation(serviceMocks.NewChatServiceMock(mc), nil, nil,
+		allowAccess(mc), chatHandler.StreamOptions{})
+
+	err := handler.ConnectChatEvents(&desc.ConnectChatRequest{
+		ChatId:         strconv.FormatInt(gofakeit.Int64(), 10),
+		Username:       gofakeit.Username(),
+		AfterMessageId: -1,
+	}, newChatStream(context.Background()))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestConnectMissingChat(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc     = minimock.NewController(t)
+		chatID = gofakeit.Int64()
+	)
+
+	chatService := serviceMocks.NewChatServiceMock(mc)
+	chatService.GetMock.Expect(minimock.AnyContext, chatID).Return(nil, nil)
+
+	handler := chatHandler.NewImplementation(chatService, nil, nil, allowAccess(mc), chatHandler.StreamOptions{})
+
+	err := handler.ConnectChatEvents(&desc.ConnectChatRequest{
+		ChatId: strconv.FormatInt(chatID, 10),
+	}, newChatStream(context.Background()))
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestMultiDevice(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		mc       = minimock.NewController(t)
+		chatID   = gofakeit.Int64()
+		username = member
+	)
+
+	chatService := serviceMocks.NewChatServiceMock(mc)
+	chatService.GetMock.Optional().Expect(minimock.AnyContext, chatID).Return(memberChat(chatID), nil)
+	chatService.ListUndeliveredMock.Optional().Return(nil, nil)
+	chatService.GetDeliveryCursorMock.Optional().Return(&model.DeliveryCursor{}, nil)
+
+	// The sessions table of every server.
+	var (
+		mu       sync.Mutex
+		sessions []*model.Session
+	)
+	chatService.OpenSessionMock.Set(func(_ context.Context, session *model.Session) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		sessions = append(sessions, session)
+
+		return nil
+	})
+	chatService.TouchSessionMock.Optional().Return(nil)
+	chatService.CloseSessionMock.Set(func(_ context.Context, id string) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		sessions = slices.DeleteFunc(sessions, func(session *model.Session) bool {
+			return session.ID == id
+		})
+
+		return nil
+	})
+	chatService.ListSessionsMock.Set(func(_ context.Context, filter *model.SessionFilter) ([]*model.Session, error) {
+		require.Equal(t, username, filter.Username)
+		require.WithinDuration(t, time.Now().Add(-4*15*time.Second), filter.ActiveSince, time.Second)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		return slices.Clone(sessions), nil
+	})
+
+	handler := chatHandler.NewImplementation(chatService, nil, nil, allowAccess(mc), chatHandler.StreamOptions{})
+
+	connect := func(deviceID string) (*chatStream, chan error) {
+		stream := newChatStream(ctx)
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- handler.ConnectChatEvents(&desc.ConnectChatRequest{
+				ChatId:   strconv.FormatInt(chatID, 10),
+				Username: username,
+				DeviceId: deviceID,
+			}, stream)
+		}()
+
+		return stream, errCh
+	}
+
+	listSessions := func() []*desc.Session {
+		res, err := handler.ListSessions(ctx, &desc.ListSessionsRequest{Username: username})
+		require.NoError(t, err)
+
+		return res.GetSessions()
+	}
+
+	phone, phoneErr := connect("phone")
+	laptop, _ := connect("laptop")
+
+	require.Eventually(t, func() bool {
+		return len(listSessions()) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// The messages of the user go to every device of the user.
+	require.NoError(t, handler.Publish(ctx, &model.ChatEvent{
+		ChatID:  chatID,
+		Type:    model.ChatEventMessageSent,
+		Message: &model.Message{ID: 1, From: username, Text: gofakeit.Color()},
+	}))
+
+	for _, stream := range []*chatStream{phone, laptop} {
+		stream := stream
+		require.Eventually(t, func() bool {
+			stream.mu.Lock()
+			defer stream.mu.Unlock()
+
+			return len(stream.sent) == 1 && stream.sent[0].GetMessage().GetId() == 1
+		}, time.Second, 10*time.Millisecond)
+	}
+
+	// A new stream of the phone replaces the old one.
+	connect("phone")
+
+	require.Equal(t, codes.Aborted, status.Code(<-phoneErr))
+	require.Eventually(t, func() bool {
+		sessions := listSessions()
+
+		return len(sessions) == 2 && sessions[0].GetDeviceId() == "laptop" && sessions[1].GetDeviceId() == "phone"
+	}, time.Second, 10*time.Millisecond)
+}
+
+// slowStream sends message 1 at once and blocks on the others until release.
+type slowStream struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	release chan struct{}
+
+	mu        sync.Mutex
+	sent      int
+	lastMsgID int64
+}
+
+func newSlowStream(ctx context.Context) *slowStream {
+	return &slowStream{ctx: ctx, release: make(chan struct{})}
+}
+
+func (s *slowStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *slowStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *slowStream) Send(event *desc.ChatEvent) error {
+	if event.GetMessage().GetId() != 1 {
+		<-s.release
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sent++
+	s.lastMsgID = event.GetMessage().GetId()
+
+	return nil
+}
+
+func (s *slowStream) joined() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.sent > 0
+}
+
+func (s *slowStream) lastID() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lastMsgID
+}
+
+// allowSessions takes the session records of the streams.
+func allowSessions(mock *serviceMocks.ChatServiceMock) {
+	mock.OpenSessionMock.Optional().Return(nil)
+	mock.TouchSessionMock.Optional().Return(nil)
+	mock.CloseSessionMock.Optional().Return(nil)
+}
+
+// member is the caller of the access tokens granted by allowAccess.
+var member = gofakeit.Username()
+
+// allowAccess grants every access token, the member's.
+func allowAccess(mc *minimock.Controller) *clientMocks.AccessServiceClientMock {
+	mock := clientMocks.NewAccessServiceClientMock(mc)
+	mock.CheckMock.Return(nil)
+	mock.CallerMock.Optional().Return(member, nil)
+
+	return mock
+}
+
+// memberChat is a chat the member is in.
+func memberChat(chatID int64) *model.Chat {
+	return &model.Chat{ID: chatID, Info: model.ChatInfo{Usernames: []string{member}}}
+}
+
+// chatStream is an in-memory server side of the Chat stream.
+type chatStream struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	frames []*desc.ChatRequest
+	// open keeps the stream open after the frames, until ctx is done.
+	open bool
+
+	mu   sync.Mutex
+	sent []*desc.ChatEvent
+}
+
+func newChatStream(ctx context.Context, frames ...*desc.ChatRequest) *chatStream {
+	return &chatStream{ctx: ctx, frames: frames}
+}
+
+func newOpenChatStream(ctx context.Context, frames ...*desc.ChatRequest) *chatStream {
+	return &chatStream{ctx: ctx, frames: frames, open: true}
+}
+
+func (s *chatStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *chatStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *chatStream) Recv() (*desc.ChatRequest, error) {
+	s.mu.Lock()
+	if len(s.frames) == 0 {
+		s.mu.Unlock()
+
+		if !s.open {
+			return nil, io.EOF
+		}
+
+		<-s.ctx.Done()
+
+		return nil, s.ctx.Err()
+	}
+
+	frame := s.frames[0]
+	s.frames = s.frames[1:]
+	s.mu.Unlock()
+
+	return frame, nil
+}
+
+func (s *chatStream) Send(event *desc.ChatEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sent = append(s.sent, event)
+
+	return nil
+}
+
+// messageStream is an in-memory server side of the ConnectChat stream.
+type messageStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+
+	mu   sync.Mutex
+	sent []*desc.Message
+}
+
+func (s *messageStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *messageStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *messageStream) Send(message *desc.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sent = append(s.sent, message)
+
+	return nil
+}
+
+// pingStream is a Chat stream whose client answers the heartbeats while
+// answer is set. Its sends always go through, like a transport buffering the
+// events of a client that doesn't read them.
+type pingStream struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	frames chan *desc.ChatRequest
+
+	answer     atomic.Bool
+	heartbeats atomic.Int32
+}
+
+func newPingStream(ctx context.Context) *pingStream {
+	return &pingStream{ctx: ctx, frames: make(chan *desc.ChatRequest, 1)}
+}
+
+func (s *pingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *pingStream) Recv() (*desc.ChatRequest, error) {
+	select {
+	case frame := <-s.frames:
+		return frame, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func (s *pingStream) Send(event *desc.ChatEvent) error {
+	if event.GetHeartbeat() == nil {
+		return nil
+	}
+
+	s.heartbeats.Add(1)
+
+	if s.answer.Load() {
+		select {
+		case s.frames <- &desc.ChatRequest{Payload: &desc.ChatRequest_Heartbeat{Heartbeat: event.GetHeartbeat()}}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// cloneFrames copies the frames because the handler fills in the sender.
+func cloneFrames(frames []*desc.ChatRequest) []*desc.ChatRequest {
+	res := make([]*desc.ChatRequest, 0, len(frames))
+	for _, frame := range frames {
+		res = append(res, proto.Clone(frame).(*desc.ChatRequest))
+	}
+
+	return res
+}
@@ -0,0 +1,15 @@
+package tests
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init(zapcore.NewNopCore())
+
+	os.Exit(m.Run())
+}
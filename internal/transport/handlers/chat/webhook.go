@@ -0,0 +1,138 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	conv "github.com/Mobo140/chat/internal/converter"
+	"github.com/Mobo140/chat/internal/model"
+	"github.com/Mobo140/platform_common/pkg/logger"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	desc "github.com/Mobo140/chat/pkg/chat_v1"
+)
+
+func (i *Implementation) CreateWebhook(ctx context.Context, req *desc.CreateWebhookRequest) (*desc.CreateWebhookResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CreateWebhook")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/CreateWebhook")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	webhook, err := i.webhookService.Create(ctx, conv.ToWebhookFromDesc(req))
+	if err != nil {
+		logger.Error("Failed to create webhook", zap.Int64("chat_id", req.GetChatId()), zap.Error(err))
+
+		return nil, webhookStatus(err)
+	}
+
+	logger.Info("Create webhook: ", zap.Int64("id", webhook.ID), zap.Int64("chat_id", webhook.ChatID))
+
+	return &desc.CreateWebhookResponse{
+		Id:     webhook.ID,
+		Secret: webhook.Secret,
+	}, nil
+}
+
+func (i *Implementation) ListWebhooks(ctx context.Context, req *desc.ListWebhooksRequest) (*desc.ListWebhooksResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListWebhooks")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/ListWebhooks")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	webhooks, err := i.webhookService.List(ctx, req.GetChatId())
+	if err != nil {
+		logger.Error("Failed to list webhooks", zap.Int64("chat_id", req.GetChatId()), zap.Error(err))
+
+		return nil, err
+	}
+
+	return &desc.ListWebhooksResponse{
+		Webhooks: conv.ToWebhooksFromService(webhooks),
+	}, nil
+}
+
+func (i *Implementation) DeleteWebhook(ctx context.Context, req *desc.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DeleteWebhook")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/DeleteWebhook")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	err = i.webhookService.Delete(ctx, req.GetId())
+	if err != nil {
+		logger.Error("Failed to delete webhook", zap.Int64("id", req.GetId()), zap.Error(err))
+
+		return nil, webhookStatus(err)
+	}
+
+	logger.Info("Delete webhook: ", zap.Int64("id", req.GetId()))
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) ListWebhookDeliveries(
+	ctx context.Context,
+	req *desc.ListWebhookDeliveriesRequest,
+) (*desc.ListWebhookDeliveriesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListWebhookDeliveries")
+	defer span.Finish()
+
+	logger.Info("Checking the access token...")
+
+	err := i.accessServiceClient.Check(ctx, "/chat_v1.ChatV1/ListWebhookDeliveries")
+	if err != nil {
+		logger.Error("Failed to check the access token", zap.Error(err))
+
+		return nil, err
+	}
+	logger.Info("Access granted")
+
+	attempts, err := i.webhookService.ListAttempts(ctx, conv.ToWebhookAttemptFilterFromDesc(req))
+	if err != nil {
+		logger.Error("Failed to list webhook deliveries", zap.Int64("webhook_id", req.GetWebhookId()), zap.Error(err))
+
+		return nil, err
+	}
+
+	return &desc.ListWebhookDeliveriesResponse{
+		Deliveries: conv.ToWebhookDeliveriesFromService(attempts),
+	}, nil
+}
+
+func webhookStatus(err error) error {
+	switch {
+	case errors.Is(err, model.ErrWebhookNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrInvalidWebhook):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
@@ -12,7 +12,16 @@ type ChatAPIHandler interface {
 	Get(ctx context.Context, req *desc.GetRequest) (*desc.GetResponse, error)
 	Delete(ctx context.Context, req *desc.DeleteRequest) (*emptypb.Empty, error)
 	// Update(ctx context.Context, info *model.UpdateInfo) error
-	SendMessage(cfg context.Context, req *desc.SendMessageRequest) (*emptypb.Empty, error)
+	SendMessage(cfg context.Context, req *desc.SendMessageRequest) (*desc.SendMessageResponse, error)
+	EditMessage(ctx context.Context, req *desc.EditMessageRequest) (*emptypb.Empty, error)
+	DeleteMessage(ctx context.Context, req *desc.DeleteMessageRequest) (*emptypb.Empty, error)
+	ListMessages(ctx context.Context, req *desc.ListMessagesRequest) (*desc.ListMessagesResponse, error)
+	ListThread(ctx context.Context, req *desc.ListThreadRequest) (*desc.ListThreadResponse, error)
+	SearchMessages(ctx context.Context, req *desc.SearchMessagesRequest) (*desc.SearchMessagesResponse, error)
+	ListAuditLog(ctx context.Context, req *desc.ListAuditLogRequest) (*desc.ListAuditLogResponse, error)
+	AddReaction(ctx context.Context, req *desc.ReactionRequest) (*emptypb.Empty, error)
+	RemoveReaction(ctx context.Context, req *desc.ReactionRequest) (*emptypb.Empty, error)
 	ConnectChat(ctx context.Context, req *desc.ConnectChatRequest, stream desc.ChatV1_ConnectChatServer)  error
+	Chat(stream desc.ChatV1_ChatServer) error
 	// GetMessagesByChatID()
 }
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE chat ADD COLUMN admins TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE message ADD COLUMN edited_at TIMESTAMP;
+ALTER TABLE message ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE TABLE message_edits (
+    id SERIAL PRIMARY KEY,
+    message_id INT NOT NULL,
+    text TEXT NOT NULL,
+    edited_by VARCHAR(255) NOT NULL,
+    edited_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (message_id) REFERENCES message(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE message_edits;
+
+ALTER TABLE message DROP COLUMN deleted_at;
+ALTER TABLE message DROP COLUMN edited_at;
+
+ALTER TABLE chat DROP COLUMN admins;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE message_reactions (
+    id SERIAL PRIMARY KEY,
+    message_id INT NOT NULL,
+    username VARCHAR(255) NOT NULL,
+    emoji VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (message_id) REFERENCES message(id) ON DELETE CASCADE,
+    UNIQUE (message_id, username, emoji)
+);
+
+CREATE INDEX message_chat_id_id_idx ON message (chat_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX message_chat_id_id_idx;
+
+DROP TABLE message_reactions;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE message ADD COLUMN reply_to_message_id INT REFERENCES message(id) ON DELETE SET NULL;
+
+CREATE INDEX message_reply_to_message_id_idx ON message (reply_to_message_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX message_reply_to_message_id_idx;
+
+ALTER TABLE message DROP COLUMN reply_to_message_id;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE message ADD COLUMN text_search TSVECTOR
+    GENERATED ALWAYS AS (to_tsvector('simple', text)) STORED;
+
+CREATE INDEX message_text_search_idx ON message USING GIN (text_search);
+
+CREATE INDEX message_from_user_idx ON message (from_user);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX message_from_user_idx;
+
+DROP INDEX message_text_search_idx;
+
+ALTER TABLE message DROP COLUMN text_search;
+-- +goose StatementEnd
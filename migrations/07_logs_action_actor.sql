@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE logs
+    ADD COLUMN action VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN actor VARCHAR(255) NOT NULL DEFAULT '';
+
+UPDATE logs SET action = CASE split_part(activity, ':', 1)
+        WHEN 'Create chat' THEN 'create_chat'
+        WHEN 'Get chat' THEN 'get_chat'
+        WHEN 'Delete chat' THEN 'delete_chat'
+        WHEN 'Send message to chat' THEN 'send_message'
+        WHEN 'Edit message' THEN 'edit_message'
+        WHEN 'Delete message' THEN 'delete_message'
+        WHEN 'List messages' THEN 'list_messages'
+        WHEN 'List thread' THEN 'list_thread'
+        WHEN 'Search messages' THEN 'search_messages'
+        WHEN 'Add reaction' THEN 'add_reaction'
+        WHEN 'Remove reaction' THEN 'remove_reaction'
+        ELSE ''
+    END,
+    actor = COALESCE(
+        substring(activity FROM 'By:([^,]*)'),
+        substring(activity FROM 'From:([^,]*)'),
+        ''
+    );
+
+CREATE INDEX logs_chat_id_id_idx ON logs (chat_id, id);
+
+CREATE INDEX logs_actor_idx ON logs (actor);
+
+CREATE INDEX logs_created_at_idx ON logs (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX logs_created_at_idx;
+
+DROP INDEX logs_actor_idx;
+
+DROP INDEX logs_chat_id_id_idx;
+
+ALTER TABLE logs DROP COLUMN actor, DROP COLUMN action;
+-- +goose StatementEnd
@@ -0,0 +1,59 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE log_action AS ENUM (
+    'unknown',
+    'create_chat',
+    'get_chat',
+    'delete_chat',
+    'send_message',
+    'edit_message',
+    'delete_message',
+    'list_messages',
+    'list_thread',
+    'search_messages',
+    'add_reaction',
+    'remove_reaction',
+    'list_audit_log'
+);
+
+ALTER TABLE logs
+    ADD COLUMN message_id INT,
+    ADD COLUMN details JSONB NOT NULL DEFAULT '{}',
+    ADD COLUMN trace_id VARCHAR(64) NOT NULL DEFAULT '';
+
+-- The free-text activity of the existing rows is kept in the details.
+UPDATE logs SET
+    details = jsonb_build_object('activity', activity),
+    message_id = substring(activity FROM 'MessageID:(\d+)')::INT,
+    action = CASE WHEN action = '' THEN 'unknown' ELSE action END;
+
+ALTER TABLE logs
+    ALTER COLUMN action DROP DEFAULT,
+    ALTER COLUMN action TYPE log_action USING action::log_action,
+    DROP COLUMN activity;
+
+CREATE INDEX logs_message_id_idx ON logs (message_id) WHERE message_id IS NOT NULL;
+
+CREATE INDEX logs_action_id_idx ON logs (action, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX logs_action_id_idx;
+
+DROP INDEX logs_message_id_idx;
+
+ALTER TABLE logs
+    ADD COLUMN activity VARCHAR(255) NOT NULL DEFAULT '',
+    ALTER COLUMN action TYPE VARCHAR(64) USING action::TEXT,
+    ALTER COLUMN action SET DEFAULT '';
+
+UPDATE logs SET activity = left(COALESCE(details->>'activity', action || ': ' || details::TEXT), 255);
+
+ALTER TABLE logs
+    DROP COLUMN trace_id,
+    DROP COLUMN details,
+    DROP COLUMN message_id;
+
+DROP TYPE log_action;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Entries written before the chain keep empty hashes, the chain starts after them.
+ALTER TABLE logs
+    ADD COLUMN prev_hash VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN hash VARCHAR(64) NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE logs
+    DROP COLUMN hash,
+    DROP COLUMN prev_hash;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Chat events written with the change that caused them, the relay publishes
+-- them after the commit.
+CREATE TABLE outbox (
+    id BIGSERIAL PRIMARY KEY,
+    chat_id INT NOT NULL,
+    event_type VARCHAR(32) NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMP
+);
+
+CREATE INDEX outbox_pending_idx ON outbox (id) WHERE published_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE outbox;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- chat_ids is empty for the bots of every chat.
+CREATE TABLE bots (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(64) NOT NULL UNIQUE,
+    chat_ids BIGINT[] NOT NULL DEFAULT '{}',
+    rate_limit INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Only the SHA-256 of a key is stored, the key itself is shown once.
+CREATE TABLE bot_keys (
+    id SERIAL PRIMARY KEY,
+    bot_id INT NOT NULL REFERENCES bots(id) ON DELETE CASCADE,
+    key_hash CHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP
+);
+
+CREATE INDEX bot_keys_bot_id_idx ON bot_keys (bot_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE bot_keys;
+DROP TABLE bots;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE chat ADD COLUMN topic TEXT NOT NULL DEFAULT '';
+
+-- Users muted by /mute, a mute is over once muted_until is past.
+CREATE TABLE chat_mutes (
+    chat_id INT NOT NULL REFERENCES chat(id) ON DELETE CASCADE,
+    username TEXT NOT NULL,
+    muted_until TIMESTAMP NOT NULL,
+    PRIMARY KEY (chat_id, username)
+);
+
+ALTER TYPE log_action ADD VALUE 'run_command';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- run_command stays in log_action: enum values cannot be dropped.
+DROP TABLE chat_mutes;
+ALTER TABLE chat DROP COLUMN topic;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Messages waiting for scheduled_at, removed once sent. A message that can
+-- not be sent anymore, e.g. its author was muted, is kept with the error
+-- until it is canceled. scheduled_at comes from the clients, with a zone.
+CREATE TABLE scheduled_messages (
+    id BIGSERIAL PRIMARY KEY,
+    chat_id INT NOT NULL REFERENCES chat(id) ON DELETE CASCADE,
+    from_user VARCHAR(255) NOT NULL,
+    text TEXT NOT NULL,
+    reply_to_message_id INT,
+    scheduled_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    error TEXT
+);
+
+CREATE INDEX scheduled_messages_due_idx ON scheduled_messages (scheduled_at) WHERE error IS NULL;
+CREATE INDEX scheduled_messages_chat_id_from_user_idx ON scheduled_messages (chat_id, from_user);
+
+ALTER TYPE log_action ADD VALUE 'schedule_message';
+ALTER TYPE log_action ADD VALUE 'list_scheduled';
+ALTER TYPE log_action ADD VALUE 'cancel_scheduled';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- The log_action values stay: enum values cannot be dropped.
+DROP TABLE scheduled_messages;
+-- +goose StatementEnd
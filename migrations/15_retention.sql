@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Retention overrides of a chat: NULL inherits the global setting, zero
+-- keeps every message.
+ALTER TABLE chat
+    ADD COLUMN retention_max_age_seconds BIGINT,
+    ADD COLUMN retention_max_count INT;
+
+CREATE INDEX message_chat_id_timestamp_idx ON message (chat_id, timestamp);
+
+ALTER TYPE log_action ADD VALUE 'set_retention';
+ALTER TYPE log_action ADD VALUE 'purge_messages';
+ALTER TYPE log_action ADD VALUE 'purge_logs';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- The log_action values stay: enum values cannot be dropped.
+DROP INDEX message_chat_id_timestamp_idx;
+
+ALTER TABLE chat
+    DROP COLUMN retention_max_count,
+    DROP COLUMN retention_max_age_seconds;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE log_action ADD VALUE 'import_chat';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- The log_action values stay: enum values cannot be dropped.
+SELECT 1;
+-- +goose StatementEnd
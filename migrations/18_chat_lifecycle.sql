@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- An archived chat is read only. A deleted chat is hidden until the purger
+-- deletes it for good after the grace period, it can be restored until then.
+ALTER TABLE chat
+    ADD COLUMN archived_at TIMESTAMP,
+    ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE INDEX chat_deleted_at_idx ON chat (deleted_at) WHERE deleted_at IS NOT NULL;
+
+ALTER TYPE log_action ADD VALUE 'archive_chat';
+ALTER TYPE log_action ADD VALUE 'unarchive_chat';
+ALTER TYPE log_action ADD VALUE 'restore_chat';
+ALTER TYPE log_action ADD VALUE 'purge_chat';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- The log_action values stay: enum values cannot be dropped.
+DROP INDEX chat_deleted_at_idx;
+
+ALTER TABLE chat
+    DROP COLUMN deleted_at,
+    DROP COLUMN archived_at;
+-- +goose StatementEnd
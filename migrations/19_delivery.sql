@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The delivered cursor of a chat member: the member acknowledged every message
+-- of the chat up to message_id. The messages after it are the offline queue of
+-- the member, sent again on the next connect.
+CREATE TABLE delivery_cursors (
+    chat_id INT NOT NULL REFERENCES chat(id) ON DELETE CASCADE,
+    username VARCHAR(255) NOT NULL,
+    message_id INT NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (chat_id, username)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE delivery_cursors;
+-- +goose StatementEnd
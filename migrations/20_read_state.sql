@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The read cursor of a chat member, shared by the devices of the member. A
+-- read message is delivered too: message_id is never behind it.
+ALTER TABLE delivery_cursors
+    ADD COLUMN read_message_id INT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE delivery_cursors
+    DROP COLUMN read_message_id;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The streams connected to the chats, on every server. A stream removes its
+-- row when it ends, the row of a server that died stays behind: a session is
+-- stale once last_active_at is older than the missed heartbeats.
+CREATE TABLE chat_sessions (
+    id VARCHAR(32) PRIMARY KEY,
+    chat_id INT NOT NULL REFERENCES chat(id) ON DELETE CASCADE,
+    username VARCHAR(255) NOT NULL,
+    device_id VARCHAR(255) NOT NULL,
+    connected_at TIMESTAMPTZ NOT NULL,
+    last_active_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX chat_sessions_username_idx ON chat_sessions (username, connected_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE chat_sessions;
+-- +goose StatementEnd
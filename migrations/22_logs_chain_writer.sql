@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The entries are inserted unchained and linked later by the chainer, in the
+-- order of chain_seq. The chain is keyed from now on: the unkeyed hashes are
+-- dropped and the existing entries are left before the chain.
+ALTER TABLE logs
+    ADD COLUMN chain_seq BIGINT;
+
+UPDATE logs SET chain_seq = id, prev_hash = '', hash = '';
+
+CREATE UNIQUE INDEX logs_chain_seq_idx ON logs (chain_seq);
+CREATE INDEX logs_unchained_idx ON logs (id) WHERE chain_seq IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX logs_unchained_idx;
+DROP INDEX logs_chain_seq_idx;
+
+ALTER TABLE logs
+    DROP COLUMN chain_seq;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The purger deletes the published events by age.
+CREATE INDEX outbox_published_idx ON outbox (published_at) WHERE published_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX outbox_published_idx;
+-- +goose StatementEnd